@@ -320,6 +320,7 @@ func (c *Client) List(ctx context.Context, authMethodId string, opt ...Option) (
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["auth_method_id"] = authMethodId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "accounts"
 	if opts.withResourcePathOverride != "" {
@@ -474,6 +475,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *AccountListResul
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "accounts"
 	if opts.withResourcePathOverride != "" {
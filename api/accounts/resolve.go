@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ResolveIdentifiersResult contains the outcome of resolving external account
+// identifiers to accounts within a single auth method.
+type ResolveIdentifiersResult struct {
+	// Accounts maps each identifier that matched exactly one account to that
+	// account.
+	Accounts map[string]*Account
+	// Unresolved contains the identifiers that did not match any account.
+	Unresolved []string
+	// Ambiguous contains the identifiers that matched more than one account.
+	Ambiguous []string
+}
+
+// ResolveIdentifiers lists the accounts of the given auth method and matches
+// each of the given identifiers against them. An identifier matches an account
+// if it equals the account's ID, login name or OIDC subject, or if it equals the
+// account's email address (compared case-insensitively). This is useful when
+// syncing membership from external systems that know users by email address or
+// subject rather than by Boundary ID.
+func (c *Client) ResolveIdentifiers(ctx context.Context, authMethodId string, identifiers []string, opt ...Option) (*ResolveIdentifiersResult, error) {
+	if authMethodId == "" {
+		return nil, fmt.Errorf("empty authMethodId value passed into ResolveIdentifiers request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in ResolveIdentifiers request")
+	}
+
+	l, err := c.List(ctx, authMethodId, opt...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing accounts during ResolveIdentifiers call: %w", err)
+	}
+	return resolveIdentifiers(l.GetItems(), identifiers), nil
+}
+
+func resolveIdentifiers(accts []*Account, identifiers []string) *ResolveIdentifiersResult {
+	ret := &ResolveIdentifiersResult{
+		Accounts: make(map[string]*Account, len(identifiers)),
+	}
+	for _, ident := range identifiers {
+		ident = strings.TrimSpace(ident)
+		if ident == "" {
+			continue
+		}
+		if _, ok := ret.Accounts[ident]; ok || slices.Contains(ret.Ambiguous, ident) || slices.Contains(ret.Unresolved, ident) {
+			continue
+		}
+		var matched []*Account
+		for _, a := range accts {
+			if accountMatches(a, ident) {
+				matched = append(matched, a)
+			}
+		}
+		switch len(matched) {
+		case 0:
+			ret.Unresolved = append(ret.Unresolved, ident)
+		case 1:
+			ret.Accounts[ident] = matched[0]
+		default:
+			ret.Ambiguous = append(ret.Ambiguous, ident)
+		}
+	}
+	return ret
+}
+
+func accountMatches(a *Account, ident string) bool {
+	if a == nil {
+		return false
+	}
+	if a.Id == ident {
+		return true
+	}
+	for _, attr := range []string{"login_name", "subject"} {
+		if v, ok := a.Attributes[attr].(string); ok && v == ident {
+			return true
+		}
+	}
+	if v, ok := a.Attributes["email"].(string); ok && strings.EqualFold(v, ident) {
+		return true
+	}
+	return false
+}
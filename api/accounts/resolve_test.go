@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accounts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveIdentifiers(t *testing.T) {
+	accts := []*Account{
+		{Id: "acctpw_1", Attributes: map[string]any{"login_name": "alice"}},
+		{Id: "acctoidc_2", Attributes: map[string]any{"subject": "sub-bob", "email": "Bob@Example.com"}},
+		{Id: "acctoidc_3", Attributes: map[string]any{"subject": "sub-carol", "email": "shared@example.com"}},
+		{Id: "acctoidc_4", Attributes: map[string]any{"subject": "sub-dave", "email": "shared@example.com"}},
+	}
+
+	got := resolveIdentifiers(accts, []string{
+		"alice",
+		"bob@example.com",
+		"sub-carol",
+		"acctoidc_4",
+		"shared@example.com",
+		"nobody",
+		" alice ",
+		"",
+	})
+	assert.Equal(t, map[string]*Account{
+		"alice":           accts[0],
+		"bob@example.com": accts[1],
+		"sub-carol":       accts[2],
+		"acctoidc_4":      accts[3],
+	}, got.Accounts)
+	assert.Equal(t, []string{"shared@example.com"}, got.Ambiguous)
+	assert.Equal(t, []string{"nobody"}, got.Unresolved)
+}
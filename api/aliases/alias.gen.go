@@ -326,6 +326,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Alia
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "aliases"
 	if opts.withResourcePathOverride != "" {
@@ -487,6 +488,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *AliasListResult,
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "aliases"
 	if opts.withResourcePathOverride != "" {
@@ -19,6 +19,10 @@ var (
 	// and sets http status http.StatusMethodNotAllowed
 	ErrUnimplemented    = &Error{Kind: codes.Unimplemented.String(), response: &Response{resp: &http.Response{StatusCode: http.StatusMethodNotAllowed}}}
 	ErrInvalidListToken = &Error{Kind: "invalid list token", response: &Response{resp: &http.Response{StatusCode: http.StatusBadRequest}}}
+	// ErrScopeQuotaExceeded is returned when the api request quota of the org
+	// scope a request is made in, or of the org of its project, is exhausted.
+	// Unlike the 429 returned by the rate limiter, it has an error body.
+	ErrScopeQuotaExceeded = &Error{Kind: codes.ResourceExhausted.String(), response: &Response{resp: &http.Response{StatusCode: http.StatusTooManyRequests}}}
 )
 
 // AsServerError returns an api *Error from the provided error.  If the provided error
@@ -0,0 +1,642 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package apitest provides an in-memory Boundary controller API for unit
+// testing programs that use the api clients, without running a controller,
+// a database or Docker.
+//
+// The server implements create, read, update, delete and list for scopes,
+// auth methods, accounts, users, groups, roles, targets and auth tokens,
+// password authentication and setting the members of groups. Items are stored in memory and get the generated
+// fields the controller sets, such as ids, versions, timestamps and scope
+// information. Updates must provide the current version, as with the
+// controller. Filters, grants and other actions are not implemented; requests
+// using them fail with an InvalidArgument error so tests do not silently rely
+// on behavior the server does not have.
+package apitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/go-secure-stdlib/base62"
+)
+
+// Ids and credentials of the items every Server starts with.
+const (
+	GlobalScopeId       = "global"
+	DefaultOrgId        = "o_1234567890"
+	DefaultProjectId    = "p_1234567890"
+	DefaultAuthMethodId = "ampw_1234567890"
+	DefaultAccountId    = "acctpw_1234567890"
+	DefaultUserId       = "u_1234567890"
+	DefaultLoginName    = "admin"
+	DefaultPassword     = "passpass"
+)
+
+// authTokenTtl is the lifetime of the auth tokens the server issues.
+const authTokenTtl = 7 * 24 * time.Hour
+
+// collection describes a resource collection served by the server.
+type collection struct {
+	// parentField is the field of an item holding the id of its parent,
+	// which is also the query parameter used to list the collection.
+	parentField string
+	// prefix returns the id prefix of a new item of the given type whose
+	// parent is the given item.
+	prefix func(parent item, typ string) (string, error)
+	// defaultType is used for new items that do not set a type.
+	defaultType string
+	// readOnly collections cannot be created or updated through the api.
+	readOnly bool
+}
+
+var collections = map[string]collection{
+	"scopes": {
+		parentField: "scope_id",
+		prefix: func(parent item, _ string) (string, error) {
+			switch parent["type"] {
+			case "global":
+				return "o", nil
+			case "org":
+				return "p", nil
+			default:
+				return "", fmt.Errorf("projects cannot contain scopes")
+			}
+		},
+	},
+	"auth-methods": {
+		parentField: "scope_id",
+		defaultType: "password",
+		prefix:      typedPrefix(map[string]string{"password": "ampw"}),
+	},
+	"accounts": {
+		parentField: "auth_method_id",
+		defaultType: "password",
+		prefix:      typedPrefix(map[string]string{"password": "acctpw"}),
+	},
+	"users":  {parentField: "scope_id", prefix: fixedPrefix("u")},
+	"groups": {parentField: "scope_id", prefix: fixedPrefix("g")},
+	"roles":  {parentField: "scope_id", prefix: fixedPrefix("r")},
+	"targets": {
+		parentField: "scope_id",
+		defaultType: "tcp",
+		prefix:      typedPrefix(map[string]string{"tcp": "ttcp"}),
+	},
+	"auth-tokens": {parentField: "scope_id", readOnly: true},
+}
+
+func fixedPrefix(p string) func(item, string) (string, error) {
+	return func(item, string) (string, error) { return p, nil }
+}
+
+func typedPrefix(prefixes map[string]string) func(item, string) (string, error) {
+	return func(_ item, typ string) (string, error) {
+		p, ok := prefixes[typ]
+		if !ok {
+			return "", fmt.Errorf("type %q is not supported", typ)
+		}
+		return p, nil
+	}
+}
+
+// item is a resource as it is encoded in the api.
+type item map[string]any
+
+// Server is an in-memory implementation of the controller api. It is safe
+// for concurrent use.
+type Server struct {
+	srv *httptest.Server
+
+	mu sync.Mutex
+	// items holds every item by id, and itemCollection the collection of
+	// each item.
+	items          map[string]item
+	itemCollection map[string]string
+	// tokens maps the tokens issued by the server to their auth token ids.
+	tokens map[string]string
+	token  string
+}
+
+// NewServer starts a Server with a global scope, an org and a project, and a
+// password auth method in the global scope with an account for
+// DefaultLoginName and DefaultPassword. The server is closed when the test
+// finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{
+		items:          make(map[string]item),
+		itemCollection: make(map[string]string),
+		tokens:         make(map[string]string),
+	}
+	now := time.Now()
+	s.seed("scopes", item{"id": GlobalScopeId, "type": "global", "name": "global", "description": "Global Scope"}, now)
+	s.seed("scopes", item{"id": DefaultOrgId, "scope_id": GlobalScopeId, "type": "org", "name": "Generated org scope"}, now)
+	s.seed("scopes", item{"id": DefaultProjectId, "scope_id": DefaultOrgId, "type": "project", "name": "Generated project scope"}, now)
+	s.seed("auth-methods", item{"id": DefaultAuthMethodId, "scope_id": GlobalScopeId, "type": "password", "name": "Generated global scope initial password auth method"}, now)
+	s.seed("users", item{"id": DefaultUserId, "scope_id": GlobalScopeId, "name": "admin", "account_ids": []any{DefaultAccountId}}, now)
+	s.seed("accounts", item{"id": DefaultAccountId, "auth_method_id": DefaultAuthMethodId, "type": "password", "attributes": map[string]any{"login_name": DefaultLoginName, "password": DefaultPassword}}, now)
+
+	tok, err := s.issueToken(DefaultAuthMethodId, DefaultAccountId, now)
+	if err != nil {
+		t.Fatalf("error issuing auth token: %v", err)
+	}
+	s.token = tok["token"].(string)
+
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+// Addr returns the address of the server.
+func (s *Server) Addr() string {
+	return s.srv.URL
+}
+
+// Token returns an auth token of the default account.
+func (s *Server) Token() string {
+	return s.token
+}
+
+// Client returns an api client for the server using the auth token of the
+// default account.
+func (s *Server) Client(t testing.TB) *api.Client {
+	t.Helper()
+	client, err := api.NewClient(nil)
+	if err != nil {
+		t.Fatalf("error creating client: %v", err)
+	}
+	if err := client.SetAddr(s.Addr()); err != nil {
+		t.Fatalf("error setting client address: %v", err)
+	}
+	client.SetToken(s.token)
+	return client
+}
+
+func (s *Server) seed(coll string, it item, now time.Time) {
+	it["created_time"] = now.Format(time.RFC3339Nano)
+	it["updated_time"] = now.Format(time.RFC3339Nano)
+	it["version"] = 1
+	s.items[it["id"].(string)] = it
+	s.itemCollection[it["id"].(string)] = coll
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := strings.CutPrefix(r.URL.Path, "/v1/")
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Resource not found.")
+		return
+	}
+	collName, id, _ := strings.Cut(p, "/")
+	id, action, _ := strings.Cut(id, ":")
+	if _, ok := collections[collName]; !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Resource not found.")
+		return
+	}
+
+	if collName == "auth-methods" && action == "authenticate" && r.Method == http.MethodPost {
+		s.authenticate(w, r, id)
+		return
+	}
+	if !s.authenticated(r) {
+		writeError(w, http.StatusUnauthorized, "Unauthenticated", "Unauthenticated, or invalid token.")
+		return
+	}
+	if r.URL.Query().Get("filter") != "" {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "Filters are not supported by apitest.")
+		return
+	}
+	if collName == "groups" && action == "set-members" && r.Method == http.MethodPost {
+		s.setMembers(w, r, id)
+		return
+	}
+	if action != "" {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", fmt.Sprintf("The %q action is not supported by apitest.", action))
+		return
+	}
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		s.list(w, r, collName)
+	case id == "" && r.Method == http.MethodPost:
+		s.create(w, r, collName)
+	case id != "" && r.Method == http.MethodGet:
+		if it, ok := s.get(collName, id); ok {
+			writeJson(w, http.StatusOK, s.present(it))
+			return
+		}
+		writeError(w, http.StatusNotFound, "NotFound", "Resource not found.")
+	case id != "" && r.Method == http.MethodPatch:
+		s.update(w, r, collName, id)
+	case id != "" && r.Method == http.MethodDelete:
+		s.delete(w, collName, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "InvalidArgument", "Method not allowed.")
+	}
+}
+
+func (s *Server) authenticated(r *http.Request) bool {
+	tok, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	atId, ok := s.tokens[tok]
+	if !ok {
+		return false
+	}
+	at, ok := s.items[atId]
+	if !ok {
+		return false
+	}
+	exp, err := time.Parse(time.RFC3339Nano, at["expiration_time"].(string))
+	return err == nil && time.Now().Before(exp)
+}
+
+func (s *Server) get(collName, id string) (item, bool) {
+	if s.itemCollection[id] != collName {
+		return nil, false
+	}
+	return s.items[id], true
+}
+
+// present returns a copy of the item as it is returned by the api, with the
+// information about its scope and without its secrets.
+func (s *Server) present(it item) item {
+	out := make(item, len(it)+2)
+	for k, v := range it {
+		out[k] = v
+	}
+	scopeId, _ := it["scope_id"].(string)
+	if amId, ok := it["auth_method_id"].(string); ok && scopeId == "" {
+		scopeId, _ = s.items[amId]["scope_id"].(string)
+	}
+	if scope, ok := s.items[scopeId]; ok {
+		info := map[string]any{"id": scope["id"], "type": scope["type"]}
+		for _, k := range []string{"name", "description"} {
+			if v, ok := scope[k]; ok {
+				info[k] = v
+			}
+		}
+		if parent, ok := scope["scope_id"]; ok {
+			info["parent_scope_id"] = parent
+		}
+		out["scope"] = info
+	} else if it["type"] == "global" {
+		out["scope"] = map[string]any{"id": "global", "type": "global", "name": "global"}
+	}
+	if attrs, ok := it["attributes"].(map[string]any); ok {
+		a := make(map[string]any, len(attrs))
+		for k, v := range attrs {
+			if k != "password" {
+				a[k] = v
+			}
+		}
+		out["attributes"] = a
+	}
+	delete(out, "token")
+	out["authorized_actions"] = []string{"no-op", "read", "update", "delete"}
+	if s.itemCollection[it["id"].(string)] == "auth-tokens" {
+		out["authorized_actions"] = []string{"no-op", "read", "delete"}
+	}
+	return out
+}
+
+// descendant reports whether the item is a descendant of the scope.
+func (s *Server) descendant(it item, scopeId string) bool {
+	parent, _ := it["scope_id"].(string)
+	if parent == "" {
+		amId, _ := it["auth_method_id"].(string)
+		parent, _ = s.items[amId]["scope_id"].(string)
+	}
+	for parent != "" {
+		if parent == scopeId {
+			return true
+		}
+		parent, _ = s.items[parent]["scope_id"].(string)
+	}
+	return false
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, collName string) {
+	coll := collections[collName]
+	q := r.URL.Query()
+	parentId := q.Get(coll.parentField)
+	if parentId == "" {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", fmt.Sprintf("Missing %s.", coll.parentField))
+		return
+	}
+	if _, ok := s.items[parentId]; !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Resource not found.")
+		return
+	}
+	recursive := q.Get("recursive") == "true"
+
+	items := make([]item, 0)
+	for id, it := range s.items {
+		if s.itemCollection[id] != collName {
+			continue
+		}
+		if it[coll.parentField] == parentId || (recursive && coll.parentField == "scope_id" && s.descendant(it, parentId)) {
+			items = append(items, it)
+		}
+	}
+	slices.SortFunc(items, func(a, b item) int {
+		if c := strings.Compare(b["created_time"].(string), a["created_time"].(string)); c != 0 {
+			return c
+		}
+		return strings.Compare(b["id"].(string), a["id"].(string))
+	})
+	out := make([]item, 0, len(items))
+	for _, it := range items {
+		out = append(out, s.present(it))
+	}
+	writeJson(w, http.StatusOK, map[string]any{
+		"items":          out,
+		"response_type":  "complete",
+		"list_token":     "apitest",
+		"sort_by":        "created_time",
+		"sort_dir":       "desc",
+		"removed_ids":    []string{},
+		"est_item_count": len(out),
+	})
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request, collName string) {
+	coll := collections[collName]
+	if coll.readOnly {
+		writeError(w, http.StatusMethodNotAllowed, "InvalidArgument", "Method not allowed.")
+		return
+	}
+	in, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+	for _, f := range []string{"id", "version", "created_time", "updated_time", "scope", "authorized_actions"} {
+		if _, ok := in[f]; ok {
+			writeError(w, http.StatusBadRequest, "InvalidArgument", fmt.Sprintf("Field %s is read only.", f))
+			return
+		}
+	}
+	parentId, _ := in[coll.parentField].(string)
+	parent, ok := s.items[parentId]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Resource not found.")
+		return
+	}
+	typ, _ := in["type"].(string)
+	if typ == "" {
+		typ = coll.defaultType
+	}
+	prefix, err := coll.prefix(parent, typ)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", err.Error())
+		return
+	}
+	if name, ok := in["name"].(string); ok && name != "" {
+		for id, it := range s.items {
+			if s.itemCollection[id] == collName && it[coll.parentField] == parentId && it["name"] == name {
+				writeError(w, http.StatusBadRequest, "InvalidArgument", fmt.Sprintf("A resource named %q already exists.", name))
+				return
+			}
+		}
+	}
+	id, err := newId(prefix)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "Internal", err.Error())
+		return
+	}
+	in["id"] = id
+	switch collName {
+	case "scopes":
+		in["type"] = map[string]string{"o": "org", "p": "project"}[prefix]
+	default:
+		if typ != "" {
+			in["type"] = typ
+		}
+	}
+	s.seed(collName, in, time.Now())
+	writeJson(w, http.StatusOK, s.present(in))
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request, collName, id string) {
+	coll := collections[collName]
+	it, ok := s.get(collName, id)
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Resource not found.")
+		return
+	}
+	if coll.readOnly {
+		writeError(w, http.StatusMethodNotAllowed, "InvalidArgument", "Method not allowed.")
+		return
+	}
+	in, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+	version, ok := in["version"].(float64)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "Missing version.")
+		return
+	}
+	if int(version) != it["version"].(int) {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "The version is out of date.")
+		return
+	}
+	delete(in, "version")
+	for _, f := range []string{"id", "type", "created_time", "updated_time", "scope", "authorized_actions", coll.parentField} {
+		if _, ok := in[f]; ok {
+			writeError(w, http.StatusBadRequest, "InvalidArgument", fmt.Sprintf("Field %s cannot be updated.", f))
+			return
+		}
+	}
+	merge(it, in)
+	it["version"] = it["version"].(int) + 1
+	it["updated_time"] = time.Now().Format(time.RFC3339Nano)
+	writeJson(w, http.StatusOK, s.present(it))
+}
+
+func (s *Server) setMembers(w http.ResponseWriter, r *http.Request, id string) {
+	it, ok := s.get("groups", id)
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Resource not found.")
+		return
+	}
+	in, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+	version, ok := in["version"].(float64)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "Missing version.")
+		return
+	}
+	if int(version) != it["version"].(int) {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "The version is out of date.")
+		return
+	}
+	if memberIds, ok := in["member_ids"].([]any); ok && len(memberIds) > 0 {
+		it["member_ids"] = memberIds
+	} else {
+		delete(it, "member_ids")
+	}
+	it["version"] = it["version"].(int) + 1
+	it["updated_time"] = time.Now().Format(time.RFC3339Nano)
+	writeJson(w, http.StatusOK, s.present(it))
+}
+
+// merge applies a patch to the item: null values remove fields and objects
+// are merged recursively.
+func merge(dst, patch map[string]any) {
+	for k, v := range patch {
+		switch v := v.(type) {
+		case nil:
+			delete(dst, k)
+		case map[string]any:
+			existing, ok := dst[k].(map[string]any)
+			if !ok {
+				existing = make(map[string]any, len(v))
+				dst[k] = existing
+			}
+			merge(existing, v)
+		default:
+			dst[k] = v
+		}
+	}
+}
+
+func (s *Server) delete(w http.ResponseWriter, collName, id string) {
+	if _, ok := s.get(collName, id); !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Resource not found.")
+		return
+	}
+	if collName == "scopes" && id == GlobalScopeId {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "The global scope cannot be deleted.")
+		return
+	}
+	s.deleteItem(id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteItem deletes the item and the items it contains.
+func (s *Server) deleteItem(id string) {
+	for childId, child := range s.items {
+		if child["scope_id"] == id || child["auth_method_id"] == id {
+			s.deleteItem(childId)
+		}
+	}
+	delete(s.items, id)
+	delete(s.itemCollection, id)
+	for tok, atId := range s.tokens {
+		if atId == id {
+			delete(s.tokens, tok)
+		}
+	}
+}
+
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request, authMethodId string) {
+	if _, ok := s.get("auth-methods", authMethodId); !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "Resource not found.")
+		return
+	}
+	in, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+	if cmd, _ := in["command"].(string); cmd != "login" {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "Only the login command is supported by apitest.")
+		return
+	}
+	attrs, _ := in["attributes"].(map[string]any)
+	for id, acct := range s.items {
+		if s.itemCollection[id] != "accounts" || acct["auth_method_id"] != authMethodId {
+			continue
+		}
+		acctAttrs, _ := acct["attributes"].(map[string]any)
+		if acctAttrs["login_name"] != attrs["login_name"] || acctAttrs["password"] != attrs["password"] {
+			continue
+		}
+		at, err := s.issueToken(authMethodId, id, time.Now())
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "Internal", err.Error())
+			return
+		}
+		out := s.present(at)
+		out["token"] = at["token"]
+		writeJson(w, http.StatusOK, map[string]any{"command": "login", "attributes": out})
+		return
+	}
+	writeError(w, http.StatusUnauthorized, "Unauthenticated", "Authentication failed.")
+}
+
+// issueToken creates an auth token for the account and the user it belongs
+// to, if any.
+func (s *Server) issueToken(authMethodId, accountId string, now time.Time) (item, error) {
+	id, err := newId("at")
+	if err != nil {
+		return nil, err
+	}
+	secret, err := base62.Random(24)
+	if err != nil {
+		return nil, err
+	}
+	var userId string
+	for uId, u := range s.items {
+		if s.itemCollection[uId] == "users" {
+			if ids, ok := u["account_ids"].([]any); ok && slices.Contains(ids, any(accountId)) {
+				userId = uId
+			}
+		}
+	}
+	at := item{
+		"id":                         id,
+		"scope_id":                   s.items[authMethodId]["scope_id"],
+		"token":                      id + "_" + secret,
+		"user_id":                    userId,
+		"auth_method_id":             authMethodId,
+		"account_id":                 accountId,
+		"approximate_last_used_time": now.Format(time.RFC3339Nano),
+		"expiration_time":            now.Add(authTokenTtl).Format(time.RFC3339Nano),
+	}
+	s.seed("auth-tokens", at, now)
+	delete(at, "version")
+	s.tokens[at["token"].(string)] = id
+	return at, nil
+}
+
+func newId(prefix string) (string, error) {
+	r, err := base62.Random(10)
+	if err != nil {
+		return "", fmt.Errorf("error generating id: %w", err)
+	}
+	return prefix + "_" + r, nil
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	in := make(map[string]any)
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", fmt.Sprintf("Error decoding request body: %v.", err))
+		return nil, false
+	}
+	return in, true
+}
+
+func writeJson(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, kind, msg string) {
+	writeJson(w, code, &api.Error{Kind: kind, Message: msg})
+}
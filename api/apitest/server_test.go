@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apitest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/boundary/api/apitest"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Targets(t *testing.T) {
+	ctx := context.Background()
+	s := apitest.NewServer(t)
+	client := s.Client(t)
+	tc := targets.NewClient(client)
+
+	created, err := tc.Create(ctx, "tcp", apitest.DefaultProjectId,
+		targets.WithName("db"),
+		targets.WithTcpTargetDefaultPort(5432),
+		targets.WithAddress("10.0.0.1"))
+	require.NoError(t, err)
+	tar := created.GetItem()
+	assert.Regexp(t, `^ttcp_\w{10}$`, tar.Id)
+	assert.Equal(t, "db", tar.Name)
+	assert.Equal(t, "tcp", tar.Type)
+	assert.EqualValues(t, 1, tar.Version)
+	assert.Equal(t, apitest.DefaultProjectId, tar.Scope.Id)
+	assert.Equal(t, "project", tar.Scope.Type)
+	assert.Equal(t, apitest.DefaultOrgId, tar.Scope.ParentScopeId)
+	assert.EqualValues(t, 5432, tar.Attributes["default_port"])
+	assert.False(t, tar.CreatedTime.IsZero())
+
+	_, err = tc.Create(ctx, "tcp", apitest.DefaultProjectId, targets.WithName("db"), targets.WithTcpTargetDefaultPort(5432))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, api.AsServerError(err).Response().StatusCode())
+
+	updated, err := tc.Update(ctx, tar.Id, tar.Version, targets.WithDescription("primary"), targets.WithTcpTargetDefaultPort(6432))
+	require.NoError(t, err)
+	assert.Equal(t, "primary", updated.GetItem().Description)
+	assert.EqualValues(t, 6432, updated.GetItem().Attributes["default_port"])
+	assert.EqualValues(t, 2, updated.GetItem().Version)
+
+	_, err = tc.Update(ctx, tar.Id, tar.Version, targets.WithDescription("stale"))
+	require.Error(t, err)
+
+	updated, err = tc.Update(ctx, tar.Id, 0, targets.WithAutomaticVersioning(true), targets.DefaultDescription())
+	require.NoError(t, err)
+	assert.Empty(t, updated.GetItem().Description)
+
+	list, err := tc.List(ctx, apitest.DefaultProjectId)
+	require.NoError(t, err)
+	require.Len(t, list.GetItems(), 1)
+	assert.Equal(t, tar.Id, list.GetItems()[0].Id)
+
+	list, err = tc.List(ctx, apitest.GlobalScopeId, targets.WithRecursive(true))
+	require.NoError(t, err)
+	assert.Len(t, list.GetItems(), 1)
+
+	_, err = tc.List(ctx, apitest.DefaultProjectId, targets.WithFilter(`"/item/name" == "db"`))
+	require.Error(t, err)
+
+	_, err = tc.Delete(ctx, tar.Id)
+	require.NoError(t, err)
+	_, err = tc.Read(ctx, tar.Id)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, api.AsServerError(err).Response().StatusCode())
+}
+
+func TestServer_Scopes(t *testing.T) {
+	ctx := context.Background()
+	s := apitest.NewServer(t)
+	sc := scopes.NewClient(s.Client(t))
+
+	org, err := sc.Create(ctx, apitest.GlobalScopeId, scopes.WithName("org"))
+	require.NoError(t, err)
+	assert.Regexp(t, `^o_\w{10}$`, org.GetItem().Id)
+	assert.Equal(t, "org", org.GetItem().Type)
+
+	proj, err := sc.Create(ctx, org.GetItem().Id, scopes.WithName("proj"))
+	require.NoError(t, err)
+	assert.Regexp(t, `^p_\w{10}$`, proj.GetItem().Id)
+
+	_, err = sc.Create(ctx, proj.GetItem().Id)
+	require.Error(t, err)
+
+	list, err := sc.List(ctx, apitest.GlobalScopeId, scopes.WithRecursive(true))
+	require.NoError(t, err)
+	assert.Len(t, list.GetItems(), 4)
+
+	// Deleting a scope deletes the scopes it contains.
+	_, err = sc.Delete(ctx, org.GetItem().Id)
+	require.NoError(t, err)
+	_, err = sc.Read(ctx, proj.GetItem().Id)
+	require.Error(t, err)
+}
+
+func TestServer_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	s := apitest.NewServer(t)
+	client := s.Client(t)
+	client.SetToken("")
+
+	_, err := scopes.NewClient(client).Read(ctx, apitest.GlobalScopeId)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, api.AsServerError(err).Response().StatusCode())
+
+	amClient := authmethods.NewClient(client)
+	_, err = amClient.Authenticate(ctx, apitest.DefaultAuthMethodId, "login", map[string]any{
+		"login_name": apitest.DefaultLoginName,
+		"password":   "wrong",
+	})
+	require.Error(t, err)
+
+	result, err := amClient.Authenticate(ctx, apitest.DefaultAuthMethodId, "login", map[string]any{
+		"login_name": apitest.DefaultLoginName,
+		"password":   apitest.DefaultPassword,
+	})
+	require.NoError(t, err)
+	tok, err := result.GetAuthToken()
+	require.NoError(t, err)
+	assert.Equal(t, apitest.DefaultUserId, tok.UserId)
+	assert.Equal(t, apitest.DefaultAccountId, tok.AccountId)
+	client.SetToken(tok.Token)
+
+	_, err = scopes.NewClient(client).Read(ctx, apitest.GlobalScopeId)
+	require.NoError(t, err)
+
+	// Accounts created through the api can authenticate too.
+	acct, err := accounts.NewClient(client).Create(ctx, apitest.DefaultAuthMethodId,
+		accounts.WithPasswordAccountLoginName("user"),
+		accounts.WithPasswordAccountPassword("userpass"))
+	require.NoError(t, err)
+	assert.NotContains(t, acct.GetItem().Attributes, "password")
+	_, err = amClient.Authenticate(ctx, apitest.DefaultAuthMethodId, "login", map[string]any{
+		"login_name": "user",
+		"password":   "userpass",
+	})
+	require.NoError(t, err)
+
+	atClient := authtokens.NewClient(client)
+	list, err := atClient.List(ctx, apitest.GlobalScopeId)
+	require.NoError(t, err)
+	assert.Len(t, list.GetItems(), 3)
+
+	// Deleting the token logs the client out.
+	_, err = atClient.Delete(ctx, tok.Id)
+	require.NoError(t, err)
+	_, err = scopes.NewClient(client).Read(ctx, apitest.GlobalScopeId)
+	require.Error(t, err)
+}
@@ -30,6 +30,7 @@ type AuthMethod struct {
 	Type                        string                 `json:"type,omitempty"`
 	Attributes                  map[string]interface{} `json:"attributes,omitempty"`
 	IsPrimary                   bool                   `json:"is_primary,omitempty"`
+	ProtectFromDeletion         bool                   `json:"protect_from_deletion,omitempty"`
 	AuthorizedActions           []string               `json:"authorized_actions,omitempty"`
 	AuthorizedCollectionActions map[string][]string    `json:"authorized_collection_actions,omitempty"`
 }
@@ -326,6 +327,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Auth
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "auth-methods"
 	if opts.withResourcePathOverride != "" {
@@ -487,6 +489,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *AuthMethodListRe
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "auth-methods"
 	if opts.withResourcePathOverride != "" {
@@ -211,6 +211,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Auth
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "auth-tokens"
 	if opts.withResourcePathOverride != "" {
@@ -372,6 +373,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *AuthTokenListRes
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "auth-tokens"
 	if opts.withResourcePathOverride != "" {
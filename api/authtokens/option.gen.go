@@ -29,6 +29,7 @@ type options struct {
 	withListToken                string
 	withClientDirectedPagination bool
 	withPageSize                 uint32
+	withExactCount               bool
 	withResourcePathOverride     string
 	withRecursive                bool
 }
@@ -63,6 +64,9 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withPageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
 	}
+	if opts.withExactCount {
+		opts.queryMap["exact_count"] = strconv.FormatBool(opts.withExactCount)
+	}
 	return opts, apiOpts
 }
 
@@ -106,6 +110,15 @@ func WithPageSize(with uint32) Option {
 	}
 }
 
+// WithExactCount tells the API to return an exact item count instead of an
+// estimate in the first page of a List call. The call fails if more than
+// 10,000 items are available.
+func WithExactCount(with bool) Option {
+	return func(o *options) {
+		o.withExactCount = with
+	}
+}
+
 // WithResourcePathOverride tells the API to use the provided resource path
 func WithResourcePathOverride(path string) Option {
 	return func(o *options) {
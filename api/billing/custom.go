@@ -61,3 +61,56 @@ func (c *Client) MonthlyActiveUsers(ctx context.Context, opt ...Option) (*Monthl
 	mau.response = resp
 	return mau, nil
 }
+
+type ScopeUsageResult struct {
+	Items    []*ScopeUsage
+	response *api.Response
+}
+
+func (r ScopeUsageResult) GetItems() any {
+	return r.Items
+}
+
+func (r ScopeUsageResult) GetResponse() *api.Response {
+	return r.response
+}
+
+// ScopeUsage returns the monthly usage of each project scope. WithStartTime
+// and WithEndTime select the months, in the YYYY-MM format.
+func (c *Client) ScopeUsage(ctx context.Context, opt ...Option) (*ScopeUsageResult, error) {
+	opts, apiOpts := getOpts(opt...)
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	req, err := c.client.NewRequest(ctx, "GET", "billing:scope-usage", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ScopeUsage request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ScopeUsage call: %w", err)
+	}
+
+	su := new(ScopeUsageResult)
+	su.Items = []*ScopeUsage{}
+	apiErr, err := resp.Decode(su)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ScopeUsage response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	su.response = resp
+	return su, nil
+}
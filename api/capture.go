@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// redactedValue replaces sensitive values in captured requests and responses.
+const redactedValue = "<redacted>"
+
+// sensitiveFields are the JSON fields whose values are redacted from captured
+// request and response bodies, wherever they appear.
+var sensitiveFields = map[string]struct{}{
+	"authorization_token":         {},
+	"bind_password":               {},
+	"client_certificate_key":      {},
+	"client_secret":               {},
+	"password":                    {},
+	"private_key":                 {},
+	"private_key_passphrase":      {},
+	"secrets":                     {},
+	"token":                       {},
+	"worker_generated_auth_token": {},
+}
+
+// sensitiveHeaders are the headers whose values are redacted from captured
+// requests and responses.
+var sensitiveHeaders = map[string]struct{}{
+	"authorization": {},
+	"cookie":        {},
+	"set-cookie":    {},
+}
+
+// CaptureEntry is a request made by a Client and the response it received,
+// with sensitive values redacted.
+type CaptureEntry struct {
+	StartTime       time.Time
+	Duration        time.Duration
+	Method          string
+	Url             string
+	RequestHeaders  http.Header
+	RequestBody     []byte
+	StatusCode      int
+	Status          string
+	ResponseHeaders http.Header
+	ResponseBody    []byte
+	// Error is set if the request failed without a response.
+	Error string
+}
+
+// Capture records the requests made by the clients it is set on, for
+// debugging and support purposes. Captured entries can be exported as cURL
+// command lines or as a HAR archive. Authorization headers and known secret
+// fields of request and response bodies are redacted when they are recorded,
+// so captured data never contains them. A Capture is safe for concurrent use
+// and can be shared by several clients.
+type Capture struct {
+	l       sync.Mutex
+	entries []*CaptureEntry
+}
+
+// NewCapture creates an empty Capture.
+func NewCapture() *Capture {
+	return &Capture{}
+}
+
+// Entries returns the captured entries in the order the requests were made.
+func (c *Capture) Entries() []*CaptureEntry {
+	c.l.Lock()
+	defer c.l.Unlock()
+	ret := make([]*CaptureEntry, len(c.entries))
+	copy(ret, c.entries)
+	return ret
+}
+
+// Reset removes all captured entries.
+func (c *Capture) Reset() {
+	c.l.Lock()
+	defer c.l.Unlock()
+	c.entries = nil
+}
+
+func (c *Capture) add(e *CaptureEntry) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	c.entries = append(c.entries, e)
+}
+
+// record captures the request, and the response if there is one. The body of
+// the response is read and replaced so it can still be decoded by the caller.
+func (c *Capture) record(start time.Time, r *retryablehttp.Request, resp *http.Response, reqErr error) error {
+	body, err := r.BodyBytes()
+	if err != nil {
+		return fmt.Errorf("error reading request body for capture: %w", err)
+	}
+	e := &CaptureEntry{
+		StartTime:      start,
+		Duration:       time.Since(start),
+		Method:         r.Method,
+		Url:            r.URL.String(),
+		RequestHeaders: sanitizeHeaders(r.Header),
+		RequestBody:    sanitizeBody(body),
+	}
+	if reqErr != nil {
+		e.Error = reqErr.Error()
+	}
+	if resp != nil {
+		e.StatusCode = resp.StatusCode
+		e.Status = resp.Status
+		e.ResponseHeaders = sanitizeHeaders(resp.Header)
+		if resp.Body != nil {
+			respBody, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return fmt.Errorf("error reading response body for capture: %w", err)
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+			if isProtobufResponse(resp) {
+				// Binary bodies cannot be searched for sensitive fields
+				e.ResponseBody = []byte(redactedValue)
+			} else {
+				e.ResponseBody = sanitizeBody(respBody)
+			}
+		}
+	}
+	c.add(e)
+	return nil
+}
+
+// CurlStrings returns a cURL command line for each captured request. As with
+// the cURL output of a Client, the authorization header is replaced by a
+// command that fetches the token.
+func (c *Capture) CurlStrings() []string {
+	entries := c.Entries()
+	ret := make([]string, 0, len(entries))
+	for _, e := range entries {
+		ret = append(ret, curlString(e.Method, e.Url, e.RequestHeaders, e.RequestBody, ""))
+	}
+	return ret
+}
+
+// WriteHAR writes the captured entries to w as a HAR 1.2 archive, which can
+// be opened by browser developer tools and most HTTP debugging tools.
+func (c *Capture) WriteHAR(w io.Writer) error {
+	entries := c.Entries()
+	h := har{
+		Log: harLog{
+			Version: "1.2",
+			Creator: harCreator{Name: "boundary-api", Version: "1"},
+			Entries: make([]harEntry, 0, len(entries)),
+		},
+	}
+	for _, e := range entries {
+		he := harEntry{
+			StartedDateTime: e.StartTime.UTC().Format(time.RFC3339Nano),
+			Time:            durationMillis(e.Duration),
+			Request: harRequest{
+				Method:      e.Method,
+				Url:         e.Url,
+				HttpVersion: "HTTP/1.1",
+				Headers:     harHeaders(e.RequestHeaders),
+				QueryString: []harNameValue{},
+				Cookies:     []harNameValue{},
+				HeadersSize: -1,
+				BodySize:    len(e.RequestBody),
+			},
+			Response: harResponse{
+				Status:      e.StatusCode,
+				StatusText:  strings.TrimSpace(strings.TrimPrefix(e.Status, fmt.Sprint(e.StatusCode))),
+				HttpVersion: "HTTP/1.1",
+				Headers:     harHeaders(e.ResponseHeaders),
+				Cookies:     []harNameValue{},
+				Content: harContent{
+					Size:     len(e.ResponseBody),
+					MimeType: e.ResponseHeaders.Get("content-type"),
+					Text:     string(e.ResponseBody),
+				},
+				HeadersSize: -1,
+				BodySize:    len(e.ResponseBody),
+			},
+			Cache: struct{}{},
+			Timings: harTimings{
+				Send:    0,
+				Wait:    durationMillis(e.Duration),
+				Receive: 0,
+			},
+			Comment: e.Error,
+		}
+		if len(e.RequestBody) > 0 {
+			he.Request.PostData = &harPostData{
+				MimeType: e.RequestHeaders.Get("content-type"),
+				Text:     string(e.RequestBody),
+			}
+		}
+		h.Log.Entries = append(h.Log.Entries, he)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(h)
+}
+
+func durationMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func sanitizeHeaders(in http.Header) http.Header {
+	ret := make(http.Header, len(in))
+	for k, v := range in {
+		if _, ok := sensitiveHeaders[strings.ToLower(k)]; ok {
+			ret[k] = []string{redactedValue}
+			continue
+		}
+		ret[k] = append([]string(nil), v...)
+	}
+	return ret
+}
+
+// sanitizeBody redacts the sensitive fields of a JSON body. Bodies that are not
+// JSON objects are returned as is.
+func sanitizeBody(in []byte) []byte {
+	if len(in) == 0 {
+		return nil
+	}
+	var m map[string]any
+	dec := json.NewDecoder(bytes.NewReader(in))
+	dec.UseNumber()
+	if err := dec.Decode(&m); err != nil {
+		return append([]byte(nil), in...)
+	}
+	redactFields(m)
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(m); err != nil {
+		return append([]byte(nil), in...)
+	}
+	return bytes.TrimSuffix(out.Bytes(), []byte("\n"))
+}
+
+func redactFields(v any) {
+	switch vv := v.(type) {
+	case map[string]any:
+		for k, val := range vv {
+			if _, ok := sensitiveFields[k]; ok {
+				vv[k] = redactedValue
+				continue
+			}
+			redactFields(val)
+		}
+	case []any:
+		for _, val := range vv {
+			redactFields(val)
+		}
+	}
+}
+
+type har struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	Url         string         `json:"url"`
+	HttpVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	Cookies     []harNameValue `json:"cookies"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HttpVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	Cookies     []harNameValue `json:"cookies"`
+	Content     harContent     `json:"content"`
+	RedirectUrl string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// harHeaders converts headers to HAR name/value pairs, sorted by name so the
+// output is stable.
+func harHeaders(in http.Header) []harNameValue {
+	ret := make([]harNameValue, 0, len(in))
+	for k, v := range in {
+		for _, h := range v {
+			ret = append(ret, harNameValue{Name: k, Value: h})
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool { return ret[i].Name < ret[j].Name })
+	return ret
+}
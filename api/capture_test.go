@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapture(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.Header().Set("set-cookie", "session=secret")
+		w.Write([]byte(`{"id":"at_1234567890","attributes":{"token":"at_1234567890_secret"}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(nil)
+	require.NoError(t, err)
+	require.NoError(t, client.SetAddr(srv.URL))
+	client.SetToken("at_1234567890_secret")
+	capture := NewCapture()
+	client.SetCapture(capture)
+
+	req, err := client.NewRequest(context.Background(), "POST", "auth-methods/ampw_1234567890:authenticate", map[string]any{
+		"attributes": map[string]any{
+			"login_name": "user",
+			"password":   "passpass",
+		},
+	})
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	// The response body must still be readable after being captured.
+	target := map[string]any{}
+	apiErr, err := resp.Decode(&target)
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+	assert.Equal(t, "at_1234567890", target["id"])
+
+	entries := capture.Entries()
+	require.Len(t, entries, 1)
+	e := entries[0]
+	assert.Equal(t, "POST", e.Method)
+	assert.Equal(t, http.StatusOK, e.StatusCode)
+	assert.Equal(t, redactedValue, e.RequestHeaders.Get("authorization"))
+	assert.Equal(t, redactedValue, e.ResponseHeaders.Get("set-cookie"))
+	assert.NotContains(t, string(e.RequestBody), "passpass")
+	assert.Contains(t, string(e.RequestBody), "user")
+	assert.NotContains(t, string(e.ResponseBody), "at_1234567890_secret")
+
+	curls := capture.CurlStrings()
+	require.Len(t, curls, 1)
+	assert.Contains(t, curls[0], "curl -X POST")
+	assert.NotContains(t, curls[0], "secret")
+	assert.NotContains(t, curls[0], "passpass")
+
+	var buf bytes.Buffer
+	require.NoError(t, capture.WriteHAR(&buf))
+	assert.NotContains(t, buf.String(), "secret")
+	assert.NotContains(t, buf.String(), "passpass")
+	var h har
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &h))
+	assert.Equal(t, "1.2", h.Log.Version)
+	require.Len(t, h.Log.Entries, 1)
+	assert.Equal(t, "POST", h.Log.Entries[0].Request.Method)
+	assert.Equal(t, 200, h.Log.Entries[0].Response.Status)
+	assert.Equal(t, "OK", h.Log.Entries[0].Response.StatusText)
+	require.NotNil(t, h.Log.Entries[0].Request.PostData)
+
+	capture.Reset()
+	assert.Empty(t, capture.Entries())
+
+	// Cloned clients share the capture.
+	clone := client.Clone()
+	req, err = clone.NewRequest(context.Background(), "GET", "scopes", nil)
+	require.NoError(t, err)
+	_, err = clone.Do(req)
+	require.NoError(t, err)
+	assert.Len(t, capture.Entries(), 1)
+}
+
+func TestSanitizeBody(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"not-json", "plain text", "plain text"},
+		{"nested", `{"items":[{"attributes":{"password":"p","login_name":"u"}}]}`, `{"items":[{"attributes":{"login_name":"u","password":"<redacted>"}}]}`},
+		{"secrets", `{"secrets":{"access_key_id":"a"},"name":"n"}`, `{"name":"n","secrets":"<redacted>"}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, string(sanitizeBody([]byte(tc.in))))
+		})
+	}
+}
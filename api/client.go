@@ -45,9 +45,19 @@ const (
 	EnvBoundaryToken         = "BOUNDARY_TOKEN"
 	EnvBoundaryRateLimit     = "BOUNDARY_RATE_LIMIT"
 	EnvBoundarySRVLookup     = "BOUNDARY_SRV_LOOKUP"
-
-	AsciiCastMimeType = "application/x-asciicast"
-	StreamChunkSize   = 1024 * 64 // stream chuck buffer size
+	EnvBoundaryProtobuf      = "BOUNDARY_PROTOBUF"
+
+	// ImpersonateUserHeader is the request header carrying the ID of the user
+	// the caller acts as. The caller must be granted the impersonate action on
+	// that user.
+	ImpersonateUserHeader = "Boundary-Impersonate-User"
+
+	AsciiCastMimeType     = "application/x-asciicast"
+	TranscriptMimeType    = "text/plain"
+	TextRenditionMimeType = "text/x-boundary-ocr"
+	ScreenshotsMimeType   = "application/zip"
+	ProtobufMimeType      = "application/x-protobuf"
+	StreamChunkSize       = 1024 * 64 // stream chuck buffer size
 )
 
 // Config is used to configure the creation of the client
@@ -79,6 +89,10 @@ type Config struct {
 	// values, ConfigureTLS should be called.
 	TLSConfig *TLSConfig
 
+	// TransportConfig contains the parameters used to tune the HTTP transport.
+	// After modifying these values, ConfigureTransport should be called.
+	TransportConfig *TransportConfig
+
 	// Headers contains extra headers that will be added to any request
 	Headers http.Header
 
@@ -108,8 +122,33 @@ type Config struct {
 	// fetching a cURL-compatible string for the operation.
 	OutputCurlString bool
 
+	// Capture, if set, records every request made by the client and its
+	// response, with sensitive values redacted. Currently if the client is
+	// cloned the same capture is used.
+	Capture *Capture
+
+	// DeprecationFunc, if set, is called with the deprecation notices of every
+	// response that has any, such as when a request uses a deprecated field.
+	// Currently if the client is cloned the same function is used.
+	DeprecationFunc func(notices []string)
+
 	// SRVLookup enables the client to lookup the host through DNS SRV lookup
 	SRVLookup bool
+
+	// Protobuf causes the client to request binary protobuf encoded responses
+	// from the endpoints that support them: listing and reading sessions and
+	// authorizing sessions. This reduces the cost of encoding and decoding
+	// large responses. Other requests, and all error responses, use JSON.
+	Protobuf bool
+
+	// ImpersonateUserId, if set, causes requests to be performed as the user
+	// with this ID. The token's user must be granted the impersonate action on
+	// that user; both identities are recorded in the controller's audit events.
+	ImpersonateUserId string
+
+	// connStats holds the connection pool statistics once ConfigureTransport
+	// has been called. It is shared with cloned clients, as is HttpClient.
+	connStats *connectionStats
 }
 
 // TLSConfig contains the parameters needed to configure TLS on the HTTP client
@@ -166,10 +205,11 @@ func RateLimitLinearJitterBackoff(min, max time.Duration, attemptNum int, resp *
 // If an error is encountered, this will return nil.
 func DefaultConfig() (*Config, error) {
 	config := &Config{
-		Addr:       "http://127.0.0.1:9200",
-		HttpClient: cleanhttp.DefaultPooledClient(),
-		Timeout:    time.Second * 60,
-		TLSConfig:  &TLSConfig{},
+		Addr:            "http://127.0.0.1:9200",
+		HttpClient:      cleanhttp.DefaultPooledClient(),
+		Timeout:         time.Second * 60,
+		TLSConfig:       &TLSConfig{},
+		TransportConfig: &TransportConfig{},
 	}
 
 	// We read the environment now; after DefaultClient returns we can override
@@ -183,6 +223,9 @@ func DefaultConfig() (*Config, error) {
 	transport.TLSClientConfig = &tls.Config{
 		MinVersion: tls.VersionTLS12,
 	}
+	if err := config.ConfigureTransport(); err != nil {
+		return config, fmt.Errorf("failed to configure transport: %w", err)
+	}
 
 	config.Backoff = RateLimitLinearJitterBackoff
 	config.MaxRetries = 2
@@ -327,6 +370,14 @@ func (c *Config) ReadEnvironment() error {
 		c.SRVLookup = lookup
 	}
 
+	if v := os.Getenv(EnvBoundaryProtobuf); v != "" {
+		protobuf, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("could not parse %s", EnvBoundaryProtobuf)
+		}
+		c.Protobuf = protobuf
+	}
+
 	if t := os.Getenv(EnvBoundaryClientTimeout); t != "" {
 		clientTimeout, err := parseutil.ParseDurationSecond(t)
 		if err != nil {
@@ -487,6 +538,19 @@ func (c *Client) SetTLSConfig(conf *TLSConfig) error {
 	return c.config.ConfigureTLS()
 }
 
+// SetTransportConfig sets the transport parameters to use and calls
+// ConfigureTransport
+func (c *Client) SetTransportConfig(conf *TransportConfig) error {
+	c.modifyLock.Lock()
+	defer c.modifyLock.Unlock()
+	if conf == nil {
+		return fmt.Errorf("nil configuration supplied to SetTransportConfig")
+	}
+
+	c.config.TransportConfig = conf
+	return c.config.ConfigureTransport()
+}
+
 // SetLimiter will set the rate limiter for this client.  This method is
 // thread-safe. rateLimit and burst are specified according to
 // https://godoc.org/golang.org/x/time/rate#NewLimiter
@@ -529,6 +593,42 @@ func (c *Client) SetOutputCurlString(curl bool) {
 	c.config.OutputCurlString = curl
 }
 
+// SetCapture sets the capture used to record future requests. A nil capture
+// disables capturing.
+func (c *Client) SetCapture(capture *Capture) {
+	c.modifyLock.Lock()
+	defer c.modifyLock.Unlock()
+
+	c.config.Capture = capture
+}
+
+// SetDeprecationFunc sets the function called with the deprecation notices of
+// responses. A nil function disables it.
+func (c *Client) SetDeprecationFunc(f func(notices []string)) {
+	c.modifyLock.Lock()
+	defer c.modifyLock.Unlock()
+
+	c.config.DeprecationFunc = f
+}
+
+// SetProtobuf sets whether binary protobuf encoded responses are requested
+// from the endpoints that support them.
+func (c *Client) SetProtobuf(protobuf bool) {
+	c.modifyLock.Lock()
+	defer c.modifyLock.Unlock()
+
+	c.config.Protobuf = protobuf
+}
+
+// SetImpersonateUserId sets the ID of the user future requests are performed
+// as. An empty value stops impersonation.
+func (c *Client) SetImpersonateUserId(userId string) {
+	c.modifyLock.Lock()
+	defer c.modifyLock.Unlock()
+
+	c.config.ImpersonateUserId = userId
+}
+
 // Token gets the configured token.
 func (c *Client) Token() string {
 	c.modifyLock.RLock()
@@ -600,12 +700,21 @@ func (c *Client) Clone() *Client {
 		CheckRetry:         config.CheckRetry,
 		Limiter:            config.Limiter,
 		OutputCurlString:   config.OutputCurlString,
+		Capture:            config.Capture,
+		DeprecationFunc:    config.DeprecationFunc,
 		SRVLookup:          config.SRVLookup,
+		Protobuf:           config.Protobuf,
+		ImpersonateUserId:  config.ImpersonateUserId,
+		connStats:          config.connStats,
 	}
 	if config.TLSConfig != nil {
 		newConfig.TLSConfig = new(TLSConfig)
 		*newConfig.TLSConfig = *config.TLSConfig
 	}
+	if config.TransportConfig != nil {
+		newConfig.TransportConfig = new(TransportConfig)
+		*newConfig.TransportConfig = *config.TransportConfig
+	}
 	for k, v := range config.Headers {
 		vSlice := make([]string, 0, len(v))
 		vSlice = append(vSlice, v...)
@@ -654,6 +763,8 @@ func (c *Client) NewRequest(ctx context.Context, method, requestPath string, bod
 	c.modifyLock.RLock()
 	addr := c.config.Addr
 	srvLookup := c.config.SRVLookup
+	protobuf := c.config.Protobuf
+	impersonateUserId := c.config.ImpersonateUserId
 	token := c.config.Token
 	httpClient := c.config.HttpClient
 	headers := copyHeaders(c.config.Headers)
@@ -719,6 +830,12 @@ func (c *Client) NewRequest(ctx context.Context, method, requestPath string, bod
 	req.Header = headers
 	req.Header.Set("authorization", "Bearer "+token)
 	req.Header.Set("content-type", "application/json")
+	if impersonateUserId != "" {
+		req.Header.Set(ImpersonateUserHeader, impersonateUserId)
+	}
+	if protobuf && protobufEnvelopeFor(method, req.URL.Path) != nil {
+		req.Header.Set("accept", ProtobufMimeType)
+	}
 	if ctx != nil {
 		req = req.Clone(ctx)
 	}
@@ -736,7 +853,7 @@ func (c *Client) NewRequest(ctx context.Context, method, requestPath string, bod
 // Do takes a properly configured request and applies client configuration to
 // it, returning the response.
 func (c *Client) Do(r *retryablehttp.Request, opt ...Option) (*Response, error) {
-	opts := getOpts(opt...)
+	opts := getOpts(append(contextOptions(r.Context()), opt...)...)
 	c.modifyLock.RLock()
 	limiter := c.config.Limiter
 	maxRetries := c.config.MaxRetries
@@ -747,6 +864,13 @@ func (c *Client) Do(r *retryablehttp.Request, opt ...Option) (*Response, error)
 	token := c.config.Token
 	recoveryKmsWrapper := c.config.RecoveryKmsWrapper
 	outputCurlString := c.config.OutputCurlString && !opts.withSkipCurlOuptut
+	capture := c.config.Capture
+	deprecationFunc := c.config.DeprecationFunc
+	connStats := c.config.connStats
+	var connStatsFunc func(ConnectionStats)
+	if c.config.TransportConfig != nil {
+		connStatsFunc = c.config.TransportConfig.ConnectionStatsFunc
+	}
 	c.modifyLock.RUnlock()
 
 	ctx := r.Context()
@@ -777,6 +901,7 @@ func (c *Client) Do(r *retryablehttp.Request, opt ...Option) (*Response, error)
 		// this as it will make reading the response body impossible
 		_ = cancel
 	}
+	ctx = withConnectionStatsTrace(ctx, connStats, connStatsFunc)
 	r.Request = r.Request.Clone(ctx)
 
 	if backoff == nil {
@@ -819,6 +944,7 @@ func (c *Client) Do(r *retryablehttp.Request, opt ...Option) (*Response, error)
 		ErrorHandler: retryablehttp.PassthroughErrorHandler,
 	}
 
+	start := time.Now()
 	result, err := client.Do(r)
 	if result != nil && err == nil && result.StatusCode == http.StatusTemporaryRedirect {
 		// Declare loc here to reuse previous error
@@ -840,6 +966,12 @@ func (c *Client) Do(r *retryablehttp.Request, opt ...Option) (*Response, error)
 		result, err = client.Do(r)
 	}
 
+	if capture != nil {
+		if captureErr := capture.record(start, r, result, err); captureErr != nil && err == nil {
+			return nil, captureErr
+		}
+	}
+
 	if err != nil {
 		if strings.Contains(err.Error(), "tls: oversized") {
 			err = fmt.Errorf(
@@ -857,5 +989,11 @@ func (c *Client) Do(r *retryablehttp.Request, opt ...Option) (*Response, error)
 		return nil, err
 	}
 
-	return &Response{resp: result}, nil
+	resp := &Response{resp: result}
+	if deprecationFunc != nil {
+		if notices := resp.Deprecations(); len(notices) > 0 {
+			deprecationFunc(notices)
+		}
+	}
+	return resp, nil
 }
@@ -4,7 +4,10 @@
 package api
 
 import (
+	"context"
 	"math"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
 	"testing"
@@ -157,3 +160,87 @@ func TestReadEnvironmentMaxRetries(t *testing.T) {
 		})
 	}
 }
+
+func TestClientDeprecationFunc(t *testing.T) {
+	notice := `The "token_type" field is deprecated and will be removed in a future release.`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("deprecated") != "" {
+			w.Header().Add(DeprecationHeader, notice)
+		}
+		w.Header().Set("content-type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(nil)
+	require.NoError(t, err)
+	require.NoError(t, client.SetAddr(srv.URL))
+	var got [][]string
+	client.SetDeprecationFunc(func(notices []string) {
+		got = append(got, notices)
+	})
+
+	req, err := client.NewRequest(context.Background(), "GET", "scopes", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Deprecations())
+	assert.Empty(t, got)
+
+	req, err = client.NewRequest(context.Background(), "GET", "scopes", nil)
+	require.NoError(t, err)
+	req.URL.RawQuery = "deprecated=true"
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{notice}, resp.Deprecations())
+	assert.Equal(t, [][]string{{notice}}, got)
+}
+
+func TestClientImpersonateUserId(t *testing.T) {
+	client, err := NewClient(nil)
+	require.NoError(t, err)
+	require.NoError(t, client.SetAddr("http://127.0.0.1:9200"))
+
+	req, err := client.NewRequest(context.Background(), "GET", "scopes", nil)
+	require.NoError(t, err)
+	assert.Empty(t, req.Header.Get(ImpersonateUserHeader))
+
+	client.SetImpersonateUserId("u_1234567890")
+	req, err = client.NewRequest(context.Background(), "GET", "scopes", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "u_1234567890", req.Header.Get(ImpersonateUserHeader))
+
+	cloned := client.Clone()
+	req, err = cloned.NewRequest(context.Background(), "GET", "scopes", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "u_1234567890", req.Header.Get(ImpersonateUserHeader))
+
+	client.SetImpersonateUserId("")
+	req, err = client.NewRequest(context.Background(), "GET", "scopes", nil)
+	require.NoError(t, err)
+	assert.Empty(t, req.Header.Get(ImpersonateUserHeader))
+}
+
+func TestErrScopeQuotaExceeded(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"kind":"ResourceExhausted","message":"The api request quota of scope o_1234567890 is exhausted."}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(nil)
+	require.NoError(t, err)
+	require.NoError(t, client.SetAddr(srv.URL))
+	client.SetMaxRetries(0)
+
+	req, err := client.NewRequest(context.Background(), "GET", "scopes/o_1234567890", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	apiErr, err := resp.Decode(nil)
+	require.NoError(t, err)
+	require.NotNil(t, apiErr)
+	assert.ErrorIs(t, apiErr, ErrScopeQuotaExceeded)
+	assert.NotErrorIs(t, apiErr, ErrInvalidArgument)
+}
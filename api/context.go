@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"context"
+	"slices"
+	"strconv"
+)
+
+type contextOptionsKey struct{}
+
+// ContextWithOptions returns a copy of ctx that carries the given options as
+// defaults for every call made with it, and with any context derived from it.
+// This allows options such as WithRecursive, WithPageSize and WithFilter to be
+// set once for a chain of calls instead of being passed to each of them.
+// Options already attached to ctx are kept, with the given options applied
+// after them. Options passed to a call take precedence over the defaults.
+func ContextWithOptions(ctx context.Context, opt ...Option) context.Context {
+	existing, _ := ctx.Value(contextOptionsKey{}).([]Option)
+	return context.WithValue(ctx, contextOptionsKey{}, append(slices.Clip(existing), opt...))
+}
+
+// contextOptions returns the options attached to ctx with ContextWithOptions.
+func contextOptions(ctx context.Context) []Option {
+	if ctx == nil {
+		return nil
+	}
+	opts, _ := ctx.Value(contextOptionsKey{}).([]Option)
+	return opts
+}
+
+// ApplyContextOptions adds the listing defaults attached to ctx with
+// ContextWithOptions to the query parameters of a call, unless the call set
+// them itself. This does not need to be called directly; it is used by the
+// clients of the resource packages.
+func ApplyContextOptions(ctx context.Context, queryMap map[string]string) {
+	ctxOpts := contextOptions(ctx)
+	if len(ctxOpts) == 0 || queryMap == nil {
+		return
+	}
+	opts := getOpts(ctxOpts...)
+	setDefault := func(k, v string) {
+		if _, ok := queryMap[k]; !ok {
+			queryMap[k] = v
+		}
+	}
+	if opts.withRecursive {
+		setDefault("recursive", strconv.FormatBool(opts.withRecursive))
+	}
+	if opts.withPageSize != 0 {
+		setDefault("page_size", strconv.FormatUint(uint64(opts.withPageSize), 10))
+	}
+	if opts.withFilter != "" {
+		setDefault("filter", opts.withFilter)
+	}
+}
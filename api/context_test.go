@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyContextOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		queryMap map[string]string
+		want     map[string]string
+	}{
+		{
+			name:     "no options",
+			ctx:      context.Background(),
+			queryMap: map[string]string{"scope_id": "global"},
+			want:     map[string]string{"scope_id": "global"},
+		},
+		{
+			name:     "defaults",
+			ctx:      ContextWithOptions(context.Background(), WithRecursive(true), WithPageSize(10), WithFilter(` "/item/name" == "foo" `)),
+			queryMap: map[string]string{"scope_id": "global"},
+			want: map[string]string{
+				"scope_id":  "global",
+				"recursive": "true",
+				"page_size": "10",
+				"filter":    `"/item/name" == "foo"`,
+			},
+		},
+		{
+			name: "call options take precedence",
+			ctx:  ContextWithOptions(context.Background(), WithPageSize(10), WithFilter(`"/item/name" == "foo"`)),
+			queryMap: map[string]string{
+				"scope_id":  "global",
+				"page_size": "20",
+			},
+			want: map[string]string{
+				"scope_id":  "global",
+				"page_size": "20",
+				"filter":    `"/item/name" == "foo"`,
+			},
+		},
+		{
+			name:     "inherited and overridden",
+			ctx:      ContextWithOptions(ContextWithOptions(context.Background(), WithRecursive(true), WithPageSize(10)), WithPageSize(30)),
+			queryMap: map[string]string{"scope_id": "global"},
+			want: map[string]string{
+				"scope_id":  "global",
+				"recursive": "true",
+				"page_size": "30",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ApplyContextOptions(tt.ctx, tt.queryMap)
+			assert.Equal(t, tt.want, tt.queryMap)
+		})
+	}
+}
+
+func TestContextWithOptions_DoesNotModifyParent(t *testing.T) {
+	parent := ContextWithOptions(context.Background(), WithPageSize(10))
+	_ = ContextWithOptions(parent, WithPageSize(20))
+	_ = ContextWithOptions(parent, WithFilter("foo"))
+
+	got := map[string]string{}
+	ApplyContextOptions(parent, got)
+	assert.Equal(t, map[string]string{"page_size": "10"}, got)
+}
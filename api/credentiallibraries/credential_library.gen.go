@@ -326,6 +326,7 @@ func (c *Client) List(ctx context.Context, credentialStoreId string, opt ...Opti
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["credential_store_id"] = credentialStoreId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "credential-libraries"
 	if opts.withResourcePathOverride != "" {
@@ -480,6 +481,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *CredentialLibrar
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "credential-libraries"
 	if opts.withResourcePathOverride != "" {
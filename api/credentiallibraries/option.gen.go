@@ -29,6 +29,7 @@ type options struct {
 	withListToken                string
 	withClientDirectedPagination bool
 	withPageSize                 uint32
+	withExactCount               bool
 	withResourcePathOverride     string
 }
 
@@ -59,6 +60,9 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withPageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
 	}
+	if opts.withExactCount {
+		opts.queryMap["exact_count"] = strconv.FormatBool(opts.withExactCount)
+	}
 	return opts, apiOpts
 }
 
@@ -112,6 +116,15 @@ func WithPageSize(with uint32) Option {
 	}
 }
 
+// WithExactCount tells the API to return an exact item count instead of an
+// estimate in the first page of a List call. The call fails if more than
+// 10,000 items are available.
+func WithExactCount(with bool) Option {
+	return func(o *options) {
+		o.withExactCount = with
+	}
+}
+
 // WithResourcePathOverride tells the API to use the provided resource path
 func WithResourcePathOverride(path string) Option {
 	return func(o *options) {
@@ -359,6 +372,54 @@ func DefaultVaultSSHCertificateCredentialLibraryKeyType() Option {
 	}
 }
 
+func WithVaultCredentialLibraryKvMinVersion(inKvMinVersion uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["kv_min_version"] = inKvMinVersion
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibraryKvMinVersion() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["kv_min_version"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialLibraryKvVersion(inKvVersion uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["kv_version"] = inKvVersion
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibraryKvVersion() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["kv_version"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -371,6 +432,54 @@ func DefaultName() Option {
 	}
 }
 
+func WithVaultCredentialLibraryNamespace(inNamespace string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["namespace"] = inNamespace
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibraryNamespace() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["namespace"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultSSHCertificateCredentialLibraryNamespace(inNamespace string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["namespace"] = inNamespace
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultSSHCertificateCredentialLibraryNamespace() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["namespace"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialLibraryPath(inPath string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
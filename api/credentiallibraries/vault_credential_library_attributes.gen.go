@@ -14,6 +14,9 @@ type VaultCredentialLibraryAttributes struct {
 	Path            string `json:"path,omitempty"`
 	HttpMethod      string `json:"http_method,omitempty"`
 	HttpRequestBody string `json:"http_request_body,omitempty"`
+	Namespace       string `json:"namespace,omitempty"`
+	KvVersion       uint32 `json:"kv_version,omitempty"`
+	KvMinVersion    uint32 `json:"kv_min_version,omitempty"`
 }
 
 func AttributesMapToVaultCredentialLibraryAttributes(in map[string]any) (*VaultCredentialLibraryAttributes, error) {
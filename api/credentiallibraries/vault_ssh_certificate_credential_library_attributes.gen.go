@@ -20,6 +20,7 @@ type VaultSSHCertificateCredentialLibraryAttributes struct {
 	CriticalOptions           map[string]string `json:"critical_options,omitempty"`
 	Extensions                map[string]string `json:"extensions,omitempty"`
 	AdditionalValidPrincipals []string          `json:"additional_valid_principals,omitempty"`
+	Namespace                 string            `json:"namespace,omitempty"`
 }
 
 func AttributesMapToVaultSSHCertificateCredentialLibraryAttributes(in map[string]any) (*VaultSSHCertificateCredentialLibraryAttributes, error) {
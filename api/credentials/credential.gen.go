@@ -29,6 +29,8 @@ type Credential struct {
 	Version           uint32                 `json:"version,omitempty"`
 	Type              string                 `json:"type,omitempty"`
 	Attributes        map[string]interface{} `json:"attributes,omitempty"`
+	Rotation          *CredentialRotation    `json:"rotation,omitempty"`
+	Checkout          *CredentialCheckout    `json:"checkout,omitempty"`
 	AuthorizedActions []string               `json:"authorized_actions,omitempty"`
 }
 
@@ -324,6 +326,7 @@ func (c *Client) List(ctx context.Context, credentialStoreId string, opt ...Opti
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["credential_store_id"] = credentialStoreId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "credentials"
 	if opts.withResourcePathOverride != "" {
@@ -478,6 +481,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *CredentialListRe
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "credentials"
 	if opts.withResourcePathOverride != "" {
@@ -0,0 +1,21 @@
+// Code generated by "make api"; DO NOT EDIT.
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"time"
+)
+
+type CredentialCheckout struct {
+	TtlSeconds          uint32    `json:"ttl_seconds,omitempty"`
+	QueueTimeoutSeconds uint32    `json:"queue_timeout_seconds,omitempty"`
+	SessionId           string    `json:"session_id,omitempty"`
+	UserId              string    `json:"user_id,omitempty"`
+	CheckoutTime        time.Time `json:"checkout_time,omitempty"`
+	ExpirationTime      time.Time `json:"expiration_time,omitempty"`
+	QueueLength         uint32    `json:"queue_length,omitempty"`
+	RotateOnCheckIn     bool      `json:"rotate_on_check_in,omitempty"`
+	RotationPending     bool      `json:"rotation_pending,omitempty"`
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+const (
+	rotationField = "rotation"
+	checkoutField = "checkout"
+)
+
+// WithRotation sets the schedule on which the password of a username password
+// credential is rotated in the database it authenticates to.
+func WithRotation(inRotation *CredentialRotation) Option {
+	return func(o *options) {
+		if inRotation == nil {
+			o.postMap[rotationField] = nil
+			return
+		}
+		o.postMap[rotationField] = map[string]any{
+			"rotator":          inRotation.Rotator,
+			"address":          inRotation.Address,
+			"database":         inRotation.Database,
+			"interval_seconds": inRotation.IntervalSeconds,
+		}
+	}
+}
+
+// DefaultRotation removes the rotation schedule of the credential.
+func DefaultRotation() Option {
+	return func(o *options) {
+		o.postMap[rotationField] = nil
+	}
+}
+
+// WithCheckout sets the exclusive checkout policy of the credential, so only
+// one session at a time may hold it.
+func WithCheckout(inCheckout *CredentialCheckout) Option {
+	return func(o *options) {
+		if inCheckout == nil {
+			o.postMap[checkoutField] = nil
+			return
+		}
+		o.postMap[checkoutField] = map[string]any{
+			"ttl_seconds":           inCheckout.TtlSeconds,
+			"queue_timeout_seconds": inCheckout.QueueTimeoutSeconds,
+			"rotate_on_check_in":    inCheckout.RotateOnCheckIn,
+		}
+	}
+}
+
+// DefaultCheckout removes the exclusive checkout policy of the credential.
+func DefaultCheckout() Option {
+	return func(o *options) {
+		o.postMap[checkoutField] = nil
+	}
+}
@@ -325,6 +325,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Cred
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "credential-stores"
 	if opts.withResourcePathOverride != "" {
@@ -486,6 +487,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *CredentialStoreL
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "credential-stores"
 	if opts.withResourcePathOverride != "" {
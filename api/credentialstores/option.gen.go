@@ -29,6 +29,7 @@ type options struct {
 	withListToken                string
 	withClientDirectedPagination bool
 	withPageSize                 uint32
+	withExactCount               bool
 	withResourcePathOverride     string
 	withRecursive                bool
 }
@@ -63,6 +64,9 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withPageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
 	}
+	if opts.withExactCount {
+		opts.queryMap["exact_count"] = strconv.FormatBool(opts.withExactCount)
+	}
 	return opts, apiOpts
 }
 
@@ -116,6 +120,15 @@ func WithPageSize(with uint32) Option {
 	}
 }
 
+// WithExactCount tells the API to return an exact item count instead of an
+// estimate in the first page of a List call. The call fails if more than
+// 10,000 items are available.
+func WithExactCount(with bool) Option {
+	return func(o *options) {
+		o.withExactCount = with
+	}
+}
+
 // WithResourcePathOverride tells the API to use the provided resource path
 func WithResourcePathOverride(path string) Option {
 	return func(o *options) {
@@ -179,6 +192,54 @@ func DefaultVaultCredentialStoreCaCert() Option {
 	}
 }
 
+func WithVaultCredentialStoreCertAuthMountPath(inCertAuthMountPath string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["cert_auth_mount_path"] = inCertAuthMountPath
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialStoreCertAuthMountPath() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["cert_auth_mount_path"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialStoreCertAuthRoleName(inCertAuthRoleName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["cert_auth_role_name"] = inCertAuthRoleName
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialStoreCertAuthRoleName() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["cert_auth_role_name"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreClientCertificate(inClientCertificate string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -23,6 +23,8 @@ type VaultCredentialStoreAttributes struct {
 	ClientCertificateKeyHmac string `json:"client_certificate_key_hmac,omitempty"`
 	WorkerFilter             string `json:"worker_filter,omitempty"`
 	TokenStatus              string `json:"token_status,omitempty"`
+	CertAuthMountPath        string `json:"cert_auth_mount_path,omitempty"`
+	CertAuthRoleName         string `json:"cert_auth_role_name,omitempty"`
 }
 
 func AttributesMapToVaultCredentialStoreAttributes(in map[string]any) (*VaultCredentialStoreAttributes, error) {
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package features
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// The names of the features reported by the controller.
+const (
+	SessionRecording        = "session-recording"
+	StoragePolicies         = "storage-policies"
+	MultiHopSessions        = "multi-hop-sessions"
+	HostCatalogWorkerFilter = "host-catalog-worker-filter"
+)
+
+type FeatureListResult struct {
+	Items    []*Feature
+	response *api.Response
+}
+
+func (r FeatureListResult) GetItems() []*Feature {
+	return r.Items
+}
+
+func (r FeatureListResult) GetResponse() *api.Response {
+	return r.response
+}
+
+// Enabled reports whether the named feature is enabled on the controller. A
+// feature the controller does not know about is not enabled.
+func (r FeatureListResult) Enabled(name string) bool {
+	for _, f := range r.Items {
+		if f.Name == name {
+			return f.Enabled
+		}
+	}
+	return false
+}
+
+// List returns the features that are not available in every edition of
+// Boundary, and whether each of them is enabled on the controller. Clients
+// can use it to tell a feature that is not available apart from a missing
+// resource before calling the endpoints of the feature.
+func (c *Client) List(ctx context.Context, opt ...Option) (*FeatureListResult, error) {
+	opts, apiOpts := getOpts(opt...)
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	req, err := c.client.NewRequest(ctx, "GET", "features", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating List request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during List call: %w", err)
+	}
+
+	target := new(FeatureListResult)
+	target.Items = []*Feature{}
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding List response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
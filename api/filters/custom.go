@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// The types of filters that can be validated.
+const (
+	WorkerType       = "worker"
+	ManagedGroupType = "managed-group"
+	ListType         = "list"
+)
+
+type ValidateResult struct {
+	Valid    bool               `json:"valid,omitempty"`
+	Errors   []*ValidationError `json:"errors,omitempty"`
+	response *api.Response
+}
+
+func (r ValidateResult) GetResponse() *api.Response {
+	return r.response
+}
+
+// Validate parses the filter expression and checks it against the data the
+// given type of filter is evaluated against. An invalid expression is not an
+// error: the problems found are returned in the result, with their position in
+// the expression.
+func (c *Client) Validate(ctx context.Context, filterType, expression string, opt ...Option) (*ValidateResult, error) {
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+	if filterType == "" {
+		return nil, fmt.Errorf("empty filter type value passed into Validate request")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	opts.postMap["type"] = filterType
+	opts.postMap["expression"] = expression
+
+	req, err := c.client.NewRequest(ctx, "POST", "filters:validate", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Validate request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Validate call: %w", err)
+	}
+
+	target := new(ValidateResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Validate response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
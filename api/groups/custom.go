@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/boundary/api/users"
+)
+
+// MembershipChanges describes how a call modified the membership of a group.
+type MembershipChanges struct {
+	// Added contains the IDs of the users that were added to the group.
+	Added []string
+	// Removed contains the IDs of the users that were removed from the group.
+	Removed []string
+}
+
+// HasChanges returns true if any member was added or removed.
+func (m *MembershipChanges) HasChanges() bool {
+	return m != nil && (len(m.Added) > 0 || len(m.Removed) > 0)
+}
+
+// GroupSetMembersDiffResult is the result of SetMembersWithDiff and
+// ImportMembers. It holds the group as updated by the call and the changes the
+// call made to its members.
+type GroupSetMembersDiffResult struct {
+	Item    *Group
+	Changes *MembershipChanges
+	// Unresolved contains the identifiers passed to ImportMembers that could
+	// not be resolved to a single user, which is only possible when the call
+	// was made with WithAllowUnresolvedIdentifiers. It is always empty for
+	// SetMembersWithDiff.
+	Unresolved []string
+	Response   *api.Response
+}
+
+func (n GroupSetMembersDiffResult) GetItem() *Group {
+	return n.Item
+}
+
+func (n GroupSetMembersDiffResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// SetMembersWithDiff sets the members of the group to the given user IDs, like
+// SetMembers, and additionally reports which members were added and removed.
+// The group is read first and its version is used for the update, so if the
+// group is modified concurrently the update fails rather than reporting an
+// incorrect diff. If version is non-zero it must match the current version of
+// the group.
+func (c *Client) SetMembersWithDiff(ctx context.Context, id string, version uint32, memberIds []string, opt ...Option) (*GroupSetMembersDiffResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into SetMembersWithDiff request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	existing, err := c.Read(ctx, id, append([]Option{WithSkipCurlOutput(true)}, opt...)...)
+	if err != nil {
+		if api.AsServerError(err) != nil {
+			return nil, fmt.Errorf("error from controller when performing initial read: %w", err)
+		}
+		return nil, fmt.Errorf("error performing initial read: %w", err)
+	}
+	if existing == nil || existing.Item == nil {
+		return nil, errors.New("nil resource found when performing initial read")
+	}
+	if version != 0 && version != existing.Item.Version {
+		return nil, fmt.Errorf("version %d passed into SetMembersWithDiff request does not match current version %d", version, existing.Item.Version)
+	}
+
+	changes := diffMembers(existing.Item.MemberIds, memberIds)
+	if !changes.HasChanges() {
+		return &GroupSetMembersDiffResult{
+			Item:     existing.Item,
+			Changes:  changes,
+			Response: existing.Response,
+		}, nil
+	}
+
+	updated, err := c.SetMembers(ctx, id, existing.Item.Version, memberIds, opt...)
+	if err != nil {
+		return nil, err
+	}
+	return &GroupSetMembersDiffResult{
+		Item:     updated.Item,
+		Changes:  changes,
+		Response: updated.Response,
+	}, nil
+}
+
+// ImportMembers sets the members of the group to the users owning the accounts
+// identified by the given identifiers within the given auth method. Identifiers
+// may be login names, email addresses or OIDC subjects; see
+// accounts.Client.ResolveIdentifiers for the matching rules. If any identifier
+// does not resolve to exactly one user, an error is returned and the group is
+// left unchanged, since setting the members without that user would remove it
+// from the group. With WithAllowUnresolvedIdentifiers those identifiers are
+// instead returned in the Unresolved field of the result and are not
+// considered members, but if none of the identifiers resolve an error is still
+// returned. As with SetMembersWithDiff, the returned result describes which
+// members were added and removed.
+func (c *Client) ImportMembers(ctx context.Context, id string, version uint32, authMethodId string, identifiers []string, opt ...Option) (*GroupSetMembersDiffResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into ImportMembers request")
+	}
+	if authMethodId == "" {
+		return nil, fmt.Errorf("empty authMethodId value passed into ImportMembers request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+	opts, _ := getOpts(opt...)
+
+	resolved, err := accounts.NewClient(c.client).ResolveIdentifiers(ctx, authMethodId, identifiers, accounts.WithSkipCurlOutput(true))
+	if err != nil {
+		return nil, fmt.Errorf("error resolving identifiers during ImportMembers call: %w", err)
+	}
+	unresolved := slices.Concat(resolved.Unresolved, resolved.Ambiguous)
+
+	var memberIds []string
+	if len(resolved.Accounts) > 0 {
+		// All accounts of an auth method belong to users in the auth method's
+		// scope, so a single list call is enough to map accounts to users.
+		var scopeId string
+		for _, a := range resolved.Accounts {
+			if a.Scope != nil {
+				scopeId = a.Scope.Id
+				break
+			}
+		}
+		if scopeId == "" {
+			return nil, errors.New("unable to determine scope of resolved accounts during ImportMembers call")
+		}
+		ul, err := users.NewClient(c.client).List(ctx, scopeId, users.WithSkipCurlOutput(true))
+		if err != nil {
+			return nil, fmt.Errorf("error listing users during ImportMembers call: %w", err)
+		}
+		userByAccount := make(map[string]string)
+		for _, u := range ul.GetItems() {
+			for _, acctId := range u.AccountIds {
+				userByAccount[acctId] = u.Id
+			}
+		}
+		for ident, a := range resolved.Accounts {
+			userId, ok := userByAccount[a.Id]
+			if !ok {
+				unresolved = append(unresolved, ident)
+				continue
+			}
+			if !slices.Contains(memberIds, userId) {
+				memberIds = append(memberIds, userId)
+			}
+		}
+	}
+	slices.Sort(memberIds)
+	slices.Sort(unresolved)
+	if len(memberIds) == 0 {
+		// Setting the members to an empty list would remove every member of
+		// the group, which is much more likely to be caused by a mistake, such
+		// as the wrong auth method, than to be intended.
+		return nil, fmt.Errorf("none of the identifiers passed into ImportMembers request resolved to a user; unresolved identifiers: %s", strings.Join(unresolved, ", "))
+	}
+	if len(unresolved) > 0 && !opts.withAllowUnresolvedIdentifiers {
+		return nil, fmt.Errorf("some of the identifiers passed into ImportMembers request did not resolve to a user; unresolved identifiers: %s", strings.Join(unresolved, ", "))
+	}
+
+	ret, err := c.SetMembersWithDiff(ctx, id, version, memberIds, opt...)
+	if err != nil {
+		return nil, err
+	}
+	ret.Unresolved = unresolved
+	return ret, nil
+}
+
+// diffMembers returns the IDs present in desired but not in current as added
+// and the IDs present in current but not in desired as removed, both sorted.
+func diffMembers(current, desired []string) *MembershipChanges {
+	ret := new(MembershipChanges)
+	for _, id := range desired {
+		if !slices.Contains(current, id) && !slices.Contains(ret.Added, id) {
+			ret.Added = append(ret.Added, id)
+		}
+	}
+	for _, id := range current {
+		if !slices.Contains(desired, id) && !slices.Contains(ret.Removed, id) {
+			ret.Removed = append(ret.Removed, id)
+		}
+	}
+	slices.Sort(ret.Added)
+	slices.Sort(ret.Removed)
+	return ret
+}
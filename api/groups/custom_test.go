@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/apitest"
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffMembers(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []string
+		desired []string
+		want    *MembershipChanges
+	}{
+		{
+			name: "empty",
+			want: &MembershipChanges{},
+		},
+		{
+			name:    "unchanged",
+			current: []string{"u_2", "u_1"},
+			desired: []string{"u_1", "u_2"},
+			want:    &MembershipChanges{},
+		},
+		{
+			name:    "add and remove",
+			current: []string{"u_1", "u_2"},
+			desired: []string{"u_3", "u_1", "u_3"},
+			want: &MembershipChanges{
+				Added:   []string{"u_3"},
+				Removed: []string{"u_2"},
+			},
+		},
+		{
+			name:    "remove all",
+			current: []string{"u_2", "u_1"},
+			want: &MembershipChanges{
+				Removed: []string{"u_1", "u_2"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffMembers(tt.current, tt.desired)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, len(tt.want.Added)+len(tt.want.Removed) > 0, got.HasChanges())
+		})
+	}
+}
+
+func TestImportMembers_NoneResolved(t *testing.T) {
+	ctx := context.Background()
+	s := apitest.NewServer(t)
+	gc := NewClient(s.Client(t))
+
+	created, err := gc.Create(ctx, apitest.GlobalScopeId, WithName("admins"))
+	require.NoError(t, err)
+	g := created.GetItem()
+
+	// A typo in the identifiers, or identifiers of another auth method, must
+	// not remove the members of the group.
+	for _, identifiers := range [][]string{nil, {"admni", "nobody@example.com"}} {
+		_, err = gc.ImportMembers(ctx, g.Id, g.Version, apitest.DefaultAuthMethodId, identifiers)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "none of the identifiers passed into ImportMembers request resolved to a user")
+	}
+
+	read, err := gc.Read(ctx, g.Id)
+	require.NoError(t, err)
+	assert.Equal(t, g.Version, read.GetItem().Version)
+}
+
+func TestImportMembers_PartiallyResolved(t *testing.T) {
+	ctx := context.Background()
+	s := apitest.NewServer(t)
+	client := s.Client(t)
+	gc := NewClient(client)
+
+	other, err := users.NewClient(client).Create(ctx, apitest.GlobalScopeId, users.WithName("other"))
+	require.NoError(t, err)
+	created, err := gc.Create(ctx, apitest.GlobalScopeId, WithName("admins"))
+	require.NoError(t, err)
+	set, err := gc.SetMembers(ctx, created.GetItem().Id, created.GetItem().Version, []string{apitest.DefaultUserId, other.GetItem().Id})
+	require.NoError(t, err)
+	g := set.GetItem()
+
+	// The unresolved identifier may have been meant for the other member, so
+	// it must not be removed unless unresolved identifiers are allowed.
+	identifiers := []string{apitest.DefaultLoginName, "nobody"}
+	_, err = gc.ImportMembers(ctx, g.Id, g.Version, apitest.DefaultAuthMethodId, identifiers)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "unresolved identifiers: nobody")
+
+	read, err := gc.Read(ctx, g.Id)
+	require.NoError(t, err)
+	assert.Equal(t, g.Version, read.GetItem().Version)
+	assert.ElementsMatch(t, []string{apitest.DefaultUserId, other.GetItem().Id}, read.GetItem().MemberIds)
+
+	got, err := gc.ImportMembers(ctx, g.Id, g.Version, apitest.DefaultAuthMethodId, identifiers, WithAllowUnresolvedIdentifiers(true))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nobody"}, got.Unresolved)
+	assert.Equal(t, &MembershipChanges{Removed: []string{other.GetItem().Id}}, got.Changes)
+	assert.Equal(t, []string{apitest.DefaultUserId}, got.GetItem().MemberIds)
+}
@@ -319,6 +319,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Grou
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "groups"
 	if opts.withResourcePathOverride != "" {
@@ -480,6 +481,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *GroupListResult,
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "groups"
 	if opts.withResourcePathOverride != "" {
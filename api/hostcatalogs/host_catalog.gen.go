@@ -37,6 +37,7 @@ type HostCatalog struct {
 	WorkerFilter                string                 `json:"worker_filter,omitempty"`
 	AuthorizedActions           []string               `json:"authorized_actions,omitempty"`
 	AuthorizedCollectionActions map[string][]string    `json:"authorized_collection_actions,omitempty"`
+	TargetRules                 []*TargetRule          `json:"target_rules,omitempty"`
 }
 
 type HostCatalogReadResult struct {
@@ -331,6 +332,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Host
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "host-catalogs"
 	if opts.withResourcePathOverride != "" {
@@ -492,6 +494,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *HostCatalogListR
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "host-catalogs"
 	if opts.withResourcePathOverride != "" {
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostcatalogs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// WithAutomaticRotationInterval sets how often RotateSecrets schedules the
+// secrets of the host catalog to be rotated automatically. The interval is
+// truncated to seconds, and an interval of 0 disables automatic rotation.
+// Without this option the current schedule is kept.
+func WithAutomaticRotationInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.postMap["automatic_rotation_interval_seconds"] = uint32(interval / time.Second)
+	}
+}
+
+type RotateSecretsResult struct {
+	Item *HostCatalog `json:"item,omitempty"`
+	// AutomaticRotationIntervalSeconds is how often the secrets of the host
+	// catalog are rotated automatically, or 0 if they are not.
+	AutomaticRotationIntervalSeconds uint32        `json:"automatic_rotation_interval_seconds,omitempty"`
+	Response                         *api.Response `json:"-"`
+}
+
+func (n RotateSecretsResult) GetItem() *HostCatalog {
+	return n.Item
+}
+
+func (n RotateSecretsResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// RotateSecrets replaces the secrets of a plugin host catalog. The new secrets
+// are given with WithSecrets and are validated by the catalog's plugin before
+// they are stored. If no secrets are given the stored secrets are passed to the
+// plugin, which lets plugins that mint their own credentials rotate them.
+func (c *Client) RotateSecrets(ctx context.Context, id string, version uint32, opt ...Option) (*RotateSecretsResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into RotateSecrets request")
+	}
+
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if version == 0 {
+		if !opts.withAutomaticVersioning {
+			return nil, errors.New("zero version number passed into RotateSecrets request")
+		}
+		existingTarget, existingErr := c.Read(ctx, id, append([]Option{WithSkipCurlOutput(true)}, opt...)...)
+		if existingErr != nil {
+			if api.AsServerError(existingErr) != nil {
+				return nil, fmt.Errorf("error from controller when performing initial check-and-set read: %w", existingErr)
+			}
+			return nil, fmt.Errorf("error performing initial check-and-set read: %w", existingErr)
+		}
+		if existingTarget == nil {
+			return nil, errors.New("nil resource response found when performing initial check-and-set read")
+		}
+		if existingTarget.Item == nil {
+			return nil, errors.New("nil resource found when performing initial check-and-set read")
+		}
+		version = existingTarget.Item.Version
+	}
+
+	opts.postMap["version"] = version
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("host-catalogs/%s:rotate-secrets", url.PathEscape(id)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RotateSecrets request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RotateSecrets call: %w", err)
+	}
+
+	target := new(RotateSecretsResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RotateSecrets response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hosts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type HostCreateRangeResult struct {
+	Items    []*Host
+	Response *api.Response
+}
+
+func (n HostCreateRangeResult) GetItems() []*Host {
+	return n.Items
+}
+
+func (n HostCreateRangeResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// CreateRange creates a static host in the given catalog for each address in
+// addressRange, which is either a CIDR block such as "10.0.0.0/28" or a
+// hostname containing a numeric range such as "web[01-20].corp". Either all of
+// the hosts are created or none are.
+//
+// nameTemplate is an optional Go template used to name the hosts. It can
+// reference the address of each host as {{.Address}} and its 1-based position
+// in the range as {{.Index}}. The description set with WithDescription is
+// applied to every host.
+func (c *Client) CreateRange(ctx context.Context, hostCatalogId, addressRange, nameTemplate string, opt ...Option) (*HostCreateRangeResult, error) {
+	if hostCatalogId == "" {
+		return nil, fmt.Errorf("empty hostCatalogId value passed into CreateRange request")
+	}
+	if addressRange == "" {
+		return nil, fmt.Errorf("empty addressRange value passed into CreateRange request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	body := map[string]any{
+		"host_catalog_id": hostCatalogId,
+		"address_range":   addressRange,
+	}
+	if nameTemplate != "" {
+		body["name_template"] = nameTemplate
+	}
+	if desc, ok := opts.postMap["description"]; ok && desc != nil {
+		body["description"] = desc
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", "hosts:create-range", body, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CreateRange request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during CreateRange call: %w", err)
+	}
+
+	target := new(HostCreateRangeResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CreateRange response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
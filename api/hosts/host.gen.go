@@ -326,6 +326,7 @@ func (c *Client) List(ctx context.Context, hostCatalogId string, opt ...Option)
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["host_catalog_id"] = hostCatalogId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "hosts"
 	if opts.withResourcePathOverride != "" {
@@ -480,6 +481,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *HostListResult,
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "hosts"
 	if opts.withResourcePathOverride != "" {
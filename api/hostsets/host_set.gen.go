@@ -324,6 +324,7 @@ func (c *Client) List(ctx context.Context, hostCatalogId string, opt ...Option)
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["host_catalog_id"] = hostCatalogId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "host-sets"
 	if opts.withResourcePathOverride != "" {
@@ -478,6 +479,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *HostSetListResul
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "host-sets"
 	if opts.withResourcePathOverride != "" {
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package listtokens contains a client for inspecting and revoking the list
+// tokens returned by list endpoints.
+package listtokens
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// ListTokenInfo contains the details of a list token.
+type ListTokenInfo struct {
+	// CreatedTime is the time the listing that the token belongs to was
+	// started.
+	CreatedTime time.Time `json:"created_time,omitempty"`
+	// ExpirationTime is the time after which the token is no longer accepted.
+	ExpirationTime time.Time `json:"expiration_time,omitempty"`
+	// ResourceType is the type of resource listed.
+	ResourceType string `json:"resource_type,omitempty"`
+	// Phase is the pagination phase the token belongs to. One of
+	// "pagination", "start-refresh" or "refresh".
+	Phase string `json:"phase,omitempty"`
+	// GrantsChanged is true if the grants of the user the token was issued to
+	// changed since the token was issued. It is nil if that user is not known.
+	GrantsChanged *bool `json:"grants_changed,omitempty"`
+	// Expired is true if the token has expired.
+	Expired bool `json:"expired,omitempty"`
+	// Revoked is true if the token has been revoked.
+	Revoked bool `json:"revoked,omitempty"`
+
+	response *api.Response
+}
+
+// GetResponse returns the response of the introspection call.
+func (n ListTokenInfo) GetResponse() *api.Response {
+	return n.response
+}
+
+// Client is a client for list tokens
+type Client struct {
+	client *api.Client
+}
+
+// Creates a new client for list tokens. The submitted API client is cloned;
+// modifications to it after generating this client will not have effect. If
+// you need to make changes to the underlying API client, use ApiClient() to
+// access it.
+func NewClient(c *api.Client) *Client {
+	return &Client{client: c.Clone()}
+}
+
+// ApiClient returns the underlying API client
+func (c *Client) ApiClient() *api.Client {
+	return c.client
+}
+
+// Introspect returns the details of the given list token. This can be used to
+// diagnose why a list endpoint rejects a list token.
+func (c *Client) Introspect(ctx context.Context, listToken string, opt ...Option) (*ListTokenInfo, error) {
+	if listToken == "" {
+		return nil, fmt.Errorf("empty listToken value passed into Introspect request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+	req, err := c.client.NewRequest(ctx, "POST", "list-tokens:introspect", map[string]any{"list_token": listToken}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Introspect request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Introspect call: %w", err)
+	}
+
+	target := new(ListTokenInfo)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Introspect response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// Revoke revokes the given list token. Once revoked, the token and every
+// other token of the same listing are rejected by list endpoints, and a new
+// listing must be started.
+func (c *Client) Revoke(ctx context.Context, listToken string, opt ...Option) (*api.Response, error) {
+	if listToken == "" {
+		return nil, fmt.Errorf("empty listToken value passed into Revoke request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+	req, err := c.client.NewRequest(ctx, "POST", "list-tokens:revoke", map[string]any{"list_token": listToken}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Revoke request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Revoke call: %w", err)
+	}
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Revoke response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+type MaintenanceResult struct {
+	Item     *Maintenance
+	Response *api.Response
+}
+
+func (n MaintenanceResult) GetItem() *Maintenance {
+	return n.Item
+}
+
+func (n MaintenanceResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// Read returns the maintenance mode of the controllers.
+func (c *Client) Read(ctx context.Context, opt ...Option) (*MaintenanceResult, error) {
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "maintenance", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Read request: %w", err)
+	}
+	return c.do(req, opts, "Read")
+}
+
+// SetReadOnly enables or disables the read-only mode of the controllers. While
+// it is enabled, the controllers reject the requests that modify resources
+// with an error that includes the optional reason. Reads, authentication and
+// the sessions that already exist keep working.
+func (c *Client) SetReadOnly(ctx context.Context, readOnly bool, reason string, opt ...Option) (*MaintenanceResult, error) {
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	opts.postMap["read_only"] = readOnly
+	if reason != "" {
+		opts.postMap["reason"] = reason
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", "maintenance:set-read-only", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SetReadOnly request: %w", err)
+	}
+	return c.do(req, opts, "SetReadOnly")
+}
+
+func (c *Client) do(req *retryablehttp.Request, opts options, call string) (*MaintenanceResult, error) {
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s call: %w", call, err)
+	}
+
+	target := new(MaintenanceResult)
+	target.Item = new(Maintenance)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", call, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
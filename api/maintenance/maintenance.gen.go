@@ -0,0 +1,35 @@
+// Code generated by "make api"; DO NOT EDIT.
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package maintenance
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type Maintenance struct {
+	ReadOnly    bool      `json:"read_only"`
+	Reason      string    `json:"reason,omitempty"`
+	UpdatedTime time.Time `json:"updated_time,omitempty"`
+}
+
+// Client is a client for this collection
+type Client struct {
+	client *api.Client
+}
+
+// Creates a new client for this collection. The submitted API client is cloned;
+// modifications to it after generating this client will not have effect. If you
+// need to make changes to the underlying API client, use ApiClient() to access
+// it.
+func NewClient(c *api.Client) *Client {
+	return &Client{client: c.Clone()}
+}
+
+// ApiClient returns the underlying API client
+func (c *Client) ApiClient() *api.Client {
+	return c.client
+}
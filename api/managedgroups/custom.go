@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedgroups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accounts"
+)
+
+// MembershipDiff describes the difference between the current membership of a
+// managed group and a desired set of accounts. Managed group membership is
+// computed by Boundary from the group's filter or group names, so it cannot be
+// set directly; the diff is meant to let syncing tools verify that the
+// configured filter produces the membership an external system expects.
+type MembershipDiff struct {
+	// Missing contains the IDs of desired accounts that are not members.
+	Missing []string
+	// Extra contains the IDs of member accounts that are not desired.
+	Extra []string
+	// Unresolved contains the identifiers that could not be resolved to a
+	// single account of the managed group's auth method, which is only
+	// possible when the call was made with WithAllowUnresolvedIdentifiers.
+	Unresolved []string
+}
+
+// InSync returns true if the managed group has exactly the desired members.
+func (m *MembershipDiff) InSync() bool {
+	return m != nil && len(m.Missing) == 0 && len(m.Extra) == 0
+}
+
+// ManagedGroupMembershipDiffResult is the result of DiffMembers. It holds the
+// managed group as read by the call and the difference between its members and
+// the desired accounts.
+type ManagedGroupMembershipDiffResult struct {
+	Item     *ManagedGroup
+	Diff     *MembershipDiff
+	Response *api.Response
+}
+
+func (n ManagedGroupMembershipDiffResult) GetItem() *ManagedGroup {
+	return n.Item
+}
+
+func (n ManagedGroupMembershipDiffResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// DiffMembers compares the members of the managed group against the accounts
+// identified by the given identifiers. Identifiers may be account IDs, login
+// names, email addresses or OIDC subjects; see
+// accounts.Client.ResolveIdentifiers for the matching rules. If any identifier
+// does not resolve to exactly one account, an error is returned, since the
+// diff would otherwise report a member the identifier was meant to match as
+// extra. With WithAllowUnresolvedIdentifiers those identifiers are instead
+// returned in the Unresolved field of the diff.
+func (c *Client) DiffMembers(ctx context.Context, id string, identifiers []string, opt ...Option) (*ManagedGroupMembershipDiffResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into DiffMembers request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+	opts, _ := getOpts(opt...)
+
+	mg, err := c.Read(ctx, id, opt...)
+	if err != nil {
+		return nil, err
+	}
+	if mg == nil || mg.Item == nil {
+		return nil, errors.New("nil resource found when reading managed group")
+	}
+
+	var lookup []string
+	var desired []string
+	for _, ident := range identifiers {
+		if slices.Contains(mg.Item.MemberIds, ident) {
+			// Already an account ID of a member, no need to resolve it.
+			desired = append(desired, ident)
+			continue
+		}
+		lookup = append(lookup, ident)
+	}
+
+	diff := new(MembershipDiff)
+	if len(lookup) > 0 {
+		resolved, err := accounts.NewClient(c.client).ResolveIdentifiers(ctx, mg.Item.AuthMethodId, lookup, accounts.WithSkipCurlOutput(true))
+		if err != nil {
+			return nil, fmt.Errorf("error resolving identifiers during DiffMembers call: %w", err)
+		}
+		for _, a := range resolved.Accounts {
+			desired = append(desired, a.Id)
+		}
+		diff.Unresolved = slices.Concat(resolved.Unresolved, resolved.Ambiguous)
+		slices.Sort(diff.Unresolved)
+		if len(diff.Unresolved) > 0 && !opts.withAllowUnresolvedIdentifiers {
+			return nil, fmt.Errorf("some of the identifiers passed into DiffMembers request did not resolve to an account; unresolved identifiers: %s", strings.Join(diff.Unresolved, ", "))
+		}
+	}
+
+	for _, acctId := range desired {
+		if !slices.Contains(mg.Item.MemberIds, acctId) && !slices.Contains(diff.Missing, acctId) {
+			diff.Missing = append(diff.Missing, acctId)
+		}
+	}
+	for _, acctId := range mg.Item.MemberIds {
+		if !slices.Contains(desired, acctId) {
+			diff.Extra = append(diff.Extra, acctId)
+		}
+	}
+	slices.Sort(diff.Missing)
+	slices.Sort(diff.Extra)
+
+	return &ManagedGroupMembershipDiffResult{
+		Item:     mg.Item,
+		Diff:     diff,
+		Response: mg.Response,
+	}, nil
+}
@@ -21,15 +21,17 @@ import (
 type Option func(*options)
 
 type options struct {
-	postMap                      map[string]any
-	queryMap                     map[string]string
-	withAutomaticVersioning      bool
-	withSkipCurlOutput           bool
-	withFilter                   string
-	withListToken                string
-	withClientDirectedPagination bool
-	withPageSize                 uint32
-	withResourcePathOverride     string
+	postMap                        map[string]any
+	queryMap                       map[string]string
+	withAutomaticVersioning        bool
+	withSkipCurlOutput             bool
+	withFilter                     string
+	withListToken                  string
+	withClientDirectedPagination   bool
+	withPageSize                   uint32
+	withExactCount                 bool
+	withResourcePathOverride       string
+	withAllowUnresolvedIdentifiers bool
 }
 
 func getDefaultOptions() options {
@@ -59,6 +61,9 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withPageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
 	}
+	if opts.withExactCount {
+		opts.queryMap["exact_count"] = strconv.FormatBool(opts.withExactCount)
+	}
 	return opts, apiOpts
 }
 
@@ -112,6 +117,15 @@ func WithPageSize(with uint32) Option {
 	}
 }
 
+// WithExactCount tells the API to return an exact item count instead of an
+// estimate in the first page of a List call. The call fails if more than
+// 10,000 items are available.
+func WithExactCount(with bool) Option {
+	return func(o *options) {
+		o.withExactCount = with
+	}
+}
+
 // WithResourcePathOverride tells the API to use the provided resource path
 func WithResourcePathOverride(path string) Option {
 	return func(o *options) {
@@ -119,6 +133,16 @@ func WithResourcePathOverride(path string) Option {
 	}
 }
 
+// WithAllowUnresolvedIdentifiers tells the calls that resolve member
+// identifiers to accounts to proceed when some of the identifiers cannot be
+// resolved, rather than failing. The identifiers that could not be resolved
+// are reported in the result of the call.
+func WithAllowUnresolvedIdentifiers(allow bool) Option {
+	return func(o *options) {
+		o.withAllowUnresolvedIdentifiers = allow
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -3,6 +3,8 @@
 
 package api
 
+import "strings"
+
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
 	for _, o := range opt {
@@ -17,6 +19,9 @@ type Option func(*options)
 // options = how options are represented
 type options struct {
 	withSkipCurlOuptut bool
+	withRecursive      bool
+	withPageSize       uint32
+	withFilter         string
 }
 
 func getDefaultOptions() options {
@@ -30,3 +35,31 @@ func WithSkipCurlOutput(skip bool) Option {
 		o.withSkipCurlOuptut = true
 	}
 }
+
+// WithRecursive tells the API to use recursion for listing operations. It is
+// meant to be attached to a context with ContextWithOptions; the clients of
+// the resource packages have their own WithRecursive option.
+func WithRecursive(recursive bool) Option {
+	return func(o *options) {
+		o.withRecursive = recursive
+	}
+}
+
+// WithPageSize controls the size of pages used during listing operations. It
+// is meant to be attached to a context with ContextWithOptions; the clients of
+// the resource packages have their own WithPageSize option.
+func WithPageSize(pageSize uint32) Option {
+	return func(o *options) {
+		o.withPageSize = pageSize
+	}
+}
+
+// WithFilter tells the API to filter the items returned by listing operations
+// using the provided filter term. It is meant to be attached to a context with
+// ContextWithOptions; the clients of the resource packages have their own
+// WithFilter option.
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = strings.TrimSpace(filter)
+	}
+}
@@ -5,6 +5,7 @@ package api
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 
@@ -49,15 +50,21 @@ func (d *OutputStringError) parseRequest() {
 		return
 	}
 
-	// Build cURL string
-	d.parsedCurlString = "curl"
-	if d.Request.Method != "GET" {
-		d.parsedCurlString = fmt.Sprintf("%s -X %s", d.parsedCurlString, d.Request.Method)
+	d.parsedCurlString = curlString(d.Request.Method, d.Request.URL.String(), d.Request.Header, body, d.unixSocket)
+}
+
+// curlString builds a cURL command line for a request. The authorization
+// header is replaced by a command that fetches the token, so the output never
+// contains it.
+func curlString(method, url string, headers http.Header, body []byte, unixSocket string) string {
+	ret := "curl"
+	if method != "GET" {
+		ret = fmt.Sprintf("%s -X %s", ret, method)
 	}
-	if d.unixSocket != "" {
-		d.parsedCurlString = fmt.Sprintf("%s --unix-socket %s", d.parsedCurlString, d.unixSocket)
+	if unixSocket != "" {
+		ret = fmt.Sprintf("%s --unix-socket %s", ret, unixSocket)
 	}
-	for k, v := range d.Request.Header {
+	for k, v := range headers {
 		for _, h := range v {
 			if strings.ToLower(k) == "authorization" {
 				tokenName := os.Getenv("BOUNDARY_TOKEN_NAME")
@@ -71,7 +78,7 @@ func (d *OutputStringError) parseRequest() {
 					h = fmt.Sprintf("Bearer $(boundary config get-token -keyring-type %s -token-name %s)", keyringType, tokenName)
 				}
 			}
-			d.parsedCurlString = fmt.Sprintf("%s -H \"%s: %s\"", d.parsedCurlString, k, h)
+			ret = fmt.Sprintf("%s -H \"%s: %s\"", ret, k, h)
 		}
 	}
 
@@ -79,11 +86,11 @@ func (d *OutputStringError) parseRequest() {
 		// We need to escape single quotes since that's what we're using to
 		// quote the body
 		escapedBody := strings.Replace(string(body), "'", "'\"'\"'", -1)
-		d.parsedCurlString = fmt.Sprintf("%s -d '%s'", d.parsedCurlString, escapedBody)
+		ret = fmt.Sprintf("%s -d '%s'", ret, escapedBody)
 	}
 
 	// Filters can have shell characters so we use single quotes to surround the URL
-	d.parsedCurlString = fmt.Sprintf("%s '%s'", d.parsedCurlString, d.Request.URL.String())
+	return fmt.Sprintf("%s '%s'", ret, url)
 }
 
 func (d *OutputStringError) CurlString() string {
@@ -6,6 +6,9 @@ const (
 	retainForField   = "retain_for"
 	daysField        = "days"
 	overridableField = "overridable"
+	samplingField    = "sampling"
+	percentField     = "percent"
+	filterField      = "filter"
 )
 
 func WithStoragePolicyDeleteAfter(inDeleteAfter map[string]any) Option {
@@ -199,3 +202,75 @@ func DefaultStoragePolicyRetainForOverridable() Option {
 		o.postMap[attributesField] = val
 	}
 }
+
+func WithStoragePolicySamplingPercent(inPercent int32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap[attributesField]
+		if !ok {
+			raw = interface{}(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		rawSampling, ok := val[samplingField]
+		if !ok {
+			rawSampling = interface{}(map[string]any{})
+		}
+		sampling := rawSampling.(map[string]any)
+		sampling[percentField] = inPercent
+		val[samplingField] = sampling
+		o.postMap[attributesField] = val
+	}
+}
+
+func DefaultStoragePolicySamplingPercent() Option {
+	return func(o *options) {
+		raw, ok := o.postMap[attributesField]
+		if !ok {
+			raw = interface{}(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		rawSampling, ok := val[samplingField]
+		if !ok {
+			rawSampling = interface{}(map[string]any{})
+		}
+		sampling := rawSampling.(map[string]any)
+		sampling[percentField] = nil
+		val[samplingField] = sampling
+		o.postMap[attributesField] = val
+	}
+}
+
+func WithStoragePolicySamplingFilter(inFilter string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap[attributesField]
+		if !ok {
+			raw = interface{}(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		rawSampling, ok := val[samplingField]
+		if !ok {
+			rawSampling = interface{}(map[string]any{})
+		}
+		sampling := rawSampling.(map[string]any)
+		sampling[filterField] = inFilter
+		val[samplingField] = sampling
+		o.postMap[attributesField] = val
+	}
+}
+
+func DefaultStoragePolicySamplingFilter() Option {
+	return func(o *options) {
+		raw, ok := o.postMap[attributesField]
+		if !ok {
+			raw = interface{}(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		rawSampling, ok := val[samplingField]
+		if !ok {
+			rawSampling = interface{}(map[string]any{})
+		}
+		sampling := rawSampling.(map[string]any)
+		sampling[filterField] = nil
+		val[samplingField] = sampling
+		o.postMap[attributesField] = val
+	}
+}
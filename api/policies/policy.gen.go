@@ -324,6 +324,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Poli
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "policies"
 	if opts.withResourcePathOverride != "" {
@@ -485,6 +486,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *PolicyListResult
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "policies"
 	if opts.withResourcePathOverride != "" {
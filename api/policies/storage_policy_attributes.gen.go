@@ -13,6 +13,7 @@ import (
 type StoragePolicyAttributes struct {
 	RetainFor   *StoragePolicyRetainFor   `json:"retain_for,omitempty"`
 	DeleteAfter *StoragePolicyDeleteAfter `json:"delete_after,omitempty"`
+	Sampling    *StoragePolicySampling    `json:"sampling,omitempty"`
 }
 
 func AttributesMapToStoragePolicyAttributes(in map[string]any) (*StoragePolicyAttributes, error) {
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	sessionspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessions"
+	targetspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// protobufFieldKind is the kind of value held by a field of a protobuf
+// response envelope.
+type protobufFieldKind int
+
+const (
+	protobufMessage protobufFieldKind = iota
+	protobufString
+	protobufUint32
+)
+
+// protobufField describes a field of a protobuf response envelope.
+type protobufField struct {
+	name     string
+	kind     protobufFieldKind
+	repeated bool
+	// newMessage returns the message to unmarshal into for protobufMessage
+	// fields.
+	newMessage func() proto.Message
+}
+
+// protobufEnvelope maps the field numbers of a service response message to
+// the fields it holds. The response messages of the services are not part of
+// the SDK, so the envelopes of the endpoints that can return protobuf are
+// described here; the resources they hold are decoded with their SDK types.
+type protobufEnvelope map[protowire.Number]protobufField
+
+var (
+	sessionItemEnvelope = protobufEnvelope{
+		1: {name: "item", kind: protobufMessage, newMessage: func() proto.Message { return new(sessionspb.Session) }},
+	}
+	sessionListEnvelope = protobufEnvelope{
+		1: {name: "items", kind: protobufMessage, repeated: true, newMessage: func() proto.Message { return new(sessionspb.Session) }},
+		2: {name: "response_type", kind: protobufString},
+		3: {name: "list_token", kind: protobufString},
+		4: {name: "sort_by", kind: protobufString},
+		5: {name: "sort_dir", kind: protobufString},
+		6: {name: "removed_ids", kind: protobufString, repeated: true},
+		7: {name: "est_item_count", kind: protobufUint32},
+	}
+	sessionAuthorizationEnvelope = protobufEnvelope{
+		1: {name: "item", kind: protobufMessage, newMessage: func() proto.Message { return new(targetspb.SessionAuthorization) }},
+	}
+)
+
+// protobufEnvelopeFor returns the envelope of the response to the request if
+// the endpoint can return protobuf, or nil otherwise.
+func protobufEnvelopeFor(method, urlPath string) protobufEnvelope {
+	idx := strings.LastIndex(urlPath, "/v1/")
+	if idx == -1 {
+		return nil
+	}
+	p := urlPath[idx+len("/v1/"):]
+	switch method {
+	case http.MethodGet:
+		if p == "sessions" {
+			return sessionListEnvelope
+		}
+		if id, ok := strings.CutPrefix(p, "sessions/"); ok && id != "" && !strings.ContainsAny(id, "/:") {
+			return sessionItemEnvelope
+		}
+	case http.MethodPost:
+		if id, ok := strings.CutPrefix(p, "targets/"); ok {
+			if id, ok = strings.CutSuffix(id, ":authorize-session"); ok && id != "" && !strings.ContainsAny(id, "/:") {
+				return sessionAuthorizationEnvelope
+			}
+		}
+	}
+	return nil
+}
+
+// toJson translates the protobuf encoded response into the JSON the endpoint
+// would have returned, so it can be decoded in the usual way.
+func (e protobufEnvelope) toJson(b []byte) ([]byte, error) {
+	marshalOpts := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: false,
+	}
+	out := make(map[string]any)
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, fmt.Errorf("error reading field tag: %w", protowire.ParseError(n))
+		}
+		b = b[n:]
+		f, ok := e[num]
+		if !ok {
+			// Skip fields added after this client was built
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return nil, fmt.Errorf("error skipping field %d: %w", num, protowire.ParseError(n))
+			}
+			b = b[n:]
+			continue
+		}
+
+		var v any
+		switch f.kind {
+		case protobufMessage, protobufString:
+			if typ != protowire.BytesType {
+				return nil, fmt.Errorf("unexpected wire type %d for field %q", typ, f.name)
+			}
+			raw, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return nil, fmt.Errorf("error reading field %q: %w", f.name, protowire.ParseError(n))
+			}
+			b = b[n:]
+			if f.kind == protobufString {
+				v = string(raw)
+				break
+			}
+			msg := f.newMessage()
+			if err := proto.Unmarshal(raw, msg); err != nil {
+				return nil, fmt.Errorf("error unmarshaling field %q: %w", f.name, err)
+			}
+			js, err := marshalOpts.Marshal(msg)
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling field %q as json: %w", f.name, err)
+			}
+			v = json.RawMessage(js)
+		case protobufUint32:
+			if typ != protowire.VarintType {
+				return nil, fmt.Errorf("unexpected wire type %d for field %q", typ, f.name)
+			}
+			u, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return nil, fmt.Errorf("error reading field %q: %w", f.name, protowire.ParseError(n))
+			}
+			b = b[n:]
+			v = uint32(u)
+		}
+
+		if f.repeated {
+			vals, _ := out[f.name].([]any)
+			out[f.name] = append(vals, v)
+			continue
+		}
+		out[f.name] = v
+	}
+	return json.Marshal(out)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sessionspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestProtobufEnvelopeFor(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   protobufEnvelope
+	}{
+		{method: http.MethodGet, path: "/v1/sessions", want: sessionListEnvelope},
+		{method: http.MethodGet, path: "/prefix/v1/sessions", want: sessionListEnvelope},
+		{method: http.MethodGet, path: "/v1/sessions/s_1234567890", want: sessionItemEnvelope},
+		{method: http.MethodPost, path: "/v1/targets/ttcp_1234567890:authorize-session", want: sessionAuthorizationEnvelope},
+		{method: http.MethodPost, path: "/v1/sessions/s_1234567890:cancel"},
+		{method: http.MethodGet, path: "/v1/targets"},
+		{method: http.MethodPost, path: "/v1/targets/:authorize-session"},
+		{method: http.MethodGet, path: "/sessions"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			got := protobufEnvelopeFor(tt.method, tt.path)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want[1].name, got[1].name)
+			assert.Len(t, got, len(tt.want))
+		})
+	}
+}
+
+func TestProtobufResponse(t *testing.T) {
+	var body []byte
+	for _, id := range []string{"s_1111111111", "s_2222222222"} {
+		item, err := proto.Marshal(&sessionspb.Session{Id: id, Status: "active"})
+		require.NoError(t, err)
+		body = protowire.AppendTag(body, 1, protowire.BytesType)
+		body = protowire.AppendBytes(body, item)
+	}
+	body = protowire.AppendTag(body, 2, protowire.BytesType)
+	body = protowire.AppendString(body, "complete")
+	body = protowire.AppendTag(body, 6, protowire.BytesType)
+	body = protowire.AppendString(body, "s_3333333333")
+	body = protowire.AppendTag(body, 7, protowire.VarintType)
+	body = protowire.AppendVarint(body, 2)
+	// An unknown field is skipped
+	body = protowire.AppendTag(body, 100, protowire.VarintType)
+	body = protowire.AppendVarint(body, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("accept") != ProtobufMimeType {
+			w.Header().Set("content-type", "application/json")
+			w.Write([]byte(`{"items":[]}`))
+			return
+		}
+		w.Header().Set("content-type", ProtobufMimeType)
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(nil)
+	require.NoError(t, err)
+	require.NoError(t, client.SetAddr(srv.URL))
+
+	type listResult struct {
+		Items []struct {
+			Id     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"items"`
+		ResponseType string   `json:"response_type"`
+		RemovedIds   []string `json:"removed_ids"`
+		EstItemCount uint     `json:"est_item_count"`
+	}
+
+	// Without protobuf enabled JSON is requested
+	req, err := client.NewRequest(context.Background(), "GET", "sessions", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	var result listResult
+	apiErr, err := resp.Decode(&result)
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+	assert.Empty(t, result.Items)
+
+	client.SetProtobuf(true)
+
+	// Endpoints that do not support protobuf still request JSON
+	req, err = client.NewRequest(context.Background(), "GET", "targets", nil)
+	require.NoError(t, err)
+	assert.Empty(t, req.Header.Get("accept"))
+
+	req, err = client.NewRequest(context.Background(), "GET", "sessions", nil)
+	require.NoError(t, err)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	result = listResult{}
+	apiErr, err = resp.Decode(&result)
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+	require.Len(t, result.Items, 2)
+	assert.Equal(t, "s_1111111111", result.Items[0].Id)
+	assert.Equal(t, "active", result.Items[0].Status)
+	assert.Equal(t, "s_2222222222", result.Items[1].Id)
+	assert.Equal(t, "complete", result.ResponseType)
+	assert.Equal(t, []string{"s_3333333333"}, result.RemovedIds)
+	assert.Equal(t, uint(2), result.EstItemCount)
+	assert.Equal(t, "complete", resp.Map["response_type"])
+	assert.Contains(t, resp.Body.String(), `"id":"s_1111111111"`)
+}
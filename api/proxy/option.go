@@ -37,6 +37,8 @@ type Options struct {
 	WithWorkerHost               string
 	WithSessionAuthorizationData *targets.SessionAuthorizationData
 	WithSkipSessionTeardown      bool
+	WithPinnedWorkerCertificate  bool
+	WithEndpointPort             uint32
 	withSessionTeardownTimeout   time.Duration
 	withApiClient                *api.Client
 }
@@ -123,6 +125,32 @@ func WithSkipSessionTeardown(with bool) Option {
 	}
 }
 
+// WithPinnedWorkerCertificate can be used to require the worker to present
+// exactly the certificate from the session authorization data. Without it, any
+// certificate that chains to the session certificate is accepted. Connections
+// to a worker presenting a different certificate fail with
+// ErrWorkerCertificatePinMismatch.
+func WithPinnedWorkerCertificate(with bool) Option {
+	return func(o *Options) error {
+		o.WithPinnedWorkerCertificate = with
+		return nil
+	}
+}
+
+// WithEndpointPort can be used to choose the port of the endpoint to connect
+// to, instead of the default port of the target. The port must be the default
+// port or one of the allowed ports of the target, otherwise the worker refuses
+// the connections.
+func WithEndpointPort(with uint32) Option {
+	return func(o *Options) error {
+		if with > 65535 {
+			return errors.New("invalid port passed to WithEndpointPort")
+		}
+		o.WithEndpointPort = with
+		return nil
+	}
+}
+
 // WithSessionTeardownTimeout provides an optional duration which overwrites
 // the default session teardown timeout.
 func WithSessionTeardownTimeout(with time.Duration) Option {
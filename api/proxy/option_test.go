@@ -88,6 +88,26 @@ func Test_GetOpts(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(opts.WithSkipSessionTeardown)
 	})
+	t.Run("with-pinned-worker-certificate", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts()
+		require.NoError(t, err)
+		assert.False(opts.WithPinnedWorkerCertificate)
+		opts, err = getOpts(WithPinnedWorkerCertificate(true))
+		require.NoError(t, err)
+		assert.True(opts.WithPinnedWorkerCertificate)
+	})
+	t.Run("with-endpoint-port", func(t *testing.T) {
+		assert := assert.New(t)
+		opts, err := getOpts()
+		require.NoError(t, err)
+		assert.Zero(opts.WithEndpointPort)
+		_, err = getOpts(WithEndpointPort(65536))
+		require.Error(t, err)
+		opts, err = getOpts(WithEndpointPort(6432))
+		require.NoError(t, err)
+		assert.Equal(uint32(6432), opts.WithEndpointPort)
+	})
 	t.Run("withSessionTeardownTimeout", func(t *testing.T) {
 		assert := assert.New(t)
 		opts, err := getOpts()
@@ -33,6 +33,11 @@ import (
 // timeout to be an option if we wish.
 const sessionCancelTimeout = 30 * time.Second
 
+// workerDialTimeout bounds how long establishing a TCP connection to a single
+// worker address may take, so that an unreachable address does not keep the
+// proxy from falling back to the next one.
+const workerDialTimeout = 10 * time.Second
+
 type ClientProxy struct {
 	tofuToken               string
 	cachedListenerAddress   *ua.String
@@ -46,7 +51,8 @@ type ClientProxy struct {
 	ctx                     context.Context
 	cancel                  context.CancelFunc
 	transport               *http.Transport
-	workerAddr              string
+	workerAddrs             []string
+	workerAddr              *ua.String
 	listenAddrPort          netip.AddrPort
 	listener                *atomic.Value
 	listenerCloseOnce       *sync.Once
@@ -54,6 +60,7 @@ type ClientProxy struct {
 	connWg                  *sync.WaitGroup
 	started                 *atomic.Bool
 	skipSessionTeardown     bool
+	endpointPort            uint32
 }
 
 // New creates a new client proxy. The given context should be cancelable; once
@@ -77,6 +84,12 @@ type ClientProxy struct {
 // * WithWorkerHost - If set, use this host name as the SNI host when making the
 // TLS connection to the worker
 //
+// * WithPinnedWorkerCertificate - If set, refuse connections to workers that do
+// not present exactly the certificate from the session authorization data
+//
+// * WithEndpointPort - If set, connect to this port of the endpoint instead of
+// the default port of the target; it must be allowed by the target
+//
 // EXPERIMENTAL: While this API is not expected to change, it is new and
 // feedback from users may necessitate changes.
 func New(ctx context.Context, authzToken string, opt ...Option) (*ClientProxy, error) {
@@ -100,6 +113,7 @@ func New(ctx context.Context, authzToken string, opt ...Option) (*ClientProxy, e
 		callerConnectionsLeftCh: opts.WithConnectionsLeftCh,
 		started:                 new(atomic.Bool),
 		skipSessionTeardown:     opts.WithSkipSessionTeardown,
+		endpointPort:            opts.WithEndpointPort,
 		apiClient:               opts.withApiClient,
 	}
 
@@ -130,7 +144,12 @@ func New(ctx context.Context, authzToken string, opt ...Option) (*ClientProxy, e
 		}
 	}
 	p.connectionsLeft.Store(p.sessionAuthzData.ConnectionLimit)
-	p.workerAddr = p.sessionAuthzData.WorkerInfo[0].Address
+	// The worker addresses are ordered by preference; the first one is tried
+	// first and the others are fallbacks for when it cannot be reached
+	for _, wi := range p.sessionAuthzData.WorkerInfo {
+		p.workerAddrs = append(p.workerAddrs, wi.Address)
+	}
+	p.workerAddr = ua.NewString(p.workerAddrs[0])
 
 	tlsConf, err := p.clientTlsConfig(opt...)
 	if err != nil {
@@ -150,7 +169,10 @@ func New(ctx context.Context, authzToken string, opt ...Option) (*ClientProxy, e
 	// hijacked, just setting for completeness
 	transport.IdleConnTimeout = 0
 	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
-		dialer := &tls.Dialer{Config: tlsConf}
+		dialer := &tls.Dialer{
+			NetDialer: &net.Dialer{Timeout: workerDialTimeout},
+			Config:    tlsConf,
+		}
 		return dialer.DialContext(ctx, network, addr)
 	}
 	p.transport = transport
@@ -7,19 +7,28 @@ import (
 	"context"
 	"crypto/ed25519"
 	"crypto/rand"
+	"crypto/tls"
 	"crypto/x509"
+	"fmt"
 	"math/big"
 	mathrand "math/rand"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/boundary/api/consts"
 	"github.com/hashicorp/boundary/api/scopes"
 	"github.com/hashicorp/boundary/api/targets"
+	pb "github.com/hashicorp/boundary/sdk/pbs/proxy"
 	"github.com/mitchellh/copystructure"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"nhooyr.io/websocket"
 )
 
 func TestNew(t *testing.T) {
@@ -104,6 +113,158 @@ func TestNew(t *testing.T) {
 			assert.NotNil(p.ctx)
 			assert.NotNil(p.cancel)
 			assert.NotNil(p.transport)
+			assert.Equal([]string{"localhost:9202", "[::1]:9201"}, p.workerAddrs)
+			assert.Equal("localhost:9202", p.workerAddr.Load())
+		})
+	}
+}
+
+func TestGetWsConn_Fallback(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+
+	sessionAuth, privKey := testSessionAuthWithKey(t)
+	cert := tls.Certificate{
+		Certificate: [][]byte{sessionAuth.Certificate},
+		PrivateKey:  privKey,
+	}
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+			Subprotocols: []string{consts.WebsocketProtocolTcpProxyV1},
+		})
+		if err != nil {
+			return
+		}
+		conn.Close(websocket.StatusNormalClosure, "")
+	}))
+	srv.TLS = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{"http/1.1"},
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	reachableAddr := srv.Listener.Addr().String()
+
+	// Find an address nothing is listening on
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	unreachableAddr := l.Addr().String()
+	require.NoError(l.Close())
+
+	sessionAuth.WorkerInfo = []*targets.WorkerInfo{
+		{Address: unreachableAddr},
+		{Address: reachableAddr},
+	}
+	p, err := New(context.Background(), "", WithSessionAuthorizationData(sessionAuth))
+	require.NoError(err)
+
+	conn, err := p.getWsConn(context.Background())
+	require.NoError(err)
+	conn.Close(websocket.StatusNormalClosure, "")
+	// The reachable address is tried first from now on
+	assert.Equal(reachableAddr, p.workerAddr.Load())
+
+	sessionAuth.WorkerInfo = []*targets.WorkerInfo{
+		{Address: unreachableAddr},
+	}
+	p, err = New(context.Background(), "", WithSessionAuthorizationData(sessionAuth))
+	require.NoError(err)
+	_, err = p.getWsConn(context.Background())
+	require.ErrorContains(err, fmt.Sprintf("unable to connect to worker at %s", unreachableAddr))
+}
+
+func TestGetWsConn_PinnedWorkerCertificate(t *testing.T) {
+	t.Parallel()
+
+	sessionAuth, privKey := testSessionAuthWithKey(t)
+	sessionCert, err := x509.ParseCertificate(sessionAuth.Certificate)
+	require.NoError(t, err)
+
+	// A certificate signed by the session certificate, as an interceptor
+	// holding the session private key could present
+	pubKey, otherKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{sessionAuth.SessionId},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		SerialNumber: big.NewInt(mathrand.Int63()),
+		NotBefore:    sessionCert.NotBefore,
+		NotAfter:     sessionCert.NotAfter,
+	}
+	otherCertBytes, err := x509.CreateCertificate(rand.Reader, template, sessionCert, pubKey, privKey)
+	require.NoError(t, err)
+
+	startWorker := func(t *testing.T, cert tls.Certificate) string {
+		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+				Subprotocols: []string{consts.WebsocketProtocolTcpProxyV1},
+			})
+			if err != nil {
+				return
+			}
+			conn.Close(websocket.StatusNormalClosure, "")
+		}))
+		srv.TLS = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			NextProtos:   []string{"http/1.1"},
+		}
+		srv.StartTLS()
+		t.Cleanup(srv.Close)
+		return srv.Listener.Addr().String()
+	}
+	sessionWorker := startWorker(t, tls.Certificate{
+		Certificate: [][]byte{sessionAuth.Certificate},
+		PrivateKey:  privKey,
+	})
+	otherWorker := startWorker(t, tls.Certificate{
+		Certificate: [][]byte{otherCertBytes, sessionAuth.Certificate},
+		PrivateKey:  otherKey,
+	})
+
+	tests := []struct {
+		name    string
+		addr    string
+		pin     bool
+		wantErr error
+	}{
+		{
+			name: "session-certificate",
+			addr: sessionWorker,
+		},
+		{
+			name: "session-certificate-pinned",
+			addr: sessionWorker,
+			pin:  true,
+		},
+		{
+			name: "other-certificate",
+			addr: otherWorker,
+		},
+		{
+			name:    "other-certificate-pinned",
+			addr:    otherWorker,
+			pin:     true,
+			wantErr: ErrWorkerCertificatePinMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			cpRaw, err := copystructure.Copy(sessionAuth)
+			require.NoError(err)
+			cp := cpRaw.(*targets.SessionAuthorizationData)
+			cp.WorkerInfo = []*targets.WorkerInfo{{Address: tt.addr}}
+			p, err := New(context.Background(), "", WithSessionAuthorizationData(cp), WithPinnedWorkerCertificate(tt.pin))
+			require.NoError(err)
+
+			conn, err := p.getWsConn(context.Background())
+			if tt.wantErr != nil {
+				require.ErrorIs(err, tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			conn.Close(websocket.StatusNormalClosure, "")
 		})
 	}
 }
@@ -150,6 +311,11 @@ func TestListenerAddr(t *testing.T) {
 }
 
 func testSessionAuth(t *testing.T) *targets.SessionAuthorizationData {
+	sessionAuth, _ := testSessionAuthWithKey(t)
+	return sessionAuth
+}
+
+func testSessionAuthWithKey(t *testing.T) (*targets.SessionAuthorizationData, ed25519.PrivateKey) {
 	sessionAuth := &targets.SessionAuthorizationData{
 		SessionId: "s_1234567890",
 		TargetId:  "ttcp_1234567890",
@@ -194,5 +360,36 @@ func testSessionAuth(t *testing.T) *targets.SessionAuthorizationData {
 	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, pubKey, privKey)
 	require.NoError(t, err)
 	sessionAuth.Certificate = certBytes
-	return sessionAuth
+	return sessionAuth, privKey
+}
+
+func TestSetHandshakePort(t *testing.T) {
+	// port returns the port encoded in the marshaled handshake, or 0.
+	port := func(t *testing.T, h *pb.ClientHandshake) uint64 {
+		t.Helper()
+		b, err := proto.Marshal(h)
+		require.NoError(t, err)
+		var ret uint64
+		for len(b) > 0 {
+			num, typ, n := protowire.ConsumeTag(b)
+			require.GreaterOrEqual(t, n, 0)
+			b = b[n:]
+			if num == handshakePortField && typ == protowire.VarintType {
+				ret, n = protowire.ConsumeVarint(b)
+			} else {
+				n = protowire.ConsumeFieldValue(num, typ, b)
+			}
+			require.GreaterOrEqual(t, n, 0)
+			b = b[n:]
+		}
+		return ret
+	}
+
+	h := &pb.ClientHandshake{TofuToken: "token"}
+	setHandshakePort(h, 0)
+	assert.Zero(t, port(t, h))
+
+	setHandshakePort(h, 5432)
+	assert.EqualValues(t, 5432, port(t, h))
+	assert.Equal(t, "token", h.GetTofuToken())
 }
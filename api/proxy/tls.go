@@ -4,20 +4,30 @@
 package proxy
 
 import (
+	"bytes"
 	"crypto/ed25519"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
 	"strings"
 )
 
+// ErrWorkerCertificatePinMismatch is returned when WithPinnedWorkerCertificate
+// is set and the worker presents a certificate other than the one from the
+// session authorization data.
+var ErrWorkerCertificatePinMismatch = errors.New("worker certificate does not match the certificate pinned from the session authorization data; the connection may have been intercepted")
+
 // clientTlsConfig creates a TLS configuration to connect to a worker proxy, or
 // returns a cached config
 //
 // Supported options: WithWorkerHost. If provided will use that host (minus
 // port) for the SNI header. Otherwise, it will use the first worker host
-// provided in the session authorization data.
+// provided in the session authorization data. WithPinnedWorkerCertificate. If
+// set, the worker must present exactly the certificate from the session
+// authorization data.
 func (p *ClientProxy) clientTlsConfig(opt ...Option) (*tls.Config, error) {
 	if p.clientTlsConf != nil {
 		return p.clientTlsConf, nil
@@ -91,10 +101,28 @@ func (p *ClientProxy) clientTlsConfig(opt ...Option) (*tls.Config, error) {
 		if len(cs.PeerCertificates) == 0 {
 			return fmt.Errorf("%s: no peer certificates provided", op)
 		}
-		_, err := cs.PeerCertificates[0].Verify(verifyOpts)
-		return err
+		if _, err := cs.PeerCertificates[0].Verify(verifyOpts); err != nil {
+			return err
+		}
+		if opts.WithPinnedWorkerCertificate {
+			// Any certificate signed by the session certificate passes the
+			// verification above, so compare the presented chain and the
+			// public key of the leaf with the session certificate itself.
+			if len(cs.PeerCertificates) != 1 ||
+				!bytes.Equal(spkiHash(cs.PeerCertificates[0]), spkiHash(parsedCert)) {
+				return fmt.Errorf("%s: %w", op, ErrWorkerCertificatePinMismatch)
+			}
+		}
+		return nil
 	}
 
 	p.clientTlsConf = tlsConf
 	return tlsConf, nil
 }
+
+// spkiHash returns the SHA-256 hash of the certificate's subject public key
+// info
+func spkiHash(cert *x509.Certificate) []byte {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return sum[:]
+}
@@ -16,13 +16,66 @@ import (
 	"github.com/hashicorp/boundary/api/consts"
 	pb "github.com/hashicorp/boundary/sdk/pbs/proxy"
 	"github.com/hashicorp/boundary/sdk/wspb"
+	"google.golang.org/protobuf/encoding/protowire"
 	"nhooyr.io/websocket"
 )
 
+// handshakePortField is the number of the port field of the client handshake.
+const handshakePortField protowire.Number = 30
+
+// setHandshakePort sets the port of the endpoint the client chose in the
+// handshake. The port field is newer than the version of the sdk this module
+// depends on, so it is encoded as an unknown field, which workers that know
+// the field decode as the port and older workers ignore. A port of 0 is not
+// sent, as with a field of the generated type. Once the module requires a
+// tagged sdk that has the field, the Port field should be set directly.
+func setHandshakePort(h *pb.ClientHandshake, port uint32) {
+	if port == 0 {
+		return
+	}
+	b := protowire.AppendTag(nil, handshakePortField, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(port))
+	h.ProtoReflect().SetUnknown(b)
+}
+
+// getWsConn connects to a worker, trying the worker addresses in order of
+// preference. The address that was last connected to is tried first, so once a
+// reachable address has been found further connections do not need to wait for
+// unreachable ones to fail. An address is only skipped when it cannot be
+// dialed; any other error is returned right away.
 func (p *ClientProxy) getWsConn(ctx context.Context) (*websocket.Conn, error) {
+	preferred := p.workerAddr.Load()
+	addrs := make([]string, 0, len(p.workerAddrs))
+	addrs = append(addrs, preferred)
+	for _, addr := range p.workerAddrs {
+		if addr != preferred {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	var dialErrs error
+	for _, addr := range addrs {
+		conn, err := p.dialWorker(ctx, addr)
+		var opErr *net.OpError
+		switch {
+		case err == nil:
+			p.workerAddr.Store(addr)
+			return conn, nil
+		case ctx.Err() != nil:
+			return nil, err
+		case errors.As(err, &opErr) && opErr.Op == "dial":
+			dialErrs = errors.Join(dialErrs, fmt.Errorf("unable to connect to worker at %s", addr))
+		default:
+			return nil, err
+		}
+	}
+	return nil, dialErrs
+}
+
+func (p *ClientProxy) dialWorker(ctx context.Context, addr string) (*websocket.Conn, error) {
 	conn, resp, err := websocket.Dial(
 		ctx,
-		fmt.Sprintf("ws://%s/v1/proxy", p.workerAddr),
+		fmt.Sprintf("ws://%s/v1/proxy", addr),
 		&websocket.DialOptions{
 			HTTPClient: &http.Client{
 				Transport: p.transport,
@@ -31,11 +84,12 @@ func (p *ClientProxy) getWsConn(ctx context.Context) (*websocket.Conn, error) {
 		},
 	)
 	if err != nil {
+		var opErr *net.OpError
 		switch {
 		case strings.Contains(err.Error(), "tls: internal error"):
 			return nil, errors.New("session credentials were not accepted, or session is unauthorized")
-		case strings.Contains(err.Error(), "connect: connection refused"):
-			return nil, fmt.Errorf("unable to connect to worker at %s", p.workerAddr)
+		case errors.As(err, &opErr) && opErr.Op == "dial":
+			return nil, opErr
 		default:
 			return nil, fmt.Errorf("error dialing the worker: %w", err)
 		}
@@ -73,6 +127,7 @@ func (p *ClientProxy) sendSessionTeardown(ctx context.Context) error {
 
 func (p *ClientProxy) runTcpProxyV1(wsConn *websocket.Conn, listeningConn net.Conn) error {
 	handshake := pb.ClientHandshake{TofuToken: p.tofuToken}
+	setHandshakePort(&handshake, p.endpointPort)
 	if err := wspb.Write(p.ctx, wsConn, &handshake); err != nil {
 		return fmt.Errorf("error sending handshake to worker: %w", err)
 	}
@@ -92,6 +147,11 @@ func (p *ClientProxy) runTcpProxyV1(wsConn *websocket.Conn, listeningConn net.Co
 			// should cancel anything we have going.
 			p.cancel()
 			return errors.New("session is already in use")
+		case strings.Contains(err.Error(), "port not allowed"):
+			// Every connection would use the same port, so there is no
+			// point in keeping the proxy around.
+			p.cancel()
+			return fmt.Errorf("port %d is not allowed by the target", p.endpointPort)
 		default:
 			// If we can't handshake we can't do anything, so quit out
 			p.cancel()
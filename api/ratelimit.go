@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit is the rate limit quota and policy the controller reported for a
+// request, which clients can use to throttle themselves before their quota is
+// exhausted. The quota fields are zero if the controller did not report a
+// quota, such as when the request was unlimited or the limiter was full.
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window of the
+	// quota that is closest to being exhausted.
+	Limit uint64
+	// Remaining is the number of requests left in the current window.
+	Remaining uint64
+	// Reset is the time until the current window ends and the quota is
+	// replenished.
+	Reset time.Duration
+	// Policies are the limits applied to the request.
+	Policies []RateLimitPolicy
+}
+
+// RateLimitPolicy is a limit applied to requests.
+type RateLimitPolicy struct {
+	// Limit is the number of requests allowed per Window.
+	Limit uint64
+	// Window is the period the limit applies to.
+	Window time.Duration
+	// Per is what the requests are counted per: "total", "ip-address" or
+	// "auth-token".
+	Per string
+}
+
+// RateLimit returns the rate limit information of the response. It returns
+// nil if the response does not contain rate limit headers.
+func (r *Response) RateLimit() (*RateLimit, error) {
+	if r == nil || r.resp == nil {
+		return nil, nil
+	}
+	return ParseRateLimitHeaders(r.resp.Header)
+}
+
+// ParseRateLimitHeaders parses the RateLimit and RateLimit-Policy headers set
+// by the controller. It returns nil if neither header is set.
+func ParseRateLimitHeaders(h http.Header) (*RateLimit, error) {
+	usage, policy := h.Get("RateLimit"), h.Get("RateLimit-Policy")
+	if usage == "" && policy == "" {
+		return nil, nil
+	}
+
+	rl := &RateLimit{}
+	if usage != "" {
+		for _, param := range strings.Split(usage, ",") {
+			k, v, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok {
+				return nil, fmt.Errorf("malformed RateLimit header %q", usage)
+			}
+			n, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("malformed RateLimit header %q: %w", usage, err)
+			}
+			switch k {
+			case "limit":
+				rl.Limit = n
+			case "remaining":
+				rl.Remaining = n
+			case "reset":
+				rl.Reset = time.Duration(n) * time.Second
+			}
+		}
+	}
+
+	if policy != "" {
+		for _, item := range strings.Split(policy, ",") {
+			params := strings.Split(strings.TrimSpace(item), ";")
+			limit, err := strconv.ParseUint(params[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("malformed RateLimit-Policy header %q: %w", policy, err)
+			}
+			p := RateLimitPolicy{Limit: limit}
+			for _, param := range params[1:] {
+				k, v, ok := strings.Cut(param, "=")
+				if !ok {
+					return nil, fmt.Errorf("malformed RateLimit-Policy header %q", policy)
+				}
+				switch k {
+				case "w":
+					w, err := strconv.ParseUint(v, 10, 64)
+					if err != nil {
+						return nil, fmt.Errorf("malformed RateLimit-Policy header %q: %w", policy, err)
+					}
+					p.Window = time.Duration(w) * time.Second
+				case "comment":
+					if p.Per, err = strconv.Unquote(v); err != nil {
+						p.Per = v
+					}
+				}
+			}
+			rl.Policies = append(rl.Policies, p)
+		}
+	}
+	return rl, nil
+}
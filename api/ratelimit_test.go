@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  http.Header
+		want    *RateLimit
+		wantErr bool
+	}{
+		{
+			name:   "none",
+			header: http.Header{},
+		},
+		{
+			name: "usage and policy",
+			header: http.Header{
+				"Ratelimit":        []string{"limit=3000, remaining=2999, reset=30"},
+				"Ratelimit-Policy": []string{`30000;w=30;comment="total", 30000;w=30;comment="ip-address", 3000;w=30;comment="auth-token"`},
+			},
+			want: &RateLimit{
+				Limit:     3000,
+				Remaining: 2999,
+				Reset:     30 * time.Second,
+				Policies: []RateLimitPolicy{
+					{Limit: 30000, Window: 30 * time.Second, Per: "total"},
+					{Limit: 30000, Window: 30 * time.Second, Per: "ip-address"},
+					{Limit: 3000, Window: 30 * time.Second, Per: "auth-token"},
+				},
+			},
+		},
+		{
+			name: "policy only",
+			header: http.Header{
+				"Ratelimit-Policy": []string{`10;w=60;comment="auth-token"`},
+			},
+			want: &RateLimit{
+				Policies: []RateLimitPolicy{{Limit: 10, Window: time.Minute, Per: "auth-token"}},
+			},
+		},
+		{
+			name: "bad usage",
+			header: http.Header{
+				"Ratelimit": []string{"limit=many"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad policy",
+			header: http.Header{
+				"Ratelimit-Policy": []string{"w=60"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRateLimitHeaders(tt.header)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
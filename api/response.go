@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"mime"
 	"net/http"
 )
 
@@ -37,6 +38,20 @@ func (r *Response) StatusCode() int {
 	return r.resp.StatusCode
 }
 
+// DeprecationHeader is the response header holding a notice for each
+// deprecated field or endpoint used by the request.
+const DeprecationHeader = "Boundary-Deprecation"
+
+// Deprecations returns the notices of the deprecated fields and endpoints used
+// by the request, which will be removed in a future release. It returns nil if
+// the request did not use anything deprecated.
+func (r *Response) Deprecations() []string {
+	if r == nil || r.resp == nil {
+		return nil
+	}
+	return r.resp.Header.Values(DeprecationHeader)
+}
+
 func (r *Response) Decode(inStruct any) (*Error, error) {
 	if r == nil || r.resp == nil {
 		return nil, fmt.Errorf("nil response, cannot decode")
@@ -63,6 +78,23 @@ func (r *Response) Decode(inStruct any) (*Error, error) {
 			return nil, fmt.Errorf("error reading response body: %w", err)
 		}
 
+		if r.resp.StatusCode < 400 && isProtobufResponse(r.resp) {
+			// Translate the body to JSON so that Body and Map are populated as
+			// they would be for a JSON response.
+			var envelope protobufEnvelope
+			if r.resp.Request != nil {
+				envelope = protobufEnvelopeFor(r.resp.Request.Method, r.resp.Request.URL.Path)
+			}
+			if envelope == nil {
+				return nil, fmt.Errorf("received protobuf response from an endpoint that does not support it")
+			}
+			js, err := envelope.toJson(r.Body.Bytes())
+			if err != nil {
+				return nil, fmt.Errorf("error decoding protobuf response: %w", err)
+			}
+			r.Body = bytes.NewBuffer(js)
+		}
+
 		if r.Body.Len() > 0 {
 			reader := bytes.NewReader(r.Body.Bytes())
 			dec := json.NewDecoder(reader)
@@ -89,3 +121,9 @@ func (r *Response) Decode(inStruct any) (*Error, error) {
 
 	return nil, nil
 }
+
+// isProtobufResponse reports whether the response body is protobuf encoded.
+func isProtobufResponse(resp *http.Response) bool {
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	return err == nil && mediaType == ProtobufMimeType
+}
@@ -322,6 +322,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Role
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "roles"
 	if opts.withResourcePathOverride != "" {
@@ -483,6 +484,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *RoleListResult,
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "roles"
 	if opts.withResourcePathOverride != "" {
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scopes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type DanglingReferenceListResult struct {
+	Items    []*DanglingReference
+	response *api.Response
+}
+
+func (n DanglingReferenceListResult) GetItems() []*DanglingReference {
+	return n.Items
+}
+
+func (n DanglingReferenceListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListDanglingReferences lists the references from the resources in a scope
+// to resources which no longer exist, such as role grants naming deleted
+// resources. If recursive is true the resources of the scopes beneath it are
+// included.
+func (c *Client) ListDanglingReferences(ctx context.Context, id string, recursive bool, opt ...Option) (*DanglingReferenceListResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into ListDanglingReferences request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("scopes/%s:list-dangling-references", url.PathEscape(id)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListDanglingReferences request: %w", err)
+	}
+
+	q := url.Values{}
+	for k, v := range opts.queryMap {
+		q.Add(k, v)
+	}
+	if recursive {
+		q.Add("recursive", strconv.FormatBool(recursive))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListDanglingReferences call: %w", err)
+	}
+
+	target := new(DanglingReferenceListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListDanglingReferences response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
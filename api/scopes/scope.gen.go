@@ -31,6 +31,9 @@ type Scope struct {
 	AuthorizedActions           []string            `json:"authorized_actions,omitempty"`
 	AuthorizedCollectionActions map[string][]string `json:"authorized_collection_actions,omitempty"`
 	StoragePolicyId             string              `json:"storage_policy_id,omitempty"`
+	RequestQuota                *RequestQuota       `json:"request_quota,omitempty"`
+	Annotations                 map[string]string   `json:"annotations,omitempty"`
+	ProtectFromDeletion         bool                `json:"protect_from_deletion,omitempty"`
 }
 
 type ScopeReadResult struct {
@@ -320,6 +323,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Scop
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "scopes"
 	if opts.withResourcePathOverride != "" {
@@ -481,6 +485,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *ScopeListResult,
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "scopes"
 	if opts.withResourcePathOverride != "" {
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scopes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// SetDeletionProtection protects a scope from deletion, or removes its
+// protection. While protected, deleting the scope or any scope containing it
+// fails.
+func (c *Client) SetDeletionProtection(ctx context.Context, id string, protect bool, opt ...Option) (*ScopeUpdateResult, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty id value passed into SetDeletionProtection request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["protect_from_deletion"] = protect
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("scopes/%s:set-deletion-protection", url.PathEscape(id)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SetDeletionProtection request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during SetDeletionProtection call: %w", err)
+	}
+
+	target := new(ScopeUpdateResult)
+	target.Item = new(Scope)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding SetDeletionProtection response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type SearchListResult struct {
+	Items     []*SearchResult
+	Truncated bool
+	response  *api.Response
+}
+
+func (r SearchListResult) GetItems() []*SearchResult {
+	return r.Items
+}
+
+func (r SearchListResult) GetResponse() *api.Response {
+	return r.response
+}
+
+// Search returns the resources in the given scope, and in the scopes beneath
+// it, whose ID, name or description contains the query. Only the resources
+// the caller is allowed to list are returned. If resourceTypes is empty every
+// searchable resource type is searched, and if limit is 0 the controller's
+// default limit is used. Truncated is set on the result when more resources
+// matched than the limit allowed to be returned.
+func (c *Client) Search(ctx context.Context, scopeId, query string, resourceTypes []string, limit uint32, opt ...Option) (*SearchListResult, error) {
+	if query == "" {
+		return nil, fmt.Errorf("empty query value passed into Search request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "search", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Search request: %w", err)
+	}
+
+	q := url.Values{}
+	for k, v := range opts.queryMap {
+		q.Add(k, v)
+	}
+	if scopeId != "" {
+		q.Add("scope_id", scopeId)
+	}
+	q.Add("query", query)
+	for _, t := range resourceTypes {
+		q.Add("resource_types", t)
+	}
+	if limit != 0 {
+		q.Add("limit", strconv.FormatUint(uint64(limit), 10))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Search call: %w", err)
+	}
+
+	target := new(SearchListResult)
+	target.Items = []*SearchResult{}
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Search response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
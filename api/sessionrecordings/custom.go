@@ -10,6 +10,9 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/api"
 )
@@ -17,6 +20,47 @@ import (
 // Download will of course download the request session recording resource.
 // Currently it always requests a mime-type of asciicast.
 func (c *Client) Download(ctx context.Context, contentId string, opt ...Option) (io.ReadCloser, error) {
+	return c.download(ctx, contentId, api.AsciiCastMimeType, opt...)
+}
+
+// DownloadTranscript downloads a plain text transcript of the requested
+// session recording resource. Each line of the transcript is the terminal
+// output of the recording, with escape sequences removed, prefixed with the
+// time it was output.
+func (c *Client) DownloadTranscript(ctx context.Context, contentId string, opt ...Option) (io.ReadCloser, error) {
+	return c.download(ctx, contentId, api.TranscriptMimeType, opt...)
+}
+
+// WithRenditionLanguages sets the languages of the text to recognize when
+// downloading a text rendition of a graphical recording with
+// DownloadRendition, as BCP 47 language tags in order of preference.
+func WithRenditionLanguages(languages ...string) Option {
+	return func(o *options) {
+		o.queryMap["languages"] = strings.Join(languages, ",")
+	}
+}
+
+// WithScreenshotInterval sets the interval between screenshots when
+// downloading a screenshots rendition of a graphical recording with
+// DownloadRendition. The interval is truncated to seconds.
+func WithScreenshotInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.queryMap["screenshot_interval_seconds"] = strconv.FormatUint(uint64(interval/time.Second), 10)
+	}
+}
+
+// DownloadRendition downloads a rendition of the requested session recording
+// resource in the given mime type, such as api.ScreenshotsMimeType for a
+// graphical recording. The mime types a recording supports are listed in its
+// MimeTypes field.
+func (c *Client) DownloadRendition(ctx context.Context, contentId, mimeType string, opt ...Option) (io.ReadCloser, error) {
+	if mimeType == "" {
+		return nil, fmt.Errorf("empty mime type value passed into download request")
+	}
+	return c.download(ctx, contentId, mimeType, opt...)
+}
+
+func (c *Client) download(ctx context.Context, contentId, mimeType string, opt ...Option) (io.ReadCloser, error) {
 	switch {
 	case contentId == "":
 		return nil, fmt.Errorf("empty content id value passed into download request")
@@ -30,8 +74,8 @@ func (c *Client) Download(ctx context.Context, contentId string, opt ...Option)
 	if err != nil {
 		return nil, fmt.Errorf("error creating download request: %w", err)
 	}
-	opts.queryMap["mime_type"] = api.AsciiCastMimeType
-	req.Header.Set("Accept", api.AsciiCastMimeType)
+	opts.queryMap["mime_type"] = mimeType
+	req.Header.Set("Accept", mimeType)
 
 	if len(opts.queryMap) > 0 {
 		q := url.Values{}
@@ -99,3 +143,47 @@ func (c *Client) ReApplyStoragePolicy(ctx context.Context, contentId string, opt
 	target.Response = resp
 	return target, nil
 }
+
+// Repair will finalize a session recording that was left incomplete because
+// the worker recording it stopped. The repaired session recording is marked
+// as partial.
+func (c *Client) Repair(ctx context.Context, contentId string, opt ...Option) (*SessionRecordingReadResult, error) {
+	switch {
+	case contentId == "":
+		return nil, fmt.Errorf("empty content id value passed into repair request")
+	case c.client == nil:
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", "session-recordings/"+url.PathEscape(contentId)+":repair", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating repair request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Repair call: %w", err)
+	}
+
+	target := new(SessionRecordingReadResult)
+	target.Item = new(SessionRecording)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Repair response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
@@ -218,6 +218,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Sess
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "session-recordings"
 	if opts.withResourcePathOverride != "" {
@@ -379,6 +380,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *SessionRecording
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "session-recordings"
 	if opts.withResourcePathOverride != "" {
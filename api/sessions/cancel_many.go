@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// CancelFilter selects the sessions canceled by CancelMany. Only sessions
+// matching every set field are canceled.
+type CancelFilter struct {
+	// ScopeId is the project containing the sessions, or the scope to cancel
+	// sessions beneath when Recursive is set.
+	ScopeId   string
+	Recursive bool
+	// UserId restricts the sessions to those requested by this user.
+	UserId string
+	// TargetId restricts the sessions to those to this target.
+	TargetId string
+	// OlderThan restricts the sessions to those created at least this long
+	// ago. It is truncated to seconds.
+	OlderThan time.Duration
+	// Filter is a filter expression evaluated against each session, in the
+	// same way as when listing sessions.
+	Filter string
+}
+
+type CancelManyResult struct {
+	Items    []*SessionCancelResult
+	Response *api.Response
+}
+
+func (n CancelManyResult) GetItems() []*SessionCancelResult {
+	return n.Items
+}
+
+func (n CancelManyResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// CancelMany cancels every session matching the filter. Sessions which could
+// not be canceled, for instance because the caller is not allowed to, are
+// reported in the returned items along with the reason rather than failing the
+// whole call.
+func (c *Client) CancelMany(ctx context.Context, filter CancelFilter, opt ...Option) (*CancelManyResult, error) {
+	if filter.ScopeId == "" {
+		return nil, fmt.Errorf("empty ScopeId value passed into CancelMany request")
+	}
+	if filter.OlderThan < 0 {
+		return nil, fmt.Errorf("negative OlderThan value passed into CancelMany request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	opts.postMap["scope_id"] = filter.ScopeId
+	if filter.Recursive {
+		opts.postMap["recursive"] = true
+	}
+	if filter.UserId != "" {
+		opts.postMap["user_id"] = filter.UserId
+	}
+	if filter.TargetId != "" {
+		opts.postMap["target_id"] = filter.TargetId
+	}
+	if secs := uint32(filter.OlderThan / time.Second); secs > 0 {
+		opts.postMap["min_age_seconds"] = secs
+	}
+	if filter.Filter != "" {
+		opts.postMap["filter"] = filter.Filter
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", "sessions:cancel", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CancelMany request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during CancelMany call: %w", err)
+	}
+
+	target := new(CancelManyResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CancelMany response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
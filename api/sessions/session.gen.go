@@ -18,26 +18,28 @@ import (
 )
 
 type Session struct {
-	Id                string            `json:"id,omitempty"`
-	TargetId          string            `json:"target_id,omitempty"`
-	Scope             *scopes.ScopeInfo `json:"scope,omitempty"`
-	CreatedTime       time.Time         `json:"created_time,omitempty"`
-	UpdatedTime       time.Time         `json:"updated_time,omitempty"`
-	Version           uint32            `json:"version,omitempty"`
-	Type              string            `json:"type,omitempty"`
-	ExpirationTime    time.Time         `json:"expiration_time,omitempty"`
-	AuthTokenId       string            `json:"auth_token_id,omitempty"`
-	UserId            string            `json:"user_id,omitempty"`
-	HostSetId         string            `json:"host_set_id,omitempty"`
-	HostId            string            `json:"host_id,omitempty"`
-	ScopeId           string            `json:"scope_id,omitempty"`
-	Endpoint          string            `json:"endpoint,omitempty"`
-	States            []*SessionState   `json:"states,omitempty"`
-	Status            string            `json:"status,omitempty"`
-	Certificate       []byte            `json:"certificate,omitempty"`
-	TerminationReason string            `json:"termination_reason,omitempty"`
-	AuthorizedActions []string          `json:"authorized_actions,omitempty"`
-	Connections       []*Connection     `json:"connections,omitempty"`
+	Id                          string            `json:"id,omitempty"`
+	TargetId                    string            `json:"target_id,omitempty"`
+	Scope                       *scopes.ScopeInfo `json:"scope,omitempty"`
+	CreatedTime                 time.Time         `json:"created_time,omitempty"`
+	UpdatedTime                 time.Time         `json:"updated_time,omitempty"`
+	Version                     uint32            `json:"version,omitempty"`
+	Type                        string            `json:"type,omitempty"`
+	ExpirationTime              time.Time         `json:"expiration_time,omitempty"`
+	AuthTokenId                 string            `json:"auth_token_id,omitempty"`
+	UserId                      string            `json:"user_id,omitempty"`
+	HostSetId                   string            `json:"host_set_id,omitempty"`
+	HostId                      string            `json:"host_id,omitempty"`
+	ScopeId                     string            `json:"scope_id,omitempty"`
+	Endpoint                    string            `json:"endpoint,omitempty"`
+	States                      []*SessionState   `json:"states,omitempty"`
+	Status                      string            `json:"status,omitempty"`
+	Certificate                 []byte            `json:"certificate,omitempty"`
+	TerminationReason           string            `json:"termination_reason,omitempty"`
+	RecordingSkipReason         string            `json:"recording_skip_reason,omitempty"`
+	AuthorizationExpirationTime *time.Time        `json:"authorization_expiration_time,omitempty"`
+	AuthorizedActions           []string          `json:"authorized_actions,omitempty"`
+	Connections                 []*Connection     `json:"connections,omitempty"`
 }
 
 type SessionReadResult struct {
@@ -178,6 +180,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Sess
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "sessions"
 	if opts.withResourcePathOverride != "" {
@@ -339,6 +342,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *SessionListResul
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "sessions"
 	if opts.withResourcePathOverride != "" {
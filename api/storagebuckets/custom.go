@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagebuckets
+
+const quotaField = "quota"
+
+// WithQuota sets the storage quota of the storage bucket. A limit of zero
+// means no limit.
+func WithQuota(inQuota *StorageBucketQuota) Option {
+	return func(o *options) {
+		if inQuota == nil {
+			o.postMap[quotaField] = nil
+			return
+		}
+		o.postMap[quotaField] = map[string]any{
+			"max_bytes":                 inQuota.MaxBytes,
+			"max_objects":               inQuota.MaxObjects,
+			"warning_threshold_percent": inQuota.WarningThresholdPercent,
+			"block_when_exceeded":       inQuota.BlockWhenExceeded,
+		}
+	}
+}
+
+// DefaultQuota removes the storage quota of the storage bucket.
+func DefaultQuota() Option {
+	return func(o *options) {
+		o.postMap[quotaField] = nil
+	}
+}
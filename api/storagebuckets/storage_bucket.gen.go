@@ -38,6 +38,9 @@ type StorageBucket struct {
 	SecretsHmac               string                 `json:"secrets_hmac,omitempty"`
 	WorkerFilter              string                 `json:"worker_filter,omitempty"`
 	StorageBucketCredentialId string                 `json:"storage_bucket_credential_id,omitempty"`
+	Usage                     *StorageBucketUsage    `json:"usage,omitempty"`
+	Quota                     *StorageBucketQuota    `json:"quota,omitempty"`
+	ProtectFromDeletion       bool                   `json:"protect_from_deletion,omitempty"`
 	AuthorizedActions         []string               `json:"authorized_actions,omitempty"`
 }
 
@@ -328,6 +331,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Stor
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "storage-buckets"
 	if opts.withResourcePathOverride != "" {
@@ -489,6 +493,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *StorageBucketLis
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "storage-buckets"
 	if opts.withResourcePathOverride != "" {
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package targets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// CreateFromTemplate creates a target from the target template with the given
+// id, in the project of the template. Each parameter is substituted into the
+// string values of the template's definition which reference it, such as
+// {{.service}}; every parameter referenced by the definition must be given.
+//
+// The name set with WithName overrides the name of the definition. Host and
+// credential sources of the definition are added to the target as it is
+// created.
+func (c *Client) CreateFromTemplate(ctx context.Context, templateId string, parameters map[string]string, opt ...Option) (*TargetCreateResult, error) {
+	if templateId == "" {
+		return nil, fmt.Errorf("empty templateId value passed into CreateFromTemplate request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	body := map[string]any{
+		"template_id": templateId,
+	}
+	if len(parameters) > 0 {
+		body["parameters"] = parameters
+	}
+	if name, ok := opts.postMap["name"]; ok && name != nil {
+		body["name"] = name
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", "targets:create-from-template", body, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CreateFromTemplate request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during CreateFromTemplate call: %w", err)
+	}
+
+	target := new(TargetCreateResult)
+	target.Item = new(Target)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CreateFromTemplate response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
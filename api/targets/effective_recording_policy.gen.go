@@ -0,0 +1,37 @@
+// Code generated by "make api"; DO NOT EDIT.
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package targets
+
+import (
+	"github.com/hashicorp/boundary/api"
+)
+
+type EffectiveRecordingPolicy struct {
+	TargetId               string `json:"target_id,omitempty"`
+	RecordingEnabled       bool   `json:"recording_enabled,omitempty"`
+	StorageBucketId        string `json:"storage_bucket_id,omitempty"`
+	StorageBucketScopeId   string `json:"storage_bucket_scope_id,omitempty"`
+	StoragePolicyId        string `json:"storage_policy_id,omitempty"`
+	StoragePolicyScopeId   string `json:"storage_policy_scope_id,omitempty"`
+	RetainForDays          int32  `json:"retain_for_days,omitempty"`
+	RetainForDaysScopeId   string `json:"retain_for_days_scope_id,omitempty"`
+	DeleteAfterDays        int32  `json:"delete_after_days,omitempty"`
+	DeleteAfterDaysScopeId string `json:"delete_after_days_scope_id,omitempty"`
+	RecordPercent          int32  `json:"record_percent,omitempty"`
+	RecordFilter           string `json:"record_filter,omitempty"`
+}
+
+type EffectiveRecordingPolicyReadResult struct {
+	Item     *EffectiveRecordingPolicy
+	Response *api.Response
+}
+
+func (n EffectiveRecordingPolicyReadResult) GetItem() *EffectiveRecordingPolicy {
+	return n.Item
+}
+
+func (n EffectiveRecordingPolicyReadResult) GetResponse() *api.Response {
+	return n.Response
+}
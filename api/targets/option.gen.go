@@ -29,6 +29,7 @@ type options struct {
 	withListToken                string
 	withClientDirectedPagination bool
 	withPageSize                 uint32
+	withExactCount               bool
 	withResourcePathOverride     string
 	withRecursive                bool
 }
@@ -63,6 +64,9 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withPageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
 	}
+	if opts.withExactCount {
+		opts.queryMap["exact_count"] = strconv.FormatBool(opts.withExactCount)
+	}
 	return opts, apiOpts
 }
 
@@ -116,6 +120,15 @@ func WithPageSize(with uint32) Option {
 	}
 }
 
+// WithExactCount tells the API to return an exact item count instead of an
+// estimate in the first page of a List call. The call fails if more than
+// 10,000 items are available.
+func WithExactCount(with bool) Option {
+	return func(o *options) {
+		o.withExactCount = with
+	}
+}
+
 // WithResourcePathOverride tells the API to use the provided resource path
 func WithResourcePathOverride(path string) Option {
 	return func(o *options) {
@@ -149,6 +162,54 @@ func WithAliases(inAliases []Alias) Option {
 	}
 }
 
+func WithSshTargetAllowedCommands(inAllowedCommands []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["allowed_commands"] = inAllowedCommands
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetAllowedCommands() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["allowed_commands"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithAllowedPorts(inAllowedPorts string) Option {
+	return func(o *options) {
+		o.postMap["allowed_ports"] = inAllowedPorts
+	}
+}
+
+func DefaultAllowedPorts() Option {
+	return func(o *options) {
+		o.postMap["allowed_ports"] = nil
+	}
+}
+
+func WithAnnotations(inAnnotations map[string]string) Option {
+	return func(o *options) {
+		o.postMap["annotations"] = inAnnotations
+	}
+}
+
+func DefaultAnnotations() Option {
+	return func(o *options) {
+		o.postMap["annotations"] = nil
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -161,6 +222,30 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithAuthorizationTokenSingleUse(inAuthorizationTokenSingleUse bool) Option {
+	return func(o *options) {
+		o.postMap["authorization_token_single_use"] = inAuthorizationTokenSingleUse
+	}
+}
+
+func DefaultAuthorizationTokenSingleUse() Option {
+	return func(o *options) {
+		o.postMap["authorization_token_single_use"] = nil
+	}
+}
+
+func WithAuthorizationTokenTtlSeconds(inAuthorizationTokenTtlSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["authorization_token_ttl_seconds"] = inAuthorizationTokenTtlSeconds
+	}
+}
+
+func DefaultAuthorizationTokenTtlSeconds() Option {
+	return func(o *options) {
+		o.postMap["authorization_token_ttl_seconds"] = nil
+	}
+}
+
 func WithBrokeredCredentialSourceIds(inBrokeredCredentialSourceIds []string) Option {
 	return func(o *options) {
 		o.postMap["brokered_credential_source_ids"] = inBrokeredCredentialSourceIds
@@ -269,6 +354,30 @@ func DefaultTcpTargetDefaultPort() Option {
 	}
 }
 
+func WithSshTargetDeniedCommands(inDeniedCommands []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["denied_commands"] = inDeniedCommands
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetDeniedCommands() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["denied_commands"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -305,6 +414,18 @@ func WithSshTargetEnableSessionRecording(inEnableSessionRecording bool) Option {
 	}
 }
 
+func WithEnvironmentId(inEnvironmentId string) Option {
+	return func(o *options) {
+		o.postMap["environment_id"] = inEnvironmentId
+	}
+}
+
+func DefaultEnvironmentId() Option {
+	return func(o *options) {
+		o.postMap["environment_id"] = nil
+	}
+}
+
 func WithHostId(inHostId string) Option {
 	return func(o *options) {
 		o.postMap["host_id"] = inHostId
@@ -335,6 +456,18 @@ func DefaultInjectedApplicationCredentialSourceIds() Option {
 	}
 }
 
+func WithLocality(inLocality string) Option {
+	return func(o *options) {
+		o.postMap["locality"] = inLocality
+	}
+}
+
+func DefaultLocality() Option {
+	return func(o *options) {
+		o.postMap["locality"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -347,6 +480,30 @@ func DefaultName() Option {
 	}
 }
 
+func WithPreferredEndpoint(inPreferredEndpoint string) Option {
+	return func(o *options) {
+		o.postMap["preferred_endpoint"] = inPreferredEndpoint
+	}
+}
+
+func DefaultPreferredEndpoint() Option {
+	return func(o *options) {
+		o.postMap["preferred_endpoint"] = nil
+	}
+}
+
+func WithRecordingParameters(inRecordingParameters *RecordingParameters) Option {
+	return func(o *options) {
+		o.postMap["recording_parameters"] = inRecordingParameters
+	}
+}
+
+func DefaultRecordingParameters() Option {
+	return func(o *options) {
+		o.postMap["recording_parameters"] = nil
+	}
+}
+
 func WithScopeId(inScopeId string) Option {
 	return func(o *options) {
 		o.postMap["scope_id"] = inScopeId
@@ -371,6 +528,30 @@ func DefaultSessionConnectionLimit() Option {
 	}
 }
 
+func WithSshTargetSessionEnvironment(inSessionEnvironment map[string]string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["session_environment"] = inSessionEnvironment
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetSessionEnvironment() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["session_environment"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSessionMaxSeconds(inSessionMaxSeconds uint32) Option {
 	return func(o *options) {
 		o.postMap["session_max_seconds"] = inSessionMaxSeconds
@@ -407,6 +588,54 @@ func DefaultSshTargetStorageBucketId() Option {
 	}
 }
 
+func WithTcpTargetTlsPassthrough(inTlsPassthrough bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_passthrough"] = inTlsPassthrough
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetTlsPassthrough() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_passthrough"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithTcpTargetTlsServerNames(inTlsServerNames string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_server_names"] = inTlsServerNames
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetTlsServerNames() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_server_names"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithWorkerFilter(inWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["worker_filter"] = inWorkerFilter
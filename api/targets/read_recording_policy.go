@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package targets
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ReadRecordingPolicy returns the recording policy that applies to the
+// sessions of the target, once the settings of the target and of the storage
+// policies that apply to it have been combined.
+func (c *Client) ReadRecordingPolicy(ctx context.Context, targetId string, opt ...Option) (*EffectiveRecordingPolicyReadResult, error) {
+	if targetId == "" {
+		return nil, fmt.Errorf("empty targetId value passed into ReadRecordingPolicy request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("targets/%s:read-recording-policy", url.PathEscape(targetId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ReadRecordingPolicy request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ReadRecordingPolicy call: %w", err)
+	}
+
+	target := new(EffectiveRecordingPolicyReadResult)
+	target.Item = new(EffectiveRecordingPolicy)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ReadRecordingPolicy response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
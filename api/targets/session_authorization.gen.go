@@ -11,19 +11,21 @@ import (
 )
 
 type SessionAuthorization struct {
-	SessionId          string               `json:"session_id,omitempty"`
-	TargetId           string               `json:"target_id,omitempty"`
-	Scope              *scopes.ScopeInfo    `json:"scope,omitempty"`
-	CreatedTime        time.Time            `json:"created_time,omitempty"`
-	UserId             string               `json:"user_id,omitempty"`
-	HostSetId          string               `json:"host_set_id,omitempty"`
-	HostId             string               `json:"host_id,omitempty"`
-	Type               string               `json:"type,omitempty"`
-	AuthorizationToken string               `json:"authorization_token,omitempty"`
-	ConnectionLimit    int32                `json:"connection_limit,omitempty"`
-	Endpoint           string               `json:"endpoint,omitempty"`
-	EndpointPort       uint32               `json:"endpoint_port,omitempty"`
-	Expiration         time.Time            `json:"expiration,omitempty"`
-	Credentials        []*SessionCredential `json:"credentials,omitempty"`
-	SessionRecordingId string               `json:"session_recording_id,omitempty"`
+	SessionId             string               `json:"session_id,omitempty"`
+	TargetId              string               `json:"target_id,omitempty"`
+	Scope                 *scopes.ScopeInfo    `json:"scope,omitempty"`
+	CreatedTime           time.Time            `json:"created_time,omitempty"`
+	UserId                string               `json:"user_id,omitempty"`
+	HostSetId             string               `json:"host_set_id,omitempty"`
+	HostId                string               `json:"host_id,omitempty"`
+	Type                  string               `json:"type,omitempty"`
+	AuthorizationToken    string               `json:"authorization_token,omitempty"`
+	ConnectionLimit       int32                `json:"connection_limit,omitempty"`
+	Endpoint              string               `json:"endpoint,omitempty"`
+	EndpointPort          uint32               `json:"endpoint_port,omitempty"`
+	Expiration            time.Time            `json:"expiration,omitempty"`
+	Credentials           []*SessionCredential `json:"credentials,omitempty"`
+	SessionRecordingId    string               `json:"session_recording_id,omitempty"`
+	WorkerId              string               `json:"worker_id,omitempty"`
+	WorkerSelectionReason string               `json:"worker_selection_reason,omitempty"`
 }
@@ -6,9 +6,12 @@ package targets
 
 import (
 	"encoding/json"
+	"time"
 )
 
 type SessionSecret struct {
-	Raw     json.RawMessage        `json:"raw,omitempty"`
-	Decoded map[string]interface{} `json:"decoded,omitempty"`
+	Raw         json.RawMessage        `json:"raw,omitempty"`
+	Decoded     map[string]interface{} `json:"decoded,omitempty"`
+	Version     uint32                 `json:"version,omitempty"`
+	CreatedTime time.Time              `json:"created_time,omitempty"`
 }
@@ -11,10 +11,13 @@ import (
 )
 
 type SshTargetAttributes struct {
-	DefaultPort            uint32 `json:"default_port,omitempty"`
-	DefaultClientPort      uint32 `json:"default_client_port,omitempty"`
-	StorageBucketId        string `json:"storage_bucket_id,omitempty"`
-	EnableSessionRecording bool   `json:"enable_session_recording,omitempty"`
+	DefaultPort            uint32            `json:"default_port,omitempty"`
+	DefaultClientPort      uint32            `json:"default_client_port,omitempty"`
+	StorageBucketId        string            `json:"storage_bucket_id,omitempty"`
+	EnableSessionRecording bool              `json:"enable_session_recording,omitempty"`
+	SessionEnvironment     map[string]string `json:"session_environment,omitempty"`
+	AllowedCommands        []string          `json:"allowed_commands,omitempty"`
+	DeniedCommands         []string          `json:"denied_commands,omitempty"`
 }
 
 func AttributesMapToSshTargetAttributes(in map[string]any) (*SshTargetAttributes, error) {
@@ -35,6 +35,11 @@ type Target struct {
 	WorkerFilter                           string                 `json:"worker_filter,omitempty"`
 	EgressWorkerFilter                     string                 `json:"egress_worker_filter,omitempty"`
 	IngressWorkerFilter                    string                 `json:"ingress_worker_filter,omitempty"`
+	Locality                               string                 `json:"locality,omitempty"`
+	AuthorizationTokenTtlSeconds           uint32                 `json:"authorization_token_ttl_seconds,omitempty"`
+	AuthorizationTokenSingleUse            bool                   `json:"authorization_token_single_use,omitempty"`
+	PreferredEndpoint                      string                 `json:"preferred_endpoint,omitempty"`
+	AllowedPorts                           string                 `json:"allowed_ports,omitempty"`
 	BrokeredCredentialSourceIds            []string               `json:"brokered_credential_source_ids,omitempty"`
 	BrokeredCredentialSources              []*CredentialSource    `json:"brokered_credential_sources,omitempty"`
 	InjectedApplicationCredentialSourceIds []string               `json:"injected_application_credential_source_ids,omitempty"`
@@ -44,6 +49,10 @@ type Target struct {
 	Address                                string                 `json:"address,omitempty"`
 	Aliases                                []*Alias               `json:"aliases,omitempty"`
 	WithAliases                            []*Alias               `json:"with_aliases,omitempty"`
+	Annotations                            map[string]string      `json:"annotations,omitempty"`
+	EnvironmentId                          string                 `json:"environment_id,omitempty"`
+	ProtectFromDeletion                    bool                   `json:"protect_from_deletion,omitempty"`
+	RecordingParameters                    *RecordingParameters   `json:"recording_parameters,omitempty"`
 }
 
 type TargetReadResult struct {
@@ -338,6 +347,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Targ
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "targets"
 	if opts.withResourcePathOverride != "" {
@@ -499,6 +509,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *TargetListResult
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "targets"
 	if opts.withResourcePathOverride != "" {
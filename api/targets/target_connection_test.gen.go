@@ -0,0 +1,37 @@
+// Code generated by "make api"; DO NOT EDIT.
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package targets
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type TargetConnectionTest struct {
+	TargetId   string    `json:"target_id,omitempty"`
+	HostId     string    `json:"host_id,omitempty"`
+	WorkerId   string    `json:"worker_id,omitempty"`
+	Address    string    `json:"address,omitempty"`
+	Check      string    `json:"check,omitempty"`
+	Success    bool      `json:"success,omitempty"`
+	LatencyMs  float64   `json:"latency_ms,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Detail     string    `json:"detail,omitempty"`
+	TestedTime time.Time `json:"tested_time,omitempty"`
+}
+
+type TargetConnectionTestReadResult struct {
+	Item     *TargetConnectionTest
+	Response *api.Response
+}
+
+func (n TargetConnectionTestReadResult) GetItem() *TargetConnectionTest {
+	return n.Item
+}
+
+func (n TargetConnectionTestReadResult) GetResponse() *api.Response {
+	return n.Response
+}
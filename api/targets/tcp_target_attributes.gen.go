@@ -13,6 +13,8 @@ import (
 type TcpTargetAttributes struct {
 	DefaultPort       uint32 `json:"default_port,omitempty"`
 	DefaultClientPort uint32 `json:"default_client_port,omitempty"`
+	TlsPassthrough    bool   `json:"tls_passthrough,omitempty"`
+	TlsServerNames    string `json:"tls_server_names,omitempty"`
 }
 
 func AttributesMapToTcpTargetAttributes(in map[string]any) (*TcpTargetAttributes, error) {
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package targets
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// WithConnectionCheck sets the check TestConnection asks the worker to perform
+// once connected to the target: "tcp" only opens the connection, "tls" also
+// performs a TLS handshake and "ssh" also reads the SSH banner. Defaults to
+// "tcp".
+func WithConnectionCheck(inCheck string) Option {
+	return func(o *options) {
+		o.postMap["check"] = inCheck
+	}
+}
+
+// TestConnection asks a worker that would be chosen to handle a session to the
+// target to connect to it, and returns whether it succeeded along with the
+// time it took. The host of a target using host sources can be chosen with
+// WithHostId.
+func (c *Client) TestConnection(ctx context.Context, targetId string, opt ...Option) (*TargetConnectionTestReadResult, error) {
+	if targetId == "" {
+		return nil, fmt.Errorf("empty targetId value passed into TestConnection request")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("targets/%s:test-connection", url.PathEscape(targetId)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating TestConnection request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during TestConnection call: %w", err)
+	}
+
+	target := new(TargetConnectionTestReadResult)
+	target.Item = new(TargetConnectionTest)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding TestConnection response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
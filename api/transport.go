@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+)
+
+const (
+	// DefaultMaxIdleConnsPerHost is the default number of idle connections
+	// kept open to the controller for reuse.
+	DefaultMaxIdleConnsPerHost = 100
+
+	// DefaultDialTimeout is the default amount of time to wait for a
+	// connection to the controller to be established.
+	DefaultDialTimeout = 30 * time.Second
+)
+
+// TransportConfig contains the parameters used to tune the HTTP transport used
+// to communicate with Boundary.
+type TransportConfig struct {
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept open
+	// to the controller for reuse. Clients that make many concurrent requests
+	// should make sure it is at least their concurrency; otherwise
+	// connections are closed and reopened for every request, which can
+	// exhaust the ephemeral ports of the host. Defaults to
+	// DefaultMaxIdleConnsPerHost.
+	MaxIdleConnsPerHost int
+
+	// DisableHTTP2, if set, prevents the client from negotiating HTTP/2 with
+	// the controller.
+	DisableHTTP2 bool
+
+	// DialTimeout is the maximum amount of time to wait for a connection to
+	// the controller to be established. Defaults to DefaultDialTimeout.
+	DialTimeout time.Duration
+
+	// ConnectionStatsFunc, if set, is called with the statistics of the
+	// connection pool each time a request obtains a connection.
+	ConnectionStatsFunc func(ConnectionStats)
+}
+
+// ConnectionStats contains statistics about the connections opened by a
+// client and the clients cloned from it.
+type ConnectionStats struct {
+	// Opened is the number of connections that have been opened.
+	Opened uint64
+
+	// Closed is the number of connections that have been closed.
+	Closed uint64
+
+	// Requests is the number of requests that obtained a connection.
+	Requests uint64
+
+	// Reused is the number of requests that were sent over a pooled
+	// connection rather than a newly opened one.
+	Reused uint64
+}
+
+// Open returns the number of connections that are currently open.
+func (s ConnectionStats) Open() uint64 {
+	return s.Opened - s.Closed
+}
+
+// connectionStats holds the counters behind ConnectionStats.
+type connectionStats struct {
+	opened   atomic.Uint64
+	closed   atomic.Uint64
+	requests atomic.Uint64
+	reused   atomic.Uint64
+}
+
+func (s *connectionStats) snapshot() ConnectionStats {
+	return ConnectionStats{
+		Opened:   s.opened.Load(),
+		Closed:   s.closed.Load(),
+		Requests: s.requests.Load(),
+		Reused:   s.reused.Load(),
+	}
+}
+
+// countedConn counts the connection as closed the first time it is closed.
+type countedConn struct {
+	net.Conn
+	once  sync.Once
+	stats *connectionStats
+}
+
+func (c *countedConn) Close() error {
+	c.once.Do(func() {
+		c.stats.closed.Add(1)
+	})
+	return c.Conn.Close()
+}
+
+// ConfigureTransport applies the TransportConfig to the HTTP transport of the
+// client.
+func (c *Config) ConfigureTransport() error {
+	if c.HttpClient == nil {
+		c.HttpClient = cleanhttp.DefaultPooledClient()
+	}
+	if c.HttpClient.Transport == nil {
+		c.HttpClient.Transport = cleanhttp.DefaultPooledTransport()
+	}
+	transport, ok := c.HttpClient.Transport.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("unable to configure transport of type %T", c.HttpClient.Transport)
+	}
+	if c.TransportConfig == nil {
+		c.TransportConfig = &TransportConfig{}
+	}
+	conf := c.TransportConfig
+
+	maxIdleConnsPerHost := conf.MaxIdleConnsPerHost
+	if maxIdleConnsPerHost == 0 {
+		maxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+	if maxIdleConnsPerHost < 0 {
+		return fmt.Errorf("max idle connections per host must not be negative")
+	}
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	if transport.MaxIdleConns != 0 && transport.MaxIdleConns < maxIdleConnsPerHost {
+		transport.MaxIdleConns = maxIdleConnsPerHost
+	}
+
+	if conf.DisableHTTP2 {
+		// A non-nil empty map disables the automatic HTTP/2 upgrade
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	} else {
+		transport.ForceAttemptHTTP2 = true
+		transport.TLSNextProto = nil
+	}
+
+	dialTimeout := conf.DialTimeout
+	if dialTimeout == 0 {
+		dialTimeout = DefaultDialTimeout
+	}
+	if dialTimeout < 0 {
+		return fmt.Errorf("dial timeout must not be negative")
+	}
+	if c.connStats == nil {
+		c.connStats = new(connectionStats)
+	}
+	stats := c.connStats
+	dialer := &net.Dialer{
+		Timeout:   dialTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dialer.DialContext(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		stats.opened.Add(1)
+		return &countedConn{Conn: conn, stats: stats}, nil
+	}
+
+	return nil
+}
+
+// withConnectionStatsTrace returns a context that reports the connection pool
+// statistics to the ConnectionStatsFunc when the request obtains a connection.
+func withConnectionStatsTrace(ctx context.Context, stats *connectionStats, fn func(ConnectionStats)) context.Context {
+	if stats == nil {
+		return ctx
+	}
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			stats.requests.Add(1)
+			if info.Reused {
+				stats.reused.Add(1)
+			}
+			if fn != nil {
+				fn(stats.snapshot())
+			}
+		},
+	})
+}
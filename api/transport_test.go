@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureTransport(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		config, err := DefaultConfig()
+		require.NoError(t, err)
+		transport := config.HttpClient.Transport.(*http.Transport)
+		assert.Equal(t, DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+		assert.GreaterOrEqual(t, transport.MaxIdleConns, DefaultMaxIdleConnsPerHost)
+		assert.True(t, transport.ForceAttemptHTTP2)
+		assert.Nil(t, transport.TLSNextProto)
+		assert.NotNil(t, transport.DialContext)
+	})
+	t.Run("tuned", func(t *testing.T) {
+		config, err := DefaultConfig()
+		require.NoError(t, err)
+		config.TransportConfig = &TransportConfig{
+			MaxIdleConnsPerHost: 500,
+			DisableHTTP2:        true,
+			DialTimeout:         time.Second,
+		}
+		require.NoError(t, config.ConfigureTransport())
+		transport := config.HttpClient.Transport.(*http.Transport)
+		assert.Equal(t, 500, transport.MaxIdleConnsPerHost)
+		assert.Equal(t, 500, transport.MaxIdleConns)
+		assert.False(t, transport.ForceAttemptHTTP2)
+		assert.NotNil(t, transport.TLSNextProto)
+		assert.Empty(t, transport.TLSNextProto)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		config, err := DefaultConfig()
+		require.NoError(t, err)
+		config.TransportConfig = &TransportConfig{MaxIdleConnsPerHost: -1}
+		assert.Error(t, config.ConfigureTransport())
+		config.TransportConfig = &TransportConfig{DialTimeout: -time.Second}
+		assert.Error(t, config.ConfigureTransport())
+	})
+}
+
+func TestConnectionStats(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(nil)
+	require.NoError(t, err)
+	require.NoError(t, client.SetAddr(srv.URL))
+
+	var l sync.Mutex
+	var got []ConnectionStats
+	require.NoError(t, client.SetTransportConfig(&TransportConfig{
+		ConnectionStatsFunc: func(s ConnectionStats) {
+			l.Lock()
+			defer l.Unlock()
+			got = append(got, s)
+		},
+	}))
+
+	// The clone shares the connection pool and its statistics
+	for _, c := range []*Client{client, client.Clone()} {
+		req, err := c.NewRequest(context.Background(), "GET", "scopes", nil)
+		require.NoError(t, err)
+		resp, err := c.Do(req)
+		require.NoError(t, err)
+		_, err = resp.Decode(nil)
+		require.NoError(t, err)
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	require.Len(t, got, 2)
+	assert.Equal(t, ConnectionStats{Opened: 1, Requests: 1}, got[0])
+	assert.Equal(t, ConnectionStats{Opened: 1, Requests: 2, Reused: 1}, got[1])
+	assert.Equal(t, uint64(1), got[1].Open())
+}
@@ -323,6 +323,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*User
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "users"
 	if opts.withResourcePathOverride != "" {
@@ -484,6 +485,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *UserListResult,
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "users"
 	if opts.withResourcePathOverride != "" {
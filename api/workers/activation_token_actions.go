@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type WorkerActivationTokenListResult struct {
+	Items    []*WorkerActivationToken
+	Response *api.Response
+}
+
+func (n WorkerActivationTokenListResult) GetItems() []*WorkerActivationToken {
+	return n.Items
+}
+
+func (n WorkerActivationTokenListResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+type WorkerActivationTokenRevokeResult struct {
+	Response *api.Response
+}
+
+func (n WorkerActivationTokenRevokeResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// WithActivationTokenTtlSeconds sets the number of seconds after which an
+// activation token can no longer be used.
+func WithActivationTokenTtlSeconds(inTtlSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["ttl_seconds"] = inTtlSeconds
+	}
+}
+
+// WithActivationTokenAllowedCidrs sets the network ranges the worker activated
+// with an activation token must report its status from.
+func WithActivationTokenAllowedCidrs(inAllowedCidrs []string) Option {
+	return func(o *options) {
+		o.postMap["allowed_cidrs"] = inAllowedCidrs
+	}
+}
+
+// WithActivationTokenApiTags sets the api tags of the worker activated with an
+// activation token.
+func WithActivationTokenApiTags(inApiTags map[string][]string) Option {
+	return func(o *options) {
+		o.postMap["api_tags"] = inApiTags
+	}
+}
+
+func (c *Client) CreateActivationToken(ctx context.Context, scopeId string, opt ...Option) (*WorkerActivationTokenCreateResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into CreateActivationToken request")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts.postMap["scope_id"] = scopeId
+
+	req, err := c.client.NewRequest(ctx, "POST", "workers:create-activation-token", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CreateActivationToken request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during CreateActivationToken call: %w", err)
+	}
+
+	target := new(WorkerActivationTokenCreateResult)
+	target.Item = new(WorkerActivationToken)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CreateActivationToken response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
+
+func (c *Client) ListActivationTokens(ctx context.Context, scopeId string, opt ...Option) (*WorkerActivationTokenListResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into ListActivationTokens request")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.queryMap["scope_id"] = scopeId
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	req, err := c.client.NewRequest(ctx, "GET", "workers:list-activation-tokens", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListActivationTokens request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListActivationTokens call: %w", err)
+	}
+
+	target := new(WorkerActivationTokenListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListActivationTokens response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
+
+func (c *Client) RevokeActivationToken(ctx context.Context, scopeId, workerId string, opt ...Option) (*WorkerActivationTokenRevokeResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into RevokeActivationToken request")
+	}
+	if workerId == "" {
+		return nil, fmt.Errorf("empty workerId value passed into RevokeActivationToken request")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts.postMap["scope_id"] = scopeId
+	opts.postMap["worker_id"] = workerId
+
+	req, err := c.client.NewRequest(ctx, "POST", "workers:revoke-activation-token", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RevokeActivationToken request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RevokeActivationToken call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RevokeActivationToken response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &WorkerActivationTokenRevokeResult{Response: resp}, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// WithLogsMinutes sets the number of minutes of logs returned by ReadLogs.
+// Defaults to 5 and can be at most 30.
+func WithLogsMinutes(inMinutes uint32) Option {
+	return func(o *options) {
+		o.postMap["minutes"] = inMinutes
+	}
+}
+
+// ReadLogs returns the system and error events the worker emitted in the last
+// minutes along with the current values of its metrics. They are retrieved
+// through the worker's connection to the controller, so the worker must be
+// connected to the controller handling the request.
+func (c *Client) ReadLogs(ctx context.Context, workerId string, opt ...Option) (*WorkerLogsReadResult, error) {
+	if workerId == "" {
+		return nil, fmt.Errorf("empty workerId value passed into ReadLogs request")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("workers/%s:read-logs", url.PathEscape(workerId)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ReadLogs request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ReadLogs call: %w", err)
+	}
+
+	target := new(WorkerLogsReadResult)
+	target.Item = new(WorkerLogs)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ReadLogs response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type WorkerUtilizationListResult struct {
+	Items    []*WorkerUtilization
+	Totals   *WorkerUtilizationTotals
+	Response *api.Response
+}
+
+func (n WorkerUtilizationListResult) GetItems() []*WorkerUtilization {
+	return n.Items
+}
+
+func (n WorkerUtilizationListResult) GetTotals() *WorkerUtilizationTotals {
+	return n.Totals
+}
+
+func (n WorkerUtilizationListResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// ListUtilization returns the load each live worker reported in its last
+// status update, along with totals across the returned workers. WithFilter
+// can be used to limit the result to a pool of workers.
+func (c *Client) ListUtilization(ctx context.Context, scopeId string, opt ...Option) (*WorkerUtilizationListResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into ListUtilization request")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.queryMap["scope_id"] = scopeId
+
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	req, err := c.client.NewRequest(ctx, "GET", "workers:list-utilization", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListUtilization request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListUtilization call: %w", err)
+	}
+
+	target := new(WorkerUtilizationListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListUtilization response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
@@ -38,6 +38,7 @@ type Worker struct {
 	AuthorizedActions                  []string                      `json:"authorized_actions,omitempty"`
 	LocalStorageState                  string                        `json:"local_storage_state,omitempty"`
 	RemoteStorageState                 map[string]RemoteStorageState `json:"remote_storage_state,omitempty"`
+	Locality                           string                        `json:"locality,omitempty"`
 }
 
 type WorkerReadResult struct {
@@ -376,6 +377,7 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Work
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "workers"
 	if opts.withResourcePathOverride != "" {
@@ -0,0 +1,39 @@
+// Code generated by "make api"; DO NOT EDIT.
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workers
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type WorkerActivationToken struct {
+	WorkerId       string              `json:"worker_id,omitempty"`
+	ScopeId        string              `json:"scope_id,omitempty"`
+	Name           string              `json:"name,omitempty"`
+	Description    string              `json:"description,omitempty"`
+	TtlSeconds     uint32              `json:"ttl_seconds,omitempty"`
+	AllowedCidrs   []string            `json:"allowed_cidrs,omitempty"`
+	ApiTags        map[string][]string `json:"api_tags,omitempty"`
+	CreatedTime    time.Time           `json:"created_time,omitempty"`
+	ExpirationTime time.Time           `json:"expiration_time,omitempty"`
+	Token          string              `json:"token,omitempty"`
+}
+
+type WorkerActivationTokenReadResult struct {
+	Item     *WorkerActivationToken
+	Response *api.Response
+}
+
+func (n WorkerActivationTokenReadResult) GetItem() *WorkerActivationToken {
+	return n.Item
+}
+
+func (n WorkerActivationTokenReadResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+type WorkerActivationTokenCreateResult = WorkerActivationTokenReadResult
@@ -0,0 +1,21 @@
+// Code generated by "make api"; DO NOT EDIT.
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workers
+
+import "time"
+
+type WorkerUtilization struct {
+	WorkerId              string              `json:"worker_id,omitempty"`
+	Name                  string              `json:"name,omitempty"`
+	OperationalState      string              `json:"operational_state,omitempty"`
+	CanonicalTags         map[string][]string `json:"canonical_tags,omitempty"`
+	ActiveSessionCount    uint32              `json:"active_session_count,omitempty"`
+	ActiveConnectionCount uint32              `json:"active_connection_count,omitempty"`
+	BytesUpPerSecond      float64             `json:"bytes_up_per_second,omitempty"`
+	BytesDownPerSecond    float64             `json:"bytes_down_per_second,omitempty"`
+	ProxyCpuUtilization   float64             `json:"proxy_cpu_utilization,omitempty"`
+	SpoolBacklogBytes     float64             `json:"spool_backlog_bytes,omitempty"`
+	UpdateTime            time.Time           `json:"update_time,omitempty"`
+}
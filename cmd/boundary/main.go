@@ -7,8 +7,11 @@ import (
 	"os"
 
 	"github.com/hashicorp/boundary/internal/cmd"
+	external_plugins "github.com/hashicorp/boundary/sdk/plugins"
 )
 
 func main() {
+	// Plugins run in a sandbox are started through this binary
+	external_plugins.RunSandboxLauncher()
 	os.Exit(cmd.Run(os.Args[1:]))
 }
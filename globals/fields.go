@@ -44,6 +44,7 @@ const (
 	EndpointField                               = "endpoint"
 	CertificateField                            = "certificate"
 	TerminationReasonField                      = "termination_reason"
+	RecordingSkipReasonField                    = "recording_skip_reason"
 	StatusField                                 = "status"
 	StatesField                                 = "states"
 	SessionConnectionLimitField                 = "session_connection_limit"
@@ -51,6 +52,12 @@ const (
 	WorkerFilterField                           = "worker_filter"
 	EgressWorkerFilterField                     = "egress_worker_filter"
 	IngressWorkerFilterField                    = "ingress_worker_filter"
+	LocalityField                               = "locality"
+	AuthorizationTokenTtlSecondsField           = "authorization_token_ttl_seconds"
+	AuthorizationTokenSingleUseField            = "authorization_token_single_use"
+	PreferredEndpointField                      = "preferred_endpoint"
+	AllowedPortsField                           = "allowed_ports"
+	AuthorizationExpirationTimeField            = "authorization_expiration_time"
 	AccountIdsField                             = "account_ids"
 	AccountsField                               = "accounts"
 	LoginNameField                              = "login_name"
@@ -83,6 +90,9 @@ const (
 	LastStatusTimeField                         = "last_status_time"
 	AddressField                                = "address"
 	AliasesField                                = "aliases"
+	AnnotationsField                            = "annotations"
+	EnvironmentIdField                          = "environment_id"
+	RecordingParametersField                    = "recording_parameters"
 	CanonicalAddressField                       = "canonical_address"
 	TagsField                                   = "tags"
 	CanonicalTagsField                          = "canonical_tags"
@@ -93,6 +103,11 @@ const (
 	WorkerProvidedConfigurationField            = "worker_provided_configuration"
 	ActiveConnectionCountField                  = "active_connection_count"
 	ControllerGeneratedActivationToken          = "controller_generated_activation_token"
+	WorkerIdField                               = "worker_id"
+	TtlSecondsField                             = "ttl_seconds"
+	AllowedCidrsField                           = "allowed_cidrs"
+	MinutesField                                = "minutes"
+	CheckField                                  = "check"
 	ReleaseVersionField                         = "release_version"
 	KeyPurposeField                             = "purpose"
 	KeyVersionsField                            = "key_versions"
@@ -101,11 +116,17 @@ const (
 	TotalCountField                             = "total_count"
 	DirectlyConnectedDownstreamWorkersField     = "directly_connected_downstream_workers"
 	AttributesAddressField                      = "attributes.address"
+	AddressRangeField                           = "address_range"
+	NameTemplateField                           = "name_template"
 	SecretsField                                = "secrets"
+	AutomaticRotationIntervalSecondsField       = "automatic_rotation_interval_seconds"
+	TargetRulesField                            = "target_rules"
+	ProtectFromDeletionField                    = "protect_from_deletion"
 	MimeTypeField                               = "mime_type"
 	MimeTypesField                              = "mime_types"
 	SessionIdField                              = "session_id"
 	StorageBucketIdField                        = "storage_bucket_id"
+	EnableSessionRecordingField                 = "enable_session_recording"
 	BytesUpField                                = "bytes_up"
 	BytesDownField                              = "bytes_down"
 	StartTimeField                              = "start_time"
@@ -124,4 +145,8 @@ const (
 	WithAliasesField                            = "with_aliases"
 	LocalStorageStateField                      = "local_storage_state"
 	RemoteStorageStateField                     = "remote_storage_state"
+	RequestQuotaField                           = "request_quota"
+	DefinitionField                             = "definition"
+	TemplateIdField                             = "template_id"
+	ParametersField                             = "parameters"
 )
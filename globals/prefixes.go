@@ -130,6 +130,12 @@ const (
 
 	// TargetAliasPrefix is the prefix for target aliases
 	TargetAliasPrefix = "alt"
+
+	// EnvironmentPrefix is the prefix for environments
+	EnvironmentPrefix = "env"
+
+	// TargetTemplatePrefix is the prefix for target templates
+	TargetTemplatePrefix = "ttpl"
 )
 
 type ResourceInfo struct {
@@ -328,6 +334,16 @@ var prefixToResourceType = map[string]ResourceInfo{
 		Type:    resource.Policy,
 		Subtype: UnknownSubtype,
 	},
+
+	EnvironmentPrefix: {
+		Type:    resource.Environment,
+		Subtype: UnknownSubtype,
+	},
+
+	TargetTemplatePrefix: {
+		Type:    resource.TargetTemplate,
+		Subtype: UnknownSubtype,
+	},
 }
 
 var resourceTypeToPrefixes map[resource.Type][]string = func() map[resource.Type][]string {
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package annotation provides the annotations of resources. Annotations are
+// string key value pairs which Boundary stores and returns but never
+// interprets, so that external systems can record things like ownership,
+// cost centers or sync markers on a resource without abusing its name or
+// description.
+package annotation
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"unicode/utf8"
+)
+
+const (
+	// MaxCount is the maximum number of annotations of a resource.
+	MaxCount = 64
+
+	// MaxKeyLength is the maximum length in bytes of an annotation key.
+	MaxKeyLength = 128
+
+	// MaxValueLength is the maximum length in bytes of an annotation value.
+	MaxValueLength = 1024
+
+	// MaxTotalSize is the maximum combined length in bytes of all the keys
+	// and values of the annotations of a resource.
+	MaxTotalSize = 16 * 1024
+)
+
+// Annotations are the annotations of a resource, keyed by annotation key.
+type Annotations map[string]string
+
+// Clone returns a copy of the annotations.
+func (a Annotations) Clone() Annotations {
+	if a == nil {
+		return nil
+	}
+	return maps.Clone(a)
+}
+
+// Validate checks that the annotations are within the size limits and that
+// every key is made of letters, digits and the characters '.', '_', '-' and
+// '/'.
+func (a Annotations) Validate() error {
+	if len(a) > MaxCount {
+		return fmt.Errorf("too many annotations: %d is more than the limit of %d", len(a), MaxCount)
+	}
+	var size int
+	for k, v := range a {
+		switch {
+		case k == "":
+			return errors.New("annotation key is empty")
+		case len(k) > MaxKeyLength:
+			return fmt.Errorf("annotation key %q is longer than %d bytes", k, MaxKeyLength)
+		case !validKey(k):
+			return fmt.Errorf("annotation key %q must only contain letters, digits, '.', '_', '-' and '/'", k)
+		case len(v) > MaxValueLength:
+			return fmt.Errorf("value of annotation %q is longer than %d bytes", k, MaxValueLength)
+		case !utf8.ValidString(v):
+			return fmt.Errorf("value of annotation %q is not valid utf-8", k)
+		}
+		size += len(k) + len(v)
+	}
+	if size > MaxTotalSize {
+		return fmt.Errorf("annotations are %d bytes which is more than the limit of %d", size, MaxTotalSize)
+	}
+	return nil
+}
+
+func validKey(k string) bool {
+	for _, c := range k {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '.', c == '_', c == '-', c == '/':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package annotation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotations_Validate(t *testing.T) {
+	t.Parallel()
+	tooMany := make(Annotations, MaxCount+1)
+	for i := range MaxCount + 1 {
+		tooMany[fmt.Sprintf("key-%d", i)] = "v"
+	}
+	tooLarge := make(Annotations, MaxCount)
+	for i := range MaxCount {
+		tooLarge[fmt.Sprintf("key-%d", i)] = strings.Repeat("v", MaxValueLength)
+	}
+
+	tests := []struct {
+		name        string
+		annotations Annotations
+		wantErr     string
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "valid",
+			annotations: Annotations{
+				"example.com/owner":               "team-a",
+				"cost_center":                     "1234",
+				"sync-marker":                     "",
+				strings.Repeat("k", MaxKeyLength): strings.Repeat("v", MaxValueLength),
+			},
+		},
+		{
+			name:        "empty-key",
+			annotations: Annotations{"": "v"},
+			wantErr:     "annotation key is empty",
+		},
+		{
+			name:        "key-too-long",
+			annotations: Annotations{strings.Repeat("k", MaxKeyLength+1): "v"},
+			wantErr:     "is longer than 128 bytes",
+		},
+		{
+			name:        "invalid-key",
+			annotations: Annotations{"owner name": "v"},
+			wantErr:     "must only contain letters",
+		},
+		{
+			name:        "value-too-long",
+			annotations: Annotations{"k": strings.Repeat("v", MaxValueLength+1)},
+			wantErr:     "is longer than 1024 bytes",
+		},
+		{
+			name:        "invalid-value",
+			annotations: Annotations{"k": "\xff"},
+			wantErr:     "is not valid utf-8",
+		},
+		{
+			name:        "too-many",
+			annotations: tooMany,
+			wantErr:     "too many annotations",
+		},
+		{
+			name:        "too-large",
+			annotations: tooLarge,
+			wantErr:     "more than the limit of 16384",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.annotations.Validate()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAnnotations_Clone(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, Annotations(nil).Clone())
+
+	a := Annotations{"k": "v"}
+	cp := a.Clone()
+	assert.Equal(t, a, cp)
+	cp["k"] = "changed"
+	assert.Equal(t, "v", a["k"])
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package annotation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// annotation is a row of a resource annotation table. Every annotated
+// resource has its own annotation table, with a resource_id column which
+// references the resource and cascades its deletion.
+type annotation struct {
+	ResourceId string `gorm:"primary_key"`
+	Key        string `gorm:"primary_key"`
+	Value      string
+	tableName  string `gorm:"-"`
+}
+
+// TableName returns the table name for gorm.
+func (a *annotation) TableName() string {
+	return a.tableName
+}
+
+// Lookup returns the annotations of the resource stored in the annotation
+// table. It returns nil if the resource has no annotations.
+func Lookup(ctx context.Context, r db.Reader, tableName, resourceId string) (Annotations, error) {
+	const op = "annotation.Lookup"
+	all, err := LookupAll(ctx, r, tableName, []string{resourceId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return all[resourceId], nil
+}
+
+// LookupAll returns the annotations of the resources stored in the
+// annotation table, keyed by resource id. Resources without annotations are
+// not included.
+func LookupAll(ctx context.Context, r db.Reader, tableName string, resourceIds []string) (map[string]Annotations, error) {
+	const op = "annotation.LookupAll"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case tableName == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing table name")
+	}
+	if len(resourceIds) == 0 {
+		return nil, nil
+	}
+	var rows []*annotation
+	if err := r.SearchWhere(ctx, &rows, "resource_id in (?)", []any{resourceIds}, db.WithTable(tableName), db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := make(map[string]Annotations, len(resourceIds))
+	for _, a := range rows {
+		if ret[a.ResourceId] == nil {
+			ret[a.ResourceId] = make(Annotations)
+		}
+		ret[a.ResourceId][a.Key] = a.Value
+	}
+	return ret, nil
+}
+
+// Set replaces the annotations of the resource stored in the annotation
+// table. Setting no annotations deletes all the annotations of the resource.
+// The annotations are validated before they are written.
+func Set(ctx context.Context, w db.Writer, tableName, resourceId string, annotations Annotations) error {
+	const op = "annotation.Set"
+	switch {
+	case w == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	case tableName == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing table name")
+	case resourceId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing resource id")
+	}
+	if err := annotations.Validate(); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, err.Error())
+	}
+	if _, err := w.Exec(ctx, fmt.Sprintf("delete from %s where resource_id = ?", tableName), []any{resourceId}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete annotations"))
+	}
+	if len(annotations) == 0 {
+		return nil
+	}
+	rows := make([]*annotation, 0, len(annotations))
+	for k, v := range annotations {
+		rows = append(rows, &annotation{
+			ResourceId: resourceId,
+			Key:        k,
+			Value:      v,
+			tableName:  tableName,
+		})
+	}
+	if err := w.CreateItems(ctx, rows); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create annotations"))
+	}
+	return nil
+}
@@ -15,19 +15,25 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/environments"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/features"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/filters"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/groups"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hosts"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/maintenance"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/managedgroups"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/policies"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/roles"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/search"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/session_recordings"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessions"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targettemplates"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/users"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/workers"
 	"google.golang.org/protobuf/proto"
@@ -126,6 +132,11 @@ type structInfo struct {
 	// listing
 	recursiveListing bool
 
+	// memberIdentifiers indicates that the members of the collection can be
+	// given as identifiers resolved to accounts, which adds an option to allow
+	// identifiers that cannot be resolved
+	memberIdentifiers bool
+
 	// extraFields allows specifying extra options that will be created for a
 	// given type, e.g. arguments only valid for one call or purpose and not
 	// conveyed within the item itself
@@ -181,6 +192,11 @@ var inputStructs = []*structInfo{
 		outFile:     "plugins/plugin_info.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &scopes.DanglingReference{},
+		outFile:     "scopes/dangling_reference.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto:     &scopes.Key{},
 		outFile:     "scopes/key.gen.go",
@@ -203,6 +219,18 @@ var inputStructs = []*structInfo{
 			{Name: "TotalCount", JsonTags: []string{"string"}},
 		},
 	},
+	{
+		inProto:     &scopes.RequestQuota{},
+		outFile:     "scopes/request_quota.gen.go",
+		skipOptions: true,
+		fieldOverrides: []fieldInfo{
+			// uint64 fields get marshalled by protobuf as strings, so we have
+			// to tell the json parser that their json representation is a
+			// string but they go into Go uint64 types.
+			{Name: "Limit", JsonTags: []string{"string"}},
+			{Name: "Used", JsonTags: []string{"string"}},
+		},
+	},
 	{
 		inProto: &scopes.Scope{},
 		outFile: "scopes/scope.gen.go",
@@ -266,6 +294,76 @@ var inputStructs = []*structInfo{
 		pluralResourceName: "billing",
 		versionEnabled:     true,
 	},
+	{
+		inProto:     &billing.ScopeUsage{},
+		outFile:     "billing/scope_usage.gen.go",
+		skipOptions: true,
+		fieldOverrides: []fieldInfo{
+			{
+				Name:       "ActiveUsersCount",
+				AllowEmpty: true,
+			},
+			// uint64 fields are encoded as strings in json, so they need the
+			// string json tag.
+			{
+				Name:       "SessionCount",
+				JsonTags:   []string{"string"},
+				AllowEmpty: true,
+			},
+			{
+				Name:       "SessionHours",
+				FieldType:  "float64",
+				AllowEmpty: true,
+			},
+			{
+				Name:       "RecordedBytes",
+				JsonTags:   []string{"string"},
+				AllowEmpty: true,
+			},
+		},
+	},
+	{
+		inProto: &features.Feature{},
+		outFile: "features/feature.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+		},
+		fieldOverrides: []fieldInfo{
+			{
+				Name:       "Enabled",
+				AllowEmpty: true,
+			},
+		},
+		pluralResourceName: "features",
+	},
+	{
+		inProto: &filters.ValidationError{},
+		outFile: "filters/validation_error.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+		},
+	},
+	{
+		inProto: &maintenance.Maintenance{},
+		outFile: "maintenance/maintenance.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+		},
+		fieldOverrides: []fieldInfo{
+			{
+				Name:       "ReadOnly",
+				AllowEmpty: true,
+			},
+		},
+	},
+	{
+		inProto: &search.SearchResult{},
+		outFile: "search/search_result.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+		},
+		pluralResourceName: "search",
+	},
 	// User related resources
 	{
 		inProto:     &users.Account{},
@@ -321,6 +419,7 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		recursiveListing:    true,
+		memberIdentifiers:   true,
 	},
 	// Role related resources
 	{
@@ -526,6 +625,7 @@ var inputStructs = []*structInfo{
 		parentTypeName:      "auth-method",
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
+		memberIdentifiers:   true,
 	},
 	// Auth Tokens
 	{
@@ -731,6 +831,14 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto: &credentials.CredentialRotation{},
+		outFile: "credentials/credential_rotation.gen.go",
+	},
+	{
+		inProto: &credentials.CredentialCheckout{},
+		outFile: "credentials/credential_checkout.gen.go",
+	},
 	{
 		inProto: &credentials.Credential{},
 		outFile: "credentials/credential.gen.go",
@@ -818,7 +926,53 @@ var inputStructs = []*structInfo{
 		recursiveListing:    true,
 	},
 
+	// Environment related resources
+	{
+		inProto: &environments.Environment{},
+		outFile: "environments/environment.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			commonCreateTemplate,
+			readTemplate,
+			updateTemplate,
+			deleteTemplate,
+			listTemplate,
+		},
+		pluralResourceName:  "environments",
+		versionEnabled:      true,
+		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
+		recursiveListing:    true,
+		nonPaginatedListing: true,
+	},
+
+	// Target template related resources
+	{
+		inProto: &targettemplates.TargetTemplate{},
+		outFile: "targettemplates/target_template.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			commonCreateTemplate,
+			readTemplate,
+			updateTemplate,
+			deleteTemplate,
+			listTemplate,
+		},
+		pluralResourceName:  "target-templates",
+		versionEnabled:      true,
+		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
+		recursiveListing:    true,
+		nonPaginatedListing: true,
+	},
+
 	// Storage related resources
+	{
+		inProto: &storagebuckets.StorageBucketUsage{},
+		outFile: "storagebuckets/storage_bucket_usage.gen.go",
+	},
+	{
+		inProto: &storagebuckets.StorageBucketQuota{},
+		outFile: "storagebuckets/storage_bucket_quota.gen.go",
+	},
 	{
 		inProto: &storagebuckets.StorageBucket{},
 		outFile: "storagebuckets/storage_bucket.gen.go",
@@ -860,6 +1014,10 @@ var inputStructs = []*structInfo{
 		inProto: &policies.StoragePolicyRetainFor{},
 		outFile: "policies/storage_policy_retain_for.gen.go",
 	},
+	{
+		inProto: &policies.StoragePolicySampling{},
+		outFile: "policies/storage_policy_sampling.gen.go",
+	},
 	{
 		inProto:        &policies.StoragePolicyAttributes{},
 		outFile:        "policies/storage_policy_attributes.gen.go",
@@ -905,6 +1063,10 @@ var inputStructs = []*structInfo{
 	},
 
 	// Host related resources
+	{
+		inProto: &hostcatalogs.TargetRule{},
+		outFile: "hostcatalogs/target_rule.gen.go",
+	},
 	{
 		inProto: &hostcatalogs.HostCatalog{},
 		outFile: "hostcatalogs/host_catalog.gen.go",
@@ -1051,6 +1213,26 @@ var inputStructs = []*structInfo{
 		outFile:     "targets/worker_info.gen.go",
 		subtypeName: "WorkerInfo",
 	},
+	{
+		inProto:             &targets.TargetConnectionTest{},
+		outFile:             "targets/target_connection_test.gen.go",
+		createResponseTypes: []string{ReadResponseType},
+		fieldOverrides: []fieldInfo{
+			{
+				Name:      "LatencyMs",
+				FieldType: "float64",
+			},
+		},
+	},
+	{
+		inProto:             &targets.EffectiveRecordingPolicy{},
+		outFile:             "targets/effective_recording_policy.gen.go",
+		createResponseTypes: []string{ReadResponseType},
+	},
+	{
+		inProto: &targets.RecordingParameters{},
+		outFile: "targets/recording_parameters.gen.go",
+	},
 	{
 		inProto:        &targets.TcpTargetAttributes{},
 		outFile:        "targets/tcp_target_attributes.gen.go",
@@ -1185,12 +1367,21 @@ var inputStructs = []*structInfo{
 				Query:     true,
 			},
 		},
+		fieldOverrides: []fieldInfo{
+			// The authorization expiration time is only set on pending
+			// sessions, and omitempty has no effect on a time.Time value.
+			{Name: "AuthorizationExpirationTime", FieldType: "*time.Time"},
+		},
 		pluralResourceName:  "sessions",
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		fieldFilter:         []string{"private_key"},
 		versionEnabled:      true,
 		recursiveListing:    true,
 	},
+	{
+		inProto: &sessions.SessionCancelResult{},
+		outFile: "sessions/session_cancel_result.gen.go",
+	},
 	{
 		inProto: &session_recordings.User{},
 		outFile: "sessionrecordings/user.gen.go",
@@ -1369,6 +1560,66 @@ var inputStructs = []*structInfo{
 		outFile:             "workers/certificate_authority.gen.go",
 		createResponseTypes: []string{ReadResponseType},
 	},
+	{
+		inProto:             &workers.WorkerActivationToken{},
+		outFile:             "workers/worker_activation_token.gen.go",
+		createResponseTypes: []string{CreateResponseType, ReadResponseType},
+	},
+	{
+		inProto:             &workers.WorkerLogs{},
+		outFile:             "workers/worker_logs.gen.go",
+		createResponseTypes: []string{ReadResponseType},
+		fieldOverrides: []fieldInfo{
+			{
+				Name:      "Metrics",
+				FieldType: "map[string]float64",
+			},
+		},
+	},
+	{
+		inProto: &workers.WorkerUtilization{},
+		outFile: "workers/worker_utilization.gen.go",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:      "BytesUpPerSecond",
+				FieldType: "float64",
+			},
+			{
+				Name:      "BytesDownPerSecond",
+				FieldType: "float64",
+			},
+			{
+				Name:      "ProxyCpuUtilization",
+				FieldType: "float64",
+			},
+			{
+				Name:      "SpoolBacklogBytes",
+				FieldType: "float64",
+			},
+		},
+	},
+	{
+		inProto: &workers.WorkerUtilizationTotals{},
+		outFile: "workers/worker_utilization_totals.gen.go",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:      "BytesUpPerSecond",
+				FieldType: "float64",
+			},
+			{
+				Name:      "BytesDownPerSecond",
+				FieldType: "float64",
+			},
+			{
+				Name:      "AverageProxyCpuUtilization",
+				FieldType: "float64",
+			},
+			{
+				Name:      "SpoolBacklogBytes",
+				FieldType: "float64",
+			},
+		},
+	},
 	{
 		inProto: &workers.Worker{},
 		outFile: "workers/worker.gen.go",
@@ -53,6 +53,7 @@ type templateInput struct {
 	CreateResponseTypes   []string
 	SkipListFiltering     bool
 	RecursiveListing      bool
+	MemberIdentifiers     bool
 	Subtype               string
 }
 
@@ -203,6 +204,7 @@ func fillTemplates() {
 			Fields:            fields,
 			SkipListFiltering: inputMap[pkg].skipListFiltering,
 			RecursiveListing:  inputMap[pkg].recursiveListing,
+			MemberIdentifiers: inputMap[pkg].memberIdentifiers,
 			VersionEnabled:    inputMap[pkg].versionEnabled,
 		}
 
@@ -242,6 +244,7 @@ func (c *Client) List(ctx context.Context, {{ .CollectionFunctionArg }} string,
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["{{ snakeCase .CollectionFunctionArg }}"] = {{ .CollectionFunctionArg }}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "{{ .CollectionPath }}"
 	if opts.withResourcePathOverride != "" {
@@ -408,6 +411,7 @@ func (c *Client) ListNextPage(ctx context.Context, currentPage *{{ .Name }}ListR
 	if currentPage.pageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(currentPage.pageSize), 10)
 	}
+	api.ApplyContextOptions(ctx, opts.queryMap)
 
 	requestPath := "{{ .CollectionPath }}"
 	if opts.withResourcePathOverride != "" {
@@ -953,8 +957,10 @@ type options struct {
 	withListToken string
 	withClientDirectedPagination bool
 	withPageSize uint32
-    withResourcePathOverride string
-	{{ if .RecursiveListing }} withRecursive bool {{ end }}
+	withExactCount bool
+    withResourcePathOverride string{{ if .RecursiveListing }}
+	withRecursive bool{{ end }}{{ if .MemberIdentifiers }}
+	withAllowUnresolvedIdentifiers bool{{ end }}
 }
 
 func getDefaultOptions() options {
@@ -987,6 +993,9 @@ func getOpts(opt ...Option) (options, []api.Option) {
 	if opts.withPageSize != 0 {
 		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
 	}
+	if opts.withExactCount {
+		opts.queryMap["exact_count"] = strconv.FormatBool(opts.withExactCount)
+	}
 	return opts, apiOpts
 }
 
@@ -1043,6 +1052,15 @@ func WithPageSize(with uint32) Option {
 	}
 }
 
+// WithExactCount tells the API to return an exact item count instead of an
+// estimate in the first page of a List call. The call fails if more than
+// 10,000 items are available.
+func WithExactCount(with bool) Option {
+	return func(o *options) {
+		o.withExactCount = with
+	}
+}
+
 // WithResourcePathOverride tells the API to use the provided resource path
 func WithResourcePathOverride(path string) Option {
 	return func(o *options) {
@@ -1058,6 +1076,17 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 {{ end }}
+{{ if .MemberIdentifiers }}
+// WithAllowUnresolvedIdentifiers tells the calls that resolve member
+// identifiers to accounts to proceed when some of the identifiers cannot be
+// resolved, rather than failing. The identifiers that could not be resolved
+// are reported in the result of the call.
+func WithAllowUnresolvedIdentifiers(allow bool) Option {
+	return func(o *options) {
+		o.withAllowUnresolvedIdentifiers = allow
+	}
+}
+{{ end }}
 {{ range $fieldIndex, $field := .Fields }}
 {{ $subtypes := (removeDups $field.SubtypeNames ) }}
 {{ if ( eq ( len ( $subtypes ) ) 0 )}}
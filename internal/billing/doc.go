@@ -2,8 +2,15 @@
 // SPDX-License-Identifier: BUSL-1.1
 
 // Package billing provides usage numbers that can be used for
-// billing purposes. The currently supported metric is monthly
-// active users. A user is considered active within a month
+// billing purposes. The currently supported metrics are monthly
+// active users and the monthly usage of each project scope.
+// A user is considered active within a month
 // if they have at least one issued auth token within the time
 // range of the start and end of a given month.
+//
+// The usage of a project scope is the number of users that
+// started sessions in it, the number of sessions started,
+// the time sessions were active and the number of bytes recorded.
+// It is aggregated periodically by a job registered with RegisterJob,
+// so that it can be reported for the whole history of a scope.
 package billing
@@ -17,3 +17,76 @@ select *
   from hcp_billing_monthly_active_users_all(@start_time, @end_time);
 `
 )
+
+const (
+	// aggregateScopeUsageQuery computes the usage of every project scope for
+	// the month between @start_time and @end_time and upserts it in
+	// billing_scope_monthly_usage. Sessions count towards the month in which
+	// they were requested, and their active time is split between the months
+	// it overlaps. Recorded bytes count towards the month in which the
+	// connection recording ended.
+	aggregateScopeUsageQuery = `
+with
+session_usage (scope_id, active_users_count, session_count) as (
+    select host.project_id, count(distinct usr.user_id), count(*)
+      from wh_session_accumulating_fact as session
+      join wh_host_dimension            as host on host.key = session.host_key
+      join wh_user_dimension            as usr  on usr.key  = session.user_key
+     where session.session_pending_time >= @start_time
+       and session.session_pending_time <  @end_time
+  group by host.project_id
+),
+session_time (scope_id, session_seconds) as (
+    select host.project_id,
+           sum(extract(epoch from least(session.session_terminated_time, @end_time, now())
+                                - greatest(session.session_active_time, @start_time)))::bigint
+      from wh_session_accumulating_fact as session
+      join wh_host_dimension            as host on host.key = session.host_key
+     where session.session_active_time     <  @end_time
+       and session.session_active_time     <  now()
+       and session.session_terminated_time >  @start_time
+  group by host.project_id
+),
+recording_usage (scope_id, recorded_bytes) as (
+    select project.public_id, sum(coalesce(conn.bytes_up, 0) + coalesce(conn.bytes_down, 0))
+      from recording_connection as conn
+      join recording_session    as rec     on rec.public_id      = conn.recording_session_id
+      join iam_scope_hst        as project on project.history_id = rec.target_project_hst_id
+     where conn.end_time >= @start_time
+       and conn.end_time <  @end_time
+  group by project.public_id
+),
+scopes (scope_id) as (
+  select scope_id from session_usage
+   union
+  select scope_id from session_time
+   union
+  select scope_id from recording_usage
+)
+insert into billing_scope_monthly_usage
+       (scope_id, start_time, active_users_count, session_count, session_seconds, recorded_bytes)
+select scopes.scope_id,
+       @start_time,
+       coalesce(session_usage.active_users_count, 0),
+       coalesce(session_usage.session_count, 0),
+       coalesce(session_time.session_seconds, 0),
+       coalesce(recording_usage.recorded_bytes, 0)
+  from scopes
+  left join session_usage   on session_usage.scope_id   = scopes.scope_id
+  left join session_time    on session_time.scope_id    = scopes.scope_id
+  left join recording_usage on recording_usage.scope_id = scopes.scope_id
+    on conflict (start_time, scope_id) do update
+   set active_users_count = excluded.active_users_count,
+       session_count      = excluded.session_count,
+       session_seconds    = excluded.session_seconds,
+       recorded_bytes     = excluded.recorded_bytes;
+`
+
+	scopeUsageQuery = `
+  select scope_id, start_time, active_users_count, session_count, session_seconds, recorded_bytes
+    from billing_scope_monthly_usage
+   where start_time >= @start_time
+     and start_time <  @end_time
+order by start_time desc, scope_id;
+`
+)
@@ -105,3 +105,77 @@ func (r *Repository) MonthlyActiveUsers(ctx context.Context, opt ...Option) ([]A
 
 	return activeUsers, nil
 }
+
+// ScopeUsage returns the usage of each project scope for a range of months,
+// from most recent to least, as last computed by the billing usage job.
+// If no start or end time is provided, it will return the usage for the last two months.
+// If a start time is provided, it will return the usage for that month until the current month.
+// If both a start and end time are provided, it will return the usage for that time range,
+// starting time inclusive and ending time exclusive.
+// The times provided must be the start of the month at midnight UTC.
+func (r *Repository) ScopeUsage(ctx context.Context, opt ...Option) ([]ScopeUsage, error) {
+	const op = "billing.Repository.ScopeUsage"
+
+	opts := getOpts(opt...)
+
+	switch {
+	case opts.withEndTime != nil && opts.withStartTime == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "end time set without start time")
+	case opts.withEndTime != nil && !opts.withEndTime.After(*opts.withStartTime):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "start time is not before end time")
+	}
+
+	currentMonth := startOfMonth(time.Now())
+	startTime := currentMonth.AddDate(0, -1, 0)
+	endTime := currentMonth.AddDate(0, 1, 0)
+	if opts.withStartTime != nil {
+		if *opts.withStartTime != startOfMonth(*opts.withStartTime) {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "start time must be the first day of the month at midnight UTC")
+		}
+		startTime = *opts.withStartTime
+	}
+	if opts.withEndTime != nil {
+		if *opts.withEndTime != startOfMonth(*opts.withEndTime) {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "end time must be the first day of the month at midnight UTC")
+		}
+		endTime = *opts.withEndTime
+	}
+
+	rows, err := r.reader.Query(ctx, scopeUsageQuery, []any{
+		sql.Named("start_time", timestamp.New(startTime)),
+		sql.Named("end_time", timestamp.New(endTime)),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var usage []ScopeUsage
+	for rows.Next() {
+		var scopeId string
+		var startTime time.Time
+		var activeUsers, sessions, sessionSeconds, recordedBytes int64
+		if err := rows.Scan(&scopeId, &startTime, &activeUsers, &sessions, &sessionSeconds, &recordedBytes); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		usage = append(usage, ScopeUsage{
+			ScopeId:          scopeId,
+			StartTime:        startTime.UTC(),
+			EndTime:          startTime.UTC().AddDate(0, 1, 0),
+			ActiveUsersCount: uint32(activeUsers),
+			SessionCount:     uint64(sessions),
+			SessionDuration:  time.Duration(sessionSeconds) * time.Second,
+			RecordedBytes:    uint64(recordedBytes),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return usage, nil
+}
+
+// startOfMonth returns midnight UTC on the first day of the month of t.
+func startOfMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -5,6 +5,7 @@ package billing
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -181,3 +182,92 @@ func TestRepository_MonthlyActiveUsers(t *testing.T) {
 		assert.Equal(t, "billing.Repository.MonthlyActiveUsers: end time must be the first day of the month at midnight UTC: parameter violation: error #100", err.Error())
 	})
 }
+
+func TestRepository_ScopeUsage(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+
+	monthStart := startOfMonth(time.Now())
+	oneMonthAgo := monthStart.AddDate(0, -1, 0)
+	threeMonthsAgo := monthStart.AddDate(0, -3, 0)
+	midMonth := monthStart.AddDate(0, 0, 14)
+
+	const insertUsage = `
+insert into billing_scope_monthly_usage
+       (scope_id, start_time, active_users_count, session_count, session_seconds, recorded_bytes)
+values (@scope_id, @start_time, 2, 3, 3600, 1024);
+`
+	for _, month := range []time.Time{monthStart, oneMonthAgo, threeMonthsAgo} {
+		for _, scopeId := range []string{"p_2222222222", "p_1111111111"} {
+			_, err := rw.Exec(ctx, insertUsage, []any{
+				sql.Named("scope_id", scopeId),
+				sql.Named("start_time", month),
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	assertUsage := func(t *testing.T, got ScopeUsage, scopeId string, month time.Time) {
+		t.Helper()
+		assert.Equal(t, ScopeUsage{
+			ScopeId:          scopeId,
+			StartTime:        month,
+			EndTime:          month.AddDate(0, 1, 0),
+			ActiveUsersCount: 2,
+			SessionCount:     3,
+			SessionDuration:  time.Hour,
+			RecordedBytes:    1024,
+		}, got)
+	}
+
+	t.Run("valid-no-options", func(t *testing.T) {
+		repo := TestRepo(t, conn)
+		usage, err := repo.ScopeUsage(ctx)
+		require.NoError(t, err)
+		require.Len(t, usage, 4)
+		assertUsage(t, usage[0], "p_1111111111", monthStart)
+		assertUsage(t, usage[1], "p_2222222222", monthStart)
+		assertUsage(t, usage[2], "p_1111111111", oneMonthAgo)
+		assertUsage(t, usage[3], "p_2222222222", oneMonthAgo)
+	})
+
+	t.Run("valid-with-start-time", func(t *testing.T) {
+		repo := TestRepo(t, conn)
+		usage, err := repo.ScopeUsage(ctx, WithStartTime(&threeMonthsAgo))
+		require.NoError(t, err)
+		require.Len(t, usage, 6)
+		assertUsage(t, usage[4], "p_1111111111", threeMonthsAgo)
+		assertUsage(t, usage[5], "p_2222222222", threeMonthsAgo)
+	})
+
+	t.Run("valid-with-start-time-and-end-time", func(t *testing.T) {
+		repo := TestRepo(t, conn)
+		usage, err := repo.ScopeUsage(ctx, WithStartTime(&threeMonthsAgo), WithEndTime(&oneMonthAgo))
+		require.NoError(t, err)
+		require.Len(t, usage, 2)
+		assertUsage(t, usage[0], "p_1111111111", threeMonthsAgo)
+		assertUsage(t, usage[1], "p_2222222222", threeMonthsAgo)
+	})
+
+	t.Run("invalid-end-time-without-start-time", func(t *testing.T) {
+		repo := TestRepo(t, conn)
+		_, err := repo.ScopeUsage(ctx, WithEndTime(&oneMonthAgo))
+		assert.Error(t, err)
+		assert.Equal(t, "billing.Repository.ScopeUsage: end time set without start time: parameter violation: error #100", err.Error())
+	})
+
+	t.Run("invalid-start-time-equals-end-time", func(t *testing.T) {
+		repo := TestRepo(t, conn)
+		_, err := repo.ScopeUsage(ctx, WithStartTime(&oneMonthAgo), WithEndTime(&oneMonthAgo))
+		assert.Error(t, err)
+		assert.Equal(t, "billing.Repository.ScopeUsage: start time is not before end time: parameter violation: error #100", err.Error())
+	})
+
+	t.Run("invalid-start-time-not-first-day-of-month", func(t *testing.T) {
+		repo := TestRepo(t, conn)
+		_, err := repo.ScopeUsage(ctx, WithStartTime(&midMonth))
+		assert.Error(t, err)
+		assert.Equal(t, "billing.Repository.ScopeUsage: start time must be the first day of the month at midnight UTC: parameter violation: error #100", err.Error())
+	})
+}
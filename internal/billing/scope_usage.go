@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package billing
+
+import "time"
+
+// ScopeUsage is the usage of a project scope between the start and end times.
+// The start time is inclusive and the end time is exclusive.
+type ScopeUsage struct {
+	ScopeId   string
+	StartTime time.Time
+	EndTime   time.Time
+	// ActiveUsersCount is the number of unique users that started at least
+	// one session in the scope.
+	ActiveUsersCount uint32
+	// SessionCount is the number of sessions started in the scope.
+	SessionCount uint64
+	// SessionDuration is the total time sessions of the scope were active.
+	SessionDuration time.Duration
+	// RecordedBytes is the number of bytes recorded by the connections of the
+	// session recordings of the scope.
+	RecordedBytes uint64
+}
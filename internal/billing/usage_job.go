@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package billing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// usageJobRunInterval is how often the usage of the current month is
+// aggregated.
+const usageJobRunInterval = time.Hour
+
+// RegisterJob registers the billing usage job with the provided scheduler.
+func RegisterJob(ctx context.Context, s *scheduler.Scheduler, w db.Writer) error {
+	const op = "billing.RegisterJob"
+	if s == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil scheduler", errors.WithoutEvent())
+	}
+	if util.IsNil(w) {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil DB writer", errors.WithoutEvent())
+	}
+
+	usageJob, err := newUsageJob(ctx, w)
+	if err != nil {
+		return fmt.Errorf("error creating billing usage job: %w", err)
+	}
+	if err := s.RegisterJob(ctx, usageJob); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// usageJob aggregates the usage of each project scope for the current and
+// previous months. The previous month is aggregated again so that sessions
+// that were still active when it ended are accounted for.
+type usageJob struct {
+	w db.Writer
+
+	// nowFn returns the current time. It is replaced in tests.
+	nowFn func() time.Time
+
+	total, completed int
+}
+
+func newUsageJob(ctx context.Context, w db.Writer) (*usageJob, error) {
+	const op = "billing.newUsageJob"
+	if util.IsNil(w) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	}
+	return &usageJob{
+		w:     w,
+		nowFn: time.Now,
+	}, nil
+}
+
+// Status reports the job’s current status.
+func (j *usageJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.completed,
+		Total:     j.total,
+	}
+}
+
+// Run performs the required work depending on the implementation.
+// The context is used to notify the job that it should exit early.
+func (j *usageJob) Run(ctx context.Context, _ time.Duration) error {
+	const op = "billing.(usageJob).Run"
+	months := usageMonths(j.nowFn())
+	j.total, j.completed = len(months), 0
+	for _, m := range months {
+		if _, err := j.w.Exec(ctx, aggregateScopeUsageQuery, []any{
+			sql.Named("start_time", timestamp.New(m)),
+			sql.Named("end_time", timestamp.New(m.AddDate(0, 1, 0))),
+		}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to aggregate usage for %s", m.Format("2006-01"))))
+		}
+		j.completed++
+	}
+	return nil
+}
+
+// NextRunIn returns the duration until the next job run should be scheduled.
+func (j *usageJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return usageJobRunInterval, nil
+}
+
+// Name is the unique name of the job.
+func (j *usageJob) Name() string {
+	return "billing_usage_aggregation"
+}
+
+// Description is the human-readable description of the job.
+func (j *usageJob) Description() string {
+	return "Aggregates the monthly active users, sessions, session time and recorded bytes of each project scope"
+}
+
+// usageMonths returns the start of the months the usage job aggregates at
+// time now: the previous month and the current month.
+func usageMonths(now time.Time) []time.Time {
+	current := startOfMonth(now)
+	return []time.Time{current.AddDate(0, -1, 0), current}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package billing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageMonths(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		now  time.Time
+		want []time.Time
+	}{
+		{
+			name: "mid-month",
+			now:  time.Date(2024, time.May, 15, 13, 30, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "start-of-month",
+			now:  time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "january",
+			now:  time.Date(2024, time.January, 31, 23, 59, 59, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "non-utc",
+			now:  time.Date(2024, time.March, 1, 0, 30, 0, 0, time.FixedZone("CET", 60*60)),
+			want: []time.Time{
+				time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, usageMonths(tt.now))
+		})
+	}
+}
+
+func TestRegisterJob(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	t.Run("nil-scheduler", func(t *testing.T) {
+		err := RegisterJob(ctx, nil, rw)
+		assert.Error(t, err)
+	})
+	t.Run("nil-writer", func(t *testing.T) {
+		err := RegisterJob(ctx, scheduler.TestScheduler(t, conn, wrapper), nil)
+		assert.Error(t, err)
+	})
+	t.Run("valid", func(t *testing.T) {
+		err := RegisterJob(ctx, scheduler.TestScheduler(t, conn, wrapper), rw)
+		assert.NoError(t, err)
+	})
+}
+
+func TestUsageJob_Run(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	s := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+
+	job, err := newUsageJob(ctx, rw)
+	require.NoError(t, err)
+	require.NoError(t, job.Run(ctx, 0))
+	assert.Equal(t, scheduler.JobStatus{Completed: 2, Total: 2}, job.Status())
+
+	// Running the job again updates the existing rows.
+	require.NoError(t, job.Run(ctx, 0))
+
+	usage, err := TestRepo(t, conn).ScopeUsage(ctx)
+	require.NoError(t, err)
+	require.Len(t, usage, 1)
+	assert.Equal(t, s.ProjectId, usage[0].ScopeId)
+	assert.Equal(t, startOfMonth(time.Now()), usage[0].StartTime)
+	assert.Equal(t, uint32(1), usage[0].ActiveUsersCount)
+	assert.Equal(t, uint64(1), usage[0].SessionCount)
+	assert.Zero(t, usage[0].SessionDuration)
+	assert.Zero(t, usage[0].RecordedBytes)
+}
@@ -775,7 +775,8 @@ func (c *Channel) NewRequestsWriter(ctx context.Context, dir Direction) (storage
 }
 
 // OpenMessageScanner opens a ChunkScanner for a channel's recorded messages.
-func (c *Channel) OpenMessageScanner(ctx context.Context, dir Direction) (*ChunkScanner, error) {
+// Supports the WithLenientDecode option.
+func (c *Channel) OpenMessageScanner(ctx context.Context, dir Direction, options ...Option) (*ChunkScanner, error) {
 	const op = "bsr.(Channel).OpenMessageScanner"
 
 	messagesName := fmt.Sprintf(messagesFileNameTemplate, dir.String())
@@ -787,11 +788,12 @@ func (c *Channel) OpenMessageScanner(ctx context.Context, dir Direction) (*Chunk
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	return NewChunkScanner(ctx, m, WithSha256Sum(expectedSum))
+	return NewChunkScanner(ctx, m, append(options, WithSha256Sum(expectedSum))...)
 }
 
 // OpenRequestScanner opens a ChunkScanner for a channel's recorded requests.
-func (c *Channel) OpenRequestScanner(ctx context.Context, dir Direction) (*ChunkScanner, error) {
+// Supports the WithLenientDecode option.
+func (c *Channel) OpenRequestScanner(ctx context.Context, dir Direction, options ...Option) (*ChunkScanner, error) {
 	const op = "bsr.(Channel).OpenRequestScanner"
 
 	requestName := fmt.Sprintf(requestsFileNameTemplate, dir.String())
@@ -803,5 +805,5 @@ func (c *Channel) OpenRequestScanner(ctx context.Context, dir Direction) (*Chunk
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	return NewChunkScanner(ctx, m, WithSha256Sum(expectedSum))
+	return NewChunkScanner(ctx, m, append(options, WithSha256Sum(expectedSum))...)
 }
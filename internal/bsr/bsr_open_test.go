@@ -24,8 +24,9 @@ func TestPopulateMeta(t *testing.T) {
 
 	sessionId := "s_01234567890"
 	srm := &SessionRecordingMeta{
-		Id:       "sr_012344567890",
-		Protocol: Protocol("TEST"),
+		Id:          "sr_012344567890",
+		Protocol:    Protocol("TEST"),
+		Compression: ZstdCompression,
 	}
 
 	// Populate session meta
@@ -41,6 +42,7 @@ func TestPopulateMeta(t *testing.T) {
 	s.Meta = sm
 	require.Equal(t, s.Meta.Id, srm.Id)
 	require.Equal(t, s.Meta.Protocol, srm.Protocol)
+	require.Equal(t, s.Meta.Compression, srm.Compression)
 
 	gotSessionMeta := &SessionMeta{}
 	r, err := s.container.container.OpenFile(ctx, sessionMetaFileName)
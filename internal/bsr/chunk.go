@@ -29,6 +29,7 @@ const (
 const (
 	ChunkHeader ChunkType = "HEAD"
 	ChunkEnd    ChunkType = "DONE"
+	ChunkIdle   ChunkType = "IDLE"
 )
 
 // ChunkType identifies the type of a chunk.
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package bsr
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/bsr/internal/is"
+)
+
+const (
+	suppressedSize = 4
+)
+
+// IdleChunk marks a period of a recording in which chunks without meaningful
+// content, such as keepalive requests, were suppressed. The timestamp of the
+// chunk is the timestamp of the last suppressed chunk, and Start is the
+// timestamp of the first one, so players can reproduce the timing of the
+// original session without the suppressed chunks being stored.
+// An IdleChunk in a bsr data file is represented as:
+//
+//	uint32 length      4 bytes
+//	uint32 protocol    4 bytes
+//	uint32 chunk_type  4 bytes
+//	uint8  direction   1 byte
+//	timest timestamp  12 bytes
+//	timest start      12 bytes
+//	uint32 suppressed  4 bytes
+//	uint32 crc         4 bytes
+type IdleChunk struct {
+	*BaseChunk
+	Start      *Timestamp
+	Suppressed uint32
+}
+
+// MarshalData serializes an IdleChunk.
+func (c *IdleChunk) MarshalData(_ context.Context) ([]byte, error) {
+	d := make([]byte, 0, timestampSize+suppressedSize)
+	d = append(d, c.Start.marshal()...)
+	d = binary.BigEndian.AppendUint32(d, c.Suppressed)
+	return d, nil
+}
+
+// NewIdle creates an IdleChunk.
+func NewIdle(ctx context.Context, p Protocol, d Direction, start, t *Timestamp, suppressed uint32) (*IdleChunk, error) {
+	const op = "bsr.NewIdle"
+
+	switch {
+	case is.Nil(start):
+		return nil, fmt.Errorf("%s: start timestamp must not be nil: %w", op, ErrInvalidParameter)
+	case suppressed == 0:
+		return nil, fmt.Errorf("%s: suppressed chunk count must be greater than 0: %w", op, ErrInvalidParameter)
+	}
+
+	bc, err := NewBaseChunk(ctx, p, d, t, ChunkIdle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IdleChunk{
+		BaseChunk:  bc,
+		Start:      start,
+		Suppressed: suppressed,
+	}, nil
+}
+
+// DecodeIdle will decode an IdleChunk.
+func DecodeIdle(_ context.Context, bc *BaseChunk, data []byte) (Chunk, error) {
+	const op = "bsr.DecodeIdle"
+
+	if is.Nil(bc) {
+		return nil, fmt.Errorf("%s: nil base chunk: %w", op, ErrInvalidParameter)
+	}
+	if bc.Type != ChunkIdle {
+		return nil, fmt.Errorf("%s: invalid chunk type %s", op, bc.Type)
+	}
+	if len(data) != timestampSize+suppressedSize {
+		return nil, fmt.Errorf("%s: invalid data length %d", op, len(data))
+	}
+
+	start, err := decodeTimestamp(data[:timestampSize])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &IdleChunk{
+		BaseChunk:  bc,
+		Start:      start,
+		Suppressed: binary.BigEndian.Uint32(data[timestampSize:]),
+	}, nil
+}
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package bsr_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/internal/fstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIdleChunk(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	cases := []struct {
+		name       string
+		start      *bsr.Timestamp
+		t          *bsr.Timestamp
+		suppressed uint32
+		want       *bsr.IdleChunk
+		wantErr    string
+	}{
+		{
+			"valid",
+			bsr.NewTimestamp(now),
+			bsr.NewTimestamp(now.Add(time.Minute)),
+			3,
+			&bsr.IdleChunk{
+				BaseChunk: &bsr.BaseChunk{
+					Protocol:  bsr.Protocol("TEST"),
+					Direction: bsr.Inbound,
+					Timestamp: bsr.NewTimestamp(now.Add(time.Minute)),
+					Type:      bsr.ChunkIdle,
+				},
+				Start:      bsr.NewTimestamp(now),
+				Suppressed: 3,
+			},
+			"",
+		},
+		{
+			"nil-start",
+			nil,
+			bsr.NewTimestamp(now),
+			3,
+			nil,
+			"bsr.NewIdle: start timestamp must not be nil: invalid parameter",
+		},
+		{
+			"zero-suppressed",
+			bsr.NewTimestamp(now),
+			bsr.NewTimestamp(now),
+			0,
+			nil,
+			"bsr.NewIdle: suppressed chunk count must be greater than 0: invalid parameter",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := bsr.NewIdle(ctx, "TEST", bsr.Inbound, tc.start, tc.t, tc.suppressed)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestChunkEncoderEmptyChunkFunc(t *testing.T) {
+	ctx := context.Background()
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC)
+
+	base := func(typ bsr.ChunkType, offset time.Duration) *bsr.BaseChunk {
+		return &bsr.BaseChunk{
+			Protocol:  "TEST",
+			Direction: bsr.Inbound,
+			Timestamp: bsr.NewTimestamp(ts.Add(offset)),
+			Type:      typ,
+		}
+	}
+	header := &bsr.HeaderChunk{
+		BaseChunk:   base(bsr.ChunkHeader, 0),
+		Compression: bsr.NoCompression,
+		Encryption:  bsr.NoEncryption,
+		SessionId:   "sess_123456789",
+	}
+	data := func(offset time.Duration, d string) *testChunk {
+		return &testChunk{BaseChunk: base("TEST", offset), Data: []byte(d)}
+	}
+	end := &bsr.EndChunk{BaseChunk: base(bsr.ChunkEnd, time.Hour)}
+
+	in := []bsr.Chunk{
+		header,
+		data(time.Second, "ls\n"),
+		data(time.Minute, ""),
+		data(2*time.Minute, ""),
+		data(3*time.Minute, ""),
+		data(4*time.Minute, "exit\n"),
+		data(5*time.Minute, ""),
+		end,
+	}
+	want := []bsr.Chunk{
+		header,
+		data(time.Second, "ls\n"),
+		&bsr.IdleChunk{
+			BaseChunk:  base(bsr.ChunkIdle, 3*time.Minute),
+			Start:      bsr.NewTimestamp(ts.Add(time.Minute)),
+			Suppressed: 3,
+		},
+		data(4*time.Minute, "exit\n"),
+		&bsr.IdleChunk{
+			BaseChunk:  base(bsr.ChunkIdle, 5*time.Minute),
+			Start:      bsr.NewTimestamp(ts.Add(5 * time.Minute)),
+			Suppressed: 1,
+		},
+		end,
+	}
+
+	buf, err := fstest.NewTempBuffer()
+	require.NoError(t, err)
+	enc, err := bsr.NewChunkEncoder(ctx, buf, bsr.NoCompression, bsr.NoEncryption, bsr.WithEmptyChunkFunc(func(c bsr.Chunk) bool {
+		tc, ok := c.(*testChunk)
+		return ok && len(tc.Data) == 0
+	}))
+	require.NoError(t, err)
+	var wrote int
+	for _, c := range in {
+		n, err := enc.Encode(ctx, c)
+		require.NoError(t, err)
+		wrote += n
+	}
+	assert.Equal(t, len(buf.Bytes()), wrote)
+
+	dec, err := bsr.NewChunkDecoder(ctx, bytes.NewBuffer(buf.Bytes()))
+	require.NoError(t, err)
+	var got []bsr.Chunk
+	for {
+		c, err := dec.Decode(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if tc, ok := c.(*testChunk); ok && len(tc.Data) == 0 {
+			tc.Data = []byte{}
+		}
+		got = append(got, c)
+	}
+	for _, c := range want {
+		if tc, ok := c.(*testChunk); ok && tc.Data == nil {
+			tc.Data = []byte{}
+		}
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestChunkEncoderCloseWritesIdle(t *testing.T) {
+	ctx := context.Background()
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC)
+
+	base := func(typ bsr.ChunkType, offset time.Duration) *bsr.BaseChunk {
+		return &bsr.BaseChunk{
+			Protocol:  "TEST",
+			Direction: bsr.Inbound,
+			Timestamp: bsr.NewTimestamp(ts.Add(offset)),
+			Type:      typ,
+		}
+	}
+	header := &bsr.HeaderChunk{
+		BaseChunk:   base(bsr.ChunkHeader, 0),
+		Compression: bsr.NoCompression,
+		Encryption:  bsr.NoEncryption,
+		SessionId:   "sess_123456789",
+	}
+
+	buf, err := fstest.NewTempBuffer()
+	require.NoError(t, err)
+	enc, err := bsr.NewChunkEncoder(ctx, buf, bsr.NoCompression, bsr.NoEncryption, bsr.WithEmptyChunkFunc(func(c bsr.Chunk) bool {
+		tc, ok := c.(*testChunk)
+		return ok && len(tc.Data) == 0
+	}))
+	require.NoError(t, err)
+	for _, c := range []bsr.Chunk{
+		header,
+		&testChunk{BaseChunk: base("TEST", time.Second), Data: []byte("ls\n")},
+		&testChunk{BaseChunk: base("TEST", time.Minute)},
+		&testChunk{BaseChunk: base("TEST", 2*time.Minute)},
+	} {
+		_, err := enc.Encode(ctx, c)
+		require.NoError(t, err)
+	}
+	require.NoError(t, enc.Close(ctx))
+	// Closing again must not write the idle chunk twice.
+	require.NoError(t, enc.Close(ctx))
+
+	dec, err := bsr.NewChunkDecoder(ctx, bytes.NewBuffer(buf.Bytes()))
+	require.NoError(t, err)
+	var got []bsr.Chunk
+	for {
+		c, err := dec.Decode(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		got = append(got, c)
+	}
+	require.Len(t, got, 3)
+	assert.Equal(t, &bsr.IdleChunk{
+		BaseChunk:  base(bsr.ChunkIdle, 2*time.Minute),
+		Start:      bsr.NewTimestamp(ts.Add(time.Minute)),
+		Suppressed: 2,
+	}, got[2])
+}
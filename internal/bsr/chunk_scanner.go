@@ -26,6 +26,9 @@ type ChunkScanner struct {
 //     scanner encounters an END chunk or an io.EOF error, it will compare the
 //     calculated sha256sum against this sum. If the sums do not match, ErrChecksum
 //     will be returned.
+//   - WithLenientDecode: This is used to skip the chunks that cannot be
+//     decoded. A mismatched sha256sum is then reported to the CorruptChunkFunc
+//     instead of being returned.
 //
 // Other options are passed through to the ChunkDecoder used by the scanner.
 func NewChunkScanner(ctx context.Context, r io.Reader, options ...Option) (*ChunkScanner, error) {
@@ -76,7 +79,11 @@ func (cs *ChunkScanner) Scan(ctx context.Context) (Chunk, error) {
 		}
 
 		if string(cs.checksum) != string(sum) {
-			return c, fmt.Errorf("%s: %w", op, ErrChecksum)
+			checksumErr := fmt.Errorf("%s: %w", op, ErrChecksum)
+			if !cs.chunkDecoder.lenient {
+				return c, checksumErr
+			}
+			cs.chunkDecoder.report(ctx, cs.chunkDecoder.offset, 0, checksumErr)
 		}
 	}
 
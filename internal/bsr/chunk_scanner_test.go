@@ -443,3 +443,32 @@ func TestChunkWalk(t *testing.T) {
 		})
 	}
 }
+
+func TestChunkScannerLenient(t *testing.T) {
+	ctx := context.Background()
+
+	var corrupt []*bsr.CorruptChunk
+	scanner, err := bsr.NewChunkScanner(ctx,
+		bytes.NewBufferString(string(bsr.Magic)+testEncodedHeader+"garbage"+testEncodedTest+testEncodedEnd[:10]),
+		bsr.WithSha256Sum([]byte("797cf38f8e3efa3da3ae95449466ffd62cfe084abd1c1134f9e74474e79570f0")),
+		bsr.WithLenientDecode(func(_ context.Context, c *bsr.CorruptChunk) {
+			corrupt = append(corrupt, c)
+		}),
+	)
+	require.NoError(t, err)
+
+	var got []bsr.ChunkType
+	err = bsr.ChunkWalk(ctx, scanner, func(_ context.Context, c bsr.Chunk) error {
+		got = append(got, c.GetType())
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []bsr.ChunkType{bsr.ChunkHeader, "TEST"}, got)
+
+	require.Len(t, corrupt, 3)
+	assert.ErrorIs(t, corrupt[0].Err, bsr.ErrChunkDecode)
+	assert.Equal(t, int64(len("garbage")), corrupt[0].Length)
+	assert.ErrorIs(t, corrupt[1].Err, bsr.ErrChunkDecode)
+	assert.Equal(t, int64(10), corrupt[1].Length)
+	assert.ErrorIs(t, corrupt[2].Err, bsr.ErrChecksum)
+}
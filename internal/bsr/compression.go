@@ -5,7 +5,10 @@ package bsr
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+
+	"github.com/klauspost/compress/zstd"
 )
 
 const (
@@ -19,6 +22,7 @@ type Compression uint8
 const (
 	NoCompression Compression = iota
 	GzipCompression
+	ZstdCompression
 )
 
 func (c Compression) String() string {
@@ -27,6 +31,8 @@ func (c Compression) String() string {
 		return "no compression"
 	case GzipCompression:
 		return "gzip"
+	case ZstdCompression:
+		return "zstd"
 	default:
 		return "unknown compression"
 	}
@@ -35,12 +41,27 @@ func (c Compression) String() string {
 // ValidCompression checks if a given Compression is valid.
 func ValidCompression(c Compression) bool {
 	switch c {
-	case NoCompression, GzipCompression:
+	case NoCompression, GzipCompression, ZstdCompression:
 		return true
 	}
 	return false
 }
 
+// ParseCompression returns the Compression with the given name, as returned
+// by Compression.String. The name "none" is accepted for NoCompression.
+func ParseCompression(s string) (Compression, error) {
+	switch s {
+	case NoCompression.String(), "none", "":
+		return NoCompression, nil
+	case GzipCompression.String():
+		return GzipCompression, nil
+	case ZstdCompression.String():
+		return ZstdCompression, nil
+	default:
+		return NoCompression, fmt.Errorf("unsupported compression %q: %w", s, ErrInvalidParameter)
+	}
+}
+
 type nullCompressionWriter struct {
 	*bytes.Buffer
 }
@@ -64,3 +85,40 @@ func (w *nullCompressionReader) Close() error {
 func newNullCompressionReader(b *bytes.Buffer) io.ReadCloser {
 	return &nullCompressionReader{Buffer: b}
 }
+
+// The zstd encoder and decoder are safe for concurrent use when only using
+// EncodeAll and DecodeAll, so they are shared by all chunk encoders and
+// decoders to avoid allocating their internal state for every chunk.
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(MaxChunkDataLength))
+)
+
+// zstdCompressionWriter buffers the data written to it and compresses it into
+// the underlying buffer as a single zstd frame when closed.
+type zstdCompressionWriter struct {
+	dst *bytes.Buffer
+	src []byte
+}
+
+func (w *zstdCompressionWriter) Write(p []byte) (int, error) {
+	w.src = append(w.src, p...)
+	return len(p), nil
+}
+
+func (w *zstdCompressionWriter) Close() error {
+	_, err := w.dst.Write(zstdEncoder.EncodeAll(w.src, nil))
+	return err
+}
+
+func newZstdCompressionWriter(b *bytes.Buffer) io.WriteCloser {
+	return &zstdCompressionWriter{dst: b}
+}
+
+func newZstdCompressionReader(b *bytes.Buffer) (io.ReadCloser, error) {
+	decompressed, err := zstdDecoder.DecodeAll(b.Bytes(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return newNullCompressionReader(bytes.NewBuffer(decompressed)), nil
+}
@@ -4,10 +4,16 @@
 package bsr_test
 
 import (
+	"bytes"
+	"context"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/internal/fstest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidCompression(t *testing.T) {
@@ -26,6 +32,11 @@ func TestValidCompression(t *testing.T) {
 			bsr.GzipCompression,
 			true,
 		},
+		{
+			bsr.ZstdCompression.String(),
+			bsr.ZstdCompression,
+			true,
+		},
 		{
 			"something else",
 			bsr.Compression(255),
@@ -57,6 +68,11 @@ func TestCompressionString(t *testing.T) {
 			bsr.GzipCompression,
 			"gzip",
 		},
+		{
+			bsr.ZstdCompression.String(),
+			bsr.ZstdCompression,
+			"zstd",
+		},
 		{
 			"something else",
 			bsr.Compression(255),
@@ -71,3 +87,100 @@ func TestCompressionString(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCompression(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    bsr.Compression
+		wantErr bool
+	}{
+		{"", bsr.NoCompression, false},
+		{"none", bsr.NoCompression, false},
+		{"no compression", bsr.NoCompression, false},
+		{"gzip", bsr.GzipCompression, false},
+		{"zstd", bsr.ZstdCompression, false},
+		{"lz4", bsr.NoCompression, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := bsr.ParseCompression(tc.in)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCompressionRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC)
+	// Terminal output is highly repetitive, which is what makes compressing
+	// recordings worthwhile.
+	data := bytes.Repeat([]byte("user@host:~$ ls -la\r\n"), 1000)
+
+	for _, c := range []bsr.Compression{bsr.NoCompression, bsr.GzipCompression, bsr.ZstdCompression} {
+		t.Run(c.String(), func(t *testing.T) {
+			chunks := []bsr.Chunk{
+				&bsr.HeaderChunk{
+					BaseChunk: &bsr.BaseChunk{
+						Protocol:  "TEST",
+						Direction: bsr.Inbound,
+						Timestamp: bsr.NewTimestamp(ts),
+						Type:      bsr.ChunkHeader,
+					},
+					Compression: c,
+					Encryption:  bsr.NoEncryption,
+					SessionId:   "sess_123456789",
+				},
+				&testChunk{
+					BaseChunk: &bsr.BaseChunk{
+						Protocol:  "TEST",
+						Direction: bsr.Inbound,
+						Timestamp: bsr.NewTimestamp(ts),
+						Type:      "TEST",
+					},
+					Data: data,
+				},
+				&bsr.EndChunk{
+					BaseChunk: &bsr.BaseChunk{
+						Protocol:  "TEST",
+						Direction: bsr.Inbound,
+						Timestamp: bsr.NewTimestamp(ts.Add(time.Second)),
+						Type:      bsr.ChunkEnd,
+					},
+				},
+			}
+
+			buf, err := fstest.NewTempBuffer()
+			require.NoError(t, err)
+			enc, err := bsr.NewChunkEncoder(ctx, buf, c, bsr.NoEncryption)
+			require.NoError(t, err)
+			var wrote int
+			for _, chunk := range chunks {
+				w, err := enc.Encode(ctx, chunk)
+				require.NoError(t, err)
+				wrote += w
+			}
+			if c != bsr.NoCompression {
+				assert.Less(t, wrote, len(data)/10)
+			}
+
+			dec, err := bsr.NewChunkDecoder(ctx, bytes.NewBuffer(buf.Bytes()))
+			require.NoError(t, err)
+			var got []bsr.Chunk
+			for {
+				chunk, err := dec.Decode(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				got = append(got, chunk)
+			}
+			assert.Equal(t, chunks, got)
+		})
+	}
+}
@@ -92,3 +92,73 @@ func ToAsciicast(ctx context.Context, session *bsr.Session, tmp storage.TempFile
 		return nil, fmt.Errorf("%s: %w", op, ErrUnsupportedProtocol)
 	}
 }
+
+// ToTranscript accepts a bsr.Session and will convert the underlying BSR channel file to a plain text
+// transcript of the terminal output. ANSI escape sequences are removed and each line is prefixed with
+// the time it was output.
+// The tempFs will be used to write the transcript to disk
+// It returns an io.Reader to the converted transcript.
+// This supports the following options:
+//   - WithChannelId to indicate this conversion should occur on a channel on a multiplexed session
+func ToTranscript(ctx context.Context, session *bsr.Session, tmp storage.TempFile, connectionId string, options ...Option) (io.ReadCloser, error) {
+	const op = "convert.ToTranscript"
+
+	switch {
+	case is.Nil(session):
+		return nil, fmt.Errorf("%s: missing session: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(session.Meta):
+		return nil, fmt.Errorf("%s: missing session meta: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(tmp):
+		return nil, fmt.Errorf("%s: missing temp file: %w", op, bsr.ErrInvalidParameter)
+	case connectionId == "":
+		return nil, fmt.Errorf("%s: missing connection id: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	opts := getOpts(options...)
+
+	switch session.Meta.Protocol {
+	case ssh.Protocol:
+		chanId := opts.withChannelId
+		switch {
+		case chanId == "":
+			return nil, fmt.Errorf("%s: protocol %q requires channel id to convert: %w", op, ssh.Protocol, bsr.ErrInvalidParameter)
+		}
+
+		conn, err := session.OpenConnection(ctx, connectionId)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Close(ctx)
+
+		ch, err := conn.OpenChannel(ctx, chanId)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer ch.Close(ctx)
+
+		switch chs := ch.Summary.(type) {
+		case *ssh.ChannelSummary:
+			switch chs.SessionProgram {
+			case ssh.Shell, ssh.Exec:
+				msgScanner, err := ch.OpenMessageScanner(ctx, bsr.Outbound)
+				if err != nil {
+					if !is.Nil(msgScanner) {
+						msgScanner.Close()
+					}
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+				defer msgScanner.Close()
+				return sshChannelToTranscript(ctx, msgScanner, tmp)
+			case "":
+				return nil, fmt.Errorf("%s: session program not set for transcript conversion", op)
+			default:
+				return nil, fmt.Errorf("%s: unsupported %q session program for transcript conversion", op, chs.SessionProgram)
+			}
+		default:
+			return nil, fmt.Errorf("%s: unexpected error occurred with channel summary. possibly a malformed Boundary Session Recording", op)
+		}
+
+	default:
+		return nil, fmt.Errorf("%s: %w", op, ErrUnsupportedProtocol)
+	}
+}
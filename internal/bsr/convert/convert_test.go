@@ -563,3 +563,118 @@ func TestConvert_ToAsciicast_Scanner(t *testing.T) {
 		})
 	}
 }
+
+func TestConvert_ToTranscript(t *testing.T) {
+	ctx := context.Background()
+
+	fs := &fstest.MemFS{}
+
+	connectionId := "test_connection"
+	channelId := "test_channel"
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14000000, time.UTC)
+
+	dataChunk := func(offset time.Duration, data string) bsr.Chunk {
+		return &ssh.DataChunk{
+			BaseChunk: &bsr.BaseChunk{
+				Protocol:  ssh.Protocol,
+				Direction: bsr.Outbound,
+				Timestamp: bsr.NewTimestamp(ts.Add(offset)),
+				Type:      ssh.DataChunkType,
+			},
+			Data: []byte(data),
+		}
+	}
+
+	cases := []struct {
+		name           string
+		id             string
+		sessionProgram ssh.SessionProgram
+		want           string
+		wantErr        error
+	}{
+		{
+			name:           "shell",
+			id:             "01234567890",
+			sessionProgram: ssh.Shell,
+			want: "2023-03-16T10:47:03.014Z $ ls\n" +
+				"2023-03-16T10:47:04.014Z dir  file\n" +
+				"2023-03-16T10:47:05.014Z $ \n",
+		},
+		{
+			name:           "unsupported session program - subsystem",
+			id:             "11234567890",
+			sessionProgram: ssh.Subsystem,
+			wantErr:        errors.New("convert.ToTranscript: unsupported \"subsystem\" session program for transcript conversion"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			keys, err := kms.CreateKeys(ctx, kms.TestWrapper(t), fmt.Sprintf("s_%s", tc.id))
+			require.NoError(t, err)
+			keyFn := func(w kms.WrappedKeys) (kms.UnwrappedKeys, error) {
+				return kms.UnwrappedKeys{
+					BsrKey:  keys.BsrKey,
+					PrivKey: keys.PrivKey,
+				}, nil
+			}
+
+			srm := &bsr.SessionRecordingMeta{
+				Id:       fmt.Sprintf("sr_%s", tc.id),
+				Protocol: ssh.Protocol,
+			}
+			sesh, err := bsr.NewSession(ctx, srm, bsr.TestSessionMeta(fmt.Sprintf("s_%s", tc.id)), fs, keys, bsr.WithSupportsMultiplex(true))
+			require.NoError(t, err)
+			require.NoError(t, sesh.EncodeSummary(ctx, &bsr.BaseSessionSummary{Id: channelId}))
+
+			conn, err := sesh.NewConnection(ctx, &bsr.ConnectionRecordingMeta{Id: connectionId})
+			require.NoError(t, err)
+			require.NoError(t, conn.EncodeSummary(ctx, &bsr.BaseConnectionSummary{
+				Id:           connectionId,
+				ChannelCount: 1,
+			}))
+
+			ch, err := conn.NewChannel(ctx, &bsr.ChannelRecordingMeta{Id: channelId, Type: "chan"})
+			require.NoError(t, err)
+			require.NoError(t, ch.EncodeSummary(ctx, &ssh.ChannelSummary{
+				ChannelSummary: &bsr.BaseChannelSummary{
+					Id:                    channelId,
+					ConnectionRecordingId: connectionId,
+				},
+				SessionProgram: tc.sessionProgram,
+			}))
+
+			chunks := testChunks(fmt.Sprintf("s_%s", tc.id), bsr.Outbound, ssh.Protocol)
+			chunks = []bsr.Chunk{
+				chunks[0],
+				dataChunk(0, "\x1b]0;user@host: ~\x07$ ls\r\n"),
+				dataChunk(time.Second, "\x1b[01;34mdir\x1b[0m  file\r\n"),
+				dataChunk(2*time.Second, "$ "),
+				chunks[1],
+			}
+			outW, err := ch.NewMessagesWriter(ctx, bsr.Outbound)
+			require.NoError(t, err)
+			require.NoError(t, writeToChannels(ctx, outW, chunks...))
+
+			require.NoError(t, ch.Close(ctx))
+			require.NoError(t, conn.Close(ctx))
+			require.NoError(t, sesh.Close(ctx))
+
+			opSesh, err := bsr.OpenSession(ctx, srm.Id, fs, keyFn)
+			require.NoError(t, err)
+
+			tmpfile, err := fstest.NewTempFile(tc.name)
+			require.NoError(t, err)
+			r, err := convert.ToTranscript(ctx, opSesh, tmpfile, connectionId, convert.WithChannelId(channelId))
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			defer r.Close()
+			got, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, string(got))
+		})
+	}
+}
@@ -7,6 +7,7 @@ import "errors"
 
 // Common convert errors.
 var (
-	ErrUnsupportedProtocol = errors.New("unsupported protocol")
-	ErrMalformedBsr        = errors.New("malformed bsr data file")
+	ErrUnsupportedProtocol  = errors.New("unsupported protocol")
+	ErrMalformedBsr         = errors.New("malformed bsr data file")
+	ErrUnsupportedRendition = errors.New("unsupported rendition")
 )
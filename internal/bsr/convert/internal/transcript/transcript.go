@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package transcript writes terminal output as a plain text transcript. ANSI
+// escape sequences and other control characters are removed and each line is
+// prefixed with the time its first character was output.
+package transcript
+
+import (
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf8"
+)
+
+// TimeFormat is the format of the timestamp at the start of every line.
+const TimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+type state int
+
+const (
+	text state = iota
+	esc        // after ESC
+	csi        // in a control sequence, ESC [
+	str        // in a string sequence such as OSC, ESC ]
+	strEsc     // after ESC in a string sequence
+	escInter   // after ESC and an intermediate byte, such as ESC ( B
+)
+
+// Writer writes terminal output as a transcript. Escape sequences that are
+// split across writes are handled. A Writer is not safe for concurrent use.
+type Writer struct {
+	w        io.Writer
+	state    state
+	line     []byte
+	lineTime time.Time
+	inLine   bool
+	cr       bool
+}
+
+// NewWriter creates a Writer that writes the transcript to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write adds the terminal output data that was output at time t to the
+// transcript. Complete lines are written to the underlying io.Writer.
+func (t *Writer) Write(ts time.Time, data []byte) error {
+	for _, b := range data {
+		switch t.state {
+		case esc:
+			switch {
+			case b == '[':
+				t.state = csi
+			case b == ']' || b == 'P' || b == 'X' || b == '^' || b == '_':
+				t.state = str
+			case b >= 0x20 && b <= 0x2f:
+				t.state = escInter
+			default:
+				t.state = text
+			}
+			continue
+		case escInter:
+			if b < 0x20 || b > 0x2f {
+				t.state = text
+			}
+			continue
+		case csi:
+			if b >= 0x40 && b <= 0x7e {
+				t.state = text
+			}
+			continue
+		case str:
+			switch b {
+			case 0x07:
+				t.state = text
+			case 0x1b:
+				t.state = strEsc
+			}
+			continue
+		case strEsc:
+			if b == '\\' {
+				t.state = text
+			} else {
+				t.state = str
+			}
+			continue
+		}
+
+		switch b {
+		case 0x1b:
+			t.state = esc
+			continue
+		case '\n':
+			if err := t.endLine(ts); err != nil {
+				return err
+			}
+		case '\r':
+			// A carriage return is followed by a line feed at the end of a
+			// line, otherwise the line is redrawn from the start.
+			t.cr = true
+			continue
+		case '\b':
+			if len(t.line) > 0 {
+				_, size := utf8.DecodeLastRune(t.line)
+				t.line = t.line[:len(t.line)-size]
+			}
+		case '\t':
+			t.add(ts, b)
+		default:
+			if b < 0x20 || b == 0x7f {
+				break
+			}
+			t.add(ts, b)
+		}
+		t.cr = false
+	}
+	return nil
+}
+
+// Close writes the last line of the transcript if it was not terminated by a
+// line feed.
+func (t *Writer) Close() error {
+	if !t.inLine {
+		return nil
+	}
+	return t.endLine(t.lineTime)
+}
+
+func (t *Writer) add(ts time.Time, b byte) {
+	if t.cr {
+		t.line = t.line[:0]
+		t.inLine = false
+	}
+	if !t.inLine {
+		t.inLine = true
+		t.lineTime = ts
+	}
+	t.line = append(t.line, b)
+}
+
+func (t *Writer) endLine(ts time.Time) error {
+	if !t.inLine {
+		t.lineTime = ts
+	}
+	if _, err := fmt.Fprintf(t.w, "%s %s\n", t.lineTime.UTC().Format(TimeFormat), t.line); err != nil {
+		return err
+	}
+	t.line = t.line[:0]
+	t.inLine = false
+	t.cr = false
+	return nil
+}
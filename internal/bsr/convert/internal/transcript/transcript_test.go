@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package transcript
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14000000, time.UTC)
+
+	type write struct {
+		offset time.Duration
+		data   string
+	}
+	cases := []struct {
+		name   string
+		writes []write
+		want   string
+	}{
+		{
+			"empty",
+			nil,
+			"",
+		},
+		{
+			"lines",
+			[]write{{0, "$ ls\r\nfoo  bar\r\n"}},
+			"2023-03-16T10:47:03.014Z $ ls\n" +
+				"2023-03-16T10:47:03.014Z foo  bar\n",
+		},
+		{
+			"line-time-is-first-character",
+			[]write{{0, "$ l"}, {time.Second, "s\r\n"}, {2 * time.Second, "foo\r\n"}},
+			"2023-03-16T10:47:03.014Z $ ls\n" +
+				"2023-03-16T10:47:05.014Z foo\n",
+		},
+		{
+			"unterminated-last-line",
+			[]write{{0, "foo\r\n$ "}},
+			"2023-03-16T10:47:03.014Z foo\n" +
+				"2023-03-16T10:47:03.014Z $ \n",
+		},
+		{
+			"blank-line",
+			[]write{{0, "\r\n"}},
+			"2023-03-16T10:47:03.014Z \n",
+		},
+		{
+			"colors",
+			[]write{{0, "\x1b[01;34mdir\x1b[0m  \x1b[01;32mexec\x1b[0m\r\n"}},
+			"2023-03-16T10:47:03.014Z dir  exec\n",
+		},
+		{
+			"split-escape-sequence",
+			[]write{{0, "\x1b[01"}, {time.Second, ";34mdir\x1b"}, {time.Second, "[0m\r\n"}},
+			"2023-03-16T10:47:04.014Z dir\n",
+		},
+		{
+			"title",
+			[]write{{0, "\x1b]0;user@host: ~\x07$ \x1b]0;other\x1b\\ls\r\n"}},
+			"2023-03-16T10:47:03.014Z $ ls\n",
+		},
+		{
+			"charset",
+			[]write{{0, "\x1b(Bfoo\x1b=\r\n"}},
+			"2023-03-16T10:47:03.014Z foo\n",
+		},
+		{
+			"backspace",
+			[]write{{0, "$ lss\b \b\r\n"}},
+			"2023-03-16T10:47:03.014Z $ ls\n",
+		},
+		{
+			"backspace-multibyte",
+			[]write{{0, "h\xc3\xa9\b\r\n"}},
+			"2023-03-16T10:47:03.014Z h\n",
+		},
+		{
+			"carriage-return-redraw",
+			[]write{{0, "10%"}, {time.Second, "\r\x1b[K100%\r\n"}},
+			"2023-03-16T10:47:04.014Z 100%\n",
+		},
+		{
+			"control-characters",
+			[]write{{0, "\x07\tfoo\x00\x7f\r\n"}},
+			"2023-03-16T10:47:03.014Z \tfoo\n",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewWriter(&buf)
+			for _, wr := range tc.writes {
+				require.NoError(t, w.Write(ts.Add(wr.offset), []byte(wr.data)))
+			}
+			require.NoError(t, w.Close())
+			assert.Equal(t, tc.want, buf.String())
+		})
+	}
+}
@@ -3,6 +3,8 @@
 
 package convert
 
+import "time"
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -20,6 +22,9 @@ type options struct {
 	withChannelId string
 	withMinWidth  uint32
 	withMinHeight uint32
+
+	withLanguages          []string
+	withScreenshotInterval time.Duration
 }
 
 func getDefaultOptions() options {
@@ -46,3 +51,19 @@ func WithMinHeight(h uint32) Option {
 		o.withMinHeight = h
 	}
 }
+
+// WithLanguages sets the languages of the text to recognize when producing a
+// searchable text rendition, as BCP 47 language tags in order of preference.
+func WithLanguages(l ...string) Option {
+	return func(o *options) {
+		o.withLanguages = l
+	}
+}
+
+// WithScreenshotInterval sets the interval between screenshots when producing
+// a screenshots rendition.
+func WithScreenshotInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.withScreenshotInterval = d
+	}
+}
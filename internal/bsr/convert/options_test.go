@@ -5,6 +5,7 @@ package convert
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -36,4 +37,18 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withMinHeight = height
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithLanguages", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithLanguages("en", "ja"))
+		testOpts := getDefaultOptions()
+		testOpts.withLanguages = []string{"en", "ja"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithScreenshotInterval", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithScreenshotInterval(5 * time.Second))
+		testOpts := getDefaultOptions()
+		testOpts.withScreenshotInterval = 5 * time.Second
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package convert
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/internal/is"
+	"github.com/hashicorp/boundary/internal/storage"
+	"golang.org/x/text/language"
+)
+
+// Mime types of the renditions of graphical protocol recordings, such as RDP
+// or VNC.
+const (
+	// TextMimeType is the mime type of a searchable text rendition, produced
+	// by recognizing the text shown on the screen during the recording. It is
+	// distinct from the "text/plain" mime type of transcripts, so that a
+	// protocol can support both.
+	TextMimeType = "text/x-boundary-ocr"
+	// ScreenshotsMimeType is the mime type of a zip archive of screenshots
+	// of the recording taken at a regular interval.
+	ScreenshotsMimeType = "application/zip"
+)
+
+// RenditionRequest is passed to a Renderer to produce a rendition of a
+// recording.
+type RenditionRequest struct {
+	// Session is the recording being rendered.
+	Session *bsr.Session
+	// Connection is the connection of the recording being rendered.
+	Connection *bsr.Connection
+	// Channel is the channel of the recording being rendered. It is nil
+	// unless a channel id was requested with WithChannelId.
+	Channel *bsr.Channel
+	// MimeType is the mime type of the requested rendition.
+	MimeType string
+	// Languages are the languages of the text to recognize, in order of
+	// preference. If empty, the renderer chooses the languages.
+	Languages []language.Tag
+	// ScreenshotInterval is the interval between screenshots. If zero, the
+	// renderer chooses the interval.
+	ScreenshotInterval time.Duration
+}
+
+// Renderer produces a rendition of a recording in a mime type that cannot be
+// converted directly from the BSR data, for example by sending the screen
+// updates of a graphical protocol to an external service that recognizes the
+// text on the screen. Protocols register a Renderer for each mime type they
+// support with RegisterRenderer.
+type Renderer interface {
+	// Render writes the rendition requested by r to w.
+	Render(ctx context.Context, r *RenditionRequest, w io.Writer) error
+}
+
+// RendererFunc is an adapter to allow the use of a function as a Renderer.
+type RendererFunc func(ctx context.Context, r *RenditionRequest, w io.Writer) error
+
+// Render calls f(ctx, r, w).
+func (f RendererFunc) Render(ctx context.Context, r *RenditionRequest, w io.Writer) error {
+	return f(ctx, r, w)
+}
+
+// rendererRegistry mappings of protocols and mime types for each Renderer
+type rendererRegistry struct {
+	sync.RWMutex
+	m map[bsr.Protocol]map[string]Renderer
+}
+
+func (r *rendererRegistry) get(p bsr.Protocol, mimeType string) (Renderer, bool) {
+	r.RLock()
+	defer r.RUnlock()
+	renderer, ok := r.m[p][mimeType]
+	return renderer, ok
+}
+
+var renderers rendererRegistry
+
+// RegisterRenderer registers a Renderer for the given Protocol and mime type.
+// A given Protocol and mime type can only have one Renderer registered.
+func RegisterRenderer(p bsr.Protocol, mimeType string, r Renderer) error {
+	const op = "convert.RegisterRenderer"
+
+	switch {
+	case p == "":
+		return fmt.Errorf("%s: missing protocol: %w", op, bsr.ErrInvalidParameter)
+	case mimeType == "":
+		return fmt.Errorf("%s: missing mime type: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(r):
+		return fmt.Errorf("%s: missing renderer: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	renderers.Lock()
+	defer renderers.Unlock()
+
+	if renderers.m == nil {
+		renderers.m = make(map[bsr.Protocol]map[string]Renderer)
+	}
+	protocol, ok := renderers.m[p]
+	if !ok {
+		protocol = make(map[string]Renderer)
+	}
+	if _, ok := protocol[mimeType]; ok {
+		return fmt.Errorf("%s: %s protocol with %s mime type: %w", op, p, mimeType, bsr.ErrAlreadyRegistered)
+	}
+	protocol[mimeType] = r
+	renderers.m[p] = protocol
+	return nil
+}
+
+// RenditionMimeTypes returns the sorted mime types that have a Renderer
+// registered for the given Protocol.
+func RenditionMimeTypes(p bsr.Protocol) []string {
+	renderers.RLock()
+	defer renderers.RUnlock()
+
+	var mimeTypes []string
+	for mt := range renderers.m[p] {
+		mimeTypes = append(mimeTypes, mt)
+	}
+	slices.Sort(mimeTypes)
+	return mimeTypes
+}
+
+// ToRendition accepts a bsr.Session and produces a rendition of the underlying
+// BSR connection or channel in the given mime type, using the Renderer
+// registered for the session's protocol and the mime type.
+// The tempFs will be used to write the rendition to disk
+// It returns an io.Reader to the rendition.
+// This supports the following options:
+//   - WithChannelId to indicate the rendition is of a channel on a multiplexed session
+//   - WithLanguages to set the languages of the text to recognize
+//   - WithScreenshotInterval to set the interval between screenshots
+func ToRendition(ctx context.Context, session *bsr.Session, tmp storage.TempFile, connectionId, mimeType string, options ...Option) (io.ReadCloser, error) {
+	const op = "convert.ToRendition"
+
+	switch {
+	case is.Nil(session):
+		return nil, fmt.Errorf("%s: missing session: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(session.Meta):
+		return nil, fmt.Errorf("%s: missing session meta: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(tmp):
+		return nil, fmt.Errorf("%s: missing temp file: %w", op, bsr.ErrInvalidParameter)
+	case connectionId == "":
+		return nil, fmt.Errorf("%s: missing connection id: %w", op, bsr.ErrInvalidParameter)
+	case mimeType == "":
+		return nil, fmt.Errorf("%s: missing mime type: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	opts := getOpts(options...)
+
+	renderer, ok := renderers.get(session.Meta.Protocol, mimeType)
+	if !ok {
+		return nil, fmt.Errorf("%s: %s protocol with %s mime type: %w", op, session.Meta.Protocol, mimeType, ErrUnsupportedRendition)
+	}
+
+	req := &RenditionRequest{
+		Session:            session,
+		MimeType:           mimeType,
+		ScreenshotInterval: opts.withScreenshotInterval,
+	}
+	for _, l := range opts.withLanguages {
+		tag, err := language.Parse(l)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid language %q: %w", op, l, bsr.ErrInvalidParameter)
+		}
+		req.Languages = append(req.Languages, tag)
+	}
+
+	conn, err := session.OpenConnection(ctx, connectionId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer conn.Close(ctx)
+	req.Connection = conn
+
+	if opts.withChannelId != "" {
+		ch, err := conn.OpenChannel(ctx, opts.withChannelId)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer ch.Close(ctx)
+		req.Channel = ch
+	}
+
+	if err := renderer.Render(ctx, req, tmp); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return tmp, nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package convert_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/convert"
+	"github.com/hashicorp/boundary/internal/bsr/internal/fstest"
+	"github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// transcriptMimeType is the mime type of the transcripts produced by
+// convert.ToTranscript.
+const transcriptMimeType = "text/plain"
+
+func TestRegisterRenderer(t *testing.T) {
+	protocol := bsr.Protocol("TEST_REGISTER_RENDERER")
+	noop := convert.RendererFunc(func(context.Context, *convert.RenditionRequest, io.Writer) error { return nil })
+
+	assert.Empty(t, convert.RenditionMimeTypes(protocol))
+
+	require.NoError(t, convert.RegisterRenderer(protocol, convert.TextMimeType, noop))
+	require.NoError(t, convert.RegisterRenderer(protocol, convert.ScreenshotsMimeType, noop))
+	require.NoError(t, convert.RegisterRenderer(protocol, transcriptMimeType, noop))
+	assert.Equal(t, []string{convert.ScreenshotsMimeType, transcriptMimeType, convert.TextMimeType}, convert.RenditionMimeTypes(protocol))
+
+	err := convert.RegisterRenderer(protocol, convert.TextMimeType, noop)
+	assert.ErrorIs(t, err, bsr.ErrAlreadyRegistered)
+
+	err = convert.RegisterRenderer("", convert.TextMimeType, noop)
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	err = convert.RegisterRenderer(protocol, "", noop)
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	err = convert.RegisterRenderer(protocol, "image/png", nil)
+	assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+}
+
+func TestConvert_ToRendition(t *testing.T) {
+	ctx := context.Background()
+	fs := &fstest.MemFS{}
+
+	protocol := bsr.Protocol("TEST_RENDITION")
+	connectionId := "test_connection"
+	channelId := "test_channel"
+
+	require.NoError(t, bsr.RegisterSummaryAllocFunc(protocol, bsr.SessionContainer, func(ctx context.Context) bsr.Summary {
+		return &bsr.BaseSessionSummary{}
+	}))
+	require.NoError(t, bsr.RegisterSummaryAllocFunc(protocol, bsr.ConnectionContainer, func(ctx context.Context) bsr.Summary {
+		return &bsr.BaseConnectionSummary{}
+	}))
+	require.NoError(t, bsr.RegisterSummaryAllocFunc(protocol, bsr.ChannelContainer, func(ctx context.Context) bsr.Summary {
+		return &bsr.BaseChannelSummary{}
+	}))
+	require.NoError(t, convert.RegisterRenderer(protocol, convert.TextMimeType, convert.RendererFunc(
+		func(_ context.Context, r *convert.RenditionRequest, w io.Writer) error {
+			chanId := ""
+			if r.Channel != nil {
+				chanId = r.Channel.Meta.Id
+			}
+			_, err := fmt.Fprintf(w, "%s %s %s %v %s", r.MimeType, r.Connection.Meta.Id, chanId, r.Languages, r.ScreenshotInterval)
+			return err
+		})))
+	// A protocol can support both a text rendition and a transcript.
+	require.NoError(t, convert.RegisterRenderer(protocol, transcriptMimeType, convert.RendererFunc(
+		func(_ context.Context, r *convert.RenditionRequest, w io.Writer) error {
+			_, err := fmt.Fprintf(w, "transcript %s", r.Connection.Meta.Id)
+			return err
+		})))
+
+	keys, err := kms.CreateKeys(ctx, kms.TestWrapper(t), "s_rendition")
+	require.NoError(t, err)
+	keyFn := func(w kms.WrappedKeys) (kms.UnwrappedKeys, error) {
+		return kms.UnwrappedKeys{
+			BsrKey:  keys.BsrKey,
+			PrivKey: keys.PrivKey,
+		}, nil
+	}
+
+	srm := &bsr.SessionRecordingMeta{Id: "sr_rendition", Protocol: protocol}
+	sesh, err := bsr.NewSession(ctx, srm, bsr.TestSessionMeta("s_rendition"), fs, keys, bsr.WithSupportsMultiplex(true))
+	require.NoError(t, err)
+	require.NoError(t, sesh.EncodeSummary(ctx, &bsr.BaseSessionSummary{Id: "s_rendition", ConnectionCount: 1}))
+	conn, err := sesh.NewConnection(ctx, &bsr.ConnectionRecordingMeta{Id: connectionId})
+	require.NoError(t, err)
+	require.NoError(t, conn.EncodeSummary(ctx, &bsr.BaseConnectionSummary{Id: connectionId, ChannelCount: 1}))
+	ch, err := conn.NewChannel(ctx, &bsr.ChannelRecordingMeta{Id: channelId, Type: "chan"})
+	require.NoError(t, err)
+	require.NoError(t, ch.EncodeSummary(ctx, &bsr.BaseChannelSummary{Id: channelId, ConnectionRecordingId: connectionId}))
+	require.NoError(t, ch.Close(ctx))
+	require.NoError(t, conn.Close(ctx))
+	require.NoError(t, sesh.Close(ctx))
+
+	opSesh, err := bsr.OpenSession(ctx, srm.Id, fs, keyFn)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name         string
+		session      *bsr.Session
+		connectionId string
+		mimeType     string
+		opts         []convert.Option
+		want         string
+		wantErr      error
+	}{
+		{
+			name:         "connection",
+			session:      opSesh,
+			connectionId: connectionId,
+			mimeType:     convert.TextMimeType,
+			want:         "text/x-boundary-ocr test_connection  [] 0s",
+		},
+		{
+			name:         "channel",
+			session:      opSesh,
+			connectionId: connectionId,
+			mimeType:     convert.TextMimeType,
+			opts: []convert.Option{
+				convert.WithChannelId(channelId),
+				convert.WithLanguages("en", "de-CH"),
+				convert.WithScreenshotInterval(10 * time.Second),
+			},
+			want: "text/x-boundary-ocr test_connection test_channel [en de-CH] 10s",
+		},
+		{
+			name:         "transcript",
+			session:      opSesh,
+			connectionId: connectionId,
+			mimeType:     transcriptMimeType,
+			want:         "transcript test_connection",
+		},
+		{
+			name:         "unsupported mime type",
+			session:      opSesh,
+			connectionId: connectionId,
+			mimeType:     convert.ScreenshotsMimeType,
+			wantErr:      convert.ErrUnsupportedRendition,
+		},
+		{
+			name:         "invalid language",
+			session:      opSesh,
+			connectionId: connectionId,
+			mimeType:     convert.TextMimeType,
+			opts:         []convert.Option{convert.WithLanguages("not a language")},
+			wantErr:      bsr.ErrInvalidParameter,
+		},
+		{
+			name:         "missing session",
+			connectionId: connectionId,
+			mimeType:     convert.TextMimeType,
+			wantErr:      bsr.ErrInvalidParameter,
+		},
+		{
+			name:     "missing connection id",
+			session:  opSesh,
+			mimeType: convert.TextMimeType,
+			wantErr:  bsr.ErrInvalidParameter,
+		},
+		{
+			name:         "missing mime type",
+			session:      opSesh,
+			connectionId: connectionId,
+			wantErr:      bsr.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpfile, err := fstest.NewTempFile(tc.name)
+			require.NoError(t, err)
+
+			r, err := convert.ToRendition(ctx, tc.session, tmpfile, tc.connectionId, tc.mimeType, tc.opts...)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			defer r.Close()
+			got, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
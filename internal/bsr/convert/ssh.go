@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/bsr"
 	"github.com/hashicorp/boundary/internal/bsr/convert/internal/asciicast"
+	"github.com/hashicorp/boundary/internal/bsr/convert/internal/transcript"
 	"github.com/hashicorp/boundary/internal/bsr/internal/is"
 	"github.com/hashicorp/boundary/internal/bsr/ssh"
 )
@@ -153,3 +154,61 @@ func sshChannelToAsciicast(ctx context.Context, requestScanner *bsr.ChunkScanner
 	}
 	return r, nil
 }
+
+// sshChannelToTranscript will convert a recording of an ssh channel from a
+// BSR into a plain text transcript. This expects a bsr.ChunkScanner for the
+// recording of the outbound messages. This also expects a io.ReadWriteSeeker
+// that will be used to write the transcript. This is then reset and returned
+// as a io.ReadCloser. The caller should call Close on the returned
+// io.ReadCloser after reading the transcript.
+func sshChannelToTranscript(ctx context.Context, messagesScanner *bsr.ChunkScanner, w io.ReadWriteSeeker) (io.ReadCloser, error) {
+	const op = "convert.sshChannelToTranscript"
+
+	switch {
+	case is.Nil(messagesScanner):
+		return nil, fmt.Errorf("%s: missing message scanner: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(w):
+		return nil, fmt.Errorf("%s: missing read write seeker: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	tw := transcript.NewWriter(w)
+	var sawHeader bool
+	if err := bsr.ChunkWalk(ctx, messagesScanner, func(ctx context.Context, c bsr.Chunk) error {
+		switch c.GetProtocol() {
+		case ssh.Protocol:
+			switch c.GetType() {
+			case bsr.ChunkHeader:
+				if sawHeader {
+					return fmt.Errorf("multiple header chunks: %w", ErrMalformedBsr)
+				}
+				sawHeader = true
+			case ssh.DataChunkType:
+				if !sawHeader {
+					return fmt.Errorf("data chunk before header: %w", ErrMalformedBsr)
+				}
+				cc := c.(*ssh.DataChunk)
+				return tw.Write(cc.GetTimestamp().AsTime(), cc.Data)
+			}
+			return nil
+		default:
+			return ErrUnsupportedProtocol
+		}
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := w.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var r io.ReadCloser
+	if v, ok := w.(io.ReadCloser); ok {
+		r = v
+	} else {
+		r = io.NopCloser(w)
+	}
+	return r, nil
+}
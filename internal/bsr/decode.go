@@ -12,7 +12,6 @@ import (
 	"fmt"
 	"hash/crc32"
 	"io"
-	"log"
 
 	"github.com/hashicorp/boundary/internal/bsr/internal/is"
 	"github.com/hashicorp/boundary/internal/bsr/kms"
@@ -30,6 +29,8 @@ func (r decodeFuncsRegistry) Get(p Protocol, t ChunkType) (DecodeChunkFunc, bool
 		return DecodeHeader, true
 	case ChunkEnd:
 		return DecodeEnd, true
+	case ChunkIdle:
+		return DecodeIdle, true
 	default:
 		protocol, ok := r[p]
 		if !ok {
@@ -73,11 +74,37 @@ type ChunkDecoder struct {
 	encryption  Encryption
 
 	keys *kms.Keys
+
+	lenient        bool
+	corruptChunkFn CorruptChunkFunc
+	// buf holds the data read from r that has not been decoded yet when
+	// decoding leniently, offset is the position of buf in the data read
+	// from r and readErr is the error that stopped reading from r, if any.
+	buf     []byte
+	offset  int64
+	eof     bool
+	readErr error
+}
+
+// CorruptChunk describes data that a lenient ChunkDecoder skipped because it
+// could not be decoded.
+type CorruptChunk struct {
+	// Offset is the position of the skipped data in the data read by the
+	// decoder.
+	Offset int64
+	// Length is the number of bytes that were skipped.
+	Length int64
+	// Err is the reason the data could not be decoded.
+	Err error
 }
 
+// CorruptChunkFunc is called by a lenient ChunkDecoder for the data that it
+// skips.
+type CorruptChunkFunc func(ctx context.Context, c *CorruptChunk)
+
 // NewChunkDecoder creates a ChunkDecoder that can decode the data read from
 // the given io.Reader. Supports the WithKeys option which will be used when
-// support for encrypted chunks is added.
+// support for encrypted chunks is added, and the WithLenientDecode option.
 func NewChunkDecoder(_ context.Context, r io.Reader, options ...Option) (*ChunkDecoder, error) {
 	const op = "bsr.NewChunkDecoder"
 
@@ -88,10 +115,12 @@ func NewChunkDecoder(_ context.Context, r io.Reader, options ...Option) (*ChunkD
 	opts := getOpts(options...)
 
 	return &ChunkDecoder{
-		r:           r,
-		compression: NoCompression,
-		encryption:  NoEncryption,
-		keys:        opts.withKeys,
+		r:              r,
+		compression:    NoCompression,
+		encryption:     NoEncryption,
+		keys:           opts.withKeys,
+		lenient:        opts.withLenientDecode,
+		corruptChunkFn: opts.withCorruptChunkFunc,
 	}, nil
 }
 
@@ -102,20 +131,164 @@ func NewChunkDecoder(_ context.Context, r io.Reader, options ...Option) (*ChunkD
 // while decoding, such as an unsupported chunk type or corrupted data, an
 // ErrChunkDecode error will be returned. This will be a wrapped error and
 // should be checked for with errors.Is.
+//
+// When the decoder was created using WithLenientDecode, corrupted data does
+// not stop the decoding. Instead, Decode skips it, reports it to the
+// CorruptChunkFunc and returns the next Chunk that can be decoded. A truncated
+// final chunk is reported and followed by io.EOF.
 func (d *ChunkDecoder) Decode(ctx context.Context) (Chunk, error) {
 	const op = "bsr.(ChunkDecoder).Decode"
 
-	var b *BaseChunk
+	if d.lenient {
+		return d.decodeLenient(ctx)
+	}
+
+	b, data, err := readChunk(ctx, d.r)
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	c, err := d.decodeChunk(ctx, b, data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return c, nil
+}
+
+func (d *ChunkDecoder) decodeLenient(ctx context.Context) (Chunk, error) {
+	const op = "bsr.(ChunkDecoder).Decode"
+
+	for {
+		d.fill(chunkBaseSize)
+		if len(d.buf) == 0 {
+			if d.readErr != nil {
+				return nil, fmt.Errorf("%s: %w: %w", op, d.readErr, ErrChunkDecode)
+			}
+			return nil, io.EOF
+		}
+
+		start := d.offset
+		size, b, data, err := d.peekChunk(ctx)
+		if err != nil {
+			// The chunk boundaries cannot be trusted, so skip ahead to the
+			// next position that holds a valid chunk.
+			d.report(ctx, start, d.resync(ctx), fmt.Errorf("%s: %w", op, err))
+			continue
+		}
+		d.consume(size)
+
+		c, err := d.decodeChunk(ctx, b, data)
+		if err != nil {
+			d.report(ctx, start, int64(size), fmt.Errorf("%s: %w", op, err))
+			continue
+		}
+		return c, nil
+	}
+}
+
+// fill reads from the io.Reader until the buffer of a lenient decoder holds
+// at least n bytes and reports whether it does.
+func (d *ChunkDecoder) fill(n int) bool {
+	if len(d.buf) >= n {
+		return true
+	}
+	if d.eof {
+		return false
+	}
+	need := make([]byte, n-len(d.buf))
+	read, err := io.ReadFull(d.r, need)
+	d.buf = append(d.buf, need[:read]...)
+	if err != nil {
+		d.eof = true
+		if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			d.readErr = err
+		}
+	}
+	return len(d.buf) >= n
+}
+
+func (d *ChunkDecoder) consume(n int) {
+	d.buf = d.buf[n:]
+	d.offset += int64(n)
+}
+
+// peekChunk reads the chunk at the start of the buffer of a lenient decoder
+// without consuming it, returning its size.
+func (d *ChunkDecoder) peekChunk(ctx context.Context) (int, *BaseChunk, []byte, error) {
+	if len(d.buf) < chunkBaseSize {
+		return 0, nil, nil, fmt.Errorf("%w: %w", io.ErrUnexpectedEOF, ErrChunkDecode)
+	}
+	length := binary.BigEndian.Uint32(d.buf[:lengthSize])
+	if length > MaxChunkDataLength {
+		return 0, nil, nil, fmt.Errorf("chunk length %d exceeds max chunk length of %d: %w", length, MaxChunkDataLength, ErrChunkDecode)
+	}
+	size := chunkBaseSize + int(length) + crcSize
+	d.fill(size)
+	if len(d.buf) < size {
+		return 0, nil, nil, fmt.Errorf("%w: truncated chunk: %w", io.ErrUnexpectedEOF, ErrChunkDecode)
+	}
+	b, data, err := readChunk(ctx, bytes.NewReader(d.buf[:size]))
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return size, b, data, nil
+}
 
+// resync skips the data at the start of the buffer of a lenient decoder up to
+// the next chunk that can be read, returning the number of bytes skipped. All
+// of the remaining data is skipped if there is no such chunk.
+func (d *ChunkDecoder) resync(ctx context.Context) int64 {
+	var skipped int64
+	for {
+		d.consume(1)
+		skipped++
+		if !d.fill(chunkBaseSize) {
+			skipped += int64(len(d.buf))
+			d.consume(len(d.buf))
+			return skipped
+		}
+		// Check the fields that are cheap to validate before reading and
+		// checksumming the whole candidate chunk.
+		protocol := Protocol(string(d.buf[lengthSize : lengthSize+protocolSize]))
+		chunkType := ChunkType(string(d.buf[lengthSize+protocolSize : lengthSize+protocolSize+chunkTypeSize]))
+		if _, ok := chunkTypes.Get(protocol, chunkType); !ok {
+			continue
+		}
+		if !ValidDirection(Direction(d.buf[lengthSize+protocolSize+chunkTypeSize])) {
+			continue
+		}
+		if _, _, _, err := d.peekChunk(ctx); err == nil {
+			return skipped
+		}
+	}
+}
+
+func (d *ChunkDecoder) report(ctx context.Context, offset, length int64, err error) {
+	if d.corruptChunkFn == nil {
+		return
+	}
+	d.corruptChunkFn(ctx, &CorruptChunk{
+		Offset: offset,
+		Length: length,
+		Err:    err,
+	})
+}
+
+// readChunk reads the next chunk from the io.Reader and validates its crc,
+// returning its base fields and its data. If the io.Reader is at EOF, it
+// returns io.EOF.
+func readChunk(ctx context.Context, r io.Reader) (*BaseChunk, []byte, error) {
 	buf := make([]byte, chunkBaseSize)
 	crcBuf := make([]byte, crcSize)
 
-	_, err := io.ReadAtLeast(d.r, buf, chunkBaseSize)
+	_, err := io.ReadAtLeast(r, buf, chunkBaseSize)
 	if err != nil {
 		if err == io.EOF || errors.Is(err, io.EOF) {
-			return nil, io.EOF
+			return nil, nil, io.EOF
 		}
-		return nil, fmt.Errorf("%s: %w: %w", op, err, ErrChunkDecode)
+		return nil, nil, fmt.Errorf("%w: %w", err, ErrChunkDecode)
 	}
 
 	var length uint32
@@ -127,28 +300,27 @@ func (d *ChunkDecoder) Decode(ctx context.Context) (Chunk, error) {
 
 	length, buf = binary.BigEndian.Uint32(buf[:lengthSize]), buf[lengthSize:]
 	if length > MaxChunkDataLength {
-		return nil, fmt.Errorf("%s: chunk length %d exceeds max chunk length of %d: %w", op, length, MaxChunkDataLength, ErrChunkDecode)
+		return nil, nil, fmt.Errorf("chunk length %d exceeds max chunk length of %d: %w", length, MaxChunkDataLength, ErrChunkDecode)
 	}
 	databuf := make([]byte, length)
-	_, err = io.ReadAtLeast(d.r, databuf, int(length))
+	_, err = io.ReadAtLeast(r, databuf, int(length))
 	if err != nil {
 		if err == io.EOF {
 			err = io.ErrUnexpectedEOF
 		}
-		return nil, fmt.Errorf("%s: %w: missing data: %w", op, err, ErrChunkDecode)
+		return nil, nil, fmt.Errorf("%w: missing data: %w", err, ErrChunkDecode)
 	}
 	crc.Write(append(buf, databuf...))
 
-	_, err = io.ReadAtLeast(d.r, crcBuf, crcSize)
+	_, err = io.ReadAtLeast(r, crcBuf, crcSize)
 	if err != nil {
 		if err == io.EOF {
 			err = io.ErrUnexpectedEOF
 		}
-		return nil, fmt.Errorf("%s: %w: missing crc: %w", op, err, ErrChunkDecode)
+		return nil, nil, fmt.Errorf("%w: missing crc: %w", err, ErrChunkDecode)
 	}
 	if crc.Sum32() != binary.BigEndian.Uint32(crcBuf) {
-		log.Printf("%x", crc.Sum32())
-		return nil, fmt.Errorf("%s: chunk crc did not match: %w", op, ErrChunkDecode)
+		return nil, nil, fmt.Errorf("chunk crc did not match: %w", ErrChunkDecode)
 	}
 
 	protocol, buf = Protocol(string(buf[:protocolSize])), buf[protocolSize:]
@@ -157,21 +329,27 @@ func (d *ChunkDecoder) Decode(ctx context.Context) (Chunk, error) {
 
 	timestamp, err := decodeTimestamp(buf[:timestampSize])
 	if err != nil {
-		return nil, fmt.Errorf("%s: error parsing timestamp: %w: %w", op, err, ErrChunkDecode)
+		return nil, nil, fmt.Errorf("error parsing timestamp: %w: %w", err, ErrChunkDecode)
 	}
 	buf = buf[timestampSize:]
 	if len(buf) != 0 {
-		return nil, fmt.Errorf("%s: extra data in chunk: %w", op, ErrChunkDecode)
+		return nil, nil, fmt.Errorf("extra data in chunk: %w", ErrChunkDecode)
 	}
 
-	b, err = NewBaseChunk(ctx, protocol, direction, timestamp, chunkType)
+	b, err := NewBaseChunk(ctx, protocol, direction, timestamp, chunkType)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w: %w", op, err, ErrChunkDecode)
+		return nil, nil, fmt.Errorf("%w: %w", err, ErrChunkDecode)
 	}
+	return b, databuf, nil
+}
 
+// decodeChunk decompresses the data of a chunk and decodes it using the
+// DecodeChunkFunc registered for the chunk's protocol and type.
+func (d *ChunkDecoder) decodeChunk(ctx context.Context, b *BaseChunk, databuf []byte) (Chunk, error) {
+	var err error
 	decompressBuf := bytes.NewBuffer(databuf)
 	var decompressor io.ReadCloser
-	switch chunkType {
+	switch b.Type {
 	// HEAD and END are never compressed
 	case ChunkHeader, ChunkEnd:
 		decompressor = newNullCompressionReader(decompressBuf)
@@ -180,7 +358,12 @@ func (d *ChunkDecoder) Decode(ctx context.Context) (Chunk, error) {
 		case GzipCompression:
 			decompressor, err = gzip.NewReader(decompressBuf)
 			if err != nil {
-				return nil, fmt.Errorf("%s: %w: %w", op, err, ErrChunkDecode)
+				return nil, fmt.Errorf("%w: %w", err, ErrChunkDecode)
+			}
+		case ZstdCompression:
+			decompressor, err = newZstdCompressionReader(decompressBuf)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", err, ErrChunkDecode)
 			}
 		default:
 			decompressor = newNullCompressionReader(decompressBuf)
@@ -191,17 +374,17 @@ func (d *ChunkDecoder) Decode(ctx context.Context) (Chunk, error) {
 	limitedDecompressionReader := io.LimitReader(decompressor, MaxChunkDataLength)
 	decompressed, err := io.ReadAll(limitedDecompressionReader)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w: %w", op, err, ErrChunkDecode)
+		return nil, fmt.Errorf("%w: %w", err, ErrChunkDecode)
 	}
 
 	df, ok := chunkTypes.Get(b.Protocol, b.Type)
 	if !ok {
-		return nil, fmt.Errorf("%s: unsupported chunk type %s for protocol %s: %w", op, b.Type, b.Protocol, ErrChunkDecode)
+		return nil, fmt.Errorf("unsupported chunk type %s for protocol %s: %w", b.Type, b.Protocol, ErrChunkDecode)
 	}
 
 	c, err := df(ctx, b, decompressed)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w: %w", op, err, ErrChunkDecode)
+		return nil, fmt.Errorf("%w: %w", err, ErrChunkDecode)
 	}
 
 	switch cc := c.(type) {
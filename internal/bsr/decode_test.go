@@ -487,3 +487,167 @@ func TestChunkDecoderDecodeErrors(t *testing.T) {
 		})
 	}
 }
+
+const (
+	testEncodedHeader = "" +
+		"\x00\x00\x00\x10" + // length
+		"TEST" + // protocol
+		"HEAD" + // type
+		"\x01" + // direction
+		"\x00\x00\x00\x00\x64\x12\xf3\xa7" + // time seconds
+		"\x00\x00\x00\x0e" + // time nanoseconds
+		"\x00" + // compression method
+		"\x00" + // encryption method
+		"sess_123456789" + // data
+		"\xbe\x4c\x7c\x20" // crc
+	testEncodedTest = "" +
+		"\x00\x00\x00\x03" + // length
+		"TEST" + // protocol
+		"TEST" + // type
+		"\x01" + // direction
+		"\x00\x00\x00\x00\x64\x12\xf3\xa7" + // time seconds
+		"\x00\x00\x00\x0e" + // time nanoseconds
+		"foo" + // data
+		"\xa4\x6e\x48\x70" // crc
+	testEncodedErr = "" +
+		"\x00\x00\x00\x03" + // length
+		"TEST" + // protocol
+		"ERRR" + // type
+		"\x01" + // direction
+		"\x00\x00\x00\x00\x64\x12\xf3\xa7" + // time seconds
+		"\x00\x00\x00\x0e" + // time nanoseconds
+		"foo" + // data
+		"\x30\xd5\x69\xbb" // crc
+	testEncodedEnd = "" +
+		"\x00\x00\x00\x00" + // length
+		"TEST" + // protocol
+		"DONE" + // type
+		"\x01" + // direction
+		"\x00\x00\x00\x00\x64\x12\xf3\xa7" + // time seconds
+		"\x00\x00\x00\x13" + // time nanoseconds
+		"\x50\x91\xfe\x72" // crc
+)
+
+func TestChunkDecoderLenient(t *testing.T) {
+	ctx := context.Background()
+
+	corruptCrc := testEncodedTest[:len(testEncodedTest)-1] + "\x00"
+	corruptLength := "\x00\x10" + testEncodedTest[2:]
+
+	cases := []struct {
+		name        string
+		encoded     string
+		wantTypes   []bsr.ChunkType
+		wantCorrupt []bsr.CorruptChunk
+	}{
+		{
+			"no-corruption",
+			testEncodedHeader + testEncodedTest + testEncodedEnd,
+			[]bsr.ChunkType{bsr.ChunkHeader, "TEST", bsr.ChunkEnd},
+			nil,
+		},
+		{
+			"crc-mismatch",
+			testEncodedHeader + corruptCrc + testEncodedTest + testEncodedEnd,
+			[]bsr.ChunkType{bsr.ChunkHeader, "TEST", bsr.ChunkEnd},
+			[]bsr.CorruptChunk{{Offset: int64(len(testEncodedHeader)), Length: int64(len(corruptCrc))}},
+		},
+		{
+			"corrupt-length",
+			testEncodedHeader + corruptLength + testEncodedEnd,
+			[]bsr.ChunkType{bsr.ChunkHeader, bsr.ChunkEnd},
+			[]bsr.CorruptChunk{{Offset: int64(len(testEncodedHeader)), Length: int64(len(corruptLength))}},
+		},
+		{
+			"decode-function-error",
+			testEncodedHeader + testEncodedErr + testEncodedTest + testEncodedEnd,
+			[]bsr.ChunkType{bsr.ChunkHeader, "TEST", bsr.ChunkEnd},
+			[]bsr.CorruptChunk{{Offset: int64(len(testEncodedHeader)), Length: int64(len(testEncodedErr))}},
+		},
+		{
+			"leading-garbage",
+			"garbage" + testEncodedHeader + testEncodedEnd,
+			[]bsr.ChunkType{bsr.ChunkHeader, bsr.ChunkEnd},
+			[]bsr.CorruptChunk{{Offset: 0, Length: int64(len("garbage"))}},
+		},
+		{
+			"truncated",
+			testEncodedHeader + testEncodedTest + testEncodedEnd[:10],
+			[]bsr.ChunkType{bsr.ChunkHeader, "TEST"},
+			[]bsr.CorruptChunk{{Offset: int64(len(testEncodedHeader + testEncodedTest)), Length: 10}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var corrupt []bsr.CorruptChunk
+			dec, err := bsr.NewChunkDecoder(ctx, bytes.NewBufferString(tc.encoded), bsr.WithLenientDecode(func(_ context.Context, c *bsr.CorruptChunk) {
+				assert.ErrorIs(t, c.Err, bsr.ErrChunkDecode)
+				corrupt = append(corrupt, bsr.CorruptChunk{Offset: c.Offset, Length: c.Length})
+			}))
+			require.NoError(t, err)
+
+			var got []bsr.ChunkType
+			for {
+				c, err := dec.Decode(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				got = append(got, c.GetType())
+			}
+			assert.Equal(t, tc.wantTypes, got)
+			assert.Equal(t, tc.wantCorrupt, corrupt)
+		})
+	}
+}
+
+func FuzzChunkDecoder(f *testing.F) {
+	f.Add([]byte(testEncodedHeader + testEncodedTest + testEncodedEnd))
+	f.Add([]byte(testEncodedHeader + testEncodedErr + testEncodedEnd))
+	f.Add([]byte(testEncodedHeader + testEncodedTest[:20]))
+	f.Add([]byte("\xff\xff\xff\xff" + testEncodedEnd))
+
+	f.Fuzz(func(t *testing.T, encoded []byte) {
+		ctx := context.Background()
+		dec, err := bsr.NewChunkDecoder(ctx, bytes.NewReader(encoded))
+		require.NoError(t, err)
+		for {
+			_, err := dec.Decode(ctx)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				require.ErrorIs(t, err, bsr.ErrChunkDecode)
+				break
+			}
+		}
+	})
+}
+
+func FuzzChunkDecoderLenient(f *testing.F) {
+	f.Add([]byte(testEncodedHeader + testEncodedTest + testEncodedEnd))
+	f.Add([]byte(testEncodedHeader + testEncodedErr + testEncodedEnd))
+	f.Add([]byte(testEncodedHeader + testEncodedTest[:20]))
+	f.Add([]byte("\xff\xff\xff\xff" + testEncodedEnd))
+
+	f.Fuzz(func(t *testing.T, encoded []byte) {
+		ctx := context.Background()
+		// Every byte is either part of a decoded chunk or reported as skipped,
+		// and decoding always reaches the end of the data.
+		var skipped int64
+		dec, err := bsr.NewChunkDecoder(ctx, bytes.NewReader(encoded), bsr.WithLenientDecode(func(_ context.Context, c *bsr.CorruptChunk) {
+			require.Positive(t, c.Length)
+			skipped += c.Length
+		}))
+		require.NoError(t, err)
+		for {
+			_, err := dec.Decode(ctx)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+		}
+		require.LessOrEqual(t, skipped, int64(len(encoded)))
+	})
+}
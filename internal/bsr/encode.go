@@ -42,16 +42,49 @@ var encodeCachePool = &sync.Pool{
 	},
 }
 
+// emptyChunkFuncs holds the EmptyChunkFunc registered for each protocol.
+var emptyChunkFuncs map[Protocol]EmptyChunkFunc
+
+// RegisterEmptyChunkFunc registers the EmptyChunkFunc used by the
+// ChunkEncoders of the given Protocol that are created with
+// WithSuppressEmptyChunks. A given Protocol can only have one EmptyChunkFunc
+// registered.
+func RegisterEmptyChunkFunc(p Protocol, fn EmptyChunkFunc) error {
+	const op = "bsr.RegisterEmptyChunkFunc"
+
+	if emptyChunkFuncs == nil {
+		emptyChunkFuncs = make(map[Protocol]EmptyChunkFunc)
+	}
+	if _, ok := emptyChunkFuncs[p]; ok {
+		return fmt.Errorf("%s: %s: %w", op, p, ErrAlreadyRegistered)
+	}
+	emptyChunkFuncs[p] = fn
+	return nil
+}
+
 // ChunkEncoder will encode a chunk and write it to the writer.
 // It will compress the chunk data based on the compression.
+// A ChunkEncoder is not safe for concurrent use.
 type ChunkEncoder struct {
 	w           storage.Writer
 	compression Compression
 	encryption  Encryption
+
+	// emptyChunkFn is looked up in the registered functions using the
+	// protocol of the first chunk if lookupEmptyChunkFn is true.
+	emptyChunkFn       EmptyChunkFunc
+	lookupEmptyChunkFn bool
+	idle               *IdleChunk
+	closed             bool
 }
 
-// NewChunkEncoder creates a ChunkEncoder.
-func NewChunkEncoder(ctx context.Context, w storage.Writer, c Compression, e Encryption) (*ChunkEncoder, error) {
+// NewChunkEncoder creates a ChunkEncoder. Every chunk is written unless the
+// suppression of empty chunks is enabled with one of the options, since
+// suppressed chunks are replaced with IdleChunks that readers of older
+// versions cannot decode. Supported options:
+//   - WithSuppressEmptyChunks
+//   - WithEmptyChunkFunc
+func NewChunkEncoder(ctx context.Context, w storage.Writer, c Compression, e Encryption, options ...Option) (*ChunkEncoder, error) {
 	const op = "bsr.NewChunkEncoder"
 
 	if w == nil {
@@ -66,15 +99,90 @@ func NewChunkEncoder(ctx context.Context, w storage.Writer, c Compression, e Enc
 		return nil, fmt.Errorf("%s: invalid encryption: %w", op, ErrInvalidParameter)
 	}
 
+	opts := getOpts(options...)
+
 	return &ChunkEncoder{
-		w:           w,
-		compression: c,
-		encryption:  e,
+		w:                  w,
+		compression:        c,
+		encryption:         e,
+		emptyChunkFn:       opts.withEmptyChunkFunc,
+		lookupEmptyChunkFn: opts.withSuppressEmptyChunks && opts.withEmptyChunkFunc == nil,
 	}, nil
 }
 
-// Encode serializes a Chunk and writes it with the encoder's writer.
-func (e ChunkEncoder) Encode(ctx context.Context, c Chunk) (int, error) {
+// Encode serializes a Chunk and writes it with the encoder's writer. If the
+// suppression of empty chunks is enabled, chunks reported as empty are
+// not written; instead, a single IdleChunk covering consecutive suppressed
+// chunks is written before the next chunk that is not suppressed. The
+// returned length is 0 for suppressed chunks and includes the IdleChunk
+// otherwise.
+func (e *ChunkEncoder) Encode(ctx context.Context, c Chunk) (int, error) {
+	if e.lookupEmptyChunkFn {
+		e.emptyChunkFn = emptyChunkFuncs[c.GetProtocol()]
+		e.lookupEmptyChunkFn = false
+	}
+	if e.emptyChunkFn == nil {
+		return e.encode(ctx, c)
+	}
+
+	switch c.GetType() {
+	case ChunkHeader, ChunkEnd, ChunkIdle:
+	default:
+		if e.emptyChunkFn(c) {
+			if e.idle == nil {
+				idle, err := NewIdle(ctx, c.GetProtocol(), c.GetDirection(), c.GetTimestamp(), c.GetTimestamp(), 1)
+				if err != nil {
+					return 0, err
+				}
+				e.idle = idle
+				return 0, nil
+			}
+			e.idle.Timestamp = c.GetTimestamp()
+			e.idle.Suppressed++
+			return 0, nil
+		}
+	}
+
+	wrote, err := e.flushIdle(ctx)
+	if err != nil {
+		return wrote, err
+	}
+	n, err := e.encode(ctx, c)
+	return wrote + n, err
+}
+
+// flushIdle writes the IdleChunk of the suppressed chunks, if any.
+func (e *ChunkEncoder) flushIdle(ctx context.Context) (int, error) {
+	if e.idle == nil {
+		return 0, nil
+	}
+	n, err := e.encode(ctx, e.idle)
+	if err != nil {
+		return n, err
+	}
+	e.idle = nil
+	return n, nil
+}
+
+// Close writes the IdleChunk of the chunks suppressed since the last chunk
+// that was written, so that the timing of a recording which stops without an
+// END chunk is kept, and closes the writer if it is an io.Closer. The writer
+// is already closed once an END chunk was encoded, and is not closed again.
+func (e *ChunkEncoder) Close(ctx context.Context) error {
+	if e.closed {
+		return nil
+	}
+	if _, err := e.flushIdle(ctx); err != nil {
+		return err
+	}
+	e.closed = true
+	if c, ok := e.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+func (e *ChunkEncoder) encode(ctx context.Context, c Chunk) (int, error) {
 	encode := encodeCachePool.Get().(*encodeCache)
 	encode.Reset()
 	defer encodeCachePool.Put(encode)
@@ -96,6 +204,8 @@ func (e ChunkEncoder) Encode(ctx context.Context, c Chunk) (int, error) {
 		switch e.compression {
 		case GzipCompression:
 			compressor = gzip.NewWriter(encode.compress)
+		case ZstdCompression:
+			compressor = newZstdCompressionWriter(encode.compress)
 		default:
 			compressor = newNullCompressionWriter(encode.compress)
 		}
@@ -130,6 +240,7 @@ func (e ChunkEncoder) Encode(ctx context.Context, c Chunk) (int, error) {
 	binary.BigEndian.PutUint32(encodedChunk[chunkBaseSize+length:], sum)
 
 	if c.GetType() == ChunkEnd {
+		e.closed = true
 		return e.w.WriteAndClose(encodedChunk)
 	}
 
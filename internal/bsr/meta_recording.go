@@ -16,8 +16,15 @@ import (
 // Slice fields are written to the meta file as id_k:v
 // Nested slice fields are written as parentId_parentKey_id_k:v
 type SessionRecordingMeta struct {
-	Id          string
-	Protocol    Protocol
+	Id       string
+	Protocol Protocol
+	// Compression is the compression used for the data in the chunks of the
+	// recording. The caller of NewSession must set it to the compression
+	// passed to the chunk encoders of the recording, since the meta file is
+	// not derived from the chunks. It is only written to the meta file when
+	// the chunks are compressed, so recordings made before compression was
+	// supported decode as NoCompression.
+	Compression Compression
 	connections map[string]bool
 }
 
@@ -30,6 +37,12 @@ func (s *SessionRecordingMeta) writeMeta(ctx context.Context, c *container) erro
 	if err != nil {
 		return err
 	}
+	if s.Compression != NoCompression {
+		_, err = c.WriteMeta(ctx, "compression", s.Compression.String())
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -60,6 +73,12 @@ func decodeSessionRecordingMeta(ctx context.Context, r io.Reader) (*SessionRecor
 			s.Id = v
 		case k == "protocol":
 			s.Protocol = Protocol(v)
+		case k == "compression":
+			c, err := ParseCompression(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			s.Compression = c
 
 		// connections
 		case k == "connection":
@@ -19,9 +19,13 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withSupportsMultiplex bool
-	withKeys              *kms.Keys
-	withSha256Sum         []byte
+	withSupportsMultiplex   bool
+	withKeys                *kms.Keys
+	withSha256Sum           []byte
+	withSuppressEmptyChunks bool
+	withEmptyChunkFunc      EmptyChunkFunc
+	withLenientDecode       bool
+	withCorruptChunkFunc    CorruptChunkFunc
 }
 
 func getDefaultOptions() options {
@@ -53,3 +57,40 @@ func WithSha256Sum(b []byte) Option {
 		o.withSha256Sum = b
 	}
 }
+
+// EmptyChunkFunc reports whether a chunk has no meaningful content for the
+// playback of a recording, such as a keepalive request, and can therefore be
+// suppressed.
+type EmptyChunkFunc func(Chunk) bool
+
+// WithSuppressEmptyChunks is used to suppress the chunks without meaningful
+// content when encoding, replacing runs of them with an IdleChunk that
+// preserves their timing. The chunks are reported as empty by the function
+// registered for their protocol with RegisterEmptyChunkFunc; if none is
+// registered, no chunk is suppressed.
+func WithSuppressEmptyChunks(b bool) Option {
+	return func(o *options) {
+		o.withSuppressEmptyChunks = b
+	}
+}
+
+// WithEmptyChunkFunc is used to suppress the chunks for which the given
+// function returns true when encoding, as with WithSuppressEmptyChunks but
+// using the given function instead of the one registered for the protocol of
+// the chunks. A nil function disables the suppression.
+func WithEmptyChunkFunc(fn EmptyChunkFunc) Option {
+	return func(o *options) {
+		o.withEmptyChunkFunc = fn
+	}
+}
+
+// WithLenientDecode is used to skip the chunks that cannot be decoded, such as
+// the corrupted or truncated chunks of a partially damaged recording, instead
+// of returning an error. The given function, which can be nil, is called with
+// a description of the data that was skipped.
+func WithLenientDecode(fn CorruptChunkFunc) Option {
+	return func(o *options) {
+		o.withLenientDecode = true
+		o.withCorruptChunkFunc = fn
+	}
+}
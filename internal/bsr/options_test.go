@@ -54,4 +54,11 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withSha256Sum = sum
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithSuppressEmptyChunks", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithSuppressEmptyChunks(true))
+		testOpts := getDefaultOptions()
+		testOpts.withSuppressEmptyChunks = true
+		assert.Equal(opts, testOpts)
+	})
 }
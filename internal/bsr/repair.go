@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package bsr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/bsr/internal/is"
+	"github.com/hashicorp/boundary/internal/storage"
+)
+
+// ChunkRepair describes the result of repairing a BSR data file with
+// RepairChunks.
+type ChunkRepair struct {
+	// Chunks is the number of chunks written to the repaired data file,
+	// including a synthesized END chunk.
+	Chunks int
+	// Corrupt describes the data that could not be decoded and was dropped.
+	Corrupt []*CorruptChunk
+	// EndSynthesized is set when the data file did not contain an END chunk
+	// and one was added.
+	EndSynthesized bool
+}
+
+// Partial reports whether the repaired data file is missing any of the data
+// that was recorded, either because some of the data could not be decoded or
+// because the recording stopped before it was finished.
+func (r *ChunkRepair) Partial() bool {
+	return r.EndSynthesized || len(r.Corrupt) > 0
+}
+
+// RepairChunks reads a BSR data file from r that may have been left
+// incomplete, for example when the worker writing it stopped, and writes the
+// chunks that can still be decoded to w as a new data file. Data that cannot
+// be decoded is skipped. If no END chunk is found, one is synthesized using
+// the protocol, direction and timestamp of the last chunk that was written.
+// Anything following an END chunk is ignored.
+//
+// An error is returned if r does not start with the magic string, or if no
+// HEADER chunk can be decoded before any other chunk, since the compression
+// and encryption of the data file cannot be known without it.
+func RepairChunks(ctx context.Context, r io.Reader, w storage.Writer) (*ChunkRepair, error) {
+	const op = "bsr.RepairChunks"
+
+	switch {
+	case is.Nil(r):
+		return nil, fmt.Errorf("%s: reader is nil: %w", op, ErrInvalidParameter)
+	case is.Nil(w):
+		return nil, fmt.Errorf("%s: writer is nil: %w", op, ErrInvalidParameter)
+	}
+
+	if err := ReadMagic(r); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	repair := &ChunkRepair{}
+	dec, err := NewChunkDecoder(ctx, r, WithLenientDecode(func(_ context.Context, c *CorruptChunk) {
+		repair.Corrupt = append(repair.Corrupt, c)
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	c, err := dec.Decode(ctx)
+	switch {
+	case errors.Is(err, io.EOF):
+		return nil, fmt.Errorf("%s: missing header chunk: %w", op, ErrChunkDecode)
+	case err != nil:
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	h, ok := c.(*HeaderChunk)
+	if !ok {
+		return nil, fmt.Errorf("%s: first chunk is %s, not a header chunk: %w", op, c.GetType(), ErrChunkDecode)
+	}
+
+	enc, err := NewChunkEncoder(ctx, w, h.Compression, h.Encryption)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := w.Write(Magic.Bytes()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for {
+		if _, err := enc.Encode(ctx, c); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		repair.Chunks++
+		if c.GetType() == ChunkEnd {
+			return repair, nil
+		}
+
+		next, err := dec.Decode(ctx)
+		switch {
+		case errors.Is(err, io.EOF):
+			end, err := NewEnd(ctx, c.GetProtocol(), c.GetDirection(), c.GetTimestamp())
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			if _, err := enc.Encode(ctx, end); err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			repair.Chunks++
+			repair.EndSynthesized = true
+			return repair, nil
+		case err != nil:
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		c = next
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package bsr_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/internal/fstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepairChunks(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name        string
+		encoded     string
+		wantTypes   []bsr.ChunkType
+		wantCorrupt int
+		wantEnd     bool
+		wantErr     error
+	}{
+		{
+			"complete",
+			string(bsr.Magic) + testEncodedHeader + testEncodedTest + testEncodedEnd,
+			[]bsr.ChunkType{bsr.ChunkHeader, "TEST", bsr.ChunkEnd},
+			0,
+			false,
+			nil,
+		},
+		{
+			"missing-end",
+			string(bsr.Magic) + testEncodedHeader + testEncodedTest,
+			[]bsr.ChunkType{bsr.ChunkHeader, "TEST", bsr.ChunkEnd},
+			0,
+			true,
+			nil,
+		},
+		{
+			"truncated",
+			string(bsr.Magic) + testEncodedHeader + testEncodedTest + testEncodedTest[:10],
+			[]bsr.ChunkType{bsr.ChunkHeader, "TEST", bsr.ChunkEnd},
+			1,
+			true,
+			nil,
+		},
+		{
+			"corrupt-chunk",
+			string(bsr.Magic) + testEncodedHeader + testEncodedErr + testEncodedTest + testEncodedEnd,
+			[]bsr.ChunkType{bsr.ChunkHeader, "TEST", bsr.ChunkEnd},
+			1,
+			false,
+			nil,
+		},
+		{
+			"data-after-end",
+			string(bsr.Magic) + testEncodedHeader + testEncodedEnd + testEncodedTest,
+			[]bsr.ChunkType{bsr.ChunkHeader, bsr.ChunkEnd},
+			0,
+			false,
+			nil,
+		},
+		{
+			"header-only",
+			string(bsr.Magic) + testEncodedHeader,
+			[]bsr.ChunkType{bsr.ChunkHeader, bsr.ChunkEnd},
+			0,
+			true,
+			nil,
+		},
+		{
+			"missing-magic",
+			testEncodedHeader + testEncodedTest,
+			nil,
+			0,
+			false,
+			bsr.ErrInvalidMagic,
+		},
+		{
+			"missing-header",
+			string(bsr.Magic) + testEncodedTest + testEncodedEnd,
+			nil,
+			0,
+			false,
+			bsr.ErrChunkDecode,
+		},
+		{
+			"empty",
+			string(bsr.Magic),
+			nil,
+			0,
+			false,
+			bsr.ErrChunkDecode,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf, err := fstest.NewTempBuffer()
+			require.NoError(t, err)
+
+			got, err := bsr.RepairChunks(ctx, bytes.NewBufferString(tc.encoded), buf)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(tc.wantTypes), got.Chunks)
+			assert.Len(t, got.Corrupt, tc.wantCorrupt)
+			assert.Equal(t, tc.wantEnd, got.EndSynthesized)
+			assert.Equal(t, tc.wantEnd || tc.wantCorrupt > 0, got.Partial())
+
+			// The repaired data file must decode without any errors.
+			scanner, err := bsr.NewChunkScanner(ctx, &buf.Buffer)
+			require.NoError(t, err)
+			var types []bsr.ChunkType
+			var last bsr.Chunk
+			for {
+				c, err := scanner.Scan(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				types = append(types, c.GetType())
+				if last != nil && c.GetType() == bsr.ChunkEnd && tc.wantEnd {
+					assert.Equal(t, last.GetProtocol(), c.GetProtocol())
+					assert.Equal(t, last.GetDirection(), c.GetDirection())
+					assert.Equal(t, last.GetTimestamp(), c.GetTimestamp())
+				}
+				last = c
+			}
+			assert.Equal(t, tc.wantTypes, types)
+		})
+	}
+
+	t.Run("nil-reader", func(t *testing.T) {
+		buf, err := fstest.NewTempBuffer()
+		require.NoError(t, err)
+		_, err = bsr.RepairChunks(ctx, nil, buf)
+		assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	})
+	t.Run("nil-writer", func(t *testing.T) {
+		_, err := bsr.RepairChunks(ctx, bytes.NewBufferString(string(bsr.Magic)), nil)
+		assert.ErrorIs(t, err, bsr.ErrInvalidParameter)
+	})
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ssh
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+)
+
+// KeepAliveRequestTypePrefix is the prefix of the request types OpenSSH and
+// compatible implementations use for keepalive requests, such as
+// "keepalive@openssh.com".
+const KeepAliveRequestTypePrefix = "keepalive@"
+
+// IsEmptyChunk reports whether c has no content that matters for the playback
+// of an SSH recording: data chunks without data and keepalive requests. It is
+// registered for the SSH protocol, so the chunk encoders of SSH recordings
+// created with bsr.WithSuppressEmptyChunks suppress such chunks in recordings
+// of idle sessions.
+func IsEmptyChunk(c bsr.Chunk) bool {
+	switch cc := c.(type) {
+	case *DataChunk:
+		return len(cc.Data) == 0
+	case *UnknownRequest:
+		return strings.HasPrefix(cc.GetRequestType(), KeepAliveRequestTypePrefix) && len(cc.GetData()) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ssh_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/internal/fstest"
+	"github.com/hashicorp/boundary/internal/bsr/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gssh "golang.org/x/crypto/ssh"
+)
+
+func TestIsEmptyChunk(t *testing.T) {
+	ctx := context.Background()
+	ts := bsr.NewTimestamp(time.Now())
+
+	mustData := func(data []byte) bsr.Chunk {
+		c, err := ssh.NewDataChunk(ctx, bsr.Inbound, ts, data)
+		require.NoError(t, err)
+		return c
+	}
+	mustUnknown := func(r *gssh.Request) bsr.Chunk {
+		c, err := ssh.NewUnknownRequest(ctx, bsr.Inbound, ts, r)
+		require.NoError(t, err)
+		return c
+	}
+	end, err := bsr.NewEnd(ctx, ssh.Protocol, bsr.Inbound, ts)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		c    bsr.Chunk
+		want bool
+	}{
+		{"empty-data", mustData(nil), true},
+		{"data", mustData([]byte("ls\n")), false},
+		{"keepalive", mustUnknown(&gssh.Request{Type: "keepalive@openssh.com", WantReply: true}), true},
+		{"keepalive-with-payload", mustUnknown(&gssh.Request{Type: "keepalive@openssh.com", Payload: []byte("x")}), false},
+		{"other-unknown", mustUnknown(&gssh.Request{Type: "hostkeys-00@openssh.com"}), false},
+		{"end", end, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ssh.IsEmptyChunk(tc.c))
+		})
+	}
+}
+
+func TestChunkEncoderSuppressesEmptyChunks(t *testing.T) {
+	ctx := context.Background()
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC)
+	at := func(offset time.Duration) *bsr.Timestamp {
+		return bsr.NewTimestamp(ts.Add(offset))
+	}
+
+	header, err := bsr.NewHeader(ctx, ssh.Protocol, bsr.Inbound, at(0), bsr.NoCompression, bsr.NoEncryption, "sess_123456789")
+	require.NoError(t, err)
+	ls, err := ssh.NewDataChunk(ctx, bsr.Inbound, at(time.Second), []byte("ls\n"))
+	require.NoError(t, err)
+	empty, err := ssh.NewDataChunk(ctx, bsr.Inbound, at(time.Minute), nil)
+	require.NoError(t, err)
+	keepalive, err := ssh.NewUnknownRequest(ctx, bsr.Inbound, at(2*time.Minute), &gssh.Request{Type: "keepalive@openssh.com", WantReply: true})
+	require.NoError(t, err)
+	end, err := bsr.NewEnd(ctx, ssh.Protocol, bsr.Inbound, at(time.Hour))
+	require.NoError(t, err)
+
+	// Chunks are only suppressed when enabled, in which case the function
+	// registered for the SSH protocol is used.
+	encoded := encodeSsh(t, []bsr.Chunk{header, ls, empty, keepalive, end})
+	assert.Equal(t, []bsr.ChunkType{bsr.ChunkHeader, ssh.DataChunkType, ssh.DataChunkType, ssh.UnknownReqChunkType, bsr.ChunkEnd}, chunkTypes(t, encoded))
+
+	suppressed := encodeSsh(t, []bsr.Chunk{header, ls, empty, keepalive, end}, bsr.WithSuppressEmptyChunks(true))
+	assert.Equal(t, []bsr.ChunkType{bsr.ChunkHeader, ssh.DataChunkType, bsr.ChunkIdle, bsr.ChunkEnd}, chunkTypes(t, suppressed))
+	idle := decodeSsh(t, suppressed)[2].(*bsr.IdleChunk)
+	assert.EqualValues(t, 2, idle.Suppressed)
+	assert.Equal(t, at(time.Minute), idle.Start)
+	assert.Equal(t, at(2*time.Minute), idle.Timestamp)
+}
+
+func TestChunkEncoderSuppressesEmptyChunksBeforeClose(t *testing.T) {
+	ctx := context.Background()
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC)
+	at := func(offset time.Duration) *bsr.Timestamp {
+		return bsr.NewTimestamp(ts.Add(offset))
+	}
+
+	header, err := bsr.NewHeader(ctx, ssh.Protocol, bsr.Inbound, at(0), bsr.NoCompression, bsr.NoEncryption, "sess_123456789")
+	require.NoError(t, err)
+	ls, err := ssh.NewDataChunk(ctx, bsr.Inbound, at(time.Second), []byte("ls\n"))
+	require.NoError(t, err)
+	keepalive, err := ssh.NewUnknownRequest(ctx, bsr.Inbound, at(time.Minute), &gssh.Request{Type: "keepalive@openssh.com", WantReply: true})
+	require.NoError(t, err)
+	empty, err := ssh.NewDataChunk(ctx, bsr.Inbound, at(2*time.Minute), nil)
+	require.NoError(t, err)
+
+	// The recording stops without an END chunk right after empty chunks, so
+	// they are only written as an IdleChunk when the encoder is closed.
+	encoded := encodeSsh(t, []bsr.Chunk{header, ls, keepalive, empty}, bsr.WithSuppressEmptyChunks(true))
+	got := decodeSsh(t, encoded)
+	require.Len(t, got, 3)
+	assert.Equal(t, []bsr.ChunkType{bsr.ChunkHeader, ssh.DataChunkType, bsr.ChunkIdle}, chunkTypes(t, encoded))
+	idle := got[2].(*bsr.IdleChunk)
+	assert.EqualValues(t, 2, idle.Suppressed)
+	assert.Equal(t, at(time.Minute), idle.Start)
+	assert.Equal(t, at(2*time.Minute), idle.Timestamp)
+}
+
+// encodeSsh encodes the chunks with a ChunkEncoder created with the given
+// options, closes it and returns the encoded bytes.
+func encodeSsh(t *testing.T, chunks []bsr.Chunk, opt ...bsr.Option) []byte {
+	t.Helper()
+	ctx := context.Background()
+	buf, err := fstest.NewTempBuffer()
+	require.NoError(t, err)
+	enc, err := bsr.NewChunkEncoder(ctx, buf, bsr.NoCompression, bsr.NoEncryption, opt...)
+	require.NoError(t, err)
+	for _, c := range chunks {
+		_, err := enc.Encode(ctx, c)
+		require.NoError(t, err)
+	}
+	require.NoError(t, enc.Close(ctx))
+	return buf.Bytes()
+}
+
+func decodeSsh(t *testing.T, encoded []byte) []bsr.Chunk {
+	t.Helper()
+	ctx := context.Background()
+	dec, err := bsr.NewChunkDecoder(ctx, bytes.NewBuffer(encoded))
+	require.NoError(t, err)
+	var chunks []bsr.Chunk
+	for {
+		c, err := dec.Decode(ctx)
+		if err == io.EOF {
+			return chunks
+		}
+		require.NoError(t, err)
+		chunks = append(chunks, c)
+	}
+}
+
+func chunkTypes(t *testing.T, encoded []byte) []bsr.ChunkType {
+	t.Helper()
+	var types []bsr.ChunkType
+	for _, c := range decodeSsh(t, encoded) {
+		types = append(types, c.GetType())
+	}
+	return types
+}
@@ -23,6 +23,10 @@ func init() {
 	if err := bsr.RegisterSummaryAllocFunc(Protocol, bsr.ConnectionContainer, bsr.AllocConnectionSummary); err != nil {
 		panic(err)
 	}
+
+	if err := bsr.RegisterEmptyChunkFunc(Protocol, IsEmptyChunk); err != nil {
+		panic(err)
+	}
 }
 
 // SessionProgram identifies the program running on this channel
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+const (
+	// serviceName is the name of the windows service running the cache.
+	serviceName        = "BoundaryCache"
+	serviceDisplayName = "Boundary Client Cache"
+	serviceDescription = "Caches Boundary resources for the boundary search command."
+	// launchdLabel is the label of the launchd agent running the cache.
+	launchdLabel = "com.hashicorp.boundary.cache"
+)
+
+var (
+	_ cli.Command             = (*ServiceCommand)(nil)
+	_ cli.CommandAutocomplete = (*ServiceCommand)(nil)
+	_ cli.Command             = (*ServiceInstallCommand)(nil)
+	_ cli.CommandAutocomplete = (*ServiceInstallCommand)(nil)
+	_ cli.Command             = (*ServiceUninstallCommand)(nil)
+	_ cli.CommandAutocomplete = (*ServiceUninstallCommand)(nil)
+)
+
+// serviceArgs are the arguments the service manager runs the boundary
+// executable with.
+var serviceArgs = []string{"cache", "start", "-service"}
+
+type ServiceCommand struct {
+	*base.Command
+}
+
+func (c *ServiceCommand) Synopsis() string {
+	return "Manage running the Boundary cache as a system service"
+}
+
+func (c *ServiceCommand) Help() string {
+	helpText := `
+Usage: boundary cache service [sub command] [options]
+
+  This command allows registering the Boundary cache with the service manager
+  of the OS, a launchd agent on macOS or a Windows service, so it is started at
+  login or boot and restarted if it crashes.
+
+  Install the cache service:
+
+      $ boundary cache service install
+
+  Uninstall the cache service:
+
+      $ boundary cache service uninstall
+
+  For a full list of examples, please see the documentation.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ServiceCommand) Flags() *base.FlagSets {
+	return c.FlagSet(base.FlagSetNone)
+}
+
+func (c *ServiceCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ServiceCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ServiceCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+type ServiceInstallCommand struct {
+	*base.Command
+
+	flagAccount  string
+	flagNoStart  bool
+	flagLogLevel string
+}
+
+func (c *ServiceInstallCommand) Synopsis() string {
+	return "Install the Boundary cache as a system service"
+}
+
+func (c *ServiceInstallCommand) Help() string {
+	helpText := `
+Usage: boundary cache service install [options]
+
+  Register the Boundary cache with the service manager of the OS and start it.
+
+  On macOS, the cache is installed as a launchd agent of the current user. On
+  Windows, it is installed as a service run as the account given with -account,
+  which defaults to the current user; the account's password is read from the
+  BOUNDARY_CACHE_SERVICE_PASSWORD environment variable, or prompted for.
+
+  The service is never run with administrative privileges. The cache rotates
+  its own log file, which is in the .boundary directory of the user's home.
+
+      $ boundary cache service install
+
+  For a full list of examples, please see the documentation.
+
+` + c.Flags().Help()
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ServiceInstallCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetNone)
+	f := set.NewFlagSet("Command Options")
+	f.StringVar(&base.StringVar{
+		Name:   "account",
+		Target: &c.flagAccount,
+		Usage:  `The account the Windows service is run as. Defaults to the current user. Ignored on macOS, where the cache is run as the current user.`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "log-level",
+		Target:     &c.flagLogLevel,
+		Completion: complete.PredictSet("trace", "debug", "info", "warn", "err"),
+		Usage:      `The log level the cache is run with.`,
+	})
+	f.BoolVar(&base.BoolVar{
+		Name:   "no-start",
+		Target: &c.flagNoStart,
+		Usage:  `Register the service without starting it.`,
+	})
+	return set
+}
+
+func (c *ServiceInstallCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ServiceInstallCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ServiceInstallCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	opts := serviceInstallOptions{
+		account: c.flagAccount,
+		start:   !c.flagNoStart,
+		args:    append([]string(nil), serviceArgs...),
+	}
+	if c.flagLogLevel != "" {
+		opts.args = append(opts.args, "-log-level", c.flagLogLevel)
+	}
+	if err := installService(c.Context, c.Command, opts); err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+	c.UI.Output("The cache service was installed.")
+	return base.CommandSuccess
+}
+
+type ServiceUninstallCommand struct {
+	*base.Command
+}
+
+func (c *ServiceUninstallCommand) Synopsis() string {
+	return "Uninstall the Boundary cache system service"
+}
+
+func (c *ServiceUninstallCommand) Help() string {
+	helpText := `
+Usage: boundary cache service uninstall
+
+  Stop the Boundary cache service and remove it from the service manager of
+  the OS:
+
+      $ boundary cache service uninstall
+
+  For a full list of examples, please see the documentation.
+
+` + c.Flags().Help()
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ServiceUninstallCommand) Flags() *base.FlagSets {
+	return c.FlagSet(base.FlagSetNone)
+}
+
+func (c *ServiceUninstallCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ServiceUninstallCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ServiceUninstallCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	if err := uninstallService(c.Context); err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+	c.UI.Output("The cache service was uninstalled.")
+	return base.CommandSuccess
+}
+
+// serviceInstallOptions are the settings the cache service is installed with.
+type serviceInstallOptions struct {
+	// account is the account a windows service is run as.
+	account string
+	// start reports whether the service is started once installed.
+	start bool
+	// args are the arguments the boundary executable is run with.
+	args []string
+}
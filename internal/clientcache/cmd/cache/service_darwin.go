@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build darwin
+// +build darwin
+
+package cache
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+// launchdAgentPath returns the path of the definition of the cache's launchd
+// agent for the current user.
+func launchdAgentPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, "Library", "LaunchAgents", launchdLabel+".plist"), nil
+}
+
+// launchdDomain returns the launchd domain of the current user's GUI session.
+func launchdDomain() string {
+	return fmt.Sprintf("gui/%d", os.Getuid())
+}
+
+func installService(ctx context.Context, baseCmd *base.Command, opts serviceInstallOptions) error {
+	if os.Geteuid() == 0 {
+		return stderrors.New("The cache service must be installed by the user it runs as, not root.")
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("Unable to find the boundary executable: %w.", err)
+	}
+	if exe, err = filepath.EvalSymlinks(exe); err != nil {
+		return fmt.Errorf("Unable to resolve the boundary executable: %w.", err)
+	}
+	plist, err := launchdPlist(exe, opts.args)
+	if err != nil {
+		return fmt.Errorf("Unable to generate the launchd agent definition: %w.", err)
+	}
+	agentPath, err := launchdAgentPath()
+	if err != nil {
+		return fmt.Errorf("Unable to find the launchd agent directory: %w.", err)
+	}
+	if _, err := os.Stat(agentPath); err == nil {
+		return fmt.Errorf("The cache service is already installed at %s.", agentPath)
+	}
+	if err := os.MkdirAll(filepath.Dir(agentPath), 0o755); err != nil {
+		return fmt.Errorf("Unable to create the launchd agent directory: %w.", err)
+	}
+	if err := os.WriteFile(agentPath, plist, 0o644); err != nil {
+		return fmt.Errorf("Unable to write the launchd agent definition: %w.", err)
+	}
+	if !opts.start {
+		return nil
+	}
+	// A cache started outside of launchd would hold the pid file, so the
+	// agent's cache would fail to start.
+	if err := stopDaemon(ctx, baseCmd); err != nil {
+		return fmt.Errorf("Unable to stop the running cache: %w.", err)
+	}
+	if out, err := exec.Command("launchctl", "bootstrap", launchdDomain(), agentPath).CombinedOutput(); err != nil {
+		return fmt.Errorf("Unable to load the launchd agent: %s: %w.", strings.TrimSpace(string(out)), err)
+	}
+	return nil
+}
+
+func uninstallService(_ context.Context) error {
+	agentPath, err := launchdAgentPath()
+	if err != nil {
+		return fmt.Errorf("Unable to find the launchd agent directory: %w.", err)
+	}
+	if _, err := os.Stat(agentPath); os.IsNotExist(err) {
+		return stderrors.New("The cache service is not installed.")
+	}
+	// Unloading fails if the agent is not loaded, which is fine.
+	_ = exec.Command("launchctl", "bootout", launchdDomain()+"/"+launchdLabel).Run()
+	if err := os.Remove(agentPath); err != nil {
+		return fmt.Errorf("Unable to remove the launchd agent definition: %w.", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"bytes"
+	"encoding/xml"
+	"text/template"
+)
+
+// launchdPlistTemplate is the definition of the launchd agent running the
+// cache. The agent is restarted if it exits unsuccessfully, but not when it's
+// stopped with the stop command. Its output is discarded since the cache
+// writes to its own rotated log file. It runs as a background process, which
+// launchd throttles in favor of interactive ones.
+var launchdPlistTemplate = template.Must(template.New("plist").Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>{{ .Label }}</string>
+	<key>ProgramArguments</key>
+	<array>
+{{- range .Args }}
+		<string>{{ . }}</string>
+{{- end }}
+	</array>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>KeepAlive</key>
+	<dict>
+		<key>SuccessfulExit</key>
+		<false/>
+	</dict>
+	<key>ThrottleInterval</key>
+	<integer>10</integer>
+	<key>ProcessType</key>
+	<string>Background</string>
+	<key>LowPriorityIO</key>
+	<true/>
+	<key>Umask</key>
+	<integer>63</integer>
+	<key>StandardOutPath</key>
+	<string>/dev/null</string>
+	<key>StandardErrorPath</key>
+	<string>/dev/null</string>
+</dict>
+</plist>
+`))
+
+// launchdPlist returns the definition of the launchd agent running the
+// executable at exe with args.
+func launchdPlist(exe string, args []string) ([]byte, error) {
+	escape := func(s string) string {
+		var b bytes.Buffer
+		_ = xml.EscapeText(&b, []byte(s))
+		return b.String()
+	}
+	data := struct {
+		Label string
+		Args  []string
+	}{
+		Label: escape(launchdLabel),
+		Args:  []string{escape(exe)},
+	}
+	for _, a := range args {
+		data.Args = append(data.Args, escape(a))
+	}
+	var b bytes.Buffer
+	if err := launchdPlistTemplate.Execute(&b, data); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
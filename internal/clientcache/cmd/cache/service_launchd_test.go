@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLaunchdPlist(t *testing.T) {
+	got, err := launchdPlist("/Applications/Boundary & Co/boundary", serviceArgs)
+	require.NoError(t, err)
+	assert.Contains(t, string(got), "<string>"+launchdLabel+"</string>")
+	assert.Contains(t, string(got), "<string>/Applications/Boundary &amp; Co/boundary</string>")
+	for _, a := range serviceArgs {
+		assert.Contains(t, string(got), "<string>"+a+"</string>")
+	}
+
+	// The plist must be well formed, and its program arguments must decode
+	// back to the executable and arguments.
+	dec := xml.NewDecoder(bytes.NewReader(got))
+	var strs []string
+	var inString bool
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			inString = tok.Name.Local == "string"
+		case xml.EndElement:
+			inString = false
+		case xml.CharData:
+			if inString {
+				strs = append(strs, string(tok))
+			}
+		}
+	}
+	assert.Subset(t, strs, append([]string{launchdLabel, "/Applications/Boundary & Co/boundary"}, serviceArgs...))
+}
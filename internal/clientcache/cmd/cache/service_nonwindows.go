@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !windows
+// +build !windows
+
+package cache
+
+import "context"
+
+// runService runs the cache with fn. Service managers other than the windows
+// one run the cache as a regular process, so this only calls fn.
+func runService(ctx context.Context, fn func(context.Context) int) int {
+	return fn(ctx)
+}
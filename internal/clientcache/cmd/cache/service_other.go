@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !darwin && !windows
+// +build !darwin,!windows
+
+package cache
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+var errServiceUnsupported = stderrors.New("Installing the cache as a service is only supported on macOS and Windows.")
+
+func installService(context.Context, *base.Command, serviceInstallOptions) error {
+	return errServiceUnsupported
+}
+
+func uninstallService(context.Context) error {
+	return errServiceUnsupported
+}
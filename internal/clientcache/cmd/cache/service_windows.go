@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build windows
+// +build windows
+
+package cache
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+// servicePasswordEnvName is the environment variable the password of the
+// account the service is run as is read from.
+const servicePasswordEnvName = "BOUNDARY_CACHE_SERVICE_PASSWORD"
+
+// privilegedAccounts are the accounts the cache service refuses to run as,
+// since the cache doesn't need their privileges.
+var privilegedAccounts = []string{
+	"localsystem",
+	`nt authority\system`,
+	`.\localsystem`,
+}
+
+func installService(ctx context.Context, baseCmd *base.Command, opts serviceInstallOptions) error {
+	account := opts.account
+	if account == "" {
+		u, err := user.Current()
+		if err != nil {
+			return fmt.Errorf("Unable to find the current user: %w.", err)
+		}
+		account = u.Username
+	}
+	for _, a := range privilegedAccounts {
+		if strings.EqualFold(account, a) {
+			return fmt.Errorf("The cache service can't be run as the privileged %q account.", account)
+		}
+	}
+	// Group managed service accounts, whose names end with $, have no
+	// password.
+	var password string
+	if !strings.HasSuffix(account, "$") {
+		password = os.Getenv(servicePasswordEnvName)
+		if password == "" {
+			var err error
+			password, err = baseCmd.UI.AskSecret(fmt.Sprintf("Password of %s:", account))
+			if err != nil {
+				return fmt.Errorf("Unable to read the password of %s: %w.", account, err)
+			}
+		}
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("Unable to find the boundary executable: %w.", err)
+	}
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("Unable to connect to the service control manager, which requires running as an administrator: %w.", err)
+	}
+	defer m.Disconnect()
+
+	if s, err := m.OpenService(serviceName); err == nil {
+		s.Close()
+		return fmt.Errorf("The %s service is already installed.", serviceName)
+	}
+	s, err := m.CreateService(serviceName, exe, mgr.Config{
+		DisplayName:      serviceDisplayName,
+		Description:      serviceDescription,
+		StartType:        mgr.StartAutomatic,
+		DelayedAutoStart: true,
+		ServiceStartName: account,
+		Password:         password,
+	}, opts.args...)
+	if err != nil {
+		return fmt.Errorf("Unable to create the %s service: %w.", serviceName, err)
+	}
+	defer s.Close()
+
+	// Restart the cache if it crashes, backing off, and reset the failure
+	// count after a day without failures.
+	if err := s.SetRecoveryActions([]mgr.RecoveryAction{
+		{Type: mgr.ServiceRestart, Delay: 5 * time.Second},
+		{Type: mgr.ServiceRestart, Delay: 30 * time.Second},
+		{Type: mgr.ServiceRestart, Delay: 2 * time.Minute},
+	}, uint32((24 * time.Hour).Seconds())); err != nil {
+		return fmt.Errorf("Unable to set the recovery actions of the %s service: %w.", serviceName, err)
+	}
+	if !opts.start {
+		return nil
+	}
+	if err := stopDaemon(ctx, baseCmd); err != nil {
+		return fmt.Errorf("Unable to stop the running cache: %w.", err)
+	}
+	if err := s.Start(); err != nil {
+		return fmt.Errorf("Unable to start the %s service: %w.", serviceName, err)
+	}
+	return nil
+}
+
+func uninstallService(_ context.Context) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("Unable to connect to the service control manager, which requires running as an administrator: %w.", err)
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(serviceName)
+	if err != nil {
+		if stderrors.Is(err, windows.ERROR_SERVICE_DOES_NOT_EXIST) {
+			return stderrors.New("The cache service is not installed.")
+		}
+		return fmt.Errorf("Unable to open the %s service: %w.", serviceName, err)
+	}
+	defer s.Close()
+
+	// Stopping fails if the service is not running, which is fine.
+	_, _ = s.Control(svc.Stop)
+	if err := s.Delete(); err != nil {
+		return fmt.Errorf("Unable to delete the %s service: %w.", serviceName, err)
+	}
+	return nil
+}
+
+// runService runs the cache with fn, as a windows service if the process was
+// started by the service control manager.
+func runService(ctx context.Context, fn func(context.Context) int) int {
+	isService, err := svc.IsWindowsService()
+	if err != nil || !isService {
+		return fn(ctx)
+	}
+	h := &serviceHandler{ctx: ctx, run: fn}
+	if err := svc.Run(serviceName, h); err != nil {
+		return base.CommandCliError
+	}
+	return h.code
+}
+
+// serviceHandler runs the cache as a windows service, stopping it when the
+// service control manager requests it.
+type serviceHandler struct {
+	ctx  context.Context
+	run  func(context.Context) int
+	code int
+}
+
+func (h *serviceHandler) Execute(_ []string, requests <-chan svc.ChangeRequest, changes chan<- svc.Status) (bool, uint32) {
+	const accepts = svc.AcceptStop | svc.AcceptShutdown
+	ctx, cancel := context.WithCancel(h.ctx)
+	defer cancel()
+
+	changes <- svc.Status{State: svc.StartPending}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.code = h.run(ctx)
+	}()
+	changes <- svc.Status{State: svc.Running, Accepts: accepts}
+
+	for {
+		select {
+		case <-done:
+			// A non-zero exit code makes the service control manager apply
+			// the recovery actions.
+			return false, uint32(h.code)
+		case r := <-requests:
+			switch r.Cmd {
+			case svc.Interrogate:
+				changes <- r.CurrentStatus
+			case svc.Stop, svc.Shutdown:
+				changes <- svc.Status{State: svc.StopPending}
+				cancel()
+				<-done
+				return false, 0
+			}
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/internal/clientcache/internal/daemon"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-hclog"
 	"github.com/mitchellh/cli"
 	"github.com/posener/complete"
 	"gopkg.in/natefinch/lumberjack.v2"
@@ -50,6 +51,8 @@ type StartCommand struct {
 	flagLogFormat               string
 	flagStoreDebug              bool
 	flagBackground              bool
+	flagSupervise               bool
+	flagService                 bool
 	flagForceResetSchema        bool
 }
 
@@ -134,6 +137,19 @@ func (c *StartCommand) Flags() *base.FlagSets {
 		Default: false,
 		Usage:   `Run the cache daemon in the background`,
 	})
+	f.BoolVar(&base.BoolVar{
+		Name:    "supervise",
+		Target:  &c.flagSupervise,
+		Default: true,
+		Usage:   `When running in the background, run the cache under a supervisor which restarts it if it crashes or fails its health checks.`,
+	})
+	f.BoolVar(&base.BoolVar{
+		Name:    "service",
+		Target:  &c.flagService,
+		Default: false,
+		Usage:   `Run the cache under a service manager, such as launchd or the Windows service control manager, which supervises it.`,
+		Hidden:  true,
+	})
 	f.BoolVar(&base.BoolVar{
 		Name:    "force-reset-schema",
 		Target:  &c.flagForceResetSchema,
@@ -154,16 +170,25 @@ func (c *StartCommand) AutocompleteFlags() complete.Flags {
 }
 
 func (c *StartCommand) Run(args []string) int {
-	ctx, cancel := context.WithCancel(c.Context)
-	defer cancel()
-
-	var err error
 	f := c.Flags()
 	if err := f.Parse(args); err != nil {
 		c.PrintCliError(err)
 		return base.CommandUserError
 	}
 
+	if c.flagService {
+		return runService(c.Context, c.start)
+	}
+	return c.start(c.Context)
+}
+
+// start runs the cache until ctx is done, or starts it in the background and
+// returns if requested.
+func (c *StartCommand) start(ctx context.Context) int {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var err error
 	dotDir, err := DefaultDotDirectory(ctx)
 	if err != nil {
 		return base.CommandCliError
@@ -187,6 +212,10 @@ func (c *StartCommand) Run(args []string) int {
 		return base.CommandSuccess
 	}
 
+	if c.supervising() {
+		return c.supervise(ctx, dotDir)
+	}
+
 	// TODO: print something out for the spawner to consume in case they can easily
 	// report if the daemon started or not.
 
@@ -214,7 +243,9 @@ func (c *StartCommand) Run(args []string) int {
 		LogWriter:               io.MultiWriter(writers...),
 		LogFileName:             logFileName,
 		DotDirectory:            dotDir,
-		RunningInBackground:     os.Getenv(backgroundEnvName) == backgroundEnvVal,
+		RunningInBackground:     os.Getenv(backgroundEnvName) == backgroundEnvVal || c.flagService,
+		Supervised:              isSupervised(),
+		SupervisorRestarts:      supervisorRestarts(),
 		ForceResetSchema:        c.flagForceResetSchema,
 	}
 
@@ -264,8 +295,14 @@ func DefaultDotDirectory(ctx context.Context) (string, error) {
 // are saved as backup. When a new log file is rotated and there is already 3
 // backups created, the oldest one is deleted.
 func logFile(ctx context.Context, dotDir string, maxSizeMb int) (io.WriteCloser, string, error) {
-	const op = "cache.logFile"
-	logFilePath := filepath.Join(dotDir, logFileName)
+	return rotatedLogFile(ctx, dotDir, logFileName, maxSizeMb)
+}
+
+// rotatedLogFile returns the log file with the given name in the dot
+// directory, rotated as described in logFile.
+func rotatedLogFile(ctx context.Context, dotDir, fileName string, maxSizeMb int) (io.WriteCloser, string, error) {
+	const op = "cache.rotatedLogFile"
+	logFilePath := filepath.Join(dotDir, fileName)
 	{
 		// Ensure the file is created with the desired permissions.
 		logFile, err := os.OpenFile(logFilePath, os.O_CREATE|os.O_WRONLY, 0o600)
@@ -288,6 +325,10 @@ func (c *StartCommand) makeBackground(ctx context.Context, dotDir string) (bool,
 	const op = "cache.makeBackground"
 
 	writers := []io.Writer{}
+	if isSupervised() {
+		// The supervisor running this process owns the pid file.
+		return true, writers, noopPidCleanup, nil
+	}
 	pidPath := filepath.Join(dotDir, pidFileName)
 	if running, err := pidFileInUse(ctx, pidPath); running != nil {
 		return false, writers, noopPidCleanup, stderrors.New("The cache is already running.")
@@ -295,13 +336,14 @@ func (c *StartCommand) makeBackground(ctx context.Context, dotDir string) (bool,
 		return false, writers, noopPidCleanup, fmt.Errorf("Error when checking if the cache pid is in use: %w.", err)
 	}
 
-	if !c.flagBackground && os.Getenv(backgroundEnvName) != backgroundEnvVal {
+	if !c.flagBackground && !c.flagService && os.Getenv(backgroundEnvName) != backgroundEnvVal {
 		writers = append(writers, os.Stderr)
 	}
 
-	if !c.flagBackground || os.Getenv(backgroundEnvName) == backgroundEnvVal {
-		// We are either already running in the background or background was
-		// not requested. Write the pid file and continue.
+	if !c.flagBackground || c.flagService || os.Getenv(backgroundEnvName) == backgroundEnvVal {
+		// We are either already running in the background, run by a service
+		// manager, or background was not requested. Write the pid file and
+		// continue.
 		cleanup, err := writePidFile(ctx, pidPath)
 		if err != nil {
 			return false, writers, noopPidCleanup, errors.Wrap(ctx, err, op)
@@ -316,6 +358,20 @@ func (c *StartCommand) makeBackground(ctx context.Context, dotDir string) (bool,
 
 	env := os.Environ()
 	env = append(env, fmt.Sprintf("%s=%s", backgroundEnvName, backgroundEnvVal))
+	cmd := exec.Command(absPath, c.startArgs()...)
+	cmd.Env = env
+	if err = cmd.Start(); err != nil {
+		return false, writers, noopPidCleanup, errors.Wrap(ctx, err, op)
+	}
+
+	// TODO: Read the output from the child process for a brief time
+	// to see if we can identify any errors that might arise.
+	return false, writers, noopPidCleanup, nil
+}
+
+// startArgs returns the arguments used to start the cache in a subprocess
+// with the same settings as this command.
+func (c *StartCommand) startArgs() []string {
 	args := []string{"cache", "start"}
 	args = append(args, "-refresh-interval", c.flagRefreshInterval.String())
 	args = append(args, "-max-search-staleness", c.flagMaxSearchStaleness.String())
@@ -330,15 +386,43 @@ func (c *StartCommand) makeBackground(ctx context.Context, dotDir string) (bool,
 	if c.flagDatabaseUrl != "" {
 		args = append(args, "-database-url", c.flagDatabaseUrl)
 	}
-	cmd := exec.Command(absPath, args...)
-	cmd.Env = env
-	if err = cmd.Start(); err != nil {
-		return false, writers, noopPidCleanup, errors.Wrap(ctx, err, op)
+	if !c.flagSupervise {
+		args = append(args, "-supervise=false")
 	}
+	return args
+}
 
-	// TODO: Read the output from the child process for a brief time
-	// to see if we can identify any errors that might arise.
-	return false, writers, noopPidCleanup, nil
+// supervising reports whether this process should supervise the cache rather
+// than run it, which is the case for the background process started by
+// -background, unless supervision is disabled.
+func (c *StartCommand) supervising() bool {
+	return c.flagSupervise && os.Getenv(backgroundEnvName) == backgroundEnvVal && !isSupervised()
+}
+
+// supervise runs the cache in a subprocess, restarting it when it crashes or
+// fails its health checks, until ctx is done.
+func (c *StartCommand) supervise(ctx context.Context, dotDir string) int {
+	lf, _, err := rotatedLogFile(ctx, dotDir, supervisorLogFileName, 1)
+	if err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+	defer lf.Close()
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:   "cache-supervisor",
+		Output: lf,
+		Level:  hclog.Info,
+	})
+	if err := newSupervisor(logger, dotDir, c.startArgs()).run(ctx); err != nil {
+		c.PrintCliError(err)
+		return base.CommandCliError
+	}
+	return base.CommandSuccess
+}
+
+// isSupervised reports whether this process is the cache run by a supervisor.
+func isSupervised() bool {
+	return os.Getenv(supervisedEnvName) == supervisedEnvVal
 }
 
 type pidCleanup func() error
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/boundary/internal/clientcache/internal/daemon"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/version"
 	"github.com/mitchellh/cli"
 	"github.com/posener/complete"
 	"golang.org/x/text/cases"
@@ -97,10 +98,29 @@ func (c *StatusCommand) Run(args []string) int {
 		}
 	default:
 		c.UI.Output(printStatusTable(result))
+		if msg := versionMismatch(result.Version, version.Get()); msg != "" {
+			c.UI.Warn(msg)
+		}
 	}
 	return base.CommandSuccess
 }
 
+// versionMismatch returns a warning if the version of the cache differs from
+// the version of the CLI, or an empty string if they match or the cache's
+// version is unknown.
+func versionMismatch(cacheVersion string, cliVersion *version.Info) string {
+	cv := version.FromVersionString(cacheVersion)
+	if cv == nil || cv.Semver() == nil || cliVersion.Semver() == nil {
+		return ""
+	}
+	if cv.Semver().Equal(cliVersion.Semver()) {
+		return ""
+	}
+	return fmt.Sprintf("The cache version (%s) does not match the CLI version (%s). "+
+		"Restart the cache with \"boundary cache stop\" followed by \"boundary cache start -background\" to run the CLI's version.",
+		cv.VersionNumber(), cliVersion.VersionNumber())
+}
+
 func (c *StatusCommand) Status(ctx context.Context) (*api.Response, *daemon.StatusResult, *api.Error, error) {
 	dotPath, err := DefaultDotDirectory(ctx)
 	if err != nil {
@@ -158,6 +178,10 @@ func printStatusTable(status *daemon.StatusResult) string {
 	if status.Uptime > 0 {
 		nonAttributeMap["Uptime"] = status.Uptime.Round(time.Second)
 	}
+	if status.Supervised {
+		nonAttributeMap["Supervised"] = status.Supervised
+		nonAttributeMap["Supervisor Restarts"] = status.SupervisorRestarts
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
@@ -185,6 +209,9 @@ func printUsersTable(us []daemon.UserStatus) []string {
 			"AuthToken Count": len(u.AuthTokens),
 			"Search Support":  u.BoundaryInstance.CacheSupport,
 		}
+		if u.SyncLag > 0 {
+			nonAttributeMap["Sync Lag"] = u.SyncLag.Round(time.Second)
+		}
 		if u.BoundaryInstance.LastSupportCheck > 0 {
 			nonAttributeMap["Since Search Support Check"] = u.BoundaryInstance.LastSupportCheck.Round(time.Second)
 		}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionMismatch(t *testing.T) {
+	cli := version.FromVersionString("0.18.0")
+	assert.Empty(t, versionMismatch("", cli))
+	assert.Empty(t, versionMismatch("Boundary v0.18.0", cli))
+	assert.Contains(t, versionMismatch("Boundary v0.17.2", cli), "The cache version (0.17.2) does not match the CLI version (0.18.0)")
+	assert.Contains(t, versionMismatch("Boundary v0.19.0", cli), "does not match")
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// Mark of process as being the cache run by a supervisor, and the number
+	// of times the supervisor restarted it.
+	supervisedEnvName = "_BOUNDARY_CACHE_SUPERVISED"
+	supervisedEnvVal  = "1"
+	restartsEnvName   = "_BOUNDARY_CACHE_RESTARTS"
+
+	supervisorLogFileName = "cache-supervisor.log"
+
+	defaultHealthCheckInterval = 30 * time.Second
+	defaultHealthCheckTimeout  = 5 * time.Second
+	// The number of consecutive failed health checks after which the cache
+	// is restarted.
+	defaultHealthCheckFailures = 3
+
+	minRestartBackoff = time.Second
+	maxRestartBackoff = time.Minute
+	// How long the cache must run for a crash to no longer be considered part
+	// of a crash loop, resetting the restart backoff.
+	stableRunDuration = 5 * time.Minute
+
+	// How long the cache is given to shut down when stopped before it is
+	// killed.
+	stopGracePeriod = 10 * time.Second
+)
+
+// errUnhealthy is returned when the cache was stopped for failing its health
+// checks.
+var errUnhealthy = stderrors.New("cache failed its health checks")
+
+// supervisedProcess is a cache process started by a supervisor.
+type supervisedProcess interface {
+	// wait blocks until the process exits, returning an error if it did not
+	// exit successfully.
+	wait() error
+	// stop asks the process to shut down, killing it if it doesn't in time.
+	stop() error
+}
+
+// supervisor runs the cache as a subprocess, restarting it when it crashes or
+// fails its health checks, until its context is done or the cache exits
+// successfully, as it does when stopped with the stop command.
+type supervisor struct {
+	logger hclog.Logger
+
+	healthCheckInterval time.Duration
+	healthCheckFailures int
+
+	// start starts the cache, passing it the number of previous restarts.
+	start func(ctx context.Context, restarts int) (supervisedProcess, error)
+	// check returns an error if the cache is not healthy.
+	check func(ctx context.Context) error
+}
+
+// newSupervisor returns a supervisor running the current executable with
+// args, and checking its health through the status endpoint of the cache
+// using the dot directory.
+func newSupervisor(logger hclog.Logger, dotDir string, args []string) *supervisor {
+	return &supervisor{
+		logger:              logger,
+		healthCheckInterval: defaultHealthCheckInterval,
+		healthCheckFailures: defaultHealthCheckFailures,
+		start: func(ctx context.Context, restarts int) (supervisedProcess, error) {
+			return startSupervisedProcess(ctx, args, restarts)
+		},
+		check: func(ctx context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx, defaultHealthCheckTimeout)
+			defer cancel()
+			_, _, apiErr, err := status(ctx, dotDir)
+			switch {
+			case err != nil:
+				return err
+			case apiErr != nil:
+				return apiErr
+			}
+			return nil
+		},
+	}
+}
+
+// run supervises the cache until ctx is done, in which case the cache is
+// stopped, or the cache exits successfully.
+func (s *supervisor) run(ctx context.Context) error {
+	backoff := minRestartBackoff
+	for restarts := 0; ; restarts++ {
+		started := time.Now()
+		err := s.runOnce(ctx, restarts)
+		switch {
+		case ctx.Err() != nil:
+			s.logger.Info("supervisor shutting down")
+			return nil
+		case err == nil:
+			s.logger.Info("cache exited, supervisor shutting down")
+			return nil
+		}
+
+		if time.Since(started) >= stableRunDuration {
+			backoff = minRestartBackoff
+		}
+		s.logger.Error("cache stopped unexpectedly, restarting", "error", err, "restarts", restarts, "backoff", backoff)
+		t := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			s.logger.Info("supervisor shutting down")
+			return nil
+		case <-t.C:
+		}
+		backoff = min(2*backoff, maxRestartBackoff)
+	}
+}
+
+// runOnce starts the cache and waits for it to exit, stopping it if ctx is
+// done or it fails too many consecutive health checks.
+func (s *supervisor) runOnce(ctx context.Context, restarts int) error {
+	const op = "cache.(supervisor).runOnce"
+	p, err := s.start(ctx, restarts)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to start cache"))
+	}
+	s.logger.Info("cache started", "restarts", restarts)
+
+	exited := make(chan error, 1)
+	go func() { exited <- p.wait() }()
+
+	ticker := time.NewTicker(s.healthCheckInterval)
+	defer ticker.Stop()
+	var failures int
+	for {
+		select {
+		case err := <-exited:
+			return err
+		case <-ctx.Done():
+			if err := p.stop(); err != nil {
+				s.logger.Error("error stopping cache", "error", err)
+			}
+			<-exited
+			return nil
+		case <-ticker.C:
+			if err := s.check(ctx); err != nil {
+				failures++
+				s.logger.Warn("cache health check failed", "error", err, "consecutive_failures", failures)
+				if failures < s.healthCheckFailures {
+					continue
+				}
+				if err := p.stop(); err != nil {
+					s.logger.Error("error stopping unhealthy cache", "error", err)
+				}
+				<-exited
+				return errUnhealthy
+			}
+			failures = 0
+		}
+	}
+}
+
+type execProcess struct {
+	cmd *exec.Cmd
+	// exited is closed once the process has been waited for.
+	exited chan struct{}
+}
+
+// startSupervisedProcess starts the current executable with args, marked as
+// run by a supervisor.
+func startSupervisedProcess(ctx context.Context, args []string, restarts int) (*execProcess, error) {
+	const op = "cache.startSupervisedProcess"
+	absPath, err := os.Executable()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cmd := exec.Command(absPath, args...)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("%s=%s", supervisedEnvName, supervisedEnvVal),
+		fmt.Sprintf("%s=%d", restartsEnvName, restarts),
+	)
+	if err := cmd.Start(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &execProcess{cmd: cmd, exited: make(chan struct{})}, nil
+}
+
+func (p *execProcess) wait() error {
+	defer close(p.exited)
+	return p.cmd.Wait()
+}
+
+func (p *execProcess) stop() error {
+	if err := terminate(p.cmd.Process); err != nil {
+		return p.cmd.Process.Kill()
+	}
+	go func() {
+		t := time.NewTimer(stopGracePeriod)
+		defer t.Stop()
+		select {
+		case <-p.exited:
+		case <-t.C:
+			_ = p.cmd.Process.Kill()
+		}
+	}()
+	return nil
+}
+
+// supervisorRestarts returns the number of times the supervisor of this
+// process restarted the cache.
+func supervisorRestarts() int {
+	n, err := strconv.Atoi(os.Getenv(restartsEnvName))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
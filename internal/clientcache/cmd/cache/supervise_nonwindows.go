@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !windows
+// +build !windows
+
+package cache
+
+import (
+	"os"
+	"syscall"
+)
+
+// terminate asks the process to shut down gracefully.
+func terminate(p *os.Process) error {
+	return p.Signal(syscall.SIGTERM)
+}
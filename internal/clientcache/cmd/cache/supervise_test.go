@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProcess is a supervisedProcess exiting with the error sent on exit, or
+// nil once stopped.
+type fakeProcess struct {
+	exit    chan error
+	stopped chan struct{}
+	once    sync.Once
+}
+
+func newFakeProcess() *fakeProcess {
+	return &fakeProcess{exit: make(chan error, 1), stopped: make(chan struct{})}
+}
+
+func (p *fakeProcess) wait() error {
+	select {
+	case err := <-p.exit:
+		return err
+	case <-p.stopped:
+		return nil
+	}
+}
+
+func (p *fakeProcess) stop() error {
+	p.once.Do(func() { close(p.stopped) })
+	return nil
+}
+
+type fakeStarter struct {
+	mu       sync.Mutex
+	procs    []*fakeProcess
+	restarts []int
+	started  chan *fakeProcess
+}
+
+func (f *fakeStarter) start(_ context.Context, restarts int) (supervisedProcess, error) {
+	p := newFakeProcess()
+	f.mu.Lock()
+	f.procs = append(f.procs, p)
+	f.restarts = append(f.restarts, restarts)
+	f.mu.Unlock()
+	f.started <- p
+	return p, nil
+}
+
+func testSupervisor(f *fakeStarter, check func(context.Context) error) *supervisor {
+	if check == nil {
+		check = func(context.Context) error { return nil }
+	}
+	return &supervisor{
+		logger:              hclog.NewNullLogger(),
+		healthCheckInterval: time.Millisecond,
+		healthCheckFailures: 2,
+		start:               f.start,
+		check:               check,
+	}
+}
+
+func TestSupervisor_restartsOnCrash(t *testing.T) {
+	ctx := context.Background()
+	f := &fakeStarter{started: make(chan *fakeProcess, 10)}
+	s := testSupervisor(f, nil)
+
+	done := make(chan error, 1)
+	go func() { done <- s.run(ctx) }()
+
+	p := <-f.started
+	p.exit <- stderrors.New("exit status 2")
+	p = <-f.started
+	// A successful exit, as caused by the stop command, ends supervision.
+	p.exit <- nil
+	require.NoError(t, <-done)
+	assert.Equal(t, []int{0, 1}, f.restarts)
+}
+
+func TestSupervisor_restartsWhenUnhealthy(t *testing.T) {
+	ctx := context.Background()
+	f := &fakeStarter{started: make(chan *fakeProcess, 10)}
+	var mu sync.Mutex
+	healthy := false
+	s := testSupervisor(f, func(context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if !healthy {
+			return stderrors.New("connection refused")
+		}
+		return nil
+	})
+
+	done := make(chan error, 1)
+	go func() { done <- s.run(ctx) }()
+
+	first := <-f.started
+	second := <-f.started
+	select {
+	case <-first.stopped:
+	default:
+		t.Fatal("unhealthy cache was not stopped")
+	}
+	mu.Lock()
+	healthy = true
+	mu.Unlock()
+	second.exit <- nil
+	require.NoError(t, <-done)
+}
+
+func TestSupervisor_stopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &fakeStarter{started: make(chan *fakeProcess, 10)}
+	s := testSupervisor(f, nil)
+
+	done := make(chan error, 1)
+	go func() { done <- s.run(ctx) }()
+
+	p := <-f.started
+	cancel()
+	require.NoError(t, <-done)
+	select {
+	case <-p.stopped:
+	default:
+		t.Fatal("cache was not stopped")
+	}
+	assert.Len(t, f.procs, 1)
+}
+
+func TestSupervisorRestarts(t *testing.T) {
+	t.Setenv(restartsEnvName, "")
+	assert.Equal(t, 0, supervisorRestarts())
+	t.Setenv(restartsEnvName, "3")
+	assert.Equal(t, 3, supervisorRestarts())
+	t.Setenv(restartsEnvName, "-1")
+	assert.Equal(t, 0, supervisorRestarts())
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build windows
+// +build windows
+
+package cache
+
+import (
+	"context"
+	"os"
+)
+
+// terminate asks the process to shut down gracefully through the stop
+// endpoint of the cache, since windows processes can't be sent signals.
+func terminate(_ *os.Process) error {
+	ctx := context.Background()
+	dotPath, err := DefaultDotDirectory(ctx)
+	if err != nil {
+		return err
+	}
+	apiErr, err := stopThroughHandler(ctx, dotPath)
+	switch {
+	case err != nil:
+		return err
+	case apiErr != nil:
+		return apiErr
+	}
+	return nil
+}
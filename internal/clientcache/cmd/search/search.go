@@ -206,24 +206,16 @@ func (c *SearchCommand) Run(args []string) int {
 }
 
 func (c *SearchCommand) Search(ctx context.Context) (*api.Response, *daemon.SearchResult, *api.Error, error) {
-	cl, err := c.Client()
+	authTokenId, err := authTokenId(c.Command)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	t := cl.Token()
-	if t == "" {
-		return nil, nil, nil, fmt.Errorf("Auth Token selected for searching is empty.")
-	}
-	tSlice := strings.SplitN(t, "_", 3)
-	if len(tSlice) != 3 {
-		return nil, nil, nil, fmt.Errorf("Auth Token selected for searching is in an unexpected format.")
-	}
 
 	tf := filterBy{
 		flagFilter:   c.FlagFilter,
 		flagQuery:    c.flagQuery,
 		resource:     c.flagResource,
-		authTokenId:  strings.Join(tSlice[:2], "_"),
+		authTokenId:  authTokenId,
 		forceRefresh: c.flagForceRefresh,
 	}
 	if c.flagMaxResultSetSize != 0 {
@@ -241,6 +233,48 @@ func (c *SearchCommand) Search(ctx context.Context) (*api.Response, *daemon.Sear
 	return search(ctx, dotPath, tf, opts...)
 }
 
+// Targets returns every target cached for the auth token used by the given
+// command.
+func Targets(ctx context.Context, c *base.Command) ([]*targets.Target, error) {
+	authTokenId, err := authTokenId(c)
+	if err != nil {
+		return nil, err
+	}
+	dotPath, err := cachecmd.DefaultDotDirectory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, result, apiErr, err := search(ctx, dotPath, filterBy{
+		resource:         "targets",
+		authTokenId:      authTokenId,
+		maxResultSetSize: -1,
+	})
+	switch {
+	case err != nil:
+		return nil, err
+	case apiErr != nil:
+		return nil, apiErr
+	}
+	return result.Targets, nil
+}
+
+// authTokenId returns the ID of the auth token used by the given command.
+func authTokenId(c *base.Command) (string, error) {
+	cl, err := c.Client()
+	if err != nil {
+		return "", err
+	}
+	t := cl.Token()
+	if t == "" {
+		return "", fmt.Errorf("Auth Token selected for searching is empty.")
+	}
+	tSlice := strings.SplitN(t, "_", 3)
+	if len(tSlice) != 3 {
+		return "", fmt.Errorf("Auth Token selected for searching is in an unexpected format.")
+	}
+	return strings.Join(tSlice[:2], "_"), nil
+}
+
 func search(ctx context.Context, daemonPath string, fb filterBy, opt ...client.Option) (*api.Response, *daemon.SearchResult, *api.Error, error) {
 	addr := daemon.SocketAddress(daemonPath)
 	_, err := os.Stat(addr.Path)
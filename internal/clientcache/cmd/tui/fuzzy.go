@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tui
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/boundary/api/targets"
+)
+
+// fuzzyScore reports whether the runes of the pattern appear in order in the
+// text, ignoring case, and scores the match. Runes matched next to each other
+// or at the start of a word score higher.
+func fuzzyScore(pattern, text string) (int, bool) {
+	if pattern == "" {
+		return 0, true
+	}
+	p := []rune(strings.ToLower(pattern))
+	t := []rune(strings.ToLower(text))
+
+	var score, pi int
+	prev := -2
+	for ti := 0; ti < len(t) && pi < len(p); ti++ {
+		if t[ti] != p[pi] {
+			continue
+		}
+		score++
+		switch {
+		case ti == prev+1:
+			score += 3
+		case ti == 0 || !unicode.IsLetter(t[ti-1]) && !unicode.IsDigit(t[ti-1]):
+			score += 2
+		}
+		prev = ti
+		pi++
+	}
+	if pi < len(p) {
+		return 0, false
+	}
+	return score, true
+}
+
+// matchTargets returns the targets matching the query, best matches first.
+// A target matches if its name, ID, description or address matches.
+func matchTargets(tgts []*targets.Target, query string) []*targets.Target {
+	type match struct {
+		target *targets.Target
+		score  int
+	}
+	var matches []match
+	for _, t := range tgts {
+		best, found := 0, false
+		for _, field := range []string{t.Name, t.Id, t.Description, t.Address} {
+			if s, ok := fuzzyScore(query, field); ok {
+				found = true
+				best = max(best, s)
+			}
+		}
+		if found {
+			matches = append(matches, match{target: t, score: best})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].target.Name < matches[j].target.Name
+	})
+	ret := make([]*targets.Target, 0, len(matches))
+	for _, m := range matches {
+		ret = append(ret, m.target)
+	}
+	return ret
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hashicorp/boundary/api/targets"
+)
+
+// defaultHeight is the height assumed until the size of the terminal is known.
+const defaultHeight = 24
+
+// action is what to do with the selected target once the TUI exits.
+type action int
+
+const (
+	actionNone action = iota
+	actionConnect
+	actionSsh
+)
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true)
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	dimStyle      = lipgloss.NewStyle().Faint(true)
+)
+
+// model is the bubbletea model of the target browser. Typing filters the
+// targets, the arrow keys move the selection, and enter or ctrl+s picks the
+// selected target to connect to.
+type model struct {
+	targets []*targets.Target
+	query   string
+	matches []*targets.Target
+	cursor  int
+	// offset is the index of the first match shown.
+	offset int
+	width  int
+	height int
+
+	action   action
+	selected *targets.Target
+}
+
+func newModel(tgts []*targets.Target) *model {
+	return &model{
+		targets: tgts,
+		matches: matchTargets(tgts, ""),
+		height:  defaultHeight,
+	}
+}
+
+func (m *model) Init() tea.Cmd {
+	return nil
+}
+
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.scroll()
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC, tea.KeyEsc:
+			return m, tea.Quit
+		case tea.KeyEnter:
+			return m, m.choose(actionConnect)
+		case tea.KeyCtrlS:
+			return m, m.choose(actionSsh)
+		case tea.KeyUp, tea.KeyCtrlP:
+			m.move(-1)
+		case tea.KeyDown, tea.KeyCtrlN:
+			m.move(1)
+		case tea.KeyPgUp:
+			m.move(-m.rows())
+		case tea.KeyPgDown:
+			m.move(m.rows())
+		case tea.KeyBackspace:
+			if r := []rune(m.query); len(r) > 0 {
+				m.search(string(r[:len(r)-1]))
+			}
+		case tea.KeyCtrlU:
+			m.search("")
+		case tea.KeyRunes, tea.KeySpace:
+			m.search(m.query + string(msg.Runes))
+		}
+	}
+	return m, nil
+}
+
+// choose selects the target under the cursor and quits, if there is one.
+func (m *model) choose(a action) tea.Cmd {
+	if len(m.matches) == 0 {
+		return nil
+	}
+	m.action = a
+	m.selected = m.matches[m.cursor]
+	return tea.Quit
+}
+
+func (m *model) search(query string) {
+	m.query = query
+	m.matches = matchTargets(m.targets, query)
+	m.cursor, m.offset = 0, 0
+}
+
+func (m *model) move(delta int) {
+	m.cursor = min(max(m.cursor+delta, 0), max(len(m.matches)-1, 0))
+	m.scroll()
+}
+
+// scroll keeps the cursor within the rows shown.
+func (m *model) scroll() {
+	rows := m.rows()
+	switch {
+	case m.cursor < m.offset:
+		m.offset = m.cursor
+	case m.cursor >= m.offset+rows:
+		m.offset = m.cursor - rows + 1
+	}
+}
+
+// rows returns the number of targets that fit on the screen, leaving room
+// for the title, the search line and the help line.
+func (m *model) rows() int {
+	return max(m.height-4, 1)
+}
+
+func (m *model) View() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(fmt.Sprintf("Boundary targets (%d of %d)", len(m.matches), len(m.targets))))
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "Search: %s_\n\n", m.query)
+
+	if len(m.matches) == 0 {
+		b.WriteString(dimStyle.Render("  No targets found"))
+		b.WriteString("\n")
+	}
+	end := min(m.offset+m.rows(), len(m.matches))
+	for i := m.offset; i < end; i++ {
+		line := m.truncate(targetLine(m.matches[i]))
+		if i == m.cursor {
+			line = selectedStyle.Render(line)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	b.WriteString(dimStyle.Render("↑/↓ move • enter connect • ctrl+s ssh • ctrl+u clear • esc quit"))
+	return b.String()
+}
+
+func (m *model) truncate(line string) string {
+	if m.width <= 0 {
+		return line
+	}
+	if r := []rune(line); len(r) > m.width {
+		return string(r[:m.width])
+	}
+	return line
+}
+
+func targetLine(t *targets.Target) string {
+	name := t.Name
+	if name == "" {
+		name = t.Id
+	}
+	parts := []string{fmt.Sprintf("  %-30s %-15s %-5s", name, t.Id, t.Type)}
+	if t.Address != "" {
+		parts = append(parts, t.Address)
+	}
+	if t.Description != "" {
+		parts = append(parts, t.Description)
+	}
+	return strings.Join(parts, "  ")
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuzzyScore(t *testing.T) {
+	_, ok := fuzzyScore("", "anything")
+	assert.True(t, ok)
+
+	_, ok = fuzzyScore("pdb", "prod-db")
+	assert.True(t, ok)
+	_, ok = fuzzyScore("PDB", "prod-db")
+	assert.True(t, ok)
+	_, ok = fuzzyScore("dbp", "prod-db")
+	assert.False(t, ok)
+
+	consecutive, ok := fuzzyScore("prod", "prod-db")
+	require.True(t, ok)
+	scattered, ok := fuzzyScore("prod", "p-r-o-d")
+	require.True(t, ok)
+	assert.Greater(t, consecutive, scattered)
+}
+
+func TestMatchTargets(t *testing.T) {
+	prod := &targets.Target{Id: "ttcp_1111111111", Name: "prod-db", Address: "10.0.0.1"}
+	staging := &targets.Target{Id: "ttcp_2222222222", Name: "staging-db", Description: "production copy"}
+	web := &targets.Target{Id: "tssh_3333333333", Name: "web"}
+	tgts := []*targets.Target{web, staging, prod}
+
+	assert.Equal(t, []*targets.Target{prod, staging, web}, matchTargets(tgts, ""))
+	assert.Equal(t, []*targets.Target{prod, staging}, matchTargets(tgts, "prod"))
+	assert.Equal(t, []*targets.Target{prod}, matchTargets(tgts, "10.0"))
+	assert.Equal(t, []*targets.Target{web}, matchTargets(tgts, "tssh"))
+	assert.Empty(t, matchTargets(tgts, "nothing"))
+}
+
+func TestModel(t *testing.T) {
+	prod := &targets.Target{Id: "ttcp_1111111111", Name: "prod-db"}
+	staging := &targets.Target{Id: "ttcp_2222222222", Name: "staging-db"}
+	web := &targets.Target{Id: "tssh_3333333333", Name: "web"}
+
+	keys := func(m *model, msgs ...tea.KeyMsg) tea.Cmd {
+		var cmd tea.Cmd
+		for _, msg := range msgs {
+			_, cmd = m.Update(msg)
+		}
+		return cmd
+	}
+	runes := func(s string) tea.KeyMsg {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+	}
+
+	t.Run("search-and-connect", func(t *testing.T) {
+		m := newModel([]*targets.Target{web, staging, prod})
+		keys(m, runes("d"), runes("b"))
+		assert.Equal(t, "db", m.query)
+		assert.Len(t, m.matches, 2)
+
+		keys(m, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown})
+		assert.Equal(t, 1, m.cursor, "the cursor stays on the last match")
+
+		cmd := keys(m, tea.KeyMsg{Type: tea.KeyEnter})
+		require.NotNil(t, cmd)
+		assert.Equal(t, actionConnect, m.action)
+		assert.Equal(t, m.matches[1], m.selected)
+	})
+
+	t.Run("ssh", func(t *testing.T) {
+		m := newModel([]*targets.Target{web, staging, prod})
+		keys(m, runes("web"))
+		cmd := keys(m, tea.KeyMsg{Type: tea.KeyCtrlS})
+		require.NotNil(t, cmd)
+		assert.Equal(t, actionSsh, m.action)
+		assert.Equal(t, web, m.selected)
+	})
+
+	t.Run("no-match", func(t *testing.T) {
+		m := newModel([]*targets.Target{web})
+		keys(m, runes("x"))
+		assert.Nil(t, keys(m, tea.KeyMsg{Type: tea.KeyEnter}))
+		assert.Equal(t, actionNone, m.action)
+		assert.Contains(t, m.View(), "No targets found")
+
+		keys(m, tea.KeyMsg{Type: tea.KeyBackspace})
+		assert.Len(t, m.matches, 1)
+	})
+
+	t.Run("quit", func(t *testing.T) {
+		m := newModel([]*targets.Target{web})
+		assert.NotNil(t, keys(m, tea.KeyMsg{Type: tea.KeyEsc}))
+		assert.Equal(t, actionNone, m.action)
+	})
+
+	t.Run("scroll", func(t *testing.T) {
+		m := newModel([]*targets.Target{web, staging, prod})
+		m.Update(tea.WindowSizeMsg{Width: 80, Height: 6})
+		require.Equal(t, 2, m.rows())
+		keys(m, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown})
+		assert.Equal(t, 2, m.cursor)
+		assert.Equal(t, 1, m.offset)
+		assert.NotContains(t, m.View(), prod.Id)
+	})
+}
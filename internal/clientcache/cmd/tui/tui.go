@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tui
+
+import (
+	stderrors "errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/clientcache/cmd/search"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	_ cli.Command             = (*TuiCommand)(nil)
+	_ cli.CommandAutocomplete = (*TuiCommand)(nil)
+)
+
+// authorizeSessionAction is the action a target must authorize to be listed.
+const authorizeSessionAction = "authorize-session"
+
+type TuiCommand struct {
+	*base.Command
+}
+
+func (c *TuiCommand) Synopsis() string {
+	return "Browse and connect to targets interactively using the client cache"
+}
+
+func (c *TuiCommand) Help() string {
+	helpText := `
+Usage: boundary tui [options]
+
+  Browse the targets you are authorized to connect to, as found in the client
+  cache, and connect to one of them:
+
+      $ boundary tui
+
+  Type to search the targets by name, ID, description or address. Use the
+  arrow keys to select a target, then press enter to run "boundary connect"
+  against it, or ctrl+s to run "boundary connect ssh". The connection and
+  client options given to this command are passed on to the connect command.
+
+  The client cache must be running; see "boundary cache start".
+
+` + c.Flags().Help()
+	return strings.TrimSpace(helpText)
+}
+
+func (c *TuiCommand) Flags() *base.FlagSets {
+	return c.FlagSet(base.FlagSetHTTP | base.FlagSetClient)
+}
+
+func (c *TuiCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *TuiCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *TuiCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	if len(f.Args()) > 0 {
+		c.PrintCliError(stderrors.New("This command takes no arguments"))
+		return base.CommandUserError
+	}
+
+	all, err := search.Targets(c.Context, c.Command)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from cache when listing targets")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error listing targets from the client cache: %w", err))
+		return base.CommandCliError
+	}
+	var authorized []*targets.Target
+	for _, t := range all {
+		if slices.Contains(t.AuthorizedActions, authorizeSessionAction) {
+			authorized = append(authorized, t)
+		}
+	}
+	if len(authorized) == 0 {
+		c.UI.Output("No targets found")
+		return base.CommandSuccess
+	}
+
+	p := tea.NewProgram(newModel(authorized), tea.WithAltScreen(), tea.WithContext(c.Context))
+	final, err := p.Run()
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error running the terminal UI: %w", err))
+		return base.CommandCliError
+	}
+	m := final.(*model)
+	if m.action == actionNone {
+		return base.CommandSuccess
+	}
+	return c.connect(m.action, m.selected, args)
+}
+
+// connect runs the connect command against the target in a child process,
+// passing on the flags given to this command, and returns its exit code.
+func (c *TuiCommand) connect(a action, t *targets.Target, flags []string) int {
+	exe, err := os.Executable()
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error finding the boundary executable: %w", err))
+		return base.CommandCliError
+	}
+	args := []string{"connect"}
+	if a == actionSsh {
+		args = append(args, "ssh")
+	}
+	args = append(args, "-target-id", t.Id)
+	args = append(args, flags...)
+
+	// The connect command handles interrupts itself, so the child is not tied
+	// to the context of this command.
+	cmd := exec.Command(exe, args...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if stderrors.As(err, &exitErr) {
+			return exitErr.ExitCode()
+		}
+		c.PrintCliError(fmt.Errorf("Error running %q: %w", strings.Join(args[:len(args)-len(flags)], " "), err))
+		return base.CommandCliError
+	}
+	return base.CommandSuccess
+}
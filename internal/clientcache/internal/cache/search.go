@@ -13,8 +13,8 @@ import (
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/hashicorp/boundary/internal/util"
-	"github.com/hashicorp/go-bexpr"
 )
 
 type SearchableResource string
@@ -94,7 +94,7 @@ func NewSearchService(ctx context.Context, repo *Repository) (*SearchService, er
 			ResolvableAliases: &resourceSearchFns[*aliases.Alias]{
 				list:  repo.ListResolvableAliases,
 				query: repo.QueryResolvableAliases,
-				filter: func(in *SearchResult, e *bexpr.Evaluator) {
+				filter: func(in *SearchResult, e filter.Evaluator) {
 					finalResults := make([]*aliases.Alias, 0, len(in.ResolvableAliases))
 					for _, item := range in.ResolvableAliases {
 						if m, err := e.Evaluate(filterItem{item}); err == nil && m {
@@ -107,7 +107,7 @@ func NewSearchService(ctx context.Context, repo *Repository) (*SearchService, er
 			Targets: &resourceSearchFns[*targets.Target]{
 				list:  repo.ListTargets,
 				query: repo.QueryTargets,
-				filter: func(in *SearchResult, e *bexpr.Evaluator) {
+				filter: func(in *SearchResult, e filter.Evaluator) {
 					finalResults := make([]*targets.Target, 0, len(in.Targets))
 					for _, item := range in.Targets {
 						if m, err := e.Evaluate(filterItem{item}); err == nil && m {
@@ -120,7 +120,7 @@ func NewSearchService(ctx context.Context, repo *Repository) (*SearchService, er
 			Sessions: &resourceSearchFns[*sessions.Session]{
 				list:  repo.ListSessions,
 				query: repo.QuerySessions,
-				filter: func(in *SearchResult, e *bexpr.Evaluator) {
+				filter: func(in *SearchResult, e filter.Evaluator) {
 					finalResults := make([]*sessions.Session, 0, len(in.Sessions))
 					for _, item := range in.Sessions {
 						if m, err := e.Evaluate(filterItem{item}); err == nil && m {
@@ -133,7 +133,7 @@ func NewSearchService(ctx context.Context, repo *Repository) (*SearchService, er
 			ImplicitScopes: &resourceSearchFns[*scopes.Scope]{
 				list:  repo.ListImplicitScopes,
 				query: repo.QueryImplicitScopes,
-				filter: func(in *SearchResult, e *bexpr.Evaluator) {
+				filter: func(in *SearchResult, e filter.Evaluator) {
 					finalResults := make([]*scopes.Scope, 0, len(in.ImplicitScopes))
 					for _, item := range in.ImplicitScopes {
 						if m, err := e.Evaluate(filterItem{item}); err == nil && m {
@@ -210,7 +210,7 @@ type resourceSearchFns[T any] struct {
 	query func(context.Context, string, string, ...Option) (*SearchResult, error)
 	// filter takes results and a ready-to-use evaluator and filters the items
 	// in the result
-	filter func(*SearchResult, *bexpr.Evaluator)
+	filter func(*SearchResult, filter.Evaluator)
 }
 
 // resourceSearcher is an interface that only resourceSearchFns[T] is expected
@@ -245,7 +245,7 @@ func (l *resourceSearchFns[T]) search(ctx context.Context, p SearchParams) (*Sea
 		return found, nil
 	}
 
-	e, err := bexpr.CreateEvaluator(p.Filter, bexpr.WithTagName("json"))
+	e, err := filter.CreateEvaluator(p.Filter, filter.WithTagName("json"))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("couldn't build filter"), errors.WithCode(errors.InvalidParameter))
 	}
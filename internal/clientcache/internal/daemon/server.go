@@ -84,6 +84,10 @@ type Config struct {
 	LogFileName            string
 	DotDirectory           string
 	RunningInBackground    bool
+	// Whether the cache is run by a supervisor, and the number of times the
+	// supervisor restarted it.
+	Supervised         bool
+	SupervisorRestarts int
 	// The amount of time since the last refresh that must have passed for a
 	// search query to trigger an inline refresh.
 	MaxSearchStaleness time.Duration
@@ -305,7 +309,7 @@ func (s *CacheServer) Serve(ctx context.Context, cmd Commander, opt ...Option) e
 	}
 	mux.Handle("/v1/search", serverMetadataInterceptor(searchFn, s.conf.RunningInBackground))
 
-	statusFn, err := newStatusHandlerFunc(ctx, repo, l.Addr().String(), s.conf.LogFileName)
+	statusFn, err := newStatusHandlerFunc(ctx, repo, l.Addr().String(), s.conf.LogFileName, s.conf.Supervised, s.conf.SupervisorRestarts)
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
@@ -63,6 +63,9 @@ type UserStatus struct {
 	AuthTokens []AuthTokenStatus `json:"auth_tokens,omitempty"`
 	// The resources tracked by the cache for this user
 	Resources []ResourceStatus `json:"resources,omitempty"`
+	// How long ago the least recently refreshed resource of this user was
+	// refreshed
+	SyncLag time.Duration `json:"sync_lag,omitempty"`
 }
 
 // StatusResult is the struct returned to status requests.
@@ -71,10 +74,15 @@ type StatusResult struct {
 	SocketAddress string        `json:"socket_address,omitempty"`
 	LogLocation   string        `json:"log_location,omitempty"`
 	Version       string        `json:"version,omitempty"`
-	Users         []UserStatus  `json:"users,omitempty"`
+	// Whether the cache is run as the subprocess of a supervisor which
+	// restarts it when it crashes or becomes unhealthy
+	Supervised bool `json:"supervised,omitempty"`
+	// The number of times the supervisor restarted the cache
+	SupervisorRestarts int          `json:"supervisor_restarts,omitempty"`
+	Users              []UserStatus `json:"users,omitempty"`
 }
 
-func newStatusHandlerFunc(ctx context.Context, repo *cache.Repository, socketAddr, logLocation string) (http.HandlerFunc, error) {
+func newStatusHandlerFunc(ctx context.Context, repo *cache.Repository, socketAddr, logLocation string, supervised bool, supervisorRestarts int) (http.HandlerFunc, error) {
 	const op = "daemon.newStatusHandlerFunc"
 	switch {
 	case util.IsNil(repo):
@@ -106,6 +114,8 @@ func newStatusHandlerFunc(ctx context.Context, repo *cache.Repository, socketAdd
 		}
 
 		apiRes := toApiStatus(res, started, socketAddr, logLocation)
+		apiRes.Supervised = supervised
+		apiRes.SupervisorRestarts = supervisorRestarts
 		j, err := json.Marshal(apiRes)
 		if err != nil {
 			writeError(w, err.Error(), http.StatusInternalServerError)
@@ -165,6 +175,7 @@ func toApiStatus(in *cache.Status, started time.Time, socketAddr, logLocation st
 					Age:      inR.RefreshToken.Age,
 					LastUsed: inR.RefreshToken.LastUsed,
 				}
+				outU.SyncLag = max(outU.SyncLag, inR.RefreshToken.LastUsed)
 			}
 			outU.Resources = append(outU.Resources, ResourceStatus{
 				Name:         inR.Name,
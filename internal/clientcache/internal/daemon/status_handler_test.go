@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/clientcache/internal/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToApiStatus_syncLag(t *testing.T) {
+	in := &cache.Status{
+		Users: []cache.UserStatus{
+			{
+				Id: "u_1234567890",
+				Resources: []cache.ResourceStatus{
+					{Name: "target", RefreshToken: &cache.RefreshTokenStatus{LastUsed: time.Minute}},
+					{Name: "session", RefreshToken: &cache.RefreshTokenStatus{LastUsed: 5 * time.Minute}},
+					{Name: "alias"},
+				},
+			},
+			{
+				Id: "u_0987654321",
+				Resources: []cache.ResourceStatus{
+					{Name: "target"},
+				},
+			},
+		},
+	}
+	out := toApiStatus(in, time.Now(), "/tmp/socket", "/tmp/cache.log")
+	require.Len(t, out.Users, 2)
+	assert.Equal(t, 5*time.Minute, out.Users[0].SyncLag)
+	assert.Zero(t, out.Users[1].SyncLag)
+}
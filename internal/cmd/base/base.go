@@ -39,6 +39,9 @@ const (
 	EnabledPluginHostAzure
 	EnabledPluginMinio
 	EnabledPluginGCP
+	EnabledPluginHostDns
+	EnabledPluginHostKubernetes
+	EnabledPluginHostConsul
 )
 
 // MinioEnabled controls if the Minio storage plugin should be initiated or not
@@ -56,6 +59,12 @@ func (e EnabledPlugin) String() string {
 		return "MinIO"
 	case EnabledPluginGCP:
 		return "GCP"
+	case EnabledPluginHostDns:
+		return "DNS"
+	case EnabledPluginHostKubernetes:
+		return "Kubernetes"
+	case EnabledPluginHostConsul:
+		return "Consul"
 	default:
 		return ""
 	}
@@ -66,6 +75,10 @@ const (
 	CommandApiError
 	CommandCliError
 	CommandUserError
+	CommandAuthError
+	CommandNotFoundError
+	CommandConflictError
+	CommandConnectionError
 )
 
 const (
@@ -102,6 +115,7 @@ type Command struct {
 	flagsOnce sync.Once
 
 	FlagAddr    string
+	flagProfile string
 	flagVerbose bool
 
 	flagTLSCACert     string
@@ -117,6 +131,7 @@ type Command struct {
 	FlagKeyringType               string
 	FlagRecoveryConfig            string
 	FlagOutputCurlString          bool
+	FlagImpersonate               string
 	FlagSkipCacheDaemon           bool
 	FlagSkipClientAgent           bool
 	FlagOutputClientAgentCliError bool
@@ -154,6 +169,15 @@ type Command struct {
 
 	client *api.Client
 
+	// deprecationsWarned holds the deprecation notices already printed, so
+	// each is printed once per invocation however many requests return it.
+	deprecationsWarned   map[string]bool
+	deprecationsWarnedMu sync.Mutex
+
+	// profile is the profile selected with -profile, loaded when the flags
+	// are parsed.
+	profile *Profile
+
 	// This will be intialized, if needed, in Config() when instantiating a
 	// recovery wrapper, if requested. It's then called as a deferred function
 	// on the Run method of the various generated commands.
@@ -275,6 +299,12 @@ func (c *Command) Client(opt ...Option) (*api.Client, error) {
 		}
 	}
 
+	c.client.SetDeprecationFunc(c.warnDeprecations)
+
+	if c.FlagImpersonate != "" {
+		c.client.SetImpersonateUserId(c.FlagImpersonate)
+	}
+
 	// Turn off retries on the CLI
 	if os.Getenv(api.EnvBoundaryMaxRetries) == "" {
 		c.client.SetMaxRetries(0)
@@ -359,6 +389,23 @@ func (c *Command) Client(opt ...Option) (*api.Client, error) {
 	return c.client, nil
 }
 
+// warnDeprecations prints the deprecation notices returned by the controller
+// that have not been printed yet by this command.
+func (c *Command) warnDeprecations(notices []string) {
+	c.deprecationsWarnedMu.Lock()
+	defer c.deprecationsWarnedMu.Unlock()
+	if c.deprecationsWarned == nil {
+		c.deprecationsWarned = make(map[string]bool, len(notices))
+	}
+	for _, n := range notices {
+		if c.deprecationsWarned[n] {
+			continue
+		}
+		c.deprecationsWarned[n] = true
+		c.UI.Warn(fmt.Sprintf("Deprecation warning: %s", n))
+	}
+}
+
 // If the first arg isn't a flag, extract it as the alias and return the remaining args
 func ExtractAliasFromArgs(inArgs []string) (string, []string) {
 	if len(inArgs) > 0 && inArgs[0][0] != '-' {
@@ -385,6 +432,19 @@ func (c *Command) FlagSet(bit FlagSetBit) *FlagSets {
 
 		if bit&FlagSetHTTP != 0 {
 			f := set.NewFlagSet("Connection Options")
+			set.afterParse = c.applyProfile
+
+			f.StringVar(&StringVar{
+				Name:       FlagNameProfile,
+				Target:     &c.flagProfile,
+				EnvVar:     EnvProfile,
+				Completion: complete.PredictAnything,
+				Usage: "Name of the profile of the CLI config file to use. The " +
+					"profile's settings are used for any connection, token name, auth " +
+					"method or scope flag that is not otherwise set. The CLI config " +
+					"file defaults to ~/.boundary/cli.hcl and can be set via the " +
+					EnvCliConfig + " environment variable.",
+			})
 
 			f.StringVar(&StringVar{
 				Name:       FlagNameAddr,
@@ -488,6 +548,12 @@ func (c *Command) FlagSet(bit FlagSetBit) *FlagSets {
 				Usage:  "Instead of executing the request, print an equivalent cURL command string and exit.",
 			})
 
+			f.StringVar(&StringVar{
+				Name:   "impersonate",
+				Target: &c.FlagImpersonate,
+				Usage:  "The ID of a user to perform the request as. The token's user must be granted the impersonate action on that user; both users are recorded in the audit events.",
+			})
+
 			f.BoolVar(&BoolVar{
 				Name:    "skip-cache-daemon",
 				Target:  &c.FlagSkipCacheDaemon,
@@ -559,6 +625,9 @@ type FlagSets struct {
 	mainSet     *flag.FlagSet
 	hiddens     map[string]struct{}
 	completions complete.Flags
+
+	// afterParse, if set, is called once the flags are parsed.
+	afterParse func() error
 }
 
 // NewFlagSets creates a new flag sets.
@@ -596,7 +665,13 @@ func (fs *FlagSets) Completions() complete.Flags {
 
 // Parse parses the given flags, returning any errors.
 func (fs *FlagSets) Parse(args []string) error {
-	return fs.mainSet.Parse(args)
+	if err := fs.mainSet.Parse(args); err != nil {
+		return err
+	}
+	if fs.afterParse != nil {
+		return fs.afterParse()
+	}
+	return nil
 }
 
 // Parsed reports whether the command-line flags have been parsed.
@@ -4,8 +4,10 @@
 package base
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/mitchellh/cli"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -73,3 +75,15 @@ func Test_extractAliasFromArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestCommand_warnDeprecations(t *testing.T) {
+	ui := cli.NewMockUi()
+	c := NewCommand(ui)
+	c.warnDeprecations([]string{"first", "second"})
+	c.warnDeprecations([]string{"second", "third"})
+	assert.Equal(t, []string{
+		"Deprecation warning: first",
+		"Deprecation warning: second",
+		"Deprecation warning: third",
+	}, strings.Split(strings.TrimSpace(ui.ErrorWriter.String()), "\n"))
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package base
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/mitchellh/cli"
+)
+
+// The kinds of error reported in the error_kind field of the JSON error
+// output. Each kind other than ErrorKindApi and ErrorKindCli has its own exit
+// code, see ExitCode.
+const (
+	// ErrorKindApi is any API error not covered by a more specific kind.
+	ErrorKindApi = "api"
+	// ErrorKindCli is any error raised by the CLI itself.
+	ErrorKindCli = "cli"
+	// ErrorKindAuth is an API error caused by a missing or invalid token, or
+	// by the caller not being permitted to perform the action.
+	ErrorKindAuth = "auth"
+	// ErrorKindNotFound is an API error caused by a missing resource.
+	ErrorKindNotFound = "not_found"
+	// ErrorKindConflict is an API error caused by a conflicting resource,
+	// such as one with the same name.
+	ErrorKindConflict = "conflict"
+	// ErrorKindConnection is a failure to connect to the controller.
+	ErrorKindConnection = "connection"
+)
+
+// apiErrorKind returns the kind of the given API error.
+func apiErrorKind(in *api.Error) string {
+	if in == nil || in.Response() == nil {
+		return ErrorKindApi
+	}
+	switch in.Response().StatusCode() {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrorKindAuth
+	case http.StatusNotFound:
+		return ErrorKindNotFound
+	case http.StatusConflict:
+		return ErrorKindConflict
+	default:
+		return ErrorKindApi
+	}
+}
+
+// cliErrorKind returns the kind of the given CLI error. Errors returned by
+// the API client when the request cannot be sent, which wrap a *url.Error or
+// a net.Error, are connection errors.
+func cliErrorKind(err error) string {
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return ErrorKindConnection
+	}
+	return ErrorKindCli
+}
+
+// recordErrorKind remembers the kind of the last error printed to the UI, so
+// that ExitCode can refine the exit code of the command.
+func recordErrorKind(ui cli.Ui, kind string) {
+	if t, ok := ui.(*BoundaryUI); ok {
+		t.errorKind = kind
+	}
+}
+
+// ExitCode refines the exit code returned by a command using the kind of the
+// last error the command printed, so that wrappers can tell failures apart
+// without parsing the output:
+//
+//	0   CommandSuccess
+//	1   CommandApiError: an API error of no more specific kind
+//	2   CommandCliError: an error raised by the CLI
+//	3   CommandUserError: an error in the flags or arguments given
+//	4   CommandAuthError: authentication or authorization failed
+//	5   CommandNotFoundError: the resource was not found
+//	6   CommandConflictError: the resource conflicts with an existing one
+//	7   CommandConnectionError: the controller could not be reached
+//
+// Only CommandApiError and CommandCliError are refined; any other code is
+// returned as is.
+func ExitCode(ui cli.Ui, code int) int {
+	if code != CommandApiError && code != CommandCliError {
+		return code
+	}
+	t, ok := ui.(*BoundaryUI)
+	if !ok {
+		return code
+	}
+	switch t.errorKind {
+	case ErrorKindAuth:
+		return CommandAuthError
+	case ErrorKindNotFound:
+		return CommandNotFoundError
+	case ErrorKindConflict:
+		return CommandConflictError
+	case ErrorKindConnection:
+		return CommandConnectionError
+	default:
+		return code
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package base
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testApiError(t *testing.T, statusCode int) *api.Error {
+	t.Helper()
+	resp := api.NewResponse(&http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(`{"kind":"Test","message":"test"}`)),
+	})
+	apiErr, err := resp.Decode(nil)
+	require.NoError(t, err)
+	require.NotNil(t, apiErr)
+	return apiErr
+}
+
+func TestExitCode(t *testing.T) {
+	connErr := fmt.Errorf("Error trying to read targets: %w", &url.Error{Op: "Get", URL: "https://boundary", Err: errors.New("connection refused")})
+
+	tests := []struct {
+		name     string
+		print    func(c *Command)
+		code     int
+		wantCode int
+		wantKind string
+	}{
+		{
+			name:     "unauthenticated",
+			print:    func(c *Command) { c.PrintApiError(testApiError(t, http.StatusUnauthorized), "") },
+			code:     CommandApiError,
+			wantCode: CommandAuthError,
+			wantKind: ErrorKindAuth,
+		},
+		{
+			name:     "permission-denied",
+			print:    func(c *Command) { c.PrintApiError(testApiError(t, http.StatusForbidden), "") },
+			code:     CommandApiError,
+			wantCode: CommandAuthError,
+			wantKind: ErrorKindAuth,
+		},
+		{
+			name:     "not-found",
+			print:    func(c *Command) { c.PrintApiError(testApiError(t, http.StatusNotFound), "") },
+			code:     CommandApiError,
+			wantCode: CommandNotFoundError,
+			wantKind: ErrorKindNotFound,
+		},
+		{
+			name:     "conflict",
+			print:    func(c *Command) { c.PrintApiError(testApiError(t, http.StatusConflict), "") },
+			code:     CommandApiError,
+			wantCode: CommandConflictError,
+			wantKind: ErrorKindConflict,
+		},
+		{
+			name:     "other-api-error",
+			print:    func(c *Command) { c.PrintApiError(testApiError(t, http.StatusBadRequest), "") },
+			code:     CommandApiError,
+			wantCode: CommandApiError,
+			wantKind: ErrorKindApi,
+		},
+		{
+			name:     "connection",
+			print:    func(c *Command) { c.PrintCliError(connErr) },
+			code:     CommandCliError,
+			wantCode: CommandConnectionError,
+			wantKind: ErrorKindConnection,
+		},
+		{
+			name:     "cli-error",
+			print:    func(c *Command) { c.PrintCliError(errors.New("bad")) },
+			code:     CommandCliError,
+			wantCode: CommandCliError,
+			wantKind: ErrorKindCli,
+		},
+		{
+			name:     "user-error-kept",
+			print:    func(c *Command) { c.PrintApiError(testApiError(t, http.StatusNotFound), "") },
+			code:     CommandUserError,
+			wantCode: CommandUserError,
+			wantKind: ErrorKindNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := cli.NewMockUi()
+			ui := &BoundaryUI{Ui: mock, Format: "json"}
+			tt.print(NewCommand(ui))
+
+			assert.Equal(t, tt.wantCode, ExitCode(ui, tt.code))
+
+			var out struct {
+				ErrorKind string `json:"error_kind"`
+			}
+			require.NoError(t, json.Unmarshal(mock.ErrorWriter.Bytes(), &out))
+			assert.Equal(t, tt.wantKind, out.ErrorKind)
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ui := &BoundaryUI{Ui: cli.NewMockUi(), Format: "table"}
+		NewCommand(ui).PrintApiError(testApiError(t, http.StatusNotFound), "")
+		assert.Equal(t, CommandSuccess, ExitCode(ui, CommandSuccess))
+	})
+}
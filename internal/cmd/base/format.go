@@ -183,6 +183,8 @@ func WrapMap(prefixSpaces, maxLengthOverride int, input map[string]any) string {
 // used, all other options are ignored.
 func (c *Command) PrintApiError(in *api.Error, contextStr string, opt ...Option) {
 	opts := GetOpts(opt...)
+	kind := apiErrorKind(in)
+	recordErrorKind(c.UI, kind)
 	switch Format(c.UI) {
 	case "json":
 		var b []byte
@@ -191,11 +193,13 @@ func (c *Command) PrintApiError(in *api.Error, contextStr string, opt ...Option)
 				Context    string          `json:"context,omitempty"`
 				StatusCode int             `json:"status_code"`
 				Status     int             `json:"status"`
+				ErrorKind  string          `json:"error_kind"`
 				ApiError   json.RawMessage `json:"api_error"`
 			}{
 				Context:    contextStr,
 				StatusCode: in.Response().StatusCode(),
 				Status:     in.Response().StatusCode(),
+				ErrorKind:  kind,
 				ApiError:   in.Response().Body.Bytes(),
 			}
 			b, _ = JsonFormatter{}.Format(output)
@@ -203,10 +207,12 @@ func (c *Command) PrintApiError(in *api.Error, contextStr string, opt ...Option)
 			output := struct {
 				Context    string          `json:"context,omitempty"`
 				StatusCode int             `json:"status_code"`
+				ErrorKind  string          `json:"error_kind"`
 				ApiError   json.RawMessage `json:"api_error"`
 			}{
 				Context:    contextStr,
 				StatusCode: in.Response().StatusCode(),
+				ErrorKind:  kind,
 				ApiError:   in.Response().Body.Bytes(),
 			}
 			b, _ = JsonFormatter{}.Format(output)
@@ -282,14 +288,18 @@ func (c *Command) PrintApiError(in *api.Error, contextStr string, opt ...Option)
 
 // PrintCliError prints the given CLI error to the UI in the appropriate format
 func (c *Command) PrintCliError(err error) {
+	kind := cliErrorKind(err)
+	recordErrorKind(c.UI, kind)
 	switch Format(c.UI) {
 	case "table":
 		c.UI.Error(err.Error())
 	case "json":
 		output := struct {
-			Error string `json:"error"`
+			Error     string `json:"error"`
+			ErrorKind string `json:"error_kind"`
 		}{
-			Error: err.Error(),
+			Error:     err.Error(),
+			ErrorKind: kind,
 		}
 		b, _ := JsonFormatter{}.Format(output)
 		c.UI.Error(string(b))
@@ -32,6 +32,7 @@ type ServerListener struct {
 	ClusterListener net.Listener
 	ProxyListener   net.Listener
 	OpsListener     net.Listener
+	TunnelListener  net.Listener
 }
 
 type WorkerAuthInfo struct {
@@ -88,6 +89,10 @@ func NewListener(l *listenerutil.ListenerConfig, ui cli.Ui) (net.Listener, map[s
 		// TODO: Eventually we'll support bringing your own cert, and we'd only
 		// want to disable if you aren't actually bringing your own
 		l.TLSDisable = true
+	case "tunnel":
+		// Session traffic is encrypted end to end between the client and the
+		// worker, the controller only routes it
+		l.TLSDisable = true
 	default:
 		switch l.TLSMinVersion {
 		case "", "tls12", "tls13":
@@ -133,6 +138,8 @@ func tcpListenerFactory(purpose string, l *listenerutil.ListenerConfig, ui cli.U
 			l.Address = "127.0.0.1:9202"
 		case "ops":
 			l.Address = "127.0.0.1:9203"
+		case "tunnel":
+			l.Address = "127.0.0.1:9204"
 		default:
 			return "", nil, errors.New("no purpose provided for listener and no address given")
 		}
@@ -152,6 +159,8 @@ func tcpListenerFactory(purpose string, l *listenerutil.ListenerConfig, ui cli.U
 			port = "9202"
 		case "ops":
 			port = "9203"
+		case "tunnel":
+			port = "9204"
 		default:
 			return "", nil, errors.New("no purpose provided for listener and no port discoverable")
 		}
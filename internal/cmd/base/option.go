@@ -46,6 +46,7 @@ type Options struct {
 	withStatusCode                          int
 	withHostPlugin                          func() (string, plugin.HostPluginServiceClient)
 	withEventGating                         bool
+	withEventLogBuffer                      *event.LogBuffer
 	withImplicitId                          string
 	WithSkipScopeIdFlag                     bool
 	WithInterceptedToken                    *string
@@ -216,6 +217,14 @@ func WithEventGating(with bool) Option {
 	}
 }
 
+// WithEventLogBuffer adds a sink which keeps the recent system and error
+// events in the provided buffer
+func WithEventLogBuffer(with *event.LogBuffer) Option {
+	return func(o *Options) {
+		o.withEventLogBuffer = with
+	}
+}
+
 // WithImplicitId is used when creating the command if we are implicitly
 // overriding the ID via a top-level read/update/delete command
 func WithImplicitId(with string) Option {
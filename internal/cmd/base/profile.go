@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package base
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
+)
+
+const (
+	// EnvProfile selects the profile of the CLI config file the CLI is run
+	// with.
+	EnvProfile = "BOUNDARY_PROFILE"
+	// EnvCliConfig is the path of the CLI config file. Defaults to cli.hcl in
+	// the .boundary directory of the user's home.
+	EnvCliConfig = "BOUNDARY_CLI_CONFIG"
+
+	// FlagNameProfile is the flag used in the base command to select a
+	// profile.
+	FlagNameProfile = "profile"
+)
+
+// Profile is a named set of connection settings in the CLI config file, such
+// as the controller address and TLS settings of one environment. The settings
+// of the selected profile are used for any flag not set on the command line or
+// through its environment variable. A profile is defined as:
+//
+//	profile "prod" {
+//	  addr           = "https://boundary.example.com:9200"
+//	  auth_method_id = "ampw_1234567890"
+//	  token_name     = "prod"
+//	  scope_id       = "o_1234567890"
+//	  tls_ca_cert    = "/etc/boundary/prod-ca.pem"
+//	}
+type Profile struct {
+	Name string `hcl:"-"`
+
+	Addr         string `hcl:"addr"`
+	AuthMethodId string `hcl:"auth_method_id"`
+	TokenName    string `hcl:"token_name"`
+	ScopeId      string `hcl:"scope_id"`
+
+	TlsCaCert     string `hcl:"tls_ca_cert"`
+	TlsCaPath     string `hcl:"tls_ca_path"`
+	TlsClientCert string `hcl:"tls_client_cert"`
+	TlsClientKey  string `hcl:"tls_client_key"`
+	TlsServerName string `hcl:"tls_server_name"`
+	TlsInsecure   bool   `hcl:"tls_insecure"`
+}
+
+// DefaultCliConfigPath returns the path of the CLI config file, which is read
+// from the BOUNDARY_CLI_CONFIG environment variable if set.
+func DefaultCliConfigPath() (string, error) {
+	if p := os.Getenv(EnvCliConfig); p != "" {
+		return p, nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".boundary", "cli.hcl"), nil
+}
+
+// LoadProfile reads the profile with the given name from the CLI config file
+// at path.
+func LoadProfile(path, name string) (*Profile, error) {
+	if name == "" {
+		return nil, errors.New("Missing profile name.")
+	}
+	d, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the CLI config file: %w.", err)
+	}
+	profiles, err := parseProfiles(string(d))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the CLI config file %s: %w.", path, err)
+	}
+	p, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("Profile %q is not defined in the CLI config file %s.", name, path)
+	}
+	return p, nil
+}
+
+// parseProfiles returns the profiles of a CLI config file, keyed by name.
+func parseProfiles(d string) (map[string]*Profile, error) {
+	obj, err := hcl.Parse(d)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := obj.Node.(*ast.ObjectList)
+	if !ok {
+		return nil, errors.New("error parsing: file doesn't contain a root object")
+	}
+	profiles := map[string]*Profile{}
+	for _, item := range list.Filter("profile").Items {
+		if len(item.Keys) != 1 {
+			return nil, errors.New("profile blocks must have exactly one name")
+		}
+		name, err := strconv.Unquote(item.Keys[0].Token.Text)
+		if err != nil {
+			name = item.Keys[0].Token.Text
+		}
+		if _, ok := profiles[name]; ok {
+			return nil, fmt.Errorf("profile %q is defined more than once", name)
+		}
+		p := &Profile{Name: name}
+		if err := hcl.DecodeObject(p, item.Val); err != nil {
+			return nil, fmt.Errorf("error decoding profile %q: %w", name, err)
+		}
+		profiles[name] = p
+	}
+	return profiles, nil
+}
+
+// Profile returns the profile the command was run with, or nil if none was
+// selected. It is only set once the command's flags are parsed.
+func (c *Command) Profile() *Profile {
+	return c.profile
+}
+
+// applyProfile loads the profile selected with the -profile flag or the
+// BOUNDARY_PROFILE environment variable, and uses its settings for the flags of
+// the command that were set neither on the command line nor through their
+// environment variable.
+func (c *Command) applyProfile() error {
+	if c.flagProfile == "" {
+		return nil
+	}
+	if c.profile == nil || c.profile.Name != c.flagProfile {
+		path, err := DefaultCliConfigPath()
+		if err != nil {
+			return fmt.Errorf("Error finding the CLI config file: %w.", err)
+		}
+		if c.profile, err = LoadProfile(path, c.flagProfile); err != nil {
+			return err
+		}
+	}
+	p := c.profile
+
+	var tlsInsecure string
+	if p.TlsInsecure {
+		tlsInsecure = "true"
+	}
+	explicit := map[string]bool{}
+	c.flags.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+	for _, s := range []struct {
+		name   string
+		envVar string
+		value  string
+	}{
+		{FlagNameAddr, api.EnvBoundaryAddr, p.Addr},
+		{"auth-method-id", "BOUNDARY_AUTH_METHOD_ID", p.AuthMethodId},
+		{"token-name", EnvTokenName, p.TokenName},
+		{"scope-id", "BOUNDARY_SCOPE_ID", p.ScopeId},
+		{FlagNameCACert, api.EnvBoundaryCACert, p.TlsCaCert},
+		{FlagNameCAPath, api.EnvBoundaryCAPath, p.TlsCaPath},
+		{FlagNameClientCert, api.EnvBoundaryClientCert, p.TlsClientCert},
+		{FlagNameClientKey, api.EnvBoundaryClientKey, p.TlsClientKey},
+		{FlagTLSServerName, api.EnvBoundaryTLSServerName, p.TlsServerName},
+		{FlagNameTLSInsecure, api.EnvBoundaryTLSInsecure, tlsInsecure},
+	} {
+		if s.value == "" || explicit[s.name] {
+			continue
+		}
+		if _, ok := os.LookupEnv(s.envVar); ok {
+			continue
+		}
+		f := c.flags.mainSet.Lookup(s.name)
+		if f == nil {
+			// The command doesn't have this flag.
+			continue
+		}
+		if err := f.Value.Set(s.value); err != nil {
+			return fmt.Errorf("Invalid value for %s in profile %q: %w.", s.name, p.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package base
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCliConfig = `
+profile "prod" {
+  addr            = "https://prod.example.com:9200"
+  auth_method_id  = "ampw_prod"
+  token_name      = "prod"
+  scope_id        = "o_prod"
+  tls_ca_cert     = "/etc/boundary/prod-ca.pem"
+  tls_server_name = "boundary.prod"
+}
+
+profile "dev" {
+  addr         = "http://127.0.0.1:9200"
+  tls_insecure = true
+}
+`
+
+func TestParseProfiles(t *testing.T) {
+	profiles, err := parseProfiles(testCliConfig)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*Profile{
+		"prod": {
+			Name:          "prod",
+			Addr:          "https://prod.example.com:9200",
+			AuthMethodId:  "ampw_prod",
+			TokenName:     "prod",
+			ScopeId:       "o_prod",
+			TlsCaCert:     "/etc/boundary/prod-ca.pem",
+			TlsServerName: "boundary.prod",
+		},
+		"dev": {
+			Name:        "dev",
+			Addr:        "http://127.0.0.1:9200",
+			TlsInsecure: true,
+		},
+	}, profiles)
+
+	_, err = parseProfiles(`profile "a" {}
+profile "a" {}`)
+	assert.ErrorContains(t, err, "defined more than once")
+	_, err = parseProfiles(`profile {}`)
+	assert.ErrorContains(t, err, "exactly one name")
+}
+
+func TestCommand_applyProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cli.hcl")
+	require.NoError(t, os.WriteFile(path, []byte(testCliConfig), 0o600))
+	t.Setenv(EnvCliConfig, path)
+	for _, env := range []string{EnvProfile, api.EnvBoundaryAddr, EnvTokenName, api.EnvBoundaryTLSServerName, api.EnvBoundaryCACert} {
+		t.Setenv(env, "")
+		require.NoError(t, os.Unsetenv(env))
+	}
+
+	newCommand := func() (*Command, *FlagSets) {
+		c := NewCommand(cli.NewMockUi())
+		set := c.FlagSet(FlagSetHTTP | FlagSetClient)
+		f := set.NewFlagSet("Command Options")
+		f.StringVar(&StringVar{
+			Name:    "scope-id",
+			Target:  &c.FlagScopeId,
+			EnvVar:  "BOUNDARY_SCOPE_ID",
+			Default: "global",
+		})
+		return c, set
+	}
+
+	t.Run("no-profile", func(t *testing.T) {
+		c, set := newCommand()
+		require.NoError(t, set.Parse(nil))
+		assert.Nil(t, c.Profile())
+		assert.Empty(t, c.FlagAddr)
+		assert.Equal(t, "global", c.FlagScopeId)
+	})
+	t.Run("flag", func(t *testing.T) {
+		c, set := newCommand()
+		require.NoError(t, set.Parse([]string{"-profile", "prod", "-token-name", "other"}))
+		require.NotNil(t, c.Profile())
+		assert.Equal(t, "ampw_prod", c.Profile().AuthMethodId)
+		assert.Equal(t, "https://prod.example.com:9200", c.FlagAddr)
+		assert.Equal(t, "/etc/boundary/prod-ca.pem", c.flagTLSCACert)
+		assert.Equal(t, "boundary.prod", c.flagTLSServerName)
+		assert.Equal(t, "o_prod", c.FlagScopeId)
+		// Flags set on the command line take precedence.
+		assert.Equal(t, "other", c.FlagTokenName)
+		assert.False(t, c.flagTLSInsecure)
+	})
+	t.Run("env", func(t *testing.T) {
+		t.Setenv(EnvProfile, "dev")
+		c, set := newCommand()
+		require.NoError(t, set.Parse(nil))
+		assert.Equal(t, "http://127.0.0.1:9200", c.FlagAddr)
+		assert.True(t, c.flagTLSInsecure)
+		assert.Equal(t, "global", c.FlagScopeId)
+	})
+	t.Run("env-var-precedence", func(t *testing.T) {
+		t.Setenv(api.EnvBoundaryAddr, "https://override.example.com")
+		c, set := newCommand()
+		require.NoError(t, set.Parse([]string{"-profile=prod"}))
+		assert.Equal(t, "https://override.example.com", c.FlagAddr)
+		assert.Equal(t, "prod", c.FlagTokenName)
+	})
+	t.Run("unknown-profile", func(t *testing.T) {
+		_, set := newCommand()
+		err := set.Parse([]string{"-profile", "staging"})
+		assert.ErrorContains(t, err, `Profile "staging" is not defined`)
+	})
+	t.Run("missing-file", func(t *testing.T) {
+		t.Setenv(EnvCliConfig, filepath.Join(t.TempDir(), "missing.hcl"))
+		_, set := newCommand()
+		err := set.Parse([]string{"-profile", "prod"})
+		assert.ErrorContains(t, err, "Error reading the CLI config file")
+	})
+}
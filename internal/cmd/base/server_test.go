@@ -481,6 +481,8 @@ func TestSetupWorkerPublicAddress(t *testing.T) {
 		expErr           bool
 		expErrStr        string
 		expPublicAddress string
+
+		expAdditionalPublicAddrs []string
 	}{
 		{
 			name: "nil worker",
@@ -820,6 +822,59 @@ func TestSetupWorkerPublicAddress(t *testing.T) {
 			expErrStr:        "Error parsing IP template on worker public addr: unable to parse address template \"{{ somethingthatdoesntexist }}\": unable to parse template \"{{ somethingthatdoesntexist }}\": template: sockaddr.Parse:1: function \"somethingthatdoesntexist\" not defined",
 			expPublicAddress: "",
 		},
+		{
+			name: "additional public addresses",
+			inputConfig: &config.Config{
+				SharedConfig: &configutil.SharedConfig{
+					Listeners: []*listenerutil.ListenerConfig{},
+				},
+				Worker: &config.Worker{
+					PublicAddr:            "worker.example.com",
+					AdditionalPublicAddrs: []string{"10.0.0.1", "worker.internal:8080", "[::1]"},
+				},
+			},
+			inputFlagValue:           "",
+			expErr:                   false,
+			expErrStr:                "",
+			expPublicAddress:         "worker.example.com:9202",
+			expAdditionalPublicAddrs: []string{"10.0.0.1:9202", "worker.internal:8080", "[::1]:9202"},
+		},
+		{
+			name: "additional public address from env var",
+			inputConfig: &config.Config{
+				SharedConfig: &configutil.SharedConfig{
+					Listeners: []*listenerutil.ListenerConfig{},
+				},
+				Worker: &config.Worker{
+					PublicAddr:            "worker.example.com",
+					AdditionalPublicAddrs: []string{"env://TEST_ADDITIONAL_ADDR"},
+				},
+			},
+			stateFn: func(t *testing.T) {
+				t.Setenv("TEST_ADDITIONAL_ADDR", "worker.mesh")
+			},
+			inputFlagValue:           "",
+			expErr:                   false,
+			expErrStr:                "",
+			expPublicAddress:         "worker.example.com:9202",
+			expAdditionalPublicAddrs: []string{"worker.mesh:9202"},
+		},
+		{
+			name: "additional public address without host",
+			inputConfig: &config.Config{
+				SharedConfig: &configutil.SharedConfig{
+					Listeners: []*listenerutil.ListenerConfig{},
+				},
+				Worker: &config.Worker{
+					PublicAddr:            "worker.example.com",
+					AdditionalPublicAddrs: []string{":8080"},
+				},
+			},
+			inputFlagValue:   "",
+			expErr:           true,
+			expErrStr:        "Additional public address \":8080\" has no host",
+			expPublicAddress: "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -837,6 +892,7 @@ func TestSetupWorkerPublicAddress(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, tt.inputConfig.Worker)
 			require.Equal(t, tt.expPublicAddress, tt.inputConfig.Worker.PublicAddr)
+			require.Equal(t, tt.expAdditionalPublicAddrs, tt.inputConfig.Worker.AdditionalPublicAddrs)
 		})
 	}
 }
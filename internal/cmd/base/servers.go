@@ -13,6 +13,7 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -76,6 +77,10 @@ type Server struct {
 	StderrLock *sync.Mutex
 	Eventer    *event.Eventer
 
+	// EventLogBuffer, if set, keeps the recent system and error events so they
+	// can be read remotely.
+	EventLogBuffer *event.LogBuffer
+
 	// NOTE: Unlike the other wrappers below, if set, DownstreamWorkerAuthKms
 	// should always be a PooledWrapper, so that we can allow multiple KMSes to
 	// accept downstream connections. As such it's made explicit here.
@@ -219,11 +224,19 @@ func (b *Server) SetupEventing(ctx context.Context, logger hclog.Logger, seriali
 		}
 	}
 
+	eventerConfig := *opts.withEventerConfig
+	if opts.withEventLogBuffer != nil {
+		// The buffer's sink is added after the event flags are applied, since
+		// its format and filters are fixed.
+		eventerConfig.Sinks = append(slices.Clone(eventerConfig.Sinks), opts.withEventLogBuffer.SinkConfig())
+		b.EventLogBuffer = opts.withEventLogBuffer
+	}
+
 	e, err := event.NewEventer(
 		logger,
 		serializationLock,
 		serverName,
-		*opts.withEventerConfig,
+		eventerConfig,
 		// Note: this may be nil at this point, it is updated later on in SetupKMSes.
 		// There is a cyclic dependency between the eventer and the wrapper, so we instantiate
 		// the eventer with a nil wrapper until we have a wrapper to use.
@@ -441,6 +454,9 @@ func (b *Server) SetupListeners(ui cli.Ui, config *configutil.SharedConfig, allo
 			if ln.OpsListener != nil {
 				ln.OpsListener.Close()
 			}
+			if ln.TunnelListener != nil {
+				ln.TunnelListener.Close()
+			}
 		}
 		return nil
 	})
@@ -532,6 +548,8 @@ func (b *Server) SetupListeners(ui cli.Ui, config *configutil.SharedConfig, allo
 			serverListener.ProxyListener = ln
 		case "ops":
 			serverListener.OpsListener = ln
+		case "tunnel":
+			serverListener.TunnelListener = ln
 		}
 
 		b.Listeners = append(b.Listeners, serverListener)
@@ -844,6 +862,28 @@ func (b *Server) SetupWorkerPublicAddress(conf *config.Config, flagValue string)
 	}
 	conf.Worker.PublicAddr = util.JoinHostPort(host, port)
 
+	for i, addr := range conf.Worker.AdditionalPublicAddrs {
+		addr, err := parseutil.ParsePath(addr)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			return fmt.Errorf("Error parsing additional public addr: %w", err)
+		}
+		addr, err = listenerutil.ParseSingleIPTemplate(addr)
+		if err != nil {
+			return fmt.Errorf("Error parsing IP template on worker additional public addr: %w", err)
+		}
+		host, port, err := util.SplitHostPort(addr)
+		if err != nil {
+			return fmt.Errorf("Error splitting additional public address host/port: %w", err)
+		}
+		if host == "" {
+			return fmt.Errorf("Additional public address %q has no host", addr)
+		}
+		if port == "" {
+			port = "9202"
+		}
+		conf.Worker.AdditionalPublicAddrs[i] = util.JoinHostPort(host, port)
+	}
+
 	return nil
 }
 
@@ -13,6 +13,9 @@ import (
 type BoundaryUI struct {
 	cli.Ui
 	Format string
+
+	// errorKind is the kind of the last error printed, see ExitCode
+	errorKind string
 }
 
 var TermWidth uint = 80
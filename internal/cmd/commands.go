@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/commands/accountscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/aliasescmd"
+	"github.com/hashicorp/boundary/internal/cmd/commands/auditcmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authenticate"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authmethodscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authtokenscmd"
@@ -17,13 +18,18 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/commands/credentialscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/credentialstorescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/database"
+	"github.com/hashicorp/boundary/internal/cmd/commands/debug"
 	"github.com/hashicorp/boundary/internal/cmd/commands/dev"
+	"github.com/hashicorp/boundary/internal/cmd/commands/doctor"
+	"github.com/hashicorp/boundary/internal/cmd/commands/environmentscmd"
+	"github.com/hashicorp/boundary/internal/cmd/commands/filterscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/genericcmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/groupscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hostcatalogscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hostscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hostsetscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/logout"
+	"github.com/hashicorp/boundary/internal/cmd/commands/maintenancecmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/managedgroupscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/policiescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/rolescmd"
@@ -33,6 +39,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/commands/sessionscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/storagebucketscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/targetscmd"
+	"github.com/hashicorp/boundary/internal/cmd/commands/targettemplatescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/unsupported"
 	"github.com/hashicorp/boundary/internal/cmd/commands/userscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/version"
@@ -65,11 +72,38 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				SigUSR2Ch: MakeSigUSR2Ch(),
 			}, nil
 		},
+		"audit": func() (cli.Command, error) {
+			return &auditcmd.Command{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"audit verify": func() (cli.Command, error) {
+			return &auditcmd.VerifyCommand{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"debug": func() (cli.Command, error) {
+			return &debug.Command{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
 		"version": func() (cli.Command, error) {
 			return &version.Command{
 				Command: base.NewCommand(ui, opts...),
 			}, nil
 		},
+		"version sbom": func() (cli.Command, error) {
+			return &version.BuildInfoCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "sbom",
+			}, nil
+		},
+		"version provenance": func() (cli.Command, error) {
+			return &version.BuildInfoCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "provenance",
+			}, nil
+		},
 
 		"authenticate": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &authenticate.Command{
@@ -301,6 +335,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "change-state",
 			}
 		}),
+		"auth-methods set-deletion-protection": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &authmethodscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "set-deletion-protection",
+			}
+		}),
 
 		"auth-tokens": func() (cli.Command, error) {
 			return &authtokenscmd.Command{
@@ -337,6 +377,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "monthly-active-users",
 			}
 		}),
+		"billing scope-usage": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &billingcmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "scope-usage",
+			}
+		}),
 
 		"client-agent": func() (cli.Command, error) {
 			return &unsupported.UnsupportedCommand{
@@ -393,6 +439,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "connect",
 			}
 		}),
+		"connect attach": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &connect.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "attach",
+			}
+		}),
 		"connect http": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &connect.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -405,6 +457,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "kube",
 			}
 		}),
+		"connect mongo": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &connect.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "mongo",
+			}
+		}),
+		"connect mysql": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &connect.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "mysql",
+			}
+		}),
 		"connect postgres": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &connect.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -417,12 +481,24 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "rdp",
 			}
 		}),
+		"connect redis": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &connect.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "redis",
+			}
+		}),
 		"connect ssh": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &connect.Command{
 				Command: base.NewCommand(ui, opts...),
 				Func:    "ssh",
 			}
 		}),
+		"connect stdio": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &connect.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "stdio",
+			}
+		}),
 
 		"credential-libraries": func() (cli.Command, error) {
 			return &credentiallibrariescmd.Command{
@@ -645,6 +721,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Server: base.NewServer(base.NewCommand(ui, opts...)),
 			}, nil
 		},
+		"database export": func() (cli.Command, error) {
+			return &database.ExportCommand{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"database import": func() (cli.Command, error) {
+			return &database.ImportCommand{
+				Server: base.NewServer(base.NewCommand(ui, opts...)),
+			}, nil
+		},
 		"database migrate": func() (cli.Command, error) {
 			return &database.MigrateCommand{
 				Command: base.NewCommand(ui, opts...),
@@ -658,6 +744,59 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			}, nil
 		},
 
+		"doctor": func() (cli.Command, error) {
+			return &doctor.Command{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+
+		"environments": func() (cli.Command, error) {
+			return &environmentscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"environments create": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &environmentscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}
+		}),
+		"environments update": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &environmentscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}
+		}),
+		"environments read": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &environmentscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "read",
+			}
+		}),
+		"environments delete": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &environmentscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "delete",
+			}
+		}),
+		"environments list": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &environmentscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "list",
+			}
+		}),
+
+		"filters": func() (cli.Command, error) {
+			return &filterscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"filters validate": func() (cli.Command, error) {
+			return &filterscmd.ValidateCommand{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+
 		"groups": func() (cli.Command, error) {
 			return &groupscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -771,6 +910,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}
 		}),
+		"host-catalogs rotate-secrets": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &hostcatalogscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "rotate-secrets",
+			}
+		}),
+		"host-catalogs set-target-rules": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &hostcatalogscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "set-target-rules",
+			}
+		}),
 
 		"host-sets": func() (cli.Command, error) {
 			return &hostsetscmd.Command{
@@ -885,6 +1036,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}
 		}),
+		"hosts create-range": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &hostscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create-range",
+			}
+		}),
 		"hosts update": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &hostscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -904,6 +1061,24 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			}, nil
 		},
 
+		"maintenance": func() (cli.Command, error) {
+			return &maintenancecmd.Command{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"maintenance read": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &maintenancecmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "read",
+			}
+		}),
+		"maintenance set-read-only": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &maintenancecmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "set-read-only",
+			}
+		}),
+
 		"managed-groups": func() (cli.Command, error) {
 			return &managedgroupscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -1149,6 +1324,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 			}
 		}),
+		"scopes list-dangling-references": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &scopescmd.ListDanglingReferencesCommand{
+				Command: base.NewCommand(ui, opts...),
+			}
+		}),
 		"scopes rotate-keys": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &scopescmd.RotateKeysCommand{
 				Command: base.NewCommand(ui, opts...),
@@ -1176,6 +1356,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "detach-storage-policy",
 			}
 		}),
+		"scopes set-deletion-protection": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &scopescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "set-deletion-protection",
+			}
+		}),
 
 		"search": func() (cli.Command, error) {
 			return &unsupported.UnsupportedCommand{
@@ -1207,6 +1393,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "cancel",
 			}
 		}),
+		"sessions cancel-many": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &sessionscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "cancel-many",
+			}
+		}),
 
 		"session-recordings": func() (cli.Command, error) {
 			return &sessionrecordingscmd.Command{
@@ -1236,11 +1428,21 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "delete",
 			}
 		}),
+		"session-recordings play": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &sessionrecordingscmd.PlayCommand{
+				Command: base.NewCommand(ui, opts...),
+			}
+		}),
 		"session-recordings reapply-storage-policy": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &sessionrecordingscmd.ReApplyStoragePolicyCommand{
 				Command: base.NewCommand(ui, opts...),
 			}
 		}),
+		"session-recordings repair": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &sessionrecordingscmd.RepairCommand{
+				Command: base.NewCommand(ui, opts...),
+			}
+		}),
 
 		"storage-buckets": func() (cli.Command, error) {
 			return &storagebucketscmd.Command{
@@ -1278,6 +1480,42 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			}
 		}),
 
+		"target-templates": func() (cli.Command, error) {
+			return &targettemplatescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"target-templates create": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targettemplatescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}
+		}),
+		"target-templates update": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targettemplatescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}
+		}),
+		"target-templates read": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targettemplatescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "read",
+			}
+		}),
+		"target-templates delete": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targettemplatescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "delete",
+			}
+		}),
+		"target-templates list": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targettemplatescmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "list",
+			}
+		}),
+
 		"targets": func() (cli.Command, error) {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -1289,6 +1527,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "authorize-session",
 			}
 		}),
+		"targets emit-ssh-config": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targetscmd.EmitSshConfigCommand{
+				Command: base.NewCommand(ui, opts...),
+			}
+		}),
 		"targets read": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -1379,6 +1622,30 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "set-credential-sources",
 			}
 		}),
+		"targets read-recording-policy": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targetscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "read-recording-policy",
+			}
+		}),
+		"targets test-connection": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targetscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "test-connection",
+			}
+		}),
+		"targets create-from-template": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targetscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create-from-template",
+			}
+		}),
+		"targets set-deletion-protection": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targetscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "set-deletion-protection",
+			}
+		}),
 
 		"update": func() (cli.Command, error) {
 			return &genericcmd.Command{
@@ -1387,6 +1654,13 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			}, nil
 		},
 
+		"tui": func() (cli.Command, error) {
+			return &unsupported.UnsupportedCommand{
+				Command:     base.NewCommand(ui, opts...),
+				CommandName: "tui",
+			}, nil
+		},
+
 		"users": func() (cli.Command, error) {
 			return &userscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -1523,6 +1797,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "reinitialize",
 			}
 		}),
+		"workers logs": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &workerscmd.WorkerLogsCommand{
+				Command: base.NewCommand(ui, opts...),
+			}
+		}),
 	}
 
 	for _, fn := range extraCommandsFuncs {
@@ -86,7 +86,7 @@ var flagsPasswordMap = map[string][]string{
 
 	"create": {"auth-method-id", "name", "description"},
 
-	"update": {"id", "name", "description", "version"},
+	"update": {"id", "name", "description", "auth-method-id", "version"},
 }
 
 func (c *PasswordCommand) Flags() *base.FlagSets {
@@ -125,7 +125,18 @@ func (c *PasswordCommand) Run(args []string) int {
 		return base.CommandUserError
 	}
 
-	if strutil.StrListContains(flagsPasswordMap[c.Func], "id") && c.FlagId == "" {
+	// The standard actions can address the resource by name instead of ID
+	var lookupByName bool
+	switch c.Func {
+	case "read", "update", "delete":
+		lookupByName = c.FlagId == "" && c.FlagName != ""
+		if c.Func != "update" && c.FlagId != "" && c.FlagName != "" {
+			c.PrintCliError(errors.New("Cannot specify both -id and -name; choose one or the other"))
+			return base.CommandUserError
+		}
+	}
+
+	if strutil.StrListContains(flagsPasswordMap[c.Func], "id") && c.FlagId == "" && !lookupByName {
 		c.PrintCliError(errors.New("ID is required but not passed in via -id"))
 		return base.CommandUserError
 	}
@@ -158,6 +169,33 @@ func (c *PasswordCommand) Run(args []string) int {
 	}
 	accountsClient := accounts.NewClient(client)
 
+	if lookupByName {
+		if c.FlagAuthMethodId == "" {
+			c.PrintCliError(errors.New("AuthMethod ID must be passed in via -auth-method-id or BOUNDARY_AUTH_METHOD_ID to look up by name"))
+			return base.CommandUserError
+		}
+
+		var exitCode int
+		c.FlagId, exitCode = common.LookupIdByName(c.Command, client, c.plural, c.FlagAuthMethodId, func(containerId, filter string) ([]common.NameMatch, error) {
+			result, err := accountsClient.List(c.Context, containerId, accounts.WithFilter(filter))
+			if err != nil {
+				return nil, err
+			}
+			matches := make([]common.NameMatch, 0, len(result.GetItems()))
+			for _, item := range result.GetItems() {
+				matches = append(matches, common.NameMatch{Id: item.Id, Scope: item.Scope})
+			}
+			return matches, nil
+		})
+		if exitCode > 0 {
+			return exitCode
+		}
+		if c.Func == "update" {
+			// The name identified the resource to update, it is not a new name
+			c.FlagName = ""
+		}
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
@@ -262,7 +300,7 @@ func (c *PasswordCommand) checkFuncError(err error) int {
 		c.PrintApiError(apiErr, fmt.Sprintf("Error from controller when performing %s on %s", c.Func, c.plural))
 		return base.CommandApiError
 	}
-	c.PrintCliError(fmt.Errorf("Error trying to %s %s: %s", c.Func, c.plural, err.Error()))
+	c.PrintCliError(fmt.Errorf("Error trying to %s %s: %w", c.Func, c.plural, err))
 	return base.CommandCliError
 }
 
@@ -90,9 +90,9 @@ func (c *Command) Help() string {
 
 var flagsMap = map[string][]string{
 
-	"read": {"id"},
+	"read": {"id", "name", "scope-id", "scope-name"},
 
-	"delete": {"id"},
+	"delete": {"id", "name", "scope-id", "scope-name"},
 
 	"list": {"scope-id", "filter", "recursive"},
 }
@@ -139,7 +139,18 @@ func (c *Command) Run(args []string) int {
 		return base.CommandUserError
 	}
 
-	if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" {
+	// The standard actions can address the resource by name instead of ID
+	var lookupByName bool
+	switch c.Func {
+	case "read", "update", "delete":
+		lookupByName = c.FlagId == "" && c.FlagName != ""
+		if c.Func != "update" && c.FlagId != "" && c.FlagName != "" {
+			c.PrintCliError(errors.New("Cannot specify both -id and -name; choose one or the other"))
+			return base.CommandUserError
+		}
+	}
+
+	if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" && !lookupByName {
 		c.PrintCliError(errors.New("ID is required but not passed in via -id"))
 		return base.CommandUserError
 	}
@@ -172,6 +183,28 @@ func (c *Command) Run(args []string) int {
 	}
 	aliasesClient := aliases.NewClient(client)
 
+	if lookupByName {
+		var exitCode int
+		c.FlagId, exitCode = common.LookupIdByName(c.Command, client, c.plural, "", func(containerId, filter string) ([]common.NameMatch, error) {
+			result, err := aliasesClient.List(c.Context, containerId, aliases.WithFilter(filter), aliases.WithRecursive(true))
+			if err != nil {
+				return nil, err
+			}
+			matches := make([]common.NameMatch, 0, len(result.GetItems()))
+			for _, item := range result.GetItems() {
+				matches = append(matches, common.NameMatch{Id: item.Id, Scope: item.Scope})
+			}
+			return matches, nil
+		})
+		if exitCode > 0 {
+			return exitCode
+		}
+		if c.Func == "update" {
+			// The name identified the resource to update, it is not a new name
+			c.FlagName = ""
+		}
+	}
+
 	switch c.FlagRecursive {
 	case true:
 		opts = append(opts, aliases.WithRecursive(true))
@@ -290,7 +323,7 @@ func (c *Command) checkFuncError(err error) int {
 		c.PrintApiError(apiErr, fmt.Sprintf("Error from controller when performing %s on %s", c.Func, c.plural))
 		return base.CommandApiError
 	}
-	c.PrintCliError(fmt.Errorf("Error trying to %s %s: %s", c.Func, c.plural, err.Error()))
+	c.PrintCliError(fmt.Errorf("Error trying to %s %s: %w", c.Func, c.plural, err))
 	return base.CommandCliError
 }
 
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package auditcmd
+
+import (
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+)
+
+var _ cli.Command = (*Command)(nil)
+
+type Command struct {
+	*base.Command
+}
+
+func (c *Command) Synopsis() string {
+	return "Work with Boundary's audit events"
+}
+
+func (c *Command) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary audit <subcommand> [options] [args]",
+		"",
+		"  This command groups subcommands for operators working with the audit events written by Boundary's sinks. Example:",
+		"",
+		"    Verify the hash chain of the audit events in a file sink:",
+		"",
+		"      $ boundary audit verify audit.log",
+		"",
+		"  Please see the individual subcommand help for detailed usage information.",
+	})
+}
+
+func (c *Command) Run(args []string) int {
+	return cli.RunResultHelp
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package auditcmd
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*VerifyCommand)(nil)
+	_ cli.CommandAutocomplete = (*VerifyCommand)(nil)
+)
+
+type VerifyCommand struct {
+	*base.Command
+
+	flagTsaCaCert string
+}
+
+func (c *VerifyCommand) Synopsis() string {
+	return "Verify the hash chain of audit events"
+}
+
+func (c *VerifyCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary audit verify [options] <file> [<file>...]",
+		"",
+		`  Verify the hash chain of the audit events written by sinks with an "audit_config" containing a "hash_chain" block. The files of a sink which rotates its files must all be given for its chains to be complete. Example:`,
+		"",
+		"    $ boundary audit verify audit-1696868405.log audit.log",
+		"",
+		"  The command fails if any audit event was modified or removed, or is not part of a chain. Timestamp tokens are verified against the certificates included in them, and with -tsa-ca-cert also against the certificate of a trusted time-stamp authority.",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *VerifyCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetOutputFormat)
+
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "tsa-ca-cert",
+		Target:     &c.flagTsaCaCert,
+		Completion: complete.PredictFiles("*"),
+		Usage:      "A file containing the PEM encoded CA certificates the certificates of the time-stamp authorities which issued timestamp tokens must chain to.",
+	})
+
+	return set
+}
+
+func (c *VerifyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*")
+}
+
+func (c *VerifyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *VerifyCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+	if len(f.Args()) == 0 {
+		c.UI.Error("No audit event files given")
+		return base.CommandUserError
+	}
+
+	var opts []event.Option
+	if c.flagTsaCaCert != "" {
+		pem, err := os.ReadFile(c.flagTsaCaCert)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading tsa ca cert file: %v", err))
+			return base.CommandUserError
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			c.UI.Error("No certificates found in tsa ca cert file")
+			return base.CommandUserError
+		}
+		opts = append(opts, event.WithTimestampRoots(roots))
+	}
+
+	readers := make([]io.Reader, 0, len(f.Args()))
+	for _, name := range f.Args() {
+		file, err := os.Open(name)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error opening audit event file: %v", err))
+			return base.CommandUserError
+		}
+		defer file.Close()
+		readers = append(readers, file)
+	}
+
+	report, err := event.VerifyAuditChain(io.MultiReader(readers...), opts...)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading audit events: %v", err))
+		return base.CommandCliError
+	}
+
+	ret := base.CommandSuccess
+	if !report.Valid() {
+		ret = base.CommandCliError
+	}
+
+	if base.Format(c.UI) == "json" {
+		b, err := base.JsonFormatter{}.Format(report)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return base.CommandCliError
+		}
+		c.UI.Output(string(b))
+		return ret
+	}
+
+	nonAttributeMap := map[string]any{
+		"Chains":           report.Chains,
+		"Audit Events":     report.Events,
+		"Timestamp Tokens": report.Timestamps,
+	}
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+	out := []string{
+		"",
+		"Audit chain verification:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+	if len(report.Failures) > 0 {
+		out = append(out,
+			"",
+			"  Failures:",
+			base.WrapSlice(4, report.Failures),
+		)
+	}
+	c.UI.Output(base.WrapForHelpText(out))
+	if ret != base.CommandSuccess {
+		c.UI.Error("Audit events failed verification")
+	}
+	return ret
+}
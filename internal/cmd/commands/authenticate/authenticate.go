@@ -91,16 +91,22 @@ func (c *Command) Run(args []string) int {
 		return base.CommandCliError
 	}
 
-	// Lookup the primary auth method ID in the global scope
-	aClient := authmethods.NewClient(client)
-	pri, err := getPrimaryAuthMethodId(c.Context, aClient, scope.Global.String(), "")
-	if err != nil {
-		c.PrintCliError(errors.New("Error looking up primary auth method ID for the global scope. Try setting a primary auth method for the global scope, or use an auth method subcommand (see 'boundary authenticate -h' for available sub command usage)."))
-		return base.CommandUserError
+	switch p := c.Profile(); {
+	case p != nil && p.AuthMethodId != "":
+		// The auth method of the selected profile takes precedence over the
+		// primary auth method.
+		c.FlagAuthMethodId = p.AuthMethodId
+	default:
+		// Lookup the primary auth method ID in the global scope
+		aClient := authmethods.NewClient(client)
+		pri, err := getPrimaryAuthMethodId(c.Context, aClient, scope.Global.String(), "")
+		if err != nil {
+			c.PrintCliError(errors.New("Error looking up primary auth method ID for the global scope. Try setting a primary auth method for the global scope, or use an auth method subcommand (see 'boundary authenticate -h' for available sub command usage)."))
+			return base.CommandUserError
+		}
+		c.FlagAuthMethodId = pri
 	}
 
-	c.FlagAuthMethodId = pri
-
 	var result int
 	switch {
 	case strings.HasPrefix(c.FlagAuthMethodId, globals.PasswordAuthMethodPrefix):
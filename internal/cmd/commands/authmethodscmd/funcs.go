@@ -15,7 +15,56 @@ import (
 )
 
 func init() {
-	extraOidcSynopsisFunc = extraSynopsisFuncImpl
+	extraOidcSynopsisFunc = extraOidcSynopsisFuncImpl
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+}
+
+type extraCmdVars struct {
+	flagProtect bool
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"set-deletion-protection": {"id", "protect"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "set-deletion-protection":
+		return "Protect an auth method from deletion, or remove its protection"
+	default:
+		return ""
+	}
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "protect":
+			f.BoolVar(&base.BoolVar{
+				Name:    "protect",
+				Target:  &c.flagProtect,
+				Default: true,
+				Usage:   "Whether to protect the auth method from deletion. Use -protect=false to remove its protection. Defaults to true.",
+			})
+		}
+	}
+}
+
+func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *authmethods.AuthMethod, origItems []*authmethods.AuthMethod, origError error, amClient *authmethods.Client, _ uint32, opts []authmethods.Option) (*api.Response, *authmethods.AuthMethod, []*authmethods.AuthMethod, error) {
+	switch c.Func {
+	case "set-deletion-protection":
+		result, err := amClient.SetDeletionProtection(c.Context, c.FlagId, c.flagProtect, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
+	}
+	return origResp, origItem, origItems, origError
 }
 
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
@@ -57,11 +106,27 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
+	case "set-deletion-protection":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods set-deletion-protection [options] [args]",
+			"",
+			"  This command protects an auth method from deletion, or removes its protection. While the auth method is protected, deleting it or any scope containing it fails. Example:",
+			"",
+			"    Protect an auth method from deletion:",
+			"",
+			`      $ boundary auth-methods set-deletion-protection -id ampw_1234567890`,
+			"",
+			"    Remove the protection of an auth method:",
+			"",
+			`      $ boundary auth-methods set-deletion-protection -id ampw_1234567890 -protect=false`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
 
-func extraSynopsisFuncImpl(c *OidcCommand) string {
+func extraOidcSynopsisFuncImpl(c *OidcCommand) string {
 	switch c.Func {
 	case "change-state":
 		return "Change the active state of an auth method"
@@ -164,6 +229,9 @@ func printItemTable(item *authmethods.AuthMethod, resp *api.Response) string {
 			nonAttributeMap["Is Primary For Scope"] = item.IsPrimary
 		}
 	}
+	if item.ProtectFromDeletion {
+		nonAttributeMap["Protect From Deletion"] = item.ProtectFromDeletion
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
 
@@ -4,8 +4,11 @@
 package billingcmd
 
 import (
+	"encoding/csv"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/billing"
@@ -26,11 +29,13 @@ type extraCmdVars struct {
 	flagStartTime      string
 	flagEndTime        string
 	monthlyActiveUsers *billing.MonthlyActiveUsersResult
+	scopeUsage         *billing.ScopeUsageResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"monthly-active-users": {"start-time", "end-time"},
+		"scope-usage":          {"start-time", "end-time"},
 	}
 }
 
@@ -46,28 +51,35 @@ func extraSynopsisFuncImpl(c *Command) string {
 		}
 		return wordwrap.WrapString(in, base.TermWidth)
 
+	case "scope-usage":
+		return wordwrap.WrapString("Get the monthly usage of each project scope.", base.TermWidth)
+
 	default:
 		return ""
 	}
 }
 
 func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	report := "monthly active users"
+	if c.Func == "scope-usage" {
+		report = "monthly usage of each project scope"
+	}
 	flagsMap[c.Func] = append(flagsMap[c.Func], "start-time", "end-time")
 	f.StringVar(&base.StringVar{
 		Name:   "start-time",
 		Target: &c.flagStartTime,
-		Usage:  "Get monthly active users, starting from this time (YYYY-MM format).",
+		Usage:  fmt.Sprintf("Get %s, starting from this time (YYYY-MM format).", report),
 	})
 	f.StringVar(&base.StringVar{
 		Name:   "end-time",
 		Target: &c.flagEndTime,
-		Usage:  "Get monthly active users, ending at this time (YYYY-MM format).",
+		Usage:  fmt.Sprintf("Get %s, ending at this time (YYYY-MM format).", report),
 	})
 }
 
 func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]billing.Option) bool {
 	switch c.Func {
-	case "monthly-active-users":
+	case "monthly-active-users", "scope-usage":
 		if len(c.flagStartTime) != 0 {
 			*opts = append(*opts, billing.WithStartTime(c.flagStartTime))
 		}
@@ -87,6 +99,12 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origError error
 		if err != nil {
 			return nil, err
 		}
+	case "scope-usage":
+		var err error
+		c.scopeUsage, err = billingClient.ScopeUsage(c.Context, opts...)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return origResp, origError
 }
@@ -119,11 +137,74 @@ func printCustomActionOutputImpl(c *Command) (bool, error) {
 			}
 			return true, nil
 		}
+
+	case "scope-usage":
+		items := c.scopeUsage.GetItems().([]*billing.ScopeUsage)
+		switch base.Format(c.UI) {
+		case "table":
+			var ret []string
+
+			ret = append(ret, "Scope usage information:")
+			ret = append(ret, "")
+			for i := range items {
+				ret = append(ret,
+					fmt.Sprintf("  Scope ID:           %s", items[i].ScopeId),
+					fmt.Sprintf("  Start Time:         %s", items[i].StartTime),
+					fmt.Sprintf("  End Time:           %s", items[i].EndTime),
+					fmt.Sprintf("  Active Users Count: %d", items[i].ActiveUsersCount),
+					fmt.Sprintf("  Session Count:      %d", items[i].SessionCount),
+					fmt.Sprintf("  Session Hours:      %.2f", items[i].SessionHours),
+					fmt.Sprintf("  Recorded Bytes:     %d", items[i].RecordedBytes),
+					"",
+				)
+			}
+
+			c.UI.Output(base.WrapForHelpText(ret))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.scopeUsage.GetResponse()); !ok {
+				return false, fmt.Errorf("error formatting as JSON")
+			}
+			return true, nil
+
+		case "csv":
+			out, err := scopeUsageCsv(items)
+			if err != nil {
+				return false, fmt.Errorf("error formatting as CSV: %w", err)
+			}
+			c.UI.Output(out)
+			return true, nil
+		}
 	}
 
 	return false, nil
 }
 
+// scopeUsageCsv returns the scope usage items as CSV, with a header row.
+func scopeUsageCsv(items []*billing.ScopeUsage) (string, error) {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	records := [][]string{
+		{"scope_id", "start_time", "end_time", "active_users_count", "session_count", "session_hours", "recorded_bytes"},
+	}
+	for _, item := range items {
+		records = append(records, []string{
+			item.ScopeId,
+			item.StartTime.Format(time.RFC3339),
+			item.EndTime.Format(time.RFC3339),
+			strconv.FormatUint(uint64(item.ActiveUsersCount), 10),
+			strconv.FormatUint(item.SessionCount, 10),
+			strconv.FormatFloat(item.SessionHours, 'f', 2, 64),
+			strconv.FormatUint(item.RecordedBytes, 10),
+		})
+	}
+	if err := w.WriteAll(records); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
@@ -137,6 +218,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary billing monthly-active-users`,
 			"",
+			"    Monthly usage of each project scope:",
+			"",
+			`      $ boundary billing scope-usage`,
+			"",
 			"  Please see the billing subcommand help for detailed usage information.",
 		})
 	case "monthly-active-users":
@@ -151,6 +236,18 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"  Please see the billing subcommand help for detailed usage information.",
 		})
+	case "scope-usage":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary billing scope-usage [options]",
+			"",
+			"  This command allows for collecting the usage of each project scope, by month: the number of active users, the number of sessions and the hours they were active, and the number of bytes recorded. Use -format=csv to export the report as CSV. Example:",
+			"",
+			"    Usage of each project scope between September 2023 and February 2024, as CSV:",
+			"",
+			`      $ boundary billing scope-usage -start-time="2023-09" -end-time="2024-02" -format=csv`,
+			"",
+			"  Please see the billing subcommand help for detailed usage information.",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
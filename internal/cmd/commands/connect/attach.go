@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/posener/complete"
+)
+
+const (
+	attachSynopsis = "Attach to a session shared by another boundary connect command"
+)
+
+// AttachInfo describes the local listener of an attached session.
+type AttachInfo struct {
+	Address         string    `json:"address"`
+	Port            int       `json:"port"`
+	SessionId       string    `json:"session_id"`
+	TokenExpiration time.Time `json:"token_expiration"`
+}
+
+func (c *Command) attachFlags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Attach Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "token",
+		Target:     &c.flagShareToken,
+		EnvVar:     "BOUNDARY_CONNECT_SHARE_TOKEN",
+		Completion: complete.PredictNothing,
+		Usage:      `The share token printed by "boundary connect -share-ttl". If set to "-", the command will attempt to read the token from standard input.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "share-host",
+		Target:     &c.flagShareHost,
+		EnvVar:     "BOUNDARY_CONNECT_SHARE_HOST",
+		Completion: complete.PredictAnything,
+		Usage:      `If set, overrides the host of the shared session's address from the token, for example "host.docker.internal" when attaching from a container.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "listen-addr",
+		Target:     &c.flagListenAddr,
+		EnvVar:     "BOUNDARY_CONNECT_LISTEN_ADDR",
+		Completion: complete.PredictAnything,
+		Usage:      `If set, the CLI will attempt to bind its listening address to the given value, which must be an IP address. If not set, defaults to the most common IPv4 loopback address (127.0.0.1).`,
+	})
+
+	f.Int64Var(&base.Int64Var{
+		Name:       "listen-port",
+		Target:     &c.flagListenPort,
+		EnvVar:     "BOUNDARY_CONNECT_LISTEN_PORT",
+		Completion: complete.PredictAnything,
+		Usage:      `If set, the CLI will attempt to bind its listening port to the given value. If it cannot, the command will error.`,
+	})
+
+	return set
+}
+
+// runAttach listens locally and forwards each connection to the shared
+// session of the token. It stops accepting connections when the token
+// expires and returns once the connections already made are closed.
+func (c *Command) runAttach(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	tokenString := c.flagShareToken
+	if tokenString == "-" {
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error reading share token from stdin: %w", err))
+			return base.CommandUserError
+		}
+		tokenString = string(b)
+	}
+	if tokenString == "" {
+		c.PrintCliError(errors.New("A share token must be provided via -token"))
+		return base.CommandUserError
+	}
+	token, err := decodeShareToken(tokenString)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error decoding share token: %w", err))
+		return base.CommandUserError
+	}
+	if time.Now().After(token.Expiration) {
+		c.PrintCliError(errors.New("Share token has expired"))
+		return base.CommandUserError
+	}
+	if c.flagListenPort < 0 || c.flagListenPort > math.MaxUint16 {
+		c.PrintCliError(errors.New("Invalid listen port supplied"))
+		return base.CommandCliError
+	}
+	if c.flagListenAddr == "" {
+		c.flagListenAddr = "127.0.0.1"
+	}
+	if net.ParseIP(c.flagListenAddr) == nil {
+		c.PrintCliError(fmt.Errorf("Error parsing listen address %q", c.flagListenAddr))
+		return base.CommandCliError
+	}
+
+	l, err := net.Listen("tcp", net.JoinHostPort(c.flagListenAddr, strconv.FormatInt(c.flagListenPort, 10)))
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error starting listener: %w", err))
+		return base.CommandCliError
+	}
+	ctx, cancel := context.WithDeadline(c.Context, token.Expiration)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = l.Close()
+	}()
+
+	addr := l.Addr().(*net.TCPAddr)
+	info := AttachInfo{
+		Address:         addr.IP.String(),
+		Port:            addr.Port,
+		SessionId:       token.SessionId,
+		TokenExpiration: token.Expiration,
+	}
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateAttachInfoTableOutput(info))
+	case "json":
+		out, err := json.Marshal(&info)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error marshaling attach information: %w", err))
+			return base.CommandCliError
+		}
+		c.UI.Output(string(out))
+	}
+
+	var wg sync.WaitGroup
+	for {
+		local, err := l.Accept()
+		if err != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer local.Close()
+			remote, err := attachShared(c.Context, token, c.flagShareHost)
+			if err != nil {
+				c.PrintCliError(err)
+				return
+			}
+			defer remote.Close()
+			pipe(local, remote)
+		}()
+	}
+	wg.Wait()
+	return base.CommandSuccess
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCredentials(username, password string) proxy.Credentials {
+	return proxy.Credentials{
+		UsernamePassword: []proxy.UsernamePassword{{Username: username, Password: password}},
+	}
+}
+
+func runCleanup(t *testing.T, c *Command) {
+	t.Helper()
+	for _, f := range c.cleanupFuncs {
+		require.NoError(t, f())
+	}
+}
+
+func TestPostgresBuildArgs(t *testing.T) {
+	c := &Command{Command: base.NewCommand(cli.NewMockUi()), Func: "postgres"}
+	c.flagPostgresStyle = "psql"
+	c.flagDbname = "app"
+
+	args, envs, creds, err := c.postgresFlags.buildArgs(c, "5432", "127.0.0.1", "", testCredentials("user", `p:a\ss`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-p", "5432", "-h", "127.0.0.1", "-d", "app", "-U", "user"}, args)
+	assert.True(t, creds.UsernamePassword[0].Consumed)
+	require.Len(t, envs, 1)
+	passfile := strings.TrimPrefix(envs[0], "PGPASSFILE=")
+	contents, err := os.ReadFile(passfile)
+	require.NoError(t, err)
+	assert.Equal(t, `*:*:*:*:p\:a\\ss`, string(contents))
+	info, err := os.Stat(passfile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	runCleanup(t, c)
+	_, err = os.Stat(passfile)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestMySQLBuildArgs(t *testing.T) {
+	c := &Command{Command: base.NewCommand(cli.NewMockUi()), Func: "mysql"}
+	c.flagMySQLStyle = "mysql"
+	c.flagDbname = "app"
+
+	leadingArgs, args, envs, creds, err := c.mysqlFlags.buildArgs(c, "3306", "127.0.0.1", "", testCredentials("user", "pass\\word"))
+	require.NoError(t, err)
+	assert.Empty(t, envs)
+	assert.Equal(t, []string{"--protocol=TCP", "-h", "127.0.0.1", "-P", "3306", "-u", "user", "-D", "app"}, args)
+	assert.True(t, creds.UsernamePassword[0].Consumed)
+	require.Len(t, leadingArgs, 1)
+	optionFile, ok := strings.CutPrefix(leadingArgs[0], "--defaults-extra-file=")
+	require.True(t, ok)
+	contents, err := os.ReadFile(optionFile)
+	require.NoError(t, err)
+	assert.Equal(t, "[client]\npassword=\"pass\\\\word\"\n", string(contents))
+
+	runCleanup(t, c)
+	_, err = os.Stat(optionFile)
+	assert.True(t, os.IsNotExist(err))
+
+	// Without brokered credentials the username flag is used
+	c = &Command{Command: base.NewCommand(cli.NewMockUi()), Func: "mysql"}
+	c.flagMySQLStyle = "mysql"
+	c.flagUsername = "flaguser"
+	leadingArgs, args, _, _, err = c.mysqlFlags.buildArgs(c, "3306", "127.0.0.1", "", proxy.Credentials{})
+	require.NoError(t, err)
+	assert.Empty(t, leadingArgs)
+	assert.Equal(t, []string{"--protocol=TCP", "-h", "127.0.0.1", "-P", "3306", "-u", "flaguser"}, args)
+}
+
+func TestRedisBuildArgs(t *testing.T) {
+	c := &Command{Command: base.NewCommand(cli.NewMockUi()), Func: "redis"}
+	c.flagRedisStyle = "redis-cli"
+	c.flagDbname = "2"
+
+	args, envs, creds, err := c.redisFlags.buildArgs(c, "6379", "127.0.0.1", "", testCredentials("user", "pass"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-h", "127.0.0.1", "-p", "6379", "-n", "2", "--user", "user"}, args)
+	assert.Equal(t, []string{"REDISCLI_AUTH=pass"}, envs)
+	assert.True(t, creds.UsernamePassword[0].Consumed)
+}
+
+func TestMongoBuildArgs(t *testing.T) {
+	c := &Command{Command: base.NewCommand(cli.NewMockUi()), Func: "mongo"}
+	c.flagMongoStyle = "mongosh"
+	c.flagMongoAuthDb = "admin"
+	c.flagDbname = "app"
+
+	args, envs, creds, err := c.mongoFlags.buildArgs(c, "27017", "127.0.0.1", "", testCredentials("user", "pass"))
+	require.NoError(t, err)
+	assert.Empty(t, envs)
+	assert.Equal(t, []string{"--host", "127.0.0.1", "--port", "27017", "--username", "user", "--authenticationDatabase", "admin", "app"}, args)
+	// The password is left to be printed, as mongosh prompts for it
+	assert.False(t, creds.UsernamePassword[0].Consumed)
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/netip"
 	"os"
 	"strconv"
@@ -42,6 +43,8 @@ type SessionInfo struct {
 	TargetId        string                       `json:"-"`
 	HostId          string                       `json:"-"`
 	Credentials     []*targets.SessionCredential `json:"credentials,omitempty"`
+	ShareToken      string                       `json:"share_token,omitempty"`
+	ShareExpiration *time.Time                   `json:"share_token_expiration,omitempty"`
 }
 
 type ConnectionInfo struct {
@@ -60,15 +63,25 @@ var (
 type Command struct {
 	*base.Command
 
-	flagAuthzToken string
-	flagListenAddr string
-	flagListenPort int64
-	flagTargetId   string
-	flagTargetName string
-	flagHostId     string
-	flagExec       string
-	flagUsername   string
-	flagDbname     string
+	flagAuthzToken   string
+	flagListenAddr   string
+	flagListenPort   int64
+	flagListenSocket string
+	flagTargetPort   int64
+	flagTargetId     string
+	flagTargetName   string
+	flagHostId       string
+	flagExec         string
+	flagUsername     string
+	flagDbname       string
+
+	flagPinWorkerCertificate bool
+
+	// Sharing
+	flagShareTtl        time.Duration
+	flagShareListenAddr string
+	flagShareToken      string
+	flagShareHost       string
 
 	// HTTP
 	httpFlags
@@ -76,9 +89,18 @@ type Command struct {
 	// Kube
 	kubeFlags
 
+	// MongoDB
+	mongoFlags
+
+	// MySQL
+	mysqlFlags
+
 	// Postgres
 	postgresFlags
 
+	// Redis
+	redisFlags
+
 	// RDP
 	rdpFlags
 
@@ -103,14 +125,24 @@ func (c *Command) Synopsis() string {
 		return "Connect to a target through a Boundary worker"
 	case "http":
 		return httpSynopsis
+	case "mongo":
+		return mongoSynopsis
+	case "mysql":
+		return mysqlSynopsis
 	case "postgres":
 		return postgresSynopsis
+	case "redis":
+		return redisSynopsis
 	case "rdp":
 		return rdpSynopsis
 	case "ssh":
 		return sshSynopsis
 	case "kube":
 		return kubeSynopsis
+	case "stdio":
+		return stdioSynopsis
+	case "attach":
+		return attachSynopsis
 	default:
 		return ""
 	}
@@ -131,6 +163,32 @@ func (c *Command) Help() string {
 			"",
 		}) + c.Flags().Help()
 
+	case "stdio":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary connect stdio [options] [args]",
+			"",
+			`  This command performs a target authorization (or consumes an existing authorization token) and proxies a single connection over its standard input and output. It is meant to be used as an SSH ProxyCommand; see "boundary targets emit-ssh-config".`,
+			"",
+			"  Example:",
+			"",
+			`      $ ssh -o ProxyCommand="boundary connect stdio -target-id tssh_1234567890" tssh_1234567890`,
+			"",
+			"",
+		}) + c.Flags().Help()
+
+	case "attach":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary connect attach [options]",
+			"",
+			`  This command attaches to a session shared by another "boundary connect -share-ttl" command, for instance from a container, using the share token it printed. It listens locally and forwards connections to the local listener of the shared session.`,
+			"",
+			"  Example:",
+			"",
+			`      $ boundary connect attach -token bst_1234567890 -share-host host.docker.internal -listen-port 5432`,
+			"",
+			"",
+		}) + c.Flags().Help()
+
 	default:
 		return base.WrapForHelpText([]string{
 			fmt.Sprintf("Usage: boundary connect %s [options] [args]", c.Func),
@@ -147,6 +205,10 @@ func (c *Command) Help() string {
 }
 
 func (c *Command) Flags() *base.FlagSets {
+	if c.Func == "attach" {
+		return c.attachFlags()
+	}
+
 	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
 	f := set.NewFlagSet("Connect Options")
 
@@ -200,6 +262,21 @@ func (c *Command) Flags() *base.FlagSets {
 		Usage:      "Target scope name, if authorizing the session via scope parameters and target name. Mutually exclusive with -scope-id.",
 	})
 
+	f.BoolVar(&base.BoolVar{
+		Name:   "pin-worker-certificate",
+		Target: &c.flagPinWorkerCertificate,
+		EnvVar: "BOUNDARY_CONNECT_PIN_WORKER_CERTIFICATE",
+		Usage:  "If set, connections are refused unless the worker presents exactly the session certificate from the authorization data, rather than any certificate signed by it. This hardens against interception of the connection between the client and the worker.",
+	})
+
+	f.Int64Var(&base.Int64Var{
+		Name:       "target-port",
+		Target:     &c.flagTargetPort,
+		EnvVar:     "BOUNDARY_CONNECT_TARGET_PORT",
+		Completion: complete.PredictAnything,
+		Usage:      `If set, connections are made to this port of the endpoint instead of the target's default port. The port must be one of the target's allowed ports.`,
+	})
+
 	switch c.Func {
 	case "connect":
 		f.StringVar(&base.StringVar{
@@ -218,12 +295,45 @@ func (c *Command) Flags() *base.FlagSets {
 			Usage:      `If set, the CLI will attempt to bind its listening port to the given value. If it cannot, the command will error.`,
 		})
 
+		f.StringVar(&base.StringVar{
+			Name:       "listen-socket",
+			Target:     &c.flagListenSocket,
+			EnvVar:     "BOUNDARY_CONNECT_LISTEN_SOCKET",
+			Completion: complete.PredictFiles("*"),
+			Usage:      `If set, the CLI will listen on a Unix socket at the given path, or on Windows a named pipe with the given name (for example \\.\pipe\boundary), instead of a TCP port. Only the current user can connect to it, so other users of the machine cannot use the session. The path must not exist. Cannot be used with -listen-addr, -listen-port or -share-ttl.`,
+		})
+
+		f.DurationVar(&base.DurationVar{
+			Name:       "share-ttl",
+			Target:     &c.flagShareTtl,
+			EnvVar:     "BOUNDARY_CONNECT_SHARE_TTL",
+			Completion: complete.PredictAnything,
+			Usage:      `If set, a share token is printed that lets another process, such as one running in a container, attach to this session's listener with "boundary connect attach" for the given duration. Connections already attached are not closed when the token expires.`,
+		})
+
+		f.StringVar(&base.StringVar{
+			Name:       "share-listen-addr",
+			Target:     &c.flagShareListenAddr,
+			EnvVar:     "BOUNDARY_CONNECT_SHARE_LISTEN_ADDR",
+			Completion: complete.PredictAnything,
+			Usage:      `The IP address on which to accept attachments when -share-ttl is set. It must be reachable from where "boundary connect attach" runs. If not set, defaults to the most common IPv4 loopback address (127.0.0.1).`,
+		})
+
 	case "http":
 		httpOptions(c, set)
 
+	case "mongo":
+		mongoOptions(c, set)
+
+	case "mysql":
+		mysqlOptions(c, set)
+
 	case "postgres":
 		postgresOptions(c, set)
 
+	case "redis":
+		redisOptions(c, set)
+
 	case "rdp":
 		rdpOptions(c, set)
 
@@ -246,6 +356,10 @@ func (c *Command) AutocompleteFlags() complete.Flags {
 }
 
 func (c *Command) Run(args []string) (retCode int) {
+	if c.Func == "attach" {
+		return c.runAttach(args)
+	}
+
 	var passthroughArgs []string
 	for i, v := range args {
 		if v == "--" {
@@ -269,6 +383,25 @@ func (c *Command) Run(args []string) (retCode int) {
 		c.PrintCliError(errors.New("Invalid listen port supplied"))
 		return base.CommandCliError
 	}
+	if c.flagTargetPort < 0 || c.flagTargetPort > math.MaxUint16 {
+		c.PrintCliError(errors.New("Invalid target port supplied"))
+		return base.CommandCliError
+	}
+	if c.flagListenSocket != "" && (c.flagListenAddr != "" || c.flagListenPort != 0 || c.flagShareTtl != 0) {
+		c.PrintCliError(errors.New("-listen-socket cannot be used with -listen-addr, -listen-port or -share-ttl"))
+		return base.CommandUserError
+	}
+	if c.flagShareTtl < 0 {
+		c.PrintCliError(errors.New("Invalid share token lifetime supplied"))
+		return base.CommandCliError
+	}
+	if c.flagShareListenAddr == "" {
+		c.flagShareListenAddr = "127.0.0.1"
+	}
+	if net.ParseIP(c.flagShareListenAddr) == nil {
+		c.PrintCliError(fmt.Errorf("Error parsing share listen address %q", c.flagShareListenAddr))
+		return base.CommandCliError
+	}
 
 	c.proxyCtx, c.proxyCancel = context.WithCancel(c.Context)
 	defer c.proxyCancel()
@@ -309,14 +442,27 @@ func (c *Command) Run(args []string) (retCode int) {
 			c.flagExec = c.httpFlags.defaultExec()
 		case "ssh":
 			c.flagExec = c.sshFlags.defaultExec()
+		case "mongo":
+			c.flagExec = c.mongoFlags.defaultExec()
+		case "mysql":
+			c.flagExec = c.mysqlFlags.defaultExec()
 		case "postgres":
 			c.flagExec = c.postgresFlags.defaultExec()
+		case "redis":
+			c.flagExec = c.redisFlags.defaultExec()
 		case "rdp":
 			c.flagExec = c.rdpFlags.defaultExec()
 		case "kube":
 			c.flagExec = c.kubeFlags.defaultExec()
 		}
 	}
+	if c.flagExec != "" {
+		// Find the client before authorizing a session that could not be used
+		if _, err := exec.LookPath(c.flagExec); err != nil {
+			c.PrintCliError(fmt.Errorf("Unable to find %q; install it or specify the client to run with -exec: %w", c.flagExec, err))
+			return base.CommandUserError
+		}
+	}
 
 	authzString := c.flagAuthzToken
 	switch {
@@ -430,9 +576,23 @@ func (c *Command) Run(args []string) (retCode int) {
 
 	connsLeftCh := make(chan int32)
 	apiProxyOpts := []apiproxy.Option{apiproxy.WithConnectionsLeftCh(connsLeftCh)}
-	if listenAddr.IsValid() {
+	switch {
+	case c.flagListenSocket != "":
+		l, err := listenSocket(c.flagListenSocket)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error starting listener: %w", err))
+			return base.CommandCliError
+		}
+		apiProxyOpts = append(apiProxyOpts, apiproxy.WithListener(l))
+	case listenAddr.IsValid():
 		apiProxyOpts = append(apiProxyOpts, apiproxy.WithListenAddrPort(listenAddr))
 	}
+	if c.flagPinWorkerCertificate {
+		apiProxyOpts = append(apiProxyOpts, apiproxy.WithPinnedWorkerCertificate(true))
+	}
+	if c.flagTargetPort != 0 {
+		apiProxyOpts = append(apiProxyOpts, apiproxy.WithEndpointPort(uint32(c.flagTargetPort)))
+	}
 	clientProxy, err := apiproxy.New(
 		c.proxyCtx,
 		authzString,
@@ -476,13 +636,33 @@ func (c *Command) Run(args []string) (retCode int) {
 
 		proxyAddr := clientProxy.ListenerAddress(context.Background())
 		var clientProxyHost, clientProxyPort string
-		clientProxyHost, clientProxyPort, err = util.SplitHostPort(proxyAddr)
-		if err != nil {
-			c.PrintCliError(fmt.Errorf("error splitting listener addr: %w", err))
-			return base.CommandCliError
+		if c.flagListenSocket != "" {
+			// Socket paths and pipe names have no port
+			clientProxyHost = proxyAddr
+		} else {
+			clientProxyHost, clientProxyPort, err = util.SplitHostPort(proxyAddr)
+			if err != nil {
+				c.PrintCliError(fmt.Errorf("error splitting listener addr: %w", err))
+				return base.CommandCliError
+			}
 		}
 		c.sessInfo.Address = clientProxyHost
 
+		if c.flagShareTtl > 0 {
+			share, err := newShareListener(net.JoinHostPort(c.flagShareListenAddr, "0"), proxyAddr, c.flagShareTtl)
+			if err != nil {
+				c.PrintCliError(err)
+				return base.CommandCliError
+			}
+			c.sessInfo.ShareToken, err = share.token(c.sessInfo.SessionId).encode()
+			if err != nil {
+				c.PrintCliError(fmt.Errorf("Error encoding share token: %w", err))
+				return base.CommandCliError
+			}
+			c.sessInfo.ShareExpiration = &share.expiration
+			go share.serve(c.proxyCtx)
+		}
+
 		if clientProxyPort != "" {
 			c.sessInfo.Port, err = strconv.Atoi(clientProxyPort)
 			if err != nil {
@@ -503,7 +683,11 @@ func (c *Command) Run(args []string) (retCode int) {
 		}
 	}
 
-	if c.flagExec != "" {
+	switch {
+	case c.Func == "stdio":
+		c.execCmdReturnValue = atomic.NewInt32(0)
+		c.handleStdio(clientProxy)
+	case c.flagExec != "":
 		c.execCmdReturnValue = atomic.NewInt32(0)
 		c.handleExec(clientProxy, passthroughArgs)
 	}
@@ -538,7 +722,7 @@ func (c *Command) Run(args []string) (retCode int) {
 		}
 	}
 
-	if termInfo.Reason != "" {
+	if termInfo.Reason != "" && c.Func != "stdio" {
 		switch base.Format(c.UI) {
 		case "table":
 			c.UI.Output(generateTerminationInfoTableOutput(termInfo))
@@ -581,7 +765,8 @@ func (c *Command) updateConnsLeft(connsLeft int32) {
 		ConnectionsLeft: connsLeft,
 	}
 
-	if c.flagExec == "" {
+	// The standard output of stdio is the proxied connection.
+	if c.flagExec == "" && c.Func != "stdio" {
 		switch base.Format(c.UI) {
 		case "table":
 			c.UI.Output(generateConnectionInfoTableOutput(connInfo))
@@ -600,14 +785,19 @@ func (c *Command) handleExec(clientProxy *apiproxy.ClientProxy, passthroughArgs
 
 	addr := clientProxy.ListenerAddress(context.Background())
 	var host, port string
-	var err error
-	host, port, err = util.SplitHostPort(addr)
-	if err != nil {
-		c.PrintCliError(fmt.Errorf("Error splitting listener addr: %w", err))
-		c.execCmdReturnValue.Store(int32(3))
-		return
+	if c.flagListenSocket != "" {
+		host = addr
+	} else {
+		var err error
+		host, port, err = util.SplitHostPort(addr)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error splitting listener addr: %w", err))
+			c.execCmdReturnValue.Store(int32(3))
+			return
+		}
 	}
 
+	var leadingArgs []string
 	var args []string
 	var envs []string
 	var argsErr error
@@ -643,6 +833,37 @@ func (c *Command) handleExec(clientProxy *apiproxy.ClientProxy, passthroughArgs
 		envs = append(envs, pgEnvs...)
 		creds = pgCreds
 
+	case "mongo":
+		mongoArgs, mongoEnvs, mongoCreds, mongoErr := c.mongoFlags.buildArgs(c, port, host, addr, creds)
+		if mongoErr != nil {
+			argsErr = mongoErr
+			break
+		}
+		args = append(args, mongoArgs...)
+		envs = append(envs, mongoEnvs...)
+		creds = mongoCreds
+
+	case "mysql":
+		mysqlLeadingArgs, mysqlArgs, mysqlEnvs, mysqlCreds, mysqlErr := c.mysqlFlags.buildArgs(c, port, host, addr, creds)
+		if mysqlErr != nil {
+			argsErr = mysqlErr
+			break
+		}
+		leadingArgs = append(leadingArgs, mysqlLeadingArgs...)
+		args = append(args, mysqlArgs...)
+		envs = append(envs, mysqlEnvs...)
+		creds = mysqlCreds
+
+	case "redis":
+		redisArgs, redisEnvs, redisCreds, redisErr := c.redisFlags.buildArgs(c, port, host, addr, creds)
+		if redisErr != nil {
+			argsErr = redisErr
+			break
+		}
+		args = append(args, redisArgs...)
+		envs = append(envs, redisEnvs...)
+		creds = redisCreds
+
 	case "rdp":
 		args = append(args, c.rdpFlags.buildArgs(c, port, host, addr)...)
 
@@ -678,7 +899,7 @@ func (c *Command) handleExec(clientProxy *apiproxy.ClientProxy, passthroughArgs
 		return
 	}
 
-	args = append(passthroughArgs, args...)
+	args = append(append(leadingArgs, passthroughArgs...), args...)
 
 	stringReplacer := func(in, typ, replacer string) string {
 		for _, style := range []string{
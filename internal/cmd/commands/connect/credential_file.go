@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"fmt"
+	"os"
+)
+
+// writeCredentialFile writes contents to a new temporary file readable only
+// by the current user and returns its name. The file is removed when the
+// command exits. desc describes the contents in error messages, e.g.
+// "postgres password".
+func (c *Command) writeCredentialFile(desc, contents string) (string, error) {
+	f, err := os.CreateTemp("", "boundary-*")
+	if err != nil {
+		return "", fmt.Errorf("Error saving %s to tmp file: %w", desc, err)
+	}
+	c.cleanupFuncs = append(c.cleanupFuncs, func() error {
+		if err := os.Remove(f.Name()); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("Error removing temporary %s file; consider removing %s manually: %w", desc, f.Name(), err)
+		}
+		return nil
+	})
+	// CreateTemp already uses 0600, but be explicit as the file holds secrets
+	if err := f.Chmod(0o600); err != nil {
+		f.Close()
+		return "", fmt.Errorf("Error setting permissions of %s file %s: %w", desc, f.Name(), err)
+	}
+	if _, err := f.WriteString(contents); err != nil {
+		f.Close()
+		return "", fmt.Errorf("Error writing %s file to %s: %w", desc, f.Name(), err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("Error closing %s file after writing to %s: %w", desc, f.Name(), err)
+	}
+	return f.Name(), nil
+}
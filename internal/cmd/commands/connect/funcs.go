@@ -20,10 +20,18 @@ func generateSessionInfoTableOutput(in SessionInfo) string {
 		"Session ID":       in.SessionId,
 		"Protocol":         in.Protocol,
 		"Address":          in.Address,
-		"Port":             in.Port,
 		"Expiration":       in.Expiration.Local().Format(time.RFC1123),
 		"Connection Limit": in.ConnectionLimit,
 	}
+	// Sessions listening on a socket or named pipe have no port
+	if in.Port != 0 {
+		nonAttributeMap["Port"] = in.Port
+	}
+
+	if in.ShareToken != "" {
+		nonAttributeMap["Share Token"] = in.ShareToken
+		nonAttributeMap["Share Token Expiration"] = in.ShareExpiration.Local().Format(time.RFC1123)
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
@@ -42,6 +50,25 @@ func generateSessionInfoTableOutput(in SessionInfo) string {
 	return base.WrapForHelpText(ret)
 }
 
+func generateAttachInfoTableOutput(in AttachInfo) string {
+	nonAttributeMap := map[string]any{
+		"Session ID":             in.SessionId,
+		"Address":                in.Address,
+		"Port":                   in.Port,
+		"Share Token Expiration": in.TokenExpiration.Local().Format(time.RFC1123),
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Attached session listening information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	return base.WrapForHelpText(ret)
+}
+
 func generateCredentialTableOutput(creds []*targets.SessionCredential) string {
 	return base.WrapForHelpText(generateCredentialTableOutputSlice(0, creds))
 }
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/posener/complete"
+)
+
+const (
+	mongoSynopsis = "Authorize a session against a target and invoke a MongoDB client to connect"
+)
+
+func mongoOptions(c *Command, set *base.FlagSets) {
+	f := set.NewFlagSet("MongoDB Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "style",
+		Target:     &c.flagMongoStyle,
+		EnvVar:     "BOUNDARY_CONNECT_MONGO_STYLE",
+		Completion: complete.PredictSet("mongosh"),
+		Default:    "mongosh",
+		Usage:      `Specifies how the CLI will attempt to invoke a MongoDB client. This will also set a suitable default for -exec if a value was not specified. Currently-understood values are "mongosh".`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "username",
+		Target:     &c.flagUsername,
+		EnvVar:     "BOUNDARY_CONNECT_USERNAME",
+		Completion: complete.PredictNothing,
+		Usage:      `Specifies the username to pass through to the client. May be overridden by credentials sourced from a credential store.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "dbname",
+		Target:     &c.flagDbname,
+		EnvVar:     "BOUNDARY_CONNECT_DBNAME",
+		Completion: complete.PredictNothing,
+		Usage:      `Specifies the database name to pass through to the client.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "authentication-database",
+		Target:     &c.flagMongoAuthDb,
+		EnvVar:     "BOUNDARY_CONNECT_MONGO_AUTHENTICATION_DATABASE",
+		Completion: complete.PredictNothing,
+		Usage:      `Specifies the database the user is defined in, if not the database given by -dbname.`,
+	})
+}
+
+type mongoFlags struct {
+	flagMongoStyle  string
+	flagMongoAuthDb string
+}
+
+func (m *mongoFlags) defaultExec() string {
+	return strings.ToLower(m.flagMongoStyle)
+}
+
+func (m *mongoFlags) buildArgs(c *Command, port, ip, _ string, creds proxy.Credentials) (args, envs []string, retCreds proxy.Credentials, retErr error) {
+	var username string
+
+	retCreds = creds
+	if len(retCreds.UsernamePassword) > 0 {
+		// mongosh only accepts a password on the command line, where other
+		// processes could read it, so only the username is used; mongosh
+		// prompts for the password, which is printed with the other brokered
+		// credentials.
+		// N.B. Do not mark credential as consumed, as user will still need
+		// to enter the password when prompted.
+		username = retCreds.UsernamePassword[0].Username
+	}
+
+	switch m.flagMongoStyle {
+	case "mongosh":
+		args = append(args, "--host", ip)
+		if port != "" {
+			args = append(args, "--port", port)
+		}
+
+		switch {
+		case username != "":
+			args = append(args, "--username", username)
+		case c.flagUsername != "":
+			args = append(args, "--username", c.flagUsername)
+		}
+
+		if m.flagMongoAuthDb != "" {
+			args = append(args, "--authenticationDatabase", m.flagMongoAuthDb)
+		}
+
+		if c.flagDbname != "" {
+			args = append(args, c.flagDbname)
+		}
+	}
+	return
+}
@@ -5,7 +5,6 @@ package connect
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/hashicorp/boundary/api/proxy"
@@ -17,6 +16,9 @@ const (
 	postgresSynopsis = "Authorize a session against a target and invoke a Postgres client to connect"
 )
 
+// pgpassEscaper escapes the characters that are special in a pgpass file
+var pgpassEscaper = strings.NewReplacer(`\`, `\\`, `:`, `\:`)
+
 func postgresOptions(c *Command, set *base.FlagSets) {
 	f := set.NewFlagSet("Postgres Options")
 
@@ -86,24 +88,11 @@ func (p *postgresFlags) buildArgs(c *Command, port, ip, _ string, creds proxy.Cr
 		}
 
 		if password != "" {
-			passfile, err := os.CreateTemp("", "*")
-			if err != nil {
-				return nil, nil, proxy.Credentials{}, fmt.Errorf("Error saving postgres password to tmp file: %w", err)
-			}
-			c.cleanupFuncs = append(c.cleanupFuncs, func() error {
-				if err := os.Remove(passfile.Name()); err != nil {
-					return fmt.Errorf("Error removing temporary password file; consider removing %s manually: %w", passfile.Name(), err)
-				}
-				return nil
-			})
-			_, err = passfile.WriteString(fmt.Sprintf("*:*:*:*:%s", password))
+			passfile, err := c.writeCredentialFile("postgres password", fmt.Sprintf("*:*:*:*:%s", pgpassEscaper.Replace(password)))
 			if err != nil {
-				return nil, nil, proxy.Credentials{}, fmt.Errorf("Error writing password file to %s: %w", passfile.Name(), err)
-			}
-			if err := passfile.Close(); err != nil {
-				return nil, nil, proxy.Credentials{}, fmt.Errorf("Error closing password file after writing to %s: %w", passfile.Name(), err)
+				return nil, nil, proxy.Credentials{}, err
 			}
-			envs = append(envs, fmt.Sprintf("PGPASSFILE=%s", passfile.Name()))
+			envs = append(envs, fmt.Sprintf("PGPASSFILE=%s", passfile))
 
 			if c.flagDbname == "" {
 				c.UI.Warn("Credentials are being brokered but no -dbname parameter provided. psql may misinterpret another parameter as the database name.")
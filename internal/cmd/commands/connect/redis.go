@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/api/proxy"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/posener/complete"
+)
+
+const (
+	redisSynopsis = "Authorize a session against a target and invoke a Redis client to connect"
+)
+
+func redisOptions(c *Command, set *base.FlagSets) {
+	f := set.NewFlagSet("Redis Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "style",
+		Target:     &c.flagRedisStyle,
+		EnvVar:     "BOUNDARY_CONNECT_REDIS_STYLE",
+		Completion: complete.PredictSet("redis-cli"),
+		Default:    "redis-cli",
+		Usage:      `Specifies how the CLI will attempt to invoke a Redis client. This will also set a suitable default for -exec if a value was not specified. Currently-understood values are "redis-cli".`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "username",
+		Target:     &c.flagUsername,
+		EnvVar:     "BOUNDARY_CONNECT_USERNAME",
+		Completion: complete.PredictNothing,
+		Usage:      `Specifies the username to pass through to the client. May be overridden by credentials sourced from a credential store.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "dbname",
+		Target:     &c.flagDbname,
+		EnvVar:     "BOUNDARY_CONNECT_DBNAME",
+		Completion: complete.PredictNothing,
+		Usage:      `Specifies the number of the database to select.`,
+	})
+}
+
+type redisFlags struct {
+	flagRedisStyle string
+}
+
+func (r *redisFlags) defaultExec() string {
+	return strings.ToLower(r.flagRedisStyle)
+}
+
+func (r *redisFlags) buildArgs(c *Command, port, ip, _ string, creds proxy.Credentials) (args, envs []string, retCreds proxy.Credentials, retErr error) {
+	var username, password string
+
+	retCreds = creds
+	if len(retCreds.UsernamePassword) > 0 {
+		// Mark credential as consumed so it is not printed to user
+		retCreds.UsernamePassword[0].Consumed = true
+
+		// For now just grab the first username password credential brokered
+		username = retCreds.UsernamePassword[0].Username
+		password = retCreds.UsernamePassword[0].Password
+	}
+
+	switch r.flagRedisStyle {
+	case "redis-cli":
+		args = append(args, "-h", ip)
+		if port != "" {
+			args = append(args, "-p", port)
+		}
+
+		if c.flagDbname != "" {
+			args = append(args, "-n", c.flagDbname)
+		}
+
+		switch {
+		case username != "":
+			args = append(args, "--user", username)
+		case c.flagUsername != "":
+			args = append(args, "--user", c.flagUsername)
+		}
+
+		if password != "" {
+			// redis-cli reads the password from the environment, which keeps
+			// it off the command line
+			envs = append(envs, fmt.Sprintf("REDISCLI_AUTH=%s", password))
+		}
+	}
+	return
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-secure-stdlib/base62"
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// shareTokenPrefix identifies share tokens so they are not mistaken for
+	// authorization tokens.
+	shareTokenPrefix = "bst_"
+	// shareHandshakeTimeout bounds how long an attaching client has to
+	// present its secret.
+	shareHandshakeTimeout = 10 * time.Second
+
+	shareHandshakeOk     byte = 0
+	shareHandshakeDenied byte = 1
+)
+
+// shareToken is what another process needs to attach to the local listener of
+// a session: where the share listener is, the secret to present to it and
+// until when it accepts new attachments.
+type shareToken struct {
+	SessionId  string    `json:"session_id"`
+	Address    string    `json:"address"`
+	Secret     string    `json:"secret"`
+	Expiration time.Time `json:"expiration"`
+}
+
+func (t *shareToken) encode() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return shareTokenPrefix + base58.FastBase58Encoding(b), nil
+}
+
+func decodeShareToken(s string) (*shareToken, error) {
+	s, ok := strings.CutPrefix(strings.TrimSpace(s), shareTokenPrefix)
+	if !ok {
+		return nil, errors.New("not a share token")
+	}
+	b, err := base58.FastBase58Decoding(s)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding share token: %w", err)
+	}
+	t := new(shareToken)
+	if err := json.Unmarshal(b, t); err != nil {
+		return nil, fmt.Errorf("error unmarshaling share token: %w", err)
+	}
+	if t.Address == "" || t.Secret == "" {
+		return nil, errors.New("share token is incomplete")
+	}
+	return t, nil
+}
+
+// shareListener lets other processes attach to the local listener of a
+// session. Clients present the secret of the share token on a connection to
+// the share listener, after which the connection is piped to the local
+// listener as if it had been made to it directly. New attachments are refused
+// once the token expires; attached connections are left open.
+type shareListener struct {
+	listener   net.Listener
+	proxyAddr  string
+	secret     string
+	expiration time.Time
+	wg         sync.WaitGroup
+}
+
+// newShareListener listens on listenAddr for attachments to the local proxy
+// listening on proxyAddr, valid for ttl.
+func newShareListener(listenAddr, proxyAddr string, ttl time.Duration) (*shareListener, error) {
+	if ttl <= 0 {
+		return nil, errors.New("share token lifetime must be positive")
+	}
+	secret, err := base62.Random(32)
+	if err != nil {
+		return nil, fmt.Errorf("error generating share secret: %w", err)
+	}
+	l, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("error starting share listener: %w", err)
+	}
+	return &shareListener{
+		listener:   l,
+		proxyAddr:  proxyAddr,
+		secret:     secret,
+		expiration: time.Now().Add(ttl),
+	}, nil
+}
+
+// token returns the share token for the session.
+func (s *shareListener) token(sessionId string) *shareToken {
+	return &shareToken{
+		SessionId:  sessionId,
+		Address:    s.listener.Addr().String(),
+		Secret:     s.secret,
+		Expiration: s.expiration,
+	}
+}
+
+// serve accepts attachments until the context is done or the token expires.
+// It waits for attached connections to finish before returning.
+func (s *shareListener) serve(ctx context.Context) {
+	ctx, cancel := context.WithDeadline(ctx, s.expiration)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = s.listener.Close()
+	}()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			break
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+	s.wg.Wait()
+}
+
+func (s *shareListener) handle(conn net.Conn) {
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(shareHandshakeTimeout))
+	secret, err := bufio.NewReaderSize(io.LimitReader(conn, 128), 128).ReadString('\n')
+	if err != nil {
+		return
+	}
+	secret = strings.TrimSuffix(secret, "\n")
+	if subtle.ConstantTimeCompare([]byte(secret), []byte(s.secret)) != 1 || time.Now().After(s.expiration) {
+		_, _ = conn.Write([]byte{shareHandshakeDenied})
+		return
+	}
+	proxyConn, err := net.Dial("tcp", s.proxyAddr)
+	if err != nil {
+		_, _ = conn.Write([]byte{shareHandshakeDenied})
+		return
+	}
+	defer proxyConn.Close()
+	if _, err := conn.Write([]byte{shareHandshakeOk}); err != nil {
+		return
+	}
+	_ = conn.SetDeadline(time.Time{})
+	pipe(conn, proxyConn)
+}
+
+// attachShared connects to the share listener of the token, overriding its
+// host if host is set, and presents the secret of the token.
+func attachShared(ctx context.Context, t *shareToken, host string) (net.Conn, error) {
+	addr := t.Address
+	if host != "" {
+		_, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing share address: %w", err)
+		}
+		addr = net.JoinHostPort(host, port)
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to shared session: %w", err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(shareHandshakeTimeout))
+	if _, err := io.WriteString(conn, t.Secret+"\n"); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("error sending share secret: %w", err)
+	}
+	status := make([]byte, 1)
+	if _, err := io.ReadFull(conn, status); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("error reading share handshake result: %w", err)
+	}
+	if status[0] != shareHandshakeOk {
+		conn.Close()
+		return nil, errors.New("shared session refused the attachment; the share token may have expired")
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+// pipe copies data between the two connections until both directions are
+// done, half-closing each side as the other finishes writing.
+func pipe(a, b net.Conn) {
+	var wg sync.WaitGroup
+	cp := func(dst, src net.Conn) {
+		defer wg.Done()
+		_, _ = io.Copy(dst, src)
+		if tcpConn, ok := dst.(*net.TCPConn); ok {
+			_ = tcpConn.CloseWrite()
+		} else {
+			_ = dst.Close()
+		}
+	}
+	wg.Add(2)
+	go cp(a, b)
+	go cp(b, a)
+	wg.Wait()
+}
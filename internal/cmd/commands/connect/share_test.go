@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareToken_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	in := &shareToken{
+		SessionId:  "s_1234567890",
+		Address:    "127.0.0.1:45678",
+		Secret:     "secret",
+		Expiration: time.Now().Add(time.Minute).Round(0).UTC(),
+	}
+	s, err := in.encode()
+	require.NoError(t, err)
+	assert.Contains(t, s, shareTokenPrefix)
+
+	out, err := decodeShareToken(s + "\n")
+	require.NoError(t, err)
+	assert.True(t, in.Expiration.Equal(out.Expiration))
+	out.Expiration = in.Expiration
+	assert.Equal(t, in, out)
+
+	_, err = decodeShareToken("at_1234567890")
+	assert.Error(t, err)
+	_, err = decodeShareToken(shareTokenPrefix + "0OIl")
+	assert.Error(t, err)
+	empty, err := (&shareToken{}).encode()
+	require.NoError(t, err)
+	_, err = decodeShareToken(empty)
+	assert.Error(t, err)
+}
+
+func TestShareListener(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Stand in for the local proxy listener with an echo server.
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer echo.Close()
+	go func() {
+		for {
+			conn, err := echo.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	share, err := newShareListener("127.0.0.1:0", echo.Addr().String(), time.Minute)
+	require.NoError(t, err)
+	go share.serve(ctx)
+	token := share.token("s_1234567890")
+
+	conn, err := attachShared(ctx, token, "")
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(buf))
+	conn.Close()
+
+	conn, err = attachShared(ctx, token, "localhost")
+	require.NoError(t, err)
+	conn.Close()
+
+	bad := *token
+	bad.Secret = "wrong"
+	_, err = attachShared(ctx, &bad, "")
+	assert.ErrorContains(t, err, "refused")
+
+	_, err = newShareListener("127.0.0.1:0", echo.Addr().String(), 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !windows
+// +build !windows
+
+package connect
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+)
+
+// socketListener is a Unix socket listener that reports, and removes on
+// close, the path it was linked to rather than the path it was bound to.
+type socketListener struct {
+	*net.UnixListener
+	addr *net.UnixAddr
+}
+
+func (l *socketListener) Addr() net.Addr {
+	return l.addr
+}
+
+func (l *socketListener) Close() error {
+	err := l.UnixListener.Close()
+	if rmErr := os.Remove(l.addr.Name); rmErr != nil && !os.IsNotExist(rmErr) {
+		err = errors.Join(err, fmt.Errorf("error removing socket %s: %w", l.addr.Name, rmErr))
+	}
+	return err
+}
+
+// listenSocket listens on a Unix socket at path that only the current user
+// can connect to. The socket is bound in a private directory and its
+// permissions restricted before it is linked to path, so there is no window
+// in which other users can connect to it. It is an error for path to exist.
+func listenSocket(path string) (net.Listener, error) {
+	dir, err := os.MkdirTemp(filepath.Dir(path), ".boundary-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating directory for socket: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	tmpPath := filepath.Join(dir, "socket")
+	l, err := net.ListenUnix("unix", &net.UnixAddr{Name: tmpPath, Net: "unix"})
+	if err != nil {
+		return nil, fmt.Errorf("error listening on socket: %w", err)
+	}
+	l.SetUnlinkOnClose(false)
+	if err := os.Chmod(tmpPath, 0o600); err != nil {
+		l.Close()
+		return nil, fmt.Errorf("error setting permissions of socket: %w", err)
+	}
+	// Unlike a rename, linking fails rather than replace an existing file
+	if err := os.Link(tmpPath, path); err != nil {
+		l.Close()
+		return nil, fmt.Errorf("error creating socket %s: %w", path, err)
+	}
+	return &socketListener{
+		UnixListener: l,
+		addr:         &net.UnixAddr{Name: path, Net: "unix"},
+	}, nil
+}
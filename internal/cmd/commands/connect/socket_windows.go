@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/Microsoft/go-winio"
+	"golang.org/x/sys/windows"
+)
+
+const pipePrefix = `\\.\pipe\`
+
+// listenSocket listens on the named pipe at path, which only the current user
+// can connect to. It is an error for the pipe to exist.
+func listenSocket(path string) (net.Listener, error) {
+	if !strings.HasPrefix(strings.ToLower(path), pipePrefix) {
+		return nil, fmt.Errorf("named pipe %s must start with %s", path, pipePrefix)
+	}
+	user, err := windows.GetCurrentProcessToken().GetTokenUser()
+	if err != nil {
+		return nil, fmt.Errorf("error looking up current user: %w", err)
+	}
+	// Grant the current user full access and nobody else any, without
+	// inheriting access from the default security descriptor
+	sddl := fmt.Sprintf("D:P(A;;GA;;;%s)", user.User.Sid.String())
+	l, err := winio.ListenPipe(path, &winio.PipeConfig{SecurityDescriptor: sddl})
+	if err != nil {
+		return nil, fmt.Errorf("error listening on named pipe: %w", err)
+	}
+	return l, nil
+}
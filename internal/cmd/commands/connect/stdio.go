@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	apiproxy "github.com/hashicorp/boundary/api/proxy"
+)
+
+const (
+	stdioSynopsis = "Authorize a session against a target and proxy a connection over standard input and output"
+)
+
+// handleStdio proxies a single connection of the session over the standard
+// input and output of the command, as expected of an SSH ProxyCommand. The
+// session is canceled once the remote end closes the connection.
+func (c *Command) handleStdio(clientProxy *apiproxy.ClientProxy) {
+	defer c.proxyCancel()
+
+	conn, err := net.Dial("tcp", clientProxy.ListenerAddress(context.Background()))
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error connecting to the local proxy: %w", err))
+		c.execCmdReturnValue.Store(int32(2))
+		return
+	}
+	defer conn.Close()
+
+	go func() {
+		_, _ = io.Copy(conn, os.Stdin)
+		// Let the remote end know no more data is coming, while still
+		// reading whatever it has left to send.
+		if tcpConn, ok := conn.(*net.TCPConn); ok {
+			_ = tcpConn.CloseWrite()
+		}
+	}()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = io.Copy(os.Stdout, conn)
+	}()
+
+	select {
+	case <-done:
+	case <-c.proxyCtx.Done():
+	}
+	c.execCmdReturnValue.Store(0)
+}
@@ -90,9 +90,9 @@ func (c *Command) Help() string {
 
 var flagsMap = map[string][]string{
 
-	"read": {"id"},
+	"read": {"id", "name", "credential-store-id"},
 
-	"delete": {"id"},
+	"delete": {"id", "name", "credential-store-id"},
 
 	"list": {"credential-store-id", "filter"},
 }
@@ -139,7 +139,18 @@ func (c *Command) Run(args []string) int {
 		return base.CommandUserError
 	}
 
-	if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" {
+	// The standard actions can address the resource by name instead of ID
+	var lookupByName bool
+	switch c.Func {
+	case "read", "update", "delete":
+		lookupByName = c.FlagId == "" && c.FlagName != ""
+		if c.Func != "update" && c.FlagId != "" && c.FlagName != "" {
+			c.PrintCliError(errors.New("Cannot specify both -id and -name; choose one or the other"))
+			return base.CommandUserError
+		}
+	}
+
+	if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" && !lookupByName {
 		c.PrintCliError(errors.New("ID is required but not passed in via -id"))
 		return base.CommandUserError
 	}
@@ -172,6 +183,33 @@ func (c *Command) Run(args []string) int {
 	}
 	credentiallibrariesClient := credentiallibraries.NewClient(client)
 
+	if lookupByName {
+		if c.FlagCredentialStoreId == "" {
+			c.PrintCliError(errors.New("CredentialStore ID must be passed in via -credential-store-id or BOUNDARY_CREDENTIAL_STORE_ID to look up by name"))
+			return base.CommandUserError
+		}
+
+		var exitCode int
+		c.FlagId, exitCode = common.LookupIdByName(c.Command, client, c.plural, c.FlagCredentialStoreId, func(containerId, filter string) ([]common.NameMatch, error) {
+			result, err := credentiallibrariesClient.List(c.Context, containerId, credentiallibraries.WithFilter(filter))
+			if err != nil {
+				return nil, err
+			}
+			matches := make([]common.NameMatch, 0, len(result.GetItems()))
+			for _, item := range result.GetItems() {
+				matches = append(matches, common.NameMatch{Id: item.Id, Scope: item.Scope})
+			}
+			return matches, nil
+		})
+		if exitCode > 0 {
+			return exitCode
+		}
+		if c.Func == "update" {
+			// The name identified the resource to update, it is not a new name
+			c.FlagName = ""
+		}
+	}
+
 	if c.FlagFilter != "" {
 		opts = append(opts, credentiallibraries.WithFilter(c.FlagFilter))
 	}
@@ -285,7 +323,7 @@ func (c *Command) checkFuncError(err error) int {
 		c.PrintApiError(apiErr, fmt.Sprintf("Error from controller when performing %s on %s", c.Func, c.plural))
 		return base.CommandApiError
 	}
-	c.PrintCliError(fmt.Errorf("Error trying to %s %s: %s", c.Func, c.plural, err.Error()))
+	c.PrintCliError(fmt.Errorf("Error trying to %s %s: %w", c.Func, c.plural, err))
 	return base.CommandCliError
 }
 
@@ -206,6 +206,9 @@ var genericKeySubstMap = map[string]string{
 	"path":              "Path",
 	"http_method":       "HTTP Method",
 	"http_request_body": "HTTP Request Body",
+	"namespace":         "Namespace",
+	"kv_version":        "KV Version",
+	"kv_min_version":    "KV Min Version",
 }
 
 var sshCertKeySubstMap = map[string]string{
@@ -217,4 +220,5 @@ var sshCertKeySubstMap = map[string]string{
 	"key_id":           "Key ID",
 	"critical_options": "Critical Options",
 	"extensions":       "Extensions",
+	"namespace":        "Namespace",
 }
@@ -33,6 +33,7 @@ const (
 
 type extraVaultSshCertificateCmdVars struct {
 	flagPath                      string
+	flagNamespace                 string
 	flagUsername                  string
 	flagKeyType                   string
 	flagKeyBits                   string
@@ -59,6 +60,7 @@ func extraVaultSshCertificateActionsFlagsMapFuncImpl() map[string][]string {
 			extensionsName,
 			piecewiseExtensionName,
 			additionalValidPrincipalsName,
+			namespaceFlagName,
 		},
 		"update": {
 			pathFlagName,
@@ -72,6 +74,7 @@ func extraVaultSshCertificateActionsFlagsMapFuncImpl() map[string][]string {
 			extensionsName,
 			piecewiseExtensionName,
 			additionalValidPrincipalsName,
+			namespaceFlagName,
 		},
 	}
 	return flags
@@ -88,6 +91,12 @@ func extraVaultSshCertificateFlagsFuncImpl(c *VaultSshCertificateCommand, set *b
 				Target: &c.flagPath,
 				Usage:  "The path in vault to request credentials from.",
 			})
+		case namespaceFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   namespaceFlagName,
+				Target: &c.flagNamespace,
+				Usage:  "The vault namespace to request credentials from. If not set, the namespace of the credential store is used.",
+			})
 		case usernameName:
 			f.StringVar(&base.StringVar{
 				Name:   usernameName,
@@ -155,6 +164,13 @@ func extraVaultSshCertificateFlagHandlingFuncImpl(c *VaultSshCertificateCommand,
 	default:
 		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryPath(c.flagPath))
 	}
+	switch c.flagNamespace {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultSSHCertificateCredentialLibraryNamespace())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultSSHCertificateCredentialLibraryNamespace(c.flagNamespace))
+	}
 	switch c.flagUsername {
 	case "":
 	default:
@@ -4,6 +4,9 @@
 package credentiallibrariescmd
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/hashicorp/boundary/api/credentiallibraries"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
@@ -44,6 +47,24 @@ func extraVaultFlagsFuncImpl(c *VaultCommand, set *base.FlagSets, _ *base.FlagSe
 				Target: &c.flagHttpRequestBody,
 				Usage:  "The http request body the library uses to communicate with vault. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
 			})
+		case namespaceFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   namespaceFlagName,
+				Target: &c.flagNamespace,
+				Usage:  "The vault namespace to request credentials from. If not set, the namespace of the credential store is used.",
+			})
+		case kvVersionFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kvVersionFlagName,
+				Target: &c.flagKvVersion,
+				Usage:  "The version of the vault KV v2 secret to read. Requires the GET http method.",
+			})
+		case kvMinVersionFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kvMinVersionFlagName,
+				Target: &c.flagKvMinVersion,
+				Usage:  "The minimum version of the vault KV v2 secret to accept. Requesting credentials fails if the secret is older. Requires the GET http method.",
+			})
 		case credentialTypeFlagName:
 			f.StringVar(&base.StringVar{
 				Name:   credentialTypeFlagName,
@@ -82,6 +103,37 @@ func extraVaultFlagHandlingFuncImpl(c *VaultCommand, _ *base.FlagSets, opts *[]c
 		rb, _ := parseutil.ParsePath(c.flagHttpRequestBody)
 		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryHttpRequestBody(rb))
 	}
+	switch c.flagNamespace {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryNamespace())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryNamespace(c.flagNamespace))
+	}
+	switch c.flagKvVersion {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryKvVersion())
+	default:
+		kvVersion, err := strconv.ParseUint(c.flagKvVersion, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKvVersion, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryKvVersion(uint32(kvVersion)))
+	}
+	switch c.flagKvMinVersion {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibraryKvMinVersion())
+	default:
+		kvMinVersion, err := strconv.ParseUint(c.flagKvMinVersion, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKvMinVersion, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryKvMinVersion(uint32(kvMinVersion)))
+	}
 	switch c.flagCredentialType {
 	case "":
 	case "null":
@@ -4,6 +4,7 @@
 package credentialscmd
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -18,6 +19,13 @@ const (
 	privateKeyFlagName           = "private-key"
 	privateKeyPassphraseFlagName = "private-key-passphrase"
 	secretFlagName               = "secret"
+	rotatorFlagName              = "rotator"
+	rotationAddressFlagName      = "rotation-address"
+	rotationDatabaseFlagName     = "rotation-database"
+	rotationIntervalFlagName     = "rotation-interval"
+	checkoutTtlFlagName          = "checkout-ttl"
+	checkoutQueueTimeoutFlagName = "checkout-queue-timeout"
+	checkoutRotateFlagName       = "checkout-rotate-on-check-in"
 )
 
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
@@ -170,6 +178,54 @@ func printItemTable(item *credentials.Credential, resp *api.Response) string {
 		)
 	}
 
+	if item.Rotation != nil {
+		rotation := map[string]any{
+			"Rotator":            item.Rotation.Rotator,
+			"Address":            item.Rotation.Address,
+			"Interval":           (time.Duration(item.Rotation.IntervalSeconds) * time.Second).String(),
+			"Next Rotation Time": item.Rotation.NextRotationTime.Local().Format(time.RFC1123),
+		}
+		if item.Rotation.Database != "" {
+			rotation["Database"] = item.Rotation.Database
+		}
+		if !item.Rotation.LastRotatedTime.IsZero() {
+			rotation["Last Rotated Time"] = item.Rotation.LastRotatedTime.Local().Format(time.RFC1123)
+		}
+		if item.Rotation.LastRotationError != "" {
+			rotation["Last Rotation Error"] = item.Rotation.LastRotationError
+		}
+		ret = append(ret,
+			"",
+			"  Rotation:",
+			base.WrapMap(4, maxLength, rotation),
+		)
+	}
+
+	if item.Checkout != nil {
+		checkout := map[string]any{
+			"TTL":          (time.Duration(item.Checkout.TtlSeconds) * time.Second).String(),
+			"Queue Length": item.Checkout.QueueLength,
+		}
+		if item.Checkout.QueueTimeoutSeconds > 0 {
+			checkout["Queue Timeout"] = (time.Duration(item.Checkout.QueueTimeoutSeconds) * time.Second).String()
+		}
+		if item.Checkout.RotateOnCheckIn {
+			checkout["Rotate On Check In"] = item.Checkout.RotateOnCheckIn
+			checkout["Rotation Pending"] = item.Checkout.RotationPending
+		}
+		if item.Checkout.SessionId != "" {
+			checkout["Session ID"] = item.Checkout.SessionId
+			checkout["User ID"] = item.Checkout.UserId
+			checkout["Checkout Time"] = item.Checkout.CheckoutTime.Local().Format(time.RFC1123)
+			checkout["Expiration Time"] = item.Checkout.ExpirationTime.Local().Format(time.RFC1123)
+		}
+		ret = append(ret,
+			"",
+			"  Checkout:",
+			base.WrapMap(4, maxLength, checkout),
+		)
+	}
+
 	if len(item.Attributes) > 0 {
 		ret = append(ret,
 			"",
@@ -181,6 +237,67 @@ func printItemTable(item *credentials.Credential, resp *api.Response) string {
 	return base.WrapForHelpText(ret)
 }
 
+// checkoutCmdVars are the flags setting the exclusive checkout policy of a
+// credential, shared by the credential types supporting them.
+type checkoutCmdVars struct {
+	flagCheckoutTtl          string
+	flagCheckoutQueueTimeout string
+	flagCheckoutRotate       bool
+}
+
+func (v *checkoutCmdVars) addCheckoutFlag(f *base.FlagSet, name string) {
+	switch name {
+	case checkoutTtlFlagName:
+		f.StringVar(&base.StringVar{
+			Name:   checkoutTtlFlagName,
+			Target: &v.flagCheckoutTtl,
+			Usage:  `The time a session may hold the credential, such as "1h". When set, only one session at a time may hold the credential. On update, "null" removes the checkout policy.`,
+		})
+	case checkoutQueueTimeoutFlagName:
+		f.StringVar(&base.StringVar{
+			Name:   checkoutQueueTimeoutFlagName,
+			Target: &v.flagCheckoutQueueTimeout,
+			Usage:  `The time a user denied the checked out credential keeps their place in its queue, such as "10m". Users must retry within this time to keep their place. If not set, users are not queued.`,
+		})
+	case checkoutRotateFlagName:
+		f.BoolVar(&base.BoolVar{
+			Name:   checkoutRotateFlagName,
+			Target: &v.flagCheckoutRotate,
+			Usage:  "Whether to rotate the password each time the credential is checked in, using the credential's rotation schedule. The credential can't be checked out again until its password was rotated.",
+		})
+	}
+}
+
+// checkoutOption returns the option setting the checkout policy given by the
+// flags, or nil if none of the flags is set.
+func (v *checkoutCmdVars) checkoutOption() (credentials.Option, error) {
+	switch {
+	case v.flagCheckoutTtl == "null":
+		return credentials.DefaultCheckout(), nil
+	case v.flagCheckoutTtl == "" && v.flagCheckoutQueueTimeout == "" && !v.flagCheckoutRotate:
+		return nil, nil
+	case v.flagCheckoutTtl == "":
+		// The checkout policy is set as a whole, so its other flags can't
+		// be set alone.
+		return nil, errors.New("The checkout queue timeout and rotate on check in flags require the checkout ttl flag")
+	}
+	ttl, err := time.ParseDuration(v.flagCheckoutTtl)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing checkout ttl flag: %w", err)
+	}
+	var queueTimeout time.Duration
+	if v.flagCheckoutQueueTimeout != "" {
+		if queueTimeout, err = time.ParseDuration(v.flagCheckoutQueueTimeout); err != nil {
+			return nil, fmt.Errorf("Error parsing checkout queue timeout flag: %w", err)
+		}
+	}
+	return credentials.WithCheckout(&credentials.CredentialCheckout{
+		TtlSeconds:          uint32(ttl / time.Second),
+		QueueTimeoutSeconds: uint32(queueTimeout / time.Second),
+		RotateOnCheckIn:     v.flagCheckoutRotate,
+	}), nil
+}
+
 var keySubstMap = map[string]string{
 	"username":                    "Username",
 	"password_hmac":               "Password HMAC",
@@ -26,6 +26,7 @@ type extraSshPrivateKeyCmdVars struct {
 	flagUsername             string
 	flagPrivateKey           string
 	flagPrivateKeyPassphrase string
+	checkoutCmdVars
 }
 
 func extraSshPrivateKeyActionsFlagsMapFuncImpl() map[string][]string {
@@ -34,6 +35,8 @@ func extraSshPrivateKeyActionsFlagsMapFuncImpl() map[string][]string {
 			usernameFlagName,
 			privateKeyFlagName,
 			privateKeyPassphraseFlagName,
+			checkoutTtlFlagName,
+			checkoutQueueTimeoutFlagName,
 		},
 	}
 	flags["update"] = flags["create"]
@@ -63,6 +66,8 @@ func extraSshPrivateKeyFlagsFuncImpl(c *SshPrivateKeyCommand, set *base.FlagSets
 				Target: &c.flagPrivateKeyPassphrase,
 				Usage:  "The passphrase associated with the SSH private key. This value is ignored if the private key does not require a passphrase or if no private key is supplied. This can refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read. Or, if left empty, if the key requires a passphrase it can be entered manually.",
 			})
+		case checkoutTtlFlagName, checkoutQueueTimeoutFlagName:
+			c.addCheckoutFlag(f, name)
 		}
 	}
 }
@@ -74,6 +79,15 @@ func extraSshPrivateKeyFlagHandlingFuncImpl(c *SshPrivateKeyCommand, _ *base.Fla
 		*opts = append(*opts, credentials.WithSshPrivateKeyCredentialUsername(c.flagUsername))
 	}
 
+	checkout, err := c.checkoutOption()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return false
+	}
+	if checkout != nil {
+		*opts = append(*opts, checkout)
+	}
+
 	// If private key not set (e.g. just a username update) then don't check
 	// either private key or passphrase
 	if c.flagPrivateKey == "" {
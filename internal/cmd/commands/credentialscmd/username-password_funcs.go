@@ -6,6 +6,7 @@ package credentialscmd
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/boundary/api/credentials"
 	"github.com/hashicorp/boundary/internal/cmd/base"
@@ -19,8 +20,13 @@ func init() {
 }
 
 type extraUsernamePasswordCmdVars struct {
-	flagUsername string
-	flagPassword string
+	flagUsername         string
+	flagPassword         string
+	flagRotator          string
+	flagRotationAddress  string
+	flagRotationDatabase string
+	flagRotationInterval string
+	checkoutCmdVars
 }
 
 func extraUsernamePasswordActionsFlagsMapFuncImpl() map[string][]string {
@@ -28,6 +34,13 @@ func extraUsernamePasswordActionsFlagsMapFuncImpl() map[string][]string {
 		"create": {
 			usernameFlagName,
 			passwordFlagName,
+			rotatorFlagName,
+			rotationAddressFlagName,
+			rotationDatabaseFlagName,
+			rotationIntervalFlagName,
+			checkoutTtlFlagName,
+			checkoutQueueTimeoutFlagName,
+			checkoutRotateFlagName,
 		},
 	}
 	flags["update"] = flags["create"]
@@ -51,6 +64,32 @@ func extraUsernamePasswordFlagsFuncImpl(c *UsernamePasswordCommand, set *base.Fl
 				Target: &c.flagPassword,
 				Usage:  "The password associated with the credential. This can be a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
 			})
+		case rotatorFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   rotatorFlagName,
+				Target: &c.flagRotator,
+				Usage:  `The rotator used to periodically rotate the password in the database the credential authenticates to, either "postgres" or "mysql". On update, "null" stops rotating the password.`,
+			})
+		case rotationAddressFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   rotationAddressFlagName,
+				Target: &c.flagRotationAddress,
+				Usage:  `The "host:port" address of the database server in which the password is rotated.`,
+			})
+		case rotationDatabaseFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   rotationDatabaseFlagName,
+				Target: &c.flagRotationDatabase,
+				Usage:  "The name of the database to connect to when rotating the password, if the server requires one.",
+			})
+		case rotationIntervalFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   rotationIntervalFlagName,
+				Target: &c.flagRotationInterval,
+				Usage:  `The time between rotations of the password, such as "720h".`,
+			})
+		case checkoutTtlFlagName, checkoutQueueTimeoutFlagName, checkoutRotateFlagName:
+			c.addCheckoutFlag(f, name)
 		}
 	}
 }
@@ -77,6 +116,37 @@ func extraUsernamePasswordFlagHandlingFuncImpl(c *UsernamePasswordCommand, _ *ba
 		*opts = append(*opts, credentials.WithUsernamePasswordCredentialPassword(password))
 	}
 
+	switch {
+	case c.flagRotator == "null":
+		*opts = append(*opts, credentials.DefaultRotation())
+	case c.flagRotator != "" || c.flagRotationAddress != "" || c.flagRotationDatabase != "" || c.flagRotationInterval != "":
+		// The rotation is set as a whole, so all of its flags are given
+		// together.
+		var interval time.Duration
+		if c.flagRotationInterval != "" {
+			var err error
+			if interval, err = time.ParseDuration(c.flagRotationInterval); err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing rotation interval flag: %v", err))
+				return false
+			}
+		}
+		*opts = append(*opts, credentials.WithRotation(&credentials.CredentialRotation{
+			Rotator:         c.flagRotator,
+			Address:         c.flagRotationAddress,
+			Database:        c.flagRotationDatabase,
+			IntervalSeconds: uint32(interval / time.Second),
+		}))
+	}
+
+	checkout, err := c.checkoutOption()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return false
+	}
+	if checkout != nil {
+		*opts = append(*opts, checkout)
+	}
+
 	return true
 }
 
@@ -91,6 +161,10 @@ func (c *UsernamePasswordCommand) extraUsernamePasswordHelpFunc(_ map[string]fun
 			"",
 			`    $ boundary credentials create username-password -credential-store-id csvlt_1234567890 -username user -password pass`,
 			"",
+			"  To have Boundary rotate the password in the database the credential authenticates to every 30 days:",
+			"",
+			`    $ boundary credentials create username-password -credential-store-id csvlt_1234567890 -username user -password env://DB_PASSWORD -rotator postgres -rotation-address db.example.com:5432 -rotation-database app -rotation-interval 720h`,
+			"",
 			"",
 		})
 
@@ -102,6 +176,14 @@ func (c *UsernamePasswordCommand) extraUsernamePasswordHelpFunc(_ map[string]fun
 			"",
 			`    $ boundary credentials update username-password -id clvlt_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"  To allow only one session at a time to hold the credential, for at most an hour, queueing the users waiting for it:",
+			"",
+			`    $ boundary credentials update username-password -id clvlt_1234567890 -checkout-ttl 1h -checkout-queue-timeout 10m`,
+			"",
+			"  To also rotate the password in the database after each checkout, so each password is only seen once:",
+			"",
+			`    $ boundary credentials update username-password -id clvlt_1234567890 -checkout-ttl 1h -checkout-rotate-on-check-in`,
+			"",
 			"",
 		})
 	}
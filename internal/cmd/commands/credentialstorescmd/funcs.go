@@ -212,4 +212,6 @@ var keySubstMap = map[string]string{
 	"client_certificate":          "Client Certificate",
 	"client_certificate_key_hmac": "Client Certificate Key HMAC",
 	"worker_filter":               "Worker Filter",
+	"cert_auth_mount_path":        "Cert Auth Mount Path",
+	"cert_auth_role_name":         "Cert Auth Role Name",
 }
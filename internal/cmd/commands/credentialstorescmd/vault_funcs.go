@@ -8,7 +8,7 @@ import (
 
 	"github.com/hashicorp/boundary/api/credentialstores"
 	"github.com/hashicorp/boundary/internal/cmd/base"
-	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
 )
 
@@ -28,6 +28,8 @@ const (
 	clientCertificateFlagName    = "vault-client-certificate"
 	clientCertificateKeyFlagName = "vault-client-certificate-key"
 	workerFilterFlagName         = "worker-filter"
+	certAuthMountPathFlagName    = "vault-cert-auth-mount-path"
+	certAuthRoleNameFlagName     = "vault-cert-auth-role-name"
 )
 
 type extraVaultCmdVars struct {
@@ -40,6 +42,9 @@ type extraVaultCmdVars struct {
 	flagTlsServerName string
 	flagTlsSkipVerify bool
 	flagWorkerFilter  string
+
+	flagCertAuthMountPath string
+	flagCertAuthRoleName  string
 }
 
 func extraVaultActionsFlagsMapFuncImpl() map[string][]string {
@@ -54,6 +59,8 @@ func extraVaultActionsFlagsMapFuncImpl() map[string][]string {
 			clientCertificateFlagName,
 			clientCertificateKeyFlagName,
 			workerFilterFlagName,
+			certAuthMountPathFlagName,
+			certAuthRoleNameFlagName,
 		},
 	}
 	flags["update"] = flags["create"]
@@ -119,6 +126,18 @@ func extraVaultFlagsFuncImpl(c *VaultCommand, set *base.FlagSets, _ *base.FlagSe
 				Target: &c.flagWorkerFilter,
 				Usage:  `A boolean expression to filter which workers can handle Vault commands for this credential store.`,
 			})
+		case certAuthMountPathFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   certAuthMountPathFlagName,
+				Target: &c.flagCertAuthMountPath,
+				Usage:  `The mount path of the Vault TLS certificate auth method, such as "cert". If set, boundary logs in to vault with the client certificate to get its token, so a vault token is not required, and logs in again when the token can no longer be renewed.`,
+			})
+		case certAuthRoleNameFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   certAuthRoleNameFlagName,
+				Target: &c.flagCertAuthRoleName,
+				Usage:  "The role of the Vault TLS certificate auth method to log in with. If not set, vault uses the roles matching the client certificate.",
+			})
 		}
 	}
 }
@@ -170,12 +189,26 @@ func extraVaultFlagHandlingFuncImpl(c *VaultCommand, f *base.FlagSets, opts *[]c
 	case "null":
 		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreWorkerFilter())
 	default:
-		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+		if _, err := filter.CreateEvaluator(c.flagWorkerFilter); err != nil {
 			c.UI.Error(fmt.Sprintf("Unable to successfully parse filter expression: %s", err))
 			return false
 		}
 		*opts = append(*opts, credentialstores.WithVaultCredentialStoreWorkerFilter(c.flagWorkerFilter))
 	}
+	switch c.flagCertAuthMountPath {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreCertAuthMountPath())
+	default:
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreCertAuthMountPath(c.flagCertAuthMountPath))
+	}
+	switch c.flagCertAuthRoleName {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreCertAuthRoleName())
+	default:
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreCertAuthRoleName(c.flagCertAuthRoleName))
+	}
 	if c.flagTlsSkipVerify {
 		*opts = append(*opts, credentialstores.WithVaultCredentialStoreTlsSkipVerify(c.flagTlsSkipVerify))
 	}
@@ -194,6 +227,10 @@ func (c *VaultCommand) extraVaultHelpFunc(helpMap map[string]func() string) stri
 			"",
 			`    $ boundary credential-stores create vault -vault-address "http://localhost:8200" -vault-token "s.s0m3t0k3n"`,
 			"",
+			"  To log in to vault with a client certificate instead of providing a token:",
+			"",
+			`    $ boundary credential-stores create vault -vault-address "https://vault.example.com:8200" -vault-client-certificate file://client.pem -vault-client-certificate-key file://client-key.pem -vault-cert-auth-mount-path cert -vault-cert-auth-role-name boundary`,
+			"",
 			"",
 		})
 
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package database
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/db/dump"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ExportCommand)(nil)
+	_ cli.CommandAutocomplete = (*ExportCommand)(nil)
+)
+
+type ExportCommand struct {
+	*base.Command
+	srv *base.Server
+
+	Config *config.Config
+
+	flagConfig       []string
+	flagConfigKms    string
+	flagLogLevel     string
+	flagLogFormat    string
+	flagMigrationUrl string
+	flagOutput       string
+}
+
+func (c *ExportCommand) Synopsis() string {
+	return "Export Boundary's database to a logical dump."
+}
+
+func (c *ExportCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary database export [options]",
+		"",
+		"  Export all resources of Boundary's database to a logical dump, which can be imported into another database with \"boundary database import\":",
+		"",
+		"    $ boundary database export -config=/etc/boundary/controller.hcl -output=boundary.dump",
+		"",
+		"  For a full list of examples, please see the documentation.",
+	}) + c.Flags().Help()
+}
+
+func (c *ExportCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP)
+
+	f := set.NewFlagSet("Command options")
+
+	f.StringSliceVar(&base.StringSliceVar{
+		Name:   "config",
+		Target: &c.flagConfig,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: "Path to the configuration file.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "config-kms",
+		Target: &c.flagConfigKms,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: `Path to a configuration file containing a "kms" block marked for "config" purpose, to perform decryption of the main configuration file. If not set, will look for such a block in the main configuration file, which has some drawbacks; see the help output for "boundary config encrypt -h" for details.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "log-level",
+		Target:     &c.flagLogLevel,
+		EnvVar:     "BOUNDARY_LOG_LEVEL",
+		Completion: complete.PredictSet("trace", "debug", "info", "warn", "err"),
+		Usage: "Log verbosity level. Supported values (in order of more detail to less) are " +
+			"\"trace\", \"debug\", \"info\", \"warn\", and \"err\".",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "log-format",
+		Target:     &c.flagLogFormat,
+		Completion: complete.PredictSet("standard", "json"),
+		Usage:      `Log format. Supported values are "standard" and "json".`,
+	})
+
+	f = set.NewFlagSet("Export options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "migration-url",
+		Target: &c.flagMigrationUrl,
+		Usage:  `If set, overrides a migration URL set in config, and specifies the URL used to connect to the database for the export. This can refer to a file on disk (file://) from which a URL will be read; an env var (env://) from which the URL will be read; or a direct database URL.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "output",
+		Target:     &c.flagOutput,
+		Completion: complete.PredictFiles("*"),
+		Usage:      `Path of the file the dump is written to. The file must not exist.`,
+	})
+
+	return set
+}
+
+func (c *ExportCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ExportCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ExportCommand) Run(args []string) (retCode int) {
+	if result := c.ParseFlagsAndConfig(args); result > 0 {
+		return result
+	}
+
+	dialect := "postgres"
+
+	c.srv = base.NewServer(&base.Command{UI: c.UI})
+
+	if err := c.srv.SetupLogging(c.flagLogLevel, c.flagLogFormat, c.Config.LogLevel, c.Config.LogFormat); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+
+	serverName, err := os.Hostname()
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Unable to determine hostname: %w", err).Error())
+		return base.CommandCliError
+	}
+	serverName = fmt.Sprintf("%s/boundary-database-export", serverName)
+	if err := c.srv.SetupEventing(
+		c.Context,
+		c.srv.Logger,
+		c.srv.StderrLock,
+		serverName,
+		base.WithEventerConfig(c.Config.Eventing)); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+
+	if c.Config.Controller == nil {
+		c.UI.Error(`"controller" config block not found`)
+		return base.CommandUserError
+	}
+
+	if c.Config.Controller.Database == nil {
+		c.UI.Error(`"controller.database" config block not found`)
+		return base.CommandUserError
+	}
+
+	migrationUrl, errCode := parseMigrationUrl(c.UI, c.Config.Controller.Database, c.flagMigrationUrl)
+	if errCode != 0 {
+		return errCode
+	}
+
+	dBase, err := common.SqlOpen(dialect, migrationUrl)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error establishing db connection: %w", err).Error())
+		return base.CommandCliError
+	}
+	defer dBase.Close()
+
+	// The dump contains encrypted secrets and the encrypted keys that decrypt
+	// them, so only the current user can read it.
+	f, err := os.OpenFile(c.flagOutput, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error creating output file: %w", err).Error())
+		return base.CommandUserError
+	}
+	defer f.Close()
+
+	count, err := dump.Export(c.Context, dBase, f)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error exporting database: %w", err).Error())
+		return base.CommandCliError
+	}
+	if err := f.Close(); err != nil {
+		c.UI.Error(fmt.Errorf("Error writing output file: %w", err).Error())
+		return base.CommandCliError
+	}
+
+	if base.Format(c.UI) == "table" {
+		c.UI.Info(fmt.Sprintf("Exported %d rows to %q.", count, c.flagOutput))
+	}
+	return base.CommandSuccess
+}
+
+func (c *ExportCommand) ParseFlagsAndConfig(args []string) int {
+	var err error
+
+	f := c.Flags()
+
+	if err = f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+
+	// Validation
+	switch {
+	case len(c.flagConfig) == 0:
+		c.UI.Error("Must specify a config file using -config")
+		return base.CommandUserError
+	case c.flagOutput == "":
+		c.UI.Error("Must specify an output file using -output")
+		return base.CommandUserError
+	}
+
+	c.Config, err = config.Load(c.Context, c.flagConfig, c.flagConfigKms)
+	if err != nil {
+		c.UI.Error("Error parsing config: " + err.Error())
+		return base.CommandUserError
+	}
+
+	return base.CommandSuccess
+}
@@ -6,12 +6,15 @@ package database
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/db/common"
 	"github.com/hashicorp/boundary/internal/db/schema"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
 	"github.com/mitchellh/cli"
 )
 
@@ -102,6 +105,103 @@ func migrateDatabase(ctx context.Context, ui cli.Ui, dialect, u string, initiali
 	return unlock, 0
 }
 
+// reviewMigrations reports the migrations migrateDatabase would run against
+// the current schema version of the database, without running them. If
+// dryRun is set, their statements are written to the UI and if outputSql is
+// set, they are written to the file at that path.
+// It owns the reporting to the UI any errors.
+// Returns a cleanup function which must be called even if an error is returned and
+// an error code where a non-zero value indicates an error happened.
+func reviewMigrations(ctx context.Context, ui cli.Ui, dialect, u string, maxOpenConns int, dryRun bool, outputSql string) (func(), int) {
+	noop := func() {}
+	dBase, err := common.SqlOpen(dialect, u)
+	if err != nil {
+		ui.Error(fmt.Errorf("Error establishing db connection: %w", err).Error())
+		return noop, 2
+	}
+	dBase.SetMaxOpenConns(maxOpenConns)
+	if err := dBase.PingContext(ctx); err != nil {
+		ui.Error(fmt.Sprintf("Unable to connect to the database at %q", u))
+		return noop, 2
+	}
+	man, err := schema.NewManager(ctx, schema.Dialect(dialect), dBase)
+	if err != nil {
+		ui.Error(fmt.Errorf("Error setting up schema manager: %w", err).Error())
+		return noop, 2
+	}
+	closeMan := func() {
+		_ = man.Close(ctx)
+	}
+
+	st, err := man.CurrentState(ctx)
+	if err != nil {
+		ui.Error(fmt.Errorf("Error getting database state: %w", err).Error())
+		return closeMan, 2
+	}
+	if !st.Initialized {
+		ui.Output(base.WrapAtLength("Database has not been initialized. Please use 'boundary database init' to initialize the boundary database."))
+		return closeMan, -1
+	}
+	pending, err := man.PendingMigrations(ctx)
+	if err != nil {
+		ui.Error(fmt.Errorf("Error getting pending database migrations: %w", err).Error())
+		return closeMan, 2
+	}
+	if len(pending) == 0 {
+		ui.Info("Database schema is up to date, no migrations to run.")
+		return closeMan, 0
+	}
+
+	var sql strings.Builder
+	if err := schema.WriteMigrationSql(&sql, pending); err != nil {
+		ui.Error(fmt.Errorf("Error writing database migrations: %w", err).Error())
+		return closeMan, 2
+	}
+	if dryRun {
+		ui.Info(fmt.Sprintf("%d migrations would be run:", len(pending)))
+		ui.Output(sql.String())
+	}
+	if outputSql != "" {
+		if err := os.WriteFile(outputSql, []byte(sql.String()), 0o644); err != nil {
+			ui.Error(fmt.Errorf("Error writing database migrations to %q: %w", outputSql, err).Error())
+			return closeMan, 2
+		}
+		ui.Info(fmt.Sprintf("Wrote %d migrations to %q.", len(pending), outputSql))
+	}
+	return closeMan, 0
+}
+
+// parseMigrationUrl returns the url used to connect to the database for
+// migration, which is the migration-url flag if set, else the migration url
+// of the database config block, else its url.
+// It owns the reporting to the UI any errors.
+// Returns an error code where a non-zero value indicates an error happened.
+func parseMigrationUrl(ui cli.Ui, database *config.Database, flagMigrationUrl string) (string, int) {
+	var migrationUrlToParse string
+	if database.MigrationUrl != "" {
+		migrationUrlToParse = database.MigrationUrl
+	}
+	if flagMigrationUrl != "" {
+		migrationUrlToParse = flagMigrationUrl
+	}
+	// Fallback to using database URL for everything
+	if migrationUrlToParse == "" {
+		migrationUrlToParse = database.Url
+	}
+
+	if migrationUrlToParse == "" {
+		ui.Error(base.WrapAtLength(`neither "url" nor "migration_url" correctly set in "database" config block nor was the "migration-url" flag used`))
+		return "", base.CommandUserError
+	}
+
+	migrationUrl, err := parseutil.ParsePath(migrationUrlToParse)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		ui.Error(fmt.Errorf("Error parsing migration url: %w", err).Error())
+		return "", base.CommandUserError
+	}
+	return migrationUrl, 0
+}
+
 type RoleInfo struct {
 	RoleId string `json:"scope_id"`
 	Name   string `json:"name"`
@@ -5,6 +5,9 @@ package database
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
@@ -207,6 +210,45 @@ func TestMigrateDatabase(t *testing.T) {
 	}
 }
 
+func TestReviewMigrations(t *testing.T) {
+	ctx := context.Background()
+	dialect := dbtest.Postgres
+
+	c, u, _, err := dbtest.StartUsingTemplate(dialect)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, c())
+	})
+	dBase, err := common.SqlOpen(dialect, u)
+	require.NoError(t, err)
+	earlyMigrationVersion := 2000
+	man, err := schema.NewManager(ctx, schema.Dialect(dialect), dBase, schema.WithEditions(
+		schema.TestCreatePartialEditions(schema.Dialect(dialect), schema.PartialEditions{"oss": earlyMigrationVersion}),
+	))
+	require.NoError(t, err)
+	_, err = man.ApplyMigrations(ctx)
+	require.NoError(t, err)
+
+	outputSql := filepath.Join(t.TempDir(), "migrate.sql")
+	ui := cli.NewMockUi()
+	clean, errCode := reviewMigrations(ctx, ui, dialect, u, 10, true, outputSql)
+	clean()
+	assert.EqualValues(t, 0, errCode)
+	assert.Empty(t, ui.ErrorWriter.String())
+	assert.Contains(t, ui.OutputWriter.String(), "migrations would be run:")
+	assert.NotContains(t, ui.OutputWriter.String(), fmt.Sprintf("version: %d\n", earlyMigrationVersion))
+	assert.Contains(t, ui.OutputWriter.String(), "insert into boundary_schema_version")
+
+	sql, err := os.ReadFile(outputSql)
+	require.NoError(t, err)
+	assert.Contains(t, ui.OutputWriter.String(), string(sql))
+
+	// Reviewing the migrations must not run them.
+	st, err := man.CurrentState(ctx)
+	require.NoError(t, err)
+	assert.False(t, st.MigrationsApplied())
+}
+
 func TestVerifyOplogIsEmpty(t *testing.T) {
 	dialect := "postgres"
 	ctx := context.Background()
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/db/dump"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ImportCommand)(nil)
+	_ cli.CommandAutocomplete = (*ImportCommand)(nil)
+)
+
+type ImportCommand struct {
+	*base.Server
+
+	Config *config.Config
+
+	flagConfig       []string
+	flagConfigKms    string
+	flagLogLevel     string
+	flagLogFormat    string
+	flagMigrationUrl string
+	flagInput        string
+}
+
+func (c *ImportCommand) Synopsis() string {
+	return "Import a logical dump into Boundary's database."
+}
+
+func (c *ImportCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary database import [options]",
+		"",
+		"  Import a logical dump created with \"boundary database export\" into a database that is not in use:",
+		"",
+		"    $ boundary database import -config=/etc/boundary/controller.hcl -input=boundary.dump",
+		"",
+		"  The database is migrated to the schema version of this binary before the dump is imported, and the dump must have been exported from a database at the same schema version.",
+		"",
+		"  The root keys of the dump are re-encrypted with the root KMS of the configuration. If the dump was exported by a Boundary with a different root KMS, add that KMS to the configuration with the \"previous_root\" purpose.",
+		"",
+		"  For a full list of examples, please see the documentation.",
+	}) + c.Flags().Help()
+}
+
+func (c *ImportCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP)
+
+	f := set.NewFlagSet("Command options")
+
+	f.StringSliceVar(&base.StringSliceVar{
+		Name:   "config",
+		Target: &c.flagConfig,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: "Path to the configuration file.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "config-kms",
+		Target: &c.flagConfigKms,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: `Path to a configuration file containing a "kms" block marked for "config" purpose, to perform decryption of the main configuration file. If not set, will look for such a block in the main configuration file, which has some drawbacks; see the help output for "boundary config encrypt -h" for details.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "log-level",
+		Target:     &c.flagLogLevel,
+		EnvVar:     "BOUNDARY_LOG_LEVEL",
+		Completion: complete.PredictSet("trace", "debug", "info", "warn", "err"),
+		Usage: "Log verbosity level. Supported values (in order of more detail to less) are " +
+			"\"trace\", \"debug\", \"info\", \"warn\", and \"err\".",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "log-format",
+		Target:     &c.flagLogFormat,
+		Completion: complete.PredictSet("standard", "json"),
+		Usage:      `Log format. Supported values are "standard" and "json".`,
+	})
+
+	f = set.NewFlagSet("Import options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "migration-url",
+		Target: &c.flagMigrationUrl,
+		Usage:  `If set, overrides a migration URL set in config, and specifies the URL used to connect to the database for the import. The import disables triggers while it runs, so the user must be allowed to set "session_replication_role". This can refer to a file on disk (file://) from which a URL will be read; an env var (env://) from which the URL will be read; or a direct database URL.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "input",
+		Target:     &c.flagInput,
+		Completion: complete.PredictFiles("*"),
+		Usage:      `Path of the dump to import.`,
+	})
+
+	return set
+}
+
+func (c *ImportCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ImportCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ImportCommand) Run(args []string) (retCode int) {
+	if result := c.ParseFlagsAndConfig(args); result > 0 {
+		return result
+	}
+
+	defer func() {
+		if err := c.RunShutdownFuncs(); err != nil {
+			c.UI.Error(fmt.Errorf("Error running shutdown tasks: %w", err).Error())
+		}
+	}()
+
+	dialect := "postgres"
+
+	if err := c.SetupLogging(c.flagLogLevel, c.flagLogFormat, c.Config.LogLevel, c.Config.LogFormat); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+
+	serverName, err := os.Hostname()
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Unable to determine hostname: %w", err).Error())
+		return base.CommandCliError
+	}
+	serverName = fmt.Sprintf("%s/boundary-database-import", serverName)
+	if err := c.SetupEventing(c.Context, c.Logger, c.StderrLock, serverName, base.WithEventerConfig(c.Config.Eventing)); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+
+	if err := c.SetupKMSes(c.Context, c.UI, c.Config); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+
+	if c.RootKms == nil {
+		c.UI.Error("Root KMS not found after parsing KMS blocks")
+		return base.CommandCliError
+	}
+
+	if c.Config.Controller == nil {
+		c.UI.Error(`"controller" config block not found`)
+		return base.CommandUserError
+	}
+
+	if c.Config.Controller.Database == nil {
+		c.UI.Error(`"controller.database" config block not found`)
+		return base.CommandUserError
+	}
+
+	migrationUrl, errCode := parseMigrationUrl(c.UI, c.Config.Controller.Database, c.flagMigrationUrl)
+	if errCode != 0 {
+		return errCode
+	}
+
+	f, err := os.Open(c.flagInput)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error opening input file: %w", err).Error())
+		return base.CommandUserError
+	}
+	defer f.Close()
+
+	dBase, err := common.SqlOpen(dialect, migrationUrl)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error establishing db connection: %w", err).Error())
+		return base.CommandCliError
+	}
+	defer dBase.Close()
+
+	// Importing replaces all rows of the database, so only import into a
+	// database no resources have been created in.
+	initialized, err := verifyDatabaseIsUnused(c.Context, dBase)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("A dump can only be imported into a database that is not in use: %w", err).Error())
+		return base.CommandUserError
+	}
+
+	clean, errCode := migrateDatabase(c.Context, c.UI, dialect, migrationUrl, initialized, c.Config.Controller.Database.MaxOpenConnections, nil)
+	defer clean()
+	if errCode != 0 {
+		return errCode
+	}
+
+	count, err := dump.Import(c.Context, dBase, f, c.RootKms)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error importing database: %w", err).Error())
+		return base.CommandCliError
+	}
+
+	if base.Format(c.UI) == "table" {
+		c.UI.Info(fmt.Sprintf("Imported %d rows from %q.", count, c.flagInput))
+	}
+	return base.CommandSuccess
+}
+
+func (c *ImportCommand) ParseFlagsAndConfig(args []string) int {
+	var err error
+
+	f := c.Flags()
+
+	if err = f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+
+	// Validation
+	switch {
+	case len(c.flagConfig) == 0:
+		c.UI.Error("Must specify a config file using -config")
+		return base.CommandUserError
+	case c.flagInput == "":
+		c.UI.Error("Must specify an input file using -input")
+		return base.CommandUserError
+	}
+
+	c.Config, err = config.Load(c.Context, c.flagConfig, c.flagConfigKms)
+	if err != nil {
+		c.UI.Error("Error parsing config: " + err.Error())
+		return base.CommandUserError
+	}
+
+	return base.CommandSuccess
+}
+
+// verifyDatabaseIsUnused returns an error if any changes to resources have been
+// recorded in the oplog of the database, and otherwise returns whether the
+// database has been initialized.
+func verifyDatabaseIsUnused(ctx context.Context, d *sql.DB) (bool, error) {
+	const op = "database.verifyDatabaseIsUnused"
+	var initialized bool
+	if err := d.QueryRowContext(ctx, "select to_regclass('oplog_entry') is not null").Scan(&initialized); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if !initialized {
+		return false, nil
+	}
+	var empty bool
+	if err := d.QueryRowContext(ctx, "select not exists(select 1 from oplog_entry limit 1)").Scan(&empty); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if !empty {
+		return false, errors.New(ctx, errors.MigrationIntegrity, op, "oplog_entry is not empty")
+	}
+	return true, nil
+}
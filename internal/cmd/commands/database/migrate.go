@@ -14,11 +14,9 @@ import (
 	"github.com/hashicorp/boundary/internal/db/schema"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
-	boundary_plugin_assets "github.com/hashicorp/boundary/plugins/boundary"
 	external_plugins "github.com/hashicorp/boundary/sdk/plugins"
 	"github.com/hashicorp/go-secure-stdlib/mlock"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
-	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
 	"github.com/mitchellh/cli"
 	"github.com/posener/complete"
 )
@@ -51,6 +49,8 @@ type MigrateCommand struct {
 	flagLogFormat          string
 	flagMigrationUrl       string
 	flagRepairMigrations   []string
+	flagDryRun             bool
+	flagOutputSql          string
 	flagAllowDevMigrations bool
 }
 
@@ -66,6 +66,14 @@ func (c *MigrateCommand) Help() string {
 		"",
 		"    $ boundary database migrate -config=/etc/boundary/controller.hcl",
 		"",
+		"  Show the statements a migration would run without running them:",
+		"",
+		"    $ boundary database migrate -config=/etc/boundary/controller.hcl -dry-run",
+		"",
+		"  Write the statements a migration would run to a file for review:",
+		"",
+		"    $ boundary database migrate -config=/etc/boundary/controller.hcl -output-sql=migrate.sql",
+		"",
 		"  For a full list of examples, please see the documentation.",
 	}) + c.Flags().Help()
 }
@@ -125,6 +133,19 @@ func (c *MigrateCommand) Flags() *base.FlagSets {
 		Usage:  `Run the repair function for the provided migration version.`,
 	})
 
+	f.BoolVar(&base.BoolVar{
+		Name:   "dry-run",
+		Target: &c.flagDryRun,
+		Usage:  `If set, prints the statements of the migrations that would be run against the current schema version of the database, without running them.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "output-sql",
+		Target:     &c.flagOutputSql,
+		Completion: complete.PredictFiles("*.sql"),
+		Usage:      `If set, writes the statements of the migrations that would be run against the current schema version of the database to the file at the given path, without running them.`,
+	})
+
 	return set
 }
 
@@ -180,14 +201,15 @@ func (c *MigrateCommand) Run(args []string) (retCode int) {
 		return base.CommandCliError
 	}
 
+	pluginOpts, err := c.Config.Plugins.PluginOptions("aws")
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error configuring dynamic host plugin: %w", err).Error())
+		return base.CommandCliError
+	}
 	_, awsCleanup, err := external_plugins.CreateHostPlugin(
 		c.Context,
 		"aws",
-		external_plugins.WithPluginOptions(
-			pluginutil.WithPluginExecutionDirectory(c.Config.Plugins.ExecutionDir),
-			pluginutil.WithPluginsFilesystem(boundary_plugin_assets.PluginPrefix, boundary_plugin_assets.FileSystem()),
-		),
-		external_plugins.WithLogger(pluginLogger.Named("aws")),
+		append(pluginOpts, external_plugins.WithLogger(pluginLogger.Named("aws")))...,
 	)
 	if err != nil {
 		c.UI.Error(fmt.Errorf("Error creating dynamic host plugin: %w", err).Error())
@@ -265,6 +287,23 @@ plugins {
 		return base.CommandUserError
 	}
 
+	if c.flagDryRun || c.flagOutputSql != "" {
+		clean, errCode := reviewMigrations(
+			c.Context,
+			c.UI,
+			dialect,
+			migrationUrl,
+			c.Config.Controller.Database.MaxOpenConnections,
+			c.flagDryRun,
+			c.flagOutputSql,
+		)
+		defer clean()
+		if errCode != 0 {
+			return errCode
+		}
+		return base.CommandSuccess
+	}
+
 	clean, errCode := migrateDatabase(
 		c.Context,
 		c.UI,
@@ -315,6 +354,9 @@ func (c *MigrateCommand) ParseFlagsAndConfig(args []string) int {
 	case len(c.flagConfig) == 0:
 		c.UI.Error("Must specify a config file using -config")
 		return base.CommandUserError
+	case len(c.flagRepairMigrations) > 0 && (c.flagDryRun || c.flagOutputSql != ""):
+		c.UI.Error("Cannot use -repair with -dry-run or -output-sql")
+		return base.CommandUserError
 	}
 
 	c.Config, err = config.Load(c.Context, c.flagConfig, c.flagConfigKms)
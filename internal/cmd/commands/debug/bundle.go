@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package debug
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"time"
+)
+
+// manifestName is the name of the file describing the contents of a bundle.
+const manifestName = "manifest.json"
+
+// manifest describes the contents of a debug bundle, along with any
+// collection errors, so that support can tell what was gathered and why
+// something is missing.
+type manifest struct {
+	CreatedTime time.Time         `json:"created_time"`
+	Version     string            `json:"version"`
+	Target      string            `json:"target,omitempty"`
+	Files       []string          `json:"files"`
+	Errors      map[string]string `json:"errors,omitempty"`
+}
+
+// bundle gathers files in memory before they are written out as a gzipped
+// tarball. Every file is placed in a directory named after the bundle so
+// that extracting it does not litter the current directory.
+type bundle struct {
+	name    string
+	created time.Time
+	files   map[string][]byte
+	errors  map[string]string
+}
+
+func newBundle(name string, created time.Time) *bundle {
+	return &bundle{
+		name:    name,
+		created: created,
+		files:   map[string][]byte{},
+		errors:  map[string]string{},
+	}
+}
+
+// add stores data under the given name, replacing any existing file.
+func (b *bundle) add(name string, data []byte) {
+	b.files[name] = data
+}
+
+// addJson stores the indented JSON encoding of v under the given name. A
+// failure to encode is recorded as a collection error.
+func (b *bundle) addJson(name string, v any) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		b.addError(name, fmt.Errorf("error encoding json: %w", err))
+		return
+	}
+	b.add(name, data)
+}
+
+// addError records that the named file could not be collected.
+func (b *bundle) addError(name string, err error) {
+	b.errors[name] = err.Error()
+}
+
+// write writes the bundle, including its manifest, to w as a gzipped
+// tarball. Files are written in name order so bundles are reproducible.
+func (b *bundle) write(w io.Writer, version, target string) error {
+	names := make([]string, 0, len(b.files))
+	for n := range b.files {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	m := manifest{
+		CreatedTime: b.created.UTC(),
+		Version:     version,
+		Target:      target,
+		Files:       names,
+	}
+	if len(b.errors) > 0 {
+		m.Errors = b.errors
+	}
+	mdata, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding manifest: %w", err)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	if err := b.writeFile(tw, manifestName, mdata); err != nil {
+		return err
+	}
+	for _, n := range names {
+		if err := b.writeFile(tw, n, b.files[n]); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("error closing tar writer: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("error closing gzip writer: %w", err)
+	}
+	return nil
+}
+
+func (b *bundle) writeFile(tw *tar.Writer, name string, data []byte) error {
+	hdr := &tar.Header{
+		Name:    path.Join(b.name, name),
+		Mode:    0o600,
+		Size:    int64(len(data)),
+		ModTime: b.created,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("error writing tar header for %q: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("error writing %q to tar: %w", name, err)
+	}
+	return nil
+}
+
+// tailFile returns at most the last maxBytes of the file at the given path.
+// If the file is truncated, the returned data starts at the beginning of a
+// line, so that partial events are not included.
+func tailFile(p string, maxBytes int64) ([]byte, error) {
+	if maxBytes <= 0 {
+		return nil, errors.New("max bytes must be greater than zero")
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	offset := fi.Size() - maxBytes
+	if offset <= 0 {
+		return io.ReadAll(f)
+	}
+	// Start one byte early so a line starting exactly at the offset is kept.
+	if _, err := f.Seek(offset-1, io.SeekStart); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		data = data[i+1:]
+	}
+	return data, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package debug
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundle_write(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	b := newBundle("boundary-debug-test", created)
+	b.add("version.json", []byte(`{"version":"0.1.0"}`))
+	b.addJson("events/audit.log", map[string]string{"id": "e_1234567890"})
+	b.addError("heap.prof", errors.New(`unexpected status "404 Not Found"`))
+
+	var buf bytes.Buffer
+	require.NoError(t, b.write(&buf, "0.1.0", "http://127.0.0.1:9203"))
+
+	gz, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	got := map[string][]byte{}
+	var order []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		assert.Equal(t, created, hdr.ModTime.UTC())
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		got[hdr.Name] = data
+		order = append(order, hdr.Name)
+	}
+	assert.Equal(t, []string{
+		"boundary-debug-test/manifest.json",
+		"boundary-debug-test/events/audit.log",
+		"boundary-debug-test/version.json",
+	}, order)
+	assert.Equal(t, `{"version":"0.1.0"}`, string(got["boundary-debug-test/version.json"]))
+
+	var m manifest
+	require.NoError(t, json.Unmarshal(got["boundary-debug-test/manifest.json"], &m))
+	assert.Equal(t, manifest{
+		CreatedTime: created,
+		Version:     "0.1.0",
+		Target:      "http://127.0.0.1:9203",
+		Files:       []string{"events/audit.log", "version.json"},
+		Errors:      map[string]string{"heap.prof": `unexpected status "404 Not Found"`},
+	}, m)
+}
+
+func TestTailFile(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "events.ndjson")
+	require.NoError(t, os.WriteFile(p, []byte("first\nsecond\nthird\n"), 0o600))
+
+	tests := []struct {
+		name     string
+		maxBytes int64
+		want     string
+		wantErr  bool
+	}{
+		{name: "whole-file", maxBytes: 1024, want: "first\nsecond\nthird\n"},
+		{name: "exact-size", maxBytes: 19, want: "first\nsecond\nthird\n"},
+		{name: "drops-partial-line", maxBytes: 10, want: "third\n"},
+		{name: "line-boundary", maxBytes: 13, want: "second\nthird\n"},
+		{name: "zero", maxBytes: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tailFile(p, tt.maxBytes)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+
+	_, err := tailFile(filepath.Join(t.TempDir(), "missing"), 10)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package debug
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/workers"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/event"
+	ver "github.com/hashicorp/boundary/version"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*Command)(nil)
+	_ cli.CommandAutocomplete = (*Command)(nil)
+)
+
+const (
+	// defaultEventBytes is the default amount of data read from the end of
+	// each configured event file sink.
+	defaultEventBytes = 1024 * 1024
+
+	// defaultRequestTimeout is the default timeout for each request made
+	// while gathering the bundle.
+	defaultRequestTimeout = 30 * time.Second
+)
+
+// opsEndpoints are the ops listener endpoints gathered into the bundle,
+// keyed by the name of the file they are stored in.
+var opsEndpoints = []struct {
+	file string
+	path string
+}{
+	{file: "health.json", path: "/health?worker_info=1"},
+	{file: "metrics.txt", path: "/metrics"},
+	{file: "goroutine.txt", path: "/debug/pprof/goroutine?debug=2"},
+	{file: "heap.prof", path: "/debug/pprof/heap"},
+}
+
+type Command struct {
+	*base.Command
+
+	flagTargetController  string
+	flagTargetTlsInsecure bool
+	flagConfig            []string
+	flagConfigKms         string
+	flagOutput            string
+	flagEventBytes        int64
+	flagTimeout           time.Duration
+	flagConsent           bool
+}
+
+func (c *Command) Synopsis() string {
+	return "Gather debugging information from a Boundary server into an archive"
+}
+
+func (c *Command) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary debug [options]",
+		"",
+		"  Gather debugging information from a Boundary controller or worker into a gzipped tarball that can be shared with support. Example:",
+		"",
+		`    $ boundary debug -target-controller https://127.0.0.1:9203 -config /etc/boundary/controller.hcl`,
+		"",
+		"  The following information is gathered, where available:",
+		"",
+		"    - Version and build information of the local binary",
+		"    - The configuration given with -config, with sensitive values removed",
+		"    - The health, metrics and goroutine and heap profile endpoints of the ops listener given with -target-controller",
+		"    - The status of the workers, as listed through the API",
+		"    - The most recent events written to the file sinks of the given configuration",
+		"    - A HAR archive of the API requests made, with sensitive values removed",
+		"",
+		"  Profiles are only available if profiling is enabled on the ops listener. Anything that could not be gathered is listed in the manifest of the archive along with the reason.",
+		"",
+		"  Events and profiles may still contain information about your deployment, such as user and target identifiers. Review the archive before sharing it. The command asks for confirmation before gathering anything unless -consent is set.",
+	}) + c.Flags().Help()
+}
+
+func (c *Command) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient)
+
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "target-controller",
+		Target:     &c.flagTargetController,
+		Completion: complete.PredictAnything,
+		Usage:      "Address of the ops listener of the controller or worker to gather information from, as a complete URL (e.g. https://127.0.0.1:9203).",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "target-tls-insecure",
+		Target: &c.flagTargetTlsInsecure,
+		Usage:  "Disable verification of the TLS certificate of the ops listener given with -target-controller.",
+	})
+
+	f.StringSliceVar(&base.StringSliceVar{
+		Name:   "config",
+		Target: &c.flagConfig,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: "Path to the configuration file of the server. Sensitive values are removed before it is added to the archive.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "config-kms",
+		Target: &c.flagConfigKms,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: `Path to a configuration file containing a "kms" block marked for "config" purpose, to perform decryption of the main configuration file.`,
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "output",
+		Target:     &c.flagOutput,
+		Completion: complete.PredictFiles("*.tar.gz"),
+		Usage:      `Path of the archive to write. Defaults to "boundary-debug-<timestamp>.tar.gz" in the current directory.`,
+	})
+
+	f.Int64Var(&base.Int64Var{
+		Name:    "event-bytes",
+		Target:  &c.flagEventBytes,
+		Default: defaultEventBytes,
+		Usage:   "The maximum number of bytes read from the end of each configured event file.",
+	})
+
+	f.DurationVar(&base.DurationVar{
+		Name:    "timeout",
+		Target:  &c.flagTimeout,
+		Default: defaultRequestTimeout,
+		Usage:   "The timeout for each request made while gathering information.",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "consent",
+		Target: &c.flagConsent,
+		Usage:  "Consent to gathering debugging information without being asked for confirmation.",
+	})
+
+	return set
+}
+
+func (c *Command) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *Command) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *Command) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.flagTargetController == "" && len(c.flagConfig) == 0:
+		c.UI.Error("At least one of -target-controller or -config must be specified")
+		return base.CommandUserError
+	case c.flagEventBytes <= 0:
+		c.UI.Error("-event-bytes must be greater than zero")
+		return base.CommandUserError
+	case c.flagTimeout <= 0:
+		c.UI.Error("-timeout must be greater than zero")
+		return base.CommandUserError
+	}
+	if c.flagTargetController != "" {
+		u, err := url.Parse(c.flagTargetController)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			c.UI.Error(fmt.Sprintf("Invalid -target-controller %q: must be a complete URL", c.flagTargetController))
+			return base.CommandUserError
+		}
+	}
+
+	if !c.flagConsent {
+		c.UI.Warn(base.WrapForHelpText([]string{
+			"This command gathers configuration, events, profiles and status information from Boundary into an archive for support purposes. Sensitive configuration values are removed, but events and profiles may contain identifiers from your deployment.",
+		}))
+		answer, err := c.UI.Ask("Do you want to continue? Only 'yes' will be accepted:")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading confirmation: %s", err))
+			return base.CommandCliError
+		}
+		if strings.ToLower(strings.TrimSpace(answer)) != "yes" {
+			c.UI.Output("Debug bundle not gathered")
+			return base.CommandUserError
+		}
+	}
+
+	now := time.Now()
+	name := fmt.Sprintf("boundary-debug-%s", now.UTC().Format("20060102T150405Z"))
+	output := c.flagOutput
+	if output == "" {
+		output = name + ".tar.gz"
+	}
+
+	b := newBundle(name, now)
+	verInfo := ver.Get()
+	b.addJson("version.json", verInfo)
+
+	if len(c.flagConfig) > 0 {
+		c.gatherConfig(b)
+	}
+	if c.flagTargetController != "" {
+		c.gatherOps(b)
+	}
+	c.gatherWorkers(b)
+
+	out, err := os.OpenFile(output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating archive: %s", err))
+		return base.CommandCliError
+	}
+	if err := b.write(out, verInfo.FullVersionNumber(true), c.flagTargetController); err != nil {
+		out.Close()
+		c.UI.Error(fmt.Sprintf("Error writing archive: %s", err))
+		return base.CommandCliError
+	}
+	if err := out.Close(); err != nil {
+		c.UI.Error(fmt.Sprintf("Error closing archive: %s", err))
+		return base.CommandCliError
+	}
+
+	for n, e := range b.errors {
+		c.UI.Warn(fmt.Sprintf("Unable to gather %s: %s", n, e))
+	}
+	c.UI.Output(fmt.Sprintf("Debug bundle written to %s", output))
+	return base.CommandSuccess
+}
+
+// gatherConfig adds the sanitized configuration, and the tail of each of its
+// event file sinks, to the bundle.
+func (c *Command) gatherConfig(b *bundle) {
+	cfg, err := config.Load(c.Context, c.flagConfig, c.flagConfigKms)
+	if err != nil {
+		b.addError("config.json", fmt.Errorf("error parsing config: %w", err))
+		return
+	}
+	b.addJson("config.json", cfg.Sanitized())
+
+	if cfg.Eventing == nil {
+		return
+	}
+	for i, s := range cfg.Eventing.Sinks {
+		if s == nil || s.Type != event.FileSink || s.FileConfig == nil {
+			continue
+		}
+		name := s.Name
+		if name == "" {
+			name = fmt.Sprintf("sink-%d", i)
+		}
+		file := filepath.Join("events", filepath.Base(name)+".log")
+		data, err := tailFile(filepath.Join(s.FileConfig.Path, s.FileConfig.FileName), c.flagEventBytes)
+		if err != nil {
+			b.addError(file, err)
+			continue
+		}
+		b.add(file, data)
+	}
+}
+
+// gatherOps adds the responses of the ops listener endpoints to the bundle.
+func (c *Command) gatherOps(b *bundle) {
+	client := cleanhttp.DefaultClient()
+	client.Timeout = c.flagTimeout
+	if c.flagTargetTlsInsecure {
+		client.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	addr := strings.TrimSuffix(c.flagTargetController, "/")
+	for _, e := range opsEndpoints {
+		data, err := fetch(c.Context, client, addr+e.path)
+		if err != nil {
+			b.addError(e.file, err)
+			continue
+		}
+		b.add(e.file, data)
+	}
+}
+
+// gatherWorkers adds the list of workers, and a HAR archive of the requests
+// made to list them, to the bundle. It requires an address and a token for
+// the API, so failures are expected when only the ops listener is reachable.
+func (c *Command) gatherWorkers(b *bundle) {
+	client, err := c.Client()
+	if err != nil {
+		b.addError("workers.json", fmt.Errorf("error creating api client: %w", err))
+		return
+	}
+	capture := api.NewCapture()
+	client.SetCapture(capture)
+
+	ctx, cancel := context.WithTimeout(c.Context, c.flagTimeout)
+	defer cancel()
+	result, err := workers.NewClient(client).List(ctx, "global", workers.WithRecursive(true))
+	if err != nil {
+		b.addError("workers.json", err)
+	} else {
+		b.addJson("workers.json", result.GetItems())
+	}
+
+	var har strings.Builder
+	if err := capture.WriteHAR(&har); err != nil {
+		b.addError("api.har", err)
+		return
+	}
+	b.add("api.har", []byte(har.String()))
+}
+
+func fetch(ctx context.Context, client *http.Client, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	// Health reports an unavailable status while shutting down, which is
+	// still worth gathering.
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusServiceUnavailable {
+		return nil, fmt.Errorf("unexpected status %q", resp.Status)
+	}
+	return data, nil
+}
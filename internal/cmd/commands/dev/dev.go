@@ -710,14 +710,27 @@ func (c *Command) Run(args []string) int {
 
 	base.StartMemProfiler(c.Context)
 
+	eventingOpts := []base.Option{
+		base.WithEventerConfig(c.Config.Eventing),
+		base.WithEventFlags(eventFlags),
+		base.WithEventGating(true),
+	}
+	if c.Config.Worker != nil {
+		// Keep the recent events of the worker so they can be read through
+		// the controller
+		logBuffer, err := worker.NewEventLogBuffer()
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error creating event log buffer: %w", err).Error())
+			return base.CommandCliError
+		}
+		eventingOpts = append(eventingOpts, base.WithEventLogBuffer(logBuffer))
+	}
 	if err := c.SetupEventing(
 		c.Context,
 		c.Logger,
 		c.StderrLock,
 		serverName,
-		base.WithEventerConfig(c.Config.Eventing),
-		base.WithEventFlags(eventFlags),
-		base.WithEventGating(true)); err != nil {
+		eventingOpts...); err != nil {
 		c.UI.Error(err.Error())
 		return base.CommandCliError
 	}
@@ -821,7 +834,7 @@ func (c *Command) Run(args []string) int {
 	}
 
 	{
-		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginAws, base.EnabledPluginHostAzure, base.EnabledPluginGCP)
+		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginAws, base.EnabledPluginHostAzure, base.EnabledPluginGCP, base.EnabledPluginHostDns, base.EnabledPluginHostKubernetes, base.EnabledPluginHostConsul)
 		if base.MinioEnabled {
 			c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginMinio)
 		}
@@ -997,6 +1010,7 @@ func (c *Command) Run(args []string) int {
 		return base.CommandCliError
 	}
 	c.opsServer = opsServer
+	c.opsServer.SetProfilingEnabled(c.Config.EnableProfiling)
 	c.opsServer.Start()
 
 	var shutdownCompleted atm.Bool
@@ -1094,6 +1108,7 @@ func (c *Command) Run(args []string) int {
 			buf := make([]byte, 32*1024*1024)
 			n := runtime.Stack(buf[:], true)
 			event.WriteSysEvent(context.TODO(), op, "goroutine trace", "stack", string(buf[:n]))
+			c.opsServer.EnableProfilingOnSignal(context.TODO(), c.Config.ProfilingSignalWindowDuration)
 
 		case <-time.After(10 * time.Millisecond):
 		}
@@ -0,0 +1,438 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package doctor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*Command)(nil)
+	_ cli.CommandAutocomplete = (*Command)(nil)
+)
+
+const (
+	// certExpiryWarning is how far ahead of a controller certificate's
+	// expiration the doctor starts warning about it.
+	certExpiryWarning = 30 * 24 * time.Hour
+	// tokenExpiryWarning is how far ahead of an auth token's expiration the
+	// doctor starts warning about it.
+	tokenExpiryWarning = time.Hour
+	// clockSkewWarning and clockSkewFailure are the thresholds at which the
+	// difference between the local clock and the controller's clock is
+	// reported.
+	clockSkewWarning = 30 * time.Second
+	clockSkewFailure = 5 * time.Minute
+)
+
+// Status is the outcome of a single diagnostic check.
+type Status string
+
+const (
+	StatusOk      Status = "ok"
+	StatusWarning Status = "warning"
+	StatusFailed  Status = "failed"
+	StatusSkipped Status = "skipped"
+)
+
+// Check is the result of a single diagnostic check, along with a hint on how
+// to remediate it if the check did not pass.
+type Check struct {
+	Name    string `json:"name"`
+	Status  Status `json:"status"`
+	Message string `json:"message,omitempty"`
+	Hint    string `json:"hint,omitempty"`
+}
+
+// CacheStatusFunc reports the version of the running client cache, or an
+// error if it cannot be reached.
+type CacheStatusFunc func(ctx context.Context) (string, error)
+
+type Command struct {
+	*base.Command
+
+	// CacheStatusFn is used to check the health of the client cache. It is
+	// only set on platforms where the client cache is supported.
+	CacheStatusFn CacheStatusFunc
+}
+
+func (c *Command) Synopsis() string {
+	return "Diagnose common problems with the local Boundary client setup"
+}
+
+func (c *Command) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary doctor [options]",
+		"",
+		"  Run a series of checks against the local environment and the configured controller, printing hints on how to fix any problems found. The following are checked: controller reachability, the TLS certificate chain presented by the controller, the freshness of the current auth token, the health of the client cache, the availability of the system keyring, and the clock skew between this machine and the controller. Example:",
+		"",
+		`    $ boundary doctor`,
+		"",
+		"  The command exits with a non-zero status if any check failed.",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *Command) Flags() *base.FlagSets {
+	return c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+}
+
+func (c *Command) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *Command) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *Command) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	checks := c.runChecks(c.Context)
+
+	switch base.Format(c.UI) {
+	case "json":
+		b, err := base.JsonFormatter{}.Format(struct {
+			Checks []Check `json:"checks"`
+		}{Checks: checks})
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error formatting as JSON: %w", err))
+			return base.CommandCliError
+		}
+		c.UI.Output(string(b))
+	default:
+		c.UI.Output(printChecksTable(checks))
+	}
+
+	for _, chk := range checks {
+		if chk.Status == StatusFailed {
+			return base.CommandCliError
+		}
+	}
+	return base.CommandSuccess
+}
+
+// runChecks runs every diagnostic check in order. Checks that depend on a
+// previous check succeeding are skipped when it did not.
+func (c *Command) runChecks(ctx context.Context) []Check {
+	var checks []Check
+
+	keyringCheck := c.checkKeyring()
+	checks = append(checks, keyringCheck)
+	if keyringCheck.Status == StatusFailed {
+		// Still build a client so the remaining checks can run; the keyring
+		// problem has already been reported.
+		c.FlagKeyringType = base.NoneKeyring
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		checks = append(checks, Check{
+			Name:    "Controller reachability",
+			Status:  StatusFailed,
+			Message: fmt.Sprintf("Error reading API client: %s", err),
+			Hint:    "Check the -addr flag or the BOUNDARY_ADDR environment variable, along with any TLS flags.",
+		})
+		return append(checks, c.checkCache(ctx))
+	}
+
+	resp, reachCheck, tlsCheck := c.checkController(ctx, client)
+	checks = append(checks, reachCheck, tlsCheck)
+
+	if resp != nil {
+		checks = append(checks, checkClockSkew(resp.HttpResponse().Header.Get("Date"), time.Now()))
+		checks = append(checks, c.checkToken(ctx, client))
+	} else {
+		checks = append(checks,
+			Check{Name: "Clock skew", Status: StatusSkipped, Message: "The controller could not be reached."},
+			Check{Name: "Auth token", Status: StatusSkipped, Message: "The controller could not be reached."},
+		)
+	}
+
+	return append(checks, c.checkCache(ctx))
+}
+
+func (c *Command) checkKeyring() Check {
+	chk := Check{Name: "Keyring"}
+	if strings.ToLower(c.FlagKeyringType) == base.NoneKeyring {
+		chk.Status = StatusSkipped
+		chk.Message = "Keyring use is disabled."
+		return chk
+	}
+	keyringType, _, err := c.DiscoverKeyringTokenInfo()
+	if err != nil {
+		chk.Status = StatusFailed
+		chk.Message = err.Error()
+		chk.Hint = `Install and initialize a supported keyring, or pass the token with "-token env://<env var name>" and set "-keyring-type=none".`
+		return chk
+	}
+	chk.Status = StatusOk
+	chk.Message = fmt.Sprintf("Using the %q keyring.", keyringType)
+	return chk
+}
+
+// checkController issues an unauthenticated request to the controller and
+// reports on whether it could be reached and on the TLS connection used. The
+// response is returned when the controller could be reached.
+func (c *Command) checkController(ctx context.Context, client *api.Client) (*api.Response, Check, Check) {
+	reachCheck := Check{Name: "Controller reachability"}
+	tlsCheck := Check{Name: "TLS"}
+
+	addr := client.Addr()
+	u, err := url.Parse(addr)
+	if err != nil {
+		reachCheck.Status = StatusFailed
+		reachCheck.Message = fmt.Sprintf("Error parsing controller address %q: %s", addr, err)
+		reachCheck.Hint = "Check the -addr flag or the BOUNDARY_ADDR environment variable."
+		tlsCheck.Status = StatusSkipped
+		return nil, reachCheck, tlsCheck
+	}
+
+	req, err := client.NewRequest(ctx, "GET", "scopes", nil, api.WithSkipCurlOutput(true))
+	if err != nil {
+		reachCheck.Status = StatusFailed
+		reachCheck.Message = fmt.Sprintf("Error creating request: %s", err)
+		tlsCheck.Status = StatusSkipped
+		return nil, reachCheck, tlsCheck
+	}
+	// Any response at all proves the controller is reachable, so don't let a
+	// stale token get in the way.
+	req.Header.Del("Authorization")
+	resp, err := client.Do(req)
+	if err != nil {
+		if tlsErr := asTlsError(err); tlsErr != nil {
+			reachCheck.Status = StatusSkipped
+			reachCheck.Message = "The TLS handshake with the controller failed."
+			tlsCheck.Status = StatusFailed
+			tlsCheck.Message = tlsErr.Error()
+			tlsCheck.Hint = "Pass the controller's CA certificate with -ca-cert or BOUNDARY_CACERT, or check that -tls-server-name matches the controller certificate."
+			return nil, reachCheck, tlsCheck
+		}
+		reachCheck.Status = StatusFailed
+		reachCheck.Message = fmt.Sprintf("Error contacting %s: %s", addr, err)
+		reachCheck.Hint = "Check that the controller is running and that -addr or BOUNDARY_ADDR points at its API listener."
+		tlsCheck.Status = StatusSkipped
+		return nil, reachCheck, tlsCheck
+	}
+
+	reachCheck.Status = StatusOk
+	reachCheck.Message = fmt.Sprintf("Reached %s.", addr)
+	if code := resp.StatusCode(); code >= http.StatusInternalServerError {
+		reachCheck.Status = StatusWarning
+		reachCheck.Message = fmt.Sprintf("Reached %s, but it returned status code %d.", addr, code)
+		reachCheck.Hint = "Check the controller logs for errors."
+	}
+
+	switch {
+	case u.Scheme != "https":
+		tlsCheck.Status = StatusWarning
+		tlsCheck.Message = "The controller address does not use TLS."
+		tlsCheck.Hint = "Use an https:// address unless the controller is only reachable over a trusted network."
+	case resp.HttpResponse().TLS == nil || len(resp.HttpResponse().TLS.PeerCertificates) == 0:
+		tlsCheck.Status = StatusWarning
+		tlsCheck.Message = "No TLS connection information was available."
+	default:
+		tlsCheck = checkCertificate(resp.HttpResponse().TLS, time.Now())
+	}
+	return resp, reachCheck, tlsCheck
+}
+
+func (c *Command) checkToken(ctx context.Context, client *api.Client) Check {
+	chk := Check{Name: "Auth token"}
+	token := client.Token()
+	if token == "" {
+		chk.Status = StatusWarning
+		chk.Message = "No auth token was found."
+		chk.Hint = `Run "boundary authenticate" to obtain a token.`
+		return chk
+	}
+	id, err := base.TokenIdFromToken(token)
+	if err != nil {
+		chk.Status = StatusFailed
+		chk.Message = err.Error()
+		chk.Hint = `Run "boundary authenticate" to obtain a new token.`
+		return chk
+	}
+	result, err := authtokens.NewClient(client).Read(ctx, id)
+	if err != nil {
+		chk.Status = StatusFailed
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			chk.Message = fmt.Sprintf("The controller rejected the token: %s", apiErr.Message)
+		} else {
+			chk.Message = fmt.Sprintf("Error reading the token: %s", err)
+		}
+		chk.Hint = `The token may have expired or been revoked; run "boundary authenticate" to obtain a new one.`
+		return chk
+	}
+	return checkTokenExpiration(result.GetItem().ExpirationTime, time.Now())
+}
+
+func (c *Command) checkCache(ctx context.Context) Check {
+	chk := Check{Name: "Client cache"}
+	if c.CacheStatusFn == nil {
+		chk.Status = StatusSkipped
+		chk.Message = "The client cache is not supported on this platform."
+		return chk
+	}
+	ver, err := c.CacheStatusFn(ctx)
+	if err != nil {
+		chk.Status = StatusWarning
+		chk.Message = err.Error()
+		chk.Hint = `Start the cache with "boundary cache start -background" to enable "boundary search".`
+		return chk
+	}
+	chk.Status = StatusOk
+	chk.Message = "The client cache is running."
+	if ver != "" {
+		chk.Message = fmt.Sprintf("The client cache (%s) is running.", ver)
+	}
+	return chk
+}
+
+// asTlsError returns the underlying certificate or TLS error if the given
+// error was caused by one, or nil otherwise.
+func asTlsError(err error) error {
+	var (
+		unknownAuthErr x509.UnknownAuthorityError
+		hostnameErr    x509.HostnameError
+		invalidErr     x509.CertificateInvalidError
+		verifyErr      *tls.CertificateVerificationError
+		recordErr      tls.RecordHeaderError
+	)
+	switch {
+	case stderrors.As(err, &verifyErr):
+		return verifyErr
+	case stderrors.As(err, &unknownAuthErr):
+		return unknownAuthErr
+	case stderrors.As(err, &hostnameErr):
+		return hostnameErr
+	case stderrors.As(err, &invalidErr):
+		return invalidErr
+	case stderrors.As(err, &recordErr):
+		return recordErr
+	}
+	return nil
+}
+
+// checkCertificate reports on the leaf certificate presented by the
+// controller.
+func checkCertificate(state *tls.ConnectionState, now time.Time) Check {
+	chk := Check{Name: "TLS"}
+	leaf := state.PeerCertificates[0]
+	switch {
+	case len(state.VerifiedChains) == 0:
+		chk.Status = StatusWarning
+		chk.Message = "The controller certificate chain was not verified."
+		chk.Hint = "Remove -tls-insecure or BOUNDARY_TLS_INSECURE and pass the controller's CA certificate with -ca-cert instead."
+	case now.After(leaf.NotAfter):
+		chk.Status = StatusFailed
+		chk.Message = fmt.Sprintf("The controller certificate expired at %s.", leaf.NotAfter.Format(time.RFC3339))
+		chk.Hint = "Renew the controller's TLS certificate."
+	case leaf.NotAfter.Sub(now) < certExpiryWarning:
+		chk.Status = StatusWarning
+		chk.Message = fmt.Sprintf("The controller certificate expires at %s.", leaf.NotAfter.Format(time.RFC3339))
+		chk.Hint = "Renew the controller's TLS certificate before it expires."
+	default:
+		chk.Status = StatusOk
+		chk.Message = fmt.Sprintf("Verified certificate for %q, valid until %s.", leaf.Subject.CommonName, leaf.NotAfter.Format(time.RFC3339))
+	}
+	return chk
+}
+
+// checkTokenExpiration reports on how soon an auth token expiring at the
+// given time will need to be replaced.
+func checkTokenExpiration(expiration, now time.Time) Check {
+	chk := Check{Name: "Auth token"}
+	switch {
+	case expiration.IsZero():
+		chk.Status = StatusOk
+		chk.Message = "The token is valid."
+	case !now.Before(expiration):
+		chk.Status = StatusFailed
+		chk.Message = fmt.Sprintf("The token expired at %s.", expiration.Format(time.RFC3339))
+		chk.Hint = `Run "boundary authenticate" to obtain a new token.`
+	case expiration.Sub(now) < tokenExpiryWarning:
+		chk.Status = StatusWarning
+		chk.Message = fmt.Sprintf("The token expires in %s.", expiration.Sub(now).Round(time.Second))
+		chk.Hint = `Run "boundary authenticate" soon to obtain a new token.`
+	default:
+		chk.Status = StatusOk
+		chk.Message = fmt.Sprintf("The token is valid until %s.", expiration.Format(time.RFC3339))
+	}
+	return chk
+}
+
+// checkClockSkew compares the Date header returned by the controller to the
+// local time.
+func checkClockSkew(date string, now time.Time) Check {
+	chk := Check{Name: "Clock skew"}
+	if date == "" {
+		chk.Status = StatusSkipped
+		chk.Message = "The controller did not return a Date header."
+		return chk
+	}
+	serverTime, err := http.ParseTime(date)
+	if err != nil {
+		chk.Status = StatusSkipped
+		chk.Message = fmt.Sprintf("Error parsing the controller's Date header: %s", err)
+		return chk
+	}
+	skew := now.Sub(serverTime)
+	if skew < 0 {
+		skew = -skew
+	}
+	// The Date header only has second precision.
+	skew = skew.Truncate(time.Second)
+	switch {
+	case skew >= clockSkewFailure:
+		chk.Status = StatusFailed
+		chk.Hint = "Synchronize this machine's clock with NTP; large skew causes token and certificate validation failures."
+	case skew >= clockSkewWarning:
+		chk.Status = StatusWarning
+		chk.Hint = "Synchronize this machine's clock with NTP."
+	default:
+		chk.Status = StatusOk
+	}
+	chk.Message = fmt.Sprintf("The local clock differs from the controller's by %s.", skew)
+	return chk
+}
+
+func printChecksTable(checks []Check) string {
+	ret := []string{"", "Diagnostics:"}
+	for _, chk := range checks {
+		ret = append(ret, fmt.Sprintf("  [%s] %s", strings.ToUpper(string(chk.Status)), chk.Name))
+		if chk.Message != "" {
+			ret = append(ret, fmt.Sprintf("    %s", chk.Message))
+		}
+		if chk.Hint != "" {
+			ret = append(ret, fmt.Sprintf("    Hint: %s", chk.Hint))
+		}
+	}
+	return base.WrapForHelpText(ret)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package doctor
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckClockSkew(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name       string
+		date       string
+		wantStatus Status
+	}{
+		{name: "no-header", date: "", wantStatus: StatusSkipped},
+		{name: "bad-header", date: "yesterday", wantStatus: StatusSkipped},
+		{name: "in-sync", date: now.Add(2 * time.Second).Format(http.TimeFormat), wantStatus: StatusOk},
+		{name: "behind", date: now.Add(-time.Minute).Format(http.TimeFormat), wantStatus: StatusWarning},
+		{name: "ahead", date: now.Add(10 * time.Minute).Format(http.TimeFormat), wantStatus: StatusFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkClockSkew(tt.date, now)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			if tt.wantStatus == StatusWarning || tt.wantStatus == StatusFailed {
+				assert.NotEmpty(t, got.Hint)
+			}
+		})
+	}
+}
+
+func TestCheckTokenExpiration(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name       string
+		expiration time.Time
+		wantStatus Status
+	}{
+		{name: "no-expiration", wantStatus: StatusOk},
+		{name: "fresh", expiration: now.Add(24 * time.Hour), wantStatus: StatusOk},
+		{name: "expiring", expiration: now.Add(10 * time.Minute), wantStatus: StatusWarning},
+		{name: "expired", expiration: now.Add(-time.Second), wantStatus: StatusFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantStatus, checkTokenExpiration(tt.expiration, now).Status)
+		})
+	}
+}
+
+func TestCheckCertificate(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	state := func(notAfter time.Time, verified bool) *tls.ConnectionState {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: "boundary"}, NotAfter: notAfter}
+		s := &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+		if verified {
+			s.VerifiedChains = [][]*x509.Certificate{{cert}}
+		}
+		return s
+	}
+	tests := []struct {
+		name       string
+		state      *tls.ConnectionState
+		wantStatus Status
+	}{
+		{name: "valid", state: state(now.Add(365*24*time.Hour), true), wantStatus: StatusOk},
+		{name: "unverified", state: state(now.Add(365*24*time.Hour), false), wantStatus: StatusWarning},
+		{name: "expiring", state: state(now.Add(24*time.Hour), true), wantStatus: StatusWarning},
+		{name: "expired", state: state(now.Add(-time.Hour), true), wantStatus: StatusFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantStatus, checkCertificate(tt.state, now).Status)
+		})
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package environmentscmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/environments"
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+}
+
+type extraCmdVars struct {
+	flagSessionMaxSeconds      string
+	flagEgressWorkerFilter     string
+	flagEnableSessionRecording string
+	flagStorageBucketId        string
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {"session-max-seconds", "egress-worker-filter", "enable-session-recording", "storage-bucket-id"},
+		"update": {"session-max-seconds", "egress-worker-filter", "enable-session-recording", "storage-bucket-id"},
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	switch c.Func {
+	case "":
+		return helpMap["base"]()
+	}
+	return c.Flags().Help()
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "session-max-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "session-max-seconds",
+				Target: &c.flagSessionMaxSeconds,
+				Usage:  `The maximum lifetime of sessions for targets in the environment. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "egress-worker-filter":
+			f.StringVar(&base.StringVar{
+				Name:   "egress-worker-filter",
+				Target: &c.flagEgressWorkerFilter,
+				Usage:  "A boolean expression to filter which egress workers can handle sessions for targets in the environment.",
+			})
+		case "enable-session-recording":
+			f.StringVar(&base.StringVar{
+				Name:   "enable-session-recording",
+				Target: &c.flagEnableSessionRecording,
+				Usage:  "A boolean indicating if session recording is enabled for SSH targets in the environment.",
+			})
+		case "storage-bucket-id":
+			f.StringVar(&base.StringVar{
+				Name:   "storage-bucket-id",
+				Target: &c.flagStorageBucketId,
+				Usage:  "The public ID of the storage bucket used to record sessions for SSH targets in the environment.",
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]environments.Option) bool {
+	switch c.flagSessionMaxSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, environments.DefaultSessionMaxSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagSessionMaxSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagSessionMaxSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, environments.WithSessionMaxSeconds(final))
+	}
+
+	switch c.flagEgressWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, environments.DefaultEgressWorkerFilter())
+	default:
+		*opts = append(*opts, environments.WithEgressWorkerFilter(c.flagEgressWorkerFilter))
+	}
+
+	switch c.flagEnableSessionRecording {
+	case "":
+	case "null":
+		*opts = append(*opts, environments.DefaultEnableSessionRecording())
+	case "false":
+		*opts = append(*opts, environments.WithEnableSessionRecording(false))
+	case "true":
+		*opts = append(*opts, environments.WithEnableSessionRecording(true))
+	default:
+		c.UI.Error(fmt.Sprintf("Invalid bool value for enable-session-recording %v", c.flagEnableSessionRecording))
+		return false
+	}
+
+	switch c.flagStorageBucketId {
+	case "":
+	case "null":
+		*opts = append(*opts, environments.DefaultStorageBucketId())
+	default:
+		*opts = append(*opts, environments.WithStorageBucketId(c.flagStorageBucketId))
+	}
+
+	return true
+}
+
+func (c *Command) printListTable(items []*environments.Environment) string {
+	if len(items) == 0 {
+		return "No environments found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Environment information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if c.FlagRecursive && item.ScopeId != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.ScopeId),
+			)
+		}
+		if item.Version > 0 {
+			output = append(output,
+				fmt.Sprintf("    Version:             %d", item.Version),
+			)
+		}
+		if item.Name != "" {
+			output = append(output,
+				fmt.Sprintf("    Name:                %s", item.Name),
+			)
+		}
+		if item.Description != "" {
+			output = append(output,
+				fmt.Sprintf("    Description:         %s", item.Description),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printItemTable(item *environments.Environment, resp *api.Response) string {
+	nonAttributeMap := map[string]any{}
+	if item.Id != "" {
+		nonAttributeMap["ID"] = item.Id
+	}
+	if item.Version != 0 {
+		nonAttributeMap["Version"] = item.Version
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = item.UpdatedTime.Local().Format(time.RFC1123)
+	}
+	if item.Name != "" {
+		nonAttributeMap["Name"] = item.Name
+	}
+	if item.Description != "" {
+		nonAttributeMap["Description"] = item.Description
+	}
+	if item.EgressWorkerFilter != "" {
+		nonAttributeMap["Egress Worker Filter"] = item.EgressWorkerFilter
+	}
+	if item.StorageBucketId != "" {
+		nonAttributeMap["Storage Bucket ID"] = item.StorageBucketId
+	}
+	if resp != nil && resp.Map != nil {
+		if resp.Map[globals.SessionMaxSecondsField] != nil {
+			nonAttributeMap["Session Max Seconds"] = item.SessionMaxSeconds
+		}
+		if resp.Map[globals.EnableSessionRecordingField] != nil {
+			nonAttributeMap["Enable Session Recording"] = item.EnableSessionRecording
+		}
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Environment information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if item.Scope != nil {
+		ret = append(ret,
+			"",
+			"  Scope:",
+			base.ScopeInfoForOutput(item.Scope, maxLength),
+		)
+	}
+
+	if len(item.AuthorizedActions) > 0 {
+		ret = append(ret,
+			"",
+			"  Authorized Actions:",
+			base.WrapSlice(4, item.AuthorizedActions),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
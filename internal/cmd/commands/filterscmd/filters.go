@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filterscmd
+
+import (
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+)
+
+var _ cli.Command = (*Command)(nil)
+
+type Command struct {
+	*base.Command
+}
+
+func (c *Command) Synopsis() string {
+	return "Check filter expressions"
+}
+
+func (c *Command) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary filters <subcommand> [options] [args]",
+		"",
+		"  This command groups subcommands for checking the filter expressions used by Boundary before they are set on resources. Example:",
+		"",
+		"    Validate a worker filter:",
+		"",
+		`      $ boundary filters validate -type worker -expression '"dev" in "/tags/env"'`,
+		"",
+		"  Please see the individual subcommand help for detailed usage information.",
+	})
+}
+
+func (c *Command) Run(args []string) int {
+	return cli.RunResultHelp
+}
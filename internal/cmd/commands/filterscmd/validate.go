@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filterscmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/filters"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ValidateCommand)(nil)
+	_ cli.CommandAutocomplete = (*ValidateCommand)(nil)
+)
+
+type ValidateCommand struct {
+	*base.Command
+
+	flagType       string
+	flagExpression string
+}
+
+func (c *ValidateCommand) Synopsis() string {
+	return "Validate a filter expression"
+}
+
+func (c *ValidateCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary filters validate [options] [expression]",
+		"",
+		"  Parse a filter expression and check it against the data the given type of filter is evaluated against, printing the problems found with their position in the expression. The expression can be given with the -expression flag or as the only argument. Example:",
+		"",
+		`      $ boundary filters validate -type worker -expression '"dev" in "/tags/env"'`,
+		"",
+		"  The command exits with a non-zero status if the expression is invalid.",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ValidateCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "type",
+		Target:     &c.flagType,
+		Default:    filters.WorkerType,
+		Completion: complete.PredictSet(filters.WorkerType, filters.ManagedGroupType, filters.ListType),
+		Usage:      `The type of filter to validate the expression as: "worker" for the worker filters of targets, host catalogs and storage buckets, "managed-group" for the filters of OIDC managed groups, or "list" for the filters of list requests. Defaults to "worker".`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "expression",
+		Target: &c.flagExpression,
+		Usage:  `The filter expression to validate. This can refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.`,
+	})
+
+	return set
+}
+
+func (c *ValidateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ValidateCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ValidateCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	expression := c.flagExpression
+	switch args := f.Args(); {
+	case len(args) > 1:
+		c.PrintCliError(errors.New("Only one filter expression can be given as an argument"))
+		return base.CommandUserError
+	case len(args) == 1 && expression != "":
+		c.PrintCliError(errors.New("The filter expression cannot be given both as an argument and with -expression"))
+		return base.CommandUserError
+	case len(args) == 1:
+		expression = args[0]
+	case expression == "":
+		c.PrintCliError(errors.New("A filter expression must be given with -expression or as an argument"))
+		return base.CommandUserError
+	}
+	expression, err := parseutil.ParsePath(expression)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		c.PrintCliError(fmt.Errorf("Error parsing filter expression: %w", err))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := filters.NewClient(client).Validate(c.Context, c.flagType, expression)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when performing validate on filter")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to validate filter: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	default:
+		c.UI.Output(printValidateTable(expression, result))
+	}
+
+	if !result.Valid {
+		return base.CommandUserError
+	}
+	return base.CommandSuccess
+}
+
+// printValidateTable lists the problems found in the expression, pointing at
+// their position under the line of the expression they were found on.
+func printValidateTable(expression string, result *filters.ValidateResult) string {
+	if result.Valid {
+		return "\nThe filter expression is valid."
+	}
+	lines := strings.Split(expression, "\n")
+	ret := []string{"", "The filter expression is invalid:"}
+	for _, e := range result.Errors {
+		if e.Line == 0 || int(e.Line) > len(lines) {
+			ret = append(ret, fmt.Sprintf("  %s", e.Message))
+			continue
+		}
+		ret = append(ret,
+			fmt.Sprintf("  %d:%d: %s", e.Line, e.Column, e.Message),
+			fmt.Sprintf("    %s", lines[e.Line-1]),
+			fmt.Sprintf("    %s^", strings.Repeat(" ", max(int(e.Column)-1, 0))),
+		)
+	}
+	// The lines are not wrapped, which would move the markers away from the
+	// positions they point at.
+	return strings.Join(ret, "\n")
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filterscmd
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/api/filters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintValidateTable(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		result     *filters.ValidateResult
+		want       string
+	}{
+		{
+			name:       "valid",
+			expression: `"dev" in "/tags/env"`,
+			result:     &filters.ValidateResult{Valid: true},
+			want:       "\nThe filter expression is valid.",
+		},
+		{
+			name:       "positioned",
+			expression: "\"dev\" in \"/tags/env\" and\n  \"/tag/name\" == \"w1\"",
+			result: &filters.ValidateResult{
+				Errors: []*filters.ValidationError{
+					{Message: `unknown selector "/tag/name"`, Line: 2, Column: 3, Offset: 28},
+				},
+			},
+			want: "\nThe filter expression is invalid:\n" +
+				"  2:3: unknown selector \"/tag/name\"\n" +
+				"      \"/tag/name\" == \"w1\"\n" +
+				"      ^",
+		},
+		{
+			name:       "not positioned",
+			expression: " ",
+			result: &filters.ValidateResult{
+				Errors: []*filters.ValidationError{{Message: "filter expression is empty"}},
+			},
+			want: "\nThe filter expression is invalid:\n  filter expression is empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, printValidateTable(tt.expression, tt.result))
+		})
+	}
+}
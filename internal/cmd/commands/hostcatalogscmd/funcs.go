@@ -6,13 +6,54 @@ package hostcatalogscmd
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/hostcatalogs"
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
 )
 
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
+}
+
+const (
+	automaticRotationIntervalFlagName = "automatic-rotation-interval"
+	targetRuleFlagName                = "target-rule"
+)
+
+type extraCmdVars struct {
+	flagAutomaticRotationInterval string
+	flagTargetRules               []string
+	rsr                           *hostcatalogs.RotateSecretsResult
+	targetRules                   []*hostcatalogs.TargetRule
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"rotate-secrets":   {"id", "version", "secrets", "secret", "string-secret", "bool-secret", "num-secret", automaticRotationIntervalFlagName},
+		"set-target-rules": {"id", "version", targetRuleFlagName},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "rotate-secrets":
+		return "Rotate the secrets of the specified plugin-type host catalog"
+	case "set-target-rules":
+		return "Set the target rules of the specified plugin-type host catalog"
+	default:
+		return ""
+	}
+}
+
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
@@ -52,12 +93,184 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
+	case "rotate-secrets":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary host-catalogs rotate-secrets [options] [args]",
+			"",
+			"  Rotate the secrets of a plugin-type host catalog given its ID. The new secrets are validated by the catalog's plugin before they are stored. If no secrets are given, the stored secrets are handed back to the plugin, which allows plugins that manage their own credentials to replace them. Example:",
+			"",
+			"    Rotate the secrets of a host catalog with new secrets:",
+			"",
+			`      $ boundary host-catalogs rotate-secrets -id hc_1234567890 -secrets file:///path/to/secrets.json`,
+			"",
+			"    Rotate the secrets of a host catalog every 30 days:",
+			"",
+			`      $ boundary host-catalogs rotate-secrets -id hc_1234567890 -automatic-rotation-interval 720h`,
+			"",
+			"",
+		})
+	case "set-target-rules":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary host-catalogs set-target-rules [options] [args]",
+			"",
+			"  Set the target rules of a plugin-type host catalog given its ID. For every host set of the catalog matching the filter of a rule, a target is created from the target template of the rule and the host set is added to its host sources. Example:",
+			"",
+			"    Create a target for every host set named web-*:",
+			"",
+			`      $ boundary host-catalogs set-target-rules -id hc_1234567890 -target-rule 'ttpl_1234567890="/name" matches "web-.*"'`,
+			"",
+			"    Remove all the target rules of a host catalog:",
+			"",
+			`      $ boundary host-catalogs set-target-rules -id hc_1234567890 -target-rule null`,
+			"",
+			"",
+		})
 	default:
 		helpStr = helpMap["base"]()
 	}
 	return helpStr + c.Flags().Help()
 }
 
+func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case automaticRotationIntervalFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   automaticRotationIntervalFlagName,
+				Target: &c.flagAutomaticRotationInterval,
+				Usage:  `How often the secrets of the host catalog are rotated automatically, e.g. "720h". Use "null" to disable automatic rotation. If not set, the current schedule is kept.`,
+			})
+		case targetRuleFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:      targetRuleFlagName,
+				Target:    &c.flagTargetRules,
+				NullCheck: func() bool { return true },
+				Usage:     `A target rule in the form "<target template id>=<filter>". May be specified multiple times. Use "null" to remove all the target rules.`,
+			})
+		}
+	}
+
+	if c.Func == "rotate-secrets" {
+		common.PopulateCombinedSliceFlagValue(common.CombinedSliceFlagValuePopulationInput{
+			FlagSet:                          set.NewFlagSet("Secrets Options"),
+			FlagNames:                        flagsMap[c.Func],
+			FullPopulationFlag:               &c.FlagSecrets,
+			FullPopulationInputName:          "secrets",
+			PiecewisePopulationFlag:          &c.FlagScrts,
+			PiecewisePopulationInputBaseName: "secret",
+		})
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]hostcatalogs.Option) bool {
+	switch c.Func {
+	case "rotate-secrets":
+		if c.FlagSecrets != "" && len(c.FlagScrts) > 0 {
+			c.UI.Error("-secrets flag cannot be used along with the following flags: secret, bool-secret, num-secret, string-secret")
+			return false
+		}
+		if err := common.HandleAttributeFlags(
+			c.Command,
+			"secret",
+			c.FlagSecrets,
+			c.FlagScrts,
+			func() {},
+			func(in map[string]any) {
+				*opts = append(*opts, hostcatalogs.WithSecrets(in))
+			}); err != nil {
+			c.UI.Error(fmt.Sprintf("Error evaluating secret flags to: %s", err.Error()))
+			return false
+		}
+
+		switch c.flagAutomaticRotationInterval {
+		case "":
+		case "null":
+			*opts = append(*opts, hostcatalogs.WithAutomaticRotationInterval(0))
+		default:
+			interval, err := time.ParseDuration(c.flagAutomaticRotationInterval)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing -%s: %s", automaticRotationIntervalFlagName, err))
+				return false
+			}
+			if interval < time.Second {
+				c.UI.Error(fmt.Sprintf("-%s must be at least one second, use \"null\" to disable automatic rotation", automaticRotationIntervalFlagName))
+				return false
+			}
+			*opts = append(*opts, hostcatalogs.WithAutomaticRotationInterval(interval))
+		}
+
+	case "set-target-rules":
+		switch {
+		case len(c.flagTargetRules) == 0:
+			c.UI.Error(fmt.Sprintf("No target rules supplied via -%s", targetRuleFlagName))
+			return false
+		case len(c.flagTargetRules) == 1 && c.flagTargetRules[0] == "null":
+			c.targetRules = nil
+		default:
+			for _, r := range c.flagTargetRules {
+				templateId, filter, ok := strings.Cut(r, "=")
+				if !ok || strings.TrimSpace(templateId) == "" || strings.TrimSpace(filter) == "" {
+					c.UI.Error(fmt.Sprintf("Target rule %q is not in the form <target template id>=<filter>", r))
+					return false
+				}
+				c.targetRules = append(c.targetRules, &hostcatalogs.TargetRule{
+					TargetTemplateId: strings.TrimSpace(templateId),
+					Filter:           strings.TrimSpace(filter),
+				})
+			}
+		}
+	}
+
+	return true
+}
+
+func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *hostcatalogs.HostCatalog, origItems []*hostcatalogs.HostCatalog, origError error, hostcatalogClient *hostcatalogs.Client, version uint32, opts []hostcatalogs.Option) (*api.Response, *hostcatalogs.HostCatalog, []*hostcatalogs.HostCatalog, error) {
+	switch c.Func {
+	case "rotate-secrets":
+		var err error
+		c.rsr, err = hostcatalogClient.RotateSecrets(c.Context, c.FlagId, version, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return c.rsr.GetResponse(), c.rsr.GetItem(), nil, err
+	case "set-target-rules":
+		result, err := hostcatalogClient.SetTargetRules(c.Context, c.FlagId, version, c.targetRules, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
+	}
+	return origResp, origItem, origItems, origError
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "rotate-secrets":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printItemTable(c.rsr.GetItem(), c.rsr.GetResponse()))
+			interval := "disabled"
+			if secs := c.rsr.AutomaticRotationIntervalSeconds; secs > 0 {
+				interval = (time.Duration(secs) * time.Second).String()
+			}
+			c.UI.Output(base.WrapForHelpText([]string{
+				"",
+				"Automatic secret rotation:",
+				fmt.Sprintf("  Interval:              %s", interval),
+			}))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.rsr.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*hostcatalogs.HostCatalog) string {
 	if len(items) == 0 {
 		return "No host catalogs found"
@@ -192,6 +405,19 @@ func printItemTable(item *hostcatalogs.HostCatalog, resp *api.Response) string {
 		)
 	}
 
+	if len(item.TargetRules) > 0 {
+		ret = append(ret,
+			"",
+			"  Target Rules:",
+		)
+		for _, r := range item.TargetRules {
+			ret = append(ret,
+				fmt.Sprintf("    Target Template ID:  %s", r.TargetTemplateId),
+				fmt.Sprintf("      Filter:            %s", r.Filter),
+			)
+		}
+	}
+
 	if len(item.AuthorizedActions) > 0 {
 		ret = append(ret,
 			"",
@@ -8,7 +8,7 @@ import (
 
 	"github.com/hashicorp/boundary/api/hostcatalogs"
 	"github.com/hashicorp/boundary/internal/cmd/base"
-	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/boundary/internal/filter"
 )
 
 func init() {
@@ -56,7 +56,7 @@ func extraPluginFlagHandlingFuncImpl(c *PluginCommand, f *base.FlagSets, opts *[
 	case "null":
 		*opts = append(*opts, hostcatalogs.DefaultWorkerFilter())
 	default:
-		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+		if _, err := filter.CreateEvaluator(c.flagWorkerFilter); err != nil {
 			c.UI.Error(fmt.Sprintf("Unable to successfully parse filter expression: %s", err))
 			return false
 		}
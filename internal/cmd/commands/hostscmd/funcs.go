@@ -12,6 +12,107 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 )
 
+const (
+	flagAddressRange = "address-range"
+	flagNameTemplate = "name-template"
+)
+
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create-range": {"host-catalog-id", "description", flagAddressRange, flagNameTemplate},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "create-range":
+		return "Create a static host for each address in a range"
+
+	default:
+		return ""
+	}
+}
+
+type extraCmdVars struct {
+	flagAddressRange string
+	flagNameTemplate string
+	crr              *hosts.HostCreateRangeResult
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case flagAddressRange:
+			f.StringVar(&base.StringVar{
+				Name:   flagAddressRange,
+				Target: &c.flagAddressRange,
+				Usage:  `The range of addresses to create hosts for. Either a CIDR block, such as "10.0.0.0/28", or a hostname containing a single numeric range, such as "web[01-20].corp".`,
+			})
+		case flagNameTemplate:
+			f.StringVar(&base.StringVar{
+				Name:   flagNameTemplate,
+				Target: &c.flagNameTemplate,
+				Usage:  `A Go template used to name the hosts. It can reference the address of each host as {{.Address}} and its 1-based position in the range as {{.Index}}. If not set, the hosts are created without names.`,
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]hosts.Option) bool {
+	if c.Func != "create-range" {
+		return true
+	}
+	if c.FlagHostCatalogId == "" {
+		c.UI.Error("HostCatalog ID must be passed in via -host-catalog-id or BOUNDARY_HOST_CATALOG_ID")
+		return false
+	}
+	if c.flagAddressRange == "" {
+		c.UI.Error("Address range must be provided via -address-range")
+		return false
+	}
+	return true
+}
+
+func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *hosts.Host, origItems []*hosts.Host, origError error, hostClient *hosts.Client, _ uint32, opts []hosts.Option) (*api.Response, *hosts.Host, []*hosts.Host, error) {
+	switch c.Func {
+	case "create-range":
+		var err error
+		c.crr, err = hostClient.CreateRange(c.Context, c.FlagHostCatalogId, c.flagAddressRange, c.flagNameTemplate, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return c.crr.GetResponse(), nil, c.crr.GetItems(), nil
+	}
+	return origResp, origItem, origItems, origError
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "create-range":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(c.printListTable(c.crr.GetItems()))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItems(c.crr.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
@@ -39,6 +140,22 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
+	case "create-range":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary hosts create-range [options] [args]",
+			"",
+			"  Create a static host for each address in a range. Either all of the hosts are created or none are. Examples:",
+			"",
+			"    Create a host for each usable address of a CIDR block:",
+			"",
+			`      $ boundary hosts create-range -host-catalog-id hcst_1234567890 -address-range "10.0.0.0/28"`,
+			"",
+			"    Create named hosts from a numeric hostname range:",
+			"",
+			`      $ boundary hosts create-range -host-catalog-id hcst_1234567890 -address-range "web[01-20].corp" -name-template "web-{{.Index}}"`,
+			"",
+			"",
+		})
 	case "update":
 		helpStr = base.WrapForHelpText([]string{
 			"Usage: boundary hosts update [type] [sub command] [options] [args]",
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package maintenancecmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/maintenance"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
+}
+
+type extraCmdVars struct {
+	flagDisable bool
+	flagReason  string
+	result      *maintenance.MaintenanceResult
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		// read has no options of its own; the empty entry still makes the
+		// client and output flags available to it.
+		"read":          {""},
+		"set-read-only": {"disable", "reason"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "read":
+		return "Read the maintenance mode of the controllers"
+	case "set-read-only":
+		return "Enable or disable the read-only maintenance mode of the controllers"
+	default:
+		return ""
+	}
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	if c.Func != "set-read-only" {
+		return
+	}
+	f.BoolVar(&base.BoolVar{
+		Name:   "disable",
+		Target: &c.flagDisable,
+		Usage:  "Disable the read-only mode instead of enabling it.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "reason",
+		Target: &c.flagReason,
+		Usage:  "The reason for the read-only mode, included in the errors returned to the requests that are rejected.",
+	})
+}
+
+func executeExtraActionsImpl(c *Command, origResp *api.Response, origError error, maintenanceClient *maintenance.Client, _ uint32, opts []maintenance.Option) (*api.Response, error) {
+	var err error
+	switch c.Func {
+	case "read":
+		c.result, err = maintenanceClient.Read(c.Context, opts...)
+	case "set-read-only":
+		c.result, err = maintenanceClient.SetReadOnly(c.Context, !c.flagDisable, c.flagReason, opts...)
+	default:
+		return origResp, origError
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.result.GetResponse(), nil
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "read", "set-read-only":
+	default:
+		return false, nil
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printItemTable(c.result.GetItem()))
+		return true, nil
+
+	case "json":
+		if ok := c.PrintJsonItem(c.result.GetResponse()); !ok {
+			return false, fmt.Errorf("error formatting as JSON")
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func printItemTable(item *maintenance.Maintenance) string {
+	nonAttributeMap := map[string]any{
+		"Read Only": item.ReadOnly,
+	}
+	if item.Reason != "" {
+		nonAttributeMap["Reason"] = item.Reason
+	}
+	if !item.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = item.UpdatedTime.Local().Format(time.RFC1123)
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Maintenance information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+	return base.WrapForHelpText(ret)
+}
+
+func (c *Command) extraHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary maintenance [sub command] [options] [args]",
+			"",
+			"  This command allows for managing the maintenance mode of the Boundary controllers. Example:",
+			"",
+			"    Read the maintenance mode:",
+			"",
+			`      $ boundary maintenance read`,
+			"",
+			"    Put the controllers in read-only mode:",
+			"",
+			`      $ boundary maintenance set-read-only -reason "Database upgrade"`,
+			"",
+			"  Please see the maintenance subcommand help for detailed usage information.",
+		})
+	case "read":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary maintenance read [options]",
+			"",
+			"  Read the maintenance mode of the controllers. Example:",
+			"",
+			`      $ boundary maintenance read`,
+			"",
+			"",
+		})
+	case "set-read-only":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary maintenance set-read-only [options]",
+			"",
+			"  Enable or disable the read-only maintenance mode of the controllers. While it is enabled, requests that modify resources are rejected, while reads, authentication and the sessions that already exist keep working. Example:",
+			"",
+			"    Enable the read-only mode:",
+			"",
+			`      $ boundary maintenance set-read-only -reason "Database upgrade"`,
+			"",
+			"    Disable the read-only mode:",
+			"",
+			`      $ boundary maintenance set-read-only -disable`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -22,6 +22,8 @@ type extraStorageCmdVars struct {
 	flagRetainForOverridable   string
 	flagDeleteAfterDays        string
 	flagDeleteAfterOverridable string
+	flagRecordPercent          string
+	flagRecordFilter           string
 
 	flagRetainFor   map[string]string
 	flagDeleteAfter map[string]string
@@ -56,8 +58,8 @@ func (c *StorageCommand) extraStorageHelpFunc(_ map[string]func() string) string
 
 func extraStorageActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"retain-for-days", "retain-for-overridable", "delete-after-days", "delete-after-overridable"},
-		"update": {"retain-for-days", "retain-for-overridable", "delete-after-days", "delete-after-overridable"},
+		"create": {"retain-for-days", "retain-for-overridable", "delete-after-days", "delete-after-overridable", "record-percent", "record-filter"},
+		"update": {"retain-for-days", "retain-for-overridable", "delete-after-days", "delete-after-overridable", "record-percent", "record-filter"},
 	}
 }
 
@@ -90,6 +92,18 @@ func extraStorageFlagsFuncImpl(c *StorageCommand, set *base.FlagSets, _ *base.Fl
 				Target: &c.flagDeleteAfterOverridable,
 				Usage:  "Allow/Disallow this policy's deletion period to be overridden by downstream Policies (true or false)",
 			})
+		case "record-percent":
+			fs.StringVar(&base.StringVar{
+				Name:   "record-percent",
+				Target: &c.flagRecordPercent,
+				Usage:  "Percentage of sessions, between 1 and 100, that will be recorded.",
+			})
+		case "record-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "record-filter",
+				Target: &c.flagRecordFilter,
+				Usage:  "A boolean expression evaluated against the session's user, target and scope. Only matching sessions will be recorded.",
+			})
 		}
 	}
 }
@@ -145,5 +159,25 @@ func extraStorageFlagsHandlingFuncImpl(c *StorageCommand, _ *base.FlagSets, opts
 		*opts = append(*opts, policies.WithStoragePolicyDeleteAfterOverridable(overridable))
 	}
 
+	switch c.flagRecordPercent {
+	case "":
+	case "null":
+		*opts = append(*opts, policies.DefaultStoragePolicySamplingPercent())
+	default:
+		percent, err := strconv.ParseInt(c.flagRecordPercent, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagRecordPercent, err))
+			return false
+		}
+		*opts = append(*opts, policies.WithStoragePolicySamplingPercent(int32(percent)))
+	}
+	switch c.flagRecordFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, policies.DefaultStoragePolicySamplingFilter())
+	default:
+		*opts = append(*opts, policies.WithStoragePolicySamplingFilter(c.flagRecordFilter))
+	}
+
 	return true
 }
@@ -15,6 +15,7 @@ import (
 
 const (
 	flagPrimaryAuthMethodIdName     = "primary-auth-method-id"
+	flagProtectName                 = "protect"
 	flagSkipAdminRoleCreationName   = "skip-admin-role-creation"
 	flagSkipDefaultRoleCreationName = "skip-default-role-creation"
 	flagStoragePolicyIdName         = "storage-policy-id"
@@ -22,6 +23,7 @@ const (
 
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
@@ -29,10 +31,20 @@ func init() {
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create":                {flagSkipAdminRoleCreationName, flagSkipDefaultRoleCreationName},
-		"update":                {flagPrimaryAuthMethodIdName},
-		"attach-storage-policy": {"id", "version", flagStoragePolicyIdName},
-		"detach-storage-policy": {"id", "version"},
+		"create":                  {flagSkipAdminRoleCreationName, flagSkipDefaultRoleCreationName},
+		"update":                  {flagPrimaryAuthMethodIdName},
+		"attach-storage-policy":   {"id", "version", flagStoragePolicyIdName},
+		"detach-storage-policy":   {"id", "version"},
+		"set-deletion-protection": {"id", flagProtectName},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "set-deletion-protection":
+		return "Protect a scope from deletion, or remove its protection"
+	default:
+		return ""
 	}
 }
 
@@ -41,6 +53,7 @@ type extraCmdVars struct {
 	flagSkipDefaultRoleCreation bool
 	flagPrimaryAuthMethodId     string
 	flagStoragePolicyId         string
+	flagProtect                 bool
 }
 
 func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
@@ -70,6 +83,13 @@ func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagStoragePolicyId,
 				Usage:  "The public ID of the Storage Policy to attach to this scope. Can only attach to the global scope and an Org scope.",
 			})
+		case flagProtectName:
+			f.BoolVar(&base.BoolVar{
+				Name:    flagProtectName,
+				Target:  &c.flagProtect,
+				Default: true,
+				Usage:   "Whether to protect the scope from deletion. Use -protect=false to remove its protection. Defaults to true.",
+			})
 		}
 	}
 }
@@ -111,6 +131,12 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *scope
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "set-deletion-protection":
+		result, err := scopeClient.SetDeletionProtection(c.Context, c.FlagId, c.flagProtect, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
@@ -200,6 +226,9 @@ func printItemTable(item *scopes.Scope, resp *api.Response) string {
 	if item.StoragePolicyId != "" {
 		nonAttributeMap["Storage Policy ID"] = item.StoragePolicyId
 	}
+	if item.ProtectFromDeletion {
+		nonAttributeMap["Protect From Deletion"] = item.ProtectFromDeletion
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
@@ -217,6 +246,32 @@ func printItemTable(item *scopes.Scope, resp *api.Response) string {
 		)
 	}
 
+	if item.RequestQuota != nil {
+		quotaMap := map[string]any{
+			"Limit":      item.RequestQuota.Limit,
+			"Used":       item.RequestQuota.Used,
+			"Period":     item.RequestQuota.Period.String(),
+			"Reset Time": item.RequestQuota.ResetTime.Local().Format(time.RFC1123),
+		}
+		ret = append(ret,
+			"",
+			"  Request Quota:",
+			base.WrapMap(4, maxLength, quotaMap),
+		)
+	}
+
+	if len(item.Annotations) > 0 {
+		annotations := make(map[string]any, len(item.Annotations))
+		for k, v := range item.Annotations {
+			annotations[k] = v
+		}
+		ret = append(ret,
+			"",
+			"  Annotations:",
+			base.WrapMap(4, maxLength, annotations),
+		)
+	}
+
 	if len(item.AuthorizedActions) > 0 {
 		ret = append(ret,
 			"",
@@ -271,6 +326,22 @@ func (c *Command) extraHelpFunc(_ map[string]func() string) string {
 			"",
 			"",
 		})
+	case "set-deletion-protection":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary scopes set-deletion-protection [options] [args]",
+			"",
+			"  This command protects a scope from deletion, or removes its protection. While the scope is protected, deleting it or any scope containing it fails. The global scope cannot be deleted and so cannot be protected. Example:",
+			"",
+			"    Protect a scope from deletion:",
+			"",
+			`      $ boundary scopes set-deletion-protection -id o_1234567890`,
+			"",
+			"    Remove the protection of a scope:",
+			"",
+			`      $ boundary scopes set-deletion-protection -id o_1234567890 -protect=false`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scopescmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ListDanglingReferencesCommand)(nil)
+	_ cli.CommandAutocomplete = (*ListDanglingReferencesCommand)(nil)
+)
+
+type ListDanglingReferencesCommand struct {
+	*base.Command
+}
+
+func (c *ListDanglingReferencesCommand) Synopsis() string {
+	return wordwrap.WrapString("List references to resources which no longer exist", base.TermWidth)
+}
+
+func (c *ListDanglingReferencesCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary scopes list-dangling-references [args]",
+		"",
+		"  List the references from the resources in a scope to resources which no longer exist, such as role grants naming deleted resources or aliases naming deleted hosts. Example:",
+		"",
+		`    $ boundary scopes list-dangling-references -id o_1234567890 -recursive`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ListDanglingReferencesCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the scope in which to list the dangling references",
+	})
+	f.BoolVar(&base.BoolVar{
+		Name:   "recursive",
+		Target: &c.FlagRecursive,
+		Usage:  "If set, the resources of the scopes beneath the scope are included",
+	})
+
+	return set
+}
+
+func (c *ListDanglingReferencesCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ListDanglingReferencesCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ListDanglingReferencesCommand) printListTable(items []*scopes.DanglingReference) string {
+	if len(items) == 0 {
+		return "No dangling references found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Dangling reference information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  Resource ID:      %s", item.ResourceId),
+			fmt.Sprintf("    Resource Type:  %s", item.ResourceType),
+			fmt.Sprintf("    Scope ID:       %s", item.ScopeId),
+			fmt.Sprintf("    Field:          %s", item.Field),
+			fmt.Sprintf("    Referenced ID:  %s", item.ReferencedId),
+		)
+		if item.Detail != "" {
+			output = append(output,
+				fmt.Sprintf("    Detail:         %s", item.Detail),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func (c *ListDanglingReferencesCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("Scope ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	sClient := scopes.NewClient(client)
+	result, err := sClient.ListDanglingReferences(c.Context, c.FlagId, c.FlagRecursive)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing dangling references")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to list dangling references: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(c.printListTable(result.GetItems()))
+	}
+
+	return base.CommandSuccess
+}
@@ -204,12 +204,25 @@ func (c *Command) Run(args []string) int {
 	}
 	serverName = fmt.Sprintf("%s/%s", serverName, strings.Join(serverTypes, "+"))
 
+	eventingOpts := []base.Option{
+		base.WithEventerConfig(c.Config.Eventing),
+		base.WithEventGating(true),
+	}
+	if c.Config.Worker != nil {
+		// Keep the recent events of the worker so they can be read through
+		// the controller
+		logBuffer, err := worker.NewEventLogBuffer()
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error creating event log buffer: %w", err).Error())
+			return base.CommandCliError
+		}
+		eventingOpts = append(eventingOpts, base.WithEventLogBuffer(logBuffer))
+	}
 	if err := c.SetupEventing(c.Context,
 		c.Logger,
 		c.StderrLock,
 		serverName,
-		base.WithEventerConfig(c.Config.Eventing),
-		base.WithEventGating(true)); err != nil {
+		eventingOpts...); err != nil {
 		c.UI.Error(err.Error())
 		return base.CommandUserError
 	}
@@ -286,6 +299,7 @@ func (c *Command) Run(args []string) int {
 	var clusterAddr string
 	var foundApi bool
 	var foundProxy bool
+	var foundTunnel bool
 	for _, lnConfig := range c.Config.Listeners {
 		switch len(lnConfig.Purpose) {
 		case 0:
@@ -309,6 +323,8 @@ func (c *Command) Run(args []string) int {
 					lnConfig.Address = "127.0.0.1:9202"
 				}
 			case "ops":
+			case "tunnel":
+				foundTunnel = true
 			default:
 				c.UI.Error(fmt.Sprintf("Unknown listener purpose %q", lnConfig.Purpose[0]))
 				return base.CommandUserError
@@ -319,6 +335,10 @@ func (c *Command) Run(args []string) int {
 			return base.CommandUserError
 		}
 	}
+	if foundTunnel && c.Config.Controller == nil {
+		c.UI.Error(`Listener with "tunnel" purpose found but config does not activate controller`)
+		return base.CommandUserError
+	}
 	if c.Config.Controller != nil {
 		if !foundApi {
 			c.UI.Error(`Config activates controller but no listener with "api" purpose found`)
@@ -399,7 +419,7 @@ func (c *Command) Run(args []string) int {
 			}
 		}
 	}
-	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy", "ops"}); err != nil {
+	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy", "ops", "tunnel"}); err != nil {
 		c.UI.Error(err.Error())
 		return base.CommandUserError
 	}
@@ -492,7 +512,7 @@ func (c *Command) Run(args []string) int {
 		}
 	}
 
-	c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginAws, base.EnabledPluginHostAzure, base.EnabledPluginGCP)
+	c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginAws, base.EnabledPluginHostAzure, base.EnabledPluginGCP, base.EnabledPluginHostDns, base.EnabledPluginHostKubernetes, base.EnabledPluginHostConsul)
 	if base.MinioEnabled {
 		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginMinio)
 	}
@@ -552,6 +572,7 @@ func (c *Command) Run(args []string) int {
 		return base.CommandCliError
 	}
 	c.opsServer = opsServer
+	c.opsServer.SetProfilingEnabled(c.Config.EnableProfiling)
 	c.opsServer.Start()
 
 	// Inform any tests that the server is ready
@@ -806,6 +827,9 @@ func (c *Command) WaitForInterrupt() int {
 			}
 
 			c.WorkerAuthDebuggingEnabled.Store(newConf.EnableWorkerAuthDebugging)
+			if c.opsServer != nil {
+				c.opsServer.SetProfilingEnabled(newConf.EnableProfiling)
+			}
 
 		RUNRELOADFUNCS:
 			if err := c.Reload(newConf); err != nil {
@@ -816,6 +840,7 @@ func (c *Command) WaitForInterrupt() int {
 			buf := make([]byte, 32*1024*1024)
 			n := runtime.Stack(buf[:], true)
 			event.WriteSysEvent(context.TODO(), op, "goroutine trace", "stack", string(buf[:n]))
+			c.opsServer.EnableProfilingOnSignal(context.TODO(), c.Config.ProfilingSignalWindowDuration)
 
 		case <-time.After(10 * time.Millisecond):
 		}
@@ -966,7 +991,10 @@ func (c *Command) reloadControllerRateLimits(newConfig *config.Config) error {
 	if c.controller == nil || newConfig == nil || newConfig.Controller == nil {
 		return nil
 	}
-	return c.controller.ReloadRateLimiter(newConfig)
+	if err := c.controller.ReloadRateLimiter(newConfig); err != nil {
+		return err
+	}
+	return c.controller.ReloadScopeQuotas(newConfig)
 }
 
 func (c *Command) reloadControllerTimings(newConfig *config.Config) error {
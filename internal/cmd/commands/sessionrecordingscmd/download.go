@@ -24,11 +24,17 @@ var (
 )
 
 const (
-	castExt = ".cast" // default download file extension (is overridden when an output file is specified)
+	castExt       = ".cast" // default download file extension (is overridden when an output file is specified)
+	transcriptExt = ".txt"  // default transcript download file extension (is overridden when an output file is specified)
+
+	renditionAsciicast  = "asciicast"
+	renditionTranscript = "transcript"
 )
 
 type DownloadCommand struct {
 	*base.Command
+
+	flagRendition string
 }
 
 func (c *DownloadCommand) Synopsis() string {
@@ -43,6 +49,10 @@ func (c *DownloadCommand) Help() string {
 		"",
 		`    $ boundary session-recordings download -id chr_u6e9wJ8B8H`,
 		"",
+		"  Download a plain text transcript of the channel recording instead. Example:",
+		"",
+		`    $ boundary session-recordings download -id chr_u6e9wJ8B8H -rendition transcript`,
+		"",
 		"",
 	}) + c.Flags().Help()
 }
@@ -59,9 +69,16 @@ func (c *DownloadCommand) Flags() *base.FlagSets {
 	f.StringVar(&base.StringVar{
 		Name:    "output",
 		Target:  &c.FlagOutputFile,
-		Usage:   "An optional output file for the download. If not provided the recording id will be used with a \".cast\" extension. Use \"-\" for stdout.",
+		Usage:   "An optional output file for the download. If not provided the recording id will be used with a \".cast\" extension, or a \".txt\" extension for transcripts. Use \"-\" for stdout.",
 		Aliases: []string{"o"},
 	})
+	f.StringVar(&base.StringVar{
+		Name:       "rendition",
+		Target:     &c.flagRendition,
+		Default:    renditionAsciicast,
+		Usage:      "The rendition of the recording to download. Either \"asciicast\" or \"transcript\", a plain text transcript of the terminal output with a timestamp on every line.",
+		Completion: complete.PredictSet(renditionAsciicast, renditionTranscript),
+	})
 	f.BoolVar(&base.BoolVar{
 		Name:    "no-clobber",
 		Target:  &c.FlagNoClobber,
@@ -91,6 +108,9 @@ func (c *DownloadCommand) Run(args []string) int {
 	case c.FlagId == "":
 		c.PrintCliError(errors.New("ID must be provided via -id"))
 		return base.CommandUserError
+	case c.flagRendition != renditionAsciicast && c.flagRendition != renditionTranscript:
+		c.PrintCliError(fmt.Errorf("Unknown rendition %q, must be %q or %q", c.flagRendition, renditionAsciicast, renditionTranscript))
+		return base.CommandUserError
 	}
 
 	client, err := c.Client()
@@ -107,7 +127,11 @@ func (c *DownloadCommand) Run(args []string) int {
 	}
 
 	sClient := sessionrecordings.NewClient(client)
-	result, err := sClient.Download(c.Context, c.FlagId)
+	download, ext := sClient.Download, castExt
+	if c.flagRendition == renditionTranscript {
+		download, ext = sClient.DownloadTranscript, transcriptExt
+	}
+	result, err := download(c.Context, c.FlagId)
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
 			c.PrintApiError(apiErr, "Error from controller when downloading session recording")
@@ -146,7 +170,7 @@ func (c *DownloadCommand) Run(args []string) int {
 		}
 		defer outFile.Close()
 	default:
-		fileName := getNextFileName(c.FlagId)
+		fileName := getNextFileName(c.FlagId, ext)
 		outFile, err = os.Create(fileName)
 		if err != nil {
 			c.PrintCliError(fmt.Errorf("Unable to create download file %q: %w", fileName, err))
@@ -162,13 +186,13 @@ func (c *DownloadCommand) Run(args []string) int {
 	return base.CommandSuccess
 }
 
-func getNextFileName(baseName string) string {
-	if _, err := os.Stat(baseName + castExt); os.IsNotExist(err) {
-		return baseName + castExt
+func getNextFileName(baseName, ext string) string {
+	if _, err := os.Stat(baseName + ext); os.IsNotExist(err) {
+		return baseName + ext
 	}
 	startIndex := 1
 	for {
-		fileName := baseName + castExt + "." + strconv.Itoa(startIndex)
+		fileName := baseName + ext + "." + strconv.Itoa(startIndex)
 		if _, err := os.Stat(fileName); os.IsNotExist(err) {
 			return fileName
 		}
@@ -38,6 +38,14 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary session-recordings download -id chr_1234567890`,
 			"",
+			"    Play a channel recording in the terminal:",
+			"",
+			`      $ boundary session-recordings play -id chr_1234567890`,
+			"",
+			"    Repair a session recording left incomplete by a worker that stopped:",
+			"",
+			`      $ boundary session-recordings repair -id sr_1234567890`,
+			"",
 
 			"  Please see the sessions subcommand help for detailed usage information.",
 		})
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sessionrecordingscmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+	"golang.org/x/term"
+)
+
+var (
+	_ cli.Command             = (*PlayCommand)(nil)
+	_ cli.CommandAutocomplete = (*PlayCommand)(nil)
+)
+
+type PlayCommand struct {
+	*base.Command
+
+	flagSpeed         float64
+	flagIdleTimeLimit time.Duration
+	flagStart         time.Duration
+}
+
+func (c *PlayCommand) Synopsis() string {
+	return wordwrap.WrapString("Play a channel recording in the terminal", base.TermWidth)
+}
+
+func (c *PlayCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary session-recordings play [args]",
+		"",
+		"  Download a channel recording and replay it in the terminal. Example:",
+		"",
+		`    $ boundary session-recordings play -id chr_u6e9wJ8B8H`,
+		"",
+		"  While playing, the following keys control the playback:",
+		"",
+		"    space       Pause or resume",
+		"    + or up     Double the speed",
+		"    - or down   Halve the speed",
+		"    right       Seek 5 seconds forward",
+		"    left        Seek 5 seconds backward",
+		"    q or esc    Stop playing",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *PlayCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the channel recording to play.",
+	})
+	f.Float64Var(&base.Float64Var{
+		Name:       "speed",
+		Target:     &c.flagSpeed,
+		Usage:      fmt.Sprintf("The initial playback speed, from %v to %v. Defaults to 1.", minSpeed, maxSpeed),
+		Completion: complete.PredictNothing,
+	})
+	f.DurationVar(&base.DurationVar{
+		Name:       "idle-time-limit",
+		Target:     &c.flagIdleTimeLimit,
+		Usage:      "If set, pauses in the recording longer than this duration are shortened to it.",
+		Completion: complete.PredictNothing,
+	})
+	f.DurationVar(&base.DurationVar{
+		Name:       "start",
+		Target:     &c.flagStart,
+		Usage:      "If set, playback starts this far into the recording.",
+		Completion: complete.PredictNothing,
+	})
+	return set
+}
+
+func (c *PlayCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *PlayCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *PlayCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.flagSpeed == 0 {
+		c.flagSpeed = 1
+	}
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagSpeed < minSpeed || c.flagSpeed > maxSpeed:
+		c.PrintCliError(fmt.Errorf("Speed must be between %v and %v", minSpeed, maxSpeed))
+		return base.CommandUserError
+	case c.flagIdleTimeLimit < 0:
+		c.PrintCliError(errors.New("Idle time limit must not be negative"))
+		return base.CommandUserError
+	case c.flagStart < 0:
+		c.PrintCliError(errors.New("Start must not be negative"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := sessionrecordings.NewClient(client).Download(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when downloading session recording")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Download error: %w", err))
+		return base.CommandCliError
+	}
+	recording, err := readCast(result)
+	result.Close()
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error reading recording: %w", err))
+		return base.CommandCliError
+	}
+
+	if w, h, err := term.GetSize(int(os.Stdout.Fd())); err == nil &&
+		(uint32(w) < recording.header.Width || uint32(h) < recording.header.Height) {
+		c.UI.Warn(fmt.Sprintf("The recording is %dx%d, larger than the terminal (%dx%d); it may not display correctly.",
+			recording.header.Width, recording.header.Height, w, h))
+	}
+
+	p := &player{
+		events:    recording.events,
+		out:       os.Stdout,
+		speed:     c.flagSpeed,
+		idleLimit: c.flagIdleTimeLimit,
+	}
+	if err := p.seek(c.flagStart); err != nil {
+		c.PrintCliError(fmt.Errorf("Error writing recording: %w", err))
+		return base.CommandCliError
+	}
+
+	ctx, cancel := context.WithCancel(c.Context)
+	defer cancel()
+	var controls <-chan control
+	if fd := int(os.Stdin.Fd()); term.IsTerminal(fd) {
+		// Read keys as they are pressed, without echoing them
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			c.PrintCliError(fmt.Errorf("Error setting up the terminal: %w", err))
+			return base.CommandCliError
+		}
+		defer term.Restore(fd, state)
+		controls = readControls(ctx, os.Stdin)
+	}
+
+	err = p.play(ctx, controls)
+	// Reset text attributes the recording may have left set
+	fmt.Fprint(os.Stdout, "\x1b[0m\r\n")
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error writing recording: %w", err))
+		return base.CommandCliError
+	}
+	return base.CommandSuccess
+}
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sessionrecordingscmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+const (
+	minSpeed = 0.25
+	maxSpeed = 16
+
+	// seekStep is how far the left and right keys seek into the recording.
+	seekStep = 5 * time.Second
+
+	// resetTerminal resets the terminal to its initial state, clearing the
+	// screen, before the recording is replayed from the start when seeking
+	// backwards.
+	resetTerminal = "\x1bc"
+)
+
+// control is a playback control sent to the player.
+type control int
+
+const (
+	controlPause control = iota + 1
+	controlFaster
+	controlSlower
+	controlForward
+	controlBackward
+	controlQuit
+)
+
+// castEvent is an output event of an asciicast recording.
+type castEvent struct {
+	// time is the offset of the event from the start of the recording.
+	time time.Duration
+	data string
+}
+
+// castHeader is the first line of an asciicast v2 recording.
+type castHeader struct {
+	Version uint32 `json:"version"`
+	Width   uint32 `json:"width"`
+	Height  uint32 `json:"height"`
+}
+
+// cast is a parsed asciicast v2 recording. Only output events are kept, as
+// they are all that is needed to replay the recording.
+type cast struct {
+	header castHeader
+	events []castEvent
+}
+
+// readCast parses an asciicast v2 recording.
+func readCast(r io.Reader) (*cast, error) {
+	s := bufio.NewScanner(r)
+	// Output events can be large, so allow for long lines
+	s.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	if !s.Scan() {
+		if err := s.Err(); err != nil {
+			return nil, fmt.Errorf("error reading recording header: %w", err)
+		}
+		return nil, errors.New("recording is empty")
+	}
+	c := &cast{}
+	if err := json.Unmarshal(s.Bytes(), &c.header); err != nil {
+		return nil, fmt.Errorf("error decoding recording header: %w", err)
+	}
+	if c.header.Version != 2 {
+		return nil, fmt.Errorf("unsupported asciicast version %d", c.header.Version)
+	}
+
+	for line := 2; s.Scan(); line++ {
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+		var ev []any
+		if err := json.Unmarshal(s.Bytes(), &ev); err != nil {
+			return nil, fmt.Errorf("error decoding recording event on line %d: %w", line, err)
+		}
+		if len(ev) != 3 {
+			return nil, fmt.Errorf("invalid recording event on line %d", line)
+		}
+		ts, ok := ev[0].(float64)
+		if !ok {
+			return nil, fmt.Errorf("invalid recording event time on line %d", line)
+		}
+		if typ, _ := ev[1].(string); typ != "o" {
+			continue
+		}
+		data, ok := ev[2].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid recording event data on line %d", line)
+		}
+		c.events = append(c.events, castEvent{
+			time: time.Duration(ts * float64(time.Second)),
+			data: data,
+		})
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("error reading recording: %w", err)
+	}
+	return c, nil
+}
+
+// player replays the output events of a recording to a terminal, following
+// the timing of the recording.
+type player struct {
+	events []castEvent
+	out    io.Writer
+	speed  float64
+	// idleLimit caps the pauses between events, if set.
+	idleLimit time.Duration
+
+	// next is the index of the next event to write, and clock the time
+	// reached in the recording.
+	next  int
+	clock time.Duration
+}
+
+// seek moves the playback to the given time in the recording. The events up
+// to that time are written at once; when seeking backwards the terminal is
+// reset and the recording replayed from the start.
+func (p *player) seek(to time.Duration) error {
+	to = min(max(to, 0), p.duration())
+	if to < p.clock {
+		if _, err := io.WriteString(p.out, resetTerminal); err != nil {
+			return err
+		}
+		p.next = 0
+	}
+	end := sort.Search(len(p.events), func(i int) bool { return p.events[i].time > to })
+	for ; p.next < end; p.next++ {
+		if _, err := io.WriteString(p.out, p.events[p.next].data); err != nil {
+			return err
+		}
+	}
+	p.clock = to
+	return nil
+}
+
+// duration returns the time of the last event of the recording.
+func (p *player) duration() time.Duration {
+	if len(p.events) == 0 {
+		return 0
+	}
+	return p.events[len(p.events)-1].time
+}
+
+// wait returns how long to wait, in real time, before writing the next event.
+func (p *player) wait() time.Duration {
+	d := p.events[p.next].time - p.clock
+	if p.idleLimit > 0 {
+		d = min(d, p.idleLimit)
+	}
+	return time.Duration(float64(d) / p.speed)
+}
+
+// play replays the recording until its end, the context is done or a
+// controlQuit is received on controls.
+func (p *player) play(ctx context.Context, controls <-chan control) error {
+	paused := false
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	// reset restarts the timer for the next event after the playback changed.
+	reset := func() {
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		if !paused && p.next < len(p.events) {
+			timer.Reset(p.wait())
+		}
+	}
+	reset()
+
+	for p.next < len(p.events) {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			ev := p.events[p.next]
+			if _, err := io.WriteString(p.out, ev.data); err != nil {
+				return err
+			}
+			p.next++
+			p.clock = ev.time
+			reset()
+		case ctl := <-controls:
+			switch ctl {
+			case controlQuit:
+				return nil
+			case controlPause:
+				paused = !paused
+			case controlFaster:
+				p.speed = min(p.speed*2, maxSpeed)
+			case controlSlower:
+				p.speed = max(p.speed/2, minSpeed)
+			case controlForward:
+				if err := p.seek(p.clock + seekStep); err != nil {
+					return err
+				}
+			case controlBackward:
+				if err := p.seek(p.clock - seekStep); err != nil {
+					return err
+				}
+			}
+			reset()
+		}
+	}
+	return nil
+}
+
+// readControls reads playback controls from the keys pressed on the given
+// terminal input and sends them on the returned channel, until the input is
+// closed or the context is done.
+func readControls(ctx context.Context, in io.Reader) <-chan control {
+	controls := make(chan control)
+	go func() {
+		buf := make([]byte, 16)
+		for {
+			n, err := in.Read(buf)
+			if err != nil {
+				return
+			}
+			var ctl control
+			switch key := string(buf[:n]); key {
+			case " ", "p":
+				ctl = controlPause
+			case "+", "=", "\x1b[A":
+				ctl = controlFaster
+			case "-", "_", "\x1b[B":
+				ctl = controlSlower
+			case "\x1b[C", "l":
+				ctl = controlForward
+			case "\x1b[D", "h":
+				ctl = controlBackward
+			case "q", "\x03", "\x1b":
+				ctl = controlQuit
+			default:
+				continue
+			}
+			select {
+			case controls <- ctl:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return controls
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sessionrecordingscmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCast = `{"version":2,"width":80,"height":24,"timestamp":1700000000,"env":{"SHELL":"/bin/bash","TERM":"xterm"}}
+[0.5,"o","one "]
+[1.0,"i","ignored"]
+[2.0,"o","two "]
+
+[10.25,"o","three"]
+`
+
+func TestReadCast(t *testing.T) {
+	c, err := readCast(strings.NewReader(testCast))
+	require.NoError(t, err)
+	assert.Equal(t, castHeader{Version: 2, Width: 80, Height: 24}, c.header)
+	assert.Equal(t, []castEvent{
+		{time: 500 * time.Millisecond, data: "one "},
+		{time: 2 * time.Second, data: "two "},
+		{time: 10250 * time.Millisecond, data: "three"},
+	}, c.events)
+
+	for name, in := range map[string]string{
+		"empty":         "",
+		"bad-header":    "not json\n",
+		"version":       `{"version":1}` + "\n",
+		"bad-event":     `{"version":2}` + "\n" + `[0.5,"o"]` + "\n",
+		"bad-data-type": `{"version":2}` + "\n" + `[0.5,"o",1]` + "\n",
+	} {
+		_, err := readCast(strings.NewReader(in))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestPlayerSeek(t *testing.T) {
+	c, err := readCast(strings.NewReader(testCast))
+	require.NoError(t, err)
+	var out strings.Builder
+	p := &player{events: c.events, out: &out, speed: 1}
+
+	require.NoError(t, p.seek(3*time.Second))
+	assert.Equal(t, "one two ", out.String())
+	assert.Equal(t, 2, p.next)
+
+	out.Reset()
+	require.NoError(t, p.seek(time.Second))
+	assert.Equal(t, resetTerminal+"one ", out.String())
+	assert.Equal(t, 1, p.next)
+	assert.Equal(t, time.Second, p.clock)
+
+	out.Reset()
+	require.NoError(t, p.seek(time.Hour))
+	assert.Equal(t, "two three", out.String())
+	assert.Equal(t, p.duration(), p.clock)
+}
+
+func TestPlayerPlay(t *testing.T) {
+	c, err := readCast(strings.NewReader(testCast))
+	require.NoError(t, err)
+
+	t.Run("idle-limit", func(t *testing.T) {
+		var out strings.Builder
+		p := &player{events: c.events, out: &out, speed: maxSpeed, idleLimit: time.Millisecond}
+		start := time.Now()
+		require.NoError(t, p.play(context.Background(), nil))
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Equal(t, "one two three", out.String())
+	})
+
+	t.Run("controls", func(t *testing.T) {
+		var out strings.Builder
+		p := &player{events: c.events, out: &out, speed: 1}
+		controls := make(chan control)
+		done := make(chan error)
+		go func() { done <- p.play(context.Background(), controls) }()
+
+		controls <- controlPause
+		controls <- controlFaster
+		controls <- controlForward
+		controls <- controlSlower
+		controls <- controlSlower
+		controls <- controlQuit
+		require.NoError(t, <-done)
+		assert.Equal(t, "one two ", out.String())
+		assert.Equal(t, 0.5, p.speed)
+	})
+
+	t.Run("readControls", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		keys := []string{" ", "+", "\x1b[B", "x", "\x1b[C", "\x1b[D", "q"}
+		controls := readControls(ctx, &keyReader{keys: keys})
+		var got []control
+		for range keys[:len(keys)-1] {
+			got = append(got, <-controls)
+		}
+		assert.Equal(t, []control{controlPause, controlFaster, controlSlower, controlForward, controlBackward, controlQuit}, got)
+	})
+}
+
+// keyReader returns one key on each Read, as a terminal in raw mode does.
+type keyReader struct {
+	keys []string
+}
+
+func (r *keyReader) Read(b []byte) (int, error) {
+	if len(r.keys) == 0 {
+		select {}
+	}
+	n := copy(b, r.keys[0])
+	r.keys = r.keys[1:]
+	return n, nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sessionrecordingscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*RepairCommand)(nil)
+	_ cli.CommandAutocomplete = (*RepairCommand)(nil)
+)
+
+type RepairCommand struct {
+	*base.Command
+}
+
+func (c *RepairCommand) Synopsis() string {
+	return wordwrap.WrapString("Repair a session recording left incomplete by a worker that stopped", base.TermWidth)
+}
+
+func (c *RepairCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary session-recordings repair [args]",
+		"",
+		"  Repair a session recording that was left in the started state because the worker recording it stopped. The chunks that can still be read are kept, end markers are synthesized for any connections and channels that were not closed, and the session recording is marked as partial. Example:",
+		"",
+		`    $ boundary session-recordings repair -id sr_0123456789`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RepairCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the session recording resource to repair.",
+	})
+	return set
+}
+
+func (c *RepairCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RepairCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RepairCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	sClient := sessionrecordings.NewClient(client)
+	result, err := sClient.Repair(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when repairing session recording")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to repair session recording: %w", err))
+		return base.CommandCliError
+	}
+	resp := result.GetResponse()
+	item := result.GetItem()
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printItemTable(item, resp))
+	case "json":
+		if ok := c.PrintJsonItem(resp); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
@@ -17,24 +17,44 @@ import (
 
 const (
 	flagIncludeTerminated = "include-terminated"
+	flagUserId            = "user-id"
+	flagTargetId          = "target-id"
+	flagOlderThan         = "older-than"
 )
 
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"cancel": {"id"},
-		"list":   {flagIncludeTerminated},
+		"cancel":      {"id"},
+		"cancel-many": {"scope-id", "recursive", "filter", flagUserId, flagTargetId, flagOlderThan},
+		"list":        {flagIncludeTerminated},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "cancel-many":
+		return "Cancel all sessions matching a filter"
+
+	default:
+		return ""
 	}
 }
 
 type extraCmdVars struct {
 	flagIncludeTerminated bool
+	flagUserId            string
+	flagTargetId          string
+	flagOlderThan         time.Duration
+	cmr                   *sessions.CancelManyResult
 }
 
 func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
@@ -46,6 +66,30 @@ func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIncludeTerminated,
 				Usage:  "If set, terminated sessions will be included in the results.",
 			})
+		case flagUserId:
+			f.StringVar(&base.StringVar{
+				Name:   flagUserId,
+				Target: &c.flagUserId,
+				Usage:  "If set, only sessions requested by this user will be canceled.",
+			})
+		case flagTargetId:
+			f.StringVar(&base.StringVar{
+				Name:   flagTargetId,
+				Target: &c.flagTargetId,
+				Usage:  "If set, only sessions to this target will be canceled.",
+			})
+		case flagOlderThan:
+			f.DurationVar(&base.DurationVar{
+				Name:   flagOlderThan,
+				Target: &c.flagOlderThan,
+				Usage:  "If set, only sessions created at least this long ago will be canceled.",
+			})
+		case "filter":
+			f.StringVar(&base.StringVar{
+				Name:   "filter",
+				Target: &c.FlagFilter,
+				Usage:  "If set, only sessions matching the filter will be canceled. The filter operates against each session in the same way as when listing. Using single quotes is recommended as filters contain double quotes. See https://www.boundaryproject.io/docs/concepts/filtering/resource-listing for details.",
+			})
 		}
 	}
 }
@@ -84,6 +128,23 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "cancel-many":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions cancel-many [options] [args]",
+			"",
+			"  Cancel every session in the given scope matching all of the provided criteria, and print the outcome for each of them. Sessions which could not be canceled are reported along with the reason. Examples:",
+			"",
+			"    Cancel all sessions of a user across every project:",
+			"",
+			`      $ boundary sessions cancel-many -scope-id global -recursive -user-id u_1234567890`,
+			"",
+			"    Cancel the sessions to a target that are more than an hour old:",
+			"",
+			`      $ boundary sessions cancel-many -scope-id p_1234567890 -target-id ttcp_1234567890 -older-than 1h`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -99,10 +160,77 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *sessi
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "cancel-many":
+		var err error
+		c.cmr, err = sessionClient.CancelMany(c.Context, sessions.CancelFilter{
+			ScopeId:   c.FlagScopeId,
+			Recursive: c.FlagRecursive,
+			UserId:    c.flagUserId,
+			TargetId:  c.flagTargetId,
+			OlderThan: c.flagOlderThan,
+			Filter:    c.FlagFilter,
+		}, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return c.cmr.GetResponse(), nil, nil, nil
 	}
 	return origResp, origItem, origItems, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "cancel-many":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printCancelManyTable(c.cmr.GetItems()))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItems(c.cmr.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func printCancelManyTable(items []*sessions.SessionCancelResult) string {
+	if len(items) == 0 {
+		return "No matching sessions found"
+	}
+	var canceled int
+	for _, item := range items {
+		if item.Canceled {
+			canceled++
+		}
+	}
+	output := []string{
+		"",
+		fmt.Sprintf("Canceled %d of %d matching sessions:", canceled, len(items)),
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  ID:                    %s", item.SessionId),
+			fmt.Sprintf("    Scope ID:            %s", item.ScopeId),
+			fmt.Sprintf("    User ID:             %s", item.UserId),
+			fmt.Sprintf("    Target ID:           %s", item.TargetId),
+			fmt.Sprintf("    Canceled:            %t", item.Canceled),
+		)
+		if item.Error != "" {
+			output = append(output,
+				fmt.Sprintf("    Error:               %s", item.Error),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
 func (c *Command) printListTable(items []*sessions.Session) string {
 	if len(items) == 0 {
 		return "No sessions found"
@@ -191,6 +319,9 @@ func printItemTable(item *sessions.Session, resp *api.Response) string {
 	if !item.ExpirationTime.IsZero() {
 		nonAttributeMap["Expiration Time"] = item.ExpirationTime.Local().Format(time.RFC1123)
 	}
+	if !item.AuthorizationExpirationTime.IsZero() {
+		nonAttributeMap["Authorization Expiration Time"] = item.AuthorizationExpirationTime.Local().Format(time.RFC1123)
+	}
 	if item.TargetId != "" {
 		nonAttributeMap["Target ID"] = item.TargetId
 	}
@@ -215,6 +346,9 @@ func printItemTable(item *sessions.Session, resp *api.Response) string {
 	if len(strings.TrimSpace(item.TerminationReason)) > 0 {
 		nonAttributeMap["Termination Reason"] = item.TerminationReason
 	}
+	if len(strings.TrimSpace(item.RecordingSkipReason)) > 0 {
+		nonAttributeMap["Recording Skip Reason"] = item.RecordingSkipReason
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
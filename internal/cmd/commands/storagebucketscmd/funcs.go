@@ -10,7 +10,7 @@ import (
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/storagebuckets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
-	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/boundary/internal/filter"
 )
 
 func init() {
@@ -90,7 +90,7 @@ func extraFlagHandlingFuncImpl(c *Command, f *base.FlagSets, opts *[]storagebuck
 	case "null":
 		*opts = append(*opts, storagebuckets.DefaultWorkerFilter())
 	default:
-		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+		if _, err := filter.CreateEvaluator(c.flagWorkerFilter); err != nil {
 			c.UI.Error(fmt.Sprintf("Unable to successfully parse filter expression: %s", err))
 			return false
 		}
@@ -279,6 +279,30 @@ func printItemTable(item *storagebuckets.StorageBucket, resp *api.Response) stri
 		)
 	}
 
+	if item.Usage != nil {
+		ret = append(ret,
+			"",
+			"  Usage:",
+			base.WrapMap(4, maxLength, map[string]any{
+				"Bytes":        item.Usage.Bytes,
+				"Object Count": item.Usage.ObjectCount,
+			}),
+		)
+	}
+
+	if item.Quota != nil {
+		ret = append(ret,
+			"",
+			"  Quota:",
+			base.WrapMap(4, maxLength, map[string]any{
+				"Max Bytes":                 item.Quota.MaxBytes,
+				"Max Objects":               item.Quota.MaxObjects,
+				"Warning Threshold Percent": item.Quota.WarningThresholdPercent,
+				"Block When Exceeded":       item.Quota.BlockWhenExceeded,
+			}),
+		)
+	}
+
 	if len(item.Attributes) > 0 {
 		ret = append(ret,
 			"",
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targetscmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*EmitSshConfigCommand)(nil)
+	_ cli.CommandAutocomplete = (*EmitSshConfigCommand)(nil)
+)
+
+// sshPort is the port of the tcp targets included in the emitted SSH config.
+const sshPort = 22
+
+type EmitSshConfigCommand struct {
+	*base.Command
+}
+
+func (c *EmitSshConfigCommand) Synopsis() string {
+	return wordwrap.WrapString("Emit an SSH config file for the SSH targets you are authorized to connect to", base.TermWidth)
+}
+
+func (c *EmitSshConfigCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary targets emit-ssh-config [options]",
+		"",
+		"  Emit an ssh_config file with a Host entry for each SSH target, and each TCP target on port 22, you are authorized to connect to. Each entry is named after the target's aliases and ID, and connects through Boundary using \"boundary connect stdio\" as its ProxyCommand. Example:",
+		"",
+		`    $ boundary targets emit-ssh-config -output ~/.ssh/boundary_config`,
+		"",
+		"  Then include the file at the top of ~/.ssh/config:",
+		"",
+		`    Include ~/.ssh/boundary_config`,
+		"",
+		"  and connect to a target with your normal SSH tooling:",
+		"",
+		`    $ ssh my-target-alias`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *EmitSshConfigCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "scope-id",
+		Target:     &c.FlagScopeId,
+		EnvVar:     "BOUNDARY_SCOPE_ID",
+		Default:    scope.Global.String(),
+		Completion: complete.PredictAnything,
+		Usage:      `Scope in which, and in whose child scopes, to look for targets.`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:    "output",
+		Target:  &c.FlagOutputFile,
+		Usage:   `An optional file to write the SSH config to. If not provided, or "-", it is written to stdout.`,
+		Aliases: []string{"o"},
+	})
+	return set
+}
+
+func (c *EmitSshConfigCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *EmitSshConfigCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *EmitSshConfigCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	tResult, err := targets.NewClient(client).List(c.Context, c.FlagScopeId, targets.WithRecursive(true))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing targets")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error listing targets: %w", err))
+		return base.CommandCliError
+	}
+	// Aliases only exist in the global scope. Not being allowed to list them
+	// is not an error, the targets are then only named by their ID.
+	var targetAliases []*aliases.Alias
+	aResult, err := aliases.NewClient(client).List(c.Context, scope.Global.String())
+	switch {
+	case err == nil:
+		targetAliases = aResult.GetItems()
+	case api.AsServerError(err) != nil:
+		c.UI.Warn(fmt.Sprintf("Unable to list aliases, targets will only be named by their ID: %s", api.AsServerError(err).Message))
+	default:
+		c.PrintCliError(fmt.Errorf("Error listing aliases: %w", err))
+		return base.CommandCliError
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Unable to find the boundary executable: %w", err))
+		return base.CommandCliError
+	}
+	sshConfig := generateSshConfig(exe, c.proxyCommandArgs(client.Addr()), tResult.GetItems(), targetAliases)
+
+	switch c.FlagOutputFile {
+	case "", "-":
+		c.UI.Output(sshConfig)
+	default:
+		if err := os.WriteFile(c.FlagOutputFile, []byte(sshConfig+"\n"), 0o600); err != nil {
+			c.PrintCliError(fmt.Errorf("Unable to write SSH config file %q: %w", c.FlagOutputFile, err))
+			return base.CommandCliError
+		}
+	}
+	return base.CommandSuccess
+}
+
+// proxyCommandArgs returns the arguments added to each ProxyCommand so that it
+// connects to the controller the config was generated from, with the same
+// token.
+func (c *EmitSshConfigCommand) proxyCommandArgs(addr string) []string {
+	if p := c.Profile(); p != nil {
+		return []string{"-" + base.FlagNameProfile, p.Name}
+	}
+	args := []string{"-" + base.FlagNameAddr, addr}
+	if c.FlagTokenName != "" {
+		args = append(args, "-token-name", c.FlagTokenName)
+	}
+	if c.FlagKeyringType != "" && c.FlagKeyringType != base.AutoKeyring {
+		args = append(args, "-keyring-type", c.FlagKeyringType)
+	}
+	return args
+}
+
+// generateSshConfig returns an ssh_config file with a Host entry for each of
+// the SSH targets that the caller is authorized to connect to, named after the
+// target's aliases and ID.
+func generateSshConfig(exe string, args []string, tgts []*targets.Target, targetAliases []*aliases.Alias) string {
+	aliasesByTarget := map[string][]string{}
+	for _, a := range targetAliases {
+		if a.DestinationId == "" || !validSshHostPattern(a.Value) {
+			continue
+		}
+		aliasesByTarget[a.DestinationId] = append(aliasesByTarget[a.DestinationId], a.Value)
+	}
+
+	var sshTargets []*targets.Target
+	for _, t := range tgts {
+		if isSshTarget(t) && slices.Contains(t.AuthorizedActions, "authorize-session") {
+			sshTargets = append(sshTargets, t)
+		}
+	}
+	slices.SortFunc(sshTargets, func(a, b *targets.Target) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+
+	var b strings.Builder
+	b.WriteString("# Generated by \"boundary targets emit-ssh-config\". Changes will be overwritten.\n")
+	for _, t := range sshTargets {
+		hosts := aliasesByTarget[t.Id]
+		slices.Sort(hosts)
+		hosts = append(hosts, t.Id)
+
+		proxyCommand := []string{sshConfigQuote(exe), "connect", "stdio", "-target-id", t.Id}
+		for _, a := range args {
+			proxyCommand = append(proxyCommand, sshConfigQuote(a))
+		}
+
+		b.WriteString("\n")
+		if t.Name != "" {
+			fmt.Fprintf(&b, "# %s in scope %s\n", strings.ReplaceAll(t.Name, "\n", " "), t.ScopeId)
+		}
+		fmt.Fprintf(&b, "Host %s\n", strings.Join(hosts, " "))
+		// Known host keys are recorded against the target, whichever of its
+		// names is used to connect.
+		fmt.Fprintf(&b, "  HostKeyAlias %s\n", t.Id)
+		fmt.Fprintf(&b, "  ProxyCommand %s\n", strings.Join(proxyCommand, " "))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// isSshTarget reports whether connections to the target are SSH connections:
+// it's either an SSH target, or a TCP target on port 22.
+func isSshTarget(t *targets.Target) bool {
+	switch t.Type {
+	case "ssh":
+		return true
+	case "tcp":
+		attrs, err := t.GetTcpTargetAttributes()
+		return err == nil && attrs.DefaultPort == sshPort
+	default:
+		return false
+	}
+}
+
+// validSshHostPattern reports whether s can be used as is in a Host line,
+// without being interpreted as a pattern or split into several.
+func validSshHostPattern(s string) bool {
+	return s != "" && !strings.ContainsAny(s, " \t\"'*?!,%#")
+}
+
+// sshConfigQuote quotes s for use in a ProxyCommand, which ssh runs through a
+// shell after expanding % tokens.
+func sshConfigQuote(s string) string {
+	s = strings.ReplaceAll(s, "%", "%%")
+	if s != "" && !strings.ContainsAny(s, " \t\"'\\$`;&|<>()*?[]{}~#!") {
+		return s
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "`", "\\`").Replace(s) + `"`
+}
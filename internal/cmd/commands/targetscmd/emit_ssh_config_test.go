@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targetscmd
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/api/aliases"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateSshConfig(t *testing.T) {
+	authorized := []string{"read", "authorize-session"}
+	tgts := []*targets.Target{
+		{Id: "tssh_2", ScopeId: "p_1", Name: "db bastion", Type: "ssh", AuthorizedActions: authorized},
+		{Id: "ttcp_1", ScopeId: "p_1", Type: "tcp", Attributes: map[string]any{"default_port": 22}, AuthorizedActions: authorized},
+		{Id: "ttcp_web", ScopeId: "p_1", Type: "tcp", Attributes: map[string]any{"default_port": 443}, AuthorizedActions: authorized},
+		{Id: "tssh_noauth", ScopeId: "p_1", Type: "ssh", AuthorizedActions: []string{"read"}},
+	}
+	targetAliases := []*aliases.Alias{
+		{Value: "db.prod", DestinationId: "tssh_2"},
+		{Value: "bastion", DestinationId: "tssh_2"},
+		{Value: "web*", DestinationId: "ttcp_1"},
+		{Value: "unbound"},
+	}
+
+	got := generateSshConfig("/opt/my tools/boundary", []string{"-addr", "https://boundary.example.com:9200", "-token-name", "100%"}, tgts, targetAliases)
+	assert.Equal(t, `# Generated by "boundary targets emit-ssh-config". Changes will be overwritten.
+
+# db bastion in scope p_1
+Host bastion db.prod tssh_2
+  HostKeyAlias tssh_2
+  ProxyCommand "/opt/my tools/boundary" connect stdio -target-id tssh_2 -addr https://boundary.example.com:9200 -token-name 100%%
+
+Host ttcp_1
+  HostKeyAlias ttcp_1
+  ProxyCommand "/opt/my tools/boundary" connect stdio -target-id ttcp_1 -addr https://boundary.example.com:9200 -token-name 100%%`, got)
+}
+
+func TestSshConfigQuote(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "boundary", want: "boundary"},
+		{in: "", want: `""`},
+		{in: "C:/Program Files/boundary.exe", want: `"C:/Program Files/boundary.exe"`},
+		{in: `a"b$c`, want: `"a\"b\$c"`},
+		{in: "50%", want: "50%%"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, sshConfigQuote(tt.in), tt.in)
+	}
+}
@@ -40,7 +40,13 @@ type extraCmdVars struct {
 	flagBrokeredCredentialSources            []string
 	flagInjectedApplicationCredentialSources []string
 	flagHostId                               string
+	flagCheck                                string
+	flagTemplateId                           string
+	flagParameters                           map[string]string
+	flagProtect                              bool
 	sar                                      *targets.SessionAuthorizationResult
+	tcr                                      *targets.TargetConnectionTestReadResult
+	rpr                                      *targets.EffectiveRecordingPolicyReadResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
@@ -52,6 +58,10 @@ func extraActionsFlagsMapFuncImpl() map[string][]string {
 		"add-credential-sources":    {"id", "brokered-credential-source", "injected-application-credential-source", "version"},
 		"remove-credential-sources": {"id", "brokered-credential-source", "injected-application-credential-source", "version"},
 		"set-credential-sources":    {"id", "brokered-credential-source", "injected-application-credential-source", "version"},
+		"test-connection":           {"id", "host-id", "check"},
+		"read-recording-policy":     {"id"},
+		"create-from-template":      {"template-id", "name", "parameter"},
+		"set-deletion-protection":   {"id", "protect"},
 	}
 }
 
@@ -84,6 +94,18 @@ func extraSynopsisFuncImpl(c *Command) string {
 	case "authorize-session":
 		return "Request session authorization against the target"
 
+	case "test-connection":
+		return "Test the connectivity from a worker to the target"
+
+	case "read-recording-policy":
+		return "Read the effective recording policy of the target"
+
+	case "create-from-template":
+		return "Create a target from a target template"
+
+	case "set-deletion-protection":
+		return "Protect a target from deletion, or remove its protection"
+
 	default:
 		return ""
 	}
@@ -216,6 +238,62 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "test-connection":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets test-connection [options] [args]",
+			"",
+			"  This command asks a worker that could handle sessions to the target to connect to it, and reports whether it succeeded and how long it took. Example:",
+			"",
+			"    Test that a worker can reach a target:",
+			"",
+			`      $ boundary targets test-connection -id ttcp_1234567890`,
+			"",
+			"    Test that a specific host of a target is running an SSH server:",
+			"",
+			`      $ boundary targets test-connection -id ttcp_1234567890 -host-id hst_1234567890 -check ssh`,
+			"",
+			"",
+		})
+	case "read-recording-policy":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets read-recording-policy [options] [args]",
+			"",
+			"  This command reads the recording policy that applies to the sessions of the target, once the settings of the target and of the storage policies that apply to it have been combined. Example:",
+			"",
+			"    Read the effective recording policy of a target:",
+			"",
+			`      $ boundary targets read-recording-policy -id tssh_1234567890`,
+			"",
+			"",
+		})
+	case "create-from-template":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets create-from-template [options] [args]",
+			"",
+			"  This command creates a target from a target template, in the project of the template. The parameters are substituted into the definition of the template, which must reference only parameters that are given. Example:",
+			"",
+			"    Create a target from a target template:",
+			"",
+			`      $ boundary targets create-from-template -template-id ttpl_1234567890 -name billing-db -parameter service=billing -parameter port=5432`,
+			"",
+			"",
+		})
+	case "set-deletion-protection":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets set-deletion-protection [options] [args]",
+			"",
+			"  This command protects a target from deletion, or removes its protection. While the target is protected, deleting it or any scope containing it fails. Example:",
+			"",
+			"    Protect a target from deletion:",
+			"",
+			`      $ boundary targets set-deletion-protection -id ttcp_1234567890`,
+			"",
+			"    Remove the protection of a target:",
+			"",
+			`      $ boundary targets set-deletion-protection -id ttcp_1234567890 -protect=false`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
@@ -241,6 +319,32 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagHostId,
 				Usage:  "The ID of a specific host to connect to out of the hosts from the target's host sets. If not specified, one is chosen at random.",
 			})
+		case "check":
+			f.StringVar(&base.StringVar{
+				Name:       "check",
+				Target:     &c.flagCheck,
+				Completion: complete.PredictSet("tcp", "tls", "ssh"),
+				Usage:      `The check to perform once connected: "tcp" only opens the connection, "tls" also performs a TLS handshake and "ssh" also reads the SSH banner. Defaults to "tcp".`,
+			})
+		case "template-id":
+			f.StringVar(&base.StringVar{
+				Name:   "template-id",
+				Target: &c.flagTemplateId,
+				Usage:  "The ID of the target template to create the target from.",
+			})
+		case "parameter":
+			f.StringMapVar(&base.StringMapVar{
+				Name:   "parameter",
+				Target: &c.flagParameters,
+				Usage:  "A parameter to substitute into the definition of the target template, in the form key=value. May be specified multiple times.",
+			})
+		case "protect":
+			f.BoolVar(&base.BoolVar{
+				Name:    "protect",
+				Target:  &c.flagProtect,
+				Default: true,
+				Usage:   "Whether to protect the target from deletion. Use -protect=false to remove its protection. Defaults to true.",
+			})
 		case "brokered-credential-source":
 			f.StringSliceVar(&base.StringSliceVar{
 				Name:   "brokered-credential-source",
@@ -287,9 +391,9 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 }
 
 func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Option) bool {
-	// This is custom logic because of the authorized-session handling. If we
-	// support all resources to be looked up by name + scope info we can
-	// eventually graduate this out to the main template.
+	// This is custom logic because of the authorized-session handling, which
+	// looks up the target by name on the controller. The standard actions look
+	// it up by name in the generated code before getting here.
 	if strutil.StrListContains(flagsMap[c.Func], "id") {
 		switch c.Func {
 		case "authorize-session":
@@ -312,11 +416,13 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Op
 		}
 	}
 
-	if strutil.StrListContains(flagsMap[c.Func], "scope-id") && c.FlagScopeId != "" {
-		*opts = append(*opts, targets.WithScopeId(c.FlagScopeId))
-	}
-	if strutil.StrListContains(flagsMap[c.Func], "scope-name") && c.FlagScopeName != "" {
-		*opts = append(*opts, targets.WithScopeName(c.FlagScopeName))
+	if c.Func == "authorize-session" {
+		if c.FlagScopeId != "" {
+			*opts = append(*opts, targets.WithScopeId(c.FlagScopeId))
+		}
+		if c.FlagScopeName != "" {
+			*opts = append(*opts, targets.WithScopeName(c.FlagScopeName))
+		}
 	}
 
 	switch c.Func {
@@ -385,6 +491,20 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Op
 		if len(c.flagHostId) != 0 {
 			*opts = append(*opts, targets.WithHostId(c.flagHostId))
 		}
+
+	case "test-connection":
+		if len(c.flagHostId) != 0 {
+			*opts = append(*opts, targets.WithHostId(c.flagHostId))
+		}
+		if len(c.flagCheck) != 0 {
+			*opts = append(*opts, targets.WithConnectionCheck(c.flagCheck))
+		}
+
+	case "create-from-template":
+		if c.flagTemplateId == "" {
+			c.UI.Error("Template ID is required but not passed in via -template-id")
+			return false
+		}
 	}
 
 	return true
@@ -433,6 +553,26 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *targe
 		c.plural = "a session against target"
 		c.sar, err = targetClient.AuthorizeSession(c.Context, c.FlagId, opts...)
 		return nil, nil, nil, err
+	case "test-connection":
+		var err error
+		c.tcr, err = targetClient.TestConnection(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "read-recording-policy":
+		var err error
+		c.rpr, err = targetClient.ReadRecordingPolicy(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "create-from-template":
+		result, err := targetClient.CreateFromTemplate(c.Context, c.flagTemplateId, c.flagParameters, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
+	case "set-deletion-protection":
+		result, err := targetClient.SetDeletionProtection(c.Context, c.FlagId, c.flagProtect, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
@@ -535,6 +675,27 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 	if item.IngressWorkerFilter != "" {
 		nonAttributeMap["Ingress Worker Filter"] = item.IngressWorkerFilter
 	}
+	if item.Locality != "" {
+		nonAttributeMap["Locality"] = item.Locality
+	}
+	if item.AuthorizationTokenTtlSeconds != 0 {
+		nonAttributeMap["Authorization Token TTL Seconds"] = item.AuthorizationTokenTtlSeconds
+	}
+	if item.AuthorizationTokenSingleUse {
+		nonAttributeMap["Authorization Token Single Use"] = item.AuthorizationTokenSingleUse
+	}
+	if item.PreferredEndpoint != "" {
+		nonAttributeMap["Preferred Endpoint"] = item.PreferredEndpoint
+	}
+	if item.AllowedPorts != "" {
+		nonAttributeMap["Allowed Ports"] = item.AllowedPorts
+	}
+	if item.EnvironmentId != "" {
+		nonAttributeMap["Environment ID"] = item.EnvironmentId
+	}
+	if item.ProtectFromDeletion {
+		nonAttributeMap["Protect From Deletion"] = item.ProtectFromDeletion
+	}
 	if resp != nil && resp.Map != nil {
 		if resp.Map[globals.SessionConnectionLimitField] != nil {
 			nonAttributeMap["Session Connection Limit"] = item.SessionConnectionLimit
@@ -679,6 +840,39 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 		}
 	}
 
+	if len(item.Annotations) > 0 {
+		annotations := make(map[string]any, len(item.Annotations))
+		for k, v := range item.Annotations {
+			annotations[k] = v
+		}
+		ret = append(ret,
+			"  Annotations:",
+			base.WrapMap(4, maxLength, annotations),
+			"",
+		)
+	}
+
+	if p := item.RecordingParameters; p != nil {
+		params := map[string]any{
+			"Compression":    p.Compression,
+			"Sample Percent": p.SamplePercent,
+		}
+		if p.CompressionLevel != 0 {
+			params["Compression Level"] = p.CompressionLevel
+		}
+		ret = append(ret,
+			"  Recording Parameters:",
+			base.WrapMap(4, maxLength, params),
+		)
+		if len(p.RedactionRules) > 0 {
+			ret = append(ret, "    Redaction Rules:")
+			for _, r := range p.RedactionRules {
+				ret = append(ret, fmt.Sprintf("      %s", r))
+			}
+		}
+		ret = append(ret, "")
+	}
+
 	if len(item.Attributes) > 0 {
 		ret = append(ret,
 			"  Attributes:",
@@ -709,6 +903,12 @@ func printCustomActionOutputImpl(c *Command) (bool, error) {
 				"Type":                item.Type,
 				"Authorization Token": item.AuthorizationToken,
 			}
+			if item.WorkerId != "" {
+				nonAttributeMap["Worker ID"] = item.WorkerId
+			}
+			if item.WorkerSelectionReason != "" {
+				nonAttributeMap["Worker Selection Reason"] = item.WorkerSelectionReason
+			}
 
 			maxLength := 0
 			for k := range nonAttributeMap {
@@ -753,6 +953,14 @@ func printCustomActionOutputImpl(c *Command) (bool, error) {
 						ret = append(ret,
 							fmt.Sprintf("    Credential Type:               %s", cred.CredentialSource.CredentialType))
 					}
+					if cred.Secret.Version > 0 {
+						ret = append(ret,
+							fmt.Sprintf("    Secret Version:                %d", cred.Secret.Version))
+					}
+					if !cred.Secret.CreatedTime.IsZero() {
+						ret = append(ret,
+							fmt.Sprintf("    Secret Created Time:           %s", cred.Secret.CreatedTime.Local().Format(time.RFC1123)))
+					}
 
 					var secretStr []string
 					switch cred.CredentialSource.Type {
@@ -808,16 +1016,121 @@ func printCustomActionOutputImpl(c *Command) (bool, error) {
 			}
 			return true, nil
 		}
+
+	case "test-connection":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printConnectionTestTable(c.tcr.GetItem()))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.tcr.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "read-recording-policy":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printRecordingPolicyTable(c.rpr.GetItem()))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.rpr.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
 	}
 
 	return false, nil
 }
 
+func printConnectionTestTable(item *targets.TargetConnectionTest) string {
+	nonAttributeMap := map[string]any{
+		"Target ID":   item.TargetId,
+		"Worker ID":   item.WorkerId,
+		"Address":     item.Address,
+		"Check":       item.Check,
+		"Success":     item.Success,
+		"Tested Time": item.TestedTime.Local().Format(time.RFC1123),
+	}
+	if item.HostId != "" {
+		nonAttributeMap["Host ID"] = item.HostId
+	}
+	if item.LatencyMs != 0 {
+		nonAttributeMap["Latency"] = fmt.Sprintf("%.3fms", item.LatencyMs)
+	}
+	if item.Detail != "" {
+		nonAttributeMap["Detail"] = item.Detail
+	}
+	if item.Error != "" {
+		nonAttributeMap["Error"] = item.Error
+	}
+
+	maxLength := 0
+	for k := range nonAttributeMap {
+		if len(k) > maxLength {
+			maxLength = len(k)
+		}
+	}
+
+	ret := []string{
+		"",
+		"Target connection test information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+	return base.WrapForHelpText(ret)
+}
+
+func printRecordingPolicyTable(item *targets.EffectiveRecordingPolicy) string {
+	nonAttributeMap := map[string]any{
+		"Target ID":         item.TargetId,
+		"Recording Enabled": item.RecordingEnabled,
+	}
+	if item.RecordingEnabled {
+		nonAttributeMap["Storage Bucket ID"] = item.StorageBucketId
+		nonAttributeMap["Storage Bucket Scope ID"] = item.StorageBucketScopeId
+		nonAttributeMap["Record Percent"] = item.RecordPercent
+	}
+	if item.StoragePolicyId != "" {
+		nonAttributeMap["Storage Policy ID"] = item.StoragePolicyId
+		nonAttributeMap["Storage Policy Scope ID"] = item.StoragePolicyScopeId
+		nonAttributeMap["Retain For Days"] = item.RetainForDays
+		nonAttributeMap["Retain For Days Scope ID"] = item.RetainForDaysScopeId
+		nonAttributeMap["Delete After Days"] = item.DeleteAfterDays
+		nonAttributeMap["Delete After Days Scope ID"] = item.DeleteAfterDaysScopeId
+	}
+	if item.RecordFilter != "" {
+		nonAttributeMap["Record Filter"] = item.RecordFilter
+	}
+
+	maxLength := 0
+	for k := range nonAttributeMap {
+		if len(k) > maxLength {
+			maxLength = len(k)
+		}
+	}
+
+	ret := []string{
+		"",
+		"Target recording policy information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+	return base.WrapForHelpText(ret)
+}
+
 var keySubstMap = map[string]string{
+	"allowed_commands":         "Allowed Commands",
+	"denied_commands":          "Denied Commands",
 	"default_port":             "Default Port",
 	"default_client_port":      "Default Client Port",
 	"enable_session_recording": "Enable Session Recording",
+	"session_environment":      "Session Environment",
 	"storage_bucket_id":        "Storage Bucket ID",
+	"tls_passthrough":          "TLS Passthrough",
+	"tls_server_names":         "TLS Server Names",
 }
 
 func exampleOutput() string {
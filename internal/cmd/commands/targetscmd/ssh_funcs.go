@@ -5,12 +5,16 @@ package targetscmd
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"time"
 
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
-	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/boundary/internal/filter"
+	"github.com/hashicorp/boundary/internal/libs/commandpolicy"
+	"github.com/hashicorp/boundary/internal/libs/endpoint"
+	"github.com/hashicorp/boundary/internal/util/template"
 )
 
 func init() {
@@ -24,31 +28,46 @@ func extraSshActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"create": {
 			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
-			"egress-worker-filter", "ingress-worker-filter", "enable-session-recording",
-			"storage-bucket-id", "with-alias-value", "with-alias-scope-id", "with-alias-authorize-session-host-id",
+			"egress-worker-filter", "ingress-worker-filter", "locality", "authorization-token-ttl", "authorization-token-single-use", "preferred-endpoint", "allowed-ports", "enable-session-recording",
+			"storage-bucket-id", "session-environment", "allowed-command", "denied-command", "environment-id", "recording-compression", "recording-compression-level",
+			"recording-redaction-rule", "recording-sample-percent", "with-alias-value", "with-alias-scope-id", "with-alias-authorize-session-host-id",
 		},
 		"update": {
 			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
-			"worker-filter", "egress-worker-filter", "ingress-worker-filter", "enable-session-recording",
-			"storage-bucket-id",
+			"worker-filter", "egress-worker-filter", "ingress-worker-filter", "locality", "authorization-token-ttl", "authorization-token-single-use", "preferred-endpoint", "allowed-ports", "enable-session-recording",
+			"storage-bucket-id", "session-environment", "allowed-command", "denied-command", "environment-id", "recording-compression", "recording-compression-level",
+			"recording-redaction-rule", "recording-sample-percent",
 		},
 	}
 }
 
 type extraSshCmdVars struct {
-	flagDefaultPort            string
-	flagDefaultClientPort      string
-	flagSessionMaxSeconds      string
-	flagSessionConnectionLimit string
-	flagWorkerFilter           string
-	flagEgressWorkerFilter     string
-	flagIngressWorkerFilter    string
-	flagAddress                string
-	flagStorageBucketId        string
-	flagEnableSessionRecording string
-	flagWithAliasValue         string
-	flagWithAliasScopeId       string
-	flagWithAliasHostId        string
+	flagDefaultPort                 string
+	flagDefaultClientPort           string
+	flagSessionMaxSeconds           string
+	flagSessionConnectionLimit      string
+	flagWorkerFilter                string
+	flagEgressWorkerFilter          string
+	flagIngressWorkerFilter         string
+	flagLocality                    string
+	flagAuthorizationTokenTtl       string
+	flagAuthorizationTokenSingleUse string
+	flagPreferredEndpoint           string
+	flagAllowedPorts                string
+	flagAddress                     string
+	flagEnvironmentId               string
+	flagStorageBucketId             string
+	flagEnableSessionRecording      string
+	flagSessionEnvironment          map[string]string
+	flagAllowedCommands             []string
+	flagDeniedCommands              []string
+	flagRecordingCompression        string
+	flagRecordingCompressionLevel   string
+	flagRecordingRedactionRules     []string
+	flagRecordingSamplePercent      string
+	flagWithAliasValue              string
+	flagWithAliasScopeId            string
+	flagWithAliasHostId             string
 }
 
 func (c *SshCommand) extraSshHelpFunc(helpMap map[string]func() string) string {
@@ -132,6 +151,36 @@ func extraSshFlagsFuncImpl(c *SshCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIngressWorkerFilter,
 				Usage:  "A boolean expression to filter which ingress workers can handle sessions for this target.",
 			})
+		case "locality":
+			fs.StringVar(&base.StringVar{
+				Name:   "locality",
+				Target: &c.flagLocality,
+				Usage:  "The locality of the target, such as a region. Sessions for this target prefer workers with the same locality.",
+			})
+		case "authorization-token-ttl":
+			fs.StringVar(&base.StringVar{
+				Name:   "authorization-token-ttl",
+				Target: &c.flagAuthorizationTokenTtl,
+				Usage:  "The maximum time a session authorization can be used to make the first connection. Can be specified as an integer number of seconds or a duration string. 0 means unlimited.",
+			})
+		case "authorization-token-single-use":
+			fs.StringVar(&base.StringVar{
+				Name:   "authorization-token-single-use",
+				Target: &c.flagAuthorizationTokenSingleUse,
+				Usage:  "A boolean indicating if session authorizations for this target can only be used for a single connection.",
+			})
+		case "preferred-endpoint":
+			fs.StringVar(&base.StringVar{
+				Name:   "preferred-endpoint",
+				Target: &c.flagPreferredEndpoint,
+				Usage:  `A comma separated list of endpoint preferences used to choose the address of hosts from the target's host sources, each a fallback for the ones before it. Supported preferences are "cidr:<block>", "dns:<glob>", "type:private_ip", "type:public_ip", "type:dns" and "any".`,
+			})
+		case "allowed-ports":
+			fs.StringVar(&base.StringVar{
+				Name:   "allowed-ports",
+				Target: &c.flagAllowedPorts,
+				Usage:  `A comma separated list of ports and port ranges, such as "5432,6432" or "8000-8100", that clients may choose from when connecting to the target instead of the default port.`,
+			})
 		case "storage-bucket-id":
 			fs.StringVar(&base.StringVar{
 				Name:   "storage-bucket-id",
@@ -144,6 +193,56 @@ func extraSshFlagsFuncImpl(c *SshCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagEnableSessionRecording,
 				Usage:  "A boolean indicating if session recording is enabled for this target.",
 			})
+		case "session-environment":
+			fs.StringMapVar(&base.StringMapVar{
+				Name:   "session-environment",
+				Target: &c.flagSessionEnvironment,
+				Usage:  `An environment variable to set in SSH sessions which use injected credentials, in the form NAME=value, such as "BOUNDARY_USER={{ .User.Name }}". The value is a template which can refer to the user, account, target and session. May be specified multiple times; on update, replaces all environment variables.`,
+			})
+		case "allowed-command":
+			fs.StringSliceVar(&base.StringSliceVar{
+				Name:      "allowed-command",
+				Target:    &c.flagAllowedCommands,
+				NullCheck: func() bool { return true },
+				Usage:     `A pattern of the commands SSH exec requests may run, such as "systemctl status *". Prefix the pattern with "re:" to use a regular expression. If set, other commands, interactive shells and subsystems are denied. May be specified multiple times; set to "null" to remove all allowed commands.`,
+			})
+		case "denied-command":
+			fs.StringSliceVar(&base.StringSliceVar{
+				Name:      "denied-command",
+				Target:    &c.flagDeniedCommands,
+				NullCheck: func() bool { return true },
+				Usage:     `A pattern of the commands SSH exec requests may not run, in the same form as allowed-command. Denied commands take precedence over allowed commands. May be specified multiple times; set to "null" to remove all denied commands.`,
+			})
+		case "environment-id":
+			fs.StringVar(&base.StringVar{
+				Name:   "environment-id",
+				Target: &c.flagEnvironmentId,
+				Usage:  "The ID of the environment the target belongs to. The target inherits the environment's settings for any it does not set itself.",
+			})
+		case "recording-compression":
+			fs.StringVar(&base.StringVar{
+				Name:   "recording-compression",
+				Target: &c.flagRecordingCompression,
+				Usage:  `The compression of the recordings of the target's sessions: "none", "gzip" or "zstd". Defaults to "gzip". The recording parameters are pushed to workers without restarting them; on update, the recording-* flags replace all recording parameters, and setting this flag to "null" removes them.`,
+			})
+		case "recording-compression-level":
+			fs.StringVar(&base.StringVar{
+				Name:   "recording-compression-level",
+				Target: &c.flagRecordingCompressionLevel,
+				Usage:  "The level of the compression of the recordings, up to 9 for gzip and 22 for zstd. 0 uses the default level of the compression.",
+			})
+		case "recording-redaction-rule":
+			fs.StringSliceVar(&base.StringSliceVar{
+				Name:   "recording-redaction-rule",
+				Target: &c.flagRecordingRedactionRules,
+				Usage:  "A regular expression matching data to redact from the recordings of the target's sessions. May be specified multiple times.",
+			})
+		case "recording-sample-percent":
+			fs.StringVar(&base.StringVar{
+				Name:   "recording-sample-percent",
+				Target: &c.flagRecordingSamplePercent,
+				Usage:  "The percentage of the target's sessions that are recorded, between 1 and 100. Defaults to 100.",
+			})
 		case "with-alias-value":
 			fs.StringVar(&base.StringVar{
 				Name:   "with-alias-value",
@@ -232,7 +331,7 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 	case "null":
 		*opts = append(*opts, targets.DefaultWorkerFilter())
 	default:
-		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+		if _, err := filter.CreateEvaluator(c.flagWorkerFilter); err != nil {
 			c.UI.Error(fmt.Sprintf("Unable to successfully parse worker filter expression: %s", err))
 			return false
 		}
@@ -244,7 +343,7 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 	case "null":
 		*opts = append(*opts, targets.DefaultEgressWorkerFilter())
 	default:
-		if _, err := bexpr.CreateEvaluator(c.flagEgressWorkerFilter); err != nil {
+		if _, err := filter.CreateEvaluator(c.flagEgressWorkerFilter); err != nil {
 			c.UI.Error(fmt.Sprintf("Unable to successfully parse egress filter expression: %s", err))
 			return false
 		}
@@ -255,13 +354,78 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 	case "null":
 		*opts = append(*opts, targets.DefaultIngressWorkerFilter())
 	default:
-		if _, err := bexpr.CreateEvaluator(c.flagIngressWorkerFilter); err != nil {
+		if _, err := filter.CreateEvaluator(c.flagIngressWorkerFilter); err != nil {
 			c.UI.Error(fmt.Sprintf("Unable to successfully parse ingress filter expression: %s", err))
 			return false
 		}
 		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
 	}
 
+	switch c.flagLocality {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultLocality())
+	default:
+		*opts = append(*opts, targets.WithLocality(c.flagLocality))
+	}
+
+	switch c.flagAuthorizationTokenTtl {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAuthorizationTokenTtlSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagAuthorizationTokenTtl, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagAuthorizationTokenTtl)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagAuthorizationTokenTtl, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithAuthorizationTokenTtlSeconds(final))
+	}
+
+	switch c.flagAuthorizationTokenSingleUse {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAuthorizationTokenSingleUse())
+	case "false":
+		*opts = append(*opts, targets.WithAuthorizationTokenSingleUse(false))
+	case "true":
+		*opts = append(*opts, targets.WithAuthorizationTokenSingleUse(true))
+	default:
+		c.UI.Error(fmt.Sprintf("Invalid bool value for authorization-token-single-use %v", c.flagAuthorizationTokenSingleUse))
+		return false
+	}
+
+	switch c.flagPreferredEndpoint {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultPreferredEndpoint())
+	default:
+		if _, err := endpoint.ParsePreferenceExpression(c.flagPreferredEndpoint); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing preferred endpoint %q: %s", c.flagPreferredEndpoint, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithPreferredEndpoint(c.flagPreferredEndpoint))
+	}
+
+	switch c.flagAllowedPorts {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAllowedPorts())
+	default:
+		if _, err := endpoint.ParsePorts(c.flagAllowedPorts); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing allowed ports %q: %s", c.flagAllowedPorts, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithAllowedPorts(c.flagAllowedPorts))
+	}
+
 	switch c.flagAddress {
 	case "":
 	case "null":
@@ -270,6 +434,14 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithAddress(c.flagAddress))
 	}
 
+	switch c.flagEnvironmentId {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultEnvironmentId())
+	default:
+		*opts = append(*opts, targets.WithEnvironmentId(c.flagEnvironmentId))
+	}
+
 	switch c.flagStorageBucketId {
 	case "":
 	case "null":
@@ -289,6 +461,70 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 		return false
 	}
 
+	if len(c.flagSessionEnvironment) > 0 {
+		if err := template.ValidateEnvironment(c.Context, c.flagSessionEnvironment); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing session environment: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSshTargetSessionEnvironment(c.flagSessionEnvironment))
+	}
+
+	var allowedCommands, deniedCommands []string
+	switch {
+	case len(c.flagAllowedCommands) == 0:
+	case len(c.flagAllowedCommands) == 1 && c.flagAllowedCommands[0] == "null":
+		*opts = append(*opts, targets.DefaultSshTargetAllowedCommands())
+	default:
+		allowedCommands = c.flagAllowedCommands
+		*opts = append(*opts, targets.WithSshTargetAllowedCommands(c.flagAllowedCommands))
+	}
+	switch {
+	case len(c.flagDeniedCommands) == 0:
+	case len(c.flagDeniedCommands) == 1 && c.flagDeniedCommands[0] == "null":
+		*opts = append(*opts, targets.DefaultSshTargetDeniedCommands())
+	default:
+		deniedCommands = c.flagDeniedCommands
+		*opts = append(*opts, targets.WithSshTargetDeniedCommands(c.flagDeniedCommands))
+	}
+	if _, err := commandpolicy.New(allowedCommands, deniedCommands); err != nil {
+		c.UI.Error(fmt.Sprintf("Error parsing commands: %s", err))
+		return false
+	}
+
+	switch {
+	case c.flagRecordingCompression == "null":
+		*opts = append(*opts, targets.DefaultRecordingParameters())
+	case c.flagRecordingCompression != "" || c.flagRecordingCompressionLevel != "" ||
+		len(c.flagRecordingRedactionRules) > 0 || c.flagRecordingSamplePercent != "":
+		params := &targets.RecordingParameters{
+			Compression:    c.flagRecordingCompression,
+			RedactionRules: c.flagRecordingRedactionRules,
+		}
+		if c.flagRecordingCompressionLevel != "" {
+			level, err := strconv.ParseUint(c.flagRecordingCompressionLevel, 10, 32)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagRecordingCompressionLevel, err))
+				return false
+			}
+			params.CompressionLevel = uint32(level)
+		}
+		if c.flagRecordingSamplePercent != "" {
+			percent, err := strconv.ParseUint(c.flagRecordingSamplePercent, 10, 32)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagRecordingSamplePercent, err))
+				return false
+			}
+			params.SamplePercent = uint32(percent)
+		}
+		for _, r := range params.RedactionRules {
+			if _, err := regexp.Compile(r); err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing recording redaction rule %q: %s", r, err))
+				return false
+			}
+		}
+		*opts = append(*opts, targets.WithRecordingParameters(params))
+	}
+
 	var aliasValue string
 	switch c.flagWithAliasValue {
 	case "":
@@ -10,7 +10,8 @@ import (
 
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
-	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/boundary/internal/filter"
+	"github.com/hashicorp/boundary/internal/libs/endpoint"
 )
 
 func init() {
@@ -23,29 +24,40 @@ func extraTcpActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"create": {
 			"address", "default-port", "default-client-port", "session-max-seconds",
-			"session-connection-limit", "egress-worker-filter", "ingress-worker-filter",
-			"with-alias-value", "with-alias-scope-id", "with-alias-authorize-session-host-id",
+			"session-connection-limit", "egress-worker-filter", "ingress-worker-filter", "locality", "authorization-token-ttl", "authorization-token-single-use", "preferred-endpoint", "allowed-ports",
+			"tls-passthrough", "tls-server-names",
+			"environment-id", "with-alias-value", "with-alias-scope-id", "with-alias-authorize-session-host-id",
 		},
 		"update": {
 			"address", "default-port", "default-client-port", "session-max-seconds",
 			"session-connection-limit", "worker-filter", "egress-worker-filter",
-			"ingress-worker-filter",
+			"ingress-worker-filter", "locality", "authorization-token-ttl", "authorization-token-single-use", "preferred-endpoint", "allowed-ports",
+			"tls-passthrough", "tls-server-names",
+			"environment-id",
 		},
 	}
 }
 
 type extraTcpCmdVars struct {
-	flagDefaultPort            string
-	flagDefaultClientPort      string
-	flagSessionMaxSeconds      string
-	flagSessionConnectionLimit string
-	flagWorkerFilter           string
-	flagEgressWorkerFilter     string
-	flagIngressWorkerFilter    string
-	flagAddress                string
-	flagWithAliasValue         string
-	flagWithAliasScopeId       string
-	flagWithAliasHostId        string
+	flagDefaultPort                 string
+	flagDefaultClientPort           string
+	flagSessionMaxSeconds           string
+	flagSessionConnectionLimit      string
+	flagWorkerFilter                string
+	flagEgressWorkerFilter          string
+	flagIngressWorkerFilter         string
+	flagLocality                    string
+	flagAuthorizationTokenTtl       string
+	flagAuthorizationTokenSingleUse string
+	flagPreferredEndpoint           string
+	flagAllowedPorts                string
+	flagTlsPassthrough              string
+	flagTlsServerNames              string
+	flagAddress                     string
+	flagEnvironmentId               string
+	flagWithAliasValue              string
+	flagWithAliasScopeId            string
+	flagWithAliasHostId             string
 }
 
 func (c *TcpCommand) extraTcpHelpFunc(helpMap map[string]func() string) string {
@@ -129,6 +141,54 @@ func extraTcpFlagsFuncImpl(c *TcpCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIngressWorkerFilter,
 				Usage:  "A boolean expression to filter which ingress workers can handle sessions for this target.",
 			})
+		case "locality":
+			fs.StringVar(&base.StringVar{
+				Name:   "locality",
+				Target: &c.flagLocality,
+				Usage:  "The locality of the target, such as a region. Sessions for this target prefer workers with the same locality.",
+			})
+		case "authorization-token-ttl":
+			fs.StringVar(&base.StringVar{
+				Name:   "authorization-token-ttl",
+				Target: &c.flagAuthorizationTokenTtl,
+				Usage:  "The maximum time a session authorization can be used to make the first connection. Can be specified as an integer number of seconds or a duration string. 0 means unlimited.",
+			})
+		case "authorization-token-single-use":
+			fs.StringVar(&base.StringVar{
+				Name:   "authorization-token-single-use",
+				Target: &c.flagAuthorizationTokenSingleUse,
+				Usage:  "A boolean indicating if session authorizations for this target can only be used for a single connection.",
+			})
+		case "preferred-endpoint":
+			fs.StringVar(&base.StringVar{
+				Name:   "preferred-endpoint",
+				Target: &c.flagPreferredEndpoint,
+				Usage:  `A comma separated list of endpoint preferences used to choose the address of hosts from the target's host sources, each a fallback for the ones before it. Supported preferences are "cidr:<block>", "dns:<glob>", "type:private_ip", "type:public_ip", "type:dns" and "any".`,
+			})
+		case "allowed-ports":
+			fs.StringVar(&base.StringVar{
+				Name:   "allowed-ports",
+				Target: &c.flagAllowedPorts,
+				Usage:  `A comma separated list of ports and port ranges, such as "5432,6432" or "8000-8100", that clients may choose from when connecting to the target instead of the default port.`,
+			})
+		case "tls-passthrough":
+			fs.StringVar(&base.StringVar{
+				Name:   "tls-passthrough",
+				Target: &c.flagTlsPassthrough,
+				Usage:  "A boolean indicating if the TLS connections of clients are passed through to the endpoint without being terminated by the worker, so that the endpoint receives the client's own certificate. Connections that do not use TLS are refused.",
+			})
+		case "tls-server-names":
+			fs.StringVar(&base.StringVar{
+				Name:   "tls-server-names",
+				Target: &c.flagTlsServerNames,
+				Usage:  `A comma separated list of server name patterns, such as "*.db.example.com", used to route TLS passthrough connections to the host named by the server name (SNI) the client sends. Server names matching none of the patterns are refused. Requires tls-passthrough.`,
+			})
+		case "environment-id":
+			fs.StringVar(&base.StringVar{
+				Name:   "environment-id",
+				Target: &c.flagEnvironmentId,
+				Usage:  "The ID of the environment the target belongs to. The target inherits the environment's settings for any it does not set itself.",
+			})
 		case "with-alias-value":
 			fs.StringVar(&base.StringVar{
 				Name:   "with-alias-value",
@@ -217,7 +277,7 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 	case "null":
 		*opts = append(*opts, targets.DefaultWorkerFilter())
 	default:
-		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+		if _, err := filter.CreateEvaluator(c.flagWorkerFilter); err != nil {
 			c.UI.Error(fmt.Sprintf("Unable to successfully parse worker filter expression: %s", err))
 			return false
 		}
@@ -229,7 +289,7 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 	case "null":
 		*opts = append(*opts, targets.DefaultEgressWorkerFilter())
 	default:
-		if _, err := bexpr.CreateEvaluator(c.flagEgressWorkerFilter); err != nil {
+		if _, err := filter.CreateEvaluator(c.flagEgressWorkerFilter); err != nil {
 			c.UI.Error(fmt.Sprintf("Unable to successfully parse egress filter expression: %s", err))
 			return false
 		}
@@ -240,13 +300,103 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 	case "null":
 		*opts = append(*opts, targets.DefaultIngressWorkerFilter())
 	default:
-		if _, err := bexpr.CreateEvaluator(c.flagIngressWorkerFilter); err != nil {
+		if _, err := filter.CreateEvaluator(c.flagIngressWorkerFilter); err != nil {
 			c.UI.Error(fmt.Sprintf("Unable to successfully parse ingress filter expression: %s", err))
 			return false
 		}
 		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
 	}
 
+	switch c.flagLocality {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultLocality())
+	default:
+		*opts = append(*opts, targets.WithLocality(c.flagLocality))
+	}
+
+	switch c.flagAuthorizationTokenTtl {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAuthorizationTokenTtlSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagAuthorizationTokenTtl, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagAuthorizationTokenTtl)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagAuthorizationTokenTtl, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithAuthorizationTokenTtlSeconds(final))
+	}
+
+	switch c.flagAuthorizationTokenSingleUse {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAuthorizationTokenSingleUse())
+	case "false":
+		*opts = append(*opts, targets.WithAuthorizationTokenSingleUse(false))
+	case "true":
+		*opts = append(*opts, targets.WithAuthorizationTokenSingleUse(true))
+	default:
+		c.UI.Error(fmt.Sprintf("Invalid bool value for authorization-token-single-use %v", c.flagAuthorizationTokenSingleUse))
+		return false
+	}
+
+	switch c.flagPreferredEndpoint {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultPreferredEndpoint())
+	default:
+		if _, err := endpoint.ParsePreferenceExpression(c.flagPreferredEndpoint); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing preferred endpoint %q: %s", c.flagPreferredEndpoint, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithPreferredEndpoint(c.flagPreferredEndpoint))
+	}
+
+	switch c.flagAllowedPorts {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAllowedPorts())
+	default:
+		if _, err := endpoint.ParsePorts(c.flagAllowedPorts); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing allowed ports %q: %s", c.flagAllowedPorts, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithAllowedPorts(c.flagAllowedPorts))
+	}
+
+	switch c.flagTlsPassthrough {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetTlsPassthrough())
+	case "false":
+		*opts = append(*opts, targets.WithTcpTargetTlsPassthrough(false))
+	case "true":
+		*opts = append(*opts, targets.WithTcpTargetTlsPassthrough(true))
+	default:
+		c.UI.Error(fmt.Sprintf("Invalid bool value for tls-passthrough %v", c.flagTlsPassthrough))
+		return false
+	}
+
+	switch c.flagTlsServerNames {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetTlsServerNames())
+	default:
+		if _, err := endpoint.ParseServerNames(c.flagTlsServerNames); err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing tls server names %q: %s", c.flagTlsServerNames, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithTcpTargetTlsServerNames(c.flagTlsServerNames))
+	}
+
 	switch c.flagAddress {
 	case "":
 	case "null":
@@ -255,6 +405,14 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithAddress(c.flagAddress))
 	}
 
+	switch c.flagEnvironmentId {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultEnvironmentId())
+	default:
+		*opts = append(*opts, targets.WithEnvironmentId(c.flagEnvironmentId))
+	}
+
 	var aliasValue string
 	switch c.flagWithAliasValue {
 	case "":
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targettemplatescmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targettemplates"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+}
+
+type extraCmdVars struct {
+	flagDefinition string
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {"definition"},
+		"update": {"definition"},
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	switch c.Func {
+	case "":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary target-templates [sub command] [options] [args]",
+			"",
+			"  This command allows operations on Boundary target template resources. Example:",
+			"",
+			"    Create a target template:",
+			"",
+			`      $ boundary target-templates create -scope-id p_1234567890 -name web -definition file:///home/user/web.json`,
+			"",
+			`  The string values of the definition can reference parameters such as {{.service}}, which are substituted when a target is created with "boundary targets create-from-template".`,
+			"",
+			"  Please see the target-templates subcommand help for detailed usage information.",
+		})
+	}
+	return c.Flags().Help()
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "definition":
+			f.StringVar(&base.StringVar{
+				Name:   "definition",
+				Target: &c.flagDefinition,
+				Usage:  `The definition of the targets created from the template, as a JSON object describing a target. Its string values can reference parameters such as {{.service}}. Can be specified directly, or as a reference to a file on disk (file://) or an env var (env://) from which the value will be read.`,
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targettemplates.Option) bool {
+	if c.flagDefinition == "" {
+		return true
+	}
+	raw, err := parseutil.ParsePath(c.flagDefinition)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		c.UI.Error(fmt.Sprintf("Error reading definition: %s", err))
+		return false
+	}
+	definition := map[string]any{}
+	if err := json.Unmarshal([]byte(raw), &definition); err != nil {
+		c.UI.Error(fmt.Sprintf("Error parsing definition as a JSON object: %s", err))
+		return false
+	}
+	*opts = append(*opts, targettemplates.WithDefinition(definition))
+	return true
+}
+
+func (c *Command) printListTable(items []*targettemplates.TargetTemplate) string {
+	if len(items) == 0 {
+		return "No target templates found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Target Template information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if c.FlagRecursive && item.ScopeId != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.ScopeId),
+			)
+		}
+		if item.Version > 0 {
+			output = append(output,
+				fmt.Sprintf("    Version:             %d", item.Version),
+			)
+		}
+		if item.Name != "" {
+			output = append(output,
+				fmt.Sprintf("    Name:                %s", item.Name),
+			)
+		}
+		if item.Description != "" {
+			output = append(output,
+				fmt.Sprintf("    Description:         %s", item.Description),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printItemTable(item *targettemplates.TargetTemplate, resp *api.Response) string {
+	nonAttributeMap := map[string]any{}
+	if item.Id != "" {
+		nonAttributeMap["ID"] = item.Id
+	}
+	if item.Version != 0 {
+		nonAttributeMap["Version"] = item.Version
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = item.UpdatedTime.Local().Format(time.RFC1123)
+	}
+	if item.Name != "" {
+		nonAttributeMap["Name"] = item.Name
+	}
+	if item.Description != "" {
+		nonAttributeMap["Description"] = item.Description
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Definition, nil)
+
+	ret := []string{
+		"",
+		"Target Template information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if item.Scope != nil {
+		ret = append(ret,
+			"",
+			"  Scope:",
+			base.ScopeInfoForOutput(item.Scope, maxLength),
+		)
+	}
+
+	if len(item.AuthorizedActions) > 0 {
+		ret = append(ret,
+			"",
+			"  Authorized Actions:",
+			base.WrapSlice(4, item.AuthorizedActions),
+		)
+	}
+
+	if len(item.Definition) > 0 {
+		ret = append(ret,
+			"",
+			"  Definition:",
+			base.WrapMap(4, maxLength, item.Definition),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package version
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/libs/buildinfo"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*BuildInfoCommand)(nil)
+	_ cli.CommandAutocomplete = (*BuildInfoCommand)(nil)
+)
+
+// defaultOpsTimeout is the default timeout of the request made to the ops
+// listener.
+const defaultOpsTimeout = 30 * time.Second
+
+// BuildInfoCommand prints the software bill of materials or the build
+// provenance of the local binary, or of the binary running behind an ops
+// listener.
+type BuildInfoCommand struct {
+	*base.Command
+
+	Func string
+
+	flagOpsAddress   string
+	flagTlsInsecure  bool
+	flagTimeout      time.Duration
+	flagVerifyBinary string
+}
+
+func (c *BuildInfoCommand) Synopsis() string {
+	switch c.Func {
+	case "sbom":
+		return "Print the software bill of materials of a Boundary binary"
+	case "provenance":
+		return "Print the build provenance of a Boundary binary"
+	}
+	return ""
+}
+
+func (c *BuildInfoCommand) Help() string {
+	var helpText []string
+	switch c.Func {
+	case "sbom":
+		helpText = []string{
+			"Usage: boundary version sbom [options]",
+			"",
+			"  Print the software bill of materials (SBOM) of a Boundary binary as a CycloneDX JSON document listing the Go modules compiled into it. Without -ops-address, the SBOM of the local binary is printed. Example:",
+			"",
+			`    $ boundary version sbom -ops-address https://controller.example.com:9203`,
+		}
+	case "provenance":
+		helpText = []string{
+			"Usage: boundary version provenance [options]",
+			"",
+			"  Print the build provenance of a Boundary binary as an in-toto statement with a SLSA provenance predicate. Its subject is the SHA256 checksum of the binary. Without -ops-address, the provenance of the local binary is printed. Example:",
+			"",
+			`    $ boundary version provenance -ops-address https://worker.example.com:9203 -verify-binary ./boundary`,
+			"",
+			"  With -verify-binary, the command fails unless the checksum of the given file matches the subject of the provenance, to check that a server runs a known artifact.",
+		}
+	}
+	helpText = append(helpText,
+		"",
+		"  The document is reported by the binary itself and is not signed, so it confirms which build is running but does not replace verifying the signatures of release artifacts.",
+	)
+	return base.WrapForHelpText(helpText) + c.Flags().Help()
+}
+
+func (c *BuildInfoCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetNone)
+
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "ops-address",
+		Target:     &c.flagOpsAddress,
+		Completion: complete.PredictAnything,
+		Usage:      "Address of the ops listener of the controller or worker to query, as a complete URL (e.g. https://127.0.0.1:9203). If not set, the local binary is described.",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "tls-insecure",
+		Target: &c.flagTlsInsecure,
+		Usage:  "Disable verification of the TLS certificate of the ops listener given with -ops-address.",
+	})
+
+	f.DurationVar(&base.DurationVar{
+		Name:    "timeout",
+		Target:  &c.flagTimeout,
+		Default: defaultOpsTimeout,
+		Usage:   "The timeout of the request made to the ops listener.",
+	})
+
+	if c.Func == "provenance" {
+		f.StringVar(&base.StringVar{
+			Name:       "verify-binary",
+			Target:     &c.flagVerifyBinary,
+			Completion: complete.PredictFiles("*"),
+			Usage:      "Path of a binary whose SHA256 checksum must match the subject of the provenance.",
+		})
+	}
+
+	return set
+}
+
+func (c *BuildInfoCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *BuildInfoCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *BuildInfoCommand) Run(args []string) int {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+
+	if c.flagTimeout <= 0 {
+		c.UI.Error("-timeout must be greater than zero")
+		return base.CommandUserError
+	}
+	if c.flagOpsAddress != "" {
+		u, err := url.Parse(c.flagOpsAddress)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			c.UI.Error(fmt.Sprintf("Invalid -ops-address %q: must be a complete URL", c.flagOpsAddress))
+			return base.CommandUserError
+		}
+	}
+
+	var doc []byte
+	var err error
+	switch {
+	case c.flagOpsAddress != "":
+		doc, err = c.fetch(c.Context)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error fetching %s from %s: %s", c.Func, c.flagOpsAddress, err))
+			return base.CommandCliError
+		}
+	default:
+		var v any
+		switch c.Func {
+		case "sbom":
+			v, err = buildinfo.Sbom()
+		case "provenance":
+			v, err = buildinfo.Provenance()
+		}
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading %s of the local binary: %s", c.Func, err))
+			return base.CommandCliError
+		}
+		if doc, err = json.Marshal(v); err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return base.CommandCliError
+		}
+	}
+
+	if c.flagVerifyBinary != "" {
+		if ret := c.verify(doc); ret != base.CommandSuccess {
+			return ret
+		}
+	}
+
+	var out strings.Builder
+	enc := json.NewEncoder(&out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(json.RawMessage(doc)); err != nil {
+		c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+		return base.CommandCliError
+	}
+	c.UI.Output(strings.TrimSuffix(out.String(), "\n"))
+	return base.CommandSuccess
+}
+
+// verify checks that the checksum of the binary given with -verify-binary
+// matches the subject of the provenance.
+func (c *BuildInfoCommand) verify(doc []byte) int {
+	var statement buildinfo.Statement
+	if err := json.Unmarshal(doc, &statement); err != nil {
+		c.UI.Error(fmt.Sprintf("Error decoding provenance: %s", err))
+		return base.CommandCliError
+	}
+	if statement.PredicateType != buildinfo.ProvenancePredicateType || len(statement.Subject) == 0 {
+		c.UI.Error("Provenance has no subject to verify")
+		return base.CommandCliError
+	}
+	got, err := buildinfo.DigestFile(c.flagVerifyBinary)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error computing checksum of %s: %s", c.flagVerifyBinary, err))
+		return base.CommandUserError
+	}
+	if want := statement.Subject[0].Digest["sha256"]; got != want {
+		c.UI.Error(fmt.Sprintf("Checksum %s of %s does not match the provenance subject %s", got, c.flagVerifyBinary, want))
+		return base.CommandCliError
+	}
+	return base.CommandSuccess
+}
+
+func (c *BuildInfoCommand) fetch(ctx context.Context) ([]byte, error) {
+	client := cleanhttp.DefaultClient()
+	client.Timeout = c.flagTimeout
+	if c.flagTlsInsecure {
+		client.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	u := strings.TrimSuffix(c.flagOpsAddress, "/") + "/" + c.Func
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q", resp.Status)
+	}
+	doc, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(doc) {
+		return nil, fmt.Errorf("response is not valid JSON")
+	}
+	return doc, nil
+}
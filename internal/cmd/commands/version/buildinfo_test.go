@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package version
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/libs/buildinfo"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildInfoCommand(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "boundary")
+	require.NoError(t, os.WriteFile(binary, []byte("boundary"), 0o755))
+	digest, err := buildinfo.DigestFile(binary)
+	require.NoError(t, err)
+
+	statement, err := json.Marshal(&buildinfo.Statement{
+		Type:          buildinfo.StatementType,
+		Subject:       []*buildinfo.Subject{{Name: "boundary", Digest: map[string]string{"sha256": digest}}},
+		PredicateType: buildinfo.ProvenancePredicateType,
+	})
+	require.NoError(t, err)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/provenance", func(w http.ResponseWriter, _ *http.Request) { w.Write(statement) })
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	run := func(t *testing.T, fn string, args ...string) (int, *cli.MockUi) {
+		t.Helper()
+		ui := cli.NewMockUi()
+		c := &BuildInfoCommand{Command: base.NewCommand(ui), Func: fn}
+		return c.Run(args), ui
+	}
+
+	t.Run("local sbom", func(t *testing.T) {
+		code, ui := run(t, "sbom")
+		require.Equal(t, base.CommandSuccess, code, ui.ErrorWriter.String())
+		var bom buildinfo.Bom
+		require.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &bom))
+		assert.Equal(t, "CycloneDX", bom.BomFormat)
+	})
+	t.Run("remote provenance", func(t *testing.T) {
+		code, ui := run(t, "provenance", "-ops-address", srv.URL, "-verify-binary", binary)
+		require.Equal(t, base.CommandSuccess, code, ui.ErrorWriter.String())
+		var got buildinfo.Statement
+		require.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &got))
+		assert.Equal(t, digest, got.Subject[0].Digest["sha256"])
+	})
+	t.Run("checksum mismatch", func(t *testing.T) {
+		other := filepath.Join(t.TempDir(), "other")
+		require.NoError(t, os.WriteFile(other, []byte("other"), 0o755))
+		code, ui := run(t, "provenance", "-ops-address", srv.URL, "-verify-binary", other)
+		assert.Equal(t, base.CommandCliError, code)
+		assert.Contains(t, ui.ErrorWriter.String(), "does not match")
+	})
+	t.Run("missing endpoint", func(t *testing.T) {
+		code, ui := run(t, "sbom", "-ops-address", srv.URL)
+		assert.Equal(t, base.CommandCliError, code)
+		assert.Contains(t, ui.ErrorWriter.String(), "404")
+	})
+	t.Run("invalid address", func(t *testing.T) {
+		code, ui := run(t, "sbom", "-ops-address", "localhost")
+		assert.Equal(t, base.CommandUserError, code)
+		assert.Contains(t, ui.ErrorWriter.String(), "must be a complete URL")
+	})
+}
@@ -30,6 +30,8 @@ func (c *Command) Help() string {
 		"Usage: boundary version",
 		"",
 		"  This command displays the version of the local Boundary binary.",
+		"",
+		"  The software bill of materials and the build provenance of a binary can be printed with the sbom and provenance subcommands.",
 	}) + c.Flags().Help()
 }
 
@@ -107,7 +107,7 @@ func (c *WorkerCACommand) checkFuncError(err error) int {
 		c.PrintApiError(apiErr, fmt.Sprintf("Error from controller when performing certificate authority %s", c.Func))
 		return base.CommandApiError
 	}
-	c.PrintCliError(fmt.Errorf("Error trying to %s certificate authority : %s", c.Func, err.Error()))
+	c.PrintCliError(fmt.Errorf("Error trying to %s certificate authority : %w", c.Func, err))
 	return base.CommandCliError
 }
 
@@ -278,6 +278,9 @@ func printItemTable(item *workers.Worker, resp *api.Response) string {
 	if item.LocalStorageState != "" {
 		nonAttributeMap["Local Storage State"] = item.LocalStorageState
 	}
+	if item.Locality != "" {
+		nonAttributeMap["Locality"] = item.Locality
+	}
 
 	resultMap := resp.Map
 	if count, ok := resultMap[globals.ActiveConnectionCountField]; ok {
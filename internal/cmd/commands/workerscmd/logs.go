@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package workerscmd
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/workers"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*WorkerLogsCommand)(nil)
+	_ cli.CommandAutocomplete = (*WorkerLogsCommand)(nil)
+)
+
+type WorkerLogsCommand struct {
+	*base.Command
+
+	flagMinutes uint64
+}
+
+func (c *WorkerLogsCommand) Synopsis() string {
+	return wordwrap.WrapString("Read the recent logs and metrics of a Boundary worker", base.TermWidth)
+}
+
+func (c *WorkerLogsCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary workers logs [options] [args]",
+		"",
+		"  Read the recent system and error events and the current metrics of a worker. The worker sends them through its connection to the controller, so it must be connected to the controller handling the request. Example:",
+		"",
+		`    $ boundary workers logs -id w_1234567890 -minutes 10`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *WorkerLogsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, "worker", map[string][]string{"logs": {"id"}}, "logs")
+
+	f.Uint64Var(&base.Uint64Var{
+		Name:   "minutes",
+		Target: &c.flagMinutes,
+		Usage:  "The number of minutes of logs to read. Defaults to 5 and can be at most 30.",
+	})
+
+	return set
+}
+
+func (c *WorkerLogsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *WorkerLogsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *WorkerLogsCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID is required but not passed in via -id"))
+		return base.CommandUserError
+	case c.flagMinutes > math.MaxUint32:
+		c.PrintCliError(fmt.Errorf("Invalid value for -minutes: %d", c.flagMinutes))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+	workersClient := workers.NewClient(client)
+
+	var opts []workers.Option
+	if c.flagMinutes != 0 {
+		opts = append(opts, workers.WithLogsMinutes(uint32(c.flagMinutes)))
+	}
+
+	result, err := workersClient.ReadLogs(c.Context, c.FlagId, opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when performing read-logs on worker")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to read logs of worker: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printLogsTable(result.GetItem()))
+
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
+
+func printLogsTable(item *workers.WorkerLogs) string {
+	if item == nil {
+		return "No logs found"
+	}
+
+	output := []string{
+		"",
+		"Worker logs information:",
+		fmt.Sprintf("  Worker ID:                 %s", item.WorkerId),
+		fmt.Sprintf("  Minutes:                   %d", item.Minutes),
+	}
+	if !item.CollectedTime.IsZero() {
+		output = append(output,
+			fmt.Sprintf("  Collected Time:            %s", item.CollectedTime.Local().Format("Mon, 02 Jan 2006 15:04:05 MST")),
+		)
+	}
+
+	if len(item.Metrics) > 0 {
+		names := make([]string, 0, len(item.Metrics))
+		for name := range item.Metrics {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		output = append(output,
+			"",
+			"  Metrics:",
+		)
+		for _, name := range names {
+			output = append(output,
+				fmt.Sprintf("    %s %v", name, item.Metrics[name]),
+			)
+		}
+	}
+
+	output = append(output,
+		"",
+		"  Logs:",
+	)
+	if len(item.LogLines) == 0 {
+		output = append(output, "    (none)")
+	}
+	ret := base.WrapForHelpText(output)
+	// Log lines are printed as they were emitted rather than wrapped, so they
+	// can be copied and searched.
+	for _, line := range item.LogLines {
+		ret += "\n    " + line
+	}
+	return ret
+}
@@ -15,28 +15,44 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/posener/complete"
 )
 
 func PopulateCommonFlags(c *base.Command, f *base.FlagSet, resourceType string, flagNames map[string][]string, command string) {
+	// Commands operating on an existing resource can look it up by name
+	// instead of ID.
+	var lookup bool
+	switch command {
+	case "read", "update", "delete":
+		lookup = strutil.StrListContains(flagNames[command], "name")
+	}
 	for _, name := range flagNames[command] {
 		switch name {
 		case "scope-id":
+			usage := `Scope in which to make the request.`
+			if lookup {
+				usage = fmt.Sprintf("Scope in which to look up the %s by name, along with the scopes beneath it.", resourceType)
+			}
 			f.StringVar(&base.StringVar{
 				Name:       "scope-id",
 				Target:     &c.FlagScopeId,
 				EnvVar:     "BOUNDARY_SCOPE_ID",
 				Default:    scope.Global.String(),
 				Completion: complete.PredictAnything,
-				Usage:      `Scope in which to make the request.`,
+				Usage:      usage,
 			})
 		case "scope-name":
+			usage := `Scope in which to make the request, identified by name.`
+			if lookup {
+				usage = fmt.Sprintf("Scope in which to look up the %s by name, identified by name. Takes precedence over -scope-id.", resourceType)
+			}
 			f.StringVar(&base.StringVar{
 				Name:       "scope-name",
 				Target:     &c.FlagScopeName,
 				EnvVar:     "BOUNDARY_SCOPE_NAME",
 				Completion: complete.PredictAnything,
-				Usage:      `Scope in which to make the request, identified by name.`,
+				Usage:      usage,
 			})
 		case "plugin-id":
 			f.StringVar(&base.StringVar{
@@ -60,10 +76,18 @@ func PopulateCommonFlags(c *base.Command, f *base.FlagSet, resourceType string,
 				Usage:   fmt.Sprintf("ID of the %s on which to operate.", resourceType),
 			})
 		case "name":
+			usage := fmt.Sprintf("Name to set on the %s.", resourceType)
+			switch {
+			case !lookup:
+			case command == "update":
+				usage = fmt.Sprintf("Name to set on the %[1]s. If -id is not set, the %[1]s with this name is updated instead, and its name is left unchanged.", resourceType)
+			default:
+				usage = fmt.Sprintf("Name of the %s on which to operate, if -id is not set.", resourceType)
+			}
 			f.StringVar(&base.StringVar{
 				Name:   "name",
 				Target: &c.FlagName,
-				Usage:  fmt.Sprintf("Name to set on the %s.", resourceType),
+				Usage:  usage,
 			})
 		case "description":
 			f.StringVar(&base.StringVar{
@@ -48,6 +48,8 @@ func HelpMap(resType string) map[string]func() string {
 		resource.StorageBucket.String():    "sb",
 		resource.Policy.String():           "p",
 		resource.Alias.String():            "alt",
+		resource.Environment.String():      "env",
+		resource.TargetTemplate.String():   "ttpl",
 	}
 	return map[string]func() string{
 		"base": func() string {
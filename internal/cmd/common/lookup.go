@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/scope"
+)
+
+// NameMatch is a resource found when looking up a resource by name.
+type NameMatch struct {
+	Id    string
+	Scope *scopes.ScopeInfo
+}
+
+// NameLister lists the resources in the container with the given ID which
+// match the filter, such as the targets in a scope and the scopes beneath it.
+type NameLister func(containerId, filter string) ([]NameMatch, error)
+
+// NameFilter returns a list filter matching the resources with the given name.
+func NameFilter(name string) string {
+	value := strconv.Quote(name)
+	if strings.Contains(name, `"`) {
+		// Double-quoted filter strings cannot contain quotes, even escaped
+		value = "`" + name + "`"
+	}
+	return fmt.Sprintf(`"/item/name" == %s`, value)
+}
+
+// LookupIdByName returns the ID of the resource named by the -name flag, so
+// that the commands which take an -id can address resources by name as well.
+// The resource is listed in the container with the given ID, such as the auth
+// method of an account. If containerId is empty the resource is instead
+// listed in the scope named by -scope-name, or set by -scope-id, and in the
+// scopes beneath it; a resource in that scope takes precedence over the ones
+// beneath it. Listing honors the grants of the caller, so only the resources
+// they can list are found.
+//
+// On failure the error is printed and a non-zero exit code is returned.
+func LookupIdByName(c *base.Command, client *api.Client, resourceType, containerId string, list NameLister) (string, int) {
+	var scopeId string
+	if containerId == "" {
+		var err error
+		scopeId, err = lookupScopeId(c.Context, client, c.FlagScopeId, c.FlagScopeName)
+		if err != nil {
+			return "", printLookupError(c, "scope", err)
+		}
+		containerId = scopeId
+	}
+
+	matches, err := list(containerId, NameFilter(c.FlagName))
+	if err != nil {
+		return "", printLookupError(c, resourceType, err)
+	}
+	id, err := selectNameMatch(resourceType, c.FlagName, scopeId, matches)
+	if err != nil {
+		return "", printLookupError(c, resourceType, err)
+	}
+	return id, 0
+}
+
+// lookupScopeId returns the ID of the scope with the given name, or scopeId
+// if the name is empty.
+func lookupScopeId(ctx context.Context, client *api.Client, scopeId, scopeName string) (string, error) {
+	switch scopeName {
+	case "":
+		if scopeId == "" {
+			return scope.Global.String(), nil
+		}
+		return scopeId, nil
+	case scope.Global.String():
+		return scope.Global.String(), nil
+	}
+
+	result, err := scopes.NewClient(client).List(ctx, scope.Global.String(),
+		scopes.WithRecursive(true),
+		scopes.WithFilter(NameFilter(scopeName)))
+	if err != nil {
+		return "", err
+	}
+	matches := make([]NameMatch, 0, len(result.GetItems()))
+	for _, item := range result.GetItems() {
+		matches = append(matches, NameMatch{Id: item.Id, Scope: item.Scope})
+	}
+	return selectNameMatch("scope", scopeName, "", matches)
+}
+
+// notFoundError is returned when no resource has the name being looked up.
+type notFoundError struct {
+	resourceType string
+	name         string
+}
+
+func (e *notFoundError) Error() string {
+	return fmt.Sprintf("No %s named %q was found", e.resourceType, e.name)
+}
+
+// ambiguousNameError is returned when several resources have the name being
+// looked up.
+type ambiguousNameError struct {
+	resourceType string
+	name         string
+	matches      []NameMatch
+}
+
+func (e *ambiguousNameError) Error() string {
+	candidates := make([]string, 0, len(e.matches))
+	for _, m := range e.matches {
+		switch {
+		case m.Scope == nil:
+			candidates = append(candidates, m.Id)
+		case m.Scope.Name != "":
+			candidates = append(candidates, fmt.Sprintf("%s (in scope %s %q)", m.Id, m.Scope.Id, m.Scope.Name))
+		default:
+			candidates = append(candidates, fmt.Sprintf("%s (in scope %s)", m.Id, m.Scope.Id))
+		}
+	}
+	return fmt.Sprintf("More than one %s is named %q; choose one with -id, or narrow the lookup with -scope-id or -scope-name: %s",
+		e.resourceType, e.name, strings.Join(candidates, ", "))
+}
+
+func selectNameMatch(resourceType, name, scopeId string, matches []NameMatch) (string, error) {
+	switch len(matches) {
+	case 0:
+		return "", &notFoundError{resourceType: resourceType, name: name}
+	case 1:
+		return matches[0].Id, nil
+	}
+	if scopeId != "" {
+		// Names are unique within a scope, so at most one of the matches is
+		// in the scope itself.
+		for _, m := range matches {
+			if m.Scope != nil && m.Scope.Id == scopeId {
+				return m.Id, nil
+			}
+		}
+	}
+	return "", &ambiguousNameError{resourceType: resourceType, name: name, matches: matches}
+}
+
+func printLookupError(c *base.Command, resourceType string, err error) int {
+	if apiErr := api.AsServerError(err); apiErr != nil {
+		c.PrintApiError(apiErr, fmt.Sprintf("Error from controller when looking up the %s by name", resourceType))
+		return base.CommandApiError
+	}
+	switch err.(type) {
+	case *notFoundError, *ambiguousNameError:
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+	c.PrintCliError(fmt.Errorf("Error trying to look up the %s by name: %w", resourceType, err))
+	return base.CommandCliError
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package common
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameFilter(t *testing.T) {
+	for _, name := range []string{"prod-db", `with "quotes"`, `back\slash`} {
+		eval, err := bexpr.CreateEvaluator(NameFilter(name))
+		require.NoError(t, err, name)
+
+		match, err := eval.Evaluate(map[string]any{"item": map[string]any{"name": name}})
+		require.NoError(t, err, name)
+		assert.True(t, match, name)
+
+		match, err = eval.Evaluate(map[string]any{"item": map[string]any{"name": name + "-2"}})
+		require.NoError(t, err, name)
+		assert.False(t, match, name)
+	}
+}
+
+func TestSelectNameMatch(t *testing.T) {
+	org := &scopes.ScopeInfo{Id: "o_1234567890", Name: "payments"}
+	proj := &scopes.ScopeInfo{Id: "p_1234567890"}
+	orgMatch := NameMatch{Id: "ttcp_1111111111", Scope: org}
+	projMatch := NameMatch{Id: "ttcp_2222222222", Scope: proj}
+
+	tests := []struct {
+		name    string
+		scopeId string
+		matches []NameMatch
+		wantId  string
+		wantErr string
+	}{
+		{
+			name:    "none",
+			wantErr: `No target named "prod-db" was found`,
+		},
+		{
+			name:    "one",
+			matches: []NameMatch{projMatch},
+			wantId:  projMatch.Id,
+		},
+		{
+			name:    "in-scope-first",
+			scopeId: org.Id,
+			matches: []NameMatch{projMatch, orgMatch},
+			wantId:  orgMatch.Id,
+		},
+		{
+			name:    "ambiguous",
+			scopeId: "global",
+			matches: []NameMatch{orgMatch, projMatch},
+			wantErr: `More than one target is named "prod-db"; choose one with -id, or narrow the lookup with -scope-id or -scope-name: ttcp_1111111111 (in scope o_1234567890 "payments"), ttcp_2222222222 (in scope p_1234567890)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := selectNameMatch("target", "prod-db", tt.scopeId, tt.matches)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantId, id)
+		})
+	}
+}
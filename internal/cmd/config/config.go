@@ -7,14 +7,19 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net"
+	"net/netip"
 	"os"
+	"path/filepath"
 	"reflect"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -22,11 +27,20 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/event"
+	intglobals "github.com/hashicorp/boundary/internal/globals"
+	"github.com/hashicorp/boundary/internal/libs/secretscan"
+	"github.com/hashicorp/boundary/internal/libs/selfupdate"
+	"github.com/hashicorp/boundary/internal/pagination/estimate"
 	"github.com/hashicorp/boundary/internal/ratelimit"
+	"github.com/hashicorp/boundary/internal/scopequota"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/storage"
 	"github.com/hashicorp/boundary/internal/util"
+	boundary_plugin_assets "github.com/hashicorp/boundary/plugins/boundary"
 	kms_plugin_assets "github.com/hashicorp/boundary/plugins/kms"
+	external_plugins "github.com/hashicorp/boundary/sdk/plugins"
 	"github.com/hashicorp/boundary/sdk/wrapper"
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-hclog"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	configutil "github.com/hashicorp/go-secure-stdlib/configutil/v2"
@@ -37,10 +51,19 @@ import (
 	"github.com/hashicorp/hcl"
 	"github.com/hashicorp/hcl/hcl/ast"
 	"github.com/mitchellh/mapstructure"
+	"golang.org/x/net/http/httpguts"
 )
 
 var extraParsingFuncs []func(*Config) error
 
+// DefaultProfilingSignalWindow is how long the profiling endpoints of the ops
+// listeners are served after SIGUSR2 is received, if not configured.
+const DefaultProfilingSignalWindow = 5 * time.Minute
+
+// defaultStaleWorkersRevivalWindow is how long a retired worker can report its
+// status again before it is deleted, if not configured.
+const defaultStaleWorkersRevivalWindow = 7 * 24 * time.Hour
+
 const (
 	desktopCorsOrigin = "serve://boundary"
 
@@ -242,8 +265,26 @@ type Config struct {
 	// toggled with SIGHUP.
 	EnableWorkerAuthDebugging bool `hcl:"enable_worker_auth_debugging"`
 
+	// Whether to serve the pprof and runtime trace endpoints under
+	// /debug/pprof/ on ops listeners. Profiles may contain sensitive
+	// information, so this should only be enabled for debugging purposes. It
+	// can be toggled with SIGHUP.
+	EnableProfiling bool `hcl:"enable_profiling"`
+
+	// ProfilingSignalWindow is how long the profiling endpoints are served
+	// after the server receives SIGUSR2, when they are not otherwise enabled.
+	// If unset, DefaultProfilingSignalWindow is used; a negative value
+	// disables enabling profiling with SIGUSR2.
+	ProfilingSignalWindow         any           `hcl:"profiling_signal_window"`
+	ProfilingSignalWindowDuration time.Duration `hcl:"-"`
+
 	// For opting out of license utilization reporting
 	Reporting Reporting `hcl:"reporting"`
+
+	// SelfMonitor configures the monitoring of goroutines, open file
+	// descriptors and the proxy connection table of the controller and
+	// worker.
+	SelfMonitor SelfMonitor `hcl:"self_monitor"`
 }
 
 type Controller struct {
@@ -253,6 +294,11 @@ type Controller struct {
 	PublicClusterAddr string    `hcl:"public_cluster_addr"`
 	Scheduler         Scheduler `hcl:"scheduler"`
 
+	// PublicTunnelAddr is the address clients reach the listener with the
+	// tunnel purpose at, for sessions routed through the reverse tunnels of
+	// workers. Defaults to the address of the tunnel listener.
+	PublicTunnelAddr string `hcl:"public_tunnel_addr"`
+
 	// AuthTokenTimeToLive is the total valid lifetime of a token denoted by time.Duration
 	AuthTokenTimeToLive         any           `hcl:"auth_token_time_to_live"`
 	AuthTokenTimeToLiveDuration time.Duration `hcl:"-"`
@@ -268,6 +314,13 @@ type Controller struct {
 	GracefulShutdownWait         any           `hcl:"graceful_shutdown_wait_duration"`
 	GracefulShutdownWaitDuration time.Duration `hcl:"-"`
 
+	// GracefulShutdownDrain is the maximum amount of time that the Controller
+	// waits for in-flight API requests and running scheduler jobs to finish
+	// when shutting down, after it stops accepting new API requests. Draining
+	// is disabled if unset.
+	GracefulShutdownDrain         any           `hcl:"graceful_shutdown_drain_duration"`
+	GracefulShutdownDrainDuration time.Duration `hcl:"-"`
+
 	// WorkerStatusGracePeriod represents the period of time (as a duration)
 	// that the controller will wait before deciding a worker is disconnected
 	// and marking connections from it as canceling
@@ -304,6 +357,10 @@ type Controller struct {
 	ApiRateLimiterMaxQuotas int               `hcl:"api_rate_limit_max_quotas"`
 	ApiRateLimitDisable     bool              `hcl:"api_rate_limit_disable"`
 
+	// ApiScopeQuotas are the api request quotas of the org scopes, parsed
+	// from the api_scope_quota blocks.
+	ApiScopeQuotas scopequota.Configs `hcl:"-"`
+
 	// License is the license used by HCP builds
 	License string `hcl:"license"`
 
@@ -314,6 +371,51 @@ type Controller struct {
 	// it is rejected by the controller.
 	MaxPageSizeRaw any  `hcl:"max_page_size"`
 	MaxPageSize    uint `hcl:"-"`
+
+	// EstimatedCountRefreshIntervals sets, per resource type, how often the
+	// database statistics used to compute the estimated item count of list
+	// responses are refreshed. Keys are resource type names such as "target"
+	// or "session". Resource types that are not set rely on the database's
+	// own statistics collection.
+	EstimatedCountRefreshIntervals         map[string]any           `hcl:"estimated_count_refresh_intervals"`
+	EstimatedCountRefreshIntervalDurations map[string]time.Duration `hcl:"-"`
+
+	// StaleWorkers enables the retirement and eventual deletion of workers
+	// that have not reported their status for a long time.
+	StaleWorkers *StaleWorkers `hcl:"stale_workers"`
+
+	// TerminatedSessionRetention is the period of time (as a duration) a
+	// session is kept after it is terminated, before it is deleted along
+	// with its connections. If unset, it defaults to one hour.
+	TerminatedSessionRetention         any           `hcl:"terminated_session_retention"`
+	TerminatedSessionRetentionDuration time.Duration `hcl:"-"`
+
+	// WorkerSelection sets how the workers that can handle a session are
+	// ordered when it is authorized: "least-loaded", the default, orders them
+	// by the load they last reported, "random" orders them randomly, and
+	// "sticky" prefers the worker used by the same user for the same target.
+	WorkerSelection string `hcl:"worker_selection"`
+
+	// SecretsScanning sets what happens when a create or update request puts
+	// what looks like a plaintext secret into a field not meant for secrets,
+	// such as a description: "warn", the default, emits an event, "block"
+	// emits an event and rejects the request, and "disabled" skips scanning.
+	SecretsScanning string `hcl:"secrets_scanning"`
+}
+
+// StaleWorkers is the configuration block that enables the retirement and
+// deletion of workers that have not reported their status for a long time.
+type StaleWorkers struct {
+	// RetireAfter is the period of time (as a duration) after which a worker
+	// that has not reported its status is retired. Required.
+	RetireAfter         any           `hcl:"retire_after"`
+	RetireAfterDuration time.Duration `hcl:"-"`
+
+	// RevivalWindow is the period of time (as a duration) a retired worker
+	// can report its status again before it is deleted. If unset, it defaults
+	// to 7 days.
+	RevivalWindow         any           `hcl:"revival_window"`
+	RevivalWindowDuration time.Duration `hcl:"-"`
 }
 
 func (c *Controller) InitNameIfEmpty(ctx context.Context) error {
@@ -338,6 +440,22 @@ type Worker struct {
 	Description string `hcl:"description"`
 	PublicAddr  string `hcl:"public_addr"`
 
+	// AdditionalPublicAddrs are addresses, such as private or mesh network
+	// names, at which clients can reach the worker when the public address is
+	// not reachable from their network. Clients try the public address first
+	// and then these addresses in the order given.
+	AdditionalPublicAddrs []string `hcl:"additional_public_addrs"`
+
+	// ReverseTunnelTargetIds are the ids of targets the worker registers
+	// reverse tunnels for with its controller. Session connections to these
+	// targets are routed through the controller and the worker's connection to
+	// it, so the worker needs no inbound connectivity.
+	ReverseTunnelTargetIds []string `hcl:"reverse_tunnel_target_ids"`
+
+	// Locality is the locality, such as a region, the worker is deployed in.
+	// Sessions to targets with the same locality prefer this worker.
+	Locality string `hcl:"locality"`
+
 	// We use a raw interface here so that we can take in a string
 	// value pointing to an env var or file. We then resolve that
 	// and get the actual upstream controller or worker addresses.
@@ -400,6 +518,215 @@ type Worker struct {
 	// pre-0.13 method of using KMSes to authenticate. This is currently only
 	// supported to throw an error if used telling people they need to upgrade.
 	UseDeprecatedKmsAuthMethod bool `hcl:"use_deprecated_kms_auth_method"`
+
+	// ConnectionEvents configures the emission of an event when each proxied
+	// connection starts and ends. It can be changed with SIGHUP.
+	ConnectionEvents *ConnectionEvents `hcl:"connection_events"`
+
+	// Dns configures how the worker resolves the hostnames of targets. It
+	// takes precedence over the -worker-dns-server flag, and can be changed
+	// with SIGHUP.
+	Dns *Dns `hcl:"dns"`
+
+	// Egress binds the connections the worker makes to the endpoints of
+	// sessions to a source address. Blocks are evaluated in order and the
+	// first whose filter matches the session is used. It can be changed with
+	// SIGHUP.
+	Egress []*WorkerEgress `hcl:"-"`
+
+	// ConnectionLiveness tunes TCP keepalives, half-open connection detection
+	// and the maximum lifetime of the connections the worker proxies. It can
+	// be changed with SIGHUP.
+	ConnectionLiveness *ConnectionLiveness `hcl:"connection_liveness"`
+
+	// AccountProvisioning configures a command which creates or enables a
+	// local account on the endpoint of a session right before its first
+	// connection, and disables it once the session ended. It can be changed
+	// with SIGHUP.
+	AccountProvisioning *AccountProvisioning `hcl:"account_provisioning"`
+
+	// AutoUpdate configures the worker to update its own binary from an
+	// artifact endpoint, draining its sessions before installing an update.
+	AutoUpdate *AutoUpdate `hcl:"auto_update"`
+}
+
+// AutoUpdate is the configuration block of the self-update of a worker. The
+// worker periodically fetches a signed manifest of the latest version, and
+// stages, installs and restarts into newer versions.
+type AutoUpdate struct {
+	// ManifestUrl is the URL of the manifest. Its Ed25519 signature is
+	// fetched from the same URL with a ".sig" suffix.
+	ManifestUrl string `hcl:"manifest_url"`
+
+	// PublicKey is the Ed25519 key verifying the signature of the manifest,
+	// PEM encoded or the base64 encoding of the raw key. It can be a path or
+	// env var.
+	PublicKey string `hcl:"public_key"`
+
+	// CheckInterval is how often the manifest is checked. Defaults to an
+	// hour.
+	CheckInterval         any           `hcl:"check_interval"`
+	CheckIntervalDuration time.Duration `hcl:"-"`
+
+	// DrainTimeout is how long the worker waits for its connections to end
+	// before installing an update; connections still open are then closed.
+	// Defaults to an hour.
+	DrainTimeout         any           `hcl:"drain_timeout"`
+	DrainTimeoutDuration time.Duration `hcl:"-"`
+
+	// StagingPath is the directory updates are downloaded to. It must be on
+	// the same file system as the worker's binary, and defaults to the
+	// directory of the binary.
+	StagingPath string `hcl:"staging_path"`
+}
+
+// AccountProvisioning is the configuration block of the command a worker runs
+// to provision just-in-time accounts for sessions. The command reads a JSON
+// document describing the session and the action, "provision" or
+// "deprovision", from its standard input.
+type AccountProvisioning struct {
+	// Command is the path of the command followed by its arguments.
+	Command []string `hcl:"command"`
+
+	// Timeout is how long the command may run before it's killed. Defaults to
+	// 30 seconds.
+	Timeout         any           `hcl:"timeout"`
+	TimeoutDuration time.Duration `hcl:"-"`
+
+	// Filter is a boolean expression evaluated against the session, e.g.
+	// `"/session/type" == "ssh"`; accounts are only provisioned for the
+	// sessions it matches. A block without a filter matches all sessions.
+	Filter string `hcl:"filter"`
+}
+
+// ConnectionLivenessSettings tunes the liveness of proxied connections. Unset
+// values leave the defaults of the OS in place.
+type ConnectionLivenessSettings struct {
+	// TcpKeepaliveIdle is how long a connection to an endpoint is idle before
+	// keepalive probes are sent.
+	TcpKeepaliveIdle         any           `hcl:"tcp_keepalive_idle"`
+	TcpKeepaliveIdleDuration time.Duration `hcl:"-"`
+
+	// TcpKeepaliveInterval is the time between keepalive probes.
+	TcpKeepaliveInterval         any           `hcl:"tcp_keepalive_interval"`
+	TcpKeepaliveIntervalDuration time.Duration `hcl:"-"`
+
+	// TcpKeepaliveCount is the number of unanswered keepalive probes after
+	// which a connection is dropped.
+	TcpKeepaliveCount int `hcl:"tcp_keepalive_count"`
+
+	// TcpUserTimeout is how long data sent on a connection to an endpoint can
+	// remain unacknowledged before the connection is dropped, which detects
+	// half-open connections. Only supported on Linux.
+	TcpUserTimeout         any           `hcl:"tcp_user_timeout"`
+	TcpUserTimeoutDuration time.Duration `hcl:"-"`
+
+	// MaxConnectionLifetime is how long a proxied connection is kept open
+	// before it's closed, even if the session has not expired.
+	MaxConnectionLifetime         any           `hcl:"max_connection_lifetime"`
+	MaxConnectionLifetimeDuration time.Duration `hcl:"-"`
+}
+
+// ConnectionLivenessTarget overrides the liveness settings for the connections
+// of a target. Values it doesn't set are inherited from the worker's settings.
+type ConnectionLivenessTarget struct {
+	ConnectionLivenessSettings `hcl:",squash"`
+
+	// TargetId is the id of the target the settings are used for.
+	TargetId string `hcl:"target_id"`
+}
+
+// ConnectionLiveness is the configuration block that tunes the liveness of
+// the connections proxied by a worker.
+type ConnectionLiveness struct {
+	ConnectionLivenessSettings `hcl:",squash"`
+
+	Targets []*ConnectionLivenessTarget `hcl:"-"`
+}
+
+// WorkerEgress specifies the source address of connections to the endpoints
+// of sessions matching its filter.
+type WorkerEgress struct {
+	// SourceAddress is the IP address connections are dialed from.
+	SourceAddress string `hcl:"source_address"`
+
+	// Interface is the name of the network interface whose address
+	// connections are dialed from. Exactly one of SourceAddress and Interface
+	// must be set.
+	Interface string `hcl:"interface"`
+
+	// Filter is a boolean expression evaluated against the target id and
+	// scope id, host id, host set id, and endpoint host and port of the
+	// session, e.g. `"/target/id" == "ttcp_1234567890"`. A block without a
+	// filter matches all sessions.
+	Filter string `hcl:"filter"`
+}
+
+// Supported values of DnsResolver.Resolver.
+const (
+	DnsResolverSystem = "system"
+	DnsResolverCustom = "custom"
+	DnsResolverDoT    = "dot"
+)
+
+// DnsResolver specifies the DNS resolver a worker uses to resolve the
+// hostnames of targets.
+type DnsResolver struct {
+	// Resolver is one of "system", which uses the resolver of the host OS,
+	// "custom", which queries Servers over UDP, or "dot", which queries
+	// Servers over TLS. Defaults to "system".
+	Resolver string `hcl:"resolver"`
+
+	// Servers are the addresses of the DNS servers queried, in order, by the
+	// custom and dot resolvers. Ports default to 53 for custom and 853 for dot.
+	Servers []string `hcl:"servers"`
+
+	// TlsServerName is the name used to verify the certificate of DoT
+	// servers. Defaults to the host of each server.
+	TlsServerName string `hcl:"tls_server_name"`
+
+	// Timeout is the timeout of each DNS query.
+	Timeout         any           `hcl:"timeout"`
+	TimeoutDuration time.Duration `hcl:"-"`
+}
+
+// DnsSplitHorizon overrides the resolver for hostnames in a domain.
+type DnsSplitHorizon struct {
+	DnsResolver `hcl:",squash"`
+
+	// Domain is the domain the resolver is used for, including its
+	// subdomains.
+	Domain string `hcl:"domain"`
+}
+
+// DnsTarget overrides the resolver for the hosts of a target.
+type DnsTarget struct {
+	DnsResolver `hcl:",squash"`
+
+	// TargetId is the id of the target the resolver is used for.
+	TargetId string `hcl:"target_id"`
+}
+
+// Dns is the configuration block that specifies how a worker resolves the
+// hostnames of targets. A resolver set for the target of a session takes
+// precedence over the resolver of the most specific split_horizon domain
+// matching the hostname, which takes precedence over the default resolver.
+type Dns struct {
+	DnsResolver `hcl:",squash"`
+
+	SplitHorizon []*DnsSplitHorizon `hcl:"-"`
+	Targets      []*DnsTarget       `hcl:"-"`
+}
+
+// ConnectionEvents is the configuration block that specifies the emission of
+// per-connection proxy events by a worker.
+type ConnectionEvents struct {
+	// Enabled turns on per-connection events.
+	Enabled bool `hcl:"enabled"`
+
+	// SamplePercent is the percentage of connections events are emitted for,
+	// from 1 to 100. Defaults to 100 when not set.
+	SamplePercent int `hcl:"sample_percent"`
 }
 
 type Database struct {
@@ -436,6 +763,76 @@ type Scheduler struct {
 
 type Plugins struct {
 	ExecutionDir string `hcl:"execution_dir"`
+
+	// Directory is a local directory the host and storage plugins are loaded
+	// from, instead of the plugins embedded in the binary, which are then
+	// never extracted. Each plugin is named as its embedded counterpart, e.g.
+	// "boundary-plugin-aws", and must have a checksum in Checksums. It can be
+	// a path or env var.
+	Directory string `hcl:"directory"`
+
+	// Checksums are the hex encoded SHA256 checksums of the plugins in
+	// Directory, keyed by plugin type, e.g. "aws". A plugin without a
+	// checksum, or whose binary doesn't match it, is not executed.
+	Checksums map[string]string `hcl:"checksums"`
+
+	checksums map[string][]byte
+
+	// Sandbox runs the host and storage plugins with reduced privileges.
+	Sandbox *PluginSandbox `hcl:"sandbox"`
+}
+
+// PluginSandbox is the configuration block reducing the privileges of plugin
+// processes. Which settings are supported depends on the platform.
+type PluginSandbox struct {
+	// User is the name or uid of the user plugins run as. Not supported on
+	// Windows.
+	User string `hcl:"user"`
+
+	// Group is the name or gid of the group plugins run as. Boundary must be
+	// a member of it. Defaults to the primary group of User. Not supported on
+	// Windows.
+	Group string `hcl:"group"`
+
+	// AppArmorProfile is the loaded AppArmor profile plugins are confined
+	// by. Linux only.
+	AppArmorProfile string `hcl:"apparmor_profile"`
+
+	// SeccompFilter is the path of a compiled seccomp BPF program filtering
+	// the system calls of plugins. Linux only.
+	SeccompFilter string `hcl:"seccomp_filter"`
+
+	// MemoryLimit is the memory each plugin process can commit, e.g.
+	// "512MiB". Windows only.
+	MemoryLimit      any    `hcl:"memory_limit"`
+	MemoryLimitBytes uint64 `hcl:"-"`
+
+	// ProcessLimit is the number of processes a plugin can run, itself
+	// included. Windows only.
+	ProcessLimit uint32 `hcl:"process_limit"`
+}
+
+type SelfMonitor struct {
+	// Disable turns off self-monitoring.
+	Disable bool `hcl:"disable"`
+
+	// Interval is how often readings are taken.
+	Interval         any           `hcl:"interval"`
+	IntervalDuration time.Duration `hcl:"-"`
+
+	// GoroutineThreshold is the number of goroutines above which a warning
+	// event is emitted. Zero uses the default; a negative value disables the
+	// warning.
+	GoroutineThreshold int `hcl:"goroutine_threshold"`
+
+	// OpenFilesThresholdPercent is the percentage of the open file descriptor
+	// limit above which a warning event is emitted. Zero uses the default; a
+	// negative value disables the warning.
+	OpenFilesThresholdPercent int `hcl:"open_files_threshold_percent"`
+
+	// ProxyConnectionThreshold is the size of the worker's proxy connection
+	// table above which a warning event is emitted. Zero disables the warning.
+	ProxyConnectionThreshold int64 `hcl:"proxy_connection_threshold"`
 }
 
 type Reporting struct {
@@ -705,6 +1102,14 @@ func Parse(d string) (*Config, error) {
 			result.Controller.GracefulShutdownWaitDuration = t
 		}
 
+		if result.Controller.GracefulShutdownDrain != "" {
+			t, err := parseutil.ParseDurationSecond(result.Controller.GracefulShutdownDrain)
+			if err != nil {
+				return result, err
+			}
+			result.Controller.GracefulShutdownDrainDuration = t
+		}
+
 		if result.Controller.Scheduler.JobRunInterval != "" {
 			t, err := parseutil.ParseDurationSecond(result.Controller.Scheduler.JobRunInterval)
 			if err != nil {
@@ -791,6 +1196,50 @@ func Parse(d string) (*Config, error) {
 			}
 		}
 
+		if len(result.Controller.EstimatedCountRefreshIntervals) > 0 {
+			result.Controller.EstimatedCountRefreshIntervalDurations = make(map[string]time.Duration, len(result.Controller.EstimatedCountRefreshIntervals))
+			for name, raw := range result.Controller.EstimatedCountRefreshIntervals {
+				if !estimate.ValidResourceType(name) {
+					return nil, fmt.Errorf("Estimated count refresh interval: unsupported resource type %q", name)
+				}
+				t, err := parseutil.ParseDurationSecond(raw)
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing estimated count refresh interval for %q: %w", name, err)
+				}
+				if t <= 0 {
+					return nil, fmt.Errorf("Estimated count refresh interval for %q must be greater than 0", name)
+				}
+				result.Controller.EstimatedCountRefreshIntervalDurations[name] = t
+			}
+		}
+
+		if !util.IsNil(result.Controller.TerminatedSessionRetention) {
+			t, err := parseutil.ParseDurationSecond(result.Controller.TerminatedSessionRetention)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing controller terminated session retention: %w", err)
+			}
+			if t <= 0 {
+				return nil, errors.New("Controller terminated session retention must be greater than 0")
+			}
+			result.Controller.TerminatedSessionRetentionDuration = t
+		}
+
+		if result.Controller.WorkerSelection != "" && !server.ValidWorkerSelection(result.Controller.WorkerSelection) {
+			return nil, fmt.Errorf("Controller worker selection %q is invalid, must be %q, %q or %q",
+				result.Controller.WorkerSelection, server.LeastLoadedWorkerSelection, server.RandomWorkerSelection, server.StickyWorkerSelection)
+		}
+
+		if result.Controller.SecretsScanning != "" && !secretscan.ValidMode(result.Controller.SecretsScanning) {
+			return nil, fmt.Errorf("Controller secrets scanning %q is invalid, must be %q, %q or %q",
+				result.Controller.SecretsScanning, secretscan.WarnMode, secretscan.BlockMode, secretscan.DisabledMode)
+		}
+
+		if result.Controller.StaleWorkers != nil {
+			if err := result.Controller.StaleWorkers.parse(); err != nil {
+				return nil, fmt.Errorf("Error parsing controller stale workers: %w", err)
+			}
+		}
+
 		if result.Controller.Database != nil {
 			if result.Controller.Database.MaxOpenConnectionsRaw != nil {
 				switch t := result.Controller.Database.MaxOpenConnectionsRaw.(type) {
@@ -856,6 +1305,11 @@ func Parse(d string) (*Config, error) {
 		if result.Controller.ApiRateLimiterMaxQuotas <= 0 {
 			result.Controller.ApiRateLimiterMaxQuotas = ratelimit.DefaultLimiterMaxQuotas()
 		}
+
+		result.Controller.ApiScopeQuotas, err = parseApiScopeQuotas(obj.Node)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Parse worker tags
@@ -883,6 +1337,14 @@ func Parse(d string) (*Config, error) {
 			return nil, errors.New("Worker description contains non-printable characters")
 		}
 
+		result.Worker.Locality, err = parseutil.ParsePath(result.Worker.Locality)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			return nil, fmt.Errorf("Error parsing worker locality: %w", err)
+		}
+		if !strutil.Printable(result.Worker.Locality) {
+			return nil, errors.New("Worker locality contains non-printable characters")
+		}
+
 		result.Worker.ControllerGeneratedActivationToken, err = parseutil.ParsePath(result.Worker.ControllerGeneratedActivationToken)
 		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
 			return nil, fmt.Errorf("Error parsing worker activation token: %w", err)
@@ -933,6 +1395,49 @@ func Parse(d string) (*Config, error) {
 			return nil, errors.New("Successful status grace period value is negative")
 		}
 
+		if ce := result.Worker.ConnectionEvents; ce != nil {
+			if ce.SamplePercent < 0 || ce.SamplePercent > 100 {
+				return nil, fmt.Errorf("Worker connection events sample percent must be between 1 and 100, got %d", ce.SamplePercent)
+			}
+		}
+
+		if result.Worker.Dns != nil {
+			result.Worker.Dns.SplitHorizon, result.Worker.Dns.Targets, err = parseWorkerDnsOverrides(obj.Node)
+			if err != nil {
+				return nil, err
+			}
+			if err := result.Worker.Dns.parse(); err != nil {
+				return nil, fmt.Errorf("Error parsing worker dns: %w", err)
+			}
+		}
+
+		result.Worker.Egress, err = parseWorkerEgress(obj.Node)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Worker.ConnectionLiveness != nil {
+			result.Worker.ConnectionLiveness.Targets, err = parseWorkerConnectionLivenessTargets(obj.Node)
+			if err != nil {
+				return nil, err
+			}
+			if err := result.Worker.ConnectionLiveness.parse(); err != nil {
+				return nil, fmt.Errorf("Error parsing worker connection liveness: %w", err)
+			}
+		}
+
+		if result.Worker.AccountProvisioning != nil {
+			if err := result.Worker.AccountProvisioning.parse(); err != nil {
+				return nil, fmt.Errorf("Error parsing worker account provisioning: %w", err)
+			}
+		}
+
+		if result.Worker.AutoUpdate != nil {
+			if err := result.Worker.AutoUpdate.parse(); err != nil {
+				return nil, fmt.Errorf("Error parsing worker auto update: %w", err)
+			}
+		}
+
 		if !util.IsNil(result.Worker.RecordingStorageMinimumAvailableCapacity) {
 			if result.Worker.RecordingStoragePath == "" {
 				return nil, errors.New("recording_storage_path cannot be empty when providing recording_storage_minimum_available_capacity")
@@ -1108,6 +1613,12 @@ func Parse(d string) (*Config, error) {
 		}
 	}
 
+	for _, listener := range result.SharedConfig.Listeners {
+		if err := validateClientIdentityHeader(listener); err != nil {
+			return nil, err
+		}
+	}
+
 	list, ok := obj.Node.(*ast.ObjectList)
 	if !ok {
 		return nil, fmt.Errorf("error parsing: file doesn't contain a root object")
@@ -1125,12 +1636,40 @@ func Parse(d string) (*Config, error) {
 		return nil, fmt.Errorf(`too many "events" nodes (max 1, got %d)`, len(eventList.Items))
 	}
 
+	if result.SelfMonitor.Interval != nil {
+		t, err := parseutil.ParseDurationSecond(result.SelfMonitor.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing self_monitor interval: %w", err)
+		}
+		if t <= 0 {
+			return nil, fmt.Errorf("self_monitor interval must be greater than zero")
+		}
+		result.SelfMonitor.IntervalDuration = t
+	}
+	if result.SelfMonitor.OpenFilesThresholdPercent > 100 {
+		return nil, fmt.Errorf("self_monitor open_files_threshold_percent must be at most 100")
+	}
+	if result.SelfMonitor.ProxyConnectionThreshold < 0 {
+		return nil, fmt.Errorf("self_monitor proxy_connection_threshold must not be negative")
+	}
+
+	if result.ProfilingSignalWindow != nil {
+		t, err := parseutil.ParseDurationSecond(result.ProfilingSignalWindow)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing profiling_signal_window: %w", err)
+		}
+		result.ProfilingSignalWindowDuration = t
+	}
+
 	if result.Plugins.ExecutionDir != "" {
 		result.Plugins.ExecutionDir, err = parseutil.ParsePath(result.Plugins.ExecutionDir)
 		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
 			return nil, fmt.Errorf("Error parsing plugins execution dir: %w", err)
 		}
 	}
+	if err := result.Plugins.parse(); err != nil {
+		return nil, fmt.Errorf("Error parsing plugins: %w", err)
+	}
 
 	for _, f := range extraParsingFuncs {
 		if err := f(result); err != nil {
@@ -1141,6 +1680,29 @@ func Parse(d string) (*Config, error) {
 	return result, nil
 }
 
+// validateClientIdentityHeader checks the client identity header option of a
+// listener. The header carries the identity of the verified client
+// certificate, so it is only valid on api listeners that require client
+// certificates issued by a configured CA.
+func validateClientIdentityHeader(l *listenerutil.ListenerConfig) error {
+	raw, ok := l.RawConfig[intglobals.ClientIdentityHeaderKey]
+	if !ok {
+		return nil
+	}
+	header, ok := raw.(string)
+	switch {
+	case !ok || !httpguts.ValidHeaderFieldName(header):
+		return fmt.Errorf("Listener %s %q is not a valid header name", intglobals.ClientIdentityHeaderKey, raw)
+	case !strutil.StrListContains(l.Purpose, "api"):
+		return fmt.Errorf("Listener %s is only supported on api listeners", intglobals.ClientIdentityHeaderKey)
+	case l.TLSDisable || !l.TLSRequireAndVerifyClientCert:
+		return fmt.Errorf("Listener %s requires tls_require_and_verify_client_cert to be enabled", intglobals.ClientIdentityHeaderKey)
+	case l.TLSClientCAFile == "":
+		return fmt.Errorf("Listener %s requires tls_client_ca_file to be set", intglobals.ClientIdentityHeaderKey)
+	}
+	return nil
+}
+
 func parseApiRateLimits(node ast.Node) (ratelimit.Configs, error) {
 	list, ok := node.(*ast.ObjectList)
 	if !ok {
@@ -1173,6 +1735,390 @@ func parseApiRateLimits(node ast.Node) (ratelimit.Configs, error) {
 	return configs, nil
 }
 
+func parseApiScopeQuotas(node ast.Node) (scopequota.Configs, error) {
+	list, ok := node.(*ast.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("error parsing: file doesn't contain a root object")
+	}
+	controllerList := list.Filter("controller")
+
+	var configs scopequota.Configs
+	for _, item := range controllerList.Items {
+		controller, ok := item.Val.(*ast.ObjectType)
+		if !ok {
+			return nil, fmt.Errorf("error parsing: file doesn't contain controller object")
+		}
+		apiScopeQuotasList := controller.List.Filter("api_scope_quota")
+
+		var err error
+		for i, item := range apiScopeQuotasList.Items {
+			var q scopequota.Config
+			if err := hcl.DecodeObject(&q, item.Val); err != nil {
+				return nil, fmt.Errorf("error decoding controller api_scope_quota entry %d", i)
+			}
+			q.Period, err = parseutil.ParseDurationSecond(q.PeriodHCL)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding controller api_scope_quota period for entry %d", i)
+			}
+			configs = append(configs, &q)
+		}
+	}
+
+	return configs, nil
+}
+
+// parseWorkerDnsOverrides decodes the split_horizon and target blocks of the
+// worker's dns block, which can be repeated.
+func parseWorkerDnsOverrides(node ast.Node) ([]*DnsSplitHorizon, []*DnsTarget, error) {
+	list, ok := node.(*ast.ObjectList)
+	if !ok {
+		return nil, nil, fmt.Errorf("error parsing: file doesn't contain a root object")
+	}
+
+	var splitHorizons []*DnsSplitHorizon
+	var targets []*DnsTarget
+	for _, item := range list.Filter("worker").Items {
+		worker, ok := item.Val.(*ast.ObjectType)
+		if !ok {
+			return nil, nil, fmt.Errorf("error parsing: file doesn't contain worker object")
+		}
+		for _, item := range worker.List.Filter("dns").Items {
+			dns, ok := item.Val.(*ast.ObjectType)
+			if !ok {
+				return nil, nil, fmt.Errorf("error parsing: worker dns is not an object")
+			}
+			for i, item := range dns.List.Filter("split_horizon").Items {
+				var sh DnsSplitHorizon
+				if err := hcl.DecodeObject(&sh, item.Val); err != nil {
+					return nil, nil, fmt.Errorf("error decoding worker dns split_horizon entry %d: %w", i, err)
+				}
+				splitHorizons = append(splitHorizons, &sh)
+			}
+			for i, item := range dns.List.Filter("target").Items {
+				var t DnsTarget
+				if err := hcl.DecodeObject(&t, item.Val); err != nil {
+					return nil, nil, fmt.Errorf("error decoding worker dns target entry %d: %w", i, err)
+				}
+				targets = append(targets, &t)
+			}
+		}
+	}
+	return splitHorizons, targets, nil
+}
+
+// parseWorkerEgress decodes and validates the worker's egress blocks, which
+// can be repeated.
+func parseWorkerEgress(node ast.Node) ([]*WorkerEgress, error) {
+	list, ok := node.(*ast.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("error parsing: file doesn't contain a root object")
+	}
+
+	var egress []*WorkerEgress
+	for _, item := range list.Filter("worker").Items {
+		worker, ok := item.Val.(*ast.ObjectType)
+		if !ok {
+			return nil, fmt.Errorf("error parsing: file doesn't contain worker object")
+		}
+		for i, item := range worker.List.Filter("egress").Items {
+			var e WorkerEgress
+			if err := hcl.DecodeObject(&e, item.Val); err != nil {
+				return nil, fmt.Errorf("error decoding worker egress entry %d: %w", i, err)
+			}
+			switch {
+			case e.SourceAddress == "" && e.Interface == "":
+				return nil, fmt.Errorf("worker egress entry %d: one of source_address or interface must be set", i)
+			case e.SourceAddress != "" && e.Interface != "":
+				return nil, fmt.Errorf("worker egress entry %d: only one of source_address or interface can be set", i)
+			case e.SourceAddress != "":
+				if _, err := netip.ParseAddr(e.SourceAddress); err != nil {
+					return nil, fmt.Errorf("worker egress entry %d: invalid source_address: %w", i, err)
+				}
+			}
+			if e.Filter != "" {
+				if _, err := bexpr.CreateEvaluator(e.Filter); err != nil {
+					return nil, fmt.Errorf("worker egress entry %d: invalid filter: %w", i, err)
+				}
+			}
+			egress = append(egress, &e)
+		}
+	}
+	return egress, nil
+}
+
+// parseWorkerConnectionLivenessTargets decodes the target blocks of the
+// worker's connection_liveness block, which can be repeated.
+func parseWorkerConnectionLivenessTargets(node ast.Node) ([]*ConnectionLivenessTarget, error) {
+	list, ok := node.(*ast.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("error parsing: file doesn't contain a root object")
+	}
+
+	var targets []*ConnectionLivenessTarget
+	for _, item := range list.Filter("worker").Items {
+		worker, ok := item.Val.(*ast.ObjectType)
+		if !ok {
+			return nil, fmt.Errorf("error parsing: file doesn't contain worker object")
+		}
+		for _, item := range worker.List.Filter("connection_liveness").Items {
+			cl, ok := item.Val.(*ast.ObjectType)
+			if !ok {
+				return nil, fmt.Errorf("error parsing: worker connection_liveness is not an object")
+			}
+			for i, item := range cl.List.Filter("target").Items {
+				var t ConnectionLivenessTarget
+				if err := hcl.DecodeObject(&t, item.Val); err != nil {
+					return nil, fmt.Errorf("error decoding worker connection_liveness target entry %d: %w", i, err)
+				}
+				targets = append(targets, &t)
+			}
+		}
+	}
+	return targets, nil
+}
+
+func (c *ConnectionLiveness) parse() error {
+	if err := c.ConnectionLivenessSettings.parse(); err != nil {
+		return err
+	}
+	targets := make(map[string]bool, len(c.Targets))
+	for i, t := range c.Targets {
+		if t == nil {
+			continue
+		}
+		if t.TargetId == "" {
+			return fmt.Errorf("target %d: missing target_id", i)
+		}
+		if targets[t.TargetId] {
+			return fmt.Errorf("target %d: duplicate target_id %q", i, t.TargetId)
+		}
+		targets[t.TargetId] = true
+		if err := t.ConnectionLivenessSettings.parse(); err != nil {
+			return fmt.Errorf("target %q: %w", t.TargetId, err)
+		}
+	}
+	return nil
+}
+
+func (s *ConnectionLivenessSettings) parse() error {
+	for _, d := range []struct {
+		name string
+		raw  any
+		dst  *time.Duration
+	}{
+		{"tcp_keepalive_idle", s.TcpKeepaliveIdle, &s.TcpKeepaliveIdleDuration},
+		{"tcp_keepalive_interval", s.TcpKeepaliveInterval, &s.TcpKeepaliveIntervalDuration},
+		{"tcp_user_timeout", s.TcpUserTimeout, &s.TcpUserTimeoutDuration},
+		{"max_connection_lifetime", s.MaxConnectionLifetime, &s.MaxConnectionLifetimeDuration},
+	} {
+		if d.raw == nil {
+			continue
+		}
+		t, err := parseutil.ParseDurationSecond(d.raw)
+		if err != nil {
+			return fmt.Errorf("error parsing %s: %w", d.name, err)
+		}
+		if t < 0 {
+			return fmt.Errorf("%s cannot be negative", d.name)
+		}
+		*d.dst = t
+	}
+	if s.TcpKeepaliveCount < 0 {
+		return fmt.Errorf("tcp_keepalive_count cannot be negative")
+	}
+	return nil
+}
+
+func (a *AccountProvisioning) parse() error {
+	if len(a.Command) == 0 || a.Command[0] == "" {
+		return fmt.Errorf("command must be set")
+	}
+	if !util.IsNil(a.Timeout) {
+		t, err := parseutil.ParseDurationSecond(a.Timeout)
+		if err != nil {
+			return fmt.Errorf("error parsing timeout: %w", err)
+		}
+		if t < 0 {
+			return fmt.Errorf("timeout cannot be negative")
+		}
+		a.TimeoutDuration = t
+	}
+	if a.Filter != "" {
+		if _, err := bexpr.CreateEvaluator(a.Filter); err != nil {
+			return fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+	return nil
+}
+
+func (a *AutoUpdate) parse() error {
+	if a.ManifestUrl == "" {
+		return fmt.Errorf("manifest_url must be set")
+	}
+	if a.PublicKey == "" {
+		return fmt.Errorf("public_key must be set")
+	}
+	var err error
+	a.PublicKey, err = parseutil.ParsePath(a.PublicKey)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		return fmt.Errorf("error parsing public_key: %w", err)
+	}
+	if _, err := selfupdate.ParsePublicKey(a.PublicKey); err != nil {
+		return fmt.Errorf("invalid public_key: %w", err)
+	}
+	for _, d := range []struct {
+		name string
+		raw  any
+		dst  *time.Duration
+	}{
+		{"check_interval", a.CheckInterval, &a.CheckIntervalDuration},
+		{"drain_timeout", a.DrainTimeout, &a.DrainTimeoutDuration},
+	} {
+		if util.IsNil(d.raw) {
+			continue
+		}
+		t, err := parseutil.ParseDurationSecond(d.raw)
+		if err != nil {
+			return fmt.Errorf("error parsing %s: %w", d.name, err)
+		}
+		if t <= 0 {
+			return fmt.Errorf("%s must be greater than 0", d.name)
+		}
+		*d.dst = t
+	}
+	return nil
+}
+
+func (p *Plugins) parse() error {
+	if p.Sandbox != nil {
+		if err := p.Sandbox.parse(); err != nil {
+			return fmt.Errorf("error parsing sandbox: %w", err)
+		}
+		if (p.Sandbox.User != "" || p.Sandbox.Group != "") && p.ExecutionDir == "" {
+			// The default execution directory is private to Boundary
+			return fmt.Errorf("execution_dir must be set to run plugins as another user or group")
+		}
+	}
+	if p.Directory == "" {
+		if len(p.Checksums) > 0 {
+			return fmt.Errorf("checksums require directory to be set")
+		}
+		return nil
+	}
+	var err error
+	p.Directory, err = parseutil.ParsePath(p.Directory)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		return fmt.Errorf("error parsing directory: %w", err)
+	}
+	if len(p.Checksums) == 0 {
+		return fmt.Errorf("directory requires the checksums of its plugins")
+	}
+	p.checksums = make(map[string][]byte, len(p.Checksums))
+	for pluginType, sum := range p.Checksums {
+		b, err := hex.DecodeString(sum)
+		if err != nil || len(b) != sha256.Size {
+			return fmt.Errorf("checksum of plugin %q must be a hex encoded SHA256 checksum", pluginType)
+		}
+		p.checksums[strings.ToLower(pluginType)] = b
+	}
+	return nil
+}
+
+// PluginOptions returns the options locating the host or storage plugin of the
+// given type and sandboxing it, if configured. Plugins are extracted from the
+// binary, unless a directory is configured: the plugin is then executed from
+// there, only if it matches its pinned checksum.
+func (p *Plugins) PluginOptions(pluginType string) ([]external_plugins.Option, error) {
+	opts := []external_plugins.Option{external_plugins.WithSandbox(p.Sandbox.sandbox())}
+	plgOpts, err := p.pluginFileOptions(pluginType)
+	if err != nil {
+		return nil, err
+	}
+	return append(opts, external_plugins.WithPluginOptions(plgOpts...)), nil
+}
+
+func (p *Plugins) pluginFileOptions(pluginType string) ([]pluginutil.Option, error) {
+	opts := []pluginutil.Option{pluginutil.WithPluginExecutionDirectory(p.ExecutionDir)}
+	if p.Directory == "" {
+		return append(opts, pluginutil.WithPluginsFilesystem(boundary_plugin_assets.PluginPrefix, boundary_plugin_assets.FileSystem())), nil
+	}
+	pluginType = strings.ToLower(pluginType)
+	sum, ok := p.checksums[pluginType]
+	if !ok {
+		return nil, fmt.Errorf("no checksum configured for plugin %q in plugins directory", pluginType)
+	}
+	name := boundary_plugin_assets.PluginPrefix + pluginType
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	return append(opts, pluginutil.WithPluginFile(pluginutil.PluginFileInfo{
+		Name:       pluginType,
+		Path:       filepath.Join(p.Directory, name),
+		Checksum:   sum,
+		HashMethod: pluginutil.HashMethodSha2256,
+	})), nil
+}
+
+func (s *PluginSandbox) parse() error {
+	if !util.IsNil(s.MemoryLimit) {
+		limit, err := parseutil.ParseCapacityString(s.MemoryLimit)
+		if err != nil {
+			return fmt.Errorf("error parsing memory_limit: %w", err)
+		}
+		s.MemoryLimitBytes = limit
+	}
+	if s.SeccompFilter != "" {
+		var err error
+		s.SeccompFilter, err = parseutil.ParsePath(s.SeccompFilter)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			return fmt.Errorf("error parsing seccomp_filter: %w", err)
+		}
+	}
+	return s.sandbox().Validate()
+}
+
+// sandbox returns the sandbox of the plugin host, or nil if s is nil.
+func (s *PluginSandbox) sandbox() *external_plugins.Sandbox {
+	if s == nil {
+		return nil
+	}
+	return &external_plugins.Sandbox{
+		User:             s.User,
+		Group:            s.Group,
+		AppArmorProfile:  s.AppArmorProfile,
+		SeccompFilter:    s.SeccompFilter,
+		MemoryLimitBytes: s.MemoryLimitBytes,
+		ProcessLimit:     s.ProcessLimit,
+	}
+}
+
+func (s *StaleWorkers) parse() error {
+	if util.IsNil(s.RetireAfter) {
+		return fmt.Errorf("retire_after must be set")
+	}
+	t, err := parseutil.ParseDurationSecond(s.RetireAfter)
+	if err != nil {
+		return fmt.Errorf("error parsing retire_after: %w", err)
+	}
+	if t <= 0 {
+		return fmt.Errorf("retire_after must be greater than 0")
+	}
+	s.RetireAfterDuration = t
+
+	s.RevivalWindowDuration = defaultStaleWorkersRevivalWindow
+	if !util.IsNil(s.RevivalWindow) {
+		t, err := parseutil.ParseDurationSecond(s.RevivalWindow)
+		if err != nil {
+			return fmt.Errorf("error parsing revival_window: %w", err)
+		}
+		if t < 0 {
+			return fmt.Errorf("revival_window cannot be negative")
+		}
+		s.RevivalWindowDuration = t
+	}
+	return nil
+}
+
 func parseWorkerUpstreams(c *Config) ([]string, error) {
 	if c == nil || c.Worker == nil {
 		return nil, fmt.Errorf("config or worker field is nil")
@@ -1243,6 +2189,8 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 				s.Type = event.StderrSink
 			case s.FileConfig != nil:
 				s.Type = event.FileSink
+			case s.SyslogConfig != nil:
+				s.Type = event.SyslogSink
 			default:
 				return nil, fmt.Errorf("sink type could not be determined")
 			}
@@ -1264,6 +2212,22 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 			}
 		}
 
+		// the tls certificates and key of a syslog config may be read from a
+		// file or env var
+		if s.SyslogConfig != nil {
+			for name, v := range map[string]*string{
+				"tls ca cert":     &s.SyslogConfig.TlsCaCert,
+				"tls client cert": &s.SyslogConfig.TlsClientCert,
+				"tls client key":  &s.SyslogConfig.TlsClientKey,
+			} {
+				parsed, err := parseutil.ParsePath(*v)
+				if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+					return nil, fmt.Errorf("error parsing syslog %s: %w", name, err)
+				}
+				*v = parsed
+			}
+		}
+
 		// parse map into event types
 		if s.AuditConfig != nil && s.AuditConfig.FilterOverridesHCL != nil {
 			s.AuditConfig.FilterOverrides = make(map[event.DataClassification]event.FilterOperation, len(s.AuditConfig.FilterOverridesHCL))
@@ -1272,6 +2236,15 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 			}
 		}
 
+		// parse the timestamp interval of an audit hash chain into a time.Duration
+		if s.AuditConfig != nil && s.AuditConfig.HashChain != nil && s.AuditConfig.HashChain.TimestampIntervalHCL != "" {
+			var err error
+			s.AuditConfig.HashChain.TimestampInterval, err = parseutil.ParseDurationSecond(s.AuditConfig.HashChain.TimestampIntervalHCL)
+			if err != nil {
+				return nil, fmt.Errorf("can't parse audit hash chain timestamp interval %s", s.AuditConfig.HashChain.TimestampIntervalHCL)
+			}
+		}
+
 		if err := s.Validate(); err != nil {
 			return nil, err
 		}
@@ -1420,3 +2393,88 @@ func (c *Config) SetupWorkerInitialUpstreams() error {
 
 	return nil
 }
+
+// parse validates the DNS configuration, and normalizes its server addresses
+// and timeouts.
+func (d *Dns) parse() error {
+	if err := d.DnsResolver.parse(); err != nil {
+		return err
+	}
+	domains := make(map[string]bool, len(d.SplitHorizon))
+	for i, sh := range d.SplitHorizon {
+		if sh == nil {
+			continue
+		}
+		sh.Domain = strings.ToLower(strings.Trim(strings.TrimSpace(sh.Domain), "."))
+		if sh.Domain == "" {
+			return fmt.Errorf("split_horizon %d: missing domain", i)
+		}
+		if domains[sh.Domain] {
+			return fmt.Errorf("split_horizon %d: duplicate domain %q", i, sh.Domain)
+		}
+		domains[sh.Domain] = true
+		if err := sh.DnsResolver.parse(); err != nil {
+			return fmt.Errorf("split_horizon %q: %w", sh.Domain, err)
+		}
+	}
+	targets := make(map[string]bool, len(d.Targets))
+	for i, t := range d.Targets {
+		if t == nil {
+			continue
+		}
+		if t.TargetId == "" {
+			return fmt.Errorf("target %d: missing target_id", i)
+		}
+		if targets[t.TargetId] {
+			return fmt.Errorf("target %d: duplicate target_id %q", i, t.TargetId)
+		}
+		targets[t.TargetId] = true
+		if err := t.DnsResolver.parse(); err != nil {
+			return fmt.Errorf("target %q: %w", t.TargetId, err)
+		}
+	}
+	return nil
+}
+
+func (r *DnsResolver) parse() error {
+	var defaultPort string
+	switch r.Resolver {
+	case "":
+		r.Resolver = DnsResolverSystem
+		fallthrough
+	case DnsResolverSystem:
+		if len(r.Servers) > 0 {
+			return fmt.Errorf("servers cannot be set with the %q resolver", DnsResolverSystem)
+		}
+	case DnsResolverCustom:
+		defaultPort = "53"
+	case DnsResolverDoT:
+		defaultPort = "853"
+	default:
+		return fmt.Errorf("unknown resolver %q", r.Resolver)
+	}
+	if defaultPort != "" && len(r.Servers) == 0 {
+		return fmt.Errorf("servers must be set with the %q resolver", r.Resolver)
+	}
+	for i, s := range r.Servers {
+		host, port, err := net.SplitHostPort(s)
+		if err != nil {
+			host, port = strings.Trim(s, "[]"), defaultPort
+		}
+		if host == "" {
+			return fmt.Errorf("invalid server address %q", s)
+		}
+		r.Servers[i] = net.JoinHostPort(host, port)
+	}
+	if r.Timeout != nil {
+		t, err := parseutil.ParseDurationSecond(r.Timeout)
+		if err != nil {
+			return fmt.Errorf("error parsing timeout: %w", err)
+		}
+		if t < 0 {
+			return fmt.Errorf("timeout cannot be negative")
+		}
+		r.TimeoutDuration = t
+	}
+	return nil
+}
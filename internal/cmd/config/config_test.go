@@ -9,15 +9,19 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/ratelimit"
+	"github.com/hashicorp/boundary/internal/scopequota"
 	"github.com/hashicorp/boundary/internal/util"
+	"github.com/hashicorp/go-plugin"
 	configutil "github.com/hashicorp/go-secure-stdlib/configutil/v2"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -1551,6 +1555,120 @@ func TestController_EventingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "audit_config_hash_chain",
+			config: []string{
+				`events {
+					audit_enabled = true
+					sink {
+						name = "audit-sink"
+						format = "cloudevents-json"
+						event_types = ["audit"]
+						file {
+							file_name = "audit.log"
+						}
+						audit_config {
+							hash_chain {
+								timestamp_url      = "https://tsa.example.com"
+								timestamp_interval = "5m"
+							}
+						}
+					}
+				}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				AuditEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:       "file",
+						Name:       "audit-sink",
+						Format:     "cloudevents-json",
+						EventTypes: []event.Type{"audit"},
+						FileConfig: &event.FileSinkTypeConfig{
+							FileName: "audit.log",
+						},
+						AuditConfig: &event.AuditConfig{
+							HashChain: &event.AuditHashChainConfig{
+								TimestampUrl:         "https://tsa.example.com",
+								TimestampInterval:    5 * time.Minute,
+								TimestampIntervalHCL: "5m",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "sink_resource_types_actors_transforms",
+			config: []string{
+				`events {
+					observations_enabled = true
+					sink {
+						name = "observation-sink"
+						format = "cloudevents-json"
+						event_types = ["observation"]
+						resource_types = ["target", "session"]
+						actors = ["u_1234567890"]
+						stderr = {}
+						transform "/data/request_info/client_ip" {
+							operation = "redact"
+						}
+						transform "/data/header/user_agent" {
+							operation = "replace"
+							value     = "client"
+						}
+					}
+				}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				ObservationsEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:          "stderr",
+						Name:          "observation-sink",
+						Format:        "cloudevents-json",
+						EventTypes:    []event.Type{"observation"},
+						StderrConfig:  &event.StderrSinkTypeConfig{},
+						ResourceTypes: []string{"target", "session"},
+						Actors:        []string{"u_1234567890"},
+						Transforms: []event.SinkTransform{
+							{
+								Field:     "/data/request_info/client_ip",
+								Operation: event.RedactTransform,
+							},
+							{
+								Field:     "/data/header/user_agent",
+								Operation: event.ReplaceTransform,
+								Value:     "client",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "audit_config_hash_chain_invalid_interval",
+			config: []string{
+				`events {
+					audit_enabled = true
+					sink {
+						name = "audit-sink"
+						format = "cloudevents-json"
+						event_types = ["audit"]
+						file {
+							file_name = "audit.log"
+						}
+						audit_config {
+							hash_chain {
+								timestamp_url      = "https://tsa.example.com"
+								timestamp_interval = "soon"
+							}
+						}
+					}
+				}`,
+			},
+			wantErr: `error parsing "events": can't parse audit hash chain timestamp interval soon`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1989,6 +2107,84 @@ func TestControllerApiRateLimits(t *testing.T) {
 	}
 }
 
+func TestControllerApiScopeQuotas(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		expQuotas scopequota.Configs
+		expErr    bool
+		expErrStr string
+	}{
+		{
+			name: "No quotas",
+			in: `
+			controller {
+				name = "example-controller"
+			}`,
+			expQuotas: nil,
+		},
+		{
+			name: "Multiple quotas",
+			in: `
+			controller {
+				api_scope_quota {
+					scopes = ["*"]
+					limit  = 1000
+					period = "1m"
+				}
+
+				api_scope_quota "noisy" {
+					scopes = ["o_1234567890"]
+					limit  = 100
+					period = "30s"
+				}
+			}`,
+			expQuotas: scopequota.Configs{
+				{
+					Scopes:    []string{"*"},
+					Limit:     1000,
+					PeriodHCL: "1m",
+					Period:    time.Minute,
+				},
+				{
+					Scopes:    []string{"o_1234567890"},
+					Limit:     100,
+					PeriodHCL: "30s",
+					Period:    30 * time.Second,
+				},
+			},
+		},
+		{
+			name: "Invalid period",
+			in: `
+			controller {
+				api_scope_quota {
+					scopes = ["*"]
+					limit  = 1000
+					period = "soon"
+				}
+			}`,
+			expErr:    true,
+			expErrStr: "error decoding controller api_scope_quota period for entry 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			require.Equal(t, tt.expQuotas, c.Controller.ApiScopeQuotas)
+		})
+	}
+}
+
 func TestWorkerDescription(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -2044,6 +2240,61 @@ func TestWorkerDescription(t *testing.T) {
 	}
 }
 
+func TestWorkerLocality(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          string
+		envLocality string
+		expLocality string
+		expErr      bool
+		expErrStr   string
+	}{
+		{
+			name: "Valid worker locality",
+			in: `
+			worker {
+				locality = "us-east-1"
+			}`,
+			expLocality: "us-east-1",
+			expErr:      false,
+		}, {
+			name: "Valid worker locality from env var",
+			in: `
+			worker {
+				locality = "env://WORKER_LOCALITY"
+			}`,
+			envLocality: "eu-west-1",
+			expLocality: "eu-west-1",
+			expErr:      false,
+		}, {
+			name: "Not a URL, non-printable locality",
+			in: `
+			worker {
+				locality = "\x00"
+			}`,
+			expErr:    true,
+			expErrStr: "Worker locality contains non-printable characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("WORKER_LOCALITY", tt.envLocality)
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Worker)
+			require.Equal(t, tt.expLocality, c.Worker.Locality)
+		})
+	}
+}
+
 func TestPluginExecutionDir(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -3384,3 +3635,1333 @@ func TestMaxPageSize(t *testing.T) {
 		})
 	}
 }
+
+func TestProfiling(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		expEnabled    bool
+		expWindow     time.Duration
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in:   `disable_mlock = true`,
+		},
+		{
+			name: "Enabled",
+			in: `
+			enable_profiling = true`,
+			expEnabled: true,
+		},
+		{
+			name: "Signal window string",
+			in: `
+			profiling_signal_window = "10m"`,
+			expWindow: 10 * time.Minute,
+		},
+		{
+			name: "Signal window seconds",
+			in: `
+			profiling_signal_window = 30`,
+			expWindow: 30 * time.Second,
+		},
+		{
+			name: "Signal window disabled",
+			in: `
+			profiling_signal_window = "-1s"`,
+			expWindow: -time.Second,
+		},
+		{
+			name: "Invalid signal window",
+			in: `
+			profiling_signal_window = "soon"`,
+			expErr:        true,
+			expErrContain: "error parsing profiling_signal_window",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.Equal(t, tt.expEnabled, c.EnableProfiling)
+			require.Equal(t, tt.expWindow, c.ProfilingSignalWindowDuration)
+		})
+	}
+}
+
+func TestSelfMonitor(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		exp           SelfMonitor
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in:   `disable_mlock = true`,
+		},
+		{
+			name: "All set",
+			in: `
+			self_monitor {
+				interval = "1m"
+				goroutine_threshold = 5000
+				open_files_threshold_percent = 90
+				proxy_connection_threshold = 2000
+			}`,
+			exp: SelfMonitor{
+				Interval:                  "1m",
+				IntervalDuration:          time.Minute,
+				GoroutineThreshold:        5000,
+				OpenFilesThresholdPercent: 90,
+				ProxyConnectionThreshold:  2000,
+			},
+		},
+		{
+			name: "Disabled",
+			in: `
+			self_monitor {
+				disable = true
+			}`,
+			exp: SelfMonitor{Disable: true},
+		},
+		{
+			name: "Invalid interval",
+			in: `
+			self_monitor {
+				interval = "0s"
+			}`,
+			expErr:        true,
+			expErrContain: "self_monitor interval must be greater than zero",
+		},
+		{
+			name: "Invalid open files percent",
+			in: `
+			self_monitor {
+				open_files_threshold_percent = 101
+			}`,
+			expErr:        true,
+			expErrContain: "self_monitor open_files_threshold_percent must be at most 100",
+		},
+		{
+			name: "Invalid proxy connection threshold",
+			in: `
+			self_monitor {
+				proxy_connection_threshold = -1
+			}`,
+			expErr:        true,
+			expErrContain: "self_monitor proxy_connection_threshold must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.Equal(t, tt.exp, c.SelfMonitor)
+		})
+	}
+}
+
+func TestWorkerConnectionEvents(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		exp           *ConnectionEvents
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			worker {
+				name = "test"
+			}`,
+		},
+		{
+			name: "Enabled",
+			in: `
+			worker {
+				name = "test"
+				connection_events {
+					enabled = true
+				}
+			}`,
+			exp: &ConnectionEvents{Enabled: true},
+		},
+		{
+			name: "Sampled",
+			in: `
+			worker {
+				name = "test"
+				connection_events {
+					enabled = true
+					sample_percent = 10
+				}
+			}`,
+			exp: &ConnectionEvents{Enabled: true, SamplePercent: 10},
+		},
+		{
+			name: "Invalid sample percent",
+			in: `
+			worker {
+				name = "test"
+				connection_events {
+					enabled = true
+					sample_percent = 101
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "Worker connection events sample percent must be between 1 and 100, got 101",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Worker)
+			require.Equal(t, tt.exp, c.Worker.ConnectionEvents)
+		})
+	}
+}
+
+func TestWorkerDns(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		exp           *Dns
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			worker {
+				name = "test"
+			}`,
+		},
+		{
+			name: "Defaults to system",
+			in: `
+			worker {
+				name = "test"
+				dns {}
+			}`,
+			exp: &Dns{DnsResolver: DnsResolver{Resolver: DnsResolverSystem}},
+		},
+		{
+			name: "Custom with overrides",
+			in: `
+			worker {
+				name = "test"
+				dns {
+					resolver = "custom"
+					servers  = ["10.0.0.53", "[fd00::53]:5353"]
+					timeout  = "2s"
+
+					split_horizon {
+						domain   = ".Corp.Example.com."
+						resolver = "dot"
+						servers  = ["10.0.1.53"]
+						tls_server_name = "dns.corp.example.com"
+					}
+
+					target {
+						target_id = "ttcp_1234567890"
+					}
+				}
+			}`,
+			exp: &Dns{
+				DnsResolver: DnsResolver{
+					Resolver:        DnsResolverCustom,
+					Servers:         []string{"10.0.0.53:53", "[fd00::53]:5353"},
+					Timeout:         "2s",
+					TimeoutDuration: 2 * time.Second,
+				},
+				SplitHorizon: []*DnsSplitHorizon{
+					{
+						DnsResolver: DnsResolver{
+							Resolver:      DnsResolverDoT,
+							Servers:       []string{"10.0.1.53:853"},
+							TlsServerName: "dns.corp.example.com",
+						},
+						Domain: "corp.example.com",
+					},
+				},
+				Targets: []*DnsTarget{
+					{
+						DnsResolver: DnsResolver{Resolver: DnsResolverSystem},
+						TargetId:    "ttcp_1234567890",
+					},
+				},
+			},
+		},
+		{
+			name: "Unknown resolver",
+			in: `
+			worker {
+				dns {
+					resolver = "doh"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: `Error parsing worker dns: unknown resolver "doh"`,
+		},
+		{
+			name: "Servers with system resolver",
+			in: `
+			worker {
+				dns {
+					servers = ["10.0.0.53"]
+				}
+			}`,
+			expErr:        true,
+			expErrContain: `servers cannot be set with the "system" resolver`,
+		},
+		{
+			name: "Custom without servers",
+			in: `
+			worker {
+				dns {
+					resolver = "custom"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: `servers must be set with the "custom" resolver`,
+		},
+		{
+			name: "Duplicate domain",
+			in: `
+			worker {
+				dns {
+					split_horizon {
+						domain = "example.com"
+					}
+					split_horizon {
+						domain = "Example.com"
+					}
+				}
+			}`,
+			expErr:        true,
+			expErrContain: `split_horizon 1: duplicate domain "example.com"`,
+		},
+		{
+			name: "Target without id",
+			in: `
+			worker {
+				dns {
+					target {
+						resolver = "system"
+					}
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "target 0: missing target_id",
+		},
+		{
+			name: "Negative timeout",
+			in: `
+			worker {
+				dns {
+					timeout = "-1s"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "timeout cannot be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Worker)
+			require.Equal(t, tt.exp, c.Worker.Dns)
+		})
+	}
+}
+
+func TestWorkerEgress(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		exp           []*WorkerEgress
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			worker {
+				name = "test"
+			}`,
+		},
+		{
+			name: "Multiple",
+			in: `
+			worker {
+				name = "test"
+				egress {
+					source_address = "10.0.0.5"
+					filter         = "\"/target/id\" == \"ttcp_1234567890\""
+				}
+				egress {
+					interface = "eth1"
+				}
+			}`,
+			exp: []*WorkerEgress{
+				{SourceAddress: "10.0.0.5", Filter: `"/target/id" == "ttcp_1234567890"`},
+				{Interface: "eth1"},
+			},
+		},
+		{
+			name: "Neither address nor interface",
+			in: `
+			worker {
+				egress {
+					filter = "\"/target/id\" == \"ttcp_1234567890\""
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "worker egress entry 0: one of source_address or interface must be set",
+		},
+		{
+			name: "Both address and interface",
+			in: `
+			worker {
+				egress {
+					source_address = "10.0.0.5"
+					interface      = "eth1"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "worker egress entry 0: only one of source_address or interface can be set",
+		},
+		{
+			name: "Invalid address",
+			in: `
+			worker {
+				egress {
+					source_address = "10.0.0"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "worker egress entry 0: invalid source_address",
+		},
+		{
+			name: "Invalid filter",
+			in: `
+			worker {
+				egress {
+					source_address = "10.0.0.5"
+				}
+				egress {
+					source_address = "10.0.0.6"
+					filter         = "/target/id =="
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "worker egress entry 1: invalid filter",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Worker)
+			require.Equal(t, tt.exp, c.Worker.Egress)
+		})
+	}
+}
+
+func TestWorkerConnectionLiveness(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		exp           *ConnectionLiveness
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			worker {
+				name = "test"
+			}`,
+		},
+		{
+			name: "Set",
+			in: `
+			worker {
+				name = "test"
+				connection_liveness {
+					tcp_keepalive_idle      = "30s"
+					tcp_keepalive_interval  = 10
+					tcp_keepalive_count     = 4
+					tcp_user_timeout        = "2m"
+					max_connection_lifetime = "12h"
+
+					target {
+						target_id               = "ttcp_1234567890"
+						max_connection_lifetime = "1h"
+					}
+				}
+			}`,
+			exp: &ConnectionLiveness{
+				ConnectionLivenessSettings: ConnectionLivenessSettings{
+					TcpKeepaliveIdle:              "30s",
+					TcpKeepaliveIdleDuration:      30 * time.Second,
+					TcpKeepaliveInterval:          10,
+					TcpKeepaliveIntervalDuration:  10 * time.Second,
+					TcpKeepaliveCount:             4,
+					TcpUserTimeout:                "2m",
+					TcpUserTimeoutDuration:        2 * time.Minute,
+					MaxConnectionLifetime:         "12h",
+					MaxConnectionLifetimeDuration: 12 * time.Hour,
+				},
+				Targets: []*ConnectionLivenessTarget{
+					{
+						ConnectionLivenessSettings: ConnectionLivenessSettings{
+							MaxConnectionLifetime:         "1h",
+							MaxConnectionLifetimeDuration: time.Hour,
+						},
+						TargetId: "ttcp_1234567890",
+					},
+				},
+			},
+		},
+		{
+			name: "Negative duration",
+			in: `
+			worker {
+				connection_liveness {
+					tcp_keepalive_idle = "-30s"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "Error parsing worker connection liveness: tcp_keepalive_idle cannot be negative",
+		},
+		{
+			name: "Negative count",
+			in: `
+			worker {
+				connection_liveness {
+					tcp_keepalive_count = -1
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "tcp_keepalive_count cannot be negative",
+		},
+		{
+			name: "Invalid target duration",
+			in: `
+			worker {
+				connection_liveness {
+					target {
+						target_id        = "ttcp_1234567890"
+						tcp_user_timeout = "soon"
+					}
+				}
+			}`,
+			expErr:        true,
+			expErrContain: `target "ttcp_1234567890": error parsing tcp_user_timeout`,
+		},
+		{
+			name: "Duplicate target",
+			in: `
+			worker {
+				connection_liveness {
+					target {
+						target_id = "ttcp_1234567890"
+					}
+					target {
+						target_id = "ttcp_1234567890"
+					}
+				}
+			}`,
+			expErr:        true,
+			expErrContain: `target 1: duplicate target_id "ttcp_1234567890"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Worker)
+			require.Equal(t, tt.exp, c.Worker.ConnectionLiveness)
+		})
+	}
+}
+
+func TestWorkerAccountProvisioning(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		exp           *AccountProvisioning
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			worker {
+				name = "test"
+			}`,
+		},
+		{
+			name: "Set",
+			in: `
+			worker {
+				name = "test"
+				account_provisioning {
+					command = ["/usr/local/bin/jit-account", "-v"]
+					timeout = "10s"
+					filter  = "\"/session/type\" == \"ssh\""
+				}
+			}`,
+			exp: &AccountProvisioning{
+				Command:         []string{"/usr/local/bin/jit-account", "-v"},
+				Timeout:         "10s",
+				TimeoutDuration: 10 * time.Second,
+				Filter:          `"/session/type" == "ssh"`,
+			},
+		},
+		{
+			name: "Missing command",
+			in: `
+			worker {
+				account_provisioning {
+					timeout = "10s"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "Error parsing worker account provisioning: command must be set",
+		},
+		{
+			name: "Negative timeout",
+			in: `
+			worker {
+				account_provisioning {
+					command = ["/usr/local/bin/jit-account"]
+					timeout = "-10s"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "timeout cannot be negative",
+		},
+		{
+			name: "Invalid filter",
+			in: `
+			worker {
+				account_provisioning {
+					command = ["/usr/local/bin/jit-account"]
+					filter  = "session/type =="
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "invalid filter",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Worker)
+			require.Equal(t, tt.exp, c.Worker.AccountProvisioning)
+		})
+	}
+}
+
+func TestControllerStaleWorkers(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		exp           *StaleWorkers
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			controller {
+				name = "test"
+			}`,
+		},
+		{
+			name: "Set",
+			in: `
+			controller {
+				name = "test"
+				stale_workers {
+					retire_after   = "30d"
+					revival_window = 86400
+				}
+			}`,
+			exp: &StaleWorkers{
+				RetireAfter:           "30d",
+				RetireAfterDuration:   30 * 24 * time.Hour,
+				RevivalWindow:         86400,
+				RevivalWindowDuration: 24 * time.Hour,
+			},
+		},
+		{
+			name: "Default revival window",
+			in: `
+			controller {
+				name = "test"
+				stale_workers {
+					retire_after = "72h"
+				}
+			}`,
+			exp: &StaleWorkers{
+				RetireAfter:           "72h",
+				RetireAfterDuration:   72 * time.Hour,
+				RevivalWindowDuration: 7 * 24 * time.Hour,
+			},
+		},
+		{
+			name: "Missing retire after",
+			in: `
+			controller {
+				name = "test"
+				stale_workers {
+					revival_window = "1d"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "Error parsing controller stale workers: retire_after must be set",
+		},
+		{
+			name: "Zero retire after",
+			in: `
+			controller {
+				name = "test"
+				stale_workers {
+					retire_after = "0s"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "retire_after must be greater than 0",
+		},
+		{
+			name: "Negative revival window",
+			in: `
+			controller {
+				name = "test"
+				stale_workers {
+					retire_after   = "1d"
+					revival_window = "-1h"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "revival_window cannot be negative",
+		},
+		{
+			name: "Invalid revival window",
+			in: `
+			controller {
+				name = "test"
+				stale_workers {
+					retire_after   = "1d"
+					revival_window = "soon"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "error parsing revival_window",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			require.Equal(t, tt.exp, c.Controller.StaleWorkers)
+		})
+	}
+}
+
+func TestControllerTerminatedSessionRetention(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		exp           time.Duration
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			controller {
+				name = "test"
+			}`,
+		},
+		{
+			name: "String",
+			in: `
+			controller {
+				name = "test"
+				terminated_session_retention = "72h"
+			}`,
+			exp: 72 * time.Hour,
+		},
+		{
+			name: "Seconds",
+			in: `
+			controller {
+				name = "test"
+				terminated_session_retention = 86400
+			}`,
+			exp: 24 * time.Hour,
+		},
+		{
+			name: "Zero",
+			in: `
+			controller {
+				name = "test"
+				terminated_session_retention = "0s"
+			}`,
+			expErr:        true,
+			expErrContain: "Controller terminated session retention must be greater than 0",
+		},
+		{
+			name: "Invalid",
+			in: `
+			controller {
+				name = "test"
+				terminated_session_retention = "a while"
+			}`,
+			expErr:        true,
+			expErrContain: "Error parsing controller terminated session retention",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			require.Equal(t, tt.exp, c.Controller.TerminatedSessionRetentionDuration)
+		})
+	}
+}
+
+func TestControllerWorkerSelection(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		exp           string
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			controller {
+				name = "test"
+			}`,
+		},
+		{
+			name: "Least loaded",
+			in: `
+			controller {
+				name = "test"
+				worker_selection = "least-loaded"
+			}`,
+			exp: "least-loaded",
+		},
+		{
+			name: "Random",
+			in: `
+			controller {
+				name = "test"
+				worker_selection = "random"
+			}`,
+			exp: "random",
+		},
+		{
+			name: "Sticky",
+			in: `
+			controller {
+				name = "test"
+				worker_selection = "sticky"
+			}`,
+			exp: "sticky",
+		},
+		{
+			name: "Invalid",
+			in: `
+			controller {
+				name = "test"
+				worker_selection = "round-robin"
+			}`,
+			expErr:        true,
+			expErrContain: `Controller worker selection "round-robin" is invalid`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			require.Equal(t, tt.exp, c.Controller.WorkerSelection)
+		})
+	}
+}
+
+func TestListenerClientIdentityHeader(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			listener "tcp" {
+				purpose = "api"
+				tls_disable = true
+			}`,
+		},
+		{
+			name: "Valid",
+			in: `
+			listener "tcp" {
+				purpose = "api"
+				tls_cert_file = "cert.pem"
+				tls_key_file = "key.pem"
+				tls_require_and_verify_client_cert = true
+				tls_client_ca_file = "ca.pem"
+				tls_client_identity_header = "X-Client-Identity"
+			}`,
+		},
+		{
+			name: "Invalid header name",
+			in: `
+			listener "tcp" {
+				purpose = "api"
+				tls_require_and_verify_client_cert = true
+				tls_client_ca_file = "ca.pem"
+				tls_client_identity_header = "X Client Identity"
+			}`,
+			expErrContain: "is not a valid header name",
+		},
+		{
+			name: "Not an api listener",
+			in: `
+			listener "tcp" {
+				purpose = "ops"
+				tls_require_and_verify_client_cert = true
+				tls_client_ca_file = "ca.pem"
+				tls_client_identity_header = "X-Client-Identity"
+			}`,
+			expErrContain: "is only supported on api listeners",
+		},
+		{
+			name: "Client certificates not required",
+			in: `
+			listener "tcp" {
+				purpose = "api"
+				tls_client_ca_file = "ca.pem"
+				tls_client_identity_header = "X-Client-Identity"
+			}`,
+			expErrContain: "requires tls_require_and_verify_client_cert to be enabled",
+		},
+		{
+			name: "TLS disabled",
+			in: `
+			listener "tcp" {
+				purpose = "api"
+				tls_disable = true
+				tls_require_and_verify_client_cert = true
+				tls_client_ca_file = "ca.pem"
+				tls_client_identity_header = "X-Client-Identity"
+			}`,
+			expErrContain: "requires tls_require_and_verify_client_cert to be enabled",
+		},
+		{
+			name: "Missing client CA",
+			in: `
+			listener "tcp" {
+				purpose = "api"
+				tls_require_and_verify_client_cert = true
+				tls_client_identity_header = "X-Client-Identity"
+			}`,
+			expErrContain: "requires tls_client_ca_file to be set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErrContain != "" {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, c)
+		})
+	}
+}
+
+func TestWorkerAutoUpdate(t *testing.T) {
+	const publicKey = "6ECIj60Y1hdwclWSuI5dk4qdvYthF/XQGL0BmpdE3fo="
+	tests := []struct {
+		name          string
+		in            string
+		exp           *AutoUpdate
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			worker {
+				name = "test"
+			}`,
+		},
+		{
+			name: "Set",
+			in: `
+			worker {
+				name = "test"
+				auto_update {
+					manifest_url   = "https://releases.example.com/boundary/manifest.json"
+					public_key     = "` + publicKey + `"
+					check_interval = "15m"
+					drain_timeout  = "2h"
+					staging_path   = "/var/lib/boundary/updates"
+				}
+			}`,
+			exp: &AutoUpdate{
+				ManifestUrl:           "https://releases.example.com/boundary/manifest.json",
+				PublicKey:             publicKey,
+				CheckInterval:         "15m",
+				CheckIntervalDuration: 15 * time.Minute,
+				DrainTimeout:          "2h",
+				DrainTimeoutDuration:  2 * time.Hour,
+				StagingPath:           "/var/lib/boundary/updates",
+			},
+		},
+		{
+			name: "Public key from env",
+			in: `
+			worker {
+				auto_update {
+					manifest_url = "https://releases.example.com/boundary/manifest.json"
+					public_key   = "env://BOUNDARY_TEST_UPDATE_PUBLIC_KEY"
+				}
+			}`,
+			exp: &AutoUpdate{
+				ManifestUrl: "https://releases.example.com/boundary/manifest.json",
+				PublicKey:   publicKey,
+			},
+		},
+		{
+			name: "Missing manifest url",
+			in: `
+			worker {
+				auto_update {
+					public_key = "` + publicKey + `"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "Error parsing worker auto update: manifest_url must be set",
+		},
+		{
+			name: "Invalid public key",
+			in: `
+			worker {
+				auto_update {
+					manifest_url = "https://releases.example.com/boundary/manifest.json"
+					public_key   = "bm90IGEga2V5"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "invalid public_key",
+		},
+		{
+			name: "Zero check interval",
+			in: `
+			worker {
+				auto_update {
+					manifest_url   = "https://releases.example.com/boundary/manifest.json"
+					public_key     = "` + publicKey + `"
+					check_interval = "0s"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "check_interval must be greater than 0",
+		},
+	}
+	t.Setenv("BOUNDARY_TEST_UPDATE_PUBLIC_KEY", publicKey)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Worker)
+			require.Equal(t, tt.exp, c.Worker.AutoUpdate)
+		})
+	}
+}
+
+func TestPluginsDirectory(t *testing.T) {
+	const awsSum = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "boundary-plugin-aws"), []byte("test"), 0o755))
+	tests := []struct {
+		name          string
+		in            string
+		expDirectory  string
+		expChecksums  map[string]string
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			plugins {
+				execution_dir = "/var/run/boundary"
+			}`,
+		},
+		{
+			name: "Set",
+			in: `
+			plugins {
+				directory = "` + dir + `"
+				checksums = {
+					aws = "` + awsSum + `"
+				}
+			}`,
+			expDirectory: dir,
+			expChecksums: map[string]string{"aws": awsSum},
+		},
+		{
+			name: "Directory from env",
+			in: `
+			plugins {
+				directory = "env://BOUNDARY_TEST_PLUGINS_DIRECTORY"
+				checksums = {
+					aws = "` + awsSum + `"
+				}
+			}`,
+			expDirectory: dir,
+			expChecksums: map[string]string{"aws": awsSum},
+		},
+		{
+			name: "Missing checksums",
+			in: `
+			plugins {
+				directory = "` + dir + `"
+			}`,
+			expErr:        true,
+			expErrContain: "Error parsing plugins: directory requires the checksums of its plugins",
+		},
+		{
+			name: "Checksums without directory",
+			in: `
+			plugins {
+				checksums = {
+					aws = "` + awsSum + `"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "checksums require directory to be set",
+		},
+		{
+			name: "Invalid checksum",
+			in: `
+			plugins {
+				directory = "` + dir + `"
+				checksums = {
+					aws = "9f86d0"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: `checksum of plugin "aws" must be a hex encoded SHA256 checksum`,
+		},
+	}
+	t.Setenv("BOUNDARY_TEST_PLUGINS_DIRECTORY", dir)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			assert.Equal(t, tt.expDirectory, c.Plugins.Directory)
+			assert.Equal(t, tt.expChecksums, c.Plugins.Checksums)
+
+			opts, err := c.Plugins.pluginFileOptions("aws")
+			require.NoError(t, err)
+			plugins, err := pluginutil.BuildPluginMap(append(opts, pluginutil.WithPluginClientCreationFunc(
+				func(string, ...pluginutil.Option) (*plugin.Client, error) { return nil, nil },
+			))...)
+			require.NoError(t, err)
+			if tt.expDirectory == "" {
+				// The embedded plugins are extracted
+				for _, p := range plugins {
+					assert.NotNil(t, p.ContainerFs)
+				}
+				return
+			}
+			require.Contains(t, plugins, "aws")
+			assert.Nil(t, plugins["aws"].ContainerFs)
+			assert.Equal(t, filepath.Join(dir, "boundary-plugin-aws"), plugins["aws"].Path)
+			require.NotNil(t, plugins["aws"].SecureConfig)
+			assert.Len(t, plugins, 1)
+
+			_, err = c.Plugins.PluginOptions("azure")
+			assert.ErrorContains(t, err, `no checksum configured for plugin "azure"`)
+		})
+	}
+}
+
+func TestPluginsSandbox(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            string
+		exp           *PluginSandbox
+		expErr        bool
+		expErrContain string
+	}{
+		{
+			name: "Not set",
+			in: `
+			plugins {
+				execution_dir = "/var/run/boundary"
+			}`,
+		},
+		{
+			name: "User and group",
+			in: `
+			plugins {
+				execution_dir = "/var/run/boundary"
+				sandbox {
+					user  = "boundary-plugin"
+					group = "boundary"
+				}
+			}`,
+			exp: &PluginSandbox{
+				User:  "boundary-plugin",
+				Group: "boundary",
+			},
+		},
+		{
+			name: "User without execution dir",
+			in: `
+			plugins {
+				sandbox {
+					user = "boundary-plugin"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "Error parsing plugins: execution_dir must be set to run plugins as another user or group",
+		},
+		{
+			name: "Invalid memory limit",
+			in: `
+			plugins {
+				sandbox {
+					memory_limit = "lots"
+				}
+			}`,
+			expErr:        true,
+			expErrContain: "error parsing memory_limit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.ErrorContains(t, err, tt.expErrContain)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.Equal(t, tt.exp, c.Plugins.Sandbox)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux || darwin || windows || (freebsd && (amd64 || arm64))
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/clientcache/cmd/cache"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/commands/doctor"
+	"github.com/mitchellh/cli"
+)
+
+func init() {
+	extraCommandsFuncs = append(extraCommandsFuncs, func(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
+		// Replace the doctor command with one that can also check the client
+		// cache on platforms where it is supported.
+		Commands["doctor"] = func() (cli.Command, error) {
+			return &doctor.Command{
+				Command: base.NewCommand(ui),
+				CacheStatusFn: func(ctx context.Context) (string, error) {
+					_, result, apiErr, err := (&cache.StatusCommand{Command: base.NewCommand(ui)}).Status(ctx)
+					switch {
+					case err != nil:
+						return "", err
+					case apiErr != nil:
+						return "", apiErr
+					}
+					return result.Version, nil
+				},
+			}, nil
+		}
+	})
+}
@@ -54,6 +54,11 @@ type cmdInfo struct {
 	// HasScopeName controls whether to add scope name options
 	HasScopeName bool
 
+	// LookupByName controls whether read, update and delete can address the
+	// resource by name, via -name and the scope or container ID flags,
+	// instead of by ID
+	LookupByName bool
+
 	// VersionedActions controls which actions to add a case for version checking
 	VersionedActions []string
 
@@ -113,6 +118,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasExtraCommandVars: true,
 			HasExtraHelpFunc:    true,
 			Container:           "AuthMethod",
+			LookupByName:        true,
 			HasId:               true,
 			HasName:             true,
 			HasDescription:      true,
@@ -129,6 +135,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:               true,
 			HasName:             true,
 			Container:           "AuthMethod",
+			LookupByName:        true,
 			HasDescription:      true,
 			VersionedActions:    []string{"update"},
 		},
@@ -143,6 +150,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:               true,
 			HasName:             true,
 			Container:           "AuthMethod",
+			LookupByName:        true,
 			HasDescription:      true,
 			VersionedActions:    []string{"update"},
 		},
@@ -157,6 +165,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:               true,
 			HasName:             true,
 			Container:           "AuthMethod",
+			LookupByName:        true,
 			HasDescription:      true,
 			VersionedActions:    []string{"update"},
 		},
@@ -168,6 +177,7 @@ var inputStructs = map[string][]*cmdInfo{
 			StdActions:       []string{"read", "delete", "list"},
 			HasExtraHelpFunc: true,
 			Container:        "Scope",
+			LookupByName:     true,
 			HasId:            true,
 		},
 		{
@@ -182,18 +192,21 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "Scope",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
 		},
 	},
 	"authmethods": {
 		{
-			ResourceType:     resource.AuthMethod.String(),
-			Pkg:              "authmethods",
-			StdActions:       []string{"read", "delete", "list"},
-			HasExtraHelpFunc: true,
-			Container:        "Scope",
-			HasId:            true,
+			ResourceType:        resource.AuthMethod.String(),
+			Pkg:                 "authmethods",
+			StdActions:          []string{"read", "delete", "list"},
+			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
+			Container:           "Scope",
+			LookupByName:        true,
+			HasId:               true,
 		},
 		{
 			ResourceType:         resource.AuthMethod.String(),
@@ -207,6 +220,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "Scope",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
 		},
@@ -222,6 +236,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "Scope",
+			LookupByName:         true,
 			VersionedActions:     []string{"update", "change-state"},
 			NeedsSubtypeInCreate: true,
 		},
@@ -237,6 +252,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "Scope",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
 		},
@@ -266,6 +282,7 @@ var inputStructs = map[string][]*cmdInfo{
 			StdActions:       []string{"read", "delete", "list"},
 			HasExtraHelpFunc: true,
 			Container:        "Scope",
+			LookupByName:     true,
 			HasId:            true,
 		},
 		{
@@ -280,6 +297,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "Scope",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
@@ -295,6 +313,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "Scope",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
@@ -307,6 +326,7 @@ var inputStructs = map[string][]*cmdInfo{
 			StdActions:       []string{"read", "delete", "list"},
 			HasExtraHelpFunc: true,
 			Container:        "CredentialStore",
+			LookupByName:     true,
 			HasId:            true,
 		},
 		{
@@ -322,6 +342,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasDescription:       true,
 			NeedsSubtypeInCreate: true,
 			Container:            "CredentialStore",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
@@ -338,6 +359,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasDescription:       true,
 			NeedsSubtypeInCreate: true,
 			Container:            "CredentialStore",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
@@ -354,6 +376,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasDescription:       true,
 			NeedsSubtypeInCreate: true,
 			Container:            "CredentialStore",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
@@ -365,6 +388,7 @@ var inputStructs = map[string][]*cmdInfo{
 			StdActions:       []string{"read", "delete", "list"},
 			HasExtraHelpFunc: true,
 			Container:        "CredentialStore",
+			LookupByName:     true,
 			HasId:            true,
 		},
 		{
@@ -379,6 +403,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "CredentialStore",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
@@ -395,6 +420,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "CredentialStore",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
@@ -410,12 +436,28 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "CredentialStore",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 			HasJsonObject: true,
 		},
 	},
+	"environments": {
+		{
+			ResourceType:        resource.Environment.String(),
+			Pkg:                 "environments",
+			StdActions:          []string{"create", "read", "update", "delete", "list"},
+			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			Container:           "Scope",
+			LookupByName:        true,
+			HasName:             true,
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
+		},
+	},
 	"groups": {
 		{
 			ResourceType:        resource.Group.String(),
@@ -425,6 +467,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasExtraHelpFunc:    true,
 			HasId:               true,
 			Container:           "Scope",
+			LookupByName:        true,
 			HasName:             true,
 			HasDescription:      true,
 			VersionedActions:    []string{"update", "add-members", "remove-members", "set-members"},
@@ -432,12 +475,15 @@ var inputStructs = map[string][]*cmdInfo{
 	},
 	"hostcatalogs": {
 		{
-			ResourceType:     resource.HostCatalog.String(),
-			Pkg:              "hostcatalogs",
-			StdActions:       []string{"read", "delete", "list"},
-			HasExtraHelpFunc: true,
-			Container:        "Scope",
-			HasId:            true,
+			ResourceType:        resource.HostCatalog.String(),
+			Pkg:                 "hostcatalogs",
+			StdActions:          []string{"read", "delete", "list"},
+			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
+			Container:           "Scope",
+			LookupByName:        true,
+			HasId:               true,
+			VersionedActions:    []string{"rotate-secrets", "set-target-rules"},
 		},
 		{
 			ResourceType:         resource.HostCatalog.String(),
@@ -450,6 +496,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "Scope",
+			LookupByName:         true,
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
 		},
@@ -465,6 +512,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "Scope",
+			LookupByName:         true,
 			IsPluginType:         true,
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
@@ -480,6 +528,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasExtraCommandVars: true,
 			HasExtraHelpFunc:    true,
 			Container:           "HostCatalog",
+			LookupByName:        true,
 			HasId:               true,
 			HasName:             true,
 			HasDescription:      true,
@@ -495,6 +544,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:            true,
 			HasName:          true,
 			Container:        "HostCatalog",
+			LookupByName:     true,
 			HasDescription:   true,
 			VersionedActions: []string{"update"},
 		},
@@ -509,6 +559,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:                true,
 			HasName:              true,
 			Container:            "HostCatalog",
+			LookupByName:         true,
 			HasDescription:       true,
 			HasGenericAttributes: true,
 			VersionedActions:     []string{"update"},
@@ -516,14 +567,16 @@ var inputStructs = map[string][]*cmdInfo{
 	},
 	"hosts": {
 		{
-			ResourceType:     resource.Host.String(),
-			Pkg:              "hosts",
-			StdActions:       []string{"read", "delete", "list"},
-			HasExtraHelpFunc: true,
-			Container:        "HostCatalog",
-			HasId:            true,
-			HasName:          true,
-			HasDescription:   true,
+			ResourceType:        resource.Host.String(),
+			Pkg:                 "hosts",
+			StdActions:          []string{"read", "delete", "list"},
+			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
+			Container:           "HostCatalog",
+			LookupByName:        true,
+			HasId:               true,
+			HasName:             true,
+			HasDescription:      true,
 		},
 		{
 			ResourceType:        resource.Host.String(),
@@ -536,16 +589,27 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:               true,
 			HasName:             true,
 			Container:           "HostCatalog",
+			LookupByName:        true,
 			HasDescription:      true,
 			VersionedActions:    []string{"update"},
 		},
 	},
+	"maintenance": {
+		{
+			ResourceType:        resource.Maintenance.String(),
+			Pkg:                 "maintenance",
+			HasCustomList:       true,
+			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
+		},
+	},
 	"managedgroups": {
 		{
 			ResourceType:   resource.ManagedGroup.String(),
 			Pkg:            "managedgroups",
 			StdActions:     []string{"read", "delete", "list"},
 			Container:      "AuthMethod",
+			LookupByName:   true,
 			HasId:          true,
 			HasName:        true,
 			HasDescription: true,
@@ -561,6 +625,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:               true,
 			HasName:             true,
 			Container:           "AuthMethod",
+			LookupByName:        true,
 			HasDescription:      true,
 			VersionedActions:    []string{"update"},
 		},
@@ -575,6 +640,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:               true,
 			HasName:             true,
 			Container:           "AuthMethod",
+			LookupByName:        true,
 			HasDescription:      true,
 			VersionedActions:    []string{"update"},
 		},
@@ -588,6 +654,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:          true,
 			HasDescription:   true,
 			Container:        "Scope",
+			LookupByName:     true,
 		},
 		{
 			ResourceType:         resource.Policy.String(),
@@ -600,6 +667,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:                true,
 			HasName:              true,
 			Container:            "Scope",
+			LookupByName:         true,
 			HasDescription:       true,
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
@@ -614,6 +682,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasExtraHelpFunc:    true,
 			HasId:               true,
 			Container:           "Scope",
+			LookupByName:        true,
 			HasName:             true,
 			HasDescription:      true,
 			VersionedActions:    []string{"update", "add-grants", "remove-grants", "set-grants", "add-principals", "remove-principals", "set-principals", "add-grant-scopes", "remove-grant-scopes", "set-grant-scopes"},
@@ -628,6 +697,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasExtraHelpFunc:    true,
 			HasId:               true,
 			Container:           "Scope",
+			LookupByName:        true,
 			HasName:             true,
 			HasDescription:      true,
 			VersionedActions:    []string{"update", "attach-storage-policy", "detach-storage-policy"},
@@ -668,6 +738,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:              true,
 			HasDescription:       true,
 			Container:            "Scope",
+			LookupByName:         true,
 			IsPluginType:         true,
 			VersionedActions:     []string{"update"},
 			HasGenericAttributes: true,
@@ -685,6 +756,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasName:                    true,
 			HasDescription:             true,
 			Container:                  "Scope",
+			LookupByName:               true,
 			VersionedActions:           []string{"add-host-sources", "remove-host-sources", "set-host-sources", "add-credential-sources", "remove-credential-sources", "set-credential-sources"},
 			UsesAlias:                  true,
 			AliasFieldFlag:             "FlagId",
@@ -701,6 +773,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:                      true,
 			HasName:                    true,
 			Container:                  "Scope",
+			LookupByName:               true,
 			HasDescription:             true,
 			VersionedActions:           []string{"update"},
 			NeedsSubtypeInCreate:       true,
@@ -719,6 +792,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:                      true,
 			HasName:                    true,
 			Container:                  "Scope",
+			LookupByName:               true,
 			HasDescription:             true,
 			VersionedActions:           []string{"update"},
 			NeedsSubtypeInCreate:       true,
@@ -727,6 +801,21 @@ var inputStructs = map[string][]*cmdInfo{
 			FlagNameOverwrittenByAlias: "id",
 		},
 	},
+	"targettemplates": {
+		{
+			ResourceType:        resource.TargetTemplate.String(),
+			Pkg:                 "targettemplates",
+			StdActions:          []string{"create", "read", "update", "delete", "list"},
+			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			Container:           "Scope",
+			LookupByName:        true,
+			HasName:             true,
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
+		},
+	},
 	"users": {
 		{
 			ResourceType:        resource.User.String(),
@@ -736,6 +825,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasExtraHelpFunc:    true,
 			HasId:               true,
 			Container:           "Scope",
+			LookupByName:        true,
 			HasName:             true,
 			HasDescription:      true,
 			VersionedActions:    []string{"update", "add-accounts", "remove-accounts", "set-accounts"},
@@ -749,6 +839,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasExtraHelpFunc: true,
 			HasId:            true,
 			Container:        "Scope",
+			LookupByName:     true,
 			HasName:          true,
 			HasDescription:   true,
 			VersionedActions: []string{"update", "add-worker-tags", "set-worker-tags", "remove-worker-tags"},
@@ -764,6 +855,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:                 true,
 			HasName:               true,
 			Container:             "Scope",
+			LookupByName:          true,
 			HasDescription:        true,
 			NeedsSubtypeInCreate:  true,
 			SkipClientCallActions: []string{"create"},
@@ -778,6 +870,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasId:                 true,
 			HasName:               true,
 			Container:             "Scope",
+			LookupByName:          true,
 			HasDescription:        true,
 			NeedsSubtypeInCreate:  true,
 			SkipClientCallActions: []string{"create"},
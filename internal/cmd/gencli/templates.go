@@ -181,18 +181,21 @@ var flags{{ camelCase .SubActionPrefix }}Map = map[string][]string{
 	{{ with $attrFlags := ", \"attributes\", \"attr\", \"string-attr\", \"bool-attr\", \"num-attr\"" }}
 	{{ with $secretFlags := ", \"secrets\", \"secret\", \"string-secret\", \"bool-secret\", \"num-secret\"" }}
 	{{ with $objectFlags := ", \"object\", \"kv\", \"string-kv\", \"bool-kv\", \"num-kv\"" }}
+	{{ $lookupContainerFlags := printf ", \"%s-id\"" (kebabCase $input.Container) }}
+	{{ if eq $input.Container "Scope" }}{{ $lookupContainerFlags = ", \"scope-id\", \"scope-name\"" }}{{ end }}
+	{{ $lookupFlags := printf ", \"name\"%s" $lookupContainerFlags }}
 	{{ range $i, $action := $input.StdActions }}
 	{{ if eq $action "create" }}
 	"create": { "{{ kebabCase $input.Container }}-id", "name", "description" {{ if $input.IsPluginType }} , "plugin-id", "plugin-name" {{ end }} {{ if $input.HasGenericAttributes }} {{ $attrFlags }} {{ end }} {{ if $input.HasGenericSecrets }} {{ $secretFlags }} {{ end }} {{ if $input.HasJsonObject }} {{ $objectFlags }} {{ end }} },
 	{{ end }}
 	{{ if eq $action "read" }}
-	"read": {"id"},
+	"read": {"id" {{ if $input.LookupByName }} {{ $lookupFlags }} {{ end }} },
 	{{ end }}
 	{{ if eq $action "update" }}
-	"update": {"id", "name", "description" {{ if hasAction $input.VersionedActions "update" }}, "version" {{ end }} {{ if $input.HasGenericAttributes }} {{ $attrFlags }} {{ end }} {{ if $input.HasGenericSecrets }} {{ $secretFlags }} {{ end }} {{ if $input.HasJsonObject }} {{ $objectFlags }} {{ end }} },
+	"update": {"id", "name", "description" {{ if $input.LookupByName }} {{ $lookupContainerFlags }} {{ end }} {{ if hasAction $input.VersionedActions "update" }}, "version" {{ end }} {{ if $input.HasGenericAttributes }} {{ $attrFlags }} {{ end }} {{ if $input.HasGenericSecrets }} {{ $secretFlags }} {{ end }} {{ if $input.HasJsonObject }} {{ $objectFlags }} {{ end }} },
 	{{ end }}
 	{{ if eq $action "delete" }}
-	"delete": {"id"},
+	"delete": {"id" {{ if $input.LookupByName }} {{ $lookupFlags }} {{ end }} },
 	{{ end }}
 	{{ if eq $action "list" }}
 	"list": { "{{ kebabCase $input.Container }}-id"{{ if not $input.SkipFiltering }},  "filter" {{ end }} {{ if (eq $input.Container "Scope") }}, "recursive"{{ end }} },
@@ -305,8 +308,21 @@ func (c *{{ camelCase .SubActionPrefix }}Command) Run(args []string) int {
 		return base.CommandUserError
 	}
 
+	{{ if .LookupByName }}
+	// The standard actions can address the resource by name instead of ID
+	var lookupByName bool
+	switch c.Func {
+	case "read", "update", "delete":
+		lookupByName = c.FlagId == "" && c.FlagName != ""
+		if c.Func != "update" && c.FlagId != "" && c.FlagName != "" {
+			c.PrintCliError(errors.New("Cannot specify both -id and -name; choose one or the other"))
+			return base.CommandUserError
+		}
+	}
+	{{ end }}
+
 	{{ if .HasId }}
-	if strutil.StrListContains(flags{{ camelCase .SubActionPrefix }}Map[c.Func], "id") && c.FlagId == "" {
+	if strutil.StrListContains(flags{{ camelCase .SubActionPrefix }}Map[c.Func], "id") && c.FlagId == "" {{ if .LookupByName }}&& !lookupByName {{ end }}{
 			c.PrintCliError(errors.New("ID is required but not passed in via -id"))
 			return base.CommandUserError
 	}
@@ -368,6 +384,36 @@ func (c *{{ camelCase .SubActionPrefix }}Command) Run(args []string) int {
 	}
 	{{ .Pkg }}Client := {{ .Pkg }}.NewClient(client)
 
+	{{ if .LookupByName }}
+	if lookupByName {
+		{{- if ne .Container "Scope" }}
+		if c.Flag{{ .Container }}Id == "" {
+			c.PrintCliError(errors.New("{{ .Container }} ID must be passed in via -{{ kebabCase .Container }}-id or BOUNDARY_{{ envCase .Container }}_ID to look up by name"))
+			return base.CommandUserError
+		}
+		{{ end }}
+		var exitCode int
+		c.FlagId, exitCode = common.LookupIdByName(c.Command, client, c.plural, {{ if eq .Container "Scope" }}""{{ else }}c.Flag{{ .Container }}Id{{ end }}, func(containerId, filter string) ([]common.NameMatch, error) {
+			result, err := {{ .Pkg }}Client.List(c.Context, containerId, {{ .Pkg }}.WithFilter(filter){{ if eq .Container "Scope" }}, {{ .Pkg }}.WithRecursive(true){{ end }})
+			if err != nil {
+				return nil, err
+			}
+			matches := make([]common.NameMatch, 0, len(result.GetItems()))
+			for _, item := range result.GetItems() {
+				matches = append(matches, common.NameMatch{Id: item.Id, Scope: item.Scope})
+			}
+			return matches, nil
+		})
+		if exitCode > 0 {
+			return exitCode
+		}
+		if c.Func == "update" {
+			// The name identified the resource to update, it is not a new name
+			c.FlagName = ""
+		}
+	}
+	{{ end }}
+
 	{{ if .HasName }}
 	switch c.FlagName {
 	case "":
@@ -626,7 +672,7 @@ func (c *{{ camelCase .SubActionPrefix }}Command) checkFuncError(err error) int
 		c.PrintApiError(apiErr, fmt.Sprintf("Error from controller when performing %s on %s", c.Func, c.plural))
 		return base.CommandApiError
 	}
-	c.PrintCliError(fmt.Errorf("Error trying to %s %s: %s", c.Func, c.plural, err.Error()))
+	c.PrintCliError(fmt.Errorf("Error trying to %s %s: %w", c.Func, c.plural, err))
 	return base.CommandCliError
 }
 
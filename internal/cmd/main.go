@@ -283,7 +283,7 @@ func RunCustom(args []string, runOpts *RunOptions) (exitCode int) {
 		return 1
 	}
 
-	return exitCode
+	return base.ExitCode(ui, exitCode)
 }
 
 func groupedHelpFunc(f cli.HelpFunc) cli.HelpFunc {
@@ -294,11 +294,11 @@ func groupedHelpFunc(f cli.HelpFunc) cli.HelpFunc {
 		fmt.Fprintf(tw, "Usage: boundary <command> [args]\n")
 
 		genericCommands := make([]string, 0, 3)
-		clientCommands := make([]string, 0, 9)
+		clientCommands := make([]string, 0, 10)
 		typeSpecificCommands := make([]string, 0, len(commands)-cap(genericCommands)-cap(clientCommands))
 		for k := range commands {
 			switch k {
-			case "authenticate", "config", "connect", "daemon", "dev", "client-agent", "logout", "search", "server":
+			case "authenticate", "config", "connect", "daemon", "dev", "client-agent", "logout", "search", "server", "tui":
 				clientCommands = append(clientCommands, k)
 			case "read", "update", "delete":
 				genericCommands = append(genericCommands, k)
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ops
+
+import (
+	"net/http"
+	"net/http/pprof"
+	"sync/atomic"
+	"time"
+)
+
+// profilingPathPrefix is the path under which the pprof and runtime trace
+// endpoints are served.
+const profilingPathPrefix = "/debug/pprof/"
+
+// profiling controls whether the pprof and runtime trace endpoints are
+// served. They are served either while profiling is enabled, or until the
+// end of a window opened with enableFor.
+type profiling struct {
+	enabled atomic.Bool
+	// until is the end of the current window, in Unix nanoseconds.
+	until atomic.Int64
+	now   func() time.Time
+}
+
+func newProfiling() *profiling {
+	return &profiling{now: time.Now}
+}
+
+func (p *profiling) setEnabled(enabled bool) {
+	p.enabled.Store(enabled)
+}
+
+// enableFor serves the endpoints for the given duration from now, extending
+// any window already open.
+func (p *profiling) enableFor(d time.Duration) time.Time {
+	until := p.now().Add(d)
+	for {
+		cur := p.until.Load()
+		if cur >= until.UnixNano() {
+			return time.Unix(0, cur)
+		}
+		if p.until.CompareAndSwap(cur, until.UnixNano()) {
+			return until
+		}
+	}
+}
+
+func (p *profiling) active() bool {
+	if p == nil {
+		return false
+	}
+	return p.enabled.Load() || p.now().UnixNano() < p.until.Load()
+}
+
+// handler returns a handler serving the pprof index, named profiles, CPU
+// profile and runtime trace endpoints. Requests are answered with a 404 when
+// profiling is not active, so the endpoints can't be told apart from
+// unregistered paths.
+func (p *profiling) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(profilingPathPrefix, pprof.Index)
+	mux.HandleFunc(profilingPathPrefix+"cmdline", pprof.Cmdline)
+	mux.HandleFunc(profilingPathPrefix+"profile", pprof.Profile)
+	mux.HandleFunc(profilingPathPrefix+"symbol", pprof.Symbol)
+	mux.HandleFunc(profilingPathPrefix+"trace", pprof.Trace)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !p.active() {
+			http.NotFound(w, r)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ops
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfiling(t *testing.T) {
+	now := time.Now()
+	p := newProfiling()
+	p.now = func() time.Time { return now }
+	h := p.handler()
+
+	status := func(t *testing.T, path string) int {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Code
+	}
+
+	t.Run("disabled-by-default", func(t *testing.T) {
+		assert.False(t, p.active())
+		assert.Equal(t, http.StatusNotFound, status(t, "/debug/pprof/"))
+		assert.Equal(t, http.StatusNotFound, status(t, "/debug/pprof/goroutine?debug=1"))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		p.setEnabled(true)
+		t.Cleanup(func() { p.setEnabled(false) })
+		assert.True(t, p.active())
+		assert.Equal(t, http.StatusOK, status(t, "/debug/pprof/"))
+		assert.Equal(t, http.StatusOK, status(t, "/debug/pprof/goroutine?debug=1"))
+		assert.Equal(t, http.StatusOK, status(t, "/debug/pprof/heap"))
+		assert.Equal(t, http.StatusOK, status(t, "/debug/pprof/cmdline"))
+	})
+
+	t.Run("window", func(t *testing.T) {
+		until := p.enableFor(time.Minute)
+		assert.Equal(t, now.Add(time.Minute).UnixNano(), until.UnixNano())
+		assert.True(t, p.active())
+		assert.Equal(t, http.StatusOK, status(t, "/debug/pprof/goroutine?debug=1"))
+
+		// A shorter window does not shorten the open one.
+		until = p.enableFor(time.Second)
+		assert.Equal(t, now.Add(time.Minute).UnixNano(), until.UnixNano())
+
+		now = now.Add(time.Minute)
+		assert.False(t, p.active())
+		assert.Equal(t, http.StatusNotFound, status(t, "/debug/pprof/goroutine?debug=1"))
+	})
+}
+
+func TestServer_EnableProfilingOnSignal(t *testing.T) {
+	tests := []struct {
+		name      string
+		bundles   []*opsBundle
+		window    time.Duration
+		expActive bool
+	}{
+		{
+			name:      "default-window",
+			bundles:   []*opsBundle{{}},
+			expActive: true,
+		},
+		{
+			name:      "configured-window",
+			bundles:   []*opsBundle{{}},
+			window:    time.Hour,
+			expActive: true,
+		},
+		{
+			name:    "disabled",
+			bundles: []*opsBundle{{}},
+			window:  -1,
+		},
+		{
+			name: "no-ops-listeners",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{bundles: tt.bundles, profiling: newProfiling()}
+			s.EnableProfilingOnSignal(context.Background(), tt.window)
+			require.Equal(t, tt.expActive, s.profiling.active())
+		})
+	}
+}
@@ -7,6 +7,7 @@ package ops
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -15,8 +16,11 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/daemon/controller"
 	"github.com/hashicorp/boundary/internal/daemon/worker"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/libs/buildinfo"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
@@ -24,11 +28,28 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// shutdownPath is the path of the endpoint reporting the progress of the
+// shutdown of the controller.
+const shutdownPath = "/shutdown"
+
+// rateLimitsPath is the path of the endpoint reporting the api rate limits of
+// the controller and the usage of its quota storage.
+const rateLimitsPath = "/rate-limits"
+
+// sbomPath is the path of the endpoint serving the software bill of materials
+// of the running binary.
+const sbomPath = "/sbom"
+
+// provenancePath is the path of the endpoint serving the build provenance of
+// the running binary.
+const provenancePath = "/provenance"
+
 // Server is a collection of all state required to serve
 // multiple ops endpoints through a single object.
 type Server struct {
 	bundles    []*opsBundle
 	controller *controller.Controller
+	profiling  *profiling
 }
 
 type opsBundle struct {
@@ -45,6 +66,7 @@ func NewServer(ctx context.Context, l hclog.Logger, c *controller.Controller, w
 		return nil, fmt.Errorf("%s: missing logger", op)
 	}
 
+	p := newProfiling()
 	bundles := make([]*opsBundle, 0, len(listeners))
 	for _, ln := range listeners {
 		if ln == nil || ln.Config == nil {
@@ -57,7 +79,7 @@ func NewServer(ctx context.Context, l hclog.Logger, c *controller.Controller, w
 			return nil, fmt.Errorf("%s: missing ops listener", op)
 		}
 
-		h, err := createOpsHandler(ctx, ln.Config, c, w)
+		h, err := createOpsHandler(ctx, ln.Config, c, w, p)
 		if err != nil {
 			return nil, err
 		}
@@ -70,7 +92,39 @@ func NewServer(ctx context.Context, l hclog.Logger, c *controller.Controller, w
 		bundles = append(bundles, b)
 	}
 
-	return &Server{bundles, c}, nil
+	return &Server{bundles, c, p}, nil
+}
+
+// SetProfilingEnabled sets whether the pprof and runtime trace endpoints are
+// served under /debug/pprof/ on all ops listeners.
+func (s *Server) SetProfilingEnabled(enabled bool) {
+	s.profiling.setEnabled(enabled)
+}
+
+// EnableProfilingOnSignal opens a window during which the pprof and runtime
+// trace endpoints are served, as configured for when the server receives
+// SIGUSR2. A zero window uses config.DefaultProfilingSignalWindow, and a
+// negative one leaves the endpoints as they are.
+func (s *Server) EnableProfilingOnSignal(ctx context.Context, window time.Duration) {
+	const op = "ops.(Server).EnableProfilingOnSignal"
+	if s == nil || len(s.bundles) == 0 {
+		return
+	}
+	if window == 0 {
+		window = config.DefaultProfilingSignalWindow
+	}
+	if window < 0 {
+		return
+	}
+	until := s.EnableProfilingFor(window)
+	event.WriteSysEvent(ctx, op, "profiling endpoints enabled on ops listeners", "until", until.Format(time.RFC3339))
+}
+
+// EnableProfilingFor serves the pprof and runtime trace endpoints for the
+// given duration, regardless of SetProfilingEnabled, and returns the time
+// they stop being served. An already open window is never shortened.
+func (s *Server) EnableProfilingFor(d time.Duration) time.Time {
+	return s.profiling.enableFor(d)
 }
 
 // Starts all goroutines that were set-up in NewServer.
@@ -131,7 +185,7 @@ func (s *Server) WaitIfHealthExists(d time.Duration, ui cli.Ui) {
 	<-time.After(d)
 }
 
-func createOpsHandler(ctx context.Context, lncfg *listenerutil.ListenerConfig, c *controller.Controller, w *worker.Worker) (http.Handler, error) {
+func createOpsHandler(ctx context.Context, lncfg *listenerutil.ListenerConfig, c *controller.Controller, w *worker.Worker, p *profiling) (http.Handler, error) {
 	mux := http.NewServeMux()
 	var h http.Handler
 	var err error
@@ -141,6 +195,8 @@ func createOpsHandler(ctx context.Context, lncfg *listenerutil.ListenerConfig, c
 		if err != nil {
 			return nil, err
 		}
+		mux.Handle(shutdownPath, shutdownProgressHandler(c))
+		mux.Handle(rateLimitsPath, rateLimitsHandler(c))
 		if w != nil {
 			c.HealthService.SetWorkerProcessInformationFunc(w.HealthInformation)
 		}
@@ -154,11 +210,64 @@ func createOpsHandler(ctx context.Context, lncfg *listenerutil.ListenerConfig, c
 		// Shouldn't happen since this function should only be called when
 		// either a controller or worker is starting up, but just to be safe.
 		mux.Handle("/health", h)
+		mux.Handle("/health/", h)
 	}
 	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle(sbomPath, buildInfoHandler(func() (any, error) { return buildinfo.Sbom() }))
+	mux.Handle(provenancePath, buildInfoHandler(func() (any, error) { return buildinfo.Provenance() }))
+	mux.Handle(profilingPathPrefix, p.handler())
 	return cleanhttp.PrintablePathCheckHandler(mux, nil), nil
 }
 
+// shutdownProgressHandler reports the progress of the shutdown of the
+// controller as JSON, so deployments can tell when draining has finished.
+func shutdownProgressHandler(c *controller.Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(c.ShutdownProgress())
+	})
+}
+
+// rateLimitsHandler reports the api rate limits currently enforced by the
+// controller as JSON.
+func rateLimitsHandler(c *controller.Controller) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(c.RateLimits())
+	})
+}
+
+// buildInfoHandler serves the document describing the running binary returned
+// by fn as JSON, so audits can verify what is deployed.
+func buildInfoHandler(fn func() (any, error)) http.Handler {
+	const op = "ops.buildInfoHandler"
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		doc, err := fn()
+		if err != nil {
+			event.WriteError(r.Context(), op, err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(doc)
+	})
+}
+
 func createHttpServer(l hclog.Logger, h http.Handler, lncfg *listenerutil.ListenerConfig) *http.Server {
 	s := &http.Server{
 		Handler:           h,
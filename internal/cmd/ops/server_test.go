@@ -9,6 +9,7 @@ import (
 	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"io"
@@ -27,6 +28,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/worker"
 	pbs "github.com/hashicorp/boundary/internal/gen/ops/services"
 	pbhealth "github.com/hashicorp/boundary/internal/gen/worker/health"
+	"github.com/hashicorp/boundary/internal/libs/buildinfo"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-secure-stdlib/base62"
@@ -613,6 +615,15 @@ func TestHealthEndpointLifecycle(t *testing.T) {
 	rsp, err = http.Get("http://" + tc.Config().Listeners[0].OpsListener.Addr().String() + "/health")
 	require.NoError(t, err)
 	require.Equal(t, http.StatusServiceUnavailable, rsp.StatusCode)
+
+	// The readiness endpoint also replies with 503 Service Unavailable, while
+	// the liveness endpoint keeps replying with 200 OK.
+	rsp, err = http.Get("http://" + tc.Config().Listeners[0].OpsListener.Addr().String() + "/health/ready")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, rsp.StatusCode)
+	rsp, err = http.Get("http://" + tc.Config().Listeners[0].OpsListener.Addr().String() + "/health/live")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
 }
 
 func TestWaitIfHealthExists(t *testing.T) {
@@ -705,6 +716,28 @@ func TestCreateOpsHandler(t *testing.T) {
 				rsp, err := http.Get("http://" + addr + "/health")
 				require.NoError(t, err)                               // We can do the GET request
 				require.Equal(t, http.StatusNotFound, rsp.StatusCode) // But the endpoint doesn't exist
+
+				// The build information of the binary is always served
+				rsp, err = http.Get("http://" + addr + sbomPath)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusOK, rsp.StatusCode)
+				var bom buildinfo.Bom
+				require.NoError(t, json.NewDecoder(rsp.Body).Decode(&bom))
+				assert.Equal(t, "CycloneDX", bom.BomFormat)
+				assert.NotEmpty(t, bom.Components)
+
+				rsp, err = http.Get("http://" + addr + provenancePath)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusOK, rsp.StatusCode)
+				var statement buildinfo.Statement
+				require.NoError(t, json.NewDecoder(rsp.Body).Decode(&statement))
+				assert.Equal(t, buildinfo.ProvenancePredicateType, statement.PredicateType)
+				require.Len(t, statement.Subject, 1)
+				assert.NotEmpty(t, statement.Subject[0].Digest["sha256"])
+
+				rsp, err = http.Post("http://"+addr+provenancePath, "application/json", nil)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusMethodNotAllowed, rsp.StatusCode)
 			},
 		},
 		{
@@ -725,6 +758,16 @@ func TestCreateOpsHandler(t *testing.T) {
 				body, err = io.ReadAll(rsp.Body)
 				require.NoError(t, err)
 				assert.EqualValues(t, []byte("{}"), body)
+
+				rsp, err = http.Get("http://" + addr + rateLimitsPath)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusOK, rsp.StatusCode)
+				var limits controller.RateLimits
+				require.NoError(t, json.NewDecoder(rsp.Body).Decode(&limits))
+				assert.False(t, limits.Disabled)
+				assert.NotZero(t, limits.MaxQuotas)
+				assert.Equal(t, limits.MaxQuotas, limits.QuotaStorageCapacity)
+				assert.NotEmpty(t, limits.Limits["target"]["list"])
 			},
 		},
 		{
@@ -816,7 +859,7 @@ func TestCreateOpsHandler(t *testing.T) {
 				w = tc.Worker()
 			}
 
-			h, err := createOpsHandler(ctx, tt.lncfg, c, w)
+			h, err := createOpsHandler(ctx, tt.lncfg, c, w, newProfiling())
 			if tt.expErr {
 				require.EqualError(t, err, tt.expErrMsg)
 				require.Nil(t, h)
@@ -40,6 +40,21 @@ func init() {
 				Command: base.NewCommand(ui),
 			}, nil
 		}
+		Commands["cache service"] = func() (cli.Command, error) {
+			return &cache.ServiceCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		}
+		Commands["cache service install"] = func() (cli.Command, error) {
+			return &cache.ServiceInstallCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		}
+		Commands["cache service uninstall"] = func() (cli.Command, error) {
+			return &cache.ServiceUninstallCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		}
 		// TODO(johanbrandhorst): remove after deprecation period
 		Commands["daemon"] = wrapper.WrapForDeprecation(
 			func() wrapper.WrappableCommand {
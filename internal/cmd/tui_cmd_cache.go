@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux || darwin || windows || (freebsd && (amd64 || arm64))
+
+package cmd
+
+import (
+	"github.com/hashicorp/boundary/internal/clientcache/cmd/tui"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+)
+
+func init() {
+	extraCommandsFuncs = append(extraCommandsFuncs, func(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
+		// The terminal UI lists targets from the client cache, so it is only
+		// available on platforms where the cache is supported.
+		Commands["tui"] = func() (cli.Command, error) {
+			return &tui.TuiCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		}
+	})
+}
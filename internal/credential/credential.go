@@ -7,6 +7,7 @@ package credential
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/boundary"
@@ -76,6 +77,16 @@ type Dynamic interface {
 	Purpose() Purpose
 }
 
+// Versioned is a credential whose secret is versioned by the credential
+// provider.
+type Versioned interface {
+	Credential
+
+	// SecretVersion returns the version of the secret and the time the
+	// version was created. The version is 0 if it is not known.
+	SecretVersion() (uint32, time.Time)
+}
+
 // Static is a static credential that is stored directly in a credential store.
 type Static interface {
 	boundary.Resource
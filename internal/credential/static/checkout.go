@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// A CheckoutPolicy makes a static credential exclusive: only one session at a
+// time may hold it, mirroring the checkout of a password vault. Sessions
+// authorized while another session holds the credential are denied, and if
+// QueueTimeoutSeconds is set their users are queued for it.
+type CheckoutPolicy struct {
+	CredentialId string `gorm:"primary_key"`
+	// TtlSeconds is the time a session may hold the credential. The
+	// credential is checked in when the session ends or once this time has
+	// passed, whichever comes first.
+	TtlSeconds uint32
+	// QueueTimeoutSeconds is the time a user denied the credential keeps
+	// their place in the queue for it. Retrying within this time keeps the
+	// place. If 0, users are not queued.
+	QueueTimeoutSeconds uint32
+	// RotateOnCheckIn rotates the password of a username password credential
+	// each time it is checked in, so a password is only ever seen by the
+	// user of one session. The credential must have a rotation schedule,
+	// which names the rotator.
+	RotateOnCheckIn bool
+	// RotationPending is set when a credential rotated on check in was
+	// checked in, and cleared once its password was rotated. The credential
+	// can't be checked out while its rotation is pending.
+	RotationPending bool
+
+	CreateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name for gorm.
+func (p *CheckoutPolicy) TableName() string {
+	return "credential_static_checkout_policy"
+}
+
+func (p *CheckoutPolicy) validate(ctx context.Context) error {
+	const op = "static.(CheckoutPolicy).validate"
+	switch {
+	case p.CredentialId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case p.TtlSeconds == 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing ttl")
+	}
+	return nil
+}
+
+// A Checkout is the session holding a static credential with a checkout
+// policy.
+type Checkout struct {
+	CredentialId string `gorm:"primary_key"`
+	SessionId    string
+	UserId       string
+	// CheckoutTime is the time the session checked out the credential.
+	CheckoutTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	// ExpirationTime is the time the credential is checked in if the
+	// session still holds it.
+	ExpirationTime *timestamp.Timestamp
+}
+
+// TableName returns the table name for gorm.
+func (c *Checkout) TableName() string {
+	return "credential_static_checkout"
+}
+
+// CheckoutStatus is the checkout policy of a static credential and who
+// currently holds the credential.
+type CheckoutStatus struct {
+	Policy *CheckoutPolicy
+	// Holder is the active checkout of the credential, or nil if the
+	// credential is checked in.
+	Holder *Checkout
+	// QueueLength is the number of users queued for the credential.
+	QueueLength int
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	credentialRotationJobName = "static_credential_rotation"
+
+	defaultNextRunIn = 5 * time.Minute
+	// checkInNextRunIn is the longest time the job waits while credentials
+	// are rotated on check in, so they are rotated soon after being checked
+	// in.
+	checkInNextRunIn = 30 * time.Second
+)
+
+// RegisterJobs registers the jobs of the static credential package with the
+// scheduler.
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms) error {
+	const op = "static.RegisterJobs"
+	credRotation, err := newCredentialRotationJob(ctx, r, w, kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, credRotation); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential rotation job"))
+	}
+	return nil
+}
+
+// CredentialRotationJob is the recurring job that rotates the passwords of
+// username password credentials whose rotation schedule is due. The
+// CredentialRotationJob is not thread safe, an attempt to Run the job
+// concurrently will result in an JobAlreadyRunning error.
+type CredentialRotationJob struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+	limit  int
+
+	running      ua.Bool
+	numCreds     int
+	numProcessed int
+}
+
+// newCredentialRotationJob creates a new in-memory CredentialRotationJob.
+//
+// WithLimit is the only supported option.
+func newCredentialRotationJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*CredentialRotationJob, error) {
+	const op = "static.newCredentialRotationJob"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &CredentialRotationJob{
+		reader: r,
+		writer: w,
+		kms:    kms,
+		limit:  opts.withLimit,
+	}, nil
+}
+
+// Status returns the current status of the credential rotation job. Total is
+// the total number of credentials that are due to be rotated. Completed is the
+// number of credentials already processed.
+func (j *CredentialRotationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.numProcessed,
+		Total:     j.numCreds,
+	}
+}
+
+// Run rotates the passwords of the credentials whose rotation schedule is
+// due. Checkouts that expired are checked in first, so the credentials
+// rotated on check in are rotated in the same run. A failed rotation is recorded in the credential's rotation schedule and
+// does not stop the other credentials from being rotated. Can not be run in
+// parallel, if Run is invoked while already running an error with code
+// JobAlreadyRunning will be returned.
+func (j *CredentialRotationJob) Run(ctx context.Context, _ time.Duration) error {
+	const op = "static.(CredentialRotationJob).Run"
+	if !j.running.CompareAndSwap(j.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer j.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	repo, err := NewRepository(ctx, j.reader, j.writer, j.kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := j.writer.Exec(ctx, checkInExpiredCheckoutsQuery, nil); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	schedules, err := repo.listDueRotationSchedules(ctx, j.limit)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// Set numProcessed and numCreds for status report
+	j.numProcessed, j.numCreds = 0, len(schedules)
+
+	for _, s := range schedules {
+		// Verify context is not done before rotating next credential
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := repo.RotateCredential(ctx, s.CredentialId); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error rotating credential", "credential id", s.CredentialId, "rotator", s.Rotator))
+		}
+		j.numProcessed++
+	}
+	return nil
+}
+
+// NextRunIn returns the time until the next credential rotation is due. While
+// credentials are rotated on check in, which can happen at any time, it is
+// never longer than checkInNextRunIn.
+func (j *CredentialRotationJob) NextRunIn(ctx context.Context) (time.Duration, error) {
+	const op = "static.(CredentialRotationJob).NextRunIn"
+	maxNextRunIn := defaultNextRunIn
+	checkIn, err := j.rotateOnCheckIn(ctx)
+	if err != nil {
+		return defaultNextRunIn, errors.Wrap(ctx, err, op)
+	}
+	if checkIn {
+		maxNextRunIn = checkInNextRunIn
+	}
+
+	rows, err := j.reader.Query(ctx, nextRotationInQuery, nil)
+	if err != nil {
+		return maxNextRunIn, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		type NextRotation struct {
+			RotationIn time.Duration
+		}
+		var n NextRotation
+		if err := j.reader.ScanRows(ctx, rows, &n); err != nil {
+			return maxNextRunIn, errors.Wrap(ctx, err, op)
+		}
+		if n.RotationIn < 0 {
+			// If we are past the next rotation time, return 0 to schedule immediately
+			return 0, nil
+		}
+		// Schedules created in the meantime may be due sooner, so don't wait
+		// longer than the default.
+		return min(n.RotationIn*time.Second, maxNextRunIn), nil
+	}
+	if err := rows.Err(); err != nil {
+		return maxNextRunIn, errors.Wrap(ctx, err, op)
+	}
+	return maxNextRunIn, nil
+}
+
+// rotateOnCheckIn reports whether a checkout policy rotates its credential on
+// check in.
+func (j *CredentialRotationJob) rotateOnCheckIn(ctx context.Context) (bool, error) {
+	const op = "static.(CredentialRotationJob).rotateOnCheckIn"
+	rows, err := j.reader.Query(ctx, rotateOnCheckInExistsQuery, nil)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var r struct{ RotateOnCheckIn bool }
+	for rows.Next() {
+		if err := j.reader.ScanRows(ctx, rows, &r); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return r.RotateOnCheckIn, nil
+}
+
+// Name is the unique name of the job.
+func (j *CredentialRotationJob) Name() string {
+	return credentialRotationJobName
+}
+
+// Description is the human readable description of the job.
+func (j *CredentialRotationJob) Description() string {
+	return "Periodically rotates the passwords of static username password credentials with a rotation schedule."
+}
@@ -349,5 +349,172 @@ final as (
   select *
     from final
 order by update_time desc, public_id desc;
+`
+
+	upsertRotationScheduleQuery = `
+insert into credential_static_username_password_rotation
+  (credential_id, rotator, address, database_name, interval_seconds, next_rotation_time)
+values
+  (@credential_id, @rotator, @address, @database_name, @interval_seconds, now() + make_interval(secs => @interval_seconds))
+on conflict (credential_id) do update
+  set rotator            = excluded.rotator,
+      address            = excluded.address,
+      database_name      = excluded.database_name,
+      interval_seconds   = excluded.interval_seconds,
+      next_rotation_time = coalesce(credential_static_username_password_rotation.last_rotated_time, now())
+                             + make_interval(secs => excluded.interval_seconds)
+returning *;
+`
+
+	dueRotationSchedulesQuery = `
+  select *
+    from credential_static_username_password_rotation
+   where next_rotation_time <= now()
+order by next_rotation_time
+   limit ?;
+`
+
+	rotationSucceededQuery = `
+with checked_in as (
+  update credential_static_checkout_policy
+     set rotation_pending = false
+   where credential_id = @credential_id
+     and rotation_pending
+)
+update credential_static_username_password_rotation
+   set last_rotated_time   = now(),
+       next_rotation_time  = now() + make_interval(secs => interval_seconds),
+       last_rotation_error = ''
+ where credential_id = @credential_id;
+`
+
+	rotationFailedQuery = `
+update credential_static_username_password_rotation
+   set next_rotation_time  = now() + make_interval(secs => @retry_seconds),
+       last_rotation_error = @last_rotation_error
+ where credential_id = @credential_id;
+`
+
+	nextRotationInQuery = `
+select extract(epoch from min(next_rotation_time) - now())::int as rotation_in
+  from credential_static_username_password_rotation
+having count(*) > 0;
+`
+
+	upsertCheckoutPolicyQuery = `
+insert into credential_static_checkout_policy
+  (credential_id, ttl_seconds, queue_timeout_seconds, rotate_on_check_in)
+values
+  (@credential_id, @ttl_seconds, @queue_timeout_seconds, @rotate_on_check_in)
+on conflict (credential_id) do update
+  set ttl_seconds           = excluded.ttl_seconds,
+      queue_timeout_seconds = excluded.queue_timeout_seconds,
+      rotate_on_check_in    = excluded.rotate_on_check_in,
+      rotation_pending      = credential_static_checkout_policy.rotation_pending
+                                and excluded.rotate_on_check_in
+returning *;
+`
+
+	checkoutStatusQuery = `
+   select p.*,
+          c.session_id,
+          c.user_id,
+          c.checkout_time,
+          c.expiration_time,
+          (select count(*)
+             from credential_static_checkout_queue q
+            where q.credential_id = p.credential_id
+              and q.expiration_time > now()) as queue_length
+     from credential_static_checkout_policy p
+left join credential_static_checkout c
+       on c.credential_id = p.credential_id
+      and c.expiration_time > now()
+    where p.credential_id = @credential_id;
+`
+
+	lockCheckoutPolicyQuery = `
+select *
+  from credential_static_checkout_policy
+ where credential_id = @credential_id
+   for update;
+`
+
+	disableRotateOnCheckInQuery = `
+update credential_static_checkout_policy
+   set rotate_on_check_in = false,
+       rotation_pending   = false
+ where credential_id = @credential_id
+   and rotate_on_check_in;
+`
+
+	checkInExpiredCheckoutsQuery = `
+delete from credential_static_checkout
+ where expiration_time <= now();
+`
+
+	rotateOnCheckInExistsQuery = `
+select exists (
+  select 1
+    from credential_static_checkout_policy
+   where rotate_on_check_in
+) as rotate_on_check_in;
+`
+
+	deleteExpiredCheckoutQuery = `
+delete from credential_static_checkout
+ where credential_id = @credential_id
+   and expiration_time <= now();
+`
+
+	deleteExpiredCheckoutQueueQuery = `
+delete from credential_static_checkout_queue
+ where credential_id = @credential_id
+   and expiration_time <= now();
+`
+
+	activeCheckoutQuery = `
+select *
+  from credential_static_checkout
+ where credential_id = @credential_id;
+`
+
+	checkoutQueueHeadQuery = `
+  select user_id
+    from credential_static_checkout_queue
+   where credential_id = @credential_id
+order by queue_time, user_id
+   limit 1;
+`
+
+	insertCheckoutQuery = `
+insert into credential_static_checkout
+  (credential_id, session_id, user_id, expiration_time)
+values
+  (@credential_id, @session_id, @user_id, now() + make_interval(secs => @ttl_seconds));
+`
+
+	leaveCheckoutQueueQuery = `
+delete from credential_static_checkout_queue
+ where credential_id = @credential_id
+   and user_id = @user_id;
+`
+
+	enqueueCheckoutQuery = `
+insert into credential_static_checkout_queue
+  (credential_id, user_id, expiration_time)
+values
+  (@credential_id, @user_id, now() + make_interval(secs => @queue_timeout_seconds))
+on conflict (credential_id, user_id) do update
+  set expiration_time = excluded.expiration_time;
+`
+
+	checkoutQueuePositionQuery = `
+select count(*) as position
+  from credential_static_checkout_queue q,
+       credential_static_checkout_queue u
+ where u.credential_id = @credential_id
+   and u.user_id       = @user_id
+   and q.credential_id = u.credential_id
+   and (q.queue_time, q.user_id) <= (u.queue_time, u.user_id);
 `
 )
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// SetCheckoutPolicy creates or replaces the checkout policy of a static
+// credential. Replacing a policy does not change the expiration time of an
+// active checkout. A policy rotating the credential on check in requires the
+// credential to have a rotation schedule.
+func (r *Repository) SetCheckoutPolicy(ctx context.Context, p *CheckoutPolicy) (*CheckoutPolicy, error) {
+	const op = "static.(Repository).SetCheckoutPolicy"
+	if p == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing checkout policy")
+	}
+	if err := p.validate(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if p.RotateOnCheckIn {
+		rs, err := r.LookupRotationSchedule(ctx, p.CredentialId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if rs == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "rotating on check in requires a rotation schedule")
+		}
+	}
+
+	ret := &CheckoutPolicy{}
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
+		rows, err := w.Query(ctx, upsertCheckoutPolicyQuery, []any{
+			sql.Named("credential_id", p.CredentialId),
+			sql.Named("ttl_seconds", p.TtlSeconds),
+			sql.Named("queue_timeout_seconds", p.QueueTimeoutSeconds),
+			sql.Named("rotate_on_check_in", p.RotateOnCheckIn),
+		})
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			if err := reader.ScanRows(ctx, rows, ret); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+// DeleteCheckoutPolicy removes the checkout policy of the credential, which
+// checks in the credential and empties its queue. It returns the number of
+// rows deleted, which is 0 if the credential has no checkout policy.
+func (r *Repository) DeleteCheckoutPolicy(ctx context.Context, credentialId string) (int, error) {
+	const op = "static.(Repository).DeleteCheckoutPolicy"
+	if credentialId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	var rowsDeleted int
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+		var err error
+		rowsDeleted, err = w.Delete(ctx, &CheckoutPolicy{CredentialId: credentialId})
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return rowsDeleted, nil
+}
+
+// checkoutStatusRow is a row of the checkoutStatusQuery.
+type checkoutStatusRow struct {
+	CredentialId        string
+	TtlSeconds          uint32
+	QueueTimeoutSeconds uint32
+	RotateOnCheckIn     bool
+	RotationPending     bool
+	CreateTime          *timestamp.Timestamp
+	UpdateTime          *timestamp.Timestamp
+	SessionId           string
+	UserId              string
+	CheckoutTime        *timestamp.Timestamp
+	ExpirationTime      *timestamp.Timestamp
+	QueueLength         int
+}
+
+// LookupCheckoutStatus returns the checkout policy of the credential and who
+// currently holds the credential, or nil if the credential has no checkout
+// policy.
+func (r *Repository) LookupCheckoutStatus(ctx context.Context, credentialId string) (*CheckoutStatus, error) {
+	const op = "static.(Repository).LookupCheckoutStatus"
+	if credentialId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	rows, err := r.reader.Query(ctx, checkoutStatusQuery, []any{sql.Named("credential_id", credentialId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var row *checkoutStatusRow
+	for rows.Next() {
+		row = &checkoutStatusRow{}
+		if err := r.reader.ScanRows(ctx, rows, row); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if row == nil {
+		return nil, nil
+	}
+	s := &CheckoutStatus{
+		Policy: &CheckoutPolicy{
+			CredentialId:        row.CredentialId,
+			TtlSeconds:          row.TtlSeconds,
+			QueueTimeoutSeconds: row.QueueTimeoutSeconds,
+			RotateOnCheckIn:     row.RotateOnCheckIn,
+			RotationPending:     row.RotationPending,
+			CreateTime:          row.CreateTime,
+			UpdateTime:          row.UpdateTime,
+		},
+		QueueLength: row.QueueLength,
+	}
+	if row.SessionId != "" {
+		s.Holder = &Checkout{
+			CredentialId:   row.CredentialId,
+			SessionId:      row.SessionId,
+			UserId:         row.UserId,
+			CheckoutTime:   row.CheckoutTime,
+			ExpirationTime: row.ExpirationTime,
+		}
+	}
+	return s, nil
+}
+
+// CheckoutCredentials checks out the credentials with a checkout policy for
+// the session of the user. Credentials without a checkout policy are ignored.
+// The credentials are checked in when the session is canceled or terminated,
+// or once the ttl of their policy has passed.
+//
+// Either all credentials are checked out or none is. If a credential is held
+// by another session, or a user queued before the user is waiting for it, an
+// error with the Conflict code is returned and, if the policy of the
+// credential has a queue timeout, the user is queued for the credential. The
+// same happens while a credential rotated on check in waits for its rotation.
+func (r *Repository) CheckoutCredentials(ctx context.Context, sessionId, userId string, credentialIds []string) error {
+	const op = "static.(Repository).CheckoutCredentials"
+	switch {
+	case sessionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case userId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	if len(credentialIds) == 0 {
+		return nil
+	}
+	// Policies are locked in a consistent order to avoid deadlocks between
+	// sessions checking out the same credentials.
+	ids := slices.Clone(credentialIds)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	var denied []string
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
+		denied = nil
+		var available, unavailable []*CheckoutPolicy
+		reasons := make(map[string]string)
+		for _, id := range ids {
+			for _, q := range []string{deleteExpiredCheckoutQuery, deleteExpiredCheckoutQueueQuery} {
+				if _, err := w.Exec(ctx, q, []any{sql.Named("credential_id", id)}); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			p := &CheckoutPolicy{}
+			found, err := queryRow(ctx, reader, w, lockCheckoutPolicyQuery, id, p)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if !found {
+				continue
+			}
+			if p.RotationPending {
+				// The credential is checked in, but its password may have
+				// been seen until rotated.
+				unavailable = append(unavailable, p)
+				reasons[id] = fmt.Sprintf("credential %s is waiting for its password to be rotated", id)
+				continue
+			}
+			holder := &Checkout{}
+			if found, err = queryRow(ctx, reader, w, activeCheckoutQuery, id, holder); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if found {
+				if holder.SessionId == sessionId {
+					continue
+				}
+				unavailable = append(unavailable, p)
+				reasons[id] = fmt.Sprintf("credential %s is checked out until %s", id, holder.ExpirationTime.AsTime().Format(time.RFC3339))
+				continue
+			}
+			head := &Checkout{}
+			if found, err = queryRow(ctx, reader, w, checkoutQueueHeadQuery, id, head); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if found && head.UserId != userId {
+				unavailable = append(unavailable, p)
+				reasons[id] = fmt.Sprintf("credential %s is reserved for a user queued before you", id)
+				continue
+			}
+			available = append(available, p)
+		}
+
+		if len(unavailable) == 0 {
+			for _, p := range available {
+				if _, err := w.Exec(ctx, insertCheckoutQuery, []any{
+					sql.Named("credential_id", p.CredentialId),
+					sql.Named("session_id", sessionId),
+					sql.Named("user_id", userId),
+					sql.Named("ttl_seconds", p.TtlSeconds),
+				}); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if _, err := w.Exec(ctx, leaveCheckoutQueueQuery, []any{
+					sql.Named("credential_id", p.CredentialId),
+					sql.Named("user_id", userId),
+				}); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			return nil
+		}
+
+		// Nothing is checked out, but the user is queued for the credentials
+		// it is waiting for.
+		for _, p := range unavailable {
+			reason := reasons[p.CredentialId]
+			if p.QueueTimeoutSeconds > 0 {
+				if _, err := w.Exec(ctx, enqueueCheckoutQuery, []any{
+					sql.Named("credential_id", p.CredentialId),
+					sql.Named("user_id", userId),
+					sql.Named("queue_timeout_seconds", p.QueueTimeoutSeconds),
+				}); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				var pos struct{ Position int }
+				rows, err := w.Query(ctx, checkoutQueuePositionQuery, []any{
+					sql.Named("credential_id", p.CredentialId),
+					sql.Named("user_id", userId),
+				})
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				for rows.Next() {
+					if err := reader.ScanRows(ctx, rows, &pos); err != nil {
+						rows.Close()
+						return errors.Wrap(ctx, err, op)
+					}
+				}
+				rows.Close()
+				if err := rows.Err(); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				reason = fmt.Sprintf("%s, you are number %d in its queue", reason, pos.Position)
+			}
+			denied = append(denied, reason)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if len(denied) > 0 {
+		return errors.New(ctx, errors.Conflict, op, strings.Join(denied, "; "))
+	}
+	return nil
+}
+
+// queryRow runs a query taking the credential id and scans its first row, if
+// any, into dest. It reports whether a row was found.
+func queryRow(ctx context.Context, reader db.Reader, w db.Writer, query, credentialId string, dest any) (bool, error) {
+	const op = "static.queryRow"
+	rows, err := w.Query(ctx, query, []any{sql.Named("credential_id", credentialId)})
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var found bool
+	for rows.Next() {
+		if err := reader.ScanRows(ctx, rows, dest); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		found = true
+	}
+	if err := rows.Err(); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return found, nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckoutPolicy_validate(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, (&CheckoutPolicy{CredentialId: "credup_1234567890", TtlSeconds: 60}).validate(ctx))
+
+	err := (&CheckoutPolicy{TtlSeconds: 60}).validate(ctx)
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	assert.ErrorContains(t, err, "missing credential id")
+
+	err = (&CheckoutPolicy{CredentialId: "credup_1234567890"}).validate(ctx)
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	assert.ErrorContains(t, err, "missing ttl")
+}
+
+func TestRepository_CheckoutCredentials(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+	sessRepo, err := session.NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	exclusive := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId())
+	shared := TestUsernamePasswordCredential(t, conn, wrapper, "shared", "pass", cs.GetPublicId(), prj.GetPublicId())
+	ids := []string{exclusive.GetPublicId(), shared.GetPublicId()}
+
+	_, err = repo.SetCheckoutPolicy(ctx, &CheckoutPolicy{
+		CredentialId:        exclusive.GetPublicId(),
+		TtlSeconds:          3600,
+		QueueTimeoutSeconds: 600,
+	})
+	require.NoError(t, err)
+
+	first := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	second := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	third := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+
+	// The first session checks out the exclusive credential
+	require.NoError(t, repo.CheckoutCredentials(ctx, first.GetPublicId(), first.UserId, ids))
+	status, err := repo.LookupCheckoutStatus(ctx, exclusive.GetPublicId())
+	require.NoError(t, err)
+	require.NotNil(t, status.Holder)
+	assert.Equal(t, first.GetPublicId(), status.Holder.SessionId)
+	assert.Equal(t, first.UserId, status.Holder.UserId)
+	assert.Zero(t, status.QueueLength)
+
+	// Credentials without a policy have no checkout status
+	status, err = repo.LookupCheckoutStatus(ctx, shared.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, status)
+
+	// The other sessions are denied the credential and their users queued
+	err = repo.CheckoutCredentials(ctx, second.GetPublicId(), second.UserId, ids)
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.Conflict), err))
+	assert.ErrorContains(t, err, "you are number 1 in its queue")
+	err = repo.CheckoutCredentials(ctx, third.GetPublicId(), third.UserId, ids)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "you are number 2 in its queue")
+
+	status, err = repo.LookupCheckoutStatus(ctx, exclusive.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.QueueLength)
+
+	// Canceling the first session checks in the credential, which is then
+	// reserved for the user queued first
+	_, err = sessRepo.CancelSession(ctx, first.GetPublicId(), first.Version)
+	require.NoError(t, err)
+	err = repo.CheckoutCredentials(ctx, third.GetPublicId(), third.UserId, ids)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "reserved for a user queued before you")
+	require.NoError(t, repo.CheckoutCredentials(ctx, second.GetPublicId(), second.UserId, ids))
+
+	status, err = repo.LookupCheckoutStatus(ctx, exclusive.GetPublicId())
+	require.NoError(t, err)
+	require.NotNil(t, status.Holder)
+	assert.Equal(t, second.GetPublicId(), status.Holder.SessionId)
+	assert.Equal(t, 1, status.QueueLength)
+
+	// Removing the policy checks in the credential
+	rows, err := repo.DeleteCheckoutPolicy(ctx, exclusive.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, rows)
+	require.NoError(t, repo.CheckoutCredentials(ctx, third.GetPublicId(), third.UserId, ids))
+}
+
+func TestRepository_CheckoutCredentials_RotateOnCheckIn(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	rotator := &testRotator{}
+	RegisterRotator("checkout-test", rotator)
+	t.Cleanup(func() {
+		rotatorsMu.Lock()
+		defer rotatorsMu.Unlock()
+		delete(rotators, "checkout-test")
+	})
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+	sessRepo, err := session.NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId())
+	ids := []string{cred.GetPublicId()}
+
+	policy := &CheckoutPolicy{
+		CredentialId:    cred.GetPublicId(),
+		TtlSeconds:      3600,
+		RotateOnCheckIn: true,
+	}
+	// Rotating on check in requires a rotation schedule
+	_, err = repo.SetCheckoutPolicy(ctx, policy)
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+
+	_, err = repo.SetRotationSchedule(ctx, &RotationSchedule{
+		CredentialId:    cred.GetPublicId(),
+		Rotator:         "checkout-test",
+		Address:         "db.example.com:5432",
+		IntervalSeconds: 30 * 24 * 3600,
+	})
+	require.NoError(t, err)
+	_, err = repo.SetCheckoutPolicy(ctx, policy)
+	require.NoError(t, err)
+
+	first := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	second := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	require.NoError(t, repo.CheckoutCredentials(ctx, first.GetPublicId(), first.UserId, ids))
+
+	// Checking in the credential makes its rotation due, and the credential
+	// can't be checked out until it is rotated
+	_, err = sessRepo.CancelSession(ctx, first.GetPublicId(), first.Version)
+	require.NoError(t, err)
+	status, err := repo.LookupCheckoutStatus(ctx, cred.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, status.Holder)
+	assert.True(t, status.Policy.RotationPending)
+	due, err := repo.listDueRotationSchedules(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, cred.GetPublicId(), due[0].CredentialId)
+
+	err = repo.CheckoutCredentials(ctx, second.GetPublicId(), second.UserId, ids)
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.Conflict), err))
+	assert.ErrorContains(t, err, "waiting for its password to be rotated")
+
+	require.NoError(t, repo.RotateCredential(ctx, cred.GetPublicId()))
+	require.Len(t, rotator.reqs, 1)
+	assert.Equal(t, "pass", rotator.reqs[0].CurrentPassword)
+	status, err = repo.LookupCheckoutStatus(ctx, cred.GetPublicId())
+	require.NoError(t, err)
+	assert.False(t, status.Policy.RotationPending)
+	require.NoError(t, repo.CheckoutCredentials(ctx, second.GetPublicId(), second.UserId, ids))
+
+	// Removing the rotation schedule stops rotating on check in
+	_, err = repo.DeleteRotationSchedule(ctx, cred.GetPublicId())
+	require.NoError(t, err)
+	status, err = repo.LookupCheckoutStatus(ctx, cred.GetPublicId())
+	require.NoError(t, err)
+	assert.False(t, status.Policy.RotateOnCheckIn)
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+)
+
+// SetRotationSchedule creates or replaces the rotation schedule of a username
+// password credential. When an existing schedule is replaced, the next
+// rotation is scheduled relative to the last one.
+func (r *Repository) SetRotationSchedule(ctx context.Context, s *RotationSchedule) (*RotationSchedule, error) {
+	const op = "static.(Repository).SetRotationSchedule"
+	if s == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing rotation schedule")
+	}
+	if err := s.validate(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	ret := &RotationSchedule{}
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
+		rows, err := w.Query(ctx, upsertRotationScheduleQuery, []any{
+			sql.Named("credential_id", s.CredentialId),
+			sql.Named("rotator", s.Rotator),
+			sql.Named("address", s.Address),
+			sql.Named("database_name", s.DatabaseName),
+			sql.Named("interval_seconds", s.IntervalSeconds),
+		})
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			if err := reader.ScanRows(ctx, rows, ret); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+// LookupRotationSchedule returns the rotation schedule of the credential, or
+// nil if it has none.
+func (r *Repository) LookupRotationSchedule(ctx context.Context, credentialId string) (*RotationSchedule, error) {
+	const op = "static.(Repository).LookupRotationSchedule"
+	if credentialId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	s := &RotationSchedule{CredentialId: credentialId}
+	if err := r.reader.LookupById(ctx, s); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return s, nil
+}
+
+// DeleteRotationSchedule removes the rotation schedule of the credential. It
+// returns the number of rows deleted, which is 0 if the credential has no
+// rotation schedule. A checkout policy of the credential stops rotating it on
+// check in.
+func (r *Repository) DeleteRotationSchedule(ctx context.Context, credentialId string) (int, error) {
+	const op = "static.(Repository).DeleteRotationSchedule"
+	if credentialId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	var rowsDeleted int
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+		var err error
+		rowsDeleted, err = w.Delete(ctx, &RotationSchedule{CredentialId: credentialId})
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if _, err := w.Exec(ctx, disableRotateOnCheckInQuery, []any{sql.Named("credential_id", credentialId)}); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return rowsDeleted, nil
+}
+
+// listDueRotationSchedules returns up to limit rotation schedules whose next
+// rotation time has passed, the most overdue first.
+func (r *Repository) listDueRotationSchedules(ctx context.Context, limit int) ([]*RotationSchedule, error) {
+	const op = "static.(Repository).listDueRotationSchedules"
+	rows, err := r.reader.Query(ctx, dueRotationSchedulesQuery, []any{limit})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var schedules []*RotationSchedule
+	for rows.Next() {
+		s := &RotationSchedule{}
+		if err := r.reader.ScanRows(ctx, rows, s); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		schedules = append(schedules, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return schedules, nil
+}
+
+// RotateCredential changes the password of the credential in the database
+// named by its rotation schedule, then stores the new password in the
+// credential. The outcome is recorded in the rotation schedule: a successful
+// rotation schedules the next one after the schedule's interval, a failed one
+// is retried after the interval or an hour, whichever is shorter.
+//
+// The password is changed in the database before it is stored in the
+// credential. If storing it fails, the password is changed back in the
+// database so the credential keeps working.
+func (r *Repository) RotateCredential(ctx context.Context, credentialId string) error {
+	const op = "static.(Repository).RotateCredential"
+	if credentialId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	s, err := r.LookupRotationSchedule(ctx, credentialId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if s == nil {
+		return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential %s has no rotation schedule", credentialId))
+	}
+
+	rotateErr := r.rotate(ctx, s)
+	if rotateErr != nil {
+		if err := r.recordRotation(ctx, rotationFailedQuery,
+			sql.Named("credential_id", credentialId),
+			sql.Named("retry_seconds", int64(s.retryIn().Seconds())),
+			sql.Named("last_rotation_error", rotateErr.Error()),
+		); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to record failed rotation", "credential_id", credentialId))
+		}
+		return errors.Wrap(ctx, rotateErr, op)
+	}
+	if err := r.recordRotation(ctx, rotationSucceededQuery, sql.Named("credential_id", credentialId)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (r *Repository) rotate(ctx context.Context, s *RotationSchedule) error {
+	const op = "static.(Repository).rotate"
+	rotator, ok := lookupRotator(s.Rotator)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown rotator %q", s.Rotator))
+	}
+
+	cred := allocUsernamePasswordCredential()
+	cred.PublicId = s.CredentialId
+	if err := r.reader.LookupByPublicId(ctx, cred); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", s.CredentialId)))
+	}
+	cs := allocCredentialStore()
+	cs.PublicId = cred.StoreId
+	if err := r.reader.LookupByPublicId(ctx, cs); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", cred.StoreId)))
+	}
+	creds, err := r.Retrieve(ctx, cs.ProjectId, []string{s.CredentialId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	current, ok := creds[0].(*UsernamePasswordCredential)
+	if !ok {
+		return errors.New(ctx, errors.Internal, op, fmt.Sprintf("credential %s is not a username password credential", s.CredentialId))
+	}
+
+	newPassword, err := generatePassword()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate password"))
+	}
+	req := RotationRequest{
+		Address:         s.Address,
+		Database:        s.DatabaseName,
+		Username:        current.Username,
+		CurrentPassword: string(current.Password),
+		NewPassword:     newPassword,
+	}
+	if err := rotator.RotatePassword(ctx, req); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to change password in database"))
+	}
+
+	updated := current.clone()
+	updated.Password = []byte(newPassword)
+	if _, _, err := r.UpdateUsernamePasswordCredential(ctx, cs.ProjectId, updated, current.Version, []string{passwordField}); err != nil {
+		revert := RotationRequest{
+			Address:         req.Address,
+			Database:        req.Database,
+			Username:        req.Username,
+			CurrentPassword: req.NewPassword,
+			NewPassword:     req.CurrentPassword,
+		}
+		if revertErr := rotator.RotatePassword(ctx, revert); revertErr != nil {
+			event.WriteError(ctx, op, revertErr, event.WithInfoMsg("unable to revert rotated password, the credential no longer authenticates", "credential_id", s.CredentialId))
+		}
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to store rotated password"))
+	}
+	return nil
+}
+
+func (r *Repository) recordRotation(ctx context.Context, query string, args ...any) error {
+	const op = "static.(Repository).recordRotation"
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+		if _, err := w.Exec(ctx, query, args); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
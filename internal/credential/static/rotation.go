@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const (
+	// rotatedPasswordLength is the length of the passwords generated when
+	// rotating a credential.
+	rotatedPasswordLength = 32
+	// rotatedPasswordChars are the characters of the passwords generated when
+	// rotating a credential. They are limited to alphanumerics so passwords
+	// never need escaping in the statements of the rotators.
+	rotatedPasswordChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	// rotationRetryInterval is the maximum time after which a failed rotation
+	// is retried.
+	rotationRetryInterval = time.Hour
+)
+
+// RotationRequest is the information a Rotator needs to change the password
+// of a user in a database.
+type RotationRequest struct {
+	// Address is the "host:port" address of the database server.
+	Address string
+	// Database is the name of the database to connect to, if the server
+	// requires one.
+	Database string
+	// Username is the user whose password is rotated.
+	Username string
+	// CurrentPassword is the password the user currently authenticates with.
+	CurrentPassword string
+	// NewPassword is the password the user authenticates with once rotated.
+	NewPassword string
+}
+
+// A Rotator changes the password of a database user. Rotators authenticate
+// as the user whose password is rotated, so no additional privileges are
+// needed.
+type Rotator interface {
+	// RotatePassword changes the password of req.Username from
+	// req.CurrentPassword to req.NewPassword.
+	RotatePassword(ctx context.Context, req RotationRequest) error
+}
+
+var (
+	rotatorsMu sync.RWMutex
+	rotators   = map[string]Rotator{
+		PostgresRotator: postgresRotator{},
+		MysqlRotator:    mysqlRotator{},
+	}
+)
+
+// RegisterRotator registers r under name, so rotation schedules can use it.
+// It replaces any rotator already registered under that name.
+func RegisterRotator(name string, r Rotator) {
+	rotatorsMu.Lock()
+	defer rotatorsMu.Unlock()
+	rotators[name] = r
+}
+
+func lookupRotator(name string) (Rotator, bool) {
+	rotatorsMu.RLock()
+	defer rotatorsMu.RUnlock()
+	r, ok := rotators[name]
+	return r, ok
+}
+
+// A RotationSchedule is the schedule on which the password of a username
+// password credential is rotated, and the outcome of its last rotation.
+type RotationSchedule struct {
+	CredentialId string `gorm:"primary_key"`
+	// Rotator is the name of the registered Rotator used to change the
+	// password.
+	Rotator string
+	// Address is the "host:port" address of the database server.
+	Address string
+	// DatabaseName is the name of the database to connect to, if the server
+	// requires one.
+	DatabaseName string
+	// IntervalSeconds is the time between rotations.
+	IntervalSeconds uint32
+
+	// LastRotatedTime is the time the password was last rotated, or nil if it
+	// never was.
+	LastRotatedTime *timestamp.Timestamp
+	// NextRotationTime is the time the password is next rotated.
+	NextRotationTime *timestamp.Timestamp
+	// LastRotationError is the error of the last rotation attempt, or empty
+	// if it succeeded.
+	LastRotationError string
+
+	CreateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime *timestamp.Timestamp `gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name for gorm.
+func (s *RotationSchedule) TableName() string {
+	return "credential_static_username_password_rotation"
+}
+
+func (s *RotationSchedule) validate(ctx context.Context) error {
+	const op = "static.(RotationSchedule).validate"
+	switch {
+	case s.CredentialId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case s.Rotator == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing rotator")
+	case s.Address == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing address")
+	case s.IntervalSeconds == 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing interval")
+	}
+	if _, ok := lookupRotator(s.Rotator); !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown rotator %q", s.Rotator))
+	}
+	return nil
+}
+
+// retryIn returns the time after which a failed rotation is retried.
+func (s *RotationSchedule) retryIn() time.Duration {
+	interval := time.Duration(s.IntervalSeconds) * time.Second
+	return min(interval, rotationRetryInterval)
+}
+
+// generatePassword returns a random password for a rotated credential.
+func generatePassword() (string, error) {
+	max := big.NewInt(int64(len(rotatedPasswordChars)))
+	b := make([]byte, rotatedPasswordLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = rotatedPasswordChars[n.Int64()]
+	}
+	return string(b), nil
+}
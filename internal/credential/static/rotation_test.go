@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRotator struct {
+	reqs []RotationRequest
+}
+
+func (r *testRotator) RotatePassword(_ context.Context, req RotationRequest) error {
+	r.reqs = append(r.reqs, req)
+	return nil
+}
+
+func TestRotationSchedule_validate(t *testing.T) {
+	ctx := context.Background()
+	valid := func() *RotationSchedule {
+		return &RotationSchedule{
+			CredentialId:    "credup_1234567890",
+			Rotator:         PostgresRotator,
+			Address:         "db.example.com:5432",
+			IntervalSeconds: 3600,
+		}
+	}
+	require.NoError(t, valid().validate(ctx))
+
+	tests := []struct {
+		name    string
+		mutate  func(*RotationSchedule)
+		wantErr string
+	}{
+		{"missing-credential-id", func(s *RotationSchedule) { s.CredentialId = "" }, "missing credential id"},
+		{"missing-rotator", func(s *RotationSchedule) { s.Rotator = "" }, "missing rotator"},
+		{"missing-address", func(s *RotationSchedule) { s.Address = "" }, "missing address"},
+		{"missing-interval", func(s *RotationSchedule) { s.IntervalSeconds = 0 }, "missing interval"},
+		{"unknown-rotator", func(s *RotationSchedule) { s.Rotator = "oracle" }, `unknown rotator "oracle"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid()
+			tt.mutate(s)
+			err := s.validate(ctx)
+			require.Error(t, err)
+			assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRegisterRotator(t *testing.T) {
+	r := &testRotator{}
+	RegisterRotator("test", r)
+	t.Cleanup(func() {
+		rotatorsMu.Lock()
+		defer rotatorsMu.Unlock()
+		delete(rotators, "test")
+	})
+
+	got, ok := lookupRotator("test")
+	require.True(t, ok)
+	assert.Same(t, r, got)
+	for _, name := range []string{PostgresRotator, MysqlRotator} {
+		_, ok := lookupRotator(name)
+		assert.True(t, ok, name)
+	}
+	_, ok = lookupRotator("unknown")
+	assert.False(t, ok)
+}
+
+func TestRotationSchedule_retryIn(t *testing.T) {
+	assert.Equal(t, 10*time.Minute, (&RotationSchedule{IntervalSeconds: 600}).retryIn())
+	assert.Equal(t, time.Hour, (&RotationSchedule{IntervalSeconds: 30 * 24 * 3600}).retryIn())
+}
+
+func TestGeneratePassword(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 10; i++ {
+		p, err := generatePassword()
+		require.NoError(t, err)
+		assert.Len(t, p, rotatedPasswordLength)
+		assert.Regexp(t, "^[a-zA-Z0-9]+$", p)
+		assert.False(t, seen[p])
+		seen[p] = true
+	}
+}
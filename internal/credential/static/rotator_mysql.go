@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// MysqlRotator is the name of the rotator which rotates the password of a
+// MySQL user.
+const MysqlRotator = "mysql"
+
+type mysqlRotator struct{}
+
+// RotatePassword connects to the MySQL server as req.Username and changes
+// the password of its user.
+func (mysqlRotator) RotatePassword(ctx context.Context, req RotationRequest) error {
+	cfg := mysql.NewConfig()
+	cfg.Net = "tcp"
+	cfg.Addr = req.Address
+	cfg.DBName = req.Database
+	cfg.User = req.Username
+	cfg.Passwd = req.CurrentPassword
+	// alter user is not supported by the prepared statement protocol, so
+	// parameters are interpolated by the driver instead.
+	cfg.InterpolateParams = true
+
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return fmt.Errorf("configuring mysql connection: %w", err)
+	}
+	d := sql.OpenDB(connector)
+	defer d.Close()
+
+	if _, err := d.ExecContext(ctx, "alter user current_user() identified by ?", req.NewPassword); err != nil {
+		return fmt.Errorf("changing password: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// PostgresRotator is the name of the rotator which rotates the password of a
+// PostgreSQL role.
+const PostgresRotator = "postgres"
+
+type postgresRotator struct{}
+
+// RotatePassword connects to the PostgreSQL server as req.Username and
+// changes the password of its role.
+func (postgresRotator) RotatePassword(ctx context.Context, req RotationRequest) error {
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(req.Username, req.CurrentPassword),
+		Host:   req.Address,
+		Path:   "/" + req.Database,
+	}
+	conn, err := pgx.Connect(ctx, u.String())
+	if err != nil {
+		return fmt.Errorf("connecting to postgres: %w", err)
+	}
+	defer conn.Close(ctx)
+
+	// alter role does not accept parameters, so the password is escaped as a
+	// string literal instead.
+	password, err := conn.PgConn().EscapeString(req.NewPassword)
+	if err != nil {
+		return fmt.Errorf("escaping password: %w", err)
+	}
+	if _, err := conn.Exec(ctx, fmt.Sprintf("alter role current_user with password '%s'", password)); err != nil {
+		return fmt.Errorf("changing password: %w", err)
+	}
+	return nil
+}
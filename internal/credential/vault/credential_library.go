@@ -37,8 +37,9 @@ type CredentialLibrary struct {
 
 // NewCredentialLibrary creates a new in memory CredentialLibrary
 // for a Vault backend at vaultPath assigned to storeId.
-// Name, description, method, request body, credential type, and mapping
-// override are the only valid options. All other options are ignored.
+// Name, description, method, request body, credential type, mapping
+// override, namespace, KV version, and KV minimum version are the only
+// valid options. All other options are ignored.
 func NewCredentialLibrary(storeId string, vaultPath string, opt ...Option) (*CredentialLibrary, error) {
 	const op = "vault.NewCredentialLibrary"
 	opts := getOpts(opt...)
@@ -53,6 +54,9 @@ func NewCredentialLibrary(storeId string, vaultPath string, opt ...Option) (*Cre
 			HttpRequestBody: opts.withRequestBody,
 			HttpMethod:      string(opts.withMethod),
 			CredentialType:  string(opts.withCredentialType),
+			Namespace:       opts.withNamespace,
+			KvVersion:       opts.withKvVersion,
+			KvMinVersion:    opts.withKvMinVersion,
 		},
 	}
 
@@ -63,6 +67,10 @@ func (l *CredentialLibrary) validate(ctx context.Context, caller errors.Op) erro
 	switch {
 	case !validMappingOverride(l.MappingOverride, l.CredentialType()):
 		return errors.New(ctx, errors.VaultInvalidMappingOverride, caller, "invalid credential type for mapping override")
+	case l.KvVersion != 0 && l.KvMinVersion != 0:
+		return errors.New(ctx, errors.InvalidParameter, caller, "kv version and kv min version cannot both be set")
+	case (l.KvVersion != 0 || l.KvMinVersion != 0) && Method(l.HttpMethod) != MethodGet:
+		return errors.New(ctx, errors.InvalidParameter, caller, "kv version and kv min version are only allowed with the GET method")
 	}
 	return nil
 }
@@ -144,6 +152,7 @@ type listCredentialLibraryResult struct {
 	Name                      string
 	Description               string
 	VaultPath                 string
+	Namespace                 string
 	CredentialType            string
 	HttpMethod                string
 	HttpRequestBody           string
@@ -158,6 +167,8 @@ type listCredentialLibraryResult struct {
 	UpdateTime                *timestamp.Timestamp
 	Version                   int
 	KeyBits                   int
+	KvVersion                 int
+	KvMinVersion              int
 	Type                      string
 }
 
@@ -177,6 +188,9 @@ func (l *listCredentialLibraryResult) toLibrary(ctx context.Context) (credential
 				VaultPath:      l.VaultPath,
 				CredentialType: l.CredentialType,
 				HttpMethod:     l.HttpMethod,
+				Namespace:      l.Namespace,
+				KvVersion:      uint32(l.KvVersion),
+				KvMinVersion:   uint32(l.KvMinVersion),
 			},
 		}
 		// Assign byte slices only if the string isn't empty
@@ -204,6 +218,7 @@ func (l *listCredentialLibraryResult) toLibrary(ctx context.Context) (credential
 				CriticalOptions:           l.CriticalOptions,
 				Extensions:                l.Extensions,
 				AdditionalValidPrincipals: l.AdditionalValidPrincipals,
+				Namespace:                 l.Namespace,
 			},
 		}, nil
 	default:
@@ -30,8 +30,11 @@ type CredentialStore struct {
 
 // NewCredentialStore creates a new in memory CredentialStore for a Vault
 // server at vaultAddress assigned to projectId. Name, description, CA cert,
-// client cert, namespace, TLS server name, worker filter, and TLS skip verify are the
-// only valid options. All other options are ignored.
+// client cert, cert auth, namespace, TLS server name, worker filter, and TLS
+// skip verify are the only valid options. All other options are ignored.
+//
+// The token can be empty if cert auth is used, the credential store then
+// logs in to Vault with its client certificate to get its token.
 func NewCredentialStore(projectId string, vaultAddress string, token TokenSecret, opt ...Option) (*CredentialStore, error) {
 	opts := getOpts(opt...)
 	cs := &CredentialStore{
@@ -47,6 +50,9 @@ func NewCredentialStore(projectId string, vaultAddress string, token TokenSecret
 			TlsServerName: opts.withTlsServerName,
 			TlsSkipVerify: opts.withTlsSkipVerify,
 			WorkerFilter:  opts.withWorkerFilter,
+
+			CertAuthMountPath: opts.withCertAuth.mountPath,
+			CertAuthRoleName:  opts.withCertAuth.roleName,
 		},
 	}
 	return cs, nil
@@ -117,6 +123,10 @@ func (cs *CredentialStore) applyUpdate(new *CredentialStore, fieldMaskPaths []st
 			cp.inputToken = new.inputToken
 		case strings.EqualFold(workerFilterField, f):
 			cp.WorkerFilter = new.WorkerFilter
+		case strings.EqualFold(certAuthMountPathField, f):
+			cp.CertAuthMountPath = new.CertAuthMountPath
+		case strings.EqualFold(certAuthRoleNameField, f):
+			cp.CertAuthRoleName = new.CertAuthRoleName
 		}
 	}
 	return cp
@@ -187,6 +197,8 @@ func (cs *CredentialStore) client(ctx context.Context) (vaultClient, error) {
 		TlsServerName: cs.TlsServerName,
 		TlsSkipVerify: cs.TlsSkipVerify,
 		Namespace:     cs.Namespace,
+
+		CertAuthMountPath: cs.CertAuthMountPath,
 	}
 	if cs.clientCert != nil {
 		clientConfig.ClientCert = cs.clientCert.GetCertificate()
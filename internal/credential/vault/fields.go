@@ -11,6 +11,8 @@ const (
 	vaultPathField       = "VaultPath"
 	httpMethodField      = "HttpMethod"
 	httpRequestBodyField = "HttpRequestBody"
+	kvVersionField       = "KvVersion"
+	kvMinVersionField    = "KvMinVersion"
 
 	usernameField = "Username"
 	keyTypeField  = "KeyType"
@@ -37,6 +39,9 @@ const (
 	tokenField          = "Token"
 	workerFilterField   = "WorkerFilter"
 
+	certAuthMountPathField = "CertAuthMountPath"
+	certAuthRoleNameField  = "CertAuthRoleName"
+
 	// MappingOverrideField represents the field mask indicating a mapping override
 	// update has been requested.
 	MappingOverrideField = "MappingOverride"
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package kv2 provides access to the metadata of a version of a secret
+// stored in a Vault KV v2 secrets engine.
+package kv2
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package kv2
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type data map[string]any
+
+// Metadata is the metadata of a version of a KV v2 secret.
+type Metadata struct {
+	Version     uint32
+	CreatedTime time.Time
+}
+
+// ExtractMetadata returns the metadata of the version of the KV v2 secret
+// in d.
+//
+// It validates d is in the expected KV v2 format:
+//
+//	{
+//		"data": {},
+//		"metadata: {}
+//	}
+//
+// If the format does not match or the metadata does not contain a version,
+// it returns nil. See:
+// https://www.vaultproject.io/api/secret/kv/kv-v2#sample-response-1
+func ExtractMetadata(d data) *Metadata {
+	if d == nil {
+		// nothing to do return early
+		return nil
+	}
+
+	var hasData bool
+	var metadata map[string]any
+	for k, v := range d {
+		switch k {
+		case "data":
+			// data is null if the version of the secret has been deleted
+			if _, ok := v.(map[string]any); !ok && v != nil {
+				return nil
+			}
+			hasData = true
+		case "metadata":
+			var ok bool
+			if metadata, ok = v.(map[string]any); !ok {
+				return nil
+			}
+		default:
+			// d contains a non valid KV v2 top level field
+			return nil
+		}
+	}
+	if !hasData || metadata == nil {
+		// missing required KV v2 field
+		return nil
+	}
+
+	version, ok := toVersion(metadata["version"])
+	if !ok {
+		return nil
+	}
+	md := &Metadata{
+		Version: version,
+	}
+	if ct, ok := metadata["created_time"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ct); err == nil {
+			md.CreatedTime = t
+		}
+	}
+	return md
+}
+
+// toVersion converts v to a version. The Vault client decodes numbers as
+// json.Number but other decoders produce float64.
+func toVersion(v any) (uint32, bool) {
+	var i int64
+	switch n := v.(type) {
+	case json.Number:
+		var err error
+		if i, err = n.Int64(); err != nil {
+			return 0, false
+		}
+	case float64:
+		i = int64(n)
+	case int:
+		i = int64(n)
+	default:
+		return 0, false
+	}
+	if i <= 0 || i > int64(^uint32(0)) {
+		return 0, false
+	}
+	return uint32(i), true
+}
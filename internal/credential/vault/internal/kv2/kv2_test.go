@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package kv2
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractMetadata(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 3, 14, 15, 9, 26, 535897000, time.UTC)
+	tests := []struct {
+		name  string
+		given data
+		want  *Metadata
+	}{
+		{
+			name: "nil-input",
+		},
+		{
+			name: "not-kv2",
+			given: data{
+				"username": "user",
+				"password": "pass",
+			},
+		},
+		{
+			name: "extra-top-level-field",
+			given: data{
+				"data":     map[string]any{},
+				"metadata": map[string]any{"version": json.Number("1")},
+				"bad":      "field",
+			},
+		},
+		{
+			name: "missing-data",
+			given: data{
+				"metadata": map[string]any{"version": json.Number("1")},
+			},
+		},
+		{
+			name: "invalid-metadata",
+			given: data{
+				"data":     map[string]any{},
+				"metadata": "version 1",
+			},
+		},
+		{
+			name: "missing-version",
+			given: data{
+				"data":     map[string]any{},
+				"metadata": map[string]any{"created_time": "2024-03-14T15:09:26.535897Z"},
+			},
+		},
+		{
+			name: "invalid-version",
+			given: data{
+				"data":     map[string]any{},
+				"metadata": map[string]any{"version": json.Number("-1")},
+			},
+		},
+		{
+			name: "json-number-version",
+			given: data{
+				"data": map[string]any{
+					"username": "user",
+					"password": "pass",
+				},
+				"metadata": map[string]any{
+					"version":      json.Number("3"),
+					"created_time": "2024-03-14T15:09:26.535897Z",
+				},
+			},
+			want: &Metadata{Version: 3, CreatedTime: created},
+		},
+		{
+			name: "float-version",
+			given: data{
+				"data":     map[string]any{},
+				"metadata": map[string]any{"version": float64(2)},
+			},
+			want: &Metadata{Version: 2},
+		},
+		{
+			name: "invalid-created-time",
+			given: data{
+				"data": map[string]any{},
+				"metadata": map[string]any{
+					"version":      json.Number("3"),
+					"created_time": "yesterday",
+				},
+			},
+			want: &Metadata{Version: 3},
+		},
+		{
+			name: "deleted-version",
+			given: data{
+				"data": nil,
+				"metadata": map[string]any{
+					"version":      json.Number("4"),
+					"created_time": "2024-03-14T15:09:26.535897Z",
+				},
+			},
+			want: &Metadata{Version: 4, CreatedTime: created},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, ExtractMetadata(tt.given))
+		})
+	}
+}
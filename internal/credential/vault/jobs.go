@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/scheduler"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	vault "github.com/hashicorp/vault/api"
 	ua "go.uber.org/atomic"
 )
@@ -28,6 +29,11 @@ const (
 
 	defaultNextRunIn = 5 * time.Minute
 	renewalWindow    = 10 * time.Minute
+
+	// certAuthLoginWindow is the remaining TTL of a renewed token below
+	// which a credential store using cert auth logs in to Vault again, as
+	// the token is reaching its max TTL.
+	certAuthLoginWindow = 2 * renewalWindow
 )
 
 func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms) error {
@@ -128,7 +134,8 @@ func (r *TokenRenewalJob) Status() scheduler.JobStatus {
 }
 
 // Run queries the vault credential repo for tokens that need to be renewed, it then creates
-// a vault client and renews each token.  Can not be run in parallel, if Run is invoked while
+// a vault client and renews each token. Credential stores using cert auth without a current
+// token then log in to Vault to get a new one. Can not be run in parallel, if Run is invoked while
 // already running an error with code JobAlreadyRunning will be returned.
 func (r *TokenRenewalJob) Run(ctx context.Context, _ time.Duration) error {
 	const op = "vault.(TokenRenewalJob).Run"
@@ -166,6 +173,31 @@ func (r *TokenRenewalJob) Run(ctx context.Context, _ time.Duration) error {
 		r.numProcessed++
 	}
 
+	// Fetch the stores using cert auth whose current token has expired,
+	// including the ones whose token expired above, or which failed to log
+	// in on a previous run.
+	var expired []*clientStore
+	if err := r.reader.SearchWhere(ctx, &expired, certAuthExpiredStoresWhere, nil, db.WithLimit(r.limit)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, s := range expired {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		databaseWrapper, err := r.kms.GetWrapper(ctx, s.ProjectId, kms.KeyPurposeDatabase)
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to get database wrapper", "credential store id", s.PublicId))
+			continue
+		}
+		if err := s.decrypt(ctx, databaseWrapper); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to decrypt credential store", "credential store id", s.PublicId))
+			continue
+		}
+		if err := r.loginWithCert(ctx, s, databaseWrapper); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error logging in to vault with client certificate", "credential store id", s.PublicId))
+		}
+	}
+
 	return nil
 }
 
@@ -235,6 +267,55 @@ func (r *TokenRenewalJob) renewToken(ctx context.Context, s *clientStore) error
 		return errors.New(ctx, errors.Unknown, op, "token renewed but failed to update repo")
 	}
 
+	if s.CertAuthMountPath != "" && s.TokenStatus == string(CurrentToken) && tokenExpires < certAuthLoginWindow {
+		// The token is reaching its max TTL and can no longer be renewed
+		// for long, log in again before it expires. The current token is
+		// kept as a maintaining token for the credentials it issued.
+		if err := r.loginWithCert(ctx, s, databaseWrapper); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+
+	return nil
+}
+
+// loginWithCert logs in to Vault with the client certificate of s, which
+// must be decrypted, and stores the token Vault returns as the current token
+// of s.
+func (r *TokenRenewalJob) loginWithCert(ctx context.Context, s *clientStore, databaseWrapper wrapping.Wrapper) error {
+	const op = "vault.(TokenRenewalJob).loginWithCert"
+	vc, err := s.client(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	login, err := vc.loginWithCert(ctx, s.CertAuthMountPath, s.CertAuthRoleName)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	tokenExpires, err := login.TokenTTL()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault token expiration"))
+	}
+	accessor, err := login.TokenAccessor()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault token accessor"))
+	}
+	token, err := newToken(ctx, s.PublicId, TokenSecret(login.Auth.ClientToken), []byte(accessor), tokenExpires)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := token.encrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	query, values := token.insertQuery()
+	numRows, err := r.writer.Exec(ctx, query, values)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if numRows != 1 {
+		return errors.New(ctx, errors.Unknown, op, "logged in but failed to insert token")
+	}
+	event.WriteSysEvent(ctx, op, "Vault credential store logged in to Vault with its client certificate", "credential store id", s.PublicId)
 	return nil
 }
 
@@ -245,6 +326,17 @@ func (r *TokenRenewalJob) NextRunIn(ctx context.Context) (time.Duration, error)
 	if err != nil {
 		return defaultNextRunIn, errors.Wrap(ctx, err, op)
 	}
+	if next > defaultNextRunIn {
+		// Retry logging in for the stores using cert auth that have no
+		// current token.
+		var expired []*clientStore
+		if err := r.reader.SearchWhere(ctx, &expired, certAuthExpiredStoresWhere, nil, db.WithLimit(1)); err != nil {
+			return defaultNextRunIn, errors.Wrap(ctx, err, op)
+		}
+		if len(expired) > 0 {
+			return defaultNextRunIn, nil
+		}
+	}
 
 	return next, nil
 }
@@ -28,9 +28,12 @@ type options struct {
 	withTlsSkipVerify  bool
 	withWorkerFilter   string
 	withClientCert     *ClientCertificate
+	withCertAuth       certAuth
 	withMethod         Method
 	withRequestBody    []byte
 	withCredentialType globals.CredentialType
+	withKvVersion      uint32
+	withKvMinVersion   uint32
 
 	withOverrideUsernameAttribute             string
 	withOverridePasswordAttribute             string
@@ -122,6 +125,21 @@ func WithClientCert(clientCert *ClientCertificate) Option {
 	}
 }
 
+// WithCertAuth provides the mount path of an optional Vault TLS
+// certificate auth method, and the optional name of its role, used to log
+// in to a Vault server with the client certificate instead of providing a
+// token.
+func WithCertAuth(mountPath, roleName string) Option {
+	return func(o *options) {
+		o.withCertAuth = certAuth{mountPath: mountPath, roleName: roleName}
+	}
+}
+
+type certAuth struct {
+	mountPath string
+	roleName  string
+}
+
 // WithMethod provides an optional Method to use for communicating with
 // Vault.
 func WithMethod(m Method) Option {
@@ -138,6 +156,22 @@ func WithRequestBody(b []byte) Option {
 	}
 }
 
+// WithKvVersion provides an optional version of a KV v2 secret to read
+// when requesting credentials using HTTP Get.
+func WithKvVersion(v uint32) Option {
+	return func(o *options) {
+		o.withKvVersion = v
+	}
+}
+
+// WithKvMinVersion provides an optional minimum version of a KV v2 secret
+// to accept when requesting credentials using HTTP Get.
+func WithKvMinVersion(v uint32) Option {
+	return func(o *options) {
+		o.withKvMinVersion = v
+	}
+}
+
 // WithCredentialType provides an optional credential type to associate
 // with a credential library.
 func WithCredentialType(t globals.CredentialType) Option {
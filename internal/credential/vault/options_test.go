@@ -94,6 +94,18 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withRequestBody = []byte("body")
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithKvVersion", func(t *testing.T) {
+		opts := getOpts(WithKvVersion(3))
+		testOpts := getDefaultOptions()
+		testOpts.withKvVersion = 3
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithKvMinVersion", func(t *testing.T) {
+		opts := getOpts(WithKvMinVersion(2))
+		testOpts := getDefaultOptions()
+		testOpts.withKvMinVersion = 2
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithCredentialType", func(t *testing.T) {
 		opts := getOpts(WithCredentialType(globals.UsernamePasswordCredentialType))
 		testOpts := getDefaultOptions()
@@ -22,6 +22,7 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/vault/internal/kv2"
 	"github.com/hashicorp/boundary/internal/credential/vault/internal/sshprivatekey"
 	"github.com/hashicorp/boundary/internal/credential/vault/internal/usernamepassword"
 	"github.com/hashicorp/boundary/internal/db/sentinel"
@@ -38,7 +39,10 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-var _ credential.Dynamic = (*baseCred)(nil)
+var (
+	_ credential.Dynamic   = (*baseCred)(nil)
+	_ credential.Versioned = (*baseCred)(nil)
+)
 
 type baseCred struct {
 	*Credential
@@ -54,6 +58,16 @@ func (bc *baseCred) getExpiration() time.Duration  { return bc.expiration }
 func (bc *baseCred) getCredential() *Credential    { return bc.Credential }
 func (bc *baseCred) isRevokable() bool             { return bc.ExternalId != sentinel.ExternalIdNone }
 
+// SecretVersion returns the version of the secret and the time the version
+// was created if the secret was read from a Vault KV v2 secrets engine.
+func (bc *baseCred) SecretVersion() (uint32, time.Time) {
+	md := kv2.ExtractMetadata(bc.secretData)
+	if md == nil {
+		return 0, time.Time{}
+	}
+	return md.Version, md.CreatedTime
+}
+
 // convert converts bc to a specific credential type if bc is not
 // UnspecifiedType.
 func convert(ctx context.Context, bc *baseCred) (dynamicCred, error) {
@@ -190,6 +204,8 @@ type genericIssuingCredentialLibrary struct {
 	VaultPath                     string
 	HttpMethod                    string
 	HttpRequestBody               []byte
+	KvVersion                     uint32
+	KvMinVersion                  uint32
 	CredType                      string
 	ProjectId                     string
 	VaultAddress                  string
@@ -234,6 +250,8 @@ func (pl *genericIssuingCredentialLibrary) clone() *genericIssuingCredentialLibr
 		VaultPath:                     pl.VaultPath,
 		HttpMethod:                    pl.HttpMethod,
 		HttpRequestBody:               append(pl.HttpRequestBody[:0:0], pl.HttpRequestBody...),
+		KvVersion:                     pl.KvVersion,
+		KvMinVersion:                  pl.KvMinVersion,
 		VaultAddress:                  pl.VaultAddress,
 		Namespace:                     pl.Namespace,
 		CaCert:                        append(pl.CaCert[:0:0], pl.CaCert...),
@@ -358,7 +376,11 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 
 	switch Method(pl.HttpMethod) {
 	case MethodGet:
-		secret, reqErr = client.get(ctx, path)
+		if pl.KvVersion > 0 {
+			secret, reqErr = client.getVersion(ctx, path, pl.KvVersion)
+		} else {
+			secret, reqErr = client.get(ctx, path)
+		}
 	case MethodPost:
 		secret, reqErr = client.post(ctx, path, []byte(body))
 	default:
@@ -373,6 +395,15 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 	if secret == nil {
 		return nil, errors.E(ctx, errors.WithCode(errors.VaultEmptySecret), errors.WithOp(op))
 	}
+	if pl.KvMinVersion > 0 {
+		md := kv2.ExtractMetadata(secret.Data)
+		switch {
+		case md == nil:
+			return nil, errors.New(ctx, errors.VaultCredentialRequest, op, fmt.Sprintf("secret is not a kv v2 secret: library: %s", pl.PublicId))
+		case md.Version < pl.KvMinVersion:
+			return nil, errors.New(ctx, errors.VaultCredentialRequest, op, fmt.Sprintf("secret version %d is older than the minimum version %d: library: %s", md.Version, pl.KvMinVersion, pl.PublicId))
+		}
+	}
 
 	leaseDuration := time.Duration(secret.LeaseDuration) * time.Second
 	cred, err := newCredential(ctx, pl.GetPublicId(), secret.LeaseID, pl.TokenHmac, leaseDuration)
@@ -471,6 +502,8 @@ type privateCredentialLibraryAllTypes struct {
 	VaultPath                     string
 	HttpMethod                    string
 	HttpRequestBody               []byte
+	KvVersion                     uint32
+	KvMinVersion                  uint32
 	CredType                      string `gorm:"column:credential_type"`
 	ProjectId                     string
 	VaultAddress                  string
@@ -563,6 +596,8 @@ func (pl *privateCredentialLibraryAllTypes) clone() *privateCredentialLibraryAll
 		VaultPath:                     pl.VaultPath,
 		HttpMethod:                    pl.HttpMethod,
 		HttpRequestBody:               append(pl.HttpRequestBody[:0:0], pl.HttpRequestBody...),
+		KvVersion:                     pl.KvVersion,
+		KvMinVersion:                  pl.KvMinVersion,
 		VaultAddress:                  pl.VaultAddress,
 		Namespace:                     pl.Namespace,
 		CaCert:                        append(pl.CaCert[:0:0], pl.CaCert...),
@@ -646,6 +681,8 @@ func (pl *privateCredentialLibraryAllTypes) toTypedIssuingCredentialLibrary() is
 			VaultPath:                     pl.VaultPath,
 			HttpMethod:                    pl.HttpMethod,
 			HttpRequestBody:               pl.HttpRequestBody,
+			KvVersion:                     pl.KvVersion,
+			KvMinVersion:                  pl.KvMinVersion,
 			VaultAddress:                  pl.VaultAddress,
 			Namespace:                     pl.Namespace,
 			CaCert:                        pl.CaCert,
@@ -43,25 +43,27 @@ func (r *Repository) lookupClientStore(ctx context.Context, publicId string) (*c
 // a Vault client. If the Vault token for the store is expired all token data will be null
 // other than the status of expired.
 type clientStore struct {
-	PublicId         string `gorm:"primary_key"`
-	ProjectId        string
-	DeleteTime       *timestamp.Timestamp
-	VaultAddress     string
-	Namespace        string
-	CaCert           []byte
-	TlsServerName    string
-	TlsSkipVerify    bool
-	WorkerFilter     string
-	TokenHmac        []byte
-	Token            TokenSecret
-	CtToken          []byte
-	TokenRenewalTime *timestamp.Timestamp
-	TokenKeyId       string
-	TokenStatus      string
-	ClientCert       []byte
-	ClientKeyId      string
-	ClientKey        KeySecret
-	CtClientKey      []byte
+	PublicId          string `gorm:"primary_key"`
+	ProjectId         string
+	DeleteTime        *timestamp.Timestamp
+	VaultAddress      string
+	Namespace         string
+	CaCert            []byte
+	TlsServerName     string
+	TlsSkipVerify     bool
+	WorkerFilter      string
+	CertAuthMountPath string
+	CertAuthRoleName  string
+	TokenHmac         []byte
+	Token             TokenSecret
+	CtToken           []byte
+	TokenRenewalTime  *timestamp.Timestamp
+	TokenKeyId        string
+	TokenStatus       string
+	ClientCert        []byte
+	ClientKeyId       string
+	ClientKey         KeySecret
+	CtClientKey       []byte
 }
 
 func allocClientStore() *clientStore {
@@ -79,6 +81,8 @@ func (ps *clientStore) toCredentialStore() *CredentialStore {
 	cs.TlsServerName = ps.TlsServerName
 	cs.TlsSkipVerify = ps.TlsSkipVerify
 	cs.WorkerFilter = ps.WorkerFilter
+	cs.CertAuthMountPath = ps.CertAuthMountPath
+	cs.CertAuthRoleName = ps.CertAuthRoleName
 	cs.privateToken = ps.token()
 	if ps.ClientCert != nil {
 		cert := allocClientCertificate()
@@ -147,6 +151,8 @@ func (ps *clientStore) client(ctx context.Context) (vaultClient, error) {
 		TlsServerName: ps.TlsServerName,
 		TlsSkipVerify: ps.TlsSkipVerify,
 		Namespace:     ps.Namespace,
+
+		CertAuthMountPath: ps.CertAuthMountPath,
 	}
 
 	if ps.ClientKey != nil {
@@ -129,6 +129,8 @@ select extract(epoch from (last_renewal_time + (expiration_time - last_renewal_t
        );
 `
 
+	certAuthExpiredStoresWhere = `token_status = 'expired' and cert_auth_mount_path is not null`
+
 	revokeCredentialsQuery = `
 update credential_vault_credential
    set status = 'revoke'
@@ -227,6 +229,7 @@ final as (
          update_time,
          version,
          vault_path,
+         namespace,
          credential_type,
          http_method,
          http_request_body,
@@ -238,6 +241,8 @@ final as (
          null as critical_options,             -- Add to make union uniform
          null as extensions,                   -- Add to make union uniform
          null as additional_valid_principals,  -- Add to make union uniform
+         kv_version,
+         kv_min_version,
          'generic' as type
     from generic_libs
    union
@@ -250,6 +255,7 @@ final as (
          update_time,
          version,
          vault_path,
+         namespace,
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
@@ -261,6 +267,8 @@ final as (
          critical_options,
          extensions,
          additional_valid_principals,
+         null as kv_version,        -- Add to make union uniform
+         null as kv_min_version,    -- Add to make union uniform
          'ssh' as type
     from ssh_cert_libs
 )
@@ -298,6 +306,7 @@ final as (
          update_time,
          version,
          vault_path,
+         namespace,
          credential_type,
          http_method,
          http_request_body,
@@ -309,6 +318,8 @@ final as (
          null as critical_options,             -- Add to make union uniform
          null as extensions,                   -- Add to make union uniform
          null as additional_valid_principals,  -- Add to make union uniform
+         kv_version,
+         kv_min_version,
          'generic' as type
     from generic_libs
    union
@@ -321,6 +332,7 @@ final as (
          update_time,
          version,
          vault_path,
+         namespace,
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
@@ -332,6 +344,8 @@ final as (
          critical_options,
          extensions,
          additional_valid_principals,
+         null as kv_version,        -- Add to make union uniform
+         null as kv_min_version,    -- Add to make union uniform
          'ssh' as type
     from ssh_cert_libs
 )
@@ -369,6 +383,7 @@ final as (
          update_time,
          version,
          vault_path,
+         namespace,
          credential_type,
          http_method,
          http_request_body,
@@ -380,6 +395,8 @@ final as (
          null as critical_options,             -- Add to make union uniform
          null as extensions,                   -- Add to make union uniform
          null as additional_valid_principals,  -- Add to make union uniform
+         kv_version,
+         kv_min_version,
          'generic' as type
     from generic_libs
    union
@@ -392,6 +409,7 @@ final as (
          update_time,
          version,
          vault_path,
+         namespace,
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
@@ -403,6 +421,8 @@ final as (
          critical_options,
          extensions,
          additional_valid_principals,
+         null as kv_version,        -- Add to make union uniform
+         null as kv_min_version,    -- Add to make union uniform
          'ssh' as type
     from ssh_cert_libs
 )
@@ -441,6 +461,7 @@ final as (
          update_time,
          version,
          vault_path,
+         namespace,
          credential_type,
          http_method,
          http_request_body,
@@ -452,6 +473,8 @@ final as (
          null as critical_options,             -- Add to make union uniform
          null as extensions,                   -- Add to make union uniform
          null as additional_valid_principals,  -- Add to make union uniform
+         kv_version,
+         kv_min_version,
          'generic' as type
     from generic_libs
    union
@@ -464,6 +487,7 @@ final as (
          update_time,
          version,
          vault_path,
+         namespace,
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
@@ -475,6 +499,8 @@ final as (
          critical_options,
          extensions,
          additional_valid_principals,
+         null as kv_version,        -- Add to make union uniform
+         null as kv_min_version,    -- Add to make union uniform
          'ssh' as type
     from ssh_cert_libs
 )
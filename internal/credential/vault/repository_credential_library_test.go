@@ -191,6 +191,69 @@ func TestRepository_CreateCredentialLibrary(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid-with-kv-version",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:    cs.GetPublicId(),
+					HttpMethod: "GET",
+					VaultPath:  "/some/path",
+					KvVersion:  3,
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:    cs.GetPublicId(),
+					HttpMethod: "GET",
+					VaultPath:  "/some/path",
+					KvVersion:  3,
+				},
+			},
+		},
+		{
+			name: "valid-with-kv-min-version",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:      cs.GetPublicId(),
+					HttpMethod:   "GET",
+					VaultPath:    "/some/path",
+					KvMinVersion: 2,
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:      cs.GetPublicId(),
+					HttpMethod:   "GET",
+					VaultPath:    "/some/path",
+					KvMinVersion: 2,
+				},
+			},
+		},
+		{
+			name: "invalid-kv-version-and-kv-min-version",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:      cs.GetPublicId(),
+					HttpMethod:   "GET",
+					VaultPath:    "/some/path",
+					KvVersion:    3,
+					KvMinVersion: 2,
+				},
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "invalid-kv-version-with-post-method",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:    cs.GetPublicId(),
+					HttpMethod: "POST",
+					VaultPath:  "/some/path",
+					KvVersion:  3,
+				},
+			},
+			wantErr: errors.InvalidParameter,
+		},
 		{
 			name: "unknown-mapping-override-type",
 			in: &CredentialLibrary{
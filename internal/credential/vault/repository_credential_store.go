@@ -27,6 +27,11 @@ import (
 // orphan. CreateCredentialStore calls the /auth/token/renew-self and
 // /auth/token/lookup-self Vault endpoints.
 //
+// If cs uses TLS certificate auth, the Vault token is optional. Without one,
+// CreateCredentialStore logs in to the cert auth method with cs's client
+// certificate, and the token Vault returns must then meet the same
+// requirements.
+//
 // Both cs.Name and cs.Description are optional. If cs.Name is set, it must
 // be unique within cs.ProjectId. Both cs.CreateTime and cs.UpdateTime are
 // ignored.
@@ -53,7 +58,7 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if cs.ProjectId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
 	}
-	if len(cs.inputToken) == 0 {
+	if len(cs.inputToken) == 0 && cs.CertAuthMountPath == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "no vault token")
 	}
 	if cs.VaultAddress == "" {
@@ -65,6 +70,9 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if cs.clientCert != nil && len(cs.clientCert.CertificateKey) == 0 {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "client certificate without private key")
 	}
+	if err := validateCertAuth(ctx, op, cs); err != nil {
+		return nil, err
+	}
 
 	cs = cs.clone()
 
@@ -81,6 +89,13 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create vault client"))
 	}
+	if len(cs.inputToken) == 0 {
+		login, err := client.loginWithCert(ctx, cs.CertAuthMountPath, cs.CertAuthRoleName)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to login to vault with client certificate"))
+		}
+		cs.inputToken = TokenSecret(login.Auth.ClientToken)
+	}
 	tokenLookup, err := client.lookupToken(ctx)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup vault token"))
@@ -250,6 +265,18 @@ func validateTokenLookup(ctx context.Context, op errors.Op, s *vault.Secret) err
 	return nil
 }
 
+// validateCertAuth checks that a credential store using TLS certificate auth
+// has the client certificate it logs in with.
+func validateCertAuth(ctx context.Context, op errors.Op, cs *CredentialStore) error {
+	switch {
+	case cs.CertAuthRoleName != "" && cs.CertAuthMountPath == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "cert auth role name without cert auth mount path")
+	case cs.CertAuthMountPath != "" && cs.clientCert == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "cert auth without client certificate")
+	}
+	return nil
+}
+
 // LookupCredentialStore returns the CredentialStore for publicId. Returns
 // nil, nil if no CredentialStore is found for publicId.
 func (r *Repository) LookupCredentialStore(ctx context.Context, publicId string, _ ...Option) (*CredentialStore, error) {
@@ -282,6 +309,8 @@ type listLookupStore struct {
 	TlsServerName     string
 	TlsSkipVerify     bool
 	WorkerFilter      string
+	CertAuthMountPath string
+	CertAuthRoleName  string
 	TokenHmac         []byte
 	TokenStatus       string
 	ClientCert        []byte
@@ -307,6 +336,8 @@ func (ps *listLookupStore) toCredentialStore() *CredentialStore {
 	cs.TlsServerName = ps.TlsServerName
 	cs.TlsSkipVerify = ps.TlsSkipVerify
 	cs.WorkerFilter = ps.WorkerFilter
+	cs.CertAuthMountPath = ps.CertAuthMountPath
+	cs.CertAuthRoleName = ps.CertAuthRoleName
 
 	tk := allocToken()
 	tk.TokenHmac = ps.TokenHmac
@@ -335,11 +366,14 @@ func (ps *listLookupStore) GetPublicId() string { return ps.PublicId }
 //
 // cs must contain a valid PublicId. Only Name, Description, Namespace,
 // TlsServerName, TlsSkipVerify, CaCert, VaultAddress, ClientCertificate,
-// ClientCertificateKey, workerFilter, and Token can be changed. If cs.Name is set to a
+// ClientCertificateKey, workerFilter, CertAuthMountPath, CertAuthRoleName,
+// and Token can be changed. If cs.Name is set to a
 // non-empty string, it must be unique within cs.Projectid. If Token is changed,
 // the new token must have the same properties defined in CreateCredentialStore
 // and UpdateCredentialStore calls the same Vault endpoints described in
-// CreateCredentialStore.
+// CreateCredentialStore. If the cert auth settings are changed without
+// changing Token, UpdateCredentialStore logs in to Vault with the new
+// settings to get the new token.
 //
 // An attribute of cs will be set to NULL in the database if the attribute
 // in cs is the zero value and it is included in fieldMaskPaths.
@@ -362,7 +396,7 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	}
 	cs = cs.clone()
 
-	var validateToken, updateToken bool
+	var validateToken, updateToken, updateCertAuth bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold(nameField, f):
@@ -376,6 +410,10 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 			validateToken = true
 		case strings.EqualFold(certificateField, f):
 		case strings.EqualFold(certificateKeyField, f):
+		case strings.EqualFold(certAuthMountPathField, f):
+			updateCertAuth = true
+		case strings.EqualFold(certAuthRoleNameField, f):
+			updateCertAuth = true
 		case strings.EqualFold(tokenField, f):
 			if len(cs.inputToken) != 0 {
 				updateToken = true
@@ -396,6 +434,9 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 			caCertField:        cs.CaCert,
 			vaultAddressField:  cs.VaultAddress,
 			tokenField:         cs.inputToken,
+
+			certAuthMountPathField: cs.CertAuthMountPath,
+			certAuthRoleNameField:  cs.CertAuthRoleName,
 		},
 		fieldMaskPaths,
 		[]string{
@@ -465,6 +506,9 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("can't recreate client certificate for vault client creation"))
 	}
 	updatedStore := origStore.applyUpdate(cs, fieldMaskPaths)
+	if err := validateCertAuth(ctx, op, updatedStore); err != nil {
+		return nil, db.NoRowsAffected, err
+	}
 
 	if len(certDbMask) > 0 && updatedStore.clientCert != nil {
 		if err := updatedStore.clientCert.encrypt(ctx, databaseWrapper); err != nil {
@@ -477,6 +521,15 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get client for updated store"))
 	}
+	if updateCertAuth && !updateToken && updatedStore.CertAuthMountPath != "" {
+		login, err := client.loginWithCert(ctx, updatedStore.CertAuthMountPath, updatedStore.CertAuthRoleName)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to login to vault with client certificate"))
+		}
+		cs.inputToken = TokenSecret(login.Auth.ClientToken)
+		updateToken = true
+		validateToken = true
+	}
 	if validateToken {
 		tokenLookup, err := client.lookupToken(ctx)
 		if err != nil {
@@ -117,7 +117,8 @@ func (r *Repository) CreateSSHCertificateCredentialLibrary(ctx context.Context,
 // number of records updated. l is not changed.
 //
 // l must contain a valid PublicId. Name, Description, VaultPath, Username,
-// KeyType, KeyBits, Ttl, KeyId, CriticalOptions, and Extensions can be updated. If
+// KeyType, KeyBits, Ttl, KeyId, CriticalOptions, Extensions, AdditionalValidPrincipals,
+// and Namespace can be updated. If
 // l.Name is set to a non-empty string, it must be unique within l.StoreId.
 //
 // An attribute of l will be set to NULL in the database if the attribute
@@ -158,6 +159,7 @@ func (r *Repository) UpdateSSHCertificateCredentialLibrary(ctx context.Context,
 		case strings.EqualFold(CriticalOptionsField, f):
 		case strings.EqualFold(ExtensionsField, f):
 		case strings.EqualFold(AdditionalValidPrincipalsField, f):
+		case strings.EqualFold(namespaceField, f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -197,6 +199,7 @@ func (r *Repository) UpdateSSHCertificateCredentialLibrary(ctx context.Context,
 			CriticalOptionsField:           l.CriticalOptions,
 			ExtensionsField:                l.Extensions,
 			AdditionalValidPrincipalsField: l.AdditionalValidPrincipals,
+			namespaceField:                 l.Namespace,
 		},
 		fieldMaskPaths,
 		[]string{keyBitsField},
@@ -41,8 +41,9 @@ type SSHCertificateCredentialLibrary struct {
 
 // NewSSHCertificateCredentialLibrary creates a new in memory SSHCertificateCredentialLibrary
 // for a Vault backend at vaultPath assigned to storeId. The SSH username field must be set.
-// Name, description, key type, key bits, ttl, key id, critical options, and extensions
-// are the only valid options. All other options are ignored.
+// Name, description, key type, key bits, ttl, key id, critical options, extensions,
+// additional valid principals, and namespace are the only valid options. All other
+// options are ignored.
 func NewSSHCertificateCredentialLibrary(storeId string, vaultPath string, username string, opt ...Option) (*SSHCertificateCredentialLibrary, error) {
 	const op = "vault.NewSSHCertificateCredentialLibrary"
 	opts := getOpts(opt...)
@@ -62,6 +63,7 @@ func NewSSHCertificateCredentialLibrary(storeId string, vaultPath string, userna
 			Extensions:                opts.withExtensions,
 			CredentialType:            string(globals.SshCertificateCredentialType),
 			AdditionalValidPrincipals: strings.Join(opts.withAdditionalValidPrincipals, ","),
+			Namespace:                 opts.withNamespace,
 		},
 	}
 
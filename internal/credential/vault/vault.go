@@ -8,6 +8,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -26,8 +27,10 @@ type vaultClient interface {
 	renewLease(context.Context, string, time.Duration) (*vault.Secret, error)
 	revokeLease(context.Context, string) error
 	lookupToken(context.Context) (*vault.Secret, error)
+	loginWithCert(ctx context.Context, mountPath, roleName string) (*vault.Secret, error)
 	swapToken(context.Context, TokenSecret) (old TokenSecret)
 	get(context.Context, string) (*vault.Secret, error)
+	getVersion(context.Context, string, uint32) (*vault.Secret, error)
 	post(context.Context, string, []byte) (*vault.Secret, error)
 	capabilities(context.Context, []string) (pathCapabilities, error)
 	headers(ctx context.Context) (http.Header, error)
@@ -53,10 +56,16 @@ type clientConfig struct {
 	TlsServerName string `json:"tls_server_name"`
 	TlsSkipVerify bool   `json:"tls_skip_verify"`
 	Namespace     string `json:"namespace"`
+	// CertAuthMountPath is the mount path of the TLS certificate auth
+	// method used to log in when the client has no token.
+	CertAuthMountPath string `json:"cert_auth_mount_path"`
 }
 
 func (c *clientConfig) isValid() bool {
-	if c == nil || c.Addr == "" || len(c.Token) == 0 {
+	if c == nil || c.Addr == "" {
+		return false
+	}
+	if len(c.Token) == 0 && (c.CertAuthMountPath == "" || !c.isClientTLS()) {
 		return false
 	}
 	return true
@@ -210,6 +219,37 @@ func (c *client) lookupToken(ctx context.Context) (*vault.Secret, error) {
 	return t, nil
 }
 
+// loginWithCert calls the /auth/<mountPath>/login Vault endpoint of a TLS
+// certificate auth method, authenticating with the client certificate, and
+// returns the vault.Secret response. The token in the Vault client is
+// replaced with the token returned by Vault. If roleName is empty, Vault
+// tries all the roles of the auth method matching the certificate. See
+// https://developer.hashicorp.com/vault/api-docs/auth/cert#login-with-tls-certificate-method.
+func (c *client) loginWithCert(ctx context.Context, mountPath, roleName string) (*vault.Secret, error) {
+	const op = "vault.(client).loginWithCert"
+	if mountPath == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing mount path")
+	}
+	var body map[string]any
+	if roleName != "" {
+		body = map[string]any{
+			"name": roleName,
+		}
+	}
+	// Login requests must not carry a token, the current one may have
+	// expired.
+	c.cl.ClearToken()
+	s, err := c.cl.Logical().WriteWithContext(ctx, fmt.Sprintf("auth/%s/login", strings.Trim(mountPath, "/")), body)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Unknown), errors.WithMsg(fmt.Sprintf("vault: %s", c.cl.Address())))
+	}
+	if s == nil || s.Auth == nil || s.Auth.ClientToken == "" {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("no token returned: vault: %s", c.cl.Address()))
+	}
+	c.cl.SetToken(s.Auth.ClientToken)
+	return s, nil
+}
+
 // swapToken replaces the token in the Vault client with t and returns the
 // token that was replaced.
 func (c *client) swapToken(ctx context.Context, new TokenSecret) (old TokenSecret) {
@@ -227,6 +267,18 @@ func (c *client) get(ctx context.Context, path string) (*vault.Secret, error) {
 	return s, nil
 }
 
+// getVersion reads the given version of the KV v2 secret at path.
+func (c *client) getVersion(ctx context.Context, path string, version uint32) (*vault.Secret, error) {
+	const op = "vault.(client).getVersion"
+	s, err := c.cl.Logical().ReadWithData(path, map[string][]string{
+		"version": {strconv.FormatUint(uint64(version), 10)},
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultCredentialRequest), errors.WithMsg(fmt.Sprintf("vault: %s", c.cl.Address())))
+	}
+	return s, nil
+}
+
 func (c *client) post(ctx context.Context, path string, data []byte) (*vault.Secret, error) {
 	const op = "vault.(client).post"
 
@@ -340,3 +340,53 @@ func Test_headers(t *testing.T) {
 	got := headers.Get("test-header")
 	assert.Equal(t, "test-header-value", got)
 }
+
+func Test_clientConfig_isValid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		config *clientConfig
+		want   bool
+	}{
+		{
+			name: "nil-config",
+		},
+		{
+			name:   "no-address",
+			config: &clientConfig{Token: TokenSecret("token")},
+		},
+		{
+			name:   "no-token",
+			config: &clientConfig{Addr: "https://vault.example.com"},
+		},
+		{
+			name:   "token",
+			config: &clientConfig{Addr: "https://vault.example.com", Token: TokenSecret("token")},
+			want:   true,
+		},
+		{
+			name: "cert-auth-without-client-cert",
+			config: &clientConfig{
+				Addr:              "https://vault.example.com",
+				CertAuthMountPath: "cert",
+			},
+		},
+		{
+			name: "cert-auth-without-token",
+			config: &clientConfig{
+				Addr:              "https://vault.example.com",
+				CertAuthMountPath: "cert",
+				ClientCert:        []byte("cert"),
+				ClientKey:         []byte("key"),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.config.isValid())
+		})
+	}
+}
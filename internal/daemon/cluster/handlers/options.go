@@ -4,6 +4,8 @@
 package handlers
 
 import (
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/nodeenrollment"
 )
 
@@ -21,7 +23,10 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withKeyProducer nodeenrollment.X25519KeyProducer
+	withKeyProducer       nodeenrollment.X25519KeyProducer
+	withWorkerDiagnostics *common.WorkerDiagnosticsBroker
+	withConnectionTests   *common.WorkerConnectionTestBroker
+	withTargetRepoFn      target.RepositoryFactory
 }
 
 func getDefaultOptions() options {
@@ -34,3 +39,28 @@ func WithKeyProducer(nodeInfo nodeenrollment.X25519KeyProducer) Option {
 		o.withKeyProducer = nodeInfo
 	}
 }
+
+// WithWorkerDiagnostics provides an option to set the broker that delivers
+// diagnostics requests to workers and their responses back to the controller.
+func WithWorkerDiagnostics(b *common.WorkerDiagnosticsBroker) Option {
+	return func(o *options) {
+		o.withWorkerDiagnostics = b
+	}
+}
+
+// WithConnectionTests provides an option to set the broker that delivers
+// target connectivity tests to workers and their results back to the
+// controller.
+func WithConnectionTests(b *common.WorkerConnectionTestBroker) Option {
+	return func(o *options) {
+		o.withConnectionTests = b
+	}
+}
+
+// WithTargetRepoFn provides an option to set the target repository used to
+// look up the recording parameters of targets pushed to workers.
+func WithTargetRepoFn(fn target.RepositoryFactory) Option {
+	return func(o *options) {
+		o.withTargetRepoFn = fn
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package handlers
+
+import (
+	"github.com/hashicorp/boundary/internal/daemon/cluster"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/event"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type reverseTunnelServiceServer struct {
+	pbs.UnsafeReverseTunnelServiceServer
+
+	serversRepoFn common.ServersRepoFactory
+	tunnels       *common.ReverseTunnelRegistry
+}
+
+var _ pbs.ReverseTunnelServiceServer = &reverseTunnelServiceServer{}
+
+// NewReverseTunnelServiceServer returns a reverse tunnel service which lets
+// workers register reverse tunnels for targets in the registry. If the
+// registry is nil the controller has no tunnel listener and registrations are
+// refused.
+func NewReverseTunnelServiceServer(
+	serversRepoFn common.ServersRepoFactory,
+	tunnels *common.ReverseTunnelRegistry,
+) *reverseTunnelServiceServer {
+	return &reverseTunnelServiceServer{
+		serversRepoFn: serversRepoFn,
+		tunnels:       tunnels,
+	}
+}
+
+// RegisterTunnels implements pbs.ReverseTunnelServiceServer.
+func (s *reverseTunnelServiceServer) RegisterTunnels(req *pbs.RegisterTunnelsRequest, stream pbs.ReverseTunnelService_RegisterTunnelsServer) error {
+	const op = "handlers.(reverseTunnelServiceServer).RegisterTunnels"
+	ctx := stream.Context()
+	switch {
+	case s.tunnels == nil:
+		return status.Error(codes.FailedPrecondition, "controller has no listener with the tunnel purpose")
+	case req.GetWorkerId() == "":
+		return status.Error(codes.InvalidArgument, "worker id is empty")
+	case len(req.GetTargetIds()) == 0:
+		return status.Error(codes.InvalidArgument, "no target ids given")
+	}
+	serversRepo, err := s.serversRepoFn()
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error getting server repo"))
+		return status.Errorf(codes.Internal, "error getting server repo: %v", err)
+	}
+	w, err := serversRepo.LookupWorker(ctx, req.GetWorkerId())
+	if err != nil {
+		return status.Errorf(codes.Internal, "error looking up worker: %v", err)
+	}
+	if w == nil {
+		return status.Errorf(codes.NotFound, "worker not found with id %q", req.GetWorkerId())
+	}
+
+	opens, unregister := s.tunnels.Register(w.GetPublicId(), req.GetTargetIds())
+	defer unregister()
+	event.WriteSysEvent(ctx, op, "worker registered reverse tunnels", "worker_id", w.GetPublicId(), "target_ids", req.GetTargetIds())
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case o := <-opens:
+			if err := stream.Send(&pbs.RegisterTunnelsResponse{
+				ConnectionId:  o.ConnectionId,
+				ClientAddress: o.ClientAddress,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Tunnel implements pbs.ReverseTunnelServiceServer.
+func (s *reverseTunnelServiceServer) Tunnel(stream pbs.ReverseTunnelService_TunnelServer) error {
+	ctx := stream.Context()
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if req.GetConnectionId() == "" {
+		return status.Error(codes.InvalidArgument, "connection id is empty")
+	}
+	addr := cluster.TunnelAddr(req.GetConnectionId())
+	conn := cluster.NewTunnelConn(
+		func(b []byte) error { return stream.Send(&pbs.TunnelResponse{Data: b}) },
+		cluster.TunnelRecvData(stream.Recv),
+		nil,
+		addr,
+		addr,
+	)
+	if !s.tunnels.Accept(req.GetConnectionId(), conn) {
+		return status.Errorf(codes.NotFound, "no connection is waiting for a tunnel with id %q", req.GetConnectionId())
+	}
+	// Returning ends the stream, so wait until the connection is done with it
+	select {
+	case <-conn.Done():
+	case <-ctx.Done():
+		conn.Close()
+	}
+	return nil
+}
@@ -6,26 +6,33 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"net"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
+	"unicode"
 
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
+	filterpkg "github.com/hashicorp/boundary/internal/filter"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	intglobals "github.com/hashicorp/boundary/internal/globals"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/boundary/sdk/pbs/plugin"
-	"github.com/hashicorp/go-bexpr"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type workerServiceServer struct {
@@ -41,6 +48,9 @@ type workerServiceServer struct {
 	kms                 *kms.Kms
 	livenessTimeToStale *atomic.Int64
 	controllerExt       intglobals.ControllerExtension
+	workerDiagnostics   *common.WorkerDiagnosticsBroker
+	connectionTests     *common.WorkerConnectionTestBroker
+	targetRepoFn        target.RepositoryFactory
 }
 
 var (
@@ -80,7 +90,9 @@ func NewWorkerServiceServer(
 	kms *kms.Kms,
 	livenessTimeToStale *atomic.Int64,
 	controllerExt intglobals.ControllerExtension,
+	opt ...Option,
 ) *workerServiceServer {
+	opts := getOpts(opt...)
 	return &workerServiceServer{
 		serversRepoFn:       serversRepoFn,
 		workerAuthRepoFn:    workerAuthRepoFn,
@@ -91,6 +103,9 @@ func NewWorkerServiceServer(
 		kms:                 kms,
 		livenessTimeToStale: livenessTimeToStale,
 		controllerExt:       controllerExt,
+		workerDiagnostics:   opts.withWorkerDiagnostics,
+		connectionTests:     opts.withConnectionTests,
+		targetRepoFn:        opts.withTargetRepoFn,
 	}
 }
 
@@ -109,9 +124,27 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 	case wStat.GetAddress() == "":
 		return &pbs.StatusResponse{}, status.Error(codes.InvalidArgument, "Address is not set but is required.")
 	}
+	for _, a := range wStat.GetAdditionalAddresses() {
+		if a == "" || strings.IndexFunc(a, func(r rune) bool { return r == ',' || unicode.IsSpace(r) }) != -1 {
+			return &pbs.StatusResponse{}, status.Errorf(codes.InvalidArgument, "Additional address %q is empty or contains a comma or whitespace.", a)
+		}
+	}
 	// This Store call is currently only for testing purposes
 	ws.updateTimes.Store(wStat.GetName(), time.Now())
 
+	// Report workers whose clocks have drifted from ours, as this otherwise
+	// surfaces as hard to diagnose certificate and session validation errors.
+	if wt := req.GetWorkerTime(); wt != nil {
+		if skew := wt.AsTime().Sub(time.Now()); server.ClockSkewExceeded(skew) {
+			event.WriteError(ctx, op, &server.ClockSkewError{Skew: skew},
+				event.WithInfoMsg("worker clock skew exceeds allowed drift",
+					"worker_name", wStat.GetName(),
+					"worker_key_id", wStat.GetKeyId(),
+					"worker_time", wt.AsTime().Format(time.RFC3339Nano),
+					"skew", skew.String()))
+		}
+	}
+
 	serverRepo, err := ws.serversRepoFn()
 	if err != nil {
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error getting server repo"))
@@ -151,7 +184,9 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		server.WithWorkerTags(workerTags...),
 		server.WithReleaseVersion(wStat.ReleaseVersion),
 		server.WithOperationalState(wStat.OperationalState),
-		server.WithLocalStorageState(wStat.LocalStorageState))
+		server.WithLocalStorageState(wStat.LocalStorageState),
+		server.WithLocality(wStat.GetLocality()),
+		server.WithAdditionalAddresses(wStat.GetAdditionalAddresses()))
 	opts := []server.Option{server.WithUpdateTags(req.GetUpdateTags())}
 	if wStat.GetPublicId() != "" {
 		opts = append(opts, server.WithPublicId(wStat.GetPublicId()))
@@ -159,9 +194,21 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 	if wStat.GetKeyId() != "" {
 		opts = append(opts, server.WithKeyId(wStat.GetKeyId()))
 	}
+	// The remote address is checked against the network ranges the worker's
+	// activation token was restricted to, if any.
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host := p.Addr.String()
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		opts = append(opts, server.WithRemoteAddress(host))
+	}
 	wrk, err := serverRepo.UpsertWorkerStatus(ctx, wConf, opts...)
 	if err != nil {
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error storing worker status"))
+		if errors.Match(errors.T(errors.Forbidden), err) {
+			return &pbs.StatusResponse{}, status.Errorf(codes.PermissionDenied, "Error storing worker status: %v", err)
+		}
 		return &pbs.StatusResponse{}, status.Errorf(codes.Internal, "Error storing worker status: %v", err)
 	}
 
@@ -170,6 +217,22 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		updateWorkerStorageBucketCredentialStatesFn(ctx, serverRepo, wrk.GetPublicId(), sbcStates)
 	}
 
+	// update utilization; failing to store it should not fail the status
+	// request since it is only informational
+	if u := wStat.GetUtilization(); u != nil && wrk.GetPublicId() != "" {
+		if err := serverRepo.UpsertWorkerUtilization(ctx, &server.WorkerUtilization{
+			WorkerId:              wrk.GetPublicId(),
+			ActiveSessionCount:    u.GetActiveSessionCount(),
+			ActiveConnectionCount: u.GetActiveConnectionCount(),
+			BytesUpPerSecond:      u.GetBytesUpPerSecond(),
+			BytesDownPerSecond:    u.GetBytesDownPerSecond(),
+			ProxyCpuUtilization:   u.GetProxyCpuUtilization(),
+			SpoolBacklogBytes:     u.GetSpoolBacklogBytes(),
+		}); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error storing worker utilization", "worker_id", wrk.GetPublicId()))
+		}
+	}
+
 	controllers, err := serverRepo.ListControllers(ctx, server.WithLiveness(time.Duration(ws.livenessTimeToStale.Load())))
 	if err != nil {
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error getting current controllers"))
@@ -225,6 +288,7 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		WorkerId:                    wrk.GetPublicId(),
 		AuthorizedWorkers:           authorizedWorkerList,
 		AuthorizedDownstreamWorkers: authorizedDownstreams,
+		ControllerTime:              timestamppb.Now(),
 	}
 
 	stateReport := make([]*session.StateReport, 0, len(req.GetJobs()))
@@ -347,6 +411,69 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		})
 	}
 
+	// Pass the logs and metrics the worker gathered to the API requests
+	// waiting for them, and send it any new requests.
+	ws.workerDiagnostics.Deliver(wrk.GetPublicId(), req.GetDiagnostics())
+	ret.DiagnosticsRequests = ws.workerDiagnostics.PendingRequests(wrk.GetPublicId())
+
+	// Likewise for the results of the target connectivity tests.
+	ws.connectionTests.Deliver(wrk.GetPublicId(), req.GetConnectionTestResults())
+	ret.ConnectionTestRequests = ws.connectionTests.PendingRequests(wrk.GetPublicId())
+
+	// Send the recording parameters of the targets if the worker has not
+	// applied their current version.
+	ret.RecordingParameters, err = ws.recordingParameters(ctx, wrk.GetPublicId(), req.GetRecordingParametersVersion())
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error getting the recording parameters", "worker_id", wrk.GetPublicId()))
+	}
+
+	return ret, nil
+}
+
+// recordingParameters records the version of the recording parameters the
+// worker acknowledged having applied, and returns the recording parameters of
+// the targets if it is not their current version, or nil otherwise.
+func (ws *workerServiceServer) recordingParameters(ctx context.Context, workerId string, ackedVersion uint64) (*pbs.RecordingParameters, error) {
+	const op = "workers.(workerServiceServer).recordingParameters"
+	if ws.targetRepoFn == nil {
+		return nil, nil
+	}
+	repo, err := ws.targetRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if ackedVersion != 0 {
+		acked, err := repo.AckRecordingParameters(ctx, workerId, ackedVersion)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if acked {
+			event.WriteSysEvent(ctx, op, "worker applied recording parameters", "worker_id", workerId, "version", ackedVersion)
+		}
+	}
+	version, err := repo.RecordingParametersVersion(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if version == ackedVersion {
+		return nil, nil
+	}
+	version, params, err := repo.ListRecordingParameters(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := &pbs.RecordingParameters{
+		Version: version,
+	}
+	for _, p := range params {
+		ret.Targets = append(ret.Targets, &pbs.TargetRecordingParameters{
+			TargetId:         p.TargetId,
+			Compression:      p.Compression,
+			CompressionLevel: p.CompressionLevel,
+			RedactionRules:   p.RedactionRules,
+			SamplePercent:    p.SamplePercent,
+		})
+	}
 	return ret, nil
 }
 
@@ -449,7 +576,7 @@ func lookupSessionWorkerFilter(ctx context.Context, sessionInfo *session.Session
 	tagMap := w.CanonicalTags()
 
 	// Create the evaluator
-	eval, err := bexpr.CreateEvaluator(filter)
+	eval, err := filterpkg.CreateEvaluator(filter)
 	if err != nil {
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error creating worker filter evaluator", "worker_id", req.WorkerId))
 		return status.Errorf(codes.Internal, "Error creating worker filter evaluator: %v", err)
@@ -532,6 +659,9 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 		Version:         sessionInfo.Version,
 		TofuToken:       string(sessionInfo.TofuToken),
 		Endpoint:        sessionInfo.Endpoint,
+		AllowedPorts:    sessionInfo.AllowedPorts,
+		TlsPassthrough:  sessionInfo.TlsPassthrough,
+		TlsServerNames:  sessionInfo.TlsServerNames,
 		Expiration:      sessionInfo.ExpirationTime.Timestamp,
 		ConnectionLimit: sessionInfo.ConnectionLimit,
 		ConnectionsLeft: authzSummary.ConnectionLimit,
@@ -540,6 +670,7 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 		TargetId:        sessionInfo.TargetId,
 		UserId:          sessionInfo.UserId,
 		Credentials:     workerCreds,
+		ControllerTime:  timestamppb.Now(),
 	}
 	if resp.ConnectionsLeft != -1 {
 		resp.ConnectionsLeft -= int32(authzSummary.CurrentConnectionCount)
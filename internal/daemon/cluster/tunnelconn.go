@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cluster
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// maxTunnelChunkSize is the largest number of bytes sent in a single message
+// of a tunnel stream.
+const maxTunnelChunkSize = 32 * 1024
+
+// TunnelConn is a net.Conn carrying the bytes of a session connection over a
+// reverse tunnel stream between a controller and a worker. Deadlines are not
+// supported; the lifetime of the connection is bound by the stream instead.
+type TunnelConn struct {
+	send    func([]byte) error
+	recv    func() ([]byte, error)
+	closeFn func() error
+	local   net.Addr
+	remote  net.Addr
+
+	readMu sync.Mutex
+	buf    []byte
+
+	writeMu sync.Mutex
+
+	closeOnce sync.Once
+	closeErr  error
+	done      chan struct{}
+}
+
+var _ net.Conn = (*TunnelConn)(nil)
+
+// NewTunnelConn returns a TunnelConn which writes with send and reads with
+// recv. closeFn, if set, is called once when the connection is closed and
+// should end the stream so a pending recv returns.
+func NewTunnelConn(send func([]byte) error, recv func() ([]byte, error), closeFn func() error, local, remote net.Addr) *TunnelConn {
+	return &TunnelConn{
+		send:    send,
+		recv:    recv,
+		closeFn: closeFn,
+		local:   local,
+		remote:  remote,
+		done:    make(chan struct{}),
+	}
+}
+
+// Read implements net.Conn.
+func (c *TunnelConn) Read(b []byte) (int, error) {
+	c.readMu.Lock()
+	defer c.readMu.Unlock()
+	for len(c.buf) == 0 {
+		data, err := c.recv()
+		if err != nil {
+			select {
+			case <-c.done:
+				return 0, net.ErrClosed
+			default:
+			}
+			return 0, err
+		}
+		c.buf = data
+	}
+	n := copy(b, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+// Write implements net.Conn.
+func (c *TunnelConn) Write(b []byte) (int, error) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	var written int
+	for len(b) > 0 {
+		select {
+		case <-c.done:
+			return written, net.ErrClosed
+		default:
+		}
+		chunk := b[:min(len(b), maxTunnelChunkSize)]
+		if err := c.send(chunk); err != nil {
+			return written, err
+		}
+		written += len(chunk)
+		b = b[len(chunk):]
+	}
+	return written, nil
+}
+
+// Close implements net.Conn.
+func (c *TunnelConn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		if c.closeFn != nil {
+			c.closeErr = c.closeFn()
+		}
+	})
+	return c.closeErr
+}
+
+// Done returns a channel which is closed once the connection is closed.
+func (c *TunnelConn) Done() <-chan struct{} {
+	return c.done
+}
+
+// LocalAddr implements net.Conn.
+func (c *TunnelConn) LocalAddr() net.Addr { return c.local }
+
+// RemoteAddr implements net.Conn.
+func (c *TunnelConn) RemoteAddr() net.Addr { return c.remote }
+
+// SetDeadline implements net.Conn. Deadlines are not supported.
+func (c *TunnelConn) SetDeadline(time.Time) error { return nil }
+
+// SetReadDeadline implements net.Conn. Deadlines are not supported.
+func (c *TunnelConn) SetReadDeadline(time.Time) error { return nil }
+
+// SetWriteDeadline implements net.Conn. Deadlines are not supported.
+func (c *TunnelConn) SetWriteDeadline(time.Time) error { return nil }
+
+// TunnelRecvData adapts the Recv function of a tunnel stream to the recv
+// function of a TunnelConn.
+func TunnelRecvData[T interface{ GetData() []byte }](recv func() (T, error)) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		m, err := recv()
+		if err != nil {
+			return nil, err
+		}
+		return m.GetData(), nil
+	}
+}
+
+// TunnelAddr is the address of the end of a tunnel, named by the id of the
+// connection the tunnel carries.
+type TunnelAddr string
+
+// Network implements net.Addr.
+func (a TunnelAddr) Network() string { return "tunnel" }
+
+// String implements net.Addr.
+func (a TunnelAddr) String() string { return string(a) }
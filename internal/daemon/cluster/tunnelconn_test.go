@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cluster
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTunnelConn(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	var sent [][]byte
+	received := make(chan []byte, 2)
+	received <- []byte("hello ")
+	received <- []byte("world")
+	closeCalls := 0
+	c := NewTunnelConn(
+		func(b []byte) error {
+			sent = append(sent, bytes.Clone(b))
+			return nil
+		},
+		func() ([]byte, error) {
+			select {
+			case b := <-received:
+				return b, nil
+			default:
+				return nil, io.EOF
+			}
+		},
+		func() error {
+			closeCalls++
+			return nil
+		},
+		TunnelAddr("local"),
+		TunnelAddr("remote"),
+	)
+	assert.Equal("tunnel", c.LocalAddr().Network())
+	assert.Equal("remote", c.RemoteAddr().String())
+
+	b, err := io.ReadAll(c)
+	require.NoError(err)
+	assert.Equal("hello world", string(b))
+
+	// Large writes are sent in chunks
+	n, err := c.Write(make([]byte, maxTunnelChunkSize+1))
+	require.NoError(err)
+	assert.Equal(maxTunnelChunkSize+1, n)
+	require.Len(sent, 2)
+	assert.Len(sent[0], maxTunnelChunkSize)
+	assert.Len(sent[1], 1)
+
+	require.NoError(c.Close())
+	require.NoError(c.Close())
+	assert.Equal(1, closeCalls)
+	<-c.Done()
+	_, err = c.Write([]byte("x"))
+	assert.ErrorIs(err, net.ErrClosed)
+	_, err = c.Read(make([]byte, 1))
+	assert.ErrorIs(err, net.ErrClosed)
+}
+
+func TestTunnelRecvData(t *testing.T) {
+	recv := TunnelRecvData(func() (*testMsg, error) { return &testMsg{data: []byte("data")}, nil })
+	b, err := recv()
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(b))
+
+	recv = TunnelRecvData(func() (*testMsg, error) { return nil, errors.New("boom") })
+	_, err = recv()
+	assert.Error(t, err)
+}
+
+type testMsg struct{ data []byte }
+
+func (m *testMsg) GetData() []byte { return m.data }
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package common
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/boundary/internal/globals"
+	"github.com/hashicorp/go-secure-stdlib/listenerutil"
+)
+
+// ClientIdentityHeader returns the name of the request header configured with
+// globals.ClientIdentityHeaderKey on the listener, or an empty string if none is.
+func ClientIdentityHeader(listenerCfg *listenerutil.ListenerConfig) string {
+	if listenerCfg == nil {
+		return ""
+	}
+	header, _ := listenerCfg.RawConfig[globals.ClientIdentityHeaderKey].(string)
+	return header
+}
+
+// ClientCertIdentity returns the identity of the client certificate verified
+// during the TLS handshake of the request: its subject common name or,
+// failing that, its first URI, email or DNS subject alternative name. An empty
+// string is returned if no client certificate was verified.
+func ClientCertIdentity(r *http.Request) string {
+	if r == nil || r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	cert := r.TLS.VerifiedChains[0][0]
+	switch {
+	case cert.Subject.CommonName != "":
+		return cert.Subject.CommonName
+	case len(cert.URIs) > 0:
+		return cert.URIs[0].String()
+	case len(cert.EmailAddresses) > 0:
+		return cert.EmailAddresses[0]
+	case len(cert.DNSNames) > 0:
+		return cert.DNSNames[0]
+	}
+	return ""
+}
+
+// setClientIdentityHeader replaces any value of the listener's client identity
+// header sent by the client with the identity of its verified certificate, so
+// the header can be trusted by anything handling the request.
+func setClientIdentityHeader(listenerCfg *listenerutil.ListenerConfig, r *http.Request, identity string) {
+	header := ClientIdentityHeader(listenerCfg)
+	if header == "" {
+		return
+	}
+	r.Header.Del(header)
+	if identity != "" {
+		r.Header.Set(header, identity)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/globals"
+	"github.com/hashicorp/go-secure-stdlib/listenerutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ClientCertIdentity(t *testing.T) {
+	t.Parallel()
+	uri, err := url.Parse("spiffe://example.org/ci")
+	assert.NoError(t, err)
+	verified := func(cert *x509.Certificate) *http.Request {
+		return &http.Request{TLS: &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+	}
+
+	tests := []struct {
+		name string
+		req  *http.Request
+		want string
+	}{
+		{
+			name: "nil-request",
+		},
+		{
+			name: "no-tls",
+			req:  &http.Request{},
+		},
+		{
+			name: "unverified",
+			req: &http.Request{TLS: &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "alice"}}},
+			}},
+		},
+		{
+			name: "common-name",
+			req: verified(&x509.Certificate{
+				Subject:        pkix.Name{CommonName: "alice"},
+				EmailAddresses: []string{"alice@example.org"},
+			}),
+			want: "alice",
+		},
+		{
+			name: "uri",
+			req: verified(&x509.Certificate{
+				URIs:           []*url.URL{uri},
+				EmailAddresses: []string{"ci@example.org"},
+			}),
+			want: "spiffe://example.org/ci",
+		},
+		{
+			name: "email",
+			req: verified(&x509.Certificate{
+				EmailAddresses: []string{"bob@example.org"},
+				DNSNames:       []string{"bob.example.org"},
+			}),
+			want: "bob@example.org",
+		},
+		{
+			name: "dns",
+			req:  verified(&x509.Certificate{DNSNames: []string{"host.example.org"}}),
+			want: "host.example.org",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClientCertIdentity(tt.req))
+		})
+	}
+}
+
+func Test_setClientIdentityHeader(t *testing.T) {
+	t.Parallel()
+	const header = "X-Client-Identity"
+	cfg := &listenerutil.ListenerConfig{
+		RawConfig: map[string]any{globals.ClientIdentityHeaderKey: header},
+	}
+
+	tests := []struct {
+		name     string
+		cfg      *listenerutil.ListenerConfig
+		sent     string
+		identity string
+		want     string
+	}{
+		{
+			name:     "not-configured",
+			cfg:      &listenerutil.ListenerConfig{},
+			sent:     "spoofed",
+			identity: "alice",
+			want:     "spoofed",
+		},
+		{
+			name:     "set",
+			cfg:      cfg,
+			identity: "alice",
+			want:     "alice",
+		},
+		{
+			name:     "replaces-client-value",
+			cfg:      cfg,
+			sent:     "spoofed",
+			identity: "alice",
+			want:     "alice",
+		},
+		{
+			name: "removes-client-value-without-identity",
+			cfg:  cfg,
+			sent: "spoofed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &http.Request{Header: http.Header{}}
+			if tt.sent != "" {
+				r.Header.Set(header, tt.sent)
+			}
+			setClientIdentityHeader(tt.cfg, r, tt.identity)
+			assert.Equal(t, tt.want, r.Header.Get(header))
+		})
+	}
+}
@@ -133,13 +133,16 @@ func WrapWithEventsHandler(ctx context.Context, h http.Handler, e *event.Eventer
 			w.WriteHeader(http.StatusInternalServerError)
 			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to determine client ip"))
 		}
+		clientCertIdentity := ClientCertIdentity(r)
+		setClientIdentityHeader(listenerCfg, r, clientCertIdentity)
 		info := &event.RequestInfo{
-			EventId:  id,
-			Id:       GeneratedTraceId(ctx),
-			PublicId: publicId,
-			Method:   r.Method,
-			Path:     r.URL.RequestURI(),
-			ClientIp: clientIp,
+			EventId:            id,
+			Id:                 GeneratedTraceId(ctx),
+			PublicId:           publicId,
+			Method:             r.Method,
+			Path:               r.URL.RequestURI(),
+			ClientIp:           clientIp,
+			ClientCertIdentity: clientCertIdentity,
 		}
 		ctx, err = event.NewRequestInfoContext(ctx, info)
 		if err != nil {
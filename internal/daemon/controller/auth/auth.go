@@ -8,6 +8,7 @@ import (
 	stderrors "errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/scopequota"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -36,6 +38,7 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/mr-tron/base58"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -55,6 +58,11 @@ const (
 	AuthTokenTypeRecoveryKms
 )
 
+// ImpersonateUserHeader is the request header holding the id of the user a
+// request is performed on behalf of. The caller must be granted the
+// impersonate action on that user.
+const ImpersonateUserHeader = "Boundary-Impersonate-User"
+
 // CallbackAction represents the action type for
 // callback operations in a request's URL path.
 // This is currently only used during auth method
@@ -74,6 +82,11 @@ type VerifyResults struct {
 	Error       error
 	Scope       *scopes.ScopeInfo
 
+	// ImpersonatorUserId is the ID of the user that performed the request on
+	// behalf of UserId, if the request impersonated another user. AuthTokenId
+	// then belongs to the impersonator.
+	ImpersonatorUserId string
+
 	// AuthenticatedFinished means that the request has passed through the
 	// authentication system successfully. This does _not_ indicate whether a
 	// token was provided on the request. Requests for `u_anon` will still have
@@ -120,6 +133,10 @@ type verifier struct {
 	act                action.Type
 	ctx                context.Context
 	acl                perms.ACL
+
+	// Set once the request has been counted against the quota of its scope,
+	// so handlers that perform several auth checks only count it once
+	scopeQuotaChecked bool
 }
 
 // TODO (jefferai 10/2022): NewVerifierContextWithAccounts performs the function
@@ -287,7 +304,8 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 	var authResults perms.ACLResults
 	var userData template.Data
 	var err error
-	authResults, ret.UserData, ret.Scope, v.acl, ret.grants, err = v.performAuthCheck(ctx)
+	var impersonator *template.Data
+	authResults, ret.UserData, ret.Scope, v.acl, ret.grants, impersonator, err = v.performAuthCheck(ctx)
 	if err != nil {
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error performing authn/authz check"))
 		return
@@ -296,6 +314,15 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 	if ret.UserData.User.Id != nil {
 		ret.UserId = *ret.UserData.User.Id
 	}
+	if impersonator != nil {
+		// Audit events record both identities, so it is always clear who
+		// actually performed a request made on behalf of another user.
+		ret.ImpersonatorUserId = *impersonator.User.Id
+		ea.ImpersonatorInfo = &event.UserInfo{UserId: ret.ImpersonatorUserId}
+		if impersonator.Account.Id != nil {
+			ea.ImpersonatorInfo.AuthAccountId = *impersonator.Account.Id
+		}
+	}
 	ret.AuthTokenId = v.requestInfo.PublicId
 	ret.AuthenticationFinished = authResults.AuthenticationFinished
 	if !authResults.Authorized {
@@ -356,6 +383,11 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 		ea.UserEmail = *userData.User.Email
 	}
 
+	if err := v.checkScopeQuota(ctx, ret.Scope); err != nil {
+		ret.Error = err
+		return
+	}
+
 	if reqInfo != nil {
 		reqInfo.UserId = ret.UserId
 		reqInfo.OutputFields = authResults.OutputFields
@@ -365,6 +397,34 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 	return
 }
 
+// checkScopeQuota counts the request against the api request quota of the org
+// scope it is made in, or of the org of its project, and returns an error if
+// the quota is exhausted. Requests made in the global scope are not subject to
+// scope quotas.
+func (v *verifier) checkScopeQuota(ctx context.Context, scp *scopes.ScopeInfo) error {
+	q, ok := scopequota.FromContext(ctx)
+	if !ok || v.scopeQuotaChecked {
+		return nil
+	}
+	var orgId string
+	switch scp.GetType() {
+	case scope.Org.String():
+		orgId = scp.GetId()
+	case scope.Project.String():
+		orgId = scp.GetParentScopeId()
+	default:
+		return nil
+	}
+	v.scopeQuotaChecked = true
+	u, ok := q.Allow(orgId)
+	if ok {
+		return nil
+	}
+	return handlers.ApiErrorWithCodeAndMessage(codes.ResourceExhausted,
+		"The api request quota of scope %s is exhausted: %d requests are allowed every %s. The quota resets in %s.",
+		u.ScopeId, u.Limit, u.Period, u.ResetsIn().Round(time.Second))
+}
+
 func (v *verifier) decryptToken(ctx context.Context) {
 	const op = "auth.(verifier).decryptToken"
 	switch v.requestInfo.TokenFormat {
@@ -497,6 +557,7 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 	scopeInfo *scopes.ScopeInfo,
 	retAcl perms.ACL,
 	grantTuples []perms.GrantTuple,
+	impersonator *template.Data,
 	retErr error,
 ) {
 	const op = "auth.(verifier).performAuthCheck"
@@ -554,6 +615,20 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 		return
 	}
 
+	if v.requestInfo.ImpersonateUserId != "" {
+		if err := v.checkImpersonation(ctx, iamRepo, userData); err != nil {
+			retErr = errors.Wrap(ctx, err, op)
+			return
+		}
+		// From here on the request is checked as the impersonated user, who
+		// did not authenticate with any account
+		impersonator = &template.Data{}
+		impersonator.User.Id = userData.User.Id
+		impersonator.Account.Id = userData.Account.Id
+		userData = template.Data{}
+		userData.User.Id = util.Pointer(v.requestInfo.ImpersonateUserId)
+	}
+
 	u, _, err := iamRepo.LookupUser(ctx, *userData.User.Id)
 	if err != nil {
 		retErr = errors.Wrap(ctx, err, op, errors.WithMsg("failed to lookup user"))
@@ -647,16 +722,31 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 		return
 	}
 
-	var parsedGrants []perms.Grant
-
-	// Fetch and parse grants for this user ID (which may include grants for
-	// u_anon and u_auth)
-	grantTuples, err = iamRepo.GrantsForUser(v.ctx, *userData.User.Id)
+	retAcl, grantTuples, err = v.aclForUser(ctx, iamRepo, userData)
 	if err != nil {
 		retErr = errors.Wrap(ctx, err, op)
 		return
 	}
-	parsedGrants = make([]perms.Grant, 0, len(grantTuples))
+	aclResults = retAcl.Allowed(*v.res, v.act, *userData.User.Id)
+	// We don't set authenticated above because setting this but not authorized
+	// is used for further permissions checks, such as during recursive listing.
+	// So we want to make sure any code relying on that has the full set of
+	// grants successfully loaded.
+	aclResults.AuthenticationFinished = true
+	retErr = nil
+	return
+}
+
+// aclForUser fetches and parses the grants for the user of userData, which may
+// include grants for u_anon and u_auth, and returns them along with the ACL
+// they form.
+func (v verifier) aclForUser(ctx context.Context, iamRepo *iam.Repository, userData template.Data) (perms.ACL, []perms.GrantTuple, error) {
+	const op = "auth.(verifier).aclForUser"
+	grantTuples, err := iamRepo.GrantsForUser(v.ctx, *userData.User.Id)
+	if err != nil {
+		return perms.ACL{}, nil, errors.Wrap(ctx, err, op)
+	}
+	parsedGrants := make([]perms.Grant, 0, len(grantTuples))
 	// Note: Below, we always skip validation so that we don't error on formats
 	// that we've since restricted, e.g. "ids=foo;actions=create,read". These
 	// will simply not have an effect.
@@ -673,21 +763,58 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 			tuple,
 			permsOpts...)
 		if err != nil {
-			retErr = errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to parse grant %#v", tuple.Grant)))
-			return
+			return perms.ACL{}, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to parse grant %#v", tuple.Grant)))
 		}
 		parsedGrants = append(parsedGrants, parsed)
 	}
+	return perms.NewACL(parsedGrants...), grantTuples, nil
+}
 
-	retAcl = perms.NewACL(parsedGrants...)
-	aclResults = retAcl.Allowed(*v.res, v.act, *userData.User.Id)
-	// We don't set authenticated above because setting this but not authorized
-	// is used for further permissions checks, such as during recursive listing.
-	// So we want to make sure any code relying on that has the full set of
-	// grants successfully loaded.
-	aclResults.AuthenticationFinished = true
-	retErr = nil
-	return
+// checkImpersonation verifies that the caller described by userData may
+// perform the request on behalf of the user in the request info, which
+// requires the impersonate action to be granted to the caller on that user.
+// Only authenticated users can impersonate, and built-in users cannot be
+// impersonated.
+func (v verifier) checkImpersonation(ctx context.Context, iamRepo *iam.Repository, userData template.Data) error {
+	const op = "auth.(verifier).checkImpersonation"
+	callerId, targetId := *userData.User.Id, v.requestInfo.ImpersonateUserId
+	switch {
+	case callerId == globals.AnonymousUserId || callerId == globals.RecoveryUserId:
+		return errors.New(ctx, errors.Forbidden, op, "only authenticated users can impersonate other users")
+	case targetId == globals.AnonymousUserId || targetId == globals.AnyAuthenticatedUserId || targetId == globals.RecoveryUserId:
+		return errors.New(ctx, errors.Forbidden, op, fmt.Sprintf("built-in user %q cannot be impersonated", targetId))
+	case targetId == callerId:
+		return errors.New(ctx, errors.Forbidden, op, "users cannot impersonate themselves")
+	}
+
+	target, _, err := iamRepo.LookupUser(ctx, targetId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to lookup user to impersonate"))
+	}
+	if target == nil {
+		return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("user %q to impersonate not found", targetId))
+	}
+	res := perms.Resource{
+		Id:      target.GetPublicId(),
+		ScopeId: target.GetScopeId(),
+		Type:    resource.User,
+	}
+	if res.ScopeId != scope.Global.String() {
+		scp, err := iamRepo.LookupScope(ctx, res.ScopeId)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		res.ParentScopeId = scp.GetParentId()
+	}
+
+	acl, _, err := v.aclForUser(ctx, iamRepo, userData)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if !acl.Allowed(res, action.Impersonate, callerId).Authorized {
+		return errors.New(ctx, errors.Forbidden, op, fmt.Sprintf("user %q is not allowed to impersonate user %q", callerId, targetId))
+	}
+	return nil
 }
 
 // FetchActionSetForId returns the allowed actions for a given ID using the
@@ -845,99 +972,57 @@ func (r *VerifyResults) ScopesAuthorizedForList(ctx context.Context, rootScopeId
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil scope in auth results")
 	}
 
+	// Determine the scopes in which the grants allow listing from the grants
+	// themselves, so that the cost of evaluating them does not depend on the
+	// number of scopes. A scope is also authorized when its parent is, so
+	// every scope is authorized if global is, and every scope other than
+	// global is if the orgs are.
+	var allowed perms.ScopesAllowed
+	switch {
+	case r.v.requestInfo.DisableAuthEntirely,
+		r.v.requestInfo.TokenFormat == uint32(AuthTokenTypeRecoveryKms):
+		allowed.Global = true
+	case r.UserData.User.Id == nil:
+		return nil, handlers.ForbiddenError()
+	default:
+		allowed = r.v.acl.AllowedScopes(resourceType, action.List, *r.UserData.User.Id)
+	}
+	allScopes := allowed.Global || allowed.Descendants || slices.Contains(allowed.ChildrenOfScopeIds, scope.Global.String())
+
+	// The authorized scopes are then fetched with a single query. Scopes are
+	// at most two levels deep, so a scope that is not authorized by the
+	// conditions above is authorized if it has one of the allowed ids or its
+	// parent does, or its parent is one of the scopes with allowed children.
+	var listOpts []iam.Option
+	if !allScopes {
+		if len(allowed.ScopeIds) == 0 && len(allowed.ChildrenOfScopeIds) == 0 {
+			return nil, handlers.ForbiddenError()
+		}
+		listOpts = append(listOpts, iam.WithScopeOrParentIds(allowed.ScopeIds, slices.Concat(allowed.ScopeIds, allowed.ChildrenOfScopeIds)))
+	}
+
 	repo, err := r.v.iamRepoFn()
 	if err != nil {
 		return nil, err
 	}
-
-	// Get all scopes recursively. Start at global because we need to take into
-	// account permissions in parent scopes even if they want to scale back the
-	// returned information to a child scope and its children.
-	scps, err := repo.ListScopesRecursively(ctx, scope.Global.String())
+	scps, err := repo.ListScopesRecursively(ctx, rootScopeId, listOpts...)
 	if err != nil {
 		return nil, err
 	}
 
-	var deferredScopes []*iam.Scope
-	var globalHasList bool                                 // Store whether global has list permission
-	scopeResourceMap := make(map[string]*scopes.ScopeInfo) // Scope data per scope id
-
-	// For each scope, see if we have permission to list that resource in that scope
+	scopeResourceMap := make(map[string]*scopes.ScopeInfo, len(scps)) // Scope data per scope id
 	for _, scp := range scps {
-		scpId := scp.GetPublicId()
-		aSet := r.FetchActionSetForType(ctx,
-			resource.Unknown, // This is overridden by `WithResource` option.
-			action.NewActionSet(action.List),
-			WithResource(&perms.Resource{Type: resourceType, ScopeId: scpId, ParentScopeId: scp.GetParentId()}),
-		)
-
-		// We only expect the action set to be nothing, or list. In case
-		// this is not the case, we bail out.
-		switch {
-		case len(aSet) == 0:
-			// Defer until we've read all scopes. We do this because if the
-			// ordering coming back isn't in parent-first ordering our map
-			// lookup might fail.
-			deferredScopes = append(deferredScopes, scp)
-		case len(aSet) == 1 || r.UserId == globals.RecoveryUserId:
-			if !aSet.HasAction(action.List) {
-				return nil, errors.New(ctx, errors.Internal, op, "unexpected action in set")
-			}
-			if scopeResourceMap[scpId] == nil {
-				scopeResourceMap[scpId] = &scopes.ScopeInfo{
-					Id:            scp.GetPublicId(),
-					Type:          scp.GetType(),
-					Name:          scp.GetName(),
-					Description:   scp.GetDescription(),
-					ParentScopeId: scp.GetParentId(),
-				}
-			}
-			if scpId == scope.Global.String() {
-				globalHasList = true
-			}
-		default:
-			return nil, errors.New(ctx, errors.Internal, op, "unexpected number of actions back in set")
-		}
-	}
-
-	// Now go through the deferred scopes and see if a parent matches
-	for _, scp := range deferredScopes {
-		// If they had list on global scope anything else is automatically
-		// included; otherwise if they had list on the parent scope, this
-		// scope is included in the map and is sufficient here.
-		if globalHasList || scopeResourceMap[scp.GetParentId()] != nil {
-			scpId := scp.GetPublicId()
-			if scopeResourceMap[scpId] == nil {
-				scopeResourceMap[scpId] = &scopes.ScopeInfo{
-					Id:            scp.GetPublicId(),
-					Type:          scp.GetType(),
-					Name:          scp.GetName(),
-					Description:   scp.GetDescription(),
-					ParentScopeId: scp.GetParentId(),
-				}
-			}
+		if scp.GetPublicId() == scope.Global.String() && !allowed.Global {
+			continue
 		}
-	}
-
-	// Elide out any that aren't under the root scope id
-	elideScopes := make([]string, 0, len(scopeResourceMap))
-	for scpId, scp := range scopeResourceMap {
-		switch rootScopeId {
-		// If the root is global, it matches
-		case scope.Global.String():
-		// If the current scope matches the root, it matches
-		case scpId:
-			// Or if the parent of this scope is the root (for orgs that would mean
-			// a root scope ID which is covered in the case above, so this is really
-			// projects matching an org used as the root)
-		case scp.ParentScopeId:
-		default:
-			elideScopes = append(elideScopes, scpId)
+		scopeResourceMap[scp.GetPublicId()] = &scopes.ScopeInfo{
+			Id:            scp.GetPublicId(),
+			Type:          scp.GetType(),
+			Name:          scp.GetName(),
+			Description:   scp.GetDescription(),
+			ParentScopeId: scp.GetParentId(),
 		}
 	}
-	for _, scpId := range elideScopes {
-		delete(scopeResourceMap, scpId)
-	}
 
 	// If we have nothing in scopeInfoMap at this point, we aren't authorized
 	// anywhere so return 403.
@@ -6,12 +6,14 @@ package auth
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -21,12 +23,15 @@ import (
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scopequota"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/tests/api"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"github.com/hashicorp/eventlogger/filters/encrypt"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 )
 
 func TestAuthTokenAuthenticator(t *testing.T) {
@@ -265,6 +270,136 @@ func TestVerify_AuditEvent(t *testing.T) {
 	}
 }
 
+func TestVerify_Impersonation(t *testing.T) {
+	ctx := context.Background()
+	eventConfig := event.TestEventerConfig(t, "Test_Verify_Impersonation", event.TestWithAuditSink(t))
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	require.NoError(t, event.InitSysEventer(testLogger, testLock, "Test_Verify_Impersonation", event.WithEventerConfig(&eventConfig.EventerConfig)))
+
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	tokenRepo, err := authtoken.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return tokenRepo, nil
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, testKms)
+	}
+
+	o, _ := iam.TestScopes(t, iamRepo)
+	target := iam.TestUser(t, iamRepo, o.GetPublicId())
+	tokenValue := func(at *authtoken.AuthToken) string {
+		encToken, err := authtoken.EncryptToken(context.Background(), testKms, o.GetPublicId(), at.GetPublicId(), at.GetToken())
+		require.NoError(t, err)
+		return at.GetPublicId() + "_" + encToken
+	}
+
+	// The support user is granted impersonation of the users of the org,
+	// while the other user is not.
+	supportAt := authtoken.TestAuthToken(t, conn, testKms, o.GetPublicId())
+	role := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, role.GetPublicId(), "ids=*;type=user;actions=impersonate")
+	iam.TestUserRole(t, conn, role.GetPublicId(), supportAt.GetIamUserId())
+	otherAt := authtoken.TestAuthToken(t, conn, testKms, o.GetPublicId())
+
+	tests := []struct {
+		name             string
+		token            string
+		impersonate      string
+		wantErr          bool
+		wantUserId       string
+		wantImpersonator string
+	}{
+		{
+			name:             "granted",
+			token:            tokenValue(supportAt),
+			impersonate:      target.GetPublicId(),
+			wantUserId:       target.GetPublicId(),
+			wantImpersonator: supportAt.GetIamUserId(),
+		},
+		{
+			name:        "not-granted",
+			token:       tokenValue(otherAt),
+			impersonate: target.GetPublicId(),
+			wantErr:     true,
+		},
+		{
+			name:        "anonymous",
+			impersonate: target.GetPublicId(),
+			wantErr:     true,
+		},
+		{
+			name:        "self",
+			token:       tokenValue(supportAt),
+			impersonate: supportAt.GetIamUserId(),
+			wantErr:     true,
+		},
+		{
+			name:        "built-in-user",
+			token:       tokenValue(supportAt),
+			impersonate: globals.AnyAuthenticatedUserId,
+			wantErr:     true,
+		},
+		{
+			name:        "missing-user",
+			token:       tokenValue(supportAt),
+			impersonate: "u_1234567890",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			req := httptest.NewRequest("GET", "http://127.0.0.1/v1/scopes/o_1", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tt.token))
+			}
+			req.Header.Set(ImpersonateUserHeader, tt.impersonate)
+
+			requestInfo := authpb.RequestInfo{
+				Path:              req.URL.Path,
+				Method:            req.Method,
+				ImpersonateUserId: req.Header.Get(ImpersonateUserHeader),
+			}
+			requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = GetTokenFromRequest(context.TODO(), testKms, req)
+			ctx := NewVerifierContext(ctx, iamRepoFn, tokenRepoFn, serversRepoFn, testKms, &requestInfo)
+
+			_ = os.WriteFile(eventConfig.AuditEvents.Name(), nil, 0o666) // clean out audit events from previous calls
+			got := Verify(ctx, WithScopeId(o.GetPublicId()))
+			if tt.wantErr {
+				assert.Error(got.Error)
+				assert.Empty(got.ImpersonatorUserId)
+				return
+			}
+			require.NoError(got.Error)
+			assert.Equal(tt.wantUserId, got.UserId)
+			assert.Equal(tt.wantImpersonator, got.ImpersonatorUserId)
+
+			e := api.CloudEventFromFile(t, eventConfig.AuditEvents.Name())
+			auth, ok := e.Data.(map[string]any)["auth"].(map[string]any)
+			require.True(ok)
+			userInfo, ok := auth["user_info"].(map[string]any)
+			require.True(ok)
+			assert.Equal(tt.wantUserId, userInfo["id"])
+			impersonatorInfo, ok := auth["impersonator_info"].(map[string]any)
+			require.True(ok)
+			assert.Equal(tt.wantImpersonator, impersonatorInfo["id"])
+			assert.Equal(supportAt.GetAuthAccountId(), impersonatorInfo["auth_account_id"])
+		})
+	}
+}
+
 func TestGrantsHash(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -343,3 +478,35 @@ func TestGrantsHash(t *testing.T) {
 	assert.False(t, bytes.Equal(hash1, hash3))
 	assert.False(t, bytes.Equal(hash1, hash3))
 }
+
+func TestVerifier_checkScopeQuota(t *testing.T) {
+	const orgId = "o_1234567890"
+	org := &scopes.ScopeInfo{Id: orgId, Type: "org", ParentScopeId: "global"}
+	proj := &scopes.ScopeInfo{Id: "p_1234567890", Type: "project", ParentScopeId: orgId}
+	global := &scopes.ScopeInfo{Id: "global", Type: "global"}
+
+	q, err := scopequota.New(context.Background(), scopequota.Configs{
+		{Scopes: []string{orgId}, Limit: 2, Period: time.Minute},
+	})
+	require.NoError(t, err)
+	ctx := scopequota.NewContext(context.Background(), q)
+
+	// Requests are counted once, however many auth checks are performed.
+	v := &verifier{}
+	require.NoError(t, v.checkScopeQuota(ctx, org))
+	require.NoError(t, v.checkScopeQuota(ctx, org))
+	assert.Equal(t, uint64(1), q.Usage(orgId).Used)
+
+	// Requests made in projects count against the quota of their org.
+	require.NoError(t, (&verifier{}).checkScopeQuota(ctx, proj))
+	assert.Equal(t, uint64(2), q.Usage(orgId).Used)
+
+	err = (&verifier{}).checkScopeQuota(ctx, proj)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.ResourceExhausted)))
+	assert.Contains(t, err.Error(), orgId)
+
+	// The global scope and requests without quotas are not limited.
+	assert.NoError(t, (&verifier{}).checkScopeQuota(ctx, global))
+	assert.NoError(t, (&verifier{}).checkScopeQuota(context.Background(), org))
+}
@@ -14,14 +14,18 @@ import (
 	"github.com/hashicorp/boundary/internal/credential"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/environment"
 	"github.com/hashicorp/boundary/internal/host"
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/maintenance"
 	"github.com/hashicorp/boundary/internal/plugin"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
+	"github.com/hashicorp/boundary/internal/targettemplate"
 )
 
 type (
@@ -45,6 +49,10 @@ type (
 	BillingRepoFactory             func() (*billing.Repository, error)
 	AliasRepoFactory               func() (*alias.Repository, error)
 	TargetAliasRepoFactory         func() (*target.Repository, error)
+	ListTokenRepoFactory           func() (*listtoken.Repository, error)
+	MaintenanceRepoFactory         func() (*maintenance.Repository, error)
+	EnvironmentRepoFactory         func() (*environment.Repository, error)
+	TargetTemplateRepoFactory      func() (*targettemplate.Repository, error)
 )
 
 // Downstreamers provides at least a minimum interface that must be met by a
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/go-secure-stdlib/base62"
+)
+
+// ErrNoReverseTunnel is returned by Open if no worker registered a reverse
+// tunnel for the target with this controller.
+var ErrNoReverseTunnel = errors.New("no reverse tunnel registered for the target")
+
+// ReverseTunnelOpen asks a worker to open a tunnel for a session connection.
+type ReverseTunnelOpen struct {
+	ConnectionId  string
+	ClientAddress string
+}
+
+// ReverseTunnelRegistry tracks the workers which registered reverse tunnels
+// for targets, and hands the session connections the controller receives on
+// its tunnel listener to the tunnels the workers open for them. Tunnels are
+// only held in memory, so a worker is only reachable through the tunnel
+// listener of the controller it registered its tunnels with.
+type ReverseTunnelRegistry struct {
+	// address is the address clients reach the tunnel listener of this
+	// controller at.
+	address string
+
+	mu sync.Mutex
+	// tunnels holds the tunnels registered for each target id, in the order
+	// they were registered.
+	tunnels map[string][]*reverseTunnel
+	// pending holds the connections waiting for their worker to open a
+	// tunnel, keyed by connection id.
+	pending map[string]chan net.Conn
+}
+
+type reverseTunnel struct {
+	workerId string
+	opens    chan ReverseTunnelOpen
+}
+
+// NewReverseTunnelRegistry returns a new ReverseTunnelRegistry for a
+// controller whose tunnel listener is reached at address.
+func NewReverseTunnelRegistry(address string) *ReverseTunnelRegistry {
+	return &ReverseTunnelRegistry{
+		address: address,
+		tunnels: make(map[string][]*reverseTunnel),
+		pending: make(map[string]chan net.Conn),
+	}
+}
+
+// Address returns the address clients reach the tunnel listener at.
+func (r *ReverseTunnelRegistry) Address() string {
+	if r == nil {
+		return ""
+	}
+	return r.address
+}
+
+// Register registers the worker as a reverse tunnel for the targets. The
+// returned channel receives a ReverseTunnelOpen for each session connection
+// the worker should open a tunnel for, until unregister is called.
+func (r *ReverseTunnelRegistry) Register(workerId string, targetIds []string) (opens <-chan ReverseTunnelOpen, unregister func()) {
+	t := &reverseTunnel{
+		workerId: workerId,
+		opens:    make(chan ReverseTunnelOpen),
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range targetIds {
+		r.tunnels[id] = append(r.tunnels[id], t)
+	}
+	return t.opens, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		for _, id := range targetIds {
+			tunnels := r.tunnels[id]
+			for i, rt := range tunnels {
+				if rt == t {
+					tunnels = append(tunnels[:i], tunnels[i+1:]...)
+					break
+				}
+			}
+			if len(tunnels) == 0 {
+				delete(r.tunnels, id)
+			} else {
+				r.tunnels[id] = tunnels
+			}
+		}
+	}
+}
+
+// Workers returns the ids of the workers with a reverse tunnel registered for
+// the target, most recently registered first.
+func (r *ReverseTunnelRegistry) Workers(targetId string) []string {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tunnels := r.tunnels[targetId]
+	ret := make([]string, 0, len(tunnels))
+	for i := len(tunnels) - 1; i >= 0; i-- {
+		ret = append(ret, tunnels[i].workerId)
+	}
+	return ret
+}
+
+// Open asks the most recently registered worker with a reverse tunnel for the
+// target to open a tunnel for a session connection from the client address,
+// and waits until the worker opened it or the context is done.
+func (r *ReverseTunnelRegistry) Open(ctx context.Context, targetId, clientAddress string) (net.Conn, error) {
+	const op = "common.(ReverseTunnelRegistry).Open"
+	switch {
+	case r == nil:
+		return nil, fmt.Errorf("%s: missing registry", op)
+	case targetId == "":
+		return nil, fmt.Errorf("%s: missing target id", op)
+	}
+	connectionId, err := base62.Random(20)
+	if err != nil {
+		return nil, fmt.Errorf("%s: error generating connection id: %w", op, err)
+	}
+	accepted := make(chan net.Conn, 1)
+
+	r.mu.Lock()
+	tunnels := r.tunnels[targetId]
+	if len(tunnels) == 0 {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("%s: %w", op, ErrNoReverseTunnel)
+	}
+	t := tunnels[len(tunnels)-1]
+	r.pending[connectionId] = accepted
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.pending, connectionId)
+		// The worker may have opened the tunnel after the context was done
+		select {
+		case c := <-accepted:
+			c.Close()
+		default:
+		}
+	}()
+
+	select {
+	case t.opens <- ReverseTunnelOpen{ConnectionId: connectionId, ClientAddress: clientAddress}:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%s: worker %s did not receive the tunnel request: %w", op, t.workerId, ctx.Err())
+	}
+	select {
+	case c := <-accepted:
+		return c, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%s: worker %s did not open the tunnel: %w", op, t.workerId, ctx.Err())
+	}
+}
+
+// Accept passes the tunnel a worker opened to the connection waiting for it.
+// It reports whether a connection was waiting for the tunnel.
+func (r *ReverseTunnelRegistry) Accept(connectionId string, c net.Conn) bool {
+	if r == nil || connectionId == "" {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	accepted, ok := r.pending[connectionId]
+	if !ok {
+		return false
+	}
+	delete(r.pending, connectionId)
+	accepted <- c
+	return true
+}
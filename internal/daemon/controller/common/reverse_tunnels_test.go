@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package common
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverseTunnelRegistry(t *testing.T) {
+	t.Run("opened", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		r := NewReverseTunnelRegistry("controller:9204")
+		assert.Equal("controller:9204", r.Address())
+
+		opens1, unregister1 := r.Register("w_1111111111", []string{"ttcp_1234567890"})
+		defer unregister1()
+		opens2, unregister2 := r.Register("w_2222222222", []string{"ttcp_1234567890", "ttcp_other"})
+		assert.Equal([]string{"w_2222222222", "w_1111111111"}, r.Workers("ttcp_1234567890"))
+		assert.Equal([]string{"w_2222222222"}, r.Workers("ttcp_other"))
+		assert.Empty(r.Workers("ttcp_unknown"))
+
+		type result struct {
+			c   net.Conn
+			err error
+		}
+		results := make(chan result)
+		go func() {
+			c, err := r.Open(context.Background(), "ttcp_1234567890", "10.0.0.1:1234")
+			results <- result{c: c, err: err}
+		}()
+
+		// The most recently registered worker is asked to open the tunnel
+		o := <-opens2
+		assert.NotEmpty(o.ConnectionId)
+		assert.Equal("10.0.0.1:1234", o.ClientAddress)
+		assert.False(r.Accept("unknown", nil))
+		tunnel, _ := net.Pipe()
+		require.True(r.Accept(o.ConnectionId, tunnel))
+		assert.False(r.Accept(o.ConnectionId, tunnel), "a tunnel is only accepted once")
+
+		res := <-results
+		require.NoError(res.err)
+		assert.Equal(tunnel, res.c)
+
+		unregister2()
+		assert.Equal([]string{"w_1111111111"}, r.Workers("ttcp_1234567890"))
+		assert.Empty(r.Workers("ttcp_other"))
+		select {
+		case <-opens1:
+			t.Fatal("unexpected tunnel request")
+		default:
+		}
+	})
+
+	t.Run("no-tunnel", func(t *testing.T) {
+		r := NewReverseTunnelRegistry("controller:9204")
+		_, err := r.Open(context.Background(), "ttcp_1234567890", "10.0.0.1:1234")
+		assert.ErrorIs(t, err, ErrNoReverseTunnel)
+
+		var nilRegistry *ReverseTunnelRegistry
+		assert.Empty(t, nilRegistry.Workers("ttcp_1234567890"))
+		assert.Empty(t, nilRegistry.Address())
+		assert.False(t, nilRegistry.Accept("connection", nil))
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		assert := assert.New(t)
+		r := NewReverseTunnelRegistry("controller:9204")
+		opens, unregister := r.Register("w_1111111111", []string{"ttcp_1234567890"})
+		defer unregister()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		connectionIds := make(chan string, 1)
+		go func() {
+			connectionIds <- (<-opens).ConnectionId
+		}()
+		_, err := r.Open(ctx, "ttcp_1234567890", "10.0.0.1:1234")
+		assert.ErrorIs(err, context.DeadlineExceeded)
+		// A tunnel opened after the connection gave up is refused
+		assert.False(r.Accept(<-connectionIds, nil))
+	})
+}
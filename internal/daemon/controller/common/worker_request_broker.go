@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/go-secure-stdlib/base62"
+)
+
+// ErrWorkerRequestTimeout is returned by Request if the worker did not answer
+// the request before the context was done.
+var ErrWorkerRequestTimeout = errors.New("worker did not answer the request in time")
+
+// WorkerRequestMessage is implemented by the requests sent to workers in the
+// status responses and by the results they send back in their status
+// requests.
+type WorkerRequestMessage interface {
+	GetRequestId() string
+}
+
+// WorkerRequestBroker passes requests for workers from the API handlers to the
+// worker status handler, which sends them to the workers in its responses, and
+// passes the results the workers send back in their next status requests to
+// the waiting API handlers. Requests are only held in memory, so a request is
+// only answered if the worker reports its status to the controller that
+// received the request.
+type WorkerRequestBroker[Req, Res WorkerRequestMessage] struct {
+	mu sync.Mutex
+	// unsent holds the requests that have not been sent yet, keyed by worker
+	// id.
+	unsent map[string][]Req
+	// waiting holds the requests waiting for results, keyed by request id.
+	waiting map[string]*requestWaiter[Res]
+	// polled holds the last time each worker asked for its pending requests,
+	// keyed by worker id.
+	polled map[string]time.Time
+}
+
+type requestWaiter[Res WorkerRequestMessage] struct {
+	workerId string
+	result   chan Res
+}
+
+// WorkerDiagnosticsBroker passes requests for the logs and metrics of workers.
+type WorkerDiagnosticsBroker = WorkerRequestBroker[*pbs.WorkerDiagnosticsRequest, *pbs.WorkerDiagnostics]
+
+// WorkerConnectionTestBroker passes requests for workers to test their
+// connectivity to an address.
+type WorkerConnectionTestBroker = WorkerRequestBroker[*pbs.WorkerConnectionTestRequest, *pbs.WorkerConnectionTestResult]
+
+// NewWorkerRequestBroker returns a new WorkerRequestBroker.
+func NewWorkerRequestBroker[Req, Res WorkerRequestMessage]() *WorkerRequestBroker[Req, Res] {
+	return &WorkerRequestBroker[Req, Res]{
+		unsent:  make(map[string][]Req),
+		waiting: make(map[string]*requestWaiter[Res]),
+		polled:  make(map[string]time.Time),
+	}
+}
+
+// NewWorkerDiagnosticsBroker returns a new WorkerDiagnosticsBroker.
+func NewWorkerDiagnosticsBroker() *WorkerDiagnosticsBroker {
+	return NewWorkerRequestBroker[*pbs.WorkerDiagnosticsRequest, *pbs.WorkerDiagnostics]()
+}
+
+// NewWorkerConnectionTestBroker returns a new WorkerConnectionTestBroker.
+func NewWorkerConnectionTestBroker() *WorkerConnectionTestBroker {
+	return NewWorkerRequestBroker[*pbs.WorkerConnectionTestRequest, *pbs.WorkerConnectionTestResult]()
+}
+
+// Request sends the request built by newReq with a generated request id to
+// the worker, and waits until the worker answers it or the context is done.
+func (b *WorkerRequestBroker[Req, Res]) Request(ctx context.Context, workerId string, newReq func(requestId string) Req) (Res, error) {
+	const op = "common.(WorkerRequestBroker).Request"
+	var zero Res
+	switch {
+	case b == nil:
+		return zero, fmt.Errorf("%s: missing broker", op)
+	case workerId == "":
+		return zero, fmt.Errorf("%s: missing worker id", op)
+	case newReq == nil:
+		return zero, fmt.Errorf("%s: missing request function", op)
+	}
+	requestId, err := base62.Random(20)
+	if err != nil {
+		return zero, fmt.Errorf("%s: error generating request id: %w", op, err)
+	}
+	w := &requestWaiter[Res]{
+		workerId: workerId,
+		result:   make(chan Res, 1),
+	}
+
+	b.mu.Lock()
+	b.waiting[requestId] = w
+	b.unsent[workerId] = append(b.unsent[workerId], newReq(requestId))
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.waiting, requestId)
+		reqs := b.unsent[workerId]
+		for i, r := range reqs {
+			if r.GetRequestId() == requestId {
+				reqs = append(reqs[:i], reqs[i+1:]...)
+				break
+			}
+		}
+		if len(reqs) == 0 {
+			delete(b.unsent, workerId)
+		} else {
+			b.unsent[workerId] = reqs
+		}
+	}()
+
+	select {
+	case r := <-w.result:
+		return r, nil
+	case <-ctx.Done():
+		return zero, fmt.Errorf("%s: %w", op, errors.Join(ErrWorkerRequestTimeout, ctx.Err()))
+	}
+}
+
+// PendingRequests returns the requests for the worker which have not been
+// sent to it yet. The requests are considered sent once returned.
+func (b *WorkerRequestBroker[Req, Res]) PendingRequests(workerId string) []Req {
+	if b == nil || workerId == "" {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.polled[workerId] = time.Now()
+	reqs := b.unsent[workerId]
+	delete(b.unsent, workerId)
+	return reqs
+}
+
+// PolledSince reports whether the worker asked for its pending requests at or
+// after t, which means it reports its status to this controller.
+func (b *WorkerRequestBroker[Req, Res]) PolledSince(workerId string, t time.Time) bool {
+	if b == nil || workerId == "" {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p, ok := b.polled[workerId]
+	return ok && !p.Before(t)
+}
+
+// Deliver passes the results sent by the worker to the requests waiting for
+// them. Results which do not answer a request made to that worker are ignored.
+func (b *WorkerRequestBroker[Req, Res]) Deliver(workerId string, results []Res) {
+	if b == nil || workerId == "" || len(results) == 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, r := range results {
+		w, ok := b.waiting[r.GetRequestId()]
+		if !ok || w.workerId != workerId {
+			continue
+		}
+		delete(b.waiting, r.GetRequestId())
+		w.result <- r
+	}
+}
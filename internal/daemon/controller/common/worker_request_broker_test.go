@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func diagnosticsRequest(minutes uint32) func(string) *pbs.WorkerDiagnosticsRequest {
+	return func(requestId string) *pbs.WorkerDiagnosticsRequest {
+		return &pbs.WorkerDiagnosticsRequest{RequestId: requestId, Minutes: minutes}
+	}
+}
+
+func TestWorkerRequestBroker(t *testing.T) {
+	t.Run("delivered", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		b := NewWorkerDiagnosticsBroker()
+
+		type result struct {
+			d   *pbs.WorkerDiagnostics
+			err error
+		}
+		results := make(chan result)
+		go func() {
+			d, err := b.Request(context.Background(), "w_1234567890", diagnosticsRequest(10))
+			results <- result{d: d, err: err}
+		}()
+
+		start := time.Now()
+		assert.False(b.PolledSince("w_1234567890", start))
+		var reqs []*pbs.WorkerDiagnosticsRequest
+		require.Eventually(func() bool {
+			reqs = b.PendingRequests("w_1234567890")
+			return len(reqs) > 0
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Len(reqs, 1)
+		assert.NotEmpty(reqs[0].GetRequestId())
+		assert.Equal(uint32(10), reqs[0].GetMinutes())
+		assert.Empty(b.PendingRequests("w_1234567890"))
+		assert.True(b.PolledSince("w_1234567890", start))
+		assert.False(b.PolledSince("w_other", start))
+
+		// Diagnostics sent by another worker are ignored
+		b.Deliver("w_other", []*pbs.WorkerDiagnostics{{RequestId: reqs[0].GetRequestId()}})
+		b.Deliver("w_1234567890", []*pbs.WorkerDiagnostics{{
+			RequestId: reqs[0].GetRequestId(),
+			LogLines:  []string{"line"},
+		}})
+
+		r := <-results
+		require.NoError(r.err)
+		assert.Equal([]string{"line"}, r.d.GetLogLines())
+		assert.Empty(b.waiting)
+	})
+	t.Run("timeout", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		b := NewWorkerDiagnosticsBroker()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		d, err := b.Request(ctx, "w_1234567890", diagnosticsRequest(5))
+		require.Error(err)
+		assert.Nil(d)
+		assert.ErrorIs(err, ErrWorkerRequestTimeout)
+		assert.ErrorIs(err, context.DeadlineExceeded)
+		assert.Empty(b.waiting)
+		assert.Empty(b.unsent)
+	})
+	t.Run("missing-worker-id", func(t *testing.T) {
+		b := NewWorkerDiagnosticsBroker()
+		_, err := b.Request(context.Background(), "", diagnosticsRequest(5))
+		assert.ErrorContains(t, err, "missing worker id")
+	})
+	t.Run("missing-request-function", func(t *testing.T) {
+		b := NewWorkerConnectionTestBroker()
+		_, err := b.Request(context.Background(), "w_1234567890", nil)
+		assert.ErrorContains(t, err, "missing request function")
+	})
+	t.Run("connection-test", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		b := NewWorkerConnectionTestBroker()
+
+		results := make(chan *pbs.WorkerConnectionTestResult)
+		go func() {
+			r, _ := b.Request(context.Background(), "w_1234567890", func(requestId string) *pbs.WorkerConnectionTestRequest {
+				return &pbs.WorkerConnectionTestRequest{RequestId: requestId, Address: "127.0.0.1:22", Check: "ssh"}
+			})
+			results <- r
+		}()
+
+		var reqs []*pbs.WorkerConnectionTestRequest
+		require.Eventually(func() bool {
+			reqs = b.PendingRequests("w_1234567890")
+			return len(reqs) > 0
+		}, 5*time.Second, 10*time.Millisecond)
+		require.Len(reqs, 1)
+		assert.Equal("127.0.0.1:22", reqs[0].GetAddress())
+		assert.Equal("ssh", reqs[0].GetCheck())
+
+		b.Deliver("w_1234567890", []*pbs.WorkerConnectionTestResult{{
+			RequestId: reqs[0].GetRequestId(),
+			Success:   true,
+		}})
+		assert.True((<-results).GetSuccess())
+	})
+	t.Run("nil-broker", func(t *testing.T) {
+		var b *WorkerDiagnosticsBroker
+		assert.Empty(t, b.PendingRequests("w_1234567890"))
+		assert.False(t, b.PolledSince("w_1234567890", time.Time{}))
+		b.Deliver("w_1234567890", []*pbs.WorkerDiagnostics{{RequestId: "r"}})
+	})
+}
@@ -28,8 +28,11 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/cluster"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/health"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
 	"github.com/hashicorp/boundary/internal/daemon/controller/internal/metric"
+	"github.com/hashicorp/boundary/internal/daemon/selfmonitor"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/environment"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	intglobals "github.com/hashicorp/boundary/internal/globals"
@@ -39,27 +42,33 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	kmsjob "github.com/hashicorp/boundary/internal/kms/job"
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/maintenance"
+	"github.com/hashicorp/boundary/internal/pagination/estimate"
 	"github.com/hashicorp/boundary/internal/pagination/purge"
 	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/plugin/consul"
+	"github.com/hashicorp/boundary/internal/plugin/dns"
+	"github.com/hashicorp/boundary/internal/plugin/kubernetes"
 	"github.com/hashicorp/boundary/internal/plugin/loopback"
 	"github.com/hashicorp/boundary/internal/ratelimit"
 	"github.com/hashicorp/boundary/internal/recording"
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/scheduler/job"
+	"github.com/hashicorp/boundary/internal/scopequota"
 	"github.com/hashicorp/boundary/internal/server"
 	serversjob "github.com/hashicorp/boundary/internal/server/job"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/snapshot"
 	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
 	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/targettemplate"
 	"github.com/hashicorp/boundary/internal/types/scope"
-	boundary_plugin_assets "github.com/hashicorp/boundary/plugins/boundary"
 	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	external_plugins "github.com/hashicorp/boundary/sdk/plugins"
 	"github.com/hashicorp/boundary/version"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-secure-stdlib/mlock"
-	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
 	"github.com/hashicorp/nodeenrollment"
 	ua "go.uber.org/atomic"
 	"google.golang.org/grpc"
@@ -106,18 +115,43 @@ type Controller struct {
 	tickerWg    *sync.WaitGroup
 	schedulerWg *sync.WaitGroup
 
+	// Used to drain requests and jobs and report shutdown progress
+	shutdownState shutdownState
+
+	// The maintenance mode last read from the database
+	maintenanceMode atomic.Pointer[maintenance.Mode]
+
+	// The api request quotas of the org scopes, nil if none are configured
+	scopeQuotas atomic.Pointer[scopequota.Quotas]
+
 	workerAuthCache *sync.Map
 
+	// Caches the grants of users across requests
+	grantsCache *iam.GrantsCache
+
 	// downstream workers and routes to those workers
 	downstreamWorkers common.Downstreamers
 	downstreamConns   downstreamReceiver
 
 	apiListeners    []*base.ServerListener
 	clusterListener *base.ServerListener
+	tunnelListener  *base.ServerListener
+
+	// The reverse tunnels workers registered for targets, nil if the
+	// controller has no tunnel listener
+	reverseTunnels *common.ReverseTunnelRegistry
 
 	// Used for testing and tracking worker health
 	workerStatusUpdateTimes *sync.Map
 
+	// Used to request the logs and metrics of workers through their status
+	// requests
+	workerDiagnostics *common.WorkerDiagnosticsBroker
+
+	// Used to request workers to test their connectivity to targets through
+	// their status requests
+	workerConnectionTests *common.WorkerConnectionTestBroker
+
 	// Timing variables. These are atomics for SIGHUP support, and are int64
 	// because they are casted to time.Duration.
 	workerStatusGracePeriod     *atomic.Int64
@@ -154,6 +188,10 @@ type Controller struct {
 	BillingRepoFn             common.BillingRepoFactory
 	AliasRepoFn               common.AliasRepoFactory
 	TargetAliasRepoFn         common.TargetAliasRepoFactory
+	ListTokenRepoFn           common.ListTokenRepoFactory
+	MaintenanceRepoFn         common.MaintenanceRepoFactory
+	EnvironmentRepoFn         common.EnvironmentRepoFactory
+	TargetTemplateRepoFn      common.TargetTemplateRepoFactory
 
 	scheduler *scheduler.Scheduler
 
@@ -175,6 +213,8 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	const op = "controller.New"
 	metric.InitializeApiCollectors(conf.PrometheusRegisterer)
 	ratelimit.InitializeMetrics(conf.PrometheusRegisterer)
+	session.InitializeMetrics(conf.PrometheusRegisterer)
+	iam.InitializeMetrics(conf.PrometheusRegisterer)
 	c := &Controller{
 		conf:                        conf,
 		logger:                      conf.Logger.Named("controller"),
@@ -182,7 +222,10 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		tickerWg:                    new(sync.WaitGroup),
 		schedulerWg:                 new(sync.WaitGroup),
 		workerAuthCache:             new(sync.Map),
+		grantsCache:                 iam.NewGrantsCache(iam.DefaultGrantsCacheMaxEntries),
 		workerStatusUpdateTimes:     new(sync.Map),
+		workerDiagnostics:           common.NewWorkerDiagnosticsBroker(),
+		workerConnectionTests:       common.NewWorkerConnectionTestBroker(),
 		enabledPlugins:              conf.Server.EnabledPlugins,
 		apiListeners:                make([]*base.ServerListener, 0),
 		downstreamConnManager:       cluster.NewDownstreamManager(),
@@ -264,6 +307,11 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 			c.apiListeners = append(c.apiListeners, l)
 		case "cluster":
 			clusterListeners = append(clusterListeners, l)
+		case "tunnel":
+			if c.tunnelListener != nil {
+				return nil, fmt.Errorf("at most one tunnel listener is allowed")
+			}
+			c.tunnelListener = l
 		}
 	}
 	if len(c.apiListeners) == 0 {
@@ -275,10 +323,20 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		return nil, fmt.Errorf("exactly one cluster listener is required")
 	}
 	c.clusterListener = clusterListeners[0]
+	if c.tunnelListener != nil {
+		tunnelAddr := conf.RawConfig.Controller.PublicTunnelAddr
+		if tunnelAddr == "" {
+			tunnelAddr = c.tunnelListener.Config.Address
+		}
+		c.reverseTunnels = common.NewReverseTunnelRegistry(tunnelAddr)
+	}
 
 	if err := c.initializeRateLimiter(conf.RawConfig); err != nil {
 		return nil, fmt.Errorf("error initializing rate limiter: %w", err)
 	}
+	if err := c.initializeScopeQuotas(conf.RawConfig); err != nil {
+		return nil, fmt.Errorf("error initializing scope quotas: %w", err)
+	}
 
 	var pluginLogger hclog.Logger
 	for _, enabledPlugin := range c.enabledPlugins {
@@ -302,16 +360,40 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 			if _, err = conf.RegisterPlugin(ctx, "loopback", plg, []plugin.PluginType{plugin.PluginTypeHost, plugin.PluginTypeStorage}, opts...); err != nil {
 				return nil, err
 			}
+		case enabledPlugin == base.EnabledPluginHostDns:
+			dp, err := dns.NewDnsPlugin()
+			if err != nil {
+				return nil, fmt.Errorf("error creating dns host plugin: %w", err)
+			}
+			if _, err := conf.RegisterPlugin(ctx, dns.PluginName, loopback.NewWrappingPluginHostClient(dp), []plugin.PluginType{plugin.PluginTypeHost}, plugin.WithDescription("Built-in DNS host plugin")); err != nil {
+				return nil, fmt.Errorf("error registering dns host plugin: %w", err)
+			}
+		case enabledPlugin == base.EnabledPluginHostKubernetes:
+			kp, err := kubernetes.NewKubernetesPlugin()
+			if err != nil {
+				return nil, fmt.Errorf("error creating kubernetes host plugin: %w", err)
+			}
+			if _, err := conf.RegisterPlugin(ctx, kubernetes.PluginName, loopback.NewWrappingPluginHostClient(kp), []plugin.PluginType{plugin.PluginTypeHost}, plugin.WithDescription("Built-in Kubernetes host plugin")); err != nil {
+				return nil, fmt.Errorf("error registering kubernetes host plugin: %w", err)
+			}
+		case enabledPlugin == base.EnabledPluginHostConsul:
+			cp, err := consul.NewConsulPlugin()
+			if err != nil {
+				return nil, fmt.Errorf("error creating consul host plugin: %w", err)
+			}
+			if _, err := conf.RegisterPlugin(ctx, consul.PluginName, loopback.NewWrappingPluginHostClient(cp), []plugin.PluginType{plugin.PluginTypeHost}, plugin.WithDescription("Built-in Consul host plugin")); err != nil {
+				return nil, fmt.Errorf("error registering consul host plugin: %w", err)
+			}
 		case enabledPlugin == base.EnabledPluginHostAzure && !c.conf.SkipPlugins:
 			pluginType := strings.ToLower(enabledPlugin.String())
+			pluginOpts, err := conf.RawConfig.Plugins.PluginOptions(pluginType)
+			if err != nil {
+				return nil, fmt.Errorf("error creating %s host plugin: %w", pluginType, err)
+			}
 			client, cleanup, err := external_plugins.CreateHostPlugin(
 				ctx,
 				pluginType,
-				external_plugins.WithPluginOptions(
-					pluginutil.WithPluginExecutionDirectory(conf.RawConfig.Plugins.ExecutionDir),
-					pluginutil.WithPluginsFilesystem(boundary_plugin_assets.PluginPrefix, boundary_plugin_assets.FileSystem()),
-				),
-				external_plugins.WithLogger(pluginLogger.Named(pluginType)),
+				append(pluginOpts, external_plugins.WithLogger(pluginLogger.Named(pluginType)))...,
 			)
 			if err != nil {
 				return nil, fmt.Errorf("error creating %s host plugin: %w", pluginType, err)
@@ -324,14 +406,14 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 			fallthrough
 		case enabledPlugin == base.EnabledPluginAws && !c.conf.SkipPlugins:
 			pluginType := strings.ToLower(enabledPlugin.String())
+			pluginOpts, err := conf.RawConfig.Plugins.PluginOptions(pluginType)
+			if err != nil {
+				return nil, fmt.Errorf("error creating %s host plugin: %w", pluginType, err)
+			}
 			client, cleanup, err := external_plugins.CreateHostPlugin(
 				ctx,
 				pluginType,
-				external_plugins.WithPluginOptions(
-					pluginutil.WithPluginExecutionDirectory(conf.RawConfig.Plugins.ExecutionDir),
-					pluginutil.WithPluginsFilesystem(boundary_plugin_assets.PluginPrefix, boundary_plugin_assets.FileSystem()),
-				),
-				external_plugins.WithLogger(pluginLogger.Named(pluginType)),
+				append(pluginOpts, external_plugins.WithLogger(pluginLogger.Named(pluginType)))...,
 			)
 			if err != nil {
 				return nil, fmt.Errorf("error creating %s host plugin: %w", pluginType, err)
@@ -412,7 +494,7 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		return nil, fmt.Errorf("error creating new scheduler: %w", err)
 	}
 	c.IamRepoFn = func() (*iam.Repository, error) {
-		return iam.NewRepository(ctx, dbase, dbase, c.kms, iam.WithRandomReader(c.conf.SecureRandomReader))
+		return iam.NewRepository(ctx, dbase, dbase, c.kms, iam.WithRandomReader(c.conf.SecureRandomReader), iam.WithGrantsCache(c.grantsCache))
 	}
 	c.StaticHostRepoFn = func() (*static.Repository, error) {
 		return static.NewRepository(ctx, dbase, dbase, c.kms)
@@ -482,6 +564,18 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.TargetAliasRepoFn = func() (*talias.Repository, error) {
 		return talias.NewRepository(ctx, dbase, dbase, c.kms)
 	}
+	c.ListTokenRepoFn = func() (*listtoken.Repository, error) {
+		return listtoken.NewRepository(ctx, dbase, dbase)
+	}
+	c.MaintenanceRepoFn = func() (*maintenance.Repository, error) {
+		return maintenance.NewRepository(ctx, dbase, dbase)
+	}
+	c.EnvironmentRepoFn = func() (*environment.Repository, error) {
+		return environment.NewRepository(ctx, dbase, dbase, c.kms)
+	}
+	c.TargetTemplateRepoFn = func() (*targettemplate.Repository, error) {
+		return targettemplate.NewRepository(ctx, dbase, dbase, c.kms)
+	}
 
 	// Check that credentials are available at startup, to avoid some harmless
 	// but nasty-looking errors
@@ -529,6 +623,14 @@ func (c *Controller) Start() error {
 	if err := c.registerJobs(); err != nil {
 		return fmt.Errorf("error registering jobs: %w", err)
 	}
+	// Read the maintenance mode before serving requests, so that requests
+	// which modify resources are rejected right away in read-only mode. The
+	// server only starts the controller once every migration was applied, so
+	// the mode can be read here; if reading fails anyway it is retried by the
+	// maintenance mode ticking.
+	if err := c.refreshMaintenanceMode(c.baseContext); err != nil {
+		event.WriteError(c.baseContext, op, err)
+	}
 	if err := c.startListeners(); err != nil {
 		return fmt.Errorf("error starting controller listeners: %w", err)
 	}
@@ -541,11 +643,15 @@ func (c *Controller) Start() error {
 		return fmt.Errorf("error starting scheduler: %w", err)
 	}
 
-	c.tickerWg.Add(5)
+	c.tickerWg.Add(6)
 	go func() {
 		defer c.tickerWg.Done()
 		c.startStatusTicking(c.baseContext)
 	}()
+	go func() {
+		defer c.tickerWg.Done()
+		c.startMaintenanceModeTicking(c.baseContext)
+	}()
 	go func() {
 		defer c.tickerWg.Done()
 		c.startNonceCleanupTicking(c.baseContext)
@@ -562,6 +668,19 @@ func (c *Controller) Start() error {
 		return errors.Wrap(c.baseContext, err, op)
 	}
 
+	if !c.conf.RawConfig.SelfMonitor.Disable {
+		opts := append(selfmonitor.ConfigOptions(c.conf.RawConfig.SelfMonitor), selfmonitor.WithPrometheusRegisterer(c.conf.PrometheusRegisterer))
+		monitor, err := selfmonitor.New(c.baseContext, "controller", opts...)
+		if err != nil {
+			return errors.Wrap(c.baseContext, err, op, errors.WithMsg("error creating self monitor"))
+		}
+		c.tickerWg.Add(1)
+		go func() {
+			defer c.tickerWg.Done()
+			monitor.Start(c.baseContext)
+		}()
+	}
+
 	if c.downstreamConns != nil {
 		c.tickerWg.Add(2)
 
@@ -619,10 +738,17 @@ func (c *Controller) registerJobs() error {
 	if err := vault.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
 		return err
 	}
-	if err := pluginhost.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.conf.HostPlugins); err != nil {
+	if err := credstatic.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
 		return err
 	}
-	if err := session.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.workerStatusGracePeriod); err != nil {
+	if err := pluginhost.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.conf.HostPlugins,
+		pluginhost.WithTargetCreator(targets.NewTemplateTargetCreator(c.TargetRepoFn, c.TargetTemplateRepoFn)),
+	); err != nil {
+		return err
+	}
+	if err := session.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.workerStatusGracePeriod,
+		session.WithTerminatedRetention(c.conf.RawConfig.Controller.TerminatedSessionRetentionDuration),
+	); err != nil {
 		return err
 	}
 	var serverJobOpts []serversjob.Option
@@ -632,6 +758,12 @@ func (c *Controller) registerJobs() error {
 			serversjob.WithRotationFrequency(c.conf.TestOverrideWorkerAuthCaCertificateLifetime/2),
 		)
 	}
+	if sw := c.conf.RawConfig.Controller.StaleWorkers; sw != nil {
+		serverJobOpts = append(serverJobOpts,
+			serversjob.WithStaleWorkerRetireAfter(sw.RetireAfterDuration),
+			serversjob.WithStaleWorkerRevivalWindow(sw.RevivalWindowDuration),
+		)
+	}
 	if err := serversjob.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.ControllerExtension, c.workerStatusGracePeriod, serverJobOpts...); err != nil {
 		return err
 	}
@@ -644,9 +776,15 @@ func (c *Controller) registerJobs() error {
 	if err := census.RegisterJob(c.baseContext, c.scheduler, c.conf.RawConfig.Reporting.License.Enabled, rw, rw); err != nil {
 		return err
 	}
+	if err := billing.RegisterJob(c.baseContext, c.scheduler, rw); err != nil {
+		return err
+	}
 	if err := purge.RegisterJobs(c.baseContext, c.scheduler, rw, rw); err != nil {
 		return err
 	}
+	if err := estimate.RegisterJobs(c.baseContext, c.scheduler, rw, c.conf.RawConfig.Controller.EstimatedCountRefreshIntervalDurations); err != nil {
+		return err
+	}
 	if err := recording.RegisterJob(c.baseContext, c.scheduler, rw, rw, c.ControllerExtension, c.kms); err != nil {
 		return err
 	}
@@ -660,6 +798,10 @@ func (c *Controller) Shutdown() error {
 		event.WriteSysEvent(context.TODO(), op, "already shut down, skipping")
 	}
 	defer c.started.Store(false)
+	if d := c.conf.RawConfig.Controller.GracefulShutdownDrainDuration; d > 0 {
+		c.drain(d)
+	}
+	c.shutdownState.setPhase(ShutdownPhaseStopping)
 	c.baseCancel()
 	if err := c.stopServersAndListeners(); err != nil {
 		return fmt.Errorf("error stopping controller servers and listeners: %w", err)
@@ -671,6 +813,7 @@ func (c *Controller) Shutdown() error {
 			return fmt.Errorf("error flushing controller eventer nodes: %w", err)
 		}
 	}
+	c.shutdownState.setPhase(ShutdownPhaseStopped)
 	return nil
 }
 
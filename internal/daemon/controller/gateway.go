@@ -21,6 +21,9 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/secretscan"
+	"github.com/hashicorp/boundary/internal/maintenance"
+	"github.com/hashicorp/boundary/internal/scopequota"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	"github.com/hashicorp/go-uuid"
 	"google.golang.org/grpc"
@@ -63,12 +66,53 @@ func newGrpcGatewayMux() *runtime.ServeMux {
 				Marshaler: handlers.JSONMarshaler(),
 			},
 		}),
+		runtime.WithMarshalerOption(handlers.ProtobufMimeType, handlers.ProtoMarshaler()),
 		runtime.WithErrorHandler(handlers.ErrorHandler()),
 		runtime.WithForwardResponseOption(handlers.OutgoingResponseFilter),
 		runtime.WithDisablePathLengthFallback(),
 	)
 }
 
+// isProtobufEndpoint reports whether clients can negotiate binary protobuf
+// messages for the request. Only the high volume endpoints used by automated
+// callers support it: listing and reading sessions and authorizing sessions.
+func isProtobufEndpoint(method, urlPath string) bool {
+	switch method {
+	case http.MethodGet:
+		if urlPath == "/v1/sessions" {
+			return true
+		}
+		id, ok := strings.CutPrefix(urlPath, "/v1/sessions/")
+		return ok && id != "" && !strings.ContainsAny(id, "/:")
+	case http.MethodPost:
+		id, ok := strings.CutPrefix(urlPath, "/v1/targets/")
+		if !ok {
+			return false
+		}
+		id, ok = strings.CutSuffix(id, ":authorize-session")
+		return ok && id != "" && !strings.ContainsAny(id, "/:")
+	}
+	return false
+}
+
+// wrapHandlerWithProtobufFilter rejects requests that negotiate binary
+// protobuf messages for an endpoint that does not support them.
+func wrapHandlerWithProtobufFilter(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isProtobufEndpoint(r.Method, r.URL.Path) {
+			for _, hdr := range []string{"Accept", "Content-Type"} {
+				for _, v := range r.Header.Values(hdr) {
+					if strings.Contains(strings.ToLower(v), handlers.ProtobufMimeType) {
+						w.WriteHeader(http.StatusNotAcceptable)
+						return
+					}
+				}
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
 func correlationIdAnnotator(_ context.Context, req *http.Request) metadata.MD {
 	var correlationId string
 	for k, v := range req.Header {
@@ -108,6 +152,10 @@ func newGrpcServer(
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
 	aliasRepoFn common.AliasRepoFactory,
+	listTokenRepoFn common.ListTokenRepoFactory,
+	maintenanceModeFn func() *maintenance.Mode,
+	scopeQuotasFn func() *scopequota.Quotas,
+	secretScanMode secretscan.Mode,
 	kms *kms.Kms,
 	eventer *event.Eventer,
 ) (*grpc.Server, string, error) {
@@ -116,7 +164,7 @@ func newGrpcServer(
 	if err != nil {
 		return nil, "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate gateway ticket"))
 	}
-	unaryCtxInterceptor, err := requestCtxUnaryInterceptor(ctx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, ldapAuthRepoFn, kms, ticket, eventer)
+	unaryCtxInterceptor, err := requestCtxUnaryInterceptor(ctx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, ldapAuthRepoFn, listTokenRepoFn, kms, ticket, eventer)
 	if err != nil {
 		return nil, "", err
 	}
@@ -149,8 +197,12 @@ func newGrpcServer(
 				unaryCtxInterceptor,                           // populated requestInfo from headers into the request ctx
 				correlationIdInterceptor(ctx),                 // populate correlationId from headers or generate random id
 				errorInterceptor(ctx),                         // convert domain and api errors into headers for the http proxy
+				readOnlyInterceptor(ctx, maintenanceModeFn),   // reject requests that modify resources in read-only maintenance mode
+				scopeQuotaInterceptor(ctx, scopeQuotasFn),     // make the scope quotas available to the auth checks of the handlers
 				aliasResolutionInterceptor(ctx, aliasRepoFn),  // Resolve ids when an alias is provided
 				subtypes.AttributeTransformerInterceptor(ctx), // convert to/from generic attributes from/to subtype specific attributes
+				secretScanInterceptor(ctx, secretScanMode),    // report (and maybe reject) plaintext secrets in non-secret fields
+				deprecationInterceptor(ctx),                   // add notices for the deprecated fields and endpoints used by the request
 				eventsRequestInterceptor(ctx),                 // before we get started, send the required events with the request
 				statusCodeInterceptor(ctx),                    // convert grpc codes into http status codes for the http proxy (can modify the resp)
 				eventsResponseInterceptor(ctx),                // as we finish, send the required events with the response
@@ -12,8 +12,10 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/tcp"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/target"
@@ -124,3 +126,75 @@ func Test_WithDisablePathLengthFallback(t *testing.T) {
 	body := w.Body.String()
 	assert.Equal(t, "POST", body)
 }
+
+func Test_isProtobufEndpoint(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{method: http.MethodGet, path: "/v1/sessions", want: true},
+		{method: http.MethodGet, path: "/v1/sessions/s_1234567890", want: true},
+		{method: http.MethodPost, path: "/v1/targets/ttcp_1234567890:authorize-session", want: true},
+		{method: http.MethodPost, path: "/v1/sessions/s_1234567890:cancel", want: false},
+		{method: http.MethodPost, path: "/v1/sessions:cancel", want: false},
+		{method: http.MethodGet, path: "/v1/sessions/", want: false},
+		{method: http.MethodGet, path: "/v1/targets", want: false},
+		{method: http.MethodGet, path: "/v1/targets/ttcp_1234567890", want: false},
+		{method: http.MethodPost, path: "/v1/targets/:authorize-session", want: false},
+		{method: http.MethodPost, path: "/v1/targets/ttcp_1234567890:add-host-sources", want: false},
+		{method: http.MethodDelete, path: "/v1/sessions/s_1234567890", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, isProtobufEndpoint(tt.method, tt.path))
+		})
+	}
+}
+
+func Test_wrapHandlerWithProtobufFilter(t *testing.T) {
+	ctx := context.Background()
+	h := wrapHandlerWithProtobufFilter(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		header string
+		value  string
+		want   int
+	}{
+		{name: "json", method: http.MethodGet, path: "/v1/targets", header: "Accept", value: "application/json", want: http.StatusOK},
+		{name: "supported accept", method: http.MethodGet, path: "/v1/sessions", header: "Accept", value: "application/x-protobuf", want: http.StatusOK},
+		{name: "supported content type", method: http.MethodPost, path: "/v1/targets/ttcp_1234567890:authorize-session", header: "Content-Type", value: "application/x-protobuf", want: http.StatusOK},
+		{name: "unsupported accept", method: http.MethodGet, path: "/v1/targets", header: "Accept", value: "application/x-protobuf", want: http.StatusNotAcceptable},
+		{name: "unsupported content type", method: http.MethodPost, path: "/v1/targets", header: "Content-Type", value: "Application/X-Protobuf", want: http.StatusNotAcceptable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := http.NewRequestWithContext(ctx, tt.method, tt.path, nil)
+			require.NoError(t, err)
+			r.Header.Set(tt.header, tt.value)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
+
+func Test_ProtobufMarshalerOption(t *testing.T) {
+	ctx := context.Background()
+	mux := newGrpcGatewayMux()
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, "/v1/sessions", nil)
+	require.NoError(t, err)
+	r.Header.Set("Accept", handlers.ProtobufMimeType)
+	_, out := runtime.MarshalerForRequest(mux, r)
+	assert.Equal(t, handlers.ProtobufMimeType, out.ContentType(nil))
+
+	r.Header.Del("Accept")
+	_, out = runtime.MarshalerForRequest(mux, r)
+	assert.Equal(t, "application/json", out.ContentType(nil))
+}
@@ -30,19 +30,26 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/credentiallibraries"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/credentials"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/credentialstores"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/environments"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/features"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/filters"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/groups"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/health"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/host_catalogs"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/host_sets"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/hosts"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/listtokens"
+	maintenancehandler "github.com/hashicorp/boundary/internal/daemon/controller/handlers/maintenance"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/managed_groups"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/policies"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/roles"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/scopes"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/search"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/session_recordings"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/storage_buckets"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targettemplates"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/users"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/workers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/internal/metric"
@@ -50,7 +57,10 @@ import (
 	"github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	opsservices "github.com/hashicorp/boundary/internal/gen/ops/services"
+	"github.com/hashicorp/boundary/internal/maintenance"
 	"github.com/hashicorp/boundary/internal/ratelimit"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
@@ -78,7 +88,7 @@ func createMuxWithEndpoints(c *Controller, props HandlerProperties) (http.Handle
 	}
 
 	mux := http.NewServeMux()
-	mux.Handle("/v1/", ratelimit.Handler(c.baseContext, c.getRateLimiter, grpcGwMux))
+	mux.Handle("/v1/", ratelimit.Handler(c.baseContext, c.getRateLimiter, wrapHandlerWithProtobufFilter(grpcGwMux)))
 	mux.Handle(uiPath, handleUi(c))
 
 	isUiRequest := func(req *http.Request) bool {
@@ -126,7 +136,7 @@ func (c *Controller) GetHealthHandler(lcfg *listenerutil.ListenerConfig) (http.H
 		return nil, fmt.Errorf("%s: failed to register health service handler: %w", op, err)
 	}
 
-	wrapped := wrapHandlerWithCommonFuncs(healthGrpcGwMux, c, HandlerProperties{lcfg, c.baseContext})
+	wrapped := wrapHandlerWithCommonFuncs(wrapHandlerWithProtobufFilter(healthGrpcGwMux), c, HandlerProperties{lcfg, c.baseContext})
 	return common.WrapWithEventsHandler(c.baseContext, wrapped, c.conf.Eventer, c.kms, lcfg)
 }
 
@@ -138,6 +148,9 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 	// We have to check against the current services because the gRPC lib treats a duplicate
 	// register call as an error and os.Exits.
 	currentServices := s.GetServiceInfo()
+	// The resource types searched by the search service, by the List method
+	// of their service.
+	listers := map[resource.Type]search.Lister{}
 
 	if _, ok := currentServices[services.HostCatalogService_ServiceDesc.ServiceName]; !ok {
 		hcs, err := host_catalogs.NewService(
@@ -153,6 +166,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			return fmt.Errorf("failed to create host catalog handler service: %w", err)
 		}
 		services.RegisterHostCatalogServiceServer(s, hcs)
+		listers[resource.HostCatalog] = search.NewLister(hcs.ListHostCatalogs)
 	}
 	if _, ok := currentServices[services.HostSetService_ServiceDesc.ServiceName]; !ok {
 		hss, err := host_sets.NewService(c.baseContext, c.StaticHostRepoFn, c.PluginHostRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
@@ -191,6 +205,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			return fmt.Errorf("failed to create auth method handler service: %w", err)
 		}
 		services.RegisterAuthMethodServiceServer(s, authMethods)
+		listers[resource.AuthMethod] = search.NewLister(authMethods.ListAuthMethods)
 	}
 	if _, ok := currentServices[services.AuthTokenService_ServiceDesc.ServiceName]; !ok {
 		authtoks, err := authtokens.NewService(c.baseContext, c.AuthTokenRepoFn, c.IamRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
@@ -205,6 +220,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			return fmt.Errorf("failed to create scope handler service: %w", err)
 		}
 		services.RegisterScopeServiceServer(s, os)
+		listers[resource.Scope] = search.NewLister(os.ListScopes)
 	}
 	if _, ok := currentServices[services.UserService_ServiceDesc.ServiceName]; !ok {
 		us, err := users.NewService(c.baseContext, c.IamRepoFn, c.TargetAliasRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
@@ -212,6 +228,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			return fmt.Errorf("failed to create user handler service: %w", err)
 		}
 		services.RegisterUserServiceServer(s, us)
+		listers[resource.User] = search.NewLister(us.ListUsers)
 	}
 	if _, ok := currentServices[services.StorageBucketService_ServiceDesc.ServiceName]; !ok {
 		sbs, err := storage_buckets.NewServiceFn(
@@ -225,6 +242,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			return fmt.Errorf("failed to create storage bucket handler service: %w", err)
 		}
 		services.RegisterStorageBucketServiceServer(s, sbs)
+		listers[resource.StorageBucket] = search.NewLister(sbs.ListStorageBuckets)
 	}
 	if _, ok := currentServices[services.PolicyService_ServiceDesc.ServiceName]; !ok {
 		ps, err := policies.NewServiceFn(
@@ -265,15 +283,21 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.VaultCredentialRepoFn,
 			c.StaticCredentialRepoFn,
 			c.TargetAliasRepoFn,
+			c.EnvironmentRepoFn,
+			c.TargetTemplateRepoFn,
 			c.downstreamWorkers,
 			c.workerStatusGracePeriod,
 			c.conf.RawConfig.Controller.MaxPageSize,
 			c.ControllerExtension,
+			targets.WithWorkerSelection(server.WorkerSelection(c.conf.RawConfig.Controller.WorkerSelection)),
+			targets.WithConnectionTests(c.workerConnectionTests),
+			targets.WithReverseTunnels(c.reverseTunnels),
 		)
 		if err != nil {
 			return fmt.Errorf("failed to create target handler service: %w", err)
 		}
 		services.RegisterTargetServiceServer(s, ts)
+		listers[resource.Target] = search.NewLister(ts.ListTargets)
 	}
 	if _, ok := currentServices[services.GroupService_ServiceDesc.ServiceName]; !ok {
 		gs, err := groups.NewService(c.baseContext, c.IamRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
@@ -281,6 +305,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			return fmt.Errorf("failed to create group handler service: %w", err)
 		}
 		services.RegisterGroupServiceServer(s, gs)
+		listers[resource.Group] = search.NewLister(gs.ListGroups)
 	}
 	if _, ok := currentServices[services.RoleService_ServiceDesc.ServiceName]; !ok {
 		rs, err := roles.NewService(c.baseContext, c.IamRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
@@ -288,6 +313,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			return fmt.Errorf("failed to create role handler service: %w", err)
 		}
 		services.RegisterRoleServiceServer(s, rs)
+		listers[resource.Role] = search.NewLister(rs.ListRoles)
 	}
 	if _, ok := currentServices[services.SessionService_ServiceDesc.ServiceName]; !ok {
 		ss, err := sessions.NewService(c.baseContext, c.SessionRepoFn, c.IamRepoFn, c.conf.RawConfig.Controller.MaxPageSize)
@@ -316,6 +342,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			return fmt.Errorf("failed to create credential store handler service: %w", err)
 		}
 		services.RegisterCredentialStoreServiceServer(s, cs)
+		listers[resource.CredentialStore] = search.NewLister(cs.ListCredentialStores)
 	}
 	if _, ok := currentServices[services.CredentialLibraryService_ServiceDesc.ServiceName]; !ok {
 		cl, err := credentiallibraries.NewService(
@@ -331,7 +358,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 	}
 	if _, ok := currentServices[services.WorkerService_ServiceDesc.ServiceName]; !ok {
 		ws, err := workers.NewService(c.baseContext, c.ServersRepoFn, c.IamRepoFn, c.WorkerAuthRepoStorageFn,
-			c.downstreamWorkers)
+			c.downstreamWorkers, workers.WithDiagnostics(c.workerDiagnostics))
 		if err != nil {
 			return fmt.Errorf("failed to create worker handler service: %w", err)
 		}
@@ -348,6 +375,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			return fmt.Errorf("failed to create alias handler service: %w", err)
 		}
 		services.RegisterAliasServiceServer(s, as)
+		listers[resource.Alias] = search.NewLister(as.ListAliases)
 	}
 	if _, ok := currentServices[services.CredentialService_ServiceDesc.ServiceName]; !ok {
 		c, err := credentials.NewService(
@@ -363,6 +391,9 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 	}
 	if _, ok := currentServices[opsservices.HealthService_ServiceDesc.ServiceName]; !ok {
 		hs := health.NewService()
+		if err := hs.SetDependencyChecks(c.healthDependencyChecks()...); err != nil {
+			return fmt.Errorf("failed to set health dependency checks: %w", err)
+		}
 		opsservices.RegisterHealthServiceServer(s, hs)
 		c.HealthService = hs
 	}
@@ -373,6 +404,57 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		}
 		services.RegisterBillingServiceServer(s, bs)
 	}
+	if _, ok := currentServices[services.FeatureService_ServiceDesc.ServiceName]; !ok {
+		fs, err := features.NewService(c.baseContext)
+		if err != nil {
+			return fmt.Errorf("failed to create feature handler service: %w", err)
+		}
+		services.RegisterFeatureServiceServer(s, fs)
+	}
+	if _, ok := currentServices[services.FilterService_ServiceDesc.ServiceName]; !ok {
+		fs, err := filters.NewService(c.baseContext)
+		if err != nil {
+			return fmt.Errorf("failed to create filter handler service: %w", err)
+		}
+		services.RegisterFilterServiceServer(s, fs)
+	}
+	if _, ok := currentServices[services.MaintenanceService_ServiceDesc.ServiceName]; !ok {
+		ms, err := maintenancehandler.NewService(c.baseContext, c.MaintenanceRepoFn, func(m *maintenance.Mode) {
+			c.setMaintenanceMode(c.baseContext, m)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create maintenance handler service: %w", err)
+		}
+		services.RegisterMaintenanceServiceServer(s, ms)
+	}
+	if _, ok := currentServices[services.EnvironmentService_ServiceDesc.ServiceName]; !ok {
+		es, err := environments.NewService(c.baseContext, c.EnvironmentRepoFn, c.IamRepoFn)
+		if err != nil {
+			return fmt.Errorf("failed to create environment handler service: %w", err)
+		}
+		services.RegisterEnvironmentServiceServer(s, es)
+	}
+	if _, ok := currentServices[services.TargetTemplateService_ServiceDesc.ServiceName]; !ok {
+		tts, err := targettemplates.NewService(c.baseContext, c.TargetTemplateRepoFn, c.IamRepoFn)
+		if err != nil {
+			return fmt.Errorf("failed to create target template handler service: %w", err)
+		}
+		services.RegisterTargetTemplateServiceServer(s, tts)
+	}
+	if _, ok := currentServices[services.ListTokenService_ServiceDesc.ServiceName]; !ok {
+		ls, err := listtokens.NewService(c.baseContext, c.ListTokenRepoFn, c.IamRepoFn)
+		if err != nil {
+			return fmt.Errorf("failed to create list token handler service: %w", err)
+		}
+		services.RegisterListTokenServiceServer(s, ls)
+	}
+	if _, ok := currentServices[services.SearchService_ServiceDesc.ServiceName]; !ok && len(listers) > 0 {
+		ss, err := search.NewService(c.baseContext, listers)
+		if err != nil {
+			return fmt.Errorf("failed to create search handler service: %w", err)
+		}
+		services.RegisterSearchServiceServer(s, ss)
+	}
 
 	return nil
 }
@@ -446,6 +528,27 @@ func registerGrpcGatewayEndpoints(ctx context.Context, gwMux *runtime.ServeMux,
 	if err := services.RegisterBillingServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
 		return fmt.Errorf("failed to register billing service handler: %w", err)
 	}
+	if err := services.RegisterFeatureServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
+		return fmt.Errorf("failed to register feature service handler: %w", err)
+	}
+	if err := services.RegisterFilterServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
+		return fmt.Errorf("failed to register filter service handler: %w", err)
+	}
+	if err := services.RegisterMaintenanceServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
+		return fmt.Errorf("failed to register maintenance service handler: %w", err)
+	}
+	if err := services.RegisterEnvironmentServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
+		return fmt.Errorf("failed to register environment service handler: %w", err)
+	}
+	if err := services.RegisterTargetTemplateServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
+		return fmt.Errorf("failed to register target template service handler: %w", err)
+	}
+	if err := services.RegisterListTokenServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
+		return fmt.Errorf("failed to register list token service handler: %w", err)
+	}
+	if err := services.RegisterSearchServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
+		return fmt.Errorf("failed to register search service handler: %w", err)
+	}
 
 	return nil
 }
@@ -492,6 +595,7 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 		}
 
 		requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = auth.GetTokenFromRequest(ctx, c.kms, r)
+		requestInfo.ImpersonateUserId = r.Header.Get(auth.ImpersonateUserHeader)
 		ctx = context.WithValue(ctx, globals.ContextAuthTokenPublicIdKey, requestInfo.PublicId)
 
 		if info, ok := event.RequestInfoFromContext(ctx); ok {
@@ -499,6 +603,7 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 			requestInfo.EventId = info.EventId
 			requestInfo.TraceId = info.Id
 			requestInfo.ClientIp = info.ClientIp
+			requestInfo.ClientCertIdentity = info.ClientCertIdentity
 			requestInfo.Actions = getActions(info.Path)
 		} else {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -547,6 +652,8 @@ func wrapHandlerWithCors(h http.Handler, props HandlerProperties) http.Handler {
 		"Retry-After",
 		"RateLimit",
 		"RateLimit-Policy",
+		"Deprecation",
+		handlers.DeprecationHeader,
 	}, ", ")
 
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -118,6 +118,8 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/auth-tokens/someid",
 			"v1/credential-stores",
 			"v1/credential-stores/someid",
+			"v1/environments",
+			"v1/environments/someid",
 			"v1/groups",
 			"v1/groups/someid",
 			"v1/host-catalogs",
@@ -131,15 +133,22 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"400_v1/sc\u200Bopes",
 			"200_v1/scopes",
 			"v1/scopes/someid",
+			"v1/scopes/someid:list-dangling-references",
 			"v1/sessions",
 			"v1/sessions/someid",
 			"v1/storage-buckets",
 			"v1/storage-buckets/someid",
+			"v1/target-templates",
+			"v1/target-templates/someid",
 			"v1/targets",
 			"v1/targets/some_id",
 			"v1/users",
 			"v1/users/someid",
 			"v1/billing:monthly-active-users",
+			"v1/billing:scope-usage",
+			"v1/features",
+			"v1/maintenance",
+			"v1/search",
 		},
 		"POST": {
 			// Creation end points
@@ -147,6 +156,7 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/aliases",
 			"v1/auth-methods",
 			"v1/credential-stores",
+			"v1/environments",
 			"v1/groups",
 			"v1/host-catalogs",
 			"v1/host-sets",
@@ -154,6 +164,7 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/roles",
 			"v1/scopes",
 			"v1/storage-buckets",
+			"v1/target-templates",
 			"v1/targets",
 			"v1/users",
 
@@ -161,19 +172,29 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/accounts/someid:set-password",
 			"v1/accounts/someid:change-password",
 			"v1/auth-methods/someid:authenticate",
+			"v1/auth-methods/someid:set-deletion-protection",
 			"v1/groups/someid:add-members",
 			"v1/groups/someid:set-members",
 			"v1/groups/someid:remove-members",
+			"v1/filters:validate",
+			"v1/host-catalogs/someid:rotate-secrets",
+			"v1/host-catalogs/someid:set-target-rules",
 			"v1/host-sets/someid:add-hosts",
 			"v1/host-sets/someid:remove-hosts",
 			"v1/host-sets/someid:set-hosts",
+			"v1/hosts:create-range",
+			"v1/maintenance:set-read-only",
 			"v1/roles/someid:add-grants",
 			"v1/roles/someid:set-grants",
 			"v1/roles/someid:remove-grants",
 			"v1/roles/someid:add-principals",
 			"v1/roles/someid:set-principals",
 			"v1/roles/someid:remove-principals",
+			"v1/scopes/someid:set-deletion-protection",
 			"v1/sessions/someid:cancel",
+			"v1/sessions:cancel",
+			"v1/storage-buckets/someid:set-deletion-protection",
+			"v1/targets:create-from-template",
 			"v1/targets/some_id:authorize-session",
 			"v1/targets/some_id:add-host-sources",
 			"v1/targets/some_id:set-host-sources",
@@ -181,6 +202,7 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/targets/some_id:add-credential-sources",
 			"v1/targets/some_id:set-credential-sources",
 			"v1/targets/some_id:remove-credential-sources",
+			"v1/targets/some_id:set-deletion-protection",
 			"v1/users/someid:add-accounts",
 			"v1/users/someid:set-accounts",
 			"v1/users/someid:remove-accounts",
@@ -191,6 +213,7 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/auth-methods/someid",
 			"v1/auth-tokens/someid",
 			"v1/credential-stores/someid",
+			"v1/environments/someid",
 			"v1/groups/someid",
 			"v1/host-catalogs/someid",
 			"v1/host-sets/someid",
@@ -198,6 +221,7 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/roles/someid",
 			"v1/scopes/someid",
 			"v1/storage-buckets/someid",
+			"v1/target-templates/someid",
 			"v1/targets/some_id",
 			"v1/users/someid",
 		},
@@ -206,6 +230,7 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/aliases/someid",
 			"v1/auth-methods/someid",
 			"v1/credential-stores/someid",
+			"v1/environments/someid",
 			"v1/groups/someid",
 			"v1/host-catalogs/someid",
 			"v1/host-sets/someid",
@@ -213,6 +238,7 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/roles/someid",
 			"v1/scopes/someid",
 			"v1/storage-buckets/someid",
+			"v1/target-templates/someid",
 			"v1/targets/some_id",
 			"v1/users/someid",
 		},
@@ -98,6 +98,9 @@ func (s Service) ListAliases(ctx context.Context, req *pbs.ListAliasesRequest) (
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, err
 	}
+	if req.GetExactCount() {
+		ctx = pagination.WithExactCount(ctx)
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
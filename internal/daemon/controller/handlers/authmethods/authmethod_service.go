@@ -155,6 +155,9 @@ func (s Service) ListAuthMethods(ctx context.Context, req *pbs.ListAuthMethodsRe
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if req.GetExactCount() {
+		ctx = pagination.WithExactCount(ctx)
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -354,6 +357,15 @@ func (s Service) GetAuthMethod(ctx context.Context, req *pbs.GetAuthMethodReques
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.ProtectFromDeletionField) {
+		iamRepo, err := s.iamRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		if item.ProtectFromDeletion, err = iamRepo.LookupDeletionProtection(ctx, am.GetPublicId()); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.GetAuthMethodResponse{Item: item}, nil
 }
@@ -523,6 +535,61 @@ func (s Service) DeleteAuthMethod(ctx context.Context, req *pbs.DeleteAuthMethod
 	return &pbs.DeleteAuthMethodResponse{}, nil
 }
 
+// SetAuthMethodDeletionProtection implements the interface pbs.AuthMethodServiceServer.
+func (s Service) SetAuthMethodDeletionProtection(ctx context.Context, req *pbs.SetAuthMethodDeletionProtectionRequest) (*pbs.SetAuthMethodDeletionProtectionResponse, error) {
+	const op = "authmethods.(Service).SetAuthMethodDeletionProtection"
+
+	if err := validateSetDeletionProtectionRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.SetDeletionProtection)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	if err := iamRepo.SetDeletionProtection(ctx, req.GetId(), req.GetProtectFromDeletion()); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set deletion protection"))
+	}
+	am, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, am.GetPublicId(), IdActions[globals.ResourceInfoFromPrefix(am.GetPublicId()).Subtype]).Strings()))
+	}
+	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+		collectionActions, err := requestauth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap, authResults.Scope, am.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
+	}
+
+	item, err := toAuthMethodProto(ctx, am, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if outputFields.Has(globals.ProtectFromDeletionField) {
+		item.ProtectFromDeletion = req.GetProtectFromDeletion()
+	}
+
+	return &pbs.SetAuthMethodDeletionProtectionResponse{Item: item}, nil
+}
+
 // Authenticate implements the interface pbs.AuthenticationServiceServer.
 func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest) (*pbs.AuthenticateResponse, error) {
 	const op = "authmethod_service.(Service).Authenticate"
@@ -1047,6 +1114,9 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest
 		if req.GetItem().GetIsPrimary() {
 			badFields[isPrimaryField] = "This field is read only."
 		}
+		if req.GetItem().GetProtectFromDeletion() {
+			badFields[globals.ProtectFromDeletionField] = "This field is read only."
+		}
 		switch req.GetItem().GetType() {
 		case password.Subtype.String():
 			// Password attributes are not required when creating a password auth method.
@@ -1162,6 +1232,9 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateAuthMethodRequest
 		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), isPrimaryField) {
 			badFields[isPrimaryField] = "This field is read only."
 		}
+		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.ProtectFromDeletionField) {
+			badFields[globals.ProtectFromDeletionField] = "This field is read only."
+		}
 		switch globals.ResourceInfoFromPrefix(req.GetId()).Subtype {
 		case password.Subtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != password.Subtype.String() {
@@ -1305,6 +1378,14 @@ func validateDeleteRequest(ctx context.Context, req *pbs.DeleteAuthMethodRequest
 	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix)
 }
 
+func validateSetDeletionProtectionRequest(ctx context.Context, req *pbs.SetAuthMethodDeletionProtectionRequest) error {
+	const op = "authmethod.validateSetDeletionProtectionRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "Missing request")
+	}
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.PasswordAuthMethodPrefix, globals.OidcAuthMethodPrefix, globals.LdapAuthMethodPrefix)
+}
+
 func validateListRequest(ctx context.Context, req *pbs.ListAuthMethodsRequest) error {
 	const op = "authmethod.validateListRequest"
 	if req == nil {
@@ -62,6 +62,7 @@ var (
 		action.Update.String(),
 		action.Delete.String(),
 		action.Authenticate.String(),
+		action.SetDeletionProtection.String(),
 	}
 	oidcAuthorizedActions = []string{
 		action.NoOp.String(),
@@ -70,6 +71,7 @@ var (
 		action.Delete.String(),
 		action.ChangeState.String(),
 		action.Authenticate.String(),
+		action.SetDeletionProtection.String(),
 	}
 	ldapAuthorizedActions = []string{
 		action.NoOp.String(),
@@ -77,6 +79,7 @@ var (
 		action.Update.String(),
 		action.Delete.String(),
 		action.Authenticate.String(),
+		action.SetDeletionProtection.String(),
 	}
 )
 
@@ -44,6 +44,7 @@ func init() {
 		action.Update,
 		action.Delete,
 		action.Authenticate,
+		action.SetDeletionProtection,
 	)
 }
 
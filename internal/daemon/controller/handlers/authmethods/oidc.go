@@ -64,6 +64,7 @@ func init() {
 		action.Delete,
 		action.ChangeState,
 		action.Authenticate,
+		action.SetDeletionProtection,
 	)
 }
 
@@ -42,6 +42,7 @@ func init() {
 		action.Update,
 		action.Delete,
 		action.Authenticate,
+		action.SetDeletionProtection,
 	)
 }
 
@@ -30,6 +30,7 @@ var (
 	// this collection
 	CollectionActions = action.NewActionSet(
 		action.MonthlyActiveUsers,
+		action.ScopeUsage,
 	)
 )
 
@@ -74,23 +75,9 @@ func (s Service) MonthlyActiveUsers(ctx context.Context, req *pbs.MonthlyActiveU
 		return nil, errors.Wrap(ctx, err, op)
 	}
 
-	var startTime, endTime *time.Time
-	if req.GetStartTime() != "" {
-		st, err := time.Parse("2006-01", req.GetStartTime())
-		if err != nil {
-			return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "start time is in an invalid format")
-		}
-		startTime = &st
-	}
-	if req.GetEndTime() != "" {
-		et, err := time.Parse("2006-01", req.GetEndTime())
-		if err != nil {
-			return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "end time is in an invalid format")
-		}
-		endTime = &et
-	}
-	if startTime != nil && endTime != nil && !endTime.After(*startTime) {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "start time is not before end time")
+	startTime, endTime, err := parseMonthRange(req.GetStartTime(), req.GetEndTime())
+	if err != nil {
+		return nil, err
 	}
 
 	months, err := repo.MonthlyActiveUsers(
@@ -115,6 +102,76 @@ func (s Service) MonthlyActiveUsers(ctx context.Context, req *pbs.MonthlyActiveU
 	return &pbs.MonthlyActiveUsersResponse{Items: activeUsers}, nil
 }
 
+// ScopeUsage returns the monthly usage of each project scope: the number of
+// active users, the number of sessions and the hours they were active, and the
+// number of bytes recorded.
+func (s Service) ScopeUsage(ctx context.Context, req *pbs.ScopeUsageRequest) (*pbs.ScopeUsageResponse, error) {
+	const op = "billing.(Service).ScopeUsage"
+
+	authResults := s.authResult(ctx, action.ScopeUsage)
+	if authResults.Error != nil {
+		return nil, errors.Wrap(ctx, authResults.Error, op)
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	startTime, endTime, err := parseMonthRange(req.GetStartTime(), req.GetEndTime())
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := repo.ScopeUsage(
+		ctx,
+		billing.WithStartTime(startTime),
+		billing.WithEndTime(endTime),
+	)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, err.Error())
+	}
+
+	var items []*pb.ScopeUsage
+	for _, u := range usages {
+		items = append(items, &pb.ScopeUsage{
+			ScopeId:          u.ScopeId,
+			StartTime:        timestamppb.New(u.StartTime),
+			EndTime:          timestamppb.New(u.EndTime),
+			ActiveUsersCount: u.ActiveUsersCount,
+			SessionCount:     u.SessionCount,
+			SessionHours:     u.SessionDuration.Hours(),
+			RecordedBytes:    u.RecordedBytes,
+		})
+	}
+
+	return &pbs.ScopeUsageResponse{Items: items}, nil
+}
+
+// parseMonthRange parses the optional start and end months of a request, in
+// the YYYY-MM format.
+func parseMonthRange(start, end string) (*time.Time, *time.Time, error) {
+	var startTime, endTime *time.Time
+	if start != "" {
+		st, err := time.Parse("2006-01", start)
+		if err != nil {
+			return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "start time is in an invalid format")
+		}
+		startTime = &st
+	}
+	if end != "" {
+		et, err := time.Parse("2006-01", end)
+		if err != nil {
+			return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "end time is in an invalid format")
+		}
+		endTime = &et
+	}
+	if startTime != nil && endTime != nil && !endTime.After(*startTime) {
+		return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "start time is not before end time")
+	}
+	return startTime, endTime, nil
+}
+
 func (s Service) authResult(ctx context.Context, a action.Type) auth.VerifyResults {
 	opts := []auth.Option{
 		auth.WithType(resource.Billing),
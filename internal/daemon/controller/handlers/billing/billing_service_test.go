@@ -5,6 +5,7 @@ package billing_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -175,3 +176,108 @@ func Test_MonthlyActiveUsers(t *testing.T) {
 		})
 	}
 }
+
+func Test_ScopeUsage(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+
+	repoFn := func() (*billing.Repository, error) {
+		return billing.TestRepo(t, conn), nil
+	}
+
+	wrap := db.TestWrapper(t)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrap), nil
+	}
+
+	today := time.Now().UTC()
+	monthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	oneMonthAgo := monthStart.AddDate(0, -1, 0)
+	threeMonthsAgo := monthStart.AddDate(0, -3, 0)
+	badFormat := time.Date(today.Year(), today.Month(), 15, 0, 0, 0, 0, time.UTC).String()
+
+	const insertUsage = `
+insert into billing_scope_monthly_usage
+       (scope_id, start_time, active_users_count, session_count, session_seconds, recorded_bytes)
+values (@scope_id, @start_time, 2, 3, 5400, 1024);
+`
+	for _, month := range []time.Time{monthStart, threeMonthsAgo} {
+		_, err := rw.Exec(ctx, insertUsage, []any{
+			sql.Named("scope_id", "p_1234567890"),
+			sql.Named("start_time", month),
+		})
+		require.NoError(t, err)
+	}
+
+	usage := func(month time.Time) *pb.ScopeUsage {
+		return &pb.ScopeUsage{
+			ScopeId:          "p_1234567890",
+			StartTime:        timestamppb.New(month),
+			EndTime:          timestamppb.New(month.AddDate(0, 1, 0)),
+			ActiveUsersCount: 2,
+			SessionCount:     3,
+			SessionHours:     1.5,
+			RecordedBytes:    1024,
+		}
+	}
+
+	cases := []struct {
+		name        string
+		req         *pbs.ScopeUsageRequest
+		res         *pbs.ScopeUsageResponse
+		errContains string
+	}{
+		{
+			name: "Valid no options, current and previous months",
+			req:  &pbs.ScopeUsageRequest{},
+			res: &pbs.ScopeUsageResponse{
+				Items: []*pb.ScopeUsage{usage(monthStart)},
+			},
+		},
+		{
+			name: "Valid start time",
+			req:  &pbs.ScopeUsageRequest{StartTime: threeMonthsAgo.Format("2006-01")},
+			res: &pbs.ScopeUsageResponse{
+				Items: []*pb.ScopeUsage{usage(monthStart), usage(threeMonthsAgo)},
+			},
+		},
+		{
+			name: "Valid start and end time",
+			req:  &pbs.ScopeUsageRequest{StartTime: threeMonthsAgo.Format("2006-01"), EndTime: oneMonthAgo.Format("2006-01")},
+			res: &pbs.ScopeUsageResponse{
+				Items: []*pb.ScopeUsage{usage(threeMonthsAgo)},
+			},
+		},
+		{
+			name:        "Invalid end time before start time",
+			req:         &pbs.ScopeUsageRequest{StartTime: oneMonthAgo.Format("2006-01"), EndTime: threeMonthsAgo.Format("2006-01")},
+			errContains: "start time is not before end time",
+		},
+		{
+			name:        "Invalid start time format",
+			req:         &pbs.ScopeUsageRequest{StartTime: badFormat},
+			errContains: "start time is in an invalid format",
+		},
+		{
+			name:        "Invalid end time format",
+			req:         &pbs.ScopeUsageRequest{StartTime: threeMonthsAgo.Format("2006-01"), EndTime: badFormat},
+			errContains: "end time is in an invalid format",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := billingservice.NewService(ctx, repoFn)
+			require.NoError(t, err, "Couldn't create new billing service.")
+
+			got, gErr := b.ScopeUsage(auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String(), auth.WithUserId(globals.AnyAuthenticatedUserId)), tc.req)
+			if tc.errContains != "" {
+				require.ErrorContains(t, gErr, tc.errContains)
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, gErr)
+			assert.Empty(t, cmp.Diff(got, tc.res, protocmp.Transform()))
+		})
+	}
+}
@@ -39,6 +39,8 @@ const (
 	vaultPathField             = "attributes.path"
 	httpMethodField            = "attributes.http_method"
 	httpRequestBodyField       = "attributes.http_request_body"
+	kvVersionField             = "attributes.kv_version"
+	kvMinVersionField          = "attributes.kv_min_version"
 	credentialMappingPathField = "credential_mapping_overrides"
 	sshCertUsernameField       = "attributes.username"
 	keyTypeField               = "attributes.key_type"
@@ -151,6 +153,9 @@ func (s Service) ListCredentialLibraries(ctx context.Context, req *pbs.ListCrede
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if req.GetExactCount() {
+		ctx = pagination.WithExactCount(ctx)
+	}
 	authResults := s.authResult(ctx, req.GetCredentialStoreId(), action.List)
 	if authResults.Error != nil {
 		return nil, authResults.Error
@@ -790,6 +795,15 @@ func toProto(ctx context.Context, in credential.Library, opt ...handlers.Option)
 			if vaultIn.GetHttpRequestBody() != nil {
 				attrs.HttpRequestBody = wrapperspb.String(string(vaultIn.GetHttpRequestBody()))
 			}
+			if vaultIn.GetNamespace() != "" {
+				attrs.Namespace = wrapperspb.String(vaultIn.GetNamespace())
+			}
+			if vaultIn.GetKvVersion() != 0 {
+				attrs.KvVersion = wrapperspb.UInt32(vaultIn.GetKvVersion())
+			}
+			if vaultIn.GetKvMinVersion() != 0 {
+				attrs.KvMinVersion = wrapperspb.UInt32(vaultIn.GetKvMinVersion())
+			}
 			out.Attrs = &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
 				VaultGenericCredentialLibraryAttributes: attrs,
 			}
@@ -837,6 +851,9 @@ func toProto(ctx context.Context, in credential.Library, opt ...handlers.Option)
 					attrs.AdditionalValidPrincipals[i] = &wrapperspb.StringValue{Value: p}
 				}
 			}
+			if vaultIn.GetNamespace() != "" {
+				attrs.Namespace = wrapperspb.String(vaultIn.GetNamespace())
+			}
 			out.Attrs = &pb.CredentialLibrary_VaultSshCertificateCredentialLibraryAttributes{
 				VaultSshCertificateCredentialLibraryAttributes: attrs,
 			}
@@ -867,6 +884,15 @@ func toStorageVaultLibrary(ctx context.Context, storeId string, in *pb.Credentia
 	if attrs.GetHttpRequestBody() != nil {
 		opts = append(opts, vault.WithRequestBody([]byte(attrs.GetHttpRequestBody().GetValue())))
 	}
+	if attrs.GetNamespace().GetValue() != "" {
+		opts = append(opts, vault.WithNamespace(attrs.GetNamespace().GetValue()))
+	}
+	if attrs.GetKvVersion() != nil {
+		opts = append(opts, vault.WithKvVersion(attrs.GetKvVersion().GetValue()))
+	}
+	if attrs.GetKvMinVersion() != nil {
+		opts = append(opts, vault.WithKvMinVersion(attrs.GetKvMinVersion().GetValue()))
+	}
 
 	credentialType := globals.CredentialType(in.GetCredentialType())
 	switch credentialType {
@@ -954,6 +980,9 @@ func toStorageVaultSSHCertificateLibrary(ctx context.Context, storeId string, in
 		}
 		opts = append(opts, vault.WithAdditionalValidPrincipals(avp))
 	}
+	if attrs.GetNamespace().GetValue() != "" {
+		opts = append(opts, vault.WithNamespace(attrs.GetNamespace().GetValue()))
+	}
 
 	cs, err := vault.NewSSHCertificateCredentialLibrary(storeId, attrs.GetPath().GetValue(), attrs.GetUsername().GetValue(), opts...)
 	if err != nil {
@@ -1053,6 +1082,7 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 				if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) != "POST" {
 					badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 				}
+				validateKvVersion(badFields, attrs)
 				validateMapping(badFields, globals.CredentialType(req.GetItem().GetCredentialType()), req.GetItem().CredentialMappingOverrides.AsMap())
 			case vault.SSHCertificateLibrarySubtype.String():
 				if req.GetItem().GetCredentialType() != "" {
@@ -1123,6 +1153,7 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 				if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "GET" {
 					badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 				}
+				validateKvVersion(badFields, attrs)
 				validateMapping(badFields, currentCredentialType, req.GetItem().CredentialMappingOverrides.AsMap())
 			}
 		case vault.SSHCertificateLibrarySubtype:
@@ -1199,6 +1230,23 @@ func validateMapping(badFields map[string]string, credentialType globals.Credent
 	}
 }
 
+// validateKvVersion appends to badFields if the KV version attributes of a
+// generic vault credential library are not accepted values or combinations.
+func validateKvVersion(badFields map[string]string, attrs *pb.VaultCredentialLibraryAttributes) {
+	if v := attrs.GetKvVersion(); v != nil && v.GetValue() == 0 {
+		badFields[kvVersionField] = "If set, value must be greater than 0."
+	}
+	if v := attrs.GetKvMinVersion(); v != nil && v.GetValue() == 0 {
+		badFields[kvMinVersionField] = "If set, value must be greater than 0."
+	}
+	if attrs.GetKvVersion() != nil && attrs.GetKvMinVersion() != nil {
+		badFields[kvMinVersionField] = fmt.Sprintf("Field cannot be set if %q is set.", kvVersionField)
+	}
+	if (attrs.GetKvVersion() != nil || attrs.GetKvMinVersion() != nil) && strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "POST" {
+		badFields[httpMethodField] = fmt.Sprintf("Field must be 'GET' if %q or %q is set.", kvVersionField, kvMinVersionField)
+	}
+}
+
 // validateKeyBits appends to badFields if keyBits and keyType aren't accepted combinations for an SSHCertificateCredentialLibrary.
 // If keyType is an empty string, validateKeyBits only validates keyBits.
 func validateKeyBits(badFields map[string]string, keyBits uint32, keyType string) {
@@ -40,6 +40,8 @@ const (
 	privateKeyField           = "attributes.private_key"
 	privateKeyPassphraseField = "attributes.private_key_passphrase"
 	objectField               = "attributes.object"
+	rotationField             = "rotation"
+	checkoutField             = "checkout"
 	domain                    = "credential"
 )
 
@@ -130,6 +132,9 @@ func (s Service) ListCredentials(ctx context.Context, req *pbs.ListCredentialsRe
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if req.GetExactCount() {
+		ctx = pagination.WithExactCount(ctx)
+	}
 	authResults := s.authResult(ctx, req.GetCredentialStoreId(), action.List)
 	if authResults.Error != nil {
 		return nil, authResults.Error
@@ -287,6 +292,16 @@ func (s Service) GetCredential(ctx context.Context, req *pbs.GetCredentialReques
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(rotationField) {
+		if item.Rotation, err = s.getRotationFromRepo(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	if outputFields.Has(checkoutField) {
+		if item.Checkout, err = s.getCheckoutFromRepo(ctx, c); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.GetCredentialResponse{Item: item}, nil
 }
@@ -306,6 +321,15 @@ func (s Service) CreateCredential(ctx context.Context, req *pbs.CreateCredential
 	if err != nil {
 		return nil, err
 	}
+	if req.GetItem().GetCheckout() != nil {
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if _, err := repo.SetCheckoutPolicy(ctx, toStorageCheckoutPolicy(cl.GetPublicId(), req.GetItem().GetCheckout())); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set credential checkout"))
+		}
+	}
 
 	outputFields, ok := requests.OutputFields(ctx)
 	if !ok {
@@ -325,6 +349,16 @@ func (s Service) CreateCredential(ctx context.Context, req *pbs.CreateCredential
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(rotationField) {
+		if item.Rotation, err = s.getRotationFromRepo(ctx, cl); err != nil {
+			return nil, err
+		}
+	}
+	if outputFields.Has(checkoutField) {
+		if item.Checkout, err = s.getCheckoutFromRepo(ctx, cl); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.CreateCredentialResponse{
 		Item: item,
@@ -372,6 +406,16 @@ func (s Service) UpdateCredential(ctx context.Context, req *pbs.UpdateCredential
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(rotationField) {
+		if item.Rotation, err = s.getRotationFromRepo(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	if outputFields.Has(checkoutField) {
+		if item.Checkout, err = s.getCheckoutFromRepo(ctx, c); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.UpdateCredentialResponse{Item: item}, nil
 }
@@ -408,6 +452,39 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Static,
 	return cred, err
 }
 
+// getRotationFromRepo returns the rotation schedule of the credential, or nil
+// if it has none. Only username password credentials can be rotated.
+func (s Service) getRotationFromRepo(ctx context.Context, c credential.Static) (*pb.CredentialRotation, error) {
+	const op = "credentials.(Service).getRotationFromRepo"
+	if _, ok := c.(*static.UsernamePasswordCredential); !ok {
+		return nil, nil
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rs, err := repo.LookupRotationSchedule(ctx, c.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return toRotationProto(rs), nil
+}
+
+// getCheckoutFromRepo returns the checkout policy of the credential and who
+// holds it, or nil if the credential has no checkout policy.
+func (s Service) getCheckoutFromRepo(ctx context.Context, c credential.Static) (*pb.CredentialCheckout, error) {
+	const op = "credentials.(Service).getCheckoutFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cs, err := repo.LookupCheckoutStatus(ctx, c.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return toCheckoutProto(cs), nil
+}
+
 func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Credential) (credential.Static, error) {
 	const op = "credentials.(Service).createInRepo"
 	switch item.GetType() {
@@ -427,6 +504,11 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 		if out == nil {
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential but no error returned from repository.")
 		}
+		if item.GetRotation() != nil {
+			if _, err := repo.SetRotationSchedule(ctx, toStorageRotationSchedule(out.GetPublicId(), item.GetRotation())); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set credential rotation"))
+			}
+		}
 		return out, nil
 	case credential.SshPrivateKeySubtype.String():
 		cred, err := toSshPrivateKeyStorageCredential(ctx, item.GetCredentialStoreId(), item)
@@ -477,94 +559,134 @@ func (s Service) updateInRepo(
 
 	var dbMasks []string
 	item := proto.Clone(in).(*pb.Credential)
+	updateCheckout := handlers.MaskContainsPrefix(masks, checkoutField)
 
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var out credential.Static
 	switch globals.ResourceInfoFromPrefix(id).Subtype {
 	case credential.UsernamePasswordSubtype:
 		dbMasks = append(dbMasks, upMaskManager.Translate(masks)...)
-		if len(dbMasks) == 0 {
+		updateRotation := handlers.MaskContainsPrefix(masks, rotationField)
+		if len(dbMasks) == 0 && !updateRotation && !updateCheckout {
 			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 		}
 
-		cred, err := toUsernamePasswordStorageCredential(ctx, storeId, in)
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert to username/password storage credential"))
-		}
-		cred.PublicId = id
-		repo, err := s.repoFn()
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-		out, rowsUpdated, err := repo.UpdateUsernamePasswordCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks)
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
+		if len(dbMasks) > 0 {
+			cred, err := toUsernamePasswordStorageCredential(ctx, storeId, in)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert to username/password storage credential"))
+			}
+			cred.PublicId = id
+			updated, rowsUpdated, err := repo.UpdateUsernamePasswordCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
+			}
+			if rowsUpdated == 0 {
+				return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+			}
+			out = updated
 		}
-		if rowsUpdated == 0 {
-			return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+		if updateRotation {
+			// The rotation schedule is stored apart from the credential, so
+			// changing it alone doesn't change the credential's version.
+			switch item.GetRotation() {
+			case nil:
+				if _, err := repo.DeleteRotationSchedule(ctx, id); err != nil {
+					return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to remove credential rotation"))
+				}
+			default:
+				if _, err := repo.SetRotationSchedule(ctx, toStorageRotationSchedule(id, item.GetRotation())); err != nil {
+					return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set credential rotation"))
+				}
+			}
 		}
-		return out, nil
 
 	case credential.SshPrivateKeySubtype:
 		dbMasks = append(dbMasks, spkMaskManager.Translate(masks)...)
-		if len(dbMasks) == 0 {
+		if len(dbMasks) == 0 && !updateCheckout {
 			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 		}
 
-		cred, err := toSshPrivateKeyStorageCredential(ctx, storeId, in)
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert to ssh private key storage credential"))
-		}
-		if cred.PassphraseUnneeded {
-			// This happens when we have a private key given and no passphrase
-			// given and everything parses correctly. In that case we want to
-			// ensure that if a passphrase was in the database for the previous
-			// key that we get rid of it. We'll have nilled out several values
-			// above. Note that adding the passphrase field will, once we get to
-			// the repo, result in the mask for the other two related fields as
-			// well.
-			dbMasks = append(dbMasks, static.PrivateKeyPassphraseField)
-		}
-		cred.PublicId = id
-		repo, err := s.repoFn()
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-		out, rowsUpdated, err := repo.UpdateSshPrivateKeyCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks)
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
-		}
-		if rowsUpdated == 0 {
-			return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+		if len(dbMasks) > 0 {
+			cred, err := toSshPrivateKeyStorageCredential(ctx, storeId, in)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert to ssh private key storage credential"))
+			}
+			if cred.PassphraseUnneeded {
+				// This happens when we have a private key given and no passphrase
+				// given and everything parses correctly. In that case we want to
+				// ensure that if a passphrase was in the database for the previous
+				// key that we get rid of it. We'll have nilled out several values
+				// above. Note that adding the passphrase field will, once we get to
+				// the repo, result in the mask for the other two related fields as
+				// well.
+				dbMasks = append(dbMasks, static.PrivateKeyPassphraseField)
+			}
+			cred.PublicId = id
+			updated, rowsUpdated, err := repo.UpdateSshPrivateKeyCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
+			}
+			if rowsUpdated == 0 {
+				return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+			}
+			out = updated
 		}
-		return out, nil
 
 	case credential.JsonSubtype:
 		dbMasks = append(dbMasks, jsonMaskManager.Translate(masks, "attributes", "object")...)
-		if len(dbMasks) == 0 {
+		if len(dbMasks) == 0 && !updateCheckout {
 			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 		}
 
-		cred, err := toJsonStorageCredential(ctx, storeId, in)
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert to json storage credential"))
-		}
-		cred.PublicId = id
-		repo, err := s.repoFn()
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-		out, rowsUpdated, err := repo.UpdateJsonCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks)
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
-		}
-		if rowsUpdated == 0 {
-			return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+		if len(dbMasks) > 0 {
+			cred, err := toJsonStorageCredential(ctx, storeId, in)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert to json storage credential"))
+			}
+			cred.PublicId = id
+			updated, rowsUpdated, err := repo.UpdateJsonCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
+			}
+			if rowsUpdated == 0 {
+				return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+			}
+			out = updated
 		}
-		return out, nil
 
 	default:
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, fmt.Sprintf("Unsupported credential type %q", item.GetType()))
 
 	}
+
+	if updateCheckout {
+		// Like the rotation schedule, the checkout policy is stored apart
+		// from the credential and doesn't change the credential's version.
+		switch item.GetCheckout() {
+		case nil:
+			if _, err := repo.DeleteCheckoutPolicy(ctx, id); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to remove credential checkout"))
+			}
+		default:
+			if _, err := repo.SetCheckoutPolicy(ctx, toStorageCheckoutPolicy(id, item.GetCheckout())); err != nil {
+				if errors.Match(errors.T(errors.InvalidParameter), err) && item.GetCheckout().GetRotateOnCheckIn() {
+					return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+						map[string]string{checkoutField + ".rotate_on_check_in": "Rotation on check in requires a rotation schedule."})
+				}
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set credential checkout"))
+			}
+		}
+	}
+	if out == nil {
+		if out, err = s.getFromRepo(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
 }
 
 func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
@@ -740,6 +862,60 @@ func toProto(in credential.Static, opt ...handlers.Option) (*pb.Credential, erro
 	return &out, nil
 }
 
+func toRotationProto(in *static.RotationSchedule) *pb.CredentialRotation {
+	if in == nil {
+		return nil
+	}
+	return &pb.CredentialRotation{
+		Rotator:           in.Rotator,
+		Address:           in.Address,
+		Database:          in.DatabaseName,
+		IntervalSeconds:   in.IntervalSeconds,
+		LastRotatedTime:   in.LastRotatedTime.GetTimestamp(),
+		NextRotationTime:  in.NextRotationTime.GetTimestamp(),
+		LastRotationError: in.LastRotationError,
+	}
+}
+
+func toStorageRotationSchedule(credentialId string, in *pb.CredentialRotation) *static.RotationSchedule {
+	return &static.RotationSchedule{
+		CredentialId:    credentialId,
+		Rotator:         in.GetRotator(),
+		Address:         in.GetAddress(),
+		DatabaseName:    in.GetDatabase(),
+		IntervalSeconds: in.GetIntervalSeconds(),
+	}
+}
+
+func toCheckoutProto(in *static.CheckoutStatus) *pb.CredentialCheckout {
+	if in == nil {
+		return nil
+	}
+	out := &pb.CredentialCheckout{
+		TtlSeconds:          in.Policy.TtlSeconds,
+		QueueTimeoutSeconds: in.Policy.QueueTimeoutSeconds,
+		QueueLength:         uint32(in.QueueLength),
+		RotateOnCheckIn:     in.Policy.RotateOnCheckIn,
+		RotationPending:     in.Policy.RotationPending,
+	}
+	if in.Holder != nil {
+		out.SessionId = in.Holder.SessionId
+		out.UserId = in.Holder.UserId
+		out.CheckoutTime = in.Holder.CheckoutTime.GetTimestamp()
+		out.ExpirationTime = in.Holder.ExpirationTime.GetTimestamp()
+	}
+	return out
+}
+
+func toStorageCheckoutPolicy(credentialId string, in *pb.CredentialCheckout) *static.CheckoutPolicy {
+	return &static.CheckoutPolicy{
+		CredentialId:        credentialId,
+		TtlSeconds:          in.GetTtlSeconds(),
+		QueueTimeoutSeconds: in.GetQueueTimeoutSeconds(),
+		RotateOnCheckIn:     in.GetRotateOnCheckIn(),
+	}
+}
+
 func toUsernamePasswordStorageCredential(ctx context.Context, storeId string, in *pb.Credential) (out *static.UsernamePasswordCredential, err error) {
 	const op = "credentials.toUsernamePasswordStorageCredential"
 	var opts []static.Option
@@ -853,6 +1029,9 @@ func validateCreateRequest(req *pbs.CreateCredentialRequest) error {
 			if req.Item.GetUsernamePasswordAttributes().GetPassword().GetValue() == "" {
 				badFields[passwordField] = "Field required for creating a username-password credential."
 			}
+			if req.Item.GetRotation() != nil {
+				validateRotation(req.Item.GetRotation(), badFields)
+			}
 
 		case credential.SshPrivateKeySubtype.String():
 			if req.Item.GetSshPrivateKeyAttributes().GetUsername().GetValue() == "" {
@@ -894,6 +1073,19 @@ func validateCreateRequest(req *pbs.CreateCredentialRequest) error {
 		default:
 			badFields[globals.TypeField] = fmt.Sprintf("Unsupported credential type %q", req.Item.GetType())
 		}
+		if req.Item.GetRotation() != nil && req.Item.GetType() != credential.UsernamePasswordSubtype.String() {
+			badFields[rotationField] = "Rotation is only supported for username-password credentials."
+		}
+		if req.Item.GetCheckout() != nil {
+			validateCheckout(req.Item.GetCheckout(), badFields)
+		}
+		switch {
+		case !req.Item.GetCheckout().GetRotateOnCheckIn():
+		case req.Item.GetType() != credential.UsernamePasswordSubtype.String():
+			badFields[checkoutField+".rotate_on_check_in"] = "Rotation on check in is only supported for username-password credentials."
+		case req.Item.GetRotation() == nil:
+			badFields[checkoutField+".rotate_on_check_in"] = "Rotation on check in requires a rotation schedule."
+		}
 
 		return badFields
 	})
@@ -911,6 +1103,9 @@ func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
 			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), passwordField) && attrs.GetPassword().GetValue() == "" {
 				badFields[passwordField] = "This is a required field and cannot be set to empty."
 			}
+			if handlers.MaskContainsPrefix(req.GetUpdateMask().GetPaths(), rotationField) && req.GetItem().GetRotation() != nil {
+				validateRotation(req.GetItem().GetRotation(), badFields)
+			}
 
 		case credential.SshPrivateKeySubtype:
 			attrs := req.GetItem().GetSshPrivateKeyAttributes()
@@ -957,6 +1152,17 @@ func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
 		default:
 			badFields[globals.IdField] = "Unknown credential type."
 		}
+		if handlers.MaskContainsPrefix(req.GetUpdateMask().GetPaths(), rotationField) &&
+			globals.ResourceInfoFromPrefix(req.GetId()).Subtype != credential.UsernamePasswordSubtype {
+			badFields[rotationField] = "Rotation is only supported for username-password credentials."
+		}
+		if handlers.MaskContainsPrefix(req.GetUpdateMask().GetPaths(), checkoutField) && req.GetItem().GetCheckout() != nil {
+			validateCheckout(req.GetItem().GetCheckout(), badFields)
+		}
+		if handlers.MaskContainsPrefix(req.GetUpdateMask().GetPaths(), checkoutField) && req.GetItem().GetCheckout().GetRotateOnCheckIn() &&
+			globals.ResourceInfoFromPrefix(req.GetId()).Subtype != credential.UsernamePasswordSubtype {
+			badFields[checkoutField+".rotate_on_check_in"] = "Rotation on check in is only supported for username-password credentials."
+		}
 
 		return badFields
 	},
@@ -967,6 +1173,27 @@ func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
 	)
 }
 
+func validateRotation(r *pb.CredentialRotation, badFields map[string]string) {
+	if r.GetRotator() == "" {
+		badFields[rotationField+".rotator"] = "Field required for rotating a credential."
+	}
+	if r.GetAddress() == "" {
+		badFields[rotationField+".address"] = "Field required for rotating a credential."
+	}
+	if r.GetIntervalSeconds() == 0 {
+		badFields[rotationField+".interval_seconds"] = "Field required for rotating a credential."
+	}
+}
+
+func validateCheckout(c *pb.CredentialCheckout, badFields map[string]string) {
+	if c.GetTtlSeconds() == 0 {
+		badFields[checkoutField+".ttl_seconds"] = "Field required for checking out a credential."
+	}
+	if c.GetSessionId() != "" || c.GetUserId() != "" || c.GetCheckoutTime() != nil || c.GetExpirationTime() != nil || c.GetQueueLength() != 0 || c.GetRotationPending() {
+		badFields[checkoutField] = "Only the ttl, queue timeout and rotation on check in of a checkout can be set."
+	}
+}
+
 func validateDeleteRequest(req *pbs.DeleteCredentialRequest) error {
 	return handlers.ValidateDeleteRequest(
 		handlers.NoopValidatorFn,
@@ -44,6 +44,8 @@ const (
 	caCertsField           = "attributes.ca_cert"
 	clientCertField        = "attributes.client_certificate"
 	clientCertKeyField     = "attributes.certificate_key"
+	certAuthMountPathField = "attributes.cert_auth_mount_path"
+	certAuthRoleNameField  = "attributes.cert_auth_role_name"
 	domain                 = "credential"
 )
 
@@ -148,6 +150,9 @@ func (s Service) ListCredentialStores(ctx context.Context, req *pbs.ListCredenti
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if req.GetExactCount() {
+		ctx = pagination.WithExactCount(ctx)
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -784,6 +789,12 @@ func toProto(ctx context.Context, in credential.Store, opt ...handlers.Option) (
 				}
 				attrs.ClientCertificateKeyHmac = base64.RawURLEncoding.EncodeToString(cc.GetCertificateKeyHmac())
 			}
+			if vaultIn.GetCertAuthMountPath() != "" {
+				attrs.CertAuthMountPath = wrapperspb.String(vaultIn.GetCertAuthMountPath())
+			}
+			if vaultIn.GetCertAuthRoleName() != "" {
+				attrs.CertAuthRoleName = wrapperspb.String(vaultIn.GetCertAuthRoleName())
+			}
 
 			out.Attrs = &pb.CredentialStore_VaultCredentialStoreAttributes{
 				VaultCredentialStoreAttributes: attrs,
@@ -833,6 +844,9 @@ func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialS
 	if attrs.GetWorkerFilter().GetValue() != "" {
 		opts = append(opts, vault.WithWorkerFilter(attrs.GetWorkerFilter().GetValue()))
 	}
+	if attrs.GetCertAuthMountPath().GetValue() != "" || attrs.GetCertAuthRoleName().GetValue() != "" {
+		opts = append(opts, vault.WithCertAuth(attrs.GetCertAuthMountPath().GetValue(), attrs.GetCertAuthRoleName().GetValue()))
+	}
 
 	// TODO (ICU-1478 and ICU-1479): Update the vault's interface around ca cert to match oidc's,
 	//  accepting x509.Certificate instead of []byte
@@ -891,8 +905,14 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRe
 			if attrs.GetAddress().GetValue() == "" {
 				badFields[globals.AttributesAddressField] = "Field required for creating a vault credential store."
 			}
-			if attrs.GetToken().GetValue() == "" {
-				badFields[vaultTokenField] = "Field required for creating a vault credential store."
+			if attrs.GetToken().GetValue() == "" && attrs.GetCertAuthMountPath().GetValue() == "" {
+				badFields[vaultTokenField] = "Field required for creating a vault credential store without cert auth."
+			}
+			if attrs.GetCertAuthMountPath().GetValue() != "" && attrs.GetClientCertificate() == nil {
+				badFields[certAuthMountPathField] = "Cannot use cert auth without a client certificate."
+			}
+			if attrs.GetCertAuthRoleName().GetValue() != "" && attrs.GetCertAuthMountPath().GetValue() == "" {
+				badFields[certAuthRoleNameField] = "Cannot set a cert auth role name without the cert auth mount path."
 			}
 			if attrs.GetTokenHmac() != "" {
 				badFields[vaultTokenHmacField] = "This is a read only field."
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// DeprecationHeader is the http response header holding a notice for
+	// each deprecated field or endpoint used by the request.
+	DeprecationHeader = "Boundary-Deprecation"
+	// deprecationFlagHeader is set to "true" on responses to requests that
+	// use anything deprecated, as proposed by the IETF deprecation header
+	// draft.
+	deprecationFlagHeader       = "Deprecation"
+	deprecationMetadataKey      = "x-boundary-deprecation"
+	deprecationGrpcMetadataName = "Grpc-Metadata-X-Boundary-Deprecation"
+)
+
+// AddDeprecation allows a grpc service handler to add a deprecation notice to
+// the outgoing http response, for deprecated usage that is not described by
+// the deprecated option of the proto definitions.
+func AddDeprecation(ctx context.Context, notice string) error {
+	const op = "handlers.AddDeprecation"
+	if notice == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing notice")
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(deprecationMetadataKey, notice)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Internal))
+	}
+	return nil
+}
+
+// DeprecationNotices returns a notice for the grpc method if it is marked as
+// deprecated and for each field set in the request that is marked as
+// deprecated, including the fields of nested messages.
+func DeprecationNotices(fullMethod string, req proto.Message) []string {
+	var notices []string
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	if d, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err == nil {
+		if md, ok := d.(protoreflect.MethodDescriptor); ok {
+			if opts, ok := md.Options().(*descriptorpb.MethodOptions); ok && opts.GetDeprecated() {
+				notices = append(notices, fmt.Sprintf("The %s endpoint is deprecated and will be removed in a future release.", md.Name()))
+			}
+		}
+	}
+	if req != nil {
+		var fields []string
+		deprecatedFields(req.ProtoReflect(), "", &fields)
+		sort.Strings(fields)
+		for _, f := range fields {
+			notices = append(notices, fmt.Sprintf("The %q field is deprecated and will be removed in a future release.", f))
+		}
+	}
+	return notices
+}
+
+func deprecatedFields(m protoreflect.Message, prefix string, fields *[]string) {
+	if strings.HasPrefix(string(m.Descriptor().FullName()), "google.protobuf.") {
+		return
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		path := prefix + fd.JSONName()
+		if opts, ok := fd.Options().(*descriptorpb.FieldOptions); ok && opts.GetDeprecated() {
+			*fields = append(*fields, path)
+		}
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					deprecatedFields(mv.Message(), path+".", fields)
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				l := v.List()
+				for i := 0; i < l.Len(); i++ {
+					deprecatedFields(l.Get(i).Message(), path+".", fields)
+				}
+			}
+		case fd.Message() != nil:
+			deprecatedFields(v.Message(), path+".", fields)
+		}
+		return true
+	})
+}
+
+// setDeprecationHeaders moves the deprecation notices set by the grpc service
+// from the grpc metadata headers to the deprecation headers of the response.
+func setDeprecationHeaders(w http.ResponseWriter, md metadata.MD) {
+	notices := md.Get(deprecationMetadataKey)
+	if len(notices) == 0 {
+		return
+	}
+	delete(md, deprecationMetadataKey)
+	w.Header().Del(deprecationGrpcMetadataName)
+
+	seen := make(map[string]bool, len(notices))
+	for _, n := range notices {
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+		w.Header().Add(DeprecationHeader, n)
+	}
+	w.Header().Set(deprecationFlagHeader, "true")
+}
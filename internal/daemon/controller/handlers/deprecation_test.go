@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package handlers
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	emptypb "github.com/hashicorp/boundary/internal/gen/controller/api"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestDeprecationNotices(t *testing.T) {
+	tests := []struct {
+		name       string
+		fullMethod string
+		req        proto.Message
+		want       []string
+	}{
+		{
+			name:       "nothing deprecated",
+			fullMethod: pbs.AuthMethodService_Authenticate_FullMethodName,
+			req:        &pbs.AuthenticateRequest{AuthMethodId: "ampw_1234567890", Type: "token"},
+		},
+		{
+			name:       "deprecated field",
+			fullMethod: pbs.AuthMethodService_Authenticate_FullMethodName,
+			req:        &pbs.AuthenticateRequest{AuthMethodId: "ampw_1234567890", TokenType: "token"},
+			want:       []string{`The "token_type" field is deprecated and will be removed in a future release.`},
+		},
+		{
+			name:       "nested deprecated field",
+			fullMethod: pbs.TargetService_CreateTarget_FullMethodName,
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				Name:         wrapperspb.String("name"),
+				WorkerFilter: wrapperspb.String(`"dev" in "/tags/type"`),
+			}},
+			want: []string{`The "item.worker_filter" field is deprecated and will be removed in a future release.`},
+		},
+		{
+			name:       "unknown method",
+			fullMethod: "/controller.api.services.v1.NoService/Nothing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DeprecationNotices(tt.fullMethod, tt.req))
+		})
+	}
+}
+
+func TestOutgoingResponseFilter_Deprecation(t *testing.T) {
+	notice := `The "token_type" field is deprecated and will be removed in a future release.`
+	md := runtime.ServerMetadata{HeaderMD: metadata.Pairs(deprecationMetadataKey, notice, deprecationMetadataKey, notice)}
+	ctx := runtime.NewServerMetadataContext(context.Background(), md)
+	rec := httptest.NewRecorder()
+	rec.Header().Set(deprecationGrpcMetadataName, notice)
+
+	require.NoError(t, OutgoingResponseFilter(ctx, rec, &emptypb.EmptyResponse{}))
+	assert.Equal(t, []string{notice}, rec.Header().Values(DeprecationHeader))
+	assert.Equal(t, "true", rec.Header().Get("Deprecation"))
+	assert.Empty(t, rec.Header().Values(deprecationGrpcMetadataName))
+	assert.Empty(t, md.HeaderMD.Get(deprecationMetadataKey))
+}
@@ -0,0 +1,550 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package environments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/environment"
+	"github.com/hashicorp/boundary/internal/environment/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/environments"
+	"github.com/hashicorp/go-bexpr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	maskManager handlers.MaskManager
+
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.NewActionSet(
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+	)
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.NewActionSet(
+		action.Create,
+		action.List,
+	)
+)
+
+func init() {
+	var err error
+	if maskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&store.Environment{}},
+		handlers.MaskSource{&pb.Environment{}},
+	); err != nil {
+		panic(err)
+	}
+
+	// TODO: refactor to remove IdActionsMap and CollectionActions package variables
+	action.RegisterResource(resource.Environment, IdActions, CollectionActions)
+}
+
+// Service handles requests as described by the pbs.EnvironmentServiceServer
+// interface.
+type Service struct {
+	pbs.UnsafeEnvironmentServiceServer
+
+	repoFn    common.EnvironmentRepoFactory
+	iamRepoFn common.IamRepoFactory
+}
+
+var _ pbs.EnvironmentServiceServer = (*Service)(nil)
+
+// NewService returns an environment service which handles environment related
+// requests to boundary.
+func NewService(ctx context.Context, repo common.EnvironmentRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
+	const op = "environments.NewService"
+	if repo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing environment repository")
+	}
+	if iamRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	}
+	return Service{repoFn: repo, iamRepoFn: iamRepo}, nil
+}
+
+// ListEnvironments implements the interface pbs.EnvironmentServiceServer.
+func (s Service) ListEnvironments(ctx context.Context, req *pbs.ListEnvironmentsRequest) (*pbs.ListEnvironmentsResponse, error) {
+	if err := validateListRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	scopeIds, scopeInfoMap, err := scopeids.GetListingScopeIds(
+		ctx, s.iamRepoFn, authResults, req.GetScopeId(), resource.Environment, req.GetRecursive())
+	if err != nil {
+		return nil, err
+	}
+	// If no scopes match, return an empty response
+	if len(scopeIds) == 0 {
+		return &pbs.ListEnvironmentsResponse{}, nil
+	}
+
+	el, err := s.listFromRepo(ctx, scopeIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(el) == 0 {
+		return &pbs.ListEnvironmentsResponse{}, nil
+	}
+
+	filter, err := handlers.NewFilter(ctx, req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	finalItems := make([]*pb.Environment, 0, len(el))
+	res := perms.Resource{
+		Type: resource.Environment,
+	}
+	for _, item := range el {
+		res.Id = item.GetPublicId()
+		res.ScopeId = item.GetProjectId()
+		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+		if len(authorizedActions) == 0 {
+			continue
+		}
+
+		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+		outputOpts := make([]handlers.Option, 0, 3)
+		outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+		if outputFields.Has(globals.ScopeField) {
+			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetProjectId()]))
+		}
+		if outputFields.Has(globals.AuthorizedActionsField) {
+			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+		}
+
+		item, err := toProto(ctx, item, outputOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter.Match(item) {
+			finalItems = append(finalItems, item)
+		}
+	}
+	return &pbs.ListEnvironmentsResponse{Items: finalItems}, nil
+}
+
+// GetEnvironment implements the interface pbs.EnvironmentServiceServer.
+func (s Service) GetEnvironment(ctx context.Context, req *pbs.GetEnvironmentRequest) (*pbs.GetEnvironmentResponse, error) {
+	const op = "environments.(Service).GetEnvironment"
+
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	e, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputOpts, err := outputOptions(ctx, authResults, e.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item, err := toProto(ctx, e, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.GetEnvironmentResponse{Item: item}, nil
+}
+
+// CreateEnvironment implements the interface pbs.EnvironmentServiceServer.
+func (s Service) CreateEnvironment(ctx context.Context, req *pbs.CreateEnvironmentRequest) (*pbs.CreateEnvironmentResponse, error) {
+	const op = "environments.(Service).CreateEnvironment"
+
+	if err := validateCreateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetItem().GetScopeId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	e, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputOpts, err := outputOptions(ctx, authResults, e.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item, err := toProto(ctx, e, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.CreateEnvironmentResponse{Item: item, Uri: fmt.Sprintf("environments/%s", item.GetId())}, nil
+}
+
+// UpdateEnvironment implements the interface pbs.EnvironmentServiceServer.
+func (s Service) UpdateEnvironment(ctx context.Context, req *pbs.UpdateEnvironmentRequest) (*pbs.UpdateEnvironmentResponse, error) {
+	const op = "environments.(Service).UpdateEnvironment"
+
+	if err := validateUpdateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	e, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputOpts, err := outputOptions(ctx, authResults, e.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item, err := toProto(ctx, e, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.UpdateEnvironmentResponse{Item: item}, nil
+}
+
+// DeleteEnvironment implements the interface pbs.EnvironmentServiceServer.
+func (s Service) DeleteEnvironment(ctx context.Context, req *pbs.DeleteEnvironmentRequest) (*pbs.DeleteEnvironmentResponse, error) {
+	if err := validateDeleteRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Delete)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	_, err := s.deleteFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, projectIds []string) ([]*environment.Environment, error) {
+	const op = "environments.(Service).listFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	el, err := repo.ListEnvironments(ctx, projectIds, environment.WithLimit(-1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return el, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*environment.Environment, error) {
+	const op = "environments.(Service).getFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	e, err := repo.LookupEnvironment(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if e == nil {
+		return nil, handlers.NotFoundErrorf("Environment %q doesn't exist.", id)
+	}
+	return e, nil
+}
+
+func (s Service) createInRepo(ctx context.Context, projectId string, item *pb.Environment) (*environment.Environment, error) {
+	const op = "environments.(Service).createInRepo"
+	e, err := environment.NewEnvironment(ctx, projectId, toOptions(item)...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build environment for creation: %v.", err)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.CreateEnvironment(ctx, e)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create environment but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, projectId, id string, mask []string, item *pb.Environment) (*environment.Environment, error) {
+	const op = "environments.(Service).updateInRepo"
+	version := item.GetVersion()
+	e, err := environment.NewEnvironment(ctx, projectId, toOptions(item)...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build environment for update: %v.", err)
+	}
+	e.PublicId = id
+	dbMask := maskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateEnvironment(ctx, e, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Environment %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
+	const op = "environments.(Service).deleteFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return false, err
+	}
+	rows, err := repo.DeleteEnvironment(ctx, id)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete environment"))
+	}
+	return rows > 0, nil
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.Environment), auth.WithAction(a)}
+	switch a {
+	case action.List, action.Create:
+		parentId = id
+		scp, err := iamRepo.LookupScope(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if scp == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+	default:
+		repo, err := s.repoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		e, err := repo.LookupEnvironment(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if e == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = e.GetProjectId()
+		opts = append(opts, auth.WithId(id))
+	}
+	opts = append(opts, auth.WithScopeId(parentId))
+	return auth.Verify(ctx, opts...)
+}
+
+func outputOptions(ctx context.Context, authResults auth.VerifyResults, id string) ([]handlers.Option, error) {
+	const op = "environments.outputOptions"
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, id, IdActions).Strings()))
+	}
+	return outputOpts, nil
+}
+
+func toOptions(item *pb.Environment) []environment.Option {
+	var opts []environment.Option
+	if item.GetName() != nil {
+		opts = append(opts, environment.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, environment.WithDescription(item.GetDescription().GetValue()))
+	}
+	if item.GetSessionMaxSeconds() != nil {
+		opts = append(opts, environment.WithSessionMaxSeconds(item.GetSessionMaxSeconds().GetValue()))
+	}
+	if item.GetEgressWorkerFilter() != nil {
+		opts = append(opts, environment.WithEgressWorkerFilter(item.GetEgressWorkerFilter().GetValue()))
+	}
+	if item.GetEnableSessionRecording() != nil {
+		opts = append(opts, environment.WithEnableSessionRecording(item.GetEnableSessionRecording().GetValue()))
+	}
+	if item.GetStorageBucketId() != nil {
+		opts = append(opts, environment.WithStorageBucketId(item.GetStorageBucketId().GetValue()))
+	}
+	return opts
+}
+
+func toProto(ctx context.Context, in *environment.Environment, opt ...handlers.Option) (*pb.Environment, error) {
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building environment proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.Environment{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if outputFields.Has(globals.ScopeIdField) {
+		out.ScopeId = in.GetProjectId()
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.NameField) && in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.GetVersion()
+	}
+	if outputFields.Has(globals.SessionMaxSecondsField) && in.GetSessionMaxSeconds() != 0 {
+		out.SessionMaxSeconds = wrapperspb.UInt32(in.GetSessionMaxSeconds())
+	}
+	if outputFields.Has(globals.EgressWorkerFilterField) && in.GetEgressWorkerFilter() != "" {
+		out.EgressWorkerFilter = wrapperspb.String(in.GetEgressWorkerFilter())
+	}
+	if outputFields.Has(globals.EnableSessionRecordingField) {
+		out.EnableSessionRecording = wrapperspb.Bool(in.GetEnableSessionRecording())
+	}
+	if outputFields.Has(globals.StorageBucketIdField) && in.GetStorageBucketId() != "" {
+		out.StorageBucketId = wrapperspb.String(in.GetStorageBucketId())
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	return &out, nil
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetEnvironmentRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.EnvironmentPrefix)
+}
+
+func validateCreateRequest(req *pbs.CreateEnvironmentRequest) error {
+	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
+		item := req.GetItem()
+		badFields := validateSettings(item)
+		if !handlers.ValidId(handlers.Id(item.GetScopeId()), scope.Project.Prefix()) {
+			badFields[globals.ScopeIdField] = "This field is missing or improperly formatted."
+		}
+		if item.GetEnableSessionRecording().GetValue() && item.GetStorageBucketId().GetValue() == "" {
+			badFields[globals.StorageBucketIdField] = "This field is required when session recording is enabled."
+		}
+		return badFields
+	})
+}
+
+func validateUpdateRequest(req *pbs.UpdateEnvironmentRequest) error {
+	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
+		return validateSettings(req.GetItem())
+	}, globals.EnvironmentPrefix)
+}
+
+// validateSettings validates the session settings of the environment, which
+// are validated the same way as those of targets.
+func validateSettings(item *pb.Environment) map[string]string {
+	badFields := map[string]string{}
+	if item.GetSessionMaxSeconds() != nil && item.GetSessionMaxSeconds().GetValue() == 0 {
+		badFields[globals.SessionMaxSecondsField] = "This must be greater than zero."
+	}
+	if filter := item.GetEgressWorkerFilter(); filter.GetValue() != "" {
+		if _, err := bexpr.CreateEvaluator(filter.GetValue()); err != nil {
+			badFields[globals.EgressWorkerFilterField] = "Unable to successfully parse egress filter expression."
+		}
+	}
+	if id := item.GetStorageBucketId().GetValue(); id != "" && !handlers.ValidId(handlers.Id(id), globals.PluginStorageBucketPrefix) {
+		badFields[globals.StorageBucketIdField] = "Incorrectly formatted identifier."
+	}
+	return badFields
+}
+
+func validateDeleteRequest(req *pbs.DeleteEnvironmentRequest) error {
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.EnvironmentPrefix)
+}
+
+func validateListRequest(ctx context.Context, req *pbs.ListEnvironmentsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) &&
+		!handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) &&
+		req.GetScopeId() != scope.Global.String() {
+		badFields[globals.ScopeIdField] = "Incorrectly formatted identifier."
+	}
+	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package environments_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/environments"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/environment"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/environments"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestCrud(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*environment.Repository, error) {
+		return environment.NewRepository(ctx, rw, rw, testKms)
+	}
+	o, p := iam.TestScopes(t, iamRepo)
+
+	s, err := environments.NewService(ctx, repoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	created, err := s.CreateEnvironment(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.CreateEnvironmentRequest{Item: &pb.Environment{
+		ScopeId:            p.GetPublicId(),
+		Name:               wrapperspb.String("prod"),
+		SessionMaxSeconds:  wrapperspb.UInt32(3600),
+		EgressWorkerFilter: wrapperspb.String(`"prod" in "/tags/env"`),
+	}})
+	require.NoError(t, err)
+	id := created.GetItem().GetId()
+	assert.Equal(t, "environments/"+id, created.GetUri())
+	assert.Equal(t, uint32(3600), created.GetItem().GetSessionMaxSeconds().GetValue())
+
+	t.Run("create-invalid", func(t *testing.T) {
+		cases := []struct {
+			name string
+			item *pb.Environment
+		}{
+			{
+				name: "org-scope",
+				item: &pb.Environment{ScopeId: o.GetPublicId()},
+			},
+			{
+				name: "zero-session-max",
+				item: &pb.Environment{ScopeId: p.GetPublicId(), SessionMaxSeconds: wrapperspb.UInt32(0)},
+			},
+			{
+				name: "bad-egress-filter",
+				item: &pb.Environment{ScopeId: p.GetPublicId(), EgressWorkerFilter: wrapperspb.String(`"prod" in`)},
+			},
+			{
+				name: "recording-without-bucket",
+				item: &pb.Environment{ScopeId: p.GetPublicId(), EnableSessionRecording: wrapperspb.Bool(true)},
+			},
+			{
+				name: "id-set",
+				item: &pb.Environment{Id: "env_1234567890", ScopeId: p.GetPublicId()},
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := s.CreateEnvironment(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.CreateEnvironmentRequest{Item: tc.item})
+				require.Error(t, err)
+				assert.ErrorIs(t, err, handlers.ApiErrorWithCode(codes.InvalidArgument))
+			})
+		}
+	})
+
+	t.Run("get", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.GetEnvironment(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.GetEnvironmentRequest{Id: id})
+		require.NoError(err)
+		assert.Equal("prod", got.GetItem().GetName().GetValue())
+		assert.Equal(p.GetPublicId(), got.GetItem().GetScopeId())
+
+		_, err = s.GetEnvironment(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.GetEnvironmentRequest{Id: "env_doesntexis"})
+		assert.ErrorIs(err, handlers.ApiErrorWithCode(codes.NotFound))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.UpdateEnvironment(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.UpdateEnvironmentRequest{
+			Id: id,
+			Item: &pb.Environment{
+				Version:     created.GetItem().GetVersion(),
+				Description: wrapperspb.String("production"),
+			},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"description", "egress_worker_filter"}},
+		})
+		require.NoError(err)
+		assert.Equal("production", got.GetItem().GetDescription().GetValue())
+		assert.Nil(got.GetItem().GetEgressWorkerFilter())
+		assert.Equal(uint32(3600), got.GetItem().GetSessionMaxSeconds().GetValue())
+		assert.Equal(created.GetItem().GetVersion()+1, got.GetItem().GetVersion())
+	})
+
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.ListEnvironments(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.ListEnvironmentsRequest{ScopeId: p.GetPublicId()})
+		require.NoError(err)
+		require.Len(got.GetItems(), 1)
+		assert.Equal(id, got.GetItems()[0].GetId())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := s.DeleteEnvironment(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.DeleteEnvironmentRequest{Id: id})
+		require.NoError(err)
+
+		_, err = s.GetEnvironment(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.GetEnvironmentRequest{Id: id})
+		assert.ErrorIs(err, handlers.ApiErrorWithCode(codes.NotFound))
+	})
+}
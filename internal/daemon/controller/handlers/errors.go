@@ -265,6 +265,10 @@ func ErrorHandler() runtime.ErrorHandlerFunc {
 		// let's check there first. (see: controller.errorInterceptor)
 		md, ok := runtime.ServerMetadataFromContext(ctx)
 		if ok {
+			// Requests can fail because of the deprecated usage they are
+			// warned about, so keep the notices on errors too.
+			setDeprecationHeaders(w, md.HeaderMD)
+
 			defer func() {
 				// make sure we don't leak the headers that were used as a comm
 				// channel between the grpc server and the http proxy
@@ -335,6 +339,12 @@ func ErrorHandler() runtime.ErrorHandlerFunc {
 			event.WriteError(ctx, op, inErr, event.WithInfoMsg("internal error returned"))
 		}
 
+		// Errors are always returned as JSON, even to clients that negotiated
+		// binary protobuf responses, so they can be read without knowing
+		// which endpoint they came from.
+		if isProtoMarshaler(mar) {
+			mar = JSONMarshaler()
+		}
 		buf, merr := mar.Marshal(apiErr.Inner)
 		if merr != nil {
 			event.WriteError(ctx, op, merr, event.WithInfoMsg("failed to marshal error response", "response", fmt.Sprintf("%#v", apiErr.Inner)))
@@ -263,3 +263,23 @@ func TestApiErrorHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestApiErrorHandler_ProtoMarshaler(t *testing.T) {
+	ctx := context.Background()
+	req, err := http.NewRequest("GET", "madeup/for/the/test", nil)
+	require.NoError(t, err)
+	mux := runtime.NewServeMux()
+
+	w := httptest.NewRecorder()
+	ErrorHandler()(ctx, mux, ProtoMarshaler(), w, req, NotFoundErrorf("Test"))
+	resp := w.Result()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	got, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	gotErr := &pb.Error{}
+	require.NoError(t, JSONMarshaler().Unmarshal(got, gotErr))
+	assert.Equal(t, "NotFound", gotErr.GetKind())
+	assert.Equal(t, "Test", gotErr.GetMessage())
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package features
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/features"
+)
+
+// The names of the features reported by the service.
+const (
+	SessionRecording        = "session-recording"
+	StoragePolicies         = "storage-policies"
+	MultiHopSessions        = "multi-hop-sessions"
+	HostCatalogWorkerFilter = "host-catalog-worker-filter"
+)
+
+var (
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.NewActionSet()
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.NewActionSet(
+		action.List,
+	)
+
+	// FeaturesFn returns the features reported by the service. Editions of
+	// Boundary that support more features than this one replace it.
+	FeaturesFn = features
+)
+
+func init() {
+	// TODO: refactor to remove IdActions and CollectionActions package variables
+	action.RegisterResource(resource.Feature, IdActions, CollectionActions)
+}
+
+// Feature is a feature of Boundary that is not available in every edition.
+type Feature struct {
+	Name        string
+	Description string
+	Enabled     bool
+}
+
+// features returns the features that are not available in this edition of
+// Boundary, all of them disabled.
+func features(context.Context) []Feature {
+	return []Feature{
+		{
+			Name:        SessionRecording,
+			Description: "Record sessions and store the recordings in storage buckets.",
+		},
+		{
+			Name:        StoragePolicies,
+			Description: "Set the retention and deletion periods of session recordings with storage policies.",
+		},
+		{
+			Name:        MultiHopSessions,
+			Description: "Proxy sessions through chains of workers selected with ingress worker filters.",
+		},
+		{
+			Name:        HostCatalogWorkerFilter,
+			Description: "Select the workers that sync the hosts of dynamic host catalogs.",
+		},
+	}
+}
+
+type Service struct {
+	pbs.UnsafeFeatureServiceServer
+}
+
+var _ pbs.FeatureServiceServer = (*Service)(nil)
+
+// NewService returns a feature service which reports the features enabled on
+// the controller.
+func NewService(context.Context) (Service, error) {
+	return Service{}, nil
+}
+
+// ListFeatures implements the interface pbs.FeatureServiceServer.
+func (s Service) ListFeatures(ctx context.Context, _ *pbs.ListFeaturesRequest) (*pbs.ListFeaturesResponse, error) {
+	const op = "features.(Service).ListFeatures"
+
+	authResults := s.authResult(ctx, action.List)
+	if authResults.Error != nil {
+		return nil, errors.Wrap(ctx, authResults.Error, op)
+	}
+
+	fs := FeaturesFn(ctx)
+	slices.SortFunc(fs, func(a, b Feature) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	items := make([]*pb.Feature, 0, len(fs))
+	for _, f := range fs {
+		items = append(items, &pb.Feature{
+			Name:        f.Name,
+			Description: f.Description,
+			Enabled:     f.Enabled,
+		})
+	}
+	return &pbs.ListFeaturesResponse{Items: items}, nil
+}
+
+func (s Service) authResult(ctx context.Context, a action.Type) auth.VerifyResults {
+	opts := []auth.Option{
+		auth.WithType(resource.Feature),
+		auth.WithAction(a),
+		auth.WithScopeId(scope.Global.String()),
+	}
+	return auth.Verify(ctx, opts...)
+}
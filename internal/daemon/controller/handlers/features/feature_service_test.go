@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package features_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/features"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/features"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestListFeatures(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrap), nil
+	}
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String(), auth.WithUserId(globals.AnyAuthenticatedUserId))
+
+	s, err := features.NewService(ctx)
+	require.NoError(t, err)
+
+	t.Run("disabled", func(t *testing.T) {
+		got, err := s.ListFeatures(requestCtx, &pbs.ListFeaturesRequest{})
+		require.NoError(t, err)
+		require.NotEmpty(t, got.GetItems())
+		var names []string
+		for _, f := range got.GetItems() {
+			assert.False(t, f.GetEnabled(), f.GetName())
+			assert.NotEmpty(t, f.GetDescription(), f.GetName())
+			names = append(names, f.GetName())
+		}
+		assert.IsNonDecreasing(t, names)
+		assert.Contains(t, names, features.SessionRecording)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		orig := features.FeaturesFn
+		t.Cleanup(func() { features.FeaturesFn = orig })
+		features.FeaturesFn = func(context.Context) []features.Feature {
+			return []features.Feature{
+				{Name: features.SessionRecording, Description: "recording", Enabled: true},
+				{Name: features.MultiHopSessions, Description: "multi-hop"},
+			}
+		}
+
+		got, err := s.ListFeatures(requestCtx, &pbs.ListFeaturesRequest{})
+		require.NoError(t, err)
+		want := &pbs.ListFeaturesResponse{
+			Items: []*pb.Feature{
+				{Name: features.MultiHopSessions, Description: "multi-hop"},
+				{Name: features.SessionRecording, Description: "recording", Enabled: true},
+			},
+		}
+		assert.Empty(t, cmp.Diff(want, got, protocmp.Transform()))
+	})
+}
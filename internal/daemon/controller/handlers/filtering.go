@@ -8,7 +8,6 @@ import (
 
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/filter"
-	"github.com/hashicorp/go-bexpr"
 )
 
 // filterItem captures all the different namespaces that can be used when
@@ -18,7 +17,7 @@ type filterItem struct {
 }
 
 type Filter struct {
-	eval *bexpr.Evaluator
+	eval filter.Evaluator
 }
 
 // NewFilter returns a Filter which can be evaluated against.  An empty string parameter indicates
@@ -28,7 +27,7 @@ func NewFilter(ctx context.Context, f string) (*Filter, error) {
 	if f == "" {
 		return &Filter{}, nil
 	}
-	e, err := bexpr.CreateEvaluator(f, bexpr.WithTagName("json"), bexpr.WithHookFn(filter.WellKnownTypeFilterHook))
+	e, err := filter.CreateEvaluator(f, filter.WithTagName("json"), filter.WithHookFn(filter.WellKnownTypeFilterHook))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("couldn't build filter"), errors.WithCode(errors.InvalidParameter))
 	}
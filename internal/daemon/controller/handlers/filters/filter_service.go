@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filters
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/filters"
+)
+
+var (
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.NewActionSet()
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.NewActionSet(
+		action.Validate,
+	)
+)
+
+func init() {
+	// TODO: refactor to remove IdActions and CollectionActions package variables
+	action.RegisterResource(resource.Filter, IdActions, CollectionActions)
+}
+
+type Service struct {
+	pbs.UnsafeFilterServiceServer
+}
+
+var _ pbs.FilterServiceServer = (*Service)(nil)
+
+// NewService returns a filter service which validates filter expressions.
+func NewService(context.Context) (Service, error) {
+	return Service{}, nil
+}
+
+// ValidateFilter implements the interface pbs.FilterServiceServer.
+func (s Service) ValidateFilter(ctx context.Context, req *pbs.ValidateFilterRequest) (*pbs.ValidateFilterResponse, error) {
+	const op = "filters.(Service).ValidateFilter"
+
+	kind, err := validateValidateFilterRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, action.Validate)
+	if authResults.Error != nil {
+		return nil, errors.Wrap(ctx, authResults.Error, op)
+	}
+
+	verrs, err := filter.Validate(req.GetExpression(), kind)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := &pbs.ValidateFilterResponse{Valid: len(verrs) == 0}
+	for _, e := range verrs {
+		item := &pb.ValidationError{Message: e.Message}
+		if e.Position != nil {
+			item.Line = uint32(e.Position.Line)
+			item.Column = uint32(e.Position.Column)
+			item.Offset = uint32(e.Position.Offset)
+		}
+		ret.Errors = append(ret.Errors, item)
+	}
+	return ret, nil
+}
+
+func (s Service) authResult(ctx context.Context, a action.Type) auth.VerifyResults {
+	opts := []auth.Option{
+		auth.WithType(resource.Filter),
+		auth.WithAction(a),
+		auth.WithScopeId(scope.Global.String()),
+	}
+	return auth.Verify(ctx, opts...)
+}
+
+func validateValidateFilterRequest(req *pbs.ValidateFilterRequest) (filter.Kind, error) {
+	badFields := map[string]string{}
+	kind, err := filter.ParseKind(req.GetType())
+	if err != nil {
+		badFields["type"] = `Must be "worker", "managed-group" or "list".`
+	}
+	if len(badFields) > 0 {
+		return "", handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return kind, nil
+}
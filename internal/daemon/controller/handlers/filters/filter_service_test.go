@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filters_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/filters"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/filters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestValidateFilter(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrap), nil
+	}
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String(), auth.WithUserId(globals.AnyAuthenticatedUserId))
+
+	s, err := filters.NewService(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		req     *pbs.ValidateFilterRequest
+		want    *pbs.ValidateFilterResponse
+		errCode codes.Code
+	}{
+		{
+			name: "valid",
+			req:  &pbs.ValidateFilterRequest{Type: "worker", Expression: `"dev" in "/tags/env"`},
+			want: &pbs.ValidateFilterResponse{Valid: true},
+		},
+		{
+			name: "syntax error",
+			req:  &pbs.ValidateFilterRequest{Type: "list", Expression: `"/item/name" == `},
+			want: &pbs.ValidateFilterResponse{
+				Errors: []*pb.ValidationError{{
+					Message: `no match found, expected: "-", "0", "\"", "` + "`" + `", [ \t\r\n], [1-9] or [a-zA-Z]`,
+					Line:    1,
+					Column:  16,
+					Offset:  15,
+				}},
+			},
+		},
+		{
+			name: "unknown selector",
+			req:  &pbs.ValidateFilterRequest{Type: "managed-group", Expression: `"/claims/sub" == "alice"`},
+			want: &pbs.ValidateFilterResponse{
+				Errors: []*pb.ValidationError{{
+					Message: `unknown selector "/claims/sub", must start with "token" or "userinfo"`,
+					Line:    1,
+					Column:  1,
+				}},
+			},
+		},
+		{
+			name: "empty",
+			req:  &pbs.ValidateFilterRequest{Type: "worker"},
+			want: &pbs.ValidateFilterResponse{
+				Errors: []*pb.ValidationError{{Message: "filter expression is empty"}},
+			},
+		},
+		{
+			name:    "bad type",
+			req:     &pbs.ValidateFilterRequest{Type: "session", Expression: `"/name" == "w1"`},
+			errCode: codes.InvalidArgument,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := s.ValidateFilter(requestCtx, tc.req)
+			if tc.errCode != codes.OK {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(tc.errCode)), "got error %v, wanted %v", err, tc.errCode)
+				return
+			}
+			require.NoError(t, err)
+			assert.Empty(t, cmp.Diff(tc.want, got, protocmp.Transform()))
+		})
+	}
+}
@@ -8,12 +8,37 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	pbs "github.com/hashicorp/boundary/internal/gen/ops/services"
 	pbhealth "github.com/hashicorp/boundary/internal/gen/worker/health"
 )
 
+// The statuses reported by the readiness endpoint.
+const (
+	ReadyStatus    = "ready"
+	NotReadyStatus = "not_ready"
+
+	OkDependencyStatus       = "ok"
+	DegradedDependencyStatus = "degraded"
+	FailedDependencyStatus   = "failed"
+)
+
+// dependencyCheckTimeout limits how long a single dependency check may take.
+const dependencyCheckTimeout = 5 * time.Second
+
+// DependencyCheck checks one of the dependencies of the controller for the
+// readiness endpoint. Check returns a human readable detail of the status of
+// the dependency, and an error if the dependency is unusable.
+type DependencyCheck struct {
+	Name string
+	// Optional dependencies are reported as degraded rather than failed when
+	// their check returns an error, and do not make the controller not ready.
+	Optional bool
+	Check    func(context.Context) (string, error)
+}
+
 type Service struct {
 	pbs.UnsafeHealthServiceServer
 	replyWithServiceUnavailable bool
@@ -21,6 +46,9 @@ type Service struct {
 	workerInfoLock sync.RWMutex
 	workerInfoOnce sync.Once
 	workerInfoFn   func() *pbhealth.HealthInfo
+
+	dependencyChecksLock sync.RWMutex
+	dependencyChecks     []DependencyCheck
 }
 
 var _ pbs.HealthServiceServer = (*Service)(nil)
@@ -52,6 +80,65 @@ func (s *Service) GetHealth(ctx context.Context, req *pbs.GetHealthRequest) (*pb
 	return resp, nil
 }
 
+// GetLiveness replies successfully as long as the controller is running, even
+// while it is shutting down.
+func (s *Service) GetLiveness(context.Context, *pbs.GetLivenessRequest) (*pbs.GetLivenessResponse, error) {
+	return &pbs.GetLivenessResponse{}, nil
+}
+
+// GetReadiness runs the dependency checks concurrently and reports their
+// status. It replies with a 503 if any required dependency has failed or the
+// controller is shutting down.
+func (s *Service) GetReadiness(ctx context.Context, _ *pbs.GetReadinessRequest) (*pbs.GetReadinessResponse, error) {
+	s.dependencyChecksLock.RLock()
+	checks := s.dependencyChecks
+	s.dependencyChecksLock.RUnlock()
+
+	resp := &pbs.GetReadinessResponse{
+		Status:       ReadyStatus,
+		Dependencies: make([]*pbs.DependencyStatus, len(checks)),
+	}
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func(i int, c DependencyCheck) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, dependencyCheckTimeout)
+			defer cancel()
+			ds := &pbs.DependencyStatus{
+				Name:   c.Name,
+				Status: OkDependencyStatus,
+			}
+			detail, err := c.Check(checkCtx)
+			ds.Detail = detail
+			if err != nil {
+				ds.Status = FailedDependencyStatus
+				if c.Optional {
+					ds.Status = DegradedDependencyStatus
+				}
+				ds.Detail = err.Error()
+			}
+			resp.Dependencies[i] = ds
+		}(i, c)
+	}
+	wg.Wait()
+
+	for _, ds := range resp.Dependencies {
+		if ds.Status == FailedDependencyStatus {
+			resp.Status = NotReadyStatus
+		}
+	}
+	if s.replyWithServiceUnavailable {
+		resp.Status = NotReadyStatus
+	}
+	if resp.Status != ReadyStatus {
+		if err := handlers.SetStatusCode(ctx, http.StatusServiceUnavailable); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
 // StartServiceUnavailableReplies gets returned to the caller of NewService.
 // When invoked, we start responding to any health queries with a 503.
 func (s *Service) StartServiceUnavailableReplies() {
@@ -71,3 +158,20 @@ func (s *Service) SetWorkerProcessInformationFunc(fn func() *pbhealth.HealthInfo
 	})
 	return nil
 }
+
+// SetDependencyChecks sets the dependency checks run by the readiness
+// endpoint, replacing any previously set checks.
+func (s *Service) SetDependencyChecks(checks ...DependencyCheck) error {
+	for _, c := range checks {
+		if c.Name == "" {
+			return fmt.Errorf("dependency check name was empty")
+		}
+		if c.Check == nil {
+			return fmt.Errorf("dependency check %q function was nil", c.Name)
+		}
+	}
+	s.dependencyChecksLock.Lock()
+	defer s.dependencyChecksLock.Unlock()
+	s.dependencyChecks = checks
+	return nil
+}
@@ -136,3 +136,97 @@ func (s *testServerTransportStream) SetHeader(md metadata.MD) error {
 	}
 	return nil
 }
+
+func TestGetLiveness(t *testing.T) {
+	hs := NewService()
+	hs.StartServiceUnavailableReplies()
+	rsp, err := hs.GetLiveness(context.Background(), &services.GetLivenessRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(&services.GetLivenessResponse{}, rsp, protocmp.Transform()))
+}
+
+func TestGetReadiness(t *testing.T) {
+	okCheck := DependencyCheck{
+		Name:  "database",
+		Check: func(context.Context) (string, error) { return "ping took 1ms", nil },
+	}
+	failedCheck := DependencyCheck{
+		Name:  "kms",
+		Check: func(context.Context) (string, error) { return "", fmt.Errorf("kms unreachable") },
+	}
+	degradedCheck := DependencyCheck{
+		Name:     "workers",
+		Optional: true,
+		Check:    func(context.Context) (string, error) { return "", fmt.Errorf("no workers are connected") },
+	}
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		checks             []DependencyCheck
+		serviceUnavailable bool
+		expResponse        *services.GetReadinessResponse
+	}{
+		{
+			name:        "no checks",
+			ctx:         context.Background(),
+			expResponse: &services.GetReadinessResponse{Status: ReadyStatus, Dependencies: []*services.DependencyStatus{}},
+		},
+		{
+			name:   "ready with degraded dependency",
+			ctx:    context.Background(),
+			checks: []DependencyCheck{okCheck, degradedCheck},
+			expResponse: &services.GetReadinessResponse{
+				Status: ReadyStatus,
+				Dependencies: []*services.DependencyStatus{
+					{Name: "database", Status: OkDependencyStatus, Detail: "ping took 1ms"},
+					{Name: "workers", Status: DegradedDependencyStatus, Detail: "no workers are connected"},
+				},
+			},
+		},
+		{
+			name:   "not ready with failed dependency",
+			ctx:    grpc.NewContextWithServerTransportStream(context.Background(), &testServerTransportStream{expHttpCode: "503"}),
+			checks: []DependencyCheck{okCheck, failedCheck},
+			expResponse: &services.GetReadinessResponse{
+				Status: NotReadyStatus,
+				Dependencies: []*services.DependencyStatus{
+					{Name: "database", Status: OkDependencyStatus, Detail: "ping took 1ms"},
+					{Name: "kms", Status: FailedDependencyStatus, Detail: "kms unreachable"},
+				},
+			},
+		},
+		{
+			name:               "not ready while shutting down",
+			ctx:                grpc.NewContextWithServerTransportStream(context.Background(), &testServerTransportStream{expHttpCode: "503"}),
+			checks:             []DependencyCheck{okCheck},
+			serviceUnavailable: true,
+			expResponse: &services.GetReadinessResponse{
+				Status: NotReadyStatus,
+				Dependencies: []*services.DependencyStatus{
+					{Name: "database", Status: OkDependencyStatus, Detail: "ping took 1ms"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hs := NewService()
+			require.NoError(t, hs.SetDependencyChecks(tt.checks...))
+			if tt.serviceUnavailable {
+				hs.StartServiceUnavailableReplies()
+			}
+
+			rsp, err := hs.GetReadiness(tt.ctx, &services.GetReadinessRequest{})
+			require.NoError(t, err)
+			assert.Empty(t, cmp.Diff(tt.expResponse, rsp, protocmp.Transform()))
+		})
+	}
+}
+
+func TestSetDependencyChecks(t *testing.T) {
+	hs := NewService()
+	assert.EqualError(t, hs.SetDependencyChecks(DependencyCheck{Check: func(context.Context) (string, error) { return "", nil }}), "dependency check name was empty")
+	assert.EqualError(t, hs.SetDependencyChecks(DependencyCheck{Name: "database"}), `dependency check "database" function was nil`)
+}
@@ -6,6 +6,8 @@ package host_catalogs
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -33,7 +35,9 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/hashicorp/go-bexpr"
 	"github.com/mr-tron/base58"
+	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -44,14 +48,24 @@ var (
 	staticMaskManager handlers.MaskManager
 	pluginMaskManager handlers.MaskManager
 
-	// IdActions contains the set of actions that can be performed on
-	// individual resources
-	IdActions = action.NewActionSet(
-		action.NoOp,
-		action.Read,
-		action.Update,
-		action.Delete,
-	)
+	// idActionsTypeMap contains the set of actions that can be performed on
+	// individual resources of each subtype
+	idActionsTypeMap = map[globals.Subtype]action.ActionSet{
+		static.Subtype: action.NewActionSet(
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		),
+		hostplugin.Subtype: action.NewActionSet(
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+			action.RotateSecrets,
+			action.SetTargetRules,
+		),
+	}
 
 	// CollectionActions contains the set of actions that can be performed on
 	// this collection
@@ -101,7 +115,7 @@ func init() {
 	}
 
 	// TODO: refactor to remove idActionsMap and CollectionActions package variables
-	action.RegisterResource(resource.HostCatalog, IdActions, CollectionActions)
+	action.RegisterResource(resource.HostCatalog, action.Union(maps.Values(idActionsTypeMap)...), CollectionActions)
 }
 
 type Service struct {
@@ -162,6 +176,9 @@ func (s Service) ListHostCatalogs(ctx context.Context, req *pbs.ListHostCatalogs
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if req.GetExactCount() {
+		ctx = pagination.WithExactCount(ctx)
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -338,7 +355,7 @@ func (s Service) GetHostCatalog(ctx context.Context, req *pbs.GetHostCatalogRequ
 		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
-		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), IdActions).Strings()))
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActionsTypeMap[globals.ResourceInfoFromPrefix(hc.GetPublicId()).Subtype]).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
 		var subtype globals.Subtype
@@ -364,6 +381,11 @@ func (s Service) GetHostCatalog(ctx context.Context, req *pbs.GetHostCatalogRequ
 	if err != nil {
 		return nil, err
 	}
+	if _, ok := hc.(*hostplugin.HostCatalog); ok && outputFields.Has(globals.TargetRulesField) {
+		if item.TargetRules, err = s.targetRulesFromRepo(ctx, hc.GetPublicId()); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.GetHostCatalogResponse{Item: item}, nil
 }
@@ -395,7 +417,7 @@ func (s Service) CreateHostCatalog(ctx context.Context, req *pbs.CreateHostCatal
 		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
-		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), IdActions).Strings()))
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActionsTypeMap[globals.ResourceInfoFromPrefix(hc.GetPublicId()).Subtype]).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
 		var subtype globals.Subtype
@@ -458,7 +480,7 @@ func (s Service) UpdateHostCatalog(ctx context.Context, req *pbs.UpdateHostCatal
 		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
-		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), IdActions).Strings()))
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActionsTypeMap[globals.ResourceInfoFromPrefix(hc.GetPublicId()).Subtype]).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
 		var subtype globals.Subtype
@@ -500,6 +522,106 @@ func (s Service) DeleteHostCatalog(ctx context.Context, req *pbs.DeleteHostCatal
 	return nil, nil
 }
 
+// RotateHostCatalogSecrets implements the interface pbs.HostCatalogServiceServer.
+func (s Service) RotateHostCatalogSecrets(ctx context.Context, req *pbs.RotateHostCatalogSecretsRequest) (*pbs.RotateHostCatalogSecretsResponse, error) {
+	const op = "host_catalogs.(Service).RotateHostCatalogSecrets"
+
+	if err := validateRotateSecretsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RotateSecrets)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	hc, plg, interval, err := s.rotateSecretsInRepo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActionsTypeMap[hostplugin.Subtype]).Strings()))
+	}
+	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[hostplugin.Subtype], authResults.Scope, hc.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
+	}
+	item, err := toProto(ctx, hc, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.RotateHostCatalogSecretsResponse{
+		Item:                             item,
+		AutomaticRotationIntervalSeconds: uint32(interval / time.Second),
+	}, nil
+}
+
+// SetHostCatalogTargetRules implements the interface pbs.HostCatalogServiceServer.
+func (s Service) SetHostCatalogTargetRules(ctx context.Context, req *pbs.SetHostCatalogTargetRulesRequest) (*pbs.SetHostCatalogTargetRulesResponse, error) {
+	const op = "host_catalogs.(Service).SetHostCatalogTargetRules"
+
+	if err := validateSetTargetRulesRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.SetTargetRules)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	rules, err := s.setTargetRulesInRepo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	hc, plg, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActionsTypeMap[hostplugin.Subtype]).Strings()))
+	}
+	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[hostplugin.Subtype], authResults.Scope, hc.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
+	}
+	item, err := toProto(ctx, hc, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if outputFields.Has(globals.TargetRulesField) {
+		item.TargetRules = toTargetRulesProto(rules)
+	}
+
+	return &pbs.SetHostCatalogTargetRulesResponse{Item: item}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (host.Catalog, *plugins.PluginInfo, error) {
 	var plg *plugins.PluginInfo
 	var cat host.Catalog
@@ -666,6 +788,82 @@ func (s Service) updateInRepo(ctx context.Context, projId string, req *pbs.Updat
 	return
 }
 
+func (s Service) rotateSecretsInRepo(ctx context.Context, req *pbs.RotateHostCatalogSecretsRequest) (*hostplugin.HostCatalog, *plugins.PluginInfo, time.Duration, error) {
+	const op = "host_catalogs.(Service).rotateSecretsInRepo"
+	repo, err := s.pluginHostRepoFn()
+	if err != nil {
+		return nil, nil, 0, errors.Wrap(ctx, err, op)
+	}
+	if req.GetSecrets() == nil {
+		cat, _, err := repo.LookupCatalog(ctx, req.GetId())
+		if err != nil {
+			return nil, nil, 0, errors.Wrap(ctx, err, op)
+		}
+		if cat == nil {
+			return nil, nil, 0, handlers.NotFoundErrorf("Host Catalog %q doesn't exist.", req.GetId())
+		}
+		if len(cat.GetSecretsHmac()) == 0 {
+			return nil, nil, 0, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{globals.SecretsField: "The host catalog has no stored secrets, new secrets must be provided."})
+		}
+	}
+	out, plg, rowsUpdated, err := repo.RotateCatalogSecrets(ctx, req.GetId(), req.GetVersion(), req.GetSecrets())
+	if err != nil {
+		return nil, nil, 0, errors.Wrap(ctx, err, op, errors.WithMsg("unable to rotate host catalog secrets"))
+	}
+	if rowsUpdated == 0 {
+		return nil, nil, 0, handlers.NotFoundErrorf("Host Catalog %q doesn't exist or incorrect version provided.", req.GetId())
+	}
+	if req.GetAutomaticRotationIntervalSeconds() != nil {
+		interval := time.Duration(req.GetAutomaticRotationIntervalSeconds().GetValue()) * time.Second
+		if err := repo.SetCatalogSecretRotation(ctx, req.GetId(), interval); err != nil {
+			return nil, nil, 0, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set automatic secret rotation"))
+		}
+	}
+	interval, err := repo.LookupCatalogSecretRotation(ctx, req.GetId())
+	if err != nil {
+		return nil, nil, 0, errors.Wrap(ctx, err, op, errors.WithMsg("unable to look up automatic secret rotation"))
+	}
+	return out, toPluginInfo(plg), interval, nil
+}
+
+func (s Service) setTargetRulesInRepo(ctx context.Context, req *pbs.SetHostCatalogTargetRulesRequest) ([]*hostplugin.CatalogTargetRule, error) {
+	const op = "host_catalogs.(Service).setTargetRulesInRepo"
+	rules := make([]*hostplugin.CatalogTargetRule, 0, len(req.GetTargetRules()))
+	for _, r := range req.GetTargetRules() {
+		rule, err := hostplugin.NewCatalogTargetRule(ctx, req.GetId(), r.GetTargetTemplateId(), r.GetFilter())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build target rule"))
+		}
+		rules = append(rules, rule)
+	}
+	repo, err := s.pluginHostRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.SetCatalogTargetRules(ctx, req.GetId(), req.GetVersion(), rules)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set host catalog target rules"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Host Catalog %q doesn't exist or incorrect version provided.", req.GetId())
+	}
+	return out, nil
+}
+
+func (s Service) targetRulesFromRepo(ctx context.Context, id string) ([]*pb.TargetRule, error) {
+	const op = "host_catalogs.(Service).targetRulesFromRepo"
+	repo, err := s.pluginHostRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rules, err := repo.ListCatalogTargetRules(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to list host catalog target rules"))
+	}
+	return toTargetRulesProto(rules), nil
+}
+
 func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	const op = "host_catalogs.(Service).deleteFromRepo"
 	rows := 0
@@ -779,7 +977,7 @@ func newOutputOpts(
 		Id:      item.GetPublicId(),
 		ScopeId: item.GetProjectId(),
 	}
-	authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+	authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActionsTypeMap[globals.ResourceInfoFromPrefix(item.GetPublicId()).Subtype], auth.WithResource(&res)).Strings()
 	if len(authorizedActions) == 0 {
 		return nil, false, nil
 	}
@@ -820,6 +1018,20 @@ func newOutputOpts(
 	return outputOpts, true, nil
 }
 
+func toTargetRulesProto(in []*hostplugin.CatalogTargetRule) []*pb.TargetRule {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*pb.TargetRule, 0, len(in))
+	for _, r := range in {
+		out = append(out, &pb.TargetRule{
+			Filter:           r.GetFilter(),
+			TargetTemplateId: r.GetTargetTemplateId(),
+		})
+	}
+	return out
+}
+
 func toProto(ctx context.Context, in host.Catalog, opt ...handlers.Option) (*pb.HostCatalog, error) {
 	const op = "host_catalog_service.toProto"
 	opts := handlers.GetOpts(opt...)
@@ -960,6 +1172,9 @@ func validateCreateRequest(req *pbs.CreateHostCatalogRequest) error {
 		if req.GetItem().GetSecretsHmac() != "" {
 			badFields[globals.SecretsHmacField] = "This is a read only field."
 		}
+		if len(req.GetItem().GetTargetRules()) > 0 {
+			badFields[globals.TargetRulesField] = "This is a read only field. Use the set-target-rules action to set the target rules."
+		}
 		switch req.GetItem().GetType() {
 		case static.Subtype.String():
 		case hostplugin.Subtype.String():
@@ -993,6 +1208,9 @@ func validateUpdateRequest(req *pbs.UpdateHostCatalogRequest) error {
 		if req.GetItem().GetSecretsHmac() != "" {
 			badFields[globals.SecretsHmacField] = "This is a read only field."
 		}
+		if len(req.GetItem().GetTargetRules()) > 0 {
+			badFields[globals.TargetRulesField] = "This is a read only field. Use the set-target-rules action to set the target rules."
+		}
 		switch globals.ResourceInfoFromPrefix(req.GetId()).Subtype {
 		case static.Subtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != static.Subtype.String() {
@@ -1023,6 +1241,56 @@ func validateDeleteRequest(req *pbs.DeleteHostCatalogRequest) error {
 	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix)
 }
 
+func validateRotateSecretsRequest(req *pbs.RotateHostCatalogSecretsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier. Only plugin host catalogs have secrets."
+	}
+	if req.GetVersion() == 0 {
+		badFields[globals.VersionField] = "Required field."
+	}
+	if req.GetSecrets() != nil && len(req.GetSecrets().GetFields()) == 0 {
+		badFields[globals.SecretsField] = "If set, this field must not be empty."
+	}
+	if req.GetAutomaticRotationIntervalSeconds().GetValue() > math.MaxInt32 {
+		badFields[globals.AutomaticRotationIntervalSecondsField] = fmt.Sprintf("This field must not be greater than %d.", math.MaxInt32)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateSetTargetRulesRequest(req *pbs.SetHostCatalogTargetRulesRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier. Only plugin host catalogs have target rules."
+	}
+	if req.GetVersion() == 0 {
+		badFields[globals.VersionField] = "Required field."
+	}
+	seen := make(map[string]bool, len(req.GetTargetRules()))
+	for _, r := range req.GetTargetRules() {
+		switch {
+		case !handlers.ValidId(handlers.Id(r.GetTargetTemplateId()), globals.TargetTemplatePrefix):
+			badFields[globals.TargetRulesField] = fmt.Sprintf("Incorrectly formatted target template identifier %q.", r.GetTargetTemplateId())
+		case seen[r.GetTargetTemplateId()]:
+			badFields[globals.TargetRulesField] = fmt.Sprintf("Target template %q is used by more than one rule.", r.GetTargetTemplateId())
+		case r.GetFilter() == "":
+			badFields[globals.TargetRulesField] = "The filter of a rule must not be empty."
+		default:
+			if _, err := bexpr.CreateEvaluator(r.GetFilter()); err != nil {
+				badFields[globals.TargetRulesField] = fmt.Sprintf("Unable to parse filter %q: %v.", r.GetFilter(), err)
+			}
+		}
+		seen[r.GetTargetTemplateId()] = true
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateListRequest(ctx context.Context, req *pbs.ListHostCatalogsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) &&
@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/targettemplate"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
@@ -81,7 +82,10 @@ var authorizedCollectionActions = map[globals.Subtype]map[string]*structpb.ListV
 	},
 }
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var (
+	testAuthorizedActions       = []string{"no-op", "read", "update", "delete"}
+	testPluginAuthorizedActions = []string{"no-op", "read", "update", "delete", "rotate-secrets", "set-target-rules"}
+)
 
 func pluginCatalogToProto(hc *hostplugin.HostCatalog, plg *plugin.Plugin, project *iam.Scope) *pb.HostCatalog {
 	return &pb.HostCatalog{
@@ -99,7 +103,7 @@ func pluginCatalogToProto(hc *hostplugin.HostCatalog, plg *plugin.Plugin, projec
 		Version:                     1,
 		Type:                        hostplugin.Subtype.String(),
 		SecretsHmac:                 base58.Encode(hc.SecretsHmac),
-		AuthorizedActions:           testAuthorizedActions,
+		AuthorizedActions:           testPluginAuthorizedActions,
 		AuthorizedCollectionActions: authorizedCollectionActions[hostplugin.Subtype],
 	}
 }
@@ -1337,7 +1341,7 @@ func TestCreate_Plugin(t *testing.T) {
 					Name:                        &wrappers.StringValue{Value: "name"},
 					Description:                 &wrappers.StringValue{Value: "desc"},
 					Type:                        hostplugin.Subtype.String(),
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testPluginAuthorizedActions,
 					AuthorizedCollectionActions: authorizedCollectionActions[hostplugin.Subtype],
 				},
 			},
@@ -2212,3 +2216,312 @@ func TestUpdate_Plugin(t *testing.T) {
 		})
 	}
 }
+
+func TestRotateSecrets(t *testing.T) {
+	testCtx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	rw := db.New(conn)
+
+	// The plugin rejects secrets containing a "reject" key and mints a new
+	// key when it is handed back the secrets it persisted.
+	var gotNewSecrets *structpb.Struct
+	plg := plugin.TestPlugin(t, conn, "test")
+	plgm := map[string]plgpb.HostPluginServiceClient{
+		plg.GetPublicId(): loopback.NewWrappingPluginHostClient(&loopback.TestPluginHostServer{
+			OnCreateCatalogFn: func(_ context.Context, req *plgpb.OnCreateCatalogRequest) (*plgpb.OnCreateCatalogResponse, error) {
+				return &plgpb.OnCreateCatalogResponse{Persisted: &plgpb.HostCatalogPersisted{Secrets: req.GetCatalog().GetSecrets()}}, nil
+			},
+			OnUpdateCatalogFn: func(_ context.Context, req *plgpb.OnUpdateCatalogRequest) (*plgpb.OnUpdateCatalogResponse, error) {
+				secrets := req.GetNewCatalog().GetSecrets()
+				gotNewSecrets = secrets
+				if _, ok := secrets.GetFields()["reject"]; ok {
+					return nil, fmt.Errorf("invalid credentials")
+				}
+				if proto.Equal(secrets, req.GetPersisted().GetSecrets()) {
+					secrets = proto.Clone(secrets).(*structpb.Struct)
+					secrets.GetFields()["key"] = structpb.NewStringValue(secrets.GetFields()["key"].GetStringValue() + "-minted")
+				}
+				return &plgpb.OnUpdateCatalogResponse{Persisted: &plgpb.HostCatalogPersisted{Secrets: secrets}}, nil
+			},
+			OnDeleteCatalogFn: func(_ context.Context, _ *plgpb.OnDeleteCatalogRequest) (*plgpb.OnDeleteCatalogResponse, error) {
+				return &plgpb.OnDeleteCatalogResponse{}, nil
+			},
+		}),
+	}
+
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(testCtx, rw, rw, kms)
+	}
+	pluginHostRepo := func() (*hostplugin.Repository, error) {
+		return hostplugin.NewRepository(testCtx, rw, rw, kms, sche, plgm)
+	}
+	pluginRepo := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(testCtx, rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	catalogServiceFn := func() (*host.CatalogRepository, error) {
+		return host.NewCatalogRepository(testCtx, rw, rw)
+	}
+
+	tested, err := NewService(testCtx, repoFn, pluginHostRepo, pluginRepo, iamRepoFn, catalogServiceFn, 1000)
+	require.NoError(t, err, "Failed to create a new host catalog service.")
+
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId())
+
+	secrets := func(t *testing.T, m map[string]any) *structpb.Struct {
+		s, err := structpb.NewStruct(m)
+		require.NoError(t, err)
+		return s
+	}
+	createCatalog := func(t *testing.T, s *structpb.Struct) *pb.HostCatalog {
+		resp, err := tested.CreateHostCatalog(ctx, &pbs.CreateHostCatalogRequest{Item: &pb.HostCatalog{
+			ScopeId:  proj.GetPublicId(),
+			PluginId: plg.GetPublicId(),
+			Type:     hostplugin.Subtype.String(),
+			Secrets:  s,
+		}})
+		require.NoError(t, err)
+		return resp.GetItem()
+	}
+
+	t.Run("new secrets", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		hc := createCatalog(t, secrets(t, map[string]any{"key": "first"}))
+		newSecrets := secrets(t, map[string]any{"key": "second"})
+		got, err := tested.RotateHostCatalogSecrets(ctx, &pbs.RotateHostCatalogSecretsRequest{
+			Id:      hc.GetId(),
+			Version: hc.GetVersion(),
+			Secrets: newSecrets,
+		})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(newSecrets, gotNewSecrets, protocmp.Transform()))
+		assert.Equal(hc.GetVersion()+1, got.GetItem().GetVersion())
+		assert.NotEqual(hc.GetSecretsHmac(), got.GetItem().GetSecretsHmac())
+		assert.ElementsMatch(testPluginAuthorizedActions, got.GetItem().GetAuthorizedActions())
+		assert.Zero(got.GetAutomaticRotationIntervalSeconds())
+	})
+
+	t.Run("plugin rejects secrets", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		hc := createCatalog(t, secrets(t, map[string]any{"key": "first"}))
+		_, err := tested.RotateHostCatalogSecrets(ctx, &pbs.RotateHostCatalogSecretsRequest{
+			Id:      hc.GetId(),
+			Version: hc.GetVersion(),
+			Secrets: secrets(t, map[string]any{"reject": true}),
+		})
+		require.Error(err)
+		assert.Contains(err.Error(), "invalid credentials")
+
+		got, err := tested.GetHostCatalog(ctx, &pbs.GetHostCatalogRequest{Id: hc.GetId()})
+		require.NoError(err)
+		assert.Equal(hc.GetVersion(), got.GetItem().GetVersion())
+		assert.Equal(hc.GetSecretsHmac(), got.GetItem().GetSecretsHmac())
+	})
+
+	t.Run("stored secrets with schedule", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		stored := secrets(t, map[string]any{"key": "first"})
+		hc := createCatalog(t, stored)
+		got, err := tested.RotateHostCatalogSecrets(ctx, &pbs.RotateHostCatalogSecretsRequest{
+			Id:                               hc.GetId(),
+			Version:                          hc.GetVersion(),
+			AutomaticRotationIntervalSeconds: wrapperspb.UInt32(3600),
+		})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(stored, gotNewSecrets, protocmp.Transform()))
+		assert.NotEqual(hc.GetSecretsHmac(), got.GetItem().GetSecretsHmac())
+		assert.Equal(uint32(3600), got.GetAutomaticRotationIntervalSeconds())
+
+		// Leaving the interval unset keeps the schedule.
+		got, err = tested.RotateHostCatalogSecrets(ctx, &pbs.RotateHostCatalogSecretsRequest{
+			Id:      hc.GetId(),
+			Version: got.GetItem().GetVersion(),
+		})
+		require.NoError(err)
+		assert.Equal(uint32(3600), got.GetAutomaticRotationIntervalSeconds())
+
+		got, err = tested.RotateHostCatalogSecrets(ctx, &pbs.RotateHostCatalogSecretsRequest{
+			Id:                               hc.GetId(),
+			Version:                          got.GetItem().GetVersion(),
+			AutomaticRotationIntervalSeconds: wrapperspb.UInt32(0),
+		})
+		require.NoError(err)
+		assert.Zero(got.GetAutomaticRotationIntervalSeconds())
+	})
+
+	t.Run("no stored secrets", func(t *testing.T) {
+		hc := createCatalog(t, nil)
+		_, err := tested.RotateHostCatalogSecrets(ctx, &pbs.RotateHostCatalogSecretsRequest{
+			Id:      hc.GetId(),
+			Version: hc.GetVersion(),
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+	})
+
+	t.Run("wrong version", func(t *testing.T) {
+		hc := createCatalog(t, secrets(t, map[string]any{"key": "first"}))
+		_, err := tested.RotateHostCatalogSecrets(ctx, &pbs.RotateHostCatalogSecretsRequest{
+			Id:      hc.GetId(),
+			Version: hc.GetVersion() + 1,
+			Secrets: secrets(t, map[string]any{"key": "second"}),
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("static catalog", func(t *testing.T) {
+		hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+		_, err := tested.RotateHostCatalogSecrets(ctx, &pbs.RotateHostCatalogSecretsRequest{
+			Id:      hc.GetPublicId(),
+			Version: hc.GetVersion(),
+			Secrets: secrets(t, map[string]any{"key": "second"}),
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+	})
+}
+
+func TestSetTargetRules(t *testing.T) {
+	testCtx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	rw := db.New(conn)
+
+	plg := plugin.TestPlugin(t, conn, "test")
+	plgm := map[string]plgpb.HostPluginServiceClient{
+		plg.GetPublicId(): &loopback.WrappingPluginHostClient{Server: &loopback.TestPluginServer{}},
+	}
+
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(testCtx, rw, rw, kms)
+	}
+	pluginHostRepo := func() (*hostplugin.Repository, error) {
+		return hostplugin.NewRepository(testCtx, rw, rw, kms, sche, plgm)
+	}
+	pluginRepo := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(testCtx, rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	catalogServiceFn := func() (*host.CatalogRepository, error) {
+		return host.NewCatalogRepository(testCtx, rw, rw)
+	}
+
+	tested, err := NewService(testCtx, repoFn, pluginHostRepo, pluginRepo, iamRepoFn, catalogServiceFn, 1000)
+	require.NoError(t, err, "Failed to create a new host catalog service.")
+
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId())
+
+	tmpl := targettemplate.TestTargetTemplate(t, rw, proj.GetPublicId(), `{"type":"tcp","name":"{{.set_name}}"}`)
+	staticCat := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+
+	t.Run("set and clear", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		hc := hostplugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
+		rules := []*pb.TargetRule{
+			{TargetTemplateId: tmpl.GetPublicId(), Filter: `"/name" matches "web-.*"`},
+		}
+		got, err := tested.SetHostCatalogTargetRules(ctx, &pbs.SetHostCatalogTargetRulesRequest{
+			Id:          hc.GetPublicId(),
+			Version:     hc.GetVersion(),
+			TargetRules: rules,
+		})
+		require.NoError(err)
+		assert.Equal(hc.GetVersion()+1, got.GetItem().GetVersion())
+		assert.Empty(cmp.Diff(rules, got.GetItem().GetTargetRules(), protocmp.Transform()))
+		assert.ElementsMatch(testPluginAuthorizedActions, got.GetItem().GetAuthorizedActions())
+
+		read, err := tested.GetHostCatalog(ctx, &pbs.GetHostCatalogRequest{Id: hc.GetPublicId()})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(rules, read.GetItem().GetTargetRules(), protocmp.Transform()))
+
+		got, err = tested.SetHostCatalogTargetRules(ctx, &pbs.SetHostCatalogTargetRulesRequest{
+			Id:      hc.GetPublicId(),
+			Version: got.GetItem().GetVersion(),
+		})
+		require.NoError(err)
+		assert.Empty(got.GetItem().GetTargetRules())
+	})
+
+	t.Run("bad requests", func(t *testing.T) {
+		hc := hostplugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
+		cases := []struct {
+			name string
+			req  *pbs.SetHostCatalogTargetRulesRequest
+			err  error
+		}{
+			{
+				name: "static catalog",
+				req: &pbs.SetHostCatalogTargetRulesRequest{
+					Id:      staticCat.GetPublicId(),
+					Version: staticCat.GetVersion(),
+				},
+				err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name: "missing version",
+				req: &pbs.SetHostCatalogTargetRulesRequest{
+					Id: hc.GetPublicId(),
+				},
+				err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name: "bad template id",
+				req: &pbs.SetHostCatalogTargetRulesRequest{
+					Id:          hc.GetPublicId(),
+					Version:     hc.GetVersion(),
+					TargetRules: []*pb.TargetRule{{TargetTemplateId: "tcp_1234567890", Filter: `"/name" == "web"`}},
+				},
+				err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name: "bad filter",
+				req: &pbs.SetHostCatalogTargetRulesRequest{
+					Id:          hc.GetPublicId(),
+					Version:     hc.GetVersion(),
+					TargetRules: []*pb.TargetRule{{TargetTemplateId: tmpl.GetPublicId(), Filter: `"/name" ==`}},
+				},
+				err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name: "duplicate template",
+				req: &pbs.SetHostCatalogTargetRulesRequest{
+					Id:      hc.GetPublicId(),
+					Version: hc.GetVersion(),
+					TargetRules: []*pb.TargetRule{
+						{TargetTemplateId: tmpl.GetPublicId(), Filter: `"/name" == "web"`},
+						{TargetTemplateId: tmpl.GetPublicId(), Filter: `"/name" == "www"`},
+					},
+				},
+				err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name: "wrong version",
+				req: &pbs.SetHostCatalogTargetRulesRequest{
+					Id:      hc.GetPublicId(),
+					Version: hc.GetVersion() + 5,
+				},
+				err: handlers.ApiErrorWithCode(codes.NotFound),
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tested.SetHostCatalogTargetRules(ctx, tc.req)
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, tc.err), "SetHostCatalogTargetRules(%+v) got error %v, wanted %v", tc.req, err, tc.err)
+			})
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"text/template"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -106,6 +107,9 @@ func (s Service) ListHosts(ctx context.Context, req *pbs.ListHostsRequest) (*pbs
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if req.GetExactCount() {
+		ctx = pagination.WithExactCount(ctx)
+	}
 	_, authResults := s.parentAndAuthResult(ctx, req.GetHostCatalogId(), action.List)
 	if authResults.Error != nil {
 		return nil, authResults.Error
@@ -363,6 +367,48 @@ func (s Service) CreateHost(ctx context.Context, req *pbs.CreateHostRequest) (*p
 	}, nil
 }
 
+// CreateHosts implements the interface pbs.HostServiceServer.
+func (s Service) CreateHosts(ctx context.Context, req *pbs.CreateHostsRequest) (*pbs.CreateHostsResponse, error) {
+	const op = "hosts.(Service).CreateHosts"
+
+	if err := validateCreateHostsRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetHostCatalogId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	hs, err := s.createRangeInRepo(ctx, authResults.Scope.GetId(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	idActions := idActionsTypeMap[static.Subtype]
+	items := make([]*pb.Host, 0, len(hs))
+	for _, h := range hs {
+		outputOpts := make([]handlers.Option, 0, 3)
+		outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+		if outputFields.Has(globals.ScopeField) {
+			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+		}
+		if outputFields.Has(globals.AuthorizedActionsField) {
+			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, h.GetPublicId(), idActions).Strings()))
+		}
+		item, err := toProto(ctx, h, outputOpts...)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return &pbs.CreateHostsResponse{Items: items}, nil
+}
+
 // UpdateHost implements the interface pbs.HostServiceServer.
 func (s Service) UpdateHost(ctx context.Context, req *pbs.UpdateHostRequest) (*pbs.UpdateHostResponse, error) {
 	const op = "hosts.(Service).UpdateHost"
@@ -485,6 +531,57 @@ func (s Service) createInRepo(ctx context.Context, projectId, catalogId string,
 	return out, nil
 }
 
+// hostNameData is the data available to the name template of CreateHosts.
+type hostNameData struct {
+	Address string
+	Index   int
+}
+
+func (s Service) createRangeInRepo(ctx context.Context, projectId string, req *pbs.CreateHostsRequest) ([]*static.Host, error) {
+	const op = "hosts.(Service).createRangeInRepo"
+	addresses, err := static.ExpandAddressRange(ctx, req.GetAddressRange())
+	if err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Invalid fields provided in request.", map[string]string{globals.AddressRangeField: err.Error()})
+	}
+	var nameTmpl *template.Template
+	if req.GetNameTemplate() != "" {
+		nameTmpl, err = template.New("name").Option("missingkey=error").Parse(req.GetNameTemplate())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse name template"))
+		}
+	}
+
+	hs := make([]*static.Host, 0, len(addresses))
+	for i, addr := range addresses {
+		opts := []static.Option{static.WithAddress(addr)}
+		if nameTmpl != nil {
+			var name strings.Builder
+			if err := nameTmpl.Execute(&name, hostNameData{Address: addr, Index: i + 1}); err != nil {
+				return nil, handlers.InvalidArgumentErrorf("Invalid fields provided in request.", map[string]string{globals.NameTemplateField: fmt.Sprintf("Unable to render the template for address %q: %v.", addr, err)})
+			}
+			opts = append(opts, static.WithName(name.String()))
+		}
+		if req.GetDescription() != "" {
+			opts = append(opts, static.WithDescription(req.GetDescription()))
+		}
+		h, err := static.NewHost(ctx, req.GetHostCatalogId(), opts...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Unable to build host for creation"))
+		}
+		hs = append(hs, h)
+	}
+
+	repo, err := s.staticRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.CreateHosts(ctx, projectId, hs)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Unable to create hosts"))
+	}
+	return out, nil
+}
+
 func (s Service) updateInRepo(ctx context.Context, projectId, catalogId, id string, mask []string, item *pb.Host) (*static.Host, error) {
 	const op = "hosts.(Service).updateInRepo"
 	ha := item.GetStaticHostAttributes()
@@ -790,6 +887,34 @@ func validateCreateRequest(req *pbs.CreateHostRequest) error {
 	})
 }
 
+func validateCreateHostsRequest(ctx context.Context, req *pbs.CreateHostsRequest) error {
+	badFields := map[string]string{}
+	switch globals.ResourceInfoFromPrefix(req.GetHostCatalogId()).Subtype {
+	case static.Subtype:
+		if !handlers.ValidId(handlers.Id(req.GetHostCatalogId()), globals.StaticHostCatalogPrefix) {
+			badFields[globals.HostCatalogIdField] = "The field is incorrectly formatted."
+		}
+	case hostplugin.Subtype:
+		badFields[globals.HostCatalogIdField] = "Cannot manually create hosts for this type of catalog."
+	default:
+		badFields[globals.HostCatalogIdField] = "The field is incorrectly formatted."
+	}
+	if req.GetAddressRange() == "" {
+		badFields[globals.AddressRangeField] = "This is a required field."
+	} else if _, err := static.ExpandAddressRange(ctx, req.GetAddressRange()); err != nil {
+		badFields[globals.AddressRangeField] = fmt.Sprintf("Unable to expand the address range: %v.", err)
+	}
+	if req.GetNameTemplate() != "" {
+		if _, err := template.New("name").Parse(req.GetNameTemplate()); err != nil {
+			badFields[globals.NameTemplateField] = fmt.Sprintf("Unable to parse the template: %v.", err)
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
 func validateUpdateRequest(req *pbs.UpdateHostRequest) error {
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
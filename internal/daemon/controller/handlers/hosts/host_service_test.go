@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/hosts"
 	"github.com/hashicorp/boundary/internal/db"
+	bnderrors "github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	"github.com/hashicorp/boundary/internal/host"
@@ -1462,6 +1463,152 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestCreateHosts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	rw := db.New(conn)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	pluginRepoFn := func() (*hostplugin.Repository, error) {
+		return hostplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(ctx, rw, rw, kms)
+	}
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+
+	plg := plugin.TestPlugin(t, conn, "test")
+	pluginHc := hostplugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
+
+	s, err := hosts.NewService(ctx, repoFn, pluginRepoFn, 1000)
+	require.NoError(t, err, "Failed to create a new host service.")
+
+	cases := []struct {
+		name          string
+		req           *pbs.CreateHostsRequest
+		wantAddresses []string
+		wantNames     []string
+		err           error
+	}{
+		{
+			name: "CIDR block",
+			req: &pbs.CreateHostsRequest{
+				HostCatalogId: hc.GetPublicId(),
+				AddressRange:  "10.0.0.0/30",
+				Description:   "desc",
+			},
+			wantAddresses: []string{"10.0.0.1", "10.0.0.2"},
+		},
+		{
+			name: "Hostname range with name template",
+			req: &pbs.CreateHostsRequest{
+				HostCatalogId: hc.GetPublicId(),
+				AddressRange:  "web[01-03].corp",
+				NameTemplate:  "web-{{.Index}}-{{.Address}}",
+			},
+			wantAddresses: []string{"web01.corp", "web02.corp", "web03.corp"},
+			wantNames:     []string{"web-1-web01.corp", "web-2-web02.corp", "web-3-web03.corp"},
+		},
+		{
+			name: "Unknown template field",
+			req: &pbs.CreateHostsRequest{
+				HostCatalogId: hc.GetPublicId(),
+				AddressRange:  "db[1-2].corp",
+				NameTemplate:  "{{.Unknown}}",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Invalid template",
+			req: &pbs.CreateHostsRequest{
+				HostCatalogId: hc.GetPublicId(),
+				AddressRange:  "db[1-2].corp",
+				NameTemplate:  "{{.Index",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Missing address range",
+			req: &pbs.CreateHostsRequest{
+				HostCatalogId: hc.GetPublicId(),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Range too large",
+			req: &pbs.CreateHostsRequest{
+				HostCatalogId: hc.GetPublicId(),
+				AddressRange:  "10.0.0.0/16",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Plugin catalog",
+			req: &pbs.CreateHostsRequest{
+				HostCatalogId: pluginHc.GetPublicId(),
+				AddressRange:  "10.0.0.0/30",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Missing catalog",
+			req: &pbs.CreateHostsRequest{
+				AddressRange: "10.0.0.0/30",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.CreateHosts(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.Nil(got)
+				assert.True(errors.Is(gErr, tc.err), "CreateHosts(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			require.Len(got.GetItems(), len(tc.wantAddresses))
+			for i, item := range got.GetItems() {
+				assert.True(strings.HasPrefix(item.GetId(), globals.StaticHostPrefix))
+				assert.Equal(hc.GetPublicId(), item.GetHostCatalogId())
+				assert.Equal("static", item.GetType())
+				assert.Equal(tc.wantAddresses[i], item.GetStaticHostAttributes().GetAddress().GetValue())
+				if tc.wantNames != nil {
+					assert.Equal(tc.wantNames[i], item.GetName().GetValue())
+				} else {
+					assert.Nil(item.GetName())
+				}
+				assert.Equal(tc.req.GetDescription(), item.GetDescription().GetValue())
+				assert.Equal(uint32(1), item.GetVersion())
+				assert.ElementsMatch(testAuthorizedActions[static.Subtype], item.GetAuthorizedActions())
+			}
+		})
+	}
+
+	t.Run("Duplicate generated names", func(t *testing.T) {
+		assert := assert.New(t)
+		got, gErr := s.CreateHosts(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), &pbs.CreateHostsRequest{
+			HostCatalogId: hc.GetPublicId(),
+			AddressRange:  "db[1-2].corp",
+			NameTemplate:  "db",
+		})
+		assert.Nil(got)
+		assert.Truef(bnderrors.Match(bnderrors.T(bnderrors.NotUnique), gErr), "want err code: %v got err: %v", bnderrors.NotUnique, gErr)
+	})
+}
+
 func TestUpdate_Static(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/mr-tron/base58"
 	"google.golang.org/grpc/codes"
@@ -16,8 +17,19 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+type listTokenRepoKey struct{}
+
+// NewListTokenRepoContext returns a context that carries the factory of the
+// repository ParseListToken uses to reject revoked list tokens.
+func NewListTokenRepoContext(ctx context.Context, repoFn func() (*listtoken.Repository, error)) context.Context {
+	return context.WithValue(ctx, listTokenRepoKey{}, repoFn)
+}
+
 // ParseListToken parses a list token from the input, returning
-// an error if the parsing fails.
+// an error if the parsing fails or if the token is not valid
+// for the expected resource type and grants hash. If the context
+// carries a list token repository, the token is also rejected
+// if it was revoked.
 func ParseListToken(
 	ctx context.Context,
 	token string,
@@ -25,6 +37,34 @@ func ParseListToken(
 	expectedGrantsHash []byte,
 ) (*listtoken.Token, error) {
 	const op = "handlers.ParseListToken"
+	listToken, err := DecodeListToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := listToken.Validate(ctx, expectedResourceType, expectedGrantsHash); err != nil {
+		return nil, err
+	}
+	if repoFn, ok := ctx.Value(listTokenRepoKey{}).(func() (*listtoken.Repository, error)); ok && repoFn != nil {
+		repo, err := repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		revoked, err := repo.IsRevoked(ctx, listToken)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if revoked {
+			return nil, errors.New(ctx, errors.InvalidListToken, op, "list token was revoked")
+		}
+	}
+	return listToken, nil
+}
+
+// DecodeListToken decodes a list token from the input without
+// validating it against a resource type or grants hash, returning
+// an error if the decoding fails.
+func DecodeListToken(ctx context.Context, token string) (*listtoken.Token, error) {
+	const op = "handlers.DecodeListToken"
 	marshaled, err := base58.Decode(token)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -77,9 +117,7 @@ func ParseListToken(
 	default:
 		return nil, ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
 	}
-	if err := listToken.Validate(ctx, expectedResourceType, expectedGrantsHash); err != nil {
-		return nil, err
-	}
+	listToken.UserId = tok.GetUserId()
 	return listToken, nil
 }
 
@@ -98,6 +136,11 @@ func MarshalListToken(ctx context.Context, token *listtoken.Token, resourceType
 		CreateTime:   timestamppb.New(token.CreateTime),
 		ResourceType: resourceType,
 		GrantsHash:   token.GrantsHash,
+		UserId:       token.UserId,
+	}
+	if reqInfo, ok := requests.RequestContextFromCtx(ctx); ok && lt.UserId == "" {
+		// Tokens are issued to the user making the request.
+		lt.UserId = reqInfo.UserId
 	}
 	switch st := token.Subtype.(type) {
 	case *listtoken.PaginationToken:
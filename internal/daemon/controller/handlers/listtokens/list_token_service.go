@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package listtokens
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.NewActionSet()
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.NewActionSet(
+		action.Introspect,
+		action.Revoke,
+	)
+)
+
+func init() {
+	// TODO: refactor to remove IdActions and CollectionActions package variables
+	action.RegisterResource(resource.ListToken, IdActions, CollectionActions)
+}
+
+// Service handles requests for inspecting and revoking list tokens.
+type Service struct {
+	pbs.UnsafeListTokenServiceServer
+
+	repoFn    common.ListTokenRepoFactory
+	iamRepoFn common.IamRepoFactory
+}
+
+var _ pbs.ListTokenServiceServer = (*Service)(nil)
+
+// NewService returns a list token service which handles list token related
+// requests to boundary.
+func NewService(
+	ctx context.Context,
+	repoFn common.ListTokenRepoFactory,
+	iamRepoFn common.IamRepoFactory,
+) (Service, error) {
+	const op = "listtokens.NewService"
+	if repoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing list token repository")
+	}
+	if iamRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	}
+	return Service{
+		repoFn:    repoFn,
+		iamRepoFn: iamRepoFn,
+	}, nil
+}
+
+// IntrospectListToken implements the interface pbs.ListTokenServiceServer.
+func (s Service) IntrospectListToken(ctx context.Context, req *pbs.IntrospectListTokenRequest) (*pbs.IntrospectListTokenResponse, error) {
+	const op = "listtokens.(Service).IntrospectListToken"
+
+	if err := validateListTokenRequest(req.GetListToken()); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, action.Introspect)
+	if authResults.Error != nil {
+		return nil, errors.Wrap(ctx, authResults.Error, op)
+	}
+	tok, err := decodeListToken(ctx, req.GetListToken())
+	if err != nil {
+		return nil, err
+	}
+	grantsChanged, err := s.grantsChanged(ctx, tok)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	revoked, err := repo.IsRevoked(ctx, tok)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	return &pbs.IntrospectListTokenResponse{
+		CreatedTime:    timestamppb.New(tok.CreateTime),
+		ExpirationTime: timestamppb.New(tok.Expiration()),
+		ResourceType:   tok.ResourceType.String(),
+		Phase:          phase(tok),
+		GrantsChanged:  grantsChanged,
+		Expired:        time.Now().After(tok.Expiration()),
+		Revoked:        revoked,
+	}, nil
+}
+
+// RevokeListToken implements the interface pbs.ListTokenServiceServer.
+func (s Service) RevokeListToken(ctx context.Context, req *pbs.RevokeListTokenRequest) (*pbs.RevokeListTokenResponse, error) {
+	const op = "listtokens.(Service).RevokeListToken"
+
+	if err := validateListTokenRequest(req.GetListToken()); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, action.Revoke)
+	if authResults.Error != nil {
+		return nil, errors.Wrap(ctx, authResults.Error, op)
+	}
+	tok, err := decodeListToken(ctx, req.GetListToken())
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := repo.Revoke(ctx, tok); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.RevokeListTokenResponse{}, nil
+}
+
+// grantsChanged reports whether the current grants of the user the token was
+// issued to differ from the grants the token was issued with. It returns nil
+// if the token does not record the user it was issued to.
+func (s Service) grantsChanged(ctx context.Context, tok *listtoken.Token) (*bool, error) {
+	const op = "listtokens.(Service).grantsChanged"
+	if tok.UserId == "" {
+		return nil, nil
+	}
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	grants, err := iamRepo.GrantsForUser(ctx, tok.UserId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	grantsHash, err := grants.GrantHash(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	changed := !bytes.Equal(tok.GrantsHash, grantsHash)
+	return &changed, nil
+}
+
+func (s Service) authResult(ctx context.Context, a action.Type) auth.VerifyResults {
+	opts := []auth.Option{
+		auth.WithType(resource.ListToken),
+		auth.WithAction(a),
+		auth.WithScopeId(scope.Global.String()),
+	}
+	return auth.Verify(ctx, opts...)
+}
+
+func decodeListToken(ctx context.Context, token string) (*listtoken.Token, error) {
+	tok, err := handlers.DecodeListToken(ctx, token)
+	if err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"list_token": "Unable to decode list token."})
+	}
+	return tok, nil
+}
+
+func validateListTokenRequest(token string) error {
+	if token == "" {
+		return handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"list_token": "This field is required."})
+	}
+	return nil
+}
+
+// phase returns the name of the pagination phase the token belongs to.
+func phase(tok *listtoken.Token) string {
+	switch tok.Subtype.(type) {
+	case *listtoken.PaginationToken:
+		return "pagination"
+	case *listtoken.StartRefreshToken:
+		return "start-refresh"
+	case *listtoken.RefreshToken:
+		return "refresh"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package listtokens_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/listtokens"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntrospectListToken_GrantsChanged(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	listTokenRepoFn := func() (*listtoken.Repository, error) {
+		return listtoken.NewRepository(ctx, rw, rw)
+	}
+	s, err := listtokens.NewService(ctx, listTokenRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	// The owner of the token and the caller introspecting it have different
+	// grants, so the hash of the grants of the caller never matches the token.
+	owner := iam.TestUser(t, iamRepo, scope.Global.String())
+	ownerRole := iam.TestRole(t, conn, scope.Global.String())
+	iam.TestRoleGrant(t, conn, ownerRole.GetPublicId(), "ids=*;type=target;actions=list")
+	iam.TestUserRole(t, conn, ownerRole.GetPublicId(), owner.GetPublicId())
+	caller := iam.TestUser(t, iamRepo, scope.Global.String())
+	callerRole := iam.TestRole(t, conn, scope.Global.String())
+	iam.TestRoleGrant(t, conn, callerRole.GetPublicId(), "ids=*;type=*;actions=*")
+	iam.TestUserRole(t, conn, callerRole.GetPublicId(), caller.GetPublicId())
+
+	ownerGrants, err := iamRepo.GrantsForUser(ctx, owner.GetPublicId())
+	require.NoError(t, err)
+	ownerGrantsHash, err := ownerGrants.GrantHash(ctx)
+	require.NoError(t, err)
+
+	newToken := func(t *testing.T, userId string) string {
+		t.Helper()
+		tok, err := listtoken.NewPagination(ctx, time.Now(), resource.Target, ownerGrantsHash, "ttcp_1234567890", time.Now())
+		require.NoError(t, err)
+		tok.UserId = userId
+		marshaled, err := handlers.MarshalListToken(ctx, tok, pbs.ResourceType_RESOURCE_TYPE_TARGET)
+		require.NoError(t, err)
+		return marshaled
+	}
+	introspect := func(t *testing.T, token string) *pbs.IntrospectListTokenResponse {
+		t.Helper()
+		callerCtx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String(), auth.WithUserId(caller.GetPublicId()))
+		got, err := s.IntrospectListToken(callerCtx, &pbs.IntrospectListTokenRequest{ListToken: token})
+		require.NoError(t, err)
+		return got
+	}
+
+	ownerToken := newToken(t, owner.GetPublicId())
+	t.Run("owner-grants-unchanged", func(t *testing.T) {
+		got := introspect(t, ownerToken)
+		require.NotNil(t, got.GrantsChanged)
+		assert.False(t, got.GetGrantsChanged())
+	})
+	t.Run("unknown-owner", func(t *testing.T) {
+		got := introspect(t, newToken(t, ""))
+		assert.Nil(t, got.GrantsChanged)
+	})
+	t.Run("owner-grants-changed", func(t *testing.T) {
+		iam.TestRoleGrant(t, conn, ownerRole.GetPublicId(), "ids=*;type=host-catalog;actions=list")
+		got := introspect(t, ownerToken)
+		require.NotNil(t, got.GrantsChanged)
+		assert.True(t, got.GetGrantsChanged())
+	})
+}
+
+func TestRevokeListToken_RejectedByParse(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	listTokenRepoFn := func() (*listtoken.Repository, error) {
+		return listtoken.NewRepository(ctx, rw, rw)
+	}
+	s, err := listtokens.NewService(ctx, listTokenRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	grantsHash := []byte("some hash")
+	tok, err := listtoken.NewPagination(ctx, time.Now(), resource.Target, grantsHash, "ttcp_1234567890", time.Now())
+	require.NoError(t, err)
+	marshaled, err := handlers.MarshalListToken(ctx, tok, pbs.ResourceType_RESOURCE_TYPE_TARGET)
+	require.NoError(t, err)
+
+	listCtx := handlers.NewListTokenRepoContext(ctx, listTokenRepoFn)
+	_, err = handlers.ParseListToken(listCtx, marshaled, resource.Target, grantsHash)
+	require.NoError(t, err)
+
+	_, err = s.RevokeListToken(auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String()), &pbs.RevokeListTokenRequest{ListToken: marshaled})
+	require.NoError(t, err)
+
+	_, err = handlers.ParseListToken(listCtx, marshaled, resource.Target, grantsHash)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "list token was revoked")
+
+	// Without a list token repository in the context the revocation is not
+	// checked.
+	_, err = handlers.ParseListToken(ctx, marshaled, resource.Target, grantsHash)
+	require.NoError(t, err)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/maintenance"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/maintenance"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.NewActionSet()
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.NewActionSet(
+		action.Read,
+		action.SetReadOnly,
+	)
+)
+
+func init() {
+	// TODO: refactor to remove IdActions and CollectionActions package variables
+	action.RegisterResource(resource.Maintenance, IdActions, CollectionActions)
+}
+
+type Service struct {
+	pbs.UnsafeMaintenanceServiceServer
+
+	repoFn   common.MaintenanceRepoFactory
+	onChange func(*maintenance.Mode)
+}
+
+var _ pbs.MaintenanceServiceServer = (*Service)(nil)
+
+// NewService returns a maintenance service which reads and changes the
+// maintenance mode of the controllers. onChange, if not nil, is called with
+// the new mode every time it is changed through the service, so that the
+// controller handling the request applies it right away.
+func NewService(ctx context.Context, repoFn common.MaintenanceRepoFactory, onChange func(*maintenance.Mode)) (Service, error) {
+	const op = "maintenance.NewService"
+	if repoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing maintenance repository")
+	}
+	return Service{
+		repoFn:   repoFn,
+		onChange: onChange,
+	}, nil
+}
+
+// GetMaintenance implements the interface pbs.MaintenanceServiceServer.
+func (s Service) GetMaintenance(ctx context.Context, _ *pbs.GetMaintenanceRequest) (*pbs.GetMaintenanceResponse, error) {
+	const op = "maintenance.(Service).GetMaintenance"
+
+	authResults := s.authResult(ctx, action.Read)
+	if authResults.Error != nil {
+		return nil, errors.Wrap(ctx, authResults.Error, op)
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	m, err := repo.ReadMode(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.GetMaintenanceResponse{Item: toProto(m)}, nil
+}
+
+// SetReadOnly implements the interface pbs.MaintenanceServiceServer.
+func (s Service) SetReadOnly(ctx context.Context, req *pbs.SetReadOnlyRequest) (*pbs.SetReadOnlyResponse, error) {
+	const op = "maintenance.(Service).SetReadOnly"
+
+	if len(req.GetReason()) > maintenance.MaxReasonLength {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{
+			"reason": fmt.Sprintf("Must be at most %d characters long.", maintenance.MaxReasonLength),
+		})
+	}
+
+	authResults := s.authResult(ctx, action.SetReadOnly)
+	if authResults.Error != nil {
+		return nil, errors.Wrap(ctx, authResults.Error, op)
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	m, err := repo.SetReadOnly(ctx, req.GetReadOnly(), req.GetReason())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if s.onChange != nil {
+		s.onChange(m)
+	}
+	return &pbs.SetReadOnlyResponse{Item: toProto(m)}, nil
+}
+
+func (s Service) authResult(ctx context.Context, a action.Type) auth.VerifyResults {
+	opts := []auth.Option{
+		auth.WithType(resource.Maintenance),
+		auth.WithAction(a),
+		auth.WithScopeId(scope.Global.String()),
+	}
+	return auth.Verify(ctx, opts...)
+}
+
+func toProto(m *maintenance.Mode) *pb.Maintenance {
+	return &pb.Maintenance{
+		ReadOnly:    m.ReadOnly,
+		Reason:      m.Reason,
+		UpdatedTime: timestamppb.New(m.UpdateTime),
+	}
+}
+
+// ReadOnlyError returns the error returned for the requests rejected while
+// the read-only mode is enabled.
+func ReadOnlyError(m *maintenance.Mode) error {
+	msg := "The controller is in read-only maintenance mode; only requests that do not modify resources are allowed."
+	if m.Reason != "" {
+		msg = fmt.Sprintf("%s Reason: %s", msg, m.Reason)
+	}
+	return handlers.ApiErrorWithCodeAndMessage(codes.Unavailable, "%s", msg)
+}
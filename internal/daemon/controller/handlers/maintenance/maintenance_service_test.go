@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package maintenance_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	maintenanceservice "github.com/hashicorp/boundary/internal/daemon/controller/handlers/maintenance"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/maintenance"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetReadOnly(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	repoFn := func() (*maintenance.Repository, error) {
+		return maintenance.NewRepository(ctx, rw, rw)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrap), nil
+	}
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String(), auth.WithUserId(globals.AnyAuthenticatedUserId))
+
+	var changed *maintenance.Mode
+	s, err := maintenanceservice.NewService(ctx, repoFn, func(m *maintenance.Mode) {
+		changed = m
+	})
+	require.NoError(t, err)
+
+	got, err := s.GetMaintenance(requestCtx, &pbs.GetMaintenanceRequest{})
+	require.NoError(t, err)
+	assert.False(t, got.GetItem().GetReadOnly())
+
+	set, err := s.SetReadOnly(requestCtx, &pbs.SetReadOnlyRequest{ReadOnly: true, Reason: "database migration"})
+	require.NoError(t, err)
+	assert.True(t, set.GetItem().GetReadOnly())
+	assert.Equal(t, "database migration", set.GetItem().GetReason())
+	require.NotNil(t, changed)
+	assert.True(t, changed.ReadOnly)
+
+	got, err = s.GetMaintenance(requestCtx, &pbs.GetMaintenanceRequest{})
+	require.NoError(t, err)
+	assert.True(t, got.GetItem().GetReadOnly())
+	assert.Equal(t, "database migration", got.GetItem().GetReason())
+
+	set, err = s.SetReadOnly(requestCtx, &pbs.SetReadOnlyRequest{})
+	require.NoError(t, err)
+	assert.False(t, set.GetItem().GetReadOnly())
+	assert.Empty(t, set.GetItem().GetReason())
+	assert.False(t, changed.ReadOnly)
+
+	_, err = s.SetReadOnly(requestCtx, &pbs.SetReadOnlyRequest{ReadOnly: true, Reason: strings.Repeat("a", maintenance.MaxReasonLength+1)})
+	assert.ErrorIs(t, err, handlers.ApiErrorWithCode(codes.InvalidArgument))
+}
+
+func TestReadOnlyError(t *testing.T) {
+	err := maintenanceservice.ReadOnlyError(&maintenance.Mode{ReadOnly: true, Reason: "100% frozen"})
+	var apiErr *handlers.ApiError
+	require.ErrorAs(t, err, &apiErr)
+	assert.EqualValues(t, http.StatusServiceUnavailable, apiErr.Status)
+	assert.Contains(t, apiErr.Inner.GetMessage(), "read-only maintenance mode")
+	assert.Contains(t, apiErr.Inner.GetMessage(), "Reason: 100% frozen")
+}
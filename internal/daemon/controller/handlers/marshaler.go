@@ -8,6 +8,10 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// ProtobufMimeType is the content type clients can negotiate to send and
+// receive binary protobuf encoded messages instead of JSON.
+const ProtobufMimeType = "application/x-protobuf"
+
 // JSONMarshaler provides marshaler used for marshaling all proto as JSON
 // in a format expected by the user facing controller API.
 func JSONMarshaler() *runtime.JSONPb {
@@ -24,3 +28,25 @@ func JSONMarshaler() *runtime.JSONPb {
 		},
 	}
 }
+
+// ProtoMarshaler provides the marshaler used for marshaling proto in the binary
+// wire format for clients that negotiated the ProtobufMimeType.
+func ProtoMarshaler() runtime.Marshaler {
+	return &protoMarshaler{}
+}
+
+type protoMarshaler struct {
+	runtime.ProtoMarshaller
+}
+
+// ContentType returns ProtobufMimeType rather than the generic octet stream
+// content type used by the embedded marshaler.
+func (protoMarshaler) ContentType(_ any) string {
+	return ProtobufMimeType
+}
+
+// isProtoMarshaler reports whether the marshaler encodes the binary wire format.
+func isProtoMarshaler(m runtime.Marshaler) bool {
+	_, ok := m.(*protoMarshaler)
+	return ok
+}
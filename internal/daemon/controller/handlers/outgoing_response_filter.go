@@ -51,6 +51,8 @@ func OutgoingResponseFilter(ctx context.Context, w http.ResponseWriter, m proto.
 	const op = "handlers.OutgoingResponseFilter"
 
 	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		setDeprecationHeaders(w, md.HeaderMD)
+
 		// set http status codes based on metadata set by the grpc service
 		if statusCodes := md.HeaderMD.Get(StatusCodeHeader); len(statusCodes) > 0 {
 			defer func() {
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/annotation"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common/scopeids"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/authmethods"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/authtokens"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/credentialstores"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/environments"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/groups"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/host_catalogs"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/policies"
@@ -26,6 +28,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/storage_buckets"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targettemplates"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/users"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/workers"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -37,6 +40,7 @@ import (
 	"github.com/hashicorp/boundary/internal/pagination"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/scopequota"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -45,6 +49,7 @@ import (
 	wrappingKms "github.com/hashicorp/go-kms-wrapping/extras/kms/v2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -61,6 +66,8 @@ var (
 		action.Delete,
 		action.AttachStoragePolicy,
 		action.DetachStoragePolicy,
+		action.SetDeletionProtection,
+		action.ListDanglingReferences,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -104,6 +111,7 @@ var (
 
 		scope.Project.String(): {
 			resource.CredentialStore: credentialstores.CollectionActions,
+			resource.Environment:     environments.CollectionActions,
 			resource.Group:           groups.CollectionActions,
 			resource.HostCatalog:     host_catalogs.CollectionActions,
 			resource.Role:            roles.CollectionActions,
@@ -113,8 +121,9 @@ var (
 				action.ListScopeKeyVersionDestructionJobs,
 				action.DestroyScopeKeyVersion,
 			), // Only Scope key actions are allowed on the project level
-			resource.Session: sessions.CollectionActions,
-			resource.Target:  targets.CollectionActions,
+			resource.Session:        sessions.CollectionActions,
+			resource.Target:         targets.CollectionActions,
+			resource.TargetTemplate: targettemplates.CollectionActions,
 		},
 	}
 )
@@ -168,6 +177,9 @@ func (s *Service) ListScopes(ctx context.Context, req *pbs.ListScopesRequest) (*
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, err
 	}
+	if req.GetExactCount() {
+		ctx = pagination.WithExactCount(ctx)
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -355,6 +367,20 @@ func (s *Service) GetScope(ctx context.Context, req *pbs.GetScopeRequest) (*pbs.
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.RequestQuotaField) && p.GetType() == scope.Org.String() {
+		if q, ok := scopequota.FromContext(ctx); ok {
+			item.RequestQuota = requestQuotaToProto(q.Usage(p.GetPublicId()))
+		}
+	}
+	if outputFields.Has(globals.ProtectFromDeletionField) && p.GetType() != scope.Global.String() {
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, err
+		}
+		if item.ProtectFromDeletion, err = repo.LookupDeletionProtection(ctx, p.GetPublicId()); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.GetScopeResponse{Item: item}, nil
 }
@@ -625,6 +651,103 @@ func (s *Service) DetachStoragePolicy(ctx context.Context, req *pbs.DetachStorag
 	return nil, status.Errorf(codes.Unimplemented, "Policies are an Enterprise-only feature")
 }
 
+// ListDanglingReferences implements the interface pbs.ScopeServiceServer.
+func (s *Service) ListDanglingReferences(ctx context.Context, req *pbs.ListDanglingReferencesRequest) (*pbs.ListDanglingReferencesResponse, error) {
+	if err := validateListDanglingReferencesRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListDanglingReferences)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	refs, err := repo.ListDanglingReferences(ctx, req.GetId(), req.GetRecursive())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Scope %q doesn't exist.", req.GetId())
+		}
+		return nil, err
+	}
+
+	items := make([]*pb.DanglingReference, 0, len(refs))
+	for _, ref := range refs {
+		items = append(items, &pb.DanglingReference{
+			ResourceId:   ref.ResourceId,
+			ResourceType: ref.ResourceType.String(),
+			ScopeId:      ref.ScopeId,
+			Field:        ref.Field,
+			ReferencedId: ref.ReferencedId,
+			Detail:       ref.Detail,
+		})
+	}
+	return &pbs.ListDanglingReferencesResponse{Items: items}, nil
+}
+
+// SetScopeDeletionProtection implements the interface pbs.ScopeServiceServer.
+func (s *Service) SetScopeDeletionProtection(ctx context.Context, req *pbs.SetScopeDeletionProtectionRequest) (*pbs.SetScopeDeletionProtectionResponse, error) {
+	const op = "scopes.(Service).SetScopeDeletionProtection"
+
+	if err := validateSetDeletionProtectionRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.SetDeletionProtection)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	if err := repo.SetDeletionProtection(ctx, req.GetId(), req.GetProtectFromDeletion()); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set deletion protection"))
+	}
+	p, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, p.GetPublicId(), idActionsById(p.GetPublicId())).Strings()))
+	}
+	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+		scopeInfo := &pb.ScopeInfo{
+			Id:            p.GetPublicId(),
+			Type:          p.Type,
+			Name:          p.GetName(),
+			Description:   p.GetDescription(),
+			ParentScopeId: p.GetParentId(),
+		}
+		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, scopeCollectionTypeMapMap[p.Type], scopeInfo, "")
+		if err != nil {
+			return nil, err
+		}
+		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
+	}
+
+	item, err := ToProto(ctx, p, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if outputFields.Has(globals.ProtectFromDeletionField) {
+		item.ProtectFromDeletion = req.GetProtectFromDeletion()
+	}
+
+	return &pbs.SetScopeDeletionProtectionResponse{Item: item}, nil
+}
+
 func (s *Service) getFromRepo(ctx context.Context, id string) (*iam.Scope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -650,6 +773,9 @@ func (s *Service) createInRepo(ctx context.Context, authResults auth.VerifyResul
 	if item.GetDescription() != nil {
 		opts = append(opts, iam.WithDescription(item.GetDescription().GetValue()))
 	}
+	if len(item.GetAnnotations()) > 0 {
+		opts = append(opts, iam.WithAnnotations(item.GetAnnotations()))
+	}
 	opts = append(opts, iam.WithSkipAdminRoleCreation(req.GetSkipAdminRoleCreation()))
 	opts = append(opts, iam.WithSkipDefaultRoleCreation(req.GetSkipDefaultRoleCreation()))
 
@@ -698,6 +824,11 @@ func (s *Service) updateInRepo(ctx context.Context, parentScope *pb.ScopeInfo, s
 		scopePrimaryAuthMethodId = primaryAuthMethodId.GetValue()
 		opts = append(opts, iam.WithPrimaryAuthMethodId(scopePrimaryAuthMethodId))
 	}
+	var scopeAnnotations annotation.Annotations
+	if handlers.MaskContains(mask, globals.AnnotationsField) {
+		scopeAnnotations = item.GetAnnotations()
+		opts = append(opts, iam.WithAnnotations(scopeAnnotations))
+	}
 	version := item.GetVersion()
 
 	var iamScope *iam.Scope
@@ -712,6 +843,7 @@ func (s *Service) updateInRepo(ctx context.Context, parentScope *pb.ScopeInfo, s
 		iamScope.Description = scopeDesc
 		iamScope.Name = scopeName
 		iamScope.PrimaryAuthMethodId = scopePrimaryAuthMethodId
+		iamScope.Annotations = scopeAnnotations
 	case parentScope.GetType() == scope.Global.String():
 		iamScope, err = iam.NewOrg(ctx, opts...)
 	case parentScope.GetType() == scope.Org.String():
@@ -722,6 +854,11 @@ func (s *Service) updateInRepo(ctx context.Context, parentScope *pb.ScopeInfo, s
 	}
 	iamScope.PublicId = scopeId
 	dbMask := maskManager.Translate(mask)
+	// Annotations are not a part of the scope schema in the database, so they
+	// are not handled by the mask manager.
+	if handlers.MaskContains(mask, globals.AnnotationsField) {
+		dbMask = append(dbMask, "Annotations")
+	}
 	if len(dbMask) == 0 {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
@@ -853,10 +990,25 @@ func ToProto(ctx context.Context, in *iam.Scope, opt ...handlers.Option) (*pb.Sc
 	if outputFields.Has(globals.StoragePolicyIdField) {
 		out.StoragePolicyId = in.GetStoragePolicyId()
 	}
+	if outputFields.Has(globals.AnnotationsField) && len(in.Annotations) > 0 {
+		out.Annotations = in.Annotations
+	}
 
 	return &out, nil
 }
 
+func requestQuotaToProto(in *scopequota.Usage) *pb.RequestQuota {
+	if in == nil {
+		return nil
+	}
+	return &pb.RequestQuota{
+		Limit:     in.Limit,
+		Used:      in.Used,
+		Period:    durationpb.New(in.Period),
+		ResetTime: timestamppb.New(in.ResetTime),
+	}
+}
+
 func keyToProto(ctx context.Context, in wrappingKms.Key, opt ...handlers.Option) (*pb.Key, error) {
 	opts := handlers.GetOpts(opt...)
 	if opts.WithOutputFields == nil {
@@ -968,6 +1120,12 @@ func validateCreateRequest(req *pbs.CreateScopeRequest) error {
 				badFields["type"] = "Project scopes can only be created under an org scope."
 			}
 		}
+		if err := annotation.Annotations(item.GetAnnotations()).Validate(); err != nil {
+			badFields[globals.AnnotationsField] = fmt.Sprintf("Invalid annotations: %v.", err)
+		}
+		if item.GetProtectFromDeletion() {
+			badFields[globals.ProtectFromDeletionField] = "This is a read only field."
+		}
 		return badFields
 	})
 }
@@ -1044,6 +1202,12 @@ func validateUpdateRequest(req *pbs.UpdateScopeRequest) error {
 			item.GetDescription().Value = trimmed
 		}
 	}
+	if err := annotation.Annotations(item.GetAnnotations()).Validate(); err != nil {
+		badFields[globals.AnnotationsField] = fmt.Sprintf("Invalid annotations: %v.", err)
+	}
+	if item.GetProtectFromDeletion() {
+		badFields[globals.ProtectFromDeletionField] = "This is a read only field and cannot be specified in an update request."
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
 	}
@@ -1074,6 +1238,29 @@ func validateDeleteRequest(req *pbs.DeleteScopeRequest) error {
 	return nil
 }
 
+func validateSetDeletionProtectionRequest(req *pbs.SetScopeDeletionProtectionRequest) error {
+	badFields := map[string]string{}
+	id := req.GetId()
+	switch {
+	case id == scope.Global.String():
+		badFields["id"] = "Invalid to protect the global scope from deletion."
+	case strings.HasPrefix(id, scope.Org.Prefix()):
+		if !handlers.ValidId(handlers.Id(id), scope.Org.Prefix()) {
+			badFields["id"] = "Invalidly formatted scope id."
+		}
+	case strings.HasPrefix(id, scope.Project.Prefix()):
+		if !handlers.ValidId(handlers.Id(id), scope.Project.Prefix()) {
+			badFields["id"] = "Invalidly formatted scope id."
+		}
+	default:
+		badFields["id"] = "Invalidly formatted scope id."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
 func validateListRequest(ctx context.Context, req *pbs.ListScopesRequest) error {
 	badFields := map[string]string{}
 	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) {
@@ -1099,6 +1286,17 @@ func validateListKeysRequest(req *pbs.ListKeysRequest) error {
 	return nil
 }
 
+func validateListDanglingReferencesRequest(req *pbs.ListDanglingReferencesRequest) error {
+	badFields := map[string]string{}
+	if req.GetId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetId()), scope.Org.Prefix()) && !handlers.ValidId(handlers.Id(req.GetId()), scope.Project.Prefix()) {
+		badFields["id"] = "Must be 'global', a valid org scope id or a valid project scope id when listing dangling references."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
 func validateRotateKeysRequest(req *pbs.RotateKeysRequest) error {
 	badFields := map[string]string{}
 	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) {
@@ -1180,7 +1378,7 @@ func idActionsById(id string) action.ActionSet {
 	switch {
 	case id == scope.Global.String():
 		// Can't delete global so elide it
-		act = action.Difference(act, action.NewActionSet(action.Delete))
+		act = action.Difference(act, action.NewActionSet(action.Delete, action.SetDeletionProtection))
 
 	case strings.HasPrefix(id, fmt.Sprintf("%s_", scope.Project.Prefix())):
 		// Can't attach/detach storage policy to projects
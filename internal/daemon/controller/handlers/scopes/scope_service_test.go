@@ -47,9 +47,9 @@ import (
 )
 
 var (
-	testAuthorizedOrgActions    = []string{"no-op", "read", "update", "delete", "attach-storage-policy", "detach-storage-policy"}
-	testAuthorizedPrjActions    = []string{"no-op", "read", "update", "delete"}
-	testAuthorizedGlobalActions = []string{"no-op", "read", "update", "attach-storage-policy", "detach-storage-policy"}
+	testAuthorizedOrgActions    = []string{"no-op", "read", "update", "delete", "attach-storage-policy", "detach-storage-policy", "set-deletion-protection", "list-dangling-references"}
+	testAuthorizedPrjActions    = []string{"no-op", "read", "update", "delete", "set-deletion-protection", "list-dangling-references"}
+	testAuthorizedGlobalActions = []string{"no-op", "read", "update", "attach-storage-policy", "detach-storage-policy", "list-dangling-references"}
 )
 
 func createDefaultScopesRepoAndKms(t *testing.T) (*iam.Scope, *iam.Scope, func() (*iam.Repository, error), *kms.Kms) {
@@ -145,11 +145,15 @@ var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
 	},
 	"workers": {
 		Values: []*structpb.Value{
+			structpb.NewStringValue("create-activation-token"),
 			structpb.NewStringValue("create:controller-led"),
 			structpb.NewStringValue("create:worker-led"),
 			structpb.NewStringValue("list"),
+			structpb.NewStringValue("list-activation-tokens"),
+			structpb.NewStringValue("list-worker-utilization"),
 			structpb.NewStringValue("read-certificate-authority"),
 			structpb.NewStringValue("reinitialize-certificate-authority"),
+			structpb.NewStringValue("revoke-activation-token"),
 		},
 	},
 }
@@ -220,6 +224,12 @@ var projectAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("list"),
 		},
 	},
+	"environments": {
+		Values: []*structpb.Value{
+			structpb.NewStringValue("create"),
+			structpb.NewStringValue("list"),
+		},
+	},
 	"groups": {
 		Values: []*structpb.Value{
 			structpb.NewStringValue("create"),
@@ -251,6 +261,12 @@ var projectAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("rotate-keys"),
 		},
 	},
+	"target-templates": {
+		Values: []*structpb.Value{
+			structpb.NewStringValue("create"),
+			structpb.NewStringValue("list"),
+		},
+	},
 	"targets": {
 		Values: []*structpb.Value{
 			structpb.NewStringValue("create"),
@@ -1104,6 +1120,104 @@ func TestDelete_twice(t *testing.T) {
 	assert.True(errors.Is(gErr, handlers.ApiErrorWithCode(codes.NotFound)), "Expected not found for the second delete.")
 }
 
+func TestSetDeletionProtection(t *testing.T) {
+	org, proj, repoFn, kms := createDefaultScopesRepoAndKms(t)
+
+	s, err := scopes.NewServiceFn(context.Background(), repoFn, kms, 1000)
+	require.NoError(t, err, "Error when getting new scopes service")
+
+	t.Run("protect-and-unprotect", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ctx := auth.DisabledAuthTestContext(repoFn, org.GetPublicId())
+
+		got, err := s.SetScopeDeletionProtection(ctx, &pbs.SetScopeDeletionProtectionRequest{Id: proj.GetPublicId(), ProtectFromDeletion: true})
+		require.NoError(err)
+		assert.True(got.GetItem().GetProtectFromDeletion())
+
+		read, err := s.GetScope(ctx, &pbs.GetScopeRequest{Id: proj.GetPublicId()})
+		require.NoError(err)
+		assert.True(read.GetItem().GetProtectFromDeletion())
+
+		_, err = s.DeleteScope(ctx, &pbs.DeleteScopeRequest{Id: proj.GetPublicId()})
+		require.Error(err)
+		assert.Truef(errors.Match(errors.T(errors.Conflict), err), "unexpected error %v", err)
+
+		got, err = s.SetScopeDeletionProtection(ctx, &pbs.SetScopeDeletionProtectionRequest{Id: proj.GetPublicId()})
+		require.NoError(err)
+		assert.False(got.GetItem().GetProtectFromDeletion())
+
+		_, err = s.DeleteScope(ctx, &pbs.DeleteScopeRequest{Id: proj.GetPublicId()})
+		require.NoError(err)
+	})
+
+	t.Run("global", func(t *testing.T) {
+		assert := assert.New(t)
+		ctx := auth.DisabledAuthTestContext(repoFn, scope.Global.String())
+		_, err := s.SetScopeDeletionProtection(ctx, &pbs.SetScopeDeletionProtectionRequest{Id: scope.Global.String(), ProtectFromDeletion: true})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	t.Run("bad-id", func(t *testing.T) {
+		assert := assert.New(t)
+		ctx := auth.DisabledAuthTestContext(repoFn, scope.Global.String())
+		_, err := s.SetScopeDeletionProtection(ctx, &pbs.SetScopeDeletionProtectionRequest{Id: "bad_format", ProtectFromDeletion: true})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+}
+
+func TestListDanglingReferences(t *testing.T) {
+	ctx := context.Background()
+	org, proj, repoFn, kms := createDefaultScopesRepoAndKms(t)
+
+	s, err := scopes.NewServiceFn(ctx, repoFn, kms, 1000)
+	require.NoError(t, err, "Error when getting new scopes service")
+
+	repo, err := repoFn()
+	require.NoError(t, err)
+	role, err := iam.NewRole(ctx, proj.GetPublicId())
+	require.NoError(t, err)
+	role, _, _, _, err = repo.CreateRole(ctx, role)
+	require.NoError(t, err)
+	_, err = repo.AddRoleGrants(ctx, role.GetPublicId(), role.GetVersion(), []string{"ids=ttcp_1234567890;actions=read"})
+	require.NoError(t, err)
+
+	want := &pb.DanglingReference{
+		ResourceId:   role.GetPublicId(),
+		ResourceType: "role",
+		ScopeId:      proj.GetPublicId(),
+		Field:        "grants",
+		ReferencedId: "ttcp_1234567890",
+		Detail:       "ids=ttcp_1234567890;actions=read",
+	}
+
+	t.Run("scope", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		authCtx := auth.DisabledAuthTestContext(repoFn, proj.GetPublicId())
+		got, err := s.ListDanglingReferences(authCtx, &pbs.ListDanglingReferencesRequest{Id: proj.GetPublicId()})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(&pbs.ListDanglingReferencesResponse{Items: []*pb.DanglingReference{want}}, got, protocmp.Transform()))
+
+		got, err = s.ListDanglingReferences(authCtx, &pbs.ListDanglingReferencesRequest{Id: org.GetPublicId()})
+		require.NoError(err)
+		assert.Empty(got.GetItems())
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		authCtx := auth.DisabledAuthTestContext(repoFn, org.GetPublicId())
+		got, err := s.ListDanglingReferences(authCtx, &pbs.ListDanglingReferencesRequest{Id: org.GetPublicId(), Recursive: true})
+		require.NoError(err)
+		assert.Empty(cmp.Diff(&pbs.ListDanglingReferencesResponse{Items: []*pb.DanglingReference{want}}, got, protocmp.Transform()))
+	})
+
+	t.Run("bad-id", func(t *testing.T) {
+		assert := assert.New(t)
+		authCtx := auth.DisabledAuthTestContext(repoFn, scope.Global.String())
+		_, err := s.ListDanglingReferences(authCtx, &pbs.ListDanglingReferencesRequest{Id: "bad_format"})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+}
+
 func TestCreate(t *testing.T) {
 	ctx := context.Background()
 	defaultOrg, defaultProj, repoFn, kms := createDefaultScopesRepoAndKms(t)
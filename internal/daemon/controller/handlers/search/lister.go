@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/search"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Lister returns a page of the resources of one type in the scope with the
+// given id and the scopes beneath it. An empty list token requests the first
+// page.
+type Lister func(ctx context.Context, scopeId, listToken string) (*Page, error)
+
+// Page is a page of resources returned by a Lister.
+type Page struct {
+	Items     []Item
+	ListToken string
+	// Complete is true when there are no further pages.
+	Complete bool
+}
+
+// Item is a resource returned by the List method of a service.
+type Item interface {
+	proto.Message
+	GetId() string
+	GetScope() *scopes.ScopeInfo
+	GetName() *wrapperspb.StringValue
+	GetDescription() *wrapperspb.StringValue
+}
+
+// NewLister returns a Lister calling the List method of a service, such as
+// the ListTargets method of the target service. The request of the method
+// must have the scope_id, recursive and list_token fields, and its response
+// the items, list_token and response_type fields, like every paginated list
+// request of the API. The search is therefore subject to the same grants as
+// the List method.
+func NewLister[Req, Resp proto.Message](list func(context.Context, Req) (Resp, error)) Lister {
+	return func(ctx context.Context, scopeId, listToken string) (*Page, error) {
+		var zero Req
+		req := zero.ProtoReflect().New()
+		reqFields := req.Descriptor().Fields()
+		for name, v := range map[protoreflect.Name]protoreflect.Value{
+			"scope_id":   protoreflect.ValueOfString(scopeId),
+			"recursive":  protoreflect.ValueOfBool(true),
+			"list_token": protoreflect.ValueOfString(listToken),
+		} {
+			fd := reqFields.ByName(name)
+			if fd == nil {
+				return nil, fmt.Errorf("%s has no %s field", req.Descriptor().FullName(), name)
+			}
+			req.Set(fd, v)
+		}
+
+		resp, err := list(ctx, req.Interface().(Req))
+		if err != nil {
+			return nil, err
+		}
+
+		r := resp.ProtoReflect()
+		respFields := r.Descriptor().Fields()
+		itemsFd, tokenFd, typeFd := respFields.ByName("items"), respFields.ByName("list_token"), respFields.ByName("response_type")
+		if itemsFd == nil || tokenFd == nil || typeFd == nil {
+			return nil, fmt.Errorf("%s is not a paginated list response", r.Descriptor().FullName())
+		}
+		items := r.Get(itemsFd).List()
+		page := &Page{
+			Items:     make([]Item, 0, items.Len()),
+			ListToken: r.Get(tokenFd).String(),
+			Complete:  r.Get(typeFd).String() == "complete",
+		}
+		for i := 0; i < items.Len(); i++ {
+			item, ok := items.Get(i).Message().Interface().(Item)
+			if !ok {
+				return nil, fmt.Errorf("%s items cannot be searched", r.Descriptor().FullName())
+			}
+			page.Items = append(page.Items, item)
+		}
+		return page, nil
+	}
+}
+
+// itemWithType is implemented by the items of resource types with subtypes.
+type itemWithType interface {
+	GetType() string
+}
+
+// itemWithValue is implemented by aliases, which are searched by value too.
+type itemWithValue interface {
+	GetValue() string
+}
+
+// matchItem reports whether the id, name or description of the item, or the
+// value of an alias, contains the lowercased query.
+func matchItem(item Item, query string) bool {
+	fields := []string{
+		item.GetId(),
+		item.GetName().GetValue(),
+		item.GetDescription().GetValue(),
+	}
+	if v, ok := item.(itemWithValue); ok {
+		fields = append(fields, v.GetValue())
+	}
+	for _, f := range fields {
+		if strings.Contains(strings.ToLower(f), query) {
+			return true
+		}
+	}
+	return false
+}
+
+func toProto(typ resource.Type, item Item) *pb.SearchResult {
+	out := &pb.SearchResult{
+		Id:           item.GetId(),
+		ResourceType: typ.String(),
+		Scope:        item.GetScope(),
+		Name:         item.GetName().GetValue(),
+		Description:  item.GetDescription().GetValue(),
+	}
+	if t, ok := item.(itemWithType); ok {
+		out.Type = t.GetType()
+	}
+	return out
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/search"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// DefaultLimit is the number of results returned when the request does
+	// not set a limit.
+	DefaultLimit = 100
+
+	// MaxLimit is the largest number of results a request can ask for.
+	MaxLimit = 1000
+)
+
+var (
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.NewActionSet()
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.NewActionSet(
+		action.List,
+	)
+)
+
+func init() {
+	// TODO: refactor to remove IdActions and CollectionActions package variables
+	action.RegisterResource(resource.Search, IdActions, CollectionActions)
+}
+
+type Service struct {
+	pbs.UnsafeSearchServiceServer
+
+	listers map[resource.Type]Lister
+}
+
+var _ pbs.SearchServiceServer = (*Service)(nil)
+
+// NewService returns a search service which searches the resource types of
+// the given listers.
+func NewService(ctx context.Context, listers map[resource.Type]Lister) (Service, error) {
+	const op = "search.NewService"
+	if len(listers) == 0 {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing listers")
+	}
+	return Service{listers: listers}, nil
+}
+
+// Search implements the interface pbs.SearchServiceServer.
+func (s Service) Search(ctx context.Context, req *pbs.SearchRequest) (*pbs.SearchResponse, error) {
+	const op = "search.(Service).Search"
+
+	if req.GetScopeId() == "" {
+		req.ScopeId = scope.Global.String()
+	}
+	if err := s.validateSearchRequest(req); err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		return nil, errors.Wrap(ctx, authResults.Error, op)
+	}
+
+	types := s.searchTypes(req.GetResourceTypes())
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	query := strings.ToLower(req.GetQuery())
+
+	resp := &pbs.SearchResponse{}
+	for _, typ := range types {
+		results, err := s.searchType(ctx, typ, req.GetScopeId(), query, limit+1-len(resp.Items))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to search %s resources", typ)))
+		}
+		resp.Items = append(resp.Items, results...)
+		if len(resp.Items) > limit {
+			resp.Items = resp.Items[:limit]
+			resp.Truncated = true
+			break
+		}
+	}
+	return resp, nil
+}
+
+// searchType returns up to limit resources of the given type in the scope
+// and the scopes beneath it which match the query. Types the caller is not
+// allowed to list yield no results.
+func (s Service) searchType(ctx context.Context, typ resource.Type, scopeId, query string, limit int) ([]*pb.SearchResult, error) {
+	list := s.listers[typ]
+	var results []*pb.SearchResult
+	var listToken string
+	for {
+		page, err := list(ctx, scopeId, listToken)
+		if err != nil {
+			if errors.Is(err, handlers.ApiErrorWithCode(codes.PermissionDenied)) ||
+				errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		for _, item := range page.Items {
+			if !matchItem(item, query) {
+				continue
+			}
+			results = append(results, toProto(typ, item))
+			if len(results) == limit {
+				return results, nil
+			}
+		}
+		if page.Complete || page.ListToken == "" {
+			return results, nil
+		}
+		listToken = page.ListToken
+	}
+}
+
+// searchTypes returns the types to search, in a stable order: the requested
+// ones, or all of them when none are requested.
+func (s Service) searchTypes(requested []string) []resource.Type {
+	var types []resource.Type
+	if len(requested) == 0 {
+		for typ := range s.listers {
+			types = append(types, typ)
+		}
+	} else {
+		for _, r := range requested {
+			typ := resource.Map[r]
+			if !slices.Contains(types, typ) {
+				types = append(types, typ)
+			}
+		}
+	}
+	slices.SortFunc(types, func(a, b resource.Type) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return types
+}
+
+func (s Service) authResult(ctx context.Context, scopeId string, a action.Type) auth.VerifyResults {
+	opts := []auth.Option{
+		auth.WithType(resource.Search),
+		auth.WithAction(a),
+		auth.WithScopeId(scopeId),
+	}
+	return auth.Verify(ctx, opts...)
+}
+
+func (s Service) validateSearchRequest(req *pbs.SearchRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() &&
+		!handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) &&
+		!handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) {
+		badFields["scope_id"] = "Must be 'global', a valid org scope id or a valid project scope id when searching."
+	}
+	if strings.TrimSpace(req.GetQuery()) == "" {
+		badFields["query"] = "This is a required field."
+	}
+	for _, r := range req.GetResourceTypes() {
+		typ, ok := resource.Map[r]
+		if _, searchable := s.listers[typ]; !ok || !searchable {
+			badFields["resource_types"] = fmt.Sprintf("%q is not a searchable resource type.", r)
+			break
+		}
+	}
+	if req.GetLimit() > MaxLimit {
+		badFields["limit"] = fmt.Sprintf("Must be at most %d.", MaxLimit)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
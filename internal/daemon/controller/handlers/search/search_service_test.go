@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package search_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/groups"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/roles"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/search"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pbgroups "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/groups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestNewLister(t *testing.T) {
+	ctx := context.Background()
+	var got []*pbs.ListGroupsRequest
+	list := search.NewLister(func(_ context.Context, req *pbs.ListGroupsRequest) (*pbs.ListGroupsResponse, error) {
+		got = append(got, req)
+		if req.GetListToken() == "" {
+			return &pbs.ListGroupsResponse{
+				Items:        []*pbgroups.Group{{Id: "g_1234567890", Name: wrapperspb.String("first")}},
+				ResponseType: "delta",
+				ListToken:    "token",
+			}, nil
+		}
+		return &pbs.ListGroupsResponse{
+			Items:        []*pbgroups.Group{{Id: "g_0987654321"}},
+			ResponseType: "complete",
+			ListToken:    "token2",
+		}, nil
+	})
+
+	page, err := list(ctx, "o_1234567890", "")
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "g_1234567890", page.Items[0].GetId())
+	assert.Equal(t, "first", page.Items[0].GetName().GetValue())
+	assert.Equal(t, "token", page.ListToken)
+	assert.False(t, page.Complete)
+
+	page, err = list(ctx, "o_1234567890", page.ListToken)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.True(t, page.Complete)
+
+	require.Len(t, got, 2)
+	for _, req := range got {
+		assert.Equal(t, "o_1234567890", req.GetScopeId())
+		assert.True(t, req.GetRecursive())
+	}
+	assert.Equal(t, "token", got[1].GetListToken())
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	gs, err := groups.NewService(ctx, iamRepoFn, 1000)
+	require.NoError(t, err)
+	rs, err := roles.NewService(ctx, iamRepoFn, 1000)
+	require.NoError(t, err)
+
+	_, err = search.NewService(ctx, nil)
+	require.Error(t, err)
+
+	s, err := search.NewService(ctx, map[resource.Type]search.Lister{
+		resource.Group: search.NewLister(gs.ListGroups),
+		resource.Role:  search.NewLister(rs.ListRoles),
+	})
+	require.NoError(t, err)
+
+	org, proj := iam.TestScopes(t, iamRepo)
+	orgGroup := iam.TestGroup(t, conn, org.GetPublicId(), iam.WithName("Payments admins"))
+	projGroup := iam.TestGroup(t, conn, proj.GetPublicId(), iam.WithDescription("payments operators"))
+	iam.TestGroup(t, conn, proj.GetPublicId(), iam.WithName("billing"))
+	role := iam.TestRole(t, conn, org.GetPublicId(), iam.WithName("payments auditors"))
+
+	requestCtx := auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId())
+
+	t.Run("all-types", func(t *testing.T) {
+		got, err := s.Search(requestCtx, &pbs.SearchRequest{ScopeId: org.GetPublicId(), Query: "PAYMENTS"})
+		require.NoError(t, err)
+		var ids []string
+		for _, r := range got.GetItems() {
+			ids = append(ids, r.GetId())
+		}
+		assert.ElementsMatch(t, []string{orgGroup.GetPublicId(), projGroup.GetPublicId(), role.GetPublicId()}, ids)
+		assert.False(t, got.GetTruncated())
+		// Groups are searched before roles.
+		assert.Equal(t, resource.Group.String(), got.GetItems()[0].GetResourceType())
+		assert.Equal(t, resource.Role.String(), got.GetItems()[2].GetResourceType())
+		assert.Equal(t, "payments auditors", got.GetItems()[2].GetName())
+		assert.Equal(t, org.GetPublicId(), got.GetItems()[2].GetScope().GetId())
+	})
+
+	t.Run("resource-types", func(t *testing.T) {
+		got, err := s.Search(requestCtx, &pbs.SearchRequest{
+			ScopeId:       org.GetPublicId(),
+			Query:         "payments",
+			ResourceTypes: []string{resource.Role.String()},
+		})
+		require.NoError(t, err)
+		require.Len(t, got.GetItems(), 1)
+		assert.Equal(t, role.GetPublicId(), got.GetItems()[0].GetId())
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		got, err := s.Search(requestCtx, &pbs.SearchRequest{ScopeId: org.GetPublicId(), Query: "payments", Limit: 2})
+		require.NoError(t, err)
+		assert.Len(t, got.GetItems(), 2)
+		assert.True(t, got.GetTruncated())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, req := range map[string]*pbs.SearchRequest{
+			"missing-query":     {ScopeId: org.GetPublicId()},
+			"bad-scope":         {ScopeId: "bad_scope", Query: "payments"},
+			"unsearchable-type": {Query: "payments", ResourceTypes: []string{resource.Session.String()}},
+			"limit":             {Query: "payments", Limit: search.MaxLimit + 1},
+		} {
+			_, err := s.Search(requestCtx, req)
+			assert.ErrorIs(t, err, handlers.ApiErrorWithCode(codes.InvalidArgument), name)
+		}
+	})
+
+	t.Run("global-default", func(t *testing.T) {
+		globalCtx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String())
+		got, err := s.Search(globalCtx, &pbs.SearchRequest{Query: "billing"})
+		require.NoError(t, err)
+		require.Len(t, got.GetItems(), 1)
+		assert.Equal(t, proj.GetPublicId(), got.GetItems()[0].GetScope().GetId())
+	})
+}
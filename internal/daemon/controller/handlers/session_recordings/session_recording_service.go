@@ -26,6 +26,7 @@ var (
 		action.Download,
 		action.Delete,
 		action.ReApplyStoragePolicy,
+		action.Repair,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -82,3 +83,8 @@ func (s Service) ReApplyStoragePolicy(context.Context, *pbs.ReApplyStoragePolicy
 func (s Service) Delete(*pbs.DownloadRequest, *pbs.DeleteSessionRecordingRequest) error {
 	return status.Errorf(codes.Unimplemented, "session recordings are an Enterprise-only feature")
 }
+
+// RepairSessionRecording implements the interface pbs.SessionRecordingServiceServer.
+func (s Service) RepairSessionRecording(context.Context, *pbs.RepairSessionRecordingRequest) (*pbs.RepairSessionRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "session recordings are an Enterprise-only feature")
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -138,6 +139,9 @@ func (s Service) ListSessions(ctx context.Context, req *pbs.ListSessionsRequest)
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if req.GetExactCount() {
+		ctx = pagination.WithExactCount(ctx)
+	}
 
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List, false)
 	if authResults.Error != nil {
@@ -366,6 +370,137 @@ func (s Service) CancelSession(ctx context.Context, req *pbs.CancelSessionReques
 	return &pbs.CancelSessionResponse{Item: item}, nil
 }
 
+// CancelSessions implements the interface pbs.SessionServiceServer.
+func (s Service) CancelSessions(ctx context.Context, req *pbs.CancelSessionsRequest) (*pbs.CancelSessionsResponse, error) {
+	const op = "sessions.(Service).CancelSessions"
+
+	if err := validateCancelSessionsRequest(ctx, req); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List, false)
+	if authResults.Error != nil {
+		// As when listing, a recursive request may still be authorized on
+		// downstream scopes.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, errors.Wrap(ctx, authResults.Error, op)
+		}
+	}
+
+	var scopeIds map[string]*scopes.ScopeInfo
+	var err error
+	if !req.GetRecursive() {
+		scopeIds = map[string]*scopes.ScopeInfo{authResults.Scope.Id: authResults.Scope}
+	} else {
+		scopeIds, err = authResults.ScopesAuthorizedForList(ctx, req.GetScopeId(), resource.Session)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var filter *handlers.Filter
+	if req.GetFilter() != "" {
+		filter, err = handlers.NewFilter(ctx, req.GetFilter())
+		if err != nil {
+			return nil, err
+		}
+	}
+	createdBefore := time.Now().Add(-time.Duration(req.GetMinAgeSeconds()) * time.Second)
+	filterItemFn := func(ctx context.Context, item *session.Session) (bool, error) {
+		switch {
+		case req.GetUserId() != "" && item.UserId != req.GetUserId(),
+			req.GetTargetId() != "" && item.TargetId != req.GetTargetId(),
+			req.GetMinAgeSeconds() > 0 && !item.CreateTime.AsTime().Before(createdBefore):
+			return false, nil
+		}
+		if filter == nil {
+			return true, nil
+		}
+		outputOpts, ok := newOutputOpts(ctx, item, scopeIds, authResults)
+		if !ok {
+			return false, nil
+		}
+		pbItem, err := toProto(ctx, item, outputOpts...)
+		if err != nil {
+			return false, err
+		}
+		return filter.Match(pbItem), nil
+	}
+
+	grantsHash, err := authResults.GrantsHash(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	listPerms := authResults.ACL().ListPermissions(scopeIds, resource.Session, IdActions, authResults.UserId)
+
+	repo, err := s.repoFn(session.WithPermissions(&perms.UserPermissions{
+		UserId:      authResults.UserId,
+		Permissions: listPerms,
+	}))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	// Page through the listing until every matching session is gathered.
+	var matched []*session.Session
+	listResp, err := session.List(ctx, grantsHash, int(s.maxPageSize), filterItemFn, repo, false)
+	if err != nil {
+		return nil, err
+	}
+	matched = append(matched, listResp.Items...)
+	for !listResp.CompleteListing {
+		listResp, err = session.ListPage(ctx, grantsHash, int(s.maxPageSize), filterItemFn, listResp.ListToken, repo, false)
+		if err != nil {
+			return nil, err
+		}
+		matched = append(matched, listResp.Items...)
+	}
+
+	results := make([]*pb.SessionCancelResult, 0, len(matched))
+	for _, item := range matched {
+		result := &pb.SessionCancelResult{
+			SessionId: item.GetPublicId(),
+			ScopeId:   item.ProjectId,
+			UserId:    item.UserId,
+			TargetId:  item.TargetId,
+		}
+		results = append(results, result)
+
+		res := perms.Resource{
+			Type:          resource.Session,
+			Id:            item.GetPublicId(),
+			ScopeId:       item.GetProjectId(),
+			ParentScopeId: scopeIds[item.ProjectId].GetParentScopeId(),
+		}
+		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res))
+		if !authorizedActions.HasAction(action.Cancel) &&
+			!(item.UserId == authResults.UserId && authorizedActions.HasAction(action.CancelSelf)) {
+			result.Error = "Forbidden."
+			continue
+		}
+
+		var skipCancel bool
+		for _, state := range item.States {
+			if state.Status == session.StatusCanceling {
+				skipCancel = true
+			}
+		}
+		if !skipCancel {
+			// Ignore decryption failures to ensure the user can always cancel a session.
+			if _, err := repo.CancelSession(ctx, item.GetPublicId(), item.Version, session.WithIgnoreDecryptionFailures(true)); err != nil {
+				result.Error = fmt.Sprintf("Unable to cancel session: %v", err)
+				continue
+			}
+		}
+		result.Canceled = true
+	}
+	return &pbs.CancelSessionsResponse{Items: results}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -481,12 +616,18 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 	if outputFields.Has(globals.ExpirationTimeField) {
 		out.ExpirationTime = in.ExpirationTime.GetTimestamp()
 	}
+	if outputFields.Has(globals.AuthorizationExpirationTimeField) && in.AuthorizationExpirationTime != nil {
+		out.AuthorizationExpirationTime = in.AuthorizationExpirationTime.GetTimestamp()
+	}
 	if outputFields.Has(globals.CertificateField) {
 		out.Certificate = in.Certificate
 	}
 	if outputFields.Has(globals.TerminationReasonField) {
 		out.TerminationReason = in.TerminationReason
 	}
+	if outputFields.Has(globals.RecordingSkipReasonField) {
+		out.RecordingSkipReason = in.RecordingSkipReason
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -573,6 +714,27 @@ func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	return nil
 }
 
+func validateCancelSessionsRequest(ctx context.Context, req *pbs.CancelSessionsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) &&
+		!req.GetRecursive() {
+		badFields["scope_id"] = "This field must be a valid project scope ID or the cancel operation must be recursive."
+	}
+	if req.GetUserId() != "" && !handlers.ValidId(handlers.Id(req.GetUserId()), globals.UserPrefix) {
+		badFields["user_id"] = "Improperly formatted identifier."
+	}
+	if req.GetTargetId() != "" && globals.ResourceInfoFromPrefix(req.GetTargetId()).Type != resource.Target {
+		badFields["target_id"] = "Improperly formatted identifier."
+	}
+	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
 func newOutputOpts(ctx context.Context, item *session.Session, scopeIds map[string]*scopes.ScopeInfo, authResults auth.VerifyResults) ([]handlers.Option, bool) {
 	res := perms.Resource{
 		Type:          resource.Session,
@@ -1294,3 +1294,144 @@ func TestCancel(t *testing.T) {
 		})
 	}
 }
+
+func TestCancelSessions(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+
+	ctx := context.Background()
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func(opt ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kms, opt...)
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
+
+	o, p := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	uId := at.GetIamUserId()
+	hc := static.TestCatalogs(t, conn, p.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar1 := tcp.TestTarget(ctx, t, conn, p.GetPublicId(), "test1", target.WithHostSources([]string{hs.GetPublicId()}))
+	tar2 := tcp.TestTarget(ctx, t, conn, p.GetPublicId(), "test2", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	newSession := func(tar target.Target) *session.Session {
+		return session.TestSession(t, conn, wrap, session.ComposedOf{
+			UserId:      uId,
+			HostId:      h.GetPublicId(),
+			TargetId:    tar.GetPublicId(),
+			HostSetId:   hs.GetPublicId(),
+			AuthTokenId: at.GetPublicId(),
+			ProjectId:   p.GetPublicId(),
+			Endpoint:    "tcp://127.0.0.1:22",
+		})
+	}
+	tar1Sessions := []*session.Session{newSession(tar1), newSession(tar1)}
+	tar2Session := newSession(tar2)
+
+	wantResults := func(sessions ...*session.Session) []*pb.SessionCancelResult {
+		var res []*pb.SessionCancelResult
+		for _, sess := range sessions {
+			res = append(res, &pb.SessionCancelResult{
+				SessionId: sess.GetPublicId(),
+				ScopeId:   p.GetPublicId(),
+				UserId:    uId,
+				TargetId:  sess.TargetId,
+				Canceled:  true,
+			})
+		}
+		return res
+	}
+
+	cases := []struct {
+		name string
+		req  *pbs.CancelSessionsRequest
+		res  *pbs.CancelSessionsResponse
+		err  error
+	}{
+		{
+			name: "Too young",
+			req:  &pbs.CancelSessionsRequest{ScopeId: p.GetPublicId(), MinAgeSeconds: 3600},
+			res:  &pbs.CancelSessionsResponse{Items: []*pb.SessionCancelResult{}},
+		},
+		{
+			name: "Filter not matching",
+			req:  &pbs.CancelSessionsRequest{ScopeId: p.GetPublicId(), Filter: `"/item/endpoint" == "tcp://127.0.0.1:2222"`},
+			res:  &pbs.CancelSessionsResponse{Items: []*pb.SessionCancelResult{}},
+		},
+		{
+			name: "Cancel by target",
+			req:  &pbs.CancelSessionsRequest{ScopeId: p.GetPublicId(), TargetId: tar1.GetPublicId()},
+			res:  &pbs.CancelSessionsResponse{Items: wantResults(tar1Sessions...)},
+		},
+		{
+			name: "Already canceling",
+			req:  &pbs.CancelSessionsRequest{ScopeId: p.GetPublicId(), TargetId: tar1.GetPublicId()},
+			res:  &pbs.CancelSessionsResponse{Items: wantResults(tar1Sessions...)},
+		},
+		{
+			name: "Cancel by user recursively",
+			req:  &pbs.CancelSessionsRequest{ScopeId: "global", Recursive: true, UserId: uId},
+			res:  &pbs.CancelSessionsResponse{Items: wantResults(append([]*session.Session{tar2Session}, tar1Sessions...)...)},
+		},
+		{
+			name: "Project scope required",
+			req:  &pbs.CancelSessionsRequest{ScopeId: o.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Bad target id",
+			req:  &pbs.CancelSessionsRequest{ScopeId: p.GetPublicId(), TargetId: uId},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Bad filter",
+			req:  &pbs.CancelSessionsRequest{ScopeId: p.GetPublicId(), Filter: `"/item/endpoint" ==`},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, 1000)
+			require.NoError(err, "Couldn't create new session service.")
+
+			requestInfo := authpb.RequestInfo{
+				TokenFormat: uint32(auth.AuthTokenTypeBearer),
+				PublicId:    at.GetPublicId(),
+				Token:       at.GetToken(),
+			}
+			requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+			ctx := auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
+			got, gErr := s.CancelSessions(ctx, tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CancelSessions(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(
+				got,
+				tc.res,
+				protocmp.Transform(),
+				cmpopts.SortSlices(func(a, b *pb.SessionCancelResult) bool {
+					return a.GetSessionId() < b.GetSessionId()
+				}),
+			), "CancelSessions(%q) got response\n%q, wanted\n%q", tc.req, got, tc.res)
+		})
+	}
+}
@@ -23,6 +23,7 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.SetDeletionProtection,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -79,3 +80,8 @@ func (s Service) UpdateStorageBucket(ctx context.Context, req *pbs.UpdateStorage
 func (s Service) DeleteStorageBucket(ctx context.Context, req *pbs.DeleteStorageBucketRequest) (*pbs.DeleteStorageBucketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "storage buckets are an Enterprise-only feature")
 }
+
+// SetStorageBucketDeletionProtection implements the interface pbs.StorageBucketServiceServer.
+func (s Service) SetStorageBucketDeletionProtection(ctx context.Context, req *pbs.SetStorageBucketDeletionProtectionRequest) (*pbs.SetStorageBucketDeletionProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "storage buckets are an Enterprise-only feature")
+}
@@ -19,6 +19,7 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // dynamicToWorkerCredential converts the strongly typed credential.Dynamic into
@@ -132,6 +133,21 @@ func dynamicToSessionCredential(ctx context.Context, cred credential.Dynamic) (*
 		}
 	}
 
+	sessSecret := &pb.SessionSecret{
+		Raw:     base64.StdEncoding.EncodeToString(jSecret),
+		Decoded: sSecret,
+	}
+	if vc, ok := cred.(credential.Versioned); ok {
+		// Pass the version of the secret through so consumers can detect
+		// stale secrets.
+		if version, created := vc.SecretVersion(); version > 0 {
+			sessSecret.Version = version
+			if !created.IsZero() {
+				sessSecret.CreatedTime = timestamppb.New(created)
+			}
+		}
+	}
+
 	return &pb.SessionCredential{
 		CredentialSource: &pb.CredentialSource{
 			Id:                l.GetPublicId(),
@@ -141,10 +157,7 @@ func dynamicToSessionCredential(ctx context.Context, cred credential.Dynamic) (*
 			Type:              globals.ResourceInfoFromPrefix(l.GetPublicId()).Subtype.String(),
 			CredentialType:    credType,
 		},
-		Secret: &pb.SessionSecret{
-			Raw:     base64.StdEncoding.EncodeToString(jSecret),
-			Decoded: sSecret,
-		},
+		Secret:     sessSecret,
 		Credential: credData,
 	}, nil
 }
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targets
+
+import (
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/server"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withWorkerSelection server.WorkerSelection
+	withConnectionTests *common.WorkerConnectionTestBroker
+	withReverseTunnels  *common.ReverseTunnelRegistry
+}
+
+func getDefaultOptions() options {
+	return options{
+		withWorkerSelection: server.LeastLoadedWorkerSelection,
+	}
+}
+
+// WithWorkerSelection provides an option to set how the workers that can
+// handle a session are ordered. An empty selection leaves the default,
+// server.LeastLoadedWorkerSelection.
+func WithWorkerSelection(s server.WorkerSelection) Option {
+	return func(o *options) {
+		if s != "" {
+			o.withWorkerSelection = s
+		}
+	}
+}
+
+// WithConnectionTests provides an option to set the broker used to run target
+// connectivity tests on workers. Without it, connectivity tests are not
+// available.
+func WithConnectionTests(b *common.WorkerConnectionTestBroker) Option {
+	return func(o *options) {
+		o.withConnectionTests = b
+	}
+}
+
+// WithReverseTunnels provides an option to set the registry of the reverse
+// tunnels workers hold open to the controller. Without it, sessions are never
+// routed through a reverse tunnel.
+func WithReverseTunnels(r *common.ReverseTunnelRegistry) Option {
+	return func(o *options) {
+		o.withReverseTunnels = r
+	}
+}
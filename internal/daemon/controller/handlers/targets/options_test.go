@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targets
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithWorkerSelection", func(t *testing.T) {
+		opts := getOpts()
+		assert.Equal(t, server.LeastLoadedWorkerSelection, opts.withWorkerSelection)
+		opts = getOpts(WithWorkerSelection(""))
+		assert.Equal(t, server.LeastLoadedWorkerSelection, opts.withWorkerSelection)
+		opts = getOpts(WithWorkerSelection(server.StickyWorkerSelection))
+		testOpts := getDefaultOptions()
+		testOpts.withWorkerSelection = server.StickyWorkerSelection
+		assert.Equal(t, testOpts, opts)
+	})
+	t.Run("WithConnectionTests", func(t *testing.T) {
+		b := common.NewWorkerConnectionTestBroker()
+		opts := getOpts(WithConnectionTests(b))
+		testOpts := getDefaultOptions()
+		testOpts.withConnectionTests = b
+		assert.Equal(t, testOpts, opts)
+	})
+	t.Run("WithReverseTunnels", func(t *testing.T) {
+		r := common.NewReverseTunnelRegistry("127.0.0.1:9201")
+		opts := getOpts(WithReverseTunnels(r))
+		testOpts := getDefaultOptions()
+		testOpts.withReverseTunnels = r
+		assert.Equal(t, testOpts, opts)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"net"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/alias"
 	talias "github.com/hashicorp/boundary/internal/alias/target"
+	"github.com/hashicorp/boundary/internal/annotation"
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
@@ -25,12 +27,15 @@ import (
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/filter"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	intglobals "github.com/hashicorp/boundary/internal/globals"
 	"github.com/hashicorp/boundary/internal/host"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/endpoint"
 	"github.com/hashicorp/boundary/internal/listtoken"
 	"github.com/hashicorp/boundary/internal/pagination"
 	"github.com/hashicorp/boundary/internal/perms"
@@ -38,6 +43,7 @@ import (
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/targettemplate"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -46,10 +52,10 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	fm "github.com/hashicorp/boundary/version"
-	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/mr-tron/base58"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -58,6 +64,16 @@ import (
 const (
 	credentialDomain = "credential"
 	hostDomain       = "host"
+
+	// The checks TestTargetConnection can ask the worker to perform once
+	// connected to the target.
+	connectionCheckTcp = "tcp"
+	connectionCheckTls = "tls"
+	connectionCheckSsh = "ssh"
+
+	// connectionTestDialTimeout is how long the worker waits for the target
+	// to accept the connection and answer the check in TestTargetConnection.
+	connectionTestDialTimeout = 10 * time.Second
 )
 
 // extraWorkerFilterFunc takes in a set of workers and returns another set,
@@ -91,6 +107,9 @@ var (
 		action.SetCredentialSources,
 		action.RemoveCredentialSources,
 		action.AuthorizeSession,
+		action.TestConnection,
+		action.ReadRecordingPolicy,
+		action.SetDeletionProtection,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -105,6 +124,12 @@ var (
 	AuthorizeSessionWorkerFilterFn = AuthorizeSessionWithWorkerFilter
 	SessionRecordingFn             = NoSessionRecording
 	WorkerFilterDeprecationMessage = fmt.Sprintf("This field is deprecated. Use %s instead.", globals.EgressWorkerFilterField)
+
+	// connectionTestTimeout is how long TestTargetConnection waits for the
+	// worker to send the result of the test. Workers report their status every
+	// few seconds, and send the result in the status request following the
+	// test.
+	connectionTestTimeout = 30 * time.Second
 )
 
 func init() {
@@ -122,6 +147,8 @@ type Service struct {
 
 	repoFn                  target.RepositoryFactory
 	aliasRepoFn             common.TargetAliasRepoFactory
+	environmentRepoFn       common.EnvironmentRepoFactory
+	targetTemplateRepoFn    common.TargetTemplateRepoFactory
 	iamRepoFn               common.IamRepoFactory
 	serversRepoFn           common.ServersRepoFactory
 	sessionRepoFn           session.RepositoryFactory
@@ -132,13 +159,18 @@ type Service struct {
 	downstreams             common.Downstreamers
 	kmsCache                *kms.Kms
 	workerStatusGracePeriod *atomic.Int64
+	workerSelection         server.WorkerSelection
 	maxPageSize             uint
 	controllerExt           intglobals.ControllerExtension
+	connectionTests         *common.WorkerConnectionTestBroker
+	reverseTunnels          *common.ReverseTunnelRegistry
 }
 
 var _ pbs.TargetServiceServer = (*Service)(nil)
 
-// NewService returns a target service which handles target related requests to boundary.
+// NewService returns a target service which handles target related requests to
+// boundary. Supported options are WithWorkerSelection, WithConnectionTests and
+// WithReverseTunnels.
 func NewService(
 	ctx context.Context,
 	kmsCache *kms.Kms,
@@ -151,10 +183,13 @@ func NewService(
 	vaultCredRepoFn common.VaultCredentialRepoFactory,
 	staticCredRepoFn common.StaticCredentialRepoFactory,
 	aliasRepoFn common.TargetAliasRepoFactory,
+	environmentRepoFn common.EnvironmentRepoFactory,
+	targetTemplateRepoFn common.TargetTemplateRepoFactory,
 	downstreams common.Downstreamers,
 	workerStatusGracePeriod *atomic.Int64,
 	maxPageSize uint,
 	controllerExt intglobals.ControllerExtension,
+	opt ...Option,
 ) (Service, error) {
 	const op = "targets.NewService"
 	switch {
@@ -178,10 +213,15 @@ func NewService(
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
 	case aliasRepoFn == nil:
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing target alias repository")
+	case environmentRepoFn == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing environment repository")
+	case targetTemplateRepoFn == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing target template repository")
 	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
+	opts := getOpts(opt...)
 	return Service{
 		repoFn:                  repoFn,
 		iamRepoFn:               iamRepoFn,
@@ -192,11 +232,16 @@ func NewService(
 		vaultCredRepoFn:         vaultCredRepoFn,
 		staticCredRepoFn:        staticCredRepoFn,
 		aliasRepoFn:             aliasRepoFn,
+		environmentRepoFn:       environmentRepoFn,
+		targetTemplateRepoFn:    targetTemplateRepoFn,
 		downstreams:             downstreams,
 		kmsCache:                kmsCache,
 		workerStatusGracePeriod: workerStatusGracePeriod,
+		workerSelection:         opts.withWorkerSelection,
 		maxPageSize:             maxPageSize,
 		controllerExt:           controllerExt,
+		connectionTests:         opts.withConnectionTests,
+		reverseTunnels:          opts.withReverseTunnels,
 	}, nil
 }
 
@@ -207,6 +252,9 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, err
 	}
+	if req.GetExactCount() {
+		ctx = pagination.WithExactCount(ctx)
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -389,6 +437,16 @@ func (s Service) GetTarget(ctx context.Context, req *pbs.GetTargetRequest) (*pbs
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.ProtectFromDeletionField) {
+		if item.ProtectFromDeletion, err = s.lookupDeletionProtection(ctx, t.GetPublicId()); err != nil {
+			return nil, err
+		}
+	}
+	if outputFields.Has(globals.RecordingParametersField) {
+		if item.RecordingParameters, err = s.lookupRecordingParameters(ctx, t.GetPublicId()); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.GetTargetResponse{Item: item}, nil
 }
@@ -415,6 +473,12 @@ func (s Service) CreateTarget(ctx context.Context, req *pbs.CreateTargetRequest)
 	if err != nil {
 		return nil, err
 	}
+	var params *pb.RecordingParameters
+	if req.GetItem().GetRecordingParameters() != nil {
+		if params, err = s.setRecordingParametersInRepo(ctx, t.GetPublicId(), req.GetItem().GetRecordingParameters()); err != nil {
+			return nil, err
+		}
+	}
 
 	outputFields, ok := requests.OutputFields(ctx)
 	if !ok {
@@ -436,10 +500,93 @@ func (s Service) CreateTarget(ctx context.Context, req *pbs.CreateTargetRequest)
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.RecordingParametersField) {
+		item.RecordingParameters = params
+	}
 
 	return &pbs.CreateTargetResponse{Item: item, Uri: fmt.Sprintf("targets/%s", item.GetId())}, nil
 }
 
+// CreateTargetFromTemplate implements the interface pbs.TargetServiceServer.
+func (s Service) CreateTargetFromTemplate(ctx context.Context, req *pbs.CreateTargetFromTemplateRequest) (*pbs.CreateTargetFromTemplateResponse, error) {
+	const op = "targets.(Service).CreateTargetFromTemplate"
+
+	if err := validateCreateFromTemplateRequest(req); err != nil {
+		return nil, err
+	}
+	tmplAuthResults, tmpl := s.templateAuthResult(ctx, req.GetTemplateId())
+	if tmplAuthResults.Error != nil {
+		return nil, tmplAuthResults.Error
+	}
+
+	item, err := instantiateTemplate(ctx, tmpl, req.GetParameters())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetName() != "" {
+		item.Name = wrapperspb.String(req.GetName())
+	}
+	hostSourceIds := item.GetHostSourceIds()
+	credSources := target.CredentialSources{
+		BrokeredCredentialIds:            item.GetBrokeredCredentialSourceIds(),
+		InjectedApplicationCredentialIds: item.GetInjectedApplicationCredentialSourceIds(),
+	}
+	item.HostSourceIds = nil
+	item.BrokeredCredentialSourceIds = nil
+	item.InjectedApplicationCredentialSourceIds = nil
+	if err := validateCreateRequest(&pbs.CreateTargetRequest{Item: item}); err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, item.GetScopeId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	var createOpts []target.Option
+	if len(hostSourceIds) > 0 {
+		authResults := s.sourcesCreateAuthResult(ctx, item.GetScopeId(), action.AddHostSources)
+		if authResults.Error != nil {
+			return nil, authResults.Error
+		}
+		createOpts = append(createOpts, target.WithHostSources(hostSourceIds))
+	}
+	if len(credSources.BrokeredCredentialIds) > 0 || len(credSources.InjectedApplicationCredentialIds) > 0 {
+		authResults := s.sourcesCreateAuthResult(ctx, item.GetScopeId(), action.AddCredentialSources)
+		if authResults.Error != nil {
+			return nil, authResults.Error
+		}
+		createOpts = append(createOpts, target.WithCredentialSources(credSources))
+	}
+
+	t, ts, cl, err := s.createInRepo(ctx, item, createOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, t.GetPublicId(), IdActions).Strings()))
+	}
+	t.SetHostSources(ts)
+	t.SetCredentialSources(cl)
+
+	out, err := toProto(ctx, t, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.CreateTargetFromTemplateResponse{Item: out, Uri: fmt.Sprintf("targets/%s", out.GetId())}, nil
+}
+
 // UpdateTarget implements the interface pbs.TargetServiceServer.
 func (s Service) UpdateTarget(ctx context.Context, req *pbs.UpdateTargetRequest) (*pbs.UpdateTargetResponse, error) {
 	const op = "targets.(Service).UpdateTarget"
@@ -476,6 +623,11 @@ func (s Service) UpdateTarget(ctx context.Context, req *pbs.UpdateTargetRequest)
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.RecordingParametersField) {
+		if item.RecordingParameters, err = s.lookupRecordingParameters(ctx, t.GetPublicId()); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.UpdateTargetResponse{Item: item}, nil
 }
@@ -743,6 +895,56 @@ func (s Service) RemoveTargetCredentialSources(ctx context.Context, req *pbs.Rem
 	return &pbs.RemoveTargetCredentialSourcesResponse{Item: item}, nil
 }
 
+// SetTargetDeletionProtection implements the interface pbs.TargetServiceServer.
+func (s Service) SetTargetDeletionProtection(ctx context.Context, req *pbs.SetTargetDeletionProtectionRequest) (*pbs.SetTargetDeletionProtectionResponse, error) {
+	const op = "targets.(Service).SetTargetDeletionProtection"
+
+	if err := validateSetDeletionProtectionRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.SetDeletionProtection)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	if err := iamRepo.SetDeletionProtection(ctx, req.GetId(), req.GetProtectFromDeletion()); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set deletion protection"))
+	}
+	t, ts, cl, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, t.GetPublicId(), IdActions).Strings()))
+	}
+	t.SetHostSources(ts)
+	t.SetCredentialSources(cl)
+
+	item, err := toProto(ctx, t, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if outputFields.Has(globals.ProtectFromDeletionField) {
+		item.ProtectFromDeletion = req.GetProtectFromDeletion()
+	}
+
+	return &pbs.SetTargetDeletionProtectionResponse{Item: item}, nil
+}
+
 // If set, use the worker_filter or egress_worker_filter to filter the selected workers
 // and ensure we have workers available to service this request. The second return
 // argument is always nil.
@@ -756,13 +958,13 @@ func AuthorizeSessionWithWorkerFilter(
 	_ ...target.Option,
 ) (server.WorkerList, *server.Worker, error) {
 	if len(selectedWorkers) > 0 {
-		var eval *bexpr.Evaluator
+		var eval filter.Evaluator
 		var err error
 		switch {
 		case len(t.GetEgressWorkerFilter()) > 0:
-			eval, err = bexpr.CreateEvaluator(t.GetEgressWorkerFilter())
+			eval, err = filter.CreateEvaluator(t.GetEgressWorkerFilter())
 		case len(t.GetWorkerFilter()) > 0:
-			eval, err = bexpr.CreateEvaluator(t.GetWorkerFilter())
+			eval, err = filter.CreateEvaluator(t.GetWorkerFilter())
 		default: // No filter
 			return selectedWorkers, nil, nil
 		}
@@ -871,7 +1073,19 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if t == nil {
 		return nil, handlers.NotFoundErrorf("Target %q not found.", t.GetPublicId())
 	}
-	hostSources := t.GetHostSources()
+	// The target uses the session settings of its environment it does not set
+	// itself.
+	if t.GetEnvironmentId() != "" {
+		envRepo, err := s.environmentRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		env, err := envRepo.LookupEnvironment(ctx, t.GetEnvironmentId())
+		if err != nil {
+			return nil, err
+		}
+		t = env.ApplyTo(t)
+	}
 	credSources := t.GetCredentialSources()
 	if len(credSources) > 0 {
 		if err := validateCredentialSourcesFn(ctx, t.GetType(), credSources); err != nil {
@@ -890,82 +1104,9 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	}
 
 	p := strconv.FormatUint(uint64(t.GetDefaultPort()), 10)
-	var h, hostId, hostSetId string
-
-	switch {
-	case t.GetAddress() != "":
-		h = t.GetAddress()
-
-	default:
-		requestedId := req.GetHostId()
-		staticHostRepo, err := s.staticHostRepoFn()
-		if err != nil {
-			return nil, err
-		}
-		pluginHostRepo, err := s.pluginHostRepoFn()
-		if err != nil {
-			return nil, err
-		}
-
-		var pluginHostSetIds []string
-		var endpoints []*host.Endpoint
-		for _, hSource := range hostSources {
-			hsId := hSource.Id()
-			switch globals.ResourceInfoFromPrefix(hsId).Subtype {
-			case static.Subtype:
-				eps, err := staticHostRepo.Endpoints(ctx, hsId)
-				if err != nil {
-					return nil, err
-				}
-				endpoints = append(endpoints, eps...)
-			default:
-				// Batch the plugin host set ids since each round trip to the plugin
-				// has the potential to be expensive.
-				pluginHostSetIds = append(pluginHostSetIds, hsId)
-			}
-		}
-		if len(pluginHostSetIds) > 0 {
-			eps, err := pluginHostRepo.Endpoints(ctx, pluginHostSetIds)
-			if err != nil {
-				return nil, err
-			}
-			endpoints = append(endpoints, eps...)
-		}
-
-		if len(endpoints) == 0 {
-			return nil, handlers.NotFoundErrorf("No host sources or address found for given target.")
-		}
-
-		var chosenEndpoint *host.Endpoint
-		if requestedId != "" {
-			for _, ep := range endpoints {
-				if ep.HostId == requestedId {
-					chosenEndpoint = ep
-				}
-			}
-			if chosenEndpoint == nil {
-				// We didn't find it
-				return nil, handlers.InvalidArgumentErrorf(
-					"Errors in provided fields.",
-					map[string]string{
-						"host_id": "The requested host id is not available.",
-					})
-			}
-		}
-
-		if chosenEndpoint == nil {
-			chosenEndpoint = endpoints[rand.Intn(len(endpoints))]
-		}
-
-		hostId = chosenEndpoint.HostId
-		hostSetId = chosenEndpoint.SetId
-		h = chosenEndpoint.Address
-	}
-
-	if h == "" {
-		return nil, handlers.ApiErrorWithCodeAndMessage(
-			codes.FailedPrecondition,
-			"No host was discovered after checking target address and host sources.")
+	h, hostId, hostSetId, err := s.chooseEndpoint(ctx, t, req.GetHostId())
+	if err != nil {
+		return nil, err
 	}
 
 	// Ensure we don't have a port from the address
@@ -997,11 +1138,58 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		return nil, err
 	}
 
-	// Randomize the workers
+	// Randomize the workers, so that the load is spread across equally loaded
+	// workers
 	rand.Shuffle(len(selectedWorkers), func(i, j int) {
 		selectedWorkers[i], selectedWorkers[j] = selectedWorkers[j], selectedWorkers[i]
 	})
 
+	workerSelectionReason := server.RandomWorkerSelection
+	if s.workerSelection == server.LeastLoadedWorkerSelection || s.workerSelection == server.StickyWorkerSelection {
+		// Failing to read the utilization only loses the reported load, so
+		// the workers are still ordered by the connections they handle.
+		utilization, err := serversRepo.ListWorkerUtilization(ctx, server.WorkerList(selectedWorkers).PublicIds())
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to read worker utilization, falling back to active connection counts"))
+		}
+		selectedWorkers = server.WorkerList(selectedWorkers).PreferLeastLoaded(utilization)
+		workerSelectionReason = server.LeastLoadedWorkerSelection
+	}
+
+	var stickyWorkerId string
+	if s.workerSelection == server.StickyWorkerSelection {
+		// The previous worker is only preferred if it is still among the
+		// workers that are live and allowed to handle this session.
+		previousWorkerId, err := sessionRepo.LastConnectionWorkerId(ctx, authResults.UserId, t.GetPublicId())
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to read previous worker, falling back to least loaded worker selection"))
+		} else {
+			var found bool
+			if selectedWorkers, found = server.WorkerList(selectedWorkers).PreferWorker(previousWorkerId); found {
+				stickyWorkerId = previousWorkerId
+			}
+		}
+	}
+
+	// Workers in the same locality as the target are tried first, so sessions
+	// are not routed through another region when a closer worker is available.
+	selectedWorkers = server.WorkerList(selectedWorkers).PreferLocality(t.GetLocality())
+
+	// A worker with a reverse tunnel for the target through this controller
+	// comes first, as the target may only be reachable from its network.
+	// Clients reach it through the tunnel listener of the controller.
+	var tunnelWorkerId string
+	for _, id := range s.reverseTunnels.Workers(t.GetPublicId()) {
+		var found bool
+		if selectedWorkers, found = server.WorkerList(selectedWorkers).PreferWorker(id); found {
+			tunnelWorkerId = id
+			break
+		}
+	}
+	if stickyWorkerId != "" && selectedWorkers[0].GetPublicId() == stickyWorkerId {
+		workerSelectionReason = server.StickyWorkerSelection
+	}
+
 	var vaultReqs []credential.Request
 	var staticIds []string
 	var dynCreds []*session.DynamicCredential
@@ -1022,22 +1210,35 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 
 	expTime := timestamppb.Now()
 	expTime.Seconds += int64(t.GetSessionMaxSeconds())
+	var authzExpTime *timestamp.Timestamp
+	if ttl := t.GetAuthorizationTokenTtlSeconds(); ttl > 0 {
+		authzExpTime = timestamp.New(time.Now().Add(time.Duration(ttl) * time.Second))
+	}
+	// A single-use authorization can only be used for one connection.
+	connectionLimit := t.GetSessionConnectionLimit()
+	if t.GetAuthorizationTokenSingleUse() {
+		connectionLimit = 1
+	}
 	sessionComposition := session.ComposedOf{
-		UserId:              authResults.UserId,
-		HostId:              hostId,
-		TargetId:            t.GetPublicId(),
-		HostSetId:           hostSetId,
-		AuthTokenId:         authResults.AuthTokenId,
-		ProjectId:           authResults.Scope.Id,
-		Endpoint:            endpointUrl.String(),
-		ExpirationTime:      &timestamp.Timestamp{Timestamp: expTime},
-		ConnectionLimit:     t.GetSessionConnectionLimit(),
-		WorkerFilter:        t.GetWorkerFilter(),
-		EgressWorkerFilter:  t.GetEgressWorkerFilter(),
-		IngressWorkerFilter: t.GetIngressWorkerFilter(),
-		DynamicCredentials:  dynCreds,
-		StaticCredentials:   staticCreds,
-		CorrelationId:       correlationId,
+		UserId:                      authResults.UserId,
+		HostId:                      hostId,
+		TargetId:                    t.GetPublicId(),
+		HostSetId:                   hostSetId,
+		AuthTokenId:                 authResults.AuthTokenId,
+		ProjectId:                   authResults.Scope.Id,
+		Endpoint:                    endpointUrl.String(),
+		ExpirationTime:              &timestamp.Timestamp{Timestamp: expTime},
+		ConnectionLimit:             connectionLimit,
+		AllowedPorts:                t.GetAllowedPorts(),
+		TlsPassthrough:              t.GetTlsPassthrough(),
+		TlsServerNames:              t.GetTlsServerNames(),
+		AuthorizationExpirationTime: authzExpTime,
+		WorkerFilter:                t.GetWorkerFilter(),
+		EgressWorkerFilter:          t.GetEgressWorkerFilter(),
+		IngressWorkerFilter:         t.GetIngressWorkerFilter(),
+		DynamicCredentials:          dynCreds,
+		StaticCredentials:           staticCreds,
+		CorrelationId:               correlationId,
 	}
 	if protoWorker != nil {
 		sessionComposition.ProtocolWorkerId = protoWorker.GetPublicId()
@@ -1107,6 +1308,13 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 
 		// Remove duplicate requests
 		staticIds = strutil.RemoveDuplicates(staticIds, false)
+
+		// Credentials with a checkout policy can only be held by one session
+		// at a time. They are checked in when the session ends, including
+		// when it is deleted because of an error below.
+		if err := credRepo.CheckoutCredentials(ctx, sess.GetPublicId(), authResults.UserId, staticIds); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		creds, err := credRepo.Retrieve(ctx, t.GetProjectId(), staticIds)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
@@ -1171,6 +1379,11 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}
 	}
 
+	workerInfos := server.WorkerList(selectedWorkers).WorkerInfos()
+	if tunnelWorkerId != "" {
+		workerInfos = append([]*pb.WorkerInfo{{Address: s.reverseTunnels.Address()}}, server.WorkerList(selectedWorkers[1:]).WorkerInfos()...)
+	}
+
 	// this is an edge case issue where the hostId cannot be empty when trying to execute an ssh connection
 	// on a tcp target type. By setting the hostId to the targetId value, this will enable support of previous
 	// boundary cli versions.
@@ -1190,8 +1403,8 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		PrivateKey:        sess.CertificatePrivateKey,
 		HostId:            hostId,
 		Endpoint:          endpointUrl.String(),
-		WorkerInfo:        server.WorkerList(selectedWorkers).WorkerInfos(),
-		ConnectionLimit:   t.GetSessionConnectionLimit(),
+		WorkerInfo:        workerInfos,
+		ConnectionLimit:   connectionLimit,
 		DefaultClientPort: t.GetDefaultClientPort(),
 	}
 	marshaledSad, err := proto.Marshal(sad)
@@ -1201,19 +1414,23 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	encodedMarshaledSad := base58.FastBase58Encoding(marshaledSad)
 
 	ret := &pb.SessionAuthorization{
-		SessionId:          sess.PublicId,
-		TargetId:           t.GetPublicId(),
-		Scope:              authResults.Scope,
-		CreatedTime:        sess.CreateTime.GetTimestamp(),
-		Expiration:         sess.ExpirationTime.GetTimestamp(),
-		EndpointPort:       t.GetDefaultPort(),
-		Type:               t.GetType().String(),
-		AuthorizationToken: encodedMarshaledSad,
-		UserId:             authResults.UserId,
-		HostId:             hostId,
-		HostSetId:          hostSetId,
-		Endpoint:           endpointUrl.String(),
-		Credentials:        creds,
+		SessionId:             sess.PublicId,
+		TargetId:              t.GetPublicId(),
+		Scope:                 authResults.Scope,
+		CreatedTime:           sess.CreateTime.GetTimestamp(),
+		Expiration:            sess.ExpirationTime.GetTimestamp(),
+		EndpointPort:          t.GetDefaultPort(),
+		Type:                  t.GetType().String(),
+		AuthorizationToken:    encodedMarshaledSad,
+		UserId:                authResults.UserId,
+		HostId:                hostId,
+		HostSetId:             hostSetId,
+		Endpoint:              endpointUrl.String(),
+		Credentials:           creds,
+		WorkerSelectionReason: string(workerSelectionReason),
+	}
+	if len(selectedWorkers) > 0 {
+		ret.WorkerId = selectedWorkers[0].GetPublicId()
 	}
 
 	ret.SessionRecordingId, err = SessionRecordingFn(
@@ -1233,49 +1450,342 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	return &pbs.AuthorizeSessionResponse{Item: ret}, nil
 }
 
-func (s Service) getFromRepo(ctx context.Context, id string) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+// TestTargetConnection implements the interface pbs.TargetServiceServer.
+func (s Service) TestTargetConnection(ctx context.Context, req *pbs.TestTargetConnectionRequest) (*pbs.TestTargetConnectionResponse, error) {
+	const op = "targets.(Service).TestTargetConnection"
+
+	if err := validateTestConnectionRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.TestConnection)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if s.connectionTests == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unimplemented, "Testing the connectivity of targets is not supported.")
+	}
+
 	repo, err := s.repoFn()
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, err
 	}
-	u, err := repo.LookupTarget(ctx, id)
+	t, err := repo.LookupTarget(ctx, req.GetId())
 	if err != nil {
 		if errors.IsNotFoundError(err) {
-			return nil, nil, nil, handlers.NotFoundErrorf("Target %q doesn't exist.", id)
+			return nil, handlers.NotFoundErrorf("Target %q not found.", req.GetId())
 		}
-		return nil, nil, nil, err
+		return nil, err
 	}
-	hs := u.GetHostSources()
-	cl := u.GetCredentialSources()
-
-	if u == nil {
-		return nil, nil, nil, handlers.NotFoundErrorf("Target %q doesn't exist.", id)
+	if t == nil {
+		return nil, handlers.NotFoundErrorf("Target %q not found.", req.GetId())
+	}
+	if t.GetDefaultPort() == 0 {
+		return nil, handlers.ConflictErrorf("Target does not have default port defined.")
 	}
-	return u, hs, cl, nil
-}
 
-// resolveAlias returns the alias resource with the specified public id.
-func (s Service) resolveAlias(ctx context.Context, id string) (*talias.Alias, error) {
-	const op = "targets.(Service).resolveAlias"
-	if id == "" {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "alias id is empty")
+	h, hostId, _, err := s.chooseEndpoint(ctx, t, req.GetHostId())
+	if err != nil {
+		return nil, err
 	}
+	if _, err := util.ParseAddress(ctx, h); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error when parsing the chosen endpoint host address"))
+	}
+	address := net.JoinHostPort(h, strconv.FormatUint(uint64(t.GetDefaultPort()), 10))
 
-	r, err := s.aliasRepoFn()
+	// Test from the same workers that would be chosen to handle a session
+	serversRepo, err := s.serversRepoFn()
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, err
 	}
-	a, err := r.LookupAlias(ctx, id)
+	livenessTime := time.Duration(s.workerStatusGracePeriod.Load())
+	selectedWorkers, err := serversRepo.ListWorkers(ctx, []string{scope.Global.String()}, server.WithLiveness(livenessTime))
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, err
 	}
-	if a == nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.NotFound, "alias with id %q not found", id)
+	if len(selectedWorkers) == 0 {
+		return nil, handlers.ApiErrorWithCodeAndMessage(
+			codes.FailedPrecondition,
+			"No workers are available to test the connection.")
 	}
-	return a, nil
-}
+	selectedWorkers, _, err = AuthorizeSessionWorkerFilterFn(ctx, t, selectedWorkers, h, s.controllerExt, s.downstreams)
+	if err != nil {
+		return nil, err
+	}
+	rand.Shuffle(len(selectedWorkers), func(i, j int) {
+		selectedWorkers[i], selectedWorkers[j] = selectedWorkers[j], selectedWorkers[i]
+	})
+	selectedWorkers = server.WorkerList(selectedWorkers).PreferLocality(t.GetLocality())
+
+	// A worker with a reverse tunnel for the target is the one sessions use.
+	for _, id := range s.reverseTunnels.Workers(t.GetPublicId()) {
+		var found bool
+		if selectedWorkers, found = server.WorkerList(selectedWorkers).PreferWorker(id); found {
+			break
+		}
+	}
+
+	// The test can only be sent to a worker reporting its status to this
+	// controller, so prefer those which recently did.
+	w := selectedWorkers[0]
+	since := time.Now().Add(-livenessTime)
+	for _, sw := range selectedWorkers {
+		if s.connectionTests.PolledSince(sw.GetPublicId(), since) {
+			w = sw
+			break
+		}
+	}
+
+	check := req.GetCheck()
+	if check == "" {
+		check = connectionCheckTcp
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, connectionTestTimeout)
+	defer cancel()
+	r, err := s.connectionTests.Request(reqCtx, w.GetPublicId(), func(requestId string) *serverpb.WorkerConnectionTestRequest {
+		return &serverpb.WorkerConnectionTestRequest{
+			RequestId: requestId,
+			Address:   address,
+			Check:     check,
+			TimeoutMs: uint32(connectionTestDialTimeout.Milliseconds()),
+		}
+	})
+	switch {
+	case stderrors.Is(err, common.ErrWorkerRequestTimeout):
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.DeadlineExceeded,
+			"Worker %q did not send the result of the connection test in time. It may be offline or connected to another controller.", w.GetPublicId())
+	case err != nil:
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	return &pbs.TestTargetConnectionResponse{Item: &pb.TargetConnectionTest{
+		TargetId:   t.GetPublicId(),
+		HostId:     hostId,
+		WorkerId:   w.GetPublicId(),
+		Address:    address,
+		Check:      check,
+		Success:    r.GetSuccess(),
+		LatencyMs:  r.GetLatencyMs(),
+		Error:      r.GetError(),
+		Detail:     r.GetDetail(),
+		TestedTime: timestamppb.Now(),
+	}}, nil
+}
+
+// ReadTargetRecordingPolicy implements the interface pbs.TargetServiceServer.
+func (s Service) ReadTargetRecordingPolicy(ctx context.Context, req *pbs.ReadTargetRecordingPolicyRequest) (*pbs.ReadTargetRecordingPolicyResponse, error) {
+	const op = "targets.(Service).ReadTargetRecordingPolicy"
+
+	if err := handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, target.Prefixes()...); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ReadRecordingPolicy)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	p, err := repo.LookupEffectiveRecordingPolicy(ctx, req.GetId())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Target %q not found.", req.GetId())
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	return &pbs.ReadTargetRecordingPolicyResponse{Item: &pb.EffectiveRecordingPolicy{
+		TargetId:               p.TargetId,
+		RecordingEnabled:       p.RecordingEnabled,
+		StorageBucketId:        p.StorageBucketId,
+		StorageBucketScopeId:   p.StorageBucketScopeId,
+		StoragePolicyId:        p.StoragePolicyId,
+		StoragePolicyScopeId:   p.StoragePolicyScopeId,
+		RetainForDays:          p.RetainForDays,
+		RetainForDaysScopeId:   p.RetainForDaysScopeId,
+		DeleteAfterDays:        p.DeleteAfterDays,
+		DeleteAfterDaysScopeId: p.DeleteAfterDaysScopeId,
+		RecordPercent:          p.RecordPercent,
+		RecordFilter:           p.RecordFilter,
+	}}, nil
+}
+
+// chooseEndpoint returns the host address to connect to for the target. It is
+// the target's address if it has one, the address of the requested host if a
+// host id is provided, and otherwise the address of a random host from the
+// target's host sources. If the target has a preferred endpoint, it chooses
+// among all of the addresses of the hosts instead of the address chosen by
+// their host set, and hosts without a matching address are not used.
+func (s Service) chooseEndpoint(ctx context.Context, t target.Target, requestedHostId string) (address, hostId, hostSetId string, _ error) {
+	switch {
+	case t.GetAddress() != "":
+		address = t.GetAddress()
+
+	default:
+		staticHostRepo, err := s.staticHostRepoFn()
+		if err != nil {
+			return "", "", "", err
+		}
+		pluginHostRepo, err := s.pluginHostRepoFn()
+		if err != nil {
+			return "", "", "", err
+		}
+
+		var pluginHostSetIds []string
+		var endpoints []*host.Endpoint
+		for _, hSource := range t.GetHostSources() {
+			hsId := hSource.Id()
+			switch globals.ResourceInfoFromPrefix(hsId).Subtype {
+			case static.Subtype:
+				eps, err := staticHostRepo.Endpoints(ctx, hsId)
+				if err != nil {
+					return "", "", "", err
+				}
+				endpoints = append(endpoints, eps...)
+			default:
+				// Batch the plugin host set ids since each round trip to the plugin
+				// has the potential to be expensive.
+				pluginHostSetIds = append(pluginHostSetIds, hsId)
+			}
+		}
+		if len(pluginHostSetIds) > 0 {
+			eps, err := pluginHostRepo.Endpoints(ctx, pluginHostSetIds)
+			if err != nil {
+				return "", "", "", err
+			}
+			endpoints = append(endpoints, eps...)
+		}
+
+		if len(endpoints) == 0 {
+			return "", "", "", handlers.NotFoundErrorf("No host sources or address found for given target.")
+		}
 
-func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+		if expr := t.GetPreferredEndpoint(); expr != "" {
+			var err error
+			endpoints, err = preferEndpoints(ctx, expr, endpoints)
+			if err != nil {
+				return "", "", "", err
+			}
+			if len(endpoints) == 0 {
+				return "", "", "", handlers.ApiErrorWithCodeAndMessage(
+					codes.FailedPrecondition,
+					"No host address matched the target's preferred endpoint.")
+			}
+		}
+
+		var chosenEndpoint *host.Endpoint
+		if requestedHostId != "" {
+			for _, ep := range endpoints {
+				if ep.HostId == requestedHostId {
+					chosenEndpoint = ep
+				}
+			}
+			if chosenEndpoint == nil {
+				// We didn't find it
+				return "", "", "", handlers.InvalidArgumentErrorf(
+					"Errors in provided fields.",
+					map[string]string{
+						"host_id": "The requested host id is not available.",
+					})
+			}
+		}
+
+		if chosenEndpoint == nil {
+			chosenEndpoint = endpoints[rand.Intn(len(endpoints))]
+		}
+
+		hostId = chosenEndpoint.HostId
+		hostSetId = chosenEndpoint.SetId
+		address = chosenEndpoint.Address
+	}
+
+	if address == "" {
+		return "", "", "", handlers.ApiErrorWithCodeAndMessage(
+			codes.FailedPrecondition,
+			"No host was discovered after checking target address and host sources.")
+	}
+	return address, hostId, hostSetId, nil
+}
+
+// preferEndpoints returns the endpoints with their address replaced by the
+// address chosen by the preferred endpoint expression from all of the
+// addresses of their host. Endpoints without a matching address are dropped.
+func preferEndpoints(ctx context.Context, expr string, endpoints []*host.Endpoint) ([]*host.Endpoint, error) {
+	const op = "targets.preferEndpoints"
+	order, err := endpoint.ParsePreferenceExpression(expr)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	pref, err := endpoint.NewPreferencer(ctx, endpoint.WithPreferenceOrder(order))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := make([]*host.Endpoint, 0, len(endpoints))
+	for _, ep := range endpoints {
+		if len(ep.Addresses) == 0 {
+			continue
+		}
+		addr, err := pref.Choose(ctx, endpoint.WithAddresses(ep.Addresses))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if addr == "" {
+			continue
+		}
+		ret = append(ret, &host.Endpoint{
+			HostId:    ep.HostId,
+			SetId:     ep.SetId,
+			Address:   addr,
+			Addresses: ep.Addresses,
+		})
+	}
+	return ret, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (target.Target, []target.HostSource, []target.CredentialSource, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	u, err := repo.LookupTarget(ctx, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil, nil, handlers.NotFoundErrorf("Target %q doesn't exist.", id)
+		}
+		return nil, nil, nil, err
+	}
+	hs := u.GetHostSources()
+	cl := u.GetCredentialSources()
+
+	if u == nil {
+		return nil, nil, nil, handlers.NotFoundErrorf("Target %q doesn't exist.", id)
+	}
+	return u, hs, cl, nil
+}
+
+// resolveAlias returns the alias resource with the specified public id.
+func (s Service) resolveAlias(ctx context.Context, id string) (*talias.Alias, error) {
+	const op = "targets.(Service).resolveAlias"
+	if id == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "alias id is empty")
+	}
+
+	r, err := s.aliasRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	a, err := r.LookupAlias(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if a == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.NotFound, "alias with id %q not found", id)
+	}
+	return a, nil
+}
+
+func (s Service) createInRepo(ctx context.Context, item *pb.Target, createOpt ...target.Option) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	const op = "targets.(Service).createInRepo"
 	opts := []target.Option{target.WithName(item.GetName().GetValue())}
 	if item.GetDescription() != nil {
@@ -1293,9 +1803,30 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Targ
 	if item.GetIngressWorkerFilter() != nil {
 		opts = append(opts, target.WithIngressWorkerFilter(item.GetIngressWorkerFilter().GetValue()))
 	}
+	if item.GetLocality() != nil {
+		opts = append(opts, target.WithLocality(strings.TrimSpace(item.GetLocality().GetValue())))
+	}
+	if item.GetAuthorizationTokenTtlSeconds() != nil {
+		opts = append(opts, target.WithAuthorizationTokenTtlSeconds(item.GetAuthorizationTokenTtlSeconds().GetValue()))
+	}
+	if item.GetAuthorizationTokenSingleUse() != nil {
+		opts = append(opts, target.WithAuthorizationTokenSingleUse(item.GetAuthorizationTokenSingleUse().GetValue()))
+	}
+	if item.GetPreferredEndpoint() != nil {
+		opts = append(opts, target.WithPreferredEndpoint(strings.TrimSpace(item.GetPreferredEndpoint().GetValue())))
+	}
+	if item.GetAllowedPorts() != nil {
+		opts = append(opts, target.WithAllowedPorts(strings.TrimSpace(item.GetAllowedPorts().GetValue())))
+	}
 	if item.GetAddress() != nil {
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
 	}
+	if len(item.GetAnnotations()) > 0 {
+		opts = append(opts, target.WithAnnotations(item.GetAnnotations()))
+	}
+	if item.GetEnvironmentId() != nil {
+		opts = append(opts, target.WithEnvironmentId(item.GetEnvironmentId().GetValue()))
+	}
 
 	attr, err := subtypeRegistry.newAttribute(target.SubtypeFromType(item.GetType()), item.GetAttrs())
 	if err != nil {
@@ -1312,7 +1843,7 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Targ
 		return nil, nil, nil, err
 	}
 
-	createOptions := []target.Option{}
+	createOptions := append([]target.Option{}, createOpt...)
 	if len(item.GetWithAliases()) > 0 {
 		writeAliases := make([]*talias.Alias, 0, len(item.GetWithAliases()))
 		for _, a := range item.GetWithAliases() {
@@ -1364,10 +1895,36 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if ingressFilter := item.GetIngressWorkerFilter(); ingressFilter != nil {
 		opts = append(opts, target.WithIngressWorkerFilter(item.GetIngressWorkerFilter().GetValue()))
 	}
+	if locality := item.GetLocality(); locality != nil {
+		opts = append(opts, target.WithLocality(strings.TrimSpace(locality.GetValue())))
+	}
+	if ttl := item.GetAuthorizationTokenTtlSeconds(); ttl != nil {
+		opts = append(opts, target.WithAuthorizationTokenTtlSeconds(ttl.GetValue()))
+	}
+	if singleUse := item.GetAuthorizationTokenSingleUse(); singleUse != nil {
+		opts = append(opts, target.WithAuthorizationTokenSingleUse(singleUse.GetValue()))
+	}
+	if pref := item.GetPreferredEndpoint(); pref != nil {
+		opts = append(opts, target.WithPreferredEndpoint(strings.TrimSpace(pref.GetValue())))
+	}
+	if ports := item.GetAllowedPorts(); ports != nil {
+		opts = append(opts, target.WithAllowedPorts(strings.TrimSpace(ports.GetValue())))
+	}
 	if item.GetAddress() != nil {
 		dbMask = append(dbMask, "Address")
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
 	}
+	// Annotations are not a part of the target schema in the database, so
+	// they are not handled by the mask manager.
+	if handlers.MaskContains(mask, globals.AnnotationsField) {
+		dbMask = append(dbMask, "Annotations")
+		opts = append(opts, target.WithAnnotations(item.GetAnnotations()))
+	}
+	// Neither is the environment of the target.
+	if handlers.MaskContains(mask, globals.EnvironmentIdField) {
+		dbMask = append(dbMask, "EnvironmentId")
+		opts = append(opts, target.WithEnvironmentId(item.GetEnvironmentId().GetValue()))
+	}
 	subtype := target.SubtypeFromId(id)
 
 	attr, err := subtypeRegistry.newAttribute(subtype, item.GetAttrs())
@@ -1392,23 +1949,50 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 		return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target"))
 	}
 	dbMask = append(dbMask, maskManager.Translate(mask)...)
-	if len(dbMask) == 0 {
+	// Nor are the recording parameters, which are set separately from the
+	// target.
+	updateRecordingParameters := handlers.MaskContains(mask, globals.RecordingParametersField)
+	if len(dbMask) == 0 && !updateRecordingParameters {
 		return nil, nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid paths provided in the update mask."})
 	}
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	out, rowsUpdated, err := repo.UpdateTarget(ctx, u, version, dbMask, opts...)
-	if err != nil {
-		return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target"))
+	var out target.Target
+	switch {
+	case len(dbMask) > 0:
+		var rowsUpdated int
+		out, rowsUpdated, err = repo.UpdateTarget(ctx, u, version, dbMask, opts...)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target"))
+		}
+		if rowsUpdated == 0 {
+			return nil, nil, nil, handlers.NotFoundErrorf("Target %q not found or incorrect version provided.", id)
+		}
+	default:
+		out, err = repo.LookupTarget(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target"))
+		}
+		if out == nil || out.GetVersion() != version {
+			return nil, nil, nil, handlers.NotFoundErrorf("Target %q not found or incorrect version provided.", id)
+		}
+	}
+	if updateRecordingParameters {
+		switch p := item.GetRecordingParameters(); p {
+		case nil:
+			if _, err := repo.DeleteRecordingParameters(ctx, id); err != nil {
+				return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete recording parameters"))
+			}
+		default:
+			if _, err := s.setRecordingParametersInRepo(ctx, id, p); err != nil {
+				return nil, nil, nil, err
+			}
+		}
 	}
 	hs := out.GetHostSources()
 	cl := out.GetCredentialSources()
-
-	if rowsUpdated == 0 {
-		return nil, nil, nil, handlers.NotFoundErrorf("Target %q not found or incorrect version provided.", id)
-	}
 	return out, hs, cl, nil
 }
 
@@ -1428,6 +2012,46 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
+func (s Service) lookupDeletionProtection(ctx context.Context, id string) (bool, error) {
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return false, err
+	}
+	return iamRepo.LookupDeletionProtection(ctx, id)
+}
+
+func (s Service) lookupRecordingParameters(ctx context.Context, id string) (*pb.RecordingParameters, error) {
+	const op = "targets.(Service).lookupRecordingParameters"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	p, err := repo.LookupRecordingParameters(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to look up recording parameters"))
+	}
+	return toRecordingParametersProto(p), nil
+}
+
+func (s Service) setRecordingParametersInRepo(ctx context.Context, id string, item *pb.RecordingParameters) (*pb.RecordingParameters, error) {
+	const op = "targets.(Service).setRecordingParametersInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	p, err := repo.SetRecordingParameters(ctx, &target.RecordingParameters{
+		TargetId:         id,
+		Compression:      item.GetCompression(),
+		CompressionLevel: item.GetCompressionLevel(),
+		RedactionRules:   item.GetRedactionRules(),
+		SamplePercent:    item.GetSamplePercent(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set recording parameters"))
+	}
+	return toRecordingParametersProto(p), nil
+}
+
 func (s Service) addHostSourcesInRepo(ctx context.Context, targetId string, hostSourceIds []string, version uint32) (target.Target, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -1623,6 +2247,58 @@ func (s Service) aliasCreateAuthResult(ctx context.Context, parentId string) aut
 	return ret
 }
 
+// templateAuthResult verifies authorization for reading the target template
+// a target is created from and returns the template.
+func (s Service) templateAuthResult(ctx context.Context, id string) (auth.VerifyResults, *targettemplate.TargetTemplate) {
+	res := auth.VerifyResults{}
+	repo, err := s.targetTemplateRepoFn()
+	if err != nil {
+		res.Error = err
+		return res, nil
+	}
+	tmpl, err := repo.LookupTargetTemplate(ctx, id)
+	if err != nil {
+		res.Error = err
+		return res, nil
+	}
+	if tmpl == nil {
+		res.Error = handlers.NotFoundError()
+		return res, nil
+	}
+	opts := []auth.Option{
+		auth.WithType(resource.TargetTemplate),
+		auth.WithAction(action.Read),
+		auth.WithId(id),
+		auth.WithScopeId(tmpl.GetProjectId()),
+	}
+	return auth.Verify(ctx, opts...), tmpl
+}
+
+// instantiateTemplate returns the target defined by the target template with
+// the parameters substituted, in the project of the template.
+func instantiateTemplate(ctx context.Context, tmpl *targettemplate.TargetTemplate, parameters map[string]string) (*pb.Target, error) {
+	const op = "targets.instantiateTemplate"
+	item := &pb.Target{}
+	if err := protojson.Unmarshal([]byte(tmpl.GetDefinition()), item); err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to convert definition of target template: %v.", err)
+	}
+	if err := targettemplate.Instantiate(ctx, item, parameters); err != nil {
+		if errors.Match(errors.T(errors.InvalidParameter), err) {
+			return nil, handlers.InvalidArgumentErrorf("Unable to instantiate target template.", map[string]string{globals.ParametersField: err.Error()})
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item.ScopeId = tmpl.GetProjectId()
+	return item, nil
+}
+
+// sourcesCreateAuthResult verifies authorization for adding sources to a
+// target which is being created in the project.
+func (s Service) sourcesCreateAuthResult(ctx context.Context, parentId string, a action.Type) auth.VerifyResults {
+	opts := []auth.Option{auth.WithType(resource.Target), auth.WithAction(a), auth.WithScopeId(parentId)}
+	return auth.Verify(ctx, opts...)
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type, lookupOpt ...target.Option) auth.VerifyResults {
 	res := auth.VerifyResults{}
 
@@ -1727,6 +2403,21 @@ func toProto(ctx context.Context, in target.Target, opt ...handlers.Option) (*pb
 	if outputFields.Has(globals.IngressWorkerFilterField) && in.GetIngressWorkerFilter() != "" {
 		out.IngressWorkerFilter = wrapperspb.String(in.GetIngressWorkerFilter())
 	}
+	if outputFields.Has(globals.LocalityField) && in.GetLocality() != "" {
+		out.Locality = wrapperspb.String(in.GetLocality())
+	}
+	if outputFields.Has(globals.AuthorizationTokenTtlSecondsField) && in.GetAuthorizationTokenTtlSeconds() != 0 {
+		out.AuthorizationTokenTtlSeconds = wrapperspb.UInt32(in.GetAuthorizationTokenTtlSeconds())
+	}
+	if outputFields.Has(globals.AuthorizationTokenSingleUseField) && in.GetAuthorizationTokenSingleUse() {
+		out.AuthorizationTokenSingleUse = wrapperspb.Bool(in.GetAuthorizationTokenSingleUse())
+	}
+	if outputFields.Has(globals.PreferredEndpointField) && in.GetPreferredEndpoint() != "" {
+		out.PreferredEndpoint = wrapperspb.String(in.GetPreferredEndpoint())
+	}
+	if outputFields.Has(globals.AllowedPortsField) && in.GetAllowedPorts() != "" {
+		out.AllowedPorts = wrapperspb.String(in.GetAllowedPorts())
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -1760,6 +2451,12 @@ func toProto(ctx context.Context, in target.Target, opt ...handlers.Option) (*pb
 	if outputFields.Has(globals.AddressField) {
 		out.Address = wrapperspb.String(in.GetAddress())
 	}
+	if outputFields.Has(globals.AnnotationsField) && len(in.GetAnnotations()) > 0 {
+		out.Annotations = in.GetAnnotations()
+	}
+	if outputFields.Has(globals.EnvironmentIdField) && in.GetEnvironmentId() != "" {
+		out.EnvironmentId = wrapperspb.String(in.GetEnvironmentId())
+	}
 
 	var brokeredSources, injectedAppSources []*pb.CredentialSource
 	var brokeredSourceIds, injectedAppSourceIds []string
@@ -1810,6 +2507,18 @@ func toProto(ctx context.Context, in target.Target, opt ...handlers.Option) (*pb
 //   - The path passed in is correctly formatted
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
+func toRecordingParametersProto(in *target.RecordingParameters) *pb.RecordingParameters {
+	if in == nil {
+		return nil
+	}
+	return &pb.RecordingParameters{
+		Compression:      in.Compression,
+		CompressionLevel: in.CompressionLevel,
+		RedactionRules:   in.RedactionRules,
+		SamplePercent:    in.SamplePercent,
+	}
+}
+
 func validateGetRequest(req *pbs.GetTargetRequest) error {
 	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, target.Prefixes()...)
 }
@@ -1854,7 +2563,7 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 			badFields[globals.WorkerFilterField] = WorkerFilterDeprecationMessage
 		}
 		if egressFilter := item.GetEgressWorkerFilter(); egressFilter != nil {
-			if _, err := bexpr.CreateEvaluator(egressFilter.GetValue()); err != nil {
+			if _, err := filter.CreateEvaluator(egressFilter.GetValue()); err != nil {
 				badFields[globals.EgressWorkerFilterField] = "Unable to successfully parse egress filter expression."
 			}
 		}
@@ -1864,6 +2573,19 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 				badFields[globals.IngressWorkerFilterField] = err.Error()
 			}
 		}
+		if locality := item.GetLocality(); locality != nil && strings.TrimSpace(locality.GetValue()) == "" {
+			badFields[globals.LocalityField] = "This field cannot be set to empty."
+		}
+		if pref := item.GetPreferredEndpoint(); pref != nil {
+			if _, err := endpoint.ParsePreferenceExpression(pref.GetValue()); err != nil {
+				badFields[globals.PreferredEndpointField] = fmt.Sprintf("Error parsing preferred endpoint: %v.", err)
+			}
+		}
+		if ports := item.GetAllowedPorts(); ports != nil {
+			if _, err := endpoint.ParsePorts(ports.GetValue()); err != nil {
+				badFields[globals.AllowedPortsField] = fmt.Sprintf("Error parsing allowed ports: %v.", err)
+			}
+		}
 		if address := item.GetAddress(); address != nil {
 			if len(address.GetValue()) < static.MinHostAddressLength ||
 				len(address.GetValue()) > static.MaxHostAddressLength {
@@ -1878,6 +2600,16 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 				badFields[globals.AddressField] = fmt.Sprintf("Error parsing address: %v.", err)
 			}
 		}
+		if err := annotation.Annotations(item.GetAnnotations()).Validate(); err != nil {
+			badFields[globals.AnnotationsField] = fmt.Sprintf("Invalid annotations: %v.", err)
+		}
+		if envId := item.GetEnvironmentId(); envId != nil && envId.GetValue() != "" && !handlers.ValidId(handlers.Id(envId.GetValue()), globals.EnvironmentPrefix) {
+			badFields[globals.EnvironmentIdField] = "Incorrectly formatted identifier."
+		}
+		if item.GetProtectFromDeletion() {
+			badFields[globals.ProtectFromDeletionField] = "This is a read only field."
+		}
+		validateRecordingParameters(item.GetRecordingParameters(), badFields)
 		subtype := target.SubtypeFromType(item.GetType())
 		_, err := subtypeRegistry.get(subtype)
 		if err != nil {
@@ -1896,6 +2628,50 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 	})
 }
 
+// validateRecordingParameters adds the invalid recording parameters, if any,
+// to badFields.
+func validateRecordingParameters(p *pb.RecordingParameters, badFields map[string]string) {
+	if p == nil {
+		return
+	}
+	compression := p.GetCompression()
+	if compression == "" {
+		compression = target.DefaultRecordingCompression
+	}
+	maxLevel, ok := target.MaxRecordingCompressionLevel(compression)
+	switch {
+	case !ok:
+		badFields["recording_parameters.compression"] = fmt.Sprintf("Unknown compression %q; must be one of %q, %q or %q.",
+			p.GetCompression(), target.NoRecordingCompression, target.GzipRecordingCompression, target.ZstdRecordingCompression)
+	case p.GetCompressionLevel() > maxLevel:
+		badFields["recording_parameters.compression_level"] = fmt.Sprintf("The level of the %s compression must be at most %d.", compression, maxLevel)
+	}
+	if p.GetSamplePercent() > 100 {
+		badFields["recording_parameters.sample_percent"] = "This must be between 1 and 100."
+	}
+	for _, r := range p.GetRedactionRules() {
+		if r == "" {
+			badFields["recording_parameters.redaction_rules"] = "Redaction rules cannot be empty."
+			break
+		}
+		if _, err := regexp.Compile(r); err != nil {
+			badFields["recording_parameters.redaction_rules"] = fmt.Sprintf("Invalid redaction rule %q: %v.", r, err)
+			break
+		}
+	}
+}
+
+func validateCreateFromTemplateRequest(req *pbs.CreateTargetFromTemplateRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetTemplateId()), globals.TargetTemplatePrefix) {
+		badFields[globals.TemplateIdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
 func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 	item := req.GetItem()
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
@@ -1922,7 +2698,7 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 		// worker_filter is mutually exclusive from ingress and egress filter
 		workerFilterFound := false
 		if workerFilter := item.GetWorkerFilter(); workerFilter != nil {
-			if _, err := bexpr.CreateEvaluator(workerFilter.GetValue()); err != nil {
+			if _, err := filter.CreateEvaluator(workerFilter.GetValue()); err != nil {
 				badFields[globals.WorkerFilterField] = "Unable to successfully parse filter expression."
 			}
 			workerFilterFound = true
@@ -1931,7 +2707,7 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 			if workerFilterFound {
 				badFields[globals.EgressWorkerFilterField] = fmt.Sprintf("Cannot set %s and %s; they are mutually exclusive fields.", globals.WorkerFilterField, globals.EgressWorkerFilterField)
 			}
-			if _, err := bexpr.CreateEvaluator(egressFilter.GetValue()); err != nil {
+			if _, err := filter.CreateEvaluator(egressFilter.GetValue()); err != nil {
 				badFields[globals.EgressWorkerFilterField] = "Unable to successfully parse egress filter expression."
 			}
 		}
@@ -1944,6 +2720,19 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 				badFields[globals.IngressWorkerFilterField] = err.Error()
 			}
 		}
+		if locality := item.GetLocality(); locality != nil && strings.TrimSpace(locality.GetValue()) == "" {
+			badFields[globals.LocalityField] = "This field cannot be set to empty."
+		}
+		if pref := item.GetPreferredEndpoint(); pref != nil {
+			if _, err := endpoint.ParsePreferenceExpression(pref.GetValue()); err != nil {
+				badFields[globals.PreferredEndpointField] = fmt.Sprintf("Error parsing preferred endpoint: %v.", err)
+			}
+		}
+		if ports := item.GetAllowedPorts(); ports != nil {
+			if _, err := endpoint.ParsePorts(ports.GetValue()); err != nil {
+				badFields[globals.AllowedPortsField] = fmt.Sprintf("Error parsing allowed ports: %v.", err)
+			}
+		}
 		if address := item.GetAddress(); address != nil {
 			if len(address.GetValue()) < static.MinHostAddressLength ||
 				len(address.GetValue()) > static.MaxHostAddressLength {
@@ -1958,6 +2747,16 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 				badFields[globals.AddressField] = fmt.Sprintf("Error parsing address: %v.", err)
 			}
 		}
+		if err := annotation.Annotations(item.GetAnnotations()).Validate(); err != nil {
+			badFields[globals.AnnotationsField] = fmt.Sprintf("Invalid annotations: %v.", err)
+		}
+		if envId := item.GetEnvironmentId(); envId != nil && envId.GetValue() != "" && !handlers.ValidId(handlers.Id(envId.GetValue()), globals.EnvironmentPrefix) {
+			badFields[globals.EnvironmentIdField] = "Incorrectly formatted identifier."
+		}
+		if item.GetProtectFromDeletion() {
+			badFields[globals.ProtectFromDeletionField] = "This is a read only field and cannot be specified in an update request."
+		}
+		validateRecordingParameters(item.GetRecordingParameters(), badFields)
 		subtype := target.SubtypeFromId(req.GetId())
 		_, err := subtypeRegistry.get(subtype)
 		if err != nil {
@@ -2158,6 +2957,17 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 	return nil
 }
 
+func validateSetDeletionProtectionRequest(req *pbs.SetTargetDeletionProtectionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourcesRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
@@ -2199,6 +3009,29 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	return nil
 }
 
+func validateTestConnectionRequest(req *pbs.TestTargetConnectionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), target.Prefixes()...) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if req.GetHostId() != "" {
+		switch globals.ResourceInfoFromPrefix(req.GetHostId()).Subtype {
+		case static.Subtype, plugin.Subtype:
+		default:
+			badFields[globals.HostIdField] = "Incorrectly formatted identifier."
+		}
+	}
+	switch req.GetCheck() {
+	case "", connectionCheckTcp, connectionCheckTls, connectionCheckSsh:
+	default:
+		badFields[globals.CheckField] = fmt.Sprintf("Must be one of %q, %q or %q.", connectionCheckTcp, connectionCheckTls, connectionCheckSsh)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 	badFields := map[string]string{}
 	nameEmpty := req.GetName() == ""
@@ -10,7 +10,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/endpoint"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/target/targettest"
 	"github.com/hashicorp/boundary/internal/target/targettest/store"
@@ -39,6 +41,83 @@ func TestWorkerList_Addresses(t *testing.T) {
 	assert.Equal(t, workerInfos, tested.WorkerInfos())
 }
 
+func TestWorkerList_AdditionalAddresses(t *testing.T) {
+	tested := server.WorkerList{
+		server.NewWorker(scope.Global.String(),
+			server.WithName("test1"),
+			server.WithAddress("public1:9202"),
+			server.WithAdditionalAddresses([]string{"private1:9202", "mesh1:9202"})),
+		server.NewWorker(scope.Global.String(),
+			server.WithName("test2"),
+			server.WithAddress("public2:9202")),
+	}
+	expected := []string{"public1:9202", "private1:9202", "mesh1:9202", "public2:9202"}
+	var workerInfos []*pb.WorkerInfo
+	for _, a := range expected {
+		workerInfos = append(workerInfos, &pb.WorkerInfo{Address: a})
+	}
+	assert.Equal(t, expected, tested.Addresses())
+	assert.Equal(t, workerInfos, tested.WorkerInfos())
+}
+
+func TestPreferEndpoints(t *testing.T) {
+	ctx := context.Background()
+	dualNetwork := &host.Endpoint{
+		HostId:    "h1",
+		SetId:     "s1",
+		Address:   "10.0.0.1",
+		Addresses: endpoint.Classify([]string{"10.0.0.1", "54.0.0.1"}, []string{"h1.example.com"}),
+	}
+	privateOnly := &host.Endpoint{
+		HostId:    "h2",
+		SetId:     "s1",
+		Address:   "10.0.0.2",
+		Addresses: endpoint.Classify([]string{"10.0.0.2"}, nil),
+	}
+	endpoints := []*host.Endpoint{dualNetwork, privateOnly}
+
+	tests := []struct {
+		name string
+		expr string
+		want map[string]string
+	}{
+		{
+			name: "public only",
+			expr: "type:public_ip",
+			want: map[string]string{"h1": "54.0.0.1"},
+		},
+		{
+			name: "public with fallback",
+			expr: "type:public_ip,type:private_ip",
+			want: map[string]string{"h1": "54.0.0.1", "h2": "10.0.0.2"},
+		},
+		{
+			name: "dns with any fallback",
+			expr: "dns:*.example.com,any",
+			want: map[string]string{"h1": "h1.example.com", "h2": "10.0.0.2"},
+		},
+		{
+			name: "no match",
+			expr: "cidr:192.168.0.0/16",
+			want: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := preferEndpoints(ctx, tt.expr, endpoints)
+			require.NoError(t, err)
+			gotAddrs := make(map[string]string, len(got))
+			for _, ep := range got {
+				gotAddrs[ep.HostId] = ep.Address
+			}
+			assert.Equal(t, tt.want, gotAddrs)
+		})
+	}
+
+	_, err := preferEndpoints(ctx, "type:ipv4", endpoints)
+	assert.Error(t, err)
+}
+
 func TestWorkerList_EgressFilter(t *testing.T) {
 	ctx := context.Background()
 	// This prevents us from running tests in parallel.
@@ -132,3 +211,62 @@ func TestWorkerList_EgressFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRecordingParameters(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *pb.RecordingParameters
+		want map[string]string
+	}{
+		{
+			name: "nil",
+			want: map[string]string{},
+		},
+		{
+			name: "defaults",
+			in:   &pb.RecordingParameters{},
+			want: map[string]string{},
+		},
+		{
+			name: "valid",
+			in: &pb.RecordingParameters{
+				Compression:      "zstd",
+				CompressionLevel: 22,
+				RedactionRules:   []string{`(?i)password:\s*\S+`},
+				SamplePercent:    10,
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "unknown compression",
+			in:   &pb.RecordingParameters{Compression: "lz4"},
+			want: map[string]string{"recording_parameters.compression": `Unknown compression "lz4"; must be one of "none", "gzip" or "zstd".`},
+		},
+		{
+			name: "compression level too high",
+			in:   &pb.RecordingParameters{CompressionLevel: 10},
+			want: map[string]string{"recording_parameters.compression_level": "The level of the gzip compression must be at most 9."},
+		},
+		{
+			name: "sample percent too high",
+			in:   &pb.RecordingParameters{SamplePercent: 101},
+			want: map[string]string{"recording_parameters.sample_percent": "This must be between 1 and 100."},
+		},
+		{
+			name: "empty redaction rule",
+			in:   &pb.RecordingParameters{RedactionRules: []string{""}},
+			want: map[string]string{"recording_parameters.redaction_rules": "Redaction rules cannot be empty."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]string{}
+			validateRecordingParameters(tt.in, got)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	got := map[string]string{}
+	validateRecordingParameters(&pb.RecordingParameters{RedactionRules: []string{"("}}, got)
+	assert.Contains(t, got, "recording_parameters.redaction_rules")
+}
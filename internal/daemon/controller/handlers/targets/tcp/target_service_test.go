@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/credentials"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/environment"
 	"github.com/hashicorp/boundary/internal/event"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
@@ -50,6 +51,7 @@ import (
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/target/tcp/store"
+	"github.com/hashicorp/boundary/internal/targettemplate"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	credlibpb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
 	credpb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
@@ -85,6 +87,9 @@ var testAuthorizedActions = []string{
 	"set-credential-sources",
 	"remove-credential-sources",
 	"authorize-session",
+	"test-connection",
+	"read-recording-policy",
+	"set-deletion-protection",
 }
 
 // Create a variable that we can overwrite in enterprise tests
@@ -122,7 +127,13 @@ func testService(t *testing.T, ctx context.Context, conn *db.DB, kms *kms.Kms, w
 	targetAliasRepoFn := func() (*talias.Repository, error) {
 		return talias.NewRepository(ctx, rw, rw, kms)
 	}
-	return targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, 1000, nil)
+	environmentRepoFn := func() (*environment.Repository, error) {
+		return environment.NewRepository(ctx, rw, rw, kms)
+	}
+	targetTemplateRepoFn := func() (*targettemplate.Repository, error) {
+		return targettemplate.NewRepository(ctx, rw, rw, kms)
+	}
+	return targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, environmentRepoFn, targetTemplateRepoFn, nil, statusGracePeriod, 1000, nil)
 }
 
 func TestGet(t *testing.T) {
@@ -551,6 +562,7 @@ func TestListGrantScopes(t *testing.T) {
 
 	cases := []struct {
 		name      string
+		scopeId   string
 		pageSize  uint32
 		setupFunc func(t *testing.T)
 		res       *pbs.ListTargetsResponse
@@ -655,6 +667,39 @@ func TestListGrantScopes(t *testing.T) {
 				EstItemCount: 5,
 			},
 		},
+		{
+			name:    "global-with-descendants-wildcard-from-org",
+			scopeId: org1.GetPublicId(),
+			setupFunc: func(t *testing.T) {
+				globalRole := iam.TestRole(t, conn, scope.Global.String(), iam.WithGrantScopeIds([]string{globals.GrantScopeDescendants}))
+				_ = iam.TestUserRole(t, conn, globalRole.GetPublicId(), at.GetIamUserId())
+				_ = iam.TestRoleGrant(t, conn, globalRole.GetPublicId(), "ids=*;type=*;actions=*")
+			},
+			res: &pbs.ListTargetsResponse{
+				Items:        totalTars[0:5],
+				ResponseType: "complete",
+				SortBy:       "created_time",
+				SortDir:      "desc",
+				EstItemCount: 5,
+			},
+		},
+		{
+			name: "project-grants-outside-listed-scope",
+			// The only grants are on a project outside of org1, so no scopes
+			// under org1 are authorized.
+			scopeId: org1.GetPublicId(),
+			setupFunc: func(t *testing.T) {
+				org2Role := iam.TestRole(t, conn, org2.GetPublicId(), iam.WithGrantScopeIds([]string{proj2.GetPublicId()}))
+				_ = iam.TestUserRole(t, conn, org2Role.GetPublicId(), at.GetIamUserId())
+				_ = iam.TestRoleGrant(t, conn, org2Role.GetPublicId(), "ids=*;type=*;actions=*")
+			},
+			err: handlers.ForbiddenError(),
+		},
+		{
+			name:      "no-grants",
+			setupFunc: func(t *testing.T) {},
+			err:       handlers.ForbiddenError(),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -673,8 +718,12 @@ func TestListGrantScopes(t *testing.T) {
 			}
 			requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
 			ctx := auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
+			scopeId := tc.scopeId
+			if scopeId == "" {
+				scopeId = scope.Global.String()
+			}
 			got, gErr := s.ListTargets(ctx, &pbs.ListTargetsRequest{
-				ScopeId:   scope.Global.String(),
+				ScopeId:   scopeId,
 				Recursive: true,
 				PageSize:  tc.pageSize,
 			})
@@ -1596,6 +1645,43 @@ func TestCreate(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Invalid allowed ports",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				AllowedPorts: wrapperspb.String("8100-8000"),
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Tls server names without tls passthrough",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				Type: tcp.Subtype.String(),
+				Attrs: &pb.Target_TcpTargetAttributes{
+					TcpTargetAttributes: &pb.TcpTargetAttributes{
+						DefaultPort:    wrapperspb.UInt32(5432),
+						TlsServerNames: wrapperspb.String("*.db.example.com"),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Invalid tls server names",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				Type: tcp.Subtype.String(),
+				Attrs: &pb.Target_TcpTargetAttributes{
+					TcpTargetAttributes: &pb.TcpTargetAttributes{
+						DefaultPort:    wrapperspb.UInt32(5432),
+						TlsPassthrough: wrapperspb.Bool(true),
+						TlsServerNames: wrapperspb.String("db.example.com:5432"),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -3439,6 +3525,12 @@ func TestAuthorizeSession(t *testing.T) {
 	targetAliasRepoFn := func() (*talias.Repository, error) {
 		return talias.NewRepository(ctx, rw, rw, kms)
 	}
+	environmentRepoFn := func() (*environment.Repository, error) {
+		return environment.NewRepository(ctx, rw, rw, kms)
+	}
+	targetTemplateRepoFn := func() (*targettemplate.Repository, error) {
+		return targettemplate.NewRepository(ctx, rw, rw, kms)
+	}
 
 	plg := plugin.TestPlugin(t, conn, "test")
 	plgm := map[string]plgpb.HostPluginServiceClient{
@@ -3578,7 +3670,7 @@ func TestAuthorizeSession(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, 1000, nil)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, environmentRepoFn, targetTemplateRepoFn, nil, statusGracePeriod, 1000, nil)
 	require.NoError(t, err)
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -3660,7 +3752,8 @@ func TestAuthorizeSession(t *testing.T) {
 						},
 					},
 				},
-				EndpointPort: uint32(defaultPort),
+				EndpointPort:          uint32(defaultPort),
+				WorkerSelectionReason: string(server.LeastLoadedWorkerSelection),
 				// TODO: validate the contents of the authorization token is what is expected
 			}
 			wantSecret := map[string]any{
@@ -3708,7 +3801,7 @@ func TestAuthorizeSession(t *testing.T) {
 				got,
 				want,
 				protocmp.Transform(),
-				protocmp.IgnoreFields(&pb.SessionAuthorization{}, "expiration"),
+				protocmp.IgnoreFields(&pb.SessionAuthorization{}, "expiration", "worker_id"),
 				cmpopts.SortSlices(func(a, b string) bool {
 					return a < b
 				}),
@@ -3762,6 +3855,12 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	targetAliasRepoFn := func() (*talias.Repository, error) {
 		return talias.NewRepository(ctx, rw, rw, kms)
 	}
+	environmentRepoFn := func() (*environment.Repository, error) {
+		return environment.NewRepository(ctx, rw, rw, kms)
+	}
+	targetTemplateRepoFn := func() (*targettemplate.Repository, error) {
+		return targettemplate.NewRepository(ctx, rw, rw, kms)
+	}
 
 	org, proj := iam.TestScopes(t, iamRepo)
 	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
@@ -3782,7 +3881,7 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, 1000, nil)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, environmentRepoFn, targetTemplateRepoFn, nil, statusGracePeriod, 1000, nil)
 	require.NoError(t, err)
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -4293,15 +4392,16 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 					Description:   proj.GetDescription(),
 					ParentScopeId: proj.GetParentId(),
 				},
-				TargetId:     tar.GetPublicId(),
-				UserId:       at.GetIamUserId(),
-				HostSetId:    tc.hostSourceId,
-				HostId:       tc.wantedHostId,
-				Type:         "tcp",
-				Endpoint:     fmt.Sprintf("tcp://%s:%d", tc.wantedEndpoint, defaultPort),
-				Credentials:  []*pb.SessionCredential{tc.wantedCred},
-				EndpointPort: uint32(defaultPort),
-				Expiration:   asRes.Item.Expiration,
+				TargetId:              tar.GetPublicId(),
+				UserId:                at.GetIamUserId(),
+				HostSetId:             tc.hostSourceId,
+				HostId:                tc.wantedHostId,
+				Type:                  "tcp",
+				Endpoint:              fmt.Sprintf("tcp://%s:%d", tc.wantedEndpoint, defaultPort),
+				Credentials:           []*pb.SessionCredential{tc.wantedCred},
+				EndpointPort:          uint32(defaultPort),
+				Expiration:            asRes.Item.Expiration,
+				WorkerSelectionReason: string(server.LeastLoadedWorkerSelection),
 				// TODO: validate the contents of the authorization token is what is expected
 			}
 			got := asRes.GetItem()
@@ -4318,6 +4418,7 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 				got,
 				want,
 				protocmp.Transform(),
+				protocmp.IgnoreFields(&pb.SessionAuthorization{}, "worker_id"),
 				cmpopts.SortSlices(func(a, b string) bool {
 					return a < b
 				}),
@@ -4370,11 +4471,17 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	targetAliasRepoFn := func() (*talias.Repository, error) {
 		return talias.NewRepository(ctx, rw, rw, kms)
 	}
+	environmentRepoFn := func() (*environment.Repository, error) {
+		return environment.NewRepository(ctx, rw, rw, kms)
+	}
+	targetTemplateRepoFn := func() (*targettemplate.Repository, error) {
+		return targettemplate.NewRepository(ctx, rw, rw, kms)
+	}
 	org, proj := iam.TestScopes(t, iamRepo)
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, nil, statusGracePeriod, 1000, nil)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, targetAliasRepoFn, environmentRepoFn, targetTemplateRepoFn, nil, statusGracePeriod, 1000, nil)
 	require.NoError(t, err)
 
 	// Authorized user gets full permissions
@@ -5,11 +5,14 @@ package tcp
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/libs/endpoint"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/target/store"
@@ -21,6 +24,8 @@ import (
 const (
 	defaultPortField       = "attributes.default_port"
 	defaultClientPortField = "attributes.default_client_port"
+	tlsPassthroughField    = "attributes.tls_passthrough"
+	tlsServerNamesField    = "attributes.tls_server_names"
 )
 
 type attribute struct {
@@ -35,6 +40,12 @@ func (a *attribute) Options() []target.Option {
 	if a.GetDefaultClientPort().GetValue() != 0 {
 		opts = append(opts, target.WithDefaultClientPort(a.GetDefaultClientPort().GetValue()))
 	}
+	if a.GetTlsPassthrough() != nil {
+		opts = append(opts, target.WithTlsPassthrough(a.GetTlsPassthrough().GetValue()))
+	}
+	if a.GetTlsServerNames() != nil {
+		opts = append(opts, target.WithTlsServerNames(strings.TrimSpace(a.GetTlsServerNames().GetValue())))
+	}
 	return opts
 }
 
@@ -58,6 +69,14 @@ func (a *attribute) Vet() map[string]string {
 			badFields[defaultClientPortField] = "Value is greater than maximum port number."
 		}
 	}
+	if names := a.GetTlsServerNames(); names != nil {
+		if !a.GetTlsPassthrough().GetValue() {
+			badFields[tlsServerNamesField] = "This field can only be set if tls_passthrough is enabled."
+		}
+		if _, err := endpoint.ParseServerNames(names.GetValue()); err != nil {
+			badFields[tlsServerNamesField] = fmt.Sprintf("Error parsing tls server names: %v.", err)
+		}
+	}
 	return badFields
 }
 
@@ -83,6 +102,11 @@ func (a *attribute) VetForUpdate(p []string) map[string]string {
 			badFields[defaultClientPortField] = "Value is greater than maximum port number."
 		}
 	}
+	if handlers.MaskContains(p, tlsServerNamesField) && a.GetTlsServerNames() != nil {
+		if _, err := endpoint.ParseServerNames(a.GetTlsServerNames().GetValue()); err != nil {
+			badFields[tlsServerNamesField] = fmt.Sprintf("Error parsing tls server names: %v.", err)
+		}
+	}
 	return badFields
 }
 
@@ -110,6 +134,12 @@ func setAttributes(t target.Target, out *pb.Target) error {
 	if t.GetDefaultClientPort() > 0 {
 		attrs.TcpTargetAttributes.DefaultClientPort = &wrappers.UInt32Value{Value: t.GetDefaultClientPort()}
 	}
+	if t.GetTlsPassthrough() {
+		attrs.TcpTargetAttributes.TlsPassthrough = &wrappers.BoolValue{Value: true}
+	}
+	if t.GetTlsServerNames() != "" {
+		attrs.TcpTargetAttributes.TlsServerNames = &wrappers.StringValue{Value: t.GetTlsServerNames()}
+	}
 
 	out.Attrs = attrs
 	return nil
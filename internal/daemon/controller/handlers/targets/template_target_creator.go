@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targets
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/target"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// TemplateTargetCreator creates the targets of the target rules of plugin
+// host catalogs from target templates. It implements the
+// plugin.TargetCreator interface.
+type TemplateTargetCreator struct {
+	repoFn         target.RepositoryFactory
+	templateRepoFn common.TargetTemplateRepoFactory
+}
+
+// NewTemplateTargetCreator returns a TemplateTargetCreator using the provided
+// repositories.
+func NewTemplateTargetCreator(repoFn target.RepositoryFactory, templateRepoFn common.TargetTemplateRepoFactory) *TemplateTargetCreator {
+	return &TemplateTargetCreator{
+		repoFn:         repoFn,
+		templateRepoFn: templateRepoFn,
+	}
+}
+
+// CreateTarget creates a target for the host set from the target template,
+// with the host set added to the host sources of the template, and returns
+// the id of the target.
+func (c *TemplateTargetCreator) CreateTarget(ctx context.Context, templateId, setId string, parameters map[string]string) (string, error) {
+	const op = "targets.(TemplateTargetCreator).CreateTarget"
+	item, err := c.instantiate(ctx, templateId, parameters)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	hostSourceIds := item.GetHostSourceIds()
+	if !slices.Contains(hostSourceIds, setId) {
+		hostSourceIds = append(hostSourceIds, setId)
+	}
+	createOpts := []target.Option{target.WithHostSources(hostSourceIds)}
+	credSources := target.CredentialSources{
+		BrokeredCredentialIds:            item.GetBrokeredCredentialSourceIds(),
+		InjectedApplicationCredentialIds: item.GetInjectedApplicationCredentialSourceIds(),
+	}
+	if len(credSources.BrokeredCredentialIds) > 0 || len(credSources.InjectedApplicationCredentialIds) > 0 {
+		createOpts = append(createOpts, target.WithCredentialSources(credSources))
+	}
+	item.HostSourceIds = nil
+	item.BrokeredCredentialSourceIds = nil
+	item.InjectedApplicationCredentialSourceIds = nil
+	if err := validateCreateRequest(&pbs.CreateTargetRequest{Item: item}); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+
+	t, _, _, err := Service{repoFn: c.repoFn}.createInRepo(ctx, item, createOpts...)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return t.GetPublicId(), nil
+}
+
+// UpdateTarget updates the name and description of the target created for
+// the host set from the target template if they no longer match the
+// template, and adds the host set back to the host sources of the target if
+// it was removed.
+func (c *TemplateTargetCreator) UpdateTarget(ctx context.Context, targetId, templateId, setId string, parameters map[string]string) error {
+	const op = "targets.(TemplateTargetCreator).UpdateTarget"
+	item, err := c.instantiate(ctx, templateId, parameters)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	repo, err := c.repoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	t, err := repo.LookupTarget(ctx, targetId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if t == nil {
+		return errors.New(ctx, errors.RecordNotFound, op, "target not found")
+	}
+	version := t.GetVersion()
+
+	var mask []string
+	update := &pb.Target{}
+	if name := item.GetName().GetValue(); name != "" && name != t.GetName() {
+		mask = append(mask, globals.NameField)
+		update.Name = wrapperspb.String(name)
+	}
+	if desc := item.GetDescription().GetValue(); desc != t.GetDescription() {
+		mask = append(mask, globals.DescriptionField)
+		update.Description = item.GetDescription()
+	}
+	if len(mask) > 0 {
+		update.Version = version
+		u, _, _, err := Service{repoFn: c.repoFn}.updateInRepo(ctx, t.GetProjectId(), targetId, mask, update)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		version = u.GetVersion()
+	}
+
+	for _, hs := range t.GetHostSources() {
+		if hs.Id() == setId {
+			return nil
+		}
+	}
+	if _, err := repo.AddTargetHostSources(ctx, targetId, version, []string{setId}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// instantiate returns the target defined by the target template with the
+// parameters substituted.
+func (c *TemplateTargetCreator) instantiate(ctx context.Context, templateId string, parameters map[string]string) (*pb.Target, error) {
+	const op = "targets.(TemplateTargetCreator).instantiate"
+	repo, err := c.templateRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	tmpl, err := repo.LookupTargetTemplate(ctx, templateId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if tmpl == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "target template not found")
+	}
+	return instantiateTemplate(ctx, tmpl, parameters)
+}
+
+var _ plugin.TargetCreator = (*TemplateTargetCreator)(nil)
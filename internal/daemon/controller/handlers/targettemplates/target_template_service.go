@@ -0,0 +1,605 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targettemplates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/targettemplate"
+	"github.com/hashicorp/boundary/internal/targettemplate/store"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	targetspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targettemplates"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	maskManager handlers.MaskManager
+
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.NewActionSet(
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+	)
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.NewActionSet(
+		action.Create,
+		action.List,
+	)
+)
+
+func init() {
+	var err error
+	if maskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&store.TargetTemplate{}},
+		handlers.MaskSource{&pb.TargetTemplate{}},
+	); err != nil {
+		panic(err)
+	}
+
+	// TODO: refactor to remove IdActionsMap and CollectionActions package variables
+	action.RegisterResource(resource.TargetTemplate, IdActions, CollectionActions)
+}
+
+// Service handles requests as described by the pbs.TargetTemplateServiceServer
+// interface.
+type Service struct {
+	pbs.UnsafeTargetTemplateServiceServer
+
+	repoFn    common.TargetTemplateRepoFactory
+	iamRepoFn common.IamRepoFactory
+}
+
+var _ pbs.TargetTemplateServiceServer = (*Service)(nil)
+
+// NewService returns a target template service which handles target template
+// related requests to boundary.
+func NewService(ctx context.Context, repo common.TargetTemplateRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
+	const op = "targettemplates.NewService"
+	if repo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing target template repository")
+	}
+	if iamRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	}
+	return Service{repoFn: repo, iamRepoFn: iamRepo}, nil
+}
+
+// ListTargetTemplates implements the interface pbs.TargetTemplateServiceServer.
+func (s Service) ListTargetTemplates(ctx context.Context, req *pbs.ListTargetTemplatesRequest) (*pbs.ListTargetTemplatesResponse, error) {
+	if err := validateListRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	scopeIds, scopeInfoMap, err := scopeids.GetListingScopeIds(
+		ctx, s.iamRepoFn, authResults, req.GetScopeId(), resource.TargetTemplate, req.GetRecursive())
+	if err != nil {
+		return nil, err
+	}
+	// If no scopes match, return an empty response
+	if len(scopeIds) == 0 {
+		return &pbs.ListTargetTemplatesResponse{}, nil
+	}
+
+	tl, err := s.listFromRepo(ctx, scopeIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(tl) == 0 {
+		return &pbs.ListTargetTemplatesResponse{}, nil
+	}
+
+	filter, err := handlers.NewFilter(ctx, req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	finalItems := make([]*pb.TargetTemplate, 0, len(tl))
+	res := perms.Resource{
+		Type: resource.TargetTemplate,
+	}
+	for _, item := range tl {
+		res.Id = item.GetPublicId()
+		res.ScopeId = item.GetProjectId()
+		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+		if len(authorizedActions) == 0 {
+			continue
+		}
+
+		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+		outputOpts := make([]handlers.Option, 0, 3)
+		outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+		if outputFields.Has(globals.ScopeField) {
+			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetProjectId()]))
+		}
+		if outputFields.Has(globals.AuthorizedActionsField) {
+			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+		}
+
+		item, err := toProto(ctx, item, outputOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter.Match(item) {
+			finalItems = append(finalItems, item)
+		}
+	}
+	return &pbs.ListTargetTemplatesResponse{Items: finalItems}, nil
+}
+
+// GetTargetTemplate implements the interface pbs.TargetTemplateServiceServer.
+func (s Service) GetTargetTemplate(ctx context.Context, req *pbs.GetTargetTemplateRequest) (*pbs.GetTargetTemplateResponse, error) {
+	const op = "targettemplates.(Service).GetTargetTemplate"
+
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	t, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputOpts, err := outputOptions(ctx, authResults, t.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item, err := toProto(ctx, t, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.GetTargetTemplateResponse{Item: item}, nil
+}
+
+// CreateTargetTemplate implements the interface pbs.TargetTemplateServiceServer.
+func (s Service) CreateTargetTemplate(ctx context.Context, req *pbs.CreateTargetTemplateRequest) (*pbs.CreateTargetTemplateResponse, error) {
+	const op = "targettemplates.(Service).CreateTargetTemplate"
+
+	if err := validateCreateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetItem().GetScopeId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	t, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputOpts, err := outputOptions(ctx, authResults, t.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item, err := toProto(ctx, t, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.CreateTargetTemplateResponse{Item: item, Uri: fmt.Sprintf("target-templates/%s", item.GetId())}, nil
+}
+
+// UpdateTargetTemplate implements the interface pbs.TargetTemplateServiceServer.
+func (s Service) UpdateTargetTemplate(ctx context.Context, req *pbs.UpdateTargetTemplateRequest) (*pbs.UpdateTargetTemplateResponse, error) {
+	const op = "targettemplates.(Service).UpdateTargetTemplate"
+
+	if err := validateUpdateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	t, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputOpts, err := outputOptions(ctx, authResults, t.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item, err := toProto(ctx, t, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.UpdateTargetTemplateResponse{Item: item}, nil
+}
+
+// DeleteTargetTemplate implements the interface pbs.TargetTemplateServiceServer.
+func (s Service) DeleteTargetTemplate(ctx context.Context, req *pbs.DeleteTargetTemplateRequest) (*pbs.DeleteTargetTemplateResponse, error) {
+	if err := validateDeleteRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Delete)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	_, err := s.deleteFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, projectIds []string) ([]*targettemplate.TargetTemplate, error) {
+	const op = "targettemplates.(Service).listFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	tl, err := repo.ListTargetTemplates(ctx, projectIds, targettemplate.WithLimit(-1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return tl, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*targettemplate.TargetTemplate, error) {
+	const op = "targettemplates.(Service).getFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	t, err := repo.LookupTargetTemplate(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if t == nil {
+		return nil, handlers.NotFoundErrorf("Target template %q doesn't exist.", id)
+	}
+	return t, nil
+}
+
+func (s Service) createInRepo(ctx context.Context, projectId string, item *pb.TargetTemplate) (*targettemplate.TargetTemplate, error) {
+	const op = "targettemplates.(Service).createInRepo"
+	definition, err := definitionToStorage(item.GetDefinition())
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to convert definition for creation: %v.", err)
+	}
+	t, err := targettemplate.NewTargetTemplate(ctx, projectId, definition, toOptions(item)...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build target template for creation: %v.", err)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.CreateTargetTemplate(ctx, t)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create target template but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, projectId, id string, mask []string, item *pb.TargetTemplate) (*targettemplate.TargetTemplate, error) {
+	const op = "targettemplates.(Service).updateInRepo"
+	version := item.GetVersion()
+	t := &targettemplate.TargetTemplate{
+		TargetTemplate: &store.TargetTemplate{
+			PublicId:    id,
+			ProjectId:   projectId,
+			Name:        item.GetName().GetValue(),
+			Description: item.GetDescription().GetValue(),
+		},
+	}
+	if item.GetDefinition() != nil {
+		definition, err := definitionToStorage(item.GetDefinition())
+		if err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to convert definition for update: %v.", err)
+		}
+		t.Definition = definition
+	}
+	dbMask := maskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateTargetTemplate(ctx, t, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Target template %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
+	const op = "targettemplates.(Service).deleteFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return false, err
+	}
+	rows, err := repo.DeleteTargetTemplate(ctx, id)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target template"))
+	}
+	return rows > 0, nil
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.TargetTemplate), auth.WithAction(a)}
+	switch a {
+	case action.List, action.Create:
+		parentId = id
+		scp, err := iamRepo.LookupScope(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if scp == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+	default:
+		repo, err := s.repoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		t, err := repo.LookupTargetTemplate(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if t == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = t.GetProjectId()
+		opts = append(opts, auth.WithId(id))
+	}
+	opts = append(opts, auth.WithScopeId(parentId))
+	return auth.Verify(ctx, opts...)
+}
+
+func outputOptions(ctx context.Context, authResults auth.VerifyResults, id string) ([]handlers.Option, error) {
+	const op = "targettemplates.outputOptions"
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, id, IdActions).Strings()))
+	}
+	return outputOpts, nil
+}
+
+func toOptions(item *pb.TargetTemplate) []targettemplate.Option {
+	var opts []targettemplate.Option
+	if item.GetName() != nil {
+		opts = append(opts, targettemplate.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, targettemplate.WithDescription(item.GetDescription().GetValue()))
+	}
+	return opts
+}
+
+func toProto(ctx context.Context, in *targettemplate.TargetTemplate, opt ...handlers.Option) (*pb.TargetTemplate, error) {
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building target template proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.TargetTemplate{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if outputFields.Has(globals.ScopeIdField) {
+		out.ScopeId = in.GetProjectId()
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.NameField) && in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.GetVersion()
+	}
+	if outputFields.Has(globals.DefinitionField) {
+		definition := &structpb.Struct{}
+		if err := protojson.Unmarshal([]byte(in.GetDefinition()), definition); err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to convert definition of target template: %v.", err)
+		}
+		out.Definition = definition
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	return &out, nil
+}
+
+// definitionToTarget converts the definition of a target template into the
+// target it describes.
+func definitionToTarget(definition *structpb.Struct) (*targetspb.Target, error) {
+	b, err := protojson.Marshal(definition)
+	if err != nil {
+		return nil, err
+	}
+	t := &targetspb.Target{}
+	if err := protojson.Unmarshal(b, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// definitionToStorage converts the definition of a target template into the
+// JSON encoding of the target it describes, which is how it is stored.
+func definitionToStorage(definition *structpb.Struct) (string, error) {
+	t, err := definitionToTarget(definition)
+	if err != nil {
+		return "", err
+	}
+	b, err := protojson.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// validateDefinition returns a description of the problem with the
+// definition, or an empty string if it is valid. A definition must describe
+// a target of a known type without any of the fields set by Boundary, and
+// each of its string values must be a valid template.
+func validateDefinition(ctx context.Context, definition *structpb.Struct) string {
+	t, err := definitionToTarget(definition)
+	if err != nil {
+		return fmt.Sprintf("This field does not describe a target: %v.", err)
+	}
+	switch {
+	case t.GetType() == "":
+		return "The type of the target is required."
+	case target.SubtypeFromType(t.GetType()) == "":
+		return "Unknown target type provided."
+	case t.GetId() != "",
+		t.GetScopeId() != "",
+		t.GetScope() != nil,
+		t.GetCreatedTime() != nil,
+		t.GetUpdatedTime() != nil,
+		t.GetVersion() != 0,
+		len(t.GetHostSources()) > 0,
+		len(t.GetBrokeredCredentialSources()) > 0,
+		len(t.GetInjectedApplicationCredentialSources()) > 0,
+		len(t.GetAliases()) > 0,
+		len(t.GetAuthorizedActions()) > 0:
+		return "The read only fields of a target cannot be set."
+	case len(t.GetWithAliases()) > 0:
+		return "Aliases cannot be created from a target template."
+	}
+	if err := targettemplate.ValidateDefinition(ctx, t); err != nil {
+		return fmt.Sprintf("This field contains an invalid template: %v.", err)
+	}
+	return ""
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetTargetTemplateRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.TargetTemplatePrefix)
+}
+
+func validateCreateRequest(ctx context.Context, req *pbs.CreateTargetTemplateRequest) error {
+	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
+		item := req.GetItem()
+		badFields := map[string]string{}
+		if !handlers.ValidId(handlers.Id(item.GetScopeId()), scope.Project.Prefix()) {
+			badFields[globals.ScopeIdField] = "This field is missing or improperly formatted."
+		}
+		if item.GetDefinition() == nil {
+			badFields[globals.DefinitionField] = "This field is required."
+		} else if msg := validateDefinition(ctx, item.GetDefinition()); msg != "" {
+			badFields[globals.DefinitionField] = msg
+		}
+		return badFields
+	})
+}
+
+func validateUpdateRequest(ctx context.Context, req *pbs.UpdateTargetTemplateRequest) error {
+	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.DefinitionField) {
+			if req.GetItem().GetDefinition() == nil {
+				badFields[globals.DefinitionField] = "This field cannot be unset."
+			} else if msg := validateDefinition(ctx, req.GetItem().GetDefinition()); msg != "" {
+				badFields[globals.DefinitionField] = msg
+			}
+		}
+		return badFields
+	}, globals.TargetTemplatePrefix)
+}
+
+func validateDeleteRequest(req *pbs.DeleteTargetTemplateRequest) error {
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.TargetTemplatePrefix)
+}
+
+func validateListRequest(ctx context.Context, req *pbs.ListTargetTemplatesRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) &&
+		!handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) &&
+		req.GetScopeId() != scope.Global.String() {
+		badFields[globals.ScopeIdField] = "Incorrectly formatted identifier."
+	}
+	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targettemplates_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targettemplates"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	_ "github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/targettemplate"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targettemplates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestCrud(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*targettemplate.Repository, error) {
+		return targettemplate.NewRepository(ctx, rw, rw, testKms)
+	}
+	o, p := iam.TestScopes(t, iamRepo)
+
+	s, err := targettemplates.NewService(ctx, repoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	definition, err := structpb.NewStruct(map[string]any{
+		"type":    "tcp",
+		"name":    "{{.service}}-db",
+		"address": "{{.service}}.db.internal",
+		"attributes": map[string]any{
+			"default_port": 5432,
+		},
+	})
+	require.NoError(t, err)
+
+	created, err := s.CreateTargetTemplate(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.CreateTargetTemplateRequest{Item: &pb.TargetTemplate{
+		ScopeId:    p.GetPublicId(),
+		Name:       wrapperspb.String("db"),
+		Definition: definition,
+	}})
+	require.NoError(t, err)
+	id := created.GetItem().GetId()
+	assert.Equal(t, "target-templates/"+id, created.GetUri())
+	assert.Equal(t, "{{.service}}-db", created.GetItem().GetDefinition().GetFields()["name"].GetStringValue())
+
+	t.Run("create-invalid", func(t *testing.T) {
+		cases := []struct {
+			name       string
+			item       *pb.TargetTemplate
+			definition map[string]any
+		}{
+			{
+				name:       "org-scope",
+				item:       &pb.TargetTemplate{ScopeId: o.GetPublicId()},
+				definition: map[string]any{"type": "tcp"},
+			},
+			{
+				name: "missing-definition",
+				item: &pb.TargetTemplate{ScopeId: p.GetPublicId()},
+			},
+			{
+				name:       "missing-type",
+				item:       &pb.TargetTemplate{ScopeId: p.GetPublicId()},
+				definition: map[string]any{"name": "db"},
+			},
+			{
+				name:       "unknown-type",
+				item:       &pb.TargetTemplate{ScopeId: p.GetPublicId()},
+				definition: map[string]any{"type": "unknown"},
+			},
+			{
+				name:       "read-only-field",
+				item:       &pb.TargetTemplate{ScopeId: p.GetPublicId()},
+				definition: map[string]any{"type": "tcp", "id": "ttcp_1234567890"},
+			},
+			{
+				name:       "invalid-template",
+				item:       &pb.TargetTemplate{ScopeId: p.GetPublicId()},
+				definition: map[string]any{"type": "tcp", "name": "{{.service"},
+			},
+			{
+				name:       "id-set",
+				item:       &pb.TargetTemplate{Id: "ttpl_1234567890", ScopeId: p.GetPublicId()},
+				definition: map[string]any{"type": "tcp"},
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				if tc.definition != nil {
+					def, err := structpb.NewStruct(tc.definition)
+					require.NoError(t, err)
+					tc.item.Definition = def
+				}
+				_, err := s.CreateTargetTemplate(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.CreateTargetTemplateRequest{Item: tc.item})
+				require.Error(t, err)
+				assert.ErrorIs(t, err, handlers.ApiErrorWithCode(codes.InvalidArgument))
+			})
+		}
+	})
+
+	t.Run("get", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.GetTargetTemplate(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.GetTargetTemplateRequest{Id: id})
+		require.NoError(err)
+		assert.Equal("db", got.GetItem().GetName().GetValue())
+		assert.Equal(p.GetPublicId(), got.GetItem().GetScopeId())
+		assert.Equal("tcp", got.GetItem().GetDefinition().GetFields()["type"].GetStringValue())
+
+		_, err = s.GetTargetTemplate(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.GetTargetTemplateRequest{Id: "ttpl_doesntexis"})
+		assert.ErrorIs(err, handlers.ApiErrorWithCode(codes.NotFound))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.UpdateTargetTemplate(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.UpdateTargetTemplateRequest{
+			Id: id,
+			Item: &pb.TargetTemplate{
+				Version:     created.GetItem().GetVersion(),
+				Description: wrapperspb.String("databases"),
+			},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"description"}},
+		})
+		require.NoError(err)
+		assert.Equal("databases", got.GetItem().GetDescription().GetValue())
+		assert.Equal("{{.service}}-db", got.GetItem().GetDefinition().GetFields()["name"].GetStringValue())
+		assert.Equal(created.GetItem().GetVersion()+1, got.GetItem().GetVersion())
+
+		_, err = s.UpdateTargetTemplate(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.UpdateTargetTemplateRequest{
+			Id: id,
+			Item: &pb.TargetTemplate{
+				Version: got.GetItem().GetVersion(),
+			},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"definition"}},
+		})
+		assert.ErrorIs(err, handlers.ApiErrorWithCode(codes.InvalidArgument))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.ListTargetTemplates(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.ListTargetTemplatesRequest{ScopeId: p.GetPublicId()})
+		require.NoError(err)
+		require.Len(got.GetItems(), 1)
+		assert.Equal(id, got.GetItems()[0].GetId())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := s.DeleteTargetTemplate(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.DeleteTargetTemplateRequest{Id: id})
+		require.NoError(err)
+
+		_, err = s.GetTargetTemplate(auth.DisabledAuthTestContext(iamRepoFn, p.GetPublicId()), &pbs.GetTargetTemplateRequest{Id: id})
+		assert.ErrorIs(err, handlers.ApiErrorWithCode(codes.NotFound))
+	})
+}
@@ -47,6 +47,7 @@ var (
 		action.SetAccounts,
 		action.RemoveAccounts,
 		action.ListResolvableAliases,
+		action.Impersonate,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -102,6 +103,9 @@ func (s Service) ListUsers(ctx context.Context, req *pbs.ListUsersRequest) (*pbs
 	if err := validateListRequest(ctx, req); err != nil {
 		return nil, err
 	}
+	if req.GetExactCount() {
+		ctx = pagination.WithExactCount(ctx)
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package workers
+
+import (
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withDiagnostics *common.WorkerDiagnosticsBroker
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDiagnostics provides an option to set the broker used to read the logs
+// and metrics of workers. Without it, the logs of workers cannot be read.
+func WithDiagnostics(b *common.WorkerDiagnosticsBroker) Option {
+	return func(o *options) {
+		o.withDiagnostics = b
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"encoding/hex"
 	stderrors "errors"
 	"fmt"
+	"net"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/server"
@@ -29,17 +32,30 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/workers"
 	"github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"github.com/hashicorp/nodeenrollment"
 	"github.com/hashicorp/nodeenrollment/types"
 	"github.com/mr-tron/base58"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
 	PkiWorkerType = "pki"
 	KmsWorkerType = "kms"
+
+	// defaultActivationTokenTtl is the lifetime of an activation token created
+	// with CreateWorkerActivationToken if the request does not specify one.
+	defaultActivationTokenTtl = time.Hour
+
+	// defaultReadLogsMinutes is the number of minutes of logs returned by
+	// ReadWorkerLogs if the request does not specify one.
+	defaultReadLogsMinutes = 5
+	// maxReadLogsMinutes is the maximum number of minutes of logs that can be
+	// requested with ReadWorkerLogs. Workers do not buffer more than that.
+	maxReadLogsMinutes = 30
 )
 
 var (
@@ -55,6 +71,7 @@ var (
 		action.AddWorkerTags,
 		action.SetWorkerTags,
 		action.RemoveWorkerTags,
+		action.ReadLogs,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -65,10 +82,19 @@ var (
 		action.List,
 		action.ReadCertificateAuthority,
 		action.ReinitializeCertificateAuthority,
+		action.CreateWorkerActivationToken,
+		action.ListWorkerActivationTokens,
+		action.RevokeWorkerActivationToken,
+		action.ListWorkerUtilization,
 	)
 	// downstreamWorkers returns a list of worker ids which are directly
 	// connected downstream of the provided worker.
 	downstreamWorkers = emptyDownstreamWorkers
+
+	// readLogsTimeout is how long ReadWorkerLogs waits for the worker to send
+	// its logs. Workers report their status every few seconds, and send their
+	// logs in the status request following the one the request was sent in.
+	readLogsTimeout = 30 * time.Second
 )
 
 func init() {
@@ -97,13 +123,15 @@ type Service struct {
 	workerAuthFn common.WorkerAuthRepoStorageFactory
 	iamRepoFn    common.IamRepoFactory
 	downstreams  common.Downstreamers
+	diagnostics  *common.WorkerDiagnosticsBroker
 }
 
 var _ pbs.WorkerServiceServer = (*Service)(nil)
 
-// NewService returns a worker service which handles worker related requests to boundary.
+// NewService returns a worker service which handles worker related requests to
+// boundary. The supported option is WithDiagnostics.
 func NewService(ctx context.Context, repo common.ServersRepoFactory, iamRepoFn common.IamRepoFactory,
-	workerAuthFn common.WorkerAuthRepoStorageFactory, ds common.Downstreamers,
+	workerAuthFn common.WorkerAuthRepoStorageFactory, ds common.Downstreamers, opt ...Option,
 ) (Service, error) {
 	const op = "workers.NewService"
 	if repo == nil {
@@ -115,7 +143,8 @@ func NewService(ctx context.Context, repo common.ServersRepoFactory, iamRepoFn c
 	if workerAuthFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing worker auth repository")
 	}
-	return Service{repoFn: repo, iamRepoFn: iamRepoFn, workerAuthFn: workerAuthFn, downstreams: ds}, nil
+	opts := getOpts(opt...)
+	return Service{repoFn: repo, iamRepoFn: iamRepoFn, workerAuthFn: workerAuthFn, downstreams: ds, diagnostics: opts.withDiagnostics}, nil
 }
 
 // ListWorkers implements the interface pbs.WorkerServiceServer.
@@ -575,6 +604,256 @@ func (s Service) ReinitializeCertificateAuthority(ctx context.Context, req *pbs.
 	return &pbs.ReinitializeCertificateAuthorityResponse{Item: ca}, nil
 }
 
+// CreateWorkerActivationToken implements the interface pbs.WorkerServiceServer
+// and handles a request to create a new worker, generating and returning a
+// single-use activation token that expires
+func (s Service) CreateWorkerActivationToken(ctx context.Context, req *pbs.CreateWorkerActivationTokenRequest) (*pbs.CreateWorkerActivationTokenResponse, error) {
+	const op = "workers.(Service).CreateWorkerActivationToken"
+	if err := validateCreateActivationTokenRequest(req); err != nil {
+		return nil, err
+	}
+	item := req.GetItem()
+
+	authResults := s.authResult(ctx, item.GetScopeId(), action.CreateWorkerActivationToken)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	ttl := defaultActivationTokenTtl
+	if item.GetTtlSeconds() > 0 {
+		ttl = time.Duration(item.GetTtlSeconds()) * time.Second
+	}
+	allowedCidrs := make([]string, 0, len(item.GetAllowedCidrs()))
+	for _, c := range item.GetAllowedCidrs() {
+		// The cidrs have been validated, so this only normalizes them.
+		_, ipNet, err := net.ParseCIDR(c)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		allowedCidrs = append(allowedCidrs, ipNet.String())
+	}
+
+	created, err := s.createActivationTokenInRepo(ctx, item, ttl, allowedCidrs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.WorkerActivationToken{
+		WorkerId:       created.GetPublicId(),
+		ScopeId:        created.GetScopeId(),
+		CreatedTime:    created.GetCreateTime().GetTimestamp(),
+		ExpirationTime: created.ControllerGeneratedActivationTokenExpirationTime.GetTimestamp(),
+		Token:          created.ControllerGeneratedActivationToken,
+	}
+	if created.GetName() != "" {
+		out.Name = wrapperspb.String(created.GetName())
+	}
+	if created.GetDescription() != "" {
+		out.Description = wrapperspb.String(created.GetDescription())
+	}
+	if len(allowedCidrs) > 0 {
+		out.AllowedCidrs = allowedCidrs
+	}
+	return &pbs.CreateWorkerActivationTokenResponse{Item: out}, nil
+}
+
+// ListWorkerActivationTokens implements the interface pbs.WorkerServiceServer
+// and lists the activation tokens that have not been used yet
+func (s Service) ListWorkerActivationTokens(ctx context.Context, req *pbs.ListWorkerActivationTokensRequest) (*pbs.ListWorkerActivationTokensResponse, error) {
+	const op = "workers.(Service).ListWorkerActivationTokens"
+	if err := validateListActivationTokensRequest(req); err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, req.GetScopeId(), action.ListWorkerActivationTokens)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := repo.ListWorkerActivationTokens(ctx, []string{req.GetScopeId()})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	items := make([]*pb.WorkerActivationToken, 0, len(tokens))
+	for _, t := range tokens {
+		items = append(items, activationTokenToProto(t))
+	}
+	return &pbs.ListWorkerActivationTokensResponse{Items: items}, nil
+}
+
+// RevokeWorkerActivationToken implements the interface pbs.WorkerServiceServer
+// and revokes an activation token that has not been used yet, deleting the
+// worker it was created for
+func (s Service) RevokeWorkerActivationToken(ctx context.Context, req *pbs.RevokeWorkerActivationTokenRequest) (*pbs.RevokeWorkerActivationTokenResponse, error) {
+	const op = "workers.(Service).RevokeWorkerActivationToken"
+	if err := validateRevokeActivationTokenRequest(req); err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, req.GetScopeId(), action.RevokeWorkerActivationToken)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	if err := repo.RevokeWorkerActivationToken(ctx, req.GetWorkerId()); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Worker %q has no activation token that has not been used.", req.GetWorkerId())
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.RevokeWorkerActivationTokenResponse{}, nil
+}
+
+// ReadWorkerLogs implements the interface pbs.WorkerServiceServer.
+func (s Service) ReadWorkerLogs(ctx context.Context, req *pbs.ReadWorkerLogsRequest) (*pbs.ReadWorkerLogsResponse, error) {
+	const op = "workers.(Service).ReadWorkerLogs"
+
+	if err := validateReadLogsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ReadLogs)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if s.diagnostics == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unimplemented, "Reading the logs of workers is not supported.")
+	}
+
+	minutes := req.GetMinutes()
+	if minutes == 0 {
+		minutes = defaultReadLogsMinutes
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, readLogsTimeout)
+	defer cancel()
+	d, err := s.diagnostics.Request(reqCtx, req.GetId(), func(requestId string) *serverpb.WorkerDiagnosticsRequest {
+		return &serverpb.WorkerDiagnosticsRequest{
+			RequestId: requestId,
+			Minutes:   minutes,
+		}
+	})
+	switch {
+	case stderrors.Is(err, common.ErrWorkerRequestTimeout):
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.DeadlineExceeded,
+			"Worker %q did not send its logs in time. It may be offline or connected to another controller.", req.GetId())
+	case err != nil:
+		return nil, errors.Wrap(ctx, err, op)
+	case d.GetError() != "":
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unavailable, "Worker %q was unable to gather its logs: %s", req.GetId(), d.GetError())
+	}
+
+	return &pbs.ReadWorkerLogsResponse{Item: &pb.WorkerLogs{
+		WorkerId:      req.GetId(),
+		Minutes:       minutes,
+		LogLines:      d.GetLogLines(),
+		Metrics:       d.GetMetrics(),
+		CollectedTime: timestamppb.Now(),
+	}}, nil
+}
+
+// ListWorkerUtilization implements the interface pbs.WorkerServiceServer
+// and lists the utilization last reported by the live workers, along with
+// their totals
+func (s Service) ListWorkerUtilization(ctx context.Context, req *pbs.ListWorkerUtilizationRequest) (*pbs.ListWorkerUtilizationResponse, error) {
+	const op = "workers.(Service).ListWorkerUtilization"
+	if err := validateListUtilizationRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, req.GetScopeId(), action.ListWorkerUtilization)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	// Workers that have not reported their status recently are not proxying
+	// anything, so they are not included in the utilization.
+	workers, err := repo.ListWorkers(ctx, []string{req.GetScopeId()}, server.WithLimit(-1))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	workerIds := make([]string, 0, len(workers))
+	for _, w := range workers {
+		workerIds = append(workerIds, w.GetPublicId())
+	}
+	utilization, err := repo.ListWorkerUtilization(ctx, workerIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	utilizationById := make(map[string]*server.WorkerUtilization, len(utilization))
+	for _, u := range utilization {
+		utilizationById[u.WorkerId] = u
+	}
+
+	filter, err := handlers.NewFilter(ctx, req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*pb.WorkerUtilization, 0, len(workers))
+	totals := &pb.WorkerUtilizationTotals{}
+	for _, w := range workers {
+		item, err := utilizationToProto(w, utilizationById[w.GetPublicId()])
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if !filter.Match(item) {
+			continue
+		}
+		items = append(items, item)
+		totals.WorkerCount++
+		totals.ActiveSessionCount += item.GetActiveSessionCount()
+		totals.ActiveConnectionCount += item.GetActiveConnectionCount()
+		totals.BytesUpPerSecond += item.GetBytesUpPerSecond()
+		totals.BytesDownPerSecond += item.GetBytesDownPerSecond()
+		totals.AverageProxyCpuUtilization += item.GetProxyCpuUtilization()
+		totals.SpoolBacklogBytes += item.GetSpoolBacklogBytes()
+	}
+	if totals.WorkerCount > 0 {
+		totals.AverageProxyCpuUtilization /= float64(totals.WorkerCount)
+	}
+	return &pbs.ListWorkerUtilizationResponse{Items: items, Totals: totals}, nil
+}
+
+func (s Service) createActivationTokenInRepo(ctx context.Context, item *pb.WorkerActivationToken, ttl time.Duration, allowedCidrs []string) (*server.Worker, error) {
+	const op = "workers.(Service).createActivationTokenInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]*server.Tag, 0, len(item.GetApiTags()))
+	for k, lv := range item.GetApiTags() {
+		for _, v := range lv.GetValues() {
+			tags = append(tags, &server.Tag{Key: k, Value: v.GetStringValue()})
+		}
+	}
+	newWorker := server.NewWorker(
+		item.GetScopeId(),
+		server.WithName(item.GetName().GetValue()),
+		server.WithDescription(item.GetDescription().GetValue()),
+		server.WithWorkerTags(tags...),
+	)
+	retWorker, err := repo.CreateWorker(ctx, newWorker,
+		server.WithCreateControllerLedActivationToken(true),
+		server.WithActivationTokenLifetime(ttl),
+		server.WithActivationTokenAllowedCidrs(allowedCidrs),
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create worker"))
+	}
+	return retWorker, nil
+}
+
 func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*server.Worker, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -760,7 +1039,9 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.Worker), auth.WithAction(a)}
 	switch a {
-	case action.List, action.CreateWorkerLed, action.CreateControllerLed, action.ReadCertificateAuthority, action.ReinitializeCertificateAuthority:
+	case action.List, action.CreateWorkerLed, action.CreateControllerLed, action.ReadCertificateAuthority, action.ReinitializeCertificateAuthority,
+		action.CreateWorkerActivationToken, action.ListWorkerActivationTokens, action.RevokeWorkerActivationToken,
+		action.ListWorkerUtilization:
 		parentId = id
 	default:
 		w, err := repo.LookupWorker(ctx, id)
@@ -862,6 +1143,9 @@ func (s Service) toProto(ctx context.Context, in *server.Worker, opt ...handlers
 	if outputFields.Has(globals.LocalStorageStateField) {
 		out.LocalStorageState = in.GetLocalStorageState()
 	}
+	if outputFields.Has(globals.LocalityField) && in.GetLocality() != "" {
+		out.Locality = in.GetLocality()
+	}
 	if outputFields.Has(globals.AuthorizedActionsField) && opts.WithAuthorizedActions != nil {
 		out.AuthorizedActions = opts.WithAuthorizedActions
 		possibleKmsWorkerId, err := server.NewWorkerIdFromScopeAndName(ctx, in.GetScopeId(), in.GetName())
@@ -935,6 +1219,23 @@ func (s Service) toProto(ctx context.Context, in *server.Worker, opt ...handlers
 	return &out, nil
 }
 
+func activationTokenToProto(in *server.WorkerActivationToken) *pb.WorkerActivationToken {
+	out := &pb.WorkerActivationToken{
+		WorkerId:       in.WorkerId,
+		ScopeId:        scope.Global.String(),
+		AllowedCidrs:   in.AllowedCidrs,
+		CreatedTime:    in.CreateTime.GetTimestamp(),
+		ExpirationTime: in.ExpirationTime.GetTimestamp(),
+	}
+	if in.Name != "" {
+		out.Name = wrapperspb.String(in.Name)
+	}
+	if in.Description != "" {
+		out.Description = wrapperspb.String(in.Description)
+	}
+	return out
+}
+
 func remoteStorageStatesToMapProto(in map[string]*plugin.StorageBucketCredentialState) (map[string]*pb.RemoteStorageState, error) {
 	ret := make(map[string]*pb.RemoteStorageState)
 	for storageBucketId, sbcState := range in {
@@ -986,6 +1287,33 @@ func remoteStorageStatesToMapProto(in map[string]*plugin.StorageBucketCredential
 	return ret, nil
 }
 
+// utilizationToProto returns the utilization of the worker. If the worker has
+// not reported its utilization, u is nil and only the worker's details are
+// set.
+func utilizationToProto(w *server.Worker, u *server.WorkerUtilization) (*pb.WorkerUtilization, error) {
+	out := &pb.WorkerUtilization{
+		WorkerId:         w.GetPublicId(),
+		Name:             w.GetName(),
+		OperationalState: w.GetOperationalState(),
+	}
+	if tags := w.CanonicalTags(); len(tags) > 0 {
+		var err error
+		if out.CanonicalTags, err = tagsToMapProto(tags); err != nil {
+			return nil, err
+		}
+	}
+	if u != nil {
+		out.ActiveSessionCount = u.ActiveSessionCount
+		out.ActiveConnectionCount = u.ActiveConnectionCount
+		out.BytesUpPerSecond = float64(u.BytesUpPerSecond)
+		out.BytesDownPerSecond = float64(u.BytesDownPerSecond)
+		out.ProxyCpuUtilization = u.ProxyCpuUtilization
+		out.SpoolBacklogBytes = float64(u.SpoolBacklogBytes)
+		out.UpdateTime = u.UpdateTime.GetTimestamp()
+	}
+	return out, nil
+}
+
 func tagsToMapProto(in map[string][]string) (map[string]*structpb.ListValue, error) {
 	b := make(map[string][]any)
 	for k, v := range in {
@@ -1045,6 +1373,9 @@ func validateUpdateRequest(req *pbs.UpdateWorkerRequest) error {
 		if req.GetItem().GetConfigTags() != nil {
 			badFields[globals.TagsField] = "This is a read only field."
 		}
+		if req.GetItem().GetLocality() != "" {
+			badFields[globals.LocalityField] = "This is a read only field."
+		}
 		nameString := req.GetItem().GetName().String()
 		if !strutil.Printable(nameString) {
 			badFields[globals.NameField] = "Contains non-printable characters"
@@ -1100,6 +1431,9 @@ func validateCreateRequest(item *pb.Worker, act action.Type) error {
 		if item.LastStatusTime != nil {
 			badFields[globals.LastStatusTimeField] = readOnlyFieldMsg
 		}
+		if item.Locality != "" {
+			badFields[globals.LocalityField] = readOnlyFieldMsg
+		}
 		if item.AuthorizedActions != nil {
 			badFields[globals.AuthorizedActionsField] = readOnlyFieldMsg
 		}
@@ -1252,6 +1586,124 @@ func validateReadCaRequest(req *pbs.ReadCertificateAuthorityRequest) error {
 	return nil
 }
 
+func validateCreateActivationTokenRequest(req *pbs.CreateWorkerActivationTokenRequest) error {
+	item := req.GetItem()
+	if util.IsNil(item) {
+		return handlers.InvalidArgumentErrorf("Request item is nil", nil)
+	}
+	const readOnlyFieldMsg = "This is a read only field."
+	badFields := map[string]string{}
+	if item.GetScopeId() != scope.Global.String() {
+		badFields[globals.ScopeIdField] = "Must be 'global'"
+	}
+	if item.GetWorkerId() != "" {
+		badFields[globals.WorkerIdField] = readOnlyFieldMsg
+	}
+	if item.GetCreatedTime() != nil {
+		badFields[globals.CreatedTimeField] = readOnlyFieldMsg
+	}
+	if item.GetExpirationTime() != nil {
+		badFields[globals.ExpirationTimeField] = readOnlyFieldMsg
+	}
+	if item.GetToken() != "" {
+		badFields["token"] = readOnlyFieldMsg
+	}
+	if item.GetName() != nil {
+		nameString := item.GetName().GetValue()
+		switch {
+		case !strutil.Printable(nameString):
+			badFields[globals.NameField] = "Name contains non-printable characters."
+		case strings.ToLower(nameString) != nameString:
+			badFields[globals.NameField] = "Name must be all lowercase."
+		}
+	}
+	if item.GetDescription() != nil && !strutil.Printable(item.GetDescription().GetValue()) {
+		badFields[globals.DescriptionField] = "Description contains non-printable characters."
+	}
+	if time.Duration(item.GetTtlSeconds())*time.Second > nodeenrollment.DefaultMaximumServerLedActivationTokenLifetime {
+		badFields[globals.TtlSecondsField] = fmt.Sprintf("Must be at most %d.", int(nodeenrollment.DefaultMaximumServerLedActivationTokenLifetime.Seconds()))
+	}
+	for _, c := range item.GetAllowedCidrs() {
+		if _, _, err := net.ParseCIDR(c); err != nil {
+			badFields[globals.AllowedCidrsField] = fmt.Sprintf("%q is not in CIDR notation.", c)
+			break
+		}
+	}
+	for k, lv := range item.GetApiTags() {
+		if err := validateStringForDb(k); err != "" {
+			badFields[globals.ApiTagsField] = "Tag keys " + err
+			break
+		}
+		if lv.GetValues() == nil {
+			badFields[globals.ApiTagsField] = "Tag values must be non-empty."
+			break
+		}
+		for _, v := range lv.GetValues() {
+			if _, ok := v.GetKind().(*structpb.Value_StringValue); !ok {
+				badFields[globals.ApiTagsField] = "Tag values must be strings."
+				break
+			}
+			if err := validateStringForDb(v.GetStringValue()); err != "" {
+				badFields[globals.ApiTagsField] = "Tag values " + err
+				break
+			}
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateListActivationTokensRequest(req *pbs.ListWorkerActivationTokensRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() {
+		badFields[globals.ScopeIdField] = "Must be 'global' when listing activation tokens."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateRevokeActivationTokenRequest(req *pbs.RevokeWorkerActivationTokenRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() {
+		badFields[globals.ScopeIdField] = "Must be 'global' when revoking activation tokens."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetWorkerId()), globals.WorkerPrefix) {
+		badFields[globals.WorkerIdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateListUtilizationRequest(ctx context.Context, req *pbs.ListWorkerUtilizationRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() {
+		badFields[globals.ScopeIdField] = "Must be 'global' when listing worker utilization."
+	}
+	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
+		badFields[globals.FilterField] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateReadLogsRequest(req *pbs.ReadWorkerLogsRequest) error {
+	return handlers.ValidateGetRequest(func() map[string]string {
+		badFields := map[string]string{}
+		if req.GetMinutes() > maxReadLogsMinutes {
+			badFields[globals.MinutesField] = fmt.Sprintf("Must be at most %d.", maxReadLogsMinutes)
+		}
+		return badFields
+	}, req, globals.WorkerPrefix)
+}
+
 func validateReinitCaRequest(req *pbs.ReinitializeCertificateAuthorityRequest) error {
 	badFields := map[string]string{}
 	if req.GetScopeId() != scope.Global.String() {
@@ -45,7 +45,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-worker-tags", "set-worker-tags", "remove-worker-tags"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-worker-tags", "set-worker-tags", "remove-worker-tags", "read-logs"}
 
 func structListValue(t *testing.T, ss ...string) *structpb.ListValue {
 	t.Helper()
@@ -2548,6 +2548,99 @@ func TestReadCertificateAuthority(t *testing.T) {
 	}
 }
 
+func TestListWorkerUtilization(t *testing.T) {
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repo, err := server.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	repoFn := func() (*server.Repository, error) {
+		return repo, nil
+	}
+	workerAuthRepo, err := server.NewRepositoryStorage(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	workerAuthRepoFn := func() (*server.WorkerAuthRepositoryStorage, error) {
+		return workerAuthRepo, nil
+	}
+
+	east1 := server.TestKmsWorker(t, conn, wrapper, server.WithWorkerTags(&server.Tag{Key: "region", Value: "east"}))
+	east2 := server.TestKmsWorker(t, conn, wrapper, server.WithWorkerTags(&server.Tag{Key: "region", Value: "east"}))
+	west := server.TestKmsWorker(t, conn, wrapper, server.WithWorkerTags(&server.Tag{Key: "region", Value: "west"}))
+	require.NoError(t, repo.UpsertWorkerUtilization(ctx, &server.WorkerUtilization{
+		WorkerId:              east1.GetPublicId(),
+		ActiveSessionCount:    2,
+		ActiveConnectionCount: 3,
+		BytesUpPerSecond:      100,
+		BytesDownPerSecond:    200,
+		ProxyCpuUtilization:   0.5,
+		SpoolBacklogBytes:     10,
+	}))
+	require.NoError(t, repo.UpsertWorkerUtilization(ctx, &server.WorkerUtilization{
+		WorkerId:              west.GetPublicId(),
+		ActiveSessionCount:    5,
+		ActiveConnectionCount: 5,
+		ProxyCpuUtilization:   0.9,
+	}))
+
+	testSrv, err := NewService(ctx, repoFn, iamRepoFn, workerAuthRepoFn, nil)
+	require.NoError(t, err, "Error when getting new worker service.")
+	authCtx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String())
+
+	t.Run("invalid-scope", func(t *testing.T) {
+		_, err := testSrv.ListWorkerUtilization(authCtx, &pbs.ListWorkerUtilizationRequest{ScopeId: "o_1234567890"})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, handlers.ApiErrorWithCode(codes.InvalidArgument))
+	})
+
+	t.Run("invalid-filter", func(t *testing.T) {
+		_, err := testSrv.ListWorkerUtilization(authCtx, &pbs.ListWorkerUtilizationRequest{ScopeId: scope.Global.String(), Filter: "foo=="})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, handlers.ApiErrorWithCode(codes.InvalidArgument))
+	})
+
+	t.Run("all", func(t *testing.T) {
+		got, err := testSrv.ListWorkerUtilization(authCtx, &pbs.ListWorkerUtilizationRequest{ScopeId: scope.Global.String()})
+		require.NoError(t, err)
+		assert.Len(t, got.GetItems(), 3)
+		assert.Equal(t, uint32(3), got.GetTotals().GetWorkerCount())
+		assert.Equal(t, uint32(7), got.GetTotals().GetActiveSessionCount())
+		assert.Equal(t, uint32(8), got.GetTotals().GetActiveConnectionCount())
+		assert.InDelta(t, 1.4/3, got.GetTotals().GetAverageProxyCpuUtilization(), 0.0001)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		got, err := testSrv.ListWorkerUtilization(authCtx, &pbs.ListWorkerUtilizationRequest{
+			ScopeId: scope.Global.String(),
+			Filter:  `"east" in "/item/canonical_tags/region"`,
+		})
+		require.NoError(t, err)
+		require.Len(t, got.GetItems(), 2)
+		byId := map[string]*pb.WorkerUtilization{}
+		for _, i := range got.GetItems() {
+			byId[i.GetWorkerId()] = i
+		}
+		require.Contains(t, byId, east1.GetPublicId())
+		assert.Equal(t, uint32(2), byId[east1.GetPublicId()].GetActiveSessionCount())
+		assert.Equal(t, float64(100), byId[east1.GetPublicId()].GetBytesUpPerSecond())
+		assert.NotNil(t, byId[east1.GetPublicId()].GetUpdateTime())
+		require.Contains(t, byId, east2.GetPublicId())
+		assert.Nil(t, byId[east2.GetPublicId()].GetUpdateTime())
+
+		assert.Equal(t, uint32(2), got.GetTotals().GetWorkerCount())
+		assert.Equal(t, uint32(2), got.GetTotals().GetActiveSessionCount())
+		assert.Equal(t, float64(200), got.GetTotals().GetBytesDownPerSecond())
+		assert.Equal(t, float64(10), got.GetTotals().GetSpoolBacklogBytes())
+		assert.InDelta(t, 0.25, got.GetTotals().GetAverageProxyCpuUtilization(), 0.0001)
+	})
+}
+
 func TestReinitializeCertificateAuthority(t *testing.T) {
 	require, assert := require.New(t), assert.New(t)
 	ctx := context.Background()
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/health"
+	"github.com/hashicorp/boundary/internal/db/schema"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/types/scope"
+)
+
+// healthDependencyChecks returns the checks of the dependencies of the
+// controller reported by the readiness endpoint. Worker connectivity is
+// optional, since a controller without workers can still serve the API.
+func (c *Controller) healthDependencyChecks() []health.DependencyCheck {
+	return []health.DependencyCheck{
+		{Name: "database", Check: c.checkDatabaseHealth},
+		{Name: "kms", Check: c.checkKmsHealth},
+		{Name: "migrations", Check: c.checkMigrationsHealth},
+		{Name: "workers", Optional: true, Check: c.checkWorkersHealth},
+	}
+}
+
+func (c *Controller) checkDatabaseHealth(ctx context.Context) (string, error) {
+	if c.conf.Database == nil {
+		return "", fmt.Errorf("no database configured")
+	}
+	sqlDb, err := c.conf.Database.SqlDB(ctx)
+	if err != nil {
+		return "", err
+	}
+	start := time.Now()
+	if err := sqlDb.PingContext(ctx); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("ping took %s", time.Since(start).Round(time.Millisecond)), nil
+}
+
+// checkKmsHealth encrypts and decrypts a value with the global database key to
+// verify the kms is usable.
+func (c *Controller) checkKmsHealth(ctx context.Context) (string, error) {
+	if c.kms == nil {
+		return "", fmt.Errorf("no kms configured")
+	}
+	w, err := c.kms.GetWrapper(ctx, scope.Global.String(), kms.KeyPurposeDatabase)
+	if err != nil {
+		return "", err
+	}
+	blob, err := w.Encrypt(ctx, []byte("health"))
+	if err != nil {
+		return "", fmt.Errorf("error encrypting with the global database key: %w", err)
+	}
+	if _, err := w.Decrypt(ctx, blob); err != nil {
+		return "", fmt.Errorf("error decrypting with the global database key: %w", err)
+	}
+	return "", nil
+}
+
+func (c *Controller) checkMigrationsHealth(ctx context.Context) (string, error) {
+	if c.conf.Database == nil {
+		return "", fmt.Errorf("no database configured")
+	}
+	sqlDb, err := c.conf.Database.SqlDB(ctx)
+	if err != nil {
+		return "", err
+	}
+	man, err := schema.NewManager(ctx, schema.Postgres, sqlDb)
+	if err != nil {
+		return "", err
+	}
+	defer man.Close(ctx)
+	st, err := man.CurrentState(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !st.Initialized {
+		return "", fmt.Errorf("database is not initialized")
+	}
+	for _, e := range st.Editions {
+		if e.DatabaseSchemaState != schema.Equal {
+			return "", fmt.Errorf("schema of edition %q is at version %d, expected %d", e.Name, e.DatabaseSchemaVersion, e.BinarySchemaVersion)
+		}
+	}
+	return "", nil
+}
+
+func (c *Controller) checkWorkersHealth(ctx context.Context) (string, error) {
+	repo, err := c.ServersRepoFn()
+	if err != nil {
+		return "", err
+	}
+	workers, err := repo.ListWorkers(ctx, []string{scope.Global.String()},
+		server.WithLiveness(time.Duration(c.workerStatusGracePeriod.Load())),
+		server.WithLimit(-1))
+	if err != nil {
+		return "", err
+	}
+	if len(workers) == 0 {
+		return "", fmt.Errorf("no workers are connected")
+	}
+	return fmt.Sprintf("%d workers connected", len(workers)), nil
+}
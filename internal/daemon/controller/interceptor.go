@@ -8,8 +8,10 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"net/http"
+	"path"
 	"reflect"
 	"runtime/debug"
+	"strings"
 	"time"
 
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
@@ -19,13 +21,17 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	maintenancehandler "github.com/hashicorp/boundary/internal/daemon/controller/handlers/maintenance"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	pberrors "github.com/hashicorp/boundary/internal/gen/errors"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/secretscan"
+	"github.com/hashicorp/boundary/internal/maintenance"
 	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/scopequota"
 	"github.com/hashicorp/go-uuid"
 	"github.com/mr-tron/base58"
 	"google.golang.org/grpc"
@@ -73,6 +79,7 @@ func requestCtxUnaryInterceptor(
 	passwordAuthRepoFn common.PasswordAuthRepoFactory,
 	oidcAuthRepoFn common.OidcAuthRepoFactory,
 	ldapAuthRepoFn common.LdapAuthRepoFactory,
+	listTokenRepoFn common.ListTokenRepoFactory,
 	kms *kms.Kms,
 	ticket string,
 	eventer *event.Eventer,
@@ -112,6 +119,10 @@ func requestCtxUnaryInterceptor(
 		if err != nil {
 			return nil, err
 		}
+		if listTokenRepoFn != nil {
+			// Revoked list tokens are rejected when list endpoints parse them.
+			updatedCtx = handlers.NewListTokenRepoContext(updatedCtx, listTokenRepoFn)
+		}
 		return handler(updatedCtx, req)
 	}, nil
 }
@@ -263,12 +274,13 @@ func sharedRequestInterceptorLogic(
 
 	// This event request info is required by downstream handlers
 	info := &event.RequestInfo{
-		EventId:  requestInfo.EventId,
-		Id:       requestInfo.TraceId,
-		PublicId: requestInfo.PublicId,
-		Method:   requestInfo.Method,
-		Path:     requestInfo.Path,
-		ClientIp: requestInfo.ClientIp,
+		EventId:            requestInfo.EventId,
+		Id:                 requestInfo.TraceId,
+		PublicId:           requestInfo.PublicId,
+		Method:             requestInfo.Method,
+		Path:               requestInfo.Path,
+		ClientIp:           requestInfo.ClientIp,
+		ClientCertIdentity: requestInfo.ClientCertIdentity,
 	}
 	interceptorCtx, err = event.NewRequestInfoContext(interceptorCtx, info)
 	if err != nil {
@@ -403,6 +415,128 @@ func aliasResolutionInterceptor(
 	}
 }
 
+// readOnlyInterceptor returns a grpc.UnaryServerInterceptor that rejects the
+// requests that modify resources while the read-only maintenance mode is
+// enabled. Requests that were already being handled when the mode was enabled
+// are not interrupted.
+func readOnlyInterceptor(
+	_ context.Context,
+	maintenanceModeFn func() *maintenance.Mode,
+) grpc.UnaryServerInterceptor {
+	return func(interceptorCtx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error,
+	) {
+		m := maintenanceModeFn()
+		if m == nil || !m.ReadOnly {
+			return handler(interceptorCtx, req)
+		}
+		var method, path string
+		if reqCtx, ok := requests.RequestContextFromCtx(interceptorCtx); ok {
+			method, path = reqCtx.Method, reqCtx.Path
+		}
+		if readOnlyAllowed(method, path) {
+			return handler(interceptorCtx, req)
+		}
+		return nil, maintenancehandler.ReadOnlyError(m)
+	}
+}
+
+// scopeQuotaInterceptor returns a grpc.UnaryServerInterceptor that adds the
+// api request quotas of the org scopes to the request context, where they are
+// enforced once the scope of the request is known by the auth checks.
+func scopeQuotaInterceptor(
+	_ context.Context,
+	scopeQuotasFn func() *scopequota.Quotas,
+) grpc.UnaryServerInterceptor {
+	return func(interceptorCtx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error,
+	) {
+		if q := scopeQuotasFn(); q != nil {
+			interceptorCtx = scopequota.NewContext(interceptorCtx, q)
+		}
+		return handler(interceptorCtx, req)
+	}
+}
+
+// deprecationInterceptor returns a grpc.UnaryServerInterceptor that adds a
+// deprecation notice to the response for the request's method and for each
+// field set in the request if they are marked as deprecated in their proto
+// definitions, so clients find out about them before they are removed.
+func deprecationInterceptor(
+	ctx context.Context,
+) grpc.UnaryServerInterceptor {
+	const op = "controller.deprecationInterceptor"
+	return func(interceptorCtx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error,
+	) {
+		reqMsg, _ := req.(proto.Message)
+		for _, notice := range handlers.DeprecationNotices(info.FullMethod, reqMsg) {
+			if err := handlers.AddDeprecation(interceptorCtx, notice); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("unable to add deprecation notice", "notice", notice))
+			}
+		}
+		return handler(interceptorCtx, req)
+	}
+}
+
+// secretScanInterceptor returns a grpc.UnaryServerInterceptor that scans the
+// item of create and update requests for plaintext secrets in fields not meant
+// to hold them, such as a password pasted into a description. Each secret
+// found is reported with a system event naming the field, never the secret.
+// In secretscan.BlockMode the request is rejected as well.
+func secretScanInterceptor(
+	ctx context.Context,
+	mode secretscan.Mode,
+) grpc.UnaryServerInterceptor {
+	const op = "controller.secretScanInterceptor"
+	return func(interceptorCtx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error,
+	) {
+		if mode == secretscan.DisabledMode {
+			return handler(interceptorCtx, req)
+		}
+		method := path.Base(info.FullMethod)
+		if !strings.HasPrefix(method, "Create") && !strings.HasPrefix(method, "Update") {
+			return handler(interceptorCtx, req)
+		}
+		reqMsg, ok := req.(proto.Message)
+		if !ok {
+			return handler(interceptorCtx, req)
+		}
+		itemField := reqMsg.ProtoReflect().Descriptor().Fields().ByName("item")
+		if itemField == nil || itemField.Message() == nil || !reqMsg.ProtoReflect().Has(itemField) {
+			return handler(interceptorCtx, req)
+		}
+		findings := secretscan.ScanMessage(reqMsg.ProtoReflect().Get(itemField).Message().Interface())
+		if len(findings) == 0 {
+			return handler(interceptorCtx, req)
+		}
+		blocked := mode == secretscan.BlockMode
+		badFields := make(map[string]string, len(findings))
+		for _, f := range findings {
+			event.WriteSysEvent(interceptorCtx, op, "possible secret found in a non-secret field",
+				"method", info.FullMethod,
+				"field", f.Field,
+				"kind", f.Kind,
+				"blocked", blocked,
+			)
+			badFields[f.Field] = fmt.Sprintf("This field appears to contain a %s. Secrets must only be set in the fields meant for them.", f.Kind)
+		}
+		if blocked {
+			return nil, handlers.InvalidArgumentErrorf("Possible secrets found in non-secret fields.", badFields)
+		}
+		return handler(interceptorCtx, req)
+	}
+}
+
 func statusCodeInterceptor(
 	_ context.Context,
 ) grpc.UnaryServerInterceptor {
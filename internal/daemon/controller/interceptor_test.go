@@ -30,8 +30,10 @@ import (
 	"github.com/hashicorp/boundary/internal/gen/testing/interceptor"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/secretscan"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-uuid"
 	"github.com/mr-tron/base58"
@@ -42,6 +44,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func Test_unaryCtxInterceptor(t *testing.T) {
@@ -324,7 +327,7 @@ func Test_unaryCtxInterceptor(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			interceptor, err := requestCtxUnaryInterceptor(factoryCtx, tt.iamRepoFn, tt.authTokenRepoFn, tt.serversRepoFn, nil, nil, nil, tt.kms, tt.ticket, tt.eventer)
+			interceptor, err := requestCtxUnaryInterceptor(factoryCtx, tt.iamRepoFn, tt.authTokenRepoFn, tt.serversRepoFn, nil, nil, nil, nil, tt.kms, tt.ticket, tt.eventer)
 			if tt.wantFactoryErr {
 				require.Error(err)
 				assert.Nil(interceptor)
@@ -1066,3 +1069,51 @@ func Test_correlationIdInterceptor(t *testing.T) {
 		})
 	}
 }
+
+func Test_secretScanInterceptor(t *testing.T) {
+	ctx := context.Background()
+	leaky := &pbs.CreateTargetRequest{Item: &targets.Target{
+		Name:        wrapperspb.String("prod-db"),
+		Description: wrapperspb.String("admin password=hunter22"),
+	}}
+	clean := &pbs.CreateTargetRequest{Item: &targets.Target{
+		Name:        wrapperspb.String("prod-db"),
+		Description: wrapperspb.String("Production database"),
+	}}
+	tests := []struct {
+		name       string
+		mode       secretscan.Mode
+		method     string
+		req        any
+		wantCalled bool
+		wantErr    bool
+	}{
+		{name: "clean", mode: secretscan.BlockMode, method: "/controller.api.services.v1.TargetService/CreateTarget", req: clean, wantCalled: true},
+		{name: "default-warns", method: "/controller.api.services.v1.TargetService/CreateTarget", req: leaky, wantCalled: true},
+		{name: "warn", mode: secretscan.WarnMode, method: "/controller.api.services.v1.TargetService/CreateTarget", req: leaky, wantCalled: true},
+		{name: "block", mode: secretscan.BlockMode, method: "/controller.api.services.v1.TargetService/CreateTarget", req: leaky, wantErr: true},
+		{name: "disabled", mode: secretscan.DisabledMode, method: "/controller.api.services.v1.TargetService/CreateTarget", req: leaky, wantCalled: true},
+		{name: "not-create-or-update", mode: secretscan.BlockMode, method: "/controller.api.services.v1.TargetService/GetTarget", req: leaky, wantCalled: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			var called bool
+			handler := func(context.Context, any) (any, error) {
+				called = true
+				return nil, nil
+			}
+			_, err := secretScanInterceptor(ctx, tt.mode)(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.Equal(tt.wantCalled, called)
+			if tt.wantErr {
+				require.Error(err)
+				var apiErr *handlers.ApiError
+				require.ErrorAs(err, &apiErr)
+				require.Len(apiErr.Inner.GetDetails().GetRequestFields(), 1)
+				assert.Equal("description", apiErr.Inner.GetDetails().GetRequestFields()[0].GetName())
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
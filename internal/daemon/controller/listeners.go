@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/internal/metric"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/libs/secretscan"
 	"github.com/hashicorp/boundary/internal/util"
 	"github.com/hashicorp/go-multierror"
 	nodee "github.com/hashicorp/nodeenrollment"
@@ -50,7 +51,7 @@ func (c *Controller) startListeners() error {
 
 	servers := make([]func(), 0, len(c.conf.Listeners))
 
-	grpcServer, gwTicket, err := newGrpcServer(c.baseContext, c.IamRepoFn, c.AuthTokenRepoFn, c.ServersRepoFn, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.AliasRepoFn, c.kms, c.conf.Eventer)
+	grpcServer, gwTicket, err := newGrpcServer(c.baseContext, c.IamRepoFn, c.AuthTokenRepoFn, c.ServersRepoFn, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.AliasRepoFn, c.ListTokenRepoFn, c.MaintenanceMode, c.ScopeQuotas, secretscan.Mode(c.conf.RawConfig.Controller.SecretsScanning), c.kms, c.conf.Eventer)
 	if err != nil {
 		return fmt.Errorf("failed to create new grpc server: %w", err)
 	}
@@ -88,6 +89,10 @@ func (c *Controller) startListeners() error {
 	}
 	servers = append(servers, clusterServer)
 
+	if c.tunnelListener != nil {
+		servers = append(servers, func() { go c.serveTunnel(c.tunnelListener.TunnelListener) })
+	}
+
 	for _, s := range servers {
 		s()
 	}
@@ -108,7 +113,7 @@ func (c *Controller) configureForApi(ln *base.ServerListener) ([]func(), error)
 
 	cancelCtx := c.baseContext // Resolve to avoid race conditions if the base context is replaced.
 	server := &http.Server{
-		Handler:           handler,
+		Handler:           c.countInFlightRequests(handler),
 		ReadHeaderTimeout: 10 * time.Second,
 		ReadTimeout:       30 * time.Second,
 		IdleTimeout:       5 * time.Minute,
@@ -270,6 +275,7 @@ func (c *Controller) stopServersAndListeners() error {
 	mg.Go(c.stopClusterGrpcServerAndListener)
 	mg.Go(c.stopHttpServersAndListeners)
 	mg.Go(c.stopApiGrpcServerAndListener)
+	mg.Go(c.stopTunnelListener)
 
 	stopErrors := mg.Wait()
 	convertedStopErrors := stopErrors.ErrorOrNil()
@@ -331,6 +337,14 @@ func (c *Controller) stopApiGrpcServerAndListener() error {
 	return nil
 }
 
+func (c *Controller) stopTunnelListener() error {
+	if c.tunnelListener == nil || c.tunnelListener.TunnelListener == nil {
+		return nil
+	}
+	err := c.tunnelListener.TunnelListener.Close()
+	return listenerCloseErrorCheck(c.tunnelListener.Config.Type, err)
+}
+
 // stopAnyListeners does a final once over the known
 // listeners to make sure we didn't miss any;
 // expected to run at the end of stopServersAndListeners.
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/maintenance"
+	"github.com/hashicorp/boundary/internal/types/action"
+)
+
+// maintenanceModeInterval is the interval between reads of the maintenance
+// mode shared by the controllers, which bounds how long a controller keeps
+// accepting requests that modify resources after the read-only mode was
+// enabled through another controller.
+const maintenanceModeInterval = 5 * time.Second
+
+// MaintenanceMode returns the maintenance mode last read by the controller,
+// or nil if it has not been read yet.
+func (c *Controller) MaintenanceMode() *maintenance.Mode {
+	return c.maintenanceMode.Load()
+}
+
+// setMaintenanceMode stores the maintenance mode and reports when the
+// read-only mode is enabled or disabled.
+func (c *Controller) setMaintenanceMode(ctx context.Context, m *maintenance.Mode) {
+	const op = "controller.(Controller).setMaintenanceMode"
+	prev := c.maintenanceMode.Swap(m)
+	wasReadOnly := prev != nil && prev.ReadOnly
+	switch {
+	case m.ReadOnly && !wasReadOnly:
+		event.WriteSysEvent(ctx, op, "read-only maintenance mode enabled", "reason", m.Reason)
+	case !m.ReadOnly && wasReadOnly:
+		event.WriteSysEvent(ctx, op, "read-only maintenance mode disabled")
+	}
+}
+
+func (c *Controller) refreshMaintenanceMode(ctx context.Context) error {
+	const op = "controller.(Controller).refreshMaintenanceMode"
+	repo, err := c.MaintenanceRepoFn()
+	if err != nil {
+		return fmt.Errorf("%s: error fetching maintenance repository: %w", op, err)
+	}
+	m, err := repo.ReadMode(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: error reading maintenance mode: %w", op, err)
+	}
+	c.setMaintenanceMode(ctx, m)
+	return nil
+}
+
+func (c *Controller) startMaintenanceModeTicking(cancelCtx context.Context) {
+	const op = "controller.(Controller).startMaintenanceModeTicking"
+	timer := time.NewTimer(maintenanceModeInterval)
+	for {
+		select {
+		case <-cancelCtx.Done():
+			event.WriteSysEvent(cancelCtx, op, "maintenance mode ticking shutting down")
+			return
+
+		case <-timer.C:
+			if err := c.refreshMaintenanceMode(cancelCtx); err != nil {
+				event.WriteError(cancelCtx, op, err)
+			}
+			timer.Reset(maintenanceModeInterval)
+		}
+	}
+}
+
+// readOnlyActions are the actions requested with a POST that are allowed
+// while the read-only mode is enabled: authentication so that users can still
+// log in to read, the actions that only read or check what they are given,
+// and the maintenance endpoints so that the mode can be disabled. Authorizing
+// a session is not allowed, since it creates a session and its credentials;
+// sessions that were already authorized are not affected.
+var readOnlyActions = action.NewActionSet(
+	action.Authenticate,
+	action.Introspect,
+	action.Validate,
+	action.SetReadOnly,
+)
+
+// readOnlyAllowed reports whether a request is allowed while the read-only
+// mode is enabled: requests that do not modify resources, the actions in
+// readOnlyActions and deleting auth tokens, so that users can log out of the
+// tokens they were able to log in to.
+func readOnlyAllowed(httpMethod, path string) bool {
+	switch httpMethod {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodDelete:
+		return strings.HasPrefix(path, "/v1/auth-tokens/")
+	case http.MethodPost:
+		// Custom actions are requested with the action name after a colon at
+		// the end of the path, with the callback of authentication methods
+		// after the authenticate action.
+		path = strings.TrimSuffix(path, ":"+auth.CallbackAction)
+		_, name, ok := strings.Cut(path[strings.LastIndex(path, "/")+1:], ":")
+		if !ok {
+			return false
+		}
+		act, ok := action.Map[name]
+		return ok && readOnlyActions.HasAction(act)
+	}
+	return false
+}
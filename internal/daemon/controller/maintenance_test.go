@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/maintenance"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func Test_readOnlyInterceptor(t *testing.T) {
+	ctx := context.Background()
+	var mode *maintenance.Mode
+	interceptor := readOnlyInterceptor(ctx, func() *maintenance.Mode { return mode })
+	handler := func(context.Context, any) (any, error) {
+		return "handled", nil
+	}
+	call := func(method, path string) (any, error) {
+		reqCtx := context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{
+			Method: method,
+			Path:   path,
+		})
+		return interceptor(reqCtx, nil, &grpc.UnaryServerInfo{}, handler)
+	}
+
+	tests := []struct {
+		name      string
+		mode      *maintenance.Mode
+		method    string
+		path      string
+		wantAllow bool
+	}{
+		{
+			name:      "mode-not-read",
+			method:    http.MethodPost,
+			path:      "/v1/users",
+			wantAllow: true,
+		},
+		{
+			name:      "read-write",
+			mode:      &maintenance.Mode{},
+			method:    http.MethodDelete,
+			path:      "/v1/users/u_1234567890",
+			wantAllow: true,
+		},
+		{
+			name:      "read-only-get",
+			mode:      &maintenance.Mode{ReadOnly: true},
+			method:    http.MethodGet,
+			path:      "/v1/users",
+			wantAllow: true,
+		},
+		{
+			name:      "read-only-authenticate",
+			mode:      &maintenance.Mode{ReadOnly: true},
+			method:    http.MethodPost,
+			path:      "/v1/auth-methods/ampw_1234567890:authenticate",
+			wantAllow: true,
+		},
+		{
+			name:      "read-only-set-read-only",
+			mode:      &maintenance.Mode{ReadOnly: true},
+			method:    http.MethodPost,
+			path:      "/v1/maintenance:set-read-only",
+			wantAllow: true,
+		},
+		{
+			name:      "read-only-oidc-callback",
+			mode:      &maintenance.Mode{ReadOnly: true},
+			method:    http.MethodPost,
+			path:      "/v1/auth-methods/amoidc_1234567890:authenticate:callback",
+			wantAllow: true,
+		},
+		{
+			name:      "read-only-logout",
+			mode:      &maintenance.Mode{ReadOnly: true},
+			method:    http.MethodDelete,
+			path:      "/v1/auth-tokens/at_1234567890",
+			wantAllow: true,
+		},
+		{
+			name:      "read-only-introspect-list-token",
+			mode:      &maintenance.Mode{ReadOnly: true},
+			method:    http.MethodPost,
+			path:      "/v1/list-tokens:introspect",
+			wantAllow: true,
+		},
+		{
+			name:      "read-only-validate-filter",
+			mode:      &maintenance.Mode{ReadOnly: true},
+			method:    http.MethodPost,
+			path:      "/v1/filters:validate",
+			wantAllow: true,
+		},
+		{
+			name:      "read-only-search",
+			mode:      &maintenance.Mode{ReadOnly: true},
+			method:    http.MethodGet,
+			path:      "/v1/search",
+			wantAllow: true,
+		},
+		{
+			name:   "read-only-revoke-list-token",
+			mode:   &maintenance.Mode{ReadOnly: true},
+			method: http.MethodPost,
+			path:   "/v1/list-tokens:revoke",
+		},
+		{
+			name:   "read-only-delete-user",
+			mode:   &maintenance.Mode{ReadOnly: true},
+			method: http.MethodDelete,
+			path:   "/v1/users/u_1234567890",
+		},
+		{
+			name:   "read-only-create",
+			mode:   &maintenance.Mode{ReadOnly: true, Reason: "migration"},
+			method: http.MethodPost,
+			path:   "/v1/users",
+		},
+		{
+			name:   "read-only-update",
+			mode:   &maintenance.Mode{ReadOnly: true},
+			method: http.MethodPatch,
+			path:   "/v1/users/u_1234567890",
+		},
+		{
+			// Authorizing a session creates the session, so it is rejected,
+			// while sessions that were already authorized are not affected.
+			name:   "read-only-authorize-session",
+			mode:   &maintenance.Mode{ReadOnly: true},
+			method: http.MethodPost,
+			path:   "/v1/targets/ttcp_1234567890:authorize-session",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mode = tt.mode
+			got, err := call(tt.method, tt.path)
+			if tt.wantAllow {
+				require.NoError(t, err)
+				assert.Equal(t, "handled", got)
+				return
+			}
+			var apiErr *handlers.ApiError
+			require.ErrorAs(t, err, &apiErr)
+			assert.EqualValues(t, http.StatusServiceUnavailable, apiErr.Status)
+			assert.Contains(t, apiErr.Inner.GetMessage(), tt.mode.Reason)
+			assert.Nil(t, got)
+		})
+	}
+}
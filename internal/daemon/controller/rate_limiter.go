@@ -63,21 +63,8 @@ func newRateLimiterConfig(ctx context.Context, configs ratelimit.Configs, maxSiz
 	}, nil
 }
 
-// writeSysEvent writes a sys event for c
-func (c *rateLimiterConfig) writeSysEvent(ctx context.Context) {
-	const op = "controller.(rateLimiterConfig).writeSysEvent"
-
-	if c.disabled {
-		event.WriteSysEvent(
-			ctx,
-			op,
-			"controller api rate limiter",
-			"disabled",
-			true,
-		)
-		return
-	}
-
+// resources returns the limits of c nested by resource and action.
+func (c *rateLimiterConfig) resources() resources {
 	e := make(resources)
 
 	for _, l := range c.limits {
@@ -115,6 +102,25 @@ func (c *rateLimiterConfig) writeSysEvent(ctx context.Context) {
 		}
 		r[l.GetAction()] = a
 	}
+	return e
+}
+
+// writeSysEvent writes a sys event for c
+func (c *rateLimiterConfig) writeSysEvent(ctx context.Context) {
+	const op = "controller.(rateLimiterConfig).writeSysEvent"
+
+	if c.disabled {
+		event.WriteSysEvent(
+			ctx,
+			op,
+			"controller api rate limiter",
+			"disabled",
+			true,
+		)
+		return
+	}
+
+	e := c.resources()
 	event.WriteSysEvent(
 		ctx,
 		op,
@@ -164,6 +170,34 @@ func (c *Controller) initializeRateLimiter(conf *config.Config) error {
 	return nil
 }
 
+// RateLimits describes the rate limits the controller currently enforces on
+// api requests and how much of the quota storage of the rate limiter is used.
+type RateLimits struct {
+	Disabled             bool      `json:"disabled"`
+	MaxQuotas            int       `json:"max_quotas,omitempty"`
+	QuotaStorageUsage    int       `json:"quota_storage_usage"`
+	QuotaStorageCapacity int       `json:"quota_storage_capacity"`
+	Limits               resources `json:"limits,omitempty"`
+}
+
+// RateLimits returns the current rate limits of the controller.
+func (c *Controller) RateLimits() *RateLimits {
+	c.rateLimiterMu.RLock()
+	rlConfig := c.conf.rateLimiterConfig
+	c.rateLimiterMu.RUnlock()
+
+	if rlConfig == nil || rlConfig.disabled {
+		return &RateLimits{Disabled: true}
+	}
+	usage, capacity := ratelimit.QuotaStorage()
+	return &RateLimits{
+		MaxQuotas:            rlConfig.maxSize,
+		QuotaStorageUsage:    usage,
+		QuotaStorageCapacity: capacity,
+		Limits:               rlConfig.resources(),
+	}
+}
+
 func (c *Controller) getRateLimiter() ratelimit.Limiter {
 	c.rateLimiterMu.RLock()
 	defer c.rateLimiterMu.RUnlock()
@@ -26,6 +26,7 @@ func init() {
 		registerControllerSessionService,
 		registerControllerMultihopService,
 		registerControllerUpstreamMessageService,
+		registerControllerReverseTunnelService,
 	)
 }
 
@@ -51,6 +52,9 @@ func registerControllerServerCoordinationService(ctx context.Context, c *Control
 		c.kms,
 		c.livenessTimeToStale,
 		c.ControllerExtension,
+		handlers.WithWorkerDiagnostics(c.workerDiagnostics),
+		handlers.WithConnectionTests(c.workerConnectionTests),
+		handlers.WithTargetRepoFn(c.TargetRepoFn),
 	)
 	pbs.RegisterServerCoordinationServiceServer(server, workerService)
 	return nil
@@ -78,6 +82,9 @@ func registerControllerSessionService(ctx context.Context, c *Controller, server
 		c.kms,
 		c.livenessTimeToStale,
 		c.ControllerExtension,
+		handlers.WithWorkerDiagnostics(c.workerDiagnostics),
+		handlers.WithConnectionTests(c.workerConnectionTests),
+		handlers.WithTargetRepoFn(c.TargetRepoFn),
 	)
 	pbs.RegisterSessionServiceServer(server, workerService)
 	return nil
@@ -145,3 +152,19 @@ func registerControllerUpstreamMessageService(ctx context.Context, c *Controller
 	}
 	return nil
 }
+
+func registerControllerReverseTunnelService(ctx context.Context, c *Controller, server *grpc.Server) error {
+	const op = "controller.registerControllerReverseTunnelService"
+
+	switch {
+	case nodeenrollment.IsNil(ctx):
+		return fmt.Errorf("%s: context is nil", op)
+	case c == nil:
+		return fmt.Errorf("%s: controller is nil", op)
+	case server == nil:
+		return fmt.Errorf("%s: server is nil", op)
+	}
+
+	pbs.RegisterReverseTunnelServiceServer(server, handlers.NewReverseTunnelServiceServer(c.ServersRepoFn, c.reverseTunnels))
+	return nil
+}
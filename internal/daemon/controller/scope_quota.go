@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/scopequota"
+)
+
+func (c *Controller) initializeScopeQuotas(conf *config.Config) error {
+	const op = "controller.(Controller).initializeScopeQuotas"
+	switch {
+	case conf == nil:
+		return errors.New(c.baseContext, errors.InvalidParameter, op, "nil config")
+	case conf.Controller == nil:
+		return errors.New(c.baseContext, errors.InvalidParameter, op, "nil config.Controller")
+	}
+
+	q, err := scopequota.New(c.baseContext, conf.Controller.ApiScopeQuotas)
+	if err != nil {
+		return err
+	}
+	c.scopeQuotas.Store(q)
+	c.writeScopeQuotasSysEvent(q)
+	return nil
+}
+
+// ScopeQuotas returns the api request quotas of the org scopes, or nil if
+// none are configured.
+func (c *Controller) ScopeQuotas() *scopequota.Quotas {
+	return c.scopeQuotas.Load()
+}
+
+// ReloadScopeQuotas replaces the Controller's scope quotas with new ones
+// created from the supplied config. If the quota configs match the current
+// ones, the quotas are not replaced and their usage is kept. Otherwise the
+// usage of every scope is effectively reset.
+func (c *Controller) ReloadScopeQuotas(newConfig *config.Config) error {
+	const op = "controller.(Controller).ReloadScopeQuotas"
+	switch {
+	case newConfig == nil:
+		return errors.New(c.baseContext, errors.InvalidParameter, op, "nil config")
+	case newConfig.Controller == nil:
+		return errors.New(c.baseContext, errors.InvalidParameter, op, "nil config.Controller")
+	}
+
+	if c.ScopeQuotas().Configs().Equal(newConfig.Controller.ApiScopeQuotas) {
+		return nil
+	}
+	q, err := scopequota.New(c.baseContext, newConfig.Controller.ApiScopeQuotas)
+	if err != nil {
+		return errors.Wrap(c.baseContext, err, op)
+	}
+	c.scopeQuotas.Store(q)
+	c.writeScopeQuotasSysEvent(q)
+	return nil
+}
+
+func (c *Controller) writeScopeQuotasSysEvent(q *scopequota.Quotas) {
+	const op = "controller.(Controller).writeScopeQuotasSysEvent"
+	if q == nil {
+		return
+	}
+	event.WriteSysEvent(c.baseContext, op, "controller api scope quotas", "quotas", q.Configs())
+}
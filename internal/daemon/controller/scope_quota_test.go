@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/scopequota"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestControllerReloadScopeQuotas(t *testing.T) {
+	// Disabling eventing so reduce noise.
+	event.TestWithoutEventing(t)
+
+	const orgId = "o_1234567890"
+	quotas := scopequota.Configs{
+		{Scopes: []string{orgId}, Limit: 10, PeriodHCL: "1m", Period: time.Minute},
+	}
+
+	c := &Controller{baseContext: context.Background()}
+	require.NoError(t, c.initializeScopeQuotas(&config.Config{Controller: &config.Controller{}}))
+	assert.Nil(t, c.ScopeQuotas())
+
+	require.NoError(t, c.ReloadScopeQuotas(&config.Config{Controller: &config.Controller{ApiScopeQuotas: quotas}}))
+	q := c.ScopeQuotas()
+	require.NotNil(t, q)
+	_, ok := q.Allow(orgId)
+	require.True(t, ok)
+
+	// Reloading the same quotas keeps their usage.
+	require.NoError(t, c.ReloadScopeQuotas(&config.Config{Controller: &config.Controller{ApiScopeQuotas: quotas}}))
+	assert.Same(t, q, c.ScopeQuotas())
+	assert.Equal(t, uint64(1), c.ScopeQuotas().Usage(orgId).Used)
+
+	// Invalid quotas are rejected and the current ones are kept.
+	err := c.ReloadScopeQuotas(&config.Config{Controller: &config.Controller{
+		ApiScopeQuotas: scopequota.Configs{{Scopes: []string{"global"}, Limit: 10, Period: time.Minute}},
+	}})
+	require.Error(t, err)
+	assert.Same(t, q, c.ScopeQuotas())
+
+	require.NoError(t, c.ReloadScopeQuotas(&config.Config{Controller: &config.Controller{}}))
+	assert.Nil(t, c.ScopeQuotas())
+}
+
+func Test_scopeQuotaInterceptor(t *testing.T) {
+	ctx := context.Background()
+	var quotas *scopequota.Quotas
+	interceptor := scopeQuotaInterceptor(ctx, func() *scopequota.Quotas { return quotas })
+	var gotOk bool
+	var got *scopequota.Quotas
+	handler := func(ctx context.Context, _ any) (any, error) {
+		got, gotOk = scopequota.FromContext(ctx)
+		return nil, nil
+	}
+
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.False(t, gotOk)
+
+	quotas, err = scopequota.New(ctx, scopequota.Configs{{Scopes: []string{scopequota.AllOrgs}, Limit: 1, Period: time.Minute}})
+	require.NoError(t, err)
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.True(t, gotOk)
+	assert.Same(t, quotas, got)
+}
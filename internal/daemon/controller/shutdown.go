@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/event"
+)
+
+// ShutdownPhase is a phase of the shutdown of the controller.
+type ShutdownPhase string
+
+// The phases of the shutdown of the controller, in order. The draining phases
+// are skipped if graceful_shutdown_drain_duration is not set.
+const (
+	ShutdownPhaseRunning          ShutdownPhase = "running"
+	ShutdownPhaseDrainingRequests ShutdownPhase = "draining_requests"
+	ShutdownPhaseDrainingJobs     ShutdownPhase = "draining_jobs"
+	ShutdownPhaseStopping         ShutdownPhase = "stopping"
+	ShutdownPhaseStopped          ShutdownPhase = "stopped"
+)
+
+// ShutdownProgress reports the progress of the shutdown of the controller.
+type ShutdownProgress struct {
+	Phase            ShutdownPhase `json:"phase"`
+	Deadline         *time.Time    `json:"deadline,omitempty"`
+	InFlightRequests int64         `json:"in_flight_requests"`
+	RunningJobs      []string      `json:"running_jobs,omitempty"`
+}
+
+// shutdownState tracks the phase of the shutdown of the controller and the
+// number of in-flight api requests.
+type shutdownState struct {
+	inFlightRequests atomic.Int64
+
+	l        sync.RWMutex
+	phase    ShutdownPhase
+	deadline time.Time
+}
+
+func (s *shutdownState) setPhase(phase ShutdownPhase) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.phase = phase
+}
+
+// countInFlightRequests wraps the handler of an api listener to track the
+// number of in-flight requests while the controller drains them.
+func (c *Controller) countInFlightRequests(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.shutdownState.inFlightRequests.Add(1)
+		defer c.shutdownState.inFlightRequests.Add(-1)
+		h.ServeHTTP(w, r)
+	})
+}
+
+// ShutdownProgress returns the current progress of the shutdown of the
+// controller.
+func (c *Controller) ShutdownProgress() *ShutdownProgress {
+	c.shutdownState.l.RLock()
+	defer c.shutdownState.l.RUnlock()
+	p := &ShutdownProgress{
+		Phase:            c.shutdownState.phase,
+		InFlightRequests: c.shutdownState.inFlightRequests.Load(),
+	}
+	if p.Phase == "" {
+		p.Phase = ShutdownPhaseRunning
+	}
+	if !c.shutdownState.deadline.IsZero() {
+		deadline := c.shutdownState.deadline
+		p.Deadline = &deadline
+	}
+	if c.scheduler != nil {
+		p.RunningJobs = c.scheduler.RunningJobs()
+	}
+	return p
+}
+
+// drain stops accepting new api requests and waits for the in-flight requests
+// and the running scheduler jobs to finish, up to the given duration. It must
+// be called before the base context is canceled, since canceling it cancels
+// both requests and jobs.
+func (c *Controller) drain(d time.Duration) {
+	const op = "controller.(Controller).drain"
+	deadline := time.Now().Add(d)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	c.shutdownState.l.Lock()
+	c.shutdownState.phase = ShutdownPhaseDrainingRequests
+	c.shutdownState.deadline = deadline
+	c.shutdownState.l.Unlock()
+	if c.HealthService != nil {
+		// Load balancers should stop sending requests while draining, if
+		// they haven't already during the graceful shutdown wait.
+		c.HealthService.StartServiceUnavailableReplies()
+	}
+	event.WriteSysEvent(ctx, op, "draining api requests",
+		"in flight requests", c.shutdownState.inFlightRequests.Load(),
+		"deadline", deadline.Format(time.RFC3339))
+	for i := range c.apiListeners {
+		ln := c.apiListeners[i]
+		if ln.HTTPServer == nil {
+			continue
+		}
+		// Shutdown stops accepting new connections and waits for the
+		// in-flight requests to finish.
+		if err := ln.HTTPServer.Shutdown(ctx); err != nil {
+			event.WriteError(context.Background(), op, err, event.WithInfoMsg("error draining api requests",
+				"in flight requests", c.shutdownState.inFlightRequests.Load()))
+		}
+	}
+
+	if c.scheduler == nil {
+		return
+	}
+	c.shutdownState.setPhase(ShutdownPhaseDrainingJobs)
+	event.WriteSysEvent(ctx, op, "draining scheduler jobs", "running jobs", c.scheduler.RunningJobs())
+	if err := c.scheduler.Drain(ctx); err != nil {
+		event.WriteError(context.Background(), op, err, event.WithInfoMsg("error draining scheduler jobs"))
+	}
+}
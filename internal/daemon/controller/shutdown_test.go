@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShutdownProgress(t *testing.T) {
+	c := &Controller{}
+	p := c.ShutdownProgress()
+	assert.Equal(t, ShutdownPhaseRunning, p.Phase)
+	assert.Nil(t, p.Deadline)
+	assert.Zero(t, p.InFlightRequests)
+
+	// In-flight requests are counted while their handler runs
+	inHandler := make(chan struct{})
+	release := make(chan struct{})
+	h := c.countInFlightRequests(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(inHandler)
+		<-release
+	}))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/scopes", nil))
+	}()
+	<-inHandler
+	assert.Equal(t, int64(1), c.ShutdownProgress().InFlightRequests)
+	close(release)
+	<-done
+	assert.Zero(t, c.ShutdownProgress().InFlightRequests)
+
+	// Draining sets the phase and deadline
+	c.drain(time.Minute)
+	p = c.ShutdownProgress()
+	assert.Equal(t, ShutdownPhaseDrainingRequests, p.Phase)
+	require.NotNil(t, p.Deadline)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), *p.Deadline, 5*time.Second)
+
+	c.shutdownState.setPhase(ShutdownPhaseStopped)
+	assert.Equal(t, ShutdownPhaseStopped, c.ShutdownProgress().Phase)
+}
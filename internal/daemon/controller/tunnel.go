@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+)
+
+// tunnelOpenTimeout bounds the time between accepting a session connection on
+// the tunnel listener and the worker opening the tunnel for it.
+const tunnelOpenTimeout = 10 * time.Second
+
+// errClientHelloRead aborts the TLS handshake once the ClientHello was read.
+var errClientHelloRead = stderrors.New("client hello read")
+
+// serveTunnel accepts session connections on the tunnel listener and routes
+// them through the reverse tunnels of workers, until the listener is closed.
+func (c *Controller) serveTunnel(l net.Listener) {
+	const op = "controller.(Controller).serveTunnel"
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				event.WriteError(c.baseContext, op, err, event.WithInfoMsg("error accepting tunnel connection"))
+			}
+			return
+		}
+		go c.handleTunnelConnection(conn)
+	}
+}
+
+// handleTunnelConnection finds the session of the connection from its TLS
+// ClientHello and pipes the connection through a reverse tunnel of a worker
+// for the session's target. The TLS session itself is terminated by the
+// worker, as if the client connected to it directly.
+func (c *Controller) handleTunnelConnection(conn net.Conn) {
+	const op = "controller.(Controller).handleTunnelConnection"
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(c.baseContext, tunnelOpenTimeout)
+	defer cancel()
+	_ = conn.SetReadDeadline(time.Now().Add(tunnelOpenTimeout))
+	sessionId, replayed, err := readTunnelSessionId(conn)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error reading session id", "client_address", conn.RemoteAddr().String()))
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	sessionRepo, err := c.SessionRepoFn()
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error getting session repo"))
+		return
+	}
+	sess, _, err := sessionRepo.LookupSession(ctx, sessionId)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error looking up session", "session_id", sessionId))
+		return
+	}
+	if sess == nil {
+		event.WriteError(ctx, op, stderrors.New("session not found"), event.WithInfo("session_id", sessionId))
+		return
+	}
+	tunnel, err := c.reverseTunnels.Open(ctx, sess.TargetId, conn.RemoteAddr().String())
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error opening reverse tunnel", "session_id", sessionId, "target_id", sess.TargetId))
+		return
+	}
+	defer tunnel.Close()
+	cancel()
+
+	pipeConns(replayed, tunnel)
+}
+
+// readTunnelSessionId reads the TLS ClientHello of the connection and returns
+// the session id the client put in the server name or ALPN protocols, like
+// workers do when accepting session connections. The returned connection
+// replays the bytes read so the worker can complete the handshake.
+func readTunnelSessionId(conn net.Conn) (string, net.Conn, error) {
+	hc := &helloConn{Conn: conn}
+	var hello *tls.ClientHelloInfo
+	err := tls.Server(hc, &tls.Config{
+		GetConfigForClient: func(h *tls.ClientHelloInfo) (*tls.Config, error) {
+			hello = h
+			return nil, errClientHelloRead
+		},
+	}).Handshake()
+	if hello == nil {
+		return "", nil, fmt.Errorf("error reading tls client hello: %w", err)
+	}
+
+	sessionPrefix := fmt.Sprintf("%s_", globals.SessionPrefix)
+	var sessionId string
+	switch {
+	case strings.HasPrefix(hello.ServerName, sessionPrefix):
+		sessionId = hello.ServerName
+	default:
+		for _, proto := range hello.SupportedProtos {
+			if strings.HasPrefix(proto, sessionPrefix) {
+				sessionId = proto
+				break
+			}
+		}
+	}
+	if sessionId == "" {
+		return "", nil, stderrors.New("could not find session ID in SNI or ALPN protos")
+	}
+	return sessionId, &replayConn{Conn: conn, r: io.MultiReader(&hc.read, conn)}, nil
+}
+
+// helloConn records the bytes read from the connection and discards the
+// bytes written to it, so the ClientHello can be read without answering it.
+type helloConn struct {
+	net.Conn
+	read bytes.Buffer
+}
+
+func (c *helloConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.read.Write(b[:n])
+	return n, err
+}
+
+func (c *helloConn) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+// replayConn reads the bytes recorded while reading the ClientHello before
+// reading from the connection again.
+type replayConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *replayConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// pipeConns copies the bytes between the connections until either of them is
+// done, and closes both.
+func pipeConns(a, b net.Conn) {
+	var once sync.Once
+	closeBoth := func() {
+		a.Close()
+		b.Close()
+	}
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(a, b)
+		once.Do(closeBoth)
+	}()
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(b, a)
+		once.Do(closeBoth)
+	}()
+	wg.Wait()
+}
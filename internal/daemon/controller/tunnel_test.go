@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadTunnelSessionId(t *testing.T) {
+	tests := []struct {
+		name          string
+		serverName    string
+		nextProtos    []string
+		wantSessionId string
+		wantErr       bool
+	}{
+		{
+			name:          "alpn",
+			serverName:    "worker.example.com",
+			nextProtos:    []string{"http/1.1", "s_1234567890"},
+			wantSessionId: "s_1234567890",
+		},
+		{
+			name:          "sni",
+			serverName:    "s_1234567890",
+			nextProtos:    []string{"http/1.1"},
+			wantSessionId: "s_1234567890",
+		},
+		{
+			name:       "missing",
+			serverName: "worker.example.com",
+			nextProtos: []string{"http/1.1"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			client, server := net.Pipe()
+			defer client.Close()
+			defer server.Close()
+			go tls.Client(client, &tls.Config{
+				ServerName:         tt.serverName,
+				NextProtos:         tt.nextProtos,
+				InsecureSkipVerify: true,
+			}).Handshake()
+
+			sessionId, replayed, err := readTunnelSessionId(server)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantSessionId, sessionId)
+
+			// The ClientHello is replayed to whoever reads the connection next
+			sessionId, _, err = readTunnelSessionId(replayed)
+			require.NoError(err)
+			assert.Equal(tt.wantSessionId, sessionId)
+		})
+	}
+
+	t.Run("not-tls", func(t *testing.T) {
+		client, server := net.Pipe()
+		go func() {
+			client.Write([]byte("GET / HTTP/1.1\r\n\r\n"))
+			client.Close()
+		}()
+		_, _, err := readTunnelSessionId(server)
+		assert.Error(t, err)
+	})
+}
+
+func TestPipeConns(t *testing.T) {
+	assert := assert.New(t)
+	client, a := net.Pipe()
+	b, worker := net.Pipe()
+	done := make(chan struct{})
+	go func() {
+		pipeConns(a, b)
+		close(done)
+	}()
+
+	go client.Write([]byte("ping"))
+	buf := make([]byte, 4)
+	_, err := io.ReadFull(worker, buf)
+	require.NoError(t, err)
+	assert.Equal("ping", string(buf))
+
+	go worker.Write([]byte("pong"))
+	_, err = io.ReadFull(client, buf)
+	require.NoError(t, err)
+	assert.Equal("pong", string(buf))
+
+	// Closing one side closes the other
+	worker.Close()
+	<-done
+	_, err = client.Read(buf)
+	assert.Error(err)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !windows
+// +build !windows
+
+package selfmonitor
+
+import (
+	"math"
+	"os"
+	"runtime"
+	"syscall"
+)
+
+// fdDir returns the directory listing the open file descriptors of the
+// current process.
+func fdDir() string {
+	if runtime.GOOS == "linux" {
+		return "/proc/self/fd"
+	}
+	return "/dev/fd"
+}
+
+// readOpenFiles returns the number of open file descriptors of the current
+// process, and the limit on that number.
+func readOpenFiles() (int, int, error) {
+	d, err := os.Open(fdDir())
+	if err != nil {
+		return 0, 0, err
+	}
+	names, err := d.Readdirnames(-1)
+	d.Close()
+	if err != nil {
+		return 0, 0, err
+	}
+	var rlimit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rlimit); err != nil {
+		return 0, 0, err
+	}
+	// An unlimited, or implausibly large, limit is reported as zero so that no
+	// percentage of it is computed.
+	var limit int
+	if cur := uint64(rlimit.Cur); cur <= math.MaxInt32 {
+		limit = int(cur)
+	}
+	// The listing includes the descriptor used to read the directory itself.
+	return len(names) - 1, limit, nil
+}
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build windows
+// +build windows
+
+package selfmonitor
+
+import "errors"
+
+// readOpenFiles is not supported on Windows, which has no limit on the number
+// of open handles comparable to RLIMIT_NOFILE.
+func readOpenFiles() (int, int, error) {
+	return 0, 0, errors.New("reading open files is not supported on windows")
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package selfmonitor
+
+import (
+	"errors"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const selfMonitorSubsystem = "self_monitor"
+
+var (
+	goroutineCount = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: selfMonitorSubsystem,
+			Name:      "goroutines",
+			Help:      "Number of goroutines in the Boundary process, as of the last self-monitor reading.",
+		},
+	)
+
+	openFiles = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: selfMonitorSubsystem,
+			Name:      "open_fds",
+			Help:      "Number of open file descriptors of the Boundary process, as of the last self-monitor reading.",
+		},
+	)
+
+	maxOpenFiles = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: selfMonitorSubsystem,
+			Name:      "max_fds",
+			Help:      "Limit on the number of open file descriptors of the Boundary process.",
+		},
+	)
+
+	proxyConnections = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: selfMonitorSubsystem,
+			Name:      "proxy_connections",
+			Help:      "Number of connections in the worker's proxy connection table, as of the last self-monitor reading.",
+		},
+	)
+
+	thresholdExceeded = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: selfMonitorSubsystem,
+			Name:      "threshold_exceeded_total",
+			Help:      "Count of times a self-monitor reading crossed above its warning threshold.",
+		},
+		[]string{"reading"},
+	)
+)
+
+// initializeCollectors registers the self-monitor collectors onto r. A
+// controller and a worker running in the same process both start a monitor,
+// so collectors that are already registered are not an error.
+func initializeCollectors(r prometheus.Registerer) error {
+	if r == nil {
+		return nil
+	}
+	for _, c := range []prometheus.Collector{goroutineCount, openFiles, maxOpenFiles, proxyConnections, thresholdExceeded} {
+		if err := r.Register(c); err != nil {
+			var are prometheus.AlreadyRegisteredError
+			if !errors.As(err, &are) {
+				return err
+			}
+		}
+	}
+	for _, l := range []string{readingGoroutines, readingOpenFiles, readingProxyConnections} {
+		thresholdExceeded.WithLabelValues(l)
+	}
+	return nil
+}
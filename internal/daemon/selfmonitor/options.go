@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package selfmonitor
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// DefaultInterval is how often readings are taken by default.
+	DefaultInterval = 30 * time.Second
+
+	// DefaultGoroutineThreshold is the default number of goroutines above
+	// which a warning is emitted.
+	DefaultGoroutineThreshold = 20000
+
+	// DefaultOpenFilesThresholdPercent is the default percentage of the open
+	// file descriptor limit above which a warning is emitted.
+	DefaultOpenFilesThresholdPercent = 80
+)
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withInterval                  time.Duration
+	withGoroutineThreshold        int
+	withOpenFilesThresholdPercent int
+	withProxyConnectionThreshold  int64
+	withProxyConnectionsFn        func() int64
+	withPrometheusRegisterer      prometheus.Registerer
+	withReadFn                    func() reading
+}
+
+func getDefaultOptions() options {
+	return options{
+		withInterval:                  DefaultInterval,
+		withGoroutineThreshold:        DefaultGoroutineThreshold,
+		withOpenFilesThresholdPercent: DefaultOpenFilesThresholdPercent,
+	}
+}
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		if o != nil {
+			o(&opts)
+		}
+	}
+	return opts
+}
+
+// WithInterval sets how often readings are taken. Values that are not
+// positive are ignored.
+func WithInterval(d time.Duration) Option {
+	return func(o *options) {
+		if d > 0 {
+			o.withInterval = d
+		}
+	}
+}
+
+// WithGoroutineThreshold sets the number of goroutines above which a warning
+// is emitted. Zero disables the check; negative values are ignored.
+func WithGoroutineThreshold(n int) Option {
+	return func(o *options) {
+		if n >= 0 {
+			o.withGoroutineThreshold = n
+		}
+	}
+}
+
+// WithOpenFilesThresholdPercent sets the percentage of the open file
+// descriptor limit above which a warning is emitted. Zero disables the check;
+// values outside of 0-100 are ignored.
+func WithOpenFilesThresholdPercent(p int) Option {
+	return func(o *options) {
+		if p >= 0 && p <= 100 {
+			o.withOpenFilesThresholdPercent = p
+		}
+	}
+}
+
+// WithProxyConnections sets the function returning the current size of the
+// proxy connection table, and the size above which a warning is emitted. A
+// zero threshold only reports the size as a metric.
+func WithProxyConnections(fn func() int64, threshold int64) Option {
+	return func(o *options) {
+		o.withProxyConnectionsFn = fn
+		if threshold >= 0 {
+			o.withProxyConnectionThreshold = threshold
+		}
+	}
+}
+
+// WithPrometheusRegisterer sets the registerer the monitor's gauges are
+// registered onto.
+func WithPrometheusRegisterer(r prometheus.Registerer) Option {
+	return func(o *options) {
+		o.withPrometheusRegisterer = r
+	}
+}
+
+// withReadFn replaces how readings are taken; used in tests.
+func withReadFn(fn func() reading) Option {
+	return func(o *options) {
+		o.withReadFn = fn
+	}
+}
+
+// ConfigOptions returns the options for the given self-monitor configuration.
+// Thresholds left at zero use the defaults and negative thresholds disable
+// the corresponding warning. The proxy connection threshold is not included,
+// as it is only relevant to workers, which pass it with WithProxyConnections.
+func ConfigOptions(c config.SelfMonitor) []Option {
+	var opts []Option
+	if c.IntervalDuration > 0 {
+		opts = append(opts, WithInterval(c.IntervalDuration))
+	}
+	switch {
+	case c.GoroutineThreshold > 0:
+		opts = append(opts, WithGoroutineThreshold(c.GoroutineThreshold))
+	case c.GoroutineThreshold < 0:
+		opts = append(opts, WithGoroutineThreshold(0))
+	}
+	switch {
+	case c.OpenFilesThresholdPercent > 0:
+		opts = append(opts, WithOpenFilesThresholdPercent(c.OpenFilesThresholdPercent))
+	case c.OpenFilesThresholdPercent < 0:
+		opts = append(opts, WithOpenFilesThresholdPercent(0))
+	}
+	return opts
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package selfmonitor provides a lightweight monitor of the resources used by
+// a controller or worker: goroutines, open file descriptors, and the size of
+// the worker's proxy connection table. Readings are exported as metrics, and
+// a warning event is emitted when one of them crosses above its threshold,
+// so leaks are caught before the node runs out of resources.
+package selfmonitor
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+)
+
+const (
+	readingGoroutines       = "goroutines"
+	readingOpenFiles        = "open_fds"
+	readingProxyConnections = "proxy_connections"
+)
+
+// reading is a single sample of the monitored resources.
+type reading struct {
+	goroutines       int
+	openFiles        int
+	maxOpenFiles     int
+	openFilesErr     error
+	proxyConnections int64
+}
+
+// Monitor periodically takes readings of the resources used by the process.
+type Monitor struct {
+	name string
+	opts options
+
+	// exceeded tracks which readings are currently above their threshold, so
+	// a warning is emitted when a threshold is crossed rather than on every
+	// reading.
+	exceeded map[string]bool
+	// openFilesErrReported is set once a failure to read the open files has
+	// been reported, since it will not resolve itself.
+	openFilesErrReported bool
+}
+
+// New creates a Monitor. The name identifies the component being monitored,
+// such as "controller" or "worker", in the events emitted. Supported options
+// are WithInterval, WithGoroutineThreshold, WithOpenFilesThresholdPercent,
+// WithProxyConnections and WithPrometheusRegisterer.
+func New(ctx context.Context, name string, opt ...Option) (*Monitor, error) {
+	const op = "selfmonitor.New"
+	if name == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	opts := getOpts(opt...)
+	if err := initializeCollectors(opts.withPrometheusRegisterer); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to register collectors"))
+	}
+	if opts.withReadFn == nil {
+		opts.withReadFn = func() reading { return read(opts.withProxyConnectionsFn) }
+	}
+	return &Monitor{
+		name:     name,
+		opts:     opts,
+		exceeded: map[string]bool{},
+	}, nil
+}
+
+// Start takes a reading every interval until ctx is done.
+func (m *Monitor) Start(ctx context.Context) {
+	const op = "selfmonitor.(Monitor).Start"
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			event.WriteSysEvent(ctx, op, "self monitor shutting down", "component", m.name)
+			return
+		case <-timer.C:
+			m.check(ctx)
+			timer.Reset(m.opts.withInterval)
+		}
+	}
+}
+
+// check takes a reading, updates the metrics and emits events for any
+// threshold crossed since the previous reading.
+func (m *Monitor) check(ctx context.Context) reading {
+	const op = "selfmonitor.(Monitor).check"
+	r := m.opts.withReadFn()
+
+	goroutineCount.Set(float64(r.goroutines))
+	m.compare(ctx, readingGoroutines, int64(r.goroutines), int64(m.opts.withGoroutineThreshold), "goroutine count")
+
+	switch {
+	case r.openFilesErr != nil:
+		if !m.openFilesErrReported {
+			event.WriteError(ctx, op, r.openFilesErr, event.WithInfoMsg("unable to read open file descriptors", "component", m.name))
+			m.openFilesErrReported = true
+		}
+	default:
+		openFiles.Set(float64(r.openFiles))
+		maxOpenFiles.Set(float64(r.maxOpenFiles))
+		if r.maxOpenFiles > 0 && m.opts.withOpenFilesThresholdPercent > 0 {
+			threshold := int64(r.maxOpenFiles) * int64(m.opts.withOpenFilesThresholdPercent) / 100
+			m.compare(ctx, readingOpenFiles, int64(r.openFiles), threshold, "open file descriptors", "max_fds", r.maxOpenFiles)
+		}
+	}
+
+	if m.opts.withProxyConnectionsFn != nil {
+		proxyConnections.Set(float64(r.proxyConnections))
+		m.compare(ctx, readingProxyConnections, r.proxyConnections, m.opts.withProxyConnectionThreshold, "proxy connection table size")
+	}
+	return r
+}
+
+// compare emits a warning event when value crosses above threshold, and an
+// event when it goes back to or below it. A threshold that is not positive
+// disables the comparison.
+func (m *Monitor) compare(ctx context.Context, name string, value, threshold int64, desc string, extra ...any) {
+	const op = "selfmonitor.(Monitor).compare"
+	if threshold <= 0 {
+		return
+	}
+	above := value > threshold
+	if above == m.exceeded[name] {
+		return
+	}
+	m.exceeded[name] = above
+	args := append([]any{"component", m.name, "value", value, "threshold", threshold}, extra...)
+	if above {
+		thresholdExceeded.WithLabelValues(name).Inc()
+		event.WriteSysEvent(ctx, op, fmt.Sprintf("warning: %s above threshold", desc), args...)
+		return
+	}
+	event.WriteSysEvent(ctx, op, fmt.Sprintf("%s back within threshold", desc), args...)
+}
+
+func read(proxyConnectionsFn func() int64) reading {
+	r := reading{goroutines: runtime.NumGoroutine()}
+	r.openFiles, r.maxOpenFiles, r.openFilesErr = readOpenFiles()
+	if proxyConnectionsFn != nil {
+		r.proxyConnections = proxyConnectionsFn()
+	}
+	return r
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package selfmonitor
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := New(ctx, "")
+	require.Error(t, err)
+
+	// Registering twice onto the same registerer, as a controller and worker
+	// running in the same process do, is not an error.
+	r := prometheus.NewRegistry()
+	m, err := New(ctx, "controller", WithPrometheusRegisterer(r))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultInterval, m.opts.withInterval)
+	assert.Equal(t, DefaultGoroutineThreshold, m.opts.withGoroutineThreshold)
+	assert.Equal(t, DefaultOpenFilesThresholdPercent, m.opts.withOpenFilesThresholdPercent)
+	_, err = New(ctx, "worker", WithPrometheusRegisterer(r), WithInterval(time.Second), WithProxyConnections(func() int64 { return 0 }, 10))
+	require.NoError(t, err)
+}
+
+func TestMonitor_check(t *testing.T) {
+	ctx := context.Background()
+	var next reading
+	m, err := New(ctx, "worker",
+		WithGoroutineThreshold(100),
+		WithOpenFilesThresholdPercent(50),
+		WithProxyConnections(func() int64 { return next.proxyConnections }, 10),
+		withReadFn(func() reading { return next }),
+	)
+	require.NoError(t, err)
+
+	exceededCount := func(name string) float64 {
+		return testutil.ToFloat64(thresholdExceeded.WithLabelValues(name))
+	}
+	startGoroutines := exceededCount(readingGoroutines)
+	startOpenFiles := exceededCount(readingOpenFiles)
+	startProxy := exceededCount(readingProxyConnections)
+
+	next = reading{goroutines: 50, openFiles: 10, maxOpenFiles: 100, proxyConnections: 5}
+	m.check(ctx)
+	assert.Empty(t, trueKeys(m.exceeded))
+	assert.Equal(t, float64(50), testutil.ToFloat64(goroutineCount))
+	assert.Equal(t, float64(10), testutil.ToFloat64(openFiles))
+	assert.Equal(t, float64(100), testutil.ToFloat64(maxOpenFiles))
+	assert.Equal(t, float64(5), testutil.ToFloat64(proxyConnections))
+
+	next = reading{goroutines: 101, openFiles: 51, maxOpenFiles: 100, proxyConnections: 11}
+	m.check(ctx)
+	assert.ElementsMatch(t, []string{readingGoroutines, readingOpenFiles, readingProxyConnections}, trueKeys(m.exceeded))
+	assert.Equal(t, startGoroutines+1, exceededCount(readingGoroutines))
+	assert.Equal(t, startOpenFiles+1, exceededCount(readingOpenFiles))
+	assert.Equal(t, startProxy+1, exceededCount(readingProxyConnections))
+
+	// Staying above a threshold does not count as crossing it again.
+	next = reading{goroutines: 200, openFiles: 60, maxOpenFiles: 100, proxyConnections: 20}
+	m.check(ctx)
+	assert.Equal(t, startGoroutines+1, exceededCount(readingGoroutines))
+	assert.Equal(t, startOpenFiles+1, exceededCount(readingOpenFiles))
+	assert.Equal(t, startProxy+1, exceededCount(readingProxyConnections))
+
+	next = reading{goroutines: 100, openFiles: 50, maxOpenFiles: 100, proxyConnections: 10}
+	m.check(ctx)
+	assert.Empty(t, trueKeys(m.exceeded))
+
+	next = reading{goroutines: 101, openFilesErr: errors.New("unsupported"), proxyConnections: 1}
+	m.check(ctx)
+	assert.ElementsMatch(t, []string{readingGoroutines}, trueKeys(m.exceeded))
+	assert.True(t, m.openFilesErrReported)
+	assert.Equal(t, startGoroutines+2, exceededCount(readingGoroutines))
+}
+
+func TestMonitor_checkDisabledThresholds(t *testing.T) {
+	ctx := context.Background()
+	m, err := New(ctx, "controller",
+		WithGoroutineThreshold(0),
+		WithOpenFilesThresholdPercent(0),
+		withReadFn(func() reading {
+			return reading{goroutines: 1_000_000, openFiles: 100, maxOpenFiles: 100}
+		}),
+	)
+	require.NoError(t, err)
+	m.check(ctx)
+	assert.Empty(t, trueKeys(m.exceeded))
+}
+
+func TestRead(t *testing.T) {
+	r := read(func() int64 { return 7 })
+	assert.Greater(t, r.goroutines, 0)
+	assert.Equal(t, int64(7), r.proxyConnections)
+	if runtime.GOOS == "windows" {
+		assert.Error(t, r.openFilesErr)
+		return
+	}
+	require.NoError(t, r.openFilesErr)
+	assert.Greater(t, r.openFiles, 0)
+}
+
+func trueKeys(m map[string]bool) []string {
+	var ret []string
+	for k, v := range m {
+		if v {
+			ret = append(ret, k)
+		}
+	}
+	return ret
+}
+
+func TestConfigOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		in   config.SelfMonitor
+		exp  options
+	}{
+		{
+			name: "defaults",
+			exp:  getDefaultOptions(),
+		},
+		{
+			name: "set",
+			in: config.SelfMonitor{
+				IntervalDuration:          time.Minute,
+				GoroutineThreshold:        10,
+				OpenFilesThresholdPercent: 90,
+			},
+			exp: options{
+				withInterval:                  time.Minute,
+				withGoroutineThreshold:        10,
+				withOpenFilesThresholdPercent: 90,
+			},
+		},
+		{
+			name: "disabled-thresholds",
+			in: config.SelfMonitor{
+				GoroutineThreshold:        -1,
+				OpenFilesThresholdPercent: -1,
+			},
+			exp: options{
+				withInterval: DefaultInterval,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, getOpts(ConfigOptions(tt.in)...))
+		})
+	}
+}
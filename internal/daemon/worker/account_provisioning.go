@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/go-bexpr"
+)
+
+const (
+	// defaultAccountHookTimeout is how long the account provisioning command
+	// may run when its configuration doesn't set a timeout.
+	defaultAccountHookTimeout = 30 * time.Second
+
+	// accountHookVersion is the version of the document passed to the
+	// account provisioning command. It is increased if fields are changed or
+	// removed, not when fields are added.
+	accountHookVersion = 1
+
+	accountHookProvision   = "provision"
+	accountHookDeprovision = "deprovision"
+)
+
+// accountHookRequest is the JSON document the account provisioning command
+// reads from its standard input. It is the contract between the worker and
+// the command, which may create the account itself or ask an agent on the
+// endpoint to do so.
+type accountHookRequest struct {
+	Version     int       `json:"version"`
+	Action      string    `json:"action"`
+	SessionId   string    `json:"session_id"`
+	SessionType string    `json:"session_type"`
+	UserId      string    `json:"user_id"`
+	TargetId    string    `json:"target_id"`
+	ScopeId     string    `json:"scope_id"`
+	HostId      string    `json:"host_id,omitempty"`
+	HostSetId   string    `json:"host_set_id,omitempty"`
+	Endpoint    string    `json:"endpoint"`
+	Expiration  time.Time `json:"expiration"`
+}
+
+// accountFilterData is the data account provisioning filters are evaluated
+// against.
+type accountFilterData struct {
+	Session  accountFilterSession `json:"session"`
+	User     egressFilterId       `json:"user"`
+	Target   egressFilterTarget   `json:"target"`
+	Host     egressFilterId       `json:"host"`
+	HostSet  egressFilterId       `json:"host_set"`
+	Endpoint egressFilterEndpoint `json:"endpoint"`
+}
+
+type accountFilterSession struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// accountHook is the account provisioning command of the worker's
+// configuration.
+type accountHook struct {
+	command []string
+	timeout time.Duration
+	// eval is nil for hooks matching all sessions.
+	eval *bexpr.Evaluator
+}
+
+// newAccountHook returns the hook for the given configuration, which must
+// have been parsed. A nil configuration returns a nil hook.
+func newAccountHook(ctx context.Context, c *config.AccountProvisioning) (*accountHook, error) {
+	const op = "worker.newAccountHook"
+	if c == nil {
+		return nil, nil
+	}
+	h := &accountHook{
+		command: c.Command,
+		timeout: c.TimeoutDuration,
+	}
+	if h.timeout == 0 {
+		h.timeout = defaultAccountHookTimeout
+	}
+	if c.Filter != "" {
+		eval, err := bexpr.CreateEvaluator(c.Filter, bexpr.WithTagName("json"))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("invalid account provisioning filter"))
+		}
+		h.eval = eval
+	}
+	return h, nil
+}
+
+// run runs the command with the request on its standard input. The output of
+// the command is part of the returned error if it fails.
+func (h *accountHook) run(ctx context.Context, req *accountHookRequest) error {
+	in, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("unable to marshal account provisioning request: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, h.command[0], h.command[1:]...)
+	cmd.Stdin = bytes.NewReader(in)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if msg := strings.TrimSpace(string(out)); msg != "" {
+			return fmt.Errorf("account provisioning command failed: %w: %s", err, msg)
+		}
+		return fmt.Errorf("account provisioning command failed: %w", err)
+	}
+	return nil
+}
+
+// accountProvisioner provisions the accounts of sessions with the worker's
+// account provisioning command, before the first connection of a session, and
+// deprovisions them once the worker forgets the session.
+type accountProvisioner struct {
+	// hook is nil when the worker has no account provisioning
+	// configuration, and is an atomic for SIGHUP support.
+	hook atomic.Pointer[accountHook]
+
+	mu       sync.Mutex
+	sessions map[string]*provisionedAccount
+}
+
+// provisionedAccount is the account of a session. done is closed once the
+// provision command ended, and err is its result.
+type provisionedAccount struct {
+	done chan struct{}
+	err  error
+	req  accountHookRequest
+	// hook is the hook the account was provisioned with, which deprovisions
+	// it even if the configuration changed since.
+	hook *accountHook
+}
+
+func newAccountProvisioner() *accountProvisioner {
+	return &accountProvisioner{sessions: make(map[string]*provisionedAccount)}
+}
+
+// provision provisions the account of the session connecting to the endpoint
+// if the hook matches the session. It only runs the hook for the first
+// connection of a session; later connections wait for its result. A failed
+// provisioning is retried by the next connection.
+func (p *accountProvisioner) provision(ctx context.Context, sess session.Session, endpoint *url.URL) error {
+	const op = "worker.(accountProvisioner).provision"
+	if p == nil {
+		return nil
+	}
+	hook := p.hook.Load()
+	if hook == nil {
+		return nil
+	}
+	if hook.eval != nil {
+		match, err := hook.eval.Evaluate(accountFilterData{
+			Session:  accountFilterSession{Id: sess.GetId(), Type: sess.GetType()},
+			User:     egressFilterId{Id: sess.GetUserId()},
+			Target:   egressFilterTarget{Id: sess.GetTargetId(), ScopeId: sess.GetScopeId()},
+			Host:     egressFilterId{Id: sess.GetHostId()},
+			HostSet:  egressFilterId{Id: sess.GetHostSetId()},
+			Endpoint: egressFilterEndpoint{Host: endpoint.Hostname(), Port: endpoint.Port()},
+		})
+		if err != nil {
+			return fmt.Errorf("%s: error evaluating account provisioning filter: %w", op, err)
+		}
+		if !match {
+			return nil
+		}
+	}
+
+	p.mu.Lock()
+	if a, ok := p.sessions[sess.GetId()]; ok {
+		p.mu.Unlock()
+		select {
+		case <-a.done:
+			return a.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	a := &provisionedAccount{
+		done: make(chan struct{}),
+		req: accountHookRequest{
+			Version:     accountHookVersion,
+			Action:      accountHookProvision,
+			SessionId:   sess.GetId(),
+			SessionType: sess.GetType(),
+			UserId:      sess.GetUserId(),
+			TargetId:    sess.GetTargetId(),
+			ScopeId:     sess.GetScopeId(),
+			HostId:      sess.GetHostId(),
+			HostSetId:   sess.GetHostSetId(),
+			Endpoint:    endpoint.String(),
+			Expiration:  sess.GetExpiration(),
+		},
+		hook: hook,
+	}
+	p.sessions[sess.GetId()] = a
+	p.mu.Unlock()
+
+	a.err = hook.run(ctx, &a.req)
+	if a.err != nil {
+		p.mu.Lock()
+		delete(p.sessions, sess.GetId())
+		p.mu.Unlock()
+	} else {
+		event.WriteSysEvent(ctx, op, "account provisioned", "session_id", sess.GetId())
+	}
+	close(a.done)
+	return a.err
+}
+
+// deprovision deprovisions the accounts provisioned for the sessions. Sessions
+// without a provisioned account are ignored.
+func (p *accountProvisioner) deprovision(ctx context.Context, sessionIds []string) {
+	const op = "worker.(accountProvisioner).deprovision"
+	if p == nil {
+		return
+	}
+	for _, id := range sessionIds {
+		p.mu.Lock()
+		a, ok := p.sessions[id]
+		if ok {
+			delete(p.sessions, id)
+		}
+		p.mu.Unlock()
+		if !ok {
+			continue
+		}
+		<-a.done
+		if a.err != nil {
+			continue
+		}
+		req := a.req
+		req.Action = accountHookDeprovision
+		if err := a.hook.run(ctx, &req); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error deprovisioning account", "session_id", id))
+			continue
+		}
+		event.WriteSysEvent(ctx, op, "account deprovisioned", "session_id", id)
+	}
+}
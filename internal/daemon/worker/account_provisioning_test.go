@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accountTestSession is a session with only the fields used for account
+// provisioning.
+type accountTestSession struct {
+	session.Session
+	id       string
+	typ      string
+	userId   string
+	targetId string
+}
+
+func (s *accountTestSession) GetId() string            { return s.id }
+func (s *accountTestSession) GetType() string          { return s.typ }
+func (s *accountTestSession) GetUserId() string        { return s.userId }
+func (s *accountTestSession) GetTargetId() string      { return s.targetId }
+func (s *accountTestSession) GetScopeId() string       { return "p_1234567890" }
+func (s *accountTestSession) GetHostId() string        { return "" }
+func (s *accountTestSession) GetHostSetId() string     { return "" }
+func (s *accountTestSession) GetExpiration() time.Time { return time.Time{} }
+
+func TestAccountProvisioner(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses a shell script")
+	}
+	ctx := context.Background()
+	dir := t.TempDir()
+	log := filepath.Join(dir, "requests")
+
+	newProvisioner := func(t *testing.T, c *config.AccountProvisioning) *accountProvisioner {
+		t.Helper()
+		h, err := newAccountHook(ctx, c)
+		require.NoError(t, err)
+		p := newAccountProvisioner()
+		p.hook.Store(h)
+		return p
+	}
+	readRequests := func(t *testing.T) []accountHookRequest {
+		t.Helper()
+		b, err := os.ReadFile(log)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		require.NoError(t, err)
+		var ret []accountHookRequest
+		for _, l := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+			var req accountHookRequest
+			require.NoError(t, json.Unmarshal([]byte(l), &req))
+			ret = append(ret, req)
+		}
+		return ret
+	}
+	endpoint, err := url.Parse("tcp://10.0.0.1:22")
+	require.NoError(t, err)
+	sess := &accountTestSession{id: "s_1234567890", typ: "ssh", userId: "u_1234567890", targetId: "tssh_1234567890"}
+
+	t.Run("provision and deprovision", func(t *testing.T) {
+		t.Cleanup(func() { os.Remove(log) })
+		p := newProvisioner(t, &config.AccountProvisioning{
+			Command: []string{"sh", "-c", `cat >> "$0"; echo >> "$0"`, log},
+			Filter:  `"/session/type" == "ssh"`,
+		})
+
+		require.NoError(t, p.provision(ctx, sess, endpoint))
+		// Later connections of the session don't provision the account again
+		require.NoError(t, p.provision(ctx, sess, endpoint))
+		// Sessions not matching the filter are ignored
+		require.NoError(t, p.provision(ctx, &accountTestSession{id: "s_tcp", typ: "tcp"}, endpoint))
+
+		p.deprovision(ctx, []string{sess.id, "s_unknown"})
+		// The account is only deprovisioned once
+		p.deprovision(ctx, []string{sess.id})
+
+		reqs := readRequests(t)
+		require.Len(t, reqs, 2)
+		assert.Equal(t, accountHookRequest{
+			Version:     accountHookVersion,
+			Action:      accountHookProvision,
+			SessionId:   "s_1234567890",
+			SessionType: "ssh",
+			UserId:      "u_1234567890",
+			TargetId:    "tssh_1234567890",
+			ScopeId:     "p_1234567890",
+			Endpoint:    "tcp://10.0.0.1:22",
+		}, reqs[0])
+		assert.Equal(t, accountHookDeprovision, reqs[1].Action)
+		assert.Equal(t, "s_1234567890", reqs[1].SessionId)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Cleanup(func() { os.Remove(log) })
+		p := newProvisioner(t, &config.AccountProvisioning{
+			Command: []string{"sh", "-c", `cat >> "$0"; echo >> "$0"; echo "no such user" >&2; exit 1`, log},
+		})
+
+		err := p.provision(ctx, sess, endpoint)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no such user")
+		// A failed provisioning is retried and never deprovisioned
+		require.Error(t, p.provision(ctx, sess, endpoint))
+		p.deprovision(ctx, []string{sess.id})
+		assert.Len(t, readRequests(t), 2)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		p := newProvisioner(t, &config.AccountProvisioning{
+			Command:         []string{"sleep", "10"},
+			TimeoutDuration: 100 * time.Millisecond,
+		})
+		require.Error(t, p.provision(ctx, sess, endpoint))
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		p := newProvisioner(t, nil)
+		require.NoError(t, p.provision(ctx, sess, endpoint))
+		var nilProvisioner *accountProvisioner
+		require.NoError(t, nilProvisioner.provision(ctx, sess, endpoint))
+		nilProvisioner.deprovision(ctx, []string{sess.id})
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/libs/selfupdate"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/version"
+)
+
+const (
+	// defaultAutoUpdateCheckInterval is how often the manifest is checked if
+	// the configuration doesn't set it.
+	defaultAutoUpdateCheckInterval = time.Hour
+
+	// defaultAutoUpdateDrainTimeout is how long connections are drained
+	// before an update is installed if the configuration doesn't set it.
+	defaultAutoUpdateDrainTimeout = time.Hour
+
+	// drainPollInterval is how often the proxied connections are counted
+	// while draining.
+	drainPollInterval = 250 * time.Millisecond
+)
+
+// autoUpdater updates the binary of the worker.
+type autoUpdater struct {
+	updater       *selfupdate.Updater
+	executable    string
+	checkInterval time.Duration
+	drainTimeout  time.Duration
+}
+
+// newAutoUpdater returns the updater of the worker's binary, or nil if auto
+// update is not configured.
+func newAutoUpdater(c *config.AutoUpdate) (*autoUpdater, error) {
+	if c == nil {
+		return nil, nil
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("error finding worker binary: %w", err)
+	}
+	if executable, err = filepath.EvalSymlinks(executable); err != nil {
+		return nil, fmt.Errorf("error resolving worker binary: %w", err)
+	}
+	stagingPath := c.StagingPath
+	if stagingPath == "" {
+		stagingPath = filepath.Dir(executable)
+	}
+	publicKey, err := selfupdate.ParsePublicKey(c.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	u, err := selfupdate.New(c.ManifestUrl, publicKey, stagingPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	a := &autoUpdater{
+		updater:       u,
+		executable:    executable,
+		checkInterval: c.CheckIntervalDuration,
+		drainTimeout:  c.DrainTimeoutDuration,
+	}
+	if a.checkInterval == 0 {
+		a.checkInterval = defaultAutoUpdateCheckInterval
+	}
+	if a.drainTimeout == 0 {
+		a.drainTimeout = defaultAutoUpdateDrainTimeout
+	}
+	return a, nil
+}
+
+// startAutoUpdateTicking checks for updates until the context is canceled or
+// an update is installed.
+func (w *Worker) startAutoUpdateTicking(cancelCtx context.Context, a *autoUpdater) {
+	const op = "worker.(Worker).startAutoUpdateTicking"
+	event.WriteSysEvent(cancelCtx, op, "starting auto update ticking", "executable", a.executable, "check_interval", a.checkInterval.String())
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-cancelCtx.Done():
+			event.WriteSysEvent(cancelCtx, op, "auto update ticking shutting down")
+			return
+
+		case <-timer.C:
+			installed, err := w.autoUpdate(cancelCtx, a)
+			if err != nil {
+				event.WriteError(cancelCtx, op, err, event.WithInfoMsg("error updating worker"))
+			}
+			if installed {
+				return
+			}
+			// Add some jitter so that workers sharing a manifest don't all
+			// check and update at once
+			jitter := time.Duration(rand.Int63n(int64(a.checkInterval/10) + 1))
+			timer.Reset(a.checkInterval + jitter)
+		}
+	}
+}
+
+// autoUpdate stages the update of the worker's binary, if any. It then drains
+// the worker's connections, installs the update and shuts the worker down, so
+// that its service manager restarts it with the new binary. It returns true
+// if the update was installed.
+func (w *Worker) autoUpdate(ctx context.Context, a *autoUpdater) (bool, error) {
+	const op = "worker.(Worker).autoUpdate"
+	up, err := a.updater.Check(ctx, version.Get().Semver())
+	if err != nil {
+		return false, fmt.Errorf("error checking for update: %w", err)
+	}
+	if up == nil {
+		return false, nil
+	}
+	staged, err := a.updater.Stage(ctx, up)
+	if err != nil {
+		return false, fmt.Errorf("error staging update to %s: %w", up.Version, err)
+	}
+	event.WriteSysEvent(ctx, op, "staged worker update, draining connections", "version", up.Version.String(), "path", staged)
+
+	// Stop taking new sessions, as in a graceful shutdown, while the existing
+	// connections drain
+	w.operationalState.Store(server.ShutdownOperationalState)
+	if !w.drainConnections(ctx, a.drainTimeout) {
+		if ctx.Err() != nil {
+			os.Remove(staged)
+			return false, ctx.Err()
+		}
+		event.WriteSysEvent(ctx, op, "connections did not drain in time, closing them", "drain_timeout", a.drainTimeout.String(),
+			"connections", proxy.ProxyState.CurrentProxiedConnections())
+	}
+
+	if err := selfupdate.Install(staged, a.executable); err != nil {
+		w.operationalState.Store(server.ActiveOperationalState)
+		os.Remove(staged)
+		return false, fmt.Errorf("error installing update to %s: %w", up.Version, err)
+	}
+	event.WriteSysEvent(ctx, op, "installed worker update, shutting down to restart", "version", up.Version.String())
+	select {
+	case w.conf.ServerSideShutdownCh <- struct{}{}:
+	case <-ctx.Done():
+	}
+	return true, nil
+}
+
+// drainConnections waits until the worker proxies no connections. It returns
+// false if connections remain after the timeout or when the context is
+// canceled.
+func (w *Worker) drainConnections(ctx context.Context, timeout time.Duration) bool {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for proxy.ProxyState.CurrentProxiedConnections() > 0 {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-deadline.C:
+			return false
+		case <-ticker.C:
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAutoUpdater(t *testing.T) {
+	a, err := newAutoUpdater(nil)
+	require.NoError(t, err)
+	assert.Nil(t, a)
+
+	executable, err := os.Executable()
+	require.NoError(t, err)
+	executable, err = filepath.EvalSymlinks(executable)
+	require.NoError(t, err)
+
+	a, err = newAutoUpdater(&config.AutoUpdate{
+		ManifestUrl: "https://releases.example.com/boundary/manifest.json",
+		PublicKey:   "6ECIj60Y1hdwclWSuI5dk4qdvYthF/XQGL0BmpdE3fo=",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, a)
+	assert.Equal(t, executable, a.executable)
+	assert.Equal(t, defaultAutoUpdateCheckInterval, a.checkInterval)
+	assert.Equal(t, defaultAutoUpdateDrainTimeout, a.drainTimeout)
+
+	_, err = newAutoUpdater(&config.AutoUpdate{
+		ManifestUrl: "https://releases.example.com/boundary/manifest.json",
+		PublicKey:   "bm90IGEga2V5",
+	})
+	assert.Error(t, err)
+}
+
+func TestWorker_drainConnections(t *testing.T) {
+	ctx := context.Background()
+	w := &Worker{}
+	assert.True(t, w.drainConnections(ctx, time.Second))
+
+	// Hold a proxied connection open until released
+	release := make(chan struct{})
+	started := make(chan struct{})
+	done := make(chan struct{})
+	h := proxy.ProxyHandlerCounter(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		close(started)
+		<-release
+	}))
+	go func() {
+		defer close(done)
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	}()
+	<-started
+
+	assert.False(t, w.drainConnections(ctx, 2*drainPollInterval))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.False(t, w.drainConnections(canceled, time.Minute))
+
+	time.AfterFunc(drainPollInterval, func() { close(release) })
+	assert.True(t, w.drainConnections(ctx, time.Minute))
+	<-done
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	stderrors "errors"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/event"
+)
+
+// Reasons a proxied connection ended, as reported in connection end events.
+const (
+	connectionEndSetupFailed    = "proxy setup failed"
+	connectionEndClosed         = "closed"
+	connectionEndCanceled       = "canceled"
+	connectionEndSessionExpired = "session expired"
+	connectionEndMaxLifetime    = "max lifetime reached"
+)
+
+// connectionEventsSamplePercent returns the percentage of connections that
+// events are emitted for, given the worker's configuration. Zero means
+// connection events are disabled.
+func connectionEventsSamplePercent(c *config.ConnectionEvents) int32 {
+	switch {
+	case c == nil || !c.Enabled:
+		return 0
+	case c.SamplePercent == 0:
+		return 100
+	default:
+		return int32(c.SamplePercent)
+	}
+}
+
+// sampleConnection reports whether events are emitted for the connection.
+// The decision is derived from the connection id so that it's stable for a
+// given connection.
+func sampleConnection(connectionId string, percent int32) bool {
+	switch {
+	case percent <= 0 || connectionId == "":
+		return false
+	case percent >= 100:
+		return true
+	}
+	sum := sha256.Sum256([]byte(connectionId))
+	return binary.BigEndian.Uint64(sum[:8])%100 < uint64(percent)
+}
+
+// connectionEvent holds the flow-level metadata of a proxied connection that
+// is reported when it starts and ends. Payload data is never included.
+type connectionEvent struct {
+	sessionId      string
+	connectionId   string
+	clientAddr     string
+	userClientIp   string
+	endpoint       string
+	endpointAddr   string
+	dialLatency    time.Duration
+	authorizedTime time.Time
+	startTime      time.Time
+}
+
+// started emits the event for a connection whose proxy is starting. A nil
+// connectionEvent is not sampled and emits nothing.
+func (e *connectionEvent) started(ctx context.Context) {
+	const op = "worker.(connectionEvent).started"
+	if e == nil {
+		return
+	}
+	e.startTime = time.Now()
+	event.WriteSysEvent(ctx, op, "proxy connection started",
+		"session_id", e.sessionId,
+		"connection_id", e.connectionId,
+		"client_address", e.clientAddr,
+		"user_client_ip", e.userClientIp,
+		"endpoint", e.endpoint,
+		"endpoint_address", e.endpointAddr,
+		"dial_latency_ms", e.dialLatency.Milliseconds(),
+	)
+}
+
+// ended emits the event for a connection that was closed. A nil
+// connectionEvent is not sampled and emits nothing.
+func (e *connectionEvent) ended(ctx context.Context, bytesUp, bytesDown int64, reason string) {
+	const op = "worker.(connectionEvent).ended"
+	if e == nil {
+		return
+	}
+	start := e.startTime
+	if start.IsZero() {
+		start = e.authorizedTime
+	}
+	event.WriteSysEvent(ctx, op, "proxy connection ended",
+		"session_id", e.sessionId,
+		"connection_id", e.connectionId,
+		"client_address", e.clientAddr,
+		"user_client_ip", e.userClientIp,
+		"endpoint", e.endpoint,
+		"endpoint_address", e.endpointAddr,
+		"dial_latency_ms", e.dialLatency.Milliseconds(),
+		"duration_ms", time.Since(start).Milliseconds(),
+		"bytes_up", bytesUp,
+		"bytes_down", bytesDown,
+		"termination_reason", reason,
+	)
+}
+
+// connectionEndReason returns why a proxied connection ended, given the
+// context it was proxied with.
+func connectionEndReason(connCtx context.Context) string {
+	switch {
+	case stderrors.Is(context.Cause(connCtx), errMaxConnectionLifetime):
+		return connectionEndMaxLifetime
+	case stderrors.Is(connCtx.Err(), context.DeadlineExceeded):
+		return connectionEndSessionExpired
+	case stderrors.Is(connCtx.Err(), context.Canceled):
+		return connectionEndCanceled
+	default:
+		return connectionEndClosed
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectionEventsSamplePercent(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *config.ConnectionEvents
+		exp  int32
+	}{
+		{name: "nil", exp: 0},
+		{name: "disabled", in: &config.ConnectionEvents{SamplePercent: 50}, exp: 0},
+		{name: "enabled-default", in: &config.ConnectionEvents{Enabled: true}, exp: 100},
+		{name: "enabled-sampled", in: &config.ConnectionEvents{Enabled: true, SamplePercent: 25}, exp: 25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, connectionEventsSamplePercent(tt.in))
+		})
+	}
+}
+
+func TestSampleConnection(t *testing.T) {
+	assert.False(t, sampleConnection("", 100))
+	assert.False(t, sampleConnection("sc_1234567890", 0))
+	assert.True(t, sampleConnection("sc_1234567890", 100))
+
+	// The decision is stable for a connection, and roughly matches the
+	// percentage across connections.
+	const total = 10000
+	var sampled int
+	for i := 0; i < total; i++ {
+		id := fmt.Sprintf("sc_%010d", i)
+		got := sampleConnection(id, 20)
+		assert.Equal(t, got, sampleConnection(id, 20))
+		if got {
+			sampled++
+		}
+	}
+	assert.InDelta(t, total/5, sampled, total/50)
+}
+
+func TestConnectionEndReason(t *testing.T) {
+	assert.Equal(t, connectionEndClosed, connectionEndReason(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, connectionEndCanceled, connectionEndReason(ctx))
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	assert.Equal(t, connectionEndSessionExpired, connectionEndReason(ctx))
+
+	ctx, cancel = context.WithDeadlineCause(context.Background(), time.Now().Add(-time.Second), errMaxConnectionLifetime)
+	defer cancel()
+	assert.Equal(t, connectionEndMaxLifetime, connectionEndReason(ctx))
+}
+
+func TestConnectionEvent_nil(t *testing.T) {
+	// A connection that isn't sampled has a nil event, which must be safe to
+	// use.
+	var e *connectionEvent
+	assert.NotPanics(t, func() {
+		e.started(context.Background())
+		e.ended(context.Background(), 1, 2, connectionEndClosed)
+	})
+}
@@ -6,10 +6,12 @@ package worker
 import (
 	"net"
 	"sync"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
 )
 
 // countingConn is a `net.Conn` implementation that records the bytes that go
-// across Read() and Write(). All other `net.Conn` function calls are a
+// across Read() and Write(), and adds them to the proxy.ProxyState totals. All other `net.Conn` function calls are a
 // pass-through to the underlying `net.Conn`, meaning it's also safe to call
 // those functions directly on the underlying object, if you have access to it.
 type countingConn struct {
@@ -43,6 +45,9 @@ func (c *countingConn) Read(in []byte) (int, error) {
 	c.mu.Lock()
 	c.bytesRead += int64(n)
 	c.mu.Unlock()
+	if n > 0 {
+		proxy.ProxyState.AddBytes(uint64(n), 0)
+	}
 	return n, err
 }
 
@@ -53,5 +58,8 @@ func (c *countingConn) Write(in []byte) (int, error) {
 	c.mu.Lock()
 	c.bytesWritten += int64(n)
 	c.mu.Unlock()
+	if n > 0 {
+		proxy.ProxyState.AddBytes(0, uint64(n))
+	}
 	return n, err
 }
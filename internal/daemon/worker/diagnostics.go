@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/event"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	// eventLogBufferMaxAge is how long the worker keeps its events so they can
+	// be read through the controller.
+	eventLogBufferMaxAge = 30 * time.Minute
+
+	// eventLogBufferMaxSize is the maximum total size of the events the worker
+	// keeps so they can be read through the controller.
+	eventLogBufferMaxSize = 4 * 1024 * 1024
+)
+
+// NewEventLogBuffer returns a buffer for the recent events of the worker,
+// which the worker sends to the controller when its logs are requested.
+func NewEventLogBuffer() (*event.LogBuffer, error) {
+	return event.NewLogBuffer(eventLogBufferMaxAge, eventLogBufferMaxSize)
+}
+
+// resultQueue holds the results gathered in response to the controller's
+// requests until they are sent in a status request.
+type resultQueue[T any] struct {
+	mu    sync.Mutex
+	items []T
+}
+
+func (q *resultQueue[T]) add(r ...T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.items = append(q.items, r...)
+}
+
+func (q *resultQueue[T]) take() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	items := q.items
+	q.items = nil
+	return items
+}
+
+// gatherDiagnostics collects the buffered events of the requested period and
+// the current values of the worker's metrics.
+func (w *Worker) gatherDiagnostics(req *pbs.WorkerDiagnosticsRequest) *pbs.WorkerDiagnostics {
+	d := &pbs.WorkerDiagnostics{
+		RequestId: req.GetRequestId(),
+	}
+	if b := w.conf.EventLogBuffer; b != nil {
+		d.LogLines = b.Since(time.Now().Add(-time.Duration(req.GetMinutes()) * time.Minute))
+	}
+	g, ok := w.conf.PrometheusRegisterer.(prometheus.Gatherer)
+	if !ok {
+		return d
+	}
+	metrics, err := gatherMetrics(g)
+	if err != nil {
+		d.Error = err.Error()
+		return d
+	}
+	d.Metrics = metrics
+	return d
+}
+
+// gatherMetrics returns the current values of Boundary's metrics, keyed by the
+// metric name and labels. Histograms and summaries are reported by their count
+// and sum.
+func gatherMetrics(g prometheus.Gatherer) (map[string]float64, error) {
+	const op = "worker.gatherMetrics"
+	families, err := g.Gather()
+	if err != nil {
+		return nil, fmt.Errorf("%s: error gathering metrics: %w", op, err)
+	}
+	metrics := make(map[string]float64)
+	for _, f := range families {
+		name := f.GetName()
+		if !strings.HasPrefix(name, globals.MetricNamespace+"_") {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			labels := metricLabels(m.GetLabel())
+			switch f.GetType() {
+			case dto.MetricType_COUNTER:
+				metrics[name+labels] = m.GetCounter().GetValue()
+			case dto.MetricType_GAUGE:
+				metrics[name+labels] = m.GetGauge().GetValue()
+			case dto.MetricType_UNTYPED:
+				metrics[name+labels] = m.GetUntyped().GetValue()
+			case dto.MetricType_HISTOGRAM:
+				metrics[name+"_count"+labels] = float64(m.GetHistogram().GetSampleCount())
+				metrics[name+"_sum"+labels] = m.GetHistogram().GetSampleSum()
+			case dto.MetricType_SUMMARY:
+				metrics[name+"_count"+labels] = float64(m.GetSummary().GetSampleCount())
+				metrics[name+"_sum"+labels] = m.GetSummary().GetSampleSum()
+			}
+		}
+	}
+	return metrics, nil
+}
+
+// metricLabels formats the labels the way Prometheus does, for instance
+// {code="OK",method="GET"}.
+func metricLabels(labels []*dto.LabelPair) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(labels))
+	for _, l := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", l.GetName(), l.GetValue()))
+	}
+	sort.Strings(pairs)
+	return "{" + strings.Join(pairs, ",") + "}"
+}
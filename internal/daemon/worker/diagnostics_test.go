@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatherMetrics(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	r := prometheus.NewRegistry()
+
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "boundary",
+		Name:      "test_total",
+	}, []string{"method", "code"})
+	counter.WithLabelValues("GET", "OK").Add(3)
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "boundary",
+		Name:      "test_open",
+	})
+	gauge.Set(2)
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "boundary",
+		Name:      "test_seconds",
+	})
+	histogram.Observe(1.5)
+	histogram.Observe(0.5)
+	other := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "other_gauge",
+	})
+	other.Set(1)
+	r.MustRegister(counter, gauge, histogram, other)
+
+	metrics, err := gatherMetrics(r)
+	require.NoError(err)
+	assert.Equal(map[string]float64{
+		`boundary_test_total{code="OK",method="GET"}`: 3,
+		"boundary_test_open":                          2,
+		"boundary_test_seconds_count":                 2,
+		"boundary_test_seconds_sum":                   2,
+	}, metrics)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+)
+
+// dnsPolicy selects the resolver used to resolve the hostname of a session's
+// endpoint, following the worker's dns configuration.
+type dnsPolicy struct {
+	defaultResolver *proxy.Resolver
+	// domains are the split-horizon resolvers, most specific domain first.
+	domains []dnsDomainResolver
+	targets map[string]*proxy.Resolver
+}
+
+type dnsDomainResolver struct {
+	domain   string
+	resolver *proxy.Resolver
+}
+
+// newDnsPolicy returns the policy for the given configuration, which must
+// have been parsed. A nil configuration returns a nil policy.
+func newDnsPolicy(c *config.Dns) *dnsPolicy {
+	if c == nil {
+		return nil
+	}
+	p := &dnsPolicy{
+		defaultResolver: toProxyResolver(c.DnsResolver),
+		targets:         make(map[string]*proxy.Resolver, len(c.Targets)),
+	}
+	for _, sh := range c.SplitHorizon {
+		if sh == nil {
+			continue
+		}
+		p.domains = append(p.domains, dnsDomainResolver{
+			domain:   strings.ToLower(sh.Domain),
+			resolver: toProxyResolver(sh.DnsResolver),
+		})
+	}
+	sort.SliceStable(p.domains, func(i, j int) bool {
+		return len(p.domains[i].domain) > len(p.domains[j].domain)
+	})
+	for _, t := range c.Targets {
+		if t == nil {
+			continue
+		}
+		p.targets[t.TargetId] = toProxyResolver(t.DnsResolver)
+	}
+	return p
+}
+
+// resolverFor returns the resolver for the given target and hostname: the
+// target's resolver if it has one, else the resolver of the most specific
+// split-horizon domain containing the hostname, else the default resolver.
+// A nil policy returns nil, leaving the dialer to its defaults.
+func (p *dnsPolicy) resolverFor(targetId, host string) *proxy.Resolver {
+	if p == nil {
+		return nil
+	}
+	if r, ok := p.targets[targetId]; ok && targetId != "" {
+		return r
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, d := range p.domains {
+		if host == d.domain || strings.HasSuffix(host, "."+d.domain) {
+			return d.resolver
+		}
+	}
+	return p.defaultResolver
+}
+
+func toProxyResolver(r config.DnsResolver) *proxy.Resolver {
+	mode := r.Resolver
+	if mode == "" {
+		mode = proxy.ResolverSystem
+	}
+	return &proxy.Resolver{
+		Mode:          mode,
+		Servers:       append([]string(nil), r.Servers...),
+		TlsServerName: r.TlsServerName,
+		Timeout:       r.TimeoutDuration,
+	}
+}
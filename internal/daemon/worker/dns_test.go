@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDnsPolicy_resolverFor(t *testing.T) {
+	var nilPolicy *dnsPolicy
+	assert.Nil(t, nilPolicy.resolverFor("ttcp_1234567890", "db.internal"))
+	assert.Nil(t, newDnsPolicy(nil))
+
+	c, err := config.Parse(`
+	worker {
+		dns {
+			resolver = "custom"
+			servers  = ["10.0.0.53"]
+
+			split_horizon {
+				domain   = "corp.example.com"
+				resolver = "dot"
+				servers  = ["10.0.1.53"]
+			}
+			split_horizon {
+				domain   = "example.com"
+				resolver = "system"
+			}
+
+			target {
+				target_id = "ttcp_1234567890"
+				resolver  = "custom"
+				servers   = ["10.0.2.53:5353"]
+			}
+		}
+	}`)
+	require.NoError(t, err)
+	p := newDnsPolicy(c.Worker.Dns)
+	require.NotNil(t, p)
+
+	tests := []struct {
+		name     string
+		targetId string
+		host     string
+		want     *proxy.Resolver
+	}{
+		{
+			name: "default",
+			host: "db.internal",
+			want: &proxy.Resolver{Mode: proxy.ResolverCustom, Servers: []string{"10.0.0.53:53"}},
+		},
+		{
+			name: "most-specific-domain",
+			host: "db.corp.example.com",
+			want: &proxy.Resolver{Mode: proxy.ResolverDoT, Servers: []string{"10.0.1.53:853"}},
+		},
+		{
+			name: "domain-itself",
+			host: "Example.com.",
+			want: &proxy.Resolver{Mode: proxy.ResolverSystem, Servers: []string{}},
+		},
+		{
+			name: "suffix-not-on-label-boundary",
+			host: "badexample.com",
+			want: &proxy.Resolver{Mode: proxy.ResolverCustom, Servers: []string{"10.0.0.53:53"}},
+		},
+		{
+			name:     "target-overrides-domain",
+			targetId: "ttcp_1234567890",
+			host:     "db.corp.example.com",
+			want:     &proxy.Resolver{Mode: proxy.ResolverCustom, Servers: []string{"10.0.2.53:5353"}},
+		},
+		{
+			name:     "other-target",
+			targetId: "ttcp_0987654321",
+			host:     "db.corp.example.com",
+			want:     &proxy.Resolver{Mode: proxy.ResolverDoT, Servers: []string{"10.0.1.53:853"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := p.resolverFor(tt.targetId, tt.host)
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want.Mode, got.Mode)
+			assert.ElementsMatch(t, tt.want.Servers, got.Servers)
+		})
+	}
+}
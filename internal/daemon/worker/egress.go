@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/go-bexpr"
+)
+
+// egressPolicy selects the local address the endpoints of sessions are
+// dialed from, following the worker's egress configuration.
+type egressPolicy struct {
+	rules []egressRule
+}
+
+type egressRule struct {
+	// eval is nil for rules matching all sessions.
+	eval   *bexpr.Evaluator
+	source *proxy.EgressSource
+}
+
+// egressFilterData is the data egress filters are evaluated against.
+type egressFilterData struct {
+	Target   egressFilterTarget   `json:"target"`
+	Host     egressFilterId       `json:"host"`
+	HostSet  egressFilterId       `json:"host_set"`
+	Endpoint egressFilterEndpoint `json:"endpoint"`
+}
+
+type egressFilterTarget struct {
+	Id      string `json:"id"`
+	ScopeId string `json:"scope_id"`
+}
+
+type egressFilterId struct {
+	Id string `json:"id"`
+}
+
+type egressFilterEndpoint struct {
+	Host string `json:"host"`
+	Port string `json:"port"`
+}
+
+// newEgressPolicy returns the policy for the given configuration, which must
+// have been parsed. An empty configuration returns a nil policy.
+func newEgressPolicy(ctx context.Context, c []*config.WorkerEgress) (*egressPolicy, error) {
+	const op = "worker.newEgressPolicy"
+	if len(c) == 0 {
+		return nil, nil
+	}
+	p := &egressPolicy{rules: make([]egressRule, 0, len(c))}
+	for i, e := range c {
+		if e == nil {
+			continue
+		}
+		var rule egressRule
+		if e.Filter != "" {
+			eval, err := bexpr.CreateEvaluator(e.Filter, bexpr.WithTagName("json"))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("invalid filter for egress entry %d", i)))
+			}
+			rule.eval = eval
+		}
+		rule.source = &proxy.EgressSource{Interface: e.Interface}
+		if e.SourceAddress != "" {
+			addr, err := netip.ParseAddr(e.SourceAddress)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("invalid source address for egress entry %d", i)))
+			}
+			rule.source.Address = addr
+		}
+		p.rules = append(p.rules, rule)
+	}
+	return p, nil
+}
+
+// sourceFor returns the egress source of the first rule matching the
+// session, or nil if none match. A nil policy returns nil, leaving the
+// dialer to its defaults.
+func (p *egressPolicy) sourceFor(ctx context.Context, data egressFilterData) *proxy.EgressSource {
+	const op = "worker.(egressPolicy).sourceFor"
+	if p == nil {
+		return nil
+	}
+	for _, r := range p.rules {
+		if r.eval == nil {
+			return r.source
+		}
+		match, err := r.eval.Evaluate(data)
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error evaluating egress filter", "target_id", data.Target.Id))
+			continue
+		}
+		if match {
+			return r.source
+		}
+	}
+	return nil
+}
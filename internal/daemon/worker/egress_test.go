@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEgressPolicy_sourceFor(t *testing.T) {
+	ctx := context.Background()
+
+	var nilPolicy *egressPolicy
+	assert.Nil(t, nilPolicy.sourceFor(ctx, egressFilterData{}))
+	p, err := newEgressPolicy(ctx, nil)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	_, err = newEgressPolicy(ctx, []*config.WorkerEgress{{SourceAddress: "10.0.0.5", Filter: "/target/id =="}})
+	require.Error(t, err)
+
+	c, err := config.Parse(`
+	worker {
+		egress {
+			source_address = "10.0.0.5"
+			filter         = "\"/target/id\" == \"ttcp_1234567890\""
+		}
+		egress {
+			source_address = "10.0.0.6"
+			filter         = "\"/endpoint/host\" matches \"\\\\.corp\\\\.example\\\\.com$\" and \"/endpoint/port\" == \"22\""
+		}
+		egress {
+			interface = "eth1"
+			filter    = "\"/target/scope_id\" == \"p_1234567890\""
+		}
+	}`)
+	require.NoError(t, err)
+	p, err = newEgressPolicy(ctx, c.Worker.Egress)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		data          egressFilterData
+		wantAddress   string
+		wantInterface string
+	}{
+		{
+			name: "no-match",
+			data: egressFilterData{
+				Target:   egressFilterTarget{Id: "ttcp_0987654321", ScopeId: "p_0987654321"},
+				Endpoint: egressFilterEndpoint{Host: "db.internal", Port: "5432"},
+			},
+		},
+		{
+			name: "target",
+			data: egressFilterData{
+				Target:   egressFilterTarget{Id: "ttcp_1234567890", ScopeId: "p_1234567890"},
+				Endpoint: egressFilterEndpoint{Host: "ssh.corp.example.com", Port: "22"},
+			},
+			wantAddress: "10.0.0.5",
+		},
+		{
+			name: "endpoint",
+			data: egressFilterData{
+				Target:   egressFilterTarget{Id: "ttcp_0987654321", ScopeId: "p_1234567890"},
+				Endpoint: egressFilterEndpoint{Host: "ssh.corp.example.com", Port: "22"},
+			},
+			wantAddress: "10.0.0.6",
+		},
+		{
+			name: "scope",
+			data: egressFilterData{
+				Target:   egressFilterTarget{Id: "ttcp_0987654321", ScopeId: "p_1234567890"},
+				Endpoint: egressFilterEndpoint{Host: "ssh.corp.example.com", Port: "2222"},
+			},
+			wantInterface: "eth1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := p.sourceFor(ctx, tt.data)
+			if tt.wantAddress == "" && tt.wantInterface == "" {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			if tt.wantAddress != "" {
+				assert.Equal(t, tt.wantAddress, got.Address.String())
+			}
+			assert.Equal(t, tt.wantInterface, got.Interface)
+		})
+	}
+
+	// A block without a filter matches all sessions.
+	p, err = newEgressPolicy(ctx, []*config.WorkerEgress{{Interface: "eth0"}})
+	require.NoError(t, err)
+	got := p.sourceFor(ctx, egressFilterData{})
+	require.NotNil(t, got)
+	assert.Equal(t, "eth0", got.Interface)
+}
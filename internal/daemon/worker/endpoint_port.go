@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/boundary/internal/libs/endpoint"
+)
+
+// endpointWithPort returns the session endpoint with its port replaced by the
+// port the client chose in its handshake. The port of the endpoint itself is
+// always allowed; any other port must be in the allowed ports of the session,
+// which are copied from the target when the session is authorized. A port of
+// 0 means the client did not choose one and the endpoint is returned as is.
+func endpointWithPort(ep, allowedPorts string, port uint32) (string, error) {
+	if port == 0 {
+		return ep, nil
+	}
+	u, err := url.Parse(ep)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse endpoint: %w", err)
+	}
+	requested := strconv.FormatUint(uint64(port), 10)
+	if u.Port() == requested {
+		return ep, nil
+	}
+	allowed, err := endpoint.ParsePorts(allowedPorts)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse allowed ports: %w", err)
+	}
+	if !allowed.Contains(port) {
+		return "", fmt.Errorf("port %d is not allowed by the target", port)
+	}
+	u.Host = net.JoinHostPort(u.Hostname(), requested)
+	return u.String(), nil
+}
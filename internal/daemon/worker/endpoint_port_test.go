@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointWithPort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		endpoint     string
+		allowedPorts string
+		port         uint32
+		want         string
+		wantErr      string
+	}{
+		{
+			name:     "no port chosen",
+			endpoint: "tcp://10.0.0.1:5432",
+			want:     "tcp://10.0.0.1:5432",
+		},
+		{
+			name:     "endpoint port without allowed ports",
+			endpoint: "tcp://10.0.0.1:5432",
+			port:     5432,
+			want:     "tcp://10.0.0.1:5432",
+		},
+		{
+			name:     "other port without allowed ports",
+			endpoint: "tcp://10.0.0.1:5432",
+			port:     6432,
+			wantErr:  "port 6432 is not allowed by the target",
+		},
+		{
+			name:         "allowed port",
+			endpoint:     "tcp://10.0.0.1:5432",
+			allowedPorts: "5432,6432",
+			port:         6432,
+			want:         "tcp://10.0.0.1:6432",
+		},
+		{
+			name:         "port in allowed range",
+			endpoint:     "tcp://db.example.com:8000",
+			allowedPorts: "8000-8100",
+			port:         8042,
+			want:         "tcp://db.example.com:8042",
+		},
+		{
+			name:         "ipv6",
+			endpoint:     "tcp://[fd00::1]:8000",
+			allowedPorts: "8000-8100",
+			port:         8100,
+			want:         "tcp://[fd00::1]:8100",
+		},
+		{
+			name:         "port outside allowed range",
+			endpoint:     "tcp://10.0.0.1:8000",
+			allowedPorts: "8000-8100",
+			port:         8101,
+			wantErr:      "port 8101 is not allowed by the target",
+		},
+		{
+			name:         "bad allowed ports",
+			endpoint:     "tcp://10.0.0.1:8000",
+			allowedPorts: "8100-8000",
+			port:         8050,
+			wantErr:      "unable to parse allowed ports",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := endpointWithPort(tt.endpoint, tt.allowedPorts, tt.port)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
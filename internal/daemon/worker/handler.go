@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/common"
@@ -136,7 +137,12 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		// Later calls will cause this to noop if they return a different status
 		defer conn.Close(websocket.StatusNormalClosure, "done")
 
-		connCtx, connCancel := context.WithDeadline(ctx, sess.GetExpiration())
+		var liveness connectionLiveness
+		if w.connectionLiveness != nil {
+			liveness = w.connectionLiveness.Load().settingsFor(sess.GetTargetId())
+		}
+		connDeadline, connDeadlineCause := connectionDeadline(time.Now(), sess.GetExpiration(), liveness.maxLifetime)
+		connCtx, connCancel := context.WithDeadlineCause(ctx, connDeadline, connDeadlineCause)
 		defer connCancel()
 
 		var handshake proxy.ClientHandshake
@@ -198,6 +204,18 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			}
 		}
 
+		// The client may choose another port than the one of the endpoint, as
+		// long as the target allows it. This is checked before authorizing the
+		// connection so that a refused port does not use up a connection.
+		endpoint, err := endpointWithPort(sess.GetEndpoint(), sess.GetAllowedPorts(), handshake.GetPort())
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("refusing port chosen by client", "session_id", sessionId, "port", handshake.GetPort()))
+			if err = conn.Close(websocket.StatusPolicyViolation, "port not allowed"); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+			}
+			return
+		}
+
 		if w.LastStatusSuccess() == nil || w.LastStatusSuccess().WorkerId == "" {
 			event.WriteError(ctx, op, stderrors.New("worker id is empty"))
 			if err = conn.Close(websocket.StatusInternalError, "worker id is empty"); err != nil {
@@ -219,6 +237,19 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 		}
 		event.WriteSysEvent(ctx, op, "connection successfully authorized", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
 
+		var connEvent *connectionEvent
+		if w.connectionEventsSamplePercent != nil && sampleConnection(acResp.GetConnectionId(), w.connectionEventsSamplePercent.Load()) {
+			connEvent = &connectionEvent{
+				sessionId:      sessionId,
+				connectionId:   acResp.GetConnectionId(),
+				clientAddr:     clientAddr.String(),
+				userClientIp:   userClientIp,
+				endpoint:       endpoint,
+				authorizedTime: time.Now(),
+			}
+		}
+		endReason := connectionEndSetupFailed
+
 		// Wrapping the client websocket with a `net.Conn` implementation that
 		// records the bytes that go across Read() and Write().
 		cc := &countingConn{Conn: websocket.NetConn(connCtx, conn, websocket.MessageBinary)}
@@ -243,6 +274,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			if sessionManager.RequestCloseConnections(ctx, ccd) {
 				event.WriteSysEvent(ctx, op, "connection closed", "session_id", sessionId, "connection_id", acResp.GetConnectionId())
 			}
+			connEvent.ended(ctx, cc.BytesRead(), cc.BytesWritten(), endReason)
 		}()
 
 		handshakeResult := &proxy.HandshakeResult{
@@ -258,9 +290,37 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 
-		endpointUrl, err := url.Parse(sess.GetEndpoint())
+		// With TLS passthrough the worker only reads the ClientHello of the
+		// client, to refuse anything but TLS and to route the connection by the
+		// server name the client asks for. The handshake, and with it the
+		// client certificate, reaches the endpoint unchanged.
+		var proxyConn net.Conn = cc
+		if sess.GetTlsPassthrough() {
+			var serverName string
+			proxyConn, serverName, err = readClientHello(cc)
+			if err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("refusing tls passthrough connection", "session_id", sessionId, "connection_id", acResp.GetConnectionId()))
+				if err = conn.Close(websocket.StatusPolicyViolation, "tls client hello required"); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+				}
+				return
+			}
+			endpoint, err = endpointWithServerName(endpoint, sess.GetTlsServerNames(), serverName)
+			if err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("refusing server name sent by client", "session_id", sessionId, "connection_id", acResp.GetConnectionId(), "server_name", serverName))
+				if err = conn.Close(websocket.StatusPolicyViolation, "server name not allowed"); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+				}
+				return
+			}
+			if connEvent != nil {
+				connEvent.endpoint = endpoint
+			}
+		}
+
+		endpointUrl, err := url.Parse(endpoint)
 		if err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("worker failed to parse target endpoint", "endpoint", sess.GetEndpoint()))
+			event.WriteError(ctx, op, err, event.WithInfoMsg("worker failed to parse target endpoint", "endpoint", endpoint))
 			if err = conn.Close(websocket.StatusProtocolError, "unable to parse endpoint"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
 			}
@@ -276,7 +336,37 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			}
 		}
 
-		pDialer, err := proxyHandlers.GetEndpointDialer(ctx, endpointUrl.Host, workerId, acResp, w.downstreamReceiver, proxyHandlers.WithDnsServerAddress(w.conf.WorkerDnsServer))
+		// Just-in-time accounts are provisioned before the first connection
+		// to the endpoint of a session, so they exist when the client
+		// authenticates to it.
+		if err := w.accountProvisioner.provision(ctx, sess, endpointUrl); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error provisioning account", "session_id", sessionId, "connection_id", acResp.GetConnectionId()))
+			if err = conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to provision account"); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+			}
+			return
+		}
+
+		dialerOpts := []proxyHandlers.Option{proxyHandlers.WithDnsServerAddress(w.conf.WorkerDnsServer)}
+		if w.dnsPolicy != nil {
+			if r := w.dnsPolicy.Load().resolverFor(sess.GetTargetId(), endpointUrl.Hostname()); r != nil {
+				dialerOpts = append(dialerOpts, proxyHandlers.WithResolver(r))
+			}
+		}
+		if w.egressPolicy != nil {
+			if src := w.egressPolicy.Load().sourceFor(ctx, egressFilterData{
+				Target:   egressFilterTarget{Id: sess.GetTargetId(), ScopeId: sess.GetScopeId()},
+				Host:     egressFilterId{Id: sess.GetHostId()},
+				HostSet:  egressFilterId{Id: sess.GetHostSetId()},
+				Endpoint: egressFilterEndpoint{Host: endpointUrl.Hostname(), Port: endpointUrl.Port()},
+			}); src != nil {
+				dialerOpts = append(dialerOpts, proxyHandlers.WithEgressSource(src))
+			}
+		}
+		if liveness.tcp != nil {
+			dialerOpts = append(dialerOpts, proxyHandlers.WithTcpLiveness(liveness.tcp))
+		}
+		pDialer, err := proxyHandlers.GetEndpointDialer(ctx, endpointUrl.Host, workerId, acResp, w.downstreamReceiver, dialerOpts...)
 		if err != nil {
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to get endpoint dialer")
 			event.WriteError(ctx, op, err)
@@ -295,7 +385,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "error getting decryption function")
 			event.WriteError(ctx, op, err)
 		}
-		runProxy, err := handleProxyFn(ctx, ctx, decryptFn, cc, pDialer, acResp.GetConnectionId(), protocolCtx, w.recorderManager)
+		runProxy, err := handleProxyFn(ctx, ctx, decryptFn, proxyConn, pDialer, acResp.GetConnectionId(), protocolCtx, w.recorderManager)
 		if err != nil {
 			conn.Close(proxyHandlers.WebsocketStatusProtocolSetupError, "unable to setup proxying")
 			event.WriteError(ctx, op, err)
@@ -322,7 +412,13 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			return
 		}
 
+		if connEvent != nil {
+			connEvent.endpointAddr = net.JoinHostPort(endpointAddr.Ip(), strconv.FormatUint(uint64(endpointAddr.Port()), 10))
+			connEvent.dialLatency = pDialer.LastDialDuration()
+		}
+		connEvent.started(ctx)
 		runProxy()
+		endReason = connectionEndReason(connCtx)
 	}, nil
 }
 
@@ -197,11 +197,19 @@ func (w *Worker) configureForWorker(ln *base.ServerListener, logger *log.Logger,
 		wrapperToUse = w.conf.DownstreamWorkerAuthKms
 	}
 
+	// Session connections carried by reverse tunnels are accepted alongside
+	// those of the proxy listener, so they are authenticated and proxied alike
+	baseListener := ln.ProxyListener
+	if len(w.conf.RawConfig.Worker.ReverseTunnelTargetIds) > 0 {
+		w.reverseTunnelListener = newTunnelListener(ln.ProxyListener)
+		baseListener = w.reverseTunnelListener
+	}
+
 	interceptingListener, err := protocol.NewInterceptingListener(
 		&protocol.InterceptingListenerConfiguration{
 			Context:      w.baseContext,
 			Storage:      w.WorkerAuthStorage,
-			BaseListener: ln.ProxyListener,
+			BaseListener: baseListener,
 			BaseTlsConfiguration: &tls.Config{
 				GetConfigForClient: w.getSessionTls(sessionManager),
 			},
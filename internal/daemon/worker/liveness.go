@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	stderrors "errors"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+)
+
+// errMaxConnectionLifetime is the cause of the cancellation of a proxied
+// connection's context when it reaches its maximum lifetime.
+var errMaxConnectionLifetime = stderrors.New("maximum connection lifetime reached")
+
+// connectionLivenessPolicy selects the liveness settings of proxied
+// connections, following the worker's connection_liveness configuration.
+type connectionLivenessPolicy struct {
+	defaults connectionLiveness
+	targets  map[string]connectionLiveness
+}
+
+// connectionLiveness are the liveness settings of a proxied connection.
+type connectionLiveness struct {
+	// tcp is nil when no TCP settings are set.
+	tcp         *proxy.TcpLiveness
+	maxLifetime time.Duration
+}
+
+// newConnectionLivenessPolicy returns the policy for the given configuration,
+// which must have been parsed. A nil configuration returns a nil policy.
+func newConnectionLivenessPolicy(c *config.ConnectionLiveness) *connectionLivenessPolicy {
+	if c == nil {
+		return nil
+	}
+	p := &connectionLivenessPolicy{
+		defaults: toConnectionLiveness(c.ConnectionLivenessSettings),
+		targets:  make(map[string]connectionLiveness, len(c.Targets)),
+	}
+	for _, t := range c.Targets {
+		if t == nil {
+			continue
+		}
+		p.targets[t.TargetId] = toConnectionLiveness(mergeConnectionLivenessSettings(c.ConnectionLivenessSettings, t.ConnectionLivenessSettings))
+	}
+	return p
+}
+
+// settingsFor returns the liveness settings of the connections of the given
+// target. A nil policy returns empty settings, leaving the defaults in place.
+func (p *connectionLivenessPolicy) settingsFor(targetId string) connectionLiveness {
+	if p == nil {
+		return connectionLiveness{}
+	}
+	if l, ok := p.targets[targetId]; ok && targetId != "" {
+		return l
+	}
+	return p.defaults
+}
+
+// connectionDeadline returns when a connection to a session expiring at
+// expiration must be closed, and the cause to cancel its context with. The
+// cause is nil when the connection lasts until the session expires.
+func connectionDeadline(now, expiration time.Time, maxLifetime time.Duration) (time.Time, error) {
+	if maxLifetime <= 0 {
+		return expiration, nil
+	}
+	if d := now.Add(maxLifetime); d.Before(expiration) {
+		return d, errMaxConnectionLifetime
+	}
+	return expiration, nil
+}
+
+// mergeConnectionLivenessSettings returns base with the values set in
+// override replacing its own.
+func mergeConnectionLivenessSettings(base, override config.ConnectionLivenessSettings) config.ConnectionLivenessSettings {
+	if override.TcpKeepaliveIdleDuration != 0 {
+		base.TcpKeepaliveIdleDuration = override.TcpKeepaliveIdleDuration
+	}
+	if override.TcpKeepaliveIntervalDuration != 0 {
+		base.TcpKeepaliveIntervalDuration = override.TcpKeepaliveIntervalDuration
+	}
+	if override.TcpKeepaliveCount != 0 {
+		base.TcpKeepaliveCount = override.TcpKeepaliveCount
+	}
+	if override.TcpUserTimeoutDuration != 0 {
+		base.TcpUserTimeoutDuration = override.TcpUserTimeoutDuration
+	}
+	if override.MaxConnectionLifetimeDuration != 0 {
+		base.MaxConnectionLifetimeDuration = override.MaxConnectionLifetimeDuration
+	}
+	return base
+}
+
+func toConnectionLiveness(s config.ConnectionLivenessSettings) connectionLiveness {
+	l := connectionLiveness{maxLifetime: s.MaxConnectionLifetimeDuration}
+	tcp := proxy.TcpLiveness{
+		KeepaliveIdle:     s.TcpKeepaliveIdleDuration,
+		KeepaliveInterval: s.TcpKeepaliveIntervalDuration,
+		KeepaliveCount:    s.TcpKeepaliveCount,
+		UserTimeout:       s.TcpUserTimeoutDuration,
+	}
+	if tcp != (proxy.TcpLiveness{}) {
+		l.tcp = &tcp
+	}
+	return l
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionLivenessPolicy_settingsFor(t *testing.T) {
+	var nilPolicy *connectionLivenessPolicy
+	assert.Equal(t, connectionLiveness{}, nilPolicy.settingsFor("ttcp_1234567890"))
+	assert.Nil(t, newConnectionLivenessPolicy(nil))
+
+	c, err := config.Parse(`
+	worker {
+		connection_liveness {
+			tcp_keepalive_idle      = "30s"
+			tcp_keepalive_interval  = "10s"
+			max_connection_lifetime = "8h"
+
+			target {
+				target_id          = "ttcp_1234567890"
+				tcp_keepalive_idle = "15s"
+				tcp_user_timeout   = "1m"
+			}
+			target {
+				target_id               = "ttcp_0987654321"
+				max_connection_lifetime = "1h"
+			}
+		}
+	}`)
+	require.NoError(t, err)
+	p := newConnectionLivenessPolicy(c.Worker.ConnectionLiveness)
+	require.NotNil(t, p)
+
+	assert.Equal(t, connectionLiveness{
+		tcp:         &proxy.TcpLiveness{KeepaliveIdle: 30 * time.Second, KeepaliveInterval: 10 * time.Second},
+		maxLifetime: 8 * time.Hour,
+	}, p.settingsFor("ttcp_other"))
+	assert.Equal(t, p.settingsFor("ttcp_other"), p.settingsFor(""))
+	assert.Equal(t, connectionLiveness{
+		tcp: &proxy.TcpLiveness{
+			KeepaliveIdle:     15 * time.Second,
+			KeepaliveInterval: 10 * time.Second,
+			UserTimeout:       time.Minute,
+		},
+		maxLifetime: 8 * time.Hour,
+	}, p.settingsFor("ttcp_1234567890"))
+	assert.Equal(t, connectionLiveness{
+		tcp:         &proxy.TcpLiveness{KeepaliveIdle: 30 * time.Second, KeepaliveInterval: 10 * time.Second},
+		maxLifetime: time.Hour,
+	}, p.settingsFor("ttcp_0987654321"))
+
+	// Without TCP settings, the dialer's defaults are left in place.
+	p = newConnectionLivenessPolicy(&config.ConnectionLiveness{
+		ConnectionLivenessSettings: config.ConnectionLivenessSettings{MaxConnectionLifetimeDuration: time.Hour},
+	})
+	assert.Nil(t, p.settingsFor("").tcp)
+}
+
+func TestConnectionDeadline(t *testing.T) {
+	now := time.Now()
+	expiration := now.Add(8 * time.Hour)
+
+	d, cause := connectionDeadline(now, expiration, 0)
+	assert.Equal(t, expiration, d)
+	assert.Nil(t, cause)
+
+	d, cause = connectionDeadline(now, expiration, time.Hour)
+	assert.Equal(t, now.Add(time.Hour), d)
+	assert.ErrorIs(t, cause, errMaxConnectionLifetime)
+
+	d, cause = connectionDeadline(now, expiration, 9*time.Hour)
+	assert.Equal(t, expiration, d)
+	assert.Nil(t, cause)
+}
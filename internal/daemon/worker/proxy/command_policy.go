@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/libs/commandpolicy"
+	"golang.org/x/crypto/ssh"
+)
+
+// SSH channel request types which start a program on the endpoint.
+const (
+	sshExecRequestType      = "exec"
+	sshShellRequestType     = "shell"
+	sshSubsystemRequestType = "subsystem"
+)
+
+// CheckSshRequest checks an SSH session channel request against the command
+// policy of the target. Exec requests are checked by their command. Shell and
+// subsystem requests are denied if the policy restricts commands, since what
+// they run cannot be checked. Other requests are allowed. The command of exec
+// requests is returned for denial events. An error is returned if the payload
+// of an exec request is malformed.
+func CheckSshRequest(p *commandpolicy.Policy, requestType string, payload []byte) (commandpolicy.Decision, string, error) {
+	const op = "proxy.CheckSshRequest"
+	switch requestType {
+	case sshExecRequestType:
+		var req struct {
+			Command string
+		}
+		if err := ssh.Unmarshal(payload, &req); err != nil {
+			return commandpolicy.Decision{}, "", fmt.Errorf("%s: unable to unmarshal exec request: %w", op, err)
+		}
+		return p.Check(req.Command), req.Command, nil
+	case sshShellRequestType, sshSubsystemRequestType:
+		if p.Restricted() {
+			return commandpolicy.Decision{Reason: fmt.Sprintf("%s requests are not allowed when commands are restricted", requestType)}, "", nil
+		}
+	}
+	return commandpolicy.Decision{Allowed: true}, "", nil
+}
+
+// WriteCommandDeniedEvent writes the event of an SSH request denied by the
+// command policy of the target of a session.
+func WriteCommandDeniedEvent(ctx context.Context, sessionId, connectionId, requestType, command string, d commandpolicy.Decision) {
+	const op = "proxy.WriteCommandDeniedEvent"
+	event.WriteSysEvent(ctx, op, "ssh request denied by command policy",
+		"session_id", sessionId,
+		"connection_id", connectionId,
+		"request_type", requestType,
+		"command", command,
+		"pattern", d.Pattern,
+		"reason", d.Reason,
+	)
+}
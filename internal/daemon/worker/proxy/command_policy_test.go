@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/libs/commandpolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestCheckSshRequest(t *testing.T) {
+	t.Parallel()
+
+	restricted, err := commandpolicy.New([]string{"uptime"}, nil)
+	require.NoError(t, err)
+	denyOnly, err := commandpolicy.New(nil, []string{"rm *"})
+	require.NoError(t, err)
+	exec := func(command string) []byte {
+		return ssh.Marshal(struct{ Command string }{command})
+	}
+
+	tests := []struct {
+		name        string
+		policy      *commandpolicy.Policy
+		requestType string
+		payload     []byte
+		wantAllowed bool
+		wantCommand string
+		wantErr     bool
+	}{
+		{
+			name:        "allowed exec",
+			policy:      restricted,
+			requestType: "exec",
+			payload:     exec("uptime"),
+			wantAllowed: true,
+			wantCommand: "uptime",
+		},
+		{
+			name:        "denied exec",
+			policy:      restricted,
+			requestType: "exec",
+			payload:     exec("cat /etc/shadow"),
+			wantCommand: "cat /etc/shadow",
+		},
+		{
+			name:        "denied exec without allowed commands",
+			policy:      denyOnly,
+			requestType: "exec",
+			payload:     exec("rm -rf /"),
+			wantCommand: "rm -rf /",
+		},
+		{
+			name:        "shell when restricted",
+			policy:      restricted,
+			requestType: "shell",
+		},
+		{
+			name:        "subsystem when restricted",
+			policy:      restricted,
+			requestType: "subsystem",
+			payload:     ssh.Marshal(struct{ Name string }{"sftp"}),
+		},
+		{
+			name:        "shell without allowed commands",
+			policy:      denyOnly,
+			requestType: "shell",
+			wantAllowed: true,
+		},
+		{
+			name:        "other request",
+			policy:      restricted,
+			requestType: "window-change",
+			wantAllowed: true,
+		},
+		{
+			name:        "no policy",
+			requestType: "exec",
+			payload:     exec("rm -rf /"),
+			wantAllowed: true,
+			wantCommand: "rm -rf /",
+		},
+		{
+			name:        "malformed exec",
+			policy:      restricted,
+			requestType: "exec",
+			payload:     []byte{0x01},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, command, err := CheckSshRequest(tt.policy, tt.requestType, tt.payload)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAllowed, d.Allowed)
+			assert.Equal(t, tt.wantCommand, command)
+			if !tt.wantAllowed {
+				assert.NotEmpty(t, d.Reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// EgressSource specifies the local address that connections to endpoints
+// are dialed from, so that the networks of targets can allow traffic from
+// stable worker addresses.
+type EgressSource struct {
+	// Address is the IP address connections are dialed from.
+	Address netip.Addr
+	// Interface is the name of the network interface whose address
+	// connections are dialed from. It's ignored when Address is set.
+	Interface string
+}
+
+// localAddr returns the address to dial endpoint from. A nil EgressSource
+// returns nil, leaving the choice to the OS. When dialing from an interface,
+// its address matching the family of the endpoint's IP is used; for
+// hostnames, an IPv4 address is preferred, and the dialer only considers
+// addresses of the hostname in the same family.
+func (e *EgressSource) localAddr(ctx context.Context, endpoint string) (*net.TCPAddr, error) {
+	const op = "proxy.(EgressSource).localAddr"
+	if e == nil {
+		return nil, nil
+	}
+	if e.Address.IsValid() {
+		return net.TCPAddrFromAddrPort(netip.AddrPortFrom(e.Address, 0)), nil
+	}
+	if e.Interface == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing address and interface")
+	}
+
+	wantV6 := false
+	if host, _, err := net.SplitHostPort(endpoint); err == nil {
+		if ip, err := netip.ParseAddr(host); err == nil {
+			wantV6 = !ip.Unmap().Is4()
+		}
+	}
+	iface, err := net.InterfaceByName(e.Interface)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to find interface %q", e.Interface)))
+	}
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to list addresses of interface %q", e.Interface)))
+	}
+	var fallback netip.Addr
+	for _, a := range addrs {
+		ipNet, ok := a.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		ip, ok := netip.AddrFromSlice(ipNet.IP)
+		if !ok || ip.IsLinkLocalUnicast() {
+			continue
+		}
+		ip = ip.Unmap()
+		if ip.Is6() == wantV6 {
+			return net.TCPAddrFromAddrPort(netip.AddrPortFrom(ip, 0)), nil
+		}
+		if !fallback.IsValid() {
+			fallback = ip
+		}
+	}
+	// Hostnames may resolve to addresses of either family, so fall back to
+	// the interface's address of the other family.
+	if fallback.IsValid() && !isIpLiteral(endpoint) {
+		return net.TCPAddrFromAddrPort(netip.AddrPortFrom(fallback, 0)), nil
+	}
+	return nil, errors.New(ctx, errors.NotFound, op, fmt.Sprintf("interface %q has no usable address for %q", e.Interface, endpoint))
+}
+
+func isIpLiteral(endpoint string) bool {
+	host, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return false
+	}
+	_, err = netip.ParseAddr(host)
+	return err == nil
+}
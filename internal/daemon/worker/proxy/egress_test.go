@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEgressSource_localAddr(t *testing.T) {
+	ctx := context.Background()
+
+	var nilSource *EgressSource
+	addr, err := nilSource.localAddr(ctx, "10.0.0.1:22")
+	require.NoError(t, err)
+	assert.Nil(t, addr)
+
+	addr, err = (&EgressSource{Address: netip.MustParseAddr("10.0.0.5")}).localAddr(ctx, "db.internal:5432")
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.5:0", addr.String())
+
+	_, err = (&EgressSource{}).localAddr(ctx, "10.0.0.1:22")
+	assert.Error(t, err)
+
+	_, err = (&EgressSource{Interface: "boundary-missing0"}).localAddr(ctx, "10.0.0.1:22")
+	assert.Error(t, err)
+
+	loopback := loopbackInterface(t)
+	addr, err = (&EgressSource{Interface: loopback}).localAddr(ctx, "127.0.0.1:22")
+	require.NoError(t, err)
+	assert.True(t, addr.IP.IsLoopback())
+	assert.NotNil(t, addr.IP.To4())
+}
+
+func TestDirectDialer_WithEgressSource(t *testing.T) {
+	ctx := context.Background()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	accepted := make(chan net.Addr, 1)
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		accepted <- c.RemoteAddr()
+		_ = c.Close()
+	}()
+
+	// 127.0.0.2 is a loopback address distinct from the listener's own.
+	src := &EgressSource{Address: netip.MustParseAddr("127.0.0.2")}
+	d, err := directDialer(ctx, l.Addr().String(), "", nil, nil, WithEgressSource(src))
+	require.NoError(t, err)
+	c, err := d.Dial(ctx)
+	if err != nil {
+		t.Skipf("unable to dial from 127.0.0.2: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	remote := (<-accepted).(*net.TCPAddr)
+	assert.Equal(t, "127.0.0.2", remote.IP.String())
+}
+
+func loopbackInterface(t *testing.T) string {
+	t.Helper()
+	ifaces, err := net.Interfaces()
+	require.NoError(t, err)
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback == 0 {
+			continue
+		}
+		addrs, err := iface.Addrs()
+		require.NoError(t, err)
+		for _, a := range addrs {
+			if ipNet, ok := a.(*net.IPNet); ok && ipNet.IP.To4() != nil {
+				return iface.Name
+			}
+		}
+	}
+	t.Skip("no loopback interface with an IPv4 address")
+	return ""
+}
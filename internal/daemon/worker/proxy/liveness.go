@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"net"
+	"time"
+)
+
+// TcpLiveness tunes the liveness of connections to endpoints that are dialed
+// directly. Zero values leave the defaults of the OS in place.
+type TcpLiveness struct {
+	// KeepaliveIdle is how long a connection is idle before keepalive probes
+	// are sent.
+	KeepaliveIdle time.Duration
+	// KeepaliveInterval is the time between keepalive probes.
+	KeepaliveInterval time.Duration
+	// KeepaliveCount is the number of unanswered probes after which the
+	// connection is dropped.
+	KeepaliveCount int
+	// UserTimeout is how long sent data can remain unacknowledged before the
+	// connection is dropped. It is only supported on Linux and ignored
+	// elsewhere.
+	UserTimeout time.Duration
+}
+
+// apply configures dialer to use the liveness settings. A nil TcpLiveness
+// leaves dialer unchanged.
+func (l *TcpLiveness) apply(dialer *net.Dialer) {
+	if l == nil {
+		return
+	}
+	// Negative values leave the OS settings unchanged, whereas zero values
+	// would be replaced with the defaults of the net package.
+	orUnchanged := func(d time.Duration) time.Duration {
+		if d == 0 {
+			return -1
+		}
+		return d
+	}
+	count := l.KeepaliveCount
+	if count == 0 {
+		count = -1
+	}
+	dialer.KeepAliveConfig = net.KeepAliveConfig{
+		Enable:   true,
+		Idle:     orUnchanged(l.KeepaliveIdle),
+		Interval: orUnchanged(l.KeepaliveInterval),
+		Count:    count,
+	}
+	if l.UserTimeout > 0 {
+		dialer.Control = userTimeoutControl(l.UserTimeout)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+// +build linux
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+func TestDirectDialer_WithTcpLiveness(t *testing.T) {
+	ctx := context.Background()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		c, err := l.Accept()
+		if err == nil {
+			t.Cleanup(func() { _ = c.Close() })
+		}
+	}()
+
+	d, err := directDialer(ctx, l.Addr().String(), "", nil, nil, WithTcpLiveness(&TcpLiveness{
+		KeepaliveIdle:     20 * time.Second,
+		KeepaliveInterval: 5 * time.Second,
+		KeepaliveCount:    3,
+		UserTimeout:       90 * time.Second,
+	}))
+	require.NoError(t, err)
+	c, err := d.Dial(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Close() })
+
+	raw, err := c.(*net.TCPConn).SyscallConn()
+	require.NoError(t, err)
+	opt := func(level, name int) int {
+		var v int
+		var sockErr error
+		require.NoError(t, raw.Control(func(fd uintptr) {
+			v, sockErr = unix.GetsockoptInt(int(fd), level, name)
+		}))
+		require.NoError(t, sockErr)
+		return v
+	}
+	assert.Equal(t, 1, opt(unix.SOL_SOCKET, unix.SO_KEEPALIVE))
+	assert.Equal(t, 20, opt(unix.IPPROTO_TCP, unix.TCP_KEEPIDLE))
+	assert.Equal(t, 5, opt(unix.IPPROTO_TCP, unix.TCP_KEEPINTVL))
+	assert.Equal(t, 3, opt(unix.IPPROTO_TCP, unix.TCP_KEEPCNT))
+	assert.Equal(t, 90000, opt(unix.IPPROTO_TCP, unix.TCP_USER_TIMEOUT))
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+// +build !linux
+
+package proxy
+
+import (
+	"syscall"
+	"time"
+)
+
+// userTimeoutControl returns nil, as TCP_USER_TIMEOUT is only supported on
+// Linux.
+func userTimeoutControl(time.Duration) func(string, string, syscall.RawConn) error {
+	return nil
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"net"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTcpLiveness_apply(t *testing.T) {
+	var nilLiveness *TcpLiveness
+	d := &net.Dialer{}
+	nilLiveness.apply(d)
+	assert.Equal(t, &net.Dialer{}, d)
+
+	d = &net.Dialer{}
+	(&TcpLiveness{KeepaliveIdle: 30 * time.Second}).apply(d)
+	assert.Equal(t, net.KeepAliveConfig{Enable: true, Idle: 30 * time.Second, Interval: -1, Count: -1}, d.KeepAliveConfig)
+	assert.Nil(t, d.Control)
+
+	d = &net.Dialer{}
+	(&TcpLiveness{KeepaliveInterval: 5 * time.Second, KeepaliveCount: 3, UserTimeout: time.Minute}).apply(d)
+	assert.Equal(t, net.KeepAliveConfig{Enable: true, Idle: -1, Interval: 5 * time.Second, Count: 3}, d.KeepAliveConfig)
+	if runtime.GOOS == "linux" {
+		assert.NotNil(t, d.Control)
+	}
+}
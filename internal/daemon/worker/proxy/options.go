@@ -26,6 +26,9 @@ type Options struct {
 	WithInjectedApplicationCredentials []*serverpb.Credential
 	WithPostConnectionHook             func(net.Conn)
 	WithDnsServerAddress               string
+	WithResolver                       *Resolver
+	WithEgressSource                   *EgressSource
+	WithTcpLiveness                    *TcpLiveness
 }
 
 func getDefaultOptions() Options {
@@ -61,3 +64,28 @@ func WithDnsServerAddress(with string) Option {
 		o.WithDnsServerAddress = with
 	}
 }
+
+// WithResolver provides an optional resolver used to resolve the hostname of
+// endpoints that are dialed directly. It takes precedence over
+// WithDnsServerAddress.
+func WithResolver(r *Resolver) Option {
+	return func(o *Options) {
+		o.WithResolver = r
+	}
+}
+
+// WithEgressSource provides an optional local address that endpoints which
+// are dialed directly are dialed from.
+func WithEgressSource(e *EgressSource) Option {
+	return func(o *Options) {
+		o.WithEgressSource = e
+	}
+}
+
+// WithTcpLiveness provides optional keepalive and timeout settings for
+// connections to endpoints which are dialed directly.
+func WithTcpLiveness(l *TcpLiveness) Option {
+	return func(o *Options) {
+		o.WithTcpLiveness = l
+	}
+}
@@ -45,4 +45,13 @@ func Test_GetOpts(t *testing.T) {
 			runtime.FuncForPC(reflect.ValueOf(testOpts.WithPostConnectionHook).Pointer()).Name(),
 		)
 	})
+	t.Run("WithResolver", func(t *testing.T) {
+		assert := assert.New(t)
+		r := &Resolver{Mode: ResolverCustom, Servers: []string{"10.0.0.53:53"}}
+		opts := GetOpts(WithResolver(r))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithResolver = r
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -9,10 +9,16 @@ import (
 )
 
 // ProxyState contains the current state of proxies in this process.
-var ProxyState proxyState = proxyState{proxyCount: new(atomic.Int64)}
+var ProxyState proxyState = proxyState{
+	proxyCount: new(atomic.Int64),
+	bytesUp:    new(atomic.Uint64),
+	bytesDown:  new(atomic.Uint64),
+}
 
 type proxyState struct {
 	proxyCount *atomic.Int64
+	bytesUp    *atomic.Uint64
+	bytesDown  *atomic.Uint64
 }
 
 // CurrentProxiedConnections returns the current number of ongoing proxied
@@ -21,6 +27,23 @@ func (p *proxyState) CurrentProxiedConnections() int64 {
 	return p.proxyCount.Load()
 }
 
+// AddBytes records bytes proxied from clients to their targets (up) and from
+// targets back to their clients (down).
+func (p *proxyState) AddBytes(up, down uint64) {
+	if up > 0 {
+		p.bytesUp.Add(up)
+	}
+	if down > 0 {
+		p.bytesDown.Add(down)
+	}
+}
+
+// TotalBytes returns the number of bytes proxied up and down since the
+// process started.
+func (p *proxyState) TotalBytes() (up, down uint64) {
+	return p.bytesUp.Load(), p.bytesDown.Load()
+}
+
 // HttpHandlerCounter records how many requests are currently running in the
 // wrapped Handler. This should be used for handlers that serve proxied traffic.
 func ProxyHandlerCounter(h http.Handler) http.Handler {
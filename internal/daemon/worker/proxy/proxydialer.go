@@ -7,12 +7,11 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"net/netip"
 	"net/url"
 	"sync/atomic"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/errors"
-	"github.com/miekg/dns"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -28,57 +27,34 @@ func directDialer(ctx context.Context, endpoint string, _ string, _ proto.Messag
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "endpoint is empty")
 	}
 	opts := GetOpts(opt...)
-	dnsServer := opts.WithDnsServerAddress
-	parsedDnsServer, err := url.Parse(dnsServer)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+	resolver := opts.WithResolver
+	if resolver == nil && opts.WithDnsServerAddress != "" {
+		parsedDnsServer, err := url.Parse(opts.WithDnsServerAddress)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		resolver = &Resolver{
+			Mode:    ResolverCustom,
+			Net:     parsedDnsServer.Scheme,
+			Servers: []string{parsedDnsServer.Host},
+		}
 	}
 
 	d, err := NewProxyDialer(ctx, func(dialerOpt ...Option) (net.Conn, error) {
-		if dnsServer != "" {
-			host, port, err := net.SplitHostPort(endpoint)
-			if err != nil {
-				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error splitting host/port"))
-			}
-			_, err = netip.ParseAddr(host)
-			// Only try resolving if it's not an IP address (err is not nil) or
-			// not something we definitely can't resolve via an upstream DNS
-			// server (localhost)
-			if err != nil && host != "localhost" && host != "localhost.localdomain" {
-				dnsClient := new(dns.Client)
-				dnsClient.Net = parsedDnsServer.Scheme
-				m := new(dns.Msg)
-				m.Id = dns.Id()
-				m.RecursionDesired = true
-				m.Question = make([]dns.Question, 1)
-				m.Question[0] = dns.Question{
-					Name:   fmt.Sprintf("%s.", host),
-					Qtype:  dns.TypeA,
-					Qclass: dns.ClassINET,
-				}
-				resp, _, err := dnsClient.Exchange(m, parsedDnsServer.Host)
-				if err != nil {
-					return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error performing dns exchange"))
-				}
-				if len(resp.Answer) == 0 {
-					return nil, errors.New(ctx, errors.Internal, op, "no answer returned from dns")
-				}
-				var found bool
-				for _, ans := range resp.Answer {
-					ans, ok := ans.(*dns.A)
-					if !ok {
-						continue
-					}
-					endpoint = net.JoinHostPort(ans.A.String(), port)
-					found = true
-					break
-				}
-				if !found {
-					return nil, errors.New(ctx, errors.Internal, op, "no A records in dns answer")
-				}
-			}
+		endpoint, err := resolver.resolveEndpoint(ctx, endpoint)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
 		}
-		remoteConn, err := net.Dial("tcp", endpoint)
+		localAddr, err := opts.WithEgressSource.localAddr(ctx, endpoint)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		dialer := &net.Dialer{}
+		if localAddr != nil {
+			dialer.LocalAddr = localAddr
+		}
+		opts.WithTcpLiveness.apply(dialer)
+		remoteConn, err := dialer.Dial("tcp", endpoint)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -115,6 +91,9 @@ func (p *proxyAddr) Port() uint32 {
 type ProxyDialer struct {
 	dialFn     func(...Option) (net.Conn, error)
 	latestAddr atomic.Pointer[proxyAddr]
+	// latestDialDuration is how long the last Dial() call took, in
+	// nanoseconds, whether or not it succeeded.
+	latestDialDuration atomic.Int64
 }
 
 // Returns a new proxy dialer using the provided function to get the net.Conn.
@@ -135,6 +114,13 @@ func (d *ProxyDialer) LastConnectionAddr() *proxyAddr {
 	return d.latestAddr.Load()
 }
 
+// LastDialDuration returns how long the last Dial() call took to return,
+// whether or not it succeeded. Zero is returned if Dial() has never been
+// called.
+func (d *ProxyDialer) LastDialDuration() time.Duration {
+	return time.Duration(d.latestDialDuration.Load())
+}
+
 // portAndIpGetter allows a dialing function to return a connection that can
 // provide it's ip address and port through the GetIp and GetPort methods
 // instead of providing directly a *net.TCPConn.  This might be helpful if the
@@ -151,7 +137,9 @@ type portAndIpGetter interface {
 // dial function associated with this ProxyDialer.
 func (d *ProxyDialer) Dial(ctx context.Context, opt ...Option) (net.Conn, error) {
 	const op = "proxy.(*ProxyDialer).Dial"
+	start := time.Now()
 	c, err := d.dialFn(opt...)
+	d.latestDialDuration.Store(int64(time.Since(start)))
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -38,14 +39,17 @@ func TestProxyDialer(t *testing.T) {
 	t.Run("Dial error", func(t *testing.T) {
 		expectedErr := errors.New("test error")
 		d, err := NewProxyDialer(ctx, func(...Option) (net.Conn, error) {
+			time.Sleep(time.Millisecond)
 			return nil, expectedErr
 		})
 		require.NoError(t, err)
 		assert.Nil(t, d.LastConnectionAddr())
+		assert.Zero(t, d.LastDialDuration())
 		badC, err := d.Dial(ctx)
 		require.Error(t, err)
 		require.Nil(t, badC)
 		assert.Nil(t, d.LastConnectionAddr())
+		assert.GreaterOrEqual(t, d.LastDialDuration(), time.Millisecond)
 	})
 
 	t.Run("Successful Dial", func(t *testing.T) {
@@ -61,5 +65,6 @@ func TestProxyDialer(t *testing.T) {
 		tcpAddr := l.Addr().(*net.TCPAddr)
 		assert.Equal(t, tcpAddr.IP.String(), d.LastConnectionAddr().Ip())
 		assert.EqualValues(t, tcpAddr.Port, d.LastConnectionAddr().Port())
+		assert.Greater(t, d.LastDialDuration(), time.Duration(0))
 	})
 }
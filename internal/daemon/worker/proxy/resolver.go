@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/miekg/dns"
+)
+
+// Supported values of Resolver.Mode.
+const (
+	// ResolverSystem leaves resolution to the resolver of the host OS.
+	ResolverSystem = "system"
+	// ResolverCustom queries the resolver's servers over plain DNS.
+	ResolverCustom = "custom"
+	// ResolverDoT queries the resolver's servers over TLS.
+	ResolverDoT = "dot"
+)
+
+// DefaultResolverTimeout is the timeout of each DNS query made by a Resolver
+// which does not set one.
+const DefaultResolverTimeout = 5 * time.Second
+
+// Resolver specifies how the hostname of an endpoint is resolved before it is
+// dialed directly.
+type Resolver struct {
+	// Mode is one of ResolverSystem, ResolverCustom or ResolverDoT.
+	Mode string
+	// Servers are the "host:port" addresses of the DNS servers queried, in
+	// order, until one of them returns an address.
+	Servers []string
+	// Net is the network used to query custom servers: "udp" (the default)
+	// or "tcp". It is ignored for DoT.
+	Net string
+	// TlsServerName is the name used to verify the certificate of DoT
+	// servers. Defaults to the host of each server.
+	TlsServerName string
+	// Timeout is the timeout of each query. Defaults to
+	// DefaultResolverTimeout.
+	Timeout time.Duration
+}
+
+// resolveEndpoint returns endpoint, a "host:port" address, with its host
+// resolved to an IP address using the resolver. Endpoints whose host is
+// already an IP address, or is localhost, are returned as is, as are all
+// endpoints when using the system resolver, which is left to resolve them
+// when dialing.
+func (r *Resolver) resolveEndpoint(ctx context.Context, endpoint string) (string, error) {
+	const op = "proxy.(Resolver).resolveEndpoint"
+	if r == nil || r.Mode == "" || r.Mode == ResolverSystem {
+		return endpoint, nil
+	}
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("error splitting host/port"))
+	}
+	// Only try resolving if it's not an IP address (err is not nil) or not
+	// something we definitely can't resolve via an upstream DNS server
+	// (localhost)
+	if _, err := netip.ParseAddr(host); err == nil || host == "localhost" || host == "localhost.localdomain" {
+		return endpoint, nil
+	}
+	if len(r.Servers) == 0 {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "no dns servers configured")
+	}
+
+	client, err := r.client(ctx)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	var lastErr error
+	for _, server := range r.Servers {
+		var ip string
+		ip, lastErr = r.lookup(ctx, client, server, host)
+		if lastErr == nil {
+			return net.JoinHostPort(ip, port), nil
+		}
+	}
+	return "", errors.Wrap(ctx, lastErr, op, errors.WithMsg(fmt.Sprintf("unable to resolve %q", host)))
+}
+
+func (r *Resolver) client(ctx context.Context) (*dns.Client, error) {
+	const op = "proxy.(Resolver).client"
+	c := &dns.Client{Timeout: r.Timeout}
+	if c.Timeout == 0 {
+		c.Timeout = DefaultResolverTimeout
+	}
+	switch r.Mode {
+	case ResolverCustom:
+		switch r.Net {
+		case "", "udp":
+			c.Net = "udp"
+		case "tcp":
+			c.Net = "tcp"
+		default:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported dns network %q", r.Net))
+		}
+	case ResolverDoT:
+		c.Net = "tcp-tls"
+		c.TLSConfig = &tls.Config{
+			ServerName: r.TlsServerName,
+			MinVersion: tls.VersionTLS12,
+		}
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown resolver mode %q", r.Mode))
+	}
+	return c, nil
+}
+
+// lookup queries server for the A records of host, falling back to its AAAA
+// records, and returns the first address found.
+func (r *Resolver) lookup(ctx context.Context, client *dns.Client, server, host string) (string, error) {
+	const op = "proxy.(Resolver).lookup"
+	if r.Mode == ResolverDoT && r.TlsServerName == "" {
+		// Verify each server against its own host when no name is set. The
+		// client is not shared across goroutines, so it's safe to update.
+		serverHost, _, err := net.SplitHostPort(server)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op, errors.WithMsg("error splitting dns server host/port"))
+		}
+		client.TLSConfig.ServerName = serverHost
+	}
+	for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		m := new(dns.Msg)
+		m.SetQuestion(dns.Fqdn(host), qtype)
+		m.RecursionDesired = true
+		resp, _, err := client.ExchangeContext(ctx, m, server)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op, errors.WithMsg("error performing dns exchange"))
+		}
+		for _, ans := range resp.Answer {
+			switch ans := ans.(type) {
+			case *dns.A:
+				return ans.A.String(), nil
+			case *dns.AAAA:
+				return ans.AAAA.String(), nil
+			}
+		}
+	}
+	return "", errors.New(ctx, errors.Internal, op, "no A or AAAA records in dns answer")
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDnsServer starts a DNS server on a local UDP port which answers A
+// queries from records, and returns its address.
+func testDnsServer(t *testing.T, records map[string]string) string {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &dns.Server{
+		PacketConn: pc,
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			m := new(dns.Msg)
+			m.SetReply(r)
+			q := r.Question[0]
+			if ip, ok := records[q.Name]; ok && q.Qtype == dns.TypeA {
+				m.Answer = append(m.Answer, &dns.A{
+					Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+					A:   net.ParseIP(ip),
+				})
+			}
+			_ = w.WriteMsg(m)
+		}),
+	}
+	started := make(chan struct{})
+	srv.NotifyStartedFunc = func() { close(started) }
+	go func() { _ = srv.ActivateAndServe() }()
+	<-started
+	t.Cleanup(func() { _ = srv.Shutdown() })
+	return pc.LocalAddr().String()
+}
+
+func TestResolver_resolveEndpoint(t *testing.T) {
+	ctx := context.Background()
+	server := testDnsServer(t, map[string]string{
+		"db.internal.": "10.1.2.3",
+	})
+	unreachable := func() string {
+		pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := pc.LocalAddr().String()
+		require.NoError(t, pc.Close())
+		return addr
+	}()
+
+	tests := []struct {
+		name     string
+		resolver *Resolver
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "nil",
+			endpoint: "db.internal:5432",
+			want:     "db.internal:5432",
+		},
+		{
+			name:     "system",
+			resolver: &Resolver{Mode: ResolverSystem},
+			endpoint: "db.internal:5432",
+			want:     "db.internal:5432",
+		},
+		{
+			name:     "custom",
+			resolver: &Resolver{Mode: ResolverCustom, Servers: []string{server}},
+			endpoint: "db.internal:5432",
+			want:     "10.1.2.3:5432",
+		},
+		{
+			name:     "custom-falls-back-to-next-server",
+			resolver: &Resolver{Mode: ResolverCustom, Servers: []string{unreachable, server}, Timeout: 500 * time.Millisecond},
+			endpoint: "db.internal:5432",
+			want:     "10.1.2.3:5432",
+		},
+		{
+			name:     "ip-address",
+			resolver: &Resolver{Mode: ResolverCustom, Servers: []string{server}},
+			endpoint: "10.9.9.9:22",
+			want:     "10.9.9.9:22",
+		},
+		{
+			name:     "localhost",
+			resolver: &Resolver{Mode: ResolverCustom, Servers: []string{server}},
+			endpoint: "localhost:22",
+			want:     "localhost:22",
+		},
+		{
+			name:     "not-found",
+			resolver: &Resolver{Mode: ResolverCustom, Servers: []string{server}},
+			endpoint: "missing.internal:5432",
+			wantErr:  true,
+		},
+		{
+			name:     "no-servers",
+			resolver: &Resolver{Mode: ResolverCustom},
+			endpoint: "db.internal:5432",
+			wantErr:  true,
+		},
+		{
+			name:     "unknown-network",
+			resolver: &Resolver{Mode: ResolverCustom, Servers: []string{server}, Net: "sctp"},
+			endpoint: "db.internal:5432",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.resolver.resolveEndpoint(ctx, tt.endpoint)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
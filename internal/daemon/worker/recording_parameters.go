@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"regexp"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+)
+
+const (
+	// defaultRecordingSamplePercent is the percentage of sessions recorded
+	// for targets without recording parameters.
+	defaultRecordingSamplePercent = 100
+
+	// redactedData replaces the data matched by the redaction rules of a
+	// target in its recordings.
+	redactedData = "[REDACTED]"
+)
+
+// RecordingParameters tune how the sessions of a target are recorded. They are
+// pushed by the controller in the responses to the status requests of the
+// worker, and take effect for the sessions recorded from then on.
+type RecordingParameters struct {
+	// Compression is the compression of the recordings.
+	Compression bsr.Compression
+	// CompressionLevel is the level of the compression. 0 uses the default
+	// level of the compression.
+	CompressionLevel int
+	// RedactionRules match the data redacted from the recordings.
+	RedactionRules []*regexp.Regexp
+	// SamplePercent is the percentage of the sessions recorded by the storage
+	// policy that are recorded.
+	SamplePercent uint32
+}
+
+// defaultRecordingParameters are the recording parameters of targets that
+// have none.
+var defaultRecordingParameters = &RecordingParameters{
+	Compression:   bsr.GzipCompression,
+	SamplePercent: defaultRecordingSamplePercent,
+}
+
+// Redact returns b with the data matched by the redaction rules replaced. b
+// is returned as is if nothing matched.
+func (p *RecordingParameters) Redact(b []byte) []byte {
+	for _, re := range p.RedactionRules {
+		b = re.ReplaceAllLiteral(b, []byte(redactedData))
+	}
+	return b
+}
+
+// Sampled reports whether the session is recorded according to the sample
+// percent. The decision is based on a hash of the session id, so it is the
+// same for all connections of the session, on all workers.
+func (p *RecordingParameters) Sampled(sessionId string) bool {
+	if p.SamplePercent >= 100 {
+		return true
+	}
+	sum := sha256.Sum256([]byte(sessionId))
+	return binary.BigEndian.Uint64(sum[:8])%100 < uint64(p.SamplePercent)
+}
+
+// recordingParametersSet is a version of the recording parameters of all
+// targets, keyed by target id.
+type recordingParametersSet struct {
+	version uint64
+	targets map[string]*RecordingParameters
+}
+
+// RecordingParameters returns the recording parameters of the target, or the
+// default parameters if the target has none.
+func (w *Worker) RecordingParameters(targetId string) *RecordingParameters {
+	if set := w.recordingParameters.Load(); set != nil {
+		if p, ok := set.targets[targetId]; ok {
+			return p
+		}
+	}
+	return defaultRecordingParameters
+}
+
+// recordingParametersVersion returns the version of the recording parameters
+// the worker applied, which is sent in status requests to acknowledge them,
+// or 0 if the worker has not received any.
+func (w *Worker) recordingParametersVersion() uint64 {
+	if set := w.recordingParameters.Load(); set != nil {
+		return set.version
+	}
+	return 0
+}
+
+// applyRecordingParameters replaces the recording parameters of the worker
+// with the parameters sent by the controller. Parameters the worker can't
+// apply, which the controller should have rejected, are reported and replaced
+// with the default parameters, so the version is still acknowledged and not
+// sent again.
+func (w *Worker) applyRecordingParameters(ctx context.Context, in *pbs.RecordingParameters) {
+	const op = "worker.(Worker).applyRecordingParameters"
+	if in == nil || in.GetVersion() == w.recordingParametersVersion() {
+		return
+	}
+	set := &recordingParametersSet{
+		version: in.GetVersion(),
+		targets: make(map[string]*RecordingParameters, len(in.GetTargets())),
+	}
+	for _, t := range in.GetTargets() {
+		p, err := toRecordingParameters(ctx, t)
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to apply recording parameters of target", "target_id", t.GetTargetId()))
+			continue
+		}
+		set.targets[t.GetTargetId()] = p
+	}
+	w.recordingParameters.Store(set)
+	event.WriteSysEvent(ctx, op, "applied recording parameters", "version", set.version, "targets", len(set.targets))
+}
+
+func toRecordingParameters(ctx context.Context, in *pbs.TargetRecordingParameters) (*RecordingParameters, error) {
+	const op = "worker.toRecordingParameters"
+	c, err := bsr.ParseCompression(in.GetCompression())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	p := &RecordingParameters{
+		Compression:      c,
+		CompressionLevel: int(in.GetCompressionLevel()),
+		SamplePercent:    in.GetSamplePercent(),
+	}
+	if p.SamplePercent == 0 {
+		p.SamplePercent = defaultRecordingSamplePercent
+	}
+	for _, r := range in.GetRedactionRules() {
+		re, err := regexp.Compile(r)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("invalid redaction rule"))
+		}
+		p.RedactionRules = append(p.RedactionRules, re)
+	}
+	return p, nil
+}
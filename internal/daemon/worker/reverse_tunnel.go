@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/cluster"
+	"github.com/hashicorp/boundary/internal/event"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+)
+
+// reverseTunnelRetryInterval is how long the worker waits before registering
+// its reverse tunnels again after the registration failed or ended.
+const reverseTunnelRetryInterval = 5 * time.Second
+
+// tunnelListener is a net.Listener which accepts the connections of its base
+// listener as well as the session connections carried by reverse tunnels, so
+// the proxy server handles both alike.
+type tunnelListener struct {
+	net.Listener
+
+	conns     chan net.Conn
+	acceptErr chan error
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func newTunnelListener(base net.Listener) *tunnelListener {
+	l := &tunnelListener{
+		Listener:  base,
+		conns:     make(chan net.Conn),
+		acceptErr: make(chan error, 1),
+		closed:    make(chan struct{}),
+	}
+	go func() {
+		for {
+			c, err := base.Accept()
+			if err != nil {
+				l.acceptErr <- err
+				return
+			}
+			select {
+			case l.conns <- c:
+			case <-l.closed:
+				c.Close()
+				return
+			}
+		}
+	}()
+	return l
+}
+
+// Accept implements net.Listener.
+func (l *tunnelListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case err := <-l.acceptErr:
+		// Keep returning the error to later calls
+		l.acceptErr <- err
+		return nil, err
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close implements net.Listener.
+func (l *tunnelListener) Close() error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	return l.Listener.Close()
+}
+
+// inject passes a connection carried by a reverse tunnel to Accept.
+func (l *tunnelListener) inject(ctx context.Context, c net.Conn) error {
+	select {
+	case l.conns <- c:
+		return nil
+	case <-l.closed:
+		return net.ErrClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// startReverseTunnels registers the reverse tunnels of the worker with its
+// controller and opens a tunnel for each session connection the controller
+// asks for, until the context is done.
+func (w *Worker) startReverseTunnels(ctx context.Context) {
+	const op = "worker.(Worker).startReverseTunnels"
+	targetIds := w.conf.RawConfig.Worker.ReverseTunnelTargetIds
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		if err := w.registerReverseTunnels(ctx, targetIds); err != nil && ctx.Err() == nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error registering reverse tunnels, retrying", "target_ids", targetIds))
+		}
+		timer.Reset(reverseTunnelRetryInterval)
+	}
+}
+
+// registerReverseTunnels registers the reverse tunnels and serves the
+// requests of the controller to open tunnels until the registration ends.
+func (w *Worker) registerReverseTunnels(ctx context.Context, targetIds []string) error {
+	const op = "worker.(Worker).registerReverseTunnels"
+	// The worker id is only known once the controller answered a status
+	lastStatus := w.LastStatusSuccess()
+	if lastStatus == nil || lastStatus.GetWorkerId() == "" {
+		return fmt.Errorf("%s: worker id is not known yet", op)
+	}
+	client := pbs.NewReverseTunnelServiceClient(w.GrpcClientConn.Load())
+	stream, err := client.RegisterTunnels(ctx, &pbs.RegisterTunnelsRequest{
+		WorkerId:  lastStatus.GetWorkerId(),
+		TargetIds: targetIds,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		go func() {
+			if err := w.openReverseTunnel(ctx, client, resp); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error opening reverse tunnel", "connection_id", resp.GetConnectionId()))
+			}
+		}()
+	}
+}
+
+// openReverseTunnel opens the tunnel for a session connection and passes it
+// to the proxy listener.
+func (w *Worker) openReverseTunnel(ctx context.Context, client pbs.ReverseTunnelServiceClient, resp *pbs.RegisterTunnelsResponse) error {
+	const op = "worker.(Worker).openReverseTunnel"
+	tunnelCtx, cancel := context.WithCancel(ctx)
+	stream, err := client.Tunnel(tunnelCtx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := stream.Send(&pbs.TunnelRequest{ConnectionId: resp.GetConnectionId()}); err != nil {
+		cancel()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// The proxy handler records the client address of session connections,
+	// so report the address of the client connecting to the controller
+	remote, err := net.ResolveTCPAddr("tcp", resp.GetClientAddress())
+	if err != nil {
+		remote = &net.TCPAddr{}
+	}
+	conn := cluster.NewTunnelConn(
+		func(b []byte) error { return stream.Send(&pbs.TunnelRequest{Data: b}) },
+		cluster.TunnelRecvData(stream.Recv),
+		func() error {
+			cancel()
+			return nil
+		},
+		cluster.TunnelAddr(resp.GetConnectionId()),
+		remote,
+	)
+	if err := w.reverseTunnelListener.inject(ctx, conn); err != nil {
+		conn.Close()
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTunnelListener(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	base, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	l := newTunnelListener(base)
+
+	// Connections of the base listener are accepted
+	dialed, err := net.Dial("tcp", base.Addr().String())
+	require.NoError(err)
+	defer dialed.Close()
+	accepted, err := l.Accept()
+	require.NoError(err)
+	assert.Equal(dialed.LocalAddr().String(), accepted.RemoteAddr().String())
+	accepted.Close()
+
+	// Injected connections are accepted too
+	injected, other := net.Pipe()
+	defer other.Close()
+	go func() {
+		assert.NoError(l.inject(context.Background(), injected))
+	}()
+	accepted, err = l.Accept()
+	require.NoError(err)
+	assert.Equal(injected, accepted)
+
+	require.NoError(l.Close())
+	_, err = l.Accept()
+	assert.ErrorIs(err, net.ErrClosed)
+	assert.ErrorIs(l.inject(context.Background(), injected), net.ErrClosed)
+}
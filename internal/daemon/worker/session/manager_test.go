@@ -205,6 +205,24 @@ func TestManager_LoadLocalSession(t *testing.T) {
 			},
 			wantError: "session is expired",
 		},
+		{
+			name:       "expired session on skewed worker clock",
+			inId:       "id",
+			inWorkerId: "worker id",
+			controllerResponse: func() (*pbs.LookupSessionResponse, error) {
+				return &pbs.LookupSessionResponse{
+					Authorization: &targets.SessionAuthorizationData{
+						SessionId:   "foo",
+						Certificate: createTestCert(t),
+					},
+					Version:        1,
+					Expiration:     timestamppb.New(time.Now().Add(-time.Minute)),
+					ControllerTime: timestamppb.New(time.Now().Add(-time.Hour)),
+					Status:         pbs.SESSIONSTATUS_SESSIONSTATUS_PENDING,
+				}, nil
+			},
+			wantError: "worker clock is 1h0m0s ahead of the controller clock",
+		},
 	}
 	for _, tc := range errorCases {
 		t.Run(tc.name, func(t *testing.T) {
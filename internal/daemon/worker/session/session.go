@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/event"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 )
 
@@ -69,6 +70,24 @@ type Session interface {
 	GetTofuToken() string
 	GetConnectionLimit() int32
 	GetEndpoint() string
+	// GetAllowedPorts returns the ports and port ranges the client may choose
+	// from when connecting, or an empty string if only the port of the
+	// endpoint is allowed.
+	GetAllowedPorts() string
+	// GetTlsPassthrough returns whether the TLS connections of the client are
+	// passed through to the endpoint without being terminated by the worker.
+	GetTlsPassthrough() bool
+	// GetTlsServerNames returns the server name patterns used to route TLS
+	// passthrough connections by the SNI of the client, or an empty string if
+	// they are passed to the endpoint.
+	GetTlsServerNames() string
+	// GetType returns the type of the session, such as "tcp" or "ssh".
+	GetType() string
+	GetUserId() string
+	GetTargetId() string
+	GetScopeId() string
+	GetHostId() string
+	GetHostSetId() string
 	GetHostKeys() ([]crypto.Signer, error)
 	GetCredentials() []*pbs.Credential
 	GetExpiration() time.Time
@@ -137,6 +156,12 @@ func newSess(client pbs.SessionServiceClient, resp *pbs.LookupSessionResponse) (
 	case isNil(client):
 		return nil, errors.New("SessionServiceClient is nil")
 	case resp.GetExpiration().AsTime().Before(time.Now()):
+		// If the controller still considers the session valid, it is the
+		// worker's clock that is off rather than the session that expired.
+		if ct := resp.GetControllerTime(); ct != nil && ct.AsTime().Before(resp.GetExpiration().AsTime()) {
+			return nil, fmt.Errorf("session is expired according to the worker's clock but not the controller's: %w",
+				&server.ClockSkewError{Skew: time.Since(ct.AsTime())})
+		}
 		return nil, fmt.Errorf("session is expired")
 	}
 
@@ -237,6 +262,60 @@ func (s *sess) GetEndpoint() string {
 	return s.resp.GetEndpoint()
 }
 
+func (s *sess) GetAllowedPorts() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetAllowedPorts()
+}
+
+func (s *sess) GetTlsPassthrough() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetTlsPassthrough()
+}
+
+func (s *sess) GetTlsServerNames() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetTlsServerNames()
+}
+
+func (s *sess) GetType() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetAuthorization().GetType()
+}
+
+func (s *sess) GetUserId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetUserId()
+}
+
+func (s *sess) GetTargetId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetTargetId()
+}
+
+func (s *sess) GetScopeId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetAuthorization().GetScope().GetId()
+}
+
+func (s *sess) GetHostId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetHostId()
+}
+
+func (s *sess) GetHostSetId() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetHostSetId()
+}
+
 func (s *sess) GetHostKeys() ([]crypto.Signer, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -11,16 +11,19 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/internal/daemon/worker/common"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
 	"github.com/hashicorp/boundary/internal/daemon/worker/session"
 	"github.com/hashicorp/boundary/internal/event"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/servers"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/storage"
 	"github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/hashicorp/boundary/version"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var firstStatusCheckPostHooks []func(context.Context, *Worker) error
@@ -33,6 +36,9 @@ type LastStatusInformation struct {
 	*pbs.StatusResponse
 	StatusTime              time.Time
 	LastCalculatedUpstreams []string
+	// ClockSkew is how far the worker's clock was estimated to be ahead of the
+	// controller's when the status was received; negative if it is behind.
+	ClockSkew time.Duration
 }
 
 func (w *Worker) startStatusTicking(cancelCtx context.Context, sessionManager session.Manager, addrReceivers *[]addressReceiver, recorderManager recorderManager) {
@@ -83,6 +89,24 @@ func (w *Worker) LastStatusSuccess() *LastStatusInformation {
 	return s
 }
 
+// reportClockSkew emits an event when the estimated skew between the worker's
+// clock and the controller's starts or stops exceeding the allowed drift.
+// Events are only emitted on changes to avoid one on every status update.
+func (w *Worker) reportClockSkew(ctx context.Context, skew time.Duration) {
+	const op = "worker.(Worker).reportClockSkew"
+	var wasExceeded bool
+	if last := w.LastStatusSuccess(); last != nil {
+		wasExceeded = server.ClockSkewExceeded(last.ClockSkew)
+	}
+	switch exceeded := server.ClockSkewExceeded(skew); {
+	case exceeded && !wasExceeded:
+		event.WriteError(ctx, op, &server.ClockSkewError{Skew: skew},
+			event.WithInfoMsg("worker clock skew exceeds allowed drift; session certificate validation may fail", "skew", skew.String()))
+	case !exceeded && wasExceeded:
+		event.WriteSysEvent(ctx, op, "worker clock skew is back within allowed drift", "skew", skew.String())
+	}
+}
+
 // WaitForNextSuccessfulStatusUpdate waits for the next successful status. It's
 // used by testing (and in the future, shutdown) in place of a more opaque and
 // possibly unnecessarily long sleep for things like initial controller
@@ -151,9 +175,13 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 	}
 
 	// Range over known sessions and collect info
+	var activeSessions uint32
 	sessionManager.ForEachLocalSession(func(s session.Session) bool {
 		var jobInfo pbs.SessionJobInfo
 		status := s.GetStatus()
+		if status == pbs.SESSIONSTATUS_SESSIONSTATUS_ACTIVE {
+			activeSessions++
+		}
 		sessionId := s.GetId()
 		localConnections := s.GetLocalConnections()
 		connections := make([]*pbs.Connection, 0, len(localConnections))
@@ -210,26 +238,45 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 			w.localStorageState.Store(w.RecordingStorage.GetLocalStorageState(cancelCtx))
 		}
 	}
+	var spoolBacklog uint64
+	if sr, ok := w.RecordingStorage.(storage.SpoolReporter); ok {
+		spoolBacklog = sr.SpoolBacklogBytes(cancelCtx)
+	}
+	utilization := w.utilization.sample(time.Now(), activeSessions, uint32(max(proxy.ProxyState.CurrentProxiedConnections(), 0)), spoolBacklog)
 	versionInfo := version.Get()
 	connectionState := w.downstreamConnManager.Connected()
+	// Diagnostics and connectivity test results that fail to be sent are
+	// dropped; the requests they answer will have timed out by the time they
+	// could be sent again.
+	diagnostics := w.diagnostics.take()
+	connectionTestResults := w.connectionTests.take()
+	sentTime := time.Now()
 	result, err := client.Status(statusCtx, &pbs.StatusRequest{
 		Jobs: activeJobs,
 		WorkerStatus: &pb.ServerWorkerStatus{
 			Name:                          w.conf.RawConfig.Worker.Name,
 			Description:                   w.conf.RawConfig.Worker.Description,
 			Address:                       w.conf.RawConfig.Worker.PublicAddr,
+			Locality:                      w.conf.RawConfig.Worker.Locality,
+			AdditionalAddresses:           w.conf.RawConfig.Worker.AdditionalPublicAddrs,
 			Tags:                          tags,
 			KeyId:                         keyId,
 			ReleaseVersion:                versionInfo.FullVersionNumber(false),
 			OperationalState:              w.operationalState.Load().(server.OperationalState).String(),
 			LocalStorageState:             w.localStorageState.Load().(server.LocalStorageState).String(),
 			StorageBucketCredentialStates: storageBucketCredentialStates,
+			Utilization:                   utilization,
 		},
 		ConnectedWorkerKeyIdentifiers:         connectionState.AllKeyIds(),
 		ConnectedUnmappedWorkerKeyIdentifiers: connectionState.UnmappedKeyIds(),
 		ConnectedWorkerPublicIds:              connectionState.WorkerIds(),
 		UpdateTags:                            w.updateTags.Load(),
+		Diagnostics:                           diagnostics,
+		ConnectionTestResults:                 connectionTestResults,
+		WorkerTime:                            timestamppb.New(sentTime),
+		RecordingParametersVersion:            w.recordingParametersVersion(),
 	})
+	receivedTime := time.Now()
 	if err != nil {
 		event.WriteError(cancelCtx, op, err, event.WithInfoMsg("error making status request to controller", "controller_address", clientCon.Target()))
 		// Check for last successful status. Ignore nil last status, this probably
@@ -328,7 +375,26 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 
 	w.updateAddresses(cancelCtx, addrs, addressReceivers)
 
-	w.lastStatusSuccess.Store(&LastStatusInformation{StatusResponse: result, StatusTime: time.Now(), LastCalculatedUpstreams: addrs})
+	var clockSkew time.Duration
+	if ct := result.GetControllerTime(); ct != nil {
+		clockSkew = server.ClockSkew(sentTime, receivedTime, ct.AsTime())
+		w.reportClockSkew(cancelCtx, clockSkew)
+	}
+
+	w.lastStatusSuccess.Store(&LastStatusInformation{StatusResponse: result, StatusTime: time.Now(), LastCalculatedUpstreams: addrs, ClockSkew: clockSkew})
+
+	for _, req := range result.GetDiagnosticsRequests() {
+		w.diagnostics.add(w.gatherDiagnostics(req))
+	}
+	// Connectivity tests can take a while, so they are run in the background
+	// and their results sent in a following status request.
+	for _, req := range result.GetConnectionTestRequests() {
+		go func() {
+			w.connectionTests.add(testTargetConnectivity(w.baseContext, req))
+		}()
+	}
+
+	w.applyRecordingParameters(cancelCtx, result.GetRecordingParameters())
 
 	var nonActiveMonitoredSessionIds []string
 
@@ -500,6 +566,12 @@ func (w *Worker) cleanupConnections(cancelCtx context.Context, ignoreSessionStat
 	// Forget sessions where the session is expired/canceled and all
 	// connections are canceled and marked closed
 	sessionManager.DeleteLocalSession(cleanSessionIds)
+	if len(cleanSessionIds) > 0 {
+		// Accounts are deprovisioned in the background so that a slow
+		// command doesn't hold up the status loop, and even if the worker is
+		// shutting down.
+		go w.accountProvisioner.deprovision(context.WithoutCancel(cancelCtx), cleanSessionIds)
+	}
 }
 
 func (w *Worker) lastSuccessfulStatusTime() time.Time {
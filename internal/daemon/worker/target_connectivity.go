@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+)
+
+// defaultConnectionTestTimeout is used when the controller does not set a
+// timeout for a connectivity test.
+const defaultConnectionTestTimeout = 10 * time.Second
+
+// testTargetConnectivity opens a TCP connection to the requested address and
+// performs the requested check on it. The returned result describes why the
+// test failed rather than returning an error, so it can be sent to the
+// controller as is.
+func testTargetConnectivity(ctx context.Context, req *pbs.WorkerConnectionTestRequest) *pbs.WorkerConnectionTestResult {
+	res := &pbs.WorkerConnectionTestResult{
+		RequestId: req.GetRequestId(),
+	}
+	timeout := time.Duration(req.GetTimeoutMs()) * time.Millisecond
+	if timeout <= 0 {
+		timeout = defaultConnectionTestTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", req.GetAddress())
+	if err != nil {
+		res.Error = fmt.Sprintf("error connecting to %s: %s", req.GetAddress(), err)
+		return res
+	}
+	defer conn.Close()
+	res.LatencyMs = float64(time.Since(start).Microseconds()) / 1000
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	switch req.GetCheck() {
+	case "", "tcp":
+	case "tls":
+		res.Detail, err = checkTls(ctx, conn, req.GetAddress())
+	case "ssh":
+		res.Detail, err = checkSshBanner(conn)
+	default:
+		err = fmt.Errorf("unknown check %q", req.GetCheck())
+	}
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	res.Success = true
+	return res
+}
+
+// checkTls performs a TLS handshake over the connection and describes the
+// negotiated version and the subject of the certificate presented by the
+// target. The certificate is not verified: the check only ensures that a TLS
+// server is listening, as the worker does not know which certificate
+// authorities the clients of the target trust.
+func checkTls(ctx context.Context, conn net.Conn, address string) (string, error) {
+	serverName, _, err := net.SplitHostPort(address)
+	if err != nil {
+		serverName = address
+	}
+	tlsConn := tls.Client(conn, &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true,
+	})
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return "", fmt.Errorf("error performing TLS handshake: %w", err)
+	}
+	state := tlsConn.ConnectionState()
+	detail := tls.VersionName(state.Version)
+	if len(state.PeerCertificates) > 0 {
+		detail += ", certificate subject: " + state.PeerCertificates[0].Subject.String()
+	}
+	return detail, nil
+}
+
+// checkSshBanner reads the identification string an SSH server sends once
+// connected.
+func checkSshBanner(conn net.Conn) (string, error) {
+	r := bufio.NewReader(conn)
+	// Servers may send other lines before the identification string; the
+	// limit is the one OpenSSH applies.
+	for i := 0; i < 1024; i++ {
+		line, err := r.ReadString('\n')
+		line = strings.TrimRight(line, "\r\n")
+		if strings.HasPrefix(line, "SSH-") {
+			return line, nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("error reading SSH banner: %w", err)
+		}
+	}
+	return "", errors.New("no SSH banner received")
+}
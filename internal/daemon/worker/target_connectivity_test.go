@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBannerListener returns the address of a listener which writes the
+// banner to every connection it accepts.
+func testBannerListener(t *testing.T, banner string) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			_, _ = conn.Write([]byte(banner))
+			conn.Close()
+		}
+	}()
+	return l.Addr().String()
+}
+
+func TestTestTargetConnectivity(t *testing.T) {
+	ctx := context.Background()
+
+	tlsServer := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(tlsServer.Close)
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := closed.Addr().String()
+	require.NoError(t, closed.Close())
+
+	sshAddr := testBannerListener(t, "Welcome\r\nSSH-2.0-OpenSSH_9.6\r\n")
+	httpAddr := testBannerListener(t, "HTTP/1.1 400 Bad Request\r\n\r\n")
+
+	tests := []struct {
+		name            string
+		address         string
+		check           string
+		wantDetail      string
+		wantErrContains string
+	}{
+		{
+			name:    "tcp",
+			address: sshAddr,
+			check:   "tcp",
+		},
+		{
+			name:    "default-check",
+			address: sshAddr,
+		},
+		{
+			name:            "tcp-refused",
+			address:         closedAddr,
+			check:           "tcp",
+			wantErrContains: "error connecting to",
+		},
+		{
+			name:       "ssh",
+			address:    sshAddr,
+			check:      "ssh",
+			wantDetail: "SSH-2.0-OpenSSH_9.6",
+		},
+		{
+			name:            "ssh-no-banner",
+			address:         httpAddr,
+			check:           "ssh",
+			wantErrContains: "error reading SSH banner",
+		},
+		{
+			name:       "tls",
+			address:    tlsServer.Listener.Addr().String(),
+			check:      "tls",
+			wantDetail: "TLS 1.3",
+		},
+		{
+			name:            "tls-not-tls",
+			address:         httpAddr,
+			check:           "tls",
+			wantErrContains: "error performing TLS handshake",
+		},
+		{
+			name:            "unknown-check",
+			address:         sshAddr,
+			check:           "udp",
+			wantErrContains: `unknown check "udp"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			res := testTargetConnectivity(ctx, &pbs.WorkerConnectionTestRequest{
+				RequestId: "req_" + tt.name,
+				Address:   tt.address,
+				Check:     tt.check,
+				TimeoutMs: 5000,
+			})
+			assert.Equal("req_"+tt.name, res.GetRequestId())
+			if tt.wantErrContains != "" {
+				assert.False(res.GetSuccess())
+				assert.Contains(res.GetError(), tt.wantErrContains)
+				return
+			}
+			assert.True(res.GetSuccess(), res.GetError())
+			assert.Empty(res.GetError())
+			assert.Contains(res.GetDetail(), tt.wantDetail)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"bytes"
+	"crypto/tls"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/libs/endpoint"
+)
+
+// tlsPassthroughHelloTimeout bounds the time the worker waits for the client
+// to send its TLS ClientHello on a TLS passthrough connection.
+const tlsPassthroughHelloTimeout = 10 * time.Second
+
+// errClientHelloRead aborts the TLS handshake once the ClientHello was read.
+var errClientHelloRead = stderrors.New("client hello read")
+
+// readClientHello reads the TLS ClientHello the client starts its connection
+// with, without answering it, and returns the server name the client asked
+// for. The worker never terminates the TLS connection: the returned
+// connection replays the bytes read, so the endpoint receives the handshake
+// of the client, and with it the client certificate, unchanged. An error is
+// returned if the connection does not start with a TLS ClientHello.
+func readClientHello(conn net.Conn) (net.Conn, string, error) {
+	hc := &helloConn{Conn: conn}
+	var hello *tls.ClientHelloInfo
+	_ = conn.SetReadDeadline(time.Now().Add(tlsPassthroughHelloTimeout))
+	err := tls.Server(hc, &tls.Config{
+		GetConfigForClient: func(h *tls.ClientHelloInfo) (*tls.Config, error) {
+			hello = h
+			return nil, errClientHelloRead
+		},
+	}).Handshake()
+	_ = conn.SetReadDeadline(time.Time{})
+	if hello == nil {
+		return nil, "", fmt.Errorf("error reading tls client hello: %w", err)
+	}
+	return &replayConn{Conn: conn, r: io.MultiReader(&hc.read, conn)}, hello.ServerName, nil
+}
+
+// endpointWithServerName returns the endpoint a TLS passthrough connection is
+// routed to. If the session has no server name patterns the endpoint is
+// returned as is. Otherwise the host of the endpoint is replaced by the server
+// name the client sent, which must match one of the patterns; the port of the
+// endpoint is kept.
+func endpointWithServerName(ep, serverNames, serverName string) (string, error) {
+	if serverNames == "" {
+		return ep, nil
+	}
+	patterns, err := endpoint.ParseServerNames(serverNames)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse tls server names: %w", err)
+	}
+	switch {
+	case serverName == "":
+		return "", stderrors.New("client did not send a server name")
+	case !patterns.Match(serverName):
+		return "", fmt.Errorf("server name %q is not allowed by the target", serverName)
+	}
+	u, err := url.Parse(ep)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse endpoint: %w", err)
+	}
+	u.Host = net.JoinHostPort(strings.ToLower(strings.TrimSuffix(serverName, ".")), u.Port())
+	return u.String(), nil
+}
+
+// helloConn records the bytes read from the connection and discards the
+// bytes written to it, so the ClientHello can be read without answering it.
+type helloConn struct {
+	net.Conn
+	read bytes.Buffer
+}
+
+func (c *helloConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.read.Write(b[:n])
+	return n, err
+}
+
+func (c *helloConn) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+// replayConn reads the bytes recorded while reading the ClientHello before
+// reading from the connection again.
+type replayConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *replayConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
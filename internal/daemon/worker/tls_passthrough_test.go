@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"bytes"
+	"crypto/tls"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadClientHello(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tls", func(t *testing.T) {
+		client, server := net.Pipe()
+		defer server.Close()
+
+		// Record what the client sends so it can be compared to what the
+		// endpoint would receive
+		sent := new(bytes.Buffer)
+		go func() {
+			_ = tls.Client(&recordingConn{Conn: client, w: sent}, &tls.Config{
+				ServerName:         "eu.db.example.com",
+				InsecureSkipVerify: true,
+			}).Handshake()
+		}()
+
+		conn, serverName, err := readClientHello(server)
+		require.NoError(t, err)
+		assert.Equal(t, "eu.db.example.com", serverName)
+
+		// The ClientHello is replayed unchanged
+		got := make([]byte, sent.Len())
+		_, err = io.ReadFull(conn, got)
+		require.NoError(t, err)
+		assert.Equal(t, sent.Bytes(), got)
+		client.Close()
+	})
+
+	t.Run("not tls", func(t *testing.T) {
+		client, server := net.Pipe()
+		defer server.Close()
+		go func() {
+			_, _ = client.Write([]byte("GET / HTTP/1.1\r\nHost: db.example.com\r\n\r\n"))
+			client.Close()
+		}()
+
+		_, _, err := readClientHello(server)
+		require.Error(t, err)
+	})
+}
+
+func TestEndpointWithServerName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		endpoint    string
+		serverNames string
+		serverName  string
+		want        string
+		wantErr     string
+	}{
+		{
+			name:       "no server names",
+			endpoint:   "tcp://10.0.0.1:5432",
+			serverName: "eu.db.example.com",
+			want:       "tcp://10.0.0.1:5432",
+		},
+		{
+			name:        "matching server name",
+			endpoint:    "tcp://10.0.0.1:5432",
+			serverNames: "*.db.example.com",
+			serverName:  "EU.db.example.com.",
+			want:        "tcp://eu.db.example.com:5432",
+		},
+		{
+			name:        "server name not allowed",
+			endpoint:    "tcp://10.0.0.1:5432",
+			serverNames: "*.db.example.com",
+			serverName:  "db.example.org",
+			wantErr:     `server name "db.example.org" is not allowed by the target`,
+		},
+		{
+			name:        "missing server name",
+			endpoint:    "tcp://10.0.0.1:5432",
+			serverNames: "*.db.example.com",
+			wantErr:     "client did not send a server name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := endpointWithServerName(tt.endpoint, tt.serverNames, tt.serverName)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// recordingConn records the bytes written to the connection.
+type recordingConn struct {
+	net.Conn
+	w *bytes.Buffer
+}
+
+func (c *recordingConn) Write(b []byte) (int, error) {
+	c.w.Write(b)
+	return c.Conn.Write(b)
+}
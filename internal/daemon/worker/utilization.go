@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/servers"
+)
+
+// utilizationSampler computes the utilization a worker reports in its status.
+// Throughput and CPU utilization are rates, so they are computed from the
+// change in the process totals since the previous sample.
+type utilizationSampler struct {
+	// cpuTime returns the CPU time consumed by the process so far
+	cpuTime func() (time.Duration, error)
+	// totalBytes returns the bytes proxied up and down by the process so far
+	totalBytes func() (uint64, uint64)
+	// numCpu returns the number of CPUs the process can use
+	numCpu func() int
+
+	mu       sync.Mutex
+	lastTime time.Time
+	lastCpu  time.Duration
+	lastUp   uint64
+	lastDown uint64
+}
+
+func newUtilizationSampler() *utilizationSampler {
+	return &utilizationSampler{
+		cpuTime:    processCpuTime,
+		totalBytes: proxy.ProxyState.TotalBytes,
+		numCpu:     func() int { return runtime.GOMAXPROCS(0) },
+	}
+}
+
+// sample returns the worker's utilization at now. The rates in the first
+// sample are zero since there is no previous sample to compare against.
+func (s *utilizationSampler) sample(now time.Time, activeSessions, activeConnections uint32, spoolBacklog uint64) *pb.WorkerUtilization {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := &pb.WorkerUtilization{
+		ActiveSessionCount:    activeSessions,
+		ActiveConnectionCount: activeConnections,
+		SpoolBacklogBytes:     spoolBacklog,
+	}
+
+	up, down := s.totalBytes()
+	cpu, cpuErr := s.cpuTime()
+	if elapsed := now.Sub(s.lastTime); !s.lastTime.IsZero() && elapsed > 0 {
+		seconds := elapsed.Seconds()
+		if up >= s.lastUp {
+			u.BytesUpPerSecond = uint64(float64(up-s.lastUp) / seconds)
+		}
+		if down >= s.lastDown {
+			u.BytesDownPerSecond = uint64(float64(down-s.lastDown) / seconds)
+		}
+		if cpuErr == nil && cpu >= s.lastCpu {
+			u.ProxyCpuUtilization = (cpu - s.lastCpu).Seconds() / seconds / float64(max(s.numCpu(), 1))
+		}
+	}
+
+	s.lastTime, s.lastUp, s.lastDown = now, up, down
+	if cpuErr == nil {
+		s.lastCpu = cpu
+	}
+	return u
+}
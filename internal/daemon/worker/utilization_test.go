@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUtilizationSampler(t *testing.T) {
+	var cpu time.Duration
+	var cpuErr error
+	var up, down uint64
+	s := &utilizationSampler{
+		cpuTime:    func() (time.Duration, error) { return cpu, cpuErr },
+		totalBytes: func() (uint64, uint64) { return up, down },
+		numCpu:     func() int { return 2 },
+	}
+	start := time.Now()
+
+	// The first sample has no previous sample to compute rates from.
+	cpu, up, down = time.Second, 1000, 2000
+	got := s.sample(start, 3, 4, 5)
+	assert.Equal(t, uint32(3), got.GetActiveSessionCount())
+	assert.Equal(t, uint32(4), got.GetActiveConnectionCount())
+	assert.Equal(t, uint64(5), got.GetSpoolBacklogBytes())
+	assert.Zero(t, got.GetBytesUpPerSecond())
+	assert.Zero(t, got.GetBytesDownPerSecond())
+	assert.Zero(t, got.GetProxyCpuUtilization())
+
+	cpu, up, down = 3*time.Second, 5000, 12000
+	got = s.sample(start.Add(2*time.Second), 1, 1, 0)
+	assert.Equal(t, uint64(2000), got.GetBytesUpPerSecond())
+	assert.Equal(t, uint64(5000), got.GetBytesDownPerSecond())
+	assert.InDelta(t, 0.5, got.GetProxyCpuUtilization(), 0.0001)
+
+	// A failure to read the CPU time only affects the CPU utilization.
+	cpuErr = errors.New("unsupported")
+	up = 6000
+	got = s.sample(start.Add(3*time.Second), 1, 1, 0)
+	assert.Equal(t, uint64(1000), got.GetBytesUpPerSecond())
+	assert.Zero(t, got.GetProxyCpuUtilization())
+
+	cpu, cpuErr = 4*time.Second, nil
+	got = s.sample(start.Add(4*time.Second), 1, 1, 0)
+	assert.InDelta(t, 0.5, got.GetProxyCpuUtilization(), 0.0001)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/daemon/cluster"
 	"github.com/hashicorp/boundary/internal/daemon/cluster/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/selfmonitor"
 	"github.com/hashicorp/boundary/internal/daemon/worker/common"
 	"github.com/hashicorp/boundary/internal/daemon/worker/internal/metric"
 	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
@@ -33,13 +34,11 @@ import (
 	wpbs "github.com/hashicorp/boundary/internal/gen/worker/servers/services"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/storage"
-	boundary_plugin_assets "github.com/hashicorp/boundary/plugins/boundary"
 	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	external_plugins "github.com/hashicorp/boundary/sdk/plugins"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"github.com/hashicorp/go-secure-stdlib/mlock"
-	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/hashicorp/nodeenrollment"
 	nodeenet "github.com/hashicorp/nodeenrollment/net"
@@ -153,8 +152,24 @@ type Worker struct {
 
 	everAuthenticated *ua.Uint32
 	lastStatusSuccess *atomic.Value
-	workerStartTime   time.Time
-	operationalState  *atomic.Value
+	// diagnostics holds the logs and metrics requested by the controller
+	// until they are sent in the next status request.
+	diagnostics resultQueue[*pbs.WorkerDiagnostics]
+	// connectionTests holds the results of the target connectivity tests
+	// requested by the controller until they are sent in the next status
+	// request.
+	connectionTests resultQueue[*pbs.WorkerConnectionTestResult]
+	// recordingParameters holds the recording parameters of the targets last
+	// pushed by the controller.
+	recordingParameters atomic.Pointer[recordingParametersSet]
+	// autoUpdater updates the binary of the worker, if auto update is
+	// configured.
+	autoUpdater *autoUpdater
+	// utilization computes the load reported to the controller in each
+	// status request.
+	utilization      *utilizationSampler
+	workerStartTime  time.Time
+	operationalState *atomic.Value
 	// localStorageState is the current state of the local storage.
 	// The local storage state is updated based on the local storage events.
 	localStorageState *atomic.Value
@@ -168,6 +183,10 @@ type Worker struct {
 
 	proxyListener *base.ServerListener
 
+	// Accepts the session connections carried by reverse tunnels, nil if the
+	// worker registers no reverse tunnels
+	reverseTunnelListener *tunnelListener
+
 	// Used to generate a random nonce for Controller connections
 	nonceFn randFn
 
@@ -198,6 +217,30 @@ type Worker struct {
 	statusCallTimeoutDuration           *atomic.Int64
 	getDownstreamWorkersTimeoutDuration *atomic.Int64
 
+	// connectionEventsSamplePercent is the percentage of proxied connections
+	// that start and end events are emitted for; zero disables them. It is an
+	// atomic for SIGHUP support.
+	connectionEventsSamplePercent *atomic.Int32
+
+	// dnsPolicy selects the resolver used for the endpoints of sessions. It
+	// is nil when the worker has no dns configuration, and is an atomic for
+	// SIGHUP support.
+	dnsPolicy *atomic.Pointer[dnsPolicy]
+
+	// egressPolicy selects the local address the endpoints of sessions are
+	// dialed from. It is nil when the worker has no egress configuration,
+	// and is an atomic for SIGHUP support.
+	egressPolicy *atomic.Pointer[egressPolicy]
+
+	// connectionLiveness selects the keepalive, timeout and lifetime settings
+	// of proxied connections. It is nil when the worker has no
+	// connection_liveness configuration, and is an atomic for SIGHUP support.
+	connectionLiveness *atomic.Pointer[connectionLivenessPolicy]
+
+	// accountProvisioner runs the account provisioning command of the
+	// worker's configuration for the sessions it proxies.
+	accountProvisioner *accountProvisioner
+
 	// AuthRotationNextRotation is useful in tests to understand how long to
 	// sleep
 	AuthRotationNextRotation atomic.Pointer[time.Time]
@@ -224,6 +267,7 @@ func New(ctx context.Context, conf *Config) (*Worker, error) {
 	baseContext, baseCancel := context.WithCancel(context.Background())
 	w := &Worker{
 		baseContext:            baseContext,
+		utilization:            newUtilizationSampler(),
 		baseCancel:             baseCancel,
 		conf:                   conf,
 		logger:                 conf.Logger.Named("worker"),
@@ -242,6 +286,11 @@ func New(ctx context.Context, conf *Config) (*Worker, error) {
 		successfulStatusGracePeriod:         new(atomic.Int64),
 		statusCallTimeoutDuration:           new(atomic.Int64),
 		getDownstreamWorkersTimeoutDuration: new(atomic.Int64),
+		connectionEventsSamplePercent:       new(atomic.Int32),
+		dnsPolicy:                           new(atomic.Pointer[dnsPolicy]),
+		egressPolicy:                        new(atomic.Pointer[egressPolicy]),
+		connectionLiveness:                  new(atomic.Pointer[connectionLivenessPolicy]),
+		accountProvisioner:                  newAccountProvisioner(),
 		upstreamConnectionState:             new(atomic.Value),
 		downstreamWorkers:                   new(atomic.Pointer[downstreamersContainer]),
 	}
@@ -276,14 +325,14 @@ func New(ctx context.Context, conf *Config) (*Worker, error) {
 			case enabledPlugin == base.EnabledPluginHostAzure && !w.conf.SkipPlugins,
 				enabledPlugin == base.EnabledPluginAws && !w.conf.SkipPlugins:
 				pluginType := strings.ToLower(enabledPlugin.String())
+				pluginOpts, err := conf.RawConfig.Plugins.PluginOptions(pluginType)
+				if err != nil {
+					return nil, fmt.Errorf("error creating %s host plugin: %w", pluginType, err)
+				}
 				client, cleanup, err := external_plugins.CreateHostPlugin(
 					ctx,
 					pluginType,
-					external_plugins.WithPluginOptions(
-						pluginutil.WithPluginExecutionDirectory(conf.RawConfig.Plugins.ExecutionDir),
-						pluginutil.WithPluginsFilesystem(boundary_plugin_assets.PluginPrefix, boundary_plugin_assets.FileSystem()),
-					),
-					external_plugins.WithLogger(pluginLogger.Named(pluginType)),
+					append(pluginOpts, external_plugins.WithLogger(pluginLogger.Named(pluginType)))...,
 				)
 				if err != nil {
 					return nil, fmt.Errorf("error creating %s host plugin: %w", pluginType, err)
@@ -311,14 +360,14 @@ func New(ctx context.Context, conf *Config) (*Worker, error) {
 				fallthrough
 			case enabledPlugin == base.EnabledPluginAws && !w.conf.SkipPlugins:
 				pluginType := strings.ToLower(enabledPlugin.String())
+				pluginOpts, err := conf.RawConfig.Plugins.PluginOptions(pluginType)
+				if err != nil {
+					return nil, fmt.Errorf("error creating %s storage plugin: %w", pluginType, err)
+				}
 				client, cleanup, err := external_plugins.CreateStoragePlugin(
 					ctx,
 					pluginType,
-					external_plugins.WithPluginOptions(
-						pluginutil.WithPluginExecutionDirectory(conf.RawConfig.Plugins.ExecutionDir),
-						pluginutil.WithPluginsFilesystem(boundary_plugin_assets.PluginPrefix, boundary_plugin_assets.FileSystem()),
-					),
-					external_plugins.WithLogger(pluginLogger.Named(pluginType)),
+					append(pluginOpts, external_plugins.WithLogger(pluginLogger.Named(pluginType)))...,
 				)
 				if err != nil {
 					return nil, fmt.Errorf("error creating %s storage plugin: %w", pluginType, err)
@@ -382,6 +431,22 @@ func New(ctx context.Context, conf *Config) (*Worker, error) {
 	default:
 		w.getDownstreamWorkersTimeoutDuration.Store(int64(conf.RawConfig.Worker.GetDownstreamWorkersTimeoutDuration))
 	}
+	w.connectionEventsSamplePercent.Store(connectionEventsSamplePercent(conf.RawConfig.Worker.ConnectionEvents))
+	w.dnsPolicy.Store(newDnsPolicy(conf.RawConfig.Worker.Dns))
+	egress, err := newEgressPolicy(ctx, conf.RawConfig.Worker.Egress)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	w.egressPolicy.Store(egress)
+	w.connectionLiveness.Store(newConnectionLivenessPolicy(conf.RawConfig.Worker.ConnectionLiveness))
+	accountHook, err := newAccountHook(ctx, conf.RawConfig.Worker.AccountProvisioning)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	w.accountProvisioner.hook.Store(accountHook)
+	if w.autoUpdater, err = newAutoUpdater(conf.RawConfig.Worker.AutoUpdate); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error configuring auto update"))
+	}
 	// FIXME: This is really ugly, but works.
 	session.CloseCallTimeout.Store(w.successfulStatusGracePeriod.Load())
 
@@ -479,6 +544,21 @@ func (w *Worker) Reload(ctx context.Context, newConf *config.Config) {
 	default:
 		w.getDownstreamWorkersTimeoutDuration.Store(int64(newConf.Worker.GetDownstreamWorkersTimeoutDuration))
 	}
+	w.connectionEventsSamplePercent.Store(connectionEventsSamplePercent(newConf.Worker.ConnectionEvents))
+	w.dnsPolicy.Store(newDnsPolicy(newConf.Worker.Dns))
+	w.connectionLiveness.Store(newConnectionLivenessPolicy(newConf.Worker.ConnectionLiveness))
+	switch egress, err := newEgressPolicy(ctx, newConf.Worker.Egress); {
+	case err != nil:
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error reloading egress configuration, keeping the current one"))
+	default:
+		w.egressPolicy.Store(egress)
+	}
+	switch accountHook, err := newAccountHook(ctx, newConf.Worker.AccountProvisioning); {
+	case err != nil:
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error reloading account provisioning configuration, keeping the current one"))
+	default:
+		w.accountProvisioner.hook.Store(accountHook)
+	}
 	// See comment about this in worker.go
 	session.CloseCallTimeout.Store(w.successfulStatusGracePeriod.Load())
 }
@@ -665,6 +745,38 @@ func (w *Worker) Start() error {
 		w.startAuthRotationTicking(w.baseContext)
 	}()
 
+	if w.autoUpdater != nil {
+		w.tickerWg.Add(1)
+		go func() {
+			defer w.tickerWg.Done()
+			w.startAutoUpdateTicking(w.baseContext, w.autoUpdater)
+		}()
+	}
+
+	if w.reverseTunnelListener != nil {
+		w.tickerWg.Add(1)
+		go func() {
+			defer w.tickerWg.Done()
+			w.startReverseTunnels(w.baseContext)
+		}()
+	}
+
+	if !w.conf.RawConfig.SelfMonitor.Disable {
+		opts := append(selfmonitor.ConfigOptions(w.conf.RawConfig.SelfMonitor),
+			selfmonitor.WithPrometheusRegisterer(w.conf.PrometheusRegisterer),
+			selfmonitor.WithProxyConnections(proxy.ProxyState.CurrentProxiedConnections, w.conf.RawConfig.SelfMonitor.ProxyConnectionThreshold),
+		)
+		monitor, err := selfmonitor.New(w.baseContext, "worker", opts...)
+		if err != nil {
+			return errors.Wrap(w.baseContext, err, op, errors.WithMsg("error creating self monitor"))
+		}
+		w.tickerWg.Add(1)
+		go func() {
+			defer w.tickerWg.Done()
+			monitor.Start(w.baseContext)
+		}()
+	}
+
 	if w.downstreamReceiver != nil {
 		w.tickerWg.Add(2)
 		servNameFn := func() string {
@@ -923,6 +1035,12 @@ func (w *Worker) getSessionTls(sessionManager session.Manager) func(hello *tls.C
 				return errors.New(ctx, errors.InvalidParameter, op, "expected peer certificate to match session certificate")
 			}
 			_, err := cs.PeerCertificates[0].Verify(verifyOpts)
+			var certErr x509.CertificateInvalidError
+			if errors.As(err, &certErr) && certErr.Reason == x509.Expired && server.ClockSkewExceeded(lastSuccess.ClockSkew) {
+				skewErr := &server.ClockSkewError{Skew: lastSuccess.ClockSkew}
+				event.WriteError(ctx, op, skewErr, event.WithInfoMsg("session certificate rejected while worker clock is skewed", "session_id", sessionId))
+				return fmt.Errorf("%w: %w", err, skewErr)
+			}
 			return err
 		}
 		return tlsConf, nil
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package dump exports the rows of a Boundary database to a versioned logical
+// dump and imports them into another database, so the database can be moved
+// between Postgres instances without pg_dump.
+//
+// A dump is a stream of newline delimited JSON values. The first value is a
+// Header, which records the version of the format and the schema versions of
+// the exported database. Each following value is a Row of a table.
+//
+// Values encrypted with Boundary's data keys are exported as is. The root keys
+// that encrypt the data keys are re-encrypted with the root KMS of the
+// importing Boundary, so a dump can be imported by a Boundary that uses a
+// different root KMS than the exporting Boundary.
+package dump
@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dump
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+)
+
+// FormatVersion is the version of the dump format written by Export. Import
+// only reads dumps of this version.
+const FormatVersion = 1
+
+// excludedTables are not exported, since they describe the schema of the
+// database rather than its resources.
+var excludedTables = map[string]bool{
+	"boundary_schema_version": true,
+	"log_migration":           true,
+}
+
+const (
+	rootKeyVersionTable  = "kms_root_key_version"
+	rootKeyVersionColumn = "key"
+)
+
+// Header is the first value of a dump.
+type Header struct {
+	Version    int       `json:"version"`
+	CreateTime time.Time `json:"create_time"`
+	// Schema is the schema version of each edition of the exported database.
+	// A dump can only be imported into a database with the same versions.
+	Schema map[string]int `json:"schema"`
+}
+
+// Row is a row of a table of the exported database.
+type Row struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row"`
+}
+
+// Export writes a dump of all rows of the database to w, and returns the
+// number of rows written. The rows are read in a single read only
+// transaction, so the dump is consistent as of a point in time.
+func Export(ctx context.Context, db *sql.DB, w io.Writer) (int, error) {
+	const op = "dump.Export"
+	switch {
+	case db == nil:
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "missing database")
+	case w == nil:
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	}
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("unable to begin transaction"))
+	}
+	defer tx.Rollback()
+
+	schema, err := schemaVersions(ctx, tx)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	tables, err := listTables(ctx, tx)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(Header{
+		Version:    FormatVersion,
+		CreateTime: time.Now().UTC(),
+		Schema:     schema,
+	}); err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("unable to write header"))
+	}
+
+	var count int
+	for _, table := range tables {
+		n, err := exportTable(ctx, tx, enc, table)
+		if err != nil {
+			return count, errors.Wrap(ctx, err, op)
+		}
+		count += n
+	}
+	return count, nil
+}
+
+func exportTable(ctx context.Context, tx *sql.Tx, enc *json.Encoder, table string) (int, error) {
+	const op = "dump.exportTable"
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(selectRowsFmt, quoteIdentifier(table)))
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read table %q", table))
+	}
+	defer rows.Close()
+	var count int
+	for rows.Next() {
+		var row []byte
+		if err := rows.Scan(&row); err != nil {
+			return count, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read row of table %q", table))
+		}
+		if err := enc.Encode(Row{Table: table, Row: row}); err != nil {
+			return count, errors.Wrap(ctx, err, op, errors.WithMsg("unable to write row of table %q", table))
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return count, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read table %q", table))
+	}
+	return count, nil
+}
+
+// Import replaces all rows of the database with the rows of the dump read from
+// r, and returns the number of rows imported. The database must be at the
+// same schema version as the exported database. The rows are imported in a
+// single transaction with triggers and foreign key checks disabled, which
+// requires a role that can set session_replication_role.
+//
+// The root keys of the dump are decrypted and re-encrypted with rootWrapper,
+// so it must be able to decrypt the root keys of the exported database, for
+// instance by pooling the exporting Boundary's root KMS as a previous root
+// KMS.
+func Import(ctx context.Context, db *sql.DB, r io.Reader, rootWrapper wrapping.Wrapper) (int, error) {
+	const op = "dump.Import"
+	switch {
+	case db == nil:
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "missing database")
+	case r == nil:
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case rootWrapper == nil:
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "missing root wrapper")
+	}
+
+	dec := json.NewDecoder(r)
+	var header Header
+	if err := dec.Decode(&header); err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read header"))
+	}
+	if header.Version != FormatVersion {
+		return 0, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported dump version %d, expected %d", header.Version, FormatVersion))
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("unable to begin transaction"))
+	}
+	defer tx.Rollback()
+
+	schema, err := schemaVersions(ctx, tx)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	if err := compareSchemaVersions(header.Schema, schema); err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	if _, err := tx.ExecContext(ctx, disableTriggers); err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("unable to disable triggers"))
+	}
+
+	// Migrations seed some tables, so clear every table before importing the
+	// rows of the dump.
+	tables, err := listTables(ctx, tx)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	inserts := make(map[string]string, len(tables))
+	for _, table := range tables {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(deleteRowsFmt, quoteIdentifier(table))); err != nil {
+			return 0, errors.Wrap(ctx, err, op, errors.WithMsg("unable to clear table %q", table))
+		}
+		if inserts[table], err = insertStatement(ctx, tx, table); err != nil {
+			return 0, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	var count int
+	for {
+		var row Row
+		err := dec.Decode(&row)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return count, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read row"))
+		}
+		insert, ok := inserts[row.Table]
+		if !ok {
+			return count, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown table %q", row.Table))
+		}
+		if row.Table == rootKeyVersionTable {
+			if row.Row, err = rewrapRootKeyVersion(ctx, rootWrapper, row.Row); err != nil {
+				return count, errors.Wrap(ctx, err, op)
+			}
+		}
+		if _, err := tx.ExecContext(ctx, insert, string(row.Row)); err != nil {
+			return count, errors.Wrap(ctx, err, op, errors.WithMsg("unable to insert row of table %q", row.Table))
+		}
+		count++
+	}
+
+	if err := resetSequences(ctx, tx); err != nil {
+		return count, errors.Wrap(ctx, err, op)
+	}
+	if err := tx.Commit(); err != nil {
+		return count, errors.Wrap(ctx, err, op, errors.WithMsg("unable to commit transaction"))
+	}
+	return count, nil
+}
+
+func schemaVersions(ctx context.Context, tx *sql.Tx) (map[string]int, error) {
+	const op = "dump.schemaVersions"
+	rows, err := tx.QueryContext(ctx, selectSchemaVersions)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read schema versions"))
+	}
+	defer rows.Close()
+	versions := make(map[string]int)
+	for rows.Next() {
+		var edition string
+		var version int
+		if err := rows.Scan(&edition, &version); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read schema versions"))
+		}
+		versions[edition] = version
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read schema versions"))
+	}
+	return versions, nil
+}
+
+func compareSchemaVersions(dump, db map[string]int) error {
+	for edition, version := range dump {
+		if db[edition] != version {
+			return fmt.Errorf("dump is at version %d of edition %q, database is at version %d", version, edition, db[edition])
+		}
+	}
+	for edition, version := range db {
+		if _, ok := dump[edition]; !ok {
+			return fmt.Errorf("dump has no version of edition %q, database is at version %d", edition, version)
+		}
+	}
+	return nil
+}
+
+func listTables(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	const op = "dump.listTables"
+	rows, err := tx.QueryContext(ctx, selectTables)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to list tables"))
+	}
+	defer rows.Close()
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to list tables"))
+		}
+		if !excludedTables[table] {
+			tables = append(tables, table)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to list tables"))
+	}
+	return tables, nil
+}
+
+// insertStatement returns the statement that inserts a row of the table from
+// its JSON representation. Generated columns are skipped, since they can't be
+// inserted.
+func insertStatement(ctx context.Context, tx *sql.Tx, table string) (string, error) {
+	const op = "dump.insertStatement"
+	rows, err := tx.QueryContext(ctx, selectInsertableColumns, table)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to list columns of table %q", table))
+	}
+	defer rows.Close()
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to list columns of table %q", table))
+		}
+		columns = append(columns, quoteIdentifier(column))
+	}
+	if err := rows.Err(); err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to list columns of table %q", table))
+	}
+	return fmt.Sprintf(insertRowFmt, quoteIdentifier(table), strings.Join(columns, ", ")), nil
+}
+
+// resetSequences advances each sequence past the largest value of the column
+// that owns it, so inserts after the import don't reuse imported values.
+func resetSequences(ctx context.Context, tx *sql.Tx) error {
+	const op = "dump.resetSequences"
+	rows, err := tx.QueryContext(ctx, selectSequenceColumns)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list sequences"))
+	}
+	type sequence struct{ table, column, name string }
+	var sequences []sequence
+	for rows.Next() {
+		var s sequence
+		if err := rows.Scan(&s.table, &s.column, &s.name); err != nil {
+			rows.Close()
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list sequences"))
+		}
+		sequences = append(sequences, s)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list sequences"))
+	}
+	for _, s := range sequences {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(setSequenceFmt, quoteIdentifier(s.column), quoteIdentifier(s.table)), quoteIdentifier(s.name)); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to reset sequence %q", s.name))
+		}
+	}
+	return nil
+}
+
+// rootKeyVersion is the encrypted key of a row of kms_root_key_version. The
+// tags match those of the kms package, which encrypts the key.
+type rootKeyVersion struct {
+	Key   []byte `wrapping:"pt,key_data"`
+	CtKey []byte `wrapping:"ct,key_data"`
+}
+
+// rewrapRootKeyVersion decrypts the key of the row of kms_root_key_version and
+// encrypts it with the root wrapper.
+func rewrapRootKeyVersion(ctx context.Context, rootWrapper wrapping.Wrapper, row json.RawMessage) (json.RawMessage, error) {
+	const op = "dump.rewrapRootKeyVersion"
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(row, &values); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to decode root key version"))
+	}
+	var encoded string
+	if err := json.Unmarshal(values[rootKeyVersionColumn], &encoded); err != nil || encoded == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "root key version has no key")
+	}
+	// bytea values are exported in the hex format.
+	ct, err := hex.DecodeString(strings.TrimPrefix(encoded, `\x`))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to decode root key version"))
+	}
+	k := rootKeyVersion{CtKey: ct}
+	if err := structwrapping.UnwrapStruct(ctx, rootWrapper, &k, nil); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("unable to decrypt root key version with the root kms"))
+	}
+	if err := structwrapping.WrapStruct(ctx, rootWrapper, &k, nil); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to encrypt root key version with the root kms"))
+	}
+	if values[rootKeyVersionColumn], err = json.Marshal(`\x` + hex.EncodeToString(k.CtKey)); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to encode root key version"))
+	}
+	rewrapped, err := json.Marshal(values)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to encode root key version"))
+	}
+	return rewrapped, nil
+}
+
+// quoteIdentifier quotes s as a postgres identifier.
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dump_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/dump"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/multi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, prj := iam.TestScopes(t, iamRepo)
+	sqlDb, err := conn.SqlDB(ctx)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	exported, err := dump.Export(ctx, sqlDb, &buf)
+	require.NoError(t, err)
+	assert.Greater(t, exported, 0)
+	dumped := buf.Bytes()
+
+	t.Run("rewrap", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		targetConn, _ := db.TestSetup(t, "postgres")
+		targetSqlDb, err := targetConn.SqlDB(ctx)
+		require.NoError(err)
+
+		// The importing Boundary uses a new root kms, and pools the root kms of
+		// the exporting Boundary as its previous root kms.
+		targetWrapper := db.TestWrapper(t)
+		rootWrapper, err := multi.NewPooledWrapper(ctx, targetWrapper)
+		require.NoError(err)
+		added, err := rootWrapper.AddWrapper(ctx, wrapper)
+		require.NoError(err)
+		require.True(added)
+
+		imported, err := dump.Import(ctx, targetSqlDb, bytes.NewReader(dumped), rootWrapper)
+		require.NoError(err)
+		assert.Equal(exported, imported)
+
+		// The imported keys can be used with the new root kms alone.
+		targetKms := kms.TestKms(t, targetConn, targetWrapper)
+		_, err = targetKms.GetWrapper(ctx, prj.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+
+		targetRepo := iam.TestRepo(t, targetConn, targetWrapper)
+		got, err := targetRepo.LookupScope(ctx, org.GetPublicId())
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(org.GetName(), got.GetName())
+	})
+
+	t.Run("unknown-root-kms", func(t *testing.T) {
+		targetConn, _ := db.TestSetup(t, "postgres")
+		targetSqlDb, err := targetConn.SqlDB(ctx)
+		require.NoError(t, err)
+		_, err = dump.Import(ctx, targetSqlDb, bytes.NewReader(dumped), db.TestWrapper(t))
+		assert.ErrorContains(t, err, "unable to decrypt root key version")
+	})
+
+	t.Run("schema-mismatch", func(t *testing.T) {
+		targetConn, _ := db.TestSetup(t, "postgres")
+		targetSqlDb, err := targetConn.SqlDB(ctx)
+		require.NoError(t, err)
+		header, err := json.Marshal(dump.Header{Version: dump.FormatVersion, Schema: map[string]int{"oss": 1}})
+		require.NoError(t, err)
+		_, err = dump.Import(ctx, targetSqlDb, bytes.NewReader(header), wrapper)
+		assert.ErrorContains(t, err, `dump is at version 1 of edition "oss"`)
+	})
+
+	t.Run("unsupported-version", func(t *testing.T) {
+		targetConn, _ := db.TestSetup(t, "postgres")
+		targetSqlDb, err := targetConn.SqlDB(ctx)
+		require.NoError(t, err)
+		_, err = dump.Import(ctx, targetSqlDb, strings.NewReader(`{"version":2}`), wrapper)
+		assert.ErrorContains(t, err, "unsupported dump version 2")
+	})
+}
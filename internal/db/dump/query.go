@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dump
+
+const (
+	selectSchemaVersions = `
+select edition, version
+  from boundary_schema_version
+order by edition;
+`
+
+	// selectTables selects the tables of the database, including the
+	// partitions of partitioned tables but not the partitioned tables
+	// themselves.
+	selectTables = `
+select c.relname
+  from pg_class c
+  join pg_namespace n
+    on n.oid = c.relnamespace
+ where n.nspname = 'public'
+   and c.relkind = 'r'
+order by c.relname;
+`
+
+	selectInsertableColumns = `
+select column_name
+  from information_schema.columns
+ where table_schema = 'public'
+   and table_name   = $1
+   and is_generated = 'NEVER'
+order by ordinal_position;
+`
+
+	// selectSequenceColumns selects the columns that own a sequence, so the
+	// sequences can be advanced past the imported values.
+	selectSequenceColumns = `
+select t.relname, a.attname, s.relname
+  from pg_depend d
+  join pg_class s
+    on s.oid = d.objid
+   and s.relkind = 'S'
+  join pg_class t
+    on t.oid = d.refobjid
+  join pg_attribute a
+    on a.attrelid = t.oid
+   and a.attnum = d.refobjsubid
+  join pg_namespace n
+    on n.oid = s.relnamespace
+ where n.nspname = 'public'
+order by t.relname, a.attname;
+`
+
+	// disableTriggers disables triggers and foreign key checks for the
+	// transaction, so rows can be imported in any order and with the values
+	// they were exported with.
+	disableTriggers = `set local session_replication_role = replica;`
+
+	selectRowsFmt  = `select row_to_json(t) from %s t;`
+	deleteRowsFmt  = `delete from %s;`
+	insertRowFmt   = `insert into %[1]s (%[2]s) overriding system value select %[2]s from json_populate_record(null::%[1]s, $1::json);`
+	setSequenceFmt = `select setval($1::regclass, coalesce(max(%s), 0) + 1, false) from %s;`
+)
@@ -34,6 +34,7 @@ import (
 	stderrors "errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/hashicorp/boundary/internal/db/schema/internal/log"
 	"github.com/hashicorp/boundary/internal/db/schema/migration"
@@ -416,6 +417,18 @@ func (p *Postgres) setVersion(ctx context.Context, version int, edition string)
 	return nil
 }
 
+// VersionStatement returns the statement which sets the version of the given
+// edition, with the version and edition inlined, so it can be run outside of
+// the driver.
+func (p *Postgres) VersionStatement(version int, edition string) string {
+	return fmt.Sprintf(upsertVersionLiteral, quoteLiteral(edition), version)
+}
+
+// quoteLiteral quotes s as a postgres string literal.
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
 // EnsureMigrationLogTable ensures that the table used to record migration lgos
 // exists and is in the correct state.
 func (p *Postgres) EnsureMigrationLogTable(ctx context.Context) error {
@@ -25,6 +25,16 @@ do update set
 	version = $2
 ;`
 
+	upsertVersionLiteral = `
+insert into boundary_schema_version
+	(edition, version)
+values
+	(%s, %d)
+on conflict (edition)
+do update set
+	version = excluded.version
+;`
+
 	selectVersion = `
 select version
   from boundary_schema_version
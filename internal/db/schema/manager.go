@@ -42,6 +42,9 @@ type driver interface {
 	// statements to execute, and the int is the version for that set of
 	// statements. This should always be wrapped by StartRun and CommitRun.
 	Run(ctx context.Context, statements io.Reader, version int, edition string) error
+	// VersionStatement returns the statement Run uses to set the version of
+	// the edition, with the version and edition inlined.
+	VersionStatement(version int, edition string) string
 	// CurrentState returns the state of the given edition.
 	// ver is the current migration version number as recorded in the database.
 	// A version of -1 indicates no version is set.
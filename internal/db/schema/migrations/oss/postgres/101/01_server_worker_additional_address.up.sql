@@ -0,0 +1,85 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+create table server_worker_additional_address (
+  worker_id wt_public_id
+    constraint server_worker_fkey
+      references server_worker(public_id)
+        on delete cascade
+        on update cascade,
+  priority int not null
+    constraint priority_must_be_greater_than_zero
+      check (priority > 0),
+  address wt_network_address not null
+    constraint address_must_not_contain_commas_or_whitespace
+      check (address !~ '[,[:space:]]'),
+  primary key(worker_id, priority),
+  constraint server_worker_additional_address_worker_id_address_uq
+    unique(worker_id, address)
+);
+comment on table server_worker_additional_address is
+  'server_worker_additional_address contains the addresses, besides its address, at which clients can reach a worker. '
+  'Clients try the addresses in order of priority, lowest first, after the worker address.';
+
+drop view server_worker_aggregate;
+-- Replaces view created in 100/01_worker_target_locality.up.sql to add the worker additional addresses
+create view server_worker_aggregate as
+with worker_config_tags(worker_id, source, tags) as (
+  select
+    ct.worker_id,
+    ct.source,
+    -- keys and tags can be any lowercase printable character so use uppercase characters as delimitors.
+    string_agg(distinct concat_ws('Y', ct.key, ct.value), 'Z') as tags
+  from server_worker_tag ct
+  group by ct.worker_id, ct.source
+),
+connection_count (worker_id, count) as (
+ select
+   worker_id,
+   count(1) as count
+ from session_connection
+ where closed_reason is null
+ group by worker_id
+),
+additional_addresses (worker_id, addresses) as (
+  select
+    worker_id,
+    -- addresses cannot contain commas so use a comma as the delimiter.
+    string_agg(address, ',' order by priority) as addresses
+  from server_worker_additional_address
+  group by worker_id
+)
+select
+  w.public_id,
+  w.scope_id,
+  w.description,
+  w.name,
+  w.address,
+  w.create_time,
+  w.update_time,
+  w.version,
+  w.last_status_time,
+  w.type,
+  w.release_version,
+  w.operational_state,
+  w.local_storage_state,
+  w.locality,
+  aa.addresses as additional_addresses,
+  cc.count as active_connection_count,
+  wt.tags as api_tags,
+  ct.tags as worker_config_tags
+from server_worker w
+ left join worker_config_tags wt on
+    w.public_id = wt.worker_id and wt.source = 'api'
+ left join worker_config_tags ct on
+    w.public_id = ct.worker_id and ct.source = 'configuration'
+ left join connection_count as cc on
+    w.public_id = cc.worker_id
+ left join additional_addresses as aa on
+    w.public_id = aa.worker_id;
+comment on view server_worker_aggregate is
+  'server_worker_aggregate contains the worker resource with its worker provided config values, additional addresses and its configuration and api provided tags.';
+
+commit;
@@ -0,0 +1,39 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- Existing tokens get the time of the migration as their create time. They
+  -- have no expiration time and remain valid for the maximum lifetime of a
+  -- controller-led activation token.
+  alter table worker_auth_server_led_activation_token
+    add column create_time wt_timestamp,
+    add column expiration_time timestamp with time zone,
+    add constraint create_time_must_be_before_expiration_time
+      check(create_time < expiration_time);
+
+  create trigger default_create_time_column before insert on worker_auth_server_led_activation_token
+    for each row execute procedure default_create_time();
+
+  -- Replaces trigger from 56/05_mutable_ciphertext_columns.up.sql
+  drop trigger immutable_columns on worker_auth_server_led_activation_token;
+  create trigger immutable_columns before update on worker_auth_server_led_activation_token
+    for each row execute procedure immutable_columns('worker_id', 'token_id', 'create_time', 'expiration_time');
+
+  create table server_worker_activation_cidr (
+    worker_id wt_public_id not null
+      constraint server_worker_fkey
+        references server_worker(public_id)
+          on delete cascade
+          on update cascade,
+    cidr cidr not null,
+    primary key(worker_id, cidr)
+  );
+  comment on table server_worker_activation_cidr is
+    'server_worker_activation_cidr contains the network ranges a worker created with a controller-led activation token must report its status from. '
+    'A worker without rows in this table may report its status from any address.';
+
+  create trigger immutable_columns before update on server_worker_activation_cidr
+    for each row execute procedure immutable_columns('worker_id', 'cidr');
+
+commit;
@@ -0,0 +1,21 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table server_worker_retirement (
+    worker_id wt_public_id primary key
+      constraint server_worker_fkey
+        references server_worker(public_id)
+          on delete cascade
+          on update cascade,
+    retire_time wt_timestamp
+  );
+  comment on table server_worker_retirement is
+    'server_worker_retirement contains the workers that were retired because they had not reported their status for a long time. '
+    'A retired worker that reports its status again is revived by deleting its row, otherwise the worker is deleted once its revival window has passed.';
+
+  create trigger immutable_columns before update on server_worker_retirement
+    for each row execute procedure immutable_columns('worker_id', 'retire_time');
+
+commit;
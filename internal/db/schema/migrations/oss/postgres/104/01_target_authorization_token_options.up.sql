@@ -0,0 +1,120 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- Targets can limit the lifetime of session authorizations and make them
+  -- single-use.
+  alter table target_tcp
+    add column authorization_token_ttl_seconds integer not null default 0
+      constraint authorization_token_ttl_seconds_must_not_be_negative
+        check(authorization_token_ttl_seconds >= 0),
+    add column authorization_token_single_use boolean not null default false;
+  alter table target_ssh
+    add column authorization_token_ttl_seconds integer not null default 0
+      constraint authorization_token_ttl_seconds_must_not_be_negative
+        check(authorization_token_ttl_seconds >= 0),
+    add column authorization_token_single_use boolean not null default false;
+
+  comment on column target_tcp.authorization_token_ttl_seconds is
+    'authorization_token_ttl_seconds is the maximum number of seconds a session authorization can be used to activate the session. 0 means unlimited.';
+  comment on column target_tcp.authorization_token_single_use is
+    'authorization_token_single_use indicates that session authorizations allow a single connection.';
+  comment on column target_ssh.authorization_token_ttl_seconds is
+    'authorization_token_ttl_seconds is the maximum number of seconds a session authorization can be used to activate the session. 0 means unlimited.';
+  comment on column target_ssh.authorization_token_single_use is
+    'authorization_token_single_use indicates that session authorizations allow a single connection.';
+
+  -- Replaces target_all_subtypes defined in 100/01_worker_target_locality.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    locality,
+    authorization_token_ttl_seconds,
+    authorization_token_single_use
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    locality,
+    authorization_token_ttl_seconds,
+    authorization_token_single_use
+  from
+    target_ssh;
+
+  -- authorization_expiration_time is set when the target of the session limits
+  -- the lifetime of session authorizations. A pending session can no longer be
+  -- activated after this time.
+  alter table session
+    add column authorization_expiration_time timestamp with time zone;
+  comment on column session.authorization_expiration_time is
+    'authorization_expiration_time is the time after which a pending session can no longer be activated.';
+
+  -- Replaces trigger from 87/01_session.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit',
+    'create_time', 'endpoint', 'worker_filter', 'egress_worker_filter', 'ingress_worker_filter', 'correlation_id',
+    'authorization_expiration_time');
+
+  -- Replaces view from 95/01_storage_policy_sampling.up.sql
+  -- Adds the authorization_expiration_time column.
+  drop view session_list;
+  create view session_list as
+      select s.public_id,
+             s.user_id,
+             shsh.host_id,
+             shsh.host_set_id,
+             s.target_id,
+             s.auth_token_id,
+             s.project_id,
+             s.certificate,
+             s.expiration_time,
+             s.authorization_expiration_time,
+             s.termination_reason,
+             s.recording_skip_reason,
+             s.create_time,
+             s.update_time,
+             s.version,
+             s.endpoint,
+             s.connection_limit,
+             ss.state,
+             lower(ss.active_time_range) as start_time,
+             upper(ss.active_time_range) as end_time
+        from session s
+        join session_state            ss on s.public_id = ss.session_id
+   left join session_host_set_host  shsh on s.public_id = shsh.session_id;
+
+commit;
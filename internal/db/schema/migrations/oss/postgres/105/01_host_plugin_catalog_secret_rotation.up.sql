@@ -0,0 +1,37 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- host_plugin_catalog_secret_rotation holds the automatic secret rotation
+  -- schedule of a plugin host catalog. A catalog without an entry is never
+  -- rotated automatically.
+  create table host_plugin_catalog_secret_rotation (
+    catalog_id wt_public_id primary key
+      constraint host_plugin_catalog_fkey
+        references host_plugin_catalog (public_id)
+        on delete cascade
+        on update cascade,
+    rotation_interval_seconds integer not null
+      constraint rotation_interval_seconds_must_be_positive
+        check(rotation_interval_seconds > 0),
+    last_rotation_time timestamp with time zone not null default current_timestamp,
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+  comment on table host_plugin_catalog_secret_rotation is
+    'host_plugin_catalog_secret_rotation holds the automatic secret rotation schedule of a plugin host catalog.';
+
+  create trigger update_time_column before update on host_plugin_catalog_secret_rotation
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on host_plugin_catalog_secret_rotation
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on host_plugin_catalog_secret_rotation
+    for each row execute procedure immutable_columns('catalog_id', 'create_time');
+
+  create index host_plugin_catalog_secret_rotation_last_rotation_time_ix
+    on host_plugin_catalog_secret_rotation (last_rotation_time);
+
+commit;
@@ -0,0 +1,73 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- Targets can choose which of the addresses of a host from their host
+  -- sources sessions connect to.
+  alter table target_tcp
+    add column preferred_endpoint text
+      constraint preferred_endpoint_must_not_be_empty
+        check(length(trim(preferred_endpoint)) > 0);
+  alter table target_ssh
+    add column preferred_endpoint text
+      constraint preferred_endpoint_must_not_be_empty
+        check(length(trim(preferred_endpoint)) > 0);
+
+  comment on column target_tcp.preferred_endpoint is
+    'preferred_endpoint is a comma separated list of endpoint preferences used to choose the address of hosts from the target''s host sources.';
+  comment on column target_ssh.preferred_endpoint is
+    'preferred_endpoint is a comma separated list of endpoint preferences used to choose the address of hosts from the target''s host sources.';
+
+  -- Replaces target_all_subtypes defined in 104/01_target_authorization_token_options.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    locality,
+    authorization_token_ttl_seconds,
+    authorization_token_single_use,
+    preferred_endpoint
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    locality,
+    authorization_token_ttl_seconds,
+    authorization_token_single_use,
+    preferred_endpoint
+  from
+    target_ssh;
+
+commit;
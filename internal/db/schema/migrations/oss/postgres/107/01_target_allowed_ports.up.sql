@@ -0,0 +1,90 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- Targets can allow clients to choose the port they connect to from a set of
+  -- ports and port ranges, such as '5432,6432' or '8000-8100'. The format is
+  -- validated by the controller.
+  alter table target_tcp
+    add column allowed_ports text
+      constraint allowed_ports_must_not_be_empty
+        check(length(trim(allowed_ports)) > 0);
+  alter table target_ssh
+    add column allowed_ports text
+      constraint allowed_ports_must_not_be_empty
+        check(length(trim(allowed_ports)) > 0);
+
+  comment on column target_tcp.allowed_ports is
+    'allowed_ports is a comma separated list of ports and port ranges a client may choose from when connecting to the target.';
+  comment on column target_ssh.allowed_ports is
+    'allowed_ports is a comma separated list of ports and port ranges a client may choose from when connecting to the target.';
+
+  -- Replaces target_all_subtypes defined in 106/01_target_preferred_endpoint.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    locality,
+    authorization_token_ttl_seconds,
+    authorization_token_single_use,
+    preferred_endpoint,
+    allowed_ports
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    locality,
+    authorization_token_ttl_seconds,
+    authorization_token_single_use,
+    preferred_endpoint,
+    allowed_ports
+  from
+    target_ssh;
+
+  -- allowed_ports is copied from the target when the session is authorized so
+  -- that the worker can enforce the port chosen by the client.
+  alter table session
+    add column allowed_ports text;
+  comment on column session.allowed_ports is
+    'allowed_ports is the set of ports of the target the client may choose from when connecting.';
+
+  -- Replaces trigger from 104/01_target_authorization_token_options.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit',
+    'create_time', 'endpoint', 'worker_filter', 'egress_worker_filter', 'ingress_worker_filter', 'correlation_id',
+    'authorization_expiration_time', 'allowed_ports');
+
+commit;
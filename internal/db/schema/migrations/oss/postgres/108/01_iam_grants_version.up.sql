@@ -0,0 +1,168 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- Controllers cache the grants of users. The version below changes with
+  -- every committed change that may change the grants of a user, so that
+  -- controllers can tell when their cached grants may be stale.
+  create sequence iam_grants_version_seq;
+  comment on sequence iam_grants_version_seq is
+    'iam_grants_version_seq provides the values of iam_grants_version.version, which are never reused even when the transaction that took one is rolled back.';
+
+  create table iam_grants_version (
+    singleton boolean primary key default true
+      constraint iam_grants_version_must_be_singleton
+        check(singleton),
+    version bigint not null default nextval('iam_grants_version_seq')
+  );
+  comment on table iam_grants_version is
+    'iam_grants_version is a single row table whose version is changed whenever a change is made that may change the grants of a user.';
+
+  insert into iam_grants_version default values;
+
+  -- The version row is locked until the transaction that changes it commits,
+  -- so the version is only changed by statements that change rows. Statements
+  -- that affect no rows, such as refreshing the managed groups of an account
+  -- whose memberships did not change on login, do not wait for the lock.
+  create function bump_iam_grants_version() returns trigger
+  as $$
+  begin
+    if tg_op = 'DELETE' then
+      perform from old_rows limit 1;
+    else
+      perform from new_rows limit 1;
+    end if;
+    if found then
+      update iam_grants_version
+         set version = nextval('iam_grants_version_seq');
+    end if;
+    return null;
+  end;
+  $$ language plpgsql;
+  comment on function bump_iam_grants_version is
+    'bump_iam_grants_version is a statement trigger function that changes the version of iam_grants_version if the statement changed any rows, which its triggers must reference as the transition table new_rows, or old_rows for delete triggers.';
+
+  -- bump_iam_grants_version_row is a row trigger function that changes the
+  -- version of iam_grants_version, for triggers whose when condition selects
+  -- the changes that may change grants.
+  create function bump_iam_grants_version_row() returns trigger
+  as $$
+  begin
+    update iam_grants_version
+       set version = nextval('iam_grants_version_seq');
+    return null;
+  end;
+  $$ language plpgsql;
+  comment on function bump_iam_grants_version_row is
+    'bump_iam_grants_version_row is a row trigger function that changes the version of iam_grants_version.';
+
+  -- Any change to these tables may change the grants of a user. Transition
+  -- tables can only be used by triggers on a single event, so each event has
+  -- its own trigger.
+  create trigger bump_iam_grants_version_on_insert after insert on iam_role
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_update after update on iam_role
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_delete after delete on iam_role
+    referencing old table as old_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_insert after insert on iam_role_grant
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_update after update on iam_role_grant
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_delete after delete on iam_role_grant
+    referencing old table as old_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_insert after insert on iam_role_grant_scope
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_update after update on iam_role_grant_scope
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_delete after delete on iam_role_grant_scope
+    referencing old table as old_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_insert after insert on iam_user_role
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_update after update on iam_user_role
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_delete after delete on iam_user_role
+    referencing old table as old_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_insert after insert on iam_group_role
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_update after update on iam_group_role
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_delete after delete on iam_group_role
+    referencing old table as old_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_insert after insert on iam_managed_group_role
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_update after update on iam_managed_group_role
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_delete after delete on iam_managed_group_role
+    referencing old table as old_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_insert after insert on iam_group_member_user
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_update after update on iam_group_member_user
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_delete after delete on iam_group_member_user
+    referencing old table as old_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_insert after insert on auth_oidc_managed_group_member_account
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_update after update on auth_oidc_managed_group_member_account
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_delete after delete on auth_oidc_managed_group_member_account
+    referencing old table as old_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_insert after insert on auth_ldap_managed_group
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_update after update on auth_ldap_managed_group
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_delete after delete on auth_ldap_managed_group
+    referencing old table as old_rows
+    for each statement execute function bump_iam_grants_version();
+
+  -- Accounts are updated on every authentication, so only changes to the
+  -- columns that grants depend on change the version.
+  create trigger bump_iam_grants_version_on_insert after insert on auth_account
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_delete after delete on auth_account
+    referencing old table as old_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_update after update on auth_account
+    for each row
+    when (old.iam_user_id is distinct from new.iam_user_id)
+    execute function bump_iam_grants_version_row();
+  create trigger bump_iam_grants_version_on_insert after insert on auth_ldap_account
+    referencing new table as new_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_delete after delete on auth_ldap_account
+    referencing old table as old_rows
+    for each statement execute function bump_iam_grants_version();
+  create trigger bump_iam_grants_version_on_update after update on auth_ldap_account
+    for each row
+    when (old.member_of_groups is distinct from new.member_of_groups)
+    execute function bump_iam_grants_version_row();
+
+commit;
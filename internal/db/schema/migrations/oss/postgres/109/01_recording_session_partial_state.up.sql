@@ -0,0 +1,34 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- A recording that was left in the started state because the worker
+  -- recording it stopped can be repaired. The repaired recording is missing
+  -- some of the recorded data, so it is marked as partial.
+
+  -- Replaces the constraint defined in 71/11_session_recording
+  alter table recording_session_state_enm
+    drop constraint only_predefined_states_allowed;
+  alter table recording_session_state_enm
+    add constraint only_predefined_states_allowed
+      check(name in ('unknown', 'started', 'available', 'partial'));
+
+  insert into recording_session_state_enm (name)
+  values
+    ('partial');
+
+  -- Replaces the constraint defined in 71/11_session_recording
+  -- Error details are allowed two different types of values:
+  --  - e'\ufffeno error details\uffff', the sentinel value for "no error details", only when the recording is in the started or available state.
+  --  - some error message when the recording is in the unknown or partial state.
+  alter table recording_session
+    drop constraint error_details_set_iff_state_not_started;
+  alter table recording_session
+    add constraint error_details_set_iff_state_not_started
+      check (
+        (error_details = wt_to_sentinel('no error details') and state in ('started', 'available')) or
+        (error_details != wt_to_sentinel('no error details') and state in ('unknown', 'partial'))
+      );
+
+commit;
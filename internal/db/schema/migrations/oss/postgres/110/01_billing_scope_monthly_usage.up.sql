@@ -0,0 +1,36 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- billing_scope_monthly_usage is populated by the billing usage job from the
+  -- warehouse and the session recording tables. Rows are kept after the scope
+  -- is deleted so that usage can still be reported for past months.
+  create table billing_scope_monthly_usage (
+    scope_id wt_scope_id not null,
+    start_time wt_timestamp not null,
+    active_users_count bigint not null default 0
+      constraint active_users_count_must_be_zero_or_positive
+        check (active_users_count >= 0),
+    session_count bigint not null default 0
+      constraint session_count_must_be_zero_or_positive
+        check (session_count >= 0),
+    session_seconds bigint not null default 0
+      constraint session_seconds_must_be_zero_or_positive
+        check (session_seconds >= 0),
+    recorded_bytes bigint not null default 0
+      constraint recorded_bytes_must_be_zero_or_positive
+        check (recorded_bytes >= 0),
+    update_time wt_timestamp,
+    primary key (start_time, scope_id)
+  );
+  comment on table billing_scope_monthly_usage is
+    'billing_scope_monthly_usage is a table where each row contains the usage of '
+    'a project scope for the month starting at start_time: the number of distinct '
+    'users that started sessions, the number of sessions started, the number of '
+    'seconds sessions were active and the number of bytes recorded.';
+
+  create trigger update_time_column before update on billing_scope_monthly_usage
+    for each row execute procedure update_time_column();
+
+commit;
@@ -0,0 +1,44 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table server_worker_utilization (
+    worker_id wt_public_id primary key
+      constraint server_worker_fkey
+        references server_worker(public_id)
+          on delete cascade
+          on update cascade,
+    active_session_count bigint not null default 0
+      constraint active_session_count_must_be_zero_or_positive
+        check (active_session_count >= 0),
+    active_connection_count bigint not null default 0
+      constraint active_connection_count_must_be_zero_or_positive
+        check (active_connection_count >= 0),
+    bytes_up_per_second bigint not null default 0
+      constraint bytes_up_per_second_must_be_zero_or_positive
+        check (bytes_up_per_second >= 0),
+    bytes_down_per_second bigint not null default 0
+      constraint bytes_down_per_second_must_be_zero_or_positive
+        check (bytes_down_per_second >= 0),
+    proxy_cpu_utilization double precision not null default 0
+      constraint proxy_cpu_utilization_must_be_zero_or_positive
+        check (proxy_cpu_utilization >= 0),
+    spool_backlog_bytes bigint not null default 0
+      constraint spool_backlog_bytes_must_be_zero_or_positive
+        check (spool_backlog_bytes >= 0),
+    update_time wt_timestamp
+  );
+  comment on table server_worker_utilization is
+    'server_worker_utilization contains the utilization last reported by each worker in its status: '
+    'the number of active sessions and proxied connections, the rate of bytes proxied in each direction, '
+    'the fraction of the available cpu used and the number of bytes of session recordings spooled to local storage '
+    'that have not yet been synced to their storage bucket.';
+
+  create trigger update_time_column before update on server_worker_utilization
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on server_worker_utilization
+    for each row execute procedure immutable_columns('worker_id');
+
+commit;
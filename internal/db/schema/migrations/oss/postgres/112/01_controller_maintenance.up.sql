@@ -0,0 +1,31 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- controller_maintenance has a single row, for the global scope, holding the
+  -- maintenance mode shared by all the controllers.
+  create table controller_maintenance (
+    scope_id wt_scope_id primary key
+      constraint iam_scope_global_fkey
+        references iam_scope_global(scope_id)
+        on delete cascade
+        on update cascade,
+    read_only boolean not null default false,
+    reason text
+      constraint reason_must_not_be_too_long
+        check (length(reason) <= 1024),
+    update_time wt_timestamp
+  );
+  comment on table controller_maintenance is
+    'controller_maintenance is a table with a single row which contains the '
+    'maintenance mode of the controllers. When read_only is set, the controllers '
+    'reject the api requests that modify resources.';
+
+  create trigger update_time_column before update on controller_maintenance
+    for each row execute procedure update_time_column();
+
+  insert into controller_maintenance (scope_id)
+  values ('global');
+
+commit;
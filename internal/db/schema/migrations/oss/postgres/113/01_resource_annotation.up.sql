@@ -0,0 +1,67 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- wt_annotation_key is the key of a resource annotation. The size limits of
+  -- the annotations of a resource are enforced by the controller.
+  create domain wt_annotation_key as text
+    not null
+    constraint annotation_key_must_be_valid
+      check (
+        length(value) between 1 and 128
+        and value ~ '^[A-Za-z0-9._/-]+$'
+      );
+  comment on domain wt_annotation_key is
+    'The key of a resource annotation';
+
+  create domain wt_annotation_value as text
+    not null
+    constraint annotation_value_must_not_be_too_long
+      check (length(value) <= 1024);
+  comment on domain wt_annotation_value is
+    'The value of a resource annotation';
+
+  create table target_annotation (
+    resource_id wt_public_id not null
+      constraint target_fkey
+        references target(public_id)
+        on delete cascade
+        on update cascade,
+    key wt_annotation_key,
+    value wt_annotation_value,
+    create_time wt_timestamp,
+    primary key (resource_id, key)
+  );
+  comment on table target_annotation is
+    'target_annotation is a table where each row is an annotation of a target. '
+    'Annotations are not interpreted by Boundary.';
+
+  create trigger immutable_columns before update on target_annotation
+    for each row execute procedure immutable_columns('resource_id', 'key', 'create_time');
+
+  create trigger default_create_time_column before insert on target_annotation
+    for each row execute procedure default_create_time();
+
+  create table iam_scope_annotation (
+    resource_id wt_scope_id not null
+      constraint iam_scope_fkey
+        references iam_scope(public_id)
+        on delete cascade
+        on update cascade,
+    key wt_annotation_key,
+    value wt_annotation_value,
+    create_time wt_timestamp,
+    primary key (resource_id, key)
+  );
+  comment on table iam_scope_annotation is
+    'iam_scope_annotation is a table where each row is an annotation of a scope. '
+    'Annotations are not interpreted by Boundary.';
+
+  create trigger immutable_columns before update on iam_scope_annotation
+    for each row execute procedure immutable_columns('resource_id', 'key', 'create_time');
+
+  create trigger default_create_time_column before insert on iam_scope_annotation
+    for each row execute procedure default_create_time();
+
+commit;
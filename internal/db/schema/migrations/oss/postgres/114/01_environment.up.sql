@@ -0,0 +1,97 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- An environment groups targets of a project and provides default settings
+  -- for the targets in it, so that near-identical targets don't have to
+  -- repeat them. The settings are applied by the controller when a session is
+  -- authorized, for the targets that don't set them themselves.
+  create table environment (
+    public_id wt_public_id primary key,
+    project_id wt_scope_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    session_max_seconds int
+      constraint session_max_seconds_must_be_greater_than_0
+        check(session_max_seconds > 0),
+    egress_worker_filter wt_bexprfilter,
+    enable_session_recording bool not null default false,
+    storage_bucket_id wt_public_id
+      constraint storage_plugin_storage_bucket_fkey
+        references storage_plugin_storage_bucket (public_id)
+        on delete set null
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint environment_project_id_name_uq
+      unique(project_id, name),
+    constraint environment_project_id_public_id_uq
+      unique(project_id, public_id)
+  );
+  comment on table environment is
+    'environment is a table where each row is an environment of a project. '
+    'An environment groups targets and provides default settings for them.';
+
+  create trigger update_version_column after update on environment
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on environment
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on environment
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on environment
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'create_time');
+
+  -- validate_target_storage_bucket is defined in 71/07_targets.up.sql. It
+  -- only uses the project_id, storage_bucket_id and enable_session_recording
+  -- columns, which environments share with targets.
+  create trigger validate_environment_storage_bucket after insert or update on environment
+    for each row execute procedure validate_target_storage_bucket();
+
+  -- target_environment associates a target with the environment it belongs
+  -- to. A target belongs to at most one environment, in the same project.
+  create table target_environment (
+    project_id wt_scope_id not null,
+    target_id wt_public_id primary key,
+    environment_id wt_public_id not null,
+    create_time wt_timestamp,
+    constraint target_fkey
+      foreign key (project_id, target_id)
+        references target (project_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint environment_fkey
+      foreign key (project_id, environment_id)
+        references environment (project_id, public_id)
+        on delete cascade
+        on update cascade
+  );
+  comment on table target_environment is
+    'target_environment is a table where each row associates a target with its environment.';
+
+  create index target_environment_environment_id_idx
+    on target_environment (environment_id);
+
+  -- insert_project_id is defined in 46/03_targets.up.sql.
+  create trigger insert_target_environment before insert on target_environment
+    for each row execute procedure insert_project_id();
+
+  create trigger default_create_time_column before insert on target_environment
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on target_environment
+    for each row execute procedure immutable_columns('project_id', 'target_id', 'create_time');
+
+  insert into oplog_ticket (name, version)
+  values
+    ('environment', 1);
+
+commit;
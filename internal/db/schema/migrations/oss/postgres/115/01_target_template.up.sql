@@ -0,0 +1,50 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- A target template captures the full definition of a target, which is
+  -- instantiated with parameter substitution to create new targets in the
+  -- project. The definition is stored as the JSON encoding of the API target
+  -- resource and is opaque to the database.
+  create table target_template (
+    public_id wt_public_id primary key,
+    project_id wt_scope_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    definition text not null
+      constraint definition_must_not_be_empty
+        check(length(trim(definition)) > 0)
+      constraint definition_must_not_be_too_long
+        check(length(definition) <= 65536),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint target_template_project_id_name_uq
+      unique(project_id, name)
+  );
+  comment on table target_template is
+    'target_template is a table where each row is a template of a project '
+    'from which targets are created.';
+
+  create trigger update_version_column after update on target_template
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on target_template
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on target_template
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on target_template
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'create_time');
+
+  insert into oplog_ticket (name, version)
+  values
+    ('target_template', 1);
+
+commit;
@@ -0,0 +1,67 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- A target rule of a plugin host catalog creates a target from a target
+  -- template for each host set of the catalog matching the rule's filter.
+  create table host_plugin_catalog_target_rule (
+    catalog_id wt_public_id not null
+      constraint host_plugin_catalog_fkey
+        references host_plugin_catalog (public_id)
+        on delete cascade
+        on update cascade,
+    target_template_id wt_public_id not null
+      constraint target_template_fkey
+        references target_template (public_id)
+        on delete cascade
+        on update cascade,
+    filter text not null
+      constraint filter_must_not_be_empty
+        check(length(trim(filter)) > 0),
+    create_time wt_timestamp,
+    primary key(catalog_id, target_template_id)
+  );
+  comment on table host_plugin_catalog_target_rule is
+    'host_plugin_catalog_target_rule is a table where each row is a rule of a plugin host catalog '
+    'which creates a target from a target template for each matching host set of the catalog.';
+
+  create trigger default_create_time_column before insert on host_plugin_catalog_target_rule
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on host_plugin_catalog_target_rule
+    for each row execute procedure immutable_columns('catalog_id', 'target_template_id', 'create_time');
+
+  -- host_plugin_set_generated_target records the target created by a target
+  -- rule for a host set. The target id is set to null when the target is
+  -- deleted, so that a target deleted by a user is not created again.
+  create table host_plugin_set_generated_target (
+    set_id wt_public_id not null
+      constraint host_plugin_set_fkey
+        references host_plugin_set (public_id)
+        on delete cascade
+        on update cascade,
+    target_template_id wt_public_id not null
+      constraint target_template_fkey
+        references target_template (public_id)
+        on delete cascade
+        on update cascade,
+    target_id wt_public_id
+      constraint target_fkey
+        references target (public_id)
+        on delete set null
+        on update cascade,
+    create_time wt_timestamp,
+    primary key(set_id, target_template_id)
+  );
+  comment on table host_plugin_set_generated_target is
+    'host_plugin_set_generated_target is a table where each row records the target created '
+    'from a target template by a target rule for a plugin host set.';
+
+  create trigger default_create_time_column before insert on host_plugin_set_generated_target
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on host_plugin_set_generated_target
+    for each row execute procedure immutable_columns('set_id', 'target_template_id', 'create_time');
+
+commit;
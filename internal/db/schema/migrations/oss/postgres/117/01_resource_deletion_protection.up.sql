@@ -0,0 +1,54 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- resource_deletion_protection has a row for each protected resource. The
+  -- resource_id is not a foreign key since protected resources cannot be
+  -- deleted: the row must be deleted first.
+  create table resource_deletion_protection (
+    resource_id wt_public_id primary key,
+    create_time wt_timestamp
+  );
+  comment on table resource_deletion_protection is
+    'resource_deletion_protection is a table where each row protects a scope, target, auth method or storage bucket from deletion.';
+
+  create trigger immutable_columns before update on resource_deletion_protection
+    for each row execute procedure immutable_columns('resource_id', 'create_time');
+
+  create trigger default_create_time_column before insert on resource_deletion_protection
+    for each row execute procedure default_create_time();
+
+  -- prevent_protected_deletion raises error code 23604 when the deleted row is
+  -- protected from deletion. Since it runs for rows deleted by a cascade as
+  -- well, deleting a scope fails if any resource within it is protected.
+  create function prevent_protected_deletion() returns trigger
+  as $$
+  begin
+    perform from resource_deletion_protection
+     where resource_id = old.public_id;
+    if found then
+      raise exception 'deletion_protection_violation: % is protected from deletion', old.public_id using
+        errcode = '23604',
+        schema = tg_table_schema,
+        table = tg_table_name;
+    end if;
+    return old;
+  end;
+  $$ language plpgsql;
+  comment on function prevent_protected_deletion() is
+    'prevent_protected_deletion is a before delete trigger function which prevents the deletion of resources with a row in resource_deletion_protection.';
+
+  create trigger prevent_protected_deletion before delete on iam_scope
+    for each row execute procedure prevent_protected_deletion();
+
+  create trigger prevent_protected_deletion before delete on target
+    for each row execute procedure prevent_protected_deletion();
+
+  create trigger prevent_protected_deletion before delete on auth_method
+    for each row execute procedure prevent_protected_deletion();
+
+  create trigger prevent_protected_deletion before delete on storage_plugin_storage_bucket
+    for each row execute procedure prevent_protected_deletion();
+
+commit;
@@ -0,0 +1,100 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- TCP targets can pass the TLS connections of clients through to the
+  -- endpoint without the worker terminating them, so that endpoints which
+  -- authenticate clients by their certificates see the client's own
+  -- certificate. Passthrough connections can be routed by the server name the
+  -- client sends (SNI), restricted to a comma separated list of server name
+  -- patterns such as '*.db.example.com'. The patterns are validated by the
+  -- controller.
+  alter table target_tcp
+    add column tls_passthrough boolean not null default false,
+    add column tls_server_names text
+      constraint tls_server_names_must_not_be_empty
+        check(length(trim(tls_server_names)) > 0),
+    add constraint tls_server_names_require_tls_passthrough
+      check(tls_server_names is null or tls_passthrough);
+
+  comment on column target_tcp.tls_passthrough is
+    'tls_passthrough is true if the TLS connections of clients are passed through to the endpoint without being terminated by the worker.';
+  comment on column target_tcp.tls_server_names is
+    'tls_server_names is a comma separated list of server name patterns used to route TLS passthrough connections by the server name sent by the client.';
+
+  -- Replaces target_all_subtypes defined in 107/01_target_allowed_ports.up.sql
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    locality,
+    authorization_token_ttl_seconds,
+    authorization_token_single_use,
+    preferred_endpoint,
+    allowed_ports,
+    tls_passthrough,
+    tls_server_names
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    locality,
+    authorization_token_ttl_seconds,
+    authorization_token_single_use,
+    preferred_endpoint,
+    allowed_ports,
+    false as tls_passthrough,
+    null as tls_server_names
+  from
+    target_ssh;
+
+  -- tls_passthrough and tls_server_names are copied from the target when the
+  -- session is authorized so that the worker can enforce them.
+  alter table session
+    add column tls_passthrough boolean not null default false,
+    add column tls_server_names text;
+  comment on column session.tls_passthrough is
+    'tls_passthrough is true if the worker passes the TLS connections of the session through to the endpoint.';
+  comment on column session.tls_server_names is
+    'tls_server_names is the set of server name patterns used to route TLS passthrough connections of the session.';
+
+  -- Replaces trigger from 107/01_target_allowed_ports.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit',
+    'create_time', 'endpoint', 'worker_filter', 'egress_worker_filter', 'ingress_worker_filter', 'correlation_id',
+    'authorization_expiration_time', 'allowed_ports', 'tls_passthrough', 'tls_server_names');
+
+commit;
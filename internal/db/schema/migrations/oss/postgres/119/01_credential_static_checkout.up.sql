@@ -0,0 +1,99 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table credential_static_checkout_policy (
+    credential_id wt_public_id primary key
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    ttl_seconds integer not null
+      constraint ttl_seconds_must_be_positive
+        check(ttl_seconds > 0),
+    queue_timeout_seconds integer not null default 0
+      constraint queue_timeout_seconds_must_not_be_negative
+        check(queue_timeout_seconds >= 0),
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+  comment on table credential_static_checkout_policy is
+    'credential_static_checkout_policy contains the exclusive checkout policy '
+    'of a static credential. A static credential with a policy can only be '
+    'held by one session at a time.';
+
+  create trigger update_time_column before update on credential_static_checkout_policy
+    for each row execute procedure update_time_column();
+  create trigger default_create_time_column before insert on credential_static_checkout_policy
+    for each row execute procedure default_create_time();
+  create trigger immutable_columns before update on credential_static_checkout_policy
+    for each row execute procedure immutable_columns('credential_id', 'create_time');
+
+  create table credential_static_checkout (
+    credential_id wt_public_id primary key
+      constraint credential_static_checkout_policy_fkey
+        references credential_static_checkout_policy (credential_id)
+        on delete cascade
+        on update cascade,
+    session_id wt_public_id not null
+      constraint session_fkey
+        references session (public_id)
+        on delete cascade
+        on update cascade,
+    user_id wt_user_id,
+    checkout_time wt_timestamp,
+    expiration_time timestamp with time zone not null
+  );
+  comment on table credential_static_checkout is
+    'credential_static_checkout contains the session holding a static '
+    'credential with an exclusive checkout policy. The credential is checked '
+    'in when the row is deleted or its expiration time has passed.';
+
+  create index credential_static_checkout_session_id_ix
+    on credential_static_checkout (session_id);
+
+  create trigger immutable_columns before update on credential_static_checkout
+    for each row execute procedure immutable_columns('credential_id', 'session_id', 'user_id', 'checkout_time');
+
+  create table credential_static_checkout_queue (
+    credential_id wt_public_id not null
+      constraint credential_static_checkout_policy_fkey
+        references credential_static_checkout_policy (credential_id)
+        on delete cascade
+        on update cascade,
+    user_id wt_user_id
+      constraint iam_user_fkey
+        references iam_user (public_id)
+        on delete cascade
+        on update cascade,
+    queue_time wt_timestamp,
+    expiration_time timestamp with time zone not null,
+    primary key(credential_id, user_id)
+  );
+  comment on table credential_static_checkout_queue is
+    'credential_static_checkout_queue contains the users waiting for a checked '
+    'out static credential. The user queued first is the next one able to '
+    'check out the credential. A user leaves the queue when checking out the '
+    'credential or once the expiration time has passed.';
+
+  create trigger immutable_columns before update on credential_static_checkout_queue
+    for each row execute procedure immutable_columns('credential_id', 'user_id', 'queue_time');
+
+  -- check_in_static_credentials checks in the static credentials held by a
+  -- session when the session enters the canceling or terminated states.
+  create function check_in_static_credentials() returns trigger
+  as $$
+  begin
+    if new.state in ('canceling', 'terminated') then
+      delete from credential_static_checkout
+        where session_id = new.session_id;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger check_in_static_credentials after insert on session_state
+    for each row execute procedure check_in_static_credentials();
+
+commit;
@@ -0,0 +1,40 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table credential_static_checkout_policy
+    add column rotate_on_check_in boolean not null default false,
+    add column rotation_pending boolean not null default false;
+
+  comment on column credential_static_checkout_policy.rotate_on_check_in is
+    'rotate_on_check_in is true if the password of the credential is rotated '
+    'each time the credential is checked in.';
+  comment on column credential_static_checkout_policy.rotation_pending is
+    'rotation_pending is true from the time a credential rotated on check in '
+    'is checked in until its password is rotated. The credential cannot be '
+    'checked out while its rotation is pending.';
+
+  -- rotate_static_credential_on_check_in marks the rotation of a credential
+  -- as pending and makes its rotation schedule due when the credential is
+  -- checked in, if its checkout policy rotates it on check in.
+  create function rotate_static_credential_on_check_in() returns trigger
+  as $$
+  begin
+    update credential_static_checkout_policy
+       set rotation_pending = true
+     where credential_id = old.credential_id
+       and rotate_on_check_in;
+    if found then
+      update credential_static_username_password_rotation
+         set next_rotation_time = now()
+       where credential_id = old.credential_id;
+    end if;
+    return old;
+  end;
+  $$ language plpgsql;
+
+  create trigger rotate_static_credential_on_check_in after delete on credential_static_checkout
+    for each row execute procedure rotate_static_credential_on_check_in();
+
+commit;
@@ -0,0 +1,104 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table target_recording_parameters (
+    target_id wt_public_id primary key
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    compression text not null default 'gzip'
+      constraint compression_must_be_known
+        check(compression in ('none', 'gzip', 'zstd')),
+    compression_level integer not null default 0
+      constraint compression_level_must_be_between_0_and_22
+        check(compression_level between 0 and 22),
+    sample_percent integer not null default 100
+      constraint sample_percent_must_be_between_1_and_100
+        check(sample_percent between 1 and 100),
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+  comment on table target_recording_parameters is
+    'target_recording_parameters contains the parameters workers use to record '
+    'the sessions of a target. They are pushed to the workers in the response '
+    'to their status requests.';
+
+  create trigger update_time_column before update on target_recording_parameters
+    for each row execute procedure update_time_column();
+  create trigger default_create_time_column before insert on target_recording_parameters
+    for each row execute procedure default_create_time();
+  create trigger immutable_columns before update on target_recording_parameters
+    for each row execute procedure immutable_columns('target_id', 'create_time');
+
+  create table target_recording_redaction_rule (
+    target_id wt_public_id not null
+      constraint target_recording_parameters_fkey
+        references target_recording_parameters (target_id)
+        on delete cascade
+        on update cascade,
+    pattern text not null
+      constraint pattern_must_not_be_empty
+        check(length(trim(pattern)) > 0),
+    primary key(target_id, pattern)
+  );
+  comment on table target_recording_redaction_rule is
+    'target_recording_redaction_rule contains the regular expressions matching '
+    'the data workers redact from the recordings of the sessions of a target.';
+
+  create trigger immutable_columns before update on target_recording_redaction_rule
+    for each row execute procedure immutable_columns('target_id', 'pattern');
+
+  -- target_recording_parameters_version is a single row table containing the
+  -- version of the recording parameters of all targets. Workers report the
+  -- version they applied in their status requests, and are sent the recording
+  -- parameters again when it is not the current version.
+  create table target_recording_parameters_version (
+    singleton boolean primary key default true
+      constraint only_one_row
+        check(singleton),
+    version bigint not null default 1,
+    update_time wt_timestamp
+  );
+  comment on table target_recording_parameters_version is
+    'target_recording_parameters_version contains the version of the recording '
+    'parameters of all targets, which is incremented each time they change.';
+
+  insert into target_recording_parameters_version (version) values (1);
+
+  create trigger update_time_column before update on target_recording_parameters_version
+    for each row execute procedure update_time_column();
+
+  create function increment_target_recording_parameters_version() returns trigger
+  as $$
+  begin
+    update target_recording_parameters_version
+       set version = version + 1;
+    return null;
+  end;
+  $$ language plpgsql;
+  comment on function increment_target_recording_parameters_version is
+    'increment_target_recording_parameters_version increments the version of '
+    'the recording parameters once per statement changing them.';
+
+  create trigger increment_target_recording_parameters_version after insert or update or delete on target_recording_parameters
+    for each statement execute procedure increment_target_recording_parameters_version();
+  create trigger increment_target_recording_parameters_version after insert or update or delete on target_recording_redaction_rule
+    for each statement execute procedure increment_target_recording_parameters_version();
+
+  create table server_worker_recording_parameters_ack (
+    worker_id wt_public_id primary key
+      constraint server_worker_fkey
+        references server_worker (public_id)
+        on delete cascade
+        on update cascade,
+    version bigint not null,
+    ack_time wt_timestamp
+  );
+  comment on table server_worker_recording_parameters_ack is
+    'server_worker_recording_parameters_ack contains the last version of the '
+    'recording parameters each worker acknowledged having applied.';
+
+commit;
@@ -50,6 +50,7 @@ begin;
     'The view returns a separate row for each active token in Vault (current, maintaining and revoke tokens); this view should only be used for token renewal and revocation. '
     'Each row may contain encrypted data. This view should not be used to retrieve data which will be returned external to boundary.';
 
+  -- Replaced in 98/01_vault_cert_auth_library_namespace.up.sql
   create view credential_vault_store_list_lookup as
   select store.public_id                   as public_id,
          store.project_id                  as project_id,
@@ -168,6 +169,7 @@ begin;
     'This view should only be used when issuing credentials from a Vault credential library. Each row may contain encrypted data. '
     'This view should not be used to retrieve data which will be returned external to boundary.';
 
+  -- Replaced in 98/01_vault_cert_auth_library_namespace.up.sql
   create view credential_vault_library_list_lookup as
   with
     password_override (library_id, username_attribute, password_attribute) as (
@@ -166,6 +166,7 @@ begin;
   comment on view host_plugin_catalog_with_secret is
     'host plugin catalog with its associated persisted data';
   
+  -- Replaced in 98/01_vault_cert_auth_library_namespace.up.sql
   create view credential_vault_store_client as
   select store.public_id                   as public_id,
          store.project_id                  as project_id,
@@ -252,6 +253,7 @@ begin;
     'This view should only be used when issuing credentials from a Vault credential library. Each row may contain encrypted data. '
     'This view should not be used to retrieve data which will be returned external to boundary.';
 
+  -- Replaced in 98/01_vault_cert_auth_library_namespace.up.sql
   create view credential_vault_token_renewal_revocation as
   with
     tokens as (
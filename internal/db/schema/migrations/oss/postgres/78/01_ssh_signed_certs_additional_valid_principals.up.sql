@@ -5,6 +5,7 @@ begin;
 
   alter table credential_vault_ssh_cert_library add column additional_valid_principals text null;
 
+  -- Replaced in 98/01_vault_cert_auth_library_namespace.up.sql
   -- Replaces view from 63/02_add_ssh_cert_to_vault_cred_library_view.up.sql
   drop view credential_vault_library_issue_credentials;
   create view credential_vault_library_issue_credentials as
@@ -12,6 +12,7 @@ begin;
     for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit',
     'create_time', 'endpoint', 'worker_filter', 'egress_worker_filter', 'ingress_worker_filter', 'correlation_id');
 
+  -- Replaced in 98/01_vault_cert_auth_library_namespace.up.sql
   -- Replaces view from 56/02_add_data_key_foreign_key_references.up.sql
   drop view credential_vault_credential_private;
   create view credential_vault_credential_private as
@@ -0,0 +1,30 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- List tokens are not stored by the controller, so a revoked token is
+  -- identified by a fingerprint derived from the fields that are constant for
+  -- the lifetime of the token: its create time, resource type and grants hash.
+  -- List tokens expire 30 days after their creation, so rows whose
+  -- token_create_time is older than that can be removed.
+  create table list_token_revocation (
+    fingerprint bytea primary key
+      constraint fingerprint_must_be_32_bytes
+        check(length(fingerprint) = 32),
+    resource_type text not null
+      constraint resource_type_must_not_be_empty
+        check(length(trim(resource_type)) > 0),
+    token_create_time timestamp with time zone not null,
+    create_time wt_timestamp
+  );
+  comment on table list_token_revocation is
+    'list_token_revocation is a table where each row represents a list token that was revoked and must no longer be accepted by list endpoints.';
+
+  create trigger default_create_time_column before insert on list_token_revocation
+    for each row execute procedure default_create_time();
+
+  create index list_token_revocation_token_create_time_ix
+    on list_token_revocation (token_create_time);
+
+commit;
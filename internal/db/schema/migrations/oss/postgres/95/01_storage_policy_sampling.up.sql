@@ -0,0 +1,56 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- Storage policies can limit session recording to a percentage of sessions
+  -- and/or to sessions matching a filter.
+  alter table policy_storage_policy
+    add column record_percent integer not null default 100
+      constraint record_percent_between_1_and_100
+        check(record_percent between 1 and 100),
+    add column record_filter text
+      constraint record_filter_not_empty
+        check(length(trim(record_filter)) > 0);
+
+  comment on column policy_storage_policy.record_percent is
+    'record_percent is the percentage of sessions that are recorded.';
+  comment on column policy_storage_policy.record_filter is
+    'record_filter is an optional boolean expression that sessions must match to be recorded.';
+
+  -- recording_skip_reason is set when a session of a target with session
+  -- recording enabled was not recorded because of the sampling settings of the
+  -- storage policy.
+  alter table session
+    add column recording_skip_reason text
+      constraint recording_skip_reason_valid
+        check(recording_skip_reason in ('sampled out', 'filtered out'));
+
+  -- Replaces view from 92/02_session_state_tstzrange.up.sql
+  -- Adds the recording_skip_reason column.
+  drop view session_list;
+  create view session_list as
+      select s.public_id,
+             s.user_id,
+             shsh.host_id,
+             shsh.host_set_id,
+             s.target_id,
+             s.auth_token_id,
+             s.project_id,
+             s.certificate,
+             s.expiration_time,
+             s.termination_reason,
+             s.recording_skip_reason,
+             s.create_time,
+             s.update_time,
+             s.version,
+             s.endpoint,
+             s.connection_limit,
+             ss.state,
+             lower(ss.active_time_range) as start_time,
+             upper(ss.active_time_range) as end_time
+        from session s
+        join session_state            ss on s.public_id = ss.session_id
+   left join session_host_set_host  shsh on s.public_id = shsh.session_id;
+
+commit;
@@ -0,0 +1,59 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table storage_plugin_storage_bucket_usage (
+    storage_bucket_id wt_public_id primary key
+      constraint storage_plugin_storage_bucket_fkey
+        references storage_plugin_storage_bucket (public_id)
+        on delete cascade
+        on update cascade,
+    bytes bigint not null default 0
+      constraint bytes_zero_or_positive
+        check(bytes >= 0),
+    object_count bigint not null default 0
+      constraint object_count_zero_or_positive
+        check(object_count >= 0),
+    update_time wt_timestamp
+  );
+  comment on table storage_plugin_storage_bucket_usage is
+    'storage_plugin_storage_bucket_usage contains the cumulative size and number '
+    'of the objects written to a storage bucket for session recordings.';
+
+  create trigger update_time_column before update on storage_plugin_storage_bucket_usage
+    for each row execute procedure update_time_column();
+
+  create table storage_plugin_storage_bucket_quota (
+    storage_bucket_id wt_public_id primary key
+      constraint storage_plugin_storage_bucket_fkey
+        references storage_plugin_storage_bucket (public_id)
+        on delete cascade
+        on update cascade,
+    max_bytes bigint not null default 0
+      constraint max_bytes_zero_or_positive
+        check(max_bytes >= 0),
+    max_objects bigint not null default 0
+      constraint max_objects_zero_or_positive
+        check(max_objects >= 0),
+    warning_threshold_percent integer not null default 80
+      constraint warning_threshold_percent_between_1_and_100
+        check(warning_threshold_percent between 1 and 100),
+    block_when_exceeded boolean not null default false,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    constraint max_bytes_or_max_objects_set
+      check(max_bytes > 0 or max_objects > 0)
+  );
+  comment on table storage_plugin_storage_bucket_quota is
+    'storage_plugin_storage_bucket_quota contains the limits on the storage used '
+    'by session recordings in a storage bucket. A limit of zero means no limit.';
+
+  create trigger update_time_column before update on storage_plugin_storage_bucket_quota
+    for each row execute procedure update_time_column();
+  create trigger default_create_time_column before insert on storage_plugin_storage_bucket_quota
+    for each row execute procedure default_create_time();
+  create trigger immutable_columns before update on storage_plugin_storage_bucket_quota
+    for each row execute procedure immutable_columns('storage_bucket_id', 'create_time');
+
+commit;
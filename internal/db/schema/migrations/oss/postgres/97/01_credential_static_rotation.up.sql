@@ -0,0 +1,43 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table credential_static_username_password_rotation (
+    credential_id wt_public_id primary key
+      constraint credential_static_username_password_credential_fkey
+        references credential_static_username_password_credential (public_id)
+        on delete cascade
+        on update cascade,
+    rotator text not null
+      constraint rotator_must_not_be_empty
+        check(length(trim(rotator)) > 0),
+    address text not null
+      constraint address_must_not_be_empty
+        check(length(trim(address)) > 0),
+    database_name text not null default '',
+    interval_seconds integer not null
+      constraint interval_seconds_must_be_positive
+        check(interval_seconds > 0),
+    last_rotated_time timestamp with time zone,
+    next_rotation_time wt_timestamp not null,
+    last_rotation_error text not null default '',
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+  comment on table credential_static_username_password_rotation is
+    'credential_static_username_password_rotation contains the schedule on '
+    'which the password of a static username password credential is rotated '
+    'in the database it authenticates to, and the outcome of the last rotation.';
+
+  create index credential_static_username_password_rotation_next_rotation_time_ix
+    on credential_static_username_password_rotation (next_rotation_time);
+
+  create trigger update_time_column before update on credential_static_username_password_rotation
+    for each row execute procedure update_time_column();
+  create trigger default_create_time_column before insert on credential_static_username_password_rotation
+    for each row execute procedure default_create_time();
+  create trigger immutable_columns before update on credential_static_username_password_rotation
+    for each row execute procedure immutable_columns('credential_id', 'create_time');
+
+commit;
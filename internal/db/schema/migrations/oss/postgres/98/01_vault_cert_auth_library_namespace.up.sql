@@ -0,0 +1,358 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- A credential store using TLS certificate auth logs in to Vault with its
+  -- client certificate to get its token, and logs in again when the token can
+  -- no longer be renewed.
+  alter table credential_vault_store
+    add column cert_auth_mount_path text
+      constraint cert_auth_mount_path_must_not_be_empty
+        check(length(trim(cert_auth_mount_path)) > 0),
+    add column cert_auth_role_name text
+      constraint cert_auth_role_name_must_not_be_empty
+        check(length(trim(cert_auth_role_name)) > 0),
+    add constraint cert_auth_role_name_requires_cert_auth_mount_path
+      check(cert_auth_role_name is null or cert_auth_mount_path is not null);
+
+  -- A credential library can request credentials from a different namespace
+  -- than the one of its credential store.
+  alter table credential_vault_library
+    add column namespace text
+      constraint namespace_must_not_be_empty
+        check(length(trim(namespace)) > 0);
+
+  alter table credential_vault_ssh_cert_library
+    add column namespace text
+      constraint namespace_must_not_be_empty
+        check(length(trim(namespace)) > 0);
+
+  drop view credential_vault_library_issue_credentials;
+  drop view credential_vault_store_client;
+
+  -- Replaces view from 56/02_add_data_key_foreign_key_references.up.sql
+  create view credential_vault_store_client as
+  select store.public_id                   as public_id,
+         store.project_id                  as project_id,
+         store.vault_address               as vault_address,
+         store.namespace                   as namespace,
+         store.ca_cert                     as ca_cert,
+         store.tls_server_name             as tls_server_name,
+         store.tls_skip_verify             as tls_skip_verify,
+         store.worker_filter               as worker_filter,
+         store.cert_auth_mount_path        as cert_auth_mount_path,
+         store.cert_auth_role_name         as cert_auth_role_name,
+         token.token                       as ct_token, -- encrypted
+         token.token_hmac                  as token_hmac,
+         coalesce(token.status, 'expired') as token_status,
+         token.key_id                      as token_key_id,
+         cert.certificate                  as client_cert,
+         cert.certificate_key              as ct_client_key, -- encrypted
+         cert.key_id                       as client_key_id
+    from credential_vault_store store
+    left join credential_vault_token token
+      on store.public_id = token.store_id
+     and token.status = 'current'
+    left join credential_vault_client_certificate cert
+      on store.public_id = cert.store_id
+   where store.delete_time is null;
+  comment on view credential_vault_store_client is
+    'credential_vault_store_client is a view where each row contains a credential store and the credential store''s data needed to connect to Vault. '
+    'The view returns the current token for the store, if the Vault token has expired this view will return an empty token_hmac and a token_status of ''expired''  '
+    'Each row may contain encrypted data. This view should not be used to retrieve data which will be returned external to boundary.';
+
+  -- Replaces view from 78/01_ssh_signed_certs_additional_valid_principals.up.sql
+  -- Replaced in 99/01_vault_library_kv_version.up.sql
+  create view credential_vault_library_issue_credentials as
+  with
+    password_override (library_id, username_attribute, password_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(password_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_username_password_mapping_override
+    ),
+    ssh_private_key_override (library_id, username_attribute, private_key_attribute, private_key_passphrase_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_passphrase_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_ssh_private_key_mapping_override
+    )
+  select library.public_id    as public_id,
+    library.store_id          as store_id,
+    library.name              as name,
+    library.description       as description,
+    library.create_time       as create_time,
+    library.update_time       as update_time,
+    library.version           as version,
+    library.vault_path        as vault_path,
+    library.http_method       as http_method,
+    library.http_request_body as http_request_body,
+    library.credential_type   as credential_type,
+    null                      as key_type,
+    null                      as key_bits,
+    null                      as username,
+    null                      as ttl,
+    null                      as key_id,
+    null                      as critical_options,
+    null                      as extensions,
+    store.project_id          as project_id,
+    store.vault_address       as vault_address,
+    coalesce(library.namespace, store.namespace)
+                              as namespace,
+    store.ca_cert             as ca_cert,
+    store.tls_server_name     as tls_server_name,
+    store.tls_skip_verify     as tls_skip_verify,
+    store.worker_filter       as worker_filter,
+    store.ct_token            as ct_token, -- encrypted
+    store.token_hmac          as token_hmac,
+    store.token_status        as token_status,
+    store.token_key_id        as token_key_id,
+    store.client_cert         as client_cert,
+    store.ct_client_key       as ct_client_key, -- encrypted
+    store.client_key_id       as client_key_id,
+    coalesce(upasso.username_attribute,sshpk.username_attribute)
+      as username_attribute,
+    upasso.password_attribute              as password_attribute,
+    sshpk.private_key_attribute            as private_key_attribute,
+    sshpk.private_key_passphrase_attribute as private_key_passphrase_attribute,
+    'generic'                              as cred_lib_type, -- used to switch on
+    null                                   as additional_valid_principals
+    from credential_vault_library library
+    join credential_vault_store_client store
+      on library.store_id = store.public_id
+    left join password_override upasso
+      on library.public_id = upasso.library_id
+    left join ssh_private_key_override sshpk
+      on library.public_id = sshpk.library_id
+  union
+  select library.public_id      as public_id,
+    library.store_id            as store_id,
+    library.name                as name,
+    library.description         as description,
+    library.create_time         as create_time,
+    library.update_time         as update_time,
+    library.version             as version,
+    library.vault_path          as vault_path,
+    null                        as http_method,
+    null                        as http_request_body,
+    library.credential_type     as credential_type,
+    library.key_type            as key_type,
+    library.key_bits            as key_bits,
+    library.username            as username,
+    library.ttl                 as ttl,
+    library.key_id              as key_id,
+    library.critical_options    as critical_options,
+    library.extensions          as extensions,
+    store.project_id            as project_id,
+    store.vault_address         as vault_address,
+    coalesce(library.namespace, store.namespace)
+                                as namespace,
+    store.ca_cert               as ca_cert,
+    store.tls_server_name       as tls_server_name,
+    store.tls_skip_verify       as tls_skip_verify,
+    store.worker_filter         as worker_filter,
+    store.ct_token              as ct_token, -- encrypted
+    store.token_hmac            as token_hmac,
+    store.token_status          as token_status,
+    store.token_key_id          as token_key_id,
+    store.client_cert           as client_cert,
+    store.ct_client_key         as ct_client_key, -- encrypted
+    store.client_key_id         as client_key_id,
+    null                        as username_attribute,
+    null                        as password_attribute,
+    null                        as private_key_attribute,
+    null                        as private_key_passphrase_attribute,
+    'ssh-signed-cert'           as cred_lib_type, -- used to switch on
+    additional_valid_principals as additional_valid_principals
+    from credential_vault_ssh_cert_library library
+    join credential_vault_store_client store
+      on library.store_id = store.public_id;
+  comment on view credential_vault_library_issue_credentials is
+    'credential_vault_library_issue_credentials is a view where each row contains a credential library and the credential library''s data needed to connect to Vault. '
+    'This view should only be used when issuing credentials from a Vault credential library. Each row may contain encrypted data. '
+    'This view should not be used to retrieve data which will be returned external to boundary.';
+
+  -- Replaces view from 56/02_add_data_key_foreign_key_references.up.sql
+  drop view credential_vault_token_renewal_revocation;
+  create view credential_vault_token_renewal_revocation as
+  with
+    tokens as (
+      select token, -- encrypted
+             token_hmac,
+             store_id,
+             -- renewal time is the midpoint between the last renewal time and the expiration time
+             last_renewal_time + (expiration_time - last_renewal_time) / 2 as renewal_time,
+             key_id,
+             status
+      from credential_vault_token
+     where status in ('current', 'maintaining', 'revoke')
+    )
+  select store.public_id            as public_id,
+         store.project_id           as project_id,
+         store.vault_address        as vault_address,
+         store.namespace            as namespace,
+         store.ca_cert              as ca_cert,
+         store.tls_server_name      as tls_server_name,
+         store.tls_skip_verify      as tls_skip_verify,
+         store.worker_filter        as worker_filter,
+         store.cert_auth_mount_path as cert_auth_mount_path,
+         store.cert_auth_role_name  as cert_auth_role_name,
+         store.delete_time          as delete_time,
+         token.token                as ct_token, -- encrypted
+         token.token_hmac           as token_hmac,
+         token.renewal_time         as token_renewal_time,
+         token.key_id               as token_key_id,
+         token.status               as token_status,
+         cert.certificate           as client_cert,
+         cert.certificate_key       as ct_client_key, -- encrypted
+         cert.key_id                as client_key_id
+  from credential_vault_store store
+  join tokens token
+    on store.public_id = token.store_id
+  left join credential_vault_client_certificate cert
+    on store.public_id = cert.store_id;
+  comment on view credential_vault_token_renewal_revocation is
+    'credential_vault_token_renewal_revocation is a view where each row contains a credential store and the credential store''s data needed to connect to Vault. '
+    'The view returns a separate row for each active token in Vault (current, maintaining and revoke tokens); this view should only be used for token renewal and revocation. '
+    'Each row may contain encrypted data. This view should not be used to retrieve data which will be returned external to boundary.';
+
+  -- Replaces view from 49/01_vault_credentials.up.sql
+  drop view credential_vault_store_list_lookup;
+  create view credential_vault_store_list_lookup as
+  select store.public_id                   as public_id,
+         store.project_id                  as project_id,
+         store.name                        as name,
+         store.description                 as description,
+         store.create_time                 as create_time,
+         store.update_time                 as update_time,
+         store.delete_time                 as delete_time,
+         store.version                     as version,
+         store.vault_address               as vault_address,
+         store.namespace                   as namespace,
+         store.ca_cert                     as ca_cert,
+         store.tls_server_name             as tls_server_name,
+         store.tls_skip_verify             as tls_skip_verify,
+         store.worker_filter               as worker_filter,
+         store.cert_auth_mount_path        as cert_auth_mount_path,
+         store.cert_auth_role_name         as cert_auth_role_name,
+         token.token_hmac                  as token_hmac,
+         coalesce(token.status, 'expired') as token_status,
+         cert.certificate                  as client_cert,
+         cert.certificate_key_hmac         as client_cert_key_hmac
+    from credential_vault_store store
+    left join credential_vault_token token
+      on store.public_id = token.store_id
+     and token.status = 'current'
+    left join credential_vault_client_certificate cert
+      on store.public_id = cert.store_id
+   where store.delete_time is null;
+  comment on view credential_vault_store_list_lookup is
+    'credential_vault_store_list_lookup is a view where each row contains a credential store. '
+    'If the Vault token has expired this view will return an empty token_hmac and a token_status of ''expired'' '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+  -- Replaces view from 49/01_vault_credentials.up.sql
+  drop view credential_vault_library_list_lookup;
+  -- Replaced in 99/01_vault_library_kv_version.up.sql
+  create view credential_vault_library_list_lookup as
+  with
+    password_override (library_id, username_attribute, password_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(password_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_username_password_mapping_override
+    ),
+    ssh_private_key_override (library_id, username_attribute, private_key_attribute, private_key_passphrase_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_passphrase_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_ssh_private_key_mapping_override
+    )
+  select library.public_id         as public_id,
+         library.store_id          as store_id,
+         library.name              as name,
+         library.description       as description,
+         library.create_time       as create_time,
+         library.update_time       as update_time,
+         library.version           as version,
+         library.vault_path        as vault_path,
+         library.http_method       as http_method,
+         library.http_request_body as http_request_body,
+         library.credential_type   as credential_type,
+         library.namespace         as namespace,
+         coalesce(upasso.username_attribute,sshpk.username_attribute)
+                                   as username_attribute,
+         upasso.password_attribute              as password_attribute,
+         sshpk.private_key_attribute            as private_key_attribute,
+         sshpk.private_key_passphrase_attribute as private_key_passphrase_attribute
+    from credential_vault_library library
+    left join password_override upasso
+      on library.public_id = upasso.library_id
+    left join ssh_private_key_override sshpk
+      on library.public_id = sshpk.library_id;
+  comment on view credential_vault_library_list_lookup is
+    'credential_vault_library_list_lookup is a view where each row contains a credential library and any of library''s credential mapping overrides. '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+  -- Replaces view from 87/01_session.up.sql
+  -- Credentials are renewed and revoked in the namespace of the library that
+  -- issued them. If the library has been deleted, the namespace of the store
+  -- is used.
+  drop view credential_vault_credential_private;
+  create view credential_vault_credential_private as
+  select credential.public_id         as public_id,
+         credential.library_id        as library_id,
+         credential.session_id        as session_id,
+         credential.create_time       as create_time,
+         credential.update_time       as update_time,
+         credential.version           as version,
+         credential.external_id       as external_id,
+         credential.last_renewal_time as last_renewal_time,
+         credential.expiration_time   as expiration_time,
+         credential.is_renewable      as is_renewable,
+         credential.status            as status,
+         credential.last_renewal_time + (credential.expiration_time - credential.last_renewal_time) / 2 as renewal_time,
+         token.token_hmac             as token_hmac,
+         token.token                  as ct_token, -- encrypted
+         token.create_time            as token_create_time,
+         token.update_time            as token_update_time,
+         token.last_renewal_time      as token_last_renewal_time,
+         token.expiration_time        as token_expiration_time,
+         token.key_id                 as token_key_id,
+         token.status                 as token_status,
+         store.project_id             as project_id,
+         store.vault_address          as vault_address,
+         coalesce(library.namespace, ssh_library.namespace, store.namespace)
+                                      as namespace,
+         store.ca_cert                as ca_cert,
+         store.tls_server_name        as tls_server_name,
+         store.tls_skip_verify        as tls_skip_verify,
+         store.worker_filter          as worker_filter,
+         cert.certificate             as client_cert,
+         cert.certificate_key         as ct_client_key, -- encrypted
+         cert.certificate_key_hmac    as client_cert_key_hmac,
+         cert.key_id                  as client_key_id,
+         sess.correlation_id          as session_correlation_id
+    from credential_vault_credential credential
+    join credential_vault_token token
+      on credential.token_hmac = token.token_hmac
+    join credential_vault_store store
+      on token.store_id = store.public_id
+    left join credential_vault_library library
+      on credential.library_id = library.public_id
+    left join credential_vault_ssh_cert_library ssh_library
+      on credential.library_id = ssh_library.public_id
+    left join session sess
+      on credential.session_id = sess.public_id
+    left join credential_vault_client_certificate cert
+      on store.public_id = cert.store_id
+   where credential.expiration_time != 'infinity'::date;
+  comment on view credential_vault_credential_private is
+    'credential_vault_credential_private is a view where each row contains a credential, '
+    'the vault token used to issue the credential, and the credential store data needed to connect to Vault. '
+    'Each row may contain encrypted data. This view should not be used to retrieve data which will be returned external to boundary.';
+
+commit;
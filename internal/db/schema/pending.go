@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/db/schema/internal/provider"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// PendingMigration is a migration that has not been applied to the database.
+type PendingMigration struct {
+	Edition string
+	Version int
+	// VersionStatement is the statement that records the version of the
+	// edition when the migration is applied.
+	VersionStatement string
+	Statements       []byte
+	// HasCheck is true if the migration runs a check prior to its statements,
+	// which may fail the migration unless its repair is selected.
+	HasCheck bool
+	// RepairDescription describes the repair that is run when the check finds
+	// problems and the repair is selected.
+	RepairDescription string
+}
+
+// PendingMigrations returns the migrations ApplyMigrations would run against
+// the current schema version of the database, in the order they would be
+// run. It does not modify the database.
+func (b *Manager) PendingMigrations(ctx context.Context) ([]PendingMigration, error) {
+	const op = "schema.(Manager).PendingMigrations"
+
+	state, err := b.CurrentState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var pending []PendingMigration
+	p := provider.New(state.databaseState(), b.editions)
+	for p.Next() {
+		m := PendingMigration{
+			Edition:          p.Edition(),
+			Version:          p.Version(),
+			VersionStatement: b.driver.VersionStatement(p.Version(), p.Edition()),
+			Statements:       p.Statements(),
+		}
+		if h := p.PreHook(); h != nil {
+			m.HasCheck = true
+			m.RepairDescription = h.RepairDescription
+		}
+		pending = append(pending, m)
+	}
+	return pending, nil
+}
+
+// WriteMigrationSql writes the statements of the pending migrations to w, in
+// the order they would be run, so they can be reviewed or run offline. Like
+// ApplyMigrations, each migration's version is recorded in the same
+// transaction as its statements, which commit the transaction.
+func WriteMigrationSql(w io.Writer, pending []PendingMigration) error {
+	for _, m := range pending {
+		if _, err := fmt.Fprintf(w, "-- edition: %s, version: %d\n", m.Edition, m.Version); err != nil {
+			return err
+		}
+		if m.HasCheck {
+			if _, err := fmt.Fprintf(w, "-- this migration runs a check before its statements that cannot be exported,\n-- if it finds problems the repair is: %s\n", m.RepairDescription); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "begin;\n%s\n\n%s\n\n", m.VersionStatement, m.Statements); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package schema_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/db/schema"
+	"github.com/hashicorp/boundary/internal/db/schema/internal/edition"
+	"github.com/hashicorp/boundary/testing/dbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingMigrations(t *testing.T) {
+	dialect := dbtest.Postgres
+	ctx := context.Background()
+
+	c, u, _, err := dbtest.StartUsingTemplate(dialect, dbtest.WithTemplate(dbtest.Template1))
+	t.Cleanup(func() {
+		if err := c(); err != nil {
+			t.Fatalf("Got error at cleanup: %v", err)
+		}
+	})
+	require.NoError(t, err)
+	d, err := common.SqlOpen(dialect, u)
+	require.NoError(t, err)
+
+	oneEdition := func() edition.Edition {
+		e, _ := edition.New("one", schema.Postgres, one, 0)
+		return e
+	}()
+	twoEdition := func() edition.Edition {
+		e, _ := edition.New("two", schema.Postgres, two, 1)
+		return e
+	}()
+
+	m, err := schema.NewManager(ctx, schema.Dialect(dialect), d, schema.WithEditions(edition.Editions{oneEdition, twoEdition}))
+	require.NoError(t, err)
+	pending, err := m.PendingMigrations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, "one", pending[0].Edition)
+	assert.Equal(t, 1, pending[0].Version)
+	assert.Equal(t, "two", pending[1].Edition)
+	assert.Equal(t, 1, pending[1].Version)
+	for _, p := range pending {
+		assert.NotEmpty(t, p.Statements)
+		assert.Contains(t, p.VersionStatement, "'"+p.Edition+"'")
+	}
+
+	// The exported sql expects the version table to exist, so initialize the
+	// database with the first edition.
+	initM, err := schema.NewManager(ctx, schema.Dialect(dialect), d, schema.WithEditions(edition.Editions{oneEdition}))
+	require.NoError(t, err)
+	_, err = initM.ApplyMigrations(ctx)
+	require.NoError(t, err)
+
+	pending, err = m.PendingMigrations(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "two", pending[0].Edition)
+
+	// Listing the pending migrations must not apply them.
+	s, err := m.CurrentState(ctx)
+	require.NoError(t, err)
+	assert.False(t, s.MigrationsApplied())
+
+	var buf bytes.Buffer
+	require.NoError(t, schema.WriteMigrationSql(&buf, pending))
+
+	// Running the exported sql must leave the database in the same state as
+	// applying the migrations.
+	_, err = d.ExecContext(ctx, buf.String())
+	require.NoError(t, err)
+	s, err = m.CurrentState(ctx)
+	require.NoError(t, err)
+	assert.True(t, s.MigrationsApplied())
+
+	pending, err = m.PendingMigrations(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestWriteMigrationSql(t *testing.T) {
+	pending := []schema.PendingMigration{
+		{
+			Edition:          "oss",
+			Version:          2001,
+			VersionStatement: "select 'version';",
+			Statements:       []byte("begin;\nselect 1;\ncommit;"),
+		},
+		{
+			Edition:           "oss",
+			Version:           3001,
+			VersionStatement:  "select 'version';",
+			Statements:        []byte("begin;\nselect 2;\ncommit;"),
+			HasCheck:          true,
+			RepairDescription: "deletes the invalid rows",
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, schema.WriteMigrationSql(&buf, pending))
+	want := `-- edition: oss, version: 2001
+begin;
+select 'version';
+
+begin;
+select 1;
+commit;
+
+-- edition: oss, version: 3001
+-- this migration runs a check before its statements that cannot be exported,
+-- if it finds problems the repair is: deletes the invalid rows
+begin;
+select 'version';
+
+begin;
+select 2;
+commit;
+
+`
+	assert.Equal(t, want, buf.String())
+}
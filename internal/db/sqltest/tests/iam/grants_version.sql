@@ -0,0 +1,56 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+select plan(7);
+
+create temporary table last_grants_version as
+  select version from iam_grants_version;
+
+create temporary view grants_version_changed as
+  select (select version from iam_grants_version) <> (select version from last_grants_version) as changed;
+
+-- Statements that change no rows do not change the version.
+delete from iam_group_member_user
+ where group_id  = 'g___gg-group'
+   and member_id = 'u______nancy';
+select is((select changed from grants_version_changed), false, 'deleting no group members does not change the version');
+
+update iam_role
+   set description = 'unchanged'
+ where public_id = 'r_nonexistent';
+select is((select changed from grants_version_changed), false, 'updating no roles does not change the version');
+
+-- Updates to accounts that do not change their user do not change the version.
+update auth_account
+   set iam_user_id = iam_user_id
+ where public_id = 'apa____clare';
+select is((select changed from grants_version_changed), false, 'updating an account without changing its user does not change the version');
+
+-- Statements that change rows change the version.
+insert into iam_group_member_user
+  (group_id,       member_id)
+values
+  ('g___gg-group', 'u______nancy');
+select is((select changed from grants_version_changed), true, 'adding a group member changes the version');
+update last_grants_version set version = (select version from iam_grants_version);
+
+delete from iam_group_member_user
+ where group_id  = 'g___gg-group'
+   and member_id = 'u______nancy';
+select is((select changed from grants_version_changed), true, 'removing a group member changes the version');
+update last_grants_version set version = (select version from iam_grants_version);
+
+update iam_role
+   set description = 'changed'
+ where public_id = 'r_gg_____buy';
+select is((select changed from grants_version_changed), true, 'updating a role changes the version');
+update last_grants_version set version = (select version from iam_grants_version);
+
+update auth_account
+   set iam_user_id = 'u______cindy'
+ where public_id = 'apa____clare';
+select is((select changed from grants_version_changed), true, 'changing the user of an account changes the version');
+
+select * from finish();
+rollback;
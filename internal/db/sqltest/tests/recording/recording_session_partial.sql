@@ -0,0 +1,53 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+-- recording_session_partial tests the partial state of a recording_session
+-- and the following constraints:
+--    only_predefined_states_allowed
+--    error_details_set_iff_state_not_started
+
+begin;
+  select plan(6);
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets', 'sessions');
+
+  select is(count(*), 1::bigint) from recording_session_state_enm where name = 'partial';
+
+  insert into recording_session
+    (public_id,      storage_bucket_id, session_id,     state,     target_org_id)
+  values
+    ('sr_________1', 'sb____global',    's2_____clare', 'started', 'o_____colors');
+
+  -- Try to set state to partial without setting error_details
+  prepare update_state_to_partial_without_error as
+    update recording_session set
+      state = 'partial'
+    where public_id = 'sr_________1';
+  select throws_ok('update_state_to_partial_without_error', 23514, null, 'updating the state to ''partial'' without setting error_details succeeded');
+
+  -- Try to set state to partial while setting error_details to the no error sentinel value
+  prepare update_state_to_partial_with_sentinel_error as
+    update recording_session set
+      state = 'partial',
+      error_details = wt_to_sentinel('no error details')
+    where public_id = 'sr_________1';
+  select throws_ok('update_state_to_partial_with_sentinel_error', 23514, null, 'updating the state to ''partial'' while setting error_details to the no error sentinel value succeeded');
+
+  prepare update_state_to_partial as
+    update recording_session set
+      state = 'partial',
+      error_details = 'recording repaired: end of recording was missing'
+    where public_id = 'sr_________1';
+  select lives_ok('update_state_to_partial');
+
+  select is(state, 'partial') from recording_session where public_id = 'sr_________1';
+
+  -- Updating state again should error
+  prepare update_state_to_available as
+    update recording_session set
+      state = 'available',
+      error_details = wt_to_sentinel('no error details')
+    where public_id = 'sr_________1';
+  select throws_ok('update_state_to_available', null, null, 'updating the state away from ''partial'' succeeded');
+
+  select * from finish();
+rollback;
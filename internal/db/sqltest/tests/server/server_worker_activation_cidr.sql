@@ -0,0 +1,49 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+  select plan(5);
+
+  insert into server_worker
+    (public_id,        scope_id,   type)
+  values
+    ('w_1234567891',   'global',   'pki');
+
+  insert into server_worker_activation_cidr
+    (worker_id,        cidr)
+  values
+    ('w_1234567891',   '10.0.0.0/24'),
+    ('w_1234567891',   '2001:db8::/32');
+
+  select is(count(*), 1::bigint) from server_worker_activation_cidr where worker_id = 'w_1234567891' and '10.0.0.7'::inet <<= cidr;
+  select is(count(*), 0::bigint) from server_worker_activation_cidr where worker_id = 'w_1234567891' and '10.0.1.7'::inet <<= cidr;
+
+  prepare invalid_cidr as
+    insert into server_worker_activation_cidr
+      (worker_id,        cidr)
+    values
+      ('w_1234567891',   '10.0.0.1/24');
+  select throws_ok(
+    'invalid_cidr',
+    '22P02',
+    null,
+    'inserting a cidr with bits set to the right of the mask'
+  );
+
+  prepare duplicate_cidr as
+    insert into server_worker_activation_cidr
+      (worker_id,        cidr)
+    values
+      ('w_1234567891',   '10.0.0.0/24');
+  select throws_ok(
+    'duplicate_cidr',
+    '23505',
+    'duplicate key value violates unique constraint "server_worker_activation_cidr_pkey"',
+    'inserting a duplicate cidr for a worker'
+  );
+
+  delete from server_worker where public_id = 'w_1234567891';
+  select is(count(*), 0::bigint) from server_worker_activation_cidr where worker_id = 'w_1234567891';
+
+  select * from finish();
+rollback;
@@ -0,0 +1,50 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+  select plan(5);
+
+  insert into server_worker
+    (public_id,        scope_id,   type)
+  values
+    ('w_1234567891',   'global',   'pki'),
+    ('w_1234567892',   'global',   'pki');
+
+  insert into server_worker_additional_address
+    (worker_id,        priority,   address)
+  values
+    ('w_1234567891',   2,          'worker.mesh:9202'),
+    ('w_1234567891',   1,          '10.0.0.1:9202');
+
+  select is(additional_addresses, '10.0.0.1:9202,worker.mesh:9202') from server_worker_aggregate where public_id = 'w_1234567891';
+  select is(additional_addresses, null) from server_worker_aggregate where public_id = 'w_1234567892';
+
+  prepare comma_in_address as
+    insert into server_worker_additional_address
+      (worker_id,        priority,   address)
+    values
+      ('w_1234567892',   1,          'a:9202,b:9202');
+  select throws_ok(
+    'comma_in_address',
+    '23514',
+    'new row for relation "server_worker_additional_address" violates check constraint "address_must_not_contain_commas_or_whitespace"',
+    'inserting an address containing a comma'
+  );
+
+  prepare duplicate_address as
+    insert into server_worker_additional_address
+      (worker_id,        priority,   address)
+    values
+      ('w_1234567891',   3,          '10.0.0.1:9202');
+  select throws_ok(
+    'duplicate_address',
+    '23505',
+    'duplicate key value violates unique constraint "server_worker_additional_address_worker_id_address_uq"',
+    'inserting a duplicate address for a worker'
+  );
+
+  delete from server_worker where public_id = 'w_1234567891';
+  select is(count(*), 0::bigint) from server_worker_additional_address where worker_id = 'w_1234567891';
+
+  select * from finish();
+rollback;
@@ -0,0 +1,31 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+  select plan(3);
+
+  -- Insert valid locality values
+  insert into server_worker
+    (public_id,        scope_id,   type,   locality)
+  values
+    ('w_1234567891',   'global',   'pki',  'us-east-1'),
+    ('w_1234567892',   'global',   'pki',  null);
+
+  select is(locality, 'us-east-1') from server_worker_aggregate where public_id = 'w_1234567891';
+  select is(locality, null) from server_worker_aggregate where public_id = 'w_1234567892';
+
+  -- Try to insert an empty locality value to test constraint
+  prepare empty_locality_value as
+    insert into server_worker
+      (public_id,       scope_id,   type,   locality)
+    values
+      ('w_1234567893',  'global',   'pki',  '  ');
+  select throws_ok(
+    'empty_locality_value',
+    '23514',
+    'new row for relation "server_worker" violates check constraint "locality_must_not_be_empty"',
+    'inserting a row with an empty locality value'
+  );
+
+  select * from finish();
+rollback;
@@ -0,0 +1,45 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+  select plan(4);
+
+  insert into server_worker
+    (public_id,        scope_id,   type)
+  values
+    ('w_1234567891',   'global',   'pki');
+
+  insert into server_worker_utilization
+    (worker_id,        active_session_count,   bytes_up_per_second,   proxy_cpu_utilization)
+  values
+    ('w_1234567891',   3,                      1024,                  0.25);
+
+  select is(active_session_count, 3::bigint) from server_worker_utilization where worker_id = 'w_1234567891';
+
+  prepare negative_count as
+    update server_worker_utilization
+       set active_connection_count = -1
+     where worker_id = 'w_1234567891';
+  select throws_ok(
+    'negative_count',
+    '23514',
+    null,
+    'setting a negative active connection count'
+  );
+
+  prepare change_worker_id as
+    update server_worker_utilization
+       set worker_id = 'w_1234567892'
+     where worker_id = 'w_1234567891';
+  select throws_ok(
+    'change_worker_id',
+    '23601',
+    null,
+    'updating the immutable worker id'
+  );
+
+  delete from server_worker where public_id = 'w_1234567891';
+  select is(count(*), 0::bigint) from server_worker_utilization where worker_id = 'w_1234567891';
+
+  select * from finish();
+rollback;
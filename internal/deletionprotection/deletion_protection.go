@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package deletionprotection provides the deletion protection of scopes,
+// targets, auth methods and storage buckets. The database refuses to delete
+// a protected resource, including when the deletion cascades from one of the
+// scopes containing it, so the protection must be removed before the
+// resource can be deleted.
+package deletionprotection
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const tableName = "resource_deletion_protection"
+
+// protection is a row of the resource_deletion_protection table.
+type protection struct {
+	ResourceId string `gorm:"primary_key"`
+}
+
+// TableName returns the table name for gorm.
+func (*protection) TableName() string {
+	return tableName
+}
+
+// IsProtected returns whether the resource is protected from deletion.
+func IsProtected(ctx context.Context, r db.Reader, resourceId string) (bool, error) {
+	const op = "deletionprotection.IsProtected"
+	switch {
+	case r == nil:
+		return false, errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case resourceId == "":
+		return false, errors.New(ctx, errors.InvalidParameter, op, "missing resource id")
+	}
+	var rows []*protection
+	if err := r.SearchWhere(ctx, &rows, "resource_id = ?", []any{resourceId}, db.WithLimit(1)); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return len(rows) > 0, nil
+}
+
+// Set protects the resource from deletion, or removes its protection. Setting
+// the protection it already has is not an error.
+func Set(ctx context.Context, w db.Writer, resourceId string, protect bool) error {
+	const op = "deletionprotection.Set"
+	switch {
+	case w == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	case resourceId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing resource id")
+	}
+	query := "delete from resource_deletion_protection where resource_id = ?"
+	if protect {
+		query = "insert into resource_deletion_protection (resource_id) values (?) on conflict do nothing"
+	}
+	if _, err := w.Exec(ctx, query, []any{resourceId}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set deletion protection"))
+	}
+	return nil
+}
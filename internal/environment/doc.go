@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package environment provides the environments of projects. An environment
+// groups targets of a project, such as the targets of a staging or production
+// deployment, and provides default settings for them: a maximum session
+// lifetime, an egress worker filter and the session recording settings. The
+// targets in an environment which don't set one of these settings themselves
+// use the setting of the environment when a session is authorized, so teams
+// with many near-identical targets only need to set them once.
+package environment
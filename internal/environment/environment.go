@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package environment
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/environment/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultEnvironmentTableName = "environment"
+
+// An Environment groups targets of a project and provides default settings
+// for them. It is owned by a project.
+type Environment struct {
+	*store.Environment
+	tableName string `gorm:"-"`
+}
+
+// NewEnvironment creates a new in memory Environment in the project.
+// WithName, WithDescription, WithSessionMaxSeconds, WithEgressWorkerFilter,
+// WithEnableSessionRecording and WithStorageBucketId are the only valid
+// options; all other options are ignored.
+func NewEnvironment(ctx context.Context, projectId string, opt ...Option) (*Environment, error) {
+	const op = "environment.NewEnvironment"
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	opts := getOpts(opt...)
+	return &Environment{
+		Environment: &store.Environment{
+			ProjectId:              projectId,
+			Name:                   opts.withName,
+			Description:            opts.withDescription,
+			SessionMaxSeconds:      opts.withSessionMaxSeconds,
+			EgressWorkerFilter:     opts.withEgressWorkerFilter,
+			EnableSessionRecording: opts.withEnableSessionRecording,
+			StorageBucketId:        opts.withStorageBucketId,
+		},
+	}, nil
+}
+
+func allocEnvironment() *Environment {
+	return &Environment{
+		Environment: &store.Environment{},
+	}
+}
+
+// Clone creates a clone of the Environment.
+func (e *Environment) Clone() *Environment {
+	cp := proto.Clone(e.Environment)
+	return &Environment{
+		Environment: cp.(*store.Environment),
+	}
+}
+
+// GetResourceType returns the resource type of the Environment.
+func (e *Environment) GetResourceType() resource.Type {
+	return resource.Environment
+}
+
+// TableName returns the table name for the Environment.
+func (e *Environment) TableName() string {
+	if e.tableName != "" {
+		return e.tableName
+	}
+	return defaultEnvironmentTableName
+}
+
+// SetTableName sets the table name. If the caller attempts to set the name to
+// "" the name will be reset to the default name.
+func (e *Environment) SetTableName(n string) {
+	e.tableName = n
+}
+
+// ApplyTo returns a copy of the target, with the settings of the environment
+// the target does not set itself:
+//
+//   - The session max seconds of the environment is used if the target uses
+//     the default of target.DefaultSessionMaxSeconds.
+//   - The egress worker filter of the environment is used if the target has
+//     no egress worker filter and no deprecated worker filter.
+//   - The storage bucket and enable session recording settings of the
+//     environment are used if the target has no storage bucket and supports
+//     session recording.
+func (e *Environment) ApplyTo(t target.Target) target.Target {
+	t = t.Clone()
+	if e == nil || e.Environment == nil {
+		return t
+	}
+	if e.GetSessionMaxSeconds() > 0 && t.GetSessionMaxSeconds() == target.DefaultSessionMaxSeconds {
+		t.SetSessionMaxSeconds(e.GetSessionMaxSeconds())
+	}
+	if e.GetEgressWorkerFilter() != "" && t.GetEgressWorkerFilter() == "" && t.GetWorkerFilter() == "" {
+		t.SetEgressWorkerFilter(e.GetEgressWorkerFilter())
+	}
+	if e.GetStorageBucketId() != "" && t.GetStorageBucketId() == "" {
+		t.SetStorageBucketId(e.GetStorageBucketId())
+		t.SetEnableSessionRecording(e.GetEnableSessionRecording())
+	}
+	return t
+}
+
+func (e *Environment) oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id": []string{e.GetPublicId()},
+		"resource-type":      []string{"environment"},
+		"op-type":            []string{op.String()},
+		"project-id":         []string{e.GetProjectId()},
+	}
+}
+
+// newEnvironmentId creates a new id for an environment.
+func newEnvironmentId(ctx context.Context) (string, error) {
+	const op = "environment.newEnvironmentId"
+	id, err := db.NewPublicId(ctx, globals.EnvironmentPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
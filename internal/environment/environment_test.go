@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package environment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/environment"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvironment_ApplyTo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const projectId = "p_1234567890"
+
+	env, err := environment.NewEnvironment(ctx, projectId,
+		environment.WithSessionMaxSeconds(3600),
+		environment.WithEgressWorkerFilter(`"prod" in "/tags/env"`),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		env              *environment.Environment
+		opts             []target.Option
+		wantSessionMax   uint32
+		wantEgressFilter string
+		wantWorkerFilter string
+	}{
+		{
+			name:             "inherits-defaults",
+			env:              env,
+			opts:             []target.Option{target.WithSessionMaxSeconds(target.DefaultSessionMaxSeconds)},
+			wantSessionMax:   3600,
+			wantEgressFilter: `"prod" in "/tags/env"`,
+		},
+		{
+			name: "target-settings-win",
+			env:  env,
+			opts: []target.Option{
+				target.WithSessionMaxSeconds(60),
+				target.WithEgressWorkerFilter(`"dev" in "/tags/env"`),
+			},
+			wantSessionMax:   60,
+			wantEgressFilter: `"dev" in "/tags/env"`,
+		},
+		{
+			name: "deprecated-worker-filter-wins",
+			env:  env,
+			opts: []target.Option{
+				target.WithSessionMaxSeconds(target.DefaultSessionMaxSeconds),
+				target.WithWorkerFilter(`"dev" in "/tags/env"`),
+			},
+			wantSessionMax:   3600,
+			wantWorkerFilter: `"dev" in "/tags/env"`,
+		},
+		{
+			name:           "nil-environment",
+			opts:           []target.Option{target.WithSessionMaxSeconds(target.DefaultSessionMaxSeconds)},
+			wantSessionMax: target.DefaultSessionMaxSeconds,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			tar, err := target.New(ctx, tcp.Subtype, projectId, tt.opts...)
+			require.NoError(err)
+			orig := tar.Clone()
+
+			got := tt.env.ApplyTo(tar)
+			assert.Equal(tt.wantSessionMax, got.GetSessionMaxSeconds())
+			assert.Equal(tt.wantEgressFilter, got.GetEgressWorkerFilter())
+			assert.Equal(tt.wantWorkerFilter, got.GetWorkerFilter())
+			assert.Equal(orig, tar, "the target passed in must not be modified")
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package environment
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName                   string
+	withDescription            string
+	withSessionMaxSeconds      uint32
+	withEgressWorkerFilter     string
+	withEnableSessionRecording bool
+	withStorageBucketId        string
+	withLimit                  int
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithName provides an option to provide a name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithDescription provides an option to provide a description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithSessionMaxSeconds provides an option to provide the maximum lifetime of
+// the sessions of the targets in the environment.
+func WithSessionMaxSeconds(seconds uint32) Option {
+	return func(o *options) {
+		o.withSessionMaxSeconds = seconds
+	}
+}
+
+// WithEgressWorkerFilter provides an option to provide the egress worker
+// filter of the targets in the environment.
+func WithEgressWorkerFilter(filter string) Option {
+	return func(o *options) {
+		o.withEgressWorkerFilter = filter
+	}
+}
+
+// WithEnableSessionRecording provides an option to enable the recording of the
+// sessions of the targets in the environment.
+func WithEnableSessionRecording(enable bool) Option {
+	return func(o *options) {
+		o.withEnableSessionRecording = enable
+	}
+}
+
+// WithStorageBucketId provides an option to provide the storage bucket the
+// sessions of the targets in the environment are recorded to.
+func WithStorageBucketId(id string) Option {
+	return func(o *options) {
+		o.withStorageBucketId = id
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
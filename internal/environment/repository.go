@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package environment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+)
+
+const (
+	nameField                   = "Name"
+	descriptionField            = "Description"
+	sessionMaxSecondsField      = "SessionMaxSeconds"
+	egressWorkerFilterField     = "EgressWorkerFilter"
+	enableSessionRecordingField = "EnableSessionRecording"
+	storageBucketIdField        = "StorageBucketId"
+)
+
+// A Repository stores and retrieves the persistent types in the environment
+// package. It is not safe to use a repository concurrently.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+	// defaultLimit provides a default for limiting the number of results
+	// returned from the repo
+	defaultLimit int
+}
+
+// NewRepository creates a new Repository. The returned repository should
+// only be used for one transaction and it is not safe for concurrent go
+// routines to access it. WithLimit option is used as a repo wide default
+// limit applied to all ListX methods.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
+	const op = "environment.NewRepository"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+
+	return &Repository{
+		reader:       r,
+		writer:       w,
+		kms:          kms,
+		defaultLimit: opts.withLimit,
+	}, nil
+}
+
+// CreateEnvironment inserts e into the repository and returns a new
+// Environment containing the environment's PublicId. e is not changed. e must
+// contain a valid ProjectId. e must not contain a PublicId. The PublicId is
+// generated and assigned by this method. opt is ignored.
+//
+// Both e.Name and e.Description are optional. If e.Name is set, it must be
+// unique within e.ProjectId. If e.EnableSessionRecording is set,
+// e.StorageBucketId must be set too.
+func (r *Repository) CreateEnvironment(ctx context.Context, e *Environment, _ ...Option) (*Environment, error) {
+	const op = "environment.(Repository).CreateEnvironment"
+	switch {
+	case e == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil Environment")
+	case e.Environment == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded Environment")
+	case e.ProjectId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	case e.PublicId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	case e.EnableSessionRecording && e.StorageBucketId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "session recording enabled without storage bucket")
+	}
+	e = e.Clone()
+
+	id, err := newEnvironmentId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	e.PublicId = id
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, e.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newEnvironment *Environment
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newEnvironment = e.Clone()
+			if err := w.Create(ctx, newEnvironment, db.WithOplog(oplogWrapper, e.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("in project: %s: name %s already exists", e.ProjectId, e.Name))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in project: %s", e.ProjectId)))
+	}
+	return newEnvironment, nil
+}
+
+// UpdateEnvironment updates the repository entry for e.PublicId with the
+// values in e for the fields listed in fieldMaskPaths. It returns a new
+// Environment containing the updated values and a count of the number of
+// records updated. e is not changed.
+//
+// e must contain a valid PublicId. Name, Description, SessionMaxSeconds,
+// EgressWorkerFilter, EnableSessionRecording and StorageBucketId can be
+// changed. If e.Name is set to a non-empty string, it must be unique within
+// e.ProjectId.
+//
+// An attribute of e will be set to NULL in the database if the attribute in e
+// is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateEnvironment(ctx context.Context, e *Environment, version uint32, fieldMaskPaths []string, _ ...Option) (*Environment, int, error) {
+	const op = "environment.(Repository).UpdateEnvironment"
+	switch {
+	case e == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil Environment")
+	case e.Environment == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil embedded Environment")
+	case e.PublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no version")
+	case len(fieldMaskPaths) == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f):
+		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(sessionMaxSecondsField, f):
+		case strings.EqualFold(egressWorkerFilterField, f):
+		case strings.EqualFold(enableSessionRecordingField, f):
+		case strings.EqualFold(storageBucketIdField, f):
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:                   e.Name,
+			descriptionField:            e.Description,
+			sessionMaxSecondsField:      e.SessionMaxSeconds,
+			egressWorkerFilterField:     e.EgressWorkerFilter,
+			enableSessionRecordingField: e.EnableSessionRecording,
+			storageBucketIdField:        e.StorageBucketId,
+		},
+		fieldMaskPaths,
+		[]string{enableSessionRecordingField},
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	current, err := r.LookupEnvironment(ctx, e.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if current == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("environment %s not found", e.PublicId), errors.WithoutEvent())
+	}
+
+	e = e.Clone()
+	e.ProjectId = current.ProjectId
+	oplogWrapper, err := r.kms.GetWrapper(ctx, e.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedEnvironment *Environment
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedEnvironment = e.Clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedEnvironment, dbMask, nullFields,
+				db.WithOplog(oplogWrapper, e.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version),
+			)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("in project %s, name %s already exists", e.ProjectId, e.Name))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", e.PublicId)))
+	}
+	return returnedEnvironment, rowsUpdated, nil
+}
+
+// LookupEnvironment returns the Environment for id. Returns nil, nil if no
+// Environment is found for id.
+func (r *Repository) LookupEnvironment(ctx context.Context, id string, _ ...Option) (*Environment, error) {
+	const op = "environment.(Repository).LookupEnvironment"
+	if id == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	e := allocEnvironment()
+	e.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, e); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", id)))
+	}
+	return e, nil
+}
+
+// ListEnvironments returns the Environments of the projects, ordered by
+// creation time. WithLimit is the only valid option.
+func (r *Repository) ListEnvironments(ctx context.Context, projectIds []string, opt ...Option) ([]*Environment, error) {
+	const op = "environment.(Repository).ListEnvironments"
+	if len(projectIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project ids")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var environments []*Environment
+	if err := r.reader.SearchWhere(ctx, &environments, "project_id in (?)", []any{projectIds}, db.WithLimit(limit), db.WithOrder("create_time asc")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return environments, nil
+}
+
+// DeleteEnvironment deletes id from the repository returning a count of the
+// number of records deleted. The targets of the environment are not deleted,
+// they no longer belong to an environment.
+func (r *Repository) DeleteEnvironment(ctx context.Context, id string, _ ...Option) (int, error) {
+	const op = "environment.(Repository).DeleteEnvironment"
+	if id == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+
+	e, err := r.LookupEnvironment(ctx, id)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if e == nil {
+		return db.NoRowsAffected, nil
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, e.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Delete(ctx, e.Clone(), db.WithOplog(oplogWrapper, e.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", id)))
+	}
+	return rowsDeleted, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package environment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/environment"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Environment(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	ctx := context.Background()
+	repo, err := environment.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	in, err := environment.NewEnvironment(ctx, proj.PublicId,
+		environment.WithName("prod"),
+		environment.WithSessionMaxSeconds(3600),
+		environment.WithEgressWorkerFilter(`"prod" in "/tags/env"`),
+	)
+	require.NoError(t, err)
+	created, err := repo.CreateEnvironment(ctx, in)
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.GetPublicId())
+	assert.Equal(t, uint32(1), created.GetVersion())
+	assert.Empty(t, in.GetPublicId(), "the environment passed in must not be modified")
+
+	t.Run("create-duplicate-name", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		dup, err := environment.NewEnvironment(ctx, proj.PublicId, environment.WithName("prod"))
+		require.NoError(err)
+		_, err = repo.CreateEnvironment(ctx, dup)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.NotUnique), err))
+	})
+	t.Run("create-recording-without-bucket", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		e, err := environment.NewEnvironment(ctx, proj.PublicId, environment.WithEnableSessionRecording(true))
+		require.NoError(err)
+		_, err = repo.CreateEnvironment(ctx, e)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("lookup", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.LookupEnvironment(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Equal(created.GetName(), got.GetName())
+		assert.Equal(created.GetSessionMaxSeconds(), got.GetSessionMaxSeconds())
+		assert.Equal(created.GetEgressWorkerFilter(), got.GetEgressWorkerFilter())
+
+		got, err = repo.LookupEnvironment(ctx, "env_doesntexist")
+		require.NoError(err)
+		assert.Nil(got)
+	})
+	t.Run("update", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		e := created.Clone()
+		e.Description = "production"
+		e.EgressWorkerFilter = ""
+		updated, rows, err := repo.UpdateEnvironment(ctx, e, created.GetVersion(), []string{"Description", "EgressWorkerFilter"})
+		require.NoError(err)
+		assert.Equal(1, rows)
+		assert.Equal("production", updated.GetDescription())
+		assert.Equal(created.GetVersion()+1, updated.GetVersion())
+
+		got, err := repo.LookupEnvironment(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Equal("production", got.GetDescription())
+		assert.Empty(got.GetEgressWorkerFilter())
+		assert.Equal(uint32(3600), got.GetSessionMaxSeconds())
+
+		_, _, err = repo.UpdateEnvironment(ctx, e, got.GetVersion(), []string{"ProjectId"})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidFieldMask), err))
+	})
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		second := environment.TestEnvironment(t, rw, proj.PublicId, environment.WithName("staging"))
+		got, err := repo.ListEnvironments(ctx, []string{proj.PublicId})
+		require.NoError(err)
+		require.Len(got, 2)
+		assert.Equal(created.GetPublicId(), got[0].GetPublicId())
+		assert.Equal(second.GetPublicId(), got[1].GetPublicId())
+
+		got, err = repo.ListEnvironments(ctx, []string{proj.PublicId}, environment.WithLimit(1))
+		require.NoError(err)
+		assert.Len(got, 1)
+	})
+	t.Run("delete", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		rows, err := repo.DeleteEnvironment(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Equal(1, rows)
+
+		rows, err = repo.DeleteEnvironment(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Equal(0, rows)
+	})
+}
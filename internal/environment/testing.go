@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package environment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEnvironment creates an environment in the project for testing.
+func TestEnvironment(t testing.TB, rw *db.Db, projectId string, opt ...Option) *Environment {
+	t.Helper()
+	ctx := context.Background()
+
+	e, err := NewEnvironment(ctx, projectId, opt...)
+	require.NoError(t, err)
+	e.PublicId, err = newEnvironmentId(ctx)
+	require.NoError(t, err)
+	require.NoError(t, rw.Create(ctx, e))
+	return e
+}
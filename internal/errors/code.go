@@ -70,6 +70,8 @@ const (
 
 	InvalidListToken Code = 136 // InvalidListToken represents an error where the provided list token is invalid
 
+	StorageBucketQuotaExceeded Code = 137 // StorageBucketQuotaExceeded represents an error when the usage of a storage bucket exceeds its quota
+
 	AuthAttemptExpired Code = 198 // AuthAttemptExpired represents an expired authentication attempt
 	AuthMethodInactive Code = 199 // AuthMethodInactive represents an error that means the auth method is not active.
 
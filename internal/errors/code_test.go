@@ -420,6 +420,11 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    InvalidListToken,
 			want: InvalidListToken,
 		},
+		{
+			name: "StorageBucketQuotaExceeded",
+			c:    StorageBucketQuotaExceeded,
+			want: StorageBucketQuotaExceeded,
+		},
 		{
 			name: "InvalidTextRepresentation",
 			c:    InvalidTextRepresentation,
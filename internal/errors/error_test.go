@@ -454,6 +454,15 @@ func TestConvertError(t *testing.T) {
 			},
 			wantErr: errors.E(ctx, errors.WithCode(errors.NotSpecificIntegrity)),
 		},
+		{
+			name: "DeletionProtection",
+			e: &pgconn.PgError{
+				Code:    "23604",
+				Message: "deletion_protection_violation: ttcp_1234567890 is protected from deletion",
+			},
+			wantErr: errors.E(ctx, errors.WithoutEvent(), errors.WithMsg("deletion_protection_violation: ttcp_1234567890 is protected from deletion"),
+				errors.WithWrap(errors.E(ctx, errors.WithoutEvent(), errors.WithCode(errors.Conflict), errors.WithMsg("deletion protection violated")))),
+		},
 		{
 			name:    "convert-domain-error",
 			e:       testErr,
@@ -355,4 +355,8 @@ var errorCodeInfo = map[Code]Info{
 		Message: "invalid list token",
 		Kind:    Parameter,
 	},
+	StorageBucketQuotaExceeded: {
+		Message: "storage bucket quota exceeded",
+		Kind:    State,
+	},
 }
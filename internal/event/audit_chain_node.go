@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/libs/rfc3161"
+	"github.com/hashicorp/eventlogger"
+)
+
+const (
+	// auditChainField is the field of a formatted audit event which holds
+	// its link in the hash chain of the sink.
+	auditChainField = "chain"
+
+	// timestampRequestTimeout limits how long a request for a timestamp
+	// token may block the audit events of a sink.
+	timestampRequestTimeout = 10 * time.Second
+)
+
+// auditChainLink is the link of an audit event in the hash chain of a sink.
+type auditChainLink struct {
+	// Id identifies the chain. A new chain is started each time the eventer
+	// is created.
+	Id string `json:"id"`
+	// Seq is the position of the event in the chain, starting at 1.
+	Seq uint64 `json:"seq"`
+	// PrevDigest is the digest of the previous event in the chain.
+	PrevDigest string `json:"prev_digest,omitempty"`
+	// Digest is the hex encoded SHA-256 digest of the event, computed by
+	// auditChainDigest.
+	Digest string `json:"digest,omitempty"`
+	// TimestampToken is the optional base64 encoded RFC 3161 timestamp token
+	// issued for the digest.
+	TimestampToken string `json:"timestamp_token,omitempty"`
+}
+
+// auditChainDigest returns the digest of the formatted event ce for its link
+// in the chain. The digest covers the compact JSON encoding of the event,
+// with its keys sorted, including the id, seq and prev_digest of the link.
+func auditChainDigest(ce map[string]any, link auditChainLink) ([]byte, error) {
+	const op = "event.auditChainDigest"
+	canonical := maps.Clone(ce)
+	canonical[auditChainField] = auditChainLink{
+		Id:         link.Id,
+		Seq:        link.Seq,
+		PrevDigest: link.PrevDigest,
+	}
+	b, err := json.Marshal(canonical)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	sum := sha256.Sum256(b)
+	return sum[:], nil
+}
+
+// auditChainNode is a formatter node which adds the link of each audit event
+// in the hash chain of a sink to the formatted event, and optionally requests
+// timestamp tokens for the chain.
+type auditChainNode struct {
+	format            SinkFormat
+	timestampUrl      string
+	timestampInterval time.Duration
+	client            *http.Client
+
+	l             sync.Mutex
+	id            string
+	seq           uint64
+	prevDigest    string
+	lastTimestamp time.Time
+}
+
+var _ eventlogger.Node = &auditChainNode{}
+
+func newAuditChainNode(format SinkFormat, c *AuditHashChainConfig) (*auditChainNode, error) {
+	const op = "event.newAuditChainNode"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing hash chain config: %w", op, ErrInvalidParameter)
+	}
+	switch format {
+	case JSONSinkFormat, TextSinkFormat:
+	default:
+		return nil, fmt.Errorf("%s: %s is not a supported format: %w", op, format, ErrInvalidParameter)
+	}
+	id, err := NewId("chain")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	n := &auditChainNode{
+		format:            format,
+		timestampUrl:      c.TimestampUrl,
+		timestampInterval: c.TimestampInterval,
+		client:            &http.Client{Timeout: timestampRequestTimeout},
+		id:                id,
+	}
+	if n.timestampInterval == 0 {
+		n.timestampInterval = DefaultTimestampInterval
+	}
+	return n, nil
+}
+
+// Process adds the link of the event in the chain to the formatted event. The
+// event is returned as a copy, since it may be shared with other pipelines.
+func (n *auditChainNode) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(auditChainNode).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	formatted, ok := e.Format(string(n.format))
+	if !ok {
+		return nil, fmt.Errorf("%s: event is not formatted as %s: %w", op, n.format, ErrInvalidParameter)
+	}
+	ce, err := decodeCloudEvent(formatted)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	n.l.Lock()
+	defer n.l.Unlock()
+	link := auditChainLink{
+		Id:         n.id,
+		Seq:        n.seq + 1,
+		PrevDigest: n.prevDigest,
+	}
+	digest, err := auditChainDigest(ce, link)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	link.Digest = hex.EncodeToString(digest)
+	if n.timestampUrl != "" && time.Since(n.lastTimestamp) >= n.timestampInterval {
+		// If the token can't be requested, the event is written without one
+		// and a token is requested again for the next event.
+		if token, err := rfc3161.Request(ctx, n.client, n.timestampUrl, digest); err == nil {
+			link.TimestampToken = base64.StdEncoding.EncodeToString(token)
+			n.lastTimestamp = time.Now()
+		}
+	}
+	ce[auditChainField] = link
+
+	b, err := encodeCloudEvent(n.format, ce)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	n.seq = link.Seq
+	n.prevDigest = link.Digest
+	return copyFormattedEvent(e, n.format, b), nil
+}
+
+// Reopen is a no op for the audit chain node.
+func (n *auditChainNode) Reopen() error {
+	return nil
+}
+
+// Type describes the type of the node as a Formatter.
+func (n *auditChainNode) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeFormatter
+}
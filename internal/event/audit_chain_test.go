@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/libs/rfc3161"
+	"github.com/hashicorp/eventlogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testChainEvents formats count audit events and passes them through n,
+// returning the formatted chained events.
+func testChainEvents(t *testing.T, n *auditChainNode, count int) [][]byte {
+	t.Helper()
+	require := require.New(t)
+	var chained [][]byte
+	for i := 0; i < count; i++ {
+		ce := map[string]any{
+			"id":          fmt.Sprintf("event-%d", i),
+			"type":        string(AuditType),
+			"specversion": "1.0",
+			"data": map[string]any{
+				"request_info": map[string]any{"path": "/v1/targets<&>"},
+				"amount":       json.Number("1.50"),
+			},
+		}
+		b, err := json.Marshal(ce)
+		require.NoError(err)
+		e := &eventlogger.Event{Type: eventlogger.EventType(AuditType), CreatedAt: time.Now()}
+		e.FormattedAs(string(n.format), append(b, '\n'))
+
+		got, err := n.Process(context.Background(), e)
+		require.NoError(err)
+		require.NotSame(e, got)
+		formatted, ok := got.Format(string(n.format))
+		require.True(ok)
+		chained = append(chained, formatted)
+	}
+	return chained
+}
+
+func TestAuditChainNode_Process(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		n, err := newAuditChainNode(JSONSinkFormat, &AuditHashChainConfig{})
+		require.NoError(err)
+		events := testChainEvents(t, n, 2)
+
+		var links []auditChainLink
+		for _, e := range events {
+			assert.True(bytes.HasSuffix(e, []byte("\n")))
+			assert.Equal(1, bytes.Count(e, []byte("\n")))
+			var ce struct {
+				Data  map[string]any `json:"data"`
+				Chain auditChainLink `json:"chain"`
+			}
+			require.NoError(json.Unmarshal(e, &ce))
+			assert.Equal(1.50, ce.Data["amount"])
+			links = append(links, ce.Chain)
+		}
+		assert.Equal(n.id, links[0].Id)
+		assert.Equal(uint64(1), links[0].Seq)
+		assert.Empty(links[0].PrevDigest)
+		assert.NotEmpty(links[0].Digest)
+		assert.Equal(uint64(2), links[1].Seq)
+		assert.Equal(links[0].Digest, links[1].PrevDigest)
+		assert.Empty(links[1].TimestampToken)
+	})
+	t.Run("text", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		n, err := newAuditChainNode(TextSinkFormat, &AuditHashChainConfig{})
+		require.NoError(err)
+		events := testChainEvents(t, n, 1)
+		assert.Contains(string(events[0]), "\n  \"chain\": {")
+	})
+	t.Run("missing-format", func(t *testing.T) {
+		require := require.New(t)
+		n, err := newAuditChainNode(JSONSinkFormat, &AuditHashChainConfig{})
+		require.NoError(err)
+		_, err = n.Process(context.Background(), &eventlogger.Event{})
+		require.ErrorIs(err, ErrInvalidParameter)
+	})
+	t.Run("unsupported-format", func(t *testing.T) {
+		_, err := newAuditChainNode(JSONHclogSinkFormat, &AuditHashChainConfig{})
+		require.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("timestamps", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tsa := rfc3161.NewTestTSA(t)
+		srv := httptest.NewServer(tsa)
+		defer srv.Close()
+
+		n, err := newAuditChainNode(JSONSinkFormat, &AuditHashChainConfig{
+			TimestampUrl:      srv.URL,
+			TimestampInterval: time.Hour,
+		})
+		require.NoError(err)
+		events := testChainEvents(t, n, 3)
+
+		// only the first event is timestamped within the interval
+		report, err := VerifyAuditChain(bytes.NewReader(bytes.Join(events, nil)), WithTimestampRoots(tsa.Roots()))
+		require.NoError(err)
+		assert.Empty(report.Failures)
+		assert.Equal(1, report.Timestamps)
+	})
+}
+
+func TestVerifyAuditChain(t *testing.T) {
+	newChain := func(t *testing.T, count int) [][]byte {
+		n, err := newAuditChainNode(JSONSinkFormat, &AuditHashChainConfig{})
+		require.NoError(t, err)
+		return testChainEvents(t, n, count)
+	}
+	otherTsa := rfc3161.NewTestTSA(t)
+
+	tests := []struct {
+		name         string
+		events       func(t *testing.T) [][]byte
+		opts         []Option
+		wantChains   int
+		wantEvents   int
+		wantFailures []string
+	}{
+		{
+			name:       "valid",
+			events:     func(t *testing.T) [][]byte { return newChain(t, 3) },
+			wantChains: 1,
+			wantEvents: 3,
+		},
+		{
+			name: "valid-out-of-order",
+			events: func(t *testing.T) [][]byte {
+				e := newChain(t, 3)
+				return [][]byte{e[2], e[0], e[1]}
+			},
+			wantChains: 1,
+			wantEvents: 3,
+		},
+		{
+			name: "valid-restarted",
+			events: func(t *testing.T) [][]byte {
+				return append(newChain(t, 2), newChain(t, 2)...)
+			},
+			wantChains: 2,
+			wantEvents: 4,
+		},
+		{
+			name: "valid-ignores-other-types",
+			events: func(t *testing.T) [][]byte {
+				return append(newChain(t, 1), []byte(`{"id":"sys","type":"system"}`+"\n"))
+			},
+			wantChains: 1,
+			wantEvents: 1,
+		},
+		{
+			name: "modified",
+			events: func(t *testing.T) [][]byte {
+				e := newChain(t, 2)
+				e[1] = bytes.Replace(e[1], []byte("targets"), []byte("tergets"), 1)
+				return e
+			},
+			wantChains:   1,
+			wantEvents:   2,
+			wantFailures: []string{"audit event event-1 (chain", "seq 2) does not match its digest"},
+		},
+		{
+			name: "removed",
+			events: func(t *testing.T) [][]byte {
+				e := newChain(t, 4)
+				return [][]byte{e[0], e[3]}
+			},
+			wantChains:   1,
+			wantEvents:   2,
+			wantFailures: []string{"is missing events 2 to 3"},
+		},
+		{
+			name: "truncated-start",
+			events: func(t *testing.T) [][]byte {
+				return newChain(t, 3)[1:]
+			},
+			wantChains:   1,
+			wantEvents:   2,
+			wantFailures: []string{"is missing events 1 to 1"},
+		},
+		{
+			name: "duplicated",
+			events: func(t *testing.T) [][]byte {
+				e := newChain(t, 2)
+				return append(e, e[1])
+			},
+			wantChains:   1,
+			wantEvents:   3,
+			wantFailures: []string{"has more than one event with seq 2"},
+		},
+		{
+			name: "unchained",
+			events: func(t *testing.T) [][]byte {
+				return append(newChain(t, 1), []byte(`{"id":"unchained","type":"audit"}`+"\n"))
+			},
+			wantChains:   1,
+			wantEvents:   2,
+			wantFailures: []string{"audit event unchained is not chained"},
+		},
+		{
+			name: "untrusted-timestamp",
+			events: func(t *testing.T) [][]byte {
+				tsa := rfc3161.NewTestTSA(t)
+				srv := httptest.NewServer(tsa)
+				t.Cleanup(srv.Close)
+				n, err := newAuditChainNode(JSONSinkFormat, &AuditHashChainConfig{TimestampUrl: srv.URL})
+				require.NoError(t, err)
+				return testChainEvents(t, n, 1)
+			},
+			opts:         []Option{WithTimestampRoots(otherTsa.Roots())},
+			wantChains:   1,
+			wantEvents:   1,
+			wantFailures: []string{"has an invalid timestamp token"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			report, err := VerifyAuditChain(bytes.NewReader(bytes.Join(tt.events(t), nil)), tt.opts...)
+			require.NoError(err)
+			assert.Equal(tt.wantChains, report.Chains)
+			assert.Equal(tt.wantEvents, report.Events)
+			if len(tt.wantFailures) == 0 {
+				assert.Empty(report.Failures)
+				assert.True(report.Valid())
+				return
+			}
+			require.Len(report.Failures, 1)
+			for _, want := range tt.wantFailures {
+				assert.Contains(report.Failures[0], want)
+			}
+			assert.False(report.Valid())
+		})
+	}
+	t.Run("invalid-json", func(t *testing.T) {
+		_, err := VerifyAuditChain(strings.NewReader("{"))
+		require.Error(t, err)
+	})
+	t.Run("missing-reader", func(t *testing.T) {
+		_, err := VerifyAuditChain(nil)
+		require.ErrorIs(t, err, ErrInvalidParameter)
+	})
+}
+
+func TestEventer_auditHashChain(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	buf := &bytes.Buffer{}
+	c := EventerConfig{
+		AuditEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:         "chained-audit",
+				EventTypes:   []Type{AuditType},
+				Format:       JSONSinkFormat,
+				Type:         WriterSink,
+				WriterConfig: &WriterSinkTypeConfig{Writer: buf},
+				AuditConfig:  &AuditConfig{HashChain: &AuditHashChainConfig{}},
+			},
+		},
+	}
+	testLock := &sync.Mutex{}
+	eventer, err := NewEventer(testLogger(t, testLock), testLock, "TestEventer_auditHashChain", c)
+	require.NoError(err)
+
+	for i := 0; i < 3; i++ {
+		a, err := newAudit(
+			"TestEventer_auditHashChain",
+			WithRequestInfo(TestRequestInfo(t)),
+			WithAuth(testAuth(t)),
+			WithRequest(testRequest(t)),
+			WithResponse(testResponse(t)),
+			WithFlush())
+		require.NoError(err)
+		require.NoError(eventer.writeAudit(ctx, a))
+	}
+
+	report, err := VerifyAuditChain(bytes.NewReader(buf.Bytes()))
+	require.NoError(err)
+	assert.Empty(report.Failures)
+	assert.Equal(1, report.Chains)
+	assert.Equal(3, report.Events)
+}
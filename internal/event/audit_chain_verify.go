@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/libs/rfc3161"
+)
+
+// AuditChainReport is the result of verifying the hash chains of audit events.
+type AuditChainReport struct {
+	// Chains is the number of chains the audit events belong to. A new chain
+	// is started each time the eventer of a sink is created.
+	Chains int `json:"chains"`
+	// Events is the number of audit events verified.
+	Events int `json:"events"`
+	// Timestamps is the number of valid timestamp tokens.
+	Timestamps int `json:"timestamps"`
+	// Failures describes each problem found with the audit events.
+	Failures []string `json:"failures,omitempty"`
+}
+
+// Valid returns true if no problems were found with the audit events.
+func (r *AuditChainReport) Valid() bool {
+	return len(r.Failures) == 0
+}
+
+// chainedEvent is the link of a verified audit event in its chain.
+type chainedEvent struct {
+	eventId string
+	link    auditChainLink
+}
+
+// VerifyAuditChain reads the cloudevents-json or cloudevents-text formatted
+// events from r and verifies the hash chains of the audit events. Events of
+// other types are ignored. The events of a chain may be read in any order, but
+// events written to a rotated file must be included for the chain to be
+// complete. Supported options are: WithTimestampRoots, which verifies the
+// certificates of the TSAs which issued timestamp tokens.
+//
+// An error is only returned if the events can't be read; problems with the
+// audit events are reported as failures.
+func VerifyAuditChain(r io.Reader, opt ...Option) (*AuditChainReport, error) {
+	const op = "event.VerifyAuditChain"
+	if r == nil {
+		return nil, fmt.Errorf("%s: missing reader: %w", op, ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+
+	report := &AuditChainReport{}
+	var chainIds []string
+	chains := map[string][]chainedEvent{}
+
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	for {
+		var ce map[string]any
+		if err := dec.Decode(&ce); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("%s: unable to decode event: %w", op, err)
+		}
+		if t, _ := ce["type"].(string); t != string(AuditType) {
+			continue
+		}
+		report.Events++
+		eventId, _ := ce["id"].(string)
+
+		raw, ok := ce[auditChainField]
+		if !ok {
+			report.Failures = append(report.Failures, fmt.Sprintf("audit event %s is not chained", eventId))
+			continue
+		}
+		var link auditChainLink
+		if b, err := json.Marshal(raw); err != nil || json.Unmarshal(b, &link) != nil || link.Id == "" || link.Seq == 0 {
+			report.Failures = append(report.Failures, fmt.Sprintf("audit event %s has an invalid chain link", eventId))
+			continue
+		}
+		delete(ce, auditChainField)
+		digest, err := auditChainDigest(ce, link)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if hex.EncodeToString(digest) != link.Digest {
+			report.Failures = append(report.Failures, fmt.Sprintf("audit event %s (chain %s seq %d) does not match its digest", eventId, link.Id, link.Seq))
+			continue
+		}
+		if link.TimestampToken != "" {
+			if err := verifyTimestampToken(link.TimestampToken, digest, opts); err != nil {
+				report.Failures = append(report.Failures, fmt.Sprintf("audit event %s (chain %s seq %d) has an invalid timestamp token: %s", eventId, link.Id, link.Seq, err))
+			} else {
+				report.Timestamps++
+			}
+		}
+
+		if _, ok := chains[link.Id]; !ok {
+			chainIds = append(chainIds, link.Id)
+		}
+		chains[link.Id] = append(chains[link.Id], chainedEvent{eventId: eventId, link: link})
+	}
+
+	report.Chains = len(chainIds)
+	for _, id := range chainIds {
+		report.Failures = append(report.Failures, verifyChainLinks(id, chains[id])...)
+	}
+	return report, nil
+}
+
+// verifyChainLinks verifies that the events of the chain id are complete and
+// each event links to the previous one, returning the problems found.
+func verifyChainLinks(id string, events []chainedEvent) []string {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].link.Seq < events[j].link.Seq
+	})
+	var failures []string
+	if first := events[0].link; first.Seq != 1 {
+		failures = append(failures, fmt.Sprintf("chain %s is missing events 1 to %d", id, first.Seq-1))
+	} else if first.PrevDigest != "" {
+		failures = append(failures, fmt.Sprintf("chain %s starts with an event linked to a previous event", id))
+	}
+	for i := 1; i < len(events); i++ {
+		prev, cur := events[i-1], events[i]
+		switch {
+		case cur.link.Seq == prev.link.Seq:
+			failures = append(failures, fmt.Sprintf("chain %s has more than one event with seq %d", id, cur.link.Seq))
+		case cur.link.Seq > prev.link.Seq+1:
+			failures = append(failures, fmt.Sprintf("chain %s is missing events %d to %d", id, prev.link.Seq+1, cur.link.Seq-1))
+		case cur.link.PrevDigest != prev.link.Digest:
+			failures = append(failures, fmt.Sprintf("audit event %s (chain %s seq %d) does not link to the previous event", cur.eventId, id, cur.link.Seq))
+		}
+	}
+	return failures
+}
+
+// verifyTimestampToken verifies that the base64 encoded timestamp token was
+// issued for the digest.
+func verifyTimestampToken(token string, digest []byte, opts options) error {
+	der, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return err
+	}
+	t, err := rfc3161.Parse(der)
+	if err != nil {
+		return err
+	}
+	return t.Verify(digest, opts.withTimestampRoots)
+}
@@ -5,6 +5,8 @@ package event
 
 import (
 	"fmt"
+	"net/url"
+	"time"
 
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 )
@@ -16,13 +18,17 @@ type AuditConfig struct {
 	FilterOverrides    AuditFilterOperations `hcl:"-"`
 	FilterOverridesHCL map[string]string     `hcl:"audit_filter_overrides"`
 
+	// HashChain optionally links the audit events written to the sink into a
+	// hash chain, so that tampering with the sink is detectable.
+	HashChain *AuditHashChainConfig `hcl:"hash_chain"`
+
 	// wrapper to use for audit event crypto operations.
 	wrapper wrapping.Wrapper
 }
 
 // NewAuditConfig creates a new config starting with the DefaultAuditConfig()
-// and applying options. Supported options are: WithWrapper and
-// WithFilterOperations.
+// and applying options. Supported options are: WithWrapper,
+// WithFilterOperations and WithHashChain.
 func NewAuditConfig(opt ...Option) (*AuditConfig, error) {
 	const op = "event.NewAuditConfig"
 	opts := getOpts(opt...)
@@ -33,6 +39,9 @@ func NewAuditConfig(opt ...Option) (*AuditConfig, error) {
 	if opts.withFilterOperations != nil {
 		c.FilterOverrides = opts.withFilterOperations
 	}
+	if opts.withHashChain != nil {
+		c.HashChain = opts.withHashChain
+	}
 	if err := c.Validate(); err != nil {
 		return nil, fmt.Errorf("%s: invalid configuration: %w", op, err)
 	}
@@ -48,6 +57,11 @@ func (ac *AuditConfig) Validate() error {
 	if err := ac.FilterOverrides.Validate(); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	if ac.HashChain != nil {
+		if err := ac.HashChain.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
 
 	// Note: we don't validate the wrapper here because it may not be set yet.
 
@@ -62,3 +76,43 @@ func DefaultAuditConfig() *AuditConfig {
 		FilterOverrides: DefaultAuditFilterOperations(),
 	}
 }
+
+// AuditHashChainConfig defines the configuration of the hash chain of the
+// audit events written to a sink.  Each audit event carries the digest of the
+// previous event written to the sink, and the chain is optionally anchored in
+// time with RFC 3161 timestamp tokens.
+type AuditHashChainConfig struct {
+	// TimestampUrl is the optional URL of an RFC 3161 time-stamp authority
+	// from which timestamp tokens for the chain are requested.
+	TimestampUrl string `hcl:"timestamp_url"`
+	// TimestampInterval is the minimum time between two timestamp tokens. It
+	// defaults to DefaultTimestampInterval.
+	TimestampInterval    time.Duration `hcl:"-"`
+	TimestampIntervalHCL string        `hcl:"timestamp_interval" json:"-"`
+}
+
+// DefaultTimestampInterval is the default minimum time between two timestamp
+// tokens of an audit event hash chain.
+const DefaultTimestampInterval = time.Minute
+
+// Validate the AuditHashChainConfig
+func (c *AuditHashChainConfig) Validate() error {
+	const op = "event.(AuditHashChainConfig).Validate"
+	if c.TimestampInterval < 0 {
+		return fmt.Errorf("%s: negative timestamp interval: %w", op, ErrInvalidParameter)
+	}
+	if c.TimestampUrl == "" {
+		if c.TimestampInterval != 0 {
+			return fmt.Errorf("%s: timestamp interval requires a timestamp url: %w", op, ErrInvalidParameter)
+		}
+		return nil
+	}
+	u, err := url.Parse(c.TimestampUrl)
+	if err != nil {
+		return fmt.Errorf("%s: invalid timestamp url: %w", op, ErrInvalidParameter)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s: timestamp url must be an http or https url: %w", op, ErrInvalidParameter)
+	}
+	return nil
+}
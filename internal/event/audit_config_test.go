@@ -5,6 +5,7 @@ package event
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -46,10 +47,50 @@ func TestAuditConfig_Validate(t *testing.T) {
 			wantIsError:     ErrInvalidParameter,
 			wantErrContains: "invalid filter override operation (invalid-operation)",
 		},
+		{
+			name: "negative-timestamp-interval",
+			ac: &AuditConfig{
+				HashChain: &AuditHashChainConfig{
+					TimestampUrl:      "https://tsa.example.com",
+					TimestampInterval: -time.Second,
+				},
+			},
+			wantIsError:     ErrInvalidParameter,
+			wantErrContains: "negative timestamp interval",
+		},
+		{
+			name: "timestamp-interval-without-url",
+			ac: &AuditConfig{
+				HashChain: &AuditHashChainConfig{
+					TimestampInterval: time.Second,
+				},
+			},
+			wantIsError:     ErrInvalidParameter,
+			wantErrContains: "timestamp interval requires a timestamp url",
+		},
+		{
+			name: "invalid-timestamp-url",
+			ac: &AuditConfig{
+				HashChain: &AuditHashChainConfig{
+					TimestampUrl: "tsa.example.com",
+				},
+			},
+			wantIsError:     ErrInvalidParameter,
+			wantErrContains: "timestamp url must be an http or https url",
+		},
 		{
 			name: "valid-default",
 			ac:   DefaultAuditConfig(),
 		},
+		{
+			name: "valid-hash-chain",
+			ac: &AuditConfig{
+				HashChain: &AuditHashChainConfig{
+					TimestampUrl:      "https://tsa.example.com",
+					TimestampInterval: time.Minute,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -73,6 +114,7 @@ func TestNewAuditConfig(t *testing.T) {
 		SensitiveClassification: EncryptOperation,
 		SecretClassification:    EncryptOperation,
 	}
+	hashChain := &AuditHashChainConfig{TimestampUrl: "https://tsa.example.com"}
 	tests := []struct {
 		name            string
 		opts            []Option
@@ -86,9 +128,10 @@ func TestNewAuditConfig(t *testing.T) {
 		},
 		{
 			name: "valid-with-all-opts",
-			opts: []Option{WithAuditWrapper(wrapper), WithFilterOperations(filterOps)},
+			opts: []Option{WithAuditWrapper(wrapper), WithFilterOperations(filterOps), WithHashChain(hashChain)},
 			want: &AuditConfig{
 				FilterOverrides: filterOps,
+				HashChain:       hashChain,
 				wrapper:         wrapper,
 			},
 		},
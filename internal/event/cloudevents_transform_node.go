@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/eventlogger/filters/encrypt"
+	"github.com/hashicorp/eventlogger/formatter_filters/cloudevents"
+)
+
+// cloudEventsTransformFilter is a node which filters the formatted
+// cloudevents written to a sink by their resource type and actor, and applies
+// the field-level transformations of the sink to them.
+type cloudEventsTransformFilter struct {
+	format        SinkFormat
+	resourceTypes []resource.Type
+	actors        []string
+	transforms    []cloudEventsTransform
+}
+
+// cloudEventsTransform is a SinkTransform with its parsed field path.
+type cloudEventsTransform struct {
+	path      []string
+	operation TransformOperation
+	value     string
+}
+
+var _ eventlogger.Node = &cloudEventsTransformFilter{}
+
+// newCloudEventsTransformFilter creates a transform filter node for the
+// resource types, actors and transforms of the sink config c.
+func newCloudEventsTransformFilter(c SinkConfig) (*cloudEventsTransformFilter, error) {
+	const op = "event.newCloudEventsTransformFilter"
+	switch c.Format {
+	case JSONSinkFormat, TextSinkFormat:
+	default:
+		return nil, fmt.Errorf("%s: %s is not a supported format: %w", op, c.Format, ErrInvalidParameter)
+	}
+	n := &cloudEventsTransformFilter{
+		format: c.Format,
+		actors: c.Actors,
+	}
+	if slices.Contains(c.Actors, "") {
+		return nil, fmt.Errorf("%s: empty actor: %w", op, ErrInvalidParameter)
+	}
+	for _, rt := range c.ResourceTypes {
+		t, ok := resource.Map[rt]
+		if !ok || t == resource.Unknown || t == resource.All {
+			return nil, fmt.Errorf("%s: invalid resource type '%s': %w", op, rt, ErrInvalidParameter)
+		}
+		n.resourceTypes = append(n.resourceTypes, t)
+	}
+	for _, t := range c.Transforms {
+		if err := t.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		path, err := transformFieldPath(t.Field)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		n.transforms = append(n.transforms, cloudEventsTransform{
+			path:      path,
+			operation: t.Operation,
+			value:     t.Value,
+		})
+	}
+	return n, nil
+}
+
+// Process filters the event by its resource type and actor, and applies the
+// transformations to the formatted event. The event is returned as a copy,
+// since it may be shared with other pipelines.
+func (n *cloudEventsTransformFilter) Process(_ context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(cloudEventsTransformFilter).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	formatted, ok := e.Format(string(n.format))
+	if !ok {
+		return nil, fmt.Errorf("%s: event is not formatted as %s: %w", op, n.format, ErrInvalidParameter)
+	}
+	ce, err := decodeCloudEvent(formatted)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(n.resourceTypes) > 0 && !slices.Contains(n.resourceTypes, cloudEventResourceType(ce)) {
+		return nil, nil
+	}
+	if len(n.actors) > 0 && !slices.Contains(n.actors, cloudEventActor(ce)) {
+		return nil, nil
+	}
+	if len(n.transforms) == 0 {
+		return e, nil
+	}
+
+	for _, t := range n.transforms {
+		t.apply(ce)
+	}
+	b, err := encodeCloudEvent(n.format, ce)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return copyFormattedEvent(e, n.format, b), nil
+}
+
+// Reopen is a no op for the transform filter node.
+func (n *cloudEventsTransformFilter) Reopen() error {
+	return nil
+}
+
+// Type describes the type of the node as a FormatterFilter.
+func (n *cloudEventsTransformFilter) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeFormatterFilter
+}
+
+// apply applies the transform to the formatted event ce. Fields which don't
+// exist in the event are ignored.
+func (t cloudEventsTransform) apply(ce map[string]any) {
+	m := ce
+	for _, p := range t.path[:len(t.path)-1] {
+		next, ok := m[p].(map[string]any)
+		if !ok {
+			return
+		}
+		m = next
+	}
+	last := t.path[len(t.path)-1]
+	if _, ok := m[last]; !ok {
+		return
+	}
+	switch t.operation {
+	case RedactTransform:
+		m[last] = encrypt.RedactedData
+	case RemoveTransform:
+		delete(m, last)
+	case ReplaceTransform:
+		m[last] = t.value
+	}
+}
+
+// cloudEventResourceType returns the type of the resource of the request of
+// the formatted event ce, based on the path of its request info.
+func cloudEventResourceType(ce map[string]any) resource.Type {
+	data, _ := ce["data"].(map[string]any)
+	info, _ := data["request_info"].(map[string]any)
+	path, _ := info["path"].(string)
+	// Paths are in the form of /v1/<collection>[/<id>][:<action>]
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments) < 2 {
+		return resource.Unknown
+	}
+	collection, _, _ := strings.Cut(segments[1], ":")
+	t, ok := resource.FromPlural(collection)
+	if !ok {
+		return resource.Unknown
+	}
+	return t
+}
+
+// cloudEventActor returns the id of the user who made the request of the
+// formatted event ce.
+func cloudEventActor(ce map[string]any) string {
+	data, _ := ce["data"].(map[string]any)
+	auth, _ := data["auth"].(map[string]any)
+	userInfo, _ := auth["user_info"].(map[string]any)
+	id, _ := userInfo["id"].(string)
+	return id
+}
+
+// decodeCloudEvent decodes the formatted cloudevent b, preserving numbers as
+// they were formatted.
+func decodeCloudEvent(b []byte) (map[string]any, error) {
+	const op = "event.decodeCloudEvent"
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var ce map[string]any
+	if err := dec.Decode(&ce); err != nil {
+		return nil, fmt.Errorf("%s: unable to decode formatted event: %w", op, err)
+	}
+	return ce, nil
+}
+
+// encodeCloudEvent encodes the cloudevent ce the same way the cloudevents
+// formatter does for the format.
+func encodeCloudEvent(format SinkFormat, ce map[string]any) ([]byte, error) {
+	const op = "event.encodeCloudEvent"
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	if format == TextSinkFormat {
+		enc.SetIndent("", cloudevents.TextIndent)
+	}
+	if err := enc.Encode(ce); err != nil {
+		return nil, fmt.Errorf("%s: unable to encode formatted event: %w", op, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// copyFormattedEvent returns a copy of the event e with its formatted value
+// for the format replaced by b.
+func copyFormattedEvent(e *eventlogger.Event, format SinkFormat, b []byte) *eventlogger.Event {
+	dup := &eventlogger.Event{
+		Type:      e.Type,
+		CreatedAt: e.CreatedAt,
+		Formatted: make(map[string][]byte, len(e.Formatted)),
+		Payload:   e.Payload,
+	}
+	for k, v := range e.Formatted {
+		dup.Formatted[k] = v
+	}
+	dup.FormattedAs(string(format), b)
+	return dup
+}
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTransformEvent(t *testing.T, format SinkFormat, path, userId string) *eventlogger.Event {
+	t.Helper()
+	ce := map[string]any{
+		"id":   "event-1",
+		"type": string(AuditType),
+		"data": map[string]any{
+			"request_info": map[string]any{"path": path, "client_ip": "127.0.0.1"},
+			"auth": map[string]any{
+				"user_info": map[string]any{"id": userId},
+				"email":     "user@example.com",
+			},
+			"amount": json.Number("1.50"),
+		},
+	}
+	b, err := json.Marshal(ce)
+	require.NoError(t, err)
+	e := &eventlogger.Event{Type: eventlogger.EventType(AuditType), CreatedAt: time.Now()}
+	e.FormattedAs(string(format), append(b, '\n'))
+	return e
+}
+
+func TestCloudEventsTransformFilter_Process(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		sc        SinkConfig
+		path      string
+		userId    string
+		wantNil   bool
+		wantData  map[string]any
+		wantSame  bool
+		wantErrIs error
+	}{
+		{
+			name:     "resource-type-match",
+			sc:       SinkConfig{Format: JSONSinkFormat, ResourceTypes: []string{"target"}},
+			path:     "/v1/targets/ttcp_1234567890:authorize-session",
+			wantSame: true,
+		},
+		{
+			name:     "resource-type-collection-match",
+			sc:       SinkConfig{Format: JSONSinkFormat, ResourceTypes: []string{"policy", "credential-library"}},
+			path:     "/v1/credential-libraries",
+			wantSame: true,
+		},
+		{
+			name:    "resource-type-mismatch",
+			sc:      SinkConfig{Format: JSONSinkFormat, ResourceTypes: []string{"session"}},
+			path:    "/v1/targets/ttcp_1234567890",
+			wantNil: true,
+		},
+		{
+			name:    "resource-type-no-path",
+			sc:      SinkConfig{Format: JSONSinkFormat, ResourceTypes: []string{"target"}},
+			wantNil: true,
+		},
+		{
+			name:     "actor-match",
+			sc:       SinkConfig{Format: JSONSinkFormat, Actors: []string{"u_1234567890", "u_0987654321"}},
+			userId:   "u_0987654321",
+			wantSame: true,
+		},
+		{
+			name:    "actor-mismatch",
+			sc:      SinkConfig{Format: JSONSinkFormat, Actors: []string{"u_1234567890"}},
+			userId:  "u_anon",
+			wantNil: true,
+		},
+		{
+			name: "resource-type-and-actor",
+			sc: SinkConfig{
+				Format:        JSONSinkFormat,
+				ResourceTypes: []string{"target"},
+				Actors:        []string{"u_1234567890"},
+			},
+			path:    "/v1/targets/ttcp_1234567890",
+			userId:  "u_anon",
+			wantNil: true,
+		},
+		{
+			name: "transforms",
+			sc: SinkConfig{
+				Format: JSONSinkFormat,
+				Transforms: []SinkTransform{
+					{Field: "/data/request_info/client_ip", Operation: RedactTransform},
+					{Field: "/data/auth/email", Operation: RemoveTransform},
+					{Field: "/data/auth/user_info/id", Operation: ReplaceTransform, Value: "someone"},
+					{Field: "/data/missing/field", Operation: RemoveTransform},
+				},
+			},
+			path:   "/v1/users",
+			userId: "u_1234567890",
+			wantData: map[string]any{
+				"request_info": map[string]any{"path": "/v1/users", "client_ip": "[REDACTED]"},
+				"auth": map[string]any{
+					"user_info": map[string]any{"id": "someone"},
+				},
+				"amount": 1.5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			n, err := newCloudEventsTransformFilter(tt.sc)
+			require.NoError(err)
+			e := testTransformEvent(t, tt.sc.Format, tt.path, tt.userId)
+			got, err := n.Process(ctx, e)
+			if tt.wantErrIs != nil {
+				require.ErrorIs(err, tt.wantErrIs)
+				return
+			}
+			require.NoError(err)
+			if tt.wantNil {
+				assert.Nil(got)
+				return
+			}
+			require.NotNil(got)
+			if tt.wantSame {
+				assert.Same(e, got)
+				return
+			}
+			assert.NotSame(e, got)
+			b, ok := got.Format(string(tt.sc.Format))
+			require.True(ok)
+			var ce struct {
+				Data map[string]any `json:"data"`
+			}
+			require.NoError(json.Unmarshal(b, &ce))
+			assert.Equal(tt.wantData, ce.Data)
+
+			// the original event is not modified
+			orig, _ := e.Format(string(tt.sc.Format))
+			assert.Contains(string(orig), "127.0.0.1")
+		})
+	}
+	t.Run("text", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		n, err := newCloudEventsTransformFilter(SinkConfig{
+			Format:     TextSinkFormat,
+			Transforms: []SinkTransform{{Field: "/data/auth", Operation: RemoveTransform}},
+		})
+		require.NoError(err)
+		got, err := n.Process(ctx, testTransformEvent(t, TextSinkFormat, "/v1/users", ""))
+		require.NoError(err)
+		b, ok := got.Format(string(TextSinkFormat))
+		require.True(ok)
+		assert.True(strings.HasPrefix(string(b), "{\n  \"data\": {"))
+		assert.NotContains(string(b), "auth")
+	})
+	t.Run("missing-format", func(t *testing.T) {
+		n, err := newCloudEventsTransformFilter(SinkConfig{Format: JSONSinkFormat, Actors: []string{"u_1234567890"}})
+		require.NoError(t, err)
+		_, err = n.Process(ctx, &eventlogger.Event{})
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("missing-event", func(t *testing.T) {
+		n, err := newCloudEventsTransformFilter(SinkConfig{Format: JSONSinkFormat, Actors: []string{"u_1234567890"}})
+		require.NoError(t, err)
+		_, err = n.Process(ctx, nil)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+}
+
+func Test_newCloudEventsTransformFilter(t *testing.T) {
+	tests := []struct {
+		name            string
+		sc              SinkConfig
+		wantErrContains string
+	}{
+		{
+			name:            "hclog-format",
+			sc:              SinkConfig{Format: JSONHclogSinkFormat},
+			wantErrContains: "hclog-json is not a supported format",
+		},
+		{
+			name:            "invalid-resource-type",
+			sc:              SinkConfig{Format: JSONSinkFormat, ResourceTypes: []string{"targets"}},
+			wantErrContains: "invalid resource type 'targets'",
+		},
+		{
+			name:            "all-resource-type",
+			sc:              SinkConfig{Format: JSONSinkFormat, ResourceTypes: []string{"*"}},
+			wantErrContains: "invalid resource type '*'",
+		},
+		{
+			name:            "empty-actor",
+			sc:              SinkConfig{Format: JSONSinkFormat, Actors: []string{""}},
+			wantErrContains: "empty actor",
+		},
+		{
+			name:            "invalid-transform",
+			sc:              SinkConfig{Format: JSONSinkFormat, Transforms: []SinkTransform{{Field: "/data"}}},
+			wantErrContains: "invalid transform operation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newCloudEventsTransformFilter(tt.sc)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrInvalidParameter)
+			assert.Contains(t, err.Error(), tt.wantErrContains)
+		})
+	}
+}
+
+func TestEventer_sinkTransforms(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	strict, noisy := &bytes.Buffer{}, &bytes.Buffer{}
+	c := EventerConfig{
+		AuditEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:          "strict-audit",
+				EventTypes:    []Type{AuditType},
+				Format:        JSONSinkFormat,
+				Type:          WriterSink,
+				WriterConfig:  &WriterSinkTypeConfig{Writer: strict},
+				ResourceTypes: []string{"session"},
+				Transforms: []SinkTransform{
+					{Field: "/data/request_info/client_ip", Operation: RedactTransform},
+				},
+				AuditConfig: &AuditConfig{HashChain: &AuditHashChainConfig{}},
+			},
+			{
+				Name:         "noisy-audit",
+				EventTypes:   []Type{AuditType},
+				Format:       JSONSinkFormat,
+				Type:         WriterSink,
+				WriterConfig: &WriterSinkTypeConfig{Writer: noisy},
+			},
+		},
+	}
+	testLock := &sync.Mutex{}
+	eventer, err := NewEventer(testLogger(t, testLock), testLock, "TestEventer_sinkTransforms", c)
+	require.NoError(err)
+
+	for _, path := range []string{"/v1/sessions/s_1234567890:cancel", "/v1/targets", "/v1/sessions"} {
+		info := TestRequestInfo(t)
+		info.Path = path
+		info.ClientIp = "127.0.0.1"
+		a, err := newAudit(
+			"TestEventer_sinkTransforms",
+			WithRequestInfo(info),
+			WithFlush())
+		require.NoError(err)
+		require.NoError(eventer.writeAudit(ctx, a))
+	}
+
+	assert.Equal(3, strings.Count(noisy.String(), "\n"))
+	assert.Equal(3, strings.Count(noisy.String(), "127.0.0.1"))
+	assert.Equal(2, strings.Count(strict.String(), "\n"))
+	assert.NotContains(strict.String(), "127.0.0.1")
+	assert.NotContains(strict.String(), "/v1/targets")
+
+	// the transformed events are chained
+	report, err := VerifyAuditChain(bytes.NewReader(strict.Bytes()))
+	require.NoError(err)
+	assert.Empty(report.Failures)
+	assert.Equal(2, report.Events)
+}
@@ -20,6 +20,9 @@ type RequestInfo struct {
 	Path     string `json:"path,omitempty" class:"public"`
 	PublicId string `json:"public_id,omitempty" class:"public"`
 	ClientIp string `json:"client_ip,omitempty" class:"public"`
+	// ClientCertIdentity is the identity of the client certificate verified
+	// by the listener, if it requires client certificates.
+	ClientCertIdentity string `json:"client_cert_identity,omitempty" class:"public"`
 }
 
 // UserInfo defines the fields captured about a user for a Boundary request.
@@ -41,7 +44,8 @@ type Grant struct {
 type Auth struct {
 	DisabledAuthEntirely *bool       `json:"disabled_auth_entirely,omitempty" class:"public"`
 	AuthTokenId          string      `json:"auth_token_id" class:"public"`
-	UserInfo             *UserInfo   `json:"user_info,omitempty"` // boundary field
+	UserInfo             *UserInfo   `json:"user_info,omitempty"`         // boundary field
+	ImpersonatorInfo     *UserInfo   `json:"impersonator_info,omitempty"` // boundary field
 	GrantsInfo           *GrantsInfo `json:"grants_info,omitempty"`
 	UserEmail            string      `json:"email,omitempty" class:"sensitive"`
 	UserName             string      `json:"name,omitempty" class:"sensitive"`
@@ -105,9 +105,24 @@ type pipeline struct {
 	sinkId          eventlogger.NodeID
 	gateId          eventlogger.NodeID
 	encryptFilterId eventlogger.NodeID
+	transformId     eventlogger.NodeID
+	chainId         eventlogger.NodeID
 	sinkConfig      *SinkConfig
 }
 
+// nodeIds returns the ids of the nodes of the pipeline. The order of nodes is
+// important!  gate (aggregate), then encrypt, then filter/format, then
+// transform, then chain, then write to sink.
+func (p pipeline) nodeIds() []eventlogger.NodeID {
+	ids := make([]eventlogger.NodeID, 0, 6)
+	for _, id := range []eventlogger.NodeID{p.gateId, p.encryptFilterId, p.fmtId, p.transformId, p.chainId, p.sinkId} {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 var (
 	sysEventer        *Eventer     // sysEventer is the system-wide Eventer
 	sysEventerLock    sync.RWMutex // sysEventerLock allows the sysEventer to safely be written concurrently.
@@ -336,6 +351,16 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
 			sinkId = eventlogger.NodeID(id)
+		case SyslogSink:
+			sinkNode, err = newSyslogSink(s.Format, s.SyslogConfig)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			id, err := NewId("syslog")
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkId = eventlogger.NodeID(id)
 		default:
 			return nil, fmt.Errorf("%s: unknown sink type %s", op, s.Type)
 		}
@@ -343,6 +368,21 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		if err != nil {
 			return nil, fmt.Errorf("%s: failed to register sink node %s: %w", op, sinkId, err)
 		}
+		var transformId eventlogger.NodeID
+		if len(s.ResourceTypes) > 0 || len(s.Actors) > 0 || len(s.Transforms) > 0 {
+			transformNode, err := newCloudEventsTransformFilter(*s)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			id, err := NewId("transform")
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			transformId = eventlogger.NodeID(id)
+			if err := e.broker.RegisterNode(transformId, transformNode); err != nil {
+				return nil, fmt.Errorf("%s: unable to register transform filter node: %w", op, err)
+			}
+		}
 		var addToAudit, addToObservation, addToErr, addToSys bool
 		for _, t := range s.EventTypes {
 			switch t {
@@ -363,10 +403,12 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		}
 		if addToAudit {
 			var fop AuditFilterOperations
+			var hashChain *AuditHashChainConfig
 			if s.AuditConfig != nil {
 				fop = s.AuditConfig.FilterOverrides
+				hashChain = s.AuditConfig.HashChain
 			}
-			s.AuditConfig, err = NewAuditConfig(WithAuditWrapper(opts.withAuditWrapper), WithFilterOperations(fop))
+			s.AuditConfig, err = NewAuditConfig(WithAuditWrapper(opts.withAuditWrapper), WithFilterOperations(fop), WithHashChain(hashChain))
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
@@ -390,35 +432,55 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 			if err := b.RegisterNode(encryptFilterId, encryptFilter); err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
+			var chainId eventlogger.NodeID
+			if s.AuditConfig.HashChain != nil {
+				chainNode, err := newAuditChainNode(s.Format, s.AuditConfig.HashChain)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+				id, err := NewId("audit-chain")
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+				chainId = eventlogger.NodeID(id)
+				if err := b.RegisterNode(chainId, chainNode); err != nil {
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+			}
 			auditPipelines = append(auditPipelines, pipeline{
 				eventType:       AuditType,
 				fmtId:           fmtId,
 				sinkId:          sinkId,
 				encryptFilterId: encryptFilterId,
+				transformId:     transformId,
+				chainId:         chainId,
 				sinkConfig:      s,
 			})
 		}
 		if addToObservation {
 			observationPipelines = append(observationPipelines, pipeline{
-				eventType:  ObservationType,
-				fmtId:      fmtId,
-				sinkId:     sinkId,
-				sinkConfig: s,
+				eventType:   ObservationType,
+				fmtId:       fmtId,
+				transformId: transformId,
+				sinkId:      sinkId,
+				sinkConfig:  s,
 			})
 		}
 		if addToErr {
 			errPipelines = append(errPipelines, pipeline{
-				eventType:  ErrorType,
-				fmtId:      fmtId,
-				sinkId:     sinkId,
-				sinkConfig: s,
+				eventType:   ErrorType,
+				fmtId:       fmtId,
+				transformId: transformId,
+				sinkId:      sinkId,
+				sinkConfig:  s,
 			})
 		}
 		if addToSys {
 			sysPipelines = append(sysPipelines, pipeline{
-				eventType: SystemType,
-				fmtId:     fmtId,
-				sinkId:    sinkId,
+				eventType:   SystemType,
+				fmtId:       fmtId,
+				transformId: transformId,
+				sinkId:      sinkId,
 			})
 		}
 	}
@@ -454,8 +516,7 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		err = e.broker.RegisterPipeline(eventlogger.Pipeline{
 			EventType:  eventlogger.EventType(p.eventType),
 			PipelineID: eventlogger.PipelineID(pipeId),
-			// order of nodes is important!  gate (aggregate), then filter/format, then encrypt, then write to sink
-			NodeIDs: []eventlogger.NodeID{p.gateId, p.encryptFilterId, p.fmtId, p.sinkId},
+			NodeIDs:    p.nodeIds(),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("%s: failed to register audit pipeline: %w", op, err)
@@ -485,8 +546,7 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		err = e.broker.RegisterPipeline(eventlogger.Pipeline{
 			EventType:  eventlogger.EventType(p.eventType),
 			PipelineID: eventlogger.PipelineID(pipeId),
-			// order of nodes is important!  gate (aggregate), then filter/format, then write to sink
-			NodeIDs: []eventlogger.NodeID{p.gateId, p.fmtId, p.sinkId},
+			NodeIDs:    p.nodeIds(),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("%s: failed to register observation pipeline: %w", op, err)
@@ -502,8 +562,7 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		err = e.broker.RegisterPipeline(eventlogger.Pipeline{
 			EventType:  eventlogger.EventType(p.eventType),
 			PipelineID: eventlogger.PipelineID(pipeId),
-			// order of nodes is important!  filter/format, then write to sink
-			NodeIDs: []eventlogger.NodeID{p.fmtId, p.sinkId},
+			NodeIDs:    p.nodeIds(),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("%s: failed to register err pipeline: %w", op, err)
@@ -519,8 +578,7 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		err = e.broker.RegisterPipeline(eventlogger.Pipeline{
 			EventType:  eventlogger.EventType(p.eventType),
 			PipelineID: eventlogger.PipelineID(pipeId),
-			// order of nodes is important! filter/format, then write to sink
-			NodeIDs: []eventlogger.NodeID{p.fmtId, p.sinkId},
+			NodeIDs:    p.nodeIds(),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("%s: failed to register sys pipeline: %w", op, err)
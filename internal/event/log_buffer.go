@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogBuffer is an io.Writer which keeps the events written to it in memory
+// for a period of time so they can be retrieved later, for instance to debug
+// a worker that cannot be accessed directly. Each write is kept as one entry.
+// Entries are dropped once they are older than the buffer's maximum age, or
+// when the total size of the entries exceeds its maximum size.
+type LogBuffer struct {
+	mu      sync.Mutex
+	maxAge  time.Duration
+	maxSize int
+	size    int
+	entries []logBufferEntry
+}
+
+type logBufferEntry struct {
+	time time.Time
+	line string
+}
+
+// NewLogBuffer creates a new LogBuffer which keeps entries for maxAge, up to a
+// total of maxSize bytes.
+func NewLogBuffer(maxAge time.Duration, maxSize int) (*LogBuffer, error) {
+	const op = "event.NewLogBuffer"
+	switch {
+	case maxAge <= 0:
+		return nil, fmt.Errorf("%s: max age must be greater than zero: %w", op, ErrInvalidParameter)
+	case maxSize <= 0:
+		return nil, fmt.Errorf("%s: max size must be greater than zero: %w", op, ErrInvalidParameter)
+	}
+	return &LogBuffer{
+		maxAge:  maxAge,
+		maxSize: maxSize,
+	}, nil
+}
+
+// SinkConfig returns the configuration of a sink which writes system and
+// error events to the buffer.
+func (b *LogBuffer) SinkConfig() *SinkConfig {
+	return &SinkConfig{
+		Name:         "log-buffer",
+		EventTypes:   []Type{SystemType, ErrorType},
+		Format:       TextHclogSinkFormat,
+		Type:         WriterSink,
+		WriterConfig: &WriterSinkTypeConfig{Writer: b},
+	}
+}
+
+// Write satisfies the io.Writer interface. The data is kept as a single
+// entry, without its trailing newline.
+func (b *LogBuffer) Write(p []byte) (int, error) {
+	now := time.Now()
+	line := strings.TrimRight(string(p), "\n")
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.entries = append(b.entries, logBufferEntry{time: now, line: line})
+	b.size += len(line)
+	b.trim(now)
+	return len(p), nil
+}
+
+// Since returns the entries written at or after t, oldest first.
+func (b *LogBuffer) Since(t time.Time) []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trim(time.Now())
+	var lines []string
+	for _, e := range b.entries {
+		if e.time.Before(t) {
+			continue
+		}
+		lines = append(lines, e.line)
+	}
+	return lines
+}
+
+// trim drops the entries that are too old or don't fit in the buffer. It must
+// be called with the lock held.
+func (b *LogBuffer) trim(now time.Time) {
+	cutoff := now.Add(-b.maxAge)
+	var n int
+	for n < len(b.entries) && (b.size > b.maxSize || b.entries[n].time.Before(cutoff)) {
+		b.size -= len(b.entries[n].line)
+		// Release the line so it can be garbage collected
+		b.entries[n] = logBufferEntry{}
+		n++
+	}
+	b.entries = b.entries[n:]
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogBuffer(t *testing.T) {
+	tests := []struct {
+		name            string
+		maxAge          time.Duration
+		maxSize         int
+		wantErrContains string
+	}{
+		{
+			name:            "missing-max-age",
+			maxSize:         10,
+			wantErrContains: "max age must be greater than zero",
+		},
+		{
+			name:            "missing-max-size",
+			maxAge:          time.Minute,
+			wantErrContains: "max size must be greater than zero",
+		},
+		{
+			name:    "valid",
+			maxAge:  time.Minute,
+			maxSize: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			b, err := NewLogBuffer(tt.maxAge, tt.maxSize)
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.Nil(b)
+				assert.ErrorIs(err, ErrInvalidParameter)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.NotNil(b)
+		})
+	}
+}
+
+func TestLogBuffer_Since(t *testing.T) {
+	t.Run("since", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		b, err := NewLogBuffer(time.Hour, 1024)
+		require.NoError(err)
+
+		_, err = b.Write([]byte("first\n"))
+		require.NoError(err)
+		mid := time.Now()
+		_, err = b.Write([]byte("second\n"))
+		require.NoError(err)
+		_, err = b.Write([]byte("third"))
+		require.NoError(err)
+
+		assert.Equal([]string{"first", "second", "third"}, b.Since(time.Time{}))
+		assert.Equal([]string{"second", "third"}, b.Since(mid))
+		assert.Empty(b.Since(time.Now().Add(time.Minute)))
+	})
+	t.Run("max-size", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		b, err := NewLogBuffer(time.Hour, 10)
+		require.NoError(err)
+
+		for _, l := range []string{"aaaa", "bbbb", "cccc"} {
+			_, err = b.Write([]byte(l))
+			require.NoError(err)
+		}
+		assert.Equal([]string{"bbbb", "cccc"}, b.Since(time.Time{}))
+	})
+	t.Run("max-age", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		b, err := NewLogBuffer(time.Hour, 1024)
+		require.NoError(err)
+
+		_, err = b.Write([]byte("old"))
+		require.NoError(err)
+		b.entries[0].time = time.Now().Add(-2 * time.Hour)
+		_, err = b.Write([]byte("new"))
+		require.NoError(err)
+
+		assert.Equal([]string{"new"}, b.Since(time.Time{}))
+		assert.Equal(3, b.size)
+	})
+}
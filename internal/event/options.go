@@ -4,6 +4,7 @@
 package event
 
 import (
+	"crypto/x509"
 	"net/url"
 	"time"
 
@@ -46,6 +47,8 @@ type options struct {
 	withSchema           *url.URL
 	withAuditWrapper     wrapping.Wrapper
 	withFilterOperations AuditFilterOperations
+	withHashChain        *AuditHashChainConfig
+	withTimestampRoots   *x509.CertPool
 	withGating           bool
 	withNoGateLocking    bool
 	withTelemetry        bool
@@ -217,6 +220,22 @@ func WithFilterOperations(fop AuditFilterOperations) Option {
 	}
 }
 
+// WithHashChain is an optional hash chain configuration for audit events
+func WithHashChain(c *AuditHashChainConfig) Option {
+	return func(o *options) {
+		o.withHashChain = c
+	}
+}
+
+// WithTimestampRoots is an optional set of root certificates used to verify
+// the certificates of the TSAs which issued the timestamp tokens of an audit
+// event chain.
+func WithTimestampRoots(p *x509.CertPool) Option {
+	return func(o *options) {
+		o.withTimestampRoots = p
+	}
+}
+
 // WithHclogLevel is an option to specify a log level if using the adapter
 func WithHclogLevel(with hclog.Level) Option {
 	return func(o *options) {
@@ -6,6 +6,7 @@ package event
 import (
 	"context"
 	"crypto/rand"
+	"crypto/x509"
 	"encoding/base64"
 	"net/url"
 	"testing"
@@ -175,6 +176,22 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withFilterOperations = overrides
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithHashChain", func(t *testing.T) {
+		assert := assert.New(t)
+		c := &AuditHashChainConfig{TimestampUrl: "https://tsa.example.com"}
+		opts := getOpts(WithHashChain(c))
+		testOpts := getDefaultOptions()
+		testOpts.withHashChain = c
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTimestampRoots", func(t *testing.T) {
+		assert := assert.New(t)
+		roots := x509.NewCertPool()
+		opts := getOpts(WithTimestampRoots(roots))
+		testOpts := getDefaultOptions()
+		testOpts.withTimestampRoots = roots
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithHclogLevel", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := getOpts(WithHclogLevel(hclog.Info))
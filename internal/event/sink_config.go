@@ -19,11 +19,15 @@ type SinkConfig struct {
 	AllowFilters   []string              `hcl:"allow_filters"`    // AllowFilters define a set predicates for including an event in the sink. If any filter matches, the event will be included. The filter should be in a format supported by hashicorp/go-bexpr.
 	DenyFilters    []string              `hcl:"deny_filters"`     // DenyFilters define a set predicates for excluding an event in the sink. If any filter matches, the event will be excluded. The filter should be in a format supported by hashicorp/go-bexpr.
 	Format         SinkFormat            `hcl:"format"`           // Format defines the format for the sink (JSONSinkFormat or TextSinkFormat).
-	Type           SinkType              `hcl:"type"`             // Type defines the type of sink (StderrSink, FileSink, or WriterSink).
+	Type           SinkType              `hcl:"type"`             // Type defines the type of sink (StderrSink, FileSink, WriterSink, or SyslogSink).
 	StderrConfig   *StderrSinkTypeConfig `hcl:"stderr"`           // StderrConfig defines parameters for a stderr output.
 	FileConfig     *FileSinkTypeConfig   `hcl:"file"`             // FileConfig defines parameters for a file output.
 	WriterConfig   *WriterSinkTypeConfig `hcl:"-"`                // WriterConfig defines parameters for an io.Writer output. This is not available via HCL.
+	SyslogConfig   *SyslogSinkTypeConfig `hcl:"syslog"`           // SyslogConfig defines parameters for a syslog output.
 	AuditConfig    *AuditConfig          `hcl:"audit_config"`     // AuditConfig defines optional parameters for audit events (if EventTypes contains audit)
+	ResourceTypes  []string              `hcl:"resource_types"`   // ResourceTypes defines an optional set of resource types (e.g. "target") of the requests of the events sent to the sink. Events which aren't about a request for one of the resource types are excluded.
+	Actors         []string              `hcl:"actors"`           // Actors defines an optional set of user ids of the requests of the events sent to the sink. Events which aren't about a request by one of the users are excluded.
+	Transforms     []SinkTransform       `hcl:"transform"`        // Transforms defines optional field-level transformations of the events sent to the sink.
 }
 
 func (sc *SinkConfig) Validate() error {
@@ -45,6 +49,9 @@ func (sc *SinkConfig) Validate() error {
 	if sc.WriterConfig != nil {
 		foundSinkTypeConfigs++
 	}
+	if sc.SyslogConfig != nil {
+		foundSinkTypeConfigs++
+	}
 	if foundSinkTypeConfigs > 1 {
 		return fmt.Errorf("%s: too many sink type config blocks: %w", op, ErrInvalidParameter)
 	}
@@ -72,6 +79,13 @@ func (sc *SinkConfig) Validate() error {
 		if sc.WriterConfig.Writer == nil {
 			return fmt.Errorf("%s: missing writer: %w", op, ErrInvalidParameter)
 		}
+	case SyslogSink:
+		if sc.SyslogConfig == nil {
+			return fmt.Errorf(`%s: missing "syslog" block: %w`, op, ErrInvalidParameter)
+		}
+		if err := sc.SyslogConfig.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
 	}
 	if sc.Name == "" {
 		return fmt.Errorf("%s: missing sink name: %w", op, ErrInvalidParameter)
@@ -98,6 +112,26 @@ func (sc *SinkConfig) Validate() error {
 				return fmt.Errorf("%s: invalid audit config: %w", op, err)
 			}
 		}
+		if (et == AuditType || et == EveryType) && sc.AuditConfig != nil && sc.AuditConfig.HashChain != nil {
+			if err := sc.AuditConfig.HashChain.Validate(); err != nil {
+				return fmt.Errorf("%s: invalid audit config: %w", op, err)
+			}
+			// the chain is embedded in the formatted cloudevent
+			if sc.Format != JSONSinkFormat && sc.Format != TextSinkFormat {
+				return fmt.Errorf("%s: audit hash chain requires a cloudevents sink format: %w", op, ErrInvalidParameter)
+			}
+		}
+	}
+
+	if len(sc.ResourceTypes) > 0 || len(sc.Actors) > 0 || len(sc.Transforms) > 0 {
+		// resource types, actors and transforms are applied to the formatted
+		// cloudevent
+		if sc.Format != JSONSinkFormat && sc.Format != TextSinkFormat {
+			return fmt.Errorf("%s: resource types, actors and transforms require a cloudevents sink format: %w", op, ErrInvalidParameter)
+		}
+		if _, err := newCloudEventsTransformFilter(*sc); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
 	}
 
 	return nil
@@ -56,6 +56,66 @@ func TestSinkConfig_Validate(t *testing.T) {
 			wantErrIs:       ErrInvalidParameter,
 			wantErrContains: "invalid audit config",
 		},
+		{
+			name: "invalid-audit-hash-chain-config",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{AuditType},
+				Type:       FileSink,
+				FileConfig: &FileSinkTypeConfig{
+					FileName: "tmp.file",
+				},
+				Format: JSONSinkFormat,
+				AuditConfig: &AuditConfig{
+					HashChain: &AuditHashChainConfig{
+						TimestampUrl: "ftp://tsa.example.com",
+					},
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "invalid audit config",
+		},
+		{
+			name: "audit-hash-chain-hclog-format",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{AuditType},
+				Type:       FileSink,
+				FileConfig: &FileSinkTypeConfig{
+					FileName: "tmp.file",
+				},
+				Format: JSONHclogSinkFormat,
+				AuditConfig: &AuditConfig{
+					HashChain: &AuditHashChainConfig{},
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "audit hash chain requires a cloudevents sink format",
+		},
+		{
+			name: "transforms-hclog-format",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       StderrSink,
+				Format:     TextHclogSinkFormat,
+				Transforms: []SinkTransform{{Field: "/data/auth", Operation: RemoveTransform}},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "resource types, actors and transforms require a cloudevents sink format",
+		},
+		{
+			name: "invalid-resource-type",
+			sc: SinkConfig{
+				Name:          "sink-name",
+				EventTypes:    []Type{EveryType},
+				Type:          StderrSink,
+				Format:        JSONSinkFormat,
+				ResourceTypes: []string{"unknown"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "invalid resource type 'unknown'",
+		},
 		{
 			name: "missing-name",
 			sc: SinkConfig{
@@ -233,6 +293,35 @@ func TestSinkConfig_Validate(t *testing.T) {
 				Format: JSONSinkFormat,
 			},
 		},
+		{
+			name: "valid-audit-hash-chain",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{AuditType},
+				Type:       FileSink,
+				FileConfig: &FileSinkTypeConfig{
+					FileName: "tmp.file",
+				},
+				Format: TextSinkFormat,
+				AuditConfig: &AuditConfig{
+					HashChain: &AuditHashChainConfig{
+						TimestampUrl: "https://tsa.example.com",
+					},
+				},
+			},
+		},
+		{
+			name: "valid-resource-types-actors-transforms",
+			sc: SinkConfig{
+				Name:          "valid",
+				EventTypes:    []Type{EveryType},
+				Type:          StderrSink,
+				Format:        JSONSinkFormat,
+				ResourceTypes: []string{"target", "session"},
+				Actors:        []string{"u_1234567890"},
+				Transforms:    []SinkTransform{{Field: "/data/request_info/client_ip", Operation: RedactTransform}},
+			},
+		},
 		{
 			name: "valid",
 			sc: SinkConfig{
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TransformOperation defines a field-level transformation (redact, remove,
+// replace) of the events written to a sink.
+type TransformOperation string
+
+const (
+	RedactTransform  TransformOperation = "redact"  // RedactTransform replaces the value of the field with "[REDACTED]".
+	RemoveTransform  TransformOperation = "remove"  // RemoveTransform removes the field.
+	ReplaceTransform TransformOperation = "replace" // ReplaceTransform replaces the value of the field with the value of the transform.
+)
+
+// Validate the TransformOperation
+func (top TransformOperation) Validate() error {
+	const op = "event.(TransformOperation).Validate"
+	switch top {
+	case RedactTransform, RemoveTransform, ReplaceTransform:
+		return nil
+	default:
+		return fmt.Errorf("%s: invalid transform operation '%s': %w", op, top, ErrInvalidParameter)
+	}
+}
+
+// SinkTransform defines a field-level transformation of the events written to
+// a sink. Transformations are applied to the formatted cloudevent, after the
+// audit filter operations, so they can only make an event more restrictive.
+type SinkTransform struct {
+	Field     string             `hcl:",key"`      // Field is a JSON pointer to the field of the formatted event, like "/data/request_info/client_ip". It is the label of a transform block.
+	Operation TransformOperation `hcl:"operation"` // Operation defines the transformation applied to the field.
+	Value     string             `hcl:"value"`     // Value replaces the value of the field for the replace operation.
+}
+
+// Validate a SinkTransform
+func (t *SinkTransform) Validate() error {
+	const op = "event.(SinkTransform).Validate"
+	if _, err := transformFieldPath(t.Field); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := t.Operation.Validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if t.Value != "" && t.Operation != ReplaceTransform {
+		return fmt.Errorf("%s: value is only supported by the %s operation: %w", op, ReplaceTransform, ErrInvalidParameter)
+	}
+	return nil
+}
+
+// transformFieldPath splits the JSON pointer field into its reference tokens.
+func transformFieldPath(field string) ([]string, error) {
+	const op = "event.transformFieldPath"
+	if !strings.HasPrefix(field, "/") || len(field) == 1 {
+		return nil, fmt.Errorf("%s: field '%s' is not a JSON pointer to a field: %w", op, field, ErrInvalidParameter)
+	}
+	path := strings.Split(field[1:], "/")
+	for i, p := range path {
+		if p == "" {
+			return nil, fmt.Errorf("%s: field '%s' has an empty reference token: %w", op, field, ErrInvalidParameter)
+		}
+		path[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(p)
+	}
+	switch path[0] {
+	case "id", "type", "source", "specversion", "time", "datacontentype", auditChainField:
+		if len(path) == 1 {
+			return nil, fmt.Errorf("%s: field '%s' is a required cloudevents field: %w", op, field, ErrInvalidParameter)
+		}
+	}
+	return path, nil
+}
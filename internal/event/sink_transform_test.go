@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransformOperation_Validate(t *testing.T) {
+	for _, top := range []TransformOperation{RedactTransform, RemoveTransform, ReplaceTransform} {
+		assert.NoError(t, top.Validate())
+	}
+	err := TransformOperation("encrypt").Validate()
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+	assert.ErrorContains(t, err, "invalid transform operation 'encrypt'")
+}
+
+func TestSinkTransform_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		st              *SinkTransform
+		wantErrContains string
+	}{
+		{
+			name:            "missing-field",
+			st:              &SinkTransform{Operation: RedactTransform},
+			wantErrContains: "is not a JSON pointer to a field",
+		},
+		{
+			name:            "relative-field",
+			st:              &SinkTransform{Field: "data/auth", Operation: RedactTransform},
+			wantErrContains: "is not a JSON pointer to a field",
+		},
+		{
+			name:            "empty-reference-token",
+			st:              &SinkTransform{Field: "/data//auth", Operation: RedactTransform},
+			wantErrContains: "has an empty reference token",
+		},
+		{
+			name:            "required-field",
+			st:              &SinkTransform{Field: "/type", Operation: RemoveTransform},
+			wantErrContains: "is a required cloudevents field",
+		},
+		{
+			name:            "invalid-operation",
+			st:              &SinkTransform{Field: "/data/auth", Operation: "encrypt"},
+			wantErrContains: "invalid transform operation 'encrypt'",
+		},
+		{
+			name:            "value-without-replace",
+			st:              &SinkTransform{Field: "/data/auth", Operation: RedactTransform, Value: "x"},
+			wantErrContains: "value is only supported by the replace operation",
+		},
+		{
+			name: "valid-redact",
+			st:   &SinkTransform{Field: "/data/request_info/client_ip", Operation: RedactTransform},
+		},
+		{
+			name: "valid-replace",
+			st:   &SinkTransform{Field: "/data/auth/email", Operation: ReplaceTransform, Value: "hidden"},
+		},
+		{
+			name: "valid-replace-empty",
+			st:   &SinkTransform{Field: "/data/auth/email", Operation: ReplaceTransform},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.st.Validate()
+			if tt.wantErrContains != "" {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, ErrInvalidParameter)
+				assert.Contains(t, err.Error(), tt.wantErrContains)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_transformFieldPath(t *testing.T) {
+	path, err := transformFieldPath("/data/header/a~1b~0c")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"data", "header", "a/b~c"}, path)
+}
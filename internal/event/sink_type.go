@@ -11,14 +11,15 @@ const (
 	StderrSink SinkType = "stderr" // StderrSink is written to stderr
 	FileSink   SinkType = "file"   // FileSink is written to a file
 	WriterSink SinkType = "writer" // WriterSink is written to an io.Writer
+	SyslogSink SinkType = "syslog" // SyslogSink is written to a syslog collector
 )
 
-type SinkType string // SinkType defines the type of sink in a config stanza (file, stderr, writer)
+type SinkType string // SinkType defines the type of sink in a config stanza (file, stderr, writer, syslog)
 
 func (t SinkType) Validate() error {
 	const op = "event.(SinkType).validate"
 	switch t {
-	case StderrSink, FileSink, WriterSink:
+	case StderrSink, FileSink, WriterSink, SyslogSink:
 		return nil
 	default:
 		return fmt.Errorf("%s: '%s' is not a valid sink type: %w", op, t, ErrInvalidParameter)
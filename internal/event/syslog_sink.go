@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+)
+
+const (
+	UdpSyslogProtocol SyslogProtocol = "udp" // UdpSyslogProtocol sends syslog messages over UDP
+	TcpSyslogProtocol SyslogProtocol = "tcp" // TcpSyslogProtocol sends syslog messages over TCP
+	TlsSyslogProtocol SyslogProtocol = "tls" // TlsSyslogProtocol sends syslog messages over TLS
+
+	// DefaultSyslogFacility is the default facility of syslog messages.
+	DefaultSyslogFacility = "local0"
+	// DefaultSyslogAppName is the default app name of syslog messages.
+	DefaultSyslogAppName = "boundary"
+
+	// syslogTimeout limits how long dialing the collector or writing a
+	// message to it may take.
+	syslogTimeout = 5 * time.Second
+	// syslogAttempts is the number of times writing a message is attempted,
+	// reconnecting to the collector between attempts.
+	syslogAttempts = 3
+	// syslogBackoff is the initial backoff between attempts; it doubles with
+	// each attempt.
+	syslogBackoff = 100 * time.Millisecond
+	// syslogMaxAppNameLen is the max length of the app name of a message.
+	syslogMaxAppNameLen = 48
+)
+
+// syslog severities of events
+const (
+	syslogSeverityErr    = 3
+	syslogSeverityNotice = 5
+	syslogSeverityInfo   = 6
+)
+
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// SyslogProtocol defines the transport of syslog messages (udp, tcp, tls)
+type SyslogProtocol string
+
+// SyslogSinkTypeConfig contains configuration structures for syslog sink types
+type SyslogSinkTypeConfig struct {
+	Address       string         `hcl:"address"`         // Address defines the "host:port" address of the syslog collector
+	Protocol      SyslogProtocol `hcl:"protocol"`        // Protocol defines the transport of messages (udp, tcp or tls). Defaults to tls.
+	Facility      string         `hcl:"facility"`        // Facility defines the facility of messages, like "auth" or "local0". Defaults to local0.
+	AppName       string         `hcl:"app_name"`        // AppName defines the app name of messages. Defaults to boundary.
+	TlsCaCert     string         `hcl:"tls_ca_cert"`     // TlsCaCert defines the PEM encoded CA certificates used to verify the collector, instead of the system roots.
+	TlsServerName string         `hcl:"tls_server_name"` // TlsServerName defines the name used to verify the collector, instead of the host of its address.
+	TlsSkipVerify bool           `hcl:"tls_skip_verify"` // TlsSkipVerify disables the verification of the collector's certificate.
+	TlsClientCert string         `hcl:"tls_client_cert"` // TlsClientCert defines the PEM encoded client certificate presented to the collector.
+	TlsClientKey  string         `hcl:"tls_client_key"`  // TlsClientKey defines the PEM encoded private key of the client certificate.
+}
+
+// Validate the SyslogSinkTypeConfig
+func (c *SyslogSinkTypeConfig) Validate() error {
+	const op = "event.(SyslogSinkTypeConfig).Validate"
+	if c.Address == "" {
+		return fmt.Errorf("%s: missing syslog address: %w", op, ErrInvalidParameter)
+	}
+	if _, _, err := net.SplitHostPort(c.Address); err != nil {
+		return fmt.Errorf("%s: invalid syslog address '%s': %w", op, c.Address, ErrInvalidParameter)
+	}
+	switch c.Protocol {
+	case "", UdpSyslogProtocol, TcpSyslogProtocol, TlsSyslogProtocol:
+	default:
+		return fmt.Errorf("%s: '%s' is not a valid syslog protocol: %w", op, c.Protocol, ErrInvalidParameter)
+	}
+	if _, ok := syslogFacilities[c.Facility]; c.Facility != "" && !ok {
+		return fmt.Errorf("%s: '%s' is not a valid syslog facility: %w", op, c.Facility, ErrInvalidParameter)
+	}
+	if len(c.AppName) > syslogMaxAppNameLen || !isSyslogPrintable(c.AppName) {
+		return fmt.Errorf("%s: app name must be at most %d printable characters: %w", op, syslogMaxAppNameLen, ErrInvalidParameter)
+	}
+	if _, err := c.tlsConfig(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// tlsConfig returns the TLS configuration for the tls protocol, or nil for
+// other protocols.
+func (c *SyslogSinkTypeConfig) tlsConfig() (*tls.Config, error) {
+	const op = "event.(SyslogSinkTypeConfig).tlsConfig"
+	if c.Protocol != "" && c.Protocol != TlsSyslogProtocol {
+		if c.TlsCaCert != "" || c.TlsServerName != "" || c.TlsSkipVerify || c.TlsClientCert != "" || c.TlsClientKey != "" {
+			return nil, fmt.Errorf("%s: tls parameters require the tls protocol: %w", op, ErrInvalidParameter)
+		}
+		return nil, nil
+	}
+	host, _, _ := net.SplitHostPort(c.Address)
+	tc := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         host,
+		InsecureSkipVerify: c.TlsSkipVerify,
+	}
+	if c.TlsServerName != "" {
+		tc.ServerName = c.TlsServerName
+	}
+	if c.TlsCaCert != "" {
+		tc.RootCAs = x509.NewCertPool()
+		if !tc.RootCAs.AppendCertsFromPEM([]byte(c.TlsCaCert)) {
+			return nil, fmt.Errorf("%s: no certificates found in tls ca cert: %w", op, ErrInvalidParameter)
+		}
+	}
+	switch {
+	case c.TlsClientCert != "" && c.TlsClientKey != "":
+		cert, err := tls.X509KeyPair([]byte(c.TlsClientCert), []byte(c.TlsClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid tls client cert: %w", op, ErrInvalidParameter)
+		}
+		tc.Certificates = []tls.Certificate{cert}
+	case c.TlsClientCert != "" || c.TlsClientKey != "":
+		return nil, fmt.Errorf("%s: tls client cert and key must be set together: %w", op, ErrInvalidParameter)
+	}
+	return tc, nil
+}
+
+func isSyslogPrintable(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 33 || s[i] > 126 {
+			return false
+		}
+	}
+	return true
+}
+
+// syslogSink is a sink node which writes events to a syslog collector as RFC
+// 5424 messages. Messages are framed with octet counting (RFC 6587) over tcp
+// and tls. Writes are synchronous, so a slow collector applies backpressure
+// to the pipeline, and a message is retried on a new connection when writing
+// it fails.
+type syslogSink struct {
+	format    SinkFormat
+	protocol  SyslogProtocol
+	address   string
+	facility  int
+	appName   string
+	hostname  string
+	procId    string
+	tlsConfig *tls.Config
+
+	l    sync.Mutex
+	conn net.Conn
+}
+
+var (
+	_ eventlogger.Node   = &syslogSink{}
+	_ eventlogger.Closer = &syslogSink{}
+)
+
+func newSyslogSink(format SinkFormat, c *SyslogSinkTypeConfig) (*syslogSink, error) {
+	const op = "event.newSyslogSink"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing syslog config: %w", op, ErrInvalidParameter)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	tc, err := c.tlsConfig()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s := &syslogSink{
+		format:    format,
+		protocol:  c.Protocol,
+		address:   c.Address,
+		facility:  syslogFacilities[DefaultSyslogFacility],
+		appName:   c.AppName,
+		hostname:  "-",
+		procId:    strconv.Itoa(os.Getpid()),
+		tlsConfig: tc,
+	}
+	if s.protocol == "" {
+		s.protocol = TlsSyslogProtocol
+	}
+	if c.Facility != "" {
+		s.facility = syslogFacilities[c.Facility]
+	}
+	if s.appName == "" {
+		s.appName = DefaultSyslogAppName
+	}
+	if h, err := os.Hostname(); err == nil && h != "" && isSyslogPrintable(h) {
+		s.hostname = h
+	}
+	return s, nil
+}
+
+// Process writes the event to the collector.
+func (s *syslogSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(syslogSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	formatted, ok := e.Format(string(s.format))
+	if !ok {
+		return nil, fmt.Errorf("%s: event is not formatted as %s: %w", op, s.format, ErrInvalidParameter)
+	}
+	msg := s.message(e, formatted)
+
+	s.l.Lock()
+	defer s.l.Unlock()
+	var err error
+	backoff := syslogBackoff
+	for attempt := 0; attempt < syslogAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("%s: %w", op, ctx.Err())
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+		if err = s.write(ctx, msg); err == nil {
+			return nil, nil
+		}
+		// the connection is closed, so the next attempt reconnects
+		s.closeConn()
+	}
+	return nil, fmt.Errorf("%s: unable to write to syslog collector %s: %w", op, s.address, err)
+}
+
+// message formats the event as an RFC 5424 message, framed for the protocol.
+func (s *syslogSink) message(e *eventlogger.Event, formatted []byte) []byte {
+	severity := syslogSeverityInfo
+	switch Type(e.Type) {
+	case ErrorType:
+		severity = syslogSeverityErr
+	case AuditType:
+		severity = syslogSeverityNotice
+	}
+	ts := e.CreatedAt
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	msgId := string(e.Type)
+	if msgId == "" || len(msgId) > 32 || !isSyslogPrintable(msgId) {
+		msgId = "-"
+	}
+	// <PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+	msg := fmt.Appendf(nil, "<%d>1 %s %s %s %s %s - ",
+		s.facility*8+severity,
+		ts.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		s.hostname,
+		s.appName,
+		s.procId,
+		msgId,
+	)
+	msg = append(msg, bytes.TrimRight(formatted, "\n")...)
+	if s.protocol == UdpSyslogProtocol {
+		return msg
+	}
+	return append(fmt.Appendf(nil, "%d ", len(msg)), msg...)
+}
+
+// write writes the message to the collector, connecting to it if required.
+func (s *syslogSink) write(ctx context.Context, msg []byte) error {
+	if s.conn == nil {
+		dialer := &net.Dialer{Timeout: syslogTimeout}
+		var conn net.Conn
+		var err error
+		switch s.protocol {
+		case TlsSyslogProtocol:
+			conn, err = (&tls.Dialer{NetDialer: dialer, Config: s.tlsConfig}).DialContext(ctx, "tcp", s.address)
+		default:
+			conn, err = dialer.DialContext(ctx, string(s.protocol), s.address)
+		}
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(syslogTimeout)); err != nil {
+		return err
+	}
+	_, err := s.conn.Write(msg)
+	return err
+}
+
+func (s *syslogSink) closeConn() {
+	if s.conn != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+}
+
+// Reopen closes the connection to the collector, so the next event is written
+// on a new connection.
+func (s *syslogSink) Reopen() error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.closeConn()
+	return nil
+}
+
+// Close closes the connection to the collector.
+func (s *syslogSink) Close(_ context.Context) error {
+	return s.Reopen()
+}
+
+// Type describes the type of the node as a Sink.
+func (s *syslogSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyslogSinkTypeConfig_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		c               *SyslogSinkTypeConfig
+		wantErrContains string
+	}{
+		{
+			name:            "missing-address",
+			c:               &SyslogSinkTypeConfig{},
+			wantErrContains: "missing syslog address",
+		},
+		{
+			name:            "invalid-address",
+			c:               &SyslogSinkTypeConfig{Address: "localhost"},
+			wantErrContains: "invalid syslog address",
+		},
+		{
+			name:            "invalid-protocol",
+			c:               &SyslogSinkTypeConfig{Address: "localhost:514", Protocol: "http"},
+			wantErrContains: "'http' is not a valid syslog protocol",
+		},
+		{
+			name:            "invalid-facility",
+			c:               &SyslogSinkTypeConfig{Address: "localhost:514", Facility: "local9"},
+			wantErrContains: "'local9' is not a valid syslog facility",
+		},
+		{
+			name:            "invalid-app-name",
+			c:               &SyslogSinkTypeConfig{Address: "localhost:514", AppName: "my app"},
+			wantErrContains: "app name must be at most 48 printable characters",
+		},
+		{
+			name:            "tls-params-without-tls",
+			c:               &SyslogSinkTypeConfig{Address: "localhost:514", Protocol: UdpSyslogProtocol, TlsSkipVerify: true},
+			wantErrContains: "tls parameters require the tls protocol",
+		},
+		{
+			name:            "invalid-ca-cert",
+			c:               &SyslogSinkTypeConfig{Address: "localhost:6514", TlsCaCert: "not a cert"},
+			wantErrContains: "no certificates found in tls ca cert",
+		},
+		{
+			name:            "client-cert-without-key",
+			c:               &SyslogSinkTypeConfig{Address: "localhost:6514", TlsClientCert: "cert"},
+			wantErrContains: "tls client cert and key must be set together",
+		},
+		{
+			name: "valid-tls",
+			c:    &SyslogSinkTypeConfig{Address: "localhost:6514", Facility: "auth", AppName: "boundary-audit"},
+		},
+		{
+			name: "valid-tcp",
+			c:    &SyslogSinkTypeConfig{Address: "localhost:514", Protocol: TcpSyslogProtocol},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.c.Validate()
+			if tt.wantErrContains != "" {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, ErrInvalidParameter)
+				assert.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSyslogSink_Process(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	// each connection is read until its first message, then closed, so the
+	// sink has to reconnect to write the second message
+	msgs := make(chan string, 2)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			r := bufio.NewReader(conn)
+			prefix, err := r.ReadString(' ')
+			if err == nil {
+				if n, err := strconv.Atoi(strings.TrimSpace(prefix)); err == nil {
+					buf := make([]byte, n)
+					if _, err := io.ReadFull(r, buf); err == nil {
+						msgs <- string(buf)
+					}
+				}
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	s, err := newSyslogSink(JSONSinkFormat, &SyslogSinkTypeConfig{
+		Address:  l.Addr().String(),
+		Protocol: TcpSyslogProtocol,
+		Facility: "auth",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close(ctx) })
+	assert.Equal(t, eventlogger.NodeTypeSink, s.Type())
+
+	for _, payload := range []string{`{"id":"1"}`, `{"id":"2"}`} {
+		e := &eventlogger.Event{
+			Type:      eventlogger.EventType(AuditType),
+			CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Formatted: map[string][]byte{string(JSONSinkFormat): []byte(payload + "\n")},
+		}
+		var got string
+		// the write following a closed connection may succeed before the
+		// collector's close is noticed, so retry until the message arrives
+		require.Eventually(t, func() bool {
+			_, err := s.Process(ctx, e)
+			require.NoError(t, err)
+			select {
+			case got = <-msgs:
+				return true
+			case <-time.After(100 * time.Millisecond):
+				return false
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.True(t, strings.HasPrefix(got, "<37>1 2024-01-02T03:04:05.000000Z "), got)
+		assert.Contains(t, got, " boundary "+s.procId+" audit - ")
+		assert.True(t, strings.HasSuffix(got, payload), got)
+	}
+
+	_, err = s.Process(ctx, &eventlogger.Event{Type: eventlogger.EventType(AuditType)})
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+	assert.ErrorContains(t, err, "event is not formatted as cloudevents-json")
+}
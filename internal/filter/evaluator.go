@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-bexpr/grammar"
+	"github.com/mitchellh/pointerstructure"
+)
+
+// Evaluator evaluates a filter expression against a datum.
+type Evaluator interface {
+	Evaluate(datum any) (bool, error)
+}
+
+var _ Evaluator = (*bexpr.Evaluator)(nil)
+
+// CreateEvaluator returns an Evaluator for the filter expression. The
+// expression uses the bexpr syntax, extended with:
+//
+//   - the functions contains(selector, value), in(value, selector) and
+//     matches(selector, pattern), which behave like the bexpr operators of the
+//     same name;
+//   - the functions contains_all(selector, [values]) and
+//     contains_any(selector, [values]), which are true when every value, or
+//     at least one of them, is an element of the selected list or a key of
+//     the selected map, such as contains_all("/tags/role", ["db", "prod"]);
+//   - the comparison of a selected number, or of the length of a selected
+//     value with len(selector), with a number using <, <=, > or >=. A
+//     selected list is compared by its elements, and matches if any of them
+//     does. Strings are compared as the number they hold, if any.
+//
+// The extensions can be combined with bexpr expressions using and, or, not and
+// parentheses, but cannot be used in the body of an any or all expression.
+// Expressions that do not use them are evaluated by bexpr alone.
+func CreateEvaluator(expression string, opt ...Option) (Evaluator, error) {
+	opts := getOpts(opt...)
+	eval, err := bexpr.CreateEvaluator(expression, opts.bexprOpts()...)
+	if err == nil {
+		return eval, nil
+	}
+	root, extended, perr := parse(expression)
+	if extended < 0 {
+		return nil, err
+	}
+	if perr != nil {
+		return nil, perr
+	}
+	e := &evaluator{opts: opts, leaves: map[*leafNode]*bexpr.Evaluator{}}
+	if err := e.compile(expression, root); err != nil {
+		return nil, err
+	}
+	e.root = root
+	return e, nil
+}
+
+// evaluator evaluates expressions that use the extensions.
+type evaluator struct {
+	root   node
+	opts   options
+	leaves map[*leafNode]*bexpr.Evaluator
+}
+
+// compile creates the bexpr evaluators of the leaves of n.
+func (e *evaluator) compile(expression string, n node) error {
+	switch n := n.(type) {
+	case *binaryNode:
+		if err := e.compile(expression, n.left); err != nil {
+			return err
+		}
+		return e.compile(expression, n.right)
+	case *notNode:
+		return e.compile(expression, n.operand)
+	case *leafNode:
+		eval, err := bexpr.CreateEvaluator(n.text, e.opts.bexprOpts()...)
+		if err != nil {
+			return leafErrors(expression, n, err)[0]
+		}
+		e.leaves[n] = eval
+	}
+	return nil
+}
+
+// Evaluate implements the Evaluator interface.
+func (e *evaluator) Evaluate(datum any) (bool, error) {
+	return e.evaluate(e.root, datum)
+}
+
+func (e *evaluator) evaluate(n node, datum any) (bool, error) {
+	switch n := n.(type) {
+	case *binaryNode:
+		left, err := e.evaluate(n.left, datum)
+		if err != nil {
+			return false, err
+		}
+		if left != n.and {
+			// true or ..., false and ...
+			return left, nil
+		}
+		return e.evaluate(n.right, datum)
+	case *notNode:
+		v, err := e.evaluate(n.operand, datum)
+		return !v, err
+	case *leafNode:
+		return e.leaves[n].Evaluate(datum)
+	case *callNode:
+		return e.evaluateCall(n, datum)
+	case *compareNode:
+		return e.evaluateComparison(n, datum)
+	}
+	return false, fmt.Errorf("unknown expression type %T", n)
+}
+
+func (e *evaluator) evaluateCall(n *callNode, datum any) (bool, error) {
+	v, present, err := e.value(datum, n.sel)
+	if err != nil || !present {
+		return false, err
+	}
+	rv := indirect(reflect.ValueOf(v))
+
+	if n.name == "matches" {
+		switch rv.Kind() {
+		case reflect.String:
+			return n.pattern.MatchString(rv.String()), nil
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < rv.Len(); i++ {
+				if s, ok := stringOf(rv.Index(i)); ok && n.pattern.MatchString(s) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		return false, fmt.Errorf("cannot perform matches on a value of type %s", rv.Kind())
+	}
+
+	has := func(value string) (bool, error) {
+		switch rv.Kind() {
+		case reflect.String:
+			return strings.Contains(rv.String(), value), nil
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < rv.Len(); i++ {
+				if equal(rv.Index(i), value) {
+					return true, nil
+				}
+			}
+			return false, nil
+		case reflect.Map:
+			for _, k := range rv.MapKeys() {
+				if equal(k, value) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		return false, fmt.Errorf("cannot perform %s on a value of type %s", n.name, rv.Kind())
+	}
+
+	// contains_any is the only function which is true if any of the values
+	// is found, the others require all of them to be found
+	anyOf := n.name == "contains_any"
+	for _, value := range n.values {
+		found, err := has(value)
+		if err != nil {
+			return false, err
+		}
+		if found == anyOf {
+			return found, nil
+		}
+	}
+	return !anyOf, nil
+}
+
+func (e *evaluator) evaluateComparison(n *compareNode, datum any) (bool, error) {
+	v, present, err := e.value(datum, n.sel)
+	if err != nil || !present {
+		return false, err
+	}
+	rv := indirect(reflect.ValueOf(v))
+
+	compare := func(f float64) bool {
+		switch n.op {
+		case "<":
+			return f < n.value
+		case "<=":
+			return f <= n.value
+		case ">":
+			return f > n.value
+		default:
+			return f >= n.value
+		}
+	}
+
+	if n.length {
+		switch rv.Kind() {
+		case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+			return compare(float64(rv.Len())), nil
+		}
+		return false, fmt.Errorf("cannot take the length of a value of type %s", rv.Kind())
+	}
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		for i := 0; i < rv.Len(); i++ {
+			if f, ok := numberOf(rv.Index(i)); ok && compare(f) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	f, ok := numberOf(rv)
+	return ok && compare(f), nil
+}
+
+// value returns the value selected by sel in datum, like bexpr does: the value
+// is not present if sel selects a missing key of a map.
+func (e *evaluator) value(datum any, sel grammar.Selector) (any, bool, error) {
+	ptr := pointerstructure.Pointer{
+		Parts: sel.Path,
+		Config: pointerstructure.Config{
+			TagName:                 e.opts.withTagName,
+			ValueTransformationHook: e.opts.withHookFn,
+		},
+	}
+	v, err := ptr.Get(datum)
+	if err == nil {
+		return v, true, nil
+	}
+	if errors.Is(err, pointerstructure.ErrNotFound) && len(ptr.Parts) > 1 {
+		ptr.Parts = ptr.Parts[:len(ptr.Parts)-1]
+		if parent, _ := ptr.Get(datum); reflect.ValueOf(parent).Kind() == reflect.Map {
+			return nil, false, nil
+		}
+	}
+	return nil, false, fmt.Errorf("error finding value in datum: %w", err)
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	return v
+}
+
+// stringOf returns v if it is a string.
+func stringOf(v reflect.Value) (string, bool) {
+	v = indirect(v)
+	if v.Kind() != reflect.String {
+		return "", false
+	}
+	return v.String(), true
+}
+
+// numberOf returns v as a number if it is one, or a string holding one.
+func numberOf(v reflect.Value) (float64, bool) {
+	v = indirect(v)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v.String()), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// equal reports whether v is equal to the value written in an expression.
+// Numbers are equal if they hold the same number, whichever way it is written.
+func equal(v reflect.Value, value string) bool {
+	v = indirect(v)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String() == value
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		return err == nil && v.Bool() == b
+	}
+	if f, ok := numberOf(v); ok {
+		g, err := strconv.ParseFloat(value, 64)
+		return err == nil && f == g
+	}
+	return false
+}
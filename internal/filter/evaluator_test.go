@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestCreateEvaluator(t *testing.T) {
+	worker := map[string]any{
+		"name": "w12",
+		"tags": map[string][]string{
+			"role":   {"db", "prod"},
+			"cpus":   {"8"},
+			"region": {"us-east-1"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		want       bool
+		wantErr    string
+	}{
+		{name: "bexpr", expression: `"db" in "/tags/role" and "/name" == "w12"`, want: true},
+		{name: "contains_all", expression: `contains_all("/tags/role", ["prod", "db"])`, want: true},
+		{name: "contains_all missing value", expression: `contains_all("/tags/role", ["db", "web"])`, want: false},
+		{name: "contains_all empty", expression: `contains_all("/tags/role", [])`, want: true},
+		{name: "contains_any", expression: `contains_any("/tags/role", ["web", "db"])`, want: true},
+		{name: "contains_any none", expression: `contains_any("/tags/role", ["web"])`, want: false},
+		{name: "contains_any map keys", expression: `contains_any("/tags", ["zone", "region"])`, want: true},
+		{name: "contains", expression: `contains(tags.role, "prod")`, want: true},
+		{name: "contains string", expression: `contains("/name", "w1")`, want: true},
+		{name: "in", expression: `in("db", "/tags/role")`, want: true},
+		{name: "matches", expression: `matches("/name", "^w[0-9]+$")`, want: true},
+		{name: "matches list", expression: `matches(tags["region"], "^eu-")`, want: false},
+		{name: "greater or equal", expression: `"/tags/cpus" >= 8`, want: true},
+		{name: "greater", expression: `"/tags/cpus" > 8`, want: false},
+		{name: "not a number", expression: `"/tags/region" < 100`, want: false},
+		{name: "len", expression: `len("/tags/role") >= 2`, want: true},
+		{name: "len of string", expression: `len(name) < 3`, want: false},
+		{name: "missing tag", expression: `contains_all("/tags/zone", ["a"]) or "/tags/zone" > 1`, want: false},
+		{name: "not", expression: `not contains_any("/tags/role", ["web"])`, want: true},
+		{
+			name:       "combined",
+			expression: `"/name" == "w12" and (contains("/tags/role", "web") or len("/tags/role") > 1) and not "/tags/cpus" < 4`,
+			want:       true,
+		},
+		{
+			name:       "function name as selector",
+			expression: `"/tags/cpus" > 2 and contains is empty`,
+			wantErr:    "error finding value in datum",
+		},
+		{
+			name:       "bexpr syntax error",
+			expression: `"/name" ==`,
+			wantErr:    "no match found",
+		},
+		{
+			name:       "bexpr syntax error next to extension",
+			expression: `len(name) > 2 and "/name" ==`,
+			wantErr:    "1:29: no match found",
+		},
+		{
+			name:       "wrong number of arguments",
+			expression: `contains("/tags/role", "db", "prod")`,
+			wantErr:    `1:28: "contains" takes 2 arguments`,
+		},
+		{
+			name:       "invalid regular expression",
+			expression: `matches("/name", "[")`,
+			wantErr:    `1:18: invalid regular expression "["`,
+		},
+		{
+			name:       "comparison without number",
+			expression: `"/tags/cpus" > "8"`,
+			wantErr:    `1:16: expected a number after ">"`,
+		},
+		{
+			name:       "unclosed call",
+			expression: `contains_any("/tags/role", ["db"]`,
+			wantErr:    `1:34: expected ")"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eval, err := CreateEvaluator(tt.expression)
+			if err == nil {
+				var got bool
+				got, err = eval.Evaluate(worker)
+				if tt.wantErr == "" {
+					require.NoError(t, err)
+					assert.Equal(t, tt.want, got)
+					return
+				}
+			}
+			require.Error(t, err)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("bexpr only", func(t *testing.T) {
+		eval, err := CreateEvaluator(`"/name" == "w12"`)
+		require.NoError(t, err)
+		assert.IsType(t, &bexpr.Evaluator{}, eval)
+	})
+}
+
+func TestCreateEvaluator_Options(t *testing.T) {
+	type resource struct {
+		Name  *wrapperspb.StringValue `json:"name"`
+		Count uint32                  `json:"count"`
+		Tags  []string                `json:"tags"`
+	}
+	item := struct {
+		Item *resource `json:"item"`
+	}{
+		Item: &resource{
+			Name:  wrapperspb.String("prod-db"),
+			Count: 12,
+			Tags:  []string{"a", "b"},
+		},
+	}
+
+	eval, err := CreateEvaluator(
+		`"/item/count" > 10 and "/item/name" matches "^prod-" and contains_all("/item/tags", ["b", "a"])`,
+		WithTagName("json"),
+		WithHookFn(WellKnownTypeFilterHook),
+	)
+	require.NoError(t, err)
+	got, err := eval.Evaluate(item)
+	require.NoError(t, err)
+	assert.True(t, got)
+
+	eval, err = CreateEvaluator(`"/item/count" < 10 or contains("/item/tags", "c")`, WithTagName("json"))
+	require.NoError(t, err)
+	got, err = eval.Evaluate(item)
+	require.NoError(t, err)
+	assert.False(t, got)
+}
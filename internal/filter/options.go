@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filter
+
+import "github.com/hashicorp/go-bexpr"
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withTagName string
+	withHookFn  bexpr.ValueTransformationHookFn
+}
+
+func getDefaultOptions() options {
+	return options{
+		// The default tag name of bexpr
+		withTagName: "bexpr",
+	}
+}
+
+// WithTagName provides an option to name the struct tag used to look up the
+// fields selected by an expression, instead of "bexpr".
+func WithTagName(name string) Option {
+	return func(o *options) {
+		o.withTagName = name
+	}
+}
+
+// WithHookFn provides an option to transform the values found while
+// resolving selectors, such as WellKnownTypeFilterHook.
+func WithHookFn(fn bexpr.ValueTransformationHookFn) Option {
+	return func(o *options) {
+		o.withHookFn = fn
+	}
+}
+
+// bexprOpts returns the bexpr options matching opts.
+func (o options) bexprOpts() []bexpr.Option {
+	ret := []bexpr.Option{bexpr.WithTagName(o.withTagName)}
+	if o.withHookFn != nil {
+		ret = append(ret, bexpr.WithHookFn(o.withHookFn))
+	}
+	return ret
+}
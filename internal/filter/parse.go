@@ -0,0 +1,488 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr/grammar"
+)
+
+// The filter language extends bexpr with function calls and numeric
+// comparisons. bexpr cannot be extended, so expressions that use the
+// extensions are parsed here down to the boolean operators joining them, and
+// every other part of the expression is left for bexpr to parse as a leaf.
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenNumber
+	tokenPunct
+	tokenCompare
+	tokenOther
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) is(kind tokenKind, text string) bool {
+	return t.kind == kind && t.text == text
+}
+
+// lex splits expression into tokens. Only the tokens the extensions need are
+// told apart; the others are kept as tokenOther for bexpr to make sense of.
+func lex(expression string) []token {
+	var toks []token
+	i := 0
+	for i < len(expression) {
+		c := expression[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+			continue
+		case c == '"' || c == '`':
+			// bexpr strings have no escapes, they end at the next quote
+			if end := strings.IndexByte(expression[i+1:], c); end >= 0 {
+				i += end + 2
+			} else {
+				i = len(expression)
+			}
+			toks = append(toks, token{kind: tokenString, text: expression[start:i], pos: start})
+		case isDigit(c) || (c == '-' && i+1 < len(expression) && isDigit(expression[i+1])):
+			i++
+			for i < len(expression) && (isDigit(expression[i]) || expression[i] == '.') {
+				i++
+			}
+			toks = append(toks, token{kind: tokenNumber, text: expression[start:i], pos: start})
+		case isLetter(c) || c == '_':
+			for i < len(expression) && (isLetter(expression[i]) || isDigit(expression[i]) || expression[i] == '_' || expression[i] == '/') {
+				i++
+			}
+			toks = append(toks, token{kind: tokenWord, text: expression[start:i], pos: start})
+		case strings.IndexByte("()[]{},.", c) >= 0:
+			i++
+			toks = append(toks, token{kind: tokenPunct, text: expression[start:i], pos: start})
+		case c == '<' || c == '>':
+			i++
+			if i < len(expression) && expression[i] == '=' {
+				i++
+			}
+			toks = append(toks, token{kind: tokenCompare, text: expression[start:i], pos: start})
+		default:
+			i++
+			for i < len(expression) && (expression[i] == '=' || expression[i] == '!') {
+				i++
+			}
+			toks = append(toks, token{kind: tokenOther, text: expression[start:i], pos: start})
+		}
+	}
+	return append(toks, token{kind: tokenEOF, pos: len(expression)})
+}
+
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+func isLetter(c byte) bool { return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') }
+
+type node interface {
+	isNode()
+}
+
+type binaryNode struct {
+	and         bool
+	left, right node
+}
+
+type notNode struct {
+	operand node
+}
+
+// leafNode is a part of the expression that does not use the extensions.
+type leafNode struct {
+	text string
+	pos  int
+}
+
+// callNode is a call to a function that returns a boolean.
+type callNode struct {
+	name    string
+	sel     grammar.Selector
+	selPos  int
+	values  []string
+	pattern *regexp.Regexp
+}
+
+// compareNode is a numeric comparison of the selected value, or of its length.
+type compareNode struct {
+	sel    grammar.Selector
+	selPos int
+	length bool
+	op     string
+	value  float64
+}
+
+func (*binaryNode) isNode()  {}
+func (*notNode) isNode()     {}
+func (*leafNode) isNode()    {}
+func (*callNode) isNode()    {}
+func (*compareNode) isNode() {}
+
+type argKind int
+
+const (
+	argSelector argKind = iota
+	argValue
+	argList
+	argPattern
+)
+
+// functions are the functions that return a boolean, with the kinds of their
+// arguments.
+var functions = map[string][]argKind{
+	"contains":     {argSelector, argValue},
+	"in":           {argValue, argSelector},
+	"matches":      {argSelector, argPattern},
+	"contains_all": {argSelector, argList},
+	"contains_any": {argSelector, argList},
+}
+
+type parser struct {
+	expression string
+	toks       []token
+	i          int
+
+	// extended is set to the offset of the first part of the expression
+	// known to use the extensions, which means that errors are better reported
+	// by this parser than by bexpr. It is -1 if there is none.
+	extended int
+}
+
+// parse parses expression, and returns the offset of the first use of the
+// extensions, or -1 if it does not use them.
+func parse(expression string) (node, int, *ValidationError) {
+	p := &parser{expression: expression, toks: lex(expression), extended: -1}
+	n, err := p.parseOr()
+	if err == nil && p.peek().kind != tokenEOF {
+		err = p.errorAt(p.peek().pos, fmt.Sprintf("unexpected %q", p.peek().text))
+	}
+	if err != nil {
+		return nil, p.extended, err
+	}
+	return n, p.extended, nil
+}
+
+func (p *parser) setExtended() {
+	if p.extended < 0 {
+		p.extended = p.peek().pos
+	}
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) errorAt(pos int, msg string) *ValidationError {
+	return &ValidationError{Message: msg, Position: positionAt(p.expression, pos)}
+}
+
+func (p *parser) expect(text string) (token, *ValidationError) {
+	t := p.next()
+	if !t.is(tokenPunct, text) {
+		return t, p.errorAt(t.pos, fmt.Sprintf("expected %q", text))
+	}
+	return t, nil
+}
+
+func (p *parser) parseOr() (node, *ValidationError) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().is(tokenWord, "or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, *ValidationError) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().is(tokenWord, "and") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, *ValidationError) {
+	if p.peek().is(tokenWord, "not") {
+		p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, *ValidationError) {
+	t := p.peek()
+	switch {
+	case t.is(tokenPunct, "("):
+		p.next()
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return n, nil
+	case t.kind == tokenWord && p.toks[p.i+1].is(tokenPunct, "("):
+		if t.text == "len" {
+			return p.parseComparison()
+		}
+		if _, ok := functions[t.text]; ok {
+			return p.parseCall()
+		}
+	}
+	return p.parseLeaf()
+}
+
+// parseLeaf consumes the tokens up to the next boolean operator or closing
+// parenthesis that is not nested in the leaf. A leaf comparing a value with a
+// number is a comparison, any other is left for bexpr.
+func (p *parser) parseLeaf() (node, *ValidationError) {
+	start := p.i
+	depth := 0
+	compare := -1
+scan:
+	for ; ; p.i++ {
+		t := p.toks[p.i]
+		switch {
+		case t.kind == tokenEOF:
+			break scan
+		case t.kind == tokenPunct && strings.Contains("([{", t.text):
+			depth++
+		case t.kind == tokenPunct && strings.Contains(")]}", t.text):
+			if depth == 0 {
+				break scan
+			}
+			depth--
+		case depth == 0 && (t.is(tokenWord, "and") || t.is(tokenWord, "or")) && (p.i == start || !p.toks[p.i-1].is(tokenPunct, ".")):
+			break scan
+		case depth == 0 && t.kind == tokenCompare && compare < 0:
+			compare = p.i
+		}
+	}
+	if p.i == start {
+		return nil, p.errorAt(p.peek().pos, "expected an expression")
+	}
+	if compare >= 0 {
+		end := p.i
+		p.i = start
+		n, err := p.parseComparison()
+		if err == nil && p.i != end {
+			err = p.errorAt(p.peek().pos, fmt.Sprintf("unexpected %q", p.peek().text))
+		}
+		return n, err
+	}
+	return &leafNode{
+		text: p.expression[p.toks[start].pos:p.endOf(p.toks[p.i-1])],
+		pos:  p.toks[start].pos,
+	}, nil
+}
+
+func (p *parser) endOf(t token) int {
+	return t.pos + len(t.text)
+}
+
+// parseComparison parses a selector, or a call to len with a selector, then a
+// comparison operator and a number.
+func (p *parser) parseComparison() (node, *ValidationError) {
+	p.setExtended()
+	n := &compareNode{}
+	var err *ValidationError
+	if p.peek().is(tokenWord, "len") && p.toks[p.i+1].is(tokenPunct, "(") {
+		p.i += 2
+		n.length = true
+		if n.sel, n.selPos, err = p.parseSelector(); err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	} else if n.sel, n.selPos, err = p.parseSelector(); err != nil {
+		return nil, err
+	}
+
+	op := p.next()
+	if op.kind != tokenCompare {
+		if n.length {
+			return nil, p.errorAt(op.pos, `the result of "len" must be compared with "<", "<=", ">" or ">="`)
+		}
+		return nil, p.errorAt(op.pos, `expected "<", "<=", ">" or ">="`)
+	}
+	n.op = op.text
+	num := p.next()
+	if num.kind != tokenNumber {
+		return nil, p.errorAt(num.pos, fmt.Sprintf("expected a number after %q", op.text))
+	}
+	v, perr := strconv.ParseFloat(num.text, 64)
+	if perr != nil {
+		return nil, p.errorAt(num.pos, fmt.Sprintf("invalid number %q", num.text))
+	}
+	n.value = v
+	return n, nil
+}
+
+func (p *parser) parseCall() (node, *ValidationError) {
+	p.setExtended()
+	name := p.next()
+	p.next() // (
+	n := &callNode{name: name.text}
+	for i, kind := range functions[name.text] {
+		if i > 0 {
+			if _, err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		var err *ValidationError
+		switch kind {
+		case argSelector:
+			n.sel, n.selPos, err = p.parseSelector()
+		case argValue:
+			var v string
+			v, _, err = p.parseValue()
+			n.values = []string{v}
+		case argPattern:
+			var v string
+			var pos int
+			if v, pos, err = p.parseValue(); err == nil {
+				var rerr error
+				if n.pattern, rerr = regexp.Compile(v); rerr != nil {
+					err = p.errorAt(pos, fmt.Sprintf("invalid regular expression %q: %s", v, rerr))
+				}
+			}
+		case argList:
+			n.values, err = p.parseList()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if t, err := p.expect(")"); err != nil {
+		if t.is(tokenPunct, ",") {
+			return nil, p.errorAt(t.pos, fmt.Sprintf("%q takes %d arguments", name.text, len(functions[name.text])))
+		}
+		return nil, err
+	}
+	return n, nil
+}
+
+// parseSelector parses a selector with bexpr, so that it is the same as the
+// selectors of the rest of the expression.
+func (p *parser) parseSelector() (grammar.Selector, int, *ValidationError) {
+	start := p.peek()
+	switch start.kind {
+	case tokenString:
+		p.next()
+	case tokenWord:
+		p.next()
+		for {
+			t := p.peek()
+			switch {
+			case t.is(tokenPunct, ".") && (p.toks[p.i+1].kind == tokenWord || p.toks[p.i+1].kind == tokenNumber):
+				p.i += 2
+				continue
+			case t.is(tokenPunct, "[") && p.toks[p.i+1].kind == tokenString && p.toks[p.i+2].is(tokenPunct, "]"):
+				p.i += 3
+				continue
+			}
+			break
+		}
+	default:
+		return grammar.Selector{}, 0, p.errorAt(start.pos, "expected a selector")
+	}
+	text := p.expression[start.pos:p.endOf(p.toks[p.i-1])]
+	ast, err := grammar.Parse("", []byte(text+" is empty"))
+	if err != nil {
+		return grammar.Selector{}, 0, p.errorAt(start.pos, fmt.Sprintf("invalid selector %s", text))
+	}
+	m, ok := ast.(*grammar.MatchExpression)
+	if !ok {
+		return grammar.Selector{}, 0, p.errorAt(start.pos, fmt.Sprintf("invalid selector %s", text))
+	}
+	return m.Selector, start.pos, nil
+}
+
+// parseValue parses a string or a number, and returns it as a string.
+func (p *parser) parseValue() (string, int, *ValidationError) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber:
+		return t.text, t.pos, nil
+	case tokenString:
+		v, err := strconv.Unquote(t.text)
+		if err != nil {
+			return "", 0, p.errorAt(t.pos, "unterminated string literal")
+		}
+		return v, t.pos, nil
+	}
+	return "", 0, p.errorAt(t.pos, "expected a string or a number")
+}
+
+// parseList parses a list of strings and numbers in brackets.
+func (p *parser) parseList() ([]string, *ValidationError) {
+	if _, err := p.expect("["); err != nil {
+		return nil, err
+	}
+	values := []string{}
+	if p.peek().is(tokenPunct, "]") {
+		p.next()
+		return values, nil
+	}
+	for {
+		v, _, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+		t := p.next()
+		if t.is(tokenPunct, "]") {
+			return values, nil
+		}
+		if !t.is(tokenPunct, ",") {
+			return nil, p.errorAt(t.pos, `expected "," or "]"`)
+		}
+	}
+}
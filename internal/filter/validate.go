@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filter
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/go-bexpr/grammar"
+)
+
+// Kind is a kind of filter expression, which determines the data the
+// expression is evaluated against.
+type Kind string
+
+const (
+	// WorkerKind is the kind of the worker filters of targets, host catalogs
+	// and storage buckets, evaluated against the name and tags of workers.
+	WorkerKind Kind = "worker"
+
+	// ManagedGroupKind is the kind of the filters of OIDC managed groups,
+	// evaluated against the claims of the ID token and of the userinfo
+	// endpoint.
+	ManagedGroupKind Kind = "managed-group"
+
+	// ListKind is the kind of the filters of list requests, evaluated against
+	// each listed item.
+	ListKind Kind = "list"
+)
+
+// selectorRoots are the top level fields that can be selected by each kind of
+// filter, mapped to whether the field can be indexed.
+var selectorRoots = map[Kind]map[string]bool{
+	WorkerKind: {
+		"name": false,
+		"tags": true,
+	},
+	ManagedGroupKind: {
+		"token":    true,
+		"userinfo": true,
+	},
+	ListKind: {
+		"item": true,
+	},
+}
+
+// extensionKinds are the kinds of filters which are evaluated with
+// CreateEvaluator, and so can use its extensions of the bexpr syntax.
+var extensionKinds = map[Kind]bool{
+	WorkerKind: true,
+	ListKind:   true,
+}
+
+// ParseKind returns the Kind with the given name.
+func ParseKind(s string) (Kind, error) {
+	k := Kind(s)
+	if _, ok := selectorRoots[k]; !ok {
+		return "", fmt.Errorf("unknown filter kind %q", s)
+	}
+	return k, nil
+}
+
+// Position is a position in a filter expression.
+type Position struct {
+	// Line is the line of the position, starting at 1.
+	Line int
+	// Column is the column of the position in characters, starting at 1.
+	Column int
+	// Offset is the offset of the position in bytes, starting at 0.
+	Offset int
+}
+
+// ValidationError is a problem found in a filter expression.
+type ValidationError struct {
+	Message string
+	// Position is where the problem was found. It is nil if the problem is
+	// not tied to a part of the expression.
+	Position *Position
+}
+
+func (e *ValidationError) Error() string {
+	if e.Position == nil {
+		return e.Message
+	}
+	return fmt.Sprintf("%d:%d: %s", e.Position.Line, e.Position.Column, e.Message)
+}
+
+// parseErrorRegex matches the errors reported by the bexpr parser, one per
+// line, which are prefixed with their position as "line:column (offset)".
+var parseErrorRegex = regexp.MustCompile(`^(\d+):(\d+) \((\d+)\): (?:rule [^:]+: )?(.*)$`)
+
+// Validate parses the filter expression, which can use the extensions of the
+// bexpr syntax described by CreateEvaluator, and checks it against the kind of
+// filter: selectors must refer to fields of the data the filter is evaluated
+// against, and regular expressions must compile. It returns the problems
+// found, which is empty if the expression is valid. An error is returned if
+// kind is unknown.
+func Validate(expression string, kind Kind) ([]*ValidationError, error) {
+	roots, ok := selectorRoots[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown filter kind %q", kind)
+	}
+	if strings.TrimSpace(expression) == "" {
+		return []*ValidationError{{Message: "filter expression is empty"}}, nil
+	}
+
+	v := &validator{expression: expression, roots: roots}
+	ast, err := grammar.Parse("", []byte(expression))
+	if err == nil {
+		v.walk(ast.(grammar.Expression), nil)
+		return v.errs, nil
+	}
+	root, extended, perr := parse(expression)
+	switch {
+	case extended < 0:
+		return parseErrors(err), nil
+	case !extensionKinds[kind]:
+		return []*ValidationError{{
+			Message:  fmt.Sprintf("functions and numeric comparisons are not supported in %s filters", kind),
+			Position: positionAt(expression, extended),
+		}}, nil
+	case perr != nil:
+		return []*ValidationError{perr}, nil
+	}
+	v.walkNode(root)
+	return v.errs, nil
+}
+
+// leafErrors converts an error of the bexpr parser on a leaf of an expression
+// that uses the extensions into validation errors positioned in expression.
+func leafErrors(expression string, leaf *leafNode, err error) []*ValidationError {
+	errs := parseErrors(err)
+	for _, e := range errs {
+		if e.Position != nil {
+			e.Position = positionAt(expression, leaf.pos+e.Position.Offset)
+		}
+	}
+	return errs
+}
+
+// parseErrors converts an error of the bexpr parser into validation errors.
+func parseErrors(err error) []*ValidationError {
+	var errs []*ValidationError
+	for _, line := range strings.Split(err.Error(), "\n") {
+		m := parseErrorRegex.FindStringSubmatch(line)
+		if m == nil {
+			errs = append(errs, &ValidationError{Message: line})
+			continue
+		}
+		// The pattern only matches digits
+		l, _ := strconv.Atoi(m[1])
+		c, _ := strconv.Atoi(m[2])
+		o, _ := strconv.Atoi(m[3])
+		errs = append(errs, &ValidationError{
+			Message:  m[4],
+			Position: &Position{Line: l, Column: c, Offset: o},
+		})
+	}
+	return errs
+}
+
+type validator struct {
+	expression string
+	roots      map[string]bool
+	errs       []*ValidationError
+
+	// cursor is the offset after the last selector found in the expression.
+	// The syntax tree does not record positions, so selectors are located
+	// by searching the expression in the order they appear in the tree.
+	cursor int
+}
+
+// walk checks expr. bound holds the names bound by the enclosing collection
+// expressions, which selectors can refer to in addition to the roots.
+func (v *validator) walk(expr grammar.Expression, bound map[string]bool) {
+	switch e := expr.(type) {
+	case *grammar.UnaryExpression:
+		v.walk(e.Operand, bound)
+	case *grammar.BinaryExpression:
+		v.walk(e.Left, bound)
+		v.walk(e.Right, bound)
+	case *grammar.MatchExpression:
+		pos := v.checkSelector(e.Selector, bound)
+		if (e.Operator == grammar.MatchMatches || e.Operator == grammar.MatchNotMatches) && e.Value != nil {
+			if _, err := regexp.Compile(e.Value.Raw); err != nil {
+				v.errs = append(v.errs, &ValidationError{
+					Message:  fmt.Sprintf("invalid regular expression %q: %s", e.Value.Raw, err),
+					Position: pos,
+				})
+			}
+		}
+	case *grammar.CollectionExpression:
+		v.checkSelector(e.Selector, bound)
+		inner := make(map[string]bool, len(bound)+2)
+		for k := range bound {
+			inner[k] = true
+		}
+		for _, name := range []string{e.NameBinding.Default, e.NameBinding.Index, e.NameBinding.Value} {
+			if name != "" {
+				inner[name] = true
+			}
+		}
+		v.walk(e.Inner, inner)
+	}
+}
+
+// walkNode checks an expression that uses the extensions.
+func (v *validator) walkNode(n node) {
+	switch n := n.(type) {
+	case *binaryNode:
+		v.walkNode(n.left)
+		v.walkNode(n.right)
+	case *notNode:
+		v.walkNode(n.operand)
+	case *leafNode:
+		ast, err := grammar.Parse("", []byte(n.text))
+		if err != nil {
+			v.errs = append(v.errs, leafErrors(v.expression, n, err)...)
+			return
+		}
+		v.cursor = n.pos
+		v.walk(ast.(grammar.Expression), nil)
+	case *callNode:
+		v.cursor = n.selPos
+		v.checkSelector(n.sel, nil)
+	case *compareNode:
+		v.cursor = n.selPos
+		v.checkSelector(n.sel, nil)
+	}
+}
+
+// checkSelector records an error if sel does not refer to a known field, and
+// returns the position of the selector if it can be located.
+func (v *validator) checkSelector(sel grammar.Selector, bound map[string]bool) *Position {
+	pos := v.locate(sel)
+	if len(sel.Path) == 0 || bound[sel.Path[0]] {
+		return pos
+	}
+	indexable, ok := v.roots[sel.Path[0]]
+	switch {
+	case !ok:
+		v.errs = append(v.errs, &ValidationError{
+			Message:  fmt.Sprintf("unknown selector %q, must start with %s", selectorString(sel), v.rootList()),
+			Position: pos,
+		})
+	case !indexable && len(sel.Path) > 1:
+		v.errs = append(v.errs, &ValidationError{
+			Message:  fmt.Sprintf("invalid selector %q, %q has no fields", selectorString(sel), sel.Path[0]),
+			Position: pos,
+		})
+	}
+	return pos
+}
+
+// locate returns the position of the next occurrence of sel in the
+// expression, or nil if it is not found.
+func (v *validator) locate(sel grammar.Selector) *Position {
+	if len(sel.Path) == 0 {
+		return nil
+	}
+	// Identifiers must not be part of a longer word or of a quoted value
+	pattern := `(?:^|[^\w"/.])(` + regexp.QuoteMeta(sel.Path[0]) + `)\b`
+	if sel.Type == grammar.SelectorTypeJsonPointer {
+		pattern = `("/` + regexp.QuoteMeta(sel.Path[0]) + `)`
+	}
+	m := regexp.MustCompile(pattern).FindStringSubmatchIndex(v.expression[v.cursor:])
+	if m == nil {
+		return nil
+	}
+	offset := v.cursor + m[2]
+	v.cursor += m[3]
+	return positionAt(v.expression, offset)
+}
+
+func (v *validator) rootList() string {
+	var names []string
+	for _, r := range slices.Sorted(maps.Keys(v.roots)) {
+		names = append(names, strconv.Quote(r))
+	}
+	return strings.Join(names, " or ")
+}
+
+// selectorString returns sel as it is written in a filter.
+func selectorString(sel grammar.Selector) string {
+	if sel.Type == grammar.SelectorTypeJsonPointer {
+		return "/" + strings.Join(sel.Path, "/")
+	}
+	return sel.String()
+}
+
+// positionAt returns the position of the byte at offset in s.
+func positionAt(s string, offset int) *Position {
+	before := s[:offset]
+	line := strings.Count(before, "\n") + 1
+	if i := strings.LastIndexByte(before, '\n'); i >= 0 {
+		before = before[i+1:]
+	}
+	return &Position{Line: line, Column: utf8.RuneCountInString(before) + 1, Offset: offset}
+}
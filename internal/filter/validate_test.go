@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		kind       Kind
+		want       []*ValidationError
+	}{
+		{
+			name:       "valid worker",
+			expression: `"/name" == "w1" or "dev" in "/tags/env"`,
+			kind:       WorkerKind,
+		},
+		{
+			name:       "valid bexpr selectors",
+			expression: `name matches "^w[0-9]+$" and tags["env"] is not empty`,
+			kind:       WorkerKind,
+		},
+		{
+			name:       "valid managed group",
+			expression: `"/token/sub" == "alice" and "admins" in "/userinfo/groups"`,
+			kind:       ManagedGroupKind,
+		},
+		{
+			name:       "valid list",
+			expression: `"/item/name" == "prod" and not ("/item/scope/type" == "global")`,
+			kind:       ListKind,
+		},
+		{
+			name:       "collection binding",
+			expression: `any "/item/tags" as k, v { k == "env" }`,
+			kind:       ListKind,
+		},
+		{
+			name:       "empty",
+			expression: " ",
+			kind:       WorkerKind,
+			want:       []*ValidationError{{Message: "filter expression is empty"}},
+		},
+		{
+			name:       "syntax error",
+			expression: "\"dev\" in \"/tags/env\" and\n  \"/name\" ==",
+			kind:       WorkerKind,
+			want: []*ValidationError{{
+				Message:  `no match found, expected: "-", "0", "\"", "` + "`" + `", [ \t\r\n], [1-9] or [a-zA-Z]`,
+				Position: &Position{Line: 2, Column: 13, Offset: 37},
+			}},
+		},
+		{
+			name:       "unknown selector",
+			expression: `"/name" == "w1" and "/tag/env" == "dev"`,
+			kind:       WorkerKind,
+			want: []*ValidationError{{
+				Message:  `unknown selector "/tag/env", must start with "name" or "tags"`,
+				Position: &Position{Line: 1, Column: 21, Offset: 20},
+			}},
+		},
+		{
+			name:       "unknown bexpr selector",
+			expression: `name == "name" and "aud" in userinfo.aud`,
+			kind:       WorkerKind,
+			want: []*ValidationError{{
+				Message:  `unknown selector "userinfo.aud", must start with "name" or "tags"`,
+				Position: &Position{Line: 1, Column: 29, Offset: 28},
+			}},
+		},
+		{
+			name:       "field of scalar",
+			expression: `"/name/first" == "w1"`,
+			kind:       WorkerKind,
+			want: []*ValidationError{{
+				Message:  `invalid selector "/name/first", "name" has no fields`,
+				Position: &Position{Line: 1, Column: 1, Offset: 0},
+			}},
+		},
+		{
+			name:       "wrong kind",
+			expression: `"/name" == "w1"`,
+			kind:       ListKind,
+			want: []*ValidationError{{
+				Message:  `unknown selector "/name", must start with "item"`,
+				Position: &Position{Line: 1, Column: 1, Offset: 0},
+			}},
+		},
+		{
+			name:       "invalid regular expression",
+			expression: `"/token/email" matches "[a-z"`,
+			kind:       ManagedGroupKind,
+			want: []*ValidationError{{
+				Message:  "invalid regular expression \"[a-z\": error parsing regexp: missing closing ]: `[a-z`",
+				Position: &Position{Line: 1, Column: 1, Offset: 0},
+			}},
+		},
+		{
+			name:       "valid extensions",
+			expression: `contains_all("/tags/role", ["db", "prod"]) and "/tags/cpus" >= 4 and name matches "^w"`,
+			kind:       WorkerKind,
+		},
+		{
+			name:       "unknown selector in function",
+			expression: `"/name" == "w1" and contains_any("/tag/role", ["db"])`,
+			kind:       WorkerKind,
+			want: []*ValidationError{{
+				Message:  `unknown selector "/tag/role", must start with "name" or "tags"`,
+				Position: &Position{Line: 1, Column: 34, Offset: 33},
+			}},
+		},
+		{
+			name:       "syntax error next to extension",
+			expression: "len(\"/tags/role\") > 1 and\n\"/name\" == ",
+			kind:       WorkerKind,
+			want: []*ValidationError{{
+				Message:  `no match found, expected: "-", "0", "\"", "` + "`" + `", [ \t\r\n], [1-9] or [a-zA-Z]`,
+				Position: &Position{Line: 2, Column: 11, Offset: 36},
+			}},
+		},
+		{
+			name:       "invalid function arguments",
+			expression: `contains_all("/tags/role", "db")`,
+			kind:       WorkerKind,
+			want: []*ValidationError{{
+				Message:  `expected "["`,
+				Position: &Position{Line: 1, Column: 28, Offset: 27},
+			}},
+		},
+		{
+			name:       "extensions in managed group",
+			expression: `"/token/sub" == "alice" and contains_any("/userinfo/groups", ["admins"])`,
+			kind:       ManagedGroupKind,
+			want: []*ValidationError{{
+				Message:  "functions and numeric comparisons are not supported in managed-group filters",
+				Position: &Position{Line: 1, Column: 29, Offset: 28},
+			}},
+		},
+		{
+			name:       "len not compared",
+			expression: `len("/tags/role") == 2`,
+			kind:       WorkerKind,
+			want: []*ValidationError{{
+				Message:  `the result of "len" must be compared with "<", "<=", ">" or ">="`,
+				Position: &Position{Line: 1, Column: 19, Offset: 18},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Validate(tt.expression, tt.kind)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("unknown kind", func(t *testing.T) {
+		_, err := Validate(`"/name" == "w1"`, "session")
+		assert.ErrorContains(t, err, `unknown filter kind "session"`)
+	})
+}
+
+func TestParseKind(t *testing.T) {
+	for _, k := range []Kind{WorkerKind, ManagedGroupKind, ListKind} {
+		got, err := ParseKind(string(k))
+		require.NoError(t, err)
+		assert.Equal(t, k, got)
+	}
+	_, err := ParseKind("session")
+	assert.Error(t, err)
+}
@@ -22,13 +22,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AuthMethodService_GetAuthMethod_FullMethodName    = "/controller.api.services.v1.AuthMethodService/GetAuthMethod"
-	AuthMethodService_ListAuthMethods_FullMethodName  = "/controller.api.services.v1.AuthMethodService/ListAuthMethods"
-	AuthMethodService_CreateAuthMethod_FullMethodName = "/controller.api.services.v1.AuthMethodService/CreateAuthMethod"
-	AuthMethodService_UpdateAuthMethod_FullMethodName = "/controller.api.services.v1.AuthMethodService/UpdateAuthMethod"
-	AuthMethodService_DeleteAuthMethod_FullMethodName = "/controller.api.services.v1.AuthMethodService/DeleteAuthMethod"
-	AuthMethodService_ChangeState_FullMethodName      = "/controller.api.services.v1.AuthMethodService/ChangeState"
-	AuthMethodService_Authenticate_FullMethodName     = "/controller.api.services.v1.AuthMethodService/Authenticate"
+	AuthMethodService_GetAuthMethod_FullMethodName                   = "/controller.api.services.v1.AuthMethodService/GetAuthMethod"
+	AuthMethodService_ListAuthMethods_FullMethodName                 = "/controller.api.services.v1.AuthMethodService/ListAuthMethods"
+	AuthMethodService_CreateAuthMethod_FullMethodName                = "/controller.api.services.v1.AuthMethodService/CreateAuthMethod"
+	AuthMethodService_UpdateAuthMethod_FullMethodName                = "/controller.api.services.v1.AuthMethodService/UpdateAuthMethod"
+	AuthMethodService_DeleteAuthMethod_FullMethodName                = "/controller.api.services.v1.AuthMethodService/DeleteAuthMethod"
+	AuthMethodService_ChangeState_FullMethodName                     = "/controller.api.services.v1.AuthMethodService/ChangeState"
+	AuthMethodService_Authenticate_FullMethodName                    = "/controller.api.services.v1.AuthMethodService/Authenticate"
+	AuthMethodService_SetAuthMethodDeletionProtection_FullMethodName = "/controller.api.services.v1.AuthMethodService/SetAuthMethodDeletionProtection"
 )
 
 // AuthMethodServiceClient is the client API for AuthMethodService service.
@@ -65,6 +66,12 @@ type AuthMethodServiceClient interface {
 	ChangeState(ctx context.Context, in *ChangeStateRequest, opts ...grpc.CallOption) (*ChangeStateResponse, error)
 	// Authenticate validates credentials provided and returns an Auth Token.
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	// SetAuthMethodDeletionProtection sets whether the AuthMethod is protected
+	// from deletion. While protected, deleting the AuthMethod, or any scope
+	// containing it, fails. Removing the protection requires the
+	// set-deletion-protection action, which is granted separately from the
+	// delete action.
+	SetAuthMethodDeletionProtection(ctx context.Context, in *SetAuthMethodDeletionProtectionRequest, opts ...grpc.CallOption) (*SetAuthMethodDeletionProtectionResponse, error)
 }
 
 type authMethodServiceClient struct {
@@ -138,6 +145,15 @@ func (c *authMethodServiceClient) Authenticate(ctx context.Context, in *Authenti
 	return out, nil
 }
 
+func (c *authMethodServiceClient) SetAuthMethodDeletionProtection(ctx context.Context, in *SetAuthMethodDeletionProtectionRequest, opts ...grpc.CallOption) (*SetAuthMethodDeletionProtectionResponse, error) {
+	out := new(SetAuthMethodDeletionProtectionResponse)
+	err := c.cc.Invoke(ctx, AuthMethodService_SetAuthMethodDeletionProtection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthMethodServiceServer is the server API for AuthMethodService service.
 // All implementations must embed UnimplementedAuthMethodServiceServer
 // for forward compatibility
@@ -172,6 +188,12 @@ type AuthMethodServiceServer interface {
 	ChangeState(context.Context, *ChangeStateRequest) (*ChangeStateResponse, error)
 	// Authenticate validates credentials provided and returns an Auth Token.
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	// SetAuthMethodDeletionProtection sets whether the AuthMethod is protected
+	// from deletion. While protected, deleting the AuthMethod, or any scope
+	// containing it, fails. Removing the protection requires the
+	// set-deletion-protection action, which is granted separately from the
+	// delete action.
+	SetAuthMethodDeletionProtection(context.Context, *SetAuthMethodDeletionProtectionRequest) (*SetAuthMethodDeletionProtectionResponse, error)
 	mustEmbedUnimplementedAuthMethodServiceServer()
 }
 
@@ -200,6 +222,9 @@ func (UnimplementedAuthMethodServiceServer) ChangeState(context.Context, *Change
 func (UnimplementedAuthMethodServiceServer) Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
 }
+func (UnimplementedAuthMethodServiceServer) SetAuthMethodDeletionProtection(context.Context, *SetAuthMethodDeletionProtectionRequest) (*SetAuthMethodDeletionProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAuthMethodDeletionProtection not implemented")
+}
 func (UnimplementedAuthMethodServiceServer) mustEmbedUnimplementedAuthMethodServiceServer() {}
 
 // UnsafeAuthMethodServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -339,6 +364,24 @@ func _AuthMethodService_Authenticate_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthMethodService_SetAuthMethodDeletionProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAuthMethodDeletionProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthMethodServiceServer).SetAuthMethodDeletionProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthMethodService_SetAuthMethodDeletionProtection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthMethodServiceServer).SetAuthMethodDeletionProtection(ctx, req.(*SetAuthMethodDeletionProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthMethodService_ServiceDesc is the grpc.ServiceDesc for AuthMethodService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -374,6 +417,10 @@ var AuthMethodService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authenticate",
 			Handler:    _AuthMethodService_Authenticate_Handler,
 		},
+		{
+			MethodName: "SetAuthMethodDeletionProtection",
+			Handler:    _AuthMethodService_SetAuthMethodDeletionProtection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/auth_method_service.proto",
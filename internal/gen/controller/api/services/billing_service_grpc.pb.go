@@ -23,6 +23,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	BillingService_MonthlyActiveUsers_FullMethodName = "/controller.api.services.v1.BillingService/MonthlyActiveUsers"
+	BillingService_ScopeUsage_FullMethodName         = "/controller.api.services.v1.BillingService/ScopeUsage"
 )
 
 // BillingServiceClient is the client API for BillingService service.
@@ -35,6 +36,12 @@ type BillingServiceClient interface {
 	// up to the current month. If the provided request contains an end time and no start time,
 	// or if the end time is prior to the start time, an error will be returned.
 	MonthlyActiveUsers(ctx context.Context, in *MonthlyActiveUsersRequest, opts ...grpc.CallOption) (*MonthlyActiveUsersResponse, error)
+	// ScopeUsage returns the monthly usage of each project scope for the given
+	// time period: the active users, the number and duration of sessions and the
+	// recorded bytes. The usage is aggregated periodically, so the usage of the
+	// current month may not include the most recent sessions. The time period
+	// is handled the same way as for MonthlyActiveUsers.
+	ScopeUsage(ctx context.Context, in *ScopeUsageRequest, opts ...grpc.CallOption) (*ScopeUsageResponse, error)
 }
 
 type billingServiceClient struct {
@@ -54,6 +61,15 @@ func (c *billingServiceClient) MonthlyActiveUsers(ctx context.Context, in *Month
 	return out, nil
 }
 
+func (c *billingServiceClient) ScopeUsage(ctx context.Context, in *ScopeUsageRequest, opts ...grpc.CallOption) (*ScopeUsageResponse, error) {
+	out := new(ScopeUsageResponse)
+	err := c.cc.Invoke(ctx, BillingService_ScopeUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BillingServiceServer is the server API for BillingService service.
 // All implementations must embed UnimplementedBillingServiceServer
 // for forward compatibility
@@ -64,6 +80,12 @@ type BillingServiceServer interface {
 	// up to the current month. If the provided request contains an end time and no start time,
 	// or if the end time is prior to the start time, an error will be returned.
 	MonthlyActiveUsers(context.Context, *MonthlyActiveUsersRequest) (*MonthlyActiveUsersResponse, error)
+	// ScopeUsage returns the monthly usage of each project scope for the given
+	// time period: the active users, the number and duration of sessions and the
+	// recorded bytes. The usage is aggregated periodically, so the usage of the
+	// current month may not include the most recent sessions. The time period
+	// is handled the same way as for MonthlyActiveUsers.
+	ScopeUsage(context.Context, *ScopeUsageRequest) (*ScopeUsageResponse, error)
 	mustEmbedUnimplementedBillingServiceServer()
 }
 
@@ -74,6 +96,9 @@ type UnimplementedBillingServiceServer struct {
 func (UnimplementedBillingServiceServer) MonthlyActiveUsers(context.Context, *MonthlyActiveUsersRequest) (*MonthlyActiveUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MonthlyActiveUsers not implemented")
 }
+func (UnimplementedBillingServiceServer) ScopeUsage(context.Context, *ScopeUsageRequest) (*ScopeUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScopeUsage not implemented")
+}
 func (UnimplementedBillingServiceServer) mustEmbedUnimplementedBillingServiceServer() {}
 
 // UnsafeBillingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -105,6 +130,24 @@ func _BillingService_MonthlyActiveUsers_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BillingService_ScopeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScopeUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).ScopeUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_ScopeUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).ScopeUsage(ctx, req.(*ScopeUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BillingService_ServiceDesc is the grpc.ServiceDesc for BillingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -116,6 +159,10 @@ var BillingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MonthlyActiveUsers",
 			Handler:    _BillingService_MonthlyActiveUsers_Handler,
 		},
+		{
+			MethodName: "ScopeUsage",
+			Handler:    _BillingService_ScopeUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/billing_service.proto",
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: controller/api/services/v1/feature_service.proto
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	FeatureService_ListFeatures_FullMethodName = "/controller.api.services.v1.FeatureService/ListFeatures"
+)
+
+// FeatureServiceClient is the client API for FeatureService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FeatureServiceClient interface {
+	// ListFeatures returns the features that are not available in every
+	// edition of Boundary, and whether each of them is enabled on the
+	// controller.
+	ListFeatures(ctx context.Context, in *ListFeaturesRequest, opts ...grpc.CallOption) (*ListFeaturesResponse, error)
+}
+
+type featureServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeatureServiceClient(cc grpc.ClientConnInterface) FeatureServiceClient {
+	return &featureServiceClient{cc}
+}
+
+func (c *featureServiceClient) ListFeatures(ctx context.Context, in *ListFeaturesRequest, opts ...grpc.CallOption) (*ListFeaturesResponse, error) {
+	out := new(ListFeaturesResponse)
+	err := c.cc.Invoke(ctx, FeatureService_ListFeatures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeatureServiceServer is the server API for FeatureService service.
+// All implementations must embed UnimplementedFeatureServiceServer
+// for forward compatibility
+type FeatureServiceServer interface {
+	// ListFeatures returns the features that are not available in every
+	// edition of Boundary, and whether each of them is enabled on the
+	// controller.
+	ListFeatures(context.Context, *ListFeaturesRequest) (*ListFeaturesResponse, error)
+	mustEmbedUnimplementedFeatureServiceServer()
+}
+
+// UnimplementedFeatureServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFeatureServiceServer struct {
+}
+
+func (UnimplementedFeatureServiceServer) ListFeatures(context.Context, *ListFeaturesRequest) (*ListFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatures not implemented")
+}
+func (UnimplementedFeatureServiceServer) mustEmbedUnimplementedFeatureServiceServer() {}
+
+// UnsafeFeatureServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeatureServiceServer will
+// result in compilation errors.
+type UnsafeFeatureServiceServer interface {
+	mustEmbedUnimplementedFeatureServiceServer()
+}
+
+func RegisterFeatureServiceServer(s grpc.ServiceRegistrar, srv FeatureServiceServer) {
+	s.RegisterService(&FeatureService_ServiceDesc, srv)
+}
+
+func _FeatureService_ListFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureServiceServer).ListFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureService_ListFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureServiceServer).ListFeatures(ctx, req.(*ListFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeatureService_ServiceDesc is the grpc.ServiceDesc for FeatureService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeatureService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controller.api.services.v1.FeatureService",
+	HandlerType: (*FeatureServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFeatures",
+			Handler:    _FeatureService_ListFeatures_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controller/api/services/v1/feature_service.proto",
+}
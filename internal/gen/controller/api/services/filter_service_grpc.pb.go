@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: controller/api/services/v1/filter_service.proto
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	FilterService_ValidateFilter_FullMethodName = "/controller.api.services.v1.FilterService/ValidateFilter"
+)
+
+// FilterServiceClient is the client API for FilterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FilterServiceClient interface {
+	// ValidateFilter parses a filter expression and checks it against the data
+	// the given type of filter is evaluated against. The problems found are
+	// returned with their position in the expression. An invalid expression is
+	// not an error of the request.
+	ValidateFilter(ctx context.Context, in *ValidateFilterRequest, opts ...grpc.CallOption) (*ValidateFilterResponse, error)
+}
+
+type filterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFilterServiceClient(cc grpc.ClientConnInterface) FilterServiceClient {
+	return &filterServiceClient{cc}
+}
+
+func (c *filterServiceClient) ValidateFilter(ctx context.Context, in *ValidateFilterRequest, opts ...grpc.CallOption) (*ValidateFilterResponse, error) {
+	out := new(ValidateFilterResponse)
+	err := c.cc.Invoke(ctx, FilterService_ValidateFilter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FilterServiceServer is the server API for FilterService service.
+// All implementations must embed UnimplementedFilterServiceServer
+// for forward compatibility
+type FilterServiceServer interface {
+	// ValidateFilter parses a filter expression and checks it against the data
+	// the given type of filter is evaluated against. The problems found are
+	// returned with their position in the expression. An invalid expression is
+	// not an error of the request.
+	ValidateFilter(context.Context, *ValidateFilterRequest) (*ValidateFilterResponse, error)
+	mustEmbedUnimplementedFilterServiceServer()
+}
+
+// UnimplementedFilterServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFilterServiceServer struct {
+}
+
+func (UnimplementedFilterServiceServer) ValidateFilter(context.Context, *ValidateFilterRequest) (*ValidateFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateFilter not implemented")
+}
+func (UnimplementedFilterServiceServer) mustEmbedUnimplementedFilterServiceServer() {}
+
+// UnsafeFilterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FilterServiceServer will
+// result in compilation errors.
+type UnsafeFilterServiceServer interface {
+	mustEmbedUnimplementedFilterServiceServer()
+}
+
+func RegisterFilterServiceServer(s grpc.ServiceRegistrar, srv FilterServiceServer) {
+	s.RegisterService(&FilterService_ServiceDesc, srv)
+}
+
+func _FilterService_ValidateFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilterServiceServer).ValidateFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilterService_ValidateFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilterServiceServer).ValidateFilter(ctx, req.(*ValidateFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FilterService_ServiceDesc is the grpc.ServiceDesc for FilterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FilterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controller.api.services.v1.FilterService",
+	HandlerType: (*FilterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidateFilter",
+			Handler:    _FilterService_ValidateFilter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controller/api/services/v1/filter_service.proto",
+}
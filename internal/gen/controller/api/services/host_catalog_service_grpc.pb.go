@@ -22,11 +22,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	HostCatalogService_GetHostCatalog_FullMethodName    = "/controller.api.services.v1.HostCatalogService/GetHostCatalog"
-	HostCatalogService_ListHostCatalogs_FullMethodName  = "/controller.api.services.v1.HostCatalogService/ListHostCatalogs"
-	HostCatalogService_CreateHostCatalog_FullMethodName = "/controller.api.services.v1.HostCatalogService/CreateHostCatalog"
-	HostCatalogService_UpdateHostCatalog_FullMethodName = "/controller.api.services.v1.HostCatalogService/UpdateHostCatalog"
-	HostCatalogService_DeleteHostCatalog_FullMethodName = "/controller.api.services.v1.HostCatalogService/DeleteHostCatalog"
+	HostCatalogService_GetHostCatalog_FullMethodName            = "/controller.api.services.v1.HostCatalogService/GetHostCatalog"
+	HostCatalogService_ListHostCatalogs_FullMethodName          = "/controller.api.services.v1.HostCatalogService/ListHostCatalogs"
+	HostCatalogService_CreateHostCatalog_FullMethodName         = "/controller.api.services.v1.HostCatalogService/CreateHostCatalog"
+	HostCatalogService_UpdateHostCatalog_FullMethodName         = "/controller.api.services.v1.HostCatalogService/UpdateHostCatalog"
+	HostCatalogService_DeleteHostCatalog_FullMethodName         = "/controller.api.services.v1.HostCatalogService/DeleteHostCatalog"
+	HostCatalogService_RotateHostCatalogSecrets_FullMethodName  = "/controller.api.services.v1.HostCatalogService/RotateHostCatalogSecrets"
+	HostCatalogService_SetHostCatalogTargetRules_FullMethodName = "/controller.api.services.v1.HostCatalogService/SetHostCatalogTargetRules"
 )
 
 // HostCatalogServiceClient is the client API for HostCatalogService service.
@@ -59,6 +61,19 @@ type HostCatalogServiceClient interface {
 	// sets from Boundary. If the provided Host Catalog IDs is malformed or not
 	// provided DeleteHostCatalog returns an error.
 	DeleteHostCatalog(ctx context.Context, in *DeleteHostCatalogRequest, opts ...grpc.CallOption) (*DeleteHostCatalogResponse, error)
+	// RotateHostCatalogSecrets replaces the secrets of a plugin Host Catalog.
+	// The new secrets are validated by the Host Catalog's plugin before they
+	// are stored, and the current secrets are kept if the plugin rejects them.
+	// If no secrets are provided the stored secrets are passed to the plugin,
+	// which lets plugins that mint their own credentials rotate them. The
+	// request can also set how often the secrets are rotated automatically.
+	RotateHostCatalogSecrets(ctx context.Context, in *RotateHostCatalogSecretsRequest, opts ...grpc.CallOption) (*RotateHostCatalogSecretsResponse, error)
+	// SetHostCatalogTargetRules sets the rules of a plugin Host Catalog which
+	// create a Target from a Target Template for each Host Set of the catalog
+	// matching a rule's filter. The Target Templates must be in the project of
+	// the Host Catalog. All existing rules are replaced by the provided rules;
+	// an empty list removes them.
+	SetHostCatalogTargetRules(ctx context.Context, in *SetHostCatalogTargetRulesRequest, opts ...grpc.CallOption) (*SetHostCatalogTargetRulesResponse, error)
 }
 
 type hostCatalogServiceClient struct {
@@ -114,6 +129,24 @@ func (c *hostCatalogServiceClient) DeleteHostCatalog(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *hostCatalogServiceClient) RotateHostCatalogSecrets(ctx context.Context, in *RotateHostCatalogSecretsRequest, opts ...grpc.CallOption) (*RotateHostCatalogSecretsResponse, error) {
+	out := new(RotateHostCatalogSecretsResponse)
+	err := c.cc.Invoke(ctx, HostCatalogService_RotateHostCatalogSecrets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostCatalogServiceClient) SetHostCatalogTargetRules(ctx context.Context, in *SetHostCatalogTargetRulesRequest, opts ...grpc.CallOption) (*SetHostCatalogTargetRulesResponse, error) {
+	out := new(SetHostCatalogTargetRulesResponse)
+	err := c.cc.Invoke(ctx, HostCatalogService_SetHostCatalogTargetRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HostCatalogServiceServer is the server API for HostCatalogService service.
 // All implementations must embed UnimplementedHostCatalogServiceServer
 // for forward compatibility
@@ -144,6 +177,19 @@ type HostCatalogServiceServer interface {
 	// sets from Boundary. If the provided Host Catalog IDs is malformed or not
 	// provided DeleteHostCatalog returns an error.
 	DeleteHostCatalog(context.Context, *DeleteHostCatalogRequest) (*DeleteHostCatalogResponse, error)
+	// RotateHostCatalogSecrets replaces the secrets of a plugin Host Catalog.
+	// The new secrets are validated by the Host Catalog's plugin before they
+	// are stored, and the current secrets are kept if the plugin rejects them.
+	// If no secrets are provided the stored secrets are passed to the plugin,
+	// which lets plugins that mint their own credentials rotate them. The
+	// request can also set how often the secrets are rotated automatically.
+	RotateHostCatalogSecrets(context.Context, *RotateHostCatalogSecretsRequest) (*RotateHostCatalogSecretsResponse, error)
+	// SetHostCatalogTargetRules sets the rules of a plugin Host Catalog which
+	// create a Target from a Target Template for each Host Set of the catalog
+	// matching a rule's filter. The Target Templates must be in the project of
+	// the Host Catalog. All existing rules are replaced by the provided rules;
+	// an empty list removes them.
+	SetHostCatalogTargetRules(context.Context, *SetHostCatalogTargetRulesRequest) (*SetHostCatalogTargetRulesResponse, error)
 	mustEmbedUnimplementedHostCatalogServiceServer()
 }
 
@@ -166,6 +212,12 @@ func (UnimplementedHostCatalogServiceServer) UpdateHostCatalog(context.Context,
 func (UnimplementedHostCatalogServiceServer) DeleteHostCatalog(context.Context, *DeleteHostCatalogRequest) (*DeleteHostCatalogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteHostCatalog not implemented")
 }
+func (UnimplementedHostCatalogServiceServer) RotateHostCatalogSecrets(context.Context, *RotateHostCatalogSecretsRequest) (*RotateHostCatalogSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateHostCatalogSecrets not implemented")
+}
+func (UnimplementedHostCatalogServiceServer) SetHostCatalogTargetRules(context.Context, *SetHostCatalogTargetRulesRequest) (*SetHostCatalogTargetRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHostCatalogTargetRules not implemented")
+}
 func (UnimplementedHostCatalogServiceServer) mustEmbedUnimplementedHostCatalogServiceServer() {}
 
 // UnsafeHostCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -269,6 +321,42 @@ func _HostCatalogService_DeleteHostCatalog_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostCatalogService_RotateHostCatalogSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateHostCatalogSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostCatalogServiceServer).RotateHostCatalogSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HostCatalogService_RotateHostCatalogSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostCatalogServiceServer).RotateHostCatalogSecrets(ctx, req.(*RotateHostCatalogSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostCatalogService_SetHostCatalogTargetRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHostCatalogTargetRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostCatalogServiceServer).SetHostCatalogTargetRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HostCatalogService_SetHostCatalogTargetRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostCatalogServiceServer).SetHostCatalogTargetRules(ctx, req.(*SetHostCatalogTargetRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HostCatalogService_ServiceDesc is the grpc.ServiceDesc for HostCatalogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -296,6 +384,14 @@ var HostCatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteHostCatalog",
 			Handler:    _HostCatalogService_DeleteHostCatalog_Handler,
 		},
+		{
+			MethodName: "RotateHostCatalogSecrets",
+			Handler:    _HostCatalogService_RotateHostCatalogSecrets_Handler,
+		},
+		{
+			MethodName: "SetHostCatalogTargetRules",
+			Handler:    _HostCatalogService_SetHostCatalogTargetRules_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/host_catalog_service.proto",
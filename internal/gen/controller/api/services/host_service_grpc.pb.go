@@ -22,11 +22,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	HostService_GetHost_FullMethodName    = "/controller.api.services.v1.HostService/GetHost"
-	HostService_ListHosts_FullMethodName  = "/controller.api.services.v1.HostService/ListHosts"
-	HostService_CreateHost_FullMethodName = "/controller.api.services.v1.HostService/CreateHost"
-	HostService_UpdateHost_FullMethodName = "/controller.api.services.v1.HostService/UpdateHost"
-	HostService_DeleteHost_FullMethodName = "/controller.api.services.v1.HostService/DeleteHost"
+	HostService_GetHost_FullMethodName     = "/controller.api.services.v1.HostService/GetHost"
+	HostService_ListHosts_FullMethodName   = "/controller.api.services.v1.HostService/ListHosts"
+	HostService_CreateHost_FullMethodName  = "/controller.api.services.v1.HostService/CreateHost"
+	HostService_CreateHosts_FullMethodName = "/controller.api.services.v1.HostService/CreateHosts"
+	HostService_UpdateHost_FullMethodName  = "/controller.api.services.v1.HostService/UpdateHost"
+	HostService_DeleteHost_FullMethodName  = "/controller.api.services.v1.HostService/DeleteHost"
 )
 
 // HostServiceClient is the client API for HostService service.
@@ -49,6 +50,13 @@ type HostServiceClient interface {
 	// non-existing resource, an error is returned.  If a name is provided that
 	// is in use by another Host in the same Host Catalog, an error is returned.
 	CreateHost(ctx context.Context, in *CreateHostRequest, opts ...grpc.CallOption) (*CreateHostResponse, error)
+	// CreateHosts creates a Host for each address in the provided address range.
+	// The address range is either a CIDR block or a hostname containing a
+	// numeric range, such as "web[01-20].corp". The request must include the
+	// static Host Catalog id in which the Hosts will be created. Either all of
+	// the Hosts are created or none are. If a generated name is in use by
+	// another Host in the same Host Catalog, an error is returned.
+	CreateHosts(ctx context.Context, in *CreateHostsRequest, opts ...grpc.CallOption) (*CreateHostsResponse, error)
 	// UpdateHost updates an existing Host in boundary.  The provided
 	// Host must not have any read only fields set.  The update mask must be
 	// included in the request and contain at least 1 mutable field.  To unset
@@ -98,6 +106,15 @@ func (c *hostServiceClient) CreateHost(ctx context.Context, in *CreateHostReques
 	return out, nil
 }
 
+func (c *hostServiceClient) CreateHosts(ctx context.Context, in *CreateHostsRequest, opts ...grpc.CallOption) (*CreateHostsResponse, error) {
+	out := new(CreateHostsResponse)
+	err := c.cc.Invoke(ctx, HostService_CreateHosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hostServiceClient) UpdateHost(ctx context.Context, in *UpdateHostRequest, opts ...grpc.CallOption) (*UpdateHostResponse, error) {
 	out := new(UpdateHostResponse)
 	err := c.cc.Invoke(ctx, HostService_UpdateHost_FullMethodName, in, out, opts...)
@@ -136,6 +153,13 @@ type HostServiceServer interface {
 	// non-existing resource, an error is returned.  If a name is provided that
 	// is in use by another Host in the same Host Catalog, an error is returned.
 	CreateHost(context.Context, *CreateHostRequest) (*CreateHostResponse, error)
+	// CreateHosts creates a Host for each address in the provided address range.
+	// The address range is either a CIDR block or a hostname containing a
+	// numeric range, such as "web[01-20].corp". The request must include the
+	// static Host Catalog id in which the Hosts will be created. Either all of
+	// the Hosts are created or none are. If a generated name is in use by
+	// another Host in the same Host Catalog, an error is returned.
+	CreateHosts(context.Context, *CreateHostsRequest) (*CreateHostsResponse, error)
 	// UpdateHost updates an existing Host in boundary.  The provided
 	// Host must not have any read only fields set.  The update mask must be
 	// included in the request and contain at least 1 mutable field.  To unset
@@ -164,6 +188,9 @@ func (UnimplementedHostServiceServer) ListHosts(context.Context, *ListHostsReque
 func (UnimplementedHostServiceServer) CreateHost(context.Context, *CreateHostRequest) (*CreateHostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateHost not implemented")
 }
+func (UnimplementedHostServiceServer) CreateHosts(context.Context, *CreateHostsRequest) (*CreateHostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHosts not implemented")
+}
 func (UnimplementedHostServiceServer) UpdateHost(context.Context, *UpdateHostRequest) (*UpdateHostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateHost not implemented")
 }
@@ -237,6 +264,24 @@ func _HostService_CreateHost_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostService_CreateHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServiceServer).CreateHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HostService_CreateHosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServiceServer).CreateHosts(ctx, req.(*CreateHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HostService_UpdateHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateHostRequest)
 	if err := dec(in); err != nil {
@@ -292,6 +337,10 @@ var HostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateHost",
 			Handler:    _HostService_CreateHost_Handler,
 		},
+		{
+			MethodName: "CreateHosts",
+			Handler:    _HostService_CreateHosts_Handler,
+		},
 		{
 			MethodName: "UpdateHost",
 			Handler:    _HostService_UpdateHost_Handler,
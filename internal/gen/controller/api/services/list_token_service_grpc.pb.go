@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: controller/api/services/v1/list_token_service.proto
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ListTokenService_IntrospectListToken_FullMethodName = "/controller.api.services.v1.ListTokenService/IntrospectListToken"
+	ListTokenService_RevokeListToken_FullMethodName     = "/controller.api.services.v1.ListTokenService/RevokeListToken"
+)
+
+// ListTokenServiceClient is the client API for ListTokenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ListTokenServiceClient interface {
+	// IntrospectListToken returns the details of a list token, including
+	// whether it would still be accepted by list endpoints for the caller.
+	// This can be used to diagnose why a list endpoint rejects a list token.
+	IntrospectListToken(ctx context.Context, in *IntrospectListTokenRequest, opts ...grpc.CallOption) (*IntrospectListTokenResponse, error)
+	// RevokeListToken revokes a list token. Once revoked, the token and every
+	// other token returned while paginating through or refreshing the same
+	// listing are rejected by list endpoints, and the client must start a new
+	// listing.
+	RevokeListToken(ctx context.Context, in *RevokeListTokenRequest, opts ...grpc.CallOption) (*RevokeListTokenResponse, error)
+}
+
+type listTokenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewListTokenServiceClient(cc grpc.ClientConnInterface) ListTokenServiceClient {
+	return &listTokenServiceClient{cc}
+}
+
+func (c *listTokenServiceClient) IntrospectListToken(ctx context.Context, in *IntrospectListTokenRequest, opts ...grpc.CallOption) (*IntrospectListTokenResponse, error) {
+	out := new(IntrospectListTokenResponse)
+	err := c.cc.Invoke(ctx, ListTokenService_IntrospectListToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listTokenServiceClient) RevokeListToken(ctx context.Context, in *RevokeListTokenRequest, opts ...grpc.CallOption) (*RevokeListTokenResponse, error) {
+	out := new(RevokeListTokenResponse)
+	err := c.cc.Invoke(ctx, ListTokenService_RevokeListToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListTokenServiceServer is the server API for ListTokenService service.
+// All implementations must embed UnimplementedListTokenServiceServer
+// for forward compatibility
+type ListTokenServiceServer interface {
+	// IntrospectListToken returns the details of a list token, including
+	// whether it would still be accepted by list endpoints for the caller.
+	// This can be used to diagnose why a list endpoint rejects a list token.
+	IntrospectListToken(context.Context, *IntrospectListTokenRequest) (*IntrospectListTokenResponse, error)
+	// RevokeListToken revokes a list token. Once revoked, the token and every
+	// other token returned while paginating through or refreshing the same
+	// listing are rejected by list endpoints, and the client must start a new
+	// listing.
+	RevokeListToken(context.Context, *RevokeListTokenRequest) (*RevokeListTokenResponse, error)
+	mustEmbedUnimplementedListTokenServiceServer()
+}
+
+// UnimplementedListTokenServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedListTokenServiceServer struct {
+}
+
+func (UnimplementedListTokenServiceServer) IntrospectListToken(context.Context, *IntrospectListTokenRequest) (*IntrospectListTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntrospectListToken not implemented")
+}
+func (UnimplementedListTokenServiceServer) RevokeListToken(context.Context, *RevokeListTokenRequest) (*RevokeListTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeListToken not implemented")
+}
+func (UnimplementedListTokenServiceServer) mustEmbedUnimplementedListTokenServiceServer() {}
+
+// UnsafeListTokenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ListTokenServiceServer will
+// result in compilation errors.
+type UnsafeListTokenServiceServer interface {
+	mustEmbedUnimplementedListTokenServiceServer()
+}
+
+func RegisterListTokenServiceServer(s grpc.ServiceRegistrar, srv ListTokenServiceServer) {
+	s.RegisterService(&ListTokenService_ServiceDesc, srv)
+}
+
+func _ListTokenService_IntrospectListToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectListTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListTokenServiceServer).IntrospectListToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListTokenService_IntrospectListToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListTokenServiceServer).IntrospectListToken(ctx, req.(*IntrospectListTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListTokenService_RevokeListToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeListTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListTokenServiceServer).RevokeListToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListTokenService_RevokeListToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListTokenServiceServer).RevokeListToken(ctx, req.(*RevokeListTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ListTokenService_ServiceDesc is the grpc.ServiceDesc for ListTokenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ListTokenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controller.api.services.v1.ListTokenService",
+	HandlerType: (*ListTokenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IntrospectListToken",
+			Handler:    _ListTokenService_IntrospectListToken_Handler,
+		},
+		{
+			MethodName: "RevokeListToken",
+			Handler:    _ListTokenService_RevokeListToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controller/api/services/v1/list_token_service.proto",
+}
@@ -33,6 +33,8 @@ const (
 	ScopeService_DestroyKeyVersion_FullMethodName             = "/controller.api.services.v1.ScopeService/DestroyKeyVersion"
 	ScopeService_AttachStoragePolicy_FullMethodName           = "/controller.api.services.v1.ScopeService/AttachStoragePolicy"
 	ScopeService_DetachStoragePolicy_FullMethodName           = "/controller.api.services.v1.ScopeService/DetachStoragePolicy"
+	ScopeService_ListDanglingReferences_FullMethodName        = "/controller.api.services.v1.ScopeService/ListDanglingReferences"
+	ScopeService_SetScopeDeletionProtection_FullMethodName    = "/controller.api.services.v1.ScopeService/SetScopeDeletionProtection"
 )
 
 // ScopeServiceClient is the client API for ScopeService service.
@@ -93,6 +95,16 @@ type ScopeServiceClient interface {
 	// if a Storage Policy is attempted to be removed from the Scope when the Scope
 	// does not have the Storage Policy attached to it.
 	DetachStoragePolicy(ctx context.Context, in *DetachStoragePolicyRequest, opts ...grpc.CallOption) (*DetachStoragePolicyResponse, error)
+	// ListDanglingReferences lists the references from the resources of the
+	// scope specified to resources which no longer exist, such as role grants
+	// naming deleted resources or aliases naming deleted hosts. If recursive is
+	// set, the resources of the descendant scopes are included.
+	ListDanglingReferences(ctx context.Context, in *ListDanglingReferencesRequest, opts ...grpc.CallOption) (*ListDanglingReferencesResponse, error)
+	// SetScopeDeletionProtection sets whether the Scope is protected from
+	// deletion. While protected, deleting the Scope, or any scope containing it,
+	// fails. Removing the protection requires the set-deletion-protection
+	// action, which is granted separately from the delete action.
+	SetScopeDeletionProtection(ctx context.Context, in *SetScopeDeletionProtectionRequest, opts ...grpc.CallOption) (*SetScopeDeletionProtectionResponse, error)
 }
 
 type scopeServiceClient struct {
@@ -202,6 +214,24 @@ func (c *scopeServiceClient) DetachStoragePolicy(ctx context.Context, in *Detach
 	return out, nil
 }
 
+func (c *scopeServiceClient) ListDanglingReferences(ctx context.Context, in *ListDanglingReferencesRequest, opts ...grpc.CallOption) (*ListDanglingReferencesResponse, error) {
+	out := new(ListDanglingReferencesResponse)
+	err := c.cc.Invoke(ctx, ScopeService_ListDanglingReferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeServiceClient) SetScopeDeletionProtection(ctx context.Context, in *SetScopeDeletionProtectionRequest, opts ...grpc.CallOption) (*SetScopeDeletionProtectionResponse, error) {
+	out := new(SetScopeDeletionProtectionResponse)
+	err := c.cc.Invoke(ctx, ScopeService_SetScopeDeletionProtection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScopeServiceServer is the server API for ScopeService service.
 // All implementations must embed UnimplementedScopeServiceServer
 // for forward compatibility
@@ -260,6 +290,16 @@ type ScopeServiceServer interface {
 	// if a Storage Policy is attempted to be removed from the Scope when the Scope
 	// does not have the Storage Policy attached to it.
 	DetachStoragePolicy(context.Context, *DetachStoragePolicyRequest) (*DetachStoragePolicyResponse, error)
+	// ListDanglingReferences lists the references from the resources of the
+	// scope specified to resources which no longer exist, such as role grants
+	// naming deleted resources or aliases naming deleted hosts. If recursive is
+	// set, the resources of the descendant scopes are included.
+	ListDanglingReferences(context.Context, *ListDanglingReferencesRequest) (*ListDanglingReferencesResponse, error)
+	// SetScopeDeletionProtection sets whether the Scope is protected from
+	// deletion. While protected, deleting the Scope, or any scope containing it,
+	// fails. Removing the protection requires the set-deletion-protection
+	// action, which is granted separately from the delete action.
+	SetScopeDeletionProtection(context.Context, *SetScopeDeletionProtectionRequest) (*SetScopeDeletionProtectionResponse, error)
 	mustEmbedUnimplementedScopeServiceServer()
 }
 
@@ -300,6 +340,12 @@ func (UnimplementedScopeServiceServer) AttachStoragePolicy(context.Context, *Att
 func (UnimplementedScopeServiceServer) DetachStoragePolicy(context.Context, *DetachStoragePolicyRequest) (*DetachStoragePolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DetachStoragePolicy not implemented")
 }
+func (UnimplementedScopeServiceServer) ListDanglingReferences(context.Context, *ListDanglingReferencesRequest) (*ListDanglingReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDanglingReferences not implemented")
+}
+func (UnimplementedScopeServiceServer) SetScopeDeletionProtection(context.Context, *SetScopeDeletionProtectionRequest) (*SetScopeDeletionProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScopeDeletionProtection not implemented")
+}
 func (UnimplementedScopeServiceServer) mustEmbedUnimplementedScopeServiceServer() {}
 
 // UnsafeScopeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -511,6 +557,42 @@ func _ScopeService_DetachStoragePolicy_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScopeService_ListDanglingReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDanglingReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).ListDanglingReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScopeService_ListDanglingReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).ListDanglingReferences(ctx, req.(*ListDanglingReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeService_SetScopeDeletionProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScopeDeletionProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).SetScopeDeletionProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScopeService_SetScopeDeletionProtection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).SetScopeDeletionProtection(ctx, req.(*SetScopeDeletionProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScopeService_ServiceDesc is the grpc.ServiceDesc for ScopeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -562,6 +644,14 @@ var ScopeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DetachStoragePolicy",
 			Handler:    _ScopeService_DetachStoragePolicy_Handler,
 		},
+		{
+			MethodName: "ListDanglingReferences",
+			Handler:    _ScopeService_ListDanglingReferences_Handler,
+		},
+		{
+			MethodName: "SetScopeDeletionProtection",
+			Handler:    _ScopeService_SetScopeDeletionProtection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/scope_service.proto",
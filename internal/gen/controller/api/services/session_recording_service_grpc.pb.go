@@ -28,6 +28,7 @@ const (
 	SessionRecordingService_Download_FullMethodName               = "/controller.api.services.v1.SessionRecordingService/Download"
 	SessionRecordingService_ReApplyStoragePolicy_FullMethodName   = "/controller.api.services.v1.SessionRecordingService/ReApplyStoragePolicy"
 	SessionRecordingService_DeleteSessionRecording_FullMethodName = "/controller.api.services.v1.SessionRecordingService/DeleteSessionRecording"
+	SessionRecordingService_RepairSessionRecording_FullMethodName = "/controller.api.services.v1.SessionRecordingService/RepairSessionRecording"
 )
 
 // SessionRecordingServiceClient is the client API for SessionRecordingService service.
@@ -46,7 +47,8 @@ type SessionRecordingServiceClient interface {
 	// Supports both Session ID and Session recording ID for looking up a Session recording.
 	// Supports both Connection ID and Connection recording ID to look up a Connection recording.
 	// A Channel recording ID is required to look up a Channel recording.
-	// The only supported mime type is "application/x-asciicast".
+	// Supported mime types are "application/x-asciicast" and "text/plain",
+	// a plain text transcript of the terminal output of an SSH Channel recording.
 	Download(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (SessionRecordingService_DownloadClient, error)
 	// ReApplyStoragePolicy calculates the resultant set of policy for a given session recording
 	// and updates the retain until and delete after values. The provided request
@@ -56,6 +58,15 @@ type SessionRecordingServiceClient interface {
 	// DeleteSessionRecording removes a Session Recording from Boundary. If the Session Recording id
 	// is malformed or not provided an error is returned.
 	DeleteSessionRecording(ctx context.Context, in *DeleteSessionRecordingRequest, opts ...grpc.CallOption) (*DeleteSessionRecordingResponse, error)
+	// RepairSessionRecording finalizes a Session recording that was left in the
+	// "started" state because the worker recording it stopped before closing it.
+	// The chunks that can still be read are kept, end markers are synthesized for
+	// any connections and channels that were not closed, and the Session
+	// recording is marked as "partial". Only Session recordings of sessions that
+	// have been terminated can be repaired. If the provided Session recording ID
+	// is missing, malformed or references a non existing resource, an error is
+	// returned.
+	RepairSessionRecording(ctx context.Context, in *RepairSessionRecordingRequest, opts ...grpc.CallOption) (*RepairSessionRecordingResponse, error)
 }
 
 type sessionRecordingServiceClient struct {
@@ -134,6 +145,15 @@ func (c *sessionRecordingServiceClient) DeleteSessionRecording(ctx context.Conte
 	return out, nil
 }
 
+func (c *sessionRecordingServiceClient) RepairSessionRecording(ctx context.Context, in *RepairSessionRecordingRequest, opts ...grpc.CallOption) (*RepairSessionRecordingResponse, error) {
+	out := new(RepairSessionRecordingResponse)
+	err := c.cc.Invoke(ctx, SessionRecordingService_RepairSessionRecording_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionRecordingServiceServer is the server API for SessionRecordingService service.
 // All implementations must embed UnimplementedSessionRecordingServiceServer
 // for forward compatibility
@@ -150,7 +170,8 @@ type SessionRecordingServiceServer interface {
 	// Supports both Session ID and Session recording ID for looking up a Session recording.
 	// Supports both Connection ID and Connection recording ID to look up a Connection recording.
 	// A Channel recording ID is required to look up a Channel recording.
-	// The only supported mime type is "application/x-asciicast".
+	// Supported mime types are "application/x-asciicast" and "text/plain",
+	// a plain text transcript of the terminal output of an SSH Channel recording.
 	Download(*DownloadRequest, SessionRecordingService_DownloadServer) error
 	// ReApplyStoragePolicy calculates the resultant set of policy for a given session recording
 	// and updates the retain until and delete after values. The provided request
@@ -160,6 +181,15 @@ type SessionRecordingServiceServer interface {
 	// DeleteSessionRecording removes a Session Recording from Boundary. If the Session Recording id
 	// is malformed or not provided an error is returned.
 	DeleteSessionRecording(context.Context, *DeleteSessionRecordingRequest) (*DeleteSessionRecordingResponse, error)
+	// RepairSessionRecording finalizes a Session recording that was left in the
+	// "started" state because the worker recording it stopped before closing it.
+	// The chunks that can still be read are kept, end markers are synthesized for
+	// any connections and channels that were not closed, and the Session
+	// recording is marked as "partial". Only Session recordings of sessions that
+	// have been terminated can be repaired. If the provided Session recording ID
+	// is missing, malformed or references a non existing resource, an error is
+	// returned.
+	RepairSessionRecording(context.Context, *RepairSessionRecordingRequest) (*RepairSessionRecordingResponse, error)
 	mustEmbedUnimplementedSessionRecordingServiceServer()
 }
 
@@ -182,6 +212,9 @@ func (UnimplementedSessionRecordingServiceServer) ReApplyStoragePolicy(context.C
 func (UnimplementedSessionRecordingServiceServer) DeleteSessionRecording(context.Context, *DeleteSessionRecordingRequest) (*DeleteSessionRecordingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSessionRecording not implemented")
 }
+func (UnimplementedSessionRecordingServiceServer) RepairSessionRecording(context.Context, *RepairSessionRecordingRequest) (*RepairSessionRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairSessionRecording not implemented")
+}
 func (UnimplementedSessionRecordingServiceServer) mustEmbedUnimplementedSessionRecordingServiceServer() {
 }
 
@@ -289,6 +322,24 @@ func _SessionRecordingService_DeleteSessionRecording_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionRecordingService_RepairSessionRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairSessionRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecordingServiceServer).RepairSessionRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionRecordingService_RepairSessionRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecordingServiceServer).RepairSessionRecording(ctx, req.(*RepairSessionRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionRecordingService_ServiceDesc is the grpc.ServiceDesc for SessionRecordingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -312,6 +363,10 @@ var SessionRecordingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSessionRecording",
 			Handler:    _SessionRecordingService_DeleteSessionRecording_Handler,
 		},
+		{
+			MethodName: "RepairSessionRecording",
+			Handler:    _SessionRecordingService_RepairSessionRecording_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -22,9 +22,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SessionService_GetSession_FullMethodName    = "/controller.api.services.v1.SessionService/GetSession"
-	SessionService_ListSessions_FullMethodName  = "/controller.api.services.v1.SessionService/ListSessions"
-	SessionService_CancelSession_FullMethodName = "/controller.api.services.v1.SessionService/CancelSession"
+	SessionService_GetSession_FullMethodName     = "/controller.api.services.v1.SessionService/GetSession"
+	SessionService_ListSessions_FullMethodName   = "/controller.api.services.v1.SessionService/ListSessions"
+	SessionService_CancelSession_FullMethodName  = "/controller.api.services.v1.SessionService/CancelSession"
+	SessionService_CancelSessions_FullMethodName = "/controller.api.services.v1.SessionService/CancelSessions"
 )
 
 // SessionServiceClient is the client API for SessionService service.
@@ -45,6 +46,11 @@ type SessionServiceClient interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(ctx context.Context, in *CancelSessionRequest, opts ...grpc.CallOption) (*CancelSessionResponse, error)
+	// CancelSessions cancels every Session in the referenced scope which
+	// matches the provided criteria, and returns the outcome for each of them.
+	// Sessions the caller is not allowed to cancel are reported with an error
+	// rather than failing the whole request.
+	CancelSessions(ctx context.Context, in *CancelSessionsRequest, opts ...grpc.CallOption) (*CancelSessionsResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -82,6 +88,15 @@ func (c *sessionServiceClient) CancelSession(ctx context.Context, in *CancelSess
 	return out, nil
 }
 
+func (c *sessionServiceClient) CancelSessions(ctx context.Context, in *CancelSessionsRequest, opts ...grpc.CallOption) (*CancelSessionsResponse, error) {
+	out := new(CancelSessionsResponse)
+	err := c.cc.Invoke(ctx, SessionService_CancelSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility
@@ -100,6 +115,11 @@ type SessionServiceServer interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error)
+	// CancelSessions cancels every Session in the referenced scope which
+	// matches the provided criteria, and returns the outcome for each of them.
+	// Sessions the caller is not allowed to cancel are reported with an error
+	// rather than failing the whole request.
+	CancelSessions(context.Context, *CancelSessionsRequest) (*CancelSessionsResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -116,6 +136,9 @@ func (UnimplementedSessionServiceServer) ListSessions(context.Context, *ListSess
 func (UnimplementedSessionServiceServer) CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSession not implemented")
 }
+func (UnimplementedSessionServiceServer) CancelSessions(context.Context, *CancelSessionsRequest) (*CancelSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSessions not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 
 // UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -183,6 +206,24 @@ func _SessionService_CancelSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_CancelSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).CancelSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_CancelSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).CancelSessions(ctx, req.(*CancelSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +243,10 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSession",
 			Handler:    _SessionService_CancelSession_Handler,
 		},
+		{
+			MethodName: "CancelSessions",
+			Handler:    _SessionService_CancelSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/session_service.proto",
@@ -315,6 +315,66 @@ func local_request_StorageBucketService_DeleteStorageBucket_0(ctx context.Contex
 
 }
 
+func request_StorageBucketService_SetStorageBucketDeletionProtection_0(ctx context.Context, marshaler runtime.Marshaler, client StorageBucketServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SetStorageBucketDeletionProtectionRequest
+	var metadata runtime.ServerMetadata
+
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+
+	protoReq.Id, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+
+	msg, err := client.SetStorageBucketDeletionProtection(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_StorageBucketService_SetStorageBucketDeletionProtection_0(ctx context.Context, marshaler runtime.Marshaler, server StorageBucketServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SetStorageBucketDeletionProtectionRequest
+	var metadata runtime.ServerMetadata
+
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+
+	protoReq.Id, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+
+	msg, err := server.SetStorageBucketDeletionProtection(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
 // RegisterStorageBucketServiceHandlerServer registers the http handlers for service StorageBucketService to "mux".
 // UnaryRPC     :call StorageBucketServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -446,6 +506,31 @@ func RegisterStorageBucketServiceHandlerServer(ctx context.Context, mux *runtime
 
 	})
 
+	mux.Handle("POST", pattern_StorageBucketService_SetStorageBucketDeletionProtection_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/controller.api.services.v1.StorageBucketService/SetStorageBucketDeletionProtection", runtime.WithHTTPPathPattern("/v1/storage-buckets/{id}:set-deletion-protection"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_StorageBucketService_SetStorageBucketDeletionProtection_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_StorageBucketService_SetStorageBucketDeletionProtection_0(annotatedContext, mux, outboundMarshaler, w, req, response_StorageBucketService_SetStorageBucketDeletionProtection_0{resp}, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -597,6 +682,28 @@ func RegisterStorageBucketServiceHandlerClient(ctx context.Context, mux *runtime
 
 	})
 
+	mux.Handle("POST", pattern_StorageBucketService_SetStorageBucketDeletionProtection_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/controller.api.services.v1.StorageBucketService/SetStorageBucketDeletionProtection", runtime.WithHTTPPathPattern("/v1/storage-buckets/{id}:set-deletion-protection"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_StorageBucketService_SetStorageBucketDeletionProtection_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_StorageBucketService_SetStorageBucketDeletionProtection_0(annotatedContext, mux, outboundMarshaler, w, req, response_StorageBucketService_SetStorageBucketDeletionProtection_0{resp}, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -627,6 +734,15 @@ func (m response_StorageBucketService_UpdateStorageBucket_0) XXX_ResponseBody()
 	return response.Item
 }
 
+type response_StorageBucketService_SetStorageBucketDeletionProtection_0 struct {
+	proto.Message
+}
+
+func (m response_StorageBucketService_SetStorageBucketDeletionProtection_0) XXX_ResponseBody() interface{} {
+	response := m.Message.(*SetStorageBucketDeletionProtectionResponse)
+	return response.Item
+}
+
 var (
 	pattern_StorageBucketService_GetStorageBucket_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "storage-buckets", "id"}, ""))
 
@@ -637,6 +753,8 @@ var (
 	pattern_StorageBucketService_UpdateStorageBucket_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "storage-buckets", "id"}, ""))
 
 	pattern_StorageBucketService_DeleteStorageBucket_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "storage-buckets", "id"}, ""))
+
+	pattern_StorageBucketService_SetStorageBucketDeletionProtection_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "storage-buckets", "id"}, "set-deletion-protection"))
 )
 
 var (
@@ -649,4 +767,6 @@ var (
 	forward_StorageBucketService_UpdateStorageBucket_0 = runtime.ForwardResponseMessage
 
 	forward_StorageBucketService_DeleteStorageBucket_0 = runtime.ForwardResponseMessage
+
+	forward_StorageBucketService_SetStorageBucketDeletionProtection_0 = runtime.ForwardResponseMessage
 )
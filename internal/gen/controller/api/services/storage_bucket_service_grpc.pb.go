@@ -22,11 +22,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	StorageBucketService_GetStorageBucket_FullMethodName    = "/controller.api.services.v1.StorageBucketService/GetStorageBucket"
-	StorageBucketService_ListStorageBuckets_FullMethodName  = "/controller.api.services.v1.StorageBucketService/ListStorageBuckets"
-	StorageBucketService_CreateStorageBucket_FullMethodName = "/controller.api.services.v1.StorageBucketService/CreateStorageBucket"
-	StorageBucketService_UpdateStorageBucket_FullMethodName = "/controller.api.services.v1.StorageBucketService/UpdateStorageBucket"
-	StorageBucketService_DeleteStorageBucket_FullMethodName = "/controller.api.services.v1.StorageBucketService/DeleteStorageBucket"
+	StorageBucketService_GetStorageBucket_FullMethodName                   = "/controller.api.services.v1.StorageBucketService/GetStorageBucket"
+	StorageBucketService_ListStorageBuckets_FullMethodName                 = "/controller.api.services.v1.StorageBucketService/ListStorageBuckets"
+	StorageBucketService_CreateStorageBucket_FullMethodName                = "/controller.api.services.v1.StorageBucketService/CreateStorageBucket"
+	StorageBucketService_UpdateStorageBucket_FullMethodName                = "/controller.api.services.v1.StorageBucketService/UpdateStorageBucket"
+	StorageBucketService_DeleteStorageBucket_FullMethodName                = "/controller.api.services.v1.StorageBucketService/DeleteStorageBucket"
+	StorageBucketService_SetStorageBucketDeletionProtection_FullMethodName = "/controller.api.services.v1.StorageBucketService/SetStorageBucketDeletionProtection"
 )
 
 // StorageBucketServiceClient is the client API for StorageBucketService service.
@@ -58,6 +59,12 @@ type StorageBucketServiceClient interface {
 	// If the provided Storage Bucket ID is malformed or not
 	// provided DeleteStorageBucket returns an error.
 	DeleteStorageBucket(ctx context.Context, in *DeleteStorageBucketRequest, opts ...grpc.CallOption) (*DeleteStorageBucketResponse, error)
+	// SetStorageBucketDeletionProtection sets whether the Storage Bucket is
+	// protected from deletion. While protected, deleting the Storage Bucket, or
+	// any scope containing it, fails. Removing the protection requires the
+	// set-deletion-protection action, which is granted separately from the
+	// delete action.
+	SetStorageBucketDeletionProtection(ctx context.Context, in *SetStorageBucketDeletionProtectionRequest, opts ...grpc.CallOption) (*SetStorageBucketDeletionProtectionResponse, error)
 }
 
 type storageBucketServiceClient struct {
@@ -113,6 +120,15 @@ func (c *storageBucketServiceClient) DeleteStorageBucket(ctx context.Context, in
 	return out, nil
 }
 
+func (c *storageBucketServiceClient) SetStorageBucketDeletionProtection(ctx context.Context, in *SetStorageBucketDeletionProtectionRequest, opts ...grpc.CallOption) (*SetStorageBucketDeletionProtectionResponse, error) {
+	out := new(SetStorageBucketDeletionProtectionResponse)
+	err := c.cc.Invoke(ctx, StorageBucketService_SetStorageBucketDeletionProtection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageBucketServiceServer is the server API for StorageBucketService service.
 // All implementations must embed UnimplementedStorageBucketServiceServer
 // for forward compatibility
@@ -142,6 +158,12 @@ type StorageBucketServiceServer interface {
 	// If the provided Storage Bucket ID is malformed or not
 	// provided DeleteStorageBucket returns an error.
 	DeleteStorageBucket(context.Context, *DeleteStorageBucketRequest) (*DeleteStorageBucketResponse, error)
+	// SetStorageBucketDeletionProtection sets whether the Storage Bucket is
+	// protected from deletion. While protected, deleting the Storage Bucket, or
+	// any scope containing it, fails. Removing the protection requires the
+	// set-deletion-protection action, which is granted separately from the
+	// delete action.
+	SetStorageBucketDeletionProtection(context.Context, *SetStorageBucketDeletionProtectionRequest) (*SetStorageBucketDeletionProtectionResponse, error)
 	mustEmbedUnimplementedStorageBucketServiceServer()
 }
 
@@ -164,6 +186,9 @@ func (UnimplementedStorageBucketServiceServer) UpdateStorageBucket(context.Conte
 func (UnimplementedStorageBucketServiceServer) DeleteStorageBucket(context.Context, *DeleteStorageBucketRequest) (*DeleteStorageBucketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStorageBucket not implemented")
 }
+func (UnimplementedStorageBucketServiceServer) SetStorageBucketDeletionProtection(context.Context, *SetStorageBucketDeletionProtectionRequest) (*SetStorageBucketDeletionProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStorageBucketDeletionProtection not implemented")
+}
 func (UnimplementedStorageBucketServiceServer) mustEmbedUnimplementedStorageBucketServiceServer() {}
 
 // UnsafeStorageBucketServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -267,6 +292,24 @@ func _StorageBucketService_DeleteStorageBucket_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageBucketService_SetStorageBucketDeletionProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStorageBucketDeletionProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageBucketServiceServer).SetStorageBucketDeletionProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageBucketService_SetStorageBucketDeletionProtection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageBucketServiceServer).SetStorageBucketDeletionProtection(ctx, req.(*SetStorageBucketDeletionProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StorageBucketService_ServiceDesc is the grpc.ServiceDesc for StorageBucketService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -294,6 +337,10 @@ var StorageBucketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStorageBucket",
 			Handler:    _StorageBucketService_DeleteStorageBucket_Handler,
 		},
+		{
+			MethodName: "SetStorageBucketDeletionProtection",
+			Handler:    _StorageBucketService_SetStorageBucketDeletionProtection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/storage_bucket_service.proto",
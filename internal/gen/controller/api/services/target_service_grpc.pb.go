@@ -25,15 +25,19 @@ const (
 	TargetService_GetTarget_FullMethodName                     = "/controller.api.services.v1.TargetService/GetTarget"
 	TargetService_ListTargets_FullMethodName                   = "/controller.api.services.v1.TargetService/ListTargets"
 	TargetService_CreateTarget_FullMethodName                  = "/controller.api.services.v1.TargetService/CreateTarget"
+	TargetService_CreateTargetFromTemplate_FullMethodName      = "/controller.api.services.v1.TargetService/CreateTargetFromTemplate"
 	TargetService_UpdateTarget_FullMethodName                  = "/controller.api.services.v1.TargetService/UpdateTarget"
 	TargetService_DeleteTarget_FullMethodName                  = "/controller.api.services.v1.TargetService/DeleteTarget"
 	TargetService_AuthorizeSession_FullMethodName              = "/controller.api.services.v1.TargetService/AuthorizeSession"
+	TargetService_TestTargetConnection_FullMethodName          = "/controller.api.services.v1.TargetService/TestTargetConnection"
+	TargetService_ReadTargetRecordingPolicy_FullMethodName     = "/controller.api.services.v1.TargetService/ReadTargetRecordingPolicy"
 	TargetService_AddTargetHostSources_FullMethodName          = "/controller.api.services.v1.TargetService/AddTargetHostSources"
 	TargetService_SetTargetHostSources_FullMethodName          = "/controller.api.services.v1.TargetService/SetTargetHostSources"
 	TargetService_RemoveTargetHostSources_FullMethodName       = "/controller.api.services.v1.TargetService/RemoveTargetHostSources"
 	TargetService_AddTargetCredentialSources_FullMethodName    = "/controller.api.services.v1.TargetService/AddTargetCredentialSources"
 	TargetService_SetTargetCredentialSources_FullMethodName    = "/controller.api.services.v1.TargetService/SetTargetCredentialSources"
 	TargetService_RemoveTargetCredentialSources_FullMethodName = "/controller.api.services.v1.TargetService/RemoveTargetCredentialSources"
+	TargetService_SetTargetDeletionProtection_FullMethodName   = "/controller.api.services.v1.TargetService/SetTargetDeletionProtection"
 )
 
 // TargetServiceClient is the client API for TargetService service.
@@ -56,6 +60,14 @@ type TargetServiceClient interface {
 	// scope, an error is returned.  If a name is provided that is in
 	// use in another Target in the same scope, an error is returned.
 	CreateTarget(ctx context.Context, in *CreateTargetRequest, opts ...grpc.CallOption) (*CreateTargetResponse, error)
+	// CreateTargetFromTemplate creates and stores a Target from a target
+	// template in the template's project. The parameters provided in the
+	// request are substituted into the template's definition, and the Target
+	// is created together with the host sources and credential sources of the
+	// definition. If the template id is missing or references a non existing
+	// template, or a parameter referenced by the definition is not provided, an
+	// error is returned.
+	CreateTargetFromTemplate(ctx context.Context, in *CreateTargetFromTemplateRequest, opts ...grpc.CallOption) (*CreateTargetFromTemplateResponse, error)
 	// UpdateTarget updates an existing Target in boundary.  The provided
 	// Target must not have any read only fields set.  The update mask must be
 	// included in the request and contain at least 1 mutable field.  To unset
@@ -74,6 +86,16 @@ type TargetServiceClient interface {
 	// "id" field to have any number of segments, which works so long as the last
 	// part of the path is the verb, which is our normal pattern.
 	AuthorizeSession(ctx context.Context, in *AuthorizeSessionRequest, opts ...grpc.CallOption) (*AuthorizeSessionResponse, error)
+	// TestTargetConnection asks a Worker that could handle sessions to the
+	// Target to connect to the Target's address, and returns the latency of the
+	// connection or why it failed. The Worker must report its status to the
+	// Controller handling the request.
+	TestTargetConnection(ctx context.Context, in *TestTargetConnectionRequest, opts ...grpc.CallOption) (*TestTargetConnectionResponse, error)
+	// ReadTargetRecordingPolicy returns the recording policy that applies to the
+	// sessions of a Target, once the settings of the Target and of the storage
+	// policies that apply to it have been combined. If the Target ID is missing,
+	// malformed or references a non existing resource, an error is returned.
+	ReadTargetRecordingPolicy(ctx context.Context, in *ReadTargetRecordingPolicyRequest, opts ...grpc.CallOption) (*ReadTargetRecordingPolicyResponse, error)
 	// AddTargetHostSources adds Host Sources to this Target. The provided request
 	// must include the Target ID to which the Host Sources will be added. All
 	// Host Sources added to the provided Target must be a child of a Catalog that
@@ -123,6 +145,11 @@ type TargetServiceClient interface {
 	// Credential Source is attempted to be removed from the Target when the
 	// Target does not have the Credential Source.
 	RemoveTargetCredentialSources(ctx context.Context, in *RemoveTargetCredentialSourcesRequest, opts ...grpc.CallOption) (*RemoveTargetCredentialSourcesResponse, error)
+	// SetTargetDeletionProtection sets whether the Target is protected from
+	// deletion. While protected, deleting the Target, or any scope containing
+	// it, fails. Removing the protection requires the set-deletion-protection
+	// action, which is granted separately from the delete action.
+	SetTargetDeletionProtection(ctx context.Context, in *SetTargetDeletionProtectionRequest, opts ...grpc.CallOption) (*SetTargetDeletionProtectionResponse, error)
 }
 
 type targetServiceClient struct {
@@ -160,6 +187,15 @@ func (c *targetServiceClient) CreateTarget(ctx context.Context, in *CreateTarget
 	return out, nil
 }
 
+func (c *targetServiceClient) CreateTargetFromTemplate(ctx context.Context, in *CreateTargetFromTemplateRequest, opts ...grpc.CallOption) (*CreateTargetFromTemplateResponse, error) {
+	out := new(CreateTargetFromTemplateResponse)
+	err := c.cc.Invoke(ctx, TargetService_CreateTargetFromTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *targetServiceClient) UpdateTarget(ctx context.Context, in *UpdateTargetRequest, opts ...grpc.CallOption) (*UpdateTargetResponse, error) {
 	out := new(UpdateTargetResponse)
 	err := c.cc.Invoke(ctx, TargetService_UpdateTarget_FullMethodName, in, out, opts...)
@@ -187,6 +223,24 @@ func (c *targetServiceClient) AuthorizeSession(ctx context.Context, in *Authoriz
 	return out, nil
 }
 
+func (c *targetServiceClient) TestTargetConnection(ctx context.Context, in *TestTargetConnectionRequest, opts ...grpc.CallOption) (*TestTargetConnectionResponse, error) {
+	out := new(TestTargetConnectionResponse)
+	err := c.cc.Invoke(ctx, TargetService_TestTargetConnection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetServiceClient) ReadTargetRecordingPolicy(ctx context.Context, in *ReadTargetRecordingPolicyRequest, opts ...grpc.CallOption) (*ReadTargetRecordingPolicyResponse, error) {
+	out := new(ReadTargetRecordingPolicyResponse)
+	err := c.cc.Invoke(ctx, TargetService_ReadTargetRecordingPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *targetServiceClient) AddTargetHostSources(ctx context.Context, in *AddTargetHostSourcesRequest, opts ...grpc.CallOption) (*AddTargetHostSourcesResponse, error) {
 	out := new(AddTargetHostSourcesResponse)
 	err := c.cc.Invoke(ctx, TargetService_AddTargetHostSources_FullMethodName, in, out, opts...)
@@ -241,6 +295,15 @@ func (c *targetServiceClient) RemoveTargetCredentialSources(ctx context.Context,
 	return out, nil
 }
 
+func (c *targetServiceClient) SetTargetDeletionProtection(ctx context.Context, in *SetTargetDeletionProtectionRequest, opts ...grpc.CallOption) (*SetTargetDeletionProtectionResponse, error) {
+	out := new(SetTargetDeletionProtectionResponse)
+	err := c.cc.Invoke(ctx, TargetService_SetTargetDeletionProtection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TargetServiceServer is the server API for TargetService service.
 // All implementations must embed UnimplementedTargetServiceServer
 // for forward compatibility
@@ -261,6 +324,14 @@ type TargetServiceServer interface {
 	// scope, an error is returned.  If a name is provided that is in
 	// use in another Target in the same scope, an error is returned.
 	CreateTarget(context.Context, *CreateTargetRequest) (*CreateTargetResponse, error)
+	// CreateTargetFromTemplate creates and stores a Target from a target
+	// template in the template's project. The parameters provided in the
+	// request are substituted into the template's definition, and the Target
+	// is created together with the host sources and credential sources of the
+	// definition. If the template id is missing or references a non existing
+	// template, or a parameter referenced by the definition is not provided, an
+	// error is returned.
+	CreateTargetFromTemplate(context.Context, *CreateTargetFromTemplateRequest) (*CreateTargetFromTemplateResponse, error)
 	// UpdateTarget updates an existing Target in boundary.  The provided
 	// Target must not have any read only fields set.  The update mask must be
 	// included in the request and contain at least 1 mutable field.  To unset
@@ -279,6 +350,16 @@ type TargetServiceServer interface {
 	// "id" field to have any number of segments, which works so long as the last
 	// part of the path is the verb, which is our normal pattern.
 	AuthorizeSession(context.Context, *AuthorizeSessionRequest) (*AuthorizeSessionResponse, error)
+	// TestTargetConnection asks a Worker that could handle sessions to the
+	// Target to connect to the Target's address, and returns the latency of the
+	// connection or why it failed. The Worker must report its status to the
+	// Controller handling the request.
+	TestTargetConnection(context.Context, *TestTargetConnectionRequest) (*TestTargetConnectionResponse, error)
+	// ReadTargetRecordingPolicy returns the recording policy that applies to the
+	// sessions of a Target, once the settings of the Target and of the storage
+	// policies that apply to it have been combined. If the Target ID is missing,
+	// malformed or references a non existing resource, an error is returned.
+	ReadTargetRecordingPolicy(context.Context, *ReadTargetRecordingPolicyRequest) (*ReadTargetRecordingPolicyResponse, error)
 	// AddTargetHostSources adds Host Sources to this Target. The provided request
 	// must include the Target ID to which the Host Sources will be added. All
 	// Host Sources added to the provided Target must be a child of a Catalog that
@@ -328,6 +409,11 @@ type TargetServiceServer interface {
 	// Credential Source is attempted to be removed from the Target when the
 	// Target does not have the Credential Source.
 	RemoveTargetCredentialSources(context.Context, *RemoveTargetCredentialSourcesRequest) (*RemoveTargetCredentialSourcesResponse, error)
+	// SetTargetDeletionProtection sets whether the Target is protected from
+	// deletion. While protected, deleting the Target, or any scope containing
+	// it, fails. Removing the protection requires the set-deletion-protection
+	// action, which is granted separately from the delete action.
+	SetTargetDeletionProtection(context.Context, *SetTargetDeletionProtectionRequest) (*SetTargetDeletionProtectionResponse, error)
 	mustEmbedUnimplementedTargetServiceServer()
 }
 
@@ -344,6 +430,9 @@ func (UnimplementedTargetServiceServer) ListTargets(context.Context, *ListTarget
 func (UnimplementedTargetServiceServer) CreateTarget(context.Context, *CreateTargetRequest) (*CreateTargetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTarget not implemented")
 }
+func (UnimplementedTargetServiceServer) CreateTargetFromTemplate(context.Context, *CreateTargetFromTemplateRequest) (*CreateTargetFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTargetFromTemplate not implemented")
+}
 func (UnimplementedTargetServiceServer) UpdateTarget(context.Context, *UpdateTargetRequest) (*UpdateTargetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTarget not implemented")
 }
@@ -353,6 +442,12 @@ func (UnimplementedTargetServiceServer) DeleteTarget(context.Context, *DeleteTar
 func (UnimplementedTargetServiceServer) AuthorizeSession(context.Context, *AuthorizeSessionRequest) (*AuthorizeSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeSession not implemented")
 }
+func (UnimplementedTargetServiceServer) TestTargetConnection(context.Context, *TestTargetConnectionRequest) (*TestTargetConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestTargetConnection not implemented")
+}
+func (UnimplementedTargetServiceServer) ReadTargetRecordingPolicy(context.Context, *ReadTargetRecordingPolicyRequest) (*ReadTargetRecordingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadTargetRecordingPolicy not implemented")
+}
 func (UnimplementedTargetServiceServer) AddTargetHostSources(context.Context, *AddTargetHostSourcesRequest) (*AddTargetHostSourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddTargetHostSources not implemented")
 }
@@ -371,6 +466,9 @@ func (UnimplementedTargetServiceServer) SetTargetCredentialSources(context.Conte
 func (UnimplementedTargetServiceServer) RemoveTargetCredentialSources(context.Context, *RemoveTargetCredentialSourcesRequest) (*RemoveTargetCredentialSourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTargetCredentialSources not implemented")
 }
+func (UnimplementedTargetServiceServer) SetTargetDeletionProtection(context.Context, *SetTargetDeletionProtectionRequest) (*SetTargetDeletionProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTargetDeletionProtection not implemented")
+}
 func (UnimplementedTargetServiceServer) mustEmbedUnimplementedTargetServiceServer() {}
 
 // UnsafeTargetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -438,6 +536,24 @@ func _TargetService_CreateTarget_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TargetService_CreateTargetFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTargetFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).CreateTargetFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetService_CreateTargetFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).CreateTargetFromTemplate(ctx, req.(*CreateTargetFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TargetService_UpdateTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTargetRequest)
 	if err := dec(in); err != nil {
@@ -492,6 +608,42 @@ func _TargetService_AuthorizeSession_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TargetService_TestTargetConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestTargetConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).TestTargetConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetService_TestTargetConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).TestTargetConnection(ctx, req.(*TestTargetConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetService_ReadTargetRecordingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadTargetRecordingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).ReadTargetRecordingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetService_ReadTargetRecordingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).ReadTargetRecordingPolicy(ctx, req.(*ReadTargetRecordingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TargetService_AddTargetHostSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddTargetHostSourcesRequest)
 	if err := dec(in); err != nil {
@@ -600,6 +752,24 @@ func _TargetService_RemoveTargetCredentialSources_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TargetService_SetTargetDeletionProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTargetDeletionProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).SetTargetDeletionProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetService_SetTargetDeletionProtection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).SetTargetDeletionProtection(ctx, req.(*SetTargetDeletionProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TargetService_ServiceDesc is the grpc.ServiceDesc for TargetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -619,6 +789,10 @@ var TargetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTarget",
 			Handler:    _TargetService_CreateTarget_Handler,
 		},
+		{
+			MethodName: "CreateTargetFromTemplate",
+			Handler:    _TargetService_CreateTargetFromTemplate_Handler,
+		},
 		{
 			MethodName: "UpdateTarget",
 			Handler:    _TargetService_UpdateTarget_Handler,
@@ -631,6 +805,14 @@ var TargetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthorizeSession",
 			Handler:    _TargetService_AuthorizeSession_Handler,
 		},
+		{
+			MethodName: "TestTargetConnection",
+			Handler:    _TargetService_TestTargetConnection_Handler,
+		},
+		{
+			MethodName: "ReadTargetRecordingPolicy",
+			Handler:    _TargetService_ReadTargetRecordingPolicy_Handler,
+		},
 		{
 			MethodName: "AddTargetHostSources",
 			Handler:    _TargetService_AddTargetHostSources_Handler,
@@ -655,6 +837,10 @@ var TargetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTargetCredentialSources",
 			Handler:    _TargetService_RemoveTargetCredentialSources_Handler,
 		},
+		{
+			MethodName: "SetTargetDeletionProtection",
+			Handler:    _TargetService_SetTargetDeletionProtection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/target_service.proto",
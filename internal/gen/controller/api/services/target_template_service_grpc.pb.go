@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: controller/api/services/v1/target_template_service.proto
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TargetTemplateService_GetTargetTemplate_FullMethodName    = "/controller.api.services.v1.TargetTemplateService/GetTargetTemplate"
+	TargetTemplateService_ListTargetTemplates_FullMethodName = "/controller.api.services.v1.TargetTemplateService/ListTargetTemplates"
+	TargetTemplateService_CreateTargetTemplate_FullMethodName = "/controller.api.services.v1.TargetTemplateService/CreateTargetTemplate"
+	TargetTemplateService_UpdateTargetTemplate_FullMethodName = "/controller.api.services.v1.TargetTemplateService/UpdateTargetTemplate"
+	TargetTemplateService_DeleteTargetTemplate_FullMethodName = "/controller.api.services.v1.TargetTemplateService/DeleteTargetTemplate"
+)
+
+// TargetTemplateServiceClient is the client API for TargetTemplateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TargetTemplateServiceClient interface {
+	// GetTargetTemplate returns a stored target template if present. The
+	// provided request must include the id for the target template be
+	// retrieved. If missing, malformed or referencing a non existing target
+	// template an error is returned.
+	GetTargetTemplate(ctx context.Context, in *GetTargetTemplateRequest, opts ...grpc.CallOption) (*GetTargetTemplateResponse, error)
+	// ListTargetTemplates returns a list of stored target templates which exist
+	// inside the provided scope. The request must include the scope id which
+	// contains the target templates being listed. If missing or malformed, an
+	// error is returned.
+	ListTargetTemplates(ctx context.Context, in *ListTargetTemplatesRequest, opts ...grpc.CallOption) (*ListTargetTemplatesResponse, error)
+	// CreateTargetTemplate creates and stores a target template in boundary. The
+	// provided request must include the project id in which the target template
+	// will be created and a definition containing the type of the targets. If
+	// the project id is missing, malformed, or references a non existing
+	// project an error is returned. If a name is provided that is in use in
+	// another target template in the same project an error is returned.
+	CreateTargetTemplate(ctx context.Context, in *CreateTargetTemplateRequest, opts ...grpc.CallOption) (*CreateTargetTemplateResponse, error)
+	// UpdateTargetTemplate updates an existing target template in boundary. The
+	// provided target template must not have any read only fields set. The
+	// update mask must be included in the request and contain at least 1
+	// mutable field. To unset a field's value, include the field in the update
+	// mask and don't set it in the provided target template. The definition
+	// cannot be unset. An error is returned if the target template id is
+	// missing or references a non-existing resource. An error is also returned
+	// if the request attempts to update the name to one that is already in use
+	// in the containing project.
+	UpdateTargetTemplate(ctx context.Context, in *UpdateTargetTemplateRequest, opts ...grpc.CallOption) (*UpdateTargetTemplateResponse, error)
+	// DeleteTargetTemplate removes a target template from Boundary. The targets
+	// created from the template are not deleted. If the provided target
+	// template id is malformed or not provided an error is returned.
+	DeleteTargetTemplate(ctx context.Context, in *DeleteTargetTemplateRequest, opts ...grpc.CallOption) (*DeleteTargetTemplateResponse, error)
+}
+
+type targetTemplateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTargetTemplateServiceClient(cc grpc.ClientConnInterface) TargetTemplateServiceClient {
+	return &targetTemplateServiceClient{cc}
+}
+
+func (c *targetTemplateServiceClient) GetTargetTemplate(ctx context.Context, in *GetTargetTemplateRequest, opts ...grpc.CallOption) (*GetTargetTemplateResponse, error) {
+	out := new(GetTargetTemplateResponse)
+	err := c.cc.Invoke(ctx, TargetTemplateService_GetTargetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetTemplateServiceClient) ListTargetTemplates(ctx context.Context, in *ListTargetTemplatesRequest, opts ...grpc.CallOption) (*ListTargetTemplatesResponse, error) {
+	out := new(ListTargetTemplatesResponse)
+	err := c.cc.Invoke(ctx, TargetTemplateService_ListTargetTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetTemplateServiceClient) CreateTargetTemplate(ctx context.Context, in *CreateTargetTemplateRequest, opts ...grpc.CallOption) (*CreateTargetTemplateResponse, error) {
+	out := new(CreateTargetTemplateResponse)
+	err := c.cc.Invoke(ctx, TargetTemplateService_CreateTargetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetTemplateServiceClient) UpdateTargetTemplate(ctx context.Context, in *UpdateTargetTemplateRequest, opts ...grpc.CallOption) (*UpdateTargetTemplateResponse, error) {
+	out := new(UpdateTargetTemplateResponse)
+	err := c.cc.Invoke(ctx, TargetTemplateService_UpdateTargetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetTemplateServiceClient) DeleteTargetTemplate(ctx context.Context, in *DeleteTargetTemplateRequest, opts ...grpc.CallOption) (*DeleteTargetTemplateResponse, error) {
+	out := new(DeleteTargetTemplateResponse)
+	err := c.cc.Invoke(ctx, TargetTemplateService_DeleteTargetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TargetTemplateServiceServer is the server API for TargetTemplateService service.
+// All implementations must embed UnimplementedTargetTemplateServiceServer
+// for forward compatibility
+type TargetTemplateServiceServer interface {
+	// GetTargetTemplate returns a stored target template if present. The
+	// provided request must include the id for the target template be
+	// retrieved. If missing, malformed or referencing a non existing target
+	// template an error is returned.
+	GetTargetTemplate(context.Context, *GetTargetTemplateRequest) (*GetTargetTemplateResponse, error)
+	// ListTargetTemplates returns a list of stored target templates which exist
+	// inside the provided scope. The request must include the scope id which
+	// contains the target templates being listed. If missing or malformed, an
+	// error is returned.
+	ListTargetTemplates(context.Context, *ListTargetTemplatesRequest) (*ListTargetTemplatesResponse, error)
+	// CreateTargetTemplate creates and stores a target template in boundary. The
+	// provided request must include the project id in which the target template
+	// will be created and a definition containing the type of the targets. If
+	// the project id is missing, malformed, or references a non existing
+	// project an error is returned. If a name is provided that is in use in
+	// another target template in the same project an error is returned.
+	CreateTargetTemplate(context.Context, *CreateTargetTemplateRequest) (*CreateTargetTemplateResponse, error)
+	// UpdateTargetTemplate updates an existing target template in boundary. The
+	// provided target template must not have any read only fields set. The
+	// update mask must be included in the request and contain at least 1
+	// mutable field. To unset a field's value, include the field in the update
+	// mask and don't set it in the provided target template. The definition
+	// cannot be unset. An error is returned if the target template id is
+	// missing or references a non-existing resource. An error is also returned
+	// if the request attempts to update the name to one that is already in use
+	// in the containing project.
+	UpdateTargetTemplate(context.Context, *UpdateTargetTemplateRequest) (*UpdateTargetTemplateResponse, error)
+	// DeleteTargetTemplate removes a target template from Boundary. The targets
+	// created from the template are not deleted. If the provided target
+	// template id is malformed or not provided an error is returned.
+	DeleteTargetTemplate(context.Context, *DeleteTargetTemplateRequest) (*DeleteTargetTemplateResponse, error)
+	mustEmbedUnimplementedTargetTemplateServiceServer()
+}
+
+// UnimplementedTargetTemplateServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTargetTemplateServiceServer struct {
+}
+
+func (UnimplementedTargetTemplateServiceServer) GetTargetTemplate(context.Context, *GetTargetTemplateRequest) (*GetTargetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargetTemplate not implemented")
+}
+func (UnimplementedTargetTemplateServiceServer) ListTargetTemplates(context.Context, *ListTargetTemplatesRequest) (*ListTargetTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTargetTemplates not implemented")
+}
+func (UnimplementedTargetTemplateServiceServer) CreateTargetTemplate(context.Context, *CreateTargetTemplateRequest) (*CreateTargetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTargetTemplate not implemented")
+}
+func (UnimplementedTargetTemplateServiceServer) UpdateTargetTemplate(context.Context, *UpdateTargetTemplateRequest) (*UpdateTargetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTargetTemplate not implemented")
+}
+func (UnimplementedTargetTemplateServiceServer) DeleteTargetTemplate(context.Context, *DeleteTargetTemplateRequest) (*DeleteTargetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTargetTemplate not implemented")
+}
+func (UnimplementedTargetTemplateServiceServer) mustEmbedUnimplementedTargetTemplateServiceServer() {}
+
+// UnsafeTargetTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TargetTemplateServiceServer will
+// result in compilation errors.
+type UnsafeTargetTemplateServiceServer interface {
+	mustEmbedUnimplementedTargetTemplateServiceServer()
+}
+
+func RegisterTargetTemplateServiceServer(s grpc.ServiceRegistrar, srv TargetTemplateServiceServer) {
+	s.RegisterService(&TargetTemplateService_ServiceDesc, srv)
+}
+
+func _TargetTemplateService_GetTargetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTargetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetTemplateServiceServer).GetTargetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetTemplateService_GetTargetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetTemplateServiceServer).GetTargetTemplate(ctx, req.(*GetTargetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetTemplateService_ListTargetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTargetTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetTemplateServiceServer).ListTargetTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetTemplateService_ListTargetTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetTemplateServiceServer).ListTargetTemplates(ctx, req.(*ListTargetTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetTemplateService_CreateTargetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTargetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetTemplateServiceServer).CreateTargetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetTemplateService_CreateTargetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetTemplateServiceServer).CreateTargetTemplate(ctx, req.(*CreateTargetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetTemplateService_UpdateTargetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTargetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetTemplateServiceServer).UpdateTargetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetTemplateService_UpdateTargetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetTemplateServiceServer).UpdateTargetTemplate(ctx, req.(*UpdateTargetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetTemplateService_DeleteTargetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTargetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetTemplateServiceServer).DeleteTargetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TargetTemplateService_DeleteTargetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetTemplateServiceServer).DeleteTargetTemplate(ctx, req.(*DeleteTargetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TargetTemplateService_ServiceDesc is the grpc.ServiceDesc for TargetTemplateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TargetTemplateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controller.api.services.v1.TargetTemplateService",
+	HandlerType: (*TargetTemplateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTargetTemplate",
+			Handler:    _TargetTemplateService_GetTargetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTargetTemplates",
+			Handler:    _TargetTemplateService_ListTargetTemplates_Handler,
+		},
+		{
+			MethodName: "CreateTargetTemplate",
+			Handler:    _TargetTemplateService_CreateTargetTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTargetTemplate",
+			Handler:    _TargetTemplateService_UpdateTargetTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTargetTemplate",
+			Handler:    _TargetTemplateService_DeleteTargetTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controller/api/services/v1/target_template_service.proto",
+}
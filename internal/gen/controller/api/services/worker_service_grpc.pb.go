@@ -33,6 +33,11 @@ const (
 	WorkerService_RemoveWorkerTags_FullMethodName                 = "/controller.api.services.v1.WorkerService/RemoveWorkerTags"
 	WorkerService_ReadCertificateAuthority_FullMethodName         = "/controller.api.services.v1.WorkerService/ReadCertificateAuthority"
 	WorkerService_ReinitializeCertificateAuthority_FullMethodName = "/controller.api.services.v1.WorkerService/ReinitializeCertificateAuthority"
+	WorkerService_CreateWorkerActivationToken_FullMethodName      = "/controller.api.services.v1.WorkerService/CreateWorkerActivationToken"
+	WorkerService_ListWorkerActivationTokens_FullMethodName       = "/controller.api.services.v1.WorkerService/ListWorkerActivationTokens"
+	WorkerService_RevokeWorkerActivationToken_FullMethodName      = "/controller.api.services.v1.WorkerService/RevokeWorkerActivationToken"
+	WorkerService_ReadWorkerLogs_FullMethodName                   = "/controller.api.services.v1.WorkerService/ReadWorkerLogs"
+	WorkerService_ListWorkerUtilization_FullMethodName            = "/controller.api.services.v1.WorkerService/ListWorkerUtilization"
 )
 
 // WorkerServiceClient is the client API for WorkerService service.
@@ -89,6 +94,32 @@ type WorkerServiceClient interface {
 	ReadCertificateAuthority(ctx context.Context, in *ReadCertificateAuthorityRequest, opts ...grpc.CallOption) (*ReadCertificateAuthorityResponse, error)
 	// ReinitializeCas removes both current and next root certs and replaces them with a new set
 	ReinitializeCertificateAuthority(ctx context.Context, in *ReinitializeCertificateAuthorityRequest, opts ...grpc.CallOption) (*ReinitializeCertificateAuthorityResponse, error)
+	// CreateWorkerActivationToken creates and stores a Worker in Boundary and
+	// returns a single-use activation token that can be used by a worker binary
+	// to claim the created Worker's identity until it expires. The token can be
+	// restricted to network ranges the Worker must report its status from. The
+	// provided request must include the Scope ID in which the Worker will be
+	// created.
+	CreateWorkerActivationToken(ctx context.Context, in *CreateWorkerActivationTokenRequest, opts ...grpc.CallOption) (*CreateWorkerActivationTokenResponse, error)
+	// ListWorkerActivationTokens returns the activation tokens that have not
+	// been used yet, including the ones that have expired. The tokens themselves
+	// are not returned.
+	ListWorkerActivationTokens(ctx context.Context, in *ListWorkerActivationTokensRequest, opts ...grpc.CallOption) (*ListWorkerActivationTokensResponse, error)
+	// RevokeWorkerActivationToken revokes an activation token that has not been
+	// used yet, deleting the Worker it was created for. If the Worker does not
+	// have such a token, an error is returned.
+	RevokeWorkerActivationToken(ctx context.Context, in *RevokeWorkerActivationTokenRequest, opts ...grpc.CallOption) (*RevokeWorkerActivationTokenResponse, error)
+	// ReadWorkerLogs returns the system and error events a Worker emitted in
+	// the last minutes along with the current values of its metrics. They are
+	// requested from the Worker through its connection to the controller, so
+	// the Worker must be connected to the controller handling the request. If
+	// the Worker does not respond in time, an error is returned.
+	ReadWorkerLogs(ctx context.Context, in *ReadWorkerLogsRequest, opts ...grpc.CallOption) (*ReadWorkerLogsResponse, error)
+	// ListWorkerUtilization returns the load each live Worker reported in its
+	// last status update, along with totals across the returned Workers. It is
+	// intended for autoscalers deciding whether to add or remove Workers; a
+	// filter on the Workers' tags limits the result to a pool of Workers.
+	ListWorkerUtilization(ctx context.Context, in *ListWorkerUtilizationRequest, opts ...grpc.CallOption) (*ListWorkerUtilizationResponse, error)
 }
 
 type workerServiceClient struct {
@@ -198,6 +229,51 @@ func (c *workerServiceClient) ReinitializeCertificateAuthority(ctx context.Conte
 	return out, nil
 }
 
+func (c *workerServiceClient) CreateWorkerActivationToken(ctx context.Context, in *CreateWorkerActivationTokenRequest, opts ...grpc.CallOption) (*CreateWorkerActivationTokenResponse, error) {
+	out := new(CreateWorkerActivationTokenResponse)
+	err := c.cc.Invoke(ctx, WorkerService_CreateWorkerActivationToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerServiceClient) ListWorkerActivationTokens(ctx context.Context, in *ListWorkerActivationTokensRequest, opts ...grpc.CallOption) (*ListWorkerActivationTokensResponse, error) {
+	out := new(ListWorkerActivationTokensResponse)
+	err := c.cc.Invoke(ctx, WorkerService_ListWorkerActivationTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerServiceClient) RevokeWorkerActivationToken(ctx context.Context, in *RevokeWorkerActivationTokenRequest, opts ...grpc.CallOption) (*RevokeWorkerActivationTokenResponse, error) {
+	out := new(RevokeWorkerActivationTokenResponse)
+	err := c.cc.Invoke(ctx, WorkerService_RevokeWorkerActivationToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerServiceClient) ReadWorkerLogs(ctx context.Context, in *ReadWorkerLogsRequest, opts ...grpc.CallOption) (*ReadWorkerLogsResponse, error) {
+	out := new(ReadWorkerLogsResponse)
+	err := c.cc.Invoke(ctx, WorkerService_ReadWorkerLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerServiceClient) ListWorkerUtilization(ctx context.Context, in *ListWorkerUtilizationRequest, opts ...grpc.CallOption) (*ListWorkerUtilizationResponse, error) {
+	out := new(ListWorkerUtilizationResponse)
+	err := c.cc.Invoke(ctx, WorkerService_ListWorkerUtilization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkerServiceServer is the server API for WorkerService service.
 // All implementations must embed UnimplementedWorkerServiceServer
 // for forward compatibility
@@ -252,6 +328,32 @@ type WorkerServiceServer interface {
 	ReadCertificateAuthority(context.Context, *ReadCertificateAuthorityRequest) (*ReadCertificateAuthorityResponse, error)
 	// ReinitializeCas removes both current and next root certs and replaces them with a new set
 	ReinitializeCertificateAuthority(context.Context, *ReinitializeCertificateAuthorityRequest) (*ReinitializeCertificateAuthorityResponse, error)
+	// CreateWorkerActivationToken creates and stores a Worker in Boundary and
+	// returns a single-use activation token that can be used by a worker binary
+	// to claim the created Worker's identity until it expires. The token can be
+	// restricted to network ranges the Worker must report its status from. The
+	// provided request must include the Scope ID in which the Worker will be
+	// created.
+	CreateWorkerActivationToken(context.Context, *CreateWorkerActivationTokenRequest) (*CreateWorkerActivationTokenResponse, error)
+	// ListWorkerActivationTokens returns the activation tokens that have not
+	// been used yet, including the ones that have expired. The tokens themselves
+	// are not returned.
+	ListWorkerActivationTokens(context.Context, *ListWorkerActivationTokensRequest) (*ListWorkerActivationTokensResponse, error)
+	// RevokeWorkerActivationToken revokes an activation token that has not been
+	// used yet, deleting the Worker it was created for. If the Worker does not
+	// have such a token, an error is returned.
+	RevokeWorkerActivationToken(context.Context, *RevokeWorkerActivationTokenRequest) (*RevokeWorkerActivationTokenResponse, error)
+	// ReadWorkerLogs returns the system and error events a Worker emitted in
+	// the last minutes along with the current values of its metrics. They are
+	// requested from the Worker through its connection to the controller, so
+	// the Worker must be connected to the controller handling the request. If
+	// the Worker does not respond in time, an error is returned.
+	ReadWorkerLogs(context.Context, *ReadWorkerLogsRequest) (*ReadWorkerLogsResponse, error)
+	// ListWorkerUtilization returns the load each live Worker reported in its
+	// last status update, along with totals across the returned Workers. It is
+	// intended for autoscalers deciding whether to add or remove Workers; a
+	// filter on the Workers' tags limits the result to a pool of Workers.
+	ListWorkerUtilization(context.Context, *ListWorkerUtilizationRequest) (*ListWorkerUtilizationResponse, error)
 	mustEmbedUnimplementedWorkerServiceServer()
 }
 
@@ -292,6 +394,21 @@ func (UnimplementedWorkerServiceServer) ReadCertificateAuthority(context.Context
 func (UnimplementedWorkerServiceServer) ReinitializeCertificateAuthority(context.Context, *ReinitializeCertificateAuthorityRequest) (*ReinitializeCertificateAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReinitializeCertificateAuthority not implemented")
 }
+func (UnimplementedWorkerServiceServer) CreateWorkerActivationToken(context.Context, *CreateWorkerActivationTokenRequest) (*CreateWorkerActivationTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkerActivationToken not implemented")
+}
+func (UnimplementedWorkerServiceServer) ListWorkerActivationTokens(context.Context, *ListWorkerActivationTokensRequest) (*ListWorkerActivationTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkerActivationTokens not implemented")
+}
+func (UnimplementedWorkerServiceServer) RevokeWorkerActivationToken(context.Context, *RevokeWorkerActivationTokenRequest) (*RevokeWorkerActivationTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeWorkerActivationToken not implemented")
+}
+func (UnimplementedWorkerServiceServer) ReadWorkerLogs(context.Context, *ReadWorkerLogsRequest) (*ReadWorkerLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadWorkerLogs not implemented")
+}
+func (UnimplementedWorkerServiceServer) ListWorkerUtilization(context.Context, *ListWorkerUtilizationRequest) (*ListWorkerUtilizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkerUtilization not implemented")
+}
 func (UnimplementedWorkerServiceServer) mustEmbedUnimplementedWorkerServiceServer() {}
 
 // UnsafeWorkerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -503,6 +620,96 @@ func _WorkerService_ReinitializeCertificateAuthority_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkerService_CreateWorkerActivationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkerActivationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).CreateWorkerActivationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_CreateWorkerActivationToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).CreateWorkerActivationToken(ctx, req.(*CreateWorkerActivationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_ListWorkerActivationTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkerActivationTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).ListWorkerActivationTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_ListWorkerActivationTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).ListWorkerActivationTokens(ctx, req.(*ListWorkerActivationTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_RevokeWorkerActivationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeWorkerActivationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).RevokeWorkerActivationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_RevokeWorkerActivationToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).RevokeWorkerActivationToken(ctx, req.(*RevokeWorkerActivationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_ReadWorkerLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadWorkerLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).ReadWorkerLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_ReadWorkerLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).ReadWorkerLogs(ctx, req.(*ReadWorkerLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_ListWorkerUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkerUtilizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).ListWorkerUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_ListWorkerUtilization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).ListWorkerUtilization(ctx, req.(*ListWorkerUtilizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkerService_ServiceDesc is the grpc.ServiceDesc for WorkerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -554,6 +761,26 @@ var WorkerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReinitializeCertificateAuthority",
 			Handler:    _WorkerService_ReinitializeCertificateAuthority_Handler,
 		},
+		{
+			MethodName: "CreateWorkerActivationToken",
+			Handler:    _WorkerService_CreateWorkerActivationToken_Handler,
+		},
+		{
+			MethodName: "ListWorkerActivationTokens",
+			Handler:    _WorkerService_ListWorkerActivationTokens_Handler,
+		},
+		{
+			MethodName: "RevokeWorkerActivationToken",
+			Handler:    _WorkerService_RevokeWorkerActivationToken_Handler,
+		},
+		{
+			MethodName: "ReadWorkerLogs",
+			Handler:    _WorkerService_ReadWorkerLogs_Handler,
+		},
+		{
+			MethodName: "ListWorkerUtilization",
+			Handler:    _WorkerService_ListWorkerUtilization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/worker_service.proto",
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: controller/servers/services/v1/reverse_tunnel_service.proto
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ReverseTunnelService_RegisterTunnels_FullMethodName = "/controller.servers.services.v1.ReverseTunnelService/RegisterTunnels"
+	ReverseTunnelService_Tunnel_FullMethodName          = "/controller.servers.services.v1.ReverseTunnelService/Tunnel"
+)
+
+// ReverseTunnelServiceClient is the client API for ReverseTunnelService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReverseTunnelServiceClient interface {
+	// RegisterTunnels registers the worker as a reverse tunnel for the given
+	// targets. For as long as the stream is open, the controller sends a
+	// response for each session connection the worker should open a tunnel for.
+	RegisterTunnels(ctx context.Context, in *RegisterTunnelsRequest, opts ...grpc.CallOption) (ReverseTunnelService_RegisterTunnelsClient, error)
+	// Tunnel carries the bytes of a session connection between the controller
+	// and the worker. The first request of the stream names the connection.
+	Tunnel(ctx context.Context, opts ...grpc.CallOption) (ReverseTunnelService_TunnelClient, error)
+}
+
+type reverseTunnelServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReverseTunnelServiceClient(cc grpc.ClientConnInterface) ReverseTunnelServiceClient {
+	return &reverseTunnelServiceClient{cc}
+}
+
+func (c *reverseTunnelServiceClient) RegisterTunnels(ctx context.Context, in *RegisterTunnelsRequest, opts ...grpc.CallOption) (ReverseTunnelService_RegisterTunnelsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ReverseTunnelService_ServiceDesc.Streams[0], ReverseTunnelService_RegisterTunnels_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &reverseTunnelServiceRegisterTunnelsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ReverseTunnelService_RegisterTunnelsClient interface {
+	Recv() (*RegisterTunnelsResponse, error)
+	grpc.ClientStream
+}
+
+type reverseTunnelServiceRegisterTunnelsClient struct {
+	grpc.ClientStream
+}
+
+func (x *reverseTunnelServiceRegisterTunnelsClient) Recv() (*RegisterTunnelsResponse, error) {
+	m := new(RegisterTunnelsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *reverseTunnelServiceClient) Tunnel(ctx context.Context, opts ...grpc.CallOption) (ReverseTunnelService_TunnelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ReverseTunnelService_ServiceDesc.Streams[1], ReverseTunnelService_Tunnel_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &reverseTunnelServiceTunnelClient{stream}
+	return x, nil
+}
+
+type ReverseTunnelService_TunnelClient interface {
+	Send(*TunnelRequest) error
+	Recv() (*TunnelResponse, error)
+	grpc.ClientStream
+}
+
+type reverseTunnelServiceTunnelClient struct {
+	grpc.ClientStream
+}
+
+func (x *reverseTunnelServiceTunnelClient) Send(m *TunnelRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *reverseTunnelServiceTunnelClient) Recv() (*TunnelResponse, error) {
+	m := new(TunnelResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ReverseTunnelServiceServer is the server API for ReverseTunnelService service.
+// All implementations must embed UnimplementedReverseTunnelServiceServer
+// for forward compatibility
+type ReverseTunnelServiceServer interface {
+	// RegisterTunnels registers the worker as a reverse tunnel for the given
+	// targets. For as long as the stream is open, the controller sends a
+	// response for each session connection the worker should open a tunnel for.
+	RegisterTunnels(*RegisterTunnelsRequest, ReverseTunnelService_RegisterTunnelsServer) error
+	// Tunnel carries the bytes of a session connection between the controller
+	// and the worker. The first request of the stream names the connection.
+	Tunnel(ReverseTunnelService_TunnelServer) error
+	mustEmbedUnimplementedReverseTunnelServiceServer()
+}
+
+// UnimplementedReverseTunnelServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedReverseTunnelServiceServer struct {
+}
+
+func (UnimplementedReverseTunnelServiceServer) RegisterTunnels(*RegisterTunnelsRequest, ReverseTunnelService_RegisterTunnelsServer) error {
+	return status.Errorf(codes.Unimplemented, "method RegisterTunnels not implemented")
+}
+func (UnimplementedReverseTunnelServiceServer) Tunnel(ReverseTunnelService_TunnelServer) error {
+	return status.Errorf(codes.Unimplemented, "method Tunnel not implemented")
+}
+func (UnimplementedReverseTunnelServiceServer) mustEmbedUnimplementedReverseTunnelServiceServer() {
+}
+
+// UnsafeReverseTunnelServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReverseTunnelServiceServer will
+// result in compilation errors.
+type UnsafeReverseTunnelServiceServer interface {
+	mustEmbedUnimplementedReverseTunnelServiceServer()
+}
+
+func RegisterReverseTunnelServiceServer(s grpc.ServiceRegistrar, srv ReverseTunnelServiceServer) {
+	s.RegisterService(&ReverseTunnelService_ServiceDesc, srv)
+}
+
+func _ReverseTunnelService_RegisterTunnels_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RegisterTunnelsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReverseTunnelServiceServer).RegisterTunnels(m, &reverseTunnelServiceRegisterTunnelsServer{stream})
+}
+
+type ReverseTunnelService_RegisterTunnelsServer interface {
+	Send(*RegisterTunnelsResponse) error
+	grpc.ServerStream
+}
+
+type reverseTunnelServiceRegisterTunnelsServer struct {
+	grpc.ServerStream
+}
+
+func (x *reverseTunnelServiceRegisterTunnelsServer) Send(m *RegisterTunnelsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ReverseTunnelService_Tunnel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ReverseTunnelServiceServer).Tunnel(&reverseTunnelServiceTunnelServer{stream})
+}
+
+type ReverseTunnelService_TunnelServer interface {
+	Send(*TunnelResponse) error
+	Recv() (*TunnelRequest, error)
+	grpc.ServerStream
+}
+
+type reverseTunnelServiceTunnelServer struct {
+	grpc.ServerStream
+}
+
+func (x *reverseTunnelServiceTunnelServer) Send(m *TunnelResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *reverseTunnelServiceTunnelServer) Recv() (*TunnelRequest, error) {
+	m := new(TunnelRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ReverseTunnelService_ServiceDesc is the grpc.ServiceDesc for ReverseTunnelService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReverseTunnelService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controller.servers.services.v1.ReverseTunnelService",
+	HandlerType: (*ReverseTunnelServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RegisterTunnels",
+			Handler:       _ReverseTunnelService_RegisterTunnels_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Tunnel",
+			Handler:       _ReverseTunnelService_Tunnel_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "controller/servers/services/v1/reverse_tunnel_service.proto",
+}
@@ -22,7 +22,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	HealthService_GetHealth_FullMethodName = "/ops.services.v1.HealthService/GetHealth"
+	HealthService_GetHealth_FullMethodName    = "/ops.services.v1.HealthService/GetHealth"
+	HealthService_GetLiveness_FullMethodName  = "/ops.services.v1.HealthService/GetLiveness"
+	HealthService_GetReadiness_FullMethodName = "/ops.services.v1.HealthService/GetReadiness"
 )
 
 // HealthServiceClient is the client API for HealthService service.
@@ -30,6 +32,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type HealthServiceClient interface {
 	GetHealth(ctx context.Context, in *GetHealthRequest, opts ...grpc.CallOption) (*GetHealthResponse, error)
+	// GetLiveness reports whether the controller is running. It does not check
+	// the dependencies of the controller, so it keeps succeeding while the
+	// controller is shutting down.
+	GetLiveness(ctx context.Context, in *GetLivenessRequest, opts ...grpc.CallOption) (*GetLivenessResponse, error)
+	// GetReadiness reports whether the controller is ready to serve requests,
+	// along with the status of each of its dependencies. It replies with a 503
+	// when a dependency has failed or the controller is shutting down.
+	GetReadiness(ctx context.Context, in *GetReadinessRequest, opts ...grpc.CallOption) (*GetReadinessResponse, error)
 }
 
 type healthServiceClient struct {
@@ -49,11 +59,37 @@ func (c *healthServiceClient) GetHealth(ctx context.Context, in *GetHealthReques
 	return out, nil
 }
 
+func (c *healthServiceClient) GetLiveness(ctx context.Context, in *GetLivenessRequest, opts ...grpc.CallOption) (*GetLivenessResponse, error) {
+	out := new(GetLivenessResponse)
+	err := c.cc.Invoke(ctx, HealthService_GetLiveness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthServiceClient) GetReadiness(ctx context.Context, in *GetReadinessRequest, opts ...grpc.CallOption) (*GetReadinessResponse, error) {
+	out := new(GetReadinessResponse)
+	err := c.cc.Invoke(ctx, HealthService_GetReadiness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HealthServiceServer is the server API for HealthService service.
 // All implementations must embed UnimplementedHealthServiceServer
 // for forward compatibility
 type HealthServiceServer interface {
 	GetHealth(context.Context, *GetHealthRequest) (*GetHealthResponse, error)
+	// GetLiveness reports whether the controller is running. It does not check
+	// the dependencies of the controller, so it keeps succeeding while the
+	// controller is shutting down.
+	GetLiveness(context.Context, *GetLivenessRequest) (*GetLivenessResponse, error)
+	// GetReadiness reports whether the controller is ready to serve requests,
+	// along with the status of each of its dependencies. It replies with a 503
+	// when a dependency has failed or the controller is shutting down.
+	GetReadiness(context.Context, *GetReadinessRequest) (*GetReadinessResponse, error)
 	mustEmbedUnimplementedHealthServiceServer()
 }
 
@@ -64,6 +100,12 @@ type UnimplementedHealthServiceServer struct {
 func (UnimplementedHealthServiceServer) GetHealth(context.Context, *GetHealthRequest) (*GetHealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
 }
+func (UnimplementedHealthServiceServer) GetLiveness(context.Context, *GetLivenessRequest) (*GetLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiveness not implemented")
+}
+func (UnimplementedHealthServiceServer) GetReadiness(context.Context, *GetReadinessRequest) (*GetReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadiness not implemented")
+}
 func (UnimplementedHealthServiceServer) mustEmbedUnimplementedHealthServiceServer() {}
 
 // UnsafeHealthServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -95,6 +137,42 @@ func _HealthService_GetHealth_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HealthService_GetLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).GetLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_GetLiveness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).GetLiveness(ctx, req.(*GetLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthService_GetReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServiceServer).GetReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthService_GetReadiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServiceServer).GetReadiness(ctx, req.(*GetReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HealthService_ServiceDesc is the grpc.ServiceDesc for HealthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -106,6 +184,14 @@ var HealthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHealth",
 			Handler:    _HealthService_GetHealth_Handler,
 		},
+		{
+			MethodName: "GetLiveness",
+			Handler:    _HealthService_GetLiveness_Handler,
+		},
+		{
+			MethodName: "GetReadiness",
+			Handler:    _HealthService_GetReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ops/services/v1/health_service.proto",
@@ -21,3 +21,8 @@ type ControllerExtension interface {
 type NetIpResolver interface {
 	LookupNetIP(context.Context, string, string) ([]netip.Addr, error)
 }
+
+// ClientIdentityHeaderKey is the api listener option naming the request header
+// that carries the identity of the client certificate verified by the
+// listener. It is only valid together with tls_require_and_verify_client_cert.
+const ClientIdentityHeaderKey = "tls_client_identity_header"
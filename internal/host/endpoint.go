@@ -3,10 +3,15 @@
 
 package host
 
+import "github.com/hashicorp/boundary/internal/libs/endpoint"
+
 // Endpoint is a struct which identifies an address provided by a host and
-// selected as the priority address by the specified host set.
+// selected as the priority address by the specified host set. Addresses holds
+// all of the addresses of the host, labeled with their type, so a different
+// address can be selected later, for example by a target's preferred endpoint.
 type Endpoint struct {
-	HostId  string
-	SetId   string
-	Address string
+	HostId    string
+	SetId     string
+	Address   string
+	Addresses []endpoint.Address
 }
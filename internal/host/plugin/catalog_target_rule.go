@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/plugin/store"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// A CatalogTargetRule creates a target from a target template for each host
+// set of a plugin host catalog which matches the rule's filter.
+type CatalogTargetRule struct {
+	*store.CatalogTargetRule
+	tableName string `gorm:"-"`
+}
+
+// NewCatalogTargetRule creates a new in memory CatalogTargetRule of the
+// catalog which creates targets from the target template for the host sets
+// matching filter. No options are currently supported.
+func NewCatalogTargetRule(ctx context.Context, catalogId, targetTemplateId, filter string, _ ...Option) (*CatalogTargetRule, error) {
+	const op = "plugin.NewCatalogTargetRule"
+	r := &CatalogTargetRule{
+		CatalogTargetRule: &store.CatalogTargetRule{
+			CatalogId:        catalogId,
+			TargetTemplateId: targetTemplateId,
+			Filter:           filter,
+		},
+	}
+	if err := r.validate(ctx, op); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *CatalogTargetRule) clone() *CatalogTargetRule {
+	cp := proto.Clone(r.CatalogTargetRule)
+	return &CatalogTargetRule{
+		CatalogTargetRule: cp.(*store.CatalogTargetRule),
+	}
+}
+
+func (r *CatalogTargetRule) validate(ctx context.Context, caller errors.Op) error {
+	switch {
+	case r.CatalogId == "":
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing catalog id")
+	case r.TargetTemplateId == "":
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing target template id")
+	case r.Filter == "":
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing filter")
+	}
+	if _, err := bexpr.CreateEvaluator(r.Filter); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, "error evaluating filter expression", errors.WithWrap(err))
+	}
+	return nil
+}
+
+// VetForWrite implements db.VetForWrite() interface for catalog target rules.
+func (r *CatalogTargetRule) VetForWrite(ctx context.Context, _ db.Reader, _ db.OpType, _ ...db.Option) error {
+	const op = "plugin.(CatalogTargetRule).VetForWrite"
+	return r.validate(ctx, op)
+}
+
+// TableName returns the table name for the catalog target rule.
+func (r *CatalogTargetRule) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return "host_plugin_catalog_target_rule"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (r *CatalogTargetRule) SetTableName(n string) {
+	r.tableName = n
+}
+
+// Matches reports whether the host set matches the filter of the rule. The
+// filter is evaluated against the id, name, description and attributes of
+// the set, such as "/name" matches "web-.*" or "prod" in "/attributes/tags".
+func (r *CatalogTargetRule) Matches(ctx context.Context, s *HostSet) (bool, error) {
+	const op = "plugin.(CatalogTargetRule).Matches"
+	eval, err := bexpr.CreateEvaluator(r.GetFilter())
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	data := map[string]any{
+		"id":          s.GetPublicId(),
+		"catalog_id":  s.GetCatalogId(),
+		"name":        s.GetName(),
+		"description": s.GetDescription(),
+		"attributes":  map[string]any{},
+	}
+	if len(s.GetAttributes()) > 0 {
+		attrs := &structpb.Struct{}
+		if err := proto.Unmarshal(s.GetAttributes(), attrs); err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal host set attributes"))
+		}
+		// Round trip through json so that numbers and nested values have the
+		// types the evaluator expects.
+		b, err := json.Marshal(attrs.AsMap())
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		var m map[string]any
+		if err := json.Unmarshal(b, &m); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		data["attributes"] = m
+	}
+	match, err := eval.Evaluate(data)
+	if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return match, nil
+}
+
+// A SetGeneratedTarget records the target created from a target template by a
+// catalog target rule for a host set. The target id is empty once the target
+// has been deleted, so that a deleted target is not created again.
+type SetGeneratedTarget struct {
+	*store.SetGeneratedTarget
+	tableName string `gorm:"-"`
+}
+
+func allocSetGeneratedTarget() *SetGeneratedTarget {
+	return &SetGeneratedTarget{
+		SetGeneratedTarget: &store.SetGeneratedTarget{},
+	}
+}
+
+// TableName returns the table name for the set generated target.
+func (t *SetGeneratedTarget) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return "host_plugin_set_generated_target"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (t *SetGeneratedTarget) SetTableName(n string) {
+	t.tableName = n
+}
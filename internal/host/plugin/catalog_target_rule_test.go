@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/plugin/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestNewCatalogTargetRule(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name             string
+		catalogId        string
+		targetTemplateId string
+		filter           string
+		wantErr          errors.Code
+	}{
+		{
+			name:             "valid",
+			catalogId:        "hc_1234567890",
+			targetTemplateId: "ttpl_1234567890",
+			filter:           `"/name" matches "web-.*"`,
+		},
+		{
+			name:             "missing-catalog-id",
+			targetTemplateId: "ttpl_1234567890",
+			filter:           `"/name" matches "web-.*"`,
+			wantErr:          errors.InvalidParameter,
+		},
+		{
+			name:      "missing-target-template-id",
+			catalogId: "hc_1234567890",
+			filter:    `"/name" matches "web-.*"`,
+			wantErr:   errors.InvalidParameter,
+		},
+		{
+			name:             "missing-filter",
+			catalogId:        "hc_1234567890",
+			targetTemplateId: "ttpl_1234567890",
+			wantErr:          errors.InvalidParameter,
+		},
+		{
+			name:             "bad-filter",
+			catalogId:        "hc_1234567890",
+			targetTemplateId: "ttpl_1234567890",
+			filter:           `"/name" matches`,
+			wantErr:          errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewCatalogTargetRule(ctx, tt.catalogId, tt.targetTemplateId, tt.filter)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.catalogId, got.GetCatalogId())
+			assert.Equal(tt.targetTemplateId, got.GetTargetTemplateId())
+			assert.Equal(tt.filter, got.GetFilter())
+		})
+	}
+}
+
+func TestCatalogTargetRule_Matches(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	attrs, err := structpb.NewStruct(map[string]any{
+		"tags":  []any{"prod", "web"},
+		"ports": 443,
+	})
+	require.NoError(t, err)
+	attrBytes, err := proto.Marshal(attrs)
+	require.NoError(t, err)
+	set := &HostSet{
+		HostSet: &store.HostSet{
+			PublicId:    "hsplg_1234567890",
+			CatalogId:   "hc_1234567890",
+			Name:        "web-frontend",
+			Description: "frontend servers",
+			Attributes:  attrBytes,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter string
+		want   bool
+	}{
+		{
+			name:   "name",
+			filter: `"/name" matches "web-.*"`,
+			want:   true,
+		},
+		{
+			name:   "name-no-match",
+			filter: `"/name" matches "db-.*"`,
+		},
+		{
+			name:   "id",
+			filter: `"/id" == "hsplg_1234567890"`,
+			want:   true,
+		},
+		{
+			name:   "attribute-list",
+			filter: `"prod" in "/attributes/tags"`,
+			want:   true,
+		},
+		{
+			name:   "attribute-number",
+			filter: `"/attributes/ports" == 443`,
+			want:   true,
+		},
+		{
+			name:   "missing-attribute",
+			filter: `"/attributes/region" == "us-east-1"`,
+		},
+		{
+			name:   "combined",
+			filter: `"/name" matches "web-.*" and "staging" in "/attributes/tags"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			rule, err := NewCatalogTargetRule(ctx, set.GetCatalogId(), "ttpl_1234567890", tt.filter)
+			require.NoError(err)
+			got, err := rule.Matches(ctx, set)
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+
+	t.Run("no-attributes", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		rule, err := NewCatalogTargetRule(ctx, "hc_1234567890", "ttpl_1234567890", `"prod" in "/attributes/tags"`)
+		require.NoError(err)
+		got, err := rule.Matches(ctx, &HostSet{HostSet: &store.HostSet{Name: "web-frontend"}})
+		require.NoError(err)
+		assert.False(got)
+	})
+}
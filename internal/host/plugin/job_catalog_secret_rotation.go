@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	catalogSecretRotationJobName        = "plugin_host_catalog_secret_rotation"
+	catalogSecretRotationJobRunInterval = 10 * time.Minute
+)
+
+// CatalogSecretRotationJob is the recurring job that rotates the secrets of
+// plugin host catalogs with an automatic rotation schedule. The persisted
+// secrets of each catalog are sent back to its plugin as new secrets, which
+// lets plugins that mint their own credentials replace them.
+// The CatalogSecretRotationJob is not thread safe,
+// an attempt to Run the job concurrently will result in an JobAlreadyRunning error.
+type CatalogSecretRotationJob struct {
+	reader    db.Reader
+	writer    db.Writer
+	kms       *kms.Kms
+	scheduler *scheduler.Scheduler
+	plugins   map[string]plgpb.HostPluginServiceClient
+	limit     int
+
+	running      ua.Bool
+	numCatalogs  int
+	numProcessed int
+}
+
+// newCatalogSecretRotationJob creates a new in-memory CatalogSecretRotationJob.
+//
+// WithLimit is the only supported option.
+func newCatalogSecretRotationJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, sched *scheduler.Scheduler, plgm map[string]plgpb.HostPluginServiceClient, opt ...Option) (*CatalogSecretRotationJob, error) {
+	const op = "plugin.newCatalogSecretRotationJob"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	case sched == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scheduler")
+	case plgm == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing plugin manager")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &CatalogSecretRotationJob{
+		reader:    r,
+		writer:    w,
+		kms:       kms,
+		scheduler: sched,
+		plugins:   plgm,
+		limit:     opts.withLimit,
+	}, nil
+}
+
+// Status returns the current status of the secret rotation job. Total is the
+// number of catalogs due for rotation. Completed is the number of catalogs
+// already processed.
+func (r *CatalogSecretRotationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numCatalogs,
+	}
+}
+
+// Run queries the plugin host repo for catalogs whose secrets are due for
+// rotation and rotates them. A catalog whose plugin rejects the rotation keeps
+// its current secrets and is retried on a later run. Can not be run in
+// parallel, if Run is invoked while already running an error with code
+// JobAlreadyRunning will be returned.
+func (r *CatalogSecretRotationJob) Run(ctx context.Context, _ time.Duration) error {
+	const op = "plugin.(CatalogSecretRotationJob).Run"
+	if !r.running.CompareAndSwap(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	catalogIds, err := r.dueCatalogs(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// Set numProcessed and numCatalogs for status report
+	r.numProcessed, r.numCatalogs = 0, len(catalogIds)
+	if len(catalogIds) == 0 {
+		// Nothing to do, return early
+		return nil
+	}
+
+	repo, err := NewRepository(ctx, r.reader, r.writer, r.kms, r.scheduler, r.plugins)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, id := range catalogIds {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := rotateCatalogSecrets(ctx, repo, id); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to rotate host catalog secrets", "catalog id", id))
+		}
+		r.numProcessed++
+	}
+	return nil
+}
+
+// NextRunIn returns the time until the secrets of the next catalog are due
+// for rotation, or the default run frequency if no catalog is scheduled.
+func (r *CatalogSecretRotationJob) NextRunIn(ctx context.Context) (time.Duration, error) {
+	const op = "plugin.(CatalogSecretRotationJob).NextRunIn"
+	rows, err := r.reader.Query(ctx, catalogSecretRotationNextRunInQuery, nil)
+	if err != nil {
+		return catalogSecretRotationJobRunInterval, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var rotateIn sql.NullInt32
+	for rows.Next() {
+		if err := rows.Scan(&rotateIn); err != nil {
+			return catalogSecretRotationJobRunInterval, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return catalogSecretRotationJobRunInterval, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case !rotateIn.Valid:
+		return catalogSecretRotationJobRunInterval, nil
+	case rotateIn.Int32 <= 0:
+		return 0, nil
+	}
+	next := time.Duration(rotateIn.Int32) * time.Second
+	if next > catalogSecretRotationJobRunInterval {
+		// Check back at the default cadence so that newly scheduled catalogs
+		// are picked up.
+		next = catalogSecretRotationJobRunInterval
+	}
+	return next, nil
+}
+
+// Name is the unique name of the job.
+func (r *CatalogSecretRotationJob) Name() string {
+	return catalogSecretRotationJobName
+}
+
+// Description is the human readable description of the job.
+func (r *CatalogSecretRotationJob) Description() string {
+	return "Periodically rotates the secrets of plugin based host catalogs with an automatic rotation schedule."
+}
+
+// dueCatalogs returns the ids of the catalogs whose secrets are due for
+// rotation, least recently rotated first.
+func (r *CatalogSecretRotationJob) dueCatalogs(ctx context.Context) ([]string, error) {
+	const op = "plugin.(CatalogSecretRotationJob).dueCatalogs"
+	rows, err := r.reader.Query(ctx, catalogSecretRotationDueQuery, []any{sql.Named("limit", r.limit)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
+
+// rotateCatalogSecrets rotates the persisted secrets of the catalog with the
+// provided id at its current version.
+func rotateCatalogSecrets(ctx context.Context, repo *Repository, id string) error {
+	const op = "plugin.rotateCatalogSecrets"
+	c, _, err := repo.LookupCatalog(ctx, id)
+	switch {
+	case err != nil:
+		return errors.Wrap(ctx, err, op)
+	case c == nil:
+		// The catalog was deleted since it was found due, its schedule is
+		// deleted along with it.
+		return nil
+	}
+	if _, _, _, err := repo.RotateCatalogSecrets(ctx, id, c.GetVersion(), nil); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/plugin/loopback"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestCatalogSecretRotationJob_Run(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	plg := plugin.TestPlugin(t, conn, "test")
+	plgm := map[string]plgpb.HostPluginServiceClient{
+		plg.GetPublicId(): loopback.NewWrappingPluginHostClient(testRotatingPluginServer()),
+	}
+	assert, require := assert.New(t), require.New(t)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched, plgm)
+	require.NoError(err)
+	secrets, err := structpb.NewStruct(map[string]any{"key": "first"})
+	require.NoError(err)
+	createCatalog := func() *HostCatalog {
+		hc, err := NewHostCatalog(ctx, prj.GetPublicId(), plg.GetPublicId(), WithSecrets(secrets))
+		require.NoError(err)
+		hc, _, err = repo.CreateCatalog(ctx, hc)
+		require.NoError(err)
+		return hc
+	}
+	due, notDue, unscheduled := createCatalog(), createCatalog(), createCatalog()
+	require.NoError(repo.SetCatalogSecretRotation(ctx, due.GetPublicId(), time.Hour))
+	require.NoError(repo.SetCatalogSecretRotation(ctx, notDue.GetPublicId(), time.Hour))
+	_, err = rw.Exec(ctx, "update host_plugin_catalog_secret_rotation set last_rotation_time = now() - interval '2 hours' where catalog_id = ?", []any{due.GetPublicId()})
+	require.NoError(err)
+
+	job, err := newCatalogSecretRotationJob(ctx, rw, rw, kmsCache, sched, plgm)
+	require.NoError(err)
+
+	next, err := job.NextRunIn(ctx)
+	require.NoError(err)
+	assert.Zero(next)
+
+	require.NoError(job.Run(ctx, 0))
+	assert.Equal(1, job.Status().Total)
+	assert.Equal(1, job.Status().Completed)
+
+	persistedKey := func(id string) string {
+		_, p, err := repo.getCatalog(ctx, id)
+		require.NoError(err)
+		return p.GetSecrets().GetFields()["key"].GetStringValue()
+	}
+	assert.Equal("first-minted", persistedKey(due.GetPublicId()))
+	assert.Equal("first", persistedKey(notDue.GetPublicId()))
+	assert.Equal("first", persistedKey(unscheduled.GetPublicId()))
+
+	// Nothing is due until the rotated catalogs' next interval.
+	next, err = job.NextRunIn(ctx)
+	require.NoError(err)
+	assert.Equal(catalogSecretRotationJobRunInterval, next)
+	require.NoError(job.Run(ctx, 0))
+	assert.Equal(0, job.Status().Total)
+	assert.Equal("first-minted", persistedKey(due.GetPublicId()))
+}
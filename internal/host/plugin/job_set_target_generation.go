@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	setTargetGenerationJobName        = "plugin_host_set_target_generation"
+	setTargetGenerationJobRunInterval = 5 * time.Minute
+)
+
+// A TargetCreator creates and updates the targets of catalog target rules.
+// The parameters passed to the target template are set_id, set_name and
+// catalog_id.
+type TargetCreator interface {
+	// CreateTarget creates a target for the host set from the target
+	// template and returns the id of the target.
+	CreateTarget(ctx context.Context, templateId, setId string, parameters map[string]string) (string, error)
+
+	// UpdateTarget updates the target created for the host set from the
+	// target template to match the current parameters.
+	UpdateTarget(ctx context.Context, targetId, templateId, setId string, parameters map[string]string) error
+}
+
+// SetTargetGenerationJob is the recurring job that creates and updates the
+// targets of the host sets matching the target rules of their catalogs.
+// The SetTargetGenerationJob is not thread safe,
+// an attempt to Run the job concurrently will result in an JobAlreadyRunning error.
+type SetTargetGenerationJob struct {
+	reader  db.Reader
+	writer  db.Writer
+	creator TargetCreator
+
+	running      ua.Bool
+	numSets      int
+	numProcessed int
+}
+
+// newSetTargetGenerationJob creates a new in-memory SetTargetGenerationJob.
+func newSetTargetGenerationJob(ctx context.Context, r db.Reader, w db.Writer, creator TargetCreator, _ ...Option) (*SetTargetGenerationJob, error) {
+	const op = "plugin.newSetTargetGenerationJob"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case creator == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target creator")
+	}
+
+	return &SetTargetGenerationJob{
+		reader:  r,
+		writer:  w,
+		creator: creator,
+	}, nil
+}
+
+// Status returns the current status of the set target generation job. Total
+// is the total number of sets in catalogs with target rules. Completed is the
+// number of sets already processed.
+func (r *SetTargetGenerationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numSets,
+	}
+}
+
+// Run matches the host sets of the plugin host catalogs with target rules
+// against the rules, creating a target for every match which does not have
+// one yet and updating the targets which were already created. A target
+// which was deleted is not created again. Can not be run in parallel, if
+// Run is invoked while already running an error with code JobAlreadyRunning
+// will be returned.
+func (r *SetTargetGenerationJob) Run(ctx context.Context, _ time.Duration) error {
+	const op = "plugin.(SetTargetGenerationJob).Run"
+	if !r.running.CompareAndSwap(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	var rules []*CatalogTargetRule
+	if err := r.reader.SearchWhere(ctx, &rules, "true", nil, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list target rules"))
+	}
+	r.numSets, r.numProcessed = 0, 0
+	if len(rules) == 0 {
+		return nil
+	}
+	catalogRules := make(map[string][]*CatalogTargetRule)
+	for _, rule := range rules {
+		catalogRules[rule.GetCatalogId()] = append(catalogRules[rule.GetCatalogId()], rule)
+	}
+
+	var sets []*HostSet
+	if err := r.reader.SearchWhere(ctx, &sets, "catalog_id in (select catalog_id from host_plugin_catalog_target_rule)", nil, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list host sets"))
+	}
+	var generated []*SetGeneratedTarget
+	if err := r.reader.SearchWhere(ctx, &generated, "true", nil, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list generated targets"))
+	}
+	generatedTargets := make(map[string]*SetGeneratedTarget, len(generated))
+	for _, g := range generated {
+		generatedTargets[g.GetSetId()+"/"+g.GetTargetTemplateId()] = g
+	}
+
+	r.numSets = len(sets)
+	for _, s := range sets {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		parameters := map[string]string{
+			"set_id":     s.GetPublicId(),
+			"set_name":   s.GetName(),
+			"catalog_id": s.GetCatalogId(),
+		}
+		for _, rule := range catalogRules[s.GetCatalogId()] {
+			match, err := rule.Matches(ctx, s)
+			if err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("unable to match host set against target rule", "set_id", s.GetPublicId(), "target_template_id", rule.GetTargetTemplateId()))
+				continue
+			}
+			if !match {
+				continue
+			}
+			g, ok := generatedTargets[s.GetPublicId()+"/"+rule.GetTargetTemplateId()]
+			switch {
+			case !ok:
+				if err := r.createTarget(ctx, rule.GetTargetTemplateId(), s.GetPublicId(), parameters); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("unable to create target for host set", "set_id", s.GetPublicId(), "target_template_id", rule.GetTargetTemplateId()))
+				}
+			case g.GetTargetId() == "":
+				// The target was deleted after it was created, which is
+				// respected.
+			default:
+				if err := r.creator.UpdateTarget(ctx, g.GetTargetId(), rule.GetTargetTemplateId(), s.GetPublicId(), parameters); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("unable to update target for host set", "set_id", s.GetPublicId(), "target_id", g.GetTargetId()))
+				}
+			}
+		}
+		r.numProcessed++
+	}
+	return nil
+}
+
+// createTarget creates the target of the host set from the target template
+// and records it, so that it is only created once.
+func (r *SetTargetGenerationJob) createTarget(ctx context.Context, templateId, setId string, parameters map[string]string) error {
+	const op = "plugin.(SetTargetGenerationJob).createTarget"
+	targetId, err := r.creator.CreateTarget(ctx, templateId, setId, parameters)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	g := allocSetGeneratedTarget()
+	g.SetId = setId
+	g.TargetTemplateId = templateId
+	g.TargetId = targetId
+	if err := r.writer.Create(ctx, g); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to record target %q", targetId)))
+	}
+	return nil
+}
+
+// NextRunIn returns the default run frequency of the set target generation job.
+func (r *SetTargetGenerationJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return setTargetGenerationJobRunInterval, nil
+}
+
+// Name is the unique name of the job.
+func (r *SetTargetGenerationJob) Name() string {
+	return setTargetGenerationJobName
+}
+
+// Description is the human readable description of the job.
+func (r *SetTargetGenerationJob) Description() string {
+	return "Periodically creates and updates the targets of plugin based host sets matching the target rules of their catalogs."
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/plugin/loopback"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/targettemplate"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testTargetCreator creates tcp targets named after the set_name parameter
+// and records the targets it updates.
+type testTargetCreator struct {
+	t         testing.TB
+	conn      *db.DB
+	projectId string
+	created   map[string]string
+	updated   map[string]string
+}
+
+func (c *testTargetCreator) CreateTarget(ctx context.Context, templateId, setId string, parameters map[string]string) (string, error) {
+	tar := tcp.TestTarget(ctx, c.t, c.conn, c.projectId, parameters["set_name"])
+	c.created[setId] = tar.GetPublicId()
+	return tar.GetPublicId(), nil
+}
+
+func (c *testTargetCreator) UpdateTarget(_ context.Context, targetId, _, setId string, _ map[string]string) error {
+	c.updated[setId] = targetId
+	return nil
+}
+
+func TestNewSetTargetGenerationJob(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	creator := &testTargetCreator{}
+
+	tests := []struct {
+		name        string
+		r           db.Reader
+		w           db.Writer
+		creator     TargetCreator
+		wantErrCode errors.Code
+	}{
+		{
+			name:        "nil reader",
+			w:           rw,
+			creator:     creator,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "nil writer",
+			r:           rw,
+			creator:     creator,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "nil creator",
+			r:           rw,
+			w:           rw,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:    "valid",
+			r:       rw,
+			w:       rw,
+			creator: creator,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := newSetTargetGenerationJob(ctx, tt.r, tt.w, tt.creator)
+			if tt.wantErrCode != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "Unexpected error %s", err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal(setTargetGenerationJobName, got.Name())
+			interval, err := got.NextRunIn(ctx)
+			require.NoError(err)
+			assert.Equal(setTargetGenerationJobRunInterval, interval)
+		})
+	}
+}
+
+func TestSetTargetGenerationJob_Run(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	plg := plugin.TestPlugin(t, conn, "test")
+	plgm := map[string]plgpb.HostPluginServiceClient{
+		plg.GetPublicId(): &loopback.WrappingPluginHostClient{Server: &loopback.TestPluginServer{}},
+	}
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched, plgm)
+	require.NoError(t, err)
+
+	cat := TestCatalog(t, conn, prj.PublicId, plg.GetPublicId())
+	web := TestSet(t, conn, kmsCache, sched, cat, plgm, WithName("web-frontend"))
+	dbSet := TestSet(t, conn, kmsCache, sched, cat, plgm, WithName("db-primary"))
+	tmpl := targettemplate.TestTargetTemplate(t, rw, prj.PublicId, `{"type":"tcp","name":"{{.set_name}}"}`)
+
+	rule, err := NewCatalogTargetRule(ctx, cat.GetPublicId(), tmpl.GetPublicId(), `"/name" matches "web-.*"`)
+	require.NoError(t, err)
+	_, n, err := repo.SetCatalogTargetRules(ctx, cat.GetPublicId(), cat.GetVersion(), []*CatalogTargetRule{rule})
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	creator := &testTargetCreator{
+		t:         t,
+		conn:      conn,
+		projectId: prj.PublicId,
+		created:   map[string]string{},
+		updated:   map[string]string{},
+	}
+	job, err := newSetTargetGenerationJob(ctx, rw, rw, creator)
+	require.NoError(t, err)
+
+	// The first run creates a target for the matching set only.
+	require.NoError(t, job.Run(ctx, 0))
+	assert.Contains(t, creator.created, web.GetPublicId())
+	assert.NotContains(t, creator.created, dbSet.GetPublicId())
+	assert.Empty(t, creator.updated)
+	assert.Equal(t, 2, job.Status().Total)
+	assert.Equal(t, 2, job.Status().Completed)
+
+	var generated []*SetGeneratedTarget
+	require.NoError(t, rw.SearchWhere(ctx, &generated, "set_id = ?", []any{web.GetPublicId()}))
+	require.Len(t, generated, 1)
+	assert.Equal(t, creator.created[web.GetPublicId()], generated[0].GetTargetId())
+
+	// The second run updates the target instead of creating another one.
+	delete(creator.created, web.GetPublicId())
+	require.NoError(t, job.Run(ctx, 0))
+	assert.Empty(t, creator.created)
+	assert.Equal(t, generated[0].GetTargetId(), creator.updated[web.GetPublicId()])
+}
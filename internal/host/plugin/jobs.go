@@ -14,8 +14,11 @@ import (
 )
 
 // RegisterJobs registers plugin host related jobs with the provided scheduler.
-func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms, plgm map[string]plgpb.HostPluginServiceClient) error {
+// The set target generation job is only registered if WithTargetCreator is
+// provided.
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms, plgm map[string]plgpb.HostPluginServiceClient, opt ...Option) error {
 	const op = "plugin.RegisterJobs"
+	opts := getOpts(opt...)
 	setSyncJob, err := newSetSyncJob(ctx, r, w, kms, plgm)
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
@@ -30,6 +33,22 @@ func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Read
 	if err = scheduler.RegisterJob(ctx, orphanedHostCleanupJob); err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithMsg("orphaned host cleanup job"))
 	}
+	catalogSecretRotationJob, err := newCatalogSecretRotationJob(ctx, r, w, kms, scheduler, plgm)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, catalogSecretRotationJob); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("catalog secret rotation job"))
+	}
+	if opts.withTargetCreator != nil {
+		setTargetGenerationJob, err := newSetTargetGenerationJob(ctx, r, w, opts.withTargetCreator)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err = scheduler.RegisterJob(ctx, setTargetGenerationJob); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("set target generation job"))
+		}
+	}
 
 	return nil
 }
@@ -38,6 +38,7 @@ type options struct {
 	withSecretsHmac         []byte
 	withStartPageAfterItem  pagination.Item
 	withWorkerFilter        string
+	withTargetCreator       TargetCreator
 }
 
 func getDefaultOptions() options {
@@ -162,3 +163,11 @@ func WithWorkerFilter(wf string) Option {
 		o.withWorkerFilter = wf
 	}
 }
+
+// WithTargetCreator provides the TargetCreator used to create the targets of
+// catalog target rules.
+func WithTargetCreator(tc TargetCreator) Option {
+	return func(o *options) {
+		o.withTargetCreator = tc
+	}
+}
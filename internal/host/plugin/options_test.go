@@ -113,4 +113,11 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withWorkerFilter = `"test" in "/tags/type"`
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithTargetCreator", func(t *testing.T) {
+		tc := &testTargetCreator{}
+		opts := getOpts(WithTargetCreator(tc))
+		testOpts := getDefaultOptions()
+		testOpts.withTargetCreator = tc
+		assert.Equal(t, opts, testOpts)
+	})
 }
@@ -20,6 +20,68 @@ delete from host_plugin_catalog_secret
  where catalog_id = @catalog_id;
 `
 
+	upsertCatalogSecretRotationQuery = `
+insert into host_plugin_catalog_secret_rotation
+  (catalog_id, rotation_interval_seconds)
+values
+  (@catalog_id, @rotation_interval_seconds)
+on conflict (catalog_id) do update
+  set rotation_interval_seconds = excluded.rotation_interval_seconds;
+`
+
+	deleteCatalogSecretRotationQuery = `
+delete from host_plugin_catalog_secret_rotation
+ where catalog_id = @catalog_id;
+`
+
+	lookupCatalogSecretRotationQuery = `
+select rotation_interval_seconds
+  from host_plugin_catalog_secret_rotation
+ where catalog_id = @catalog_id;
+`
+
+	updateCatalogSecretRotationTimeQuery = `
+update host_plugin_catalog_secret_rotation
+   set last_rotation_time = current_timestamp
+ where catalog_id = @catalog_id;
+`
+
+	catalogSecretRotationDueQuery = `
+select catalog_id
+  from host_plugin_catalog_secret_rotation
+ where wt_add_seconds(rotation_interval_seconds, last_rotation_time) <= current_timestamp
+order by last_rotation_time
+ limit @limit;
+`
+
+	catalogSecretRotationNextRunInQuery = `
+select extract(epoch from
+         min(wt_add_seconds(rotation_interval_seconds, last_rotation_time)) - current_timestamp
+       )::int as rotate_in
+  from host_plugin_catalog_secret_rotation;
+`
+
+	bumpCatalogVersionQuery = `
+update host_plugin_catalog
+   set version = version + 1
+ where public_id = @catalog_id
+   and version = @version;
+`
+
+	deleteCatalogTargetRulesQuery = `
+delete from host_plugin_catalog_target_rule
+ where catalog_id = @catalog_id;
+`
+
+	targetTemplateInCatalogProjectQuery = `
+select tt.public_id
+  from target_template tt
+  join host_plugin_catalog hc
+    on hc.project_id = tt.project_id
+ where hc.public_id = @catalog_id
+   and tt.public_id = any(@target_template_ids);
+`
+
 	setSyncNextRunInQuery = `
 select
   need_sync as sync_now,
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// SetCatalogTargetRules replaces the target rules of the host catalog with
+// the provided id with rules. The target templates of the rules must be in
+// the project of the catalog, and each template can only be used by one rule
+// of the catalog. An empty rules removes all the rules of the catalog.
+//
+// The version of the catalog is incremented. Returns the rules of the
+// catalog and the number of catalogs updated, which is 0 if the catalog does
+// not exist or version does not match. All options are ignored.
+func (r *Repository) SetCatalogTargetRules(ctx context.Context, catalogId string, version uint32, rules []*CatalogTargetRule, _ ...Option) ([]*CatalogTargetRule, int, error) {
+	const op = "plugin.(Repository).SetCatalogTargetRules"
+	switch {
+	case catalogId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no catalog id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no version")
+	}
+
+	newRules := make([]*CatalogTargetRule, 0, len(rules))
+	templateIds := make([]string, 0, len(rules))
+	seen := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		if rule == nil || rule.CatalogTargetRule == nil {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil rule")
+		}
+		if rule.CatalogId != "" && rule.CatalogId != catalogId {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("rule of catalog %q", rule.CatalogId))
+		}
+		nr := rule.clone()
+		nr.CatalogId = catalogId
+		if err := nr.validate(ctx, op); err != nil {
+			return nil, db.NoRowsAffected, err
+		}
+		if seen[nr.TargetTemplateId] {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("target template %q used by more than one rule", nr.TargetTemplateId))
+		}
+		seen[nr.TargetTemplateId] = true
+		newRules = append(newRules, nr)
+		templateIds = append(templateIds, nr.TargetTemplateId)
+	}
+
+	if len(templateIds) > 0 {
+		found, err := r.templatesInCatalogProject(ctx, catalogId, templateIds)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		var missing []string
+		for _, id := range templateIds {
+			if !found[id] {
+				missing = append(missing, id)
+			}
+		}
+		if len(missing) > 0 {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op,
+				fmt.Sprintf("target templates not found in the project of catalog %q: %s", catalogId, strings.Join(missing, ", ")))
+		}
+	}
+
+	var rowsUpdated int
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsUpdated, err = w.Exec(ctx, bumpCatalogVersionQuery, []any{
+				sql.Named("catalog_id", catalogId),
+				sql.Named("version", version),
+			})
+			switch {
+			case err != nil:
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update catalog version"))
+			case rowsUpdated == 0:
+				return nil
+			case rowsUpdated > 1:
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			if _, err := w.Exec(ctx, deleteCatalogTargetRulesQuery, []any{sql.Named("catalog_id", catalogId)}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target rules"))
+			}
+			if len(newRules) > 0 {
+				if err := w.CreateItems(ctx, newRules); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target rules"))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if rowsUpdated == 0 {
+		return nil, db.NoRowsAffected, nil
+	}
+	return newRules, rowsUpdated, nil
+}
+
+// ListCatalogTargetRules returns the target rules of the host catalog with
+// the provided id. All options are ignored.
+func (r *Repository) ListCatalogTargetRules(ctx context.Context, catalogId string, _ ...Option) ([]*CatalogTargetRule, error) {
+	const op = "plugin.(Repository).ListCatalogTargetRules"
+	if catalogId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no catalog id")
+	}
+	var rules []*CatalogTargetRule
+	if err := r.reader.SearchWhere(ctx, &rules, "catalog_id = ?", []any{catalogId}, db.WithOrder("create_time asc, target_template_id asc")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return rules, nil
+}
+
+// templatesInCatalogProject returns the ids of the target templates in ids
+// which are in the project of the catalog.
+func (r *Repository) templatesInCatalogProject(ctx context.Context, catalogId string, ids []string) (map[string]bool, error) {
+	const op = "plugin.(Repository).templatesInCatalogProject"
+	rows, err := r.reader.Query(ctx, targetTemplateInCatalogProjectQuery, []any{
+		sql.Named("catalog_id", catalogId),
+		sql.Named("target_template_ids", ids),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	found := make(map[string]bool, len(ids))
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		found[id] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return found, nil
+}
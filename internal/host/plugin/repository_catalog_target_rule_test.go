@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/plugin/loopback"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/targettemplate"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_SetCatalogTargetRules(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	_, otherPrj := iam.TestScopes(t, iamRepo)
+	plg := plugin.TestPlugin(t, conn, "test")
+	plgm := map[string]plgpb.HostPluginServiceClient{
+		plg.GetPublicId(): &loopback.WrappingPluginHostClient{Server: &loopback.TestPluginServer{}},
+	}
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched, plgm)
+	require.NoError(t, err)
+
+	const definition = `{"type":"tcp","name":"{{.set_name}}"}`
+	web := targettemplate.TestTargetTemplate(t, rw, prj.PublicId, definition, targettemplate.WithName("web"))
+	db1 := targettemplate.TestTargetTemplate(t, rw, prj.PublicId, definition, targettemplate.WithName("db"))
+	other := targettemplate.TestTargetTemplate(t, rw, otherPrj.PublicId, definition)
+
+	newRule := func(templateId, filter string) *CatalogTargetRule {
+		r, err := NewCatalogTargetRule(ctx, "hc_placeholder", templateId, filter)
+		require.NoError(t, err)
+		r.CatalogId = ""
+		return r
+	}
+
+	t.Run("set-list-and-clear", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cat := TestCatalog(t, conn, prj.PublicId, plg.GetPublicId())
+
+		got, n, err := repo.SetCatalogTargetRules(ctx, cat.GetPublicId(), cat.GetVersion(), []*CatalogTargetRule{
+			newRule(web.GetPublicId(), `"/name" matches "web-.*"`),
+			newRule(db1.GetPublicId(), `"/name" matches "db-.*"`),
+		})
+		require.NoError(err)
+		assert.Equal(1, n)
+		assert.Len(got, 2)
+
+		rules, err := repo.ListCatalogTargetRules(ctx, cat.GetPublicId())
+		require.NoError(err)
+		assert.Len(rules, 2)
+		for _, r := range rules {
+			assert.Equal(cat.GetPublicId(), r.GetCatalogId())
+		}
+
+		updated, _, err := repo.LookupCatalog(ctx, cat.GetPublicId())
+		require.NoError(err)
+		assert.Equal(cat.GetVersion()+1, updated.GetVersion())
+
+		got, n, err = repo.SetCatalogTargetRules(ctx, cat.GetPublicId(), updated.GetVersion(), []*CatalogTargetRule{
+			newRule(web.GetPublicId(), `"/name" matches "www-.*"`),
+		})
+		require.NoError(err)
+		assert.Equal(1, n)
+		require.Len(got, 1)
+		rules, err = repo.ListCatalogTargetRules(ctx, cat.GetPublicId())
+		require.NoError(err)
+		require.Len(rules, 1)
+		assert.Equal(`"/name" matches "www-.*"`, rules[0].GetFilter())
+
+		_, n, err = repo.SetCatalogTargetRules(ctx, cat.GetPublicId(), updated.GetVersion()+1, nil)
+		require.NoError(err)
+		assert.Equal(1, n)
+		rules, err = repo.ListCatalogTargetRules(ctx, cat.GetPublicId())
+		require.NoError(err)
+		assert.Empty(rules)
+	})
+
+	t.Run("bad-version", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cat := TestCatalog(t, conn, prj.PublicId, plg.GetPublicId())
+		got, n, err := repo.SetCatalogTargetRules(ctx, cat.GetPublicId(), cat.GetVersion()+5, []*CatalogTargetRule{
+			newRule(web.GetPublicId(), `"/name" matches "web-.*"`),
+		})
+		require.NoError(err)
+		assert.Equal(0, n)
+		assert.Nil(got)
+		rules, err := repo.ListCatalogTargetRules(ctx, cat.GetPublicId())
+		require.NoError(err)
+		assert.Empty(rules)
+	})
+
+	t.Run("template-in-other-project", func(t *testing.T) {
+		assert := assert.New(t)
+		cat := TestCatalog(t, conn, prj.PublicId, plg.GetPublicId())
+		_, _, err := repo.SetCatalogTargetRules(ctx, cat.GetPublicId(), cat.GetVersion(), []*CatalogTargetRule{
+			newRule(other.GetPublicId(), `"/name" matches "web-.*"`),
+		})
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("duplicate-template", func(t *testing.T) {
+		assert := assert.New(t)
+		cat := TestCatalog(t, conn, prj.PublicId, plg.GetPublicId())
+		_, _, err := repo.SetCatalogTargetRules(ctx, cat.GetPublicId(), cat.GetVersion(), []*CatalogTargetRule{
+			newRule(web.GetPublicId(), `"/name" matches "web-.*"`),
+			newRule(web.GetPublicId(), `"/name" matches "www-.*"`),
+		})
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+
+	t.Run("missing-params", func(t *testing.T) {
+		assert := assert.New(t)
+		_, _, err := repo.SetCatalogTargetRules(ctx, "", 1, nil)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, _, err = repo.SetCatalogTargetRules(ctx, "hc_1234567890", 0, nil)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+		_, err = repo.ListCatalogTargetRules(ctx, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	plg "github.com/hashicorp/boundary/internal/plugin"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// RotateCatalogSecrets replaces the secrets of the host catalog with the
+// provided id. The new secrets are sent to the catalog's plugin through
+// OnUpdateCatalog, in the same way as when updating the catalog's secrets, and
+// are only persisted if the plugin accepts them. If secrets is nil the
+// currently persisted secrets are sent instead, which allows plugins that mint
+// their own credentials to rotate them.
+//
+// Returns the updated HostCatalog, its plugin and the number of records
+// updated. The automatic rotation schedule of the catalog, if any, restarts
+// from the time of the rotation. All options are ignored.
+func (r *Repository) RotateCatalogSecrets(ctx context.Context, id string, version uint32, secrets *structpb.Struct, _ ...Option) (*HostCatalog, *plg.Plugin, int, error) {
+	const op = "plugin.(Repository).RotateCatalogSecrets"
+	if id == "" {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	if version == 0 {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no version")
+	}
+
+	c, persisted, err := r.getCatalog(ctx, id)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if secrets == nil {
+		secrets = persisted.GetSecrets()
+	}
+	if len(secrets.GetFields()) == 0 {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("no secrets to rotate for catalog %q", id))
+	}
+
+	c.Secrets = secrets
+	hc, p, numUpdated, err := r.UpdateCatalog(ctx, c, version, []string{"secrets"})
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if _, err := r.writer.Exec(ctx, updateCatalogSecretRotationTimeQuery, []any{sql.Named("catalog_id", id)}); err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update last rotation time"))
+	}
+	return hc, p, numUpdated, nil
+}
+
+// SetCatalogSecretRotation sets how often the secrets of the host catalog with
+// the provided id are rotated automatically. An interval of 0 disables
+// automatic rotation. The interval is truncated to seconds and the first
+// automatic rotation happens one interval after it is set. All options are
+// ignored.
+func (r *Repository) SetCatalogSecretRotation(ctx context.Context, id string, interval time.Duration, _ ...Option) error {
+	const op = "plugin.(Repository).SetCatalogSecretRotation"
+	switch {
+	case id == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	case interval < 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "negative rotation interval")
+	case interval.Seconds() > math.MaxInt32:
+		return errors.New(ctx, errors.InvalidParameter, op, "rotation interval too large")
+	}
+
+	query, args := deleteCatalogSecretRotationQuery, []any{sql.Named("catalog_id", id)}
+	if seconds := int32(interval / time.Second); seconds > 0 {
+		query = upsertCatalogSecretRotationQuery
+		args = append(args, sql.Named("rotation_interval_seconds", seconds))
+	}
+	if _, err := r.writer.Exec(ctx, query, args); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// LookupCatalogSecretRotation returns how often the secrets of the host
+// catalog with the provided id are rotated automatically, or 0 if they are not.
+// All options are ignored.
+func (r *Repository) LookupCatalogSecretRotation(ctx context.Context, id string, _ ...Option) (time.Duration, error) {
+	const op = "plugin.(Repository).LookupCatalogSecretRotation"
+	if id == "" {
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	rows, err := r.reader.Query(ctx, lookupCatalogSecretRotationQuery, []any{sql.Named("catalog_id", id)})
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var seconds int32
+	for rows.Next() {
+		if err := rows.Scan(&seconds); err != nil {
+			return 0, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/plugin/loopback"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// testRotatingPluginServer persists the secrets it is given, rejects secrets
+// containing a "reject" key and mints a new "key" when it is handed back the
+// secrets it persisted.
+func testRotatingPluginServer() *loopback.TestPluginHostServer {
+	return &loopback.TestPluginHostServer{
+		OnCreateCatalogFn: func(_ context.Context, req *plgpb.OnCreateCatalogRequest) (*plgpb.OnCreateCatalogResponse, error) {
+			return &plgpb.OnCreateCatalogResponse{Persisted: &plgpb.HostCatalogPersisted{Secrets: req.GetCatalog().GetSecrets()}}, nil
+		},
+		OnUpdateCatalogFn: func(_ context.Context, req *plgpb.OnUpdateCatalogRequest) (*plgpb.OnUpdateCatalogResponse, error) {
+			secrets := req.GetNewCatalog().GetSecrets()
+			if _, ok := secrets.GetFields()["reject"]; ok {
+				return nil, fmt.Errorf("invalid credentials")
+			}
+			if proto.Equal(secrets, req.GetPersisted().GetSecrets()) {
+				secrets = proto.Clone(secrets).(*structpb.Struct)
+				secrets.GetFields()["key"] = structpb.NewStringValue(secrets.GetFields()["key"].GetStringValue() + "-minted")
+			}
+			return &plgpb.OnUpdateCatalogResponse{Persisted: &plgpb.HostCatalogPersisted{Secrets: secrets}}, nil
+		},
+	}
+}
+
+func TestRepository_RotateCatalogSecrets(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	plg := plugin.TestPlugin(t, conn, "test")
+	plgm := map[string]plgpb.HostPluginServiceClient{
+		plg.GetPublicId(): loopback.NewWrappingPluginHostClient(testRotatingPluginServer()),
+	}
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched, plgm)
+	require.NoError(t, err)
+
+	secrets := func(t *testing.T, key string) *structpb.Struct {
+		s, err := structpb.NewStruct(map[string]any{"key": key})
+		require.NoError(t, err)
+		return s
+	}
+	createCatalog := func(t *testing.T) *HostCatalog {
+		hc, err := NewHostCatalog(ctx, prj.GetPublicId(), plg.GetPublicId(), WithSecrets(secrets(t, "first")))
+		require.NoError(t, err)
+		hc, _, err = repo.CreateCatalog(ctx, hc)
+		require.NoError(t, err)
+		return hc
+	}
+	persistedKey := func(t *testing.T, id string) string {
+		_, p, err := repo.getCatalog(ctx, id)
+		require.NoError(t, err)
+		return p.GetSecrets().GetFields()["key"].GetStringValue()
+	}
+
+	t.Run("new secrets", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		hc := createCatalog(t)
+		got, _, n, err := repo.RotateCatalogSecrets(ctx, hc.GetPublicId(), hc.GetVersion(), secrets(t, "second"))
+		require.NoError(err)
+		assert.Equal(1, n)
+		assert.Equal(hc.GetVersion()+1, got.GetVersion())
+		assert.NotEqual(hc.GetSecretsHmac(), got.GetSecretsHmac())
+		assert.Equal("second", persistedKey(t, hc.GetPublicId()))
+	})
+	t.Run("stored secrets", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		hc := createCatalog(t)
+		_, _, _, err := repo.RotateCatalogSecrets(ctx, hc.GetPublicId(), hc.GetVersion(), nil)
+		require.NoError(err)
+		assert.Equal("first-minted", persistedKey(t, hc.GetPublicId()))
+	})
+	t.Run("rejected", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		hc := createCatalog(t)
+		rejected, err := structpb.NewStruct(map[string]any{"reject": true})
+		require.NoError(err)
+		_, _, _, err = repo.RotateCatalogSecrets(ctx, hc.GetPublicId(), hc.GetVersion(), rejected)
+		require.Error(err)
+		assert.Equal("first", persistedKey(t, hc.GetPublicId()))
+	})
+	t.Run("version mismatch", func(t *testing.T) {
+		hc := createCatalog(t)
+		_, _, _, err := repo.RotateCatalogSecrets(ctx, hc.GetPublicId(), hc.GetVersion()+1, secrets(t, "second"))
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.VersionMismatch), err), "unexpected error: %v", err)
+	})
+	t.Run("no secrets", func(t *testing.T) {
+		hc := TestCatalog(t, conn, prj.GetPublicId(), plg.GetPublicId())
+		_, _, _, err := repo.RotateCatalogSecrets(ctx, hc.GetPublicId(), hc.GetVersion(), nil)
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+}
+
+func TestRepository_CatalogSecretRotation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	plg := plugin.TestPlugin(t, conn, "test")
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched, map[string]plgpb.HostPluginServiceClient{})
+	require.NoError(t, err)
+	hc := TestCatalog(t, conn, prj.GetPublicId(), plg.GetPublicId())
+
+	assert, require := assert.New(t), require.New(t)
+	got, err := repo.LookupCatalogSecretRotation(ctx, hc.GetPublicId())
+	require.NoError(err)
+	assert.Zero(got)
+
+	require.NoError(repo.SetCatalogSecretRotation(ctx, hc.GetPublicId(), 90*time.Minute))
+	got, err = repo.LookupCatalogSecretRotation(ctx, hc.GetPublicId())
+	require.NoError(err)
+	assert.Equal(90*time.Minute, got)
+
+	require.NoError(repo.SetCatalogSecretRotation(ctx, hc.GetPublicId(), time.Hour))
+	got, err = repo.LookupCatalogSecretRotation(ctx, hc.GetPublicId())
+	require.NoError(err)
+	assert.Equal(time.Hour, got)
+
+	require.NoError(repo.SetCatalogSecretRotation(ctx, hc.GetPublicId(), 0))
+	got, err = repo.LookupCatalogSecretRotation(ctx, hc.GetPublicId())
+	require.NoError(err)
+	assert.Zero(got)
+
+	assert.Error(repo.SetCatalogSecretRotation(ctx, hc.GetPublicId(), -time.Hour))
+	assert.Error(repo.SetCatalogSecretRotation(ctx, "", time.Hour))
+
+	// The schedule is deleted along with the catalog.
+	require.NoError(repo.SetCatalogSecretRotation(ctx, hc.GetPublicId(), time.Hour))
+	_, err = repo.DeleteCatalog(ctx, hc.GetPublicId())
+	require.NoError(err)
+	got, err = repo.LookupCatalogSecretRotation(ctx, hc.GetPublicId())
+	require.NoError(err)
+	assert.Zero(got)
+}
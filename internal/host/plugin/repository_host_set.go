@@ -1006,9 +1006,10 @@ func (r *Repository) Endpoints(ctx context.Context, setIds []string) ([]*host.En
 				continue
 			}
 			es = append(es, &host.Endpoint{
-				HostId:  h.GetPublicId(),
-				SetId:   sId,
-				Address: addr,
+				HostId:    h.GetPublicId(),
+				SetId:     sId,
+				Address:   addr,
+				Addresses: endpoint.Classify(h.GetIpAddresses(), h.GetDnsNames()),
 			})
 		}
 	}
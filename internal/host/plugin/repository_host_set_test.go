@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/boundary/internal/host/plugin/store"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/endpoint"
 	"github.com/hashicorp/boundary/internal/libs/patchstruct"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/plugin"
@@ -1451,6 +1452,11 @@ func TestRepository_Endpoints(t *testing.T) {
 	TestSetMembers(t, conn, hostSet192.GetPublicId(), []*Host{h1})
 	TestSetMembers(t, conn, hostSet100.GetPublicId(), []*Host{h1})
 	TestSetMembers(t, conn, hostSetDNS.GetPublicId(), []*Host{h1})
+	h1Addresses := []endpoint.Address{
+		{Value: "10.0.0.5", Type: endpoint.PrivateIp},
+		{Value: "192.168.0.5", Type: endpoint.PrivateIp},
+		{Value: "example.com", Type: endpoint.DnsName},
+	}
 
 	tests := []struct {
 		name      string
@@ -1472,8 +1478,9 @@ func TestRepository_Endpoints(t *testing.T) {
 						require.NoError(t, err)
 						return s
 					}(),
-					SetId:   hostSet10.GetPublicId(),
-					Address: "10.0.0.5",
+					SetId:     hostSet10.GetPublicId(),
+					Address:   "10.0.0.5",
+					Addresses: h1Addresses,
 				},
 			},
 		},
@@ -1487,8 +1494,9 @@ func TestRepository_Endpoints(t *testing.T) {
 						require.NoError(t, err)
 						return s
 					}(),
-					SetId:   hostSet192.GetPublicId(),
-					Address: "192.168.0.5",
+					SetId:     hostSet192.GetPublicId(),
+					Address:   "192.168.0.5",
+					Addresses: h1Addresses,
 				},
 			},
 		},
@@ -1512,8 +1520,9 @@ func TestRepository_Endpoints(t *testing.T) {
 						require.NoError(t, err)
 						return s
 					}(),
-					SetId:   hostSetDNS.GetPublicId(),
-					Address: "example.com",
+					SetId:     hostSetDNS.GetPublicId(),
+					Address:   "example.com",
+					Addresses: h1Addresses,
 				},
 			},
 		},
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// MaxAddressRangeSize is the maximum number of addresses an address range may
+// expand to.
+const MaxAddressRangeSize = 256
+
+// hostnameRangeRe matches a hostname containing a single numeric range, e.g.
+// "web[01-20].corp".
+var hostnameRangeRe = regexp.MustCompile(`^([^\[\]]*)\[(\d+)-(\d+)\]([^\[\]]*)$`)
+
+// ExpandAddressRange expands addressRange into the individual addresses it
+// contains. addressRange is either a CIDR block, e.g. "10.0.0.0/28", or a
+// hostname containing a single numeric range, e.g. "web[01-20].corp".
+//
+// For IPv4 CIDR blocks larger than /31 the network and broadcast addresses are
+// skipped. For hostname ranges the numbers are zero padded to the width of the
+// start of the range if it has a leading zero. The range must not expand to
+// more than MaxAddressRangeSize addresses.
+func ExpandAddressRange(ctx context.Context, addressRange string) ([]string, error) {
+	const op = "static.ExpandAddressRange"
+	addressRange = strings.TrimSpace(addressRange)
+	switch {
+	case addressRange == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no address range")
+	case strings.Contains(addressRange, "/"):
+		return expandCidr(ctx, addressRange)
+	case strings.Contains(addressRange, "["):
+		return expandHostnameRange(ctx, addressRange)
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%q is neither a CIDR block nor a hostname range", addressRange))
+	}
+}
+
+func expandCidr(ctx context.Context, cidr string) ([]string, error) {
+	const op = "static.expandCidr"
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg(fmt.Sprintf("invalid CIDR block %q", cidr)))
+	}
+	prefix = prefix.Masked()
+
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	if hostBits >= 32 || 1<<hostBits > MaxAddressRangeSize {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("CIDR block %q contains more than %d addresses", cidr, MaxAddressRangeSize))
+	}
+	size := 1 << hostBits
+
+	addr := prefix.Addr()
+	skipEnds := prefix.Addr().Is4() && hostBits > 1
+	addresses := make([]string, 0, size)
+	for i := 0; i < size; i, addr = i+1, addr.Next() {
+		if skipEnds && (i == 0 || i == size-1) {
+			// Network and broadcast addresses
+			continue
+		}
+		addresses = append(addresses, addr.String())
+	}
+	return addresses, nil
+}
+
+func expandHostnameRange(ctx context.Context, hostnameRange string) ([]string, error) {
+	const op = "static.expandHostnameRange"
+	m := hostnameRangeRe.FindStringSubmatch(hostnameRange)
+	if m == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid hostname range %q, must contain a single numeric range such as [01-20]", hostnameRange))
+	}
+	prefix, startStr, endStr, suffix := m[1], m[2], m[3], m[4]
+	if prefix == "" && suffix == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid hostname range %q, a hostname must surround the numeric range", hostnameRange))
+	}
+	start, err := strconv.Atoi(startStr)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg(fmt.Sprintf("invalid range start %q", startStr)))
+	}
+	end, err := strconv.Atoi(endStr)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg(fmt.Sprintf("invalid range end %q", endStr)))
+	}
+	switch {
+	case start > end:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("range start %d is greater than range end %d", start, end))
+	case end-start+1 > MaxAddressRangeSize:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("hostname range %q contains more than %d addresses", hostnameRange, MaxAddressRangeSize))
+	}
+
+	width := 0
+	if len(startStr) > 1 && startStr[0] == '0' {
+		width = len(startStr)
+	}
+	addresses := make([]string, 0, end-start+1)
+	for i := start; i <= end; i++ {
+		addresses = append(addresses, fmt.Sprintf("%s%0*d%s", prefix, width, i, suffix))
+	}
+	return addresses, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandAddressRange(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		in        string
+		want      []string
+		wantLen   int
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "empty",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "single-address",
+			in:        "10.0.0.1",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "ipv4-cidr",
+			in:   "10.0.0.0/29",
+			want: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6"},
+		},
+		{
+			name: "ipv4-cidr-unmasked",
+			in:   "10.0.0.5/30",
+			want: []string{"10.0.0.5", "10.0.0.6"},
+		},
+		{
+			name: "ipv4-cidr-31",
+			in:   "10.0.0.4/31",
+			want: []string{"10.0.0.4", "10.0.0.5"},
+		},
+		{
+			name: "ipv4-cidr-32",
+			in:   "10.0.0.4/32",
+			want: []string{"10.0.0.4"},
+		},
+		{
+			name:    "ipv4-cidr-24",
+			in:      "192.168.1.0/24",
+			wantLen: 254,
+		},
+		{
+			name:      "ipv4-cidr-too-large",
+			in:        "192.168.0.0/23",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "ipv6-cidr",
+			in:   "2001:db8::/126",
+			want: []string{"2001:db8::", "2001:db8::1", "2001:db8::2", "2001:db8::3"},
+		},
+		{
+			name:      "ipv6-cidr-too-large",
+			in:        "2001:db8::/64",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "invalid-cidr",
+			in:        "10.0.0.0/33",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "hostname-range-padded",
+			in:   "web[08-11].corp",
+			want: []string{"web08.corp", "web09.corp", "web10.corp", "web11.corp"},
+		},
+		{
+			name: "hostname-range-unpadded",
+			in:   "web[9-11].corp",
+			want: []string{"web9.corp", "web10.corp", "web11.corp"},
+		},
+		{
+			name: "hostname-range-prefix-only",
+			in:   "db-[1-2]",
+			want: []string{"db-1", "db-2"},
+		},
+		{
+			name:      "hostname-range-reversed",
+			in:        "web[20-01].corp",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "hostname-range-multiple",
+			in:        "web[1-2].rack[1-2].corp",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "hostname-range-not-numeric",
+			in:        "web[a-c].corp",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "hostname-range-no-hostname",
+			in:        "[1-3]",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "hostname-range-too-large",
+			in:        "web[1-1000].corp",
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := ExpandAddressRange(ctx, tt.in)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			if tt.want != nil {
+				assert.Equal(tt.want, got)
+			}
+			if tt.wantLen != 0 {
+				assert.Len(got, tt.wantLen)
+			}
+		})
+	}
+}
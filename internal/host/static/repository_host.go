@@ -105,6 +105,85 @@ func (r *Repository) CreateHost(ctx context.Context, projectId string, h *Host,
 	return newHost, nil
 }
 
+// CreateHosts inserts hosts into the repository in a single transaction and
+// returns the new Hosts in the same order, either all of the hosts are created
+// or none are. Each host must satisfy the same requirements as for CreateHost
+// and all hosts must belong to the same catalog. The hosts are not changed.
+// opt is ignored.
+func (r *Repository) CreateHosts(ctx context.Context, projectId string, hosts []*Host, _ ...Option) ([]*Host, error) {
+	const op = "static.(Repository).CreateHosts"
+	if len(hosts) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no hosts")
+	}
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+
+	toCreate := make([]*Host, 0, len(hosts))
+	for i, h := range hosts {
+		switch {
+		case h == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("nil Host at index %d", i))
+		case h.Host == nil:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("nil embedded Host at index %d", i))
+		case h.CatalogId == "":
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("no catalog id at index %d", i))
+		case h.CatalogId != hosts[0].CatalogId:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "hosts must belong to the same catalog")
+		case h.PublicId != "":
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("public id not empty at index %d", i))
+		}
+		h = h.clone()
+		var err error
+		h.Address, err = util.ParseAddress(ctx, h.Address)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidAddress), errors.WithMsg(fmt.Sprintf("invalid address at index %d", i)))
+		}
+		h.PublicId, err = newHostId(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		toCreate = append(toCreate, h)
+	}
+	catalogId := toCreate[0].CatalogId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newHosts []*Host
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newHosts = make([]*Host, 0, len(toCreate))
+			for _, h := range toCreate {
+				newHost := h.clone()
+				if err := w.Create(ctx, newHost, db.WithOplog(oplogWrapper, h.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("address %s", h.Address)))
+				}
+				newHosts = append(newHosts, newHost)
+			}
+			return nil
+		},
+	)
+
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in catalog: %s: name already exists", catalogId)))
+		}
+		if errors.IsCheckConstraintError(err) || errors.IsNotNullError(err) {
+			return nil, errors.New(ctx,
+				errors.InvalidAddress,
+				op,
+				fmt.Sprintf("in catalog: %s", catalogId),
+				errors.WithWrap(err),
+			)
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in catalog: %s", catalogId)))
+	}
+	return newHosts, nil
+}
+
 // UpdateHost updates the repository entry for h.PublicId with the values
 // in h for the fields listed in fieldMaskPaths. It returns a new Host
 // containing the updated values and a count of the number of records
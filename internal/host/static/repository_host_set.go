@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/host"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/endpoint"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/go-dbw"
 )
@@ -201,9 +202,10 @@ func (r *Repository) Endpoints(ctx context.Context, setId string) ([]*host.Endpo
 	var es []*host.Endpoint
 	for _, h := range hs {
 		es = append(es, &host.Endpoint{
-			HostId:  h.GetPublicId(),
-			SetId:   setId,
-			Address: h.GetAddress(),
+			HostId:    h.GetPublicId(),
+			SetId:     setId,
+			Address:   h.GetAddress(),
+			Addresses: []endpoint.Address{endpoint.ClassifyAddress(h.GetAddress())},
 		})
 	}
 	return es, err
@@ -362,6 +362,115 @@ func TestRepository_CreateHost(t *testing.T) {
 	})
 }
 
+func TestRepository_CreateHosts(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalogs := TestCatalogs(t, conn, prj.PublicId, 2)
+	catalogA, catalogB := catalogs[0], catalogs[1]
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+	require.NotNil(t, repo)
+
+	newHost := func(catalogId, name, address string) *Host {
+		return &Host{
+			Host: &store.Host{
+				CatalogId: catalogId,
+				Name:      name,
+				Address:   address,
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		projectId string
+		in        []*Host
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "no-hosts",
+			projectId: prj.PublicId,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "no-project-id",
+			in:        []*Host{newHost(catalogA.PublicId, "", "10.0.0.1")},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "nil-host",
+			projectId: prj.PublicId,
+			in:        []*Host{newHost(catalogA.PublicId, "", "10.0.0.1"), nil},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "different-catalogs",
+			projectId: prj.PublicId,
+			in:        []*Host{newHost(catalogA.PublicId, "", "10.0.0.1"), newHost(catalogB.PublicId, "", "10.0.0.2")},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "invalid-address",
+			projectId: prj.PublicId,
+			in:        []*Host{newHost(catalogA.PublicId, "", "10.0.0.1"), newHost(catalogA.PublicId, "", "12")},
+			wantIsErr: errors.InvalidAddress,
+		},
+		{
+			name:      "duplicate-names",
+			projectId: prj.PublicId,
+			in:        []*Host{newHost(catalogA.PublicId, "dup", "10.0.0.1"), newHost(catalogA.PublicId, "dup", "10.0.0.2")},
+			wantIsErr: errors.NotUnique,
+		},
+		{
+			name:      "valid",
+			projectId: prj.PublicId,
+			in:        []*Host{newHost(catalogA.PublicId, "web01", "web01.corp"), newHost(catalogA.PublicId, "web02", "web02.corp"), newHost(catalogA.PublicId, "", "10.0.0.3")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.CreateHosts(ctx, tt.projectId, tt.in)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.Len(got, len(tt.in))
+			for i, h := range got {
+				assertPublicId(t, globals.StaticHostPrefix, h.PublicId)
+				assert.NotSame(tt.in[i], h)
+				assert.Empty(tt.in[i].PublicId)
+				assert.Equal(tt.in[i].Name, h.Name)
+				assert.Equal(tt.in[i].Address, h.Address)
+				assert.Equal(h.CreateTime, h.UpdateTime)
+				assert.NoError(db.TestVerifyOplog(t, rw, h.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+			}
+		})
+	}
+
+	t.Run("all-or-nothing", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := repo.CreateHost(ctx, prj.PublicId, newHost(catalogB.PublicId, "taken", "10.0.1.1"))
+		require.NoError(err)
+
+		got, err := repo.CreateHosts(ctx, prj.PublicId, []*Host{newHost(catalogB.PublicId, "free", "10.0.1.2"), newHost(catalogB.PublicId, "taken", "10.0.1.3")})
+		assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want err code: %v got err: %v", errors.NotUnique, err)
+		assert.Nil(got)
+
+		hosts, _, err := repo.listHosts(ctx, catalogB.PublicId)
+		require.NoError(err)
+		assert.Len(hosts, 1)
+	})
+}
+
 func TestRepository_UpdateHost(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
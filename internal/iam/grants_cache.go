@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/boundary/internal/perms"
+)
+
+// DefaultGrantsCacheMaxEntries is the number of users whose grants a
+// GrantsCache holds when no other size is given.
+const DefaultGrantsCacheMaxEntries = 10000
+
+// GrantsCache caches the grant tuples of users between requests, so that
+// GrantsForUser does not resolve them from roles for every request. All
+// entries are tagged with the grants version read from the database when they
+// were resolved. The version changes with every committed change to roles,
+// grants, principals or memberships, on any controller, so entries are only
+// used while no change that could affect them has been made.
+//
+// The version is a single row that every such change updates, so
+// transactions that change roles, grants, principals or memberships are
+// serialized on its lock until they commit. Statements that change no rows,
+// such as refreshing the managed groups of an account on login when its
+// memberships have not changed, and updates to accounts that do not change
+// their user or groups, do not take the lock. Logins that do change
+// memberships still wait on each other and on administrative changes.
+//
+// A GrantsCache is safe for concurrent use and is meant to be shared by the
+// repositories of a controller using WithGrantsCache.
+type GrantsCache struct {
+	maxEntries int
+
+	mu      sync.RWMutex
+	version int64
+	entries map[string]perms.GrantTuples
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// NewGrantsCache returns a cache holding the grants of at most maxEntries
+// users. If maxEntries is not positive, DefaultGrantsCacheMaxEntries is used.
+func NewGrantsCache(maxEntries int) *GrantsCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultGrantsCacheMaxEntries
+	}
+	return &GrantsCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]perms.GrantTuples),
+	}
+}
+
+// Hits returns the number of lookups answered from the cache.
+func (c *GrantsCache) Hits() uint64 {
+	return c.hits.Load()
+}
+
+// Misses returns the number of lookups that had to resolve grants.
+func (c *GrantsCache) Misses() uint64 {
+	return c.misses.Load()
+}
+
+// get returns the grants of the user if they were cached at the given
+// version.
+func (c *GrantsCache) get(version int64, userId string) (perms.GrantTuples, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if version == c.version {
+		if gt, ok := c.entries[userId]; ok {
+			c.hits.Add(1)
+			grantsCacheLookups.WithLabelValues(grantsCacheHit).Inc()
+			return slices.Clone(gt), true
+		}
+	}
+	c.misses.Add(1)
+	grantsCacheLookups.WithLabelValues(grantsCacheMiss).Inc()
+	return nil, false
+}
+
+// put caches the grants of the user resolved at the given version. Entries
+// of older versions are dropped; grants of an older version than the cache's
+// are not cached.
+func (c *GrantsCache) put(version int64, userId string, gt perms.GrantTuples) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case version < c.version:
+		return
+	case version > c.version:
+		if len(c.entries) > 0 {
+			grantsCacheInvalidations.Inc()
+		}
+		c.version = version
+		clear(c.entries)
+	}
+	if _, ok := c.entries[userId]; !ok && len(c.entries) >= c.maxEntries {
+		// Evict an arbitrary entry to make room
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+	c.entries[userId] = slices.Clone(gt)
+}
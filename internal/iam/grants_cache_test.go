@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantsCache(t *testing.T) {
+	t.Parallel()
+	gt1 := perms.GrantTuples{{RoleId: "r_1", GrantScopeId: "global", Grant: "ids=*;type=*;actions=read"}}
+	gt2 := perms.GrantTuples{{RoleId: "r_2", GrantScopeId: "global", Grant: "ids=*;type=*;actions=*"}}
+
+	c := NewGrantsCache(2)
+	_, ok := c.get(1, "u_1")
+	assert.False(t, ok)
+
+	c.put(1, "u_1", gt1)
+	got, ok := c.get(1, "u_1")
+	assert.True(t, ok)
+	assert.Equal(t, gt1, got)
+
+	// Returned grants are copies
+	got[0].Grant = "changed"
+	got, _ = c.get(1, "u_1")
+	assert.Equal(t, gt1, got)
+
+	// A newer version drops all entries
+	_, ok = c.get(2, "u_1")
+	assert.False(t, ok)
+	c.put(2, "u_2", gt2)
+	_, ok = c.get(2, "u_1")
+	assert.False(t, ok)
+	got, ok = c.get(2, "u_2")
+	assert.True(t, ok)
+	assert.Equal(t, gt2, got)
+
+	// Grants resolved at an older version are not cached
+	c.put(1, "u_1", gt1)
+	_, ok = c.get(2, "u_1")
+	assert.False(t, ok)
+	_, ok = c.get(1, "u_1")
+	assert.False(t, ok)
+
+	// The number of entries is bounded
+	c.put(2, "u_1", gt1)
+	c.put(2, "u_3", gt1)
+	assert.Len(t, c.entries, 2)
+	_, ok = c.get(2, "u_3")
+	assert.True(t, ok)
+
+	assert.Equal(t, uint64(4), c.Hits())
+	assert.Equal(t, uint64(5), c.Misses())
+
+	assert.Equal(t, DefaultGrantsCacheMaxEntries, NewGrantsCache(0).maxEntries)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	grantsCacheSubsystem = "controller_grants_cache"
+
+	labelGrantsCacheResult = "result"
+	grantsCacheHit         = "hit"
+	grantsCacheMiss        = "miss"
+)
+
+var (
+	grantsCacheLookups = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: grantsCacheSubsystem,
+			Name:      "lookups_total",
+			Help:      "Count of lookups of the grants of users in the grants cache, by whether the grants were cached.",
+		},
+		[]string{labelGrantsCacheResult},
+	)
+	grantsCacheInvalidations prometheus.Counter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: grantsCacheSubsystem,
+			Name:      "invalidations_total",
+			Help:      "Count of times the grants cache was emptied because roles, grants or memberships changed.",
+		},
+	)
+)
+
+// InitializeMetrics initializes the metrics for visibility into the grants
+// cache.
+func InitializeMetrics(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(
+		grantsCacheLookups,
+		grantsCacheInvalidations,
+	)
+	for _, l := range []string{grantsCacheHit, grantsCacheMiss} {
+		grantsCacheLookups.With(prometheus.Labels{labelGrantsCacheResult: l})
+	}
+}
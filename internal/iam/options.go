@@ -6,6 +6,7 @@ package iam
 import (
 	"io"
 
+	"github.com/hashicorp/boundary/internal/annotation"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/pagination"
 )
@@ -43,6 +44,10 @@ type options struct {
 	withWriter                    db.Writer
 	withStartPageAfterItem        pagination.Item
 	withTestCacheMultiGrantTuples *[]multiGrantTuple
+	withScopeIds                  []string
+	withParentScopeIds            []string
+	withGrantsCache               *GrantsCache
+	withAnnotations               annotation.Annotations
 }
 
 func getDefaultOptions() options {
@@ -157,6 +162,13 @@ func WithPrimaryAuthMethodId(id string) Option {
 	}
 }
 
+// WithAnnotations provides an optional set of annotations.
+func WithAnnotations(annotations annotation.Annotations) Option {
+	return func(o *options) {
+		o.withAnnotations = annotations
+	}
+}
+
 // WithReaderWriter allows the caller to pass an inflight transaction to be used
 // for all database operations. If WithReaderWriter(...) is used, then the
 // caller is responsible for managing the transaction. The purpose of the
@@ -169,6 +181,14 @@ func WithReaderWriter(r db.Reader, w db.Writer) Option {
 	}
 }
 
+// WithGrantsCache provides an option to cache the grants resolved by
+// GrantsForUser in the given cache.
+func WithGrantsCache(c *GrantsCache) Option {
+	return func(o *options) {
+		o.withGrantsCache = c
+	}
+}
+
 // WithStartPageAfterItem is used to paginate over the results.
 // The next page will start after the provided item.
 func WithStartPageAfterItem(item pagination.Item) Option {
@@ -177,6 +197,16 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 	}
 }
 
+// WithScopeOrParentIds restricts ListScopesRecursively to the scopes with one
+// of the given scope ids, or whose parent has one of the given parent ids. It
+// is ignored if both are empty.
+func WithScopeOrParentIds(scopeIds, parentIds []string) Option {
+	return func(o *options) {
+		o.withScopeIds = scopeIds
+		o.withParentScopeIds = parentIds
+	}
+}
+
 func withTestCacheMultiGrantTuples(cache *[]multiGrantTuple) Option {
 	return func(o *options) {
 		o.withTestCacheMultiGrantTuples = cache
@@ -99,4 +99,12 @@ func Test_GetOpts(t *testing.T) {
 		assert.Equal(opts.withStartPageAfterItem.GetPublicId(), "s_1")
 		assert.Equal(opts.withStartPageAfterItem.GetUpdateTime(), timestamp.New(updateTime))
 	})
+	t.Run("WithScopeOrParentIds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithScopeOrParentIds([]string{"p_1"}, []string{"o_1", "o_2"}))
+		testOpts := getDefaultOptions()
+		testOpts.withScopeIds = []string{"p_1"}
+		testOpts.withParentScopeIds = []string{"o_1", "o_2"}
+		assert.Equal(opts, testOpts)
+	})
 }
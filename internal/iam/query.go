@@ -206,6 +206,10 @@ const (
         on grants.role_id = roles.role_id;
     `
 
+	grantsVersionQuery = `
+		select version from iam_grants_version
+	`
+
 	estimateCountRoles = `
 		select reltuples::bigint as estimate from pg_class where oid in ('iam_role'::regclass)
 	`
@@ -221,4 +225,93 @@ const (
 	estimateCountScopes = `
 		select reltuples::bigint as estimate from pg_class where oid in ('iam_scope'::regclass)
 	`
+
+	// danglingReferenceScopeIdsQuery returns the given scope and, if it is an
+	// org or global, the scopes beneath it.
+	danglingReferenceScopeIdsQuery = `
+    select public_id from iam_scope where public_id = @scope_id
+    union
+    select public_id from iam_scope where parent_id = @scope_id
+    union
+    select public_id
+      from iam_scope
+     where parent_id in (select public_id from iam_scope where parent_id = @scope_id)
+	`
+
+	// roleGrantsInScopesQuery returns the grants of the roles in the given
+	// scopes.
+	roleGrantsInScopesQuery = `
+    select iam_role.public_id as role_id,
+           iam_role.scope_id,
+           iam_role_grant.canonical_grant
+      from iam_role_grant
+      join iam_role
+        on iam_role.public_id = iam_role_grant.role_id
+     where iam_role.scope_id = any(@scope_ids)
+  order by iam_role.public_id, iam_role_grant.canonical_grant
+	`
+
+	// existingResourceIdsQuery returns which of the given ids belong to a
+	// resource which exists.
+	existingResourceIdsQuery = `
+    select public_id from iam_scope where public_id = any(@ids)
+    union
+    select public_id from iam_user where public_id = any(@ids)
+    union
+    select public_id from iam_group where public_id = any(@ids)
+    union
+    select public_id from iam_role where public_id = any(@ids)
+    union
+    select public_id from auth_method where public_id = any(@ids)
+    union
+    select public_id from auth_account where public_id = any(@ids)
+    union
+    select public_id from auth_managed_group where public_id = any(@ids)
+    union
+    select public_id from auth_token where public_id = any(@ids)
+    union
+    select public_id from host_catalog where public_id = any(@ids)
+    union
+    select public_id from host_set where public_id = any(@ids)
+    union
+    select public_id from host where public_id = any(@ids)
+    union
+    select public_id from target where public_id = any(@ids)
+    union
+    select public_id from credential_store where public_id = any(@ids)
+    union
+    select public_id from credential_library where public_id = any(@ids)
+    union
+    select public_id from credential where public_id = any(@ids)
+    union
+    select public_id from session where public_id = any(@ids)
+    union
+    select public_id from recording_session where public_id = any(@ids)
+    union
+    select public_id from server_worker where public_id = any(@ids)
+    union
+    select public_id from storage_plugin_storage_bucket where public_id = any(@ids)
+    union
+    select public_id from policy where public_id = any(@ids)
+    union
+    select public_id from alias where public_id = any(@ids)
+    union
+    select public_id from environment where public_id = any(@ids)
+    union
+    select public_id from target_template where public_id = any(@ids)
+    union
+    select public_id from plugin where public_id = any(@ids)
+	`
+
+	// danglingAliasHostsQuery returns the target aliases in the given scopes
+	// whose host no longer exists. The destination of an alias is cleared by
+	// a foreign key when its target is deleted, but its host is not.
+	danglingAliasHostsQuery = `
+    select public_id, scope_id, host_id
+      from alias_target
+     where scope_id = any(@scope_ids)
+       and host_id is not null
+       and host_id not in (select public_id from host)
+  order by public_id
+	`
 )
@@ -25,10 +25,14 @@ type Repository struct {
 
 	// defaultLimit provides a default for limiting the number of results returned from the repo
 	defaultLimit int
+
+	// grantsCache, if set, caches the grants resolved by GrantsForUser
+	grantsCache *GrantsCache
 }
 
 // NewRepository creates a new iam Repository. Supports the options: WithLimit
-// which sets a default limit on results returned by repo operations.
+// which sets a default limit on results returned by repo operations, and
+// WithGrantsCache which caches the grants resolved by GrantsForUser.
 func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	const op = "iam.NewRepository"
 	if r == nil {
@@ -50,6 +54,7 @@ func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms,
 		writer:       w,
 		kms:          kms,
 		defaultLimit: opts.withLimit,
+		grantsCache:  opts.withGrantsCache,
 	}, nil
 }
 
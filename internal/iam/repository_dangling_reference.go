@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// DanglingReference is a reference from a resource to another resource which
+// no longer exists.
+type DanglingReference struct {
+	// ResourceId is the id of the resource holding the reference.
+	ResourceId string
+	// ResourceType is the type of the resource holding the reference.
+	ResourceType resource.Type
+	// ScopeId is the id of the scope of the resource holding the reference.
+	ScopeId string
+	// Field is the field of the resource holding the reference.
+	Field string
+	// ReferencedId is the id of the resource which no longer exists.
+	ReferencedId string
+	// Detail describes the reference, such as the grant naming the id.
+	Detail string
+}
+
+// ListDanglingReferences returns the references from the resources in the
+// scope with the given id to resources which no longer exist. If recursive is
+// true the resources of the scopes beneath it are included.
+//
+// Only references not enforced by a foreign key can dangle: the ids named in
+// role grants and the hosts of target aliases. References such as the host
+// sources of a target or the destination of an alias are removed by the
+// database when the referenced resource is deleted.
+func (r *Repository) ListDanglingReferences(ctx context.Context, scopeId string, recursive bool, _ ...Option) ([]*DanglingReference, error) {
+	const op = "iam.(Repository).ListDanglingReferences"
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+
+	scopeIds := []string{scopeId}
+	if recursive {
+		var err error
+		scopeIds, err = r.queryIds(ctx, danglingReferenceScopeIdsQuery, sql.Named("scope_id", scopeId))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to list scopes"))
+		}
+		if len(scopeIds) == 0 {
+			return nil, errors.New(ctx, errors.RecordNotFound, op, "scope not found")
+		}
+	}
+	scopeIdsArg := sql.Named("scope_ids", "{"+strings.Join(scopeIds, ",")+"}")
+
+	grantRefs, err := r.danglingGrantReferences(ctx, scopeIdsArg)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	rows, err := r.reader.Query(ctx, danglingAliasHostsQuery, []any{scopeIdsArg})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to query aliases"))
+	}
+	defer rows.Close()
+	var aliasRefs []*DanglingReference
+	for rows.Next() {
+		ref := &DanglingReference{
+			ResourceType: resource.Alias,
+			Field:        "authorize_session_arguments.host_id",
+		}
+		if err := rows.Scan(&ref.ResourceId, &ref.ScopeId, &ref.ReferencedId); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan alias"))
+		}
+		aliasRefs = append(aliasRefs, ref)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan aliases"))
+	}
+
+	refs := append(grantRefs, aliasRefs...)
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].ScopeId != refs[j].ScopeId {
+			return refs[i].ScopeId < refs[j].ScopeId
+		}
+		return refs[i].ResourceId < refs[j].ResourceId
+	})
+	return refs, nil
+}
+
+// danglingGrantReferences returns the ids named in the grants of the roles in
+// the given scopes which do not belong to an existing resource.
+func (r *Repository) danglingGrantReferences(ctx context.Context, scopeIdsArg sql.NamedArg) ([]*DanglingReference, error) {
+	const op = "iam.(Repository).danglingGrantReferences"
+	rows, err := r.reader.Query(ctx, roleGrantsInScopesQuery, []any{scopeIdsArg})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to query role grants"))
+	}
+	defer rows.Close()
+
+	type grantRef struct {
+		roleId, scopeId, grant, id string
+	}
+	var candidates []grantRef
+	idSet := map[string]struct{}{}
+	for rows.Next() {
+		var roleId, scopeId, grant string
+		if err := rows.Scan(&roleId, &scopeId, &grant); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan role grant"))
+		}
+		for _, id := range grantIds(grant) {
+			candidates = append(candidates, grantRef{roleId: roleId, scopeId: scopeId, grant: grant, id: id})
+			idSet[id] = struct{}{}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan role grants"))
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(idSet))
+	for id := range idSet {
+		ids = append(ids, id)
+	}
+	existing, err := r.queryIds(ctx, existingResourceIdsQuery, sql.Named("ids", "{"+strings.Join(ids, ",")+"}"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to query resources"))
+	}
+	existingSet := make(map[string]struct{}, len(existing))
+	for _, id := range existing {
+		existingSet[id] = struct{}{}
+	}
+
+	var refs []*DanglingReference
+	for _, c := range candidates {
+		if _, ok := existingSet[c.id]; ok {
+			continue
+		}
+		refs = append(refs, &DanglingReference{
+			ResourceId:   c.roleId,
+			ResourceType: resource.Role,
+			ScopeId:      c.scopeId,
+			Field:        "grants",
+			ReferencedId: c.id,
+			Detail:       c.grant,
+		})
+	}
+	return refs, nil
+}
+
+// queryIds runs a query returning a single column of ids.
+func (r *Repository) queryIds(ctx context.Context, query string, args ...any) ([]string, error) {
+	const op = "iam.(Repository).queryIds"
+	rows, err := r.reader.Query(ctx, query, args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
+
+// grantIds returns the resource ids named by the ids field of the given
+// canonical grant, ignoring wildcards and templated ids.
+func grantIds(grant string) []string {
+	var ids []string
+	for _, segment := range strings.Split(grant, ";") {
+		k, v, ok := strings.Cut(segment, "=")
+		if !ok || (k != "ids" && k != "id") {
+			continue
+		}
+		for _, id := range strings.Split(v, ",") {
+			id = strings.TrimSpace(id)
+			if id == "" || id == "*" || strings.Contains(id, "{{") {
+				continue
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Repository_ListDanglingReferences(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := iam.TestRepo(t, conn, wrapper)
+
+	t.Run("missing-scope-id", func(t *testing.T) {
+		_, err := repo.ListDanglingReferences(ctx, "", false)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+	})
+
+	org, prj := iam.TestScopes(t, repo)
+	user := iam.TestUser(t, repo, org.PublicId)
+	orgRole := iam.TestRole(t, conn, org.PublicId)
+	iam.TestRoleGrant(t, conn, orgRole.PublicId, "ids=*;type=*;actions=*")
+	iam.TestRoleGrant(t, conn, orgRole.PublicId, "ids="+user.PublicId+";actions=read")
+	iam.TestRoleGrant(t, conn, orgRole.PublicId, "ids=ttcp_1234567890;actions=read")
+	prjRole := iam.TestRole(t, conn, prj.PublicId)
+	iam.TestRoleGrant(t, conn, prjRole.PublicId, "ids=hsst_1234567890;actions=read")
+
+	t.Run("scope", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		refs, err := repo.ListDanglingReferences(ctx, org.PublicId, false)
+		require.NoError(err)
+		require.Len(refs, 1)
+		assert.Equal(&iam.DanglingReference{
+			ResourceId:   orgRole.PublicId,
+			ResourceType: resource.Role,
+			ScopeId:      org.PublicId,
+			Field:        "grants",
+			ReferencedId: "ttcp_1234567890",
+			Detail:       "ids=ttcp_1234567890;actions=read",
+		}, refs[0])
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		refs, err := repo.ListDanglingReferences(ctx, org.PublicId, true)
+		require.NoError(err)
+		require.Len(refs, 2)
+		assert.Equal("ttcp_1234567890", refs[0].ReferencedId)
+		assert.Equal(prjRole.PublicId, refs[1].ResourceId)
+		assert.Equal("hsst_1234567890", refs[1].ReferencedId)
+	})
+
+	t.Run("unknown-scope", func(t *testing.T) {
+		_, err := repo.ListDanglingReferences(ctx, "o_1234567890", true)
+		assert.Truef(t, errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %v", err)
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/deletionprotection"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// LookupDeletionProtection returns whether the scope, target, auth method or
+// storage bucket with the given public id is protected from deletion.
+func (r *Repository) LookupDeletionProtection(ctx context.Context, publicId string, _ ...Option) (bool, error) {
+	const op = "iam.(Repository).LookupDeletionProtection"
+	if publicId == "" {
+		return false, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	protected, err := deletionprotection.IsProtected(ctx, r.reader, publicId)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return protected, nil
+}
+
+// SetDeletionProtection protects the scope, target, auth method or storage
+// bucket with the given public id from deletion, or removes its protection.
+// While protected, deleting the resource or any scope containing it fails
+// with a Conflict error.
+func (r *Repository) SetDeletionProtection(ctx context.Context, publicId string, protect bool, _ ...Option) error {
+	const op = "iam.(Repository).SetDeletionProtection"
+	if publicId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if err := deletionprotection.Set(ctx, r.writer, publicId, protect); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Repository_DeletionProtection(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	t.Run("missing-public-id", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.LookupDeletionProtection(ctx, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+		err = repo.SetDeletionProtection(ctx, "", true)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+	})
+
+	t.Run("protect-scope", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, prj := iam.TestScopes(t, repo)
+
+		protected, err := repo.LookupDeletionProtection(ctx, prj.PublicId)
+		require.NoError(err)
+		assert.False(protected)
+
+		require.NoError(repo.SetDeletionProtection(ctx, prj.PublicId, true))
+		// Protecting an already protected scope is not an error.
+		require.NoError(repo.SetDeletionProtection(ctx, prj.PublicId, true))
+		protected, err = repo.LookupDeletionProtection(ctx, prj.PublicId)
+		require.NoError(err)
+		assert.True(protected)
+
+		_, err = repo.DeleteScope(ctx, prj.PublicId)
+		assert.Truef(errors.Match(errors.T(errors.Conflict), err), "unexpected error %v", err)
+
+		require.NoError(repo.SetDeletionProtection(ctx, prj.PublicId, false))
+		protected, err = repo.LookupDeletionProtection(ctx, prj.PublicId)
+		require.NoError(err)
+		assert.False(protected)
+
+		rowsDeleted, err := repo.DeleteScope(ctx, prj.PublicId)
+		require.NoError(err)
+		assert.Equal(1, rowsDeleted)
+	})
+
+	t.Run("protect-auth-method-in-scope", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		org := iam.TestOrg(t, repo)
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+		require.NoError(err)
+		am := oidc.TestAuthMethod(t, conn, databaseWrapper, org.PublicId, oidc.InactiveState, "alice-rp", "fido")
+
+		require.NoError(repo.SetDeletionProtection(ctx, am.PublicId, true))
+
+		// The protected auth method also protects the scope containing it.
+		_, err = repo.DeleteScope(ctx, org.PublicId)
+		assert.Truef(errors.Match(errors.T(errors.Conflict), err), "unexpected error %v", err)
+
+		require.NoError(repo.SetDeletionProtection(ctx, am.PublicId, false))
+		rowsDeleted, err := repo.DeleteScope(ctx, org.PublicId)
+		require.NoError(err)
+		assert.Equal(1, rowsDeleted)
+	})
+}
@@ -420,6 +420,10 @@ type multiGrantTuple struct {
 	Grants            string
 }
 
+// GrantsForUser returns the grant tuples of all roles the user is a principal
+// of, directly or through groups, managed groups, u_anon and u_auth. If the
+// repository has a grants cache, the grants are cached until the grants
+// version changes.
 func (r *Repository) GrantsForUser(ctx context.Context, userId string, opt ...Option) (perms.GrantTuples, error) {
 	const op = "iam.(Repository).GrantsForUser"
 	if userId == "" {
@@ -427,6 +431,50 @@ func (r *Repository) GrantsForUser(ctx context.Context, userId string, opt ...Op
 	}
 
 	opts := getOpts(opt...)
+	if r.grantsCache == nil || opts.withTestCacheMultiGrantTuples != nil {
+		return r.grantsForUser(ctx, userId, opts)
+	}
+
+	// The version must be read before resolving the grants: if grants change
+	// in between, the new grants are cached with the old version and dropped
+	// on the next lookup.
+	version, err := r.grantsVersion(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if gt, ok := r.grantsCache.get(version, userId); ok {
+		return gt, nil
+	}
+	gt, err := r.grantsForUser(ctx, userId, opts)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	r.grantsCache.put(version, userId, gt)
+	return gt, nil
+}
+
+// grantsVersion returns the current version of the grants of all users.
+func (r *Repository) grantsVersion(ctx context.Context) (int64, error) {
+	const op = "iam.(Repository).grantsVersion"
+	rows, err := r.reader.Query(ctx, grantsVersionQuery, nil)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query grants version"))
+	}
+	defer rows.Close()
+	var version int64
+	for rows.Next() {
+		if err := r.reader.ScanRows(ctx, rows, &version); err != nil {
+			return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query grants version"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query grants version"))
+	}
+	return version, nil
+}
+
+func (r *Repository) grantsForUser(ctx context.Context, userId string, opts options) (perms.GrantTuples, error) {
+	const op = "iam.(Repository).grantsForUser"
 
 	const (
 		anonUser = `where public_id in (?)`
@@ -1536,3 +1536,67 @@ func TestGrantsForUser(t *testing.T) {
 		}
 	})
 }
+
+func TestGrantsForUser_Cache(t *testing.T) {
+	ctx := context.Background()
+
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+
+	cache := NewGrantsCache(DefaultGrantsCacheMaxEntries)
+	repo := TestRepo(t, conn, wrap, WithGrantsCache(cache))
+	user := TestUser(t, repo, "global")
+	org, _ := TestScopes(t, repo, WithSkipAdminRoleCreation(true), WithSkipDefaultRoleCreation(true))
+
+	hasGrant := func(gts perms.GrantTuples, roleId, grant string) bool {
+		for _, gt := range gts {
+			if gt.RoleId == roleId && gt.Grant == grant {
+				return true
+			}
+		}
+		return false
+	}
+
+	role := TestRole(t, conn, org.PublicId)
+	TestRoleGrant(t, conn, role.PublicId, "ids=*;type=*;actions=read")
+	TestUserRole(t, conn, role.PublicId, user.PublicId)
+
+	gts, err := repo.GrantsForUser(ctx, user.PublicId)
+	require.NoError(t, err)
+	assert.True(t, hasGrant(gts, role.PublicId, "ids=*;type=*;actions=read"))
+	assert.Equal(t, uint64(0), cache.Hits())
+
+	cached, err := repo.GrantsForUser(ctx, user.PublicId)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, gts, cached)
+	assert.Equal(t, uint64(1), cache.Hits())
+
+	// Adding a grant to the role invalidates the cached grants
+	TestRoleGrant(t, conn, role.PublicId, "ids=*;type=*;actions=update")
+	gts, err = repo.GrantsForUser(ctx, user.PublicId)
+	require.NoError(t, err)
+	assert.True(t, hasGrant(gts, role.PublicId, "ids=*;type=*;actions=update"))
+	assert.Equal(t, uint64(1), cache.Hits())
+
+	// So does adding the user to a group with a role
+	groupRole := TestRole(t, conn, org.PublicId)
+	TestRoleGrant(t, conn, groupRole.PublicId, "ids=*;type=*;actions=delete")
+	group := TestGroup(t, conn, org.PublicId)
+	TestGroupRole(t, conn, groupRole.PublicId, group.PublicId)
+	_, err = repo.GrantsForUser(ctx, user.PublicId)
+	require.NoError(t, err)
+	TestGroupMember(t, conn, group.PublicId, user.PublicId)
+	gts, err = repo.GrantsForUser(ctx, user.PublicId)
+	require.NoError(t, err)
+	assert.True(t, hasGrant(gts, groupRole.PublicId, "ids=*;type=*;actions=delete"))
+
+	// And removing the user from the role
+	role, _, _, _, err = repo.LookupRole(ctx, role.PublicId)
+	require.NoError(t, err)
+	_, err = repo.DeletePrincipalRoles(ctx, role.PublicId, role.Version, []string{user.PublicId})
+	require.NoError(t, err)
+	gts, err = repo.GrantsForUser(ctx, user.PublicId)
+	require.NoError(t, err)
+	assert.False(t, hasGrant(gts, role.PublicId, "ids=*;type=*;actions=read"))
+	assert.Equal(t, uint64(1), cache.Hits())
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/annotation"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -37,6 +38,9 @@ func (r *Repository) CreateScope(ctx context.Context, s *Scope, userId string, o
 	if s.PublicId != "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
 	}
+	if err := s.Annotations.Validate(); err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid annotations: %v", err))
+	}
 
 	var parentOplogWrapper wrapping.Wrapper
 	var err error
@@ -180,6 +184,12 @@ func (r *Repository) CreateScope(ctx context.Context, s *Scope, userId string, o
 
 			s := scopeRaw.(*Scope)
 
+			if len(s.Annotations) > 0 {
+				if err := annotation.Set(ctx, w, scopeAnnotationTable, s.PublicId, s.Annotations); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+
 			txnKms, err := kms.NewUsingReaderWriter(ctx, dbr, w)
 			if err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("error creating transaction's kms"))
@@ -408,8 +418,9 @@ func (r *Repository) CreateScope(ctx context.Context, s *Scope, userId string, o
 // scope.  fieldMaskPaths provides field_mask.proto paths for fields that should
 // be updated.  Fields will be set to NULL if the field is a zero value and
 // included in fieldMask. Name and Description are the only updatable fields,
-// and everything else is ignored.  If no updatable fields are included in the
-// fieldMaskPaths, then an error is returned.
+// and everything else is ignored.  Annotations replaces all the annotations of
+// the scope. If no updatable fields are included in the fieldMaskPaths, then an
+// error is returned.
 func (r *Repository) UpdateScope(ctx context.Context, scope *Scope, version uint32, fieldMaskPaths []string, _ ...Option) (*Scope, int, error) {
 	const op = "iam.(Repository).UpdateScope"
 	if scope == nil {
@@ -431,11 +442,45 @@ func (r *Repository) UpdateScope(ctx context.Context, scope *Scope, version uint
 		fieldMaskPaths,
 		nil,
 	)
+	updateAnnotations := contains(fieldMaskPaths, "Annotations")
 	// nada to update, so reload scope from db and return it
-	if len(dbMask) == 0 && len(nullFields) == 0 {
+	if len(dbMask) == 0 && len(nullFields) == 0 && !updateAnnotations {
 		return nil, db.NoRowsAffected, errors.E(ctx, errors.WithCode(errors.EmptyFieldMask), errors.WithOp(op))
 	}
-	resource, rowsUpdated, err := r.update(ctx, scope, version, dbMask, nullFields)
+	if updateAnnotations {
+		if err := scope.Annotations.Validate(); err != nil {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid annotations: %v", err))
+		}
+		// Annotations are child objects of the scope, so the scope's version
+		// must still be updated when they are the only change.
+		if len(dbMask) == 0 && len(nullFields) == 0 {
+			scope = scope.Clone().(*Scope)
+			scope.Version = version + 1
+			dbMask = append(dbMask, "Version")
+		}
+	}
+	var resource Resource
+	var rowsUpdated int
+	var annotations annotation.Annotations
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(rd db.Reader, w db.Writer) error {
+			var err error
+			resource, rowsUpdated, err = r.update(ctx, scope, version, dbMask, nullFields, WithReaderWriter(rd, w))
+			if err != nil {
+				return err
+			}
+			if updateAnnotations {
+				if err := annotation.Set(ctx, w, scopeAnnotationTable, scope.PublicId, scope.Annotations); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			annotations, err = annotation.Lookup(ctx, rd, scopeAnnotationTable, scope.PublicId)
+			return err
+		},
+	)
 	if err != nil {
 		if errors.IsUniqueError(err) {
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("%s name %s already exists", scope.PublicId, scope.Name))
@@ -444,6 +489,7 @@ func (r *Repository) UpdateScope(ctx context.Context, scope *Scope, version uint
 	}
 	var childComponentErrs error
 	item := resource.(*Scope)
+	item.Annotations = annotations
 	if err := setScopeStoragePolicyId(ctx, r.reader, item); err != nil && !errors.IsNotFoundError(err) {
 		childComponentErrs = stderr.Join(err)
 	}
@@ -472,6 +518,11 @@ func (r *Repository) LookupScope(ctx context.Context, withPublicId string, _ ...
 	if err := setScopeStoragePolicyId(ctx, r.reader, &scope); err != nil && !errors.IsNotFoundError(err) {
 		childComponentErrs = stderr.Join(err)
 	}
+	annotations, err := annotation.Lookup(ctx, r.reader, scopeAnnotationTable, scope.PublicId)
+	if err != nil {
+		childComponentErrs = stderr.Join(childComponentErrs, err)
+	}
+	scope.Annotations = annotations
 	if childComponentErrs != nil {
 		return nil, errors.Wrap(ctx, childComponentErrs, op, errors.WithMsg(fmt.Sprintf("failed to fetch one or more child components for %s", scope.PublicId)))
 	}
@@ -533,14 +584,8 @@ func (r *Repository) listScopes(ctx context.Context, withParentIds []string, opt
 		return scopes, t, err
 	}
 
-	var childComponentErrs error
-	for _, scope := range scopes {
-		if err := setScopeStoragePolicyId(ctx, r.reader, scope); err != nil && !errors.IsNotFoundError(err) {
-			childComponentErrs = stderr.Join(err)
-		}
-	}
-	if childComponentErrs != nil {
-		return scopes, t, errors.Wrap(ctx, childComponentErrs, op, errors.WithMsg("failed to fetch one or more child components"))
+	if err := setScopesStoragePolicyIds(ctx, r.reader, scopes); err != nil {
+		return scopes, t, errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch one or more child components"))
 	}
 
 	return scopes, t, nil
@@ -590,14 +635,8 @@ func (r *Repository) listScopesRefresh(ctx context.Context, updatedAfter time.Ti
 		return scopes, t, err
 	}
 
-	var childComponentErrs error
-	for _, scope := range scopes {
-		if err := setScopeStoragePolicyId(ctx, r.reader, scope); err != nil && !errors.IsNotFoundError(err) {
-			childComponentErrs = stderr.Join(err)
-		}
-	}
-	if childComponentErrs != nil {
-		return scopes, t, errors.Wrap(ctx, childComponentErrs, op, errors.WithMsg("failed to fetch one or more child components"))
+	if err := setScopesStoragePolicyIds(ctx, r.reader, scopes); err != nil {
+		return scopes, t, errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch one or more child components"))
 	}
 
 	return scopes, t, nil
@@ -613,8 +652,18 @@ func (r *Repository) queryScopes(ctx context.Context, whereClause string, args [
 		if err := rd.SearchWhere(ctx, &inRet, whereClause, args, opt...); err != nil {
 			return errors.Wrap(ctx, err, op)
 		}
+		scopeIds := make([]string, 0, len(inRet))
+		for _, s := range inRet {
+			scopeIds = append(scopeIds, s.PublicId)
+		}
+		annotations, err := annotation.LookupAll(ctx, rd, scopeAnnotationTable, scopeIds)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		for _, s := range inRet {
+			s.Annotations = annotations[s.PublicId]
+		}
 		ret = inRet
-		var err error
 		transactionTimestamp, err = rd.Now(ctx)
 		return err
 	}); err != nil {
@@ -625,6 +674,8 @@ func (r *Repository) queryScopes(ctx context.Context, whereClause string, args [
 
 // ListScopesRecursively allows for recursive listing of scopes based on a root scope
 // ID. It returns the root scope ID as a part of the set.
+//
+// Supported options: WithLimit, WithReaderWriter, WithScopeOrParentIds
 func (r *Repository) ListScopesRecursively(ctx context.Context, rootScopeId string, opt ...Option) ([]*Scope, error) {
 	const op = "iam.(Repository).ListRecursively"
 	var scopes []*Scope
@@ -646,18 +697,30 @@ func (r *Repository) ListScopesRecursively(ctx context.Context, rootScopeId stri
 		// We have no idea what scope type this is so bail
 		return nil, errors.New(ctx, errors.InvalidPublicId, op+":TypeSwitch", "invalid scope ID")
 	}
+	opts := getOpts(opt...)
+	var idsWhere []string
+	if len(opts.withScopeIds) > 0 {
+		idsWhere = append(idsWhere, "public_id in (?)")
+		args = append(args, opts.withScopeIds)
+	}
+	if len(opts.withParentScopeIds) > 0 {
+		idsWhere = append(idsWhere, "parent_id in (?)")
+		args = append(args, opts.withParentScopeIds)
+	}
+	if len(idsWhere) > 0 {
+		ids := fmt.Sprintf("(%s)", strings.Join(idsWhere, " or "))
+		if where != "" {
+			where = fmt.Sprintf("(%s) and %s", where, ids)
+		} else {
+			where = ids
+		}
+	}
 	err := r.list(ctx, &scopes, where, args, opt...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op+":ListQuery")
 	}
-	var childComponentErrs error
-	for _, scope := range scopes {
-		if err := setScopeStoragePolicyId(ctx, r.reader, scope); err != nil && !errors.IsNotFoundError(err) {
-			childComponentErrs = stderr.Join(err)
-		}
-	}
-	if childComponentErrs != nil {
-		return nil, errors.Wrap(ctx, childComponentErrs, op, errors.WithMsg("failed to fetch one or more child components"))
+	if err := setScopesStoragePolicyIds(ctx, r.reader, scopes); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch one or more child components"))
 	}
 	return scopes, nil
 }
@@ -26,3 +26,42 @@ func setScopeStoragePolicyId(ctx context.Context, r db.Reader, scope *Scope) err
 	scope.StoragePolicyId = policy.GetStoragePolicyId()
 	return nil
 }
+
+// scopeStoragePolicyBatchSize bounds the number of scope ids included in a
+// single query by setScopesStoragePolicyIds.
+const scopeStoragePolicyBatchSize = 1000
+
+// setScopesStoragePolicyIds fetches the storage policies associated with the
+// given scopes and sets the StoragePolicyId field of every scope that has an
+// association. Unlike setScopeStoragePolicyId, scopes without an association
+// are not an error. Lookups are done in batches of
+// scopeStoragePolicyBatchSize scopes, so listing a large number of scopes does
+// not result in a query per scope.
+func setScopesStoragePolicyIds(ctx context.Context, r db.Reader, scopes []*Scope) error {
+	const op = "iam.setScopesStoragePolicyIds"
+	if len(scopes) == 0 {
+		return nil
+	}
+	byId := make(map[string]*Scope, len(scopes))
+	ids := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		if _, ok := byId[s.PublicId]; ok {
+			continue
+		}
+		byId[s.PublicId] = s
+		ids = append(ids, s.PublicId)
+	}
+	for start := 0; start < len(ids); start += scopeStoragePolicyBatchSize {
+		end := min(start+scopeStoragePolicyBatchSize, len(ids))
+		var policies []*ScopePolicyStoragePolicy
+		if err := r.SearchWhere(ctx, &policies, "scope_id in (?)", []any{ids[start:end]}); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		for _, p := range policies {
+			if s, ok := byId[p.GetScopeId()]; ok {
+				s.StoragePolicyId = p.GetStoragePolicyId()
+			}
+		}
+	}
+	return nil
+}
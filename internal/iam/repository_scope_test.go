@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/annotation"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	iam_store "github.com/hashicorp/boundary/internal/iam/store"
@@ -482,6 +483,50 @@ func TestRepository_UpdateScope(t *testing.T) {
 	})
 }
 
+func Test_Repository_Scope_Annotations(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+
+	assert, require := assert.New(t), require.New(t)
+	want := annotation.Annotations{"example.com/owner": "team-a"}
+	s, err := NewOrg(ctx, WithName(testId(t)), WithAnnotations(want))
+	require.NoError(err)
+	s, err = repo.CreateScope(ctx, s, "")
+	require.NoError(err)
+	assert.Equal(want, s.Annotations)
+
+	found, err := repo.LookupScope(ctx, s.PublicId)
+	require.NoError(err)
+	assert.Equal(want, found.Annotations)
+
+	want = annotation.Annotations{"example.com/owner": "team-b", "sync": "true"}
+	found.Annotations = want
+	updated, rows, err := repo.UpdateScope(ctx, found, found.Version, []string{"Annotations"})
+	require.NoError(err)
+	assert.Equal(1, rows)
+	assert.Equal(want, updated.Annotations)
+	assert.Equal(found.Version+1, updated.Version)
+
+	scopes, _, err := repo.listScopes(ctx, []string{scope.Global.String()})
+	require.NoError(err)
+	var listed *Scope
+	for _, l := range scopes {
+		if l.PublicId == s.PublicId {
+			listed = l
+		}
+	}
+	require.NotNil(listed)
+	assert.Equal(want, listed.Annotations)
+
+	updated.Annotations = nil
+	updated, _, err = repo.UpdateScope(ctx, updated, updated.Version, []string{"Annotations"})
+	require.NoError(err)
+	assert.Empty(updated.Annotations)
+}
+
 func Test_Repository_ListScopes(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
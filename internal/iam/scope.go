@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/annotation"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -21,6 +22,9 @@ import (
 
 const (
 	defaultScopeTableName = "iam_scope"
+
+	// scopeAnnotationTable is the table of the annotations of scopes.
+	scopeAnnotationTable = "iam_scope_annotation"
 )
 
 // Scope is used to create a hierarchy of "containers" that encompass the scope of
@@ -30,6 +34,9 @@ type Scope struct {
 
 	StoragePolicyId string `json:"storage_policy_id,omitempty" gorm:"-"`
 
+	// Annotations are stored in the iam_scope_annotation table.
+	Annotations annotation.Annotations `json:"annotations,omitempty" gorm:"-"`
+
 	// tableName which is used to support overriding the table name in the db
 	// and making the Scope a ReplayableMessage
 	tableName string `gorm:"-"`
@@ -89,6 +96,7 @@ func newScope(ctx context.Context, parent *Scope, opt ...Option) (*Scope, error)
 			ParentId:            parent.PublicId,
 			PrimaryAuthMethodId: opts.withPrimaryAuthMethodId,
 		},
+		Annotations: opts.withAnnotations,
 	}
 
 	return s, nil
@@ -104,7 +112,8 @@ func AllocScope() Scope {
 func (s *Scope) Clone() any {
 	cp := proto.Clone(s.Scope)
 	return &Scope{
-		Scope: cp.(*store.Scope),
+		Scope:       cp.(*store.Scope),
+		Annotations: s.Annotations.Clone(),
 	}
 }
 
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package buildinfo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/version"
+)
+
+const (
+	// cycloneDxSpecVersion is the version of the CycloneDX specification the
+	// SBOM conforms to.
+	cycloneDxSpecVersion = "1.5"
+
+	// StatementType is the type of in-toto statements.
+	StatementType = "https://in-toto.io/Statement/v1"
+
+	// ProvenancePredicateType is the predicate type of SLSA v1 provenance.
+	ProvenancePredicateType = "https://slsa.dev/provenance/v1"
+
+	// goBuildType is the build type of binaries built by the Go toolchain.
+	goBuildType = "https://go.dev/cmd/go"
+
+	// develVersion is the module version the Go toolchain reports for the
+	// main module when it's built from a working copy.
+	develVersion = "(devel)"
+)
+
+// Bom is a CycloneDX software bill of materials.
+type Bom struct {
+	BomFormat   string       `json:"bomFormat"`
+	SpecVersion string       `json:"specVersion"`
+	Version     int          `json:"version"`
+	Metadata    *BomMetadata `json:"metadata"`
+	Components  []*Component `json:"components"`
+}
+
+// BomMetadata describes the subject of a Bom.
+type BomMetadata struct {
+	Timestamp string     `json:"timestamp,omitempty"`
+	Component *Component `json:"component"`
+}
+
+// Component is a piece of software described by a Bom.
+type Component struct {
+	Type       string      `json:"type"`
+	BomRef     string      `json:"bom-ref,omitempty"`
+	Name       string      `json:"name"`
+	Version    string      `json:"version,omitempty"`
+	Purl       string      `json:"purl,omitempty"`
+	Properties []*Property `json:"properties,omitempty"`
+}
+
+// Property is a name and value attached to a Component.
+type Property struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Statement is an in-toto statement about a set of subjects.
+type Statement struct {
+	Type          string               `json:"_type"`
+	Subject       []*Subject           `json:"subject"`
+	PredicateType string               `json:"predicateType"`
+	Predicate     *ProvenancePredicate `json:"predicate"`
+}
+
+// Subject is an artifact a Statement is about, identified by its digests.
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// ProvenancePredicate is a SLSA v1 provenance predicate.
+type ProvenancePredicate struct {
+	BuildDefinition *BuildDefinition `json:"buildDefinition"`
+	RunDetails      *RunDetails      `json:"runDetails"`
+}
+
+// BuildDefinition describes the inputs of a build.
+type BuildDefinition struct {
+	BuildType            string                `json:"buildType"`
+	ExternalParameters   map[string]any        `json:"externalParameters"`
+	InternalParameters   map[string]any        `json:"internalParameters,omitempty"`
+	ResolvedDependencies []*ResourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+// ResourceDescriptor identifies an artifact used by a build.
+type ResourceDescriptor struct {
+	Uri    string            `json:"uri"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// RunDetails describes the build's execution.
+type RunDetails struct {
+	Builder  *Builder       `json:"builder"`
+	Metadata *BuildMetadata `json:"metadata,omitempty"`
+}
+
+// Builder identifies the builder of a build.
+type Builder struct {
+	Id string `json:"id"`
+}
+
+// BuildMetadata is metadata about a build's execution.
+type BuildMetadata struct {
+	FinishedOn string `json:"finishedOn,omitempty"`
+}
+
+var (
+	executableDigest    string
+	executableDigestErr error
+	executableOnce      sync.Once
+)
+
+// Sbom returns the software bill of materials of the running binary.
+func Sbom() (*Bom, error) {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil, errors.New("binary has no build information")
+	}
+	return newBom(bi, version.Get()), nil
+}
+
+// Provenance returns the provenance statement of the running binary. The
+// checksum of the binary is computed on the first call and reused.
+func Provenance() (*Statement, error) {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil, errors.New("binary has no build information")
+	}
+	executableOnce.Do(func() {
+		executableDigest, executableDigestErr = digestExecutable()
+	})
+	if executableDigestErr != nil {
+		return nil, executableDigestErr
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("error finding binary: %w", err)
+	}
+	return newStatement(bi, version.Get(), filepath.Base(exe), executableDigest), nil
+}
+
+func newBom(bi *debug.BuildInfo, info *version.Info) *Bom {
+	main := moduleComponent("application", &bi.Main)
+	if main.Version == "" || main.Version == develVersion {
+		main.Version = info.VersionNumber()
+		main.Purl = purl(main.Name, main.Version)
+		main.BomRef = main.Purl
+	}
+
+	components := make([]*Component, 0, len(bi.Deps))
+	for _, m := range bi.Deps {
+		components = append(components, moduleComponent("library", m))
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].Name < components[j].Name
+	})
+
+	return &Bom{
+		BomFormat:   "CycloneDX",
+		SpecVersion: cycloneDxSpecVersion,
+		Version:     1,
+		Metadata: &BomMetadata{
+			Timestamp: info.BuildDate,
+			Component: main,
+		},
+		Components: components,
+	}
+}
+
+// moduleComponent returns the component of a module. A replaced module is
+// described by its replacement, which is what is compiled into the binary,
+// and the replaced module is recorded in its properties.
+func moduleComponent(typ string, m *debug.Module) *Component {
+	c := &Component{Type: typ}
+	mod := m
+	if m.Replace != nil {
+		mod = m.Replace
+		c.Properties = append(c.Properties, &Property{Name: "golang:replaces", Value: strings.TrimSpace(m.Path + " " + m.Version)})
+	}
+	c.Name = mod.Path
+	c.Version = mod.Version
+	if mod.Version != "" {
+		c.Purl = purl(mod.Path, mod.Version)
+		c.BomRef = c.Purl
+	}
+	if mod.Sum != "" {
+		c.Properties = append(c.Properties, &Property{Name: "golang:sum", Value: mod.Sum})
+	}
+	return c
+}
+
+// purl returns the package URL of a Go module.
+func purl(path, version string) string {
+	return fmt.Sprintf("pkg:golang/%s@%s", path, version)
+}
+
+func newStatement(bi *debug.BuildInfo, info *version.Info, name, digest string) *Statement {
+	settings := make(map[string]string, len(bi.Settings))
+	for _, s := range bi.Settings {
+		settings[s.Key] = s.Value
+	}
+
+	revision := info.Revision
+	if revision == "" {
+		revision = settings["vcs.revision"]
+	}
+	var deps []*ResourceDescriptor
+	if revision != "" {
+		deps = append(deps, &ResourceDescriptor{
+			Uri:    "git+https://" + bi.Main.Path,
+			Digest: map[string]string{"gitCommit": revision},
+		})
+	}
+
+	finishedOn := info.BuildDate
+	if finishedOn == "" {
+		finishedOn = settings["vcs.time"]
+	}
+
+	return &Statement{
+		Type: StatementType,
+		Subject: []*Subject{{
+			Name:   name,
+			Digest: map[string]string{"sha256": digest},
+		}},
+		PredicateType: ProvenancePredicateType,
+		Predicate: &ProvenancePredicate{
+			BuildDefinition: &BuildDefinition{
+				BuildType: goBuildType,
+				ExternalParameters: map[string]any{
+					"module":   bi.Main.Path,
+					"package":  bi.Path,
+					"version":  info.VersionNumber(),
+					"settings": settings,
+				},
+				InternalParameters: map[string]any{
+					"goVersion":  bi.GoVersion,
+					"cgoEnabled": info.CgoEnabled,
+				},
+				ResolvedDependencies: deps,
+			},
+			RunDetails: &RunDetails{
+				Builder:  &Builder{Id: goBuildType + "@" + bi.GoVersion},
+				Metadata: &BuildMetadata{FinishedOn: finishedOn},
+			},
+		},
+	}
+}
+
+// digestExecutable returns the hex encoded SHA256 checksum of the running
+// binary.
+func digestExecutable() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("error finding binary: %w", err)
+	}
+	if exe, err = filepath.EvalSymlinks(exe); err != nil {
+		return "", fmt.Errorf("error resolving binary: %w", err)
+	}
+	return DigestFile(exe)
+}
+
+// DigestFile returns the hex encoded SHA256 checksum of the file at path.
+func DigestFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("error opening file: %w", err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("error reading file: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
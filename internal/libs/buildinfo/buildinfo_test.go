@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package buildinfo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"testing"
+
+	"github.com/hashicorp/boundary/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBuildInfo() *debug.BuildInfo {
+	return &debug.BuildInfo{
+		GoVersion: "go1.23.1",
+		Path:      "github.com/hashicorp/boundary/cmd/boundary",
+		Main:      debug.Module{Path: "github.com/hashicorp/boundary", Version: "(devel)"},
+		Deps: []*debug.Module{
+			{Path: "google.golang.org/grpc", Version: "v1.66.0", Sum: "h1:grpcsum="},
+			{Path: "github.com/hashicorp/boundary/api", Version: "v0.0.52", Replace: &debug.Module{Path: "./api"}},
+		},
+		Settings: []debug.BuildSetting{
+			{Key: "GOOS", Value: "linux"},
+			{Key: "GOARCH", Value: "amd64"},
+			{Key: "vcs.revision", Value: "0123456789abcdef"},
+			{Key: "vcs.time", Value: "2024-09-01T12:00:00Z"},
+		},
+	}
+}
+
+func TestNewBom(t *testing.T) {
+	bom := newBom(testBuildInfo(), &version.Info{Version: "0.19.0", BuildDate: "2024-09-02T00:00:00Z"})
+	assert.Equal(t, "CycloneDX", bom.BomFormat)
+	assert.Equal(t, cycloneDxSpecVersion, bom.SpecVersion)
+	assert.Equal(t, "2024-09-02T00:00:00Z", bom.Metadata.Timestamp)
+	assert.Equal(t, &Component{
+		Type:    "application",
+		BomRef:  "pkg:golang/github.com/hashicorp/boundary@0.19.0",
+		Name:    "github.com/hashicorp/boundary",
+		Version: "0.19.0",
+		Purl:    "pkg:golang/github.com/hashicorp/boundary@0.19.0",
+	}, bom.Metadata.Component)
+
+	// Components are sorted, and replaced modules are described by their
+	// replacement
+	assert.Equal(t, []*Component{
+		{
+			Type:       "library",
+			Name:       "./api",
+			Properties: []*Property{{Name: "golang:replaces", Value: "github.com/hashicorp/boundary/api v0.0.52"}},
+		},
+		{
+			Type:       "library",
+			BomRef:     "pkg:golang/google.golang.org/grpc@v1.66.0",
+			Name:       "google.golang.org/grpc",
+			Version:    "v1.66.0",
+			Purl:       "pkg:golang/google.golang.org/grpc@v1.66.0",
+			Properties: []*Property{{Name: "golang:sum", Value: "h1:grpcsum="}},
+		},
+	}, bom.Components)
+}
+
+func TestNewStatement(t *testing.T) {
+	t.Run("revision from build settings", func(t *testing.T) {
+		s := newStatement(testBuildInfo(), &version.Info{Version: "0.19.0"}, "boundary", "abcd")
+		assert.Equal(t, StatementType, s.Type)
+		assert.Equal(t, ProvenancePredicateType, s.PredicateType)
+		assert.Equal(t, []*Subject{{Name: "boundary", Digest: map[string]string{"sha256": "abcd"}}}, s.Subject)
+
+		bd := s.Predicate.BuildDefinition
+		assert.Equal(t, goBuildType, bd.BuildType)
+		assert.Equal(t, "0.19.0", bd.ExternalParameters["version"])
+		assert.Equal(t, "linux", bd.ExternalParameters["settings"].(map[string]string)["GOOS"])
+		assert.Equal(t, "go1.23.1", bd.InternalParameters["goVersion"])
+		assert.Equal(t, []*ResourceDescriptor{{
+			Uri:    "git+https://github.com/hashicorp/boundary",
+			Digest: map[string]string{"gitCommit": "0123456789abcdef"},
+		}}, bd.ResolvedDependencies)
+		assert.Equal(t, "https://go.dev/cmd/go@go1.23.1", s.Predicate.RunDetails.Builder.Id)
+		assert.Equal(t, "2024-09-01T12:00:00Z", s.Predicate.RunDetails.Metadata.FinishedOn)
+	})
+	t.Run("revision and date from version", func(t *testing.T) {
+		s := newStatement(testBuildInfo(), &version.Info{Version: "0.19.0", Revision: "fedcba", BuildDate: "2024-09-02T00:00:00Z"}, "boundary", "abcd")
+		assert.Equal(t, "fedcba", s.Predicate.BuildDefinition.ResolvedDependencies[0].Digest["gitCommit"])
+		assert.Equal(t, "2024-09-02T00:00:00Z", s.Predicate.RunDetails.Metadata.FinishedOn)
+	})
+	t.Run("no revision", func(t *testing.T) {
+		bi := testBuildInfo()
+		bi.Settings = nil
+		s := newStatement(bi, &version.Info{Version: "0.19.0"}, "boundary", "abcd")
+		assert.Empty(t, s.Predicate.BuildDefinition.ResolvedDependencies)
+	})
+}
+
+func TestProvenance(t *testing.T) {
+	s, err := Provenance()
+	require.NoError(t, err)
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	want, err := DigestFile(exe)
+	require.NoError(t, err)
+	require.Len(t, s.Subject, 1)
+	assert.Equal(t, filepath.Base(exe), s.Subject[0].Name)
+	assert.Equal(t, want, s.Subject[0].Digest["sha256"])
+
+	bom, err := Sbom()
+	require.NoError(t, err)
+	assert.NotEmpty(t, bom.Components)
+}
+
+func TestDigestFile(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "boundary")
+	require.NoError(t, os.WriteFile(p, []byte("boundary"), 0o755))
+	sum := sha256.Sum256([]byte("boundary"))
+	got, err := DigestFile(p)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(sum[:]), got)
+
+	_, err = DigestFile(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
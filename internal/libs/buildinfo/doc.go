@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package buildinfo
+
+// This package describes the running Boundary binary for audits: its software
+// bill of materials (SBOM) and its build provenance.
+//
+// Both are derived from the build information the Go toolchain embeds in every
+// binary, plus the version information set at link time. The SBOM is a
+// CycloneDX 1.5 JSON document listing the Go modules compiled into the binary.
+// The provenance is an in-toto v1 statement with a SLSA v1 provenance
+// predicate, whose subject is the SHA256 checksum of the binary on disk, so it
+// can be compared with the checksums of released artifacts.
+//
+// Neither document is signed: they report what the binary says about itself.
+// They are useful to confirm which build and dependencies are deployed, but
+// are not a substitute for verifying the signatures of release artifacts.
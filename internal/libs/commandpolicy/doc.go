@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandpolicy
+
+// This package contains the policy restricting the commands SSH exec requests
+// may run in the sessions to a target.
+//
+// A policy consists of the allowed and denied command patterns of the target.
+// A pattern is a glob where "*" matches any characters, such as
+// "systemctl status *", or a regular expression when prefixed with "re:", such
+// as "re:^journalctl -u [a-z-]+$"; both must match the whole command. Denied
+// commands take precedence over allowed commands and, if any allowed commands
+// are set, all other commands are denied. Creating a policy validates the
+// patterns, so calling New and ignoring the returned policy is a fine way to
+// validate incoming patterns.
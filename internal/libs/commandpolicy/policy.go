@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandpolicy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ryanuber/go-glob"
+)
+
+// regexPrefix marks a pattern as a regular expression.
+const regexPrefix = "re:"
+
+// Policy restricts the commands that may be run. The zero value allows all
+// commands.
+type Policy struct {
+	allowed []pattern
+	denied  []pattern
+}
+
+type pattern struct {
+	raw string
+	// re is nil for glob patterns.
+	re *regexp.Regexp
+}
+
+func (p pattern) match(command string) bool {
+	if p.re != nil {
+		return p.re.MatchString(command)
+	}
+	return glob.Glob(p.raw, command)
+}
+
+// Decision is the result of checking a command against a policy.
+type Decision struct {
+	// Allowed reports whether the command may be run.
+	Allowed bool
+
+	// Pattern is the pattern that decided, either the denied pattern or the
+	// allowed pattern the command matched. It is empty if the command was
+	// allowed because the policy has no allowed patterns, or denied because
+	// it matched none of them.
+	Pattern string
+
+	// Reason describes why the command was denied.
+	Reason string
+}
+
+// New returns the policy for the allowed and denied command patterns. An error
+// is returned if any pattern is empty or is an invalid regular expression.
+func New(allowed, denied []string) (*Policy, error) {
+	p := &Policy{}
+	var err error
+	if p.allowed, err = parsePatterns(allowed); err != nil {
+		return nil, fmt.Errorf("invalid allowed command: %w", err)
+	}
+	if p.denied, err = parsePatterns(denied); err != nil {
+		return nil, fmt.Errorf("invalid denied command: %w", err)
+	}
+	return p, nil
+}
+
+func parsePatterns(in []string) ([]pattern, error) {
+	ret := make([]pattern, 0, len(in))
+	for _, raw := range in {
+		if strings.TrimSpace(raw) == "" {
+			return nil, fmt.Errorf("empty pattern")
+		}
+		p := pattern{raw: raw}
+		if expr, ok := strings.CutPrefix(raw, regexPrefix); ok {
+			if expr == "" {
+				return nil, fmt.Errorf("empty regular expression in %q", raw)
+			}
+			// Anchor the expression so that it must match the whole command,
+			// like globs do
+			re, err := regexp.Compile(`^(?:` + expr + `)$`)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression %q: %w", raw, err)
+			}
+			p.re = re
+		}
+		ret = append(ret, p)
+	}
+	return ret, nil
+}
+
+// Restricted reports whether the policy has any allowed patterns, in which
+// case requests whose commands cannot be checked, such as interactive shells
+// and subsystems, must be denied.
+func (p *Policy) Restricted() bool {
+	return p != nil && len(p.allowed) > 0
+}
+
+// Check checks whether the command may be run. A nil policy allows all
+// commands.
+func (p *Policy) Check(command string) Decision {
+	if p == nil {
+		return Decision{Allowed: true}
+	}
+	for _, d := range p.denied {
+		if d.match(command) {
+			return Decision{Pattern: d.raw, Reason: "command matches a denied command"}
+		}
+	}
+	if len(p.allowed) == 0 {
+		return Decision{Allowed: true}
+	}
+	for _, a := range p.allowed {
+		if a.match(command) {
+			return Decision{Allowed: true, Pattern: a.raw}
+		}
+	}
+	return Decision{Reason: "command matches no allowed command"}
+}
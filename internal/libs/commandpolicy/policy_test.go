@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package commandpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		allowed []string
+		denied  []string
+		wantErr string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:    "valid",
+			allowed: []string{"systemctl status *", "re:journalctl -u [a-z-]+"},
+			denied:  []string{"* --force*"},
+		},
+		{
+			name:    "empty allowed pattern",
+			allowed: []string{" "},
+			wantErr: "invalid allowed command: empty pattern",
+		},
+		{
+			name:    "empty regular expression",
+			denied:  []string{"re:"},
+			wantErr: `invalid denied command: empty regular expression in "re:"`,
+		},
+		{
+			name:    "invalid regular expression",
+			denied:  []string{"re:rm (-rf"},
+			wantErr: `invalid denied command: invalid regular expression "re:rm (-rf"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(tt.allowed, tt.denied)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, p)
+		})
+	}
+}
+
+func TestPolicy_Check(t *testing.T) {
+	t.Parallel()
+
+	p, err := New(
+		[]string{"systemctl status *", "re:journalctl -u [a-z-]+", "uptime"},
+		[]string{"* --force*", "re:.*[;&|`].*"},
+	)
+	require.NoError(t, err)
+	assert.True(t, p.Restricted())
+
+	tests := []struct {
+		command     string
+		wantAllowed bool
+		wantPattern string
+	}{
+		{command: "uptime", wantAllowed: true, wantPattern: "uptime"},
+		{command: "systemctl status nginx", wantAllowed: true, wantPattern: "systemctl status *"},
+		{command: "journalctl -u nginx", wantAllowed: true, wantPattern: "re:journalctl -u [a-z-]+"},
+		// Regular expressions must match the whole command
+		{command: "journalctl -u nginx -f"},
+		{command: "uptime -p"},
+		{command: "systemctl restart nginx"},
+		{command: "systemctl status nginx --force", wantPattern: "* --force*"},
+		{command: "uptime; rm -rf /", wantPattern: "re:.*[;&|`].*"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			d := p.Check(tt.command)
+			assert.Equal(t, tt.wantAllowed, d.Allowed)
+			assert.Equal(t, tt.wantPattern, d.Pattern)
+			if !tt.wantAllowed {
+				assert.NotEmpty(t, d.Reason)
+			}
+		})
+	}
+
+	t.Run("denylist only", func(t *testing.T) {
+		p, err := New(nil, []string{"rm *"})
+		require.NoError(t, err)
+		assert.False(t, p.Restricted())
+		assert.True(t, p.Check("ls -la").Allowed)
+		assert.False(t, p.Check("rm -rf /tmp").Allowed)
+	})
+
+	t.Run("nil policy", func(t *testing.T) {
+		var p *Policy
+		assert.False(t, p.Restricted())
+		assert.True(t, p.Check("rm -rf /").Allowed)
+	})
+}
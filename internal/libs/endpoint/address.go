@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package endpoint
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// AddressType labels the kind of an address discovered for a host.
+type AddressType string
+
+const (
+	// PrivateIp is an IP address in one of the private ranges of RFC 1918
+	// (IPv4) or RFC 4193 (IPv6).
+	PrivateIp AddressType = "private_ip"
+	// PublicIp is any other IP address.
+	PublicIp AddressType = "public_ip"
+	// DnsName is a DNS name.
+	DnsName AddressType = "dns"
+)
+
+// Address is an address of a host together with its type.
+type Address struct {
+	Value string
+	Type  AddressType
+}
+
+// String returns the value of the address.
+func (a Address) String() string {
+	return a.Value
+}
+
+// ClassifyAddress returns the address labeled with its type. Anything that
+// does not parse as an IP address is considered a DNS name.
+func ClassifyAddress(addr string) Address {
+	ip := net.ParseIP(addr)
+	switch {
+	case ip == nil:
+		return Address{Value: addr, Type: DnsName}
+	case ip.IsPrivate():
+		return Address{Value: addr, Type: PrivateIp}
+	default:
+		return Address{Value: addr, Type: PublicIp}
+	}
+}
+
+// Classify returns all of the IP addresses and DNS names labeled with their
+// type, IP addresses first, keeping the order in which they were given.
+func Classify(ipAddrs, dnsNames []string) []Address {
+	ret := make([]Address, 0, len(ipAddrs)+len(dnsNames))
+	for _, a := range ipAddrs {
+		ret = append(ret, ClassifyAddress(a))
+	}
+	for _, n := range dnsNames {
+		ret = append(ret, Address{Value: n, Type: DnsName})
+	}
+	return ret
+}
+
+// ParsePreferenceExpression splits a preferred endpoint expression into the
+// preference order it describes. An expression is a comma separated list of
+// preferences, such as "cidr:10.0.0.0/8,type:private_ip,any", where each
+// preference is a fallback for the ones before it. The preferences are
+// validated.
+func ParsePreferenceExpression(expr string) ([]string, error) {
+	var ret []string
+	for _, p := range strings.Split(expr, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			return nil, fmt.Errorf("empty preference in expression %q", expr)
+		}
+		ret = append(ret, p)
+	}
+	if err := WithPreferenceOrder(ret)(&options{}); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package endpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	got := Classify(
+		[]string{"10.0.0.1", "54.1.2.3", "fd00::1", "2001:db8::1"},
+		[]string{"host.example.com"},
+	)
+	assert.Equal(t, []Address{
+		{Value: "10.0.0.1", Type: PrivateIp},
+		{Value: "54.1.2.3", Type: PublicIp},
+		{Value: "fd00::1", Type: PrivateIp},
+		{Value: "2001:db8::1", Type: PublicIp},
+		{Value: "host.example.com", Type: DnsName},
+	}, got)
+
+	assert.Equal(t, Address{Value: "db.internal", Type: DnsName}, ClassifyAddress("db.internal"))
+	assert.Equal(t, Address{Value: "192.168.1.1", Type: PrivateIp}, ClassifyAddress("192.168.1.1"))
+}
+
+func TestParsePreferenceExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "single",
+			expr: "type:private_ip",
+			want: []string{"type:private_ip"},
+		},
+		{
+			name: "fallbacks",
+			expr: "cidr:10.0.0.0/8, dns:*.internal ,type:public_ip,any",
+			want: []string{"cidr:10.0.0.0/8", "dns:*.internal", "type:public_ip", "any"},
+		},
+		{
+			name:    "empty",
+			expr:    "",
+			wantErr: true,
+		},
+		{
+			name:    "empty fallback",
+			expr:    "type:dns,",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported",
+			expr:    "type:dns,host:foo",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePreferenceExpression(tt.expr)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
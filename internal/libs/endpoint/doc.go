@@ -5,9 +5,12 @@ package endpoint
 
 // This package contains enpoint-related libraries.
 //
-// Currently, this consists only of a preference chooser that, given inputs of
-// IP addresses/DNS names and a user-defined preference string, can select the
-// most preferred endpoint to use. If no user-defined preference string is
+// It consists of a preference chooser that, given inputs of IP addresses/DNS
+// names and a user-defined preference string, can select the most preferred
+// endpoint to use, and of helpers labeling addresses with their type (private
+// IP, public IP or DNS name), as well as parsers for sets of ports and port
+// ranges (e.g. "5432,8000-8100") and of server name patterns (e.g.
+// "*.db.example.com"). If no user-defined preference string is
 // supplied, an endpoint is selected at random. Creating a preferencer will
 // validate input, so calling NewPreferencer and ignoring the returned struct is
 // a fine way to validate incoming preference order statements.
@@ -17,6 +17,8 @@ type matcher interface {
 var (
 	_ matcher = (*dnsMatcher)(nil)
 	_ matcher = (*cidrMatcher)(nil)
+	_ matcher = (*typeMatcher)(nil)
+	_ matcher = (*anyMatcher)(nil)
 )
 
 // DnsMatcher is a function that given an input returns true if there is a
@@ -49,3 +51,23 @@ func (m cidrMatcher) Match(in string) bool {
 	}
 	return m.ipNet.Contains(ip)
 }
+
+// typeMatcher is a function that given an input returns true if the input is
+// an address of the given type
+type typeMatcher struct {
+	addrType AddressType
+}
+
+// Match satisfies the matcher interface
+func (m typeMatcher) Match(in string) bool {
+	return ClassifyAddress(in).Type == m.addrType
+}
+
+// anyMatcher matches any input. When used as a preference it falls back to the
+// default selection among all addresses.
+type anyMatcher struct{}
+
+// Match satisfies the matcher interface
+func (m anyMatcher) Match(string) bool {
+	return true
+}
@@ -52,6 +52,26 @@ func WithIpAddrs(with []string) Option {
 	}
 }
 
+// WithAddresses contains labeled addresses to add into the endpoint
+// possibilities. DNS names are added as with WithDnsNames and all other
+// addresses as with WithIpAddrs.
+func WithAddresses(with []Address) Option {
+	return func(o *options) error {
+		for _, addr := range with {
+			switch addr.Type {
+			case DnsName:
+				o.withDnsNames = append(o.withDnsNames, addr.Value)
+			default:
+				if net.ParseIP(addr.Value) == nil {
+					return fmt.Errorf("input '%s' is not parseable as an ip address", addr.Value)
+				}
+				o.withIpAddrs = append(o.withIpAddrs, addr.Value)
+			}
+		}
+		return nil
+	}
+}
+
 // WithDnsNames contains DNS names to add into the endpoint possibilities
 func WithDnsNames(with []string) Option {
 	return func(o *options) error {
@@ -97,6 +117,19 @@ func WithPreferenceOrder(with []string) Option {
 					pattern: pattern,
 				}
 
+			case strings.HasPrefix(input, "type:"):
+				switch t := AddressType(strings.TrimPrefix(input, "type:")); t {
+				case PrivateIp, PublicIp, DnsName:
+					m = typeMatcher{
+						addrType: t,
+					}
+				default:
+					return fmt.Errorf("unknown address type %q, must be one of %q, %q or %q", t, PrivateIp, PublicIp, DnsName)
+				}
+
+			case input == "any":
+				m = anyMatcher{}
+
 			default:
 				return fmt.Errorf("preference string %q is not supported", input)
 			}
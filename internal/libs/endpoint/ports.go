@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package endpoint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PortRange is an inclusive range of ports. A single port is a range whose
+// start and end are the same.
+type PortRange struct {
+	Start uint32
+	End   uint32
+}
+
+// String returns the range as "start-end", or as the port alone if the range
+// contains a single port.
+func (r PortRange) String() string {
+	if r.Start == r.End {
+		return strconv.FormatUint(uint64(r.Start), 10)
+	}
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+// PortSet is a set of ports made of ranges, in the order they were given.
+type PortSet []PortRange
+
+// ParsePorts parses a comma separated list of ports and inclusive port
+// ranges, such as "5432,6432" or "8000-8100". Ports must be between 1 and
+// 65535 and ranges must not be reversed. An empty string is an empty set.
+func ParsePorts(s string) (PortSet, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var ret PortSet
+	for _, p := range strings.Split(s, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			return nil, fmt.Errorf("empty port in %q", s)
+		}
+		start, end, isRange := strings.Cut(p, "-")
+		if !isRange {
+			end = start
+		}
+		r := PortRange{}
+		var err error
+		if r.Start, err = parsePort(start); err != nil {
+			return nil, err
+		}
+		if r.End, err = parsePort(end); err != nil {
+			return nil, err
+		}
+		if r.End < r.Start {
+			return nil, fmt.Errorf("port range %q ends before it starts", p)
+		}
+		ret = append(ret, r)
+	}
+	return ret, nil
+}
+
+func parsePort(s string) (uint32, error) {
+	s = strings.TrimSpace(s)
+	p, err := strconv.ParseUint(s, 10, 16)
+	if err != nil || p == 0 {
+		return 0, fmt.Errorf("invalid port %q", s)
+	}
+	return uint32(p), nil
+}
+
+// Contains reports whether the port is in the set.
+func (s PortSet) Contains(port uint32) bool {
+	for _, r := range s {
+		if port >= r.Start && port <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+// First returns the first port of the set, or 0 if the set is empty.
+func (s PortSet) First() uint32 {
+	if len(s) == 0 {
+		return 0
+	}
+	return s[0].Start
+}
+
+// String returns the set in the form accepted by ParsePorts.
+func (s PortSet) String() string {
+	ranges := make([]string, 0, len(s))
+	for _, r := range s {
+		ranges = append(ranges, r.String())
+	}
+	return strings.Join(ranges, ",")
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package endpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePorts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		want    PortSet
+		wantStr string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			in:   " ",
+		},
+		{
+			name:    "single",
+			in:      "5432",
+			want:    PortSet{{Start: 5432, End: 5432}},
+			wantStr: "5432",
+		},
+		{
+			name:    "list",
+			in:      "5432, 6432",
+			want:    PortSet{{Start: 5432, End: 5432}, {Start: 6432, End: 6432}},
+			wantStr: "5432,6432",
+		},
+		{
+			name:    "range",
+			in:      "22,8000-8100",
+			want:    PortSet{{Start: 22, End: 22}, {Start: 8000, End: 8100}},
+			wantStr: "22,8000-8100",
+		},
+		{
+			name:    "empty entry",
+			in:      "22,,80",
+			wantErr: true,
+		},
+		{
+			name:    "zero",
+			in:      "0",
+			wantErr: true,
+		},
+		{
+			name:    "too large",
+			in:      "65536",
+			wantErr: true,
+		},
+		{
+			name:    "reversed range",
+			in:      "8100-8000",
+			wantErr: true,
+		},
+		{
+			name:    "open range",
+			in:      "8000-",
+			wantErr: true,
+		},
+		{
+			name:    "not a number",
+			in:      "postgres",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePorts(tt.in)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantStr, got.String())
+		})
+	}
+}
+
+func TestPortSet_Contains(t *testing.T) {
+	t.Parallel()
+
+	s, err := ParsePorts("5432,8000-8100")
+	require.NoError(t, err)
+	assert.True(t, s.Contains(5432))
+	assert.True(t, s.Contains(8000))
+	assert.True(t, s.Contains(8050))
+	assert.True(t, s.Contains(8100))
+	assert.False(t, s.Contains(6432))
+	assert.False(t, s.Contains(8101))
+	assert.Equal(t, uint32(5432), s.First())
+
+	assert.False(t, PortSet(nil).Contains(5432))
+	assert.Equal(t, uint32(0), PortSet(nil).First())
+}
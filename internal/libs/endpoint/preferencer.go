@@ -33,10 +33,12 @@ func NewPreferencer(ctx context.Context, opt ...Option) (*preferencer, error) {
 
 // Choose takes in IP addresses and/or DNS names and chooses an endpoint from
 // among them, picking one at random if there are no preferences supplied. If
-// preferences are specified but none match, the empty string is returned.
+// preferences are specified, the first preference that matches any of the
+// inputs decides the endpoint; later preferences are fallbacks for earlier
+// ones. If none match, the empty string is returned.
 // However, if no IP addresses or DNS names are supplied, an error is returned.
 //
-// Supported options: WithIpAddrs, WithDnsNames
+// Supported options: WithIpAddrs, WithDnsNames, WithAddresses
 func (p *preferencer) Choose(ctx context.Context, opt ...Option) (string, error) {
 	const op = "endpoint.(preferencer).Choose"
 	opts, err := getOpts(opt...)
@@ -47,74 +49,88 @@ func (p *preferencer) Choose(ctx context.Context, opt ...Option) (string, error)
 		return "", errors.New(ctx, errors.InvalidParameter, op, "no ip addresses or dns names passed in")
 	}
 
-	switch len(p.matchers) {
-	case 0:
-		// We have no matchers, so pick a private address if we have one (since
-		// those are most likely to be what the user is trying to gain access to
-		// via a worker). If we don't have one, pick any IP address, as that's
-		// also more likely desired than a DNS name. Otherwise, pick a DNS name.
-		// IPv6 follows same rules as 4, but least preferenced.
-		nonPrivateIp4s := make([]string, 0, len(opts.withIpAddrs))
-		nonPrivateIp6s := make([]string, 0, len(opts.withIpAddrs))
-		privateIp6s := make([]string, 0, len(opts.withIpAddrs))
-
-		for _, ipStr := range opts.withIpAddrs {
-			ipVal := net.ParseIP(ipStr)
-			if ipVal != nil {
-				switch ipVal.To4() {
-				case nil: // it's v6
-					if ipVal.IsPrivate() {
-						privateIp6s = append(privateIp6s, ipStr)
-					} else {
-						nonPrivateIp6s = append(nonPrivateIp6s, ipStr)
-					}
-				default:
-					if ipVal.IsPrivate() {
-						// private IPv4 is most highly preferenced, so return it directly
-						return ipStr, nil
-					}
-					nonPrivateIp4s = append(nonPrivateIp4s, ipStr)
+	if len(p.matchers) == 0 {
+		return chooseDefault(opts), nil
+	}
+	for _, m := range p.matchers {
+		switch m := m.(type) {
+		case anyMatcher:
+			return chooseDefault(opts), nil
+		case dnsMatcher:
+			for _, name := range opts.withDnsNames {
+				if m.Match(name) {
+					return name, nil
+				}
+			}
+		case cidrMatcher:
+			for _, addr := range opts.withIpAddrs {
+				if m.Match(addr) {
+					return addr, nil
+				}
+			}
+		case typeMatcher:
+			candidates := opts.withIpAddrs
+			if m.addrType == DnsName {
+				candidates = opts.withDnsNames
+			}
+			for _, addr := range candidates {
+				if m.Match(addr) {
+					return addr, nil
 				}
 			}
 		}
+	}
+	// Nothing matched. Don't treat it as an error, let the calling function
+	// simply ignore the empty result.
+	return "", nil
+}
+
+// chooseDefault picks an endpoint when there are no preferences. It picks a
+// private address if there is one (since those are most likely to be what the
+// user is trying to gain access to via a worker). If there isn't one, it picks
+// any IP address, as that's also more likely desired than a DNS name.
+// Otherwise, it picks a DNS name. IPv6 follows same rules as 4, but least
+// preferenced.
+func chooseDefault(opts options) string {
+	nonPrivateIp4s := make([]string, 0, len(opts.withIpAddrs))
+	nonPrivateIp6s := make([]string, 0, len(opts.withIpAddrs))
+	privateIp6s := make([]string, 0, len(opts.withIpAddrs))
 
-		switch {
-		case len(nonPrivateIp4s) > 0:
-			return nonPrivateIp4s[0], nil
+	for _, ipStr := range opts.withIpAddrs {
+		ipVal := net.ParseIP(ipStr)
+		if ipVal != nil {
+			switch ipVal.To4() {
+			case nil: // it's v6
+				if ipVal.IsPrivate() {
+					privateIp6s = append(privateIp6s, ipStr)
+				} else {
+					nonPrivateIp6s = append(nonPrivateIp6s, ipStr)
+				}
+			default:
+				if ipVal.IsPrivate() {
+					// private IPv4 is most highly preferenced, so return it directly
+					return ipStr
+				}
+				nonPrivateIp4s = append(nonPrivateIp4s, ipStr)
+			}
+		}
+	}
 
-		case len(opts.withDnsNames) > 0:
-			return opts.withDnsNames[0], nil
+	switch {
+	case len(nonPrivateIp4s) > 0:
+		return nonPrivateIp4s[0]
 
-		case len(privateIp6s) > 0:
-			return privateIp6s[0], nil
+	case len(opts.withDnsNames) > 0:
+		return opts.withDnsNames[0]
 
-		case len(nonPrivateIp6s) > 0:
-			return nonPrivateIp6s[0], nil
+	case len(privateIp6s) > 0:
+		return privateIp6s[0]
 
-		default:
-			// We literally have nothing if we get here, so return nothing
-			return "", nil
-		}
+	case len(nonPrivateIp6s) > 0:
+		return nonPrivateIp6s[0]
 
 	default:
-		for _, m := range p.matchers {
-			switch m.(type) {
-			case dnsMatcher:
-				for _, name := range opts.withDnsNames {
-					if m.Match(name) {
-						return name, nil
-					}
-				}
-			case cidrMatcher:
-				for _, addr := range opts.withIpAddrs {
-					if m.Match(addr) {
-						return addr, nil
-					}
-				}
-			}
-		}
-		// Nothing matched. Don't treat it as an error, let the calling function
-		// simply ignore the empty result.
-		return "", nil
+		// We literally have nothing if we get here, so return nothing
+		return ""
 	}
 }
@@ -152,4 +152,61 @@ func TestPreferencer(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, exp, out)
 	})
+	t.Run("typePreferences", func(t *testing.T) {
+		p, err := NewPreferencer(ctx,
+			WithPreferenceOrder([]string{
+				"cidr:10.1.0.0/16",
+				"type:public_ip",
+				"type:dns",
+			}))
+		require.NoError(t, err)
+
+		cases := []struct {
+			name             string
+			withAddresses    []Address
+			expectedEndpoint string
+		}{
+			{
+				name:             "cidr first",
+				withAddresses:    Classify([]string{"54.1.2.3", "10.1.2.3"}, []string{"host.example.com"}),
+				expectedEndpoint: "10.1.2.3",
+			},
+			{
+				name:             "public ip fallback",
+				withAddresses:    Classify([]string{"10.2.2.3", "54.1.2.3"}, []string{"host.example.com"}),
+				expectedEndpoint: "54.1.2.3",
+			},
+			{
+				name:             "dns fallback",
+				withAddresses:    Classify([]string{"10.2.2.3"}, []string{"host.example.com"}),
+				expectedEndpoint: "host.example.com",
+			},
+			{
+				name:          "no match",
+				withAddresses: Classify([]string{"10.2.2.3"}, nil),
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				out, err := p.Choose(ctx, WithAddresses(tc.withAddresses))
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedEndpoint, out)
+			})
+		}
+	})
+	t.Run("anyFallback", func(t *testing.T) {
+		p, err := NewPreferencer(ctx, WithPreferenceOrder([]string{"dns:*.internal", "any"}))
+		require.NoError(t, err)
+		out, err := p.Choose(
+			ctx,
+			WithIpAddrs([]string{"48.134.5.1", "192.168.4.3"}),
+			WithDnsNames([]string{"foo.bar.com"}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "192.168.4.3", out)
+	})
+	t.Run("badType", func(t *testing.T) {
+		_, err := NewPreferencer(ctx, WithPreferenceOrder([]string{"type:ipv4"}))
+		assert.Error(t, err)
+	})
 }
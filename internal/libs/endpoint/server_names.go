@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package endpoint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ryanuber/go-glob"
+)
+
+// ServerNames is a set of server name patterns, in the order they were given.
+// A pattern is a DNS name which may contain "*" wildcards, each matching any
+// number of characters, such as "*.db.example.com".
+type ServerNames []string
+
+// ParseServerNames parses a comma separated list of server name patterns,
+// such as "db.example.com,*.db.example.com". Patterns are case insensitive and
+// may only contain letters, digits, hyphens, dots and wildcards. An empty
+// string is an empty set.
+func ParseServerNames(s string) (ServerNames, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var ret ServerNames
+	for _, n := range strings.Split(s, ",") {
+		n = strings.ToLower(strings.TrimSpace(n))
+		if n == "" {
+			return nil, fmt.Errorf("empty server name in %q", s)
+		}
+		if !validServerName(n, true) {
+			return nil, fmt.Errorf("invalid server name pattern %q", n)
+		}
+		ret = append(ret, n)
+	}
+	return ret, nil
+}
+
+// Match reports whether the server name matches any pattern of the set. Names
+// which are not valid DNS names never match.
+func (s ServerNames) Match(name string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if !validServerName(name, false) {
+		return false
+	}
+	for _, p := range s {
+		if glob.Glob(p, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the set in the form accepted by ParseServerNames.
+func (s ServerNames) String() string {
+	return strings.Join(s, ",")
+}
+
+// validServerName reports whether the lower case name only contains letters,
+// digits, hyphens and dots, and wildcards if they are allowed.
+func validServerName(name string, wildcards bool) bool {
+	if name == "" || len(name) > 253 {
+		return false
+	}
+	for _, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '-', c == '.':
+		case c == '*' && wildcards:
+		default:
+			return false
+		}
+	}
+	return true
+}
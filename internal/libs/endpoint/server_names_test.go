@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package endpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServerNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		want    ServerNames
+		wantStr string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			in:   " ",
+		},
+		{
+			name:    "single",
+			in:      "db.example.com",
+			want:    ServerNames{"db.example.com"},
+			wantStr: "db.example.com",
+		},
+		{
+			name:    "list",
+			in:      "DB.example.com, *.db.example.com",
+			want:    ServerNames{"db.example.com", "*.db.example.com"},
+			wantStr: "db.example.com,*.db.example.com",
+		},
+		{
+			name:    "empty entry",
+			in:      "db.example.com,,*.example.com",
+			wantErr: true,
+		},
+		{
+			name:    "port",
+			in:      "db.example.com:5432",
+			wantErr: true,
+		},
+		{
+			name:    "space",
+			in:      "db example.com",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseServerNames(tt.in)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantStr, got.String())
+		})
+	}
+}
+
+func TestServerNames_Match(t *testing.T) {
+	t.Parallel()
+
+	s, err := ParseServerNames("db.example.com,*.db.example.com")
+	require.NoError(t, err)
+	assert.True(t, s.Match("db.example.com"))
+	assert.True(t, s.Match("DB.Example.com."))
+	assert.True(t, s.Match("eu.db.example.com"))
+	assert.False(t, s.Match("example.com"))
+	assert.False(t, s.Match("db.example.com.evil.com"))
+	assert.False(t, s.Match("eu.db.example.com:5432"))
+	assert.False(t, s.Match(""))
+
+	assert.False(t, ServerNames(nil).Match("db.example.com"))
+}
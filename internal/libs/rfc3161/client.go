@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rfc3161
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+)
+
+const (
+	requestContentType  = "application/timestamp-query"
+	responseContentType = "application/timestamp-reply"
+
+	// maxResponseSize limits how much of a response is read from a TSA.
+	maxResponseSize = 1 << 20
+)
+
+// Request requests a time-stamp token for the SHA-256 digest from the TSA at
+// url. It returns the DER encoded token after checking that it was issued
+// for the digest and the nonce of the request. The signature of the token is
+// not verified; use Parse and Token.Verify for that.
+func Request(ctx context.Context, client *http.Client, url string, digest []byte) ([]byte, error) {
+	const op = "rfc3161.Request"
+	if len(digest) != sha256.Size {
+		return nil, fmt.Errorf("%s: digest is not a sha256 digest", op)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	nonce, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to generate nonce: %w", op, err)
+	}
+	req, err := asn1.Marshal(timeStampReq{
+		Version: 1,
+		MessageImprint: messageImprint{
+			HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue},
+			HashedMessage: digest,
+		},
+		Nonce:   nonce,
+		CertReq: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to encode request: %w", op, err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(req))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	httpReq.Header.Set("Content-Type", requestContentType)
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: unexpected status %q", op, resp.Status)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.HasPrefix(ct, responseContentType) {
+		return nil, fmt.Errorf("%s: unexpected content type %q", op, ct)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to read response: %w", op, err)
+	}
+
+	var tsResp timeStampResp
+	if _, err := asn1.Unmarshal(body, &tsResp); err != nil {
+		return nil, fmt.Errorf("%s: invalid response: %w", op, err)
+	}
+	switch tsResp.Status.Status {
+	case statusGranted, statusGrantedWithMods:
+	default:
+		return nil, fmt.Errorf("%s: request rejected with status %d: %s", op, tsResp.Status.Status, strings.Join(tsResp.Status.StatusString, "; "))
+	}
+	token := tsResp.TimeStampToken.FullBytes
+	if len(token) == 0 {
+		return nil, fmt.Errorf("%s: response does not contain a token", op)
+	}
+	t, err := Parse(token)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !bytes.Equal(t.HashedMessage, digest) {
+		return nil, fmt.Errorf("%s: token was not issued for the digest", op)
+	}
+	if t.Nonce == nil || t.Nonce.Cmp(nonce) != 0 {
+		return nil, fmt.Errorf("%s: token nonce does not match the request", op)
+	}
+	return token, nil
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package rfc3161 provides a minimal client for the Time-Stamp Protocol
+// defined in RFC 3161. It requests time-stamp tokens for SHA-256 digests from
+// a Time Stamping Authority (TSA) over HTTP and parses and verifies the
+// returned tokens.
+//
+// Only what is needed to anchor digests in time is supported: requests
+// always use SHA-256, and tokens are verified by checking the message
+// imprint, the signature of the TSA over the token and, optionally, the
+// certificate chain of the TSA.
+package rfc3161
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rfc3161
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequest(t *testing.T) {
+	ctx := context.Background()
+	digest := sha256.Sum256([]byte("event"))
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tsa := NewTestTSA(t)
+		srv := httptest.NewServer(tsa)
+		defer srv.Close()
+
+		der, err := Request(ctx, srv.Client(), srv.URL, digest[:])
+		require.NoError(err)
+		token, err := Parse(der)
+		require.NoError(err)
+		assert.Equal(digest[:], token.HashedMessage)
+		assert.NotNil(token.Nonce)
+		assert.NoError(token.Verify(digest[:], tsa.Roots()))
+	})
+	t.Run("rejected", func(t *testing.T) {
+		tsa := NewTestTSA(t)
+		tsa.Status = 2
+		srv := httptest.NewServer(tsa)
+		defer srv.Close()
+
+		_, err := Request(ctx, srv.Client(), srv.URL, digest[:])
+		assert.ErrorContains(t, err, "request rejected with status 2: rejected")
+	})
+	t.Run("nonce-mismatch", func(t *testing.T) {
+		tsa := NewTestTSA(t)
+		tsa.Nonce = big.NewInt(1)
+		srv := httptest.NewServer(tsa)
+		defer srv.Close()
+
+		_, err := Request(ctx, srv.Client(), srv.URL, digest[:])
+		assert.ErrorContains(t, err, "token nonce does not match the request")
+	})
+	t.Run("invalid-digest", func(t *testing.T) {
+		_, err := Request(ctx, nil, "http://127.0.0.1", []byte("short"))
+		assert.ErrorContains(t, err, "digest is not a sha256 digest")
+	})
+}
+
+func TestToken_Verify(t *testing.T) {
+	digest := sha256.Sum256([]byte("event"))
+	other := sha256.Sum256([]byte("other"))
+	tsa := NewTestTSA(t)
+
+	tests := []struct {
+		name    string
+		token   func() []byte
+		digest  []byte
+		roots   *x509.CertPool
+		wantErr string
+	}{
+		{
+			name:   "valid-without-roots",
+			token:  func() []byte { return tsa.Token(digest[:], nil) },
+			digest: digest[:],
+		},
+		{
+			name:   "valid-with-roots",
+			token:  func() []byte { return tsa.Token(digest[:], nil) },
+			digest: digest[:],
+			roots:  tsa.Roots(),
+		},
+		{
+			name:    "wrong-digest",
+			token:   func() []byte { return tsa.Token(digest[:], nil) },
+			digest:  other[:],
+			wantErr: "token was not issued for the digest",
+		},
+		{
+			name:    "untrusted-tsa",
+			token:   func() []byte { return tsa.Token(digest[:], nil) },
+			digest:  digest[:],
+			roots:   NewTestTSA(t).Roots(),
+			wantErr: "invalid tsa certificate",
+		},
+		{
+			name: "wrong-signer",
+			token: func() []byte {
+				// Sign with a different key, but claim to be the TSA.
+				forged := NewTestTSA(t)
+				forged.Cert = tsa.Cert
+				return forged.Token(digest[:], nil)
+			},
+			digest:  digest[:],
+			wantErr: "invalid signature",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			token, err := Parse(tt.token())
+			require.NoError(err)
+			err = token.Verify(tt.digest, tt.roots)
+			if tt.wantErr != "" {
+				assert.ErrorContains(err, tt.wantErr)
+				return
+			}
+			assert.NoError(err)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	digest := sha256.Sum256([]byte("event"))
+	tsa := NewTestTSA(t)
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		token, err := Parse(tsa.Token(digest[:], big.NewInt(42)))
+		require.NoError(err)
+		assert.Equal(digest[:], token.HashedMessage)
+		assert.Equal(big.NewInt(42), token.Nonce)
+		assert.Equal(asn1.ObjectIdentifier{1, 2, 3, 4}, token.Policy)
+		require.Len(token.Certificates, 1)
+		assert.True(tsa.Cert.Equal(token.Certificates[0]))
+		assert.False(token.GenTime.IsZero())
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := Parse([]byte("not a token"))
+		assert.ErrorContains(t, err, "invalid content info")
+	})
+	t.Run("trailing-data", func(t *testing.T) {
+		_, err := Parse(append(tsa.Token(digest[:], nil), 0))
+		assert.ErrorContains(t, err, "trailing data after content info")
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rfc3161
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"io"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestTSA is a minimal time-stamp authority which issues tokens in tests. It
+// can be served with httptest.
+type TestTSA struct {
+	t   testing.TB
+	key *ecdsa.PrivateKey
+
+	// Cert is the certificate of the TSA.
+	Cert *x509.Certificate
+
+	// Status is the status returned in responses.
+	Status int
+	// Nonce, if set, overrides the nonce of requests in issued tokens.
+	Nonce *big.Int
+}
+
+// NewTestTSA creates a TestTSA with a self-signed certificate.
+func NewTestTSA(t testing.TB) *TestTSA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test tsa"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &TestTSA{t: t, key: key, Cert: cert}
+}
+
+// Roots returns a pool containing the certificate of the TSA.
+func (a *TestTSA) Roots() *x509.CertPool {
+	p := x509.NewCertPool()
+	p.AddCert(a.Cert)
+	return p
+}
+
+// Token issues a DER encoded token for the SHA-256 digest and nonce.
+func (a *TestTSA) Token(digest []byte, nonce *big.Int) []byte {
+	a.t.Helper()
+	require := require.New(a.t)
+	sha256Algo := pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue}
+	info, err := asn1.Marshal(tstInfo{
+		Version:        1,
+		Policy:         asn1.ObjectIdentifier{1, 2, 3, 4},
+		MessageImprint: messageImprint{HashAlgorithm: sha256Algo, HashedMessage: digest},
+		SerialNumber:   big.NewInt(time.Now().UnixNano()),
+		GenTime:        time.Now().UTC().Truncate(time.Second),
+		Nonce:          nonce,
+	})
+	require.NoError(err)
+
+	infoSum := sha256.Sum256(info)
+	var attrs []byte
+	for _, attr := range []struct {
+		oid   asn1.ObjectIdentifier
+		value any
+	}{
+		{oidAttrContentType, oidTSTInfo},
+		{oidAttrMessageDigest, infoSum[:]},
+	} {
+		v, err := asn1.Marshal(attr.value)
+		require.NoError(err)
+		a, err := asn1.Marshal(attribute{
+			Type:   attr.oid,
+			Values: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: v},
+		})
+		require.NoError(err)
+		attrs = append(attrs, a...)
+	}
+	signed, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: attrs})
+	require.NoError(err)
+	signedSum := sha256.Sum256(signed)
+	sig, err := a.key.Sign(rand.Reader, signedSum[:], crypto.SHA256)
+	require.NoError(err)
+
+	sid, err := asn1.Marshal(issuerAndSerialNumber{
+		Issuer:       asn1.RawValue{FullBytes: a.Cert.RawIssuer},
+		SerialNumber: a.Cert.SerialNumber,
+	})
+	require.NoError(err)
+	sd, err := asn1.Marshal(signedData{
+		Version:          3,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{sha256Algo},
+		EncapContentInfo: encapContentInfo{EContentType: oidTSTInfo, EContent: info},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: a.Cert.Raw},
+		SignerInfos: []signerInfo{{
+			Version:            1,
+			Sid:                asn1.RawValue{FullBytes: sid},
+			DigestAlgorithm:    sha256Algo,
+			SignedAttrs:        asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: attrs},
+			SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidECDSAWithSHA256},
+			Signature:          sig,
+		}},
+	})
+	require.NoError(err)
+	token, err := asn1.Marshal(contentInfo{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: sd},
+	})
+	require.NoError(err)
+	return token
+}
+
+// ServeHTTP issues a token for the time-stamp request of r.
+func (a *TestTSA) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	require := require.New(a.t)
+	body, err := io.ReadAll(r.Body)
+	require.NoError(err)
+	var req timeStampReq
+	_, err = asn1.Unmarshal(body, &req)
+	require.NoError(err)
+
+	resp := timeStampResp{Status: pkiStatusInfo{Status: a.Status}}
+	if a.Status == statusGranted {
+		nonce := req.Nonce
+		if a.Nonce != nil {
+			nonce = a.Nonce
+		}
+		resp.TimeStampToken = asn1.RawValue{FullBytes: a.Token(req.MessageImprint.HashedMessage, nonce)}
+	} else {
+		resp.Status.StatusString = []string{"rejected"}
+	}
+	der, err := asn1.Marshal(resp)
+	require.NoError(err)
+	w.Header().Set("Content-Type", responseContentType)
+	_, _ = w.Write(der)
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rfc3161
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Token is a parsed time-stamp token.
+type Token struct {
+	// GenTime is the time at which the token was created by the TSA.
+	GenTime time.Time
+	// SerialNumber is the serial number the TSA assigned to the token.
+	SerialNumber *big.Int
+	// Policy is the TSA policy under which the token was issued.
+	Policy asn1.ObjectIdentifier
+	// HashedMessage is the digest the token was issued for.
+	HashedMessage []byte
+	// Nonce is the nonce of the request, if one was sent.
+	Nonce *big.Int
+	// Certificates are the certificates included in the token.
+	Certificates []*x509.Certificate
+
+	hashAlgorithm asn1.ObjectIdentifier
+	tstInfo       []byte
+	signer        signerInfo
+}
+
+// Parse parses the DER encoded time-stamp token der.
+func Parse(der []byte) (*Token, error) {
+	const op = "rfc3161.Parse"
+	var ci contentInfo
+	if rest, err := asn1.Unmarshal(der, &ci); err != nil {
+		return nil, fmt.Errorf("%s: invalid content info: %w", op, err)
+	} else if len(rest) > 0 {
+		return nil, fmt.Errorf("%s: trailing data after content info", op)
+	}
+	if !ci.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("%s: content type %s is not signed data", op, ci.ContentType)
+	}
+	var sd signedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, fmt.Errorf("%s: invalid signed data: %w", op, err)
+	}
+	if !sd.EncapContentInfo.EContentType.Equal(oidTSTInfo) {
+		return nil, fmt.Errorf("%s: content type %s is not a time-stamp token info", op, sd.EncapContentInfo.EContentType)
+	}
+	if len(sd.SignerInfos) != 1 {
+		return nil, fmt.Errorf("%s: expected one signer, got %d", op, len(sd.SignerInfos))
+	}
+	var info tstInfo
+	if _, err := asn1.Unmarshal(sd.EncapContentInfo.EContent, &info); err != nil {
+		return nil, fmt.Errorf("%s: invalid time-stamp token info: %w", op, err)
+	}
+	t := &Token{
+		GenTime:       info.GenTime,
+		SerialNumber:  info.SerialNumber,
+		Policy:        info.Policy,
+		HashedMessage: info.MessageImprint.HashedMessage,
+		Nonce:         info.Nonce,
+		hashAlgorithm: info.MessageImprint.HashAlgorithm.Algorithm,
+		tstInfo:       sd.EncapContentInfo.EContent,
+		signer:        sd.SignerInfos[0],
+	}
+	if len(sd.Certificates.Bytes) > 0 {
+		certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid certificates: %w", op, err)
+		}
+		t.Certificates = certs
+	}
+	return t, nil
+}
+
+// Verify verifies that the token was issued for the SHA-256 digest and that
+// it is signed by the certificate of the TSA included in the token. If roots
+// is not nil, the certificate of the TSA must also chain to one of roots and
+// be valid for time stamping.
+func (t *Token) Verify(digest []byte, roots *x509.CertPool) error {
+	const op = "rfc3161.(Token).Verify"
+	if !t.hashAlgorithm.Equal(oidSHA256) {
+		return fmt.Errorf("%s: unsupported message imprint hash algorithm %s", op, t.hashAlgorithm)
+	}
+	if !bytes.Equal(t.HashedMessage, digest) {
+		return fmt.Errorf("%s: token was not issued for the digest", op)
+	}
+
+	cert, err := t.signerCertificate()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := t.verifySignature(cert); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if roots == nil {
+		return nil
+	}
+	intermediates := x509.NewCertPool()
+	for _, c := range t.Certificates {
+		if c != cert {
+			intermediates.AddCert(c)
+		}
+	}
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   t.GenTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	}); err != nil {
+		return fmt.Errorf("%s: invalid tsa certificate: %w", op, err)
+	}
+	return nil
+}
+
+// signerCertificate returns the certificate identified by the signer
+// identifier of the token.
+func (t *Token) signerCertificate() (*x509.Certificate, error) {
+	switch {
+	case t.signer.Sid.Class == asn1.ClassUniversal && t.signer.Sid.Tag == asn1.TagSequence:
+		var isn issuerAndSerialNumber
+		if _, err := asn1.Unmarshal(t.signer.Sid.FullBytes, &isn); err != nil {
+			return nil, fmt.Errorf("invalid signer identifier: %w", err)
+		}
+		for _, c := range t.Certificates {
+			if c.SerialNumber.Cmp(isn.SerialNumber) == 0 && bytes.Equal(c.RawIssuer, isn.Issuer.FullBytes) {
+				return c, nil
+			}
+		}
+	case t.signer.Sid.Class == asn1.ClassContextSpecific && t.signer.Sid.Tag == 0:
+		for _, c := range t.Certificates {
+			if bytes.Equal(c.SubjectKeyId, t.signer.Sid.Bytes) {
+				return c, nil
+			}
+		}
+	default:
+		return nil, errors.New("invalid signer identifier")
+	}
+	return nil, errors.New("tsa certificate not included in token")
+}
+
+// verifySignature verifies the signature of the signer of the token over its
+// signed attributes, and that the signed attributes cover the token info.
+func (t *Token) verifySignature(cert *x509.Certificate) error {
+	if len(t.signer.SignedAttrs.FullBytes) == 0 {
+		return errors.New("token has no signed attributes")
+	}
+	h, err := hashFor(t.signer.DigestAlgorithm.Algorithm)
+	if err != nil {
+		return err
+	}
+	sum := h.New()
+	sum.Write(t.tstInfo)
+
+	var contentType asn1.ObjectIdentifier
+	var messageDigest []byte
+	rest := t.signer.SignedAttrs.Bytes
+	for len(rest) > 0 {
+		var a attribute
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &a); err != nil {
+			return fmt.Errorf("invalid signed attribute: %w", err)
+		}
+		switch {
+		case a.Type.Equal(oidAttrContentType):
+			if _, err := asn1.Unmarshal(a.Values.Bytes, &contentType); err != nil {
+				return fmt.Errorf("invalid content type attribute: %w", err)
+			}
+		case a.Type.Equal(oidAttrMessageDigest):
+			if _, err := asn1.Unmarshal(a.Values.Bytes, &messageDigest); err != nil {
+				return fmt.Errorf("invalid message digest attribute: %w", err)
+			}
+		}
+	}
+	if !contentType.Equal(oidTSTInfo) {
+		return errors.New("signed content type is not a time-stamp token info")
+	}
+	if !bytes.Equal(messageDigest, sum.Sum(nil)) {
+		return errors.New("signed message digest does not match the token info")
+	}
+
+	algo, err := signatureAlgorithm(t.signer.DigestAlgorithm.Algorithm, t.signer.SignatureAlgorithm)
+	if err != nil {
+		return err
+	}
+	// The signature covers the DER encoding of the signed attributes as a
+	// SET OF, rather than with the implicit tag they are stored with.
+	signed := append([]byte{}, t.signer.SignedAttrs.FullBytes...)
+	signed[0] = asn1.TagSet | 0x20
+	if err := cert.CheckSignature(algo, signed, t.signer.Signature); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	return nil
+}
+
+func hashFor(oid asn1.ObjectIdentifier) (crypto.Hash, error) {
+	switch {
+	case oid.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case oid.Equal(oidSHA384):
+		return crypto.SHA384, nil
+	case oid.Equal(oidSHA512):
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("unsupported digest algorithm %s", oid)
+	}
+}
+
+// signatureAlgorithm maps the digest and signature algorithms of a signer
+// to the corresponding x509 signature algorithm. Signers may either name
+// the combined algorithm or only the key algorithm.
+func signatureAlgorithm(digest asn1.ObjectIdentifier, sig pkix.AlgorithmIdentifier) (x509.SignatureAlgorithm, error) {
+	h, err := hashFor(digest)
+	if err != nil {
+		return x509.UnknownSignatureAlgorithm, err
+	}
+	switch {
+	case sig.Algorithm.Equal(oidRSAEncryption):
+		switch h {
+		case crypto.SHA256:
+			return x509.SHA256WithRSA, nil
+		case crypto.SHA384:
+			return x509.SHA384WithRSA, nil
+		default:
+			return x509.SHA512WithRSA, nil
+		}
+	case sig.Algorithm.Equal(oidECPublicKey):
+		switch h {
+		case crypto.SHA256:
+			return x509.ECDSAWithSHA256, nil
+		case crypto.SHA384:
+			return x509.ECDSAWithSHA384, nil
+		default:
+			return x509.ECDSAWithSHA512, nil
+		}
+	case sig.Algorithm.Equal(oidSHA256WithRSA):
+		return x509.SHA256WithRSA, nil
+	case sig.Algorithm.Equal(oidSHA384WithRSA):
+		return x509.SHA384WithRSA, nil
+	case sig.Algorithm.Equal(oidSHA512WithRSA):
+		return x509.SHA512WithRSA, nil
+	case sig.Algorithm.Equal(oidECDSAWithSHA256):
+		return x509.ECDSAWithSHA256, nil
+	case sig.Algorithm.Equal(oidECDSAWithSHA384):
+		return x509.ECDSAWithSHA384, nil
+	case sig.Algorithm.Equal(oidECDSAWithSHA512):
+		return x509.ECDSAWithSHA512, nil
+	default:
+		return x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported signature algorithm %s", sig.Algorithm)
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package secretscan
+
+// This package detects plaintext secrets, such as passwords, private keys and
+// access tokens, placed into fields of API resources that are not meant to
+// hold them, like names, descriptions and the attributes of resources that
+// have no secrets.
+//
+// Fields classified as secret or sensitive by their `class` struct tag, and
+// the secrets fields of plugin-backed resources, are where secrets belong and
+// are never scanned. Detection relies on well-known secret formats and on
+// assignments of values to secret-sounding keys such as "password=...", so it
+// can miss secrets; it is a guard against mistakes, not a guarantee.
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package secretscan
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Mode is what happens when a secret is found in a request.
+type Mode string
+
+const (
+	// DisabledMode does not scan requests.
+	DisabledMode Mode = "disabled"
+	// WarnMode emits an event for each secret found and lets the request
+	// through.
+	WarnMode Mode = "warn"
+	// BlockMode emits an event for each secret found and rejects the
+	// request.
+	BlockMode Mode = "block"
+)
+
+// ValidMode reports whether s is a known Mode.
+func ValidMode(s string) bool {
+	switch Mode(s) {
+	case DisabledMode, WarnMode, BlockMode:
+		return true
+	}
+	return false
+}
+
+// detector finds one kind of secret in a string.
+type detector struct {
+	kind string
+	re   *regexp.Regexp
+}
+
+// detectors are tried in order, so the more specific ones come first.
+var detectors = []detector{
+	{kind: "private key", re: regexp.MustCompile(`-----BEGIN ([A-Z0-9]+ )?PRIVATE KEY-----`)},
+	{kind: "AWS access key id", re: regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{kind: "GitHub token", re: regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`)},
+	{kind: "Slack token", re: regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`)},
+	{kind: "JSON web token", re: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`)},
+	{kind: "Boundary auth token", re: regexp.MustCompile(`\bat_[0-9A-Za-z]{10}_[1-9A-HJ-NP-Za-km-z]{20,}`)},
+	{kind: "credentials in URL", re: regexp.MustCompile(`\b[A-Za-z][A-Za-z0-9+.-]*://[^/\s:@]+:[^/\s@]+@`)},
+	{kind: "password", re: regexp.MustCompile(`(?i)\b(password|passwd|passphrase|pwd|secret|api[_-]?key|access[_-]?key|auth[_-]?token|token)\s*[:=]\s*["']?[^\s"']{6,}`)},
+}
+
+// Scan returns the kind of secret found in s, or an empty string if none was
+// found.
+func Scan(s string) string {
+	for _, d := range detectors {
+		if d.re.MatchString(s) {
+			return d.kind
+		}
+	}
+	return ""
+}
+
+// Finding is a secret found in a field of a message. It never holds the
+// secret itself.
+type Finding struct {
+	// Field is the path of the field, such as "description" or
+	// "attributes.username".
+	Field string
+	// Kind is the kind of secret found, such as "private key".
+	Kind string
+}
+
+// ScanMessage scans the string fields of m, recursively, and returns the
+// secrets found, ordered by field. Fields with a `class` struct tag of
+// "secret" or "sensitive", and fields named "secrets", are skipped. Subtype
+// attributes are reported under "attributes", like in the API.
+func ScanMessage(m proto.Message) []Finding {
+	if m == nil {
+		return nil
+	}
+	var findings []Finding
+	scanValue(reflect.ValueOf(m), "", &findings)
+	sort.Slice(findings, func(i, j int) bool {
+		return findings[i].Field < findings[j].Field
+	})
+	return findings
+}
+
+func scanString(s, path string, findings *[]Finding) {
+	if kind := Scan(s); kind != "" {
+		*findings = append(*findings, Finding{Field: path, Kind: kind})
+	}
+}
+
+func scanValue(v reflect.Value, path string, findings *[]Finding) {
+	if !v.IsValid() {
+		return
+	}
+	if v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+	}
+	if v.CanInterface() {
+		switch t := v.Interface().(type) {
+		case *wrapperspb.StringValue:
+			scanString(t.GetValue(), path, findings)
+			return
+		case *structpb.Struct:
+			for k, fv := range t.GetFields() {
+				scanStructValue(fv, joinPath(path, k), findings)
+			}
+			return
+		case *structpb.Value:
+			scanStructValue(t, path, findings)
+			return
+		case *structpb.ListValue:
+			for _, fv := range t.GetValues() {
+				scanStructValue(fv, path, findings)
+			}
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		scanValue(v.Elem(), path, findings)
+	case reflect.String:
+		scanString(v.String(), path, findings)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			// Raw bytes are not scanned.
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			scanValue(v.Index(i), path, findings)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			scanValue(iter.Value(), joinPath(path, iter.Key().String()), findings)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			switch f.Tag.Get("class") {
+			case "secret", "sensitive":
+				continue
+			}
+			name := fieldName(f)
+			if name == "secrets" {
+				continue
+			}
+			fieldPath := joinPath(path, name)
+			switch {
+			case f.Tag.Get("protobuf_oneof") != "":
+				// The oneof field only wraps the field that is set, which
+				// names the path.
+				fieldPath = path
+			case strings.HasSuffix(name, "attributes"):
+				// The subtype attributes are all set in the attributes field
+				// of the API.
+				fieldPath = joinPath(path, "attributes")
+			}
+			scanValue(v.Field(i), fieldPath, findings)
+		}
+	}
+}
+
+func scanStructValue(v *structpb.Value, path string, findings *[]Finding) {
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		scanString(k.StringValue, path, findings)
+	case *structpb.Value_StructValue:
+		for fk, fv := range k.StructValue.GetFields() {
+			scanStructValue(fv, joinPath(path, fk), findings)
+		}
+	case *structpb.Value_ListValue:
+		for _, fv := range k.ListValue.GetValues() {
+			scanStructValue(fv, path, findings)
+		}
+	}
+}
+
+// fieldName returns the name of the field in the API: the proto name of
+// generated fields, or the lowercase Go name otherwise.
+func fieldName(f reflect.StructField) string {
+	for _, part := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if name, ok := strings.CutPrefix(part, "name="); ok {
+			return name
+		}
+	}
+	return strings.ToLower(f.Name)
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
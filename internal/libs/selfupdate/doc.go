@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package selfupdate
+
+// This package updates the running Boundary binary from an artifact endpoint.
+//
+// The endpoint serves a JSON manifest listing the latest version and, for each
+// OS and architecture, the URL and SHA256 checksum of its binary, e.g.:
+//
+//	{
+//	  "version": "0.19.1",
+//	  "artifacts": [
+//	    {"os": "linux", "arch": "amd64", "url": "https://.../boundary", "sha256": "9f86d0..."}
+//	  ]
+//	}
+//
+// The manifest is signed with an Ed25519 key: the base64 encoded signature of
+// the manifest is served at the URL of the manifest with a ".sig" suffix. A
+// manifest whose signature doesn't verify with the configured public key is
+// rejected, and so is an artifact whose checksum doesn't match the manifest,
+// so the endpoint and the artifact storage don't need to be trusted.
+//
+// An update is staged next to the running binary before it's installed, and
+// installing it keeps the replaced binary with a ".old" suffix. Installing an
+// update doesn't restart the process; callers are expected to exit and rely on
+// their service manager to start the new binary.
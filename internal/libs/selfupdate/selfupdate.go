@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package selfupdate
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	gvers "github.com/hashicorp/go-version"
+)
+
+const (
+	// signatureSuffix is appended to the URL of the manifest to get the URL
+	// of its signature.
+	signatureSuffix = ".sig"
+
+	// oldSuffix is appended to the path of the binary replaced by an update.
+	oldSuffix = ".old"
+
+	// maxManifestSize is the largest manifest or signature that is read.
+	maxManifestSize = 1 << 20
+)
+
+// Manifest describes the latest version served by an artifact endpoint.
+type Manifest struct {
+	// Version is the version of the artifacts.
+	Version string `json:"version"`
+	// Artifacts are the binaries of the version, one per OS and architecture.
+	Artifacts []*Artifact `json:"artifacts"`
+}
+
+// Artifact is the binary of a version for an OS and architecture.
+type Artifact struct {
+	// Os is the OS of the binary, as in runtime.GOOS.
+	Os string `json:"os"`
+	// Arch is the architecture of the binary, as in runtime.GOARCH.
+	Arch string `json:"arch"`
+	// Url is the URL the binary is downloaded from.
+	Url string `json:"url"`
+	// Sha256 is the hex encoded SHA256 checksum of the binary.
+	Sha256 string `json:"sha256"`
+}
+
+// Update is a version newer than the running one, and its binary for the OS
+// and architecture of the running process.
+type Update struct {
+	Version  *gvers.Version
+	Artifact *Artifact
+}
+
+// Updater checks an artifact endpoint for updates, and stages and installs
+// them.
+type Updater struct {
+	manifestUrl string
+	publicKey   ed25519.PublicKey
+	stagingPath string
+	client      *http.Client
+}
+
+// New returns an Updater checking the manifest at manifestUrl, whose signature
+// must verify with publicKey. Updates are downloaded to stagingPath, which
+// must be on the same file system as the binary they replace. If client is
+// nil, http.DefaultClient is used.
+func New(manifestUrl string, publicKey ed25519.PublicKey, stagingPath string, client *http.Client) (*Updater, error) {
+	switch {
+	case manifestUrl == "":
+		return nil, errors.New("missing manifest url")
+	case len(publicKey) != ed25519.PublicKeySize:
+		return nil, errors.New("invalid public key")
+	case stagingPath == "":
+		return nil, errors.New("missing staging path")
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Updater{
+		manifestUrl: manifestUrl,
+		publicKey:   publicKey,
+		stagingPath: stagingPath,
+		client:      client,
+	}, nil
+}
+
+// ParsePublicKey parses an Ed25519 public key, either PEM encoded in PKIX
+// form or the base64 encoding of the raw key.
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	s = strings.TrimSpace(s)
+	if block, _ := pem.Decode([]byte(s)); block != nil {
+		k, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key: %w", err)
+		}
+		pk, ok := k.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("public key is a %T, not an ed25519 key", k)
+		}
+		return pk, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding public key: %w", err)
+	}
+	if len(b) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("public key is %d bytes, expected %d", len(b), ed25519.PublicKeySize)
+	}
+	return ed25519.PublicKey(b), nil
+}
+
+// Check fetches and verifies the manifest, and returns the update to install,
+// or nil if the manifest's version is not newer than current.
+func (u *Updater) Check(ctx context.Context, current *gvers.Version) (*Update, error) {
+	if current == nil {
+		return nil, errors.New("unknown current version")
+	}
+	manifest, err := u.get(ctx, u.manifestUrl)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching manifest: %w", err)
+	}
+	encodedSig, err := u.get(ctx, u.manifestUrl+signatureSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching manifest signature: %w", err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encodedSig)))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding manifest signature: %w", err)
+	}
+	if !ed25519.Verify(u.publicKey, manifest, sig) {
+		return nil, errors.New("manifest signature verification failed")
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(manifest, &m); err != nil {
+		return nil, fmt.Errorf("error decoding manifest: %w", err)
+	}
+	v, err := gvers.NewSemver(m.Version)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing manifest version: %w", err)
+	}
+	if !v.GreaterThan(current) {
+		return nil, nil
+	}
+	for _, a := range m.Artifacts {
+		if a.Os == runtime.GOOS && a.Arch == runtime.GOARCH {
+			if a.Url == "" || a.Sha256 == "" {
+				return nil, fmt.Errorf("artifact for %s/%s is missing its url or checksum", a.Os, a.Arch)
+			}
+			return &Update{Version: v, Artifact: a}, nil
+		}
+	}
+	return nil, fmt.Errorf("version %s has no artifact for %s/%s", v, runtime.GOOS, runtime.GOARCH)
+}
+
+// Stage downloads the binary of the update to the staging path and verifies
+// its checksum. It returns the path of the staged binary.
+func (u *Updater) Stage(ctx context.Context, up *Update) (string, error) {
+	if up == nil || up.Artifact == nil {
+		return "", errors.New("missing update")
+	}
+	want, err := hex.DecodeString(up.Artifact.Sha256)
+	if err != nil {
+		return "", fmt.Errorf("error decoding artifact checksum: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, up.Artifact.Url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error downloading artifact: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error downloading artifact: unexpected status %q", resp.Status)
+	}
+
+	f, err := os.CreateTemp(u.stagingPath, ".boundary-update-*")
+	if err != nil {
+		return "", fmt.Errorf("error creating staged binary: %w", err)
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(f, h), resp.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("error downloading artifact: %w", err)
+	}
+	if got := h.Sum(nil); !bytes.Equal(got, want) {
+		return "", fmt.Errorf("artifact checksum %x does not match %x", got, want)
+	}
+	if err := os.Chmod(tmp, 0o755); err != nil {
+		return "", fmt.Errorf("error making staged binary executable: %w", err)
+	}
+	staged := filepath.Join(u.stagingPath, "boundary-"+up.Version.String())
+	if err := os.Rename(tmp, staged); err != nil {
+		return "", fmt.Errorf("error staging binary: %w", err)
+	}
+	return staged, nil
+}
+
+// Install replaces the binary at path with the staged binary. The replaced
+// binary is kept with a ".old" suffix, and restored if the staged binary
+// can't be moved into place.
+func Install(staged, path string) error {
+	old := path + oldSuffix
+	if err := os.Remove(old); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error removing previous binary: %w", err)
+	}
+	if err := os.Rename(path, old); err != nil {
+		return fmt.Errorf("error moving current binary: %w", err)
+	}
+	if err := os.Rename(staged, path); err != nil {
+		if restoreErr := os.Rename(old, path); restoreErr != nil {
+			err = errors.Join(err, fmt.Errorf("error restoring current binary: %w", restoreErr))
+		}
+		return fmt.Errorf("error installing staged binary: %w", err)
+	}
+	return nil
+}
+
+func (u *Updater) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q", resp.Status)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > maxManifestSize {
+		return nil, fmt.Errorf("larger than %d bytes", maxManifestSize)
+	}
+	return b, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package selfupdate
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	gvers "github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePublicKey(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	got, err := ParsePublicKey(base64.StdEncoding.EncodeToString(pub))
+	require.NoError(t, err)
+	assert.Equal(t, pub, got)
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	got, err = ParsePublicKey(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+	require.NoError(t, err)
+	assert.Equal(t, pub, got)
+
+	_, err = ParsePublicKey(base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.ErrorContains(t, err, "expected 32")
+	_, err = ParsePublicKey("not a key")
+	assert.Error(t, err)
+}
+
+func TestUpdater(t *testing.T) {
+	ctx := context.Background()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	binary := []byte("#!/bin/sh\necho boundary 0.19.1\n")
+	sum := sha256.Sum256(binary)
+	var manifest []byte
+	signature := func(b []byte) string { return base64.StdEncoding.EncodeToString(ed25519.Sign(priv, b)) }
+	sig := ""
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/manifest.json", func(w http.ResponseWriter, _ *http.Request) { w.Write(manifest) })
+	mux.HandleFunc("/manifest.json.sig", func(w http.ResponseWriter, _ *http.Request) { w.Write([]byte(sig)) })
+	mux.HandleFunc("/boundary", func(w http.ResponseWriter, _ *http.Request) { w.Write(binary) })
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	setManifest := func(t *testing.T, m *Manifest) {
+		t.Helper()
+		var err error
+		manifest, err = json.Marshal(m)
+		require.NoError(t, err)
+		sig = signature(manifest)
+	}
+	artifact := &Artifact{Os: runtime.GOOS, Arch: runtime.GOARCH, Url: srv.URL + "/boundary", Sha256: hex.EncodeToString(sum[:])}
+	current := gvers.Must(gvers.NewSemver("0.19.0"))
+
+	staging := t.TempDir()
+	u, err := New(srv.URL+"/manifest.json", pub, staging, srv.Client())
+	require.NoError(t, err)
+
+	t.Run("no newer version", func(t *testing.T) {
+		setManifest(t, &Manifest{Version: "0.19.0", Artifacts: []*Artifact{artifact}})
+		up, err := u.Check(ctx, current)
+		require.NoError(t, err)
+		assert.Nil(t, up)
+	})
+	t.Run("bad signature", func(t *testing.T) {
+		setManifest(t, &Manifest{Version: "0.19.1", Artifacts: []*Artifact{artifact}})
+		sig = signature([]byte("something else"))
+		_, err := u.Check(ctx, current)
+		assert.ErrorContains(t, err, "signature verification failed")
+	})
+	t.Run("no artifact for platform", func(t *testing.T) {
+		setManifest(t, &Manifest{Version: "0.19.1", Artifacts: []*Artifact{{Os: "plan9", Arch: "mips", Url: artifact.Url, Sha256: artifact.Sha256}}})
+		_, err := u.Check(ctx, current)
+		assert.ErrorContains(t, err, "has no artifact for")
+	})
+	t.Run("checksum mismatch", func(t *testing.T) {
+		bad := *artifact
+		bad.Sha256 = hex.EncodeToString(make([]byte, sha256.Size))
+		_, err := u.Stage(ctx, &Update{Version: gvers.Must(gvers.NewSemver("0.19.1")), Artifact: &bad})
+		assert.ErrorContains(t, err, "does not match")
+		entries, err := os.ReadDir(staging)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+	t.Run("update", func(t *testing.T) {
+		setManifest(t, &Manifest{Version: "0.19.1", Artifacts: []*Artifact{artifact}})
+		up, err := u.Check(ctx, current)
+		require.NoError(t, err)
+		require.NotNil(t, up)
+		assert.Equal(t, "0.19.1", up.Version.String())
+
+		staged, err := u.Stage(ctx, up)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(staging, "boundary-0.19.1"), staged)
+
+		path := filepath.Join(staging, "boundary")
+		require.NoError(t, os.WriteFile(path, []byte("old"), 0o755))
+		require.NoError(t, Install(staged, path))
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, binary, b)
+		b, err = os.ReadFile(path + ".old")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("old"), b)
+		assert.NoFileExists(t, staged)
+	})
+}
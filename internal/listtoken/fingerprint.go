@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package listtoken
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+)
+
+// Fingerprint returns a value that identifies the token across all the pages
+// and refresh phases of a listing. It is derived from the fields that are
+// constant for the lifetime of the token, so every token returned while
+// paginating through or refreshing the same listing has the same fingerprint.
+func (tk *Token) Fingerprint() []byte {
+	h := sha256.New()
+	_, _ = h.Write(binary.BigEndian.AppendUint64(nil, uint64(tk.CreateTime.UnixNano())))
+	_, _ = h.Write([]byte(tk.ResourceType.String()))
+	_, _ = h.Write(tk.GrantsHash)
+	return h.Sum(nil)
+}
+
+// Expiration returns the time after which the token is no longer accepted
+// by list endpoints.
+func (tk *Token) Expiration() time.Time {
+	return tk.CreateTime.Add(tokenLifetime)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package listtoken_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToken_Fingerprint(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	createTime := time.Now().Add(-time.Hour)
+
+	pagination, err := listtoken.NewPagination(ctx, createTime, resource.Target, []byte("some hash"), "ttcp_1234567890", createTime)
+	require.NoError(t, err)
+	assert.Len(t, pagination.Fingerprint(), 32)
+
+	// Tokens of the same listing share a fingerprint across phases
+	startRefresh, err := listtoken.NewStartRefresh(ctx, createTime, resource.Target, []byte("some hash"), createTime, createTime)
+	require.NoError(t, err)
+	assert.Equal(t, pagination.Fingerprint(), startRefresh.Fingerprint())
+
+	otherHash, err := listtoken.NewPagination(ctx, createTime, resource.Target, []byte("some other hash"), "ttcp_1234567890", createTime)
+	require.NoError(t, err)
+	assert.NotEqual(t, pagination.Fingerprint(), otherHash.Fingerprint())
+
+	otherType, err := listtoken.NewPagination(ctx, createTime, resource.Session, []byte("some hash"), "s_1234567890", createTime)
+	require.NoError(t, err)
+	assert.NotEqual(t, pagination.Fingerprint(), otherType.Fingerprint())
+
+	otherTime, err := listtoken.NewPagination(ctx, createTime.Add(time.Nanosecond), resource.Target, []byte("some hash"), "ttcp_1234567890", createTime)
+	require.NoError(t, err)
+	assert.NotEqual(t, pagination.Fingerprint(), otherTime.Fingerprint())
+
+	assert.Equal(t, createTime.Add(30*24*time.Hour), pagination.Expiration())
+}
@@ -19,6 +19,10 @@ import (
 	"github.com/hashicorp/boundary/internal/types/resource"
 )
 
+// tokenLifetime is how long a token is accepted by list endpoints after
+// its creation.
+const tokenLifetime = 30 * 24 * time.Hour
+
 // A Token is returned in list endpoints for the purposes of pagination.
 // A Token has a subtype, which defines which stage in the list pagination
 // lifecycle is in place. The transitions between subtypes can be seen as
@@ -64,6 +68,10 @@ type Token struct {
 	// set ot either PaginationToken, StartRefreshToken
 	// or RefreshToken.
 	Subtype TokenSubtype
+	// The ID of the user who made the original request. Only
+	// used to report on the token, it is empty for tokens
+	// issued before it was recorded.
+	UserId string
 }
 
 // TokenSubtype is used to create a discriminated union of types
@@ -354,7 +362,7 @@ func (tk *Token) Validate(
 		return errors.New(ctx, errors.InvalidListToken, op, "list token was missing its grants hash")
 	case !bytes.Equal(tk.GrantsHash, expectedGrantsHash):
 		return errors.New(ctx, errors.InvalidListToken, op, "grants have changed since list token was issued")
-	case tk.CreateTime.Before(time.Now().AddDate(0, 0, -30)):
+	case tk.CreateTime.Before(time.Now().Add(-tokenLifetime)):
 		// Tokens older than 30 days have expired
 		return errors.New(ctx, errors.InvalidListToken, op, "list token was expired")
 	case tk.ResourceType != expectedResourceType:
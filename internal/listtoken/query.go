@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package listtoken
+
+const (
+	insertRevocationQuery = `
+insert into list_token_revocation
+  (fingerprint, resource_type, token_create_time)
+values
+  (@fingerprint, @resource_type, @token_create_time)
+on conflict (fingerprint) do nothing;
+`
+	deleteExpiredRevocationsQuery = `
+delete from list_token_revocation
+ where token_create_time < now() - interval '30 days';
+`
+	revocationExistsQuery = `
+select exists (
+  select 1
+    from list_token_revocation
+   where fingerprint = @fingerprint
+);
+`
+)
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package listtoken
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// A Repository stores and retrieves list token revocations. It is not safe
+// to use a repository concurrently.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+}
+
+// NewRepository creates a new Repository. The returned repository is not
+// safe for concurrent go routines to access it.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer) (*Repository, error) {
+	const op = "listtoken.NewRepository"
+	switch {
+	case util.IsNil(r):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil db reader")
+	case util.IsNil(w):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil db writer")
+	}
+	return &Repository{
+		reader: r,
+		writer: w,
+	}, nil
+}
+
+// Revoke records the token as revoked, which causes list endpoints to
+// reject it and every other token of the same listing. Revoking a token
+// that was already revoked is not an error. Revocations of tokens that have
+// expired are removed as a side effect.
+func (r *Repository) Revoke(ctx context.Context, tk *Token) error {
+	const op = "listtoken.(Repository).Revoke"
+	switch {
+	case tk == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing list token")
+	case tk.CreateTime.IsZero():
+		return errors.New(ctx, errors.InvalidParameter, op, "missing list token create time")
+	}
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+		if _, err := w.Exec(ctx, deleteExpiredRevocationsQuery, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete expired revocations"))
+		}
+		if _, err := w.Exec(ctx, insertRevocationQuery, []any{
+			sql.Named("fingerprint", tk.Fingerprint()),
+			sql.Named("resource_type", tk.ResourceType.String()),
+			sql.Named("token_create_time", timestamp.New(tk.CreateTime)),
+		}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to insert revocation"))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// IsRevoked returns true if the token, or any other token of the same
+// listing, was revoked.
+func (r *Repository) IsRevoked(ctx context.Context, tk *Token) (bool, error) {
+	const op = "listtoken.(Repository).IsRevoked"
+	if tk == nil {
+		return false, errors.New(ctx, errors.InvalidParameter, op, "missing list token")
+	}
+	rows, err := r.reader.Query(ctx, revocationExistsQuery, []any{
+		sql.Named("fingerprint", tk.Fingerprint()),
+	})
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var revoked bool
+	for rows.Next() {
+		if err := rows.Scan(&revoked); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return revoked, nil
+}
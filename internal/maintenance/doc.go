@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package maintenance stores the maintenance mode shared by all the
+// controllers. While the read-only mode is enabled, the controllers reject
+// the api requests that modify resources, while reads and the sessions that
+// already exist keep working. It is meant to freeze the state of Boundary
+// during database migrations, disaster recovery failover checks and incidents.
+package maintenance
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package maintenance
+
+import "time"
+
+// MaxReasonLength is the maximum length of the reason given when the
+// read-only mode is changed.
+const MaxReasonLength = 1024
+
+// Mode is the maintenance mode of the controllers.
+type Mode struct {
+	// ReadOnly is set when the controllers reject the api requests that
+	// modify resources.
+	ReadOnly bool
+	// Reason is the optional reason given when the mode was last changed.
+	Reason string
+	// UpdateTime is the time the mode was last changed.
+	UpdateTime time.Time
+}
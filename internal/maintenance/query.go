@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package maintenance
+
+const (
+	readModeQuery = `
+select read_only,
+       coalesce(reason, ''),
+       update_time
+  from controller_maintenance
+ where scope_id = 'global';
+`
+	setReadOnlyQuery = `
+update controller_maintenance
+   set read_only = @read_only,
+       reason    = nullif(@reason, '')
+ where scope_id = 'global';
+`
+)
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// A Repository reads and changes the maintenance mode of the controllers.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+}
+
+// NewRepository creates a new Repository.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer) (*Repository, error) {
+	const op = "maintenance.NewRepository"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil db reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil db writer")
+	}
+	return &Repository{
+		reader: r,
+		writer: w,
+	}, nil
+}
+
+// ReadMode returns the current maintenance mode of the controllers.
+func (r *Repository) ReadMode(ctx context.Context) (*Mode, error) {
+	const op = "maintenance.(Repository).ReadMode"
+	rows, err := r.reader.Query(ctx, readModeQuery, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "maintenance mode not found")
+	}
+	var m Mode
+	var updateTime time.Time
+	if err := rows.Scan(&m.ReadOnly, &m.Reason, &updateTime); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	m.UpdateTime = updateTime.UTC()
+	return &m, nil
+}
+
+// SetReadOnly enables or disables the read-only mode of the controllers and
+// returns the resulting mode. The reason is optional and replaces the reason
+// given previously.
+func (r *Repository) SetReadOnly(ctx context.Context, readOnly bool, reason string) (*Mode, error) {
+	const op = "maintenance.(Repository).SetReadOnly"
+	if len(reason) > MaxReasonLength {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "reason is too long")
+	}
+	rowsUpdated, err := r.writer.Exec(ctx, setReadOnlyQuery, []any{
+		sql.Named("read_only", readOnly),
+		sql.Named("reason", reason),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if rowsUpdated != 1 {
+		return nil, errors.New(ctx, errors.MultipleRecords, op, "unexpected number of maintenance modes updated")
+	}
+	return r.ReadMode(ctx)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package maintenance
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_New(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+
+	got, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+	assert.Equal(t, &Repository{reader: rw, writer: rw}, got)
+
+	_, err = NewRepository(ctx, nil, rw)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err)
+	_, err = NewRepository(ctx, rw, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err)
+}
+
+func TestRepository_SetReadOnly(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	repo, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+
+	m, err := repo.ReadMode(ctx)
+	require.NoError(t, err)
+	assert.False(t, m.ReadOnly)
+	assert.Empty(t, m.Reason)
+	assert.False(t, m.UpdateTime.IsZero())
+
+	m, err = repo.SetReadOnly(ctx, true, "database migration")
+	require.NoError(t, err)
+	assert.True(t, m.ReadOnly)
+	assert.Equal(t, "database migration", m.Reason)
+
+	got, err := repo.ReadMode(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, m, got)
+
+	m, err = repo.SetReadOnly(ctx, false, "")
+	require.NoError(t, err)
+	assert.False(t, m.ReadOnly)
+	assert.Empty(t, m.Reason)
+
+	_, err = repo.SetReadOnly(ctx, true, strings.Repeat("a", MaxReasonLength+1))
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err)
+}
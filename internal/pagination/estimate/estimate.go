@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package estimate implements scheduler jobs used to refresh the table
+// statistics that estimated item counts in list responses are based on.
+package estimate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// resourceTables maps each listable resource type to the tables whose
+// statistics are used to estimate the number of items of that type.
+var resourceTables = map[resource.Type][]string{
+	resource.Account:           {"auth_password_account", "auth_oidc_account", "auth_ldap_account"},
+	resource.Alias:             {"alias"},
+	resource.AuthMethod:        {"auth_password_method", "auth_oidc_method", "auth_ldap_method"},
+	resource.AuthToken:         {"auth_token"},
+	resource.Credential:        {"credential_static_username_password_credential", "credential_static_ssh_private_key_credential", "credential_static_json_credential"},
+	resource.CredentialLibrary: {"credential_vault_library", "credential_vault_ssh_cert_library"},
+	resource.CredentialStore:   {"credential_static_store", "credential_vault_store"},
+	resource.Group:             {"iam_group"},
+	resource.HostCatalog:       {"static_host_catalog", "host_plugin_catalog"},
+	resource.HostSet:           {"static_host_set", "host_plugin_set"},
+	resource.Host:              {"static_host", "host_plugin_host"},
+	resource.ManagedGroup:      {"auth_oidc_managed_group", "auth_ldap_managed_group"},
+	resource.Role:              {"iam_role"},
+	resource.Scope:             {"iam_scope"},
+	resource.Session:           {"session"},
+	resource.Target:            {"target_tcp", "target_ssh"},
+	resource.User:              {"iam_user"},
+}
+
+// RegisterJobs registers a refresh job with the provided scheduler for each
+// resource type in intervals. The keys of intervals are resource type names,
+// such as "target" or "session", and the values are the time between
+// refreshes. Resource types that are not configured rely on the database's
+// autovacuum to refresh their statistics.
+func RegisterJobs(ctx context.Context, s *scheduler.Scheduler, w db.Writer, intervals map[string]time.Duration) error {
+	const op = "estimate.RegisterJobs"
+	if s == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil scheduler", errors.WithoutEvent())
+	}
+	if util.IsNil(w) {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil DB writer", errors.WithoutEvent())
+	}
+
+	for name, interval := range intervals {
+		refreshJob, err := newRefreshJob(ctx, w, name, interval)
+		if err != nil {
+			return fmt.Errorf("error creating estimate refresh job: %w", err)
+		}
+		if err := s.RegisterJob(ctx, refreshJob); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	return nil
+}
+
+// ValidResourceType returns true if the estimated count refresh interval of
+// the named resource type can be configured.
+func ValidResourceType(name string) bool {
+	_, ok := resourceTables[resource.Map[name]]
+	return ok
+}
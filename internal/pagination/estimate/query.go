@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package estimate
+
+const analyzeQueryTemplate = `
+analyze %s
+`
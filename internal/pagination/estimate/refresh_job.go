@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package estimate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+type refreshJob struct {
+	w        db.Writer
+	resource resource.Type
+	interval time.Duration
+	query    string
+}
+
+func newRefreshJob(ctx context.Context, w db.Writer, name string, interval time.Duration) (*refreshJob, error) {
+	const op = "estimate.newRefreshJob"
+	rt := resource.Map[name]
+	tables, ok := resourceTables[rt]
+	switch {
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case !ok:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported resource type %q", name))
+	case interval <= 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("refresh interval for resource type %q must be greater than 0", name))
+	}
+
+	return &refreshJob{
+		w:        w,
+		resource: rt,
+		interval: interval,
+		query:    fmt.Sprintf(analyzeQueryTemplate, strings.Join(tables, ", ")),
+	}, nil
+}
+
+// Status reports the job’s current status.
+func (c *refreshJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{}
+}
+
+// Run performs the required work depending on the implementation.
+// The context is used to notify the job that it should exit early.
+func (c *refreshJob) Run(ctx context.Context, _ time.Duration) error {
+	const op = "estimate.(refreshJob).Run"
+	_, err := c.w.Exec(ctx, c.query, nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to refresh estimates for %s", c.resource.PluralString())))
+	}
+	return nil
+}
+
+// NextRunIn returns the duration until the next job run should be scheduled.
+// This job runs at the interval configured for its resource type.
+func (c *refreshJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return c.interval, nil
+}
+
+// Name is the unique name of the job.
+func (c *refreshJob) Name() string {
+	return fmt.Sprintf("%s_estimated_count_refresh", strings.ReplaceAll(c.resource.String(), "-", "_"))
+}
+
+// Description is the human-readable description of the job.
+func (c *refreshJob) Description() string {
+	return fmt.Sprintf("Periodically refreshes the database statistics used to estimate the number of %s returned in list responses", c.resource.PluralString())
+}
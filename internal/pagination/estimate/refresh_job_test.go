@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package estimate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRefreshJob(t *testing.T) {
+	ctx := context.Background()
+	w := &db.Db{}
+
+	tests := []struct {
+		name      string
+		resource  string
+		interval  time.Duration
+		wantQuery string
+		wantName  string
+		wantErr   bool
+	}{
+		{
+			name:      "target",
+			resource:  "target",
+			interval:  time.Hour,
+			wantQuery: "\nanalyze target_tcp, target_ssh\n",
+			wantName:  "target_estimated_count_refresh",
+		},
+		{
+			name:      "credential-library",
+			resource:  "credential-library",
+			interval:  time.Minute,
+			wantQuery: "\nanalyze credential_vault_library, credential_vault_ssh_cert_library\n",
+			wantName:  "credential_library_estimated_count_refresh",
+		},
+		{
+			name:     "unknown-resource",
+			resource: "nope",
+			interval: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "unlistable-resource",
+			resource: "worker",
+			interval: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "zero-interval",
+			resource: "session",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := newRefreshJob(ctx, w, tt.resource, tt.interval)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				assert.False(ValidResourceType(tt.resource) && tt.interval > 0)
+				return
+			}
+			require.NoError(err)
+			assert.True(ValidResourceType(tt.resource))
+			assert.Equal(tt.wantQuery, got.query)
+			assert.Equal(tt.wantName, got.Name())
+			next, err := got.NextRunIn(ctx)
+			require.NoError(err)
+			assert.Equal(tt.interval, next)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package pagination
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/boundary"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// MaxExactItemCount is the largest number of items that will be counted when
+// an exact count is requested. If more items are available, the request fails
+// rather than returning an estimate the client did not ask for, which bounds
+// the cost of counting on the server.
+const MaxExactItemCount = 10_000
+
+// exactCountBatchSize is the number of items requested from the list items
+// function at a time while counting.
+const exactCountBatchSize = 1_000
+
+type exactCountKey struct{}
+
+// WithExactCount returns a context that requests List to replace the estimated
+// item count of a response with the exact number of items that pass the filter
+// item function. Listing fails if that number exceeds MaxExactItemCount.
+// Subsequent pages and refresh listings always use the estimated count, since
+// their list items functions only return items after the list token's
+// position.
+func WithExactCount(ctx context.Context) context.Context {
+	return context.WithValue(ctx, exactCountKey{}, true)
+}
+
+func exactCountRequested(ctx context.Context) bool {
+	v, ok := ctx.Value(exactCountKey{}).(bool)
+	return ok && v
+}
+
+// ExactCountFunc returns an EstimatedCountFunc that counts the items returned
+// by listItemsFn that pass filterItemFn if an exact count was requested in the
+// context, falling back to estimatedCountFn if it was not. It returns an
+// invalid parameter error if there are more than MaxExactItemCount items. The
+// list items function must list items from the start when given an empty
+// previous page item.
+func ExactCountFunc[T boundary.Resource](
+	filterItemFn ListFilterFunc[T],
+	listItemsFn ListItemsFunc[T],
+	estimatedCountFn EstimatedCountFunc,
+) EstimatedCountFunc {
+	return func(ctx context.Context) (int, error) {
+		const op = "pagination.ExactCountFunc"
+		if !exactCountRequested(ctx) {
+			return estimatedCountFn(ctx)
+		}
+		count, ok, err := exactCount(ctx, MaxExactItemCount, filterItemFn, listItemsFn)
+		if err != nil {
+			return 0, errors.Wrap(ctx, err, op)
+		}
+		if !ok {
+			return 0, errors.New(ctx, errors.InvalidParameter, op,
+				fmt.Sprintf("more than %d items are available, too many for an exact count", MaxExactItemCount))
+		}
+		return count, nil
+	}
+}
+
+// exactCount counts the items returned by listItemsFn that pass filterItemFn.
+// It returns false if more than limit items pass the filter.
+func exactCount[T boundary.Resource](
+	ctx context.Context,
+	limit int,
+	filterItemFn ListFilterFunc[T],
+	listItemsFn ListItemsFunc[T],
+) (int, bool, error) {
+	const op = "pagination.exactCount"
+	var lastItem T
+	var count int
+	for {
+		page, _, err := listItemsFn(ctx, lastItem, exactCountBatchSize)
+		if err != nil {
+			return 0, false, errors.Wrap(ctx, err, op)
+		}
+		for _, item := range page {
+			ok, err := filterItemFn(ctx, item)
+			if err != nil {
+				return 0, false, errors.Wrap(ctx, err, op)
+			}
+			if ok {
+				count++
+				if count > limit {
+					return 0, false, nil
+				}
+			}
+		}
+		if len(page) < exactCountBatchSize {
+			return count, true, nil
+		}
+		lastItem = page[len(page)-1]
+	}
+}
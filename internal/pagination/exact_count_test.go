@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package pagination
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExactCountFunc(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	newListItemsFn := func(n int) ListItemsFunc[*testType] {
+		var items []*testType
+		for i := 0; i < n; i++ {
+			items = append(items, &testType{ID: fmt.Sprintf("ttype_%05d", i)})
+		}
+		return func(ctx context.Context, prevPageLast *testType, limit int) ([]*testType, time.Time, error) {
+			start := 0
+			if prevPageLast != nil {
+				start = slices.IndexFunc(items, func(i *testType) bool { return i.ID == prevPageLast.ID }) + 1
+			}
+			end := min(start+limit, len(items))
+			return items[start:end], time.Now(), nil
+		}
+	}
+	filterItemFn := func(ctx context.Context, item *testType) (bool, error) {
+		// Filter out every other item
+		var i int
+		_, err := fmt.Sscanf(item.ID, "ttype_%d", &i)
+		return i%2 == 0, err
+	}
+	estimatedItemCountFn := func(ctx context.Context) (int, error) {
+		return 1, nil
+	}
+
+	t.Run("not-requested", func(t *testing.T) {
+		t.Parallel()
+		fn := ExactCountFunc(filterItemFn, newListItemsFn(10), estimatedItemCountFn)
+		got, err := fn(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, got)
+	})
+	t.Run("requested", func(t *testing.T) {
+		t.Parallel()
+		fn := ExactCountFunc(filterItemFn, newListItemsFn(2*exactCountBatchSize+5), estimatedItemCountFn)
+		got, err := fn(WithExactCount(ctx))
+		require.NoError(t, err)
+		assert.Equal(t, exactCountBatchSize+3, got)
+	})
+	t.Run("requested-exact-batch", func(t *testing.T) {
+		t.Parallel()
+		fn := ExactCountFunc(filterItemFn, newListItemsFn(exactCountBatchSize), estimatedItemCountFn)
+		got, err := fn(WithExactCount(ctx))
+		require.NoError(t, err)
+		assert.Equal(t, exactCountBatchSize/2, got)
+	})
+	t.Run("at-limit", func(t *testing.T) {
+		t.Parallel()
+		fn := ExactCountFunc(filterItemFn, newListItemsFn(2*MaxExactItemCount), estimatedItemCountFn)
+		got, err := fn(WithExactCount(ctx))
+		require.NoError(t, err)
+		assert.Equal(t, MaxExactItemCount, got)
+	})
+	t.Run("over-limit", func(t *testing.T) {
+		t.Parallel()
+		fn := ExactCountFunc(filterItemFn, newListItemsFn(2*MaxExactItemCount+2), estimatedItemCountFn)
+		_, err := fn(WithExactCount(ctx))
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+		assert.ErrorContains(t, err, "too many for an exact count")
+	})
+	t.Run("list-error", func(t *testing.T) {
+		t.Parallel()
+		listItemsFn := func(ctx context.Context, prevPageLast *testType, limit int) ([]*testType, time.Time, error) {
+			return nil, time.Time{}, fmt.Errorf("failed to list")
+		}
+		fn := ExactCountFunc(filterItemFn, listItemsFn, estimatedItemCountFn)
+		_, err := fn(WithExactCount(ctx))
+		require.ErrorContains(t, err, "failed to list")
+	})
+}
@@ -72,7 +72,9 @@ type ListDeletedIDsFunc func(ctx context.Context, since time.Time) ([]string, ti
 // The response includes a new list token used to continue pagination or refresh.
 // The estimatedCountFn is used to provide an estimated total number of
 // items that can be returned by making additional requests using the returned
-// list token.
+// list token. If an exact count was requested using WithExactCount, the
+// estimate is replaced with the exact number of items, or an error is returned
+// if there are too many items to count.
 func List[T boundary.Resource](
 	ctx context.Context,
 	grantsHash []byte,
@@ -101,7 +103,7 @@ func List[T boundary.Resource](
 		return nil, errors.Wrap(ctx, err, op)
 	}
 
-	return buildListResp(ctx, grantsHash, items, completeListing, listTime, estimatedCountFn)
+	return buildListResp(ctx, grantsHash, items, completeListing, listTime, ExactCountFunc(filterItemFn, listItemsFn, estimatedCountFn))
 }
 
 // ListPage returns a ListResponse. The response will contain at most pageSize
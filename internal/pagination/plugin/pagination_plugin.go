@@ -32,7 +32,8 @@ type ListPluginItemsFunc[T boundary.Resource] func(ctx context.Context, prevPage
 // The response includes a new list token used to continue pagination or refresh.
 // The estimatedCountFn is used to provide an estimated total number of
 // items that can be returned by making additional requests using the returned
-// list token.
+// list token. If an exact count was requested using pagination.WithExactCount,
+// the estimate is replaced with the exact number of items when possible.
 func ListPlugin[T boundary.Resource](
 	ctx context.Context,
 	grantsHash []byte,
@@ -61,7 +62,21 @@ func ListPlugin[T boundary.Resource](
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 
-	resp, err := buildListResp(ctx, grantsHash, items, completeListing, listTime, estimatedCountFn)
+	var countPlg *plugin.Plugin
+	countFn := pagination.ExactCountFunc(
+		func(ctx context.Context, item T) (bool, error) {
+			return filterItemFn(ctx, item, countPlg)
+		},
+		func(ctx context.Context, prevPageLastItem T, limit int) ([]T, time.Time, error) {
+			var items []T
+			var listTime time.Time
+			var err error
+			items, countPlg, listTime, err = listItemsFn(ctx, prevPageLastItem, limit)
+			return items, listTime, err
+		},
+		estimatedCountFn,
+	)
+	resp, err := buildListResp(ctx, grantsHash, items, completeListing, listTime, countFn)
 	if err != nil {
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
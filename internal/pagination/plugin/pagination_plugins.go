@@ -33,7 +33,8 @@ type ListPluginsItemsFunc[T boundary.Resource] func(ctx context.Context, prevPag
 // The response includes a new list token used to continue pagination or refresh.
 // The estimatedCountFn is used to provide an estimated total number of
 // items that can be returned by making additional requests using the returned
-// list token.
+// list token. If an exact count was requested using pagination.WithExactCount,
+// the estimate is replaced with the exact number of items when possible.
 func ListPlugins[T boundary.Resource](
 	ctx context.Context,
 	grantsHash []byte,
@@ -62,7 +63,21 @@ func ListPlugins[T boundary.Resource](
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 
-	resp, err := buildListResp(ctx, grantsHash, items, completeListing, listTime, estimatedCountFn)
+	countPlgs := make(map[string]*plugin.Plugin)
+	countFn := pagination.ExactCountFunc(
+		func(ctx context.Context, item T) (bool, error) {
+			return filterItemFn(ctx, item, countPlgs)
+		},
+		func(ctx context.Context, prevPageLastItem T, limit int) ([]T, time.Time, error) {
+			items, plgs, listTime, err := listItemsFn(ctx, prevPageLastItem, limit)
+			for _, plg := range plgs {
+				countPlgs[plg.PublicId] = plg
+			}
+			return items, listTime, err
+		},
+		estimatedCountFn,
+	)
+	resp, err := buildListResp(ctx, grantsHash, items, completeListing, listTime, countFn)
 	if err != nil {
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
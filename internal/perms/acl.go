@@ -4,6 +4,7 @@
 package perms
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
@@ -226,10 +227,63 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 		// Descendants grants do not apply to global!
 		grants = append(grants, a.descendantsGrants...)
 	}
+	results = allowedByGrants(grants, r, aType, userId, opts)
 	results.directScopeMap = a.directScopeMap
 	results.childrenScopeMap = a.childrenScopeMap
 	results.descendantsGrants = a.descendantsGrants
+	return results
+}
+
+// ScopesAllowed describes the scopes in which the grants of an ACL allow an
+// action on a resource type. A scope is allowed if it is global and Global is
+// true, if it is not global and Descendants is true, if its id is in ScopeIds,
+// or if the id of its parent is in ChildrenOfScopeIds.
+type ScopesAllowed struct {
+	// Global is true if direct grants in the global scope allow the action.
+	Global bool
+	// Descendants is true if descendants grants allow the action.
+	Descendants bool
+	// ScopeIds are the ids of the scopes other than global in which direct
+	// grants allow the action.
+	ScopeIds []string
+	// ChildrenOfScopeIds are the ids of the scopes of the roles whose children
+	// grants allow the action.
+	ChildrenOfScopeIds []string
+}
+
+// AllowedScopes determines the scopes in which the grants for an ACL allow an
+// action on a resource type with no id. Unlike calling Allowed for every scope,
+// the grants are evaluated once per scope they apply to, so the cost does not
+// depend on the number of scopes.
+func (a ACL) AllowedScopes(requestedType resource.Type, aType action.Type, userId string, opt ...Option) ScopesAllowed {
+	opts := getOpts(opt...)
+	r := Resource{Type: requestedType}
+
+	var ret ScopesAllowed
+	ret.Descendants = allowedByGrants(a.descendantsGrants, r, aType, userId, opts).Authorized
+	for scopeId, grants := range a.directScopeMap {
+		if !allowedByGrants(grants, r, aType, userId, opts).Authorized {
+			continue
+		}
+		if scopeId == scope.Global.String() {
+			ret.Global = true
+			continue
+		}
+		ret.ScopeIds = append(ret.ScopeIds, scopeId)
+	}
+	for scopeId, grants := range a.childrenScopeMap {
+		if allowedByGrants(grants, r, aType, userId, opts).Authorized {
+			ret.ChildrenOfScopeIds = append(ret.ChildrenOfScopeIds, scopeId)
+		}
+	}
+	slices.Sort(ret.ScopeIds)
+	slices.Sort(ret.ChildrenOfScopeIds)
+	return ret
+}
 
+// allowedByGrants determines if any of the grants allow an action for a
+// resource. The scopes of the grants are not checked against the resource.
+func allowedByGrants(grants []AclGrant, r Resource, aType action.Type, userId string, opts options) (results ACLResults) {
 	var parentAction action.Type
 	split := strings.Split(aType.String(), ":")
 	if len(split) == 2 {
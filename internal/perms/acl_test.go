@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/boundary/globals"
@@ -1117,6 +1118,147 @@ func TestACL_ListResolvableAliasesPermissions(t *testing.T) {
 	}
 }
 
+func TestACL_AllowedScopes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// The scopes used to check that AllowedScopes agrees with Allowed.
+	scps := []Resource{
+		{ScopeId: scope.Global.String()},
+		{ScopeId: "o_1", ParentScopeId: scope.Global.String()},
+		{ScopeId: "o_2", ParentScopeId: scope.Global.String()},
+		{ScopeId: "p_1", ParentScopeId: "o_1"},
+		{ScopeId: "p_2", ParentScopeId: "o_2"},
+	}
+
+	tests := []struct {
+		name      string
+		aclGrants []scopeGrant
+		userId    string
+		want      ScopesAllowed
+	}{
+		{
+			name: "no grants",
+		},
+		{
+			name: "other type",
+			aclGrants: []scopeGrant{
+				{
+					roleScope:  scope.Global.String(),
+					grantScope: globals.GrantScopeDescendants,
+					grants:     []string{"ids=*;type=host-catalog;actions=list"},
+				},
+			},
+		},
+		{
+			name: "other action",
+			aclGrants: []scopeGrant{
+				{
+					grantScope: "o_1",
+					grants:     []string{"ids=*;type=target;actions=read"},
+				},
+			},
+		},
+		{
+			name: "global",
+			aclGrants: []scopeGrant{
+				{
+					grantScope: scope.Global.String(),
+					grants:     []string{"type=target;actions=list"},
+				},
+			},
+			want: ScopesAllowed{Global: true},
+		},
+		{
+			name: "descendants",
+			aclGrants: []scopeGrant{
+				{
+					roleScope:  scope.Global.String(),
+					grantScope: globals.GrantScopeDescendants,
+					grants:     []string{"ids=*;type=*;actions=*"},
+				},
+			},
+			want: ScopesAllowed{Descendants: true},
+		},
+		{
+			name: "direct and children",
+			aclGrants: []scopeGrant{
+				{
+					roleScope:         "o_1",
+					roleParentScopeId: scope.Global.String(),
+					grantScope:        "p_1",
+					grants:            []string{"ids=*;type=target;actions=list,read"},
+				},
+				{
+					roleScope:         "o_2",
+					roleParentScopeId: scope.Global.String(),
+					grantScope:        globals.GrantScopeChildren,
+					grants:            []string{"ids=*;type=*;actions=list"},
+				},
+				{
+					roleScope:         "o_1",
+					roleParentScopeId: scope.Global.String(),
+					grantScope:        "o_1",
+					grants:            []string{"ids=*;type=target;actions=read"},
+				},
+			},
+			want: ScopesAllowed{ScopeIds: []string{"p_1"}, ChildrenOfScopeIds: []string{"o_2"}},
+		},
+		{
+			name: "anonymous user",
+			aclGrants: []scopeGrant{
+				{
+					grantScope: "o_1",
+					grants:     []string{"ids=*;type=target;actions=list"},
+				},
+				{
+					grantScope: "o_2",
+					grants:     []string{"ids=*;type=scope;actions=list"},
+				},
+			},
+			userId: globals.AnonymousUserId,
+			want:   ScopesAllowed{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var grants []Grant
+			for _, sg := range tt.aclGrants {
+				if sg.roleScope == "" {
+					sg.roleScope = sg.grantScope
+				}
+				for _, g := range sg.grants {
+					grant, err := Parse(ctx, GrantTuple{RoleScopeId: sg.roleScope, RoleParentScopeId: sg.roleParentScopeId, GrantScopeId: sg.grantScope, Grant: g})
+					require.NoError(t, err)
+					grants = append(grants, grant)
+				}
+			}
+			userId := tt.userId
+			if userId == "" {
+				userId = "u_1234567890"
+			}
+
+			acl := NewACL(grants...)
+			got := acl.AllowedScopes(resource.Target, action.List, userId)
+			assert.Equal(t, tt.want, got)
+
+			for _, r := range scps {
+				r.Type = resource.Target
+				want := acl.Allowed(r, action.List, userId).Authorized
+				allowed := slices.Contains(got.ScopeIds, r.ScopeId) || slices.Contains(got.ChildrenOfScopeIds, r.ParentScopeId)
+				if r.ScopeId == scope.Global.String() {
+					allowed = allowed || got.Global
+				} else {
+					allowed = allowed || got.Descendants
+				}
+				assert.Equal(t, want, allowed, r.ScopeId)
+			}
+		})
+	}
+}
+
 func TestACL_ListPermissions(t *testing.T) {
 	t.Parallel()
 
@@ -1880,11 +2022,11 @@ func Test_AnonRestrictions(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			require, assert := require.New(t), assert.New(t)
-			for i := resource.Type(1); i <= resource.Alias; i++ {
+			for i := resource.Type(1); i <= resource.Filter; i++ {
 				if i == resource.Controller || i == resource.Worker {
 					continue
 				}
-				for j := action.Type(1); j <= action.ListResolvableAliases; j++ {
+				for j := action.Type(1); j <= action.Validate; j++ {
 					id := "foobar"
 					prefixes := globals.ResourcePrefixesFromType(resource.Type(i))
 					if len(prefixes) > 0 {
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const requestTimeout = 30 * time.Second
+
+type node struct {
+	ID              string            `json:"ID"`
+	Node            string            `json:"Node"`
+	Address         string            `json:"Address"`
+	Datacenter      string            `json:"Datacenter"`
+	TaggedAddresses map[string]string `json:"TaggedAddresses"`
+}
+
+type serviceAddress struct {
+	Address string `json:"Address"`
+	Port    int    `json:"Port"`
+}
+
+type agentService struct {
+	ID              string                    `json:"ID"`
+	Service         string                    `json:"Service"`
+	Tags            []string                  `json:"Tags"`
+	Address         string                    `json:"Address"`
+	Port            int                       `json:"Port"`
+	TaggedAddresses map[string]serviceAddress `json:"TaggedAddresses"`
+}
+
+type healthCheck struct {
+	CheckID string `json:"CheckID"`
+	Status  string `json:"Status"`
+}
+
+// serviceEntry is an instance of a service as returned by the health
+// endpoint of the Consul API.
+type serviceEntry struct {
+	Node    node          `json:"Node"`
+	Service agentService  `json:"Service"`
+	Checks  []healthCheck `json:"Checks"`
+}
+
+// apiClient is a minimal client of the Consul HTTP API which lists the
+// instances of a service.
+type apiClient struct {
+	address    string
+	datacenter string
+	token      string
+	client     *http.Client
+}
+
+func newApiClient(attrs *catalogAttributes, secrets *catalogSecrets) (*apiClient, error) {
+	address := attrs.Address
+	if address == "" {
+		address = defaultAddress
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if attrs.CaCert != "" {
+		pool, err := certPool(attrs.CaCert)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	return &apiClient{
+		address:    strings.TrimSuffix(address, "/"),
+		datacenter: attrs.Datacenter,
+		token:      secrets.Token,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+		},
+	}, nil
+}
+
+// serviceInstances returns the instances of the service which have all of
+// the given tags. If healthyOnly is set only instances whose checks are all
+// passing are returned.
+func (c *apiClient) serviceInstances(ctx context.Context, svc string, tags []string, healthyOnly bool) ([]*serviceEntry, error) {
+	q := url.Values{}
+	if c.datacenter != "" {
+		q.Set("dc", c.datacenter)
+	}
+	if healthyOnly {
+		q.Set("passing", "true")
+	}
+	for _, t := range tags {
+		q.Add("tag", t)
+	}
+	u := fmt.Sprintf("%s/v1/health/service/%s?%s", c.address, url.PathEscape(svc), q.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("X-Consul-Token", c.token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error listing instances of service %s: %w", svc, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("error listing instances of service %s: %s: %s", svc, resp.Status, strings.TrimSpace(string(body)))
+	}
+	var entries []*serviceEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("error decoding instances of service %s: %w", svc, err)
+	}
+
+	// Older Consul versions only honor the last tag parameter, so the tags
+	// and health of the instances are checked here as well.
+	ret := entries[:0]
+	for _, e := range entries {
+		if !hasTags(e.Service.Tags, tags) {
+			continue
+		}
+		if healthyOnly && !passing(e.Checks) {
+			continue
+		}
+		if e.Node.Datacenter == "" {
+			e.Node.Datacenter = c.datacenter
+		}
+		ret = append(ret, e)
+	}
+	return ret, nil
+}
+
+func hasTags(have, want []string) bool {
+	for _, w := range want {
+		found := false
+		for _, h := range have {
+			if h == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func passing(checks []healthCheck) bool {
+	for _, c := range checks {
+		if c.Status != "passing" {
+			return false
+		}
+	}
+	return true
+}
+
+func certPool(pemCert string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(pemCert)) {
+		return nil, fmt.Errorf("no valid PEM encoded certificate found")
+	}
+	return pool, nil
+}
@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package consul provides a built-in host plugin which populates host sets
+// from the instances of services registered in the Consul catalog, so service
+// inventory kept in Consul does not have to be duplicated into Boundary.
+//
+// Each service instance becomes a host with the address of the service, or
+// the address of its node if the service does not set one, and the Consul
+// DNS name of its node. The port of the instance is listed in the description
+// of the host.
+package consul
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// PluginName is the name the Consul host plugin is registered under.
+	PluginName = "consul"
+
+	// AddressAttrField is the host catalog attribute holding the URL of the
+	// Consul HTTP API. It defaults to http://127.0.0.1:8500.
+	AddressAttrField = "address"
+	// DatacenterAttrField is the host catalog attribute holding the Consul
+	// datacenter to query. If it is not set, the datacenter of the agent is
+	// used.
+	DatacenterAttrField = "datacenter"
+	// CaCertAttrField is the host catalog attribute holding the PEM encoded CA
+	// certificate used to verify the Consul HTTP API.
+	CaCertAttrField = "ca_cert"
+
+	// TokenSecretField is the host catalog secret holding the ACL token used
+	// to query Consul.
+	TokenSecretField = "token"
+
+	// ServicesAttrField is the host set attribute holding the names of the
+	// services whose instances are added to the set.
+	ServicesAttrField = "services"
+	// TagsAttrField is the host set attribute holding tags an instance must
+	// all have to be added to the set.
+	TagsAttrField = "tags"
+	// HealthyOnlyAttrField is the host set attribute which, when true, only
+	// adds instances whose health checks are all passing.
+	HealthyOnlyAttrField = "healthy_only"
+
+	defaultAddress = "http://127.0.0.1:8500"
+)
+
+var _ plgpb.HostPluginServiceServer = (*ConsulPlugin)(nil)
+
+// taggedAddressKeys are the keys of the tagged addresses of nodes and services
+// which are added to hosts, in order of preference.
+var taggedAddressKeys = []string{"lan", "lan_ipv4", "lan_ipv6", "wan", "wan_ipv4", "wan_ipv6"}
+
+type catalogAttributes struct {
+	Address    string `mapstructure:"address"`
+	Datacenter string `mapstructure:"datacenter"`
+	CaCert     string `mapstructure:"ca_cert"`
+}
+
+type catalogSecrets struct {
+	Token string `mapstructure:"token"`
+}
+
+type setAttributes struct {
+	Services    []string `mapstructure:"services"`
+	Tags        []string `mapstructure:"tags"`
+	HealthyOnly bool     `mapstructure:"healthy_only"`
+}
+
+// ConsulPlugin is a host plugin which discovers hosts from the Consul
+// catalog. It keeps no state and is safe for concurrent use.
+type ConsulPlugin struct {
+	plgpb.UnimplementedHostPluginServiceServer
+}
+
+// NewConsulPlugin returns a new Consul host plugin.
+func NewConsulPlugin() (*ConsulPlugin, error) {
+	return &ConsulPlugin{}, nil
+}
+
+// OnCreateCatalog validates the attributes of the new catalog and persists its
+// secrets.
+func (p *ConsulPlugin) OnCreateCatalog(_ context.Context, req *plgpb.OnCreateCatalogRequest) (*plgpb.OnCreateCatalogResponse, error) {
+	cat := req.GetCatalog()
+	if _, err := getCatalogAttributes(cat); err != nil {
+		return nil, err
+	}
+	persisted, err := getPersisted(cat.GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateCatalogResponse{Persisted: persisted}, nil
+}
+
+// OnUpdateCatalog validates the attributes of the updated catalog. New secrets
+// replace the persisted ones.
+func (p *ConsulPlugin) OnUpdateCatalog(_ context.Context, req *plgpb.OnUpdateCatalogRequest) (*plgpb.OnUpdateCatalogResponse, error) {
+	cat := req.GetNewCatalog()
+	if _, err := getCatalogAttributes(cat); err != nil {
+		return nil, err
+	}
+	if cat.GetSecrets() == nil {
+		return &plgpb.OnUpdateCatalogResponse{}, nil
+	}
+	persisted, err := getPersisted(cat.GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateCatalogResponse{Persisted: persisted}, nil
+}
+
+// OnDeleteCatalog is a no-op, the plugin keeps no state for a catalog.
+func (p *ConsulPlugin) OnDeleteCatalog(context.Context, *plgpb.OnDeleteCatalogRequest) (*plgpb.OnDeleteCatalogResponse, error) {
+	return &plgpb.OnDeleteCatalogResponse{}, nil
+}
+
+// OnCreateSet validates the attributes of the new set.
+func (p *ConsulPlugin) OnCreateSet(_ context.Context, req *plgpb.OnCreateSetRequest) (*plgpb.OnCreateSetResponse, error) {
+	if _, err := getSetAttributes(req.GetSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateSetResponse{}, nil
+}
+
+// OnUpdateSet validates the attributes of the updated set.
+func (p *ConsulPlugin) OnUpdateSet(_ context.Context, req *plgpb.OnUpdateSetRequest) (*plgpb.OnUpdateSetResponse, error) {
+	if _, err := getSetAttributes(req.GetNewSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateSetResponse{}, nil
+}
+
+// OnDeleteSet is a no-op, the plugin keeps no state for a set.
+func (p *ConsulPlugin) OnDeleteSet(context.Context, *plgpb.OnDeleteSetRequest) (*plgpb.OnDeleteSetResponse, error) {
+	return &plgpb.OnDeleteSetResponse{}, nil
+}
+
+// ListHosts queries Consul for the instances of the services of each of the
+// requested sets and returns the hosts found. An instance which is selected
+// by several sets is returned once with the IDs of all of those sets.
+func (p *ConsulPlugin) ListHosts(ctx context.Context, req *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+	const op = "consul.(ConsulPlugin).ListHosts"
+	catAttrs, err := getCatalogAttributes(req.GetCatalog())
+	if err != nil {
+		return nil, err
+	}
+	secrets := new(catalogSecrets)
+	if req.GetPersisted().GetSecrets() != nil {
+		if err := decodeAttributes(req.GetPersisted().GetSecrets(), secrets); err != nil {
+			return nil, err
+		}
+	}
+	client, err := newApiClient(catAttrs, secrets)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	hosts := make(map[string]*plgpb.ListHostsResponseHost)
+	for _, set := range req.GetSets() {
+		setAttrs, err := getSetAttributes(set)
+		if err != nil {
+			return nil, err
+		}
+		for _, svc := range setAttrs.Services {
+			entries, err := client.serviceInstances(ctx, svc, setAttrs.Tags, setAttrs.HealthyOnly)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("host set %s", set.GetId())))
+			}
+			for _, e := range entries {
+				f := instanceHost(e)
+				h, ok := hosts[f.GetExternalId()]
+				if !ok {
+					h = f
+					hosts[f.GetExternalId()] = h
+				}
+				if !slices.Contains(h.SetIds, set.GetId()) {
+					h.SetIds = append(h.SetIds, set.GetId())
+				}
+			}
+		}
+	}
+
+	resp := &plgpb.ListHostsResponse{
+		Hosts: make([]*plgpb.ListHostsResponseHost, 0, len(hosts)),
+	}
+	for _, h := range hosts {
+		resp.Hosts = append(resp.Hosts, h)
+	}
+	sort.Slice(resp.Hosts, func(i, j int) bool {
+		return resp.Hosts[i].GetExternalId() < resp.Hosts[j].GetExternalId()
+	})
+	return resp, nil
+}
+
+// instanceHost maps a service instance to a host. The external ID combines
+// the node and service IDs, which together identify the instance.
+func instanceHost(e *serviceEntry) *plgpb.ListHostsResponseHost {
+	h := &plgpb.ListHostsResponseHost{
+		ExternalId:   fmt.Sprintf("%s/%s", e.Node.Node, e.Service.ID),
+		ExternalName: e.Service.ID,
+	}
+	if e.Service.Port > 0 {
+		h.Description = fmt.Sprintf("Port: %d", e.Service.Port)
+	}
+	addAddress := func(addr string) {
+		if addr == "" {
+			return
+		}
+		if net.ParseIP(addr) != nil {
+			if !slices.Contains(h.IpAddresses, addr) {
+				h.IpAddresses = append(h.IpAddresses, addr)
+			}
+			return
+		}
+		if !slices.Contains(h.DnsNames, addr) {
+			h.DnsNames = append(h.DnsNames, addr)
+		}
+	}
+	addAddress(e.Service.Address)
+	addAddress(e.Node.Address)
+	for _, k := range taggedAddressKeys {
+		addAddress(e.Service.TaggedAddresses[k].Address)
+	}
+	for _, k := range taggedAddressKeys {
+		addAddress(e.Node.TaggedAddresses[k])
+	}
+	if e.Node.Node != "" && e.Node.Datacenter != "" {
+		addAddress(fmt.Sprintf("%s.node.%s.consul", e.Node.Node, e.Node.Datacenter))
+	}
+	return h
+}
+
+func getCatalogAttributes(cat *hostcatalogs.HostCatalog) (*catalogAttributes, error) {
+	attrs := new(catalogAttributes)
+	if cat == nil || cat.GetAttributes() == nil {
+		return attrs, nil
+	}
+	if err := decodeAttributes(cat.GetAttributes(), attrs); err != nil {
+		return nil, err
+	}
+	if attrs.Address != "" {
+		u, err := url.Parse(attrs.Address)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: must be an http or https URL", AddressAttrField)
+		}
+	}
+	if attrs.CaCert != "" {
+		if _, err := certPool(attrs.CaCert); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: %v", CaCertAttrField, err)
+		}
+	}
+	return attrs, nil
+}
+
+func getPersisted(secrets *structpb.Struct) (*plgpb.HostCatalogPersisted, error) {
+	if secrets == nil {
+		return nil, nil
+	}
+	if err := decodeAttributes(secrets, new(catalogSecrets)); err != nil {
+		return nil, err
+	}
+	return &plgpb.HostCatalogPersisted{Secrets: secrets}, nil
+}
+
+func getSetAttributes(set *hostsets.HostSet) (*setAttributes, error) {
+	attrs := new(setAttributes)
+	if set != nil && set.GetAttributes() != nil {
+		if err := decodeAttributes(set.GetAttributes(), attrs); err != nil {
+			return nil, err
+		}
+	}
+	if len(attrs.Services) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: at least one service name is required", ServicesAttrField)
+	}
+	for _, s := range attrs.Services {
+		if strings.TrimSpace(s) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: service names must not be empty", ServicesAttrField)
+		}
+	}
+	for _, t := range attrs.Tags {
+		if strings.TrimSpace(t) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: tags must not be empty", TagsAttrField)
+		}
+	}
+	return attrs, nil
+}
+
+func decodeAttributes(in *structpb.Struct, out any) error {
+	md := new(mapstructure.Metadata)
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Metadata:         md,
+		Result:           out,
+		WeaklyTypedInput: true,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to create attributes decoder: %v", err)
+	}
+	if err := dec.Decode(in.AsMap()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid attributes: %v", err)
+	}
+	if len(md.Unused) > 0 {
+		slices.Sort(md.Unused)
+		return status.Errorf(codes.InvalidArgument, "unrecognized attributes: %s", strings.Join(md.Unused, ", "))
+	}
+	return nil
+}
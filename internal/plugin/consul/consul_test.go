@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func testStruct(t *testing.T, m map[string]any) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(m)
+	require.NoError(t, err)
+	return s
+}
+
+func testSet(t *testing.T, id string, attrs map[string]any) *hostsets.HostSet {
+	t.Helper()
+	return &hostsets.HostSet{Id: id, Attrs: &hostsets.HostSet_Attributes{Attributes: testStruct(t, attrs)}}
+}
+
+func TestConsulPlugin_ListHosts(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTestServer(t)
+	srv.SetInstances(
+		&TestInstance{Node: "node-1", NodeAddress: "10.0.0.1", Service: "web", ID: "web-1", Port: 8080, Tags: []string{"prod", "v2"}, Healthy: true},
+		&TestInstance{Node: "node-2", NodeAddress: "10.0.0.2", Service: "web", ID: "web-2", Address: "192.168.1.2", Port: 8080, Tags: []string{"prod"}, Healthy: false},
+		&TestInstance{Node: "node-3", NodeAddress: "10.0.0.3", Service: "web", ID: "web-3", Address: "web-3.example.com", Port: 8080, Tags: []string{"staging"}, Healthy: true},
+		&TestInstance{Node: "node-1", NodeAddress: "10.0.0.1", Service: "db", ID: "db-1", Port: 5432, Tags: []string{"prod"}, Healthy: true},
+	)
+
+	plg, err := NewConsulPlugin()
+	require.NoError(t, err)
+	req := &plgpb.ListHostsRequest{
+		Catalog: &hostcatalogs.HostCatalog{Attrs: &hostcatalogs.HostCatalog_Attributes{Attributes: testStruct(t, srv.CatalogAttributes())}},
+		Persisted: &plgpb.HostCatalogPersisted{
+			Secrets: testStruct(t, srv.CatalogSecrets()),
+		},
+	}
+
+	tests := []struct {
+		name string
+		sets []*hostsets.HostSet
+		want []*plgpb.ListHostsResponseHost
+	}{
+		{
+			name: "all instances",
+			sets: []*hostsets.HostSet{testSet(t, "s1", map[string]any{ServicesAttrField: []any{"web"}})},
+			want: []*plgpb.ListHostsResponseHost{
+				{
+					ExternalId:   "node-1/web-1",
+					ExternalName: "web-1",
+					Description:  "Port: 8080",
+					SetIds:       []string{"s1"},
+					IpAddresses:  []string{"10.0.0.1"},
+					DnsNames:     []string{"node-1.node.dc1.consul"},
+				},
+				{
+					ExternalId:   "node-2/web-2",
+					ExternalName: "web-2",
+					Description:  "Port: 8080",
+					SetIds:       []string{"s1"},
+					IpAddresses:  []string{"192.168.1.2", "10.0.0.2"},
+					DnsNames:     []string{"node-2.node.dc1.consul"},
+				},
+				{
+					ExternalId:   "node-3/web-3",
+					ExternalName: "web-3",
+					Description:  "Port: 8080",
+					SetIds:       []string{"s1"},
+					IpAddresses:  []string{"10.0.0.3"},
+					DnsNames:     []string{"web-3.example.com", "node-3.node.dc1.consul"},
+				},
+			},
+		},
+		{
+			name: "tags and health",
+			sets: []*hostsets.HostSet{testSet(t, "s1", map[string]any{ServicesAttrField: []any{"web"}, TagsAttrField: []any{"prod"}, HealthyOnlyAttrField: true})},
+			want: []*plgpb.ListHostsResponseHost{
+				{
+					ExternalId:   "node-1/web-1",
+					ExternalName: "web-1",
+					Description:  "Port: 8080",
+					SetIds:       []string{"s1"},
+					IpAddresses:  []string{"10.0.0.1"},
+					DnsNames:     []string{"node-1.node.dc1.consul"},
+				},
+			},
+		},
+		{
+			name: "multiple tags",
+			sets: []*hostsets.HostSet{testSet(t, "s1", map[string]any{ServicesAttrField: []any{"web"}, TagsAttrField: []any{"prod", "v2"}})},
+			want: []*plgpb.ListHostsResponseHost{
+				{
+					ExternalId:   "node-1/web-1",
+					ExternalName: "web-1",
+					Description:  "Port: 8080",
+					SetIds:       []string{"s1"},
+					IpAddresses:  []string{"10.0.0.1"},
+					DnsNames:     []string{"node-1.node.dc1.consul"},
+				},
+			},
+		},
+		{
+			name: "hosts shared between sets",
+			sets: []*hostsets.HostSet{
+				testSet(t, "s1", map[string]any{ServicesAttrField: []any{"db", "web"}, TagsAttrField: []any{"prod"}, HealthyOnlyAttrField: true}),
+				testSet(t, "s2", map[string]any{ServicesAttrField: []any{"db"}}),
+			},
+			want: []*plgpb.ListHostsResponseHost{
+				{
+					ExternalId:   "node-1/db-1",
+					ExternalName: "db-1",
+					Description:  "Port: 5432",
+					SetIds:       []string{"s1", "s2"},
+					IpAddresses:  []string{"10.0.0.1"},
+					DnsNames:     []string{"node-1.node.dc1.consul"},
+				},
+				{
+					ExternalId:   "node-1/web-1",
+					ExternalName: "web-1",
+					Description:  "Port: 8080",
+					SetIds:       []string{"s1"},
+					IpAddresses:  []string{"10.0.0.1"},
+					DnsNames:     []string{"node-1.node.dc1.consul"},
+				},
+			},
+		},
+		{
+			name: "unknown service",
+			sets: []*hostsets.HostSet{testSet(t, "s1", map[string]any{ServicesAttrField: []any{"cache"}})},
+			want: []*plgpb.ListHostsResponseHost{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			req.Sets = tt.sets
+			got, err := plg.ListHosts(ctx, req)
+			require.NoError(err)
+			require.Len(got.GetHosts(), len(tt.want))
+			for i, h := range got.GetHosts() {
+				assert.Equal(tt.want[i].GetExternalId(), h.GetExternalId())
+				assert.Equal(tt.want[i].GetExternalName(), h.GetExternalName())
+				assert.Equal(tt.want[i].GetDescription(), h.GetDescription())
+				assert.Equal(tt.want[i].GetSetIds(), h.GetSetIds())
+				assert.Equal(tt.want[i].GetIpAddresses(), h.GetIpAddresses())
+				assert.Equal(tt.want[i].GetDnsNames(), h.GetDnsNames())
+			}
+		})
+	}
+
+	t.Run("bad token", func(t *testing.T) {
+		_, err := plg.ListHosts(ctx, &plgpb.ListHostsRequest{
+			Catalog:   req.Catalog,
+			Persisted: &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]any{TokenSecretField: "wrong"})},
+			Sets:      []*hostsets.HostSet{testSet(t, "s1", map[string]any{ServicesAttrField: []any{"web"}})},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "403")
+	})
+}
+
+func TestConsulPlugin_Validation(t *testing.T) {
+	ctx := context.Background()
+	plg, err := NewConsulPlugin()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		attrs   map[string]any
+		secrets map[string]any
+		set     map[string]any
+	}{
+		{
+			name:  "bad address",
+			attrs: map[string]any{AddressAttrField: "consul:8500"},
+		},
+		{
+			name:  "bad ca cert",
+			attrs: map[string]any{CaCertAttrField: "not a certificate"},
+		},
+		{
+			name:  "unknown attribute",
+			attrs: map[string]any{"namespace": "default"},
+		},
+		{
+			name:    "unknown secret",
+			attrs:   map[string]any{},
+			secrets: map[string]any{"password": "secret"},
+		},
+		{
+			name: "missing services",
+			set:  map[string]any{TagsAttrField: []any{"prod"}},
+		},
+		{
+			name: "empty service name",
+			set:  map[string]any{ServicesAttrField: []any{"web", " "}},
+		},
+		{
+			name: "empty tag",
+			set:  map[string]any{ServicesAttrField: []any{"web"}, TagsAttrField: []any{""}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.set == nil {
+				cat := &hostcatalogs.HostCatalog{Attrs: &hostcatalogs.HostCatalog_Attributes{Attributes: testStruct(t, tt.attrs)}}
+				if tt.secrets != nil {
+					cat.Secrets = testStruct(t, tt.secrets)
+				}
+				_, err = plg.OnCreateCatalog(ctx, &plgpb.OnCreateCatalogRequest{Catalog: cat})
+			} else {
+				_, err = plg.OnCreateSet(ctx, &plgpb.OnCreateSetRequest{Set: testSet(t, "s1", tt.set)})
+			}
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
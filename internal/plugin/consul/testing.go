@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestInstance is an instance of a service registered with a TestServer.
+type TestInstance struct {
+	Node        string
+	NodeAddress string
+	Service     string
+	ID          string
+	Address     string
+	Port        int
+	Tags        []string
+	// Healthy reports whether the checks of the instance are passing.
+	Healthy bool
+}
+
+// TestServer is an in-memory fake of the parts of the Consul HTTP API used by
+// the plugin. It serves the health endpoint for services over TLS, requires
+// an ACL token and filters by tag and health. It is safe for concurrent use.
+type TestServer struct {
+	// Address is the URL of the server, for the address attribute.
+	Address string
+	// CaCert is the PEM encoded certificate of the server, for the ca_cert
+	// attribute.
+	CaCert string
+	// Token is the ACL token the server requires, for the token secret.
+	Token string
+	// Datacenter is the only datacenter the server knows about.
+	Datacenter string
+
+	mu        sync.Mutex
+	instances []*TestInstance
+}
+
+// NewTestServer starts a TestServer which is stopped when the test finishes.
+func NewTestServer(t testing.TB) *TestServer {
+	t.Helper()
+	s := &TestServer{
+		Token:      "test-token",
+		Datacenter: "dc1",
+	}
+	srv := httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(srv.Close)
+	s.Address = srv.URL
+	s.CaCert = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	return s
+}
+
+// CatalogAttributes returns the host catalog attributes to reach the server.
+func (s *TestServer) CatalogAttributes() map[string]any {
+	return map[string]any{
+		AddressAttrField:    s.Address,
+		CaCertAttrField:     s.CaCert,
+		DatacenterAttrField: s.Datacenter,
+	}
+}
+
+// CatalogSecrets returns the host catalog secrets to authenticate to the
+// server.
+func (s *TestServer) CatalogSecrets() map[string]any {
+	return map[string]any{
+		TokenSecretField: s.Token,
+	}
+}
+
+// SetInstances replaces the service instances served.
+func (s *TestServer) SetInstances(instances ...*TestInstance) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.instances = instances
+}
+
+func (s *TestServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Consul-Token") != s.Token {
+		http.Error(w, "ACL not found", http.StatusForbidden)
+		return
+	}
+	svc, ok := strings.CutPrefix(r.URL.Path, "/v1/health/service/")
+	if r.Method != http.MethodGet || !ok || svc == "" {
+		http.NotFound(w, r)
+		return
+	}
+	q := r.URL.Query()
+	if dc := q.Get("dc"); dc != "" && dc != s.Datacenter {
+		http.Error(w, "No path to datacenter", http.StatusInternalServerError)
+		return
+	}
+	passingOnly := q.Has("passing")
+
+	s.mu.Lock()
+	entries := []*serviceEntry{}
+	for _, i := range s.instances {
+		if i.Service != svc || !hasTags(i.Tags, q["tag"]) || (passingOnly && !i.Healthy) {
+			continue
+		}
+		entries = append(entries, i.toApi(s.Datacenter))
+	}
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(entries)
+}
+
+func (i *TestInstance) toApi(dc string) *serviceEntry {
+	status := "passing"
+	if !i.Healthy {
+		status = "critical"
+	}
+	return &serviceEntry{
+		Node: node{
+			Node:       i.Node,
+			Address:    i.NodeAddress,
+			Datacenter: dc,
+		},
+		Service: agentService{
+			ID:      i.ID,
+			Service: i.Service,
+			Tags:    i.Tags,
+			Address: i.Address,
+			Port:    i.Port,
+		},
+		Checks: []healthCheck{
+			{CheckID: "serfHealth", Status: "passing"},
+			{CheckID: "service:" + i.ID, Status: status},
+		},
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package dns provides a built-in host plugin which populates host sets from
+// DNS queries. It allows environments without a cloud API, such as bare metal
+// deployments that publish their services in DNS, to use dynamic host
+// catalogs.
+//
+// A host set lists one or more DNS names and the type of record to look up for
+// them. For A and AAAA records every address becomes a host. For SRV records
+// every target becomes a host, with the addresses of the target as its IP
+// addresses. Answers are cached for their TTL, so a host set can use a short
+// sync interval without querying the resolver more often than the records
+// change.
+package dns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// PluginName is the name the DNS host plugin is registered under.
+	PluginName = "dns"
+
+	// ResolverAddressAttrField is the host catalog attribute holding the
+	// address of the DNS server to query, as host or host:port. If it is not
+	// set, the first name server from /etc/resolv.conf is used.
+	ResolverAddressAttrField = "resolver_address"
+	// TimeoutSecondsAttrField is the host catalog attribute holding the
+	// timeout for a single DNS query in seconds.
+	TimeoutSecondsAttrField = "timeout_seconds"
+
+	// NamesAttrField is the host set attribute holding the DNS names to query.
+	NamesAttrField = "names"
+	// RecordTypeAttrField is the host set attribute holding the type of record
+	// to query for the names: A, AAAA or SRV. It defaults to A.
+	RecordTypeAttrField = "record_type"
+
+	defaultQueryTimeout = 5 * time.Second
+)
+
+// Record types supported in the record_type host set attribute.
+const (
+	RecordTypeA    = "A"
+	RecordTypeAAAA = "AAAA"
+	RecordTypeSRV  = "SRV"
+)
+
+var _ plgpb.HostPluginServiceServer = (*DnsPlugin)(nil)
+
+type catalogAttributes struct {
+	ResolverAddress string `mapstructure:"resolver_address"`
+	TimeoutSeconds  uint32 `mapstructure:"timeout_seconds"`
+}
+
+type setAttributes struct {
+	Names      []string `mapstructure:"names"`
+	RecordType string   `mapstructure:"record_type"`
+}
+
+// DnsPlugin is a host plugin which discovers hosts by querying DNS. It is
+// safe for concurrent use.
+type DnsPlugin struct {
+	plgpb.UnimplementedHostPluginServiceServer
+
+	resolver *resolver
+}
+
+// NewDnsPlugin returns a new DNS host plugin. WithDefaultResolverAddress is
+// the only supported option.
+func NewDnsPlugin(opt ...Option) (*DnsPlugin, error) {
+	opts := getOpts(opt...)
+	return &DnsPlugin{
+		resolver: newResolver(opts.withDefaultResolverAddress),
+	}, nil
+}
+
+// OnCreateCatalog validates the attributes of the new catalog.
+func (p *DnsPlugin) OnCreateCatalog(_ context.Context, req *plgpb.OnCreateCatalogRequest) (*plgpb.OnCreateCatalogResponse, error) {
+	if _, err := getCatalogAttributes(req.GetCatalog()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateCatalogResponse{}, nil
+}
+
+// OnUpdateCatalog validates the attributes of the updated catalog.
+func (p *DnsPlugin) OnUpdateCatalog(_ context.Context, req *plgpb.OnUpdateCatalogRequest) (*plgpb.OnUpdateCatalogResponse, error) {
+	if _, err := getCatalogAttributes(req.GetNewCatalog()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateCatalogResponse{}, nil
+}
+
+// OnDeleteCatalog is a no-op, the plugin keeps no state for a catalog.
+func (p *DnsPlugin) OnDeleteCatalog(context.Context, *plgpb.OnDeleteCatalogRequest) (*plgpb.OnDeleteCatalogResponse, error) {
+	return &plgpb.OnDeleteCatalogResponse{}, nil
+}
+
+// NormalizeSetData upper cases the record type of the set so it is stored
+// consistently.
+func (p *DnsPlugin) NormalizeSetData(_ context.Context, req *plgpb.NormalizeSetDataRequest) (*plgpb.NormalizeSetDataResponse, error) {
+	attrs := req.GetAttributes()
+	if attrs == nil {
+		return &plgpb.NormalizeSetDataResponse{}, nil
+	}
+	if v, ok := attrs.GetFields()[RecordTypeAttrField]; ok {
+		if s, ok := v.GetKind().(*structpb.Value_StringValue); ok {
+			attrs.GetFields()[RecordTypeAttrField] = structpb.NewStringValue(strings.ToUpper(strings.TrimSpace(s.StringValue)))
+		}
+	}
+	return &plgpb.NormalizeSetDataResponse{Attributes: attrs}, nil
+}
+
+// OnCreateSet validates the attributes of the new set.
+func (p *DnsPlugin) OnCreateSet(_ context.Context, req *plgpb.OnCreateSetRequest) (*plgpb.OnCreateSetResponse, error) {
+	if _, err := getSetAttributes(req.GetSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateSetResponse{}, nil
+}
+
+// OnUpdateSet validates the attributes of the updated set.
+func (p *DnsPlugin) OnUpdateSet(_ context.Context, req *plgpb.OnUpdateSetRequest) (*plgpb.OnUpdateSetResponse, error) {
+	if _, err := getSetAttributes(req.GetNewSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateSetResponse{}, nil
+}
+
+// OnDeleteSet is a no-op, the plugin keeps no state for a set.
+func (p *DnsPlugin) OnDeleteSet(context.Context, *plgpb.OnDeleteSetRequest) (*plgpb.OnDeleteSetResponse, error) {
+	return &plgpb.OnDeleteSetResponse{}, nil
+}
+
+// ListHosts queries DNS for the names of each of the requested sets and
+// returns the hosts found. A host which is found for several sets is returned
+// once with the IDs of all of those sets. Names which do not exist result in no
+// hosts, but any other failure to query DNS fails the whole request so hosts
+// are not removed from a set because of a transient resolver error.
+func (p *DnsPlugin) ListHosts(ctx context.Context, req *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+	const op = "dns.(DnsPlugin).ListHosts"
+	catAttrs, err := getCatalogAttributes(req.GetCatalog())
+	if err != nil {
+		return nil, err
+	}
+	server := catAttrs.ResolverAddress
+	timeout := defaultQueryTimeout
+	if catAttrs.TimeoutSeconds > 0 {
+		timeout = time.Duration(catAttrs.TimeoutSeconds) * time.Second
+	}
+
+	hosts := make(map[string]*plgpb.ListHostsResponseHost)
+	for _, set := range req.GetSets() {
+		setAttrs, err := getSetAttributes(set)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range setAttrs.Names {
+			found, err := p.resolver.lookup(ctx, server, timeout, setAttrs.RecordType, name)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("host set %s", set.GetId())))
+			}
+			for _, f := range found {
+				h, ok := hosts[f.externalId]
+				if !ok {
+					h = &plgpb.ListHostsResponseHost{
+						ExternalId:   f.externalId,
+						ExternalName: f.externalName,
+					}
+					hosts[f.externalId] = h
+				}
+				h.SetIds = appendUnique(h.SetIds, set.GetId())
+				h.IpAddresses = appendUnique(h.IpAddresses, f.ipAddresses...)
+				h.DnsNames = appendUnique(h.DnsNames, f.dnsNames...)
+			}
+		}
+	}
+
+	resp := &plgpb.ListHostsResponse{
+		Hosts: make([]*plgpb.ListHostsResponseHost, 0, len(hosts)),
+	}
+	for _, h := range hosts {
+		resp.Hosts = append(resp.Hosts, h)
+	}
+	sort.Slice(resp.Hosts, func(i, j int) bool {
+		return resp.Hosts[i].GetExternalId() < resp.Hosts[j].GetExternalId()
+	})
+	return resp, nil
+}
+
+func getCatalogAttributes(cat *hostcatalogs.HostCatalog) (*catalogAttributes, error) {
+	attrs := new(catalogAttributes)
+	if cat == nil || cat.GetAttributes() == nil {
+		return attrs, nil
+	}
+	if err := decodeAttributes(cat.GetAttributes(), attrs); err != nil {
+		return nil, err
+	}
+	if attrs.ResolverAddress != "" {
+		if _, err := resolverAddress(attrs.ResolverAddress); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: %v", ResolverAddressAttrField, err)
+		}
+	}
+	return attrs, nil
+}
+
+func getSetAttributes(set *hostsets.HostSet) (*setAttributes, error) {
+	attrs := new(setAttributes)
+	if set != nil && set.GetAttributes() != nil {
+		if err := decodeAttributes(set.GetAttributes(), attrs); err != nil {
+			return nil, err
+		}
+	}
+	if len(attrs.Names) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: at least one DNS name is required", NamesAttrField)
+	}
+	for _, n := range attrs.Names {
+		if strings.TrimSpace(n) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: DNS names must not be empty", NamesAttrField)
+		}
+	}
+	attrs.RecordType = strings.ToUpper(strings.TrimSpace(attrs.RecordType))
+	switch attrs.RecordType {
+	case "":
+		attrs.RecordType = RecordTypeA
+	case RecordTypeA, RecordTypeAAAA, RecordTypeSRV:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: unsupported record type %q, must be one of %s, %s or %s", RecordTypeAttrField, attrs.RecordType, RecordTypeA, RecordTypeAAAA, RecordTypeSRV)
+	}
+	return attrs, nil
+}
+
+func decodeAttributes(in *structpb.Struct, out any) error {
+	md := new(mapstructure.Metadata)
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Metadata:         md,
+		Result:           out,
+		WeaklyTypedInput: true,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to create attributes decoder: %v", err)
+	}
+	if err := dec.Decode(in.AsMap()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid attributes: %v", err)
+	}
+	if len(md.Unused) > 0 {
+		slices.Sort(md.Unused)
+		return status.Errorf(codes.InvalidArgument, "unrecognized attributes: %s", strings.Join(md.Unused, ", "))
+	}
+	return nil
+}
+
+// appendUnique appends the values which are not already in s.
+func appendUnique(s []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(s, v) {
+			s = append(s, v)
+		}
+	}
+	return s
+}
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dns
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// testDnsServer starts a DNS server answering from records and returns its
+// address and a counter of the queries it received.
+func testDnsServer(t *testing.T, records map[string][]string) (string, *atomic.Int32) {
+	t.Helper()
+	zone := make(map[uint16]map[string][]dns.RR)
+	for name, rrs := range records {
+		for _, s := range rrs {
+			rr, err := dns.NewRR(s)
+			require.NoError(t, err)
+			qtype := rr.Header().Rrtype
+			if zone[qtype] == nil {
+				zone[qtype] = make(map[string][]dns.RR)
+			}
+			zone[qtype][dns.Fqdn(name)] = append(zone[qtype][dns.Fqdn(name)], rr)
+		}
+	}
+
+	var queries atomic.Int32
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &dns.Server{
+		PacketConn: pc,
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			queries.Add(1)
+			m := new(dns.Msg)
+			m.SetReply(req)
+			q := req.Question[0]
+			m.Answer = zone[q.Qtype][q.Name]
+			if len(m.Answer) == 0 {
+				m.Rcode = dns.RcodeNameError
+			}
+			_ = w.WriteMsg(m)
+		}),
+	}
+	go func() { _ = srv.ActivateAndServe() }()
+	t.Cleanup(func() { _ = srv.Shutdown() })
+	return pc.LocalAddr().String(), &queries
+}
+
+func testCatalog(t *testing.T, attrs map[string]any) *hostcatalogs.HostCatalog {
+	t.Helper()
+	s, err := structpb.NewStruct(attrs)
+	require.NoError(t, err)
+	return &hostcatalogs.HostCatalog{Attrs: &hostcatalogs.HostCatalog_Attributes{Attributes: s}}
+}
+
+func testSet(t *testing.T, id string, attrs map[string]any) *hostsets.HostSet {
+	t.Helper()
+	s, err := structpb.NewStruct(attrs)
+	require.NoError(t, err)
+	return &hostsets.HostSet{Id: id, Attrs: &hostsets.HostSet_Attributes{Attributes: s}}
+}
+
+func TestDnsPlugin_ListHosts(t *testing.T) {
+	ctx := context.Background()
+	addr, _ := testDnsServer(t, map[string][]string{
+		"web.corp": {
+			"web.corp. 60 IN A 10.0.0.1",
+			"web.corp. 60 IN A 10.0.0.2",
+			"web.corp. 60 IN AAAA 2001:db8::1",
+		},
+		"api.corp": {
+			"api.corp. 60 IN A 10.0.0.2",
+		},
+		"_db._tcp.corp": {
+			"_db._tcp.corp. 60 IN SRV 0 0 5432 db1.corp.",
+			"_db._tcp.corp. 60 IN SRV 0 0 6432 db1.corp.",
+			"_db._tcp.corp. 60 IN SRV 0 0 5432 db2.corp.",
+		},
+		"db1.corp": {
+			"db1.corp. 60 IN A 10.0.1.1",
+		},
+		"db2.corp": {
+			"db2.corp. 60 IN A 10.0.1.2",
+			"db2.corp. 60 IN AAAA 2001:db8::2",
+		},
+	})
+	plg, err := NewDnsPlugin()
+	require.NoError(t, err)
+	cat := testCatalog(t, map[string]any{ResolverAddressAttrField: addr})
+
+	tests := []struct {
+		name string
+		sets []*hostsets.HostSet
+		want []*plgpb.ListHostsResponseHost
+	}{
+		{
+			name: "a records",
+			sets: []*hostsets.HostSet{testSet(t, "s1", map[string]any{NamesAttrField: []any{"web.corp"}})},
+			want: []*plgpb.ListHostsResponseHost{
+				{ExternalId: "10.0.0.1", ExternalName: "web.corp", SetIds: []string{"s1"}, IpAddresses: []string{"10.0.0.1"}, DnsNames: []string{"web.corp"}},
+				{ExternalId: "10.0.0.2", ExternalName: "web.corp", SetIds: []string{"s1"}, IpAddresses: []string{"10.0.0.2"}, DnsNames: []string{"web.corp"}},
+			},
+		},
+		{
+			name: "aaaa records",
+			sets: []*hostsets.HostSet{testSet(t, "s1", map[string]any{NamesAttrField: []any{"web.corp"}, RecordTypeAttrField: "aaaa"})},
+			want: []*plgpb.ListHostsResponseHost{
+				{ExternalId: "2001:db8::1", ExternalName: "web.corp", SetIds: []string{"s1"}, IpAddresses: []string{"2001:db8::1"}, DnsNames: []string{"web.corp"}},
+			},
+		},
+		{
+			name: "srv records",
+			sets: []*hostsets.HostSet{testSet(t, "s1", map[string]any{NamesAttrField: "_db._tcp.corp", RecordTypeAttrField: RecordTypeSRV})},
+			want: []*plgpb.ListHostsResponseHost{
+				{ExternalId: "db1.corp", ExternalName: "db1.corp", SetIds: []string{"s1"}, IpAddresses: []string{"10.0.1.1"}, DnsNames: []string{"db1.corp"}},
+				{ExternalId: "db2.corp", ExternalName: "db2.corp", SetIds: []string{"s1"}, IpAddresses: []string{"10.0.1.2", "2001:db8::2"}, DnsNames: []string{"db2.corp"}},
+			},
+		},
+		{
+			name: "hosts shared between sets",
+			sets: []*hostsets.HostSet{
+				testSet(t, "s1", map[string]any{NamesAttrField: []any{"web.corp"}}),
+				testSet(t, "s2", map[string]any{NamesAttrField: []any{"api.corp", "missing.corp"}}),
+			},
+			want: []*plgpb.ListHostsResponseHost{
+				{ExternalId: "10.0.0.1", ExternalName: "web.corp", SetIds: []string{"s1"}, IpAddresses: []string{"10.0.0.1"}, DnsNames: []string{"web.corp"}},
+				{ExternalId: "10.0.0.2", ExternalName: "web.corp", SetIds: []string{"s1", "s2"}, IpAddresses: []string{"10.0.0.2"}, DnsNames: []string{"web.corp", "api.corp"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := plg.ListHosts(ctx, &plgpb.ListHostsRequest{Catalog: cat, Sets: tt.sets})
+			require.NoError(err)
+			require.Len(got.GetHosts(), len(tt.want))
+			for i, h := range got.GetHosts() {
+				assert.Equal(tt.want[i].GetExternalId(), h.GetExternalId())
+				assert.Equal(tt.want[i].GetExternalName(), h.GetExternalName())
+				assert.Equal(tt.want[i].GetSetIds(), h.GetSetIds())
+				assert.Equal(tt.want[i].GetIpAddresses(), h.GetIpAddresses())
+				assert.Equal(tt.want[i].GetDnsNames(), h.GetDnsNames())
+			}
+		})
+	}
+}
+
+func TestDnsPlugin_ListHostsCachesForTtl(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	addr, queries := testDnsServer(t, map[string][]string{
+		"web.corp": {
+			"web.corp. 30 IN A 10.0.0.1",
+			"web.corp. 60 IN A 10.0.0.2",
+		},
+	})
+	plg, err := NewDnsPlugin(WithDefaultResolverAddress(addr))
+	require.NoError(err)
+	now := time.Now()
+	plg.resolver.now = func() time.Time { return now }
+
+	req := &plgpb.ListHostsRequest{
+		Sets: []*hostsets.HostSet{testSet(t, "s1", map[string]any{NamesAttrField: []any{"web.corp"}})},
+	}
+	_, err = plg.ListHosts(ctx, req)
+	require.NoError(err)
+	assert.EqualValues(1, queries.Load())
+
+	now = now.Add(29 * time.Second)
+	_, err = plg.ListHosts(ctx, req)
+	require.NoError(err)
+	assert.EqualValues(1, queries.Load(), "answer should be cached until the lowest TTL expires")
+
+	now = now.Add(time.Second)
+	_, err = plg.ListHosts(ctx, req)
+	require.NoError(err)
+	assert.EqualValues(2, queries.Load())
+}
+
+func TestDnsPlugin_Validation(t *testing.T) {
+	ctx := context.Background()
+	plg, err := NewDnsPlugin()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		cat  *hostcatalogs.HostCatalog
+		set  *hostsets.HostSet
+	}{
+		{
+			name: "bad resolver address",
+			cat:  testCatalog(t, map[string]any{ResolverAddressAttrField: "10.0.0.0/8"}),
+		},
+		{
+			name: "unknown catalog attribute",
+			cat:  testCatalog(t, map[string]any{"region": "us-east-1"}),
+		},
+		{
+			name: "missing names",
+			set:  testSet(t, "s1", map[string]any{}),
+		},
+		{
+			name: "empty name",
+			set:  testSet(t, "s1", map[string]any{NamesAttrField: []any{" "}}),
+		},
+		{
+			name: "unsupported record type",
+			set:  testSet(t, "s1", map[string]any{NamesAttrField: []any{"web.corp"}, RecordTypeAttrField: "MX"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.cat != nil {
+				_, err = plg.OnCreateCatalog(ctx, &plgpb.OnCreateCatalogRequest{Catalog: tt.cat})
+			} else {
+				_, err = plg.OnCreateSet(ctx, &plgpb.OnCreateSetRequest{Set: tt.set})
+			}
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dns
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withDefaultResolverAddress string
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDefaultResolverAddress provides the address of the DNS server queried
+// for catalogs which do not set a resolver address. If not provided, the first
+// name server from /etc/resolv.conf is used.
+func WithDefaultResolverAddress(addr string) Option {
+	return func(o *options) {
+		o.withDefaultResolverAddress = addr
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	resolvConfPath = "/etc/resolv.conf"
+	defaultDnsPort = "53"
+)
+
+// foundHost is a host discovered by a lookup.
+type foundHost struct {
+	externalId   string
+	externalName string
+	ipAddresses  []string
+	dnsNames     []string
+}
+
+type cacheKey struct {
+	server string
+	qtype  uint16
+	name   string
+}
+
+type cacheEntry struct {
+	answers []dns.RR
+	extra   []dns.RR
+	expires time.Time
+}
+
+// resolver queries DNS servers and caches the answers for their TTL.
+type resolver struct {
+	defaultServer string
+	now           func() time.Time
+
+	mu    sync.Mutex
+	cache map[cacheKey]*cacheEntry
+}
+
+func newResolver(defaultServer string) *resolver {
+	return &resolver{
+		defaultServer: defaultServer,
+		now:           time.Now,
+		cache:         make(map[cacheKey]*cacheEntry),
+	}
+}
+
+// lookup resolves name using the given record type and returns the hosts it
+// refers to. server is the DNS server to query, if empty the default server
+// is used.
+func (r *resolver) lookup(ctx context.Context, server string, timeout time.Duration, recordType, name string) ([]*foundHost, error) {
+	if server == "" {
+		server = r.defaultServer
+	}
+	if server == "" {
+		cfg, err := dns.ClientConfigFromFile(resolvConfPath)
+		if err != nil {
+			return nil, fmt.Errorf("no resolver address configured and unable to read %s: %w", resolvConfPath, err)
+		}
+		if len(cfg.Servers) == 0 {
+			return nil, fmt.Errorf("no resolver address configured and no name servers found in %s", resolvConfPath)
+		}
+		server = net.JoinHostPort(cfg.Servers[0], cfg.Port)
+	}
+	server, err := resolverAddress(server)
+	if err != nil {
+		return nil, err
+	}
+	name = dns.Fqdn(strings.TrimSpace(name))
+
+	switch recordType {
+	case RecordTypeSRV:
+		return r.lookupSrv(ctx, server, timeout, name)
+	case RecordTypeAAAA:
+		return r.lookupAddresses(ctx, server, timeout, dns.TypeAAAA, name)
+	default:
+		return r.lookupAddresses(ctx, server, timeout, dns.TypeA, name)
+	}
+}
+
+// lookupAddresses returns a host for each address name resolves to.
+func (r *resolver) lookupAddresses(ctx context.Context, server string, timeout time.Duration, qtype uint16, name string) ([]*foundHost, error) {
+	entry, err := r.query(ctx, server, timeout, qtype, name)
+	if err != nil {
+		return nil, err
+	}
+	hostName := strings.TrimSuffix(name, ".")
+	var ret []*foundHost
+	for _, addr := range addresses(entry.answers, qtype) {
+		ret = append(ret, &foundHost{
+			externalId:   addr,
+			externalName: hostName,
+			ipAddresses:  []string{addr},
+			dnsNames:     []string{hostName},
+		})
+	}
+	return ret, nil
+}
+
+// lookupSrv returns a host for each target of the SRV records of name. The
+// addresses of a target are taken from the additional section of the response
+// when the server provides them, otherwise they are queried.
+func (r *resolver) lookupSrv(ctx context.Context, server string, timeout time.Duration, name string) ([]*foundHost, error) {
+	entry, err := r.query(ctx, server, timeout, dns.TypeSRV, name)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*foundHost
+	seen := make(map[string]bool)
+	for _, rr := range entry.answers {
+		srv, ok := rr.(*dns.SRV)
+		if !ok || srv.Target == "." || seen[srv.Target] {
+			continue
+		}
+		seen[srv.Target] = true
+		hostName := strings.TrimSuffix(srv.Target, ".")
+		h := &foundHost{
+			externalId:   hostName,
+			externalName: hostName,
+			dnsNames:     []string{hostName},
+		}
+		extra := recordsFor(entry.extra, srv.Target)
+		for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+			rrs := extra
+			if len(rrs) == 0 {
+				targetEntry, err := r.query(ctx, server, timeout, qtype, srv.Target)
+				if err != nil {
+					return nil, err
+				}
+				rrs = targetEntry.answers
+			}
+			h.ipAddresses = append(h.ipAddresses, addresses(rrs, qtype)...)
+		}
+		ret = append(ret, h)
+	}
+	return ret, nil
+}
+
+// query returns the answer to a single question, from the cache if it has
+// not expired yet.
+func (r *resolver) query(ctx context.Context, server string, timeout time.Duration, qtype uint16, name string) (*cacheEntry, error) {
+	key := cacheKey{server: server, qtype: qtype, name: strings.ToLower(name)}
+	now := r.now()
+	r.mu.Lock()
+	entry, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry, nil
+	}
+
+	msg := new(dns.Msg)
+	msg.SetQuestion(name, qtype)
+	client := &dns.Client{Timeout: timeout}
+	resp, _, err := client.ExchangeContext(ctx, msg, server)
+	if err == nil && resp.Truncated {
+		client.Net = "tcp"
+		resp, _, err = client.ExchangeContext(ctx, msg, server)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error querying %s for %s %s: %w", server, dns.TypeToString[qtype], name, err)
+	}
+	switch resp.Rcode {
+	case dns.RcodeSuccess, dns.RcodeNameError:
+	default:
+		return nil, fmt.Errorf("error querying %s for %s %s: %s", server, dns.TypeToString[qtype], name, dns.RcodeToString[resp.Rcode])
+	}
+
+	entry = &cacheEntry{
+		answers: resp.Answer,
+		extra:   resp.Extra,
+		expires: now.Add(minTtl(resp)),
+	}
+	r.mu.Lock()
+	r.cache[key] = entry
+	r.mu.Unlock()
+	return entry, nil
+}
+
+// minTtl returns the lowest TTL of the records in the response. For a
+// response without answers the TTL of the SOA record in the authority
+// section is used, as for negative caching.
+func minTtl(resp *dns.Msg) time.Duration {
+	rrs := resp.Answer
+	if len(rrs) == 0 {
+		rrs = resp.Ns
+	}
+	var ttl uint32
+	for i, rr := range rrs {
+		if i == 0 || rr.Header().Ttl < ttl {
+			ttl = rr.Header().Ttl
+		}
+	}
+	return time.Duration(ttl) * time.Second
+}
+
+// addresses returns the addresses of the records of the given type.
+func addresses(rrs []dns.RR, qtype uint16) []string {
+	var ret []string
+	for _, rr := range rrs {
+		switch v := rr.(type) {
+		case *dns.A:
+			if qtype == dns.TypeA {
+				ret = append(ret, v.A.String())
+			}
+		case *dns.AAAA:
+			if qtype == dns.TypeAAAA {
+				ret = append(ret, v.AAAA.String())
+			}
+		}
+	}
+	return ret
+}
+
+// recordsFor returns the records which belong to name.
+func recordsFor(rrs []dns.RR, name string) []dns.RR {
+	var ret []dns.RR
+	for _, rr := range rrs {
+		if strings.EqualFold(rr.Header().Name, name) {
+			ret = append(ret, rr)
+		}
+	}
+	return ret
+}
+
+// resolverAddress validates addr and adds the default DNS port if it has no
+// port.
+func resolverAddress(addr string) (string, error) {
+	addr = strings.TrimSpace(addr)
+	if addr == "" {
+		return "", fmt.Errorf("empty resolver address")
+	}
+	if _, _, err := net.SplitHostPort(addr); err == nil {
+		return addr, nil
+	}
+	host := strings.TrimSuffix(strings.TrimPrefix(addr, "["), "]")
+	if strings.ContainsAny(host, "[]/ ") {
+		return "", fmt.Errorf("invalid resolver address %q", addr)
+	}
+	return net.JoinHostPort(host, defaultDnsPort), nil
+}
@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	defaultServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+	listPageSize             = 500
+	requestTimeout           = 30 * time.Second
+)
+
+type objectMeta struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Uid       string            `json:"uid"`
+	Labels    map[string]string `json:"labels"`
+}
+
+type listMeta struct {
+	Continue string `json:"continue"`
+}
+
+type servicePort struct {
+	Name     string `json:"name"`
+	Port     int32  `json:"port"`
+	Protocol string `json:"protocol"`
+}
+
+type service struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     struct {
+		ClusterIP    string        `json:"clusterIP"`
+		ClusterIPs   []string      `json:"clusterIPs"`
+		ExternalName string        `json:"externalName"`
+		Ports        []servicePort `json:"ports"`
+	} `json:"spec"`
+	Status struct {
+		LoadBalancer struct {
+			Ingress []struct {
+				IP       string `json:"ip"`
+				Hostname string `json:"hostname"`
+			} `json:"ingress"`
+		} `json:"loadBalancer"`
+	} `json:"status"`
+}
+
+type serviceList struct {
+	Metadata listMeta   `json:"metadata"`
+	Items    []*service `json:"items"`
+}
+
+type containerPort struct {
+	Name          string `json:"name"`
+	ContainerPort int32  `json:"containerPort"`
+	Protocol      string `json:"protocol"`
+}
+
+type container struct {
+	Name  string          `json:"name"`
+	Ports []containerPort `json:"ports"`
+}
+
+type pod struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     struct {
+		Hostname   string      `json:"hostname"`
+		Subdomain  string      `json:"subdomain"`
+		Containers []container `json:"containers"`
+	} `json:"spec"`
+	Status struct {
+		PodIP  string `json:"podIP"`
+		PodIPs []struct {
+			IP string `json:"ip"`
+		} `json:"podIPs"`
+	} `json:"status"`
+}
+
+type podList struct {
+	Metadata listMeta `json:"metadata"`
+	Items    []*pod   `json:"items"`
+}
+
+// inClusterConfig describes how to reach the API server of the cluster the
+// controller runs in.
+type inClusterConfig struct {
+	serviceAccountDir string
+}
+
+// apiClient is a minimal client of the Kubernetes API which lists Services
+// and Pods.
+type apiClient struct {
+	address string
+	token   string
+	client  *http.Client
+}
+
+func newApiClient(attrs *catalogAttributes, secrets *catalogSecrets, inCluster inClusterConfig) (*apiClient, error) {
+	address, token, caCert := attrs.ApiServerAddress, secrets.Token, attrs.CaCert
+	if address == "" || token == "" {
+		dir := inCluster.serviceAccountDir
+		if dir == "" {
+			dir = defaultServiceAccountDir
+		}
+		if address == "" {
+			host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+			if host == "" || port == "" {
+				return nil, fmt.Errorf("no API server address configured and not running in a Kubernetes cluster")
+			}
+			address = "https://" + net.JoinHostPort(host, port)
+			if caCert == "" {
+				b, err := os.ReadFile(filepath.Join(dir, "ca.crt"))
+				if err != nil {
+					return nil, fmt.Errorf("unable to read in-cluster CA certificate: %w", err)
+				}
+				caCert = string(b)
+			}
+		}
+		if token == "" {
+			b, err := os.ReadFile(filepath.Join(dir, "token"))
+			if err != nil {
+				return nil, fmt.Errorf("no token configured and unable to read service account token: %w", err)
+			}
+			token = strings.TrimSpace(string(b))
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caCert != "" {
+		pool, err := certPool(caCert)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	return &apiClient{
+		address: strings.TrimSuffix(address, "/"),
+		token:   token,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+		},
+	}, nil
+}
+
+func (c *apiClient) listServices(ctx context.Context, namespace, labelSelector string) ([]*service, error) {
+	var ret []*service
+	var cont string
+	for {
+		var l serviceList
+		if err := c.list(ctx, namespace, ResourceServices, labelSelector, cont, &l); err != nil {
+			return nil, err
+		}
+		ret = append(ret, l.Items...)
+		if cont = l.Metadata.Continue; cont == "" {
+			return ret, nil
+		}
+	}
+}
+
+func (c *apiClient) listPods(ctx context.Context, namespace, labelSelector string) ([]*pod, error) {
+	var ret []*pod
+	var cont string
+	for {
+		var l podList
+		if err := c.list(ctx, namespace, ResourcePods, labelSelector, cont, &l); err != nil {
+			return nil, err
+		}
+		ret = append(ret, l.Items...)
+		if cont = l.Metadata.Continue; cont == "" {
+			return ret, nil
+		}
+	}
+}
+
+func (c *apiClient) list(ctx context.Context, namespace, resource, labelSelector, cont string, out any) error {
+	q := url.Values{}
+	q.Set("labelSelector", labelSelector)
+	q.Set("limit", fmt.Sprint(listPageSize))
+	if cont != "" {
+		q.Set("continue", cont)
+	}
+	u := fmt.Sprintf("%s/api/v1/namespaces/%s/%s?%s", c.address, url.PathEscape(namespace), resource, q.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error listing %s in namespace %s: %w", resource, namespace, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("error listing %s in namespace %s: %s: %s", resource, namespace, resp.Status, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding %s in namespace %s: %w", resource, namespace, err)
+	}
+	return nil
+}
+
+func certPool(pemCert string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(pemCert)) {
+		return nil, fmt.Errorf("no valid PEM encoded certificate found")
+	}
+	return pool, nil
+}
+
+// labelRequirement is a single requirement of an equality or existence based
+// label selector.
+type labelRequirement struct {
+	key      string
+	operator string // one of "=", "!=", "exists" or "!exists"
+	value    string
+}
+
+var labelKeyRe = regexp.MustCompile(`^([a-z0-9]([-a-z0-9.]*[a-z0-9])?/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+
+// parseLabelSelector parses an equality or existence based label selector,
+// such as "app=web,tier!=canary,!legacy". Set based selectors are not
+// supported.
+func parseLabelSelector(selector string) ([]labelRequirement, error) {
+	var ret []labelRequirement
+	for _, part := range strings.Split(selector, ",") {
+		part = strings.TrimSpace(part)
+		var r labelRequirement
+		switch {
+		case part == "":
+			return nil, fmt.Errorf("empty requirement in label selector %q", selector)
+		case strings.Contains(part, "!="):
+			k, v, _ := strings.Cut(part, "!=")
+			r = labelRequirement{key: strings.TrimSpace(k), operator: "!=", value: strings.TrimSpace(v)}
+		case strings.Contains(part, "=="):
+			k, v, _ := strings.Cut(part, "==")
+			r = labelRequirement{key: strings.TrimSpace(k), operator: "=", value: strings.TrimSpace(v)}
+		case strings.Contains(part, "="):
+			k, v, _ := strings.Cut(part, "=")
+			r = labelRequirement{key: strings.TrimSpace(k), operator: "=", value: strings.TrimSpace(v)}
+		case strings.HasPrefix(part, "!"):
+			r = labelRequirement{key: strings.TrimSpace(part[1:]), operator: "!exists"}
+		default:
+			r = labelRequirement{key: part, operator: "exists"}
+		}
+		if !labelKeyRe.MatchString(r.key) {
+			return nil, fmt.Errorf("invalid label key %q in label selector %q", r.key, selector)
+		}
+		if strings.ContainsAny(r.value, " ()=!") {
+			return nil, fmt.Errorf("invalid label value %q in label selector %q, only equality and existence requirements are supported", r.value, selector)
+		}
+		ret = append(ret, r)
+	}
+	return ret, nil
+}
+
+// matches reports whether labels satisfy all of the requirements.
+func matches(reqs []labelRequirement, labels map[string]string) bool {
+	for _, r := range reqs {
+		v, ok := labels[r.key]
+		switch r.operator {
+		case "=":
+			if !ok || v != r.value {
+				return false
+			}
+		case "!=":
+			if ok && v == r.value {
+				return false
+			}
+		case "exists":
+			if !ok {
+				return false
+			}
+		case "!exists":
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
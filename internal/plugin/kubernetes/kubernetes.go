@@ -0,0 +1,441 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package kubernetes provides a built-in host plugin which populates host sets
+// from Kubernetes Services or Pods selected by a label selector, so workloads
+// can be targeted without maintaining static hosts for them.
+//
+// Each Service becomes a host with its cluster IPs and load balancer ingress
+// addresses, and its cluster DNS name. Each Pod becomes a host with its pod
+// IPs and its cluster DNS names. The ports of the Service or the containers of
+// the Pod are listed in the description of the host.
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// PluginName is the name the Kubernetes host plugin is registered under.
+	PluginName = "kubernetes"
+
+	// ApiServerAddressAttrField is the host catalog attribute holding the URL
+	// of the Kubernetes API server. If it is not set, the in-cluster API server
+	// is used.
+	ApiServerAddressAttrField = "api_server_address"
+	// CaCertAttrField is the host catalog attribute holding the PEM encoded CA
+	// certificate used to verify the API server.
+	CaCertAttrField = "ca_cert"
+	// ClusterDomainAttrField is the host catalog attribute holding the DNS
+	// domain of the cluster. It defaults to cluster.local.
+	ClusterDomainAttrField = "cluster_domain"
+	// DefaultNamespaceAttrField is the host catalog attribute holding the
+	// namespace used by host sets which do not set one. It defaults to
+	// default.
+	DefaultNamespaceAttrField = "default_namespace"
+
+	// TokenSecretField is the host catalog secret holding the bearer token
+	// used to authenticate to the API server. If it is not set, the token of
+	// the service account of the controller is used.
+	TokenSecretField = "token"
+
+	// ResourceAttrField is the host set attribute holding the kind of resource
+	// to list: services or pods. It defaults to services.
+	ResourceAttrField = "resource"
+	// NamespaceAttrField is the host set attribute holding the namespace to
+	// list resources from.
+	NamespaceAttrField = "namespace"
+	// LabelSelectorAttrField is the host set attribute holding the Kubernetes
+	// label selector used to select resources, e.g. "app=web,tier!=canary".
+	LabelSelectorAttrField = "label_selector"
+
+	defaultClusterDomain = "cluster.local"
+	defaultNamespace     = "default"
+)
+
+// Resources supported in the resource host set attribute.
+const (
+	ResourceServices = "services"
+	ResourcePods     = "pods"
+)
+
+var _ plgpb.HostPluginServiceServer = (*KubernetesPlugin)(nil)
+
+type catalogAttributes struct {
+	ApiServerAddress string `mapstructure:"api_server_address"`
+	CaCert           string `mapstructure:"ca_cert"`
+	ClusterDomain    string `mapstructure:"cluster_domain"`
+	DefaultNamespace string `mapstructure:"default_namespace"`
+}
+
+type catalogSecrets struct {
+	Token string `mapstructure:"token"`
+}
+
+type setAttributes struct {
+	Resource      string `mapstructure:"resource"`
+	Namespace     string `mapstructure:"namespace"`
+	LabelSelector string `mapstructure:"label_selector"`
+}
+
+// KubernetesPlugin is a host plugin which discovers hosts from the Services
+// and Pods of a Kubernetes cluster. It keeps no state and is safe for
+// concurrent use.
+type KubernetesPlugin struct {
+	plgpb.UnimplementedHostPluginServiceServer
+
+	inCluster inClusterConfig
+}
+
+// NewKubernetesPlugin returns a new Kubernetes host plugin.
+// WithInClusterConfig is the only supported option.
+func NewKubernetesPlugin(opt ...Option) (*KubernetesPlugin, error) {
+	opts := getOpts(opt...)
+	return &KubernetesPlugin{
+		inCluster: opts.withInClusterConfig,
+	}, nil
+}
+
+// OnCreateCatalog validates the attributes of the new catalog and persists its
+// secrets.
+func (p *KubernetesPlugin) OnCreateCatalog(_ context.Context, req *plgpb.OnCreateCatalogRequest) (*plgpb.OnCreateCatalogResponse, error) {
+	cat := req.GetCatalog()
+	if _, err := getCatalogAttributes(cat); err != nil {
+		return nil, err
+	}
+	persisted, err := getPersisted(cat.GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateCatalogResponse{Persisted: persisted}, nil
+}
+
+// OnUpdateCatalog validates the attributes of the updated catalog. New secrets
+// replace the persisted ones.
+func (p *KubernetesPlugin) OnUpdateCatalog(_ context.Context, req *plgpb.OnUpdateCatalogRequest) (*plgpb.OnUpdateCatalogResponse, error) {
+	cat := req.GetNewCatalog()
+	if _, err := getCatalogAttributes(cat); err != nil {
+		return nil, err
+	}
+	if cat.GetSecrets() == nil {
+		return &plgpb.OnUpdateCatalogResponse{}, nil
+	}
+	persisted, err := getPersisted(cat.GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateCatalogResponse{Persisted: persisted}, nil
+}
+
+// OnDeleteCatalog is a no-op, the plugin keeps no state for a catalog.
+func (p *KubernetesPlugin) OnDeleteCatalog(context.Context, *plgpb.OnDeleteCatalogRequest) (*plgpb.OnDeleteCatalogResponse, error) {
+	return &plgpb.OnDeleteCatalogResponse{}, nil
+}
+
+// NormalizeSetData lower cases the resource of the set so it is stored
+// consistently.
+func (p *KubernetesPlugin) NormalizeSetData(_ context.Context, req *plgpb.NormalizeSetDataRequest) (*plgpb.NormalizeSetDataResponse, error) {
+	attrs := req.GetAttributes()
+	if attrs == nil {
+		return &plgpb.NormalizeSetDataResponse{}, nil
+	}
+	if v, ok := attrs.GetFields()[ResourceAttrField]; ok {
+		if s, ok := v.GetKind().(*structpb.Value_StringValue); ok {
+			attrs.GetFields()[ResourceAttrField] = structpb.NewStringValue(strings.ToLower(strings.TrimSpace(s.StringValue)))
+		}
+	}
+	return &plgpb.NormalizeSetDataResponse{Attributes: attrs}, nil
+}
+
+// OnCreateSet validates the attributes of the new set.
+func (p *KubernetesPlugin) OnCreateSet(_ context.Context, req *plgpb.OnCreateSetRequest) (*plgpb.OnCreateSetResponse, error) {
+	if _, err := getSetAttributes(req.GetSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateSetResponse{}, nil
+}
+
+// OnUpdateSet validates the attributes of the updated set.
+func (p *KubernetesPlugin) OnUpdateSet(_ context.Context, req *plgpb.OnUpdateSetRequest) (*plgpb.OnUpdateSetResponse, error) {
+	if _, err := getSetAttributes(req.GetNewSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateSetResponse{}, nil
+}
+
+// OnDeleteSet is a no-op, the plugin keeps no state for a set.
+func (p *KubernetesPlugin) OnDeleteSet(context.Context, *plgpb.OnDeleteSetRequest) (*plgpb.OnDeleteSetResponse, error) {
+	return &plgpb.OnDeleteSetResponse{}, nil
+}
+
+// ListHosts lists the resources selected by each of the requested sets and
+// returns the hosts found. A host which is selected by several sets is
+// returned once with the IDs of all of those sets.
+func (p *KubernetesPlugin) ListHosts(ctx context.Context, req *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+	const op = "kubernetes.(KubernetesPlugin).ListHosts"
+	catAttrs, err := getCatalogAttributes(req.GetCatalog())
+	if err != nil {
+		return nil, err
+	}
+	secrets := new(catalogSecrets)
+	if req.GetPersisted().GetSecrets() != nil {
+		if err := decodeAttributes(req.GetPersisted().GetSecrets(), secrets); err != nil {
+			return nil, err
+		}
+	}
+	client, err := newApiClient(catAttrs, secrets, p.inCluster)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	clusterDomain := catAttrs.ClusterDomain
+	if clusterDomain == "" {
+		clusterDomain = defaultClusterDomain
+	}
+
+	hosts := make(map[string]*plgpb.ListHostsResponseHost)
+	for _, set := range req.GetSets() {
+		setAttrs, err := getSetAttributes(set)
+		if err != nil {
+			return nil, err
+		}
+		namespace := setAttrs.Namespace
+		if namespace == "" {
+			namespace = catAttrs.DefaultNamespace
+		}
+		if namespace == "" {
+			namespace = defaultNamespace
+		}
+
+		var found []*plgpb.ListHostsResponseHost
+		switch setAttrs.Resource {
+		case ResourcePods:
+			pods, err := client.listPods(ctx, namespace, setAttrs.LabelSelector)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("host set %s", set.GetId())))
+			}
+			for _, pod := range pods {
+				if h := podHost(pod, clusterDomain); h != nil {
+					found = append(found, h)
+				}
+			}
+		default:
+			svcs, err := client.listServices(ctx, namespace, setAttrs.LabelSelector)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("host set %s", set.GetId())))
+			}
+			for _, svc := range svcs {
+				found = append(found, serviceHost(svc, clusterDomain))
+			}
+		}
+
+		for _, f := range found {
+			h, ok := hosts[f.GetExternalId()]
+			if !ok {
+				h = f
+				hosts[f.GetExternalId()] = h
+			}
+			if !slices.Contains(h.SetIds, set.GetId()) {
+				h.SetIds = append(h.SetIds, set.GetId())
+			}
+		}
+	}
+
+	resp := &plgpb.ListHostsResponse{
+		Hosts: make([]*plgpb.ListHostsResponseHost, 0, len(hosts)),
+	}
+	for _, h := range hosts {
+		resp.Hosts = append(resp.Hosts, h)
+	}
+	sort.Slice(resp.Hosts, func(i, j int) bool {
+		return resp.Hosts[i].GetExternalId() < resp.Hosts[j].GetExternalId()
+	})
+	return resp, nil
+}
+
+// serviceHost maps a Service to a host. The external ID is the UID of the
+// Service so the host survives the Service being updated.
+func serviceHost(svc *service, clusterDomain string) *plgpb.ListHostsResponseHost {
+	name := fmt.Sprintf("%s.%s", svc.Metadata.Name, svc.Metadata.Namespace)
+	h := &plgpb.ListHostsResponseHost{
+		ExternalId:   externalId(svc.Metadata),
+		ExternalName: name,
+		Description:  describePorts(svc.Spec.Ports),
+		DnsNames:     []string{fmt.Sprintf("%s.svc.%s", name, clusterDomain)},
+	}
+	for _, ip := range svc.Spec.ClusterIPs {
+		if ip != "" && ip != "None" && !slices.Contains(h.IpAddresses, ip) {
+			h.IpAddresses = append(h.IpAddresses, ip)
+		}
+	}
+	if svc.Spec.ClusterIP != "" && svc.Spec.ClusterIP != "None" && !slices.Contains(h.IpAddresses, svc.Spec.ClusterIP) {
+		h.IpAddresses = append(h.IpAddresses, svc.Spec.ClusterIP)
+	}
+	for _, ing := range svc.Status.LoadBalancer.Ingress {
+		if ing.IP != "" && !slices.Contains(h.IpAddresses, ing.IP) {
+			h.IpAddresses = append(h.IpAddresses, ing.IP)
+		}
+		if ing.Hostname != "" && !slices.Contains(h.DnsNames, ing.Hostname) {
+			h.DnsNames = append(h.DnsNames, ing.Hostname)
+		}
+	}
+	if svc.Spec.ExternalName != "" && !slices.Contains(h.DnsNames, svc.Spec.ExternalName) {
+		h.DnsNames = append(h.DnsNames, svc.Spec.ExternalName)
+	}
+	return h
+}
+
+// podHost maps a Pod to a host, or returns nil if the Pod has no IP yet
+// because it has not been scheduled.
+func podHost(pod *pod, clusterDomain string) *plgpb.ListHostsResponseHost {
+	var ips []string
+	for _, ip := range pod.Status.PodIPs {
+		if ip.IP != "" && !slices.Contains(ips, ip.IP) {
+			ips = append(ips, ip.IP)
+		}
+	}
+	if pod.Status.PodIP != "" && !slices.Contains(ips, pod.Status.PodIP) {
+		ips = append(ips, pod.Status.PodIP)
+	}
+	if len(ips) == 0 {
+		return nil
+	}
+	var ports []servicePort
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			ports = append(ports, servicePort{Name: p.Name, Port: p.ContainerPort, Protocol: p.Protocol})
+		}
+	}
+	h := &plgpb.ListHostsResponseHost{
+		ExternalId:   externalId(pod.Metadata),
+		ExternalName: fmt.Sprintf("%s.%s", pod.Metadata.Name, pod.Metadata.Namespace),
+		Description:  describePorts(ports),
+		IpAddresses:  ips,
+	}
+	for _, ip := range ips {
+		// Pod A records replace the separators of the address with dashes.
+		dashed := strings.NewReplacer(".", "-", ":", "-").Replace(ip)
+		h.DnsNames = append(h.DnsNames, fmt.Sprintf("%s.%s.pod.%s", dashed, pod.Metadata.Namespace, clusterDomain))
+	}
+	if pod.Spec.Hostname != "" && pod.Spec.Subdomain != "" {
+		h.DnsNames = append(h.DnsNames, fmt.Sprintf("%s.%s.%s.svc.%s", pod.Spec.Hostname, pod.Spec.Subdomain, pod.Metadata.Namespace, clusterDomain))
+	}
+	return h
+}
+
+func externalId(m objectMeta) string {
+	if m.Uid != "" {
+		return m.Uid
+	}
+	return fmt.Sprintf("%s/%s", m.Namespace, m.Name)
+}
+
+// describePorts returns a description listing the ports, e.g.
+// "Ports: http 80/TCP, metrics 9090/TCP".
+func describePorts(ports []servicePort) string {
+	if len(ports) == 0 {
+		return ""
+	}
+	desc := make([]string, 0, len(ports))
+	for _, p := range ports {
+		proto := p.Protocol
+		if proto == "" {
+			proto = "TCP"
+		}
+		s := fmt.Sprintf("%d/%s", p.Port, proto)
+		if p.Name != "" {
+			s = p.Name + " " + s
+		}
+		desc = append(desc, s)
+	}
+	return "Ports: " + strings.Join(desc, ", ")
+}
+
+func getCatalogAttributes(cat *hostcatalogs.HostCatalog) (*catalogAttributes, error) {
+	attrs := new(catalogAttributes)
+	if cat == nil || cat.GetAttributes() == nil {
+		return attrs, nil
+	}
+	if err := decodeAttributes(cat.GetAttributes(), attrs); err != nil {
+		return nil, err
+	}
+	if attrs.ApiServerAddress != "" {
+		u, err := url.Parse(attrs.ApiServerAddress)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: must be an http or https URL", ApiServerAddressAttrField)
+		}
+	}
+	if attrs.CaCert != "" {
+		if _, err := certPool(attrs.CaCert); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: %v", CaCertAttrField, err)
+		}
+	}
+	return attrs, nil
+}
+
+func getPersisted(secrets *structpb.Struct) (*plgpb.HostCatalogPersisted, error) {
+	if secrets == nil {
+		return nil, nil
+	}
+	if err := decodeAttributes(secrets, new(catalogSecrets)); err != nil {
+		return nil, err
+	}
+	return &plgpb.HostCatalogPersisted{Secrets: secrets}, nil
+}
+
+func getSetAttributes(set *hostsets.HostSet) (*setAttributes, error) {
+	attrs := new(setAttributes)
+	if set != nil && set.GetAttributes() != nil {
+		if err := decodeAttributes(set.GetAttributes(), attrs); err != nil {
+			return nil, err
+		}
+	}
+	attrs.Resource = strings.ToLower(strings.TrimSpace(attrs.Resource))
+	switch attrs.Resource {
+	case "":
+		attrs.Resource = ResourceServices
+	case ResourceServices, ResourcePods:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: unsupported resource %q, must be %s or %s", ResourceAttrField, attrs.Resource, ResourceServices, ResourcePods)
+	}
+	if strings.TrimSpace(attrs.LabelSelector) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: a label selector is required", LabelSelectorAttrField)
+	}
+	if _, err := parseLabelSelector(attrs.LabelSelector); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: %v", LabelSelectorAttrField, err)
+	}
+	return attrs, nil
+}
+
+func decodeAttributes(in *structpb.Struct, out any) error {
+	md := new(mapstructure.Metadata)
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Metadata:         md,
+		Result:           out,
+		WeaklyTypedInput: true,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to create attributes decoder: %v", err)
+	}
+	if err := dec.Decode(in.AsMap()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid attributes: %v", err)
+	}
+	if len(md.Unused) > 0 {
+		slices.Sort(md.Unused)
+		return status.Errorf(codes.InvalidArgument, "unrecognized attributes: %s", strings.Join(md.Unused, ", "))
+	}
+	return nil
+}
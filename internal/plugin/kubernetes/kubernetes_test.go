@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func testStruct(t *testing.T, m map[string]any) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(m)
+	require.NoError(t, err)
+	return s
+}
+
+func testSet(t *testing.T, id string, attrs map[string]any) *hostsets.HostSet {
+	t.Helper()
+	return &hostsets.HostSet{Id: id, Attrs: &hostsets.HostSet_Attributes{Attributes: testStruct(t, attrs)}}
+}
+
+func TestKubernetesPlugin_ListHosts(t *testing.T) {
+	ctx := context.Background()
+	srv := NewTestApiServer(t)
+	srv.PageSize = 1
+	srv.SetServices(
+		&TestService{Namespace: "prod", Name: "web", Uid: "uid-web", Labels: map[string]string{"app": "web"}, ClusterIP: "10.96.0.10", Ports: []TestPort{{Name: "http", Port: 80}, {Name: "dns", Port: 53, Protocol: "UDP"}}},
+		&TestService{Namespace: "prod", Name: "web-canary", Uid: "uid-canary", Labels: map[string]string{"app": "web", "track": "canary"}, ClusterIP: "10.96.0.11"},
+		&TestService{Namespace: "prod", Name: "db", Uid: "uid-db", Labels: map[string]string{"app": "db"}, ClusterIP: "None"},
+		&TestService{Namespace: "default", Name: "web", Uid: "uid-default-web", Labels: map[string]string{"app": "web"}, ClusterIP: "10.96.0.12"},
+		&TestService{Namespace: "prod", Name: "web-2", Uid: "uid-web-2", Labels: map[string]string{"app": "web"}, ClusterIP: "10.96.0.13"},
+	)
+	srv.SetPods(
+		&TestPod{Namespace: "prod", Name: "web-abc", Uid: "uid-pod-1", Labels: map[string]string{"app": "web"}, PodIP: "10.244.0.5", Ports: []TestPort{{Name: "http", Port: 8080}}},
+		&TestPod{Namespace: "prod", Name: "web-pending", Uid: "uid-pod-2", Labels: map[string]string{"app": "web"}},
+	)
+
+	plg, err := NewKubernetesPlugin()
+	require.NoError(t, err)
+	req := &plgpb.ListHostsRequest{
+		Catalog: &hostcatalogs.HostCatalog{Attrs: &hostcatalogs.HostCatalog_Attributes{Attributes: testStruct(t, srv.CatalogAttributes())}},
+		Persisted: &plgpb.HostCatalogPersisted{
+			Secrets: testStruct(t, srv.CatalogSecrets()),
+		},
+	}
+
+	tests := []struct {
+		name string
+		sets []*hostsets.HostSet
+		want []*plgpb.ListHostsResponseHost
+	}{
+		{
+			name: "services across pages",
+			sets: []*hostsets.HostSet{testSet(t, "s1", map[string]any{NamespaceAttrField: "prod", LabelSelectorAttrField: "app=web,track!=canary"})},
+			want: []*plgpb.ListHostsResponseHost{
+				{
+					ExternalId:   "uid-web",
+					ExternalName: "web.prod",
+					Description:  "Ports: http 80/TCP, dns 53/UDP",
+					SetIds:       []string{"s1"},
+					IpAddresses:  []string{"10.96.0.10"},
+					DnsNames:     []string{"web.prod.svc.cluster.local"},
+				},
+				{
+					ExternalId:   "uid-web-2",
+					ExternalName: "web-2.prod",
+					SetIds:       []string{"s1"},
+					IpAddresses:  []string{"10.96.0.13"},
+					DnsNames:     []string{"web-2.prod.svc.cluster.local"},
+				},
+			},
+		},
+		{
+			name: "headless service and default namespace",
+			sets: []*hostsets.HostSet{
+				testSet(t, "s1", map[string]any{NamespaceAttrField: "prod", LabelSelectorAttrField: "app=db"}),
+				testSet(t, "s2", map[string]any{LabelSelectorAttrField: "app"}),
+			},
+			want: []*plgpb.ListHostsResponseHost{
+				{
+					ExternalId:   "uid-db",
+					ExternalName: "db.prod",
+					SetIds:       []string{"s1"},
+					DnsNames:     []string{"db.prod.svc.cluster.local"},
+				},
+				{
+					ExternalId:   "uid-default-web",
+					ExternalName: "web.default",
+					SetIds:       []string{"s2"},
+					IpAddresses:  []string{"10.96.0.12"},
+					DnsNames:     []string{"web.default.svc.cluster.local"},
+				},
+			},
+		},
+		{
+			name: "pods",
+			sets: []*hostsets.HostSet{testSet(t, "s1", map[string]any{ResourceAttrField: "Pods", NamespaceAttrField: "prod", LabelSelectorAttrField: "app==web"})},
+			want: []*plgpb.ListHostsResponseHost{
+				{
+					ExternalId:   "uid-pod-1",
+					ExternalName: "web-abc.prod",
+					Description:  "Ports: http 8080/TCP",
+					SetIds:       []string{"s1"},
+					IpAddresses:  []string{"10.244.0.5"},
+					DnsNames:     []string{"10-244-0-5.prod.pod.cluster.local"},
+				},
+			},
+		},
+		{
+			name: "hosts shared between sets",
+			sets: []*hostsets.HostSet{
+				testSet(t, "s1", map[string]any{NamespaceAttrField: "prod", LabelSelectorAttrField: "track=canary"}),
+				testSet(t, "s2", map[string]any{NamespaceAttrField: "prod", LabelSelectorAttrField: "app=web,track"}),
+			},
+			want: []*plgpb.ListHostsResponseHost{
+				{
+					ExternalId:   "uid-canary",
+					ExternalName: "web-canary.prod",
+					SetIds:       []string{"s1", "s2"},
+					IpAddresses:  []string{"10.96.0.11"},
+					DnsNames:     []string{"web-canary.prod.svc.cluster.local"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			req.Sets = tt.sets
+			got, err := plg.ListHosts(ctx, req)
+			require.NoError(err)
+			require.Len(got.GetHosts(), len(tt.want))
+			for i, h := range got.GetHosts() {
+				assert.Equal(tt.want[i].GetExternalId(), h.GetExternalId())
+				assert.Equal(tt.want[i].GetExternalName(), h.GetExternalName())
+				assert.Equal(tt.want[i].GetDescription(), h.GetDescription())
+				assert.Equal(tt.want[i].GetSetIds(), h.GetSetIds())
+				assert.Equal(tt.want[i].GetIpAddresses(), h.GetIpAddresses())
+				assert.Equal(tt.want[i].GetDnsNames(), h.GetDnsNames())
+			}
+		})
+	}
+
+	t.Run("bad token", func(t *testing.T) {
+		_, err := plg.ListHosts(ctx, &plgpb.ListHostsRequest{
+			Catalog:   req.Catalog,
+			Persisted: &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]any{TokenSecretField: "wrong"})},
+			Sets:      []*hostsets.HostSet{testSet(t, "s1", map[string]any{LabelSelectorAttrField: "app=web"})},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "401")
+	})
+}
+
+func TestKubernetesPlugin_Validation(t *testing.T) {
+	ctx := context.Background()
+	plg, err := NewKubernetesPlugin()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		attrs   map[string]any
+		secrets map[string]any
+		set     map[string]any
+	}{
+		{
+			name:  "bad api server address",
+			attrs: map[string]any{ApiServerAddressAttrField: "kubernetes:6443"},
+		},
+		{
+			name:  "bad ca cert",
+			attrs: map[string]any{CaCertAttrField: "not a certificate"},
+		},
+		{
+			name:    "unknown secret",
+			attrs:   map[string]any{},
+			secrets: map[string]any{"password": "secret"},
+		},
+		{
+			name: "missing label selector",
+			set:  map[string]any{NamespaceAttrField: "prod"},
+		},
+		{
+			name: "set based label selector",
+			set:  map[string]any{LabelSelectorAttrField: "app in (web, api)"},
+		},
+		{
+			name: "unsupported resource",
+			set:  map[string]any{ResourceAttrField: "deployments", LabelSelectorAttrField: "app=web"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.set == nil {
+				cat := &hostcatalogs.HostCatalog{Attrs: &hostcatalogs.HostCatalog_Attributes{Attributes: testStruct(t, tt.attrs)}}
+				if tt.secrets != nil {
+					cat.Secrets = testStruct(t, tt.secrets)
+				}
+				_, err = plg.OnCreateCatalog(ctx, &plgpb.OnCreateCatalogRequest{Catalog: cat})
+			} else {
+				_, err = plg.OnCreateSet(ctx, &plgpb.OnCreateSetRequest{Set: testSet(t, "s1", tt.set)})
+			}
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package kubernetes
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withInClusterConfig inClusterConfig
+}
+
+func getDefaultOptions() options {
+	return options{
+		withInClusterConfig: inClusterConfig{
+			serviceAccountDir: defaultServiceAccountDir,
+		},
+	}
+}
+
+// WithServiceAccountDir provides the directory holding the token and CA
+// certificate of the service account used for catalogs which do not configure
+// their own. It defaults to the directory Kubernetes mounts them in a Pod.
+func WithServiceAccountDir(dir string) Option {
+	return func(o *options) {
+		o.withInClusterConfig.serviceAccountDir = dir
+	}
+}
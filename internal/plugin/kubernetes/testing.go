@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestPort is a named port of a TestService or TestPod.
+type TestPort struct {
+	Name     string
+	Port     int32
+	Protocol string
+}
+
+// TestService is a Service served by a TestApiServer.
+type TestService struct {
+	Namespace string
+	Name      string
+	Uid       string
+	Labels    map[string]string
+	ClusterIP string
+	Ports     []TestPort
+}
+
+// TestPod is a Pod served by a TestApiServer.
+type TestPod struct {
+	Namespace string
+	Name      string
+	Uid       string
+	Labels    map[string]string
+	PodIP     string
+	Ports     []TestPort
+}
+
+// TestApiServer is an in-memory fake of the parts of the Kubernetes API used
+// by the plugin. It serves Services and Pods over TLS, requires a bearer
+// token, filters by label selector and paginates results. It is safe for
+// concurrent use.
+type TestApiServer struct {
+	// Address is the URL of the server, for the api_server_address attribute.
+	Address string
+	// CaCert is the PEM encoded certificate of the server, for the ca_cert
+	// attribute.
+	CaCert string
+	// Token is the bearer token the server requires, for the token secret.
+	Token string
+	// PageSize is the number of items returned per page. It defaults to 2
+	// so tests exercise pagination.
+	PageSize int
+
+	mu       sync.Mutex
+	services []*TestService
+	pods     []*TestPod
+}
+
+// NewTestApiServer starts a TestApiServer which is stopped when the test
+// finishes.
+func NewTestApiServer(t testing.TB) *TestApiServer {
+	t.Helper()
+	s := &TestApiServer{
+		Token:    "test-token",
+		PageSize: 2,
+	}
+	srv := httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(srv.Close)
+	s.Address = srv.URL
+	s.CaCert = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	return s
+}
+
+// CatalogAttributes returns the host catalog attributes to reach the server.
+func (s *TestApiServer) CatalogAttributes() map[string]any {
+	return map[string]any{
+		ApiServerAddressAttrField: s.Address,
+		CaCertAttrField:           s.CaCert,
+	}
+}
+
+// CatalogSecrets returns the host catalog secrets to authenticate to the
+// server.
+func (s *TestApiServer) CatalogSecrets() map[string]any {
+	return map[string]any{
+		TokenSecretField: s.Token,
+	}
+}
+
+// SetServices replaces the Services served.
+func (s *TestApiServer) SetServices(svcs ...*TestService) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.services = svcs
+}
+
+// SetPods replaces the Pods served.
+func (s *TestApiServer) SetPods(pods ...*TestPod) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pods = pods
+}
+
+func (s *TestApiServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+s.Token {
+		http.Error(w, `{"kind":"Status","reason":"Unauthorized"}`, http.StatusUnauthorized)
+		return
+	}
+	// /api/v1/namespaces/{namespace}/{resource}
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if r.Method != http.MethodGet || len(parts) != 5 || parts[0] != "api" || parts[1] != "v1" || parts[2] != "namespaces" {
+		http.NotFound(w, r)
+		return
+	}
+	namespace, resource := parts[3], parts[4]
+	reqs, err := parseLabelSelector(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	var items []any
+	switch resource {
+	case ResourceServices:
+		for _, svc := range s.services {
+			if svc.Namespace == namespace && matches(reqs, svc.Labels) {
+				items = append(items, svc.toApi())
+			}
+		}
+	case ResourcePods:
+		for _, p := range s.pods {
+			if p.Namespace == namespace && matches(reqs, p.Labels) {
+				items = append(items, p.toApi())
+			}
+		}
+	default:
+		s.mu.Unlock()
+		http.NotFound(w, r)
+		return
+	}
+	pageSize := s.PageSize
+	s.mu.Unlock()
+
+	start, _ := strconv.Atoi(r.URL.Query().Get("continue"))
+	if limit, _ := strconv.Atoi(r.URL.Query().Get("limit")); limit > 0 && limit < pageSize {
+		pageSize = limit
+	}
+	end := len(items)
+	var cont string
+	if pageSize > 0 && start+pageSize < end {
+		end = start + pageSize
+		cont = strconv.Itoa(end)
+	}
+	if start > end {
+		start = end
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"metadata": map[string]any{"continue": cont},
+		"items":    items[start:end],
+	})
+}
+
+func (svc *TestService) toApi() *service {
+	ret := &service{Metadata: objectMeta{
+		Name:      svc.Name,
+		Namespace: svc.Namespace,
+		Uid:       svc.Uid,
+		Labels:    svc.Labels,
+	}}
+	ret.Spec.ClusterIP = svc.ClusterIP
+	if svc.ClusterIP != "" {
+		ret.Spec.ClusterIPs = []string{svc.ClusterIP}
+	}
+	for _, p := range svc.Ports {
+		ret.Spec.Ports = append(ret.Spec.Ports, servicePort{Name: p.Name, Port: p.Port, Protocol: p.Protocol})
+	}
+	return ret
+}
+
+func (p *TestPod) toApi() *pod {
+	ret := &pod{Metadata: objectMeta{
+		Name:      p.Name,
+		Namespace: p.Namespace,
+		Uid:       p.Uid,
+		Labels:    p.Labels,
+	}}
+	ret.Status.PodIP = p.PodIP
+	if len(p.Ports) > 0 {
+		ports := make([]containerPort, 0, len(p.Ports))
+		for _, tp := range p.Ports {
+			ports = append(ports, containerPort{Name: tp.Name, ContainerPort: tp.Port, Protocol: tp.Protocol})
+		}
+		ret.Spec.Containers = append(ret.Spec.Containers, container{Name: "main", Ports: ports})
+	}
+	return ret
+}
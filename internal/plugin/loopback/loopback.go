@@ -39,8 +39,8 @@ type LoopbackPlugin struct {
 }
 
 // NewLoopbackPlugin returns a new loopback plugin.
-// For storage service testings NewLoopbackPlugin Supports WithMockErrors
-// and WithMockBuckets as options. If no mock buckets are provided,
+// For storage service testings NewLoopbackPlugin Supports WithMockErrors,
+// WithMockBuckets and WithBucketProfile as options. If no mock buckets are provided,
 // a bucket named `default` will be created with several zero-length files
 // included.
 func NewLoopbackPlugin(opt ...TestOption) (*LoopbackPlugin, error) {
@@ -121,6 +121,9 @@ func NewLoopbackPlugin(opt ...TestOption) (*LoopbackPlugin, error) {
 	if len(opts.withMockPutObjectResponse) > 0 {
 		ret.putObjectResponse = opts.withMockPutObjectResponse
 	}
+	for name, profile := range opts.withBucketProfiles {
+		ret.SetBucketProfile(string(name), profile)
+	}
 
 	return ret, nil
 }
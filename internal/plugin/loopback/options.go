@@ -4,6 +4,9 @@
 package loopback
 
 import (
+	"errors"
+	"time"
+
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
 	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"google.golang.org/grpc/codes"
@@ -121,6 +124,39 @@ func (r PluginMockPutObjectResponse) match(bucket *storagebuckets.StorageBucket,
 	return true
 }
 
+// BucketProfile is used to simulate the latency and the failures of an
+// external object store bucket.
+type BucketProfile struct {
+	// Latency is added to each call to a plugin method for the bucket.
+	Latency time.Duration
+	// Jitter is the upper bound of a random duration added to the latency.
+	Jitter time.Duration
+	// ErrorRate is the probability, between 0 and 1, of a call failing with
+	// the ErrCode and ErrMsg. ErrCode defaults to codes.Unavailable.
+	ErrorRate float64
+	ErrCode   codes.Code
+	ErrMsg    string
+	// Methods limits the profile to the given plugin methods. The profile
+	// is used by all the plugin methods when Methods is empty or contains Any.
+	Methods []Method
+	// Seed is used to seed the jitter and the failures of the bucket, so
+	// that they are reproducible between test runs.
+	Seed int64
+}
+
+// match returns true when the profile should be used for the given plugin method.
+func (p BucketProfile) match(method Method) bool {
+	if len(p.Methods) == 0 {
+		return true
+	}
+	for _, m := range p.Methods {
+		if m == Any || m == method {
+			return true
+		}
+	}
+	return false
+}
+
 type TestOption func(*TestOptions) error
 
 type TestOptions struct {
@@ -128,6 +164,7 @@ type TestOptions struct {
 	withMockError             []PluginMockError
 	withMockPutObjectResponse []PluginMockPutObjectResponse
 	withChunkSize             int
+	withBucketProfiles        map[BucketName]BucketProfile
 }
 
 // getTestOpts - iterate the inbound Options and return a struct
@@ -170,6 +207,21 @@ func WithMockPutObjectResponse(response PluginMockPutObjectResponse) TestOption
 	}
 }
 
+// WithBucketProfile provides an option to simulate the latency and the
+// failures of a mocked external object store bucket.
+func WithBucketProfile(name BucketName, profile BucketProfile) TestOption {
+	return func(o *TestOptions) error {
+		if profile.ErrorRate < 0 || profile.ErrorRate > 1 {
+			return errors.New("bucket profile error rate must be between 0 and 1")
+		}
+		if o.withBucketProfiles == nil {
+			o.withBucketProfiles = make(map[BucketName]BucketProfile)
+		}
+		o.withBucketProfiles[name] = profile
+		return nil
+	}
+}
+
 // WithChunkSize provides an option to set the chunkSize used for grpc streams.
 func WithChunkSize(size int) TestOption {
 	return func(o *TestOptions) error {
@@ -9,6 +9,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"io"
+	"math/rand"
 	"os"
 	"path"
 	"strings"
@@ -122,6 +123,61 @@ type LoopbackStorage struct {
 	buckets           map[BucketName]Bucket
 	errs              []PluginMockError
 	putObjectResponse []PluginMockPutObjectResponse
+	profiles          map[BucketName]*bucketProfile
+}
+
+// bucketProfile is a BucketProfile along with the random number generator
+// seeded for it.
+type bucketProfile struct {
+	BucketProfile
+	rand *rand.Rand
+}
+
+func newBucketProfile(p BucketProfile) *bucketProfile {
+	return &bucketProfile{
+		BucketProfile: p,
+		rand:          rand.New(rand.NewSource(p.Seed)),
+	}
+}
+
+// simulateProfile applies the profile of the named bucket, if any, to a call
+// of the given plugin method. It waits for the latency of the profile without
+// holding the storage lock, so calls to other buckets are not delayed, and
+// returns the error of the profile when the call is selected to fail.
+func (l *LoopbackStorage) simulateProfile(ctx context.Context, op, bucketName string, method Method) error {
+	l.m.Lock()
+	p, ok := l.profiles[BucketName(bucketName)]
+	if !ok || !p.match(method) {
+		l.m.Unlock()
+		return nil
+	}
+	delay := p.Latency
+	if p.Jitter > 0 {
+		delay += time.Duration(p.rand.Int63n(int64(p.Jitter)))
+	}
+	fail := p.ErrorRate > 0 && p.rand.Float64() < p.ErrorRate
+	errCode, errMsg := p.ErrCode, p.ErrMsg
+	l.m.Unlock()
+
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+	if !fail {
+		return nil
+	}
+	if errCode == codes.OK {
+		errCode = codes.Unavailable
+	}
+	if errMsg == "" {
+		errMsg = "simulated bucket failure"
+	}
+	return status.Errorf(errCode, "%s: %s", op, errMsg)
 }
 
 func (l *LoopbackStorage) onCreateStorageBucket(ctx context.Context, req *plgpb.OnCreateStorageBucketRequest) (*plgpb.OnCreateStorageBucketResponse, error) {
@@ -135,6 +191,9 @@ func (l *LoopbackStorage) onCreateStorageBucket(ctx context.Context, req *plgpb.
 	if req.GetBucket().GetAttributes() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s: missing attributes", op)
 	}
+	if err := l.simulateProfile(ctx, op, req.GetBucket().GetBucketName(), OnCreateStorageBucket); err != nil {
+		return nil, err
+	}
 	l.m.Lock()
 	defer l.m.Unlock()
 	if _, ok := l.buckets[BucketName(req.GetBucket().GetBucketName())]; !ok {
@@ -179,6 +238,9 @@ func (l *LoopbackStorage) onUpdateStorageBucket(ctx context.Context, req *plgpb.
 	if req.GetNewBucket().GetAttributes() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s: missing attributes", op)
 	}
+	if err := l.simulateProfile(ctx, op, req.GetNewBucket().GetBucketName(), OnUpdateStorageBucket); err != nil {
+		return nil, err
+	}
 	l.m.Lock()
 	defer l.m.Unlock()
 	if _, ok := l.buckets[BucketName(req.GetNewBucket().GetBucketName())]; !ok {
@@ -228,6 +290,9 @@ func (l *LoopbackStorage) onDeleteStorageBucket(ctx context.Context, req *plgpb.
 	if req.GetBucket().GetAttributes() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s: missing attributes", op)
 	}
+	if err := l.simulateProfile(ctx, op, req.GetBucket().GetBucketName(), OnDeleteStorageBucket); err != nil {
+		return nil, err
+	}
 	return &plgpb.OnDeleteStorageBucketResponse{}, nil
 }
 
@@ -242,6 +307,9 @@ func (l *LoopbackStorage) validatePermissions(ctx context.Context, req *plgpb.Va
 	if req.GetBucket().GetAttributes() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s: missing attributes", op)
 	}
+	if err := l.simulateProfile(ctx, op, req.GetBucket().GetBucketName(), ValidatePermissions); err != nil {
+		return nil, err
+	}
 	l.m.Lock()
 	defer l.m.Unlock()
 	if _, ok := l.buckets[BucketName(req.GetBucket().GetBucketName())]; !ok {
@@ -273,6 +341,9 @@ func (l *LoopbackStorage) headObject(ctx context.Context, req *plgpb.HeadObjectR
 	if req.GetKey() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "%s; missing object key", op)
 	}
+	if err := l.simulateProfile(ctx, op, req.GetBucket().GetBucketName(), HeadObject); err != nil {
+		return nil, err
+	}
 	l.m.Lock()
 	defer l.m.Unlock()
 	bucket, ok := l.buckets[BucketName(req.GetBucket().GetBucketName())]
@@ -316,6 +387,9 @@ func (l *LoopbackStorage) getObject(req *plgpb.GetObjectRequest, stream plgpb.St
 	if req.GetKey() == "" {
 		return status.Errorf(codes.InvalidArgument, "%s; missing object key", op)
 	}
+	if err := l.simulateProfile(stream.Context(), op, req.GetBucket().GetBucketName(), GetObject); err != nil {
+		return err
+	}
 	l.m.Lock()
 	defer l.m.Unlock()
 	bucket, ok := l.buckets[BucketName(req.GetBucket().GetBucketName())]
@@ -335,15 +409,17 @@ func (l *LoopbackStorage) getObject(req *plgpb.GetObjectRequest, stream plgpb.St
 			return status.Errorf(err.ErrCode, "%s: %s", op, err.ErrMsg)
 		}
 	}
+	// copy the object data while holding the lock, so the stream is not
+	// affected by changes made to the bucket while it is being sent.
+	data := []byte{}
+	for _, chunk := range object.DataChunks {
+		data = append(data, chunk...)
+	}
 	go func() {
 		chunkSize := req.GetChunkSize()
 		if chunkSize == 0 {
 			chunkSize = defaultStreamChunkSize
 		}
-		data := []byte{}
-		for _, chunk := range object.DataChunks {
-			data = append(data, chunk...)
-		}
 		for i := 0; i < len(data); i += int(chunkSize) {
 			end := i + int(chunkSize)
 			if end > len(data) {
@@ -391,6 +467,9 @@ func (l *LoopbackStorage) putObject(ctx context.Context, req *plgpb.PutObjectReq
 	if info.IsDir() {
 		return nil, status.Errorf(codes.InvalidArgument, "%s: path is a directory", op)
 	}
+	if err := l.simulateProfile(ctx, op, req.GetBucket().GetBucketName(), PutObject); err != nil {
+		return nil, err
+	}
 	l.m.Lock()
 	defer l.m.Unlock()
 	bucket, ok := l.buckets[BucketName(req.GetBucket().GetBucketName())]
@@ -464,6 +543,9 @@ func (l *LoopbackStorage) deleteObjects(ctx context.Context, req *plgpb.DeleteOb
 	if req.GetKeyPrefix() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "%s; missing key prefix", op)
 	}
+	if err := l.simulateProfile(ctx, op, req.GetBucket().GetBucketName(), DeleteObjects); err != nil {
+		return nil, err
+	}
 	l.m.Lock()
 	defer l.m.Unlock()
 	bucket, ok := l.buckets[BucketName(req.GetBucket().GetBucketName())]
@@ -504,13 +586,80 @@ func (l *LoopbackStorage) CloneBucket(name string) Bucket {
 	if _, ok := l.buckets[BucketName(name)]; !ok {
 		return nil
 	}
-	bucket := Bucket{}
-	for objName, obj := range l.buckets[BucketName(name)] {
+	return copyBucket(l.buckets[BucketName(name)])
+}
+
+// Snapshot is a copy of the contents of all the buckets of a LoopbackStorage.
+type Snapshot map[BucketName]Bucket
+
+// Snapshot returns a copy of all the buckets and their objects, which can be
+// used to restore the storage to its current state with Restore.
+func (l *LoopbackStorage) Snapshot() Snapshot {
+	l.m.Lock()
+	defer l.m.Unlock()
+	return Snapshot(copyBuckets(l.buckets))
+}
+
+// Restore replaces all the buckets and their objects with a copy of the
+// snapshot. Buckets created after the snapshot was taken are removed.
+func (l *LoopbackStorage) Restore(s Snapshot) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.buckets = copyBuckets(s)
+}
+
+// SetBucket creates or replaces the named bucket with a copy of the bucket.
+func (l *LoopbackStorage) SetBucket(name string, bucket Bucket) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.buckets == nil {
+		l.buckets = make(map[BucketName]Bucket)
+	}
+	l.buckets[BucketName(name)] = copyBucket(bucket)
+}
+
+// DeleteBucket deletes the named bucket and its objects.
+func (l *LoopbackStorage) DeleteBucket(name string) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	delete(l.buckets, BucketName(name))
+}
+
+// SetBucketProfile sets the latency and failures simulated for the named
+// bucket, replacing any previous profile of the bucket and resetting its
+// random number generator to the seed of the profile.
+func (l *LoopbackStorage) SetBucketProfile(name string, profile BucketProfile) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.profiles == nil {
+		l.profiles = make(map[BucketName]*bucketProfile)
+	}
+	l.profiles[BucketName(name)] = newBucketProfile(profile)
+}
+
+// DeleteBucketProfile stops simulating latency and failures for the named bucket.
+func (l *LoopbackStorage) DeleteBucketProfile(name string) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	delete(l.profiles, BucketName(name))
+}
+
+func copyBuckets(buckets map[BucketName]Bucket) map[BucketName]Bucket {
+	ret := make(map[BucketName]Bucket, len(buckets))
+	for name, bucket := range buckets {
+		ret[name] = copyBucket(bucket)
+	}
+	return ret
+}
+
+func copyBucket(bucket Bucket) Bucket {
+	ret := make(Bucket, len(bucket))
+	for objName, obj := range bucket {
 		if obj != nil {
-			bucket[objName] = copyStorageInfo(obj)
+			ret[objName] = copyStorageInfo(obj)
 		}
 	}
-	return bucket
+	return ret
 }
 
 // CloneStorageInfo returns a clone of the object stored in memory.
@@ -534,13 +683,18 @@ func copyStorageInfo(obj *storagePluginStorageInfo) *storagePluginStorageInfo {
 	for i, c := range obj.DataChunks {
 		chunks[i] = copyBytes(c)
 	}
-	contentLength := *obj.contentLength
-	lastModified := *obj.lastModified
-	return &storagePluginStorageInfo{
-		DataChunks:    chunks,
-		lastModified:  &lastModified,
-		contentLength: &contentLength,
+	ret := &storagePluginStorageInfo{
+		DataChunks: chunks,
+	}
+	if obj.contentLength != nil {
+		contentLength := *obj.contentLength
+		ret.contentLength = &contentLength
+	}
+	if obj.lastModified != nil {
+		lastModified := *obj.lastModified
+		ret.lastModified = &lastModified
 	}
+	return ret
 }
 
 func MockObject(data []Chunk) *storagePluginStorageInfo {
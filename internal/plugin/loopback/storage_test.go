@@ -1704,6 +1704,158 @@ func TestLoopbackStoragePlugin(t *testing.T) {
 	assert.EqualValues(objectData, getObjectData)
 }
 
+func TestLoopbackStorage_SnapshotRestore(t *testing.T) {
+	require, assert := tr.New(t), ta.New(t)
+	td := t.TempDir()
+
+	plg, err := NewLoopbackPlugin(WithMockBuckets(map[BucketName]Bucket{
+		"bucket-1": {"existing": MockObject([]Chunk{[]byte("existing")})},
+	}))
+	require.NoError(err)
+	snapshot := plg.Snapshot()
+
+	objectPath := path.Join(td, "object")
+	require.NoError(os.WriteFile(objectPath, []byte("new object"), fs.ModePerm))
+	bucket := &storagebuckets.StorageBucket{
+		BucketName: "bucket-1",
+		Attributes: &structpb.Struct{},
+	}
+	_, err = plg.PutObject(context.Background(), &plgpb.PutObjectRequest{
+		Bucket: bucket,
+		Key:    "new",
+		Path:   objectPath,
+	})
+	require.NoError(err)
+	_, err = plg.DeleteObjects(context.Background(), &plgpb.DeleteObjectsRequest{
+		Bucket:    bucket,
+		KeyPrefix: "existing",
+	})
+	require.NoError(err)
+	plg.SetBucket("bucket-2", Bucket{"other": MockObject([]Chunk{[]byte("other")})})
+
+	// the snapshot is not affected by changes made to the storage
+	assert.Len(snapshot, 1)
+	assert.Contains(snapshot["bucket-1"], ObjectName("existing"))
+	assert.NotContains(snapshot["bucket-1"], ObjectName("new"))
+	assert.NotNil(plg.CloneBucket("bucket-2"))
+
+	plg.Restore(snapshot)
+	restored := plg.CloneBucket("bucket-1")
+	require.NotNil(restored)
+	assert.Len(restored, 1)
+	require.Contains(restored, ObjectName("existing"))
+	assert.Equal([]Chunk{[]byte("existing")}, restored["existing"].DataChunks)
+	assert.Nil(plg.CloneBucket("bucket-2"))
+
+	// the storage is not affected by changes made to the restored snapshot
+	delete(snapshot["bucket-1"], "existing")
+	assert.NotNil(plg.CloneStorageInfo("bucket-1", "existing"))
+
+	plg.DeleteBucket("bucket-1")
+	assert.Nil(plg.CloneBucket("bucket-1"))
+}
+
+func TestLoopbackStorage_BucketProfile(t *testing.T) {
+	bucket := func(name string) *storagebuckets.StorageBucket {
+		return &storagebuckets.StorageBucket{
+			BucketName: name,
+			Attributes: &structpb.Struct{},
+		}
+	}
+	headObject := func(plg *LoopbackPlugin, ctx context.Context, name string) error {
+		_, err := plg.HeadObject(ctx, &plgpb.HeadObjectRequest{
+			Bucket: bucket(name),
+			Key:    "object",
+		})
+		return err
+	}
+	buckets := func() map[BucketName]Bucket {
+		return map[BucketName]Bucket{
+			"slow":  {"object": MockObject([]Chunk{[]byte("data")})},
+			"flaky": {"object": MockObject([]Chunk{[]byte("data")})},
+		}
+	}
+
+	t.Run("invalid-error-rate", func(t *testing.T) {
+		_, err := NewLoopbackPlugin(WithBucketProfile("flaky", BucketProfile{ErrorRate: 1.5}))
+		ta.Error(t, err)
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		require, assert := tr.New(t), ta.New(t)
+		plg, err := NewLoopbackPlugin(
+			WithMockBuckets(buckets()),
+			WithBucketProfile("slow", BucketProfile{Latency: 200 * time.Millisecond}),
+		)
+		require.NoError(err)
+
+		start := time.Now()
+		require.NoError(headObject(plg, context.Background(), "slow"))
+		assert.GreaterOrEqual(time.Since(start), 200*time.Millisecond)
+
+		// a slow bucket does not delay calls to other buckets
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			assert.NoError(headObject(plg, context.Background(), "slow"))
+		}()
+		start = time.Now()
+		require.NoError(headObject(plg, context.Background(), "flaky"))
+		assert.Less(time.Since(start), 200*time.Millisecond)
+		<-done
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err = headObject(plg, ctx, "slow")
+		require.Error(err)
+		assert.Equal(codes.Canceled, status.Code(err))
+	})
+
+	t.Run("error-rate", func(t *testing.T) {
+		require, assert := tr.New(t), ta.New(t)
+		profile := BucketProfile{
+			ErrorRate: 0.5,
+			ErrCode:   codes.Internal,
+			ErrMsg:    "flaky bucket",
+			Methods:   []Method{HeadObject},
+			Seed:      42,
+		}
+		results := func(plg *LoopbackPlugin) []bool {
+			var failed []bool
+			for i := 0; i < 20; i++ {
+				err := headObject(plg, context.Background(), "flaky")
+				if err != nil {
+					assert.Equal(codes.Internal, status.Code(err))
+					assert.Contains(err.Error(), "flaky bucket")
+				}
+				failed = append(failed, err != nil)
+			}
+			return failed
+		}
+		plg, err := NewLoopbackPlugin(WithMockBuckets(buckets()), WithBucketProfile("flaky", profile))
+		require.NoError(err)
+		first := results(plg)
+		assert.Contains(first, true)
+		assert.Contains(first, false)
+
+		// the failures are reproducible for the same seed
+		plg.SetBucketProfile("flaky", profile)
+		assert.Equal(first, results(plg))
+
+		// the profile is not used by other methods and other buckets
+		for i := 0; i < 20; i++ {
+			_, err := plg.ValidatePermissions(context.Background(), &plgpb.ValidatePermissionsRequest{Bucket: bucket("flaky")})
+			require.NoError(err)
+			require.NoError(headObject(plg, context.Background(), "slow"))
+		}
+
+		plg.DeleteBucketProfile("flaky")
+		for i := 0; i < 20; i++ {
+			require.NoError(headObject(plg, context.Background(), "flaky"))
+		}
+	})
+}
+
 func parseStorageBucketCredentialStateFromError(err error) *plgpb.StorageBucketCredentialState {
 	sbcState := &plgpb.StorageBucketCredentialState{
 		State: &plgpb.Permissions{
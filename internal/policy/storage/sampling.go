@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package storage contains the logic shared by storage policies.
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
+)
+
+// DefaultRecordPercent is the percentage of sessions recorded when a storage
+// policy does not set one.
+const DefaultRecordPercent = 100
+
+// Sampling contains the settings of a storage policy that select which
+// sessions are recorded.
+type Sampling struct {
+	// Percent is the percentage of sessions, between 1 and 100, that are
+	// recorded. A zero value is treated as DefaultRecordPercent.
+	Percent int32
+	// Filter is an optional boolean expression that sessions must match to be
+	// recorded. It is evaluated against the data described in SamplingInput.
+	Filter string
+}
+
+// SamplingInput describes a session for the evaluation of a Sampling. The
+// filter of a Sampling is evaluated against the following data:
+//
+//	/session/id, /user/id, /target/id, /scope/id and /scope/parent_scope_id
+//
+// where the scope is the project of the target and its parent is the org.
+type SamplingInput struct {
+	SessionId     string
+	UserId        string
+	TargetId      string
+	ProjectId     string
+	OrgId         string
+	evaluatedData map[string]any
+}
+
+func (in *SamplingInput) data() map[string]any {
+	if in.evaluatedData == nil {
+		in.evaluatedData = map[string]any{
+			"session": map[string]any{"id": in.SessionId},
+			"user":    map[string]any{"id": in.UserId},
+			"target":  map[string]any{"id": in.TargetId},
+			"scope": map[string]any{
+				"id":              in.ProjectId,
+				"parent_scope_id": in.OrgId,
+			},
+		}
+	}
+	return in.evaluatedData
+}
+
+// Validate returns an error if the sampling settings are not valid.
+func (s *Sampling) Validate(ctx context.Context) error {
+	const op = "storage.(Sampling).Validate"
+	if s.Percent < 0 || s.Percent > 100 {
+		return errors.New(ctx, errors.InvalidParameter, op, "record percent must be between 1 and 100")
+	}
+	if s.Filter != "" {
+		if _, err := bexpr.CreateEvaluator(s.Filter); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid record filter"))
+		}
+	}
+	return nil
+}
+
+// Evaluate decides whether the session described by the input is recorded.
+// It returns an empty reason if the session must be recorded, or the reason
+// the session is skipped otherwise. The filter is evaluated first; sessions
+// matching it are then selected by a hash of their ID, so the decision is
+// stable for a session and sessions are spread evenly across the percentage.
+func (s *Sampling) Evaluate(ctx context.Context, in *SamplingInput) (session.RecordingSkipReason, error) {
+	const op = "storage.(Sampling).Evaluate"
+	switch {
+	case s == nil:
+		return "", nil
+	case in == nil:
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing sampling input")
+	case in.SessionId == "":
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+
+	if s.Filter != "" {
+		eval, err := bexpr.CreateEvaluator(s.Filter)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		match, err := eval.Evaluate(in.data())
+		if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		if !match {
+			return session.FilteredOut, nil
+		}
+	}
+
+	percent := s.Percent
+	if percent == 0 {
+		percent = DefaultRecordPercent
+	}
+	if percent < 100 && sampleBucket(in.SessionId) >= uint64(percent) {
+		return session.SampledOut, nil
+	}
+	return "", nil
+}
+
+// sampleBucket maps a session ID to a number between 0 and 99.
+func sampleBucket(sessionId string) uint64 {
+	sum := sha256.Sum256([]byte(sessionId))
+	return binary.BigEndian.Uint64(sum[:8]) % 100
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSampling_Validate(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name    string
+		s       Sampling
+		wantErr bool
+	}{
+		{"zero", Sampling{}, false},
+		{"percent", Sampling{Percent: 25}, false},
+		{"filter", Sampling{Filter: `"/user/id" == "u_1234567890"`}, false},
+		{"negative-percent", Sampling{Percent: -1}, true},
+		{"too-large-percent", Sampling{Percent: 101}, true},
+		{"invalid-filter", Sampling{Filter: `"/user/id" ==`}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.s.Validate(ctx)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSampling_Evaluate(t *testing.T) {
+	ctx := context.Background()
+	in := func(id string) *SamplingInput {
+		return &SamplingInput{
+			SessionId: id,
+			UserId:    "u_1234567890",
+			TargetId:  "ttcp_1234567890",
+			ProjectId: "p_1234567890",
+			OrgId:     "o_1234567890",
+		}
+	}
+
+	t.Run("nil-sampling", func(t *testing.T) {
+		var s *Sampling
+		got, err := s.Evaluate(ctx, in("s_1234567890"))
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+	t.Run("missing-session-id", func(t *testing.T) {
+		s := &Sampling{}
+		_, err := s.Evaluate(ctx, in(""))
+		assert.Error(t, err)
+	})
+	t.Run("filter-match", func(t *testing.T) {
+		s := &Sampling{Filter: `"/scope/parent_scope_id" == "o_1234567890"`}
+		got, err := s.Evaluate(ctx, in("s_1234567890"))
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+	t.Run("filter-no-match", func(t *testing.T) {
+		s := &Sampling{Filter: `"/user/id" == "u_other"`}
+		got, err := s.Evaluate(ctx, in("s_1234567890"))
+		require.NoError(t, err)
+		assert.Equal(t, session.FilteredOut, got)
+	})
+	t.Run("filter-missing-field", func(t *testing.T) {
+		s := &Sampling{Filter: `"/user/name" == "alice"`}
+		got, err := s.Evaluate(ctx, in("s_1234567890"))
+		require.NoError(t, err)
+		assert.Equal(t, session.FilteredOut, got)
+	})
+	t.Run("percent", func(t *testing.T) {
+		s := &Sampling{Percent: 30}
+		var recorded int
+		for i := 0; i < 10_000; i++ {
+			id := fmt.Sprintf("s_%010d", i)
+			got, err := s.Evaluate(ctx, in(id))
+			require.NoError(t, err)
+			again, err := s.Evaluate(ctx, in(id))
+			require.NoError(t, err)
+			assert.Equal(t, got, again, "decision must be stable")
+			switch got {
+			case "":
+				recorded++
+			default:
+				assert.Equal(t, session.SampledOut, got)
+			}
+		}
+		assert.InDelta(t, 3_000, recorded, 300)
+	})
+	t.Run("full-percent", func(t *testing.T) {
+		s := &Sampling{Percent: 100}
+		for i := 0; i < 100; i++ {
+			got, err := s.Evaluate(ctx, in(fmt.Sprintf("s_%010d", i)))
+			require.NoError(t, err)
+			assert.Empty(t, got)
+		}
+	})
+}
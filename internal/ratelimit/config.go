@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -23,19 +24,26 @@ import (
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/credentiallibraries"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/credentials"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/credentialstores"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/environments"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/features"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/filters"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/groups"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/health"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/host_catalogs"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/host_sets"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/hosts"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/listtokens"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/maintenance"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/managed_groups"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/policies"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/roles"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/scopes"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/search"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/session_recordings"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/sessions"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/storage_buckets"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targettemplates"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/users"
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/workers"
 )
@@ -95,12 +103,40 @@ func DefaultLimiterMaxQuotas() int {
 	return defaultLimiterMaxQuotas
 }
 
+// Endpoint classes that can be used in a Config instead of listing actions.
+const (
+	// ClassList matches the list action and the other actions that list
+	// items, such as list-keys.
+	ClassList = "list"
+	// ClassRead matches the actions that only read, such as read, read:self
+	// and no-op.
+	ClassRead = "read"
+	// ClassWrite matches every action that is not in the list or read class.
+	ClassWrite = "write"
+)
+
+// actionClass returns the endpoint class of the action.
+func actionClass(a action.Type) string {
+	s := a.String()
+	switch {
+	case a == action.List, strings.HasPrefix(s, "list-"):
+		return ClassList
+	case a == action.Read, a == action.NoOp, a == action.Download,
+		strings.HasPrefix(s, "read:"), strings.HasPrefix(s, "read-"):
+		return ClassRead
+	default:
+		return ClassWrite
+	}
+}
+
 // Config is used to configure rate limits. Each config is used to specify
 // the maximum number of requests that can be made in a time period for the
-// corresponding resources and actions.
+// corresponding resources and actions. Classes can be used instead of
+// Actions to apply the config to every action of an endpoint class.
 type Config struct {
 	Resources []string      `hcl:"resources"`
 	Actions   []string      `hcl:"actions"`
+	Classes   []string      `hcl:"classes"`
 	Per       string        `hcl:"per"`
 	Limit     int           `hcl:"limit"`
 	PeriodHCL string        `hcl:"period"`
@@ -209,34 +245,61 @@ func (c Configs) Limits(ctx context.Context) ([]rate.Limit, error) {
 			}
 		}
 
+		override := func(res resource.Type, a action.Type) {
+			key := fmt.Sprintf("%s:%s:%s", res.String(), a.String(), rate.LimitPer(cc.Per))
+
+			switch {
+			case cc.Unlimited:
+				defaults[key] = &rate.Unlimited{
+					Resource: res.String(),
+					Action:   a.String(),
+					Per:      rate.LimitPer(cc.Per),
+				}
+			default:
+				defaults[key] = &rate.Limited{
+					Resource:    res.String(),
+					Action:      a.String(),
+					Per:         rate.LimitPer(cc.Per),
+					MaxRequests: uint64(cc.Limit),
+					Period:      cc.Period,
+				}
+			}
+		}
+
 		switch {
-		case len(cc.Actions) == 1 && cc.Actions[0] == action.All.String():
+		case len(cc.Actions) != 0 && len(cc.Classes) != 0:
+			return nil, errors.New(ctx, errors.InvalidConfiguration, op, "", errors.WithMsg("actions and classes cannot both be set"))
+		case len(cc.Classes) != 0:
+			classes := make(map[string]bool, len(cc.Classes))
+			for _, class := range cc.Classes {
+				switch class {
+				case ClassList, ClassRead, ClassWrite:
+					classes[class] = true
+				default:
+					return nil, errors.New(ctx, errors.InvalidConfiguration, op, "", errors.WithMsg("unknown class %s", class))
+				}
+			}
 			for _, res := range resourceSet {
 				validActions, err := action.ActionSetForResource(res)
 				if err != nil {
 					return nil, err
 				}
 				for a := range validActions {
-					key := fmt.Sprintf("%s:%s:%s", res.String(), a.String(), rate.LimitPer(cc.Per))
-
-					switch {
-					case cc.Unlimited:
-						defaults[key] = &rate.Unlimited{
-							Resource: res.String(),
-							Action:   a.String(),
-							Per:      rate.LimitPer(cc.Per),
-						}
-					default:
-						defaults[key] = &rate.Limited{
-							Resource:    res.String(),
-							Action:      a.String(),
-							Per:         rate.LimitPer(cc.Per),
-							MaxRequests: uint64(cc.Limit),
-							Period:      cc.Period,
-						}
+					if classes[actionClass(a)] {
+						override(res, a)
 					}
 				}
 			}
+		case len(cc.Actions) == 1 && cc.Actions[0] == action.All.String():
+			for _, res := range resourceSet {
+				validActions, err := action.ActionSetForResource(res)
+				if err != nil {
+					return nil, err
+				}
+				for a := range validActions {
+					override(res, a)
+				}
+			}
 		default:
 			for _, res := range resourceSet {
 				validActions, err := action.ActionSetForResource(res)
@@ -253,24 +316,7 @@ func (c Configs) Limits(ctx context.Context) ([]rate.Limit, error) {
 					if !ok {
 						return nil, errors.New(ctx, errors.InvalidConfiguration, op, "", errors.WithMsg("action %s not valid for resource %s", aStr, res.String()))
 					}
-					key := fmt.Sprintf("%s:%s:%s", res.String(), a.String(), rate.LimitPer(cc.Per))
-
-					switch {
-					case cc.Unlimited:
-						defaults[key] = &rate.Unlimited{
-							Resource: res.String(),
-							Action:   a.String(),
-							Per:      rate.LimitPer(cc.Per),
-						}
-					default:
-						defaults[key] = &rate.Limited{
-							Resource:    res.String(),
-							Action:      a.String(),
-							Per:         rate.LimitPer(cc.Per),
-							MaxRequests: uint64(cc.Limit),
-							Period:      cc.Period,
-						}
-					}
+					override(res, a)
 				}
 			}
 		}
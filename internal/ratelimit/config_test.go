@@ -779,6 +779,71 @@ func TestConfigsLimits(t *testing.T) {
 	}
 }
 
+func TestConfigsLimits_Classes(t *testing.T) {
+	ctx := context.Background()
+
+	configs := Configs{
+		{
+			Resources: []string{"*"},
+			Classes:   []string{ClassRead, ClassList},
+			Per:       "auth-token",
+			Limit:     100,
+			Period:    time.Minute,
+		},
+		{
+			Resources: []string{"session"},
+			Classes:   []string{ClassWrite},
+			Per:       "auth-token",
+			Unlimited: true,
+		},
+	}
+	got, err := configs.Limits(ctx)
+	require.NoError(t, err)
+
+	byKey := make(map[string]rate.Limit, len(got))
+	for _, l := range got {
+		byKey[fmt.Sprintf("%s:%s:%s", l.GetResource(), l.GetAction(), l.GetPer())] = l
+	}
+	configured := &rate.Limited{Per: rate.LimitPerAuthToken, MaxRequests: 100, Period: time.Minute}
+	cases := []struct {
+		resource string
+		action   string
+		want     rate.Limit
+	}{
+		{"target", "read", configured},
+		{"target", "list", configured},
+		{"session", "read:self", configured},
+		{"scope", "list-keys", configured},
+		{"auth-token", "read:self", configured},
+		{"target", "unknown", nil},
+		{"target", "authorize-session", &rate.Limited{Per: rate.LimitPerAuthToken, MaxRequests: DefaultAuthTokenRequestLimit, Period: DefaultPeriod}},
+		{"session", "cancel", &rate.Unlimited{Per: rate.LimitPerAuthToken}},
+		{"session", "cancel:self", &rate.Unlimited{Per: rate.LimitPerAuthToken}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.resource+":"+tc.action, func(t *testing.T) {
+			l, ok := byKey[fmt.Sprintf("%s:%s:%s", tc.resource, tc.action, rate.LimitPerAuthToken)]
+			if tc.want == nil {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			switch want := tc.want.(type) {
+			case *rate.Limited:
+				want.Resource, want.Action = tc.resource, tc.action
+			case *rate.Unlimited:
+				want.Resource, want.Action = tc.resource, tc.action
+			}
+			assert.Equal(t, tc.want, l)
+		})
+	}
+
+	_, err = Configs{{Resources: []string{"*"}, Classes: []string{"delete"}, Per: "total", Limit: 1, Period: time.Second}}.Limits(ctx)
+	assert.EqualError(t, err, "ratelimit.(Configs).Limits: unknown class delete: configuration issue: error #5000")
+	_, err = Configs{{Resources: []string{"*"}, Actions: []string{"read"}, Classes: []string{"read"}, Per: "total", Limit: 1, Period: time.Second}}.Limits(ctx)
+	assert.EqualError(t, err, "ratelimit.(Configs).Limits: actions and classes cannot both be set: configuration issue: error #5000")
+}
+
 func TestDefaulLimiterMaxQuotas(t *testing.T) {
 	var want int
 
@@ -118,6 +118,9 @@ type LimiterFunc func() Limiter
 // using the rate limiter returned by f. If the request is allowed, the next handler
 // is called. Otherwise a 429 is returned with the Retry-After response header
 // set to the number of seconds the client should wait to make it's next request.
+// The RateLimit-Policy header, and the RateLimit header when a quota applies,
+// are set on every response for a known resource and action so clients can
+// throttle themselves before running out of quota.
 func Handler(ctx context.Context, f LimiterFunc, next http.Handler) http.Handler {
 	const op = "ratelimit.Handler"
 	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
@@ -148,6 +151,9 @@ func Handler(ctx context.Context, f LimiterFunc, next http.Handler) http.Handler
 		allowed, quota, err := l.Allow(res, a, reqInfo.ClientIp, authtoken)
 		if err != nil {
 			if errFull, ok := err.(*rate.ErrLimiterFull); ok {
+				// There is no quota to report usage for, but the policy
+				// still lets clients know the limits they are subject to.
+				_ = l.SetPolicyHeader(res, a, rw.Header())
 				rw.Header().Add("Retry-After", fmt.Sprintf("%.0f", math.Ceil(errFull.RetryIn.Seconds())))
 				rw.WriteHeader(http.StatusServiceUnavailable)
 				return
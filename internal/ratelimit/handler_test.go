@@ -440,7 +440,8 @@ func TestHandler(t *testing.T) {
 			"authtoken",
 			http.StatusServiceUnavailable,
 			http.Header{
-				"Retry-After": []string{"1"},
+				"Retry-After":      []string{"1"},
+				"RateLimit-Policy": []string{`2;w=60;comment="total", 2;w=60;comment="ip-address", 2;w=60;comment="auth-token"`},
 			},
 		},
 		{
@@ -4,6 +4,9 @@
 package ratelimit
 
 import (
+	"math"
+	"sync/atomic"
+
 	"github.com/hashicorp/boundary/globals"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -12,8 +15,29 @@ const (
 	subsystem = "controller_api_ratelimiter"
 )
 
+// recordedGauge is a prometheus.Gauge that remembers the last value it was
+// set to, so the value can be reported outside of the metrics endpoint.
+type recordedGauge struct {
+	prometheus.Gauge
+	v atomic.Uint64
+}
+
+func newRecordedGauge(opts prometheus.GaugeOpts) *recordedGauge {
+	return &recordedGauge{Gauge: prometheus.NewGauge(opts)}
+}
+
+// Set sets the gauge to v.
+func (g *recordedGauge) Set(v float64) {
+	g.v.Store(math.Float64bits(v))
+	g.Gauge.Set(v)
+}
+
+func (g *recordedGauge) value() float64 {
+	return math.Float64frombits(g.v.Load())
+}
+
 var (
-	rateLimitQuotaStorageCapacity prometheus.Gauge = prometheus.NewGauge(
+	rateLimitQuotaStorageCapacity = newRecordedGauge(
 		prometheus.GaugeOpts{
 			Namespace: globals.MetricNamespace,
 			Subsystem: subsystem,
@@ -21,7 +45,7 @@ var (
 			Help:      "Guague of the number if quotas that can be stored by the rate limiter",
 		},
 	)
-	rateLimitQuotaUsage prometheus.Gauge = prometheus.NewGauge(
+	rateLimitQuotaUsage = newRecordedGauge(
 		prometheus.GaugeOpts{
 			Namespace: globals.MetricNamespace,
 			Subsystem: subsystem,
@@ -41,3 +65,9 @@ func InitializeMetrics(r prometheus.Registerer) {
 		rateLimitQuotaUsage,
 	)
 }
+
+// QuotaStorage returns the number of quotas currently stored by the rate
+// limiter and the number of quotas it can store.
+func QuotaStorage() (usage, capacity int) {
+	return int(rateLimitQuotaUsage.value()), int(rateLimitQuotaStorageCapacity.value())
+}
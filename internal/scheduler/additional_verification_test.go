@@ -663,3 +663,62 @@ func waitForRunStatus(t *testing.T, repo *job.Repository, runId string, status j
 
 	return run
 }
+
+func TestSchedulerDrain(t *testing.T) {
+	// do not use t.Parallel() since it relies on the sys eventer
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iam.TestRepo(t, conn, wrapper)
+	testConfig := event.DefaultEventerConfig()
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+	})
+	err := event.InitSysEventer(testLogger, testLock, "TestSchedulerDrain", event.WithEventerConfig(testConfig))
+	require.NoError(err)
+	sched := TestScheduler(t, conn, wrapper, WithRunJobsInterval(time.Second))
+
+	jobCh := make(chan error)
+	jobReady := make(chan struct{})
+	fn := func(_ context.Context, _ time.Duration) error {
+		jobReady <- struct{}{}
+		return <-jobCh
+	}
+	tj := testJob{name: "name", description: "desc", fn: fn, nextRunIn: 0}
+	err = sched.RegisterJob(context.Background(), tj)
+	require.NoError(err)
+
+	baseCtx, baseCnl := context.WithCancel(context.Background())
+	defer baseCnl()
+	var wg sync.WaitGroup
+	err = sched.Start(baseCtx, &wg)
+	require.NoError(err)
+
+	// Wait for scheduler to run job
+	<-jobReady
+	assert.Equal([]string{"name"}, sched.RunningJobs())
+
+	// Draining fails while the job is still running
+	drainCtx, drainCnl := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer drainCnl()
+	err = sched.Drain(drainCtx)
+	require.Error(err)
+	assert.Contains(err.Error(), "jobs still running: name")
+
+	// Draining succeeds once the job completes, and the job is not run again
+	// even though its next run is due
+	jobCh <- nil
+	err = sched.Drain(context.Background())
+	require.NoError(err)
+	assert.Empty(sched.RunningJobs())
+	sched.RunNow()
+	select {
+	case <-jobReady:
+		t.Fatal("job ran while the scheduler was draining")
+	case <-time.After(2 * time.Second):
+	}
+
+	baseCnl()
+	wg.Wait()
+}
@@ -6,6 +6,8 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +19,9 @@ import (
 
 type jobRepoFactory func() (*job.Repository, error)
 
+// drainPollInterval is how often Drain checks whether running jobs finished.
+const drainPollInterval = 100 * time.Millisecond
+
 type runningJob struct {
 	runId     string
 	cancelCtx context.CancelFunc
@@ -31,6 +36,7 @@ type Scheduler struct {
 	registeredJobs *sync.Map
 	runningJobs    *sync.Map
 	started        ua.Bool
+	draining       ua.Bool
 
 	runJobsInterval    time.Duration
 	monitorInterval    time.Duration
@@ -210,6 +216,9 @@ func (s *Scheduler) start(ctx context.Context) {
 
 func (s *Scheduler) schedule(ctx context.Context, wg *sync.WaitGroup) {
 	const op = "scheduler.(Scheduler).schedule"
+	if s.draining.Load() {
+		return
+	}
 	repo, err := s.jobRepoFn()
 	if err != nil {
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error creating job repo"))
@@ -344,6 +353,40 @@ func (s *Scheduler) updateRunningJobProgress(ctx context.Context, j *runningJob)
 	return nil
 }
 
+// Drain stops the scheduler from running new jobs and waits for the running
+// jobs to finish. An error is returned if jobs are still running once the ctx
+// is done. Running jobs are only canceled once the ctx passed to Start is
+// canceled, so draining allows jobs to finish before shutting down.
+func (s *Scheduler) Drain(ctx context.Context) error {
+	const op = "scheduler.(Scheduler).Drain"
+	s.draining.Store(true)
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for {
+		running := s.RunningJobs()
+		if len(running) == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx, ctx.Err(), op, errors.WithMsg("jobs still running: %s", strings.Join(running, ", ")))
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunningJobs returns the sorted names of the jobs currently running on the
+// scheduler.
+func (s *Scheduler) RunningJobs() []string {
+	var names []string
+	s.runningJobs.Range(func(k, _ any) bool {
+		names = append(names, k.(string))
+		return true
+	})
+	sort.Strings(names)
+	return names
+}
+
 // GetRunJobsInterval returns the value runJobsInterval,
 // which represents an interval at which the scheduler
 // will query the repository for jobs to run.
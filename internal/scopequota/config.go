@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scopequota
+
+import (
+	"reflect"
+	"time"
+)
+
+// AllOrgs can be used in the Scopes of a Config to apply it to every org
+// scope that is not listed in another Config.
+const AllOrgs = "*"
+
+// Config is used to configure a request quota. Each config specifies the
+// maximum number of requests that can be made against each of the org scopes
+// it lists, and their projects, in a time period.
+type Config struct {
+	Scopes    []string      `hcl:"scopes"`
+	Limit     int           `hcl:"limit"`
+	PeriodHCL string        `hcl:"period"`
+	Period    time.Duration `hcl:"-"`
+}
+
+// Configs is an ordered set of Config. When several configs list the same
+// scope, the last one is used.
+type Configs []*Config
+
+// Equal checks if a set of Configs is equal to another set of Configs.
+func (c Configs) Equal(o Configs) bool {
+	return reflect.DeepEqual(c, o)
+}
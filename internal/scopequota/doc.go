@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package scopequota provides the per org scope api request quotas of the
+// controller. Each org scope gets its own quota, counting the requests made
+// against the org and its projects, so that a single tenant's runaway
+// automation cannot starve the others on a shared controller. Quotas are
+// tracked in memory by each controller.
+package scopequota
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scopequota
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// Usage describes the request quota of an org scope and how much of it is
+// used in the current period.
+type Usage struct {
+	ScopeId   string
+	Limit     uint64
+	Used      uint64
+	Period    time.Duration
+	ResetTime time.Time
+}
+
+// ResetsIn returns the time until the current period ends and the quota is
+// replenished.
+func (u *Usage) ResetsIn() time.Duration {
+	return time.Until(u.ResetTime)
+}
+
+type limit struct {
+	requests uint64
+	period   time.Duration
+}
+
+type window struct {
+	used      uint64
+	resetTime time.Time
+}
+
+// Quotas tracks the request quotas of the org scopes. It is safe for
+// concurrent use.
+type Quotas struct {
+	configs  Configs
+	limits   map[string]limit
+	fallback *limit
+
+	mu      sync.Mutex
+	windows map[string]*window
+
+	// now is overridden in tests
+	now func() time.Time
+}
+
+// New creates the Quotas for the given Configs. It returns nil, and no
+// error, if no quotas are configured.
+func New(ctx context.Context, configs Configs) (*Quotas, error) {
+	const op = "scopequota.New"
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	q := &Quotas{
+		configs: configs,
+		limits:  make(map[string]limit),
+		windows: make(map[string]*window),
+		now:     time.Now,
+	}
+	for i, c := range configs {
+		switch {
+		case c == nil:
+			return nil, errors.New(ctx, errors.InvalidConfiguration, op, "", errors.WithMsg("quota %d is nil", i))
+		case len(c.Scopes) == 0:
+			return nil, errors.New(ctx, errors.InvalidConfiguration, op, "", errors.WithMsg("quota %d has no scopes", i))
+		case c.Limit <= 0:
+			return nil, errors.New(ctx, errors.InvalidConfiguration, op, "", errors.WithMsg("quota %d limit must be greater than 0", i))
+		case c.Period <= 0:
+			return nil, errors.New(ctx, errors.InvalidConfiguration, op, "", errors.WithMsg("quota %d period must be greater than 0", i))
+		}
+		l := limit{requests: uint64(c.Limit), period: c.Period}
+		for _, s := range c.Scopes {
+			switch {
+			case s == AllOrgs:
+				q.fallback = &l
+			case strings.HasPrefix(s, globals.OrgPrefix+"_"):
+				q.limits[s] = l
+			default:
+				return nil, errors.New(ctx, errors.InvalidConfiguration, op, "", errors.WithMsg("quota %d scope %q is not an org scope id", i, s))
+			}
+		}
+	}
+	return q, nil
+}
+
+// Configs returns the Configs the Quotas were created from.
+func (q *Quotas) Configs() Configs {
+	if q == nil {
+		return nil
+	}
+	return q.configs
+}
+
+func (q *Quotas) limitFor(scopeId string) (limit, bool) {
+	if l, ok := q.limits[scopeId]; ok {
+		return l, true
+	}
+	if q.fallback != nil {
+		return *q.fallback, true
+	}
+	return limit{}, false
+}
+
+// currentWindow returns the window of the scope for the current period,
+// starting a new one if the previous period ended. q.mu must be held.
+func (q *Quotas) currentWindow(scopeId string, l limit) *window {
+	now := q.now()
+	w, ok := q.windows[scopeId]
+	if !ok || !now.Before(w.resetTime) {
+		w = &window{resetTime: now.Add(l.period)}
+		q.windows[scopeId] = w
+	}
+	return w
+}
+
+// Allow counts a request made against the org scope and reports whether it
+// is allowed by the scope's quota. It returns the usage of the quota, which
+// is nil if no quota applies to the scope.
+func (q *Quotas) Allow(scopeId string) (*Usage, bool) {
+	if q == nil || scopeId == "" {
+		return nil, true
+	}
+	l, ok := q.limitFor(scopeId)
+	if !ok {
+		return nil, true
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	w := q.currentWindow(scopeId, l)
+	allowed := w.used < l.requests
+	if allowed {
+		w.used++
+	}
+	return &Usage{
+		ScopeId:   scopeId,
+		Limit:     l.requests,
+		Used:      w.used,
+		Period:    l.period,
+		ResetTime: w.resetTime,
+	}, allowed
+}
+
+// Usage returns the usage of the quota of the org scope without counting a
+// request. It returns nil if no quota applies to the scope.
+func (q *Quotas) Usage(scopeId string) *Usage {
+	if q == nil || scopeId == "" {
+		return nil
+	}
+	l, ok := q.limitFor(scopeId)
+	if !ok {
+		return nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	w := q.currentWindow(scopeId, l)
+	return &Usage{
+		ScopeId:   scopeId,
+		Limit:     l.requests,
+		Used:      w.used,
+		Period:    l.period,
+		ResetTime: w.resetTime,
+	}
+}
+
+type ctxKey struct{}
+
+// NewContext returns a context that carries the Quotas, so that they can be
+// enforced and reported on by the service handlers.
+func NewContext(ctx context.Context, q *Quotas) context.Context {
+	return context.WithValue(ctx, ctxKey{}, q)
+}
+
+// FromContext returns the Quotas carried by the context, if any.
+func FromContext(ctx context.Context) (*Quotas, bool) {
+	q, ok := ctx.Value(ctxKey{}).(*Quotas)
+	return q, ok && q != nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scopequota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name        string
+		configs     Configs
+		wantNil     bool
+		wantErrCode errors.Code
+	}{
+		{
+			name:    "no-configs",
+			wantNil: true,
+		},
+		{
+			name: "valid",
+			configs: Configs{
+				{Scopes: []string{AllOrgs}, Limit: 100, Period: time.Minute},
+				{Scopes: []string{"o_1234567890"}, Limit: 10, Period: time.Second},
+			},
+		},
+		{
+			name:        "nil-config",
+			configs:     Configs{nil},
+			wantErrCode: errors.InvalidConfiguration,
+		},
+		{
+			name:        "no-scopes",
+			configs:     Configs{{Limit: 10, Period: time.Second}},
+			wantErrCode: errors.InvalidConfiguration,
+		},
+		{
+			name:        "zero-limit",
+			configs:     Configs{{Scopes: []string{AllOrgs}, Period: time.Second}},
+			wantErrCode: errors.InvalidConfiguration,
+		},
+		{
+			name:        "zero-period",
+			configs:     Configs{{Scopes: []string{AllOrgs}, Limit: 10}},
+			wantErrCode: errors.InvalidConfiguration,
+		},
+		{
+			name:        "global-scope",
+			configs:     Configs{{Scopes: []string{"global"}, Limit: 10, Period: time.Second}},
+			wantErrCode: errors.InvalidConfiguration,
+		},
+		{
+			name:        "project-scope",
+			configs:     Configs{{Scopes: []string{"p_1234567890"}, Limit: 10, Period: time.Second}},
+			wantErrCode: errors.InvalidConfiguration,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			q, err := New(ctx, tc.configs)
+			if tc.wantErrCode != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tc.wantErrCode), err))
+				return
+			}
+			require.NoError(err)
+			if tc.wantNil {
+				assert.Nil(q)
+				return
+			}
+			require.NotNil(q)
+			assert.Equal(tc.configs, q.Configs())
+		})
+	}
+}
+
+func TestQuotas_Allow(t *testing.T) {
+	ctx := context.Background()
+	const (
+		limitedOrg = "o_1234567890"
+		otherOrg   = "o_0987654321"
+	)
+
+	t.Run("per-scope", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		q, err := New(ctx, Configs{{Scopes: []string{limitedOrg}, Limit: 2, Period: time.Minute}})
+		require.NoError(err)
+
+		u, ok := q.Allow(limitedOrg)
+		assert.True(ok)
+		require.NotNil(u)
+		assert.Equal(uint64(2), u.Limit)
+		assert.Equal(uint64(1), u.Used)
+		assert.Equal(time.Minute, u.Period)
+
+		_, ok = q.Allow(limitedOrg)
+		assert.True(ok)
+		u, ok = q.Allow(limitedOrg)
+		assert.False(ok)
+		assert.Equal(uint64(2), u.Used)
+
+		// Other orgs are not limited, so they are not affected.
+		u, ok = q.Allow(otherOrg)
+		assert.True(ok)
+		assert.Nil(u)
+		assert.Nil(q.Usage(otherOrg))
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		q, err := New(ctx, Configs{
+			{Scopes: []string{AllOrgs}, Limit: 1, Period: time.Minute},
+			{Scopes: []string{limitedOrg}, Limit: 3, Period: time.Minute},
+		})
+		require.NoError(err)
+
+		_, ok := q.Allow(otherOrg)
+		assert.True(ok)
+		_, ok = q.Allow(otherOrg)
+		assert.False(ok)
+
+		// A runaway org does not use up the quota of the others.
+		for i := 0; i < 3; i++ {
+			_, ok = q.Allow(limitedOrg)
+			assert.True(ok)
+		}
+		_, ok = q.Allow(limitedOrg)
+		assert.False(ok)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		q, err := New(ctx, Configs{{Scopes: []string{limitedOrg}, Limit: 1, Period: time.Minute}})
+		require.NoError(err)
+		now := time.Now()
+		q.now = func() time.Time { return now }
+
+		_, ok := q.Allow(limitedOrg)
+		assert.True(ok)
+		u, ok := q.Allow(limitedOrg)
+		assert.False(ok)
+		assert.Equal(now.Add(time.Minute), u.ResetTime)
+
+		now = now.Add(time.Minute)
+		u = q.Usage(limitedOrg)
+		require.NotNil(u)
+		assert.Equal(uint64(0), u.Used)
+		_, ok = q.Allow(limitedOrg)
+		assert.True(ok)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var q *Quotas
+		u, ok := q.Allow(limitedOrg)
+		assert.True(t, ok)
+		assert.Nil(t, u)
+		assert.Nil(t, q.Usage(limitedOrg))
+	})
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	_, ok := FromContext(ctx)
+	assert.False(t, ok)
+
+	_, ok = FromContext(NewContext(ctx, nil))
+	assert.False(t, ok)
+
+	q, err := New(ctx, Configs{{Scopes: []string{AllOrgs}, Limit: 1, Period: time.Minute}})
+	require.NoError(t, err)
+	got, ok := FromContext(NewContext(ctx, q))
+	assert.True(t, ok)
+	assert.Same(t, q, got)
+}
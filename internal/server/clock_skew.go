@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxClockSkew is the largest difference between the clocks of a worker and a
+// controller that is tolerated before it is reported. Session certificates and
+// tokens are validated against the local clock, so skew beyond this value
+// risks spurious validation failures.
+var MaxClockSkew = 30 * time.Second
+
+// ClockSkew returns how far the local clock is ahead of the remote clock; a
+// negative value means the local clock is behind. The remote time is assumed
+// to have been read halfway through the round trip that started at sent and
+// completed at received, which removes network latency from the estimate.
+func ClockSkew(sent, received, remote time.Time) time.Duration {
+	local := sent.Add(received.Sub(sent) / 2)
+	return local.Sub(remote)
+}
+
+// ClockSkewExceeded reports whether the given skew, in either direction, is
+// larger than MaxClockSkew.
+func ClockSkewExceeded(skew time.Duration) bool {
+	if skew < 0 {
+		skew = -skew
+	}
+	return skew > MaxClockSkew
+}
+
+// ClockSkewError describes a clock difference between a worker and a
+// controller that exceeds MaxClockSkew.
+type ClockSkewError struct {
+	// Skew is how far the worker's clock is ahead of the controller's; a
+	// negative value means it is behind.
+	Skew time.Duration
+}
+
+func (e *ClockSkewError) Error() string {
+	direction := "ahead of"
+	skew := e.Skew
+	if skew < 0 {
+		direction = "behind"
+		skew = -skew
+	}
+	return fmt.Sprintf("worker clock is %s %s the controller clock, exceeding the allowed drift of %s; check NTP synchronization",
+		skew.Round(time.Millisecond), direction, MaxClockSkew)
+}
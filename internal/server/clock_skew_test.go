@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClockSkew(t *testing.T) {
+	sent := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	received := sent.Add(2 * time.Second)
+	tests := []struct {
+		name         string
+		remote       time.Time
+		wantSkew     time.Duration
+		wantExceeded bool
+	}{
+		{name: "in-sync", remote: sent.Add(time.Second), wantSkew: 0},
+		{name: "ahead", remote: sent.Add(-time.Minute), wantSkew: time.Minute + time.Second, wantExceeded: true},
+		{name: "behind", remote: sent.Add(time.Minute), wantSkew: -time.Minute + time.Second, wantExceeded: true},
+		{name: "within-drift", remote: sent.Add(-20 * time.Second), wantSkew: 21 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			skew := ClockSkew(sent, received, tt.remote)
+			assert.Equal(t, tt.wantSkew, skew)
+			assert.Equal(t, tt.wantExceeded, ClockSkewExceeded(skew))
+		})
+	}
+}
+
+func TestClockSkewError(t *testing.T) {
+	assert.Equal(t,
+		"worker clock is 2m0s ahead of the controller clock, exceeding the allowed drift of 30s; check NTP synchronization",
+		(&ClockSkewError{Skew: 2 * time.Minute}).Error())
+	assert.Equal(t,
+		"worker clock is 1.5s behind the controller clock, exceeding the allowed drift of 30s; check NTP synchronization",
+		(&ClockSkewError{Skew: -1500 * time.Millisecond}).Error())
+}
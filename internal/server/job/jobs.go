@@ -15,7 +15,9 @@ import (
 	"github.com/hashicorp/boundary/internal/scheduler"
 )
 
-// RegisterJobs registers the rotate roots job with the provided scheduler.
+// RegisterJobs registers the rotate roots job with the provided scheduler. If a
+// stale worker retire after period is provided, the retire stale workers job
+// is registered as well.
 func RegisterJobs(
 	ctx context.Context,
 	scheduler *scheduler.Scheduler,
@@ -52,6 +54,16 @@ func RegisterJobs(
 		return errors.Wrap(ctx, err, op)
 	}
 
+	if opts := getOpts(opt...); opts.withStaleWorkerRetireAfter > 0 {
+		retireStaleWorkersJob, err := newRetireStaleWorkersJob(ctx, r, w, kms, opt...)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err = scheduler.RegisterJob(ctx, retireStaleWorkersJob); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+
 	usbJob, err := NewUpsertWorkerStorageBucketJobFn(ctx, r, w, kms, controllerExt, workerStatusGracePeriod, scheduler)
 	if err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithMsg("error creating upsert worker storage bucket job"))
@@ -7,7 +7,10 @@ import (
 	"time"
 )
 
-const defaultRotationFrequency = time.Hour
+const (
+	defaultRotationFrequency     = time.Hour
+	defaultStaleWorkersFrequency = time.Hour
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
@@ -23,8 +26,10 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withRotationFrequency   time.Duration
-	withCertificateLifetime time.Duration
+	withRotationFrequency        time.Duration
+	withCertificateLifetime      time.Duration
+	withStaleWorkerRetireAfter   time.Duration
+	withStaleWorkerRevivalWindow time.Duration
 }
 
 func getDefaultOptions() options {
@@ -49,3 +54,20 @@ func WithCertificateLifetime(with time.Duration) Option {
 		o.withCertificateLifetime = with
 	}
 }
+
+// WithStaleWorkerRetireAfter provides the period of time after which a worker
+// that has not reported its status is retired. If it is zero, stale workers
+// are not retired.
+func WithStaleWorkerRetireAfter(with time.Duration) Option {
+	return func(o *options) {
+		o.withStaleWorkerRetireAfter = with
+	}
+}
+
+// WithStaleWorkerRevivalWindow provides the period of time a retired worker
+// can report its status again before it is deleted.
+func WithStaleWorkerRevivalWindow(with time.Duration) Option {
+	return func(o *options) {
+		o.withStaleWorkerRevivalWindow = with
+	}
+}
@@ -24,4 +24,16 @@ func Test_GetOpts(t *testing.T) {
 		opts := getOpts(WithCertificateLifetime(time.Minute))
 		assert.Equal(t, time.Minute, opts.withCertificateLifetime)
 	})
+	t.Run("WithStaleWorkerRetireAfter", func(t *testing.T) {
+		testOpts := getDefaultOptions()
+		assert.Equal(t, testOpts.withStaleWorkerRetireAfter, time.Duration(0))
+		opts := getOpts(WithStaleWorkerRetireAfter(time.Hour))
+		assert.Equal(t, time.Hour, opts.withStaleWorkerRetireAfter)
+	})
+	t.Run("WithStaleWorkerRevivalWindow", func(t *testing.T) {
+		testOpts := getDefaultOptions()
+		assert.Equal(t, testOpts.withStaleWorkerRevivalWindow, time.Duration(0))
+		opts := getOpts(WithStaleWorkerRevivalWindow(time.Minute))
+		assert.Equal(t, time.Minute, opts.withStaleWorkerRevivalWindow)
+	})
 }
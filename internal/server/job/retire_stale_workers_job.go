@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package servers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/server"
+)
+
+// retireStaleWorkersJob defines a periodic job that retires workers that have
+// not reported their status for a long time, revives retired workers that
+// have reported their status again, and deletes retired workers whose revival
+// window has passed. Every retirement, revival and deletion is recorded as a
+// system event.
+type retireStaleWorkersJob struct {
+	serversRepo *server.Repository
+
+	retireAfter   time.Duration
+	revivalWindow time.Duration
+
+	totalRuns int
+}
+
+// newRetireStaleWorkersJob instantiates the retire stale workers job.
+func newRetireStaleWorkersJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*retireStaleWorkersJob, error) {
+	const op = "server.newRetireStaleWorkersJob"
+	switch {
+	case isNil(r):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case isNil(w):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	opts := getOpts(opt...)
+	switch {
+	case opts.withStaleWorkerRetireAfter <= 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "retire after must be greater than 0")
+	case opts.withStaleWorkerRevivalWindow < 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "revival window is negative")
+	}
+
+	serversRepo, err := server.NewRepository(ctx, r, w, kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	return &retireStaleWorkersJob{
+		serversRepo:   serversRepo,
+		retireAfter:   opts.withStaleWorkerRetireAfter,
+		revivalWindow: opts.withStaleWorkerRevivalWindow,
+	}, nil
+}
+
+// Name returns a short, unique name for the job.
+func (j *retireStaleWorkersJob) Name() string { return "retire_stale_workers" }
+
+// Description returns the description for the job.
+func (j *retireStaleWorkersJob) Description() string {
+	return "Retire workers that have not reported their status for a long time and delete them once their revival window has passed"
+}
+
+// NextRunIn returns the next run time after a job is completed. The job runs
+// every hour, or more often if workers are retired sooner than that.
+func (j *retireStaleWorkersJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return min(defaultStaleWorkersFrequency, j.retireAfter), nil
+}
+
+// Status returns the status of the running job.
+func (j *retireStaleWorkersJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.totalRuns,
+		Total:     j.totalRuns,
+	}
+}
+
+// Run revives the retired workers that have reported their status again,
+// retires the stale workers, and deletes the retired workers whose revival
+// window has passed, in that order.
+func (j *retireStaleWorkersJob) Run(ctx context.Context, _ time.Duration) error {
+	const op = "server.(retireStaleWorkersJob).Run"
+
+	revived, err := j.serversRepo.ReviveRetiredWorkers(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, id := range revived {
+		event.WriteSysEvent(ctx, op, "revived retired worker", "worker_id", id)
+	}
+
+	retired, err := j.serversRepo.RetireStaleWorkers(ctx, j.retireAfter)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, id := range retired {
+		event.WriteSysEvent(ctx, op, "retired stale worker", "worker_id", id, "retire_after", j.retireAfter.String())
+	}
+
+	deleted, err := j.serversRepo.DeleteRetiredWorkers(ctx, j.revivalWindow)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, id := range deleted {
+		event.WriteSysEvent(ctx, op, "deleted retired worker", "worker_id", id, "revival_window", j.revivalWindow.String())
+	}
+
+	j.totalRuns += 1
+
+	return nil
+}
@@ -50,6 +50,11 @@ type options struct {
 	withReleaseVersion                     string
 	withOperationalState                   string
 	withLocalStorageState                  string
+	withLocality                           string
+	withAdditionalAddresses                []string
+	withActivationTokenLifetime            time.Duration
+	withActivationTokenAllowedCidrs        []string
+	withRemoteAddress                      string
 	withActiveWorkers                      bool
 	withFeature                            version.Feature
 	withDirectlyConnected                  bool
@@ -276,3 +281,44 @@ func WithLocalStorageState(state string) Option {
 		o.withLocalStorageState = state
 	}
 }
+
+// WithLocality provides an optional locality, such as a region.
+func WithLocality(locality string) Option {
+	return func(o *options) {
+		o.withLocality = locality
+	}
+}
+
+// WithAdditionalAddresses provides optional addresses, besides the address, at
+// which clients can reach a worker in the order they should be tried.
+func WithAdditionalAddresses(addresses []string) Option {
+	return func(o *options) {
+		o.withAdditionalAddresses = addresses
+	}
+}
+
+// WithActivationTokenLifetime provides an optional lifetime of a controller-led
+// activation token, after which it can no longer be used to activate the
+// worker.
+func WithActivationTokenLifetime(lifetime time.Duration) Option {
+	return func(o *options) {
+		o.withActivationTokenLifetime = lifetime
+	}
+}
+
+// WithActivationTokenAllowedCidrs provides optional network ranges, in CIDR
+// notation, that a worker created with a controller-led activation token must
+// report its status from.
+func WithActivationTokenAllowedCidrs(cidrs []string) Option {
+	return func(o *options) {
+		o.withActivationTokenAllowedCidrs = cidrs
+	}
+}
+
+// WithRemoteAddress provides an optional address a worker status was received
+// from.
+func WithRemoteAddress(address string) Option {
+	return func(o *options) {
+		o.withRemoteAddress = address
+	}
+}
@@ -252,4 +252,44 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withNewIdFunc = nil
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithLocality", func(t *testing.T) {
+		opts := GetOpts(WithLocality("us-east-1"))
+		testOpts := getDefaultOptions()
+		testOpts.withLocality = "us-east-1"
+		opts.withNewIdFunc = nil
+		testOpts.withNewIdFunc = nil
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithAdditionalAddresses", func(t *testing.T) {
+		opts := GetOpts(WithAdditionalAddresses([]string{"10.0.0.1:9202", "worker.mesh:9202"}))
+		testOpts := getDefaultOptions()
+		testOpts.withAdditionalAddresses = []string{"10.0.0.1:9202", "worker.mesh:9202"}
+		opts.withNewIdFunc = nil
+		testOpts.withNewIdFunc = nil
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithActivationTokenLifetime", func(t *testing.T) {
+		opts := GetOpts(WithActivationTokenLifetime(time.Hour))
+		testOpts := getDefaultOptions()
+		testOpts.withActivationTokenLifetime = time.Hour
+		opts.withNewIdFunc = nil
+		testOpts.withNewIdFunc = nil
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithActivationTokenAllowedCidrs", func(t *testing.T) {
+		opts := GetOpts(WithActivationTokenAllowedCidrs([]string{"10.0.0.0/24", "2001:db8::/32"}))
+		testOpts := getDefaultOptions()
+		testOpts.withActivationTokenAllowedCidrs = []string{"10.0.0.0/24", "2001:db8::/32"}
+		opts.withNewIdFunc = nil
+		testOpts.withNewIdFunc = nil
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRemoteAddress", func(t *testing.T) {
+		opts := GetOpts(WithRemoteAddress("10.0.0.1"))
+		testOpts := getDefaultOptions()
+		testOpts.withRemoteAddress = "10.0.0.1"
+		opts.withNewIdFunc = nil
+		testOpts.withNewIdFunc = nil
+		assert.Equal(t, opts, testOpts)
+	})
 }
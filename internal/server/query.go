@@ -16,6 +16,108 @@ const (
 
 	deleteWhereCreateTimeSql = `create_time < ?`
 
+	deleteAdditionalAddressesByWorkerIdSql = `
+	delete
+	from server_worker_additional_address
+	where
+		worker_id = ?`
+
+	listWorkerActivationTokensQuery = `
+		select
+			token.worker_id,
+			worker.name,
+			worker.description,
+			token.create_time,
+			token.expiration_time,
+			string_agg(cidr.cidr::text, ',' order by cidr.cidr) as allowed_cidrs
+		from worker_auth_server_led_activation_token token
+			inner join server_worker worker
+				on worker.public_id = token.worker_id
+			left join server_worker_activation_cidr cidr
+				on cidr.worker_id = token.worker_id
+		where worker.scope_id in (?)
+		group by token.worker_id, worker.name, worker.description, token.create_time, token.expiration_time
+		order by token.create_time, token.worker_id
+	`
+
+	upsertWorkerUtilizationQuery = `
+		insert into server_worker_utilization
+			(worker_id, active_session_count, active_connection_count,
+			 bytes_up_per_second, bytes_down_per_second,
+			 proxy_cpu_utilization, spool_backlog_bytes)
+		values
+			(@worker_id, @active_session_count, @active_connection_count,
+			 @bytes_up_per_second, @bytes_down_per_second,
+			 @proxy_cpu_utilization, @spool_backlog_bytes)
+		on conflict (worker_id) do update set
+			active_session_count    = excluded.active_session_count,
+			active_connection_count = excluded.active_connection_count,
+			bytes_up_per_second     = excluded.bytes_up_per_second,
+			bytes_down_per_second   = excluded.bytes_down_per_second,
+			proxy_cpu_utilization   = excluded.proxy_cpu_utilization,
+			spool_backlog_bytes     = excluded.spool_backlog_bytes
+	`
+
+	listWorkerUtilizationQuery = `
+		select
+			worker_id,
+			active_session_count,
+			active_connection_count,
+			bytes_up_per_second,
+			bytes_down_per_second,
+			proxy_cpu_utilization,
+			spool_backlog_bytes,
+			update_time
+		from server_worker_utilization
+		where worker_id in (?)
+		order by worker_id
+	`
+
+	deleteWorkerWithActivationTokenQuery = `
+		delete from server_worker
+		where public_id = @worker_id
+			and public_id in (select worker_id
+							    from worker_auth_server_led_activation_token)
+	`
+
+	workerActivationCidrAllowsAddressQuery = `
+		select not exists (select 1
+							 from server_worker_activation_cidr
+							where worker_id = @worker_id)
+			or exists (select 1
+						 from server_worker_activation_cidr
+						where worker_id = @worker_id
+						  and nullif(@address, '')::inet <<= cidr)
+			as allowed
+	`
+
+	reviveRetiredWorkersQuery = `
+		delete from server_worker_retirement retirement
+		using server_worker worker
+		where worker.public_id = retirement.worker_id
+			and worker.last_status_time > retirement.retire_time
+		returning retirement.worker_id
+	`
+
+	retireStaleWorkersQuery = `
+		insert into server_worker_retirement (worker_id)
+		select public_id
+		from server_worker
+		where last_status_time < wt_sub_seconds_from_now(@retire_after_seconds)
+			and public_id not in (select worker_id
+									from server_worker_retirement)
+		returning worker_id
+	`
+
+	deleteRetiredWorkersQuery = `
+		delete from server_worker worker
+		using server_worker_retirement retirement
+		where worker.public_id = retirement.worker_id
+			and retirement.retire_time < wt_sub_seconds_from_now(@revival_window_seconds)
+			and worker.last_status_time <= retirement.retire_time
+		returning worker.public_id
+	`
+
 	deleteTagsByWorkerIdSql = `
 	delete 
 	from server_worker_tag 
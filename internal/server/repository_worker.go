@@ -7,9 +7,12 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
+	"net"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/server/store"
@@ -299,8 +302,11 @@ func ListWorkers(ctx context.Context, reader db.Reader, scopeIds []string, opt .
 // If the worker is a kms worker that hasn't been seen yet, it'll attempt to
 // create a new one, but will return an error if another worker (kms or other)
 // has the same name.  This returns the Worker object with the changes applied.
-// The WithPublicId, WithKeyId, and WithUpdateTags options are
-// the only ones used. All others are ignored.
+// The WithPublicId, WithKeyId, WithUpdateTags, and WithRemoteAddress options
+// are the only ones used. All others are ignored. A worker created with a
+// controller-led activation token restricted to network ranges must report its
+// status from an address in one of them, which is provided with
+// WithRemoteAddress; otherwise a Forbidden error is returned.
 // Workers are intentionally not oplogged.
 func (r *Repository) UpsertWorkerStatus(ctx context.Context, worker *Worker, opt ...Option) (*Worker, error) {
 	const op = "server.(Repository).UpsertWorkerStatus"
@@ -365,7 +371,22 @@ func (r *Repository) UpsertWorkerStatus(ctx context.Context, worker *Worker, opt
 				// KMS-PKI) PKI-based workers to come via API only. We can't
 				// really guard on this in the DB so we need to be sure to not
 				// include it here.
-				n, err := w.Update(ctx, workerClone, []string{"address", "ReleaseVersion", "OperationalState", "LocalStorageState"}, nil)
+				allowed, err := workerActivationCidrsAllowAddress(ctx, reader, workerClone.GetPublicId(), opts.withRemoteAddress)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to check worker activation cidrs"))
+				}
+				if !allowed {
+					return errors.New(ctx, errors.Forbidden, op, fmt.Sprintf("worker %q is not allowed to report its status from address %q", workerClone.GetPublicId(), opts.withRemoteAddress))
+				}
+
+				fieldMask := []string{"address", "ReleaseVersion", "OperationalState", "LocalStorageState"}
+				var setToNull []string
+				if workerClone.GetLocality() != "" {
+					fieldMask = append(fieldMask, "Locality")
+				} else {
+					setToNull = append(setToNull, "Locality")
+				}
+				n, err := w.Update(ctx, workerClone, fieldMask, setToNull)
 				if err != nil {
 					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update status of pki worker"))
 				}
@@ -382,7 +403,7 @@ func (r *Repository) UpsertWorkerStatus(ctx context.Context, worker *Worker, opt
 				workerClone.Type = KmsWorkerType.String()
 				workerCreateConflict := &db.OnConflict{
 					Target: db.Columns{"public_id"},
-					Action: append(db.SetColumns([]string{"address", "release_version", "operational_state", "local_storage_state"}),
+					Action: append(db.SetColumns([]string{"address", "release_version", "operational_state", "local_storage_state", "locality"}),
 						db.SetColumnValues(map[string]any{"last_status_time": "now()"})...),
 				}
 				var withRowsAffected int64
@@ -421,6 +442,15 @@ func (r *Repository) UpsertWorkerStatus(ctx context.Context, worker *Worker, opt
 				return errors.Wrap(ctx, err, op, errors.WithMsg("error converting worker aggregate to worker"))
 			}
 
+			// Workers report their additional addresses with every status, so
+			// only write them when they have changed.
+			if !slices.Equal(ret.additionalAddresses, workerClone.additionalAddresses) {
+				if err := setWorkerAdditionalAddresses(ctx, w, workerClone.GetPublicId(), workerClone.additionalAddresses); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("error setting worker additional addresses"))
+				}
+				ret.additionalAddresses = workerClone.additionalAddresses
+			}
+
 			return nil
 		},
 	)
@@ -509,6 +539,40 @@ func setWorkerTags(ctx context.Context, w db.Writer, id string, ts TagSource, ta
 	return nil
 }
 
+// setWorkerAdditionalAddresses removes all existing additional addresses of
+// the worker and creates new ones based on the ones provided, in order.  This
+// function should be called from inside a db transaction.
+// Worker additional addresses are intentionally not oplogged.
+func setWorkerAdditionalAddresses(ctx context.Context, w db.Writer, id string, addresses []string) error {
+	const op = "server.setWorkerAdditionalAddresses"
+	switch {
+	case id == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "worker id is empty")
+	case isNil(w):
+		return errors.New(ctx, errors.InvalidParameter, op, "db.Writer is nil")
+	}
+	_, err := w.Exec(ctx, deleteAdditionalAddressesByWorkerIdSql, []any{id})
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("couldn't delete existing additional addresses for worker %q", id)))
+	}
+
+	if len(addresses) > 0 {
+		uAddrs := make([]*workerAdditionalAddress, 0, len(addresses))
+		for i, a := range addresses {
+			uAddrs = append(uAddrs, &workerAdditionalAddress{
+				WorkerId: id,
+				Priority: uint32(i + 1),
+				Address:  a,
+			})
+		}
+		if err = w.CreateItems(ctx, uAddrs); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error creating additional addresses for worker %q", id)))
+		}
+	}
+
+	return nil
+}
+
 // UpdateWorker will update a worker in the repository and return the resulting
 // worker. fieldMaskPaths provides field_mask.proto paths for fields that should
 // be updated. Fields will be set to NULL if the field is a zero value and
@@ -629,7 +693,10 @@ func (r *Repository) UpdateWorker(ctx context.Context, worker *Worker, version u
 // withLocalStorageState (this option is likely only useful for tests),
 // WithFetchNodeCredentialsRequest,
 // WithCreateControllerLedActivationToken. The latter two are mutually
-// exclusive.
+// exclusive. WithActivationTokenLifetime and WithActivationTokenAllowedCidrs
+// are only supported with WithCreateControllerLedActivationToken; a worker
+// created with a controller-led activation token also gets the worker's tags as
+// api tags.
 func (r *Repository) CreateWorker(ctx context.Context, worker *Worker, opt ...Option) (*Worker, error) {
 	const op = "server.(Repository).CreateWorker"
 
@@ -650,6 +717,21 @@ func (r *Repository) CreateWorker(ctx context.Context, worker *Worker, opt ...Op
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "last status time is not nil")
 	case opts.WithFetchNodeCredentialsRequest != nil && opts.WithCreateControllerLedActivationToken:
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "fetch node credentials request and controller led activation token option cannot both be set")
+	case !opts.WithCreateControllerLedActivationToken && (opts.withActivationTokenLifetime != 0 || len(opts.withActivationTokenAllowedCidrs) > 0):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "activation token lifetime and allowed cidrs require the controller led activation token option")
+	case opts.withActivationTokenLifetime < 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "activation token lifetime is negative")
+	case opts.withActivationTokenLifetime > nodeenrollment.DefaultMaximumServerLedActivationTokenLifetime:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("activation token lifetime is greater than %s", nodeenrollment.DefaultMaximumServerLedActivationTokenLifetime))
+	}
+
+	allowedCidrs := make([]string, 0, len(opts.withActivationTokenAllowedCidrs))
+	for _, c := range opts.withActivationTokenAllowedCidrs {
+		_, ipNet, err := net.ParseCIDR(c)
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid allowed cidr %q", c))
+		}
+		allowedCidrs = append(allowedCidrs, ipNet.String())
 	}
 
 	worker.OperationalState = UnknownOperationalState.String()
@@ -727,6 +809,9 @@ func (r *Repository) CreateWorker(ctx context.Context, worker *Worker, opt ...Op
 				if err != nil {
 					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in-memory activation token"))
 				}
+				if opts.withActivationTokenLifetime > 0 {
+					activationTokenEntry.ExpirationTime = timestamp.New(creationTime.AsTime().Add(opts.withActivationTokenLifetime))
+				}
 				if err := activationTokenEntry.encrypt(ctx, databaseWrapper); err != nil {
 					return errors.Wrap(ctx, err, op)
 				}
@@ -736,7 +821,23 @@ func (r *Repository) CreateWorker(ctx context.Context, worker *Worker, opt ...Op
 				); err != nil {
 					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create worker activation token in storage"))
 				}
+				if len(allowedCidrs) > 0 {
+					cidrs := make([]*workerActivationCidr, 0, len(allowedCidrs))
+					for _, c := range allowedCidrs {
+						cidrs = append(cidrs, &workerActivationCidr{WorkerId: worker.PublicId, Cidr: c})
+					}
+					if err := w.CreateItems(ctx, cidrs); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create worker activation cidrs"))
+					}
+				}
+				if len(worker.inputTags) > 0 {
+					if err := setWorkerTags(ctx, w, worker.PublicId, ApiTagSource, worker.inputTags); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to set worker tags"))
+					}
+					returnedWorker.apiTags = returnedWorker.inputTags
+				}
 				returnedWorker.ControllerGeneratedActivationToken = activationToken
+				returnedWorker.ControllerGeneratedActivationTokenExpirationTime = activationTokenEntry.ExpirationTime
 			}
 
 			return nil
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// WorkerActivationToken describes an outstanding controller-led activation
+// token, which is a token that has not yet been used to activate the worker it
+// was created for. The token itself is only available when it is created.
+type WorkerActivationToken struct {
+	WorkerId       string
+	Name           string
+	Description    string
+	CreateTime     *timestamp.Timestamp
+	ExpirationTime *timestamp.Timestamp
+	AllowedCidrs   []string
+}
+
+// workerActivationCidr is a network range a worker created with a
+// controller-led activation token must report its status from.
+type workerActivationCidr struct {
+	WorkerId string `gorm:"primary_key"`
+	Cidr     string `gorm:"primary_key"`
+}
+
+// TableName overrides the table name used by workerActivationCidr to
+// `server_worker_activation_cidr`
+func (workerActivationCidr) TableName() string {
+	return "server_worker_activation_cidr"
+}
+
+// ListWorkerActivationTokens returns the outstanding controller-led activation
+// tokens of the workers in the provided scopes, including the ones that have
+// expired, ordered by their create time.
+func (r *Repository) ListWorkerActivationTokens(ctx context.Context, scopeIds []string, _ ...Option) ([]*WorkerActivationToken, error) {
+	const op = "server.(Repository).ListWorkerActivationTokens"
+	if len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scope ids set")
+	}
+
+	rows, err := r.reader.Query(ctx, listWorkerActivationTokensQuery, []any{scopeIds})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	type rowsResult struct {
+		WorkerId       string
+		Name           string
+		Description    string
+		CreateTime     *timestamp.Timestamp
+		ExpirationTime *timestamp.Timestamp
+		AllowedCidrs   string
+	}
+	var ret []*WorkerActivationToken
+	for rows.Next() {
+		var result rowsResult
+		if err := r.reader.ScanRows(ctx, rows, &result); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		t := &WorkerActivationToken{
+			WorkerId:       result.WorkerId,
+			Name:           result.Name,
+			Description:    result.Description,
+			CreateTime:     result.CreateTime,
+			ExpirationTime: result.ExpirationTime,
+		}
+		if result.AllowedCidrs != "" {
+			t.AllowedCidrs = strings.Split(result.AllowedCidrs, ",")
+		}
+		ret = append(ret, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	return ret, nil
+}
+
+// RevokeWorkerActivationToken revokes the outstanding controller-led
+// activation token of the worker with the provided id. Since the worker has
+// not been activated, the worker is deleted along with its token. A
+// RecordNotFound error is returned if the worker has no outstanding token.
+func (r *Repository) RevokeWorkerActivationToken(ctx context.Context, workerId string, _ ...Option) error {
+	const op = "server.(Repository).RevokeWorkerActivationToken"
+	if workerId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	}
+
+	var rowsDeleted int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Exec(ctx, deleteWorkerWithActivationTokenQuery, []any{sql.Named("worker_id", workerId)})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				// return err, which will result in a rollback of the delete
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", workerId)))
+	}
+	if rowsDeleted == 0 {
+		return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("no outstanding activation token for worker %q", workerId))
+	}
+	return nil
+}
+
+// workerActivationCidrsAllowAddress returns whether the worker with the
+// provided id may report its status from the provided address, which is the
+// case if the worker has no activation cidrs or the address is in one of them.
+func workerActivationCidrsAllowAddress(ctx context.Context, reader db.Reader, workerId, address string) (bool, error) {
+	const op = "server.workerActivationCidrsAllowAddress"
+	rows, err := reader.Query(ctx, workerActivationCidrAllowsAddressQuery, []any{
+		sql.Named("worker_id", workerId),
+		sql.Named("address", address),
+	})
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var result struct {
+		Allowed bool
+	}
+	for rows.Next() {
+		if err := reader.ScanRows(ctx, rows, &result); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return result.Allowed, nil
+}
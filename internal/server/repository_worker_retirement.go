@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// ReviveRetiredWorkers revives the retired workers that have reported their
+// status since they were retired and returns their ids.
+func (r *Repository) ReviveRetiredWorkers(ctx context.Context, _ ...Option) ([]string, error) {
+	const op = "server.(Repository).ReviveRetiredWorkers"
+	ids, err := r.queryWorkerIds(ctx, reviveRetiredWorkersQuery, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
+
+// RetireStaleWorkers retires the workers that have not reported their status
+// for longer than retireAfter and returns their ids. Workers that have never
+// reported their status are not retired.
+func (r *Repository) RetireStaleWorkers(ctx context.Context, retireAfter time.Duration, _ ...Option) ([]string, error) {
+	const op = "server.(Repository).RetireStaleWorkers"
+	if retireAfter <= 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "retire after must be greater than 0")
+	}
+	ids, err := r.queryWorkerIds(ctx, retireStaleWorkersQuery, []any{
+		sql.Named("retire_after_seconds", int(retireAfter.Seconds())),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
+
+// DeleteRetiredWorkers deletes the workers that were retired longer than
+// revivalWindow ago and have not reported their status since, and returns
+// their ids.
+func (r *Repository) DeleteRetiredWorkers(ctx context.Context, revivalWindow time.Duration, _ ...Option) ([]string, error) {
+	const op = "server.(Repository).DeleteRetiredWorkers"
+	if revivalWindow < 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "revival window is negative")
+	}
+	ids, err := r.queryWorkerIds(ctx, deleteRetiredWorkersQuery, []any{
+		sql.Named("revival_window_seconds", int(revivalWindow.Seconds())),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
+
+// queryWorkerIds runs the provided query, which must return a single column
+// of worker ids, in a transaction and returns the ids.
+func (r *Repository) queryWorkerIds(ctx context.Context, query string, args []any) ([]string, error) {
+	const op = "server.(Repository).queryWorkerIds"
+	var ids []string
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			ids = nil
+			rows, err := w.Query(ctx, query, args)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var id string
+				if err := rows.Scan(&id); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				ids = append(ids, id)
+			}
+			if err := rows.Err(); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_WorkerRetirement(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := server.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	setLastStatusTime := func(t *testing.T, workerId string, ago time.Duration) {
+		t.Helper()
+		_, err := rw.Exec(ctx, "update server_worker set last_status_time = wt_sub_seconds_from_now(?) where public_id = ?",
+			[]any{int(ago.Seconds()), workerId})
+		require.NoError(t, err)
+	}
+	setRetireTime := func(t *testing.T, workerId string, ago time.Duration) {
+		t.Helper()
+		// retire_time is immutable, so recreate the row to move it back in time.
+		_, err := rw.Exec(ctx, "delete from server_worker_retirement where worker_id = ?", []any{workerId})
+		require.NoError(t, err)
+		_, err = rw.Exec(ctx, "insert into server_worker_retirement (worker_id, retire_time) values (?, wt_sub_seconds_from_now(?))",
+			[]any{workerId, int(ago.Seconds())})
+		require.NoError(t, err)
+	}
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		_, err := repo.RetireStaleWorkers(ctx, 0)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.DeleteRetiredWorkers(ctx, -time.Second)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+
+	fresh := server.TestKmsWorker(t, conn, wrapper)
+	stale := server.TestKmsWorker(t, conn, wrapper)
+	setLastStatusTime(t, stale.GetPublicId(), 3*time.Hour)
+
+	t.Run("retire", func(t *testing.T) {
+		retired, err := repo.RetireStaleWorkers(ctx, 2*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, []string{stale.GetPublicId()}, retired)
+
+		// Retiring again does not retire the worker twice.
+		retired, err = repo.RetireStaleWorkers(ctx, 2*time.Hour)
+		require.NoError(t, err)
+		assert.Empty(t, retired)
+	})
+
+	t.Run("revive", func(t *testing.T) {
+		revived, err := repo.ReviveRetiredWorkers(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, revived)
+
+		setLastStatusTime(t, stale.GetPublicId(), 0)
+		revived, err = repo.ReviveRetiredWorkers(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{stale.GetPublicId()}, revived)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		setLastStatusTime(t, stale.GetPublicId(), 3*time.Hour)
+		retired, err := repo.RetireStaleWorkers(ctx, 2*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, []string{stale.GetPublicId()}, retired)
+
+		// The worker is still within its revival window.
+		deleted, err := repo.DeleteRetiredWorkers(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Empty(t, deleted)
+
+		setRetireTime(t, stale.GetPublicId(), 2*time.Hour)
+		deleted, err = repo.DeleteRetiredWorkers(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, []string{stale.GetPublicId()}, deleted)
+
+		w, err := repo.LookupWorker(ctx, stale.GetPublicId())
+		require.NoError(t, err)
+		assert.Nil(t, w)
+		w, err = repo.LookupWorker(ctx, fresh.GetPublicId())
+		require.NoError(t, err)
+		assert.NotNil(t, w)
+	})
+}
@@ -322,6 +322,20 @@ func TestUpsertWorkerStatus(t *testing.T) {
 		assert.Equal(t, uint32(1), worker.Version)
 		assert.Equal(t, server.AvailableLocalStorageState.String(), worker.GetLocalStorageState())
 
+		// update again with a locality
+		wStatus5 := server.NewWorker(scope.Global.String(),
+			server.WithAddress("new_address"), server.WithName("config_name1"),
+			server.WithOperationalState("shutdown"), server.WithReleaseVersion("Boundary v0.11.0"),
+			server.WithLocalStorageState("available"), server.WithLocality("us-east-1"))
+		worker, err = repo.UpsertWorkerStatus(ctx, wStatus5)
+		require.NoError(t, err)
+		assert.Equal(t, "us-east-1", worker.GetLocality())
+
+		// the locality is removed when the worker no longer reports it
+		worker, err = repo.UpsertWorkerStatus(ctx, wStatus4)
+		require.NoError(t, err)
+		assert.Empty(t, worker.GetLocality())
+
 		// Should no longer see this worker in listing if we exclude shutdown workers
 		workers, err = repo.ListWorkers(ctx, []string{scope.Global.String()}, server.WithActiveWorkers(true))
 		require.NoError(t, err)
@@ -349,6 +363,38 @@ func TestUpsertWorkerStatus(t *testing.T) {
 		assert.Equal(t, "pki_address", worker.GetAddress())
 		assert.Equal(t, "test-version", worker.ReleaseVersion)
 		assert.Equal(t, server.PkiWorkerType.String(), worker.Type)
+		assert.Empty(t, worker.GetLocality())
+
+		wStatus2 := server.NewWorker(scope.Global.String(),
+			server.WithAddress("pki_address"), server.WithReleaseVersion("test-version"),
+			server.WithLocality("eu-west-1"))
+		worker, err = repo.UpsertWorkerStatus(ctx, wStatus2, server.WithKeyId(pkiWorkerKeyId))
+		require.NoError(t, err)
+		assert.Equal(t, "eu-west-1", worker.GetLocality())
+		assert.Empty(t, worker.GetAdditionalAddresses())
+
+		wStatus3 := server.NewWorker(scope.Global.String(),
+			server.WithAddress("pki_address"), server.WithReleaseVersion("test-version"),
+			server.WithAdditionalAddresses([]string{"private_address:9202", "mesh_address:9202"}))
+		worker, err = repo.UpsertWorkerStatus(ctx, wStatus3, server.WithKeyId(pkiWorkerKeyId))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"private_address:9202", "mesh_address:9202"}, worker.GetAdditionalAddresses())
+
+		// the order of the additional addresses is kept
+		wStatus4 := server.NewWorker(scope.Global.String(),
+			server.WithAddress("pki_address"), server.WithReleaseVersion("test-version"),
+			server.WithAdditionalAddresses([]string{"mesh_address:9202", "private_address:9202"}))
+		worker, err = repo.UpsertWorkerStatus(ctx, wStatus4, server.WithKeyId(pkiWorkerKeyId))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"mesh_address:9202", "private_address:9202"}, worker.GetAdditionalAddresses())
+		worker, err = repo.LookupWorker(ctx, worker.GetPublicId())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"mesh_address:9202", "private_address:9202"}, worker.GetAdditionalAddresses())
+
+		// the additional addresses are removed when the worker no longer reports them
+		worker, err = repo.UpsertWorkerStatus(ctx, wStatus2, server.WithKeyId(pkiWorkerKeyId))
+		require.NoError(t, err)
+		assert.Empty(t, worker.GetAdditionalAddresses())
 	})
 
 	failureCases := []struct {
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// WorkerUtilization is the load on a worker as last reported in its status.
+// Rates are averaged over the interval between the worker's last two status
+// reports.
+type WorkerUtilization struct {
+	WorkerId              string
+	ActiveSessionCount    uint32
+	ActiveConnectionCount uint32
+	BytesUpPerSecond      uint64
+	BytesDownPerSecond    uint64
+	ProxyCpuUtilization   float64
+	SpoolBacklogBytes     uint64
+	UpdateTime            *timestamp.Timestamp
+}
+
+// UpsertWorkerUtilization stores the utilization reported by a worker,
+// replacing the one it previously reported.
+func (r *Repository) UpsertWorkerUtilization(ctx context.Context, u *WorkerUtilization, _ ...Option) error {
+	const op = "server.(Repository).UpsertWorkerUtilization"
+	switch {
+	case u == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing worker utilization")
+	case u.WorkerId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	}
+
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, upsertWorkerUtilizationQuery, []any{
+				sql.Named("worker_id", u.WorkerId),
+				sql.Named("active_session_count", u.ActiveSessionCount),
+				sql.Named("active_connection_count", u.ActiveConnectionCount),
+				sql.Named("bytes_up_per_second", u.BytesUpPerSecond),
+				sql.Named("bytes_down_per_second", u.BytesDownPerSecond),
+				sql.Named("proxy_cpu_utilization", u.ProxyCpuUtilization),
+				sql.Named("spool_backlog_bytes", u.SpoolBacklogBytes),
+			}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// ListWorkerUtilization returns the utilization last reported by the workers
+// with the provided ids. Workers that have not reported their utilization are
+// not included.
+func (r *Repository) ListWorkerUtilization(ctx context.Context, workerIds []string, _ ...Option) ([]*WorkerUtilization, error) {
+	const op = "server.(Repository).ListWorkerUtilization"
+	if len(workerIds) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.reader.Query(ctx, listWorkerUtilizationQuery, []any{workerIds})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var ret []*WorkerUtilization
+	for rows.Next() {
+		var u WorkerUtilization
+		if err := r.reader.ScanRows(ctx, rows, &u); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ret = append(ret, &u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_WorkerUtilization(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	ctx := context.Background()
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := server.NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	w1 := server.TestKmsWorker(t, conn, wrapper)
+	w2 := server.TestPkiWorker(t, conn, wrapper)
+	w3 := server.TestPkiWorker(t, conn, wrapper)
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Error(t, repo.UpsertWorkerUtilization(ctx, nil))
+		assert.Error(t, repo.UpsertWorkerUtilization(ctx, &server.WorkerUtilization{}))
+		assert.Error(t, repo.UpsertWorkerUtilization(ctx, &server.WorkerUtilization{WorkerId: "w_unknown"}))
+	})
+
+	t.Run("upsert-and-list", func(t *testing.T) {
+		require.NoError(t, repo.UpsertWorkerUtilization(ctx, &server.WorkerUtilization{
+			WorkerId:              w1.GetPublicId(),
+			ActiveSessionCount:    1,
+			ActiveConnectionCount: 2,
+		}))
+		require.NoError(t, repo.UpsertWorkerUtilization(ctx, &server.WorkerUtilization{
+			WorkerId:              w1.GetPublicId(),
+			ActiveSessionCount:    3,
+			ActiveConnectionCount: 4,
+			BytesUpPerSecond:      5,
+			BytesDownPerSecond:    6,
+			ProxyCpuUtilization:   0.5,
+			SpoolBacklogBytes:     7,
+		}))
+		require.NoError(t, repo.UpsertWorkerUtilization(ctx, &server.WorkerUtilization{
+			WorkerId:           w2.GetPublicId(),
+			ActiveSessionCount: 8,
+		}))
+
+		got, err := repo.ListWorkerUtilization(ctx, []string{w1.GetPublicId(), w2.GetPublicId(), w3.GetPublicId()})
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		byId := map[string]*server.WorkerUtilization{}
+		for _, u := range got {
+			assert.NotNil(t, u.UpdateTime)
+			byId[u.WorkerId] = u
+		}
+		require.Contains(t, byId, w1.GetPublicId())
+		u := byId[w1.GetPublicId()]
+		assert.Equal(t, uint32(3), u.ActiveSessionCount)
+		assert.Equal(t, uint32(4), u.ActiveConnectionCount)
+		assert.Equal(t, uint64(5), u.BytesUpPerSecond)
+		assert.Equal(t, uint64(6), u.BytesDownPerSecond)
+		assert.Equal(t, 0.5, u.ProxyCpuUtilization)
+		assert.Equal(t, uint64(7), u.SpoolBacklogBytes)
+		require.Contains(t, byId, w2.GetPublicId())
+		assert.Equal(t, uint32(8), byId[w2.GetPublicId()].ActiveSessionCount)
+
+		got, err = repo.ListWorkerUtilization(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("deleted-with-worker", func(t *testing.T) {
+		_, err := repo.DeleteWorker(ctx, w2.GetPublicId())
+		require.NoError(t, err)
+		got, err := repo.ListWorkerUtilization(ctx, []string{w2.GetPublicId()})
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/fatih/structs"
 	"github.com/hashicorp/boundary/internal/daemon/cluster"
@@ -616,6 +617,11 @@ func (r *WorkerAuthRepositoryStorage) loadServerLedActivationToken(ctx context.C
 		}
 		return errors.Wrap(ctx, err, op)
 	}
+	// An expired token is treated as if it did not exist, so that it cannot
+	// be used to activate the worker.
+	if exp := activationTokenEntry.GetExpirationTime(); exp != nil && !exp.AsTime().After(time.Now()) {
+		return nodeenrollment.ErrNotFound
+	}
 
 	token.State, err = AttachWorkerIdToState(ctx, activationTokenEntry.WorkerId)
 	if err != nil {
@@ -5,6 +5,7 @@ package server
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/fatih/structs"
@@ -125,15 +126,24 @@ type Worker struct {
 	// inputTags should only be read when performing mutations on the database.
 	inputTags []*Tag `gorm:"-"`
 
+	// additionalAddresses are the addresses, besides the address, at which
+	// clients can reach the worker in the order they should be tried.
+	additionalAddresses []string `gorm:"-"`
+
 	// This is used to pass the token back to the calling function
 	ControllerGeneratedActivationToken string `gorm:"-"`
 
+	// This is used to pass the time after which the token can no longer be
+	// used back to the calling function. It is nil if the token does not
+	// expire before the maximum lifetime of a controller-led activation token.
+	ControllerGeneratedActivationTokenExpirationTime *timestamp.Timestamp `gorm:"-"`
+
 	// RemoteStorageStates is a map of storage buckets and their storage bucket credential states
 	RemoteStorageStates map[string]*plugin.StorageBucketCredentialState `gorm:"-"`
 }
 
 // NewWorker returns a new Worker. Valid options are WithName, WithDescription
-// WithAddress, and WithWorkerTags. All other options are ignored.  This does
+// WithAddress, WithAdditionalAddresses, and WithWorkerTags. All other options are ignored.  This does
 // not set any of the worker reported values.
 func NewWorker(scopeId string, opt ...Option) *Worker {
 	opts := GetOpts(opt...)
@@ -146,8 +156,10 @@ func NewWorker(scopeId string, opt ...Option) *Worker {
 			ReleaseVersion:    opts.withReleaseVersion,
 			OperationalState:  opts.withOperationalState,
 			LocalStorageState: opts.withLocalStorageState,
+			Locality:          opts.withLocality,
 		},
-		inputTags: opts.withWorkerTags,
+		inputTags:           opts.withWorkerTags,
+		additionalAddresses: opts.withAdditionalAddresses,
 	}
 	if opts.withTestUseInputTagsAsApiTags {
 		worker.apiTags = worker.inputTags
@@ -186,6 +198,9 @@ func (w *Worker) clone() *Worker {
 			cWorker.inputTags = append(cWorker.inputTags, &Tag{Key: t.Key, Value: t.Value})
 		}
 	}
+	if w.additionalAddresses != nil {
+		cWorker.additionalAddresses = slices.Clone(w.additionalAddresses)
+	}
 	return cWorker
 }
 
@@ -232,6 +247,12 @@ func (w *Worker) GetApiTags() map[string][]string {
 	return tags
 }
 
+// GetAdditionalAddresses returns the addresses, besides the address, at which
+// clients can reach this worker in the order they should be tried.
+func (w *Worker) GetAdditionalAddresses() []string {
+	return w.additionalAddresses
+}
+
 // GetLastStatusTime contains the last time the worker has reported to the
 // controller its connection status.  If the worker has never reported to a
 // controller then nil is returned.
@@ -247,6 +268,20 @@ func (Worker) TableName() string {
 	return "server_worker"
 }
 
+// workerAdditionalAddress is an address, besides its address, at which clients
+// can reach a worker. Addresses with a lower priority are tried first.
+type workerAdditionalAddress struct {
+	WorkerId string `gorm:"primary_key"`
+	Priority uint32 `gorm:"primary_key"`
+	Address  string
+}
+
+// TableName overrides the table name used by workerAdditionalAddress to
+// `server_worker_additional_address`
+func (workerAdditionalAddress) TableName() string {
+	return "server_worker_additional_address"
+}
+
 // workerAggregate contains an aggregated view of the values associated with
 // a single worker.
 type workerAggregate struct {
@@ -264,6 +299,8 @@ type workerAggregate struct {
 	ActiveConnectionCount uint32
 	OperationalState      string
 	LocalStorageState     string
+	Locality              string
+	AdditionalAddresses   string
 	// Config Fields
 	LastStatusTime   *timestamp.Timestamp
 	WorkerConfigTags string
@@ -286,6 +323,7 @@ func (a *workerAggregate) toWorker(ctx context.Context) (*Worker, error) {
 			ReleaseVersion:    a.ReleaseVersion,
 			OperationalState:  a.OperationalState,
 			LocalStorageState: a.LocalStorageState,
+			Locality:          a.Locality,
 		},
 		activeConnectionCount: a.ActiveConnectionCount,
 		RemoteStorageStates:   map[string]*plugin.StorageBucketCredentialState{},
@@ -302,6 +340,12 @@ func (a *workerAggregate) toWorker(ctx context.Context) (*Worker, error) {
 	}
 	worker.configTags = tags
 
+	if a.AdditionalAddresses != "" {
+		// Addresses cannot contain commas, so the database aggregates them
+		// into a comma delimited string.
+		worker.additionalAddresses = strings.Split(a.AdditionalAddresses, ",")
+	}
+
 	return worker, nil
 }
 
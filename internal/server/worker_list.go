@@ -4,28 +4,59 @@
 package server
 
 import (
+	"cmp"
 	stderrors "errors"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/filter"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/hashicorp/boundary/version"
-	"github.com/hashicorp/go-bexpr"
 	"github.com/mitchellh/pointerstructure"
 	"google.golang.org/grpc/codes"
 )
 
 const ManagedWorkerTag = "boundary.cloud.hashicorp.com:managed"
 
+// WorkerSelection is how the workers that can handle a session are ordered
+// when the session is authorized. Clients try the workers in that order.
+type WorkerSelection string
+
+const (
+	// LeastLoadedWorkerSelection orders the workers from least to most loaded,
+	// according to the utilization they last reported. Workers with nearly the
+	// same load are ordered randomly. It is the default.
+	LeastLoadedWorkerSelection WorkerSelection = "least-loaded"
+	// RandomWorkerSelection orders the workers randomly.
+	RandomWorkerSelection WorkerSelection = "random"
+	// StickyWorkerSelection orders the workers like
+	// LeastLoadedWorkerSelection, except that the worker that handled the
+	// previous connection of the same user to the same target comes first
+	// while it is able to handle the session.
+	StickyWorkerSelection WorkerSelection = "sticky"
+)
+
+// ValidWorkerSelection reports whether s is a known WorkerSelection.
+func ValidWorkerSelection(s string) bool {
+	switch WorkerSelection(s) {
+	case LeastLoadedWorkerSelection, RandomWorkerSelection, StickyWorkerSelection:
+		return true
+	}
+	return false
+}
+
 // WorkerList is a helper type to make the selection of workers clearer and more declarative.
 type WorkerList []*Worker
 
-// addresses converts the slice of workers to a slice of their addresses
+// addresses converts the slice of workers to a slice of their addresses. Each
+// worker's address is followed by its additional addresses.
 func (w WorkerList) Addresses() []string {
 	ret := make([]string, 0, len(w))
 	for _, worker := range w {
 		ret = append(ret, worker.GetAddress())
+		ret = append(ret, worker.GetAdditionalAddresses()...)
 	}
 	return ret
 }
@@ -40,11 +71,17 @@ func (w WorkerList) PublicIds() []string {
 	return ret
 }
 
-// workerInfos converts the slice of workers to a slice of their workerInfo protos
+// workerInfos converts the slice of workers to a slice of workerInfo protos in
+// the order clients should try them. Each worker's address is followed by its
+// additional addresses, so a client that cannot reach a worker at its address
+// tries the worker's other addresses before moving on to the next worker.
 func (w WorkerList) WorkerInfos() []*pb.WorkerInfo {
 	ret := make([]*pb.WorkerInfo, 0, len(w))
 	for _, worker := range w {
 		ret = append(ret, &pb.WorkerInfo{Address: worker.GetAddress()})
+		for _, a := range worker.GetAdditionalAddresses() {
+			ret = append(ret, &pb.WorkerInfo{Address: a})
+		}
 	}
 	return ret
 }
@@ -64,7 +101,7 @@ func (w WorkerList) SupportsFeature(f version.Feature) WorkerList {
 
 // filtered returns a new workerList where all elements contained in it are the
 // ones which from the original workerList that pass the evaluator's evaluation.
-func (w WorkerList) Filtered(eval *bexpr.Evaluator) (WorkerList, error) {
+func (w WorkerList) Filtered(eval filter.Evaluator) (WorkerList, error) {
 	var ret []*Worker
 	for _, worker := range w {
 		filterInput := map[string]any{
@@ -84,6 +121,112 @@ func (w WorkerList) Filtered(eval *bexpr.Evaluator) (WorkerList, error) {
 	return ret, nil
 }
 
+// PreferLocality returns a new WorkerList with the same workers, where the
+// workers with the provided locality come before the others. The order of the
+// workers is otherwise preserved. If locality is empty the WorkerList is
+// returned unchanged.
+func (w WorkerList) PreferLocality(locality string) WorkerList {
+	if locality == "" {
+		return w
+	}
+	ret := make([]*Worker, 0, len(w))
+	others := make([]*Worker, 0, len(w))
+	for _, worker := range w {
+		if worker.GetLocality() == locality {
+			ret = append(ret, worker)
+		} else {
+			others = append(others, worker)
+		}
+	}
+	return append(ret, others...)
+}
+
+// PreferWorker returns a new WorkerList with the same workers, where the
+// worker with the provided id comes first. The order of the workers is
+// otherwise preserved. The returned bool reports whether the worker is in the
+// WorkerList; if it is not, the WorkerList is returned unchanged.
+func (w WorkerList) PreferWorker(workerId string) (WorkerList, bool) {
+	i := slices.IndexFunc(w, func(worker *Worker) bool {
+		return workerId != "" && worker.GetPublicId() == workerId
+	})
+	if i < 0 {
+		return w, false
+	}
+	ret := make([]*Worker, 0, len(w))
+	ret = append(ret, w[i])
+	ret = append(ret, w[:i]...)
+	return append(ret, w[i+1:]...), true
+}
+
+// leastLoadedSlack is the number of active sessions by which a worker can
+// exceed the least loaded worker and still be considered equally loaded. A
+// tenth of the sessions of the least loaded worker is used instead when it is
+// larger.
+const leastLoadedSlack = 5
+
+// leastLoadedCpuSlack is the proxy cpu utilization by which a worker can
+// exceed the least utilized worker and still be considered equally loaded.
+const leastLoadedCpuSlack = 0.1
+
+// PreferLeastLoaded returns a new WorkerList with the same workers ordered
+// from least to most loaded, according to the provided utilization they last
+// reported. Workers are compared by their active session count, then by their
+// proxy cpu utilization. The active connection count known to the controller
+// is used in place of the session count of workers that have not reported
+// their utilization, such as those running an older version, and their cpu
+// utilization is considered the same as the least utilized worker.
+//
+// Workers whose session count is within leastLoadedSlack of the least loaded
+// worker, or whose cpu utilization is within leastLoadedCpuSlack of the least
+// utilized worker, are considered equally loaded on that measure, so a burst
+// of sessions is spread across them instead of being sent to a single worker.
+// The order of equally loaded workers is preserved, so callers should shuffle
+// the list first.
+func (w WorkerList) PreferLeastLoaded(utilization []*WorkerUtilization) WorkerList {
+	byId := make(map[string]*WorkerUtilization, len(utilization))
+	for _, u := range utilization {
+		byId[u.WorkerId] = u
+	}
+	ret := slices.Clone(w)
+	if len(ret) == 0 {
+		return ret
+	}
+
+	sessions := func(w *Worker) uint32 {
+		if u := byId[w.GetPublicId()]; u != nil {
+			return u.ActiveSessionCount
+		}
+		return w.ActiveConnectionCount()
+	}
+	leastSessions := sessions(ret[0])
+	leastCpu := -1.0
+	for _, w := range ret {
+		leastSessions = min(leastSessions, sessions(w))
+		if u := byId[w.GetPublicId()]; u != nil && (leastCpu < 0 || u.ProxyCpuUtilization < leastCpu) {
+			leastCpu = u.ProxyCpuUtilization
+		}
+	}
+	sessionThreshold := leastSessions + max(leastLoadedSlack, leastSessions/10)
+	cpuThreshold := max(leastCpu, 0) + leastLoadedCpuSlack
+
+	sessionLoad := func(w *Worker) uint32 {
+		return max(sessions(w), sessionThreshold)
+	}
+	cpuLoad := func(w *Worker) float64 {
+		if u := byId[w.GetPublicId()]; u != nil {
+			return max(u.ProxyCpuUtilization, cpuThreshold)
+		}
+		return cpuThreshold
+	}
+	slices.SortStableFunc(ret, func(a, b *Worker) int {
+		return cmp.Or(
+			cmp.Compare(sessionLoad(a), sessionLoad(b)),
+			cmp.Compare(cpuLoad(a), cpuLoad(b)),
+		)
+	})
+	return ret
+}
+
 // SeparateManagedWorkers divides the incoming workers into managed and
 // unmanaged workers, respectively
 func SeparateManagedWorkers(workers WorkerList) (managedWorkers, nonManagedWorkers WorkerList) {
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/server/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerList_PreferLocality(t *testing.T) {
+	newWorker := func(id, locality string) *Worker {
+		return &Worker{Worker: &store.Worker{PublicId: id, Locality: locality}}
+	}
+	workers := WorkerList{
+		newWorker("w_1", "eu-west-1"),
+		newWorker("w_2", "us-east-1"),
+		newWorker("w_3", ""),
+		newWorker("w_4", "us-east-1"),
+		newWorker("w_5", "eu-west-1"),
+	}
+
+	tests := []struct {
+		name     string
+		locality string
+		want     []string
+	}{
+		{
+			name:     "no locality",
+			locality: "",
+			want:     []string{"w_1", "w_2", "w_3", "w_4", "w_5"},
+		},
+		{
+			name:     "matching locality",
+			locality: "us-east-1",
+			want:     []string{"w_2", "w_4", "w_1", "w_3", "w_5"},
+		},
+		{
+			name:     "no worker in locality",
+			locality: "ap-south-1",
+			want:     []string{"w_1", "w_2", "w_3", "w_4", "w_5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := workers.PreferLocality(tt.locality)
+			assert.Equal(t, tt.want, got.PublicIds())
+			// The input list is left unchanged.
+			assert.Equal(t, []string{"w_1", "w_2", "w_3", "w_4", "w_5"}, workers.PublicIds())
+		})
+	}
+}
+
+func TestWorkerList_PreferLeastLoaded(t *testing.T) {
+	newWorkers := func(connections ...uint32) WorkerList {
+		var workers WorkerList
+		for i, c := range connections {
+			workers = append(workers, &Worker{
+				Worker:                &store.Worker{PublicId: fmt.Sprintf("w_%d", i+1)},
+				activeConnectionCount: c,
+			})
+		}
+		return workers
+	}
+	sessions := func(counts ...uint32) []*WorkerUtilization {
+		var ret []*WorkerUtilization
+		for i, c := range counts {
+			ret = append(ret, &WorkerUtilization{WorkerId: fmt.Sprintf("w_%d", i+1), ActiveSessionCount: c})
+		}
+		return ret
+	}
+
+	tests := []struct {
+		name        string
+		workers     WorkerList
+		utilization []*WorkerUtilization
+		want        []string
+	}{
+		{
+			name: "empty",
+			want: []string{},
+		},
+		{
+			name:    "no utilization",
+			workers: newWorkers(0, 0, 0, 0, 0),
+			want:    []string{"w_1", "w_2", "w_3", "w_4", "w_5"},
+		},
+		{
+			name:    "no utilization by connections",
+			workers: newWorkers(50, 10, 30, 0, 20),
+			want:    []string{"w_4", "w_2", "w_5", "w_3", "w_1"},
+		},
+		{
+			name:        "by sessions",
+			workers:     newWorkers(0, 0, 0, 0, 0),
+			utilization: sessions(50, 10, 30, 0, 20),
+			want:        []string{"w_4", "w_2", "w_5", "w_3", "w_1"},
+		},
+		{
+			name:        "nearly equal sessions",
+			workers:     newWorkers(0, 0, 0, 0, 0),
+			utilization: sessions(3, 0, 5, 1, 4),
+			want:        []string{"w_1", "w_2", "w_3", "w_4", "w_5"},
+		},
+		{
+			name:        "session slack",
+			workers:     newWorkers(0, 0, 0, 0, 0),
+			utilization: sessions(20, 5, 9, 0, 6),
+			want:        []string{"w_2", "w_4", "w_5", "w_3", "w_1"},
+		},
+		{
+			name:        "session slack grows with load",
+			workers:     newWorkers(0, 0, 0, 0, 0),
+			utilization: sessions(108, 100, 111, 110, 105),
+			want:        []string{"w_1", "w_2", "w_4", "w_5", "w_3"},
+		},
+		{
+			name:    "cpu",
+			workers: newWorkers(0, 0, 0, 0, 0),
+			utilization: []*WorkerUtilization{
+				{WorkerId: "w_1", ActiveSessionCount: 1, ProxyCpuUtilization: 0.9},
+				{WorkerId: "w_2", ActiveSessionCount: 1, ProxyCpuUtilization: 0.15},
+				{WorkerId: "w_3", ActiveSessionCount: 1, ProxyCpuUtilization: 0.05},
+				{WorkerId: "w_4", ActiveSessionCount: 1, ProxyCpuUtilization: 0.5},
+				{WorkerId: "w_5", ActiveSessionCount: 1, ProxyCpuUtilization: 0.1},
+			},
+			want: []string{"w_2", "w_3", "w_5", "w_4", "w_1"},
+		},
+		{
+			name:    "unreported by connections",
+			workers: newWorkers(0, 100, 20, 100, 1),
+			utilization: []*WorkerUtilization{
+				{WorkerId: "w_2", ActiveSessionCount: 9, ProxyCpuUtilization: 0.2},
+				{WorkerId: "w_4", ActiveSessionCount: 3, ProxyCpuUtilization: 0.2},
+			},
+			want: []string{"w_1", "w_4", "w_5", "w_2", "w_3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := tt.workers.PublicIds()
+			got := tt.workers.PreferLeastLoaded(tt.utilization)
+			assert.Equal(t, tt.want, got.PublicIds())
+			// The input list is left unchanged.
+			assert.Equal(t, before, tt.workers.PublicIds())
+		})
+	}
+}
+
+func TestWorkerList_PreferWorker(t *testing.T) {
+	newWorker := func(id string) *Worker {
+		return &Worker{Worker: &store.Worker{PublicId: id}}
+	}
+	workers := WorkerList{
+		newWorker("w_1"),
+		newWorker("w_2"),
+		newWorker("w_3"),
+	}
+
+	tests := []struct {
+		name      string
+		workerId  string
+		want      []string
+		wantFound bool
+	}{
+		{
+			name:      "first",
+			workerId:  "w_1",
+			want:      []string{"w_1", "w_2", "w_3"},
+			wantFound: true,
+		},
+		{
+			name:      "last",
+			workerId:  "w_3",
+			want:      []string{"w_3", "w_1", "w_2"},
+			wantFound: true,
+		},
+		{
+			name:     "missing",
+			workerId: "w_4",
+			want:     []string{"w_1", "w_2", "w_3"},
+		},
+		{
+			name: "empty",
+			want: []string{"w_1", "w_2", "w_3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := workers.PreferWorker(tt.workerId)
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.want, got.PublicIds())
+			// The input list is left unchanged.
+			assert.Equal(t, []string{"w_1", "w_2", "w_3"}, workers.PublicIds())
+		})
+	}
+}
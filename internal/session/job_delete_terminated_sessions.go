@@ -5,6 +5,7 @@ package session
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -63,7 +64,13 @@ func (d *deleteTerminatedJob) Run(ctx context.Context, statusThreshold time.Dura
 
 	exec := func() batch.Exec {
 		return func(ctx context.Context, batchSize int) (int, error) {
-			return d.repo.deleteTerminatedSessionsBatch(ctx, params.WindowStartTime, batchSize)
+			sessions, connections, err := d.repo.deleteTerminatedSessionsBatch(ctx, params.WindowStartTime, batchSize)
+			if err != nil {
+				return 0, err
+			}
+			deletedTerminatedSessions.Add(float64(sessions))
+			deletedTerminatedConnections.Add(float64(connections))
+			return sessions, nil
 		}
 	}
 
@@ -101,5 +108,5 @@ func (d *deleteTerminatedJob) Name() string {
 
 // Description is the human readable description of the job.
 func (d *deleteTerminatedJob) Description() string {
-	return "Delete terminated sessions that were terminated "
+	return fmt.Sprintf("Delete sessions that were terminated more than %s ago", d.threshold)
 }
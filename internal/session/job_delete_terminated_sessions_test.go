@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -64,11 +65,13 @@ func TestDeleteTermiantedSessionsJob(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, tc.terminateCount, c)
 
+			deletedBefore := testutil.ToFloat64(deletedTerminatedSessions)
 			job, err := newDeleteTerminatedJob(ctx, repo, tc.threshold)
 			require.NoError(t, err)
 			err = job.Run(ctx, 1*time.Second)
 			require.NoError(t, err)
 			assert.Equal(t, tc.expected, job.Status().Completed)
+			assert.Equal(t, float64(tc.expected), testutil.ToFloat64(deletedTerminatedSessions)-deletedBefore)
 		})
 	}
 }
@@ -18,7 +18,8 @@ import (
 const deleteTerminatedThreshold = time.Hour
 
 // RegisterJobs registers session related jobs with the provided scheduler.
-func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, w db.Writer, r db.Reader, k *kms.Kms, workerStatusGracePeriod *atomic.Int64) error {
+// Supported options are WithTerminatedRetention.
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, w db.Writer, r db.Reader, k *kms.Kms, workerStatusGracePeriod *atomic.Int64, opt ...Option) error {
 	const op = "session.RegisterJobs"
 
 	if workerStatusGracePeriod == nil {
@@ -37,7 +38,11 @@ func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, w db.Writ
 	if err != nil {
 		return fmt.Errorf("error creating repository: %w", err)
 	}
-	deleteTerminatedJob, err := newDeleteTerminatedJob(ctx, repo, deleteTerminatedThreshold)
+	retention := deleteTerminatedThreshold
+	if opts := getOpts(opt...); opts.withTerminatedRetention > 0 {
+		retention = opts.withTerminatedRetention
+	}
+	deleteTerminatedJob, err := newDeleteTerminatedJob(ctx, repo, retention)
 	if err != nil {
 		return fmt.Errorf("error creating delete terminated session job: %w", err)
 	}
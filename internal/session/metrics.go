@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	subsystem = "controller_session_cleanup"
+)
+
+var (
+	deletedTerminatedSessions prometheus.Counter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: subsystem,
+			Name:      "deleted_sessions_total",
+			Help:      "Count of terminated sessions deleted once their retention period elapsed.",
+		},
+	)
+	deletedTerminatedConnections prometheus.Counter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: globals.MetricNamespace,
+			Subsystem: subsystem,
+			Name:      "deleted_connections_total",
+			Help:      "Count of session connections deleted along with their terminated session.",
+		},
+	)
+)
+
+// InitializeMetrics initializes the metrics for visibility into the deletion
+// of terminated sessions.
+func InitializeMetrics(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(
+		deletedTerminatedSessions,
+		deletedTerminatedConnections,
+	)
+}
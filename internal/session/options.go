@@ -42,6 +42,7 @@ type options struct {
 	withIgnoreDecryptionFailures bool
 	withRandomReader             io.Reader
 	withStartPageAfterItem       pagination.Item
+	withTerminatedRetention      time.Duration
 }
 
 func getDefaultOptions() options {
@@ -159,3 +160,12 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 		o.withStartPageAfterItem = item
 	}
 }
+
+// WithTerminatedRetention provides the period of time a session is kept after
+// it is terminated, before it is deleted along with its connections. If it is
+// zero, the default retention is used.
+func WithTerminatedRetention(with time.Duration) Option {
+	return func(o *options) {
+		o.withTerminatedRetention = with
+	}
+}
@@ -6,6 +6,7 @@ package session
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -94,4 +95,11 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withRandomReader = reader
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithTerminatedRetention", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithTerminatedRetention(72 * time.Hour))
+		testOpts := getDefaultOptions()
+		testOpts.withTerminatedRetention = 72 * time.Hour
+		assert.Equal(opts, testOpts)
+	})
 }
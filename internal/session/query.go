@@ -21,6 +21,7 @@ with not_active as (
 		ss.state = 'pending' and
 		ss.session_id = @session_id and
 		s.version = @version and
+		(s.authorization_expiration_time is null or s.authorization_expiration_time > now()) and
 		s.public_id not in(select session_id from session_state where session_id = @session_id and state = 'active')
 )
 select * from not_active;
@@ -132,6 +133,23 @@ from
       where public_id=@public_id
 `
 
+	setRecordingSkipReason = `
+update session
+   set recording_skip_reason = @reason
+ where public_id = @public_id
+   and recording_skip_reason is null;
+`
+	lastConnectionWorkerId = `
+  select sc.worker_id
+    from session_connection sc
+    join session s
+      on s.public_id = sc.session_id
+   where s.user_id = @user_id
+     and s.target_id = @target_id
+     and sc.worker_id is not null
+order by sc.create_time desc
+   limit 1;
+`
 	terminateSessionIfPossible = `
     -- is terminate_session_id in a canceling state
     with session_version as (
@@ -217,6 +235,7 @@ from
 	//	* sessions that are expired and all their connections are closed.
 	// 	* sessions that are canceling and all their connections are closed
 	//  * sessions that have exhausted their connection limit and all their connections are closed.
+	//  * sessions that were never activated before their authorization expired.
 	termSessionsUpdate = `
 with canceling_session(session_id) as
 (
@@ -227,12 +246,31 @@ with canceling_session(session_id) as
 	where
 		ss.state = 'canceling' and
 		upper(ss.active_time_range) is null
+),
+unactivated_session(session_id) as
+(
+	select
+		s.public_id
+	from
+		session s
+	where
+		now() > s.authorization_expiration_time and
+		s.public_id not in (select session_id from session_state where state = 'active')
 )
 update session us
 	set termination_reason =
 	case
 		-- timed out sessions
 		when now() > us.expiration_time then 'timed out'
+		-- sessions whose authorization expired before they were activated
+		when us.public_id in(
+			select
+				session_id
+			from
+				unactivated_session uas
+			where
+				us.public_id = uas.session_id
+			) then 'timed out'
 		-- canceling sessions
 		when us.public_id in(
 			select
@@ -251,6 +289,15 @@ where
 	(
 		-- expired sessions...
 		now() > us.expiration_time or
+		-- sessions whose authorization expired before they were activated...
+		us.public_id in (
+			select
+				session_id
+			from
+				unactivated_session uas
+			where
+				us.public_id = uas.session_id
+		) or
 		-- connection limit reached...
 		(
 			-- handle unlimited connections...
@@ -475,10 +522,17 @@ with batch (session_id) as (
    where state                                  = 'terminated'
      and lower(session_state.active_time_range) < @terminated_before
    limit @batch_size
+),
+deleted_session (public_id) as (
+  delete
+    from session
+   where public_id in (select session_id from batch)
+  returning public_id
 )
-delete
-  from session
- where public_id in (select session_id from batch);
+select (select count(*) from deleted_session) as deleted_sessions,
+       (select count(*)
+          from session_connection
+         where session_id in (select session_id from batch)) as deleted_connections;
 `
 )
 
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// RecordingSkipReason of the session, which explains why a session of a target
+// with session recording enabled was not recorded.
+type RecordingSkipReason string
+
+const (
+	// SampledOut means the session was not selected by the sampling percentage
+	// of the storage policy.
+	SampledOut RecordingSkipReason = "sampled out"
+	// FilteredOut means the session did not match the sampling filter of the
+	// storage policy.
+	FilteredOut RecordingSkipReason = "filtered out"
+)
+
+// String representation of the recording skip reason
+func (r RecordingSkipReason) String() string {
+	return string(r)
+}
+
+func convertToRecordingSkipReason(ctx context.Context, s string) (RecordingSkipReason, error) {
+	const op = "session.convertToRecordingSkipReason"
+	switch s {
+	case SampledOut.String():
+		return SampledOut, nil
+	case FilteredOut.String():
+		return FilteredOut, nil
+	default:
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is not a valid recording skip reason", s))
+	}
+}
@@ -141,26 +141,28 @@ func (r *Repository) convertToSessions(ctx context.Context, sessionList []*sessi
 			}
 			prevSessionId = sv.PublicId
 			workingSession = &Session{
-				PublicId:                sv.PublicId,
-				UserId:                  sv.UserId,
-				HostId:                  sv.HostId,
-				HostSetId:               sv.HostSetId,
-				TargetId:                sv.TargetId,
-				AuthTokenId:             sv.AuthTokenId,
-				ProjectId:               sv.ProjectId,
-				Certificate:             sv.Certificate,
-				ExpirationTime:          sv.ExpirationTime,
-				TerminationReason:       sv.TerminationReason,
-				CreateTime:              sv.CreateTime,
-				UpdateTime:              sv.UpdateTime,
-				Version:                 sv.Version,
-				Endpoint:                sv.Endpoint,
-				ConnectionLimit:         sv.ConnectionLimit,
-				CtCertificatePrivateKey: nil, // CtCertificatePrivateKey should not be returned in lists
-				CertificatePrivateKey:   nil, // CertificatePrivateKey should not be returned in lists
-				CtTofuToken:             nil, // CtTofuToken should not be returned in lists
-				TofuToken:               nil, // TofuToken should not be returned in lists
-				KeyId:                   "",  // KeyId should not be returned in lists
+				PublicId:                    sv.PublicId,
+				UserId:                      sv.UserId,
+				HostId:                      sv.HostId,
+				HostSetId:                   sv.HostSetId,
+				TargetId:                    sv.TargetId,
+				AuthTokenId:                 sv.AuthTokenId,
+				ProjectId:                   sv.ProjectId,
+				Certificate:                 sv.Certificate,
+				ExpirationTime:              sv.ExpirationTime,
+				AuthorizationExpirationTime: sv.AuthorizationExpirationTime,
+				TerminationReason:           sv.TerminationReason,
+				RecordingSkipReason:         sv.RecordingSkipReason,
+				CreateTime:                  sv.CreateTime,
+				UpdateTime:                  sv.UpdateTime,
+				Version:                     sv.Version,
+				Endpoint:                    sv.Endpoint,
+				ConnectionLimit:             sv.ConnectionLimit,
+				CtCertificatePrivateKey:     nil, // CtCertificatePrivateKey should not be returned in lists
+				CertificatePrivateKey:       nil, // CertificatePrivateKey should not be returned in lists
+				CtTofuToken:                 nil, // CtTofuToken should not be returned in lists
+				TofuToken:                   nil, // TofuToken should not be returned in lists
+				KeyId:                       "",  // KeyId should not be returned in lists
 			}
 		}
 
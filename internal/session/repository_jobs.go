@@ -56,7 +56,15 @@ func (r *Repository) setDeleteJobBatchSize(ctx context.Context, batchSize int) e
 	return nil
 }
 
-func (r *Repository) deleteTerminatedSessionsBatch(ctx context.Context, terminatedBefore *timestamp.Timestamp, batchSize int) (int, error) {
+type deleteTerminatedResult struct {
+	DeletedSessions    int
+	DeletedConnections int
+}
+
+// deleteTerminatedSessionsBatch deletes up to batchSize sessions terminated
+// before terminatedBefore. It returns the number of deleted sessions along
+// with the number of their connections, which are deleted with them.
+func (r *Repository) deleteTerminatedSessionsBatch(ctx context.Context, terminatedBefore *timestamp.Timestamp, batchSize int) (int, int, error) {
 	const op = "session.(Repository).deleteTerminatedSessionsBatch"
 
 	args := []any{
@@ -64,9 +72,20 @@ func (r *Repository) deleteTerminatedSessionsBatch(ctx context.Context, terminat
 		sql.Named("batch_size", batchSize),
 	}
 
-	c, err := r.writer.Exec(ctx, deleteTerminatedInBatch, args)
+	rows, err := r.writer.Query(ctx, deleteTerminatedInBatch, args)
 	if err != nil {
-		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("error deleting terminated sessions"))
+		return 0, 0, errors.Wrap(ctx, err, op, errors.WithMsg("error deleting terminated sessions"))
+	}
+	defer rows.Close()
+
+	var result deleteTerminatedResult
+	for rows.Next() {
+		if err := r.writer.ScanRows(ctx, rows, &result); err != nil {
+			return 0, 0, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, 0, errors.Wrap(ctx, err, op, errors.WithMsg("next row failed"))
 	}
-	return c, nil
+	return result.DeletedSessions, result.DeletedConnections, nil
 }
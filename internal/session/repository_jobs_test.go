@@ -140,7 +140,7 @@ func TestRepository_deleteTerminatedSessionsBatch(t *testing.T) {
 				assert.Equal(t, tc.terminateCount, c)
 			}
 
-			c, err := repo.deleteTerminatedSessionsBatch(ctx, p.WindowStartTime, tc.batchSize)
+			c, _, err := repo.deleteTerminatedSessionsBatch(ctx, p.WindowStartTime, tc.batchSize)
 			require.NoError(t, err)
 			assert.Equal(t, tc.expected, c)
 		})
@@ -561,6 +561,76 @@ func (r *Repository) terminateSessionIfPossible(ctx context.Context, sessionId s
 	return rowsAffected, nil
 }
 
+// SetRecordingSkipReason records on the session why it was not recorded
+// despite its target having session recording enabled. It is called when the
+// session is established, after evaluating the sampling settings of the
+// storage policy that applies to the target's storage bucket. The reason of a
+// session can only be set once.
+func (r *Repository) SetRecordingSkipReason(ctx context.Context, sessionId string, reason RecordingSkipReason) error {
+	const op = "session.(Repository).SetRecordingSkipReason"
+	if sessionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if _, err := convertToRecordingSkipReason(ctx, reason.String()); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			rowsUpdated, err := w.Exec(ctx, setRecordingSkipReason, []any{
+				sql.Named("public_id", sessionId),
+				sql.Named("reason", reason.String()),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("session %s not found or recording skip reason already set", sessionId))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// LastConnectionWorkerId returns the id of the worker that handled the most
+// recent connection of the given user to the given target. An empty string is
+// returned if the user has no connection to the target with a known worker.
+func (r *Repository) LastConnectionWorkerId(ctx context.Context, userId, targetId string) (string, error) {
+	const op = "session.(Repository).LastConnectionWorkerId"
+	switch {
+	case userId == "":
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	case targetId == "":
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	rows, err := r.reader.Query(ctx, lastConnectionWorkerId, []any{
+		sql.Named("user_id", userId),
+		sql.Named("target_id", targetId),
+	})
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var workerId string
+	for rows.Next() {
+		if err := rows.Scan(&workerId); err != nil {
+			return "", errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("failed to get next row for worker id"))
+	}
+	return workerId, nil
+}
+
 type AuthzSummary struct {
 	ExpirationTime         *timestamp.Timestamp
 	ConnectionLimit        int32
@@ -686,6 +756,9 @@ func (r *Repository) ActivateSession(ctx context.Context, sessionId string, sess
 	if err := r.reader.LookupById(ctx, &foundSession); err != nil {
 		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", sessionId)))
 	}
+	if exp := foundSession.AuthorizationExpirationTime; exp != nil && len(foundSession.CtTofuToken) == 0 && time.Now().After(exp.GetTimestamp().AsTime()) {
+		return nil, nil, errors.New(ctx, errors.InvalidSessionState, op, "session authorization has expired")
+	}
 
 	// Encrypt the tofu before we start a database transaction to avoid holding the transaction while encrypting.
 	updatedSession := AllocSession()
@@ -1564,6 +1564,16 @@ func TestRepository_ActivateSession(t *testing.T) {
 			}(),
 			wantErr: true,
 		},
+		{
+			name: "authorization-expired",
+			session: func() *Session {
+				composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+				composedOf.AuthorizationExpirationTime = timestamp.New(time.Now().Add(-time.Minute))
+				return TestSession(t, conn, wrapper, composedOf)
+			}(),
+			wantErr:     true,
+			wantIsError: errors.InvalidSessionState,
+		},
 		{
 			name:    "bad-session-id",
 			session: TestDefaultSession(t, conn, wrapper, iamRepo),
@@ -1910,3 +1920,53 @@ func TestRepository_CheckIfNoLongerActive(t *testing.T) {
 	}
 	assert.ElementsMatch(t, gotIds, []string{unrecognizedSessionId, terminatedSession.PublicId, cancelingSess.PublicId})
 }
+
+func TestRepository_LastConnectionWorkerId(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	sessionRepo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+	connectionRepo, err := NewConnectionRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	worker1 := server.TestKmsWorker(t, conn, wrapper)
+	worker2 := server.TestKmsWorker(t, conn, wrapper)
+	params := TestSessionParams(t, conn, wrapper, iamRepo)
+
+	t.Run("missing-user-id", func(t *testing.T) {
+		_, err := sessionRepo.LastConnectionWorkerId(ctx, "", params.TargetId)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("missing-target-id", func(t *testing.T) {
+		_, err := sessionRepo.LastConnectionWorkerId(ctx, params.UserId, "")
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+
+	got, err := sessionRepo.LastConnectionWorkerId(ctx, params.UserId, params.TargetId)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	for _, workerId := range []string{worker1.GetPublicId(), worker2.GetPublicId()} {
+		params.CorrelationId = ""
+		sess := TestSession(t, conn, wrapper, params)
+		_, _, err := sessionRepo.ActivateSession(ctx, sess.GetPublicId(), sess.Version, TestTofu(t))
+		require.NoError(t, err)
+		_, _, err = AuthorizeConnection(ctx, sessionRepo, connectionRepo, sess.GetPublicId(), workerId)
+		require.NoError(t, err)
+
+		got, err := sessionRepo.LastConnectionWorkerId(ctx, params.UserId, params.TargetId)
+		require.NoError(t, err)
+		assert.Equal(t, workerId, got)
+	}
+
+	// Connections of other users to the target are not considered.
+	other := TestDefaultSession(t, conn, wrapper, iamRepo)
+	got, err = sessionRepo.LastConnectionWorkerId(ctx, other.UserId, params.TargetId)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -51,6 +51,18 @@ type ComposedOf struct {
 	ExpirationTime *timestamp.Timestamp
 	// Max connections for the session
 	ConnectionLimit int32
+	// AllowedPorts are the ports of the target the client may choose from when
+	// connecting. It is optional.
+	AllowedPorts string
+	// TlsPassthrough is true if the worker passes the TLS connections of the
+	// client through to the endpoint. It is optional.
+	TlsPassthrough bool
+	// TlsServerNames are the server name patterns used to route TLS passthrough
+	// connections by the SNI of the client. It is optional.
+	TlsServerNames string
+	// AuthorizationExpirationTime is the time after which the session can no
+	// longer be activated. It is optional.
+	AuthorizationExpirationTime *timestamp.Timestamp
 	// Ingress and egress worker filters. Active filters when the session was created, used to
 	// validate the session via the same set of rules at consumption time as
 	// existed at creation time. Round tripping it through here saves a lookup
@@ -93,12 +105,18 @@ type Session struct {
 	CertificatePrivateKey []byte `json:"certificate_private_key,omitempty" gorm:"-" wrapping:"pt,certificate_private_key"`
 	// ExpirationTime - after this time the connection will be expired, e.g. forcefully terminated
 	ExpirationTime *timestamp.Timestamp `json:"expiration_time,omitempty" gorm:"default:null"`
+	// AuthorizationExpirationTime - after this time a pending session can no
+	// longer be activated
+	AuthorizationExpirationTime *timestamp.Timestamp `json:"authorization_expiration_time,omitempty" gorm:"default:null"`
 	// CtTofuToken is the ciphertext Tofutoken value stored in the database
 	CtTofuToken []byte `json:"ct_tofu_token,omitempty" gorm:"column:tofu_token;default:null" wrapping:"ct,tofu_token"`
 	// TofuToken - plain text of the "trust on first use" token for session
 	TofuToken []byte `json:"tofu_token,omitempty" gorm:"-" wrapping:"pt,tofu_token"`
 	// termination_reason for the session
 	TerminationReason string `json:"termination_reason,omitempty" gorm:"default:null"`
+	// RecordingSkipReason is set if the session was not recorded because of
+	// the sampling settings of the applicable storage policy
+	RecordingSkipReason string `json:"recording_skip_reason,omitempty" gorm:"default:null"`
 	// CreateTime from the RDBMS
 	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
 	// UpdateTime from the RDBMS
@@ -109,6 +127,13 @@ type Session struct {
 	Endpoint string `json:"-" gorm:"default:null"`
 	// Maximum number of connections in a session
 	ConnectionLimit int32 `json:"connection_limit,omitempty" gorm:"default:null"`
+	// AllowedPorts the client may choose from when connecting
+	AllowedPorts string `json:"-" gorm:"default:null"`
+	// TlsPassthrough is true if TLS connections are passed through to the
+	// endpoint
+	TlsPassthrough bool `json:"-" gorm:"default:null"`
+	// TlsServerNames used to route TLS passthrough connections
+	TlsServerNames string `json:"-" gorm:"default:null"`
 
 	// Worker filters
 	WorkerFilter        string `json:"-" gorm:"default:null"`
@@ -195,22 +220,26 @@ var (
 func New(ctx context.Context, c ComposedOf, _ ...Option) (*Session, error) {
 	const op = "session.New"
 	s := Session{
-		UserId:              c.UserId,
-		HostId:              c.HostId,
-		TargetId:            c.TargetId,
-		HostSetId:           c.HostSetId,
-		AuthTokenId:         c.AuthTokenId,
-		ProjectId:           c.ProjectId,
-		Endpoint:            c.Endpoint,
-		ExpirationTime:      c.ExpirationTime,
-		ConnectionLimit:     c.ConnectionLimit,
-		WorkerFilter:        c.WorkerFilter,
-		EgressWorkerFilter:  c.EgressWorkerFilter,
-		IngressWorkerFilter: c.IngressWorkerFilter,
-		DynamicCredentials:  c.DynamicCredentials,
-		StaticCredentials:   c.StaticCredentials,
-		ProtocolWorkerId:    c.ProtocolWorkerId,
-		CorrelationId:       c.CorrelationId,
+		UserId:                      c.UserId,
+		HostId:                      c.HostId,
+		TargetId:                    c.TargetId,
+		HostSetId:                   c.HostSetId,
+		AuthTokenId:                 c.AuthTokenId,
+		ProjectId:                   c.ProjectId,
+		Endpoint:                    c.Endpoint,
+		ExpirationTime:              c.ExpirationTime,
+		ConnectionLimit:             c.ConnectionLimit,
+		AllowedPorts:                c.AllowedPorts,
+		TlsPassthrough:              c.TlsPassthrough,
+		TlsServerNames:              c.TlsServerNames,
+		AuthorizationExpirationTime: c.AuthorizationExpirationTime,
+		WorkerFilter:                c.WorkerFilter,
+		EgressWorkerFilter:          c.EgressWorkerFilter,
+		IngressWorkerFilter:         c.IngressWorkerFilter,
+		DynamicCredentials:          c.DynamicCredentials,
+		StaticCredentials:           c.StaticCredentials,
+		ProtocolWorkerId:            c.ProtocolWorkerId,
+		CorrelationId:               c.CorrelationId,
 	}
 	if err := s.validateNewSession(ctx); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -234,9 +263,13 @@ func (s *Session) Clone() any {
 		AuthTokenId:         s.AuthTokenId,
 		ProjectId:           s.ProjectId,
 		TerminationReason:   s.TerminationReason,
+		RecordingSkipReason: s.RecordingSkipReason,
 		Version:             s.Version,
 		Endpoint:            s.Endpoint,
 		ConnectionLimit:     s.ConnectionLimit,
+		AllowedPorts:        s.AllowedPorts,
+		TlsPassthrough:      s.TlsPassthrough,
+		TlsServerNames:      s.TlsServerNames,
 		WorkerFilter:        s.WorkerFilter,
 		EgressWorkerFilter:  s.EgressWorkerFilter,
 		IngressWorkerFilter: s.IngressWorkerFilter,
@@ -293,6 +326,14 @@ func (s *Session) Clone() any {
 			},
 		}
 	}
+	if s.AuthorizationExpirationTime != nil {
+		clone.AuthorizationExpirationTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: s.AuthorizationExpirationTime.Timestamp.Seconds,
+				Nanos:   s.AuthorizationExpirationTime.Timestamp.Nanos,
+			},
+		}
+	}
 	if s.CreateTime != nil {
 		clone.CreateTime = &timestamp.Timestamp{
 			Timestamp: &timestamppb.Timestamp{
@@ -352,8 +393,16 @@ func (s *Session) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType
 			return errors.New(ctx, errors.InvalidParameter, op, "endpoint is immutable")
 		case contains(opts.WithFieldMaskPaths, "ExpirationTime"):
 			return errors.New(ctx, errors.InvalidParameter, op, "expiration time is immutable")
+		case contains(opts.WithFieldMaskPaths, "AuthorizationExpirationTime"):
+			return errors.New(ctx, errors.InvalidParameter, op, "authorization expiration time is immutable")
 		case contains(opts.WithFieldMaskPaths, "ConnectionLimit"):
 			return errors.New(ctx, errors.InvalidParameter, op, "connection limit is immutable")
+		case contains(opts.WithFieldMaskPaths, "AllowedPorts"):
+			return errors.New(ctx, errors.InvalidParameter, op, "allowed ports are immutable")
+		case contains(opts.WithFieldMaskPaths, "TlsPassthrough"):
+			return errors.New(ctx, errors.InvalidParameter, op, "tls passthrough is immutable")
+		case contains(opts.WithFieldMaskPaths, "TlsServerNames"):
+			return errors.New(ctx, errors.InvalidParameter, op, "tls server names are immutable")
 		case contains(opts.WithFieldMaskPaths, "WorkerFilter"):
 			return errors.New(ctx, errors.InvalidParameter, op, "worker filter is immutable")
 		case contains(opts.WithFieldMaskPaths, "EgressWorkerFilter"):
@@ -370,6 +419,10 @@ func (s *Session) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType
 			if _, err := convertToReason(ctx, s.TerminationReason); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+		case contains(opts.WithFieldMaskPaths, "RecordingSkipReason"):
+			if _, err := convertToRecordingSkipReason(ctx, s.RecordingSkipReason); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 		}
 	}
 	return nil
@@ -414,6 +467,9 @@ func (s *Session) validateNewSession(ctx context.Context) error {
 	if s.TerminationReason != "" {
 		return errors.New(ctx, errors.InvalidParameter, op, "termination reason must be empty")
 	}
+	if s.RecordingSkipReason != "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "recording skip reason must be empty")
+	}
 	if s.TofuToken != nil {
 		return errors.New(ctx, errors.InvalidParameter, op, "tofu token must be empty")
 	}
@@ -572,21 +628,23 @@ func (s *Session) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
 
 type sessionListView struct {
 	// Session fields, we omit some fields that are not included when listing sessions.
-	PublicId          string               `gorm:"primary_key"`
-	UserId            string               `gorm:"default:null"`
-	HostId            string               `gorm:"default:null"`
-	HostSetId         string               `gorm:"default:null"`
-	TargetId          string               `gorm:"default:null"`
-	AuthTokenId       string               `gorm:"default:null"`
-	ProjectId         string               `gorm:"default:null"`
-	Certificate       []byte               `gorm:"default:null"`
-	ExpirationTime    *timestamp.Timestamp `gorm:"default:null"`
-	TerminationReason string               `gorm:"default:null"`
-	CreateTime        *timestamp.Timestamp `gorm:"default:current_timestamp"`
-	UpdateTime        *timestamp.Timestamp `gorm:"default:current_timestamp"`
-	Version           uint32               `gorm:"default:null"`
-	Endpoint          string               `gorm:"default:null"`
-	ConnectionLimit   int32                `gorm:"default:null"`
+	PublicId                    string               `gorm:"primary_key"`
+	UserId                      string               `gorm:"default:null"`
+	HostId                      string               `gorm:"default:null"`
+	HostSetId                   string               `gorm:"default:null"`
+	TargetId                    string               `gorm:"default:null"`
+	AuthTokenId                 string               `gorm:"default:null"`
+	ProjectId                   string               `gorm:"default:null"`
+	Certificate                 []byte               `gorm:"default:null"`
+	ExpirationTime              *timestamp.Timestamp `gorm:"default:null"`
+	AuthorizationExpirationTime *timestamp.Timestamp `gorm:"default:null"`
+	TerminationReason           string               `gorm:"default:null"`
+	RecordingSkipReason         string               `gorm:"default:null"`
+	CreateTime                  *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime                  *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	Version                     uint32               `gorm:"default:null"`
+	Endpoint                    string               `gorm:"default:null"`
+	ConnectionLimit             int32                `gorm:"default:null"`
 
 	// State fields
 	Status    string               `gorm:"column:state"`
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+const (
+	addUsageQuery = `
+insert into storage_plugin_storage_bucket_usage
+  (storage_bucket_id, bytes, object_count)
+values
+  (@storage_bucket_id, @bytes, @object_count)
+on conflict (storage_bucket_id) do update
+  set bytes        = storage_plugin_storage_bucket_usage.bytes + excluded.bytes,
+      object_count = storage_plugin_storage_bucket_usage.object_count + excluded.object_count
+returning storage_bucket_id, bytes, object_count, update_time;
+`
+
+	upsertQuotaQuery = `
+insert into storage_plugin_storage_bucket_quota
+  (storage_bucket_id, max_bytes, max_objects, warning_threshold_percent, block_when_exceeded)
+values
+  (@storage_bucket_id, @max_bytes, @max_objects, @warning_threshold_percent, @block_when_exceeded)
+on conflict (storage_bucket_id) do update
+  set max_bytes                 = excluded.max_bytes,
+      max_objects               = excluded.max_objects,
+      warning_threshold_percent = excluded.warning_threshold_percent,
+      block_when_exceeded       = excluded.block_when_exceeded
+returning storage_bucket_id, max_bytes, max_objects, warning_threshold_percent, block_when_exceeded, create_time, update_time;
+`
+)
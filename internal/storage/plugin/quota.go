@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"math/bits"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// DefaultWarningThresholdPercent is the percentage of a quota limit at which a
+// warning is emitted when a quota does not set one.
+const DefaultWarningThresholdPercent = 80
+
+// Usage is the cumulative size and number of the objects written to a
+// storage bucket for session recordings.
+type Usage struct {
+	StorageBucketId string `gorm:"primary_key"`
+	Bytes           uint64
+	ObjectCount     uint64
+	UpdateTime      *timestamp.Timestamp `gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name for gorm.
+func (u *Usage) TableName() string {
+	return "storage_plugin_storage_bucket_usage"
+}
+
+// Quota contains the limits on the storage used by session recordings in a
+// storage bucket. A limit of zero means no limit.
+type Quota struct {
+	StorageBucketId         string `gorm:"primary_key"`
+	MaxBytes                uint64
+	MaxObjects              uint64
+	WarningThresholdPercent uint32
+	BlockWhenExceeded       bool
+	CreateTime              *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	UpdateTime              *timestamp.Timestamp `gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name for gorm.
+func (q *Quota) TableName() string {
+	return "storage_plugin_storage_bucket_quota"
+}
+
+func (q *Quota) validate(ctx context.Context) error {
+	const op = "plugin.(Quota).validate"
+	switch {
+	case q.StorageBucketId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing storage bucket id")
+	case q.MaxBytes == 0 && q.MaxObjects == 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "max bytes or max objects must be set")
+	case q.WarningThresholdPercent > 100:
+		return errors.New(ctx, errors.InvalidParameter, op, "warning threshold percent must be between 1 and 100")
+	}
+	return nil
+}
+
+// QuotaStatus is the state of the usage of a storage bucket relative to its
+// quota.
+type QuotaStatus int
+
+const (
+	// QuotaOk means the usage is below the warning threshold of all limits, or
+	// that the storage bucket has no quota.
+	QuotaOk QuotaStatus = iota
+	// QuotaWarning means the usage reached the warning threshold of a limit.
+	QuotaWarning
+	// QuotaExceeded means the usage reached a limit.
+	QuotaExceeded
+)
+
+// String returns the string representation of the quota status.
+func (s QuotaStatus) String() string {
+	switch s {
+	case QuotaWarning:
+		return "warning"
+	case QuotaExceeded:
+		return "exceeded"
+	default:
+		return "ok"
+	}
+}
+
+// Status returns the status of the given usage relative to the quota. A nil
+// quota always returns QuotaOk.
+func (q *Quota) Status(u *Usage) QuotaStatus {
+	if q == nil || u == nil {
+		return QuotaOk
+	}
+	threshold := q.WarningThresholdPercent
+	if threshold == 0 {
+		threshold = DefaultWarningThresholdPercent
+	}
+	status := QuotaOk
+	for _, l := range []struct{ used, max uint64 }{
+		{u.Bytes, q.MaxBytes},
+		{u.ObjectCount, q.MaxObjects},
+	} {
+		switch {
+		case l.max == 0:
+		case l.used >= l.max:
+			return QuotaExceeded
+		case reachedPercent(l.used, l.max, uint64(threshold)):
+			status = QuotaWarning
+		}
+	}
+	return status
+}
+
+// reachedPercent reports whether used is at least percent of max. The
+// products are computed on 128 bits so large limits cannot overflow.
+func reachedPercent(used, max, percent uint64) bool {
+	uHi, uLo := bits.Mul64(used, 100)
+	mHi, mLo := bits.Mul64(max, percent)
+	return uHi > mHi || (uHi == mHi && uLo >= mLo)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuota_Status(t *testing.T) {
+	cases := []struct {
+		name string
+		q    *Quota
+		u    *Usage
+		want QuotaStatus
+	}{
+		{"nil-quota", nil, &Usage{Bytes: 100}, QuotaOk},
+		{"nil-usage", &Quota{MaxBytes: 100}, nil, QuotaOk},
+		{"below-threshold", &Quota{MaxBytes: 100}, &Usage{Bytes: 79}, QuotaOk},
+		{"default-threshold", &Quota{MaxBytes: 100}, &Usage{Bytes: 80}, QuotaWarning},
+		{"custom-threshold", &Quota{MaxBytes: 100, WarningThresholdPercent: 50}, &Usage{Bytes: 50}, QuotaWarning},
+		{"bytes-exceeded", &Quota{MaxBytes: 100}, &Usage{Bytes: 100}, QuotaExceeded},
+		{"objects-warning", &Quota{MaxObjects: 10}, &Usage{ObjectCount: 9}, QuotaWarning},
+		{"objects-exceeded", &Quota{MaxBytes: 1000, MaxObjects: 10}, &Usage{Bytes: 1, ObjectCount: 10}, QuotaExceeded},
+		{"unlimited-bytes", &Quota{MaxObjects: 10}, &Usage{Bytes: math.MaxUint64}, QuotaOk},
+		{"large-limit", &Quota{MaxBytes: math.MaxUint64}, &Usage{Bytes: math.MaxUint64 / 10 * 9}, QuotaWarning},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.q.Status(tc.u))
+		})
+	}
+}
+
+func TestQuota_validate(t *testing.T) {
+	ctx := context.Background()
+	assert.Error(t, (&Quota{MaxBytes: 1}).validate(ctx))
+	assert.Error(t, (&Quota{StorageBucketId: "sb_1234567890"}).validate(ctx))
+	assert.Error(t, (&Quota{StorageBucketId: "sb_1234567890", MaxBytes: 1, WarningThresholdPercent: 101}).validate(ctx))
+	assert.NoError(t, (&Quota{StorageBucketId: "sb_1234567890", MaxObjects: 1}).validate(ctx))
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+)
+
+// AddStorageBucketUsage adds the given number of bytes and objects to the
+// usage of the storage bucket and returns the updated usage. It is called when
+// the objects of a session recording are written to the storage bucket.
+func (r *Repository) AddStorageBucketUsage(ctx context.Context, storageBucketId string, bytes, objects uint64) (*Usage, error) {
+	const op = "plugin.(Repository).AddStorageBucketUsage"
+	if storageBucketId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing storage bucket id")
+	}
+
+	u := &Usage{}
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
+		rows, err := w.Query(ctx, addUsageQuery, []any{
+			sql.Named("storage_bucket_id", storageBucketId),
+			sql.Named("bytes", bytes),
+			sql.Named("object_count", objects),
+		})
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			if err := reader.ScanRows(ctx, rows, u); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return u, nil
+}
+
+// LookupStorageBucketUsage returns the usage of the storage bucket. A storage
+// bucket to which nothing was written has a zero usage.
+func (r *Repository) LookupStorageBucketUsage(ctx context.Context, storageBucketId string) (*Usage, error) {
+	const op = "plugin.(Repository).LookupStorageBucketUsage"
+	if storageBucketId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing storage bucket id")
+	}
+	u := &Usage{StorageBucketId: storageBucketId}
+	if err := r.reader.LookupById(ctx, u); err != nil {
+		if errors.IsNotFoundError(err) {
+			return &Usage{StorageBucketId: storageBucketId}, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return u, nil
+}
+
+// SetStorageBucketQuota creates or replaces the quota of a storage bucket.
+func (r *Repository) SetStorageBucketQuota(ctx context.Context, q *Quota) (*Quota, error) {
+	const op = "plugin.(Repository).SetStorageBucketQuota"
+	if q == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing quota")
+	}
+	if err := q.validate(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	threshold := q.WarningThresholdPercent
+	if threshold == 0 {
+		threshold = DefaultWarningThresholdPercent
+	}
+
+	ret := &Quota{}
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
+		rows, err := w.Query(ctx, upsertQuotaQuery, []any{
+			sql.Named("storage_bucket_id", q.StorageBucketId),
+			sql.Named("max_bytes", q.MaxBytes),
+			sql.Named("max_objects", q.MaxObjects),
+			sql.Named("warning_threshold_percent", threshold),
+			sql.Named("block_when_exceeded", q.BlockWhenExceeded),
+		})
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			if err := reader.ScanRows(ctx, rows, ret); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+// LookupStorageBucketQuota returns the quota of the storage bucket, or nil
+// if it has none.
+func (r *Repository) LookupStorageBucketQuota(ctx context.Context, storageBucketId string) (*Quota, error) {
+	const op = "plugin.(Repository).LookupStorageBucketQuota"
+	if storageBucketId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing storage bucket id")
+	}
+	q := &Quota{StorageBucketId: storageBucketId}
+	if err := r.reader.LookupById(ctx, q); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return q, nil
+}
+
+// DeleteStorageBucketQuota removes the quota of the storage bucket. It
+// returns the number of rows deleted, which is 0 if the storage bucket has no
+// quota.
+func (r *Repository) DeleteStorageBucketQuota(ctx context.Context, storageBucketId string) (int, error) {
+	const op = "plugin.(Repository).DeleteStorageBucketQuota"
+	if storageBucketId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing storage bucket id")
+	}
+	var rowsDeleted int
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+		var err error
+		rowsDeleted, err = w.Delete(ctx, &Quota{StorageBucketId: storageBucketId})
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return rowsDeleted, nil
+}
+
+// CheckStorageBucketQuota compares the usage of the storage bucket against its
+// quota. A warning event is emitted when the usage reached the warning
+// threshold or a limit of the quota. If a limit is reached and the quota
+// blocks new sessions when exceeded, an error with the code
+// StorageBucketQuotaExceeded is returned along with the status, so callers
+// establishing a recorded session can reject it.
+func (r *Repository) CheckStorageBucketQuota(ctx context.Context, storageBucketId string) (QuotaStatus, error) {
+	const op = "plugin.(Repository).CheckStorageBucketQuota"
+	if storageBucketId == "" {
+		return QuotaOk, errors.New(ctx, errors.InvalidParameter, op, "missing storage bucket id")
+	}
+	q, err := r.LookupStorageBucketQuota(ctx, storageBucketId)
+	if err != nil {
+		return QuotaOk, errors.Wrap(ctx, err, op)
+	}
+	if q == nil {
+		return QuotaOk, nil
+	}
+	u, err := r.LookupStorageBucketUsage(ctx, storageBucketId)
+	if err != nil {
+		return QuotaOk, errors.Wrap(ctx, err, op)
+	}
+
+	status := q.Status(u)
+	if status == QuotaOk {
+		return status, nil
+	}
+	msg := "storage bucket usage is approaching its quota"
+	if status == QuotaExceeded {
+		msg = "storage bucket usage exceeded its quota"
+	}
+	event.WriteSysEvent(ctx, op, msg,
+		"storage_bucket_id", storageBucketId,
+		"bytes", u.Bytes,
+		"max_bytes", q.MaxBytes,
+		"object_count", u.ObjectCount,
+		"max_objects", q.MaxObjects,
+		"status", status.String(),
+	)
+	if status == QuotaExceeded && q.BlockWhenExceeded {
+		return status, errors.New(ctx, errors.StorageBucketQuotaExceeded, op, fmt.Sprintf("storage bucket %s exceeded its quota", storageBucketId))
+	}
+	return status, nil
+}
@@ -55,6 +55,14 @@ type RecordingStorage interface {
 	RemoveDependant(ctx context.Context, storageBucketId string, dependantId string) error
 }
 
+// SpoolReporter can be implemented by a RecordingStorage that caches
+// recordings locally before syncing them to a storage bucket.
+type SpoolReporter interface {
+	// SpoolBacklogBytes returns the number of bytes cached locally that have
+	// not yet been synced to a storage bucket.
+	SpoolBacklogBytes(ctx context.Context) uint64
+}
+
 // Bucket is a resource that represents a bucket in an external object store
 type Bucket interface {
 	boundary.Resource
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	talias "github.com/hashicorp/boundary/internal/alias/target"
+	"github.com/hashicorp/boundary/internal/annotation"
 	intglobals "github.com/hashicorp/boundary/internal/globals"
 	"github.com/hashicorp/boundary/internal/pagination"
 	"github.com/hashicorp/boundary/internal/perms"
@@ -28,61 +29,78 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	WithName                   string
-	WithDescription            string
-	WithDefaultPort            uint32
-	WithDefaultClientPort      uint32
-	WithLimit                  int
-	WithProjectId              string
-	WithProjectIds             []string
-	WithProjectName            string
-	WithUserId                 string
-	WithType                   globals.Subtype
-	WithHostSources            []string
-	WithCredentialLibraries    []*CredentialLibrary
-	WithStaticCredentials      []*StaticCredential
-	WithSessionMaxSeconds      uint32
-	WithSessionConnectionLimit int32
-	WithPermissions            []perms.Permission
-	WithPublicId               string
-	WithWorkerFilter           string
-	WithTestWorkerFilter       string
-	WithEgressWorkerFilter     string
-	WithIngressWorkerFilter    string
-	WithTargetIds              []string
-	WithAddress                string
-	WithStorageBucketId        string
-	WithEnableSessionRecording bool
-	WithNetResolver            intglobals.NetIpResolver
-	WithStartPageAfterItem     pagination.Item
-	withAliases                []*talias.Alias
+	WithName                         string
+	WithDescription                  string
+	WithDefaultPort                  uint32
+	WithDefaultClientPort            uint32
+	WithLimit                        int
+	WithProjectId                    string
+	WithProjectIds                   []string
+	WithProjectName                  string
+	WithUserId                       string
+	WithType                         globals.Subtype
+	WithHostSources                  []string
+	WithCredentialSources            CredentialSources
+	WithCredentialLibraries          []*CredentialLibrary
+	WithStaticCredentials            []*StaticCredential
+	WithSessionMaxSeconds            uint32
+	WithSessionConnectionLimit       int32
+	WithPermissions                  []perms.Permission
+	WithPublicId                     string
+	WithWorkerFilter                 string
+	WithTestWorkerFilter             string
+	WithEgressWorkerFilter           string
+	WithIngressWorkerFilter          string
+	WithLocality                     string
+	WithAuthorizationTokenTtlSeconds uint32
+	WithAuthorizationTokenSingleUse  bool
+	WithPreferredEndpoint            string
+	WithAllowedPorts                 string
+	WithTlsPassthrough               bool
+	WithTlsServerNames               string
+	WithTargetIds                    []string
+	WithAddress                      string
+	WithStorageBucketId              string
+	WithEnableSessionRecording       bool
+	WithNetResolver                  intglobals.NetIpResolver
+	WithStartPageAfterItem           pagination.Item
+	withAliases                      []*talias.Alias
+	WithAnnotations                  annotation.Annotations
+	WithEnvironmentId                string
 }
 
 func getDefaultOptions() options {
 	return options{
-		WithName:                   "",
-		WithDescription:            "",
-		WithLimit:                  0,
-		WithDefaultPort:            0,
-		WithDefaultClientPort:      0,
-		WithProjectId:              "",
-		WithProjectIds:             nil,
-		WithProjectName:            "",
-		WithUserId:                 "",
-		WithType:                   "",
-		WithHostSources:            nil,
-		WithCredentialLibraries:    nil,
-		WithStaticCredentials:      nil,
-		WithSessionMaxSeconds:      uint32((8 * time.Hour).Seconds()),
-		WithSessionConnectionLimit: -1,
-		WithPermissions:            nil,
-		WithPublicId:               "",
-		WithWorkerFilter:           "",
-		WithTestWorkerFilter:       "",
-		WithEgressWorkerFilter:     "",
-		WithIngressWorkerFilter:    "",
-		WithAddress:                "",
-		WithNetResolver:            net.DefaultResolver,
+		WithName:                         "",
+		WithDescription:                  "",
+		WithLimit:                        0,
+		WithDefaultPort:                  0,
+		WithDefaultClientPort:            0,
+		WithProjectId:                    "",
+		WithProjectIds:                   nil,
+		WithProjectName:                  "",
+		WithUserId:                       "",
+		WithType:                         "",
+		WithHostSources:                  nil,
+		WithCredentialLibraries:          nil,
+		WithStaticCredentials:            nil,
+		WithSessionMaxSeconds:            uint32((8 * time.Hour).Seconds()),
+		WithSessionConnectionLimit:       -1,
+		WithPermissions:                  nil,
+		WithPublicId:                     "",
+		WithWorkerFilter:                 "",
+		WithTestWorkerFilter:             "",
+		WithEgressWorkerFilter:           "",
+		WithIngressWorkerFilter:          "",
+		WithLocality:                     "",
+		WithAuthorizationTokenTtlSeconds: 0,
+		WithAuthorizationTokenSingleUse:  false,
+		WithPreferredEndpoint:            "",
+		WithAllowedPorts:                 "",
+		WithTlsPassthrough:               false,
+		WithTlsServerNames:               "",
+		WithAddress:                      "",
+		WithNetResolver:                  net.DefaultResolver,
 	}
 }
 
@@ -165,6 +183,14 @@ func WithHostSources(hs []string) Option {
 	}
 }
 
+// WithCredentialSources provides an option for providing the ids of the
+// credential sources of a target, by purpose.
+func WithCredentialSources(cs CredentialSources) Option {
+	return func(o *options) {
+		o.WithCredentialSources = cs
+	}
+}
+
 // WithCredentialLibraries provides an option for providing a list of credential libraries.
 func WithCredentialLibraries(cl []*CredentialLibrary) Option {
 	return func(o *options) {
@@ -226,6 +252,61 @@ func WithIngressWorkerFilter(filter string) Option {
 	}
 }
 
+// WithLocality provides an optional locality, such as a region
+func WithLocality(locality string) Option {
+	return func(o *options) {
+		o.WithLocality = locality
+	}
+}
+
+// WithAuthorizationTokenTtlSeconds provides an optional maximum lifetime, in
+// seconds, of the authorization of sessions to the target
+func WithAuthorizationTokenTtlSeconds(seconds uint32) Option {
+	return func(o *options) {
+		o.WithAuthorizationTokenTtlSeconds = seconds
+	}
+}
+
+// WithAuthorizationTokenSingleUse provides an optional flag that makes the
+// authorization of sessions to the target single-use
+func WithAuthorizationTokenSingleUse(singleUse bool) Option {
+	return func(o *options) {
+		o.WithAuthorizationTokenSingleUse = singleUse
+	}
+}
+
+// WithPreferredEndpoint provides an optional preferred endpoint expression
+// used to choose the address of hosts from the target's host sources
+func WithPreferredEndpoint(expr string) Option {
+	return func(o *options) {
+		o.WithPreferredEndpoint = expr
+	}
+}
+
+// WithAllowedPorts provides an optional set of ports and port ranges a client
+// may choose from when connecting to the target
+func WithAllowedPorts(ports string) Option {
+	return func(o *options) {
+		o.WithAllowedPorts = ports
+	}
+}
+
+// WithTlsPassthrough provides an option to pass the TLS connections of clients
+// through to the endpoint without terminating them on the worker
+func WithTlsPassthrough(passthrough bool) Option {
+	return func(o *options) {
+		o.WithTlsPassthrough = passthrough
+	}
+}
+
+// WithTlsServerNames provides an optional set of server name patterns used to
+// route TLS passthrough connections by the SNI of the client
+func WithTlsServerNames(names string) Option {
+	return func(o *options) {
+		o.WithTlsServerNames = names
+	}
+}
+
 // WithTargetIds provides an option to search by specific target IDs
 func WithTargetIds(with []string) Option {
 	return func(o *options) {
@@ -278,6 +359,20 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 	}
 }
 
+// WithAnnotations provides an optional set of annotations.
+func WithAnnotations(annotations annotation.Annotations) Option {
+	return func(o *options) {
+		o.WithAnnotations = annotations
+	}
+}
+
+// WithEnvironmentId provides an optional environment id.
+func WithEnvironmentId(id string) Option {
+	return func(o *options) {
+		o.WithEnvironmentId = id
+	}
+}
+
 // WithAliases provides an option to provide aliases.
 func WithAliases(in []*talias.Alias) Option {
 	return func(o *options) {
@@ -166,6 +166,55 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithIngressWorkerFilter = `"/foo" == "bar"`
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithLocality", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithLocality("us-east-1"))
+		testOpts := getDefaultOptions()
+		testOpts.WithLocality = "us-east-1"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAuthorizationTokenTtlSeconds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithAuthorizationTokenTtlSeconds(60))
+		testOpts := getDefaultOptions()
+		testOpts.WithAuthorizationTokenTtlSeconds = 60
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAuthorizationTokenSingleUse", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithAuthorizationTokenSingleUse(true))
+		testOpts := getDefaultOptions()
+		testOpts.WithAuthorizationTokenSingleUse = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithPreferredEndpoint", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithPreferredEndpoint("type:private_ip,any"))
+		testOpts := getDefaultOptions()
+		testOpts.WithPreferredEndpoint = "type:private_ip,any"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAllowedPorts", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithAllowedPorts("5432,8000-8100"))
+		testOpts := getDefaultOptions()
+		testOpts.WithAllowedPorts = "5432,8000-8100"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTlsPassthrough", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithTlsPassthrough(true))
+		testOpts := getDefaultOptions()
+		testOpts.WithTlsPassthrough = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTlsServerNames", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithTlsServerNames("*.db.example.com"))
+		testOpts := getDefaultOptions()
+		testOpts.WithTlsServerNames = "*.db.example.com"
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithPermissions", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts(WithPermissions([]perms.Permission{{GrantScopeId: "test1"}, {GrantScopeId: "test2"}}))
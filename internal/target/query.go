@@ -98,7 +98,14 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         locality,
+         authorization_token_ttl_seconds,
+         authorization_token_single_use,
+         preferred_endpoint,
+         allowed_ports,
+         tls_passthrough,
+         tls_server_names
     from tcp_targets
    union
   select public_id,
@@ -117,7 +124,14 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         locality,
+         authorization_token_ttl_seconds,
+         authorization_token_single_use,
+         preferred_endpoint,
+         allowed_ports,
+         false as tls_passthrough,
+         null as tls_server_names
     from ssh_targets
 )
   select *
@@ -161,7 +175,14 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         locality,
+         authorization_token_ttl_seconds,
+         authorization_token_single_use,
+         preferred_endpoint,
+         allowed_ports,
+         tls_passthrough,
+         tls_server_names
     from tcp_targets
    union
   select public_id,
@@ -180,7 +201,14 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         locality,
+         authorization_token_ttl_seconds,
+         authorization_token_single_use,
+         preferred_endpoint,
+         allowed_ports,
+         false as tls_passthrough,
+         null as tls_server_names
     from ssh_targets
 )
   select *
@@ -224,7 +252,14 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         locality,
+         authorization_token_ttl_seconds,
+         authorization_token_single_use,
+         preferred_endpoint,
+         allowed_ports,
+         tls_passthrough,
+         tls_server_names
     from tcp_targets
    union
   select public_id,
@@ -243,7 +278,14 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         locality,
+         authorization_token_ttl_seconds,
+         authorization_token_single_use,
+         preferred_endpoint,
+         allowed_ports,
+         false as tls_passthrough,
+         null as tls_server_names
     from ssh_targets
 )
   select *
@@ -288,7 +330,14 @@ final as (
          default_client_port,
          null as storage_bucket_id,
          false as enable_session_recording,
-         'tcp' as type
+         'tcp' as type,
+         locality,
+         authorization_token_ttl_seconds,
+         authorization_token_single_use,
+         preferred_endpoint,
+         allowed_ports,
+         tls_passthrough,
+         tls_server_names
     from tcp_targets
    union
   select public_id,
@@ -307,11 +356,137 @@ final as (
          default_client_port,
          storage_bucket_id,
          enable_session_recording,
-         'ssh' as type
+         'ssh' as type,
+         locality,
+         authorization_token_ttl_seconds,
+         authorization_token_single_use,
+         preferred_endpoint,
+         allowed_ports,
+         false as tls_passthrough,
+         null as tls_server_names
     from ssh_targets
 )
   select *
     from final
 order by update_time desc, public_id desc;
+`
+
+	lookupRecordingPolicyQuery = `
+with
+target_settings (target_id, enable_session_recording, storage_bucket_id) as (
+  -- a target which supports session recording and has no storage bucket uses
+  -- the session recording settings of its environment
+     select t.public_id,
+            case when t.storage_bucket_id is null and t.type = 'ssh'
+                 then coalesce(e.enable_session_recording, false)
+                 else t.enable_session_recording
+             end,
+            case when t.storage_bucket_id is null and t.type = 'ssh'
+                 then e.storage_bucket_id
+                 else t.storage_bucket_id
+             end
+       from target_all_subtypes t
+  left join target_environment te
+         on te.target_id = t.public_id
+  left join environment e
+         on e.public_id = te.environment_id
+      where t.public_id = @target_id
+),
+target_recording (target_id, enable_session_recording, storage_bucket_id, storage_bucket_scope_id) as (
+     select ts.target_id,
+            ts.enable_session_recording,
+            ts.storage_bucket_id,
+            sb.scope_id
+       from target_settings ts
+  left join storage_plugin_storage_bucket sb
+         on sb.public_id = ts.storage_bucket_id
+)
+   select tr.target_id,
+          tr.enable_session_recording,
+          coalesce(tr.storage_bucket_id, '')                as storage_bucket_id,
+          coalesce(tr.storage_bucket_scope_id, '')          as storage_bucket_scope_id,
+          coalesce(spsp.scope_id, '')                       as policy_scope_id,
+          coalesce(sp.public_id, '')                        as storage_policy_id,
+          coalesce(sp.retain_for_days, 0)                   as retain_for_days,
+          coalesce(sp.retain_for_days_overridable, false)   as retain_for_days_overridable,
+          coalesce(sp.delete_after_days, 0)                 as delete_after_days,
+          coalesce(sp.delete_after_days_overridable, false) as delete_after_days_overridable,
+          coalesce(sp.record_percent, 0)                    as record_percent,
+          coalesce(sp.record_filter, '')                    as record_filter
+     from target_recording tr
+left join scope_policy_storage_policy spsp
+       on spsp.scope_id in (tr.storage_bucket_scope_id, 'global')
+left join policy_storage_policy sp
+       on sp.public_id = spsp.storage_policy_id;
+`
+
+	upsertRecordingParametersQuery = `
+insert into target_recording_parameters
+  (target_id, compression, compression_level, sample_percent)
+values
+  (@target_id, @compression, @compression_level, @sample_percent)
+on conflict (target_id) do update
+  set compression       = excluded.compression,
+      compression_level = excluded.compression_level,
+      sample_percent    = excluded.sample_percent
+returning target_id, compression, compression_level, sample_percent, create_time, update_time;
+`
+
+	deleteRecordingParametersQuery = `
+delete from target_recording_parameters
+      where target_id = @target_id;
+`
+
+	deleteRedactionRulesQuery = `
+delete from target_recording_redaction_rule
+      where target_id = @target_id;
+`
+
+	insertRedactionRuleQuery = `
+insert into target_recording_redaction_rule
+  (target_id, pattern)
+values
+  (@target_id, @pattern);
+`
+
+	lookupRecordingParametersQuery = `
+  select target_id, compression, compression_level, sample_percent, create_time, update_time
+    from target_recording_parameters
+   where target_id = @target_id;
+`
+
+	lookupRedactionRulesQuery = `
+  select target_id, pattern
+    from target_recording_redaction_rule
+   where target_id = @target_id
+order by pattern;
+`
+
+	listRecordingParametersQuery = `
+  select target_id, compression, compression_level, sample_percent, create_time, update_time
+    from target_recording_parameters
+order by target_id;
+`
+
+	listRedactionRulesQuery = `
+  select target_id, pattern
+    from target_recording_redaction_rule
+order by target_id, pattern;
+`
+
+	recordingParametersVersionQuery = `
+select version
+  from target_recording_parameters_version;
+`
+
+	ackRecordingParametersQuery = `
+insert into server_worker_recording_parameters_ack
+  (worker_id, version)
+values
+  (@worker_id, @version)
+on conflict (worker_id) do update
+  set version  = excluded.version,
+      ack_time = now()
+where server_worker_recording_parameters_ack.version <> excluded.version;
 `
 )
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	talias "github.com/hashicorp/boundary/internal/alias/target"
+	"github.com/hashicorp/boundary/internal/annotation"
 	"github.com/hashicorp/boundary/internal/boundary"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -137,6 +138,8 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 	var hostSources []HostSource
 	var credSources []CredentialSource
 	var aliases []*talias.Alias
+	var annotations annotation.Annotations
+	var environmentId string
 	_, err := r.writer.DoTx(
 		ctx,
 		db.StdRetryCnt,
@@ -163,6 +166,12 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 			if aliases, err = fetchTargetAliases(ctx, read, target.PublicId); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			if annotations, err = annotation.Lookup(ctx, read, targetAnnotationTable, target.PublicId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if environmentId, err = fetchEnvironmentId(ctx, read, target.PublicId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 			targetAddress, err := fetchAddress(ctx, read, target.PublicId)
 			if err != nil && !errors.IsNotFoundError(err) {
 				return errors.Wrap(ctx, err, op)
@@ -186,6 +195,8 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 	subtype.SetHostSources(hostSources)
 	subtype.SetCredentialSources(credSources)
 	subtype.SetAliases(aliases)
+	subtype.SetAnnotations(annotations)
+	subtype.SetEnvironmentId(environmentId)
 
 	return subtype, nil
 }
@@ -348,6 +359,14 @@ func (r *Repository) queryTargets(ctx context.Context, query string, args []any,
 		for _, addr := range foundAddresses {
 			addresses[addr.TargetId()] = addr.Address()
 		}
+		annotations, err := annotation.LookupAll(ctx, r, targetAnnotationTable, targetIds)
+		if err != nil {
+			return err
+		}
+		environmentIds, err := fetchEnvironmentIds(ctx, r, targetIds)
+		if err != nil {
+			return err
+		}
 
 		for _, t := range foundTargets {
 			var address string
@@ -364,6 +383,8 @@ func (r *Repository) queryTargets(ctx context.Context, query string, args []any,
 			if err != nil {
 				return err
 			}
+			subtype.SetAnnotations(annotations[t.GetPublicId()])
+			subtype.SetEnvironmentId(environmentIds[t.GetPublicId()])
 			targets = append(targets, subtype)
 		}
 		transactionTimestamp, err = r.Now(ctx)
@@ -505,7 +526,9 @@ func (r *Repository) DeleteTarget(ctx context.Context, publicId string, _ ...Opt
 
 // CreateTarget inserts into the repository and returns the new Target with
 // its list of host sets and credential libraries.
-// WithPublicId and WithAliases are supported options.
+// WithPublicId, WithAliases, WithHostSources and WithCredentialSources are
+// supported options. The host sources and credential sources are added to the
+// target in the same transaction the target is created in.
 func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Option) (Target, error) {
 	const op = "target.(Repository).CreateTarget"
 	opts := GetOpts(opt...)
@@ -529,6 +552,9 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 	if target.GetPublicId() != "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
 	}
+	if err := target.GetAnnotations().Validate(); err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid annotations: %v", err))
+	}
 
 	t := target.Clone()
 
@@ -562,6 +588,30 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 		}
 	}
 
+	var hostSources []*TargetHostSet
+	if len(opts.WithHostSources) > 0 {
+		if address != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to add host sources because a network address is directly assigned to the target")
+		}
+		hostSources = make([]*TargetHostSet, 0, len(opts.WithHostSources))
+		for _, id := range opts.WithHostSources {
+			ths, err := NewTargetHostSet(ctx, t.GetPublicId(), id)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory target host set"))
+			}
+			hostSources = append(hostSources, ths)
+		}
+	}
+
+	var credLibs []*CredentialLibrary
+	var staticCreds []*StaticCredential
+	if len(opts.WithCredentialSources.BrokeredCredentialIds) > 0 || len(opts.WithCredentialSources.InjectedApplicationCredentialIds) > 0 {
+		credLibs, staticCreds, err = r.createSources(ctx, t.GetPublicId(), t.GetType(), opts.WithCredentialSources)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
 	oplogWrapper, err := r.kms.GetWrapper(ctx, target.GetProjectId(), kms.KeyPurposeOplog)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
@@ -570,6 +620,8 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 	metadata := t.Oplog(oplog.OpType_OP_TYPE_CREATE)
 	var createdAliases []*talias.Alias
 	var returnedTarget Target
+	var currentHostSources []HostSource
+	var currentCredSources []CredentialSource
 	_, err = r.writer.DoTx(
 		ctx,
 		db.StdRetryCnt,
@@ -613,10 +665,57 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 				msgs = append(msgs, &targetAliasOplogMsg)
 			}
 
+			if len(t.GetAnnotations()) > 0 {
+				if err := annotation.Set(ctx, w, targetAnnotationTable, t.GetPublicId(), t.GetAnnotations()); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+
+			if t.GetEnvironmentId() != "" {
+				if err := setEnvironmentId(ctx, read, w, t.GetProjectId(), t.GetPublicId(), t.GetEnvironmentId()); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+
+			if len(hostSources) > 0 {
+				hostSourcesOplogMsgs := make([]*oplog.Message, 0, len(hostSources))
+				if err := w.CreateItems(ctx, hostSources, db.NewOplogMsgs(&hostSourcesOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add target host sources"))
+				}
+				msgs = append(msgs, hostSourcesOplogMsgs...)
+			}
+
+			if len(credLibs) > 0 {
+				credLibsOplogMsgs := make([]*oplog.Message, 0, len(credLibs))
+				if err := w.CreateItems(ctx, credLibs, db.NewOplogMsgs(&credLibsOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target credential library"))
+				}
+				msgs = append(msgs, credLibsOplogMsgs...)
+			}
+
+			if len(staticCreds) > 0 {
+				credStaticOplogMsgs := make([]*oplog.Message, 0, len(staticCreds))
+				if err := w.CreateItems(ctx, staticCreds, db.NewOplogMsgs(&credStaticOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target static credential"))
+				}
+				msgs = append(msgs, credStaticOplogMsgs...)
+			}
+
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
 			}
 
+			if len(hostSources) > 0 {
+				if currentHostSources, err = fetchHostSources(ctx, read, t.GetPublicId()); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current host sources"))
+				}
+			}
+			if len(credLibs) > 0 || len(staticCreds) > 0 {
+				if currentCredSources, err = fetchCredentialSources(ctx, read, t.GetPublicId()); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current credential sources"))
+				}
+			}
+
 			return nil
 		},
 	)
@@ -624,6 +723,8 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s target id", t.GetPublicId())))
 	}
 	returnedTarget.SetAliases(createdAliases)
+	returnedTarget.SetHostSources(currentHostSources)
+	returnedTarget.SetCredentialSources(currentCredSources)
 	return returnedTarget, nil
 }
 
@@ -631,8 +732,10 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 // target. fieldMaskPaths provides field_mask.proto paths for fields that should
 // be updated.  Fields will be set to NULL if the field is a zero value and
 // included in fieldMask. Name, Description, and WorkerFilter are the only
-// updatable fields. If no updatable fields are included in the fieldMaskPaths,
-// then an error is returned.
+// updatable fields. Annotations replaces all the annotations of the target.
+// EnvironmentId moves the target to another environment of its project, or
+// out of its environment if empty. If no updatable fields are included in the
+// fieldMaskPaths, then an error is returned.
 func (r *Repository) UpdateTarget(ctx context.Context, target Target, version uint32, fieldMaskPaths []string, _ ...Option) (Target, int, error) {
 	const op = "target.(Repository).UpdateTarget"
 	if target == nil {
@@ -655,8 +758,13 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		return nil, db.NoRowsAffected, err
 	}
 
+	var updateAnnotations, updateEnvironment bool
 	for _, f := range fieldMaskPaths {
 		switch {
+		case strings.EqualFold("annotations", f):
+			updateAnnotations = true
+		case strings.EqualFold("environmentid", f):
+			updateEnvironment = true
 		case strings.EqualFold("name", f):
 		case strings.EqualFold("description", f):
 		case strings.EqualFold("defaultport", f):
@@ -666,6 +774,13 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		case strings.EqualFold("workerfilter", f):
 		case strings.EqualFold("egressworkerfilter", f):
 		case strings.EqualFold("ingressworkerfilter", f):
+		case strings.EqualFold("locality", f):
+		case strings.EqualFold("authorizationtokenttlseconds", f):
+		case strings.EqualFold("authorizationtokensingleuse", f):
+		case strings.EqualFold("preferredendpoint", f):
+		case strings.EqualFold("allowedports", f):
+		case strings.EqualFold("tlspassthrough", f):
+		case strings.EqualFold("tlsservernames", f):
 		case strings.EqualFold("address", f):
 		case strings.EqualFold("storagebucketid", f):
 		case strings.EqualFold("enablesessionrecording", f):
@@ -677,25 +792,37 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			"Name":                   target.GetName(),
-			"Description":            target.GetDescription(),
-			"DefaultPort":            target.GetDefaultPort(),
-			"DefaultClientPort":      target.GetDefaultClientPort(),
-			"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
-			"SessionConnectionLimit": target.GetSessionConnectionLimit(),
-			"WorkerFilter":           target.GetWorkerFilter(),
-			"EgressWorkerFilter":     target.GetEgressWorkerFilter(),
-			"IngressWorkerFilter":    target.GetIngressWorkerFilter(),
-			"Address":                target.GetAddress(),
-			"StorageBucketId":        target.GetStorageBucketId(),
-			"EnableSessionRecording": target.GetEnableSessionRecording(),
+			"Name":                         target.GetName(),
+			"Description":                  target.GetDescription(),
+			"DefaultPort":                  target.GetDefaultPort(),
+			"DefaultClientPort":            target.GetDefaultClientPort(),
+			"SessionMaxSeconds":            target.GetSessionMaxSeconds(),
+			"SessionConnectionLimit":       target.GetSessionConnectionLimit(),
+			"WorkerFilter":                 target.GetWorkerFilter(),
+			"EgressWorkerFilter":           target.GetEgressWorkerFilter(),
+			"IngressWorkerFilter":          target.GetIngressWorkerFilter(),
+			"Locality":                     target.GetLocality(),
+			"AuthorizationTokenTtlSeconds": target.GetAuthorizationTokenTtlSeconds(),
+			"AuthorizationTokenSingleUse":  target.GetAuthorizationTokenSingleUse(),
+			"PreferredEndpoint":            target.GetPreferredEndpoint(),
+			"AllowedPorts":                 target.GetAllowedPorts(),
+			"TlsPassthrough":               target.GetTlsPassthrough(),
+			"TlsServerNames":               target.GetTlsServerNames(),
+			"Address":                      target.GetAddress(),
+			"StorageBucketId":              target.GetStorageBucketId(),
+			"EnableSessionRecording":       target.GetEnableSessionRecording(),
 		},
 		fieldMaskPaths,
-		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording"},
+		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording", "TlsPassthrough"},
 	)
-	if len(dbMask) == 0 && len(nullFields) == 0 {
+	if len(dbMask) == 0 && len(nullFields) == 0 && !updateAnnotations && !updateEnvironment {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
 	}
+	if updateAnnotations {
+		if err := target.GetAnnotations().Validate(); err != nil {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid annotations: %v", err))
+		}
+	}
 
 	// The Address field is not a part of the target schema in the database. It
 	// is a part of a different table called target_address, which is why the
@@ -726,10 +853,10 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		}
 	}
 
-	// If the Address field is the only present change, then we must still
-	// update the target's version because a target address is a child object
-	// of the target.
-	if (len(filteredDbMask) == 0 && len(filteredNullFields) == 0) && (updateAddress || deleteAddress) {
+	// If the Address, Annotations or EnvironmentId fields are the only present
+	// changes, then we must still update the target's version because target
+	// addresses, annotations and environments are child objects of the target.
+	if (len(filteredDbMask) == 0 && len(filteredNullFields) == 0) && (updateAddress || deleteAddress || updateAnnotations || updateEnvironment) {
 		target.SetVersion(version + 1)
 		filteredDbMask = append(filteredDbMask, "Version")
 	}
@@ -767,6 +894,28 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 				return errors.Wrap(ctx, err, op)
 			}
 
+			if updateAnnotations {
+				if err := annotation.Set(ctx, w, targetAnnotationTable, t.GetPublicId(), t.GetAnnotations()); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			annotations, err := annotation.Lookup(ctx, read, targetAnnotationTable, t.GetPublicId())
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			t.SetAnnotations(annotations)
+
+			if updateEnvironment {
+				if err := setEnvironmentId(ctx, read, w, t.GetProjectId(), t.GetPublicId(), t.GetEnvironmentId()); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			environmentId, err := fetchEnvironmentId(ctx, read, t.GetPublicId())
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			t.SetEnvironmentId(environmentId)
+
 			var address *Address
 			switch {
 			case updateAddress:
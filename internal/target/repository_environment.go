@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// targetEnvironment is a row of the target_environment table.
+type targetEnvironment struct {
+	TargetId      string `gorm:"primary_key"`
+	EnvironmentId string
+}
+
+// TableName returns the table name for gorm.
+func (*targetEnvironment) TableName() string {
+	return targetEnvironmentTable
+}
+
+// fetchEnvironmentIds returns the environment ids of the targets, keyed by
+// target id. Targets which don't belong to an environment are not included.
+func fetchEnvironmentIds(ctx context.Context, r db.Reader, targetIds []string) (map[string]string, error) {
+	const op = "target.fetchEnvironmentIds"
+	if len(targetIds) == 0 {
+		return nil, nil
+	}
+	var rows []*targetEnvironment
+	if err := r.SearchWhere(ctx, &rows, "target_id in (?)", []any{targetIds}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := make(map[string]string, len(rows))
+	for _, te := range rows {
+		ret[te.TargetId] = te.EnvironmentId
+	}
+	return ret, nil
+}
+
+// fetchEnvironmentId returns the environment id of the target, or an empty
+// string if the target doesn't belong to an environment.
+func fetchEnvironmentId(ctx context.Context, r db.Reader, targetId string) (string, error) {
+	const op = "target.fetchEnvironmentId"
+	ids, err := fetchEnvironmentIds(ctx, r, []string{targetId})
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return ids[targetId], nil
+}
+
+// setEnvironmentId replaces the environment of the target. An empty
+// environmentId removes the target from its environment. The environment must
+// be in the project of the target.
+func setEnvironmentId(ctx context.Context, r db.Reader, w db.Writer, projectId, targetId, environmentId string) error {
+	const op = "target.setEnvironmentId"
+	switch {
+	case projectId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case targetId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	if _, err := w.Exec(ctx, "delete from target_environment where target_id = ?", []any{targetId}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target environment"))
+	}
+	if environmentId == "" {
+		return nil
+	}
+	rows, err := r.Query(ctx, "select project_id from environment where public_id = ?", []any{environmentId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	var envProjectId string
+	for rows.Next() {
+		if err := rows.Scan(&envProjectId); err != nil {
+			_ = rows.Close()
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if envProjectId != projectId {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("environment %s not found in project %s", environmentId, projectId))
+	}
+	if _, err := w.Exec(ctx, "insert into target_environment (target_id, environment_id) values (?, ?)", []any{targetId, environmentId}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target environment"))
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/target/store"
 	"github.com/hashicorp/boundary/internal/target/targettest"
@@ -666,3 +667,126 @@ func TestRepository_SetTargetCredentialSources(t *testing.T) {
 		assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %s", err.Error())
 	})
 }
+
+func TestRepository_LookupEffectiveRecordingPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	rw := db.New(conn)
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	tg := targettest.TestNewTestTarget(ctx, t, conn, proj.GetPublicId(), "recording-policy")
+
+	got, err := repo.LookupEffectiveRecordingPolicy(ctx, tg.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, &target.EffectiveRecordingPolicy{TargetId: tg.GetPublicId()}, got)
+
+	_, err = repo.LookupEffectiveRecordingPolicy(ctx, "ttcp_doesnotexist")
+	assert.True(t, errors.IsNotFoundError(err))
+
+	_, err = repo.LookupEffectiveRecordingPolicy(ctx, "")
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_RecordingParameters(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	rw := db.New(conn)
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	tg1 := targettest.TestNewTestTarget(ctx, t, conn, proj.GetPublicId(), "recording-parameters-1")
+	tg2 := targettest.TestNewTestTarget(ctx, t, conn, proj.GetPublicId(), "recording-parameters-2")
+
+	got, err := repo.LookupRecordingParameters(ctx, tg1.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+	startVersion, params, err := repo.ListRecordingParameters(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, params)
+
+	// Setting parameters increments the version
+	set, err := repo.SetRecordingParameters(ctx, &target.RecordingParameters{
+		TargetId:         tg1.GetPublicId(),
+		Compression:      target.ZstdRecordingCompression,
+		CompressionLevel: 3,
+		RedactionRules:   []string{`token=\S+`, `(?i)password:\s*\S+`, `token=\S+`},
+		SamplePercent:    50,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{`(?i)password:\s*\S+`, `token=\S+`}, set.RedactionRules)
+	_, err = repo.SetRecordingParameters(ctx, &target.RecordingParameters{TargetId: tg2.GetPublicId()})
+	require.NoError(t, err)
+
+	got, err = repo.LookupRecordingParameters(ctx, tg1.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, set, got)
+
+	version, params, err := repo.ListRecordingParameters(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, version, startVersion)
+	require.Len(t, params, 2)
+	got2 := params[0]
+	if got2.TargetId != tg2.GetPublicId() {
+		got2 = params[1]
+	}
+	assert.Equal(t, target.DefaultRecordingCompression, got2.Compression)
+	assert.Equal(t, uint32(target.DefaultRecordingSamplePercent), got2.SamplePercent)
+	assert.Empty(t, got2.RedactionRules)
+
+	// Replacing the parameters replaces the redaction rules
+	set, err = repo.SetRecordingParameters(ctx, &target.RecordingParameters{
+		TargetId:       tg1.GetPublicId(),
+		RedactionRules: []string{`secret=\S+`},
+	})
+	require.NoError(t, err)
+	got, err = repo.LookupRecordingParameters(ctx, tg1.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, []string{`secret=\S+`}, got.RedactionRules)
+	assert.Equal(t, target.DefaultRecordingCompression, got.Compression)
+
+	_, err = repo.SetRecordingParameters(ctx, &target.RecordingParameters{TargetId: tg1.GetPublicId(), Compression: "lz4"})
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+
+	// Deleting the parameters, including by deleting the target, increments
+	// the version too
+	prev, err := repo.RecordingParametersVersion(ctx)
+	require.NoError(t, err)
+	rows, err := repo.DeleteRecordingParameters(ctx, tg1.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, rows)
+	version, err = repo.RecordingParametersVersion(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, version, prev)
+	_, err = repo.DeleteTarget(ctx, tg2.GetPublicId())
+	require.NoError(t, err)
+	prev = version
+	version, params, err = repo.ListRecordingParameters(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, version, prev)
+	assert.Empty(t, params)
+
+	// Workers acknowledge the versions they applied
+	w := server.TestKmsWorker(t, conn, wrapper)
+	acked, err := repo.AckRecordingParameters(ctx, w.GetPublicId(), version)
+	require.NoError(t, err)
+	assert.True(t, acked)
+	acked, err = repo.AckRecordingParameters(ctx, w.GetPublicId(), version)
+	require.NoError(t, err)
+	assert.False(t, acked)
+	_, err = repo.AckRecordingParameters(ctx, w.GetPublicId(), 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// The compressions of session recordings.
+const (
+	NoRecordingCompression   = "none"
+	GzipRecordingCompression = "gzip"
+	ZstdRecordingCompression = "zstd"
+)
+
+// The defaults of the recording parameters that are not set.
+const (
+	DefaultRecordingCompression   = GzipRecordingCompression
+	DefaultRecordingSamplePercent = 100
+)
+
+// maxCompressionLevels are the highest compression levels of the
+// compressions. A level of 0 uses the default level of the compression.
+var maxCompressionLevels = map[string]uint32{
+	NoRecordingCompression:   0,
+	GzipRecordingCompression: 9,
+	ZstdRecordingCompression: 22,
+}
+
+// MaxRecordingCompressionLevel returns the highest level of the compression,
+// and false if the compression is unknown.
+func MaxRecordingCompressionLevel(compression string) (uint32, bool) {
+	l, ok := maxCompressionLevels[compression]
+	return l, ok
+}
+
+// RecordingParameters tune how workers record the sessions of a target. They
+// are pushed to the workers in the response to their status requests, so
+// changing them does not require changing the configuration of the workers or
+// restarting them. Each change increments the version of the recording
+// parameters of all targets, which workers acknowledge once they applied it.
+type RecordingParameters struct {
+	// TargetId is the ID of the target.
+	TargetId string
+	// Compression is the compression of the recordings. It defaults to
+	// DefaultRecordingCompression.
+	Compression string
+	// CompressionLevel is the level of the compression. 0 uses the default
+	// level of the compression.
+	CompressionLevel uint32
+	// RedactionRules are regular expressions matching the data redacted from
+	// the recordings.
+	RedactionRules []string
+	// SamplePercent is the percentage of the sessions recorded by the storage
+	// policy that are recorded. It defaults to DefaultRecordingSamplePercent.
+	SamplePercent uint32
+	CreateTime    *timestamp.Timestamp
+	UpdateTime    *timestamp.Timestamp
+}
+
+// validate validates the parameters and sets the defaults of the parameters
+// that are not set.
+func (p *RecordingParameters) validate(ctx context.Context) error {
+	const op = "target.(RecordingParameters).validate"
+	if p.TargetId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	if p.Compression == "" {
+		p.Compression = DefaultRecordingCompression
+	}
+	maxLevel, ok := maxCompressionLevels[p.Compression]
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown compression %q", p.Compression))
+	}
+	if p.CompressionLevel > maxLevel {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("compression level of %s must be at most %d", p.Compression, maxLevel))
+	}
+	if p.SamplePercent == 0 {
+		p.SamplePercent = DefaultRecordingSamplePercent
+	}
+	if p.SamplePercent > 100 {
+		return errors.New(ctx, errors.InvalidParameter, op, "sample percent must be between 1 and 100")
+	}
+	for _, r := range p.RedactionRules {
+		if r == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "empty redaction rule")
+		}
+		if _, err := regexp.Compile(r); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid redaction rule %q: %s", r, err))
+		}
+	}
+	return nil
+}
+
+// recordingParametersRow is a row of the target_recording_parameters table.
+type recordingParametersRow struct {
+	TargetId         string
+	Compression      string
+	CompressionLevel uint32
+	SamplePercent    uint32
+	CreateTime       *timestamp.Timestamp
+	UpdateTime       *timestamp.Timestamp
+}
+
+// redactionRuleRow is a row of the target_recording_redaction_rule table.
+type redactionRuleRow struct {
+	TargetId string
+	Pattern  string
+}
+
+// SetRecordingParameters creates or replaces the recording parameters of the
+// target. Parameters that are not set take their default value.
+func (r *Repository) SetRecordingParameters(ctx context.Context, p *RecordingParameters) (*RecordingParameters, error) {
+	const op = "target.(Repository).SetRecordingParameters"
+	if p == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing recording parameters")
+	}
+	p = &RecordingParameters{
+		TargetId:         p.TargetId,
+		Compression:      p.Compression,
+		CompressionLevel: p.CompressionLevel,
+		RedactionRules:   slices.Compact(slices.Sorted(slices.Values(p.RedactionRules))),
+		SamplePercent:    p.SamplePercent,
+	}
+	if err := p.validate(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var ret *RecordingParameters
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
+		rows, err := w.Query(ctx, upsertRecordingParametersQuery, []any{
+			sql.Named("target_id", p.TargetId),
+			sql.Named("compression", p.Compression),
+			sql.Named("compression_level", p.CompressionLevel),
+			sql.Named("sample_percent", p.SamplePercent),
+		})
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		defer rows.Close()
+		var row recordingParametersRow
+		for rows.Next() {
+			if err := reader.ScanRows(ctx, rows, &row); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		rows.Close()
+
+		if _, err := w.Exec(ctx, deleteRedactionRulesQuery, []any{sql.Named("target_id", p.TargetId)}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete redaction rules"))
+		}
+		for _, rule := range p.RedactionRules {
+			if _, err := w.Exec(ctx, insertRedactionRuleQuery, []any{
+				sql.Named("target_id", p.TargetId),
+				sql.Named("pattern", rule),
+			}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create redaction rule"))
+			}
+		}
+		ret = row.toRecordingParameters()
+		ret.RedactionRules = p.RedactionRules
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
+
+// DeleteRecordingParameters removes the recording parameters of the target,
+// so its sessions are recorded with the default parameters. It returns the
+// number of rows deleted, which is 0 if the target has no recording
+// parameters.
+func (r *Repository) DeleteRecordingParameters(ctx context.Context, targetId string) (int, error) {
+	const op = "target.(Repository).DeleteRecordingParameters"
+	if targetId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	var rowsDeleted int
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+		var err error
+		rowsDeleted, err = w.Exec(ctx, deleteRecordingParametersQuery, []any{sql.Named("target_id", targetId)})
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return rowsDeleted, nil
+}
+
+// LookupRecordingParameters returns the recording parameters of the target,
+// or nil if the target has none.
+func (r *Repository) LookupRecordingParameters(ctx context.Context, targetId string) (*RecordingParameters, error) {
+	const op = "target.(Repository).LookupRecordingParameters"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	params, err := r.listRecordingParameters(ctx, lookupRecordingParametersQuery, lookupRedactionRulesQuery, sql.Named("target_id", targetId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(params) == 0 {
+		return nil, nil
+	}
+	return params[0], nil
+}
+
+// ListRecordingParameters returns the recording parameters of all targets
+// that have some, ordered by target id, along with their version. The version
+// is read first, so the parameters are at least as recent as the version.
+func (r *Repository) ListRecordingParameters(ctx context.Context) (uint64, []*RecordingParameters, error) {
+	const op = "target.(Repository).ListRecordingParameters"
+	version, err := r.RecordingParametersVersion(ctx)
+	if err != nil {
+		return 0, nil, errors.Wrap(ctx, err, op)
+	}
+	params, err := r.listRecordingParameters(ctx, listRecordingParametersQuery, listRedactionRulesQuery)
+	if err != nil {
+		return 0, nil, errors.Wrap(ctx, err, op)
+	}
+	return version, params, nil
+}
+
+// RecordingParametersVersion returns the version of the recording parameters
+// of all targets, which is incremented each time they change.
+func (r *Repository) RecordingParametersVersion(ctx context.Context) (uint64, error) {
+	const op = "target.(Repository).RecordingParametersVersion"
+	rows, err := r.reader.Query(ctx, recordingParametersVersionQuery, nil)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var version uint64
+	for rows.Next() {
+		if err := rows.Scan(&version); err != nil {
+			return 0, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	return version, nil
+}
+
+// AckRecordingParameters records that the worker applied the version of the
+// recording parameters. It reports whether the version differs from the one
+// the worker acknowledged before.
+func (r *Repository) AckRecordingParameters(ctx context.Context, workerId string, version uint64) (bool, error) {
+	const op = "target.(Repository).AckRecordingParameters"
+	switch {
+	case workerId == "":
+		return false, errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	case version == 0:
+		return false, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	var rowsAffected int
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+		var err error
+		rowsAffected, err = w.Exec(ctx, ackRecordingParametersQuery, []any{
+			sql.Named("worker_id", workerId),
+			sql.Named("version", version),
+		})
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return rowsAffected > 0, nil
+}
+
+// listRecordingParameters runs the queries returning the recording parameters
+// and redaction rules of targets with the args, and combines their results.
+func (r *Repository) listRecordingParameters(ctx context.Context, paramsQuery, rulesQuery string, args ...any) ([]*RecordingParameters, error) {
+	const op = "target.(Repository).listRecordingParameters"
+	rows, err := r.reader.Query(ctx, paramsQuery, args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var params []*RecordingParameters
+	byTarget := make(map[string]*RecordingParameters)
+	for rows.Next() {
+		var row recordingParametersRow
+		if err := r.reader.ScanRows(ctx, rows, &row); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		p := row.toRecordingParameters()
+		params = append(params, p)
+		byTarget[p.TargetId] = p
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	ruleRows, err := r.reader.Query(ctx, rulesQuery, args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer ruleRows.Close()
+	for ruleRows.Next() {
+		var row redactionRuleRow
+		if err := r.reader.ScanRows(ctx, ruleRows, &row); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if p, ok := byTarget[row.TargetId]; ok {
+			p.RedactionRules = append(p.RedactionRules, row.Pattern)
+		}
+	}
+	if err := ruleRows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return params, nil
+}
+
+func (row *recordingParametersRow) toRecordingParameters() *RecordingParameters {
+	return &RecordingParameters{
+		TargetId:         row.TargetId,
+		Compression:      row.Compression,
+		CompressionLevel: row.CompressionLevel,
+		SamplePercent:    row.SamplePercent,
+		CreateTime:       row.CreateTime,
+		UpdateTime:       row.UpdateTime,
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingParameters_validate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		in      *RecordingParameters
+		want    *RecordingParameters
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			in:   &RecordingParameters{TargetId: "ttcp_1234567890"},
+			want: &RecordingParameters{
+				TargetId:      "ttcp_1234567890",
+				Compression:   DefaultRecordingCompression,
+				SamplePercent: DefaultRecordingSamplePercent,
+			},
+		},
+		{
+			name: "valid",
+			in: &RecordingParameters{
+				TargetId:         "ttcp_1234567890",
+				Compression:      ZstdRecordingCompression,
+				CompressionLevel: 19,
+				RedactionRules:   []string{`(?i)password:\s*\S+`},
+				SamplePercent:    25,
+			},
+			want: &RecordingParameters{
+				TargetId:         "ttcp_1234567890",
+				Compression:      ZstdRecordingCompression,
+				CompressionLevel: 19,
+				RedactionRules:   []string{`(?i)password:\s*\S+`},
+				SamplePercent:    25,
+			},
+		},
+		{
+			name:    "missing-target-id",
+			in:      &RecordingParameters{},
+			wantErr: "missing target id",
+		},
+		{
+			name:    "unknown-compression",
+			in:      &RecordingParameters{TargetId: "ttcp_1234567890", Compression: "lz4"},
+			wantErr: `unknown compression "lz4"`,
+		},
+		{
+			name:    "compression-level-too-high",
+			in:      &RecordingParameters{TargetId: "ttcp_1234567890", CompressionLevel: 10},
+			wantErr: "compression level of gzip must be at most 9",
+		},
+		{
+			name:    "no-compression-level",
+			in:      &RecordingParameters{TargetId: "ttcp_1234567890", Compression: NoRecordingCompression, CompressionLevel: 1},
+			wantErr: "compression level of none must be at most 0",
+		},
+		{
+			name:    "sample-percent-too-high",
+			in:      &RecordingParameters{TargetId: "ttcp_1234567890", SamplePercent: 101},
+			wantErr: "sample percent must be between 1 and 100",
+		},
+		{
+			name:    "invalid-redaction-rule",
+			in:      &RecordingParameters{TargetId: "ttcp_1234567890", RedactionRules: []string{"("}},
+			wantErr: `invalid redaction rule "("`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.in.validate(ctx)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tt.in)
+		})
+	}
+}
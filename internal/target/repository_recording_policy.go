@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/scope"
+)
+
+// allSessionsRecordedPercent is the percentage of sessions recorded when no
+// storage policy applies to a target's storage bucket.
+const allSessionsRecordedPercent = 100
+
+// EffectiveRecordingPolicy describes what happens to the sessions of a target
+// with regard to session recording, once the settings of the target and of the
+// storage policies that apply to it have been combined. The settings are
+// applied in the following order of precedence:
+//
+//  1. The target decides whether its sessions are recorded, and to which
+//     storage bucket. A target without a storage bucket uses the session
+//     recording settings of its environment, if any. A target that does not
+//     enable session recording is never recorded, whatever the storage
+//     policies say.
+//  2. The storage policy attached to the scope of the storage bucket applies to
+//     the recordings. If the scope has no storage policy attached, the storage
+//     policy attached to the global scope applies.
+//  3. When the storage policy of an org applies, the retention and deletion
+//     periods of the global storage policy still win if it does not allow them
+//     to be overridden.
+//
+// The sampling settings of the storage policy that applies then decide which
+// sessions are recorded.
+type EffectiveRecordingPolicy struct {
+	// TargetId is the ID of the target.
+	TargetId string
+	// RecordingEnabled is set if the sessions of the target are recorded.
+	RecordingEnabled bool
+	// StorageBucketId is the ID of the storage bucket recordings are stored in.
+	StorageBucketId string
+	// StorageBucketScopeId is the ID of the scope of the storage bucket.
+	StorageBucketScopeId string
+	// StoragePolicyId is the ID of the storage policy that applies to the
+	// recordings, if any.
+	StoragePolicyId string
+	// StoragePolicyScopeId is the ID of the scope the storage policy is
+	// attached to.
+	StoragePolicyScopeId string
+	// RetainForDays is the number of days recordings must be kept for. -1
+	// means recordings are kept forever.
+	RetainForDays int32
+	// RetainForDaysScopeId is the ID of the scope whose storage policy sets
+	// RetainForDays.
+	RetainForDaysScopeId string
+	// DeleteAfterDays is the number of days after which recordings are
+	// deleted. 0 means recordings are not deleted automatically.
+	DeleteAfterDays int32
+	// DeleteAfterDaysScopeId is the ID of the scope whose storage policy sets
+	// DeleteAfterDays.
+	DeleteAfterDaysScopeId string
+	// RecordPercent is the percentage of sessions that are recorded.
+	RecordPercent int32
+	// RecordFilter is the filter sessions must match to be recorded, if any.
+	RecordFilter string
+}
+
+// recordingPolicyRow is a row returned by lookupRecordingPolicyQuery. There is
+// a row for each storage policy attached to the scope of the target's storage
+// bucket or to the global scope, or a single row with no storage policy.
+type recordingPolicyRow struct {
+	TargetId                   string
+	EnableSessionRecording     bool
+	StorageBucketId            string
+	StorageBucketScopeId       string
+	PolicyScopeId              string
+	StoragePolicyId            string
+	RetainForDays              int32
+	RetainForDaysOverridable   bool
+	DeleteAfterDays            int32
+	DeleteAfterDaysOverridable bool
+	RecordPercent              int32
+	RecordFilter               string
+}
+
+// LookupEffectiveRecordingPolicy returns the recording policy that applies to
+// the sessions of the target. See EffectiveRecordingPolicy for the order of
+// precedence of the settings. If the target is not found, a RecordNotFound
+// error is returned.
+func (r *Repository) LookupEffectiveRecordingPolicy(ctx context.Context, targetId string) (*EffectiveRecordingPolicy, error) {
+	const op = "target.(Repository).LookupEffectiveRecordingPolicy"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+
+	rows, err := r.reader.Query(ctx, lookupRecordingPolicyQuery, []any{sql.Named("target_id", targetId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var policyRows []*recordingPolicyRow
+	for rows.Next() {
+		var row recordingPolicyRow
+		if err := r.reader.ScanRows(ctx, rows, &row); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		policyRows = append(policyRows, &row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(policyRows) == 0 {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("target %s not found", targetId), errors.WithoutEvent())
+	}
+	return effectiveRecordingPolicy(policyRows), nil
+}
+
+// effectiveRecordingPolicy combines the rows returned for a target by
+// lookupRecordingPolicyQuery.
+func effectiveRecordingPolicy(rows []*recordingPolicyRow) *EffectiveRecordingPolicy {
+	t := rows[0]
+	p := &EffectiveRecordingPolicy{
+		TargetId: t.TargetId,
+	}
+	if !t.EnableSessionRecording || t.StorageBucketId == "" {
+		return p
+	}
+	p.RecordingEnabled = true
+	p.StorageBucketId = t.StorageBucketId
+	p.StorageBucketScopeId = t.StorageBucketScopeId
+	p.RecordPercent = allSessionsRecordedPercent
+
+	var bucketScopePolicy, globalPolicy *recordingPolicyRow
+	for _, row := range rows {
+		switch row.PolicyScopeId {
+		case "":
+		case scope.Global.String():
+			globalPolicy = row
+		case t.StorageBucketScopeId:
+			bucketScopePolicy = row
+		}
+	}
+
+	applied := bucketScopePolicy
+	if applied == nil {
+		applied = globalPolicy
+	}
+	if applied == nil {
+		return p
+	}
+	p.StoragePolicyId = applied.StoragePolicyId
+	p.StoragePolicyScopeId = applied.PolicyScopeId
+	p.RetainForDays, p.RetainForDaysScopeId = applied.RetainForDays, applied.PolicyScopeId
+	p.DeleteAfterDays, p.DeleteAfterDaysScopeId = applied.DeleteAfterDays, applied.PolicyScopeId
+	p.RecordFilter = applied.RecordFilter
+	if applied.RecordPercent != 0 {
+		p.RecordPercent = applied.RecordPercent
+	}
+
+	if globalPolicy != nil && applied != globalPolicy {
+		if !globalPolicy.RetainForDaysOverridable {
+			p.RetainForDays, p.RetainForDaysScopeId = globalPolicy.RetainForDays, globalPolicy.PolicyScopeId
+		}
+		if !globalPolicy.DeleteAfterDaysOverridable {
+			p.DeleteAfterDays, p.DeleteAfterDaysScopeId = globalPolicy.DeleteAfterDays, globalPolicy.PolicyScopeId
+		}
+	}
+	return p
+}
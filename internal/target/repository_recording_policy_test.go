@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package target
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectiveRecordingPolicy(t *testing.T) {
+	t.Parallel()
+
+	recordingTarget := func(policies ...*recordingPolicyRow) []*recordingPolicyRow {
+		if len(policies) == 0 {
+			policies = []*recordingPolicyRow{{}}
+		}
+		for _, p := range policies {
+			p.TargetId = "tssh_1234567890"
+			p.EnableSessionRecording = true
+			p.StorageBucketId = "sb_1234567890"
+			p.StorageBucketScopeId = "o_1234567890"
+		}
+		return policies
+	}
+	globalPolicy := func() *recordingPolicyRow {
+		return &recordingPolicyRow{
+			PolicyScopeId:              "global",
+			StoragePolicyId:            "pst_global",
+			RetainForDays:              30,
+			RetainForDaysOverridable:   true,
+			DeleteAfterDays:            60,
+			DeleteAfterDaysOverridable: true,
+			RecordPercent:              100,
+		}
+	}
+	orgPolicy := func() *recordingPolicyRow {
+		return &recordingPolicyRow{
+			PolicyScopeId:   "o_1234567890",
+			StoragePolicyId: "pst_org",
+			RetainForDays:   7,
+			DeleteAfterDays: 14,
+			RecordPercent:   50,
+			RecordFilter:    `"/user/id" == "u_1234567890"`,
+		}
+	}
+
+	tests := []struct {
+		name string
+		rows []*recordingPolicyRow
+		want *EffectiveRecordingPolicy
+	}{
+		{
+			name: "recording-disabled",
+			rows: []*recordingPolicyRow{{
+				TargetId:             "tssh_1234567890",
+				StorageBucketId:      "sb_1234567890",
+				StorageBucketScopeId: "o_1234567890",
+				PolicyScopeId:        "global",
+				StoragePolicyId:      "pst_global",
+			}},
+			want: &EffectiveRecordingPolicy{TargetId: "tssh_1234567890"},
+		},
+		{
+			name: "no-storage-policy",
+			rows: recordingTarget(),
+			want: &EffectiveRecordingPolicy{
+				TargetId:             "tssh_1234567890",
+				RecordingEnabled:     true,
+				StorageBucketId:      "sb_1234567890",
+				StorageBucketScopeId: "o_1234567890",
+				RecordPercent:        100,
+			},
+		},
+		{
+			name: "global-policy",
+			rows: recordingTarget(globalPolicy()),
+			want: &EffectiveRecordingPolicy{
+				TargetId:               "tssh_1234567890",
+				RecordingEnabled:       true,
+				StorageBucketId:        "sb_1234567890",
+				StorageBucketScopeId:   "o_1234567890",
+				StoragePolicyId:        "pst_global",
+				StoragePolicyScopeId:   "global",
+				RetainForDays:          30,
+				RetainForDaysScopeId:   "global",
+				DeleteAfterDays:        60,
+				DeleteAfterDaysScopeId: "global",
+				RecordPercent:          100,
+			},
+		},
+		{
+			name: "org-policy-overrides-global",
+			rows: recordingTarget(globalPolicy(), orgPolicy()),
+			want: &EffectiveRecordingPolicy{
+				TargetId:               "tssh_1234567890",
+				RecordingEnabled:       true,
+				StorageBucketId:        "sb_1234567890",
+				StorageBucketScopeId:   "o_1234567890",
+				StoragePolicyId:        "pst_org",
+				StoragePolicyScopeId:   "o_1234567890",
+				RetainForDays:          7,
+				RetainForDaysScopeId:   "o_1234567890",
+				DeleteAfterDays:        14,
+				DeleteAfterDaysScopeId: "o_1234567890",
+				RecordPercent:          50,
+				RecordFilter:           `"/user/id" == "u_1234567890"`,
+			},
+		},
+		{
+			name: "global-policy-not-overridable",
+			rows: func() []*recordingPolicyRow {
+				g := globalPolicy()
+				g.RetainForDaysOverridable = false
+				return recordingTarget(orgPolicy(), g)
+			}(),
+			want: &EffectiveRecordingPolicy{
+				TargetId:               "tssh_1234567890",
+				RecordingEnabled:       true,
+				StorageBucketId:        "sb_1234567890",
+				StorageBucketScopeId:   "o_1234567890",
+				StoragePolicyId:        "pst_org",
+				StoragePolicyScopeId:   "o_1234567890",
+				RetainForDays:          30,
+				RetainForDaysScopeId:   "global",
+				DeleteAfterDays:        14,
+				DeleteAfterDaysScopeId: "o_1234567890",
+				RecordPercent:          50,
+				RecordFilter:           `"/user/id" == "u_1234567890"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, effectiveRecordingPolicy(tt.rows))
+		})
+	}
+}
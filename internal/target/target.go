@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/alias/target"
+	"github.com/hashicorp/boundary/internal/annotation"
 	"github.com/hashicorp/boundary/internal/boundary"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -36,8 +37,17 @@ type Target interface {
 	GetWorkerFilter() string
 	GetEgressWorkerFilter() string
 	GetIngressWorkerFilter() string
+	GetLocality() string
+	GetAuthorizationTokenTtlSeconds() uint32
+	GetAuthorizationTokenSingleUse() bool
+	GetPreferredEndpoint() string
+	GetAllowedPorts() string
+	GetTlsPassthrough() bool
+	GetTlsServerNames() string
 	GetAddress() string
 	GetAliases() []*target.Alias
+	GetAnnotations() annotation.Annotations
+	GetEnvironmentId() string
 	GetHostSources() []HostSource
 	GetCredentialSources() []CredentialSource
 	GetStorageBucketId() string
@@ -57,8 +67,17 @@ type Target interface {
 	SetWorkerFilter(string)
 	SetEgressWorkerFilter(string)
 	SetIngressWorkerFilter(string)
+	SetLocality(string)
+	SetAuthorizationTokenTtlSeconds(uint32)
+	SetAuthorizationTokenSingleUse(bool)
+	SetPreferredEndpoint(string)
+	SetAllowedPorts(string)
+	SetTlsPassthrough(bool)
+	SetTlsServerNames(string)
 	SetAddress(string)
 	SetAliases([]*target.Alias)
+	SetAnnotations(annotation.Annotations)
+	SetEnvironmentId(string)
 	SetHostSources([]HostSource)
 	SetCredentialSources([]CredentialSource)
 	SetStorageBucketId(string)
@@ -66,8 +85,19 @@ type Target interface {
 	Oplog(op oplog.OpType) oplog.Metadata
 }
 
+// DefaultSessionMaxSeconds is the maximum lifetime of the sessions of a target
+// which does not set one. It matches the default of the session_max_seconds
+// column of the target table.
+const DefaultSessionMaxSeconds = 28800
+
 const (
 	targetsViewDefaultTable = "target_all_subtypes"
+
+	// targetAnnotationTable is the table of the annotations of targets.
+	targetAnnotationTable = "target_annotation"
+
+	// targetEnvironmentTable is the table of the environments of targets.
+	targetEnvironmentTable = "target_environment"
 )
 
 var (
@@ -186,6 +216,13 @@ func (t *targetView) targetSubtype(ctx context.Context, address string) (Target,
 	tt.SetWorkerFilter(t.WorkerFilter)
 	tt.SetEgressWorkerFilter(t.EgressWorkerFilter)
 	tt.SetIngressWorkerFilter(t.IngressWorkerFilter)
+	tt.SetLocality(t.Locality)
+	tt.SetAuthorizationTokenTtlSeconds(t.AuthorizationTokenTtlSeconds)
+	tt.SetAuthorizationTokenSingleUse(t.AuthorizationTokenSingleUse)
+	tt.SetPreferredEndpoint(t.PreferredEndpoint)
+	tt.SetAllowedPorts(t.AllowedPorts)
+	tt.SetTlsPassthrough(t.TlsPassthrough)
+	tt.SetTlsServerNames(t.TlsServerNames)
 	tt.SetAddress(address)
 	tt.SetHostSources(t.HostSource)
 	tt.SetCredentialSources(t.CredentialSources)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	talias "github.com/hashicorp/boundary/internal/alias/target"
+	"github.com/hashicorp/boundary/internal/annotation"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -36,6 +37,8 @@ type Target struct {
 	HostSource        []target.HostSource       `gorm:"-"`
 	CredentialSources []target.CredentialSource `gorm:"-"`
 	Aliases           []*talias.Alias           `gorm:"-"`
+	Annotations       annotation.Annotations    `gorm:"-"`
+	EnvironmentId     string                    `gorm:"-"`
 }
 
 var (
@@ -137,6 +140,34 @@ func (t *Target) GetIngressWorkerFilter() string {
 	return t.IngressWorkerFilter
 }
 
+func (t *Target) GetLocality() string {
+	return t.Locality
+}
+
+func (t *Target) GetAuthorizationTokenTtlSeconds() uint32 {
+	return t.AuthorizationTokenTtlSeconds
+}
+
+func (t *Target) GetAuthorizationTokenSingleUse() bool {
+	return t.AuthorizationTokenSingleUse
+}
+
+func (t *Target) GetPreferredEndpoint() string {
+	return t.PreferredEndpoint
+}
+
+func (t *Target) GetAllowedPorts() string {
+	return t.AllowedPorts
+}
+
+func (t *Target) GetTlsPassthrough() bool {
+	return t.TlsPassthrough
+}
+
+func (t *Target) GetTlsServerNames() string {
+	return t.TlsServerNames
+}
+
 func (t *Target) GetAddress() string {
 	return t.Address
 }
@@ -145,6 +176,14 @@ func (t *Target) GetAliases() []*talias.Alias {
 	return t.Aliases
 }
 
+func (t *Target) GetAnnotations() annotation.Annotations {
+	return t.Annotations
+}
+
+func (t *Target) GetEnvironmentId() string {
+	return t.EnvironmentId
+}
+
 func (t *Target) GetHostSources() []target.HostSource {
 	return t.HostSource
 }
@@ -168,6 +207,8 @@ func (t *Target) Clone() target.Target {
 		Target:            cp.(*store.Target),
 		HostSource:        t.HostSource,
 		CredentialSources: t.CredentialSources,
+		Annotations:       t.Annotations.Clone(),
+		EnvironmentId:     t.EnvironmentId,
 	}
 }
 
@@ -233,6 +274,34 @@ func (t *Target) SetIngressWorkerFilter(filter string) {
 	t.IngressWorkerFilter = filter
 }
 
+func (t *Target) SetLocality(locality string) {
+	t.Locality = locality
+}
+
+func (t *Target) SetAuthorizationTokenTtlSeconds(s uint32) {
+	t.AuthorizationTokenTtlSeconds = s
+}
+
+func (t *Target) SetAuthorizationTokenSingleUse(singleUse bool) {
+	t.AuthorizationTokenSingleUse = singleUse
+}
+
+func (t *Target) SetPreferredEndpoint(expr string) {
+	t.PreferredEndpoint = expr
+}
+
+func (t *Target) SetAllowedPorts(ports string) {
+	t.AllowedPorts = ports
+}
+
+func (t *Target) SetTlsPassthrough(passthrough bool) {
+	t.TlsPassthrough = passthrough
+}
+
+func (t *Target) SetTlsServerNames(names string) {
+	t.TlsServerNames = names
+}
+
 func (t *Target) SetAddress(a string) {
 	t.Address = a
 }
@@ -241,6 +310,14 @@ func (t *Target) SetAliases(aliases []*talias.Alias) {
 	t.Aliases = aliases
 }
 
+func (t *Target) SetAnnotations(annotations annotation.Annotations) {
+	t.Annotations = annotations
+}
+
+func (t *Target) SetEnvironmentId(id string) {
+	t.EnvironmentId = id
+}
+
 func (t *Target) SetHostSources(sources []target.HostSource) {
 	t.HostSource = sources
 }
@@ -328,18 +405,27 @@ func New(ctx context.Context, projectId string, opt ...target.Option) (target.Ta
 	}
 	t := &Target{
 		Target: &store.Target{
-			ProjectId:              projectId,
-			Name:                   opts.WithName,
-			Description:            opts.WithDescription,
-			DefaultPort:            opts.WithDefaultPort,
-			DefaultClientPort:      opts.WithDefaultClientPort,
-			SessionConnectionLimit: opts.WithSessionConnectionLimit,
-			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
-			WorkerFilter:           opts.WithWorkerFilter,
-			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
-			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			ProjectId:                    projectId,
+			Name:                         opts.WithName,
+			Description:                  opts.WithDescription,
+			DefaultPort:                  opts.WithDefaultPort,
+			DefaultClientPort:            opts.WithDefaultClientPort,
+			SessionConnectionLimit:       opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:            opts.WithSessionMaxSeconds,
+			WorkerFilter:                 opts.WithWorkerFilter,
+			EgressWorkerFilter:           opts.WithEgressWorkerFilter,
+			IngressWorkerFilter:          opts.WithIngressWorkerFilter,
+			Locality:                     opts.WithLocality,
+			AuthorizationTokenTtlSeconds: opts.WithAuthorizationTokenTtlSeconds,
+			AuthorizationTokenSingleUse:  opts.WithAuthorizationTokenSingleUse,
+			PreferredEndpoint:            opts.WithPreferredEndpoint,
+			AllowedPorts:                 opts.WithAllowedPorts,
+			TlsPassthrough:               opts.WithTlsPassthrough,
+			TlsServerNames:               opts.WithTlsServerNames,
 		},
-		Address: opts.WithAddress,
+		Address:       opts.WithAddress,
+		Annotations:   opts.WithAnnotations,
+		EnvironmentId: opts.WithEnvironmentId,
 	}
 	return t, nil
 }
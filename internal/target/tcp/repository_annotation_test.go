@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tcp_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/annotation"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_TargetAnnotations(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	ctx := context.Background()
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	want := annotation.Annotations{
+		"example.com/owner":       "team-a",
+		"example.com/cost-center": "1234",
+	}
+	tar, err := target.New(ctx, tcp.Subtype, proj.PublicId, target.WithName("annotated"), target.WithDefaultPort(22), target.WithAnnotations(want))
+	require.NoError(t, err)
+	created, err := repo.CreateTarget(ctx, tar)
+	require.NoError(t, err)
+	assert.Equal(t, want, created.GetAnnotations())
+
+	got, err := repo.LookupTarget(ctx, created.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, want, got.GetAnnotations())
+
+	t.Run("update-only-annotations", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.LookupTarget(ctx, created.GetPublicId())
+		require.NoError(err)
+		want := annotation.Annotations{"example.com/sync": "2024-01-01"}
+		got.SetAnnotations(want)
+		updated, rows, err := repo.UpdateTarget(ctx, got, got.GetVersion(), []string{"Annotations"})
+		require.NoError(err)
+		assert.Equal(1, rows)
+		assert.Equal(want, updated.GetAnnotations())
+		assert.Equal(got.GetVersion()+1, updated.GetVersion())
+
+		found, err := repo.LookupTarget(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Equal(want, found.GetAnnotations())
+	})
+	t.Run("clear-annotations", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.LookupTarget(ctx, created.GetPublicId())
+		require.NoError(err)
+		got.SetAnnotations(nil)
+		got.SetDescription("no annotations")
+		updated, _, err := repo.UpdateTarget(ctx, got, got.GetVersion(), []string{"Annotations", "Description"})
+		require.NoError(err)
+		assert.Empty(updated.GetAnnotations())
+		assert.Equal("no annotations", updated.GetDescription())
+	})
+	t.Run("too-large", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.LookupTarget(ctx, created.GetPublicId())
+		require.NoError(err)
+		got.SetAnnotations(annotation.Annotations{"big": strings.Repeat("a", annotation.MaxValueLength+1)})
+		_, _, err = repo.UpdateTarget(ctx, got, got.GetVersion(), []string{"Annotations"})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("deleted-with-target", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := repo.DeleteTarget(ctx, created.GetPublicId())
+		require.NoError(err)
+		all, err := annotation.LookupAll(ctx, rw, "target_annotation", []string{created.GetPublicId()})
+		require.NoError(err)
+		assert.Empty(all)
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tcp_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/environment"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_TargetEnvironment(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	_, otherProj := iam.TestScopes(t, iamRepo)
+	ctx := context.Background()
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	prod := environment.TestEnvironment(t, rw, proj.PublicId, environment.WithName("prod"))
+	staging := environment.TestEnvironment(t, rw, proj.PublicId, environment.WithName("staging"))
+	other := environment.TestEnvironment(t, rw, otherProj.PublicId, environment.WithName("other"))
+
+	tar, err := target.New(ctx, tcp.Subtype, proj.PublicId, target.WithName("in-env"), target.WithDefaultPort(22), target.WithEnvironmentId(prod.GetPublicId()))
+	require.NoError(t, err)
+	created, err := repo.CreateTarget(ctx, tar)
+	require.NoError(t, err)
+	assert.Equal(t, prod.GetPublicId(), created.GetEnvironmentId())
+
+	got, err := repo.LookupTarget(ctx, created.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, prod.GetPublicId(), got.GetEnvironmentId())
+
+	t.Run("create-in-other-project", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar, err := target.New(ctx, tcp.Subtype, proj.PublicId, target.WithName("wrong-env"), target.WithDefaultPort(22), target.WithEnvironmentId(other.GetPublicId()))
+		require.NoError(err)
+		_, err = repo.CreateTarget(ctx, tar)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("move-to-other-environment", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.LookupTarget(ctx, created.GetPublicId())
+		require.NoError(err)
+		got.SetEnvironmentId(staging.GetPublicId())
+		updated, rows, err := repo.UpdateTarget(ctx, got, got.GetVersion(), []string{"EnvironmentId"})
+		require.NoError(err)
+		assert.Equal(1, rows)
+		assert.Equal(staging.GetPublicId(), updated.GetEnvironmentId())
+		assert.Equal(got.GetVersion()+1, updated.GetVersion())
+
+		found, err := repo.LookupTarget(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Equal(staging.GetPublicId(), found.GetEnvironmentId())
+	})
+	t.Run("environment-deleted", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		envRepo, err := environment.NewRepository(ctx, rw, rw, testKms)
+		require.NoError(err)
+		_, err = envRepo.DeleteEnvironment(ctx, staging.GetPublicId())
+		require.NoError(err)
+
+		found, err := repo.LookupTarget(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Empty(found.GetEnvironmentId())
+	})
+	t.Run("remove-from-environment", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.LookupTarget(ctx, created.GetPublicId())
+		require.NoError(err)
+		got.SetEnvironmentId(prod.GetPublicId())
+		got, _, err = repo.UpdateTarget(ctx, got, got.GetVersion(), []string{"EnvironmentId"})
+		require.NoError(err)
+		require.Equal(prod.GetPublicId(), got.GetEnvironmentId())
+
+		got.SetEnvironmentId("")
+		updated, _, err := repo.UpdateTarget(ctx, got, got.GetVersion(), []string{"EnvironmentId"})
+		require.NoError(err)
+		assert.Empty(updated.GetEnvironmentId())
+	})
+}
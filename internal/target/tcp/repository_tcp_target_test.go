@@ -305,6 +305,99 @@ func TestRepository_CreateTarget(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid-with-locality",
+			args: args{
+				target: func() target.Target {
+					target, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+						target.WithName("valid-locality"),
+						target.WithDescription("valid-org"),
+						target.WithDefaultPort(uint32(22)),
+						target.WithLocality("us-east-1"))
+					require.NoError(t, err)
+					return target
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid-with-authorization-token-options",
+			args: args{
+				target: func() target.Target {
+					target, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+						target.WithName("valid-authorization-token-options"),
+						target.WithDescription("valid-org"),
+						target.WithDefaultPort(uint32(22)),
+						target.WithAuthorizationTokenTtlSeconds(60),
+						target.WithAuthorizationTokenSingleUse(true))
+					require.NoError(t, err)
+					return target
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid-with-preferred-endpoint",
+			args: args{
+				target: func() target.Target {
+					target, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+						target.WithName("valid-preferred-endpoint"),
+						target.WithDescription("valid-org"),
+						target.WithDefaultPort(uint32(22)),
+						target.WithPreferredEndpoint("type:public_ip,any"))
+					require.NoError(t, err)
+					return target
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid-with-allowed-ports",
+			args: args{
+				target: func() target.Target {
+					target, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+						target.WithName("valid-allowed-ports"),
+						target.WithDescription("valid-org"),
+						target.WithDefaultPort(uint32(5432)),
+						target.WithAllowedPorts("5432,6432"))
+					require.NoError(t, err)
+					return target
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid-with-tls-passthrough",
+			args: args{
+				target: func() target.Target {
+					target, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+						target.WithName("valid-tls-passthrough"),
+						target.WithDescription("valid-org"),
+						target.WithDefaultPort(uint32(5432)),
+						target.WithTlsPassthrough(true),
+						target.WithTlsServerNames("*.db.example.com"))
+					require.NoError(t, err)
+					return target
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty-locality",
+			args: args{
+				target: func() target.Target {
+					target, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+						target.WithName("empty-locality"),
+						target.WithDescription("valid-org"),
+						target.WithDefaultPort(uint32(22)),
+						target.WithLocality("  "))
+					require.NoError(t, err)
+					return target
+				}(),
+			},
+			wantErr:     true,
+			wantIsError: errors.CheckConstraint,
+		},
 		{
 			name: "deprecated-worker-filter",
 			args: args{
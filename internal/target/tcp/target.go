@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	talias "github.com/hashicorp/boundary/internal/alias/target"
+	"github.com/hashicorp/boundary/internal/annotation"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -38,6 +39,8 @@ type Target struct {
 	HostSource        []target.HostSource       `gorm:"-"`
 	CredentialSources []target.CredentialSource `gorm:"-"`
 	Aliases           []*talias.Alias           `gorm:"-"`
+	Annotations       annotation.Annotations    `gorm:"-"`
+	EnvironmentId     string                    `gorm:"-"`
 }
 
 // Ensure Target implements interfaces
@@ -57,18 +60,27 @@ func (h targetHooks) NewTarget(ctx context.Context, projectId string, opt ...tar
 	}
 	t := &Target{
 		Target: &store.Target{
-			ProjectId:              projectId,
-			Name:                   opts.WithName,
-			Description:            opts.WithDescription,
-			DefaultPort:            opts.WithDefaultPort,
-			DefaultClientPort:      opts.WithDefaultClientPort,
-			SessionConnectionLimit: opts.WithSessionConnectionLimit,
-			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
-			WorkerFilter:           opts.WithWorkerFilter,
-			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
-			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			ProjectId:                    projectId,
+			Name:                         opts.WithName,
+			Description:                  opts.WithDescription,
+			DefaultPort:                  opts.WithDefaultPort,
+			DefaultClientPort:            opts.WithDefaultClientPort,
+			SessionConnectionLimit:       opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:            opts.WithSessionMaxSeconds,
+			WorkerFilter:                 opts.WithWorkerFilter,
+			EgressWorkerFilter:           opts.WithEgressWorkerFilter,
+			IngressWorkerFilter:          opts.WithIngressWorkerFilter,
+			Locality:                     opts.WithLocality,
+			AuthorizationTokenTtlSeconds: opts.WithAuthorizationTokenTtlSeconds,
+			AuthorizationTokenSingleUse:  opts.WithAuthorizationTokenSingleUse,
+			PreferredEndpoint:            opts.WithPreferredEndpoint,
+			AllowedPorts:                 opts.WithAllowedPorts,
+			TlsPassthrough:               opts.WithTlsPassthrough,
+			TlsServerNames:               opts.WithTlsServerNames,
 		},
-		Address: opts.WithAddress,
+		Address:       opts.WithAddress,
+		Annotations:   opts.WithAnnotations,
+		EnvironmentId: opts.WithEnvironmentId,
 	}
 	return t, nil
 }
@@ -89,6 +101,8 @@ func (t *Target) Clone() target.Target {
 		HostSource:        t.HostSource,
 		CredentialSources: t.CredentialSources,
 		Aliases:           t.Aliases,
+		Annotations:       t.Annotations.Clone(),
+		EnvironmentId:     t.EnvironmentId,
 	}
 }
 
@@ -148,6 +162,14 @@ func (t *Target) GetAliases() []*talias.Alias {
 	return t.Aliases
 }
 
+func (t *Target) GetAnnotations() annotation.Annotations {
+	return t.Annotations
+}
+
+func (t *Target) GetEnvironmentId() string {
+	return t.EnvironmentId
+}
+
 func (t *Target) GetHostSources() []target.HostSource {
 	return t.HostSource
 }
@@ -231,6 +253,34 @@ func (t *Target) SetIngressWorkerFilter(filter string) {
 	t.IngressWorkerFilter = filter
 }
 
+func (t *Target) SetLocality(locality string) {
+	t.Locality = locality
+}
+
+func (t *Target) SetAuthorizationTokenTtlSeconds(s uint32) {
+	t.AuthorizationTokenTtlSeconds = s
+}
+
+func (t *Target) SetAuthorizationTokenSingleUse(singleUse bool) {
+	t.AuthorizationTokenSingleUse = singleUse
+}
+
+func (t *Target) SetPreferredEndpoint(expr string) {
+	t.PreferredEndpoint = expr
+}
+
+func (t *Target) SetAllowedPorts(ports string) {
+	t.AllowedPorts = ports
+}
+
+func (t *Target) SetTlsPassthrough(passthrough bool) {
+	t.TlsPassthrough = passthrough
+}
+
+func (t *Target) SetTlsServerNames(names string) {
+	t.TlsServerNames = names
+}
+
 func (t *Target) SetAddress(address string) {
 	t.Address = address
 }
@@ -239,6 +289,14 @@ func (t *Target) SetAliases(aliases []*talias.Alias) {
 	t.Aliases = aliases
 }
 
+func (t *Target) SetAnnotations(annotations annotation.Annotations) {
+	t.Annotations = annotations
+}
+
+func (t *Target) SetEnvironmentId(id string) {
+	t.EnvironmentId = id
+}
+
 func (t *Target) SetHostSources(sources []target.HostSource) {
 	t.HostSource = sources
 }
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package targettemplate provides the target templates of projects. A target
+// template captures the full definition of a target, including its type,
+// ports, filters, host sources and credential sources, so that creating a
+// target for a new service only requires instantiating the template with the
+// parameters that differ between services.
+//
+// The string values of a definition are Go templates, which can reference the
+// parameters supplied when the template is instantiated, such as
+// {{.service}}. The definition is stored as the JSON encoding of the API
+// target resource and is otherwise opaque to this package.
+package targettemplate
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targettemplate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// validParameterName matches the names of parameters, which have to be valid
+// Go template field names so they can be referenced as {{.name}}.
+var validParameterName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateDefinition returns an error if any string value of the definition
+// is not a valid template.
+func ValidateDefinition(ctx context.Context, definition proto.Message) error {
+	const op = "targettemplate.ValidateDefinition"
+	if definition == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing definition")
+	}
+	err := walkStrings(definition.ProtoReflect(), func(s string) (string, error) {
+		if !strings.Contains(s, "{{") {
+			return s, nil
+		}
+		if _, err := template.New("definition").Parse(s); err != nil {
+			return "", err
+		}
+		return s, nil
+	})
+	if err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid template: %s", err))
+	}
+	return nil
+}
+
+// Instantiate substitutes the parameters into the string values of the
+// definition, which is changed in place. Every parameter referenced by the
+// definition must be supplied.
+func Instantiate(ctx context.Context, definition proto.Message, parameters map[string]string) error {
+	const op = "targettemplate.Instantiate"
+	if definition == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing definition")
+	}
+	for k := range parameters {
+		if !validParameterName.MatchString(k) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid parameter name %q", k))
+		}
+	}
+	err := walkStrings(definition.ProtoReflect(), func(s string) (string, error) {
+		if !strings.Contains(s, "{{") {
+			return s, nil
+		}
+		tmpl, err := template.New("definition").Option("missingkey=error").Parse(s)
+		if err != nil {
+			return "", err
+		}
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, parameters); err != nil {
+			return "", err
+		}
+		return sb.String(), nil
+	})
+	if err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to substitute parameters: %s", err))
+	}
+	return nil
+}
+
+// walkStrings calls fn for every populated string value of m, including the
+// string values of nested messages, lists and maps, and replaces the value
+// with the result.
+func walkStrings(m protoreflect.Message, fn func(string) (string, error)) error {
+	type update struct {
+		fd protoreflect.FieldDescriptor
+		v  string
+	}
+	var updates []update
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			l := v.List()
+			for i := 0; i < l.Len() && err == nil; i++ {
+				switch fd.Kind() {
+				case protoreflect.StringKind:
+					var s string
+					if s, err = fn(l.Get(i).String()); err == nil {
+						l.Set(i, protoreflect.ValueOfString(s))
+					}
+				case protoreflect.MessageKind:
+					err = walkStrings(l.Get(i).Message(), fn)
+				}
+			}
+		case fd.IsMap():
+			mv := v.Map()
+			var keys []protoreflect.MapKey
+			mv.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, k)
+				return true
+			})
+			for _, k := range keys {
+				switch fd.MapValue().Kind() {
+				case protoreflect.StringKind:
+					var s string
+					if s, err = fn(mv.Get(k).String()); err == nil {
+						mv.Set(k, protoreflect.ValueOfString(s))
+					}
+				case protoreflect.MessageKind:
+					err = walkStrings(mv.Get(k).Message(), fn)
+				}
+				if err != nil {
+					break
+				}
+			}
+		case fd.Kind() == protoreflect.StringKind:
+			var s string
+			if s, err = fn(v.String()); err == nil {
+				updates = append(updates, update{fd: fd, v: s})
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			err = walkStrings(v.Message(), fn)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, u := range updates {
+		m.Set(u.fd, protoreflect.ValueOfString(u.v))
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targettemplate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/errors"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestInstantiate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		definition *pb.Target
+		parameters map[string]string
+		want       *pb.Target
+		wantErr    errors.Code
+	}{
+		{
+			name: "substitutes-nested-values",
+			definition: &pb.Target{
+				Type:                        "tcp",
+				Name:                        wrapperspb.String("{{.service}}-db"),
+				Address:                     wrapperspb.String("{{.service}}.{{.env}}.internal"),
+				BrokeredCredentialSourceIds: []string{"clvlt_{{.env}}"},
+			},
+			parameters: map[string]string{"service": "billing", "env": "prod"},
+			want: &pb.Target{
+				Type:                        "tcp",
+				Name:                        wrapperspb.String("billing-db"),
+				Address:                     wrapperspb.String("billing.prod.internal"),
+				BrokeredCredentialSourceIds: []string{"clvlt_prod"},
+			},
+		},
+		{
+			name: "no-parameters-referenced",
+			definition: &pb.Target{
+				Type: "tcp",
+				Name: wrapperspb.String("static"),
+			},
+			want: &pb.Target{
+				Type: "tcp",
+				Name: wrapperspb.String("static"),
+			},
+		},
+		{
+			name: "missing-parameter",
+			definition: &pb.Target{
+				Type: "tcp",
+				Name: wrapperspb.String("{{.service}}-db"),
+			},
+			parameters: map[string]string{"env": "prod"},
+			wantErr:    errors.InvalidParameter,
+		},
+		{
+			name: "invalid-parameter-name",
+			definition: &pb.Target{
+				Type: "tcp",
+			},
+			parameters: map[string]string{"not-valid": "x"},
+			wantErr:    errors.InvalidParameter,
+		},
+		{
+			name:    "nil-definition",
+			wantErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			var err error
+			if tt.definition == nil {
+				err = Instantiate(ctx, nil, tt.parameters)
+			} else {
+				err = Instantiate(ctx, tt.definition, tt.parameters)
+			}
+			if tt.wantErr != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Empty(cmp.Diff(tt.want, tt.definition, protocmp.Transform()))
+		})
+	}
+}
+
+func TestValidateDefinition(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	err := ValidateDefinition(ctx, &pb.Target{Type: "tcp", Name: wrapperspb.String("{{.service}}-db")})
+	require.NoError(t, err)
+
+	err = ValidateDefinition(ctx, &pb.Target{Type: "tcp", Name: wrapperspb.String("{{.service")})
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targettemplate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+)
+
+const (
+	nameField        = "Name"
+	descriptionField = "Description"
+	definitionField  = "Definition"
+)
+
+// A Repository stores and retrieves the persistent types in the targettemplate
+// package. It is not safe to use a repository concurrently.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+	// defaultLimit provides a default for limiting the number of results
+	// returned from the repo
+	defaultLimit int
+}
+
+// NewRepository creates a new Repository. The returned repository should
+// only be used for one transaction and it is not safe for concurrent go
+// routines to access it. WithLimit option is used as a repo wide default
+// limit applied to all ListX methods.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
+	const op = "targettemplate.NewRepository"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+
+	return &Repository{
+		reader:       r,
+		writer:       w,
+		kms:          kms,
+		defaultLimit: opts.withLimit,
+	}, nil
+}
+
+// CreateTargetTemplate inserts t into the repository and returns a new
+// TargetTemplate containing the template's PublicId. t is not changed. t must
+// contain a valid ProjectId and a Definition. t must not contain a PublicId.
+// The PublicId is generated and assigned by this method. opt is ignored.
+//
+// Both t.Name and t.Description are optional. If t.Name is set, it must be
+// unique within t.ProjectId.
+func (r *Repository) CreateTargetTemplate(ctx context.Context, t *TargetTemplate, _ ...Option) (*TargetTemplate, error) {
+	const op = "targettemplate.(Repository).CreateTargetTemplate"
+	switch {
+	case t == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil TargetTemplate")
+	case t.TargetTemplate == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded TargetTemplate")
+	case t.ProjectId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	case t.Definition == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no definition")
+	case t.PublicId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	t = t.Clone()
+
+	id, err := newTargetTemplateId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	t.PublicId = id
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, t.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newTemplate *TargetTemplate
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newTemplate = t.Clone()
+			if err := w.Create(ctx, newTemplate, db.WithOplog(oplogWrapper, t.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("in project: %s: name %s already exists", t.ProjectId, t.Name))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in project: %s", t.ProjectId)))
+	}
+	return newTemplate, nil
+}
+
+// UpdateTargetTemplate updates the repository entry for t.PublicId with the
+// values in t for the fields listed in fieldMaskPaths. It returns a new
+// TargetTemplate containing the updated values and a count of the number of
+// records updated. t is not changed.
+//
+// t must contain a valid PublicId. Name, Description and Definition can be
+// changed. If t.Name is set to a non-empty string, it must be unique within
+// t.ProjectId. The Definition cannot be set to NULL.
+//
+// An attribute of t will be set to NULL in the database if the attribute in t
+// is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateTargetTemplate(ctx context.Context, t *TargetTemplate, version uint32, fieldMaskPaths []string, _ ...Option) (*TargetTemplate, int, error) {
+	const op = "targettemplate.(Repository).UpdateTargetTemplate"
+	switch {
+	case t == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil TargetTemplate")
+	case t.TargetTemplate == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil embedded TargetTemplate")
+	case t.PublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no version")
+	case len(fieldMaskPaths) == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f):
+		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(definitionField, f):
+			if t.Definition == "" {
+				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no definition")
+			}
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:        t.Name,
+			descriptionField: t.Description,
+			definitionField:  t.Definition,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	current, err := r.LookupTargetTemplate(ctx, t.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if current == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("target template %s not found", t.PublicId), errors.WithoutEvent())
+	}
+
+	t = t.Clone()
+	t.ProjectId = current.ProjectId
+	oplogWrapper, err := r.kms.GetWrapper(ctx, t.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedTemplate *TargetTemplate
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedTemplate = t.Clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedTemplate, dbMask, nullFields,
+				db.WithOplog(oplogWrapper, t.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version),
+			)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("in project %s, name %s already exists", t.ProjectId, t.Name))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", t.PublicId)))
+	}
+	return returnedTemplate, rowsUpdated, nil
+}
+
+// LookupTargetTemplate returns the TargetTemplate for id. Returns nil, nil
+// if no TargetTemplate is found for id.
+func (r *Repository) LookupTargetTemplate(ctx context.Context, id string, _ ...Option) (*TargetTemplate, error) {
+	const op = "targettemplate.(Repository).LookupTargetTemplate"
+	if id == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	t := allocTargetTemplate()
+	t.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, t); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", id)))
+	}
+	return t, nil
+}
+
+// ListTargetTemplates returns the TargetTemplates of the projects, ordered by
+// creation time. WithLimit is the only valid option.
+func (r *Repository) ListTargetTemplates(ctx context.Context, projectIds []string, opt ...Option) ([]*TargetTemplate, error) {
+	const op = "targettemplate.(Repository).ListTargetTemplates"
+	if len(projectIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project ids")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var templates []*TargetTemplate
+	if err := r.reader.SearchWhere(ctx, &templates, "project_id in (?)", []any{projectIds}, db.WithLimit(limit), db.WithOrder("create_time asc")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return templates, nil
+}
+
+// DeleteTargetTemplate deletes id from the repository returning a count of
+// the number of records deleted. The targets created from the template are
+// not deleted.
+func (r *Repository) DeleteTargetTemplate(ctx context.Context, id string, _ ...Option) (int, error) {
+	const op = "targettemplate.(Repository).DeleteTargetTemplate"
+	if id == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+
+	t, err := r.LookupTargetTemplate(ctx, id)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if t == nil {
+		return db.NoRowsAffected, nil
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, t.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Delete(ctx, t.Clone(), db.WithOplog(oplogWrapper, t.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", id)))
+	}
+	return rowsDeleted, nil
+}
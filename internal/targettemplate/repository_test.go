@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targettemplate_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/targettemplate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_TargetTemplate(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	ctx := context.Background()
+	repo, err := targettemplate.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	const definition = `{"type":"tcp","name":"{{.service}}-db"}`
+	in, err := targettemplate.NewTargetTemplate(ctx, proj.PublicId, definition, targettemplate.WithName("db"))
+	require.NoError(t, err)
+	created, err := repo.CreateTargetTemplate(ctx, in)
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.GetPublicId())
+	assert.Equal(t, uint32(1), created.GetVersion())
+	assert.Empty(t, in.GetPublicId(), "the target template passed in must not be modified")
+
+	t.Run("create-duplicate-name", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		dup, err := targettemplate.NewTargetTemplate(ctx, proj.PublicId, definition, targettemplate.WithName("db"))
+		require.NoError(err)
+		_, err = repo.CreateTargetTemplate(ctx, dup)
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.NotUnique), err))
+	})
+	t.Run("lookup", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.LookupTargetTemplate(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Equal(created.GetName(), got.GetName())
+		assert.JSONEq(definition, got.GetDefinition())
+
+		got, err = repo.LookupTargetTemplate(ctx, "ttpl_doesntexist")
+		require.NoError(err)
+		assert.Nil(got)
+	})
+	t.Run("update", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tt := created.Clone()
+		tt.Description = "databases"
+		tt.Definition = `{"type":"tcp","name":"{{.service}}-database"}`
+		updated, rows, err := repo.UpdateTargetTemplate(ctx, tt, created.GetVersion(), []string{"Description", "Definition"})
+		require.NoError(err)
+		assert.Equal(1, rows)
+		assert.Equal("databases", updated.GetDescription())
+		assert.Equal(created.GetVersion()+1, updated.GetVersion())
+
+		got, err := repo.LookupTargetTemplate(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.JSONEq(tt.Definition, got.GetDefinition())
+
+		tt.Definition = ""
+		_, _, err = repo.UpdateTargetTemplate(ctx, tt, got.GetVersion(), []string{"Definition"})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+
+		_, _, err = repo.UpdateTargetTemplate(ctx, tt, got.GetVersion(), []string{"ProjectId"})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidFieldMask), err))
+	})
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		second := targettemplate.TestTargetTemplate(t, rw, proj.PublicId, definition, targettemplate.WithName("web"))
+		got, err := repo.ListTargetTemplates(ctx, []string{proj.PublicId})
+		require.NoError(err)
+		require.Len(got, 2)
+		assert.Equal(created.GetPublicId(), got[0].GetPublicId())
+		assert.Equal(second.GetPublicId(), got[1].GetPublicId())
+
+		got, err = repo.ListTargetTemplates(ctx, []string{proj.PublicId}, targettemplate.WithLimit(1))
+		require.NoError(err)
+		assert.Len(got, 1)
+	})
+	t.Run("delete", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		rows, err := repo.DeleteTargetTemplate(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Equal(1, rows)
+
+		rows, err = repo.DeleteTargetTemplate(ctx, created.GetPublicId())
+		require.NoError(err)
+		assert.Equal(0, rows)
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targettemplate
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/targettemplate/store"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultTargetTemplateTableName = "target_template"
+
+// A TargetTemplate captures the definition of targets created from it. It is
+// owned by a project.
+type TargetTemplate struct {
+	*store.TargetTemplate
+	tableName string `gorm:"-"`
+}
+
+// NewTargetTemplate creates a new in memory TargetTemplate in the project with
+// the definition. WithName and WithDescription are the only valid options;
+// all other options are ignored.
+func NewTargetTemplate(ctx context.Context, projectId, definition string, opt ...Option) (*TargetTemplate, error) {
+	const op = "targettemplate.NewTargetTemplate"
+	switch {
+	case projectId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	case definition == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no definition")
+	}
+	opts := getOpts(opt...)
+	return &TargetTemplate{
+		TargetTemplate: &store.TargetTemplate{
+			ProjectId:   projectId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Definition:  definition,
+		},
+	}, nil
+}
+
+func allocTargetTemplate() *TargetTemplate {
+	return &TargetTemplate{
+		TargetTemplate: &store.TargetTemplate{},
+	}
+}
+
+// Clone creates a clone of the TargetTemplate.
+func (t *TargetTemplate) Clone() *TargetTemplate {
+	cp := proto.Clone(t.TargetTemplate)
+	return &TargetTemplate{
+		TargetTemplate: cp.(*store.TargetTemplate),
+	}
+}
+
+// GetResourceType returns the resource type of the TargetTemplate.
+func (t *TargetTemplate) GetResourceType() resource.Type {
+	return resource.TargetTemplate
+}
+
+// TableName returns the table name for the TargetTemplate.
+func (t *TargetTemplate) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return defaultTargetTemplateTableName
+}
+
+// SetTableName sets the table name. If the caller attempts to set the name to
+// "" the name will be reset to the default name.
+func (t *TargetTemplate) SetTableName(n string) {
+	t.tableName = n
+}
+
+func (t *TargetTemplate) oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id": []string{t.GetPublicId()},
+		"resource-type":      []string{"target-template"},
+		"op-type":            []string{op.String()},
+		"project-id":         []string{t.GetProjectId()},
+	}
+}
+
+// newTargetTemplateId creates a new id for a target template.
+func newTargetTemplateId(ctx context.Context) (string, error) {
+	const op = "targettemplate.newTargetTemplateId"
+	id, err := db.NewPublicId(ctx, globals.TargetTemplatePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
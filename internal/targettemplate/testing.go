@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targettemplate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTargetTemplate creates a target template with the definition in the
+// project for testing.
+func TestTargetTemplate(t testing.TB, rw *db.Db, projectId, definition string, opt ...Option) *TargetTemplate {
+	t.Helper()
+	ctx := context.Background()
+
+	tt, err := NewTargetTemplate(ctx, projectId, definition, opt...)
+	require.NoError(t, err)
+	tt.PublicId, err = newTargetTemplateId(ctx)
+	require.NoError(t, err)
+	require.NoError(t, rw.Create(ctx, tt))
+	return tt
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accounts"
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/api/managedgroups"
 	"github.com/hashicorp/boundary/globals"
@@ -140,6 +141,44 @@ func TestCrudOidc(t *testing.T) {
 	require.NoError(err)
 }
 
+func TestDiffMembersOidc(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	tc := controller.NewTestController(t, nil)
+	defer tc.Shutdown()
+
+	client := tc.Client()
+	token := tc.Token()
+	client.SetToken(token.Token)
+	amResult, err := authmethods.NewClient(client).Create(tc.Context(), "oidc", "global",
+		authmethods.WithName("foo"),
+		authmethods.WithOidcAuthMethodApiUrlPrefix("https://api.com"),
+		authmethods.WithOidcAuthMethodIssuer("https://example.com"),
+		authmethods.WithOidcAuthMethodClientSecret("secret"),
+		authmethods.WithOidcAuthMethodClientId("client-id"))
+	require.NoError(err)
+	amId := amResult.Item.Id
+	acct, err := accounts.NewClient(client).Create(tc.Context(), amId, accounts.WithOidcAccountSubject("alice"))
+	require.NoError(err)
+
+	managedgroupClient := managedgroups.NewClient(client)
+	mg, err := managedgroupClient.Create(tc.Context(), amId, managedgroups.WithOidcManagedGroupFilter(`"/token/sub"=="alice"`))
+	require.NoError(err)
+
+	// An identifier that does not resolve may have been meant for a member,
+	// which the diff would then report as extra, so it fails unless
+	// unresolved identifiers are allowed.
+	identifiers := []string{"alice", "nobody"}
+	_, err = managedgroupClient.DiffMembers(tc.Context(), mg.Item.Id, identifiers)
+	require.Error(err)
+	assert.ErrorContains(err, "unresolved identifiers: nobody")
+
+	got, err := managedgroupClient.DiffMembers(tc.Context(), mg.Item.Id, identifiers, managedgroups.WithAllowUnresolvedIdentifiers(true))
+	require.NoError(err)
+	assert.Equal([]string{acct.Item.Id}, got.Diff.Missing)
+	assert.Empty(got.Diff.Extra)
+	assert.Equal([]string{"nobody"}, got.Diff.Unresolved)
+}
+
 func TestErrors(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 	tc := controller.NewTestController(t, nil)
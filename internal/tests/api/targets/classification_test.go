@@ -65,10 +65,15 @@ func TestTargets(t *testing.T) {
 							HostCatalogId: "host-catalog-id",
 						},
 					},
-					SessionMaxSeconds:           &wrapperspb.UInt32Value{Value: 0},
-					SessionConnectionLimit:      &wrapperspb.Int32Value{Value: 0},
-					EgressWorkerFilter:          &wrapperspb.StringValue{Value: "egress-worker-filter"},
-					BrokeredCredentialSourceIds: []string{"brokered-credential-source-id"},
+					SessionMaxSeconds:            &wrapperspb.UInt32Value{Value: 0},
+					SessionConnectionLimit:       &wrapperspb.Int32Value{Value: 0},
+					EgressWorkerFilter:           &wrapperspb.StringValue{Value: "egress-worker-filter"},
+					Locality:                     &wrapperspb.StringValue{Value: "locality"},
+					AuthorizationTokenTtlSeconds: &wrapperspb.UInt32Value{Value: 60},
+					AuthorizationTokenSingleUse:  &wrapperspb.BoolValue{Value: true},
+					PreferredEndpoint:            &wrapperspb.StringValue{Value: "type:private_ip,any"},
+					AllowedPorts:                 &wrapperspb.StringValue{Value: "5432,8000-8100"},
+					BrokeredCredentialSourceIds:  []string{"brokered-credential-source-id"},
 					BrokeredCredentialSources: []*pb.CredentialSource{
 						{
 							Id:                "id",
@@ -94,6 +99,8 @@ func TestTargets(t *testing.T) {
 						TcpTargetAttributes: &pb.TcpTargetAttributes{
 							DefaultPort:       &wrapperspb.UInt32Value{Value: 26},
 							DefaultClientPort: &wrapperspb.UInt32Value{Value: 27},
+							TlsPassthrough:    &wrapperspb.BoolValue{Value: true},
+							TlsServerNames:    &wrapperspb.StringValue{Value: "*.db.example.com"},
 						},
 					},
 					AuthorizedActions: []string{"action-1"},
@@ -127,10 +134,15 @@ func TestTargets(t *testing.T) {
 							HostCatalogId: "host-catalog-id",
 						},
 					},
-					SessionMaxSeconds:           &wrapperspb.UInt32Value{Value: 0},
-					SessionConnectionLimit:      &wrapperspb.Int32Value{Value: 0},
-					EgressWorkerFilter:          &wrapperspb.StringValue{Value: "egress-worker-filter"},
-					BrokeredCredentialSourceIds: []string{"brokered-credential-source-id"},
+					SessionMaxSeconds:            &wrapperspb.UInt32Value{Value: 0},
+					SessionConnectionLimit:       &wrapperspb.Int32Value{Value: 0},
+					EgressWorkerFilter:           &wrapperspb.StringValue{Value: "egress-worker-filter"},
+					Locality:                     &wrapperspb.StringValue{Value: "locality"},
+					AuthorizationTokenTtlSeconds: &wrapperspb.UInt32Value{Value: 60},
+					AuthorizationTokenSingleUse:  &wrapperspb.BoolValue{Value: true},
+					PreferredEndpoint:            &wrapperspb.StringValue{Value: "type:private_ip,any"},
+					AllowedPorts:                 &wrapperspb.StringValue{Value: "5432,8000-8100"},
+					BrokeredCredentialSourceIds:  []string{"brokered-credential-source-id"},
 					BrokeredCredentialSources: []*pb.CredentialSource{
 						{
 							Id:                "id",
@@ -156,6 +168,8 @@ func TestTargets(t *testing.T) {
 						TcpTargetAttributes: &pb.TcpTargetAttributes{
 							DefaultPort:       &wrapperspb.UInt32Value{Value: 26},
 							DefaultClientPort: &wrapperspb.UInt32Value{Value: 27},
+							TlsPassthrough:    &wrapperspb.BoolValue{Value: true},
+							TlsServerNames:    &wrapperspb.StringValue{Value: "*.db.example.com"},
 						},
 					},
 					AuthorizedActions: []string{"action-1"},
@@ -76,6 +76,24 @@ const (
 	RemoveGrantScopes                  Type = 62
 	MonthlyActiveUsers                 Type = 63
 	ListResolvableAliases              Type = 64
+	Introspect                         Type = 65
+	Revoke                             Type = 66
+	CreateWorkerActivationToken        Type = 67
+	ListWorkerActivationTokens         Type = 68
+	RevokeWorkerActivationToken        Type = 69
+	ReadLogs                           Type = 70
+	TestConnection                     Type = 71
+	RotateSecrets                      Type = 72
+	Repair                             Type = 73
+	ReadRecordingPolicy                Type = 74
+	ScopeUsage                         Type = 75
+	ListWorkerUtilization              Type = 76
+	Impersonate                        Type = 77
+	SetReadOnly                        Type = 78
+	SetTargetRules                     Type = 79
+	SetDeletionProtection              Type = 80
+	ListDanglingReferences             Type = 81
+	Validate                           Type = 82
 
 	// When adding new actions, be sure to update:
 	//
@@ -148,6 +166,24 @@ var Map = map[string]Type{
 	RemoveGrantScopes.String():                  RemoveGrantScopes,
 	MonthlyActiveUsers.String():                 MonthlyActiveUsers,
 	ListResolvableAliases.String():              ListResolvableAliases,
+	Introspect.String():                         Introspect,
+	Revoke.String():                             Revoke,
+	CreateWorkerActivationToken.String():        CreateWorkerActivationToken,
+	ListWorkerActivationTokens.String():         ListWorkerActivationTokens,
+	RevokeWorkerActivationToken.String():        RevokeWorkerActivationToken,
+	ReadLogs.String():                           ReadLogs,
+	TestConnection.String():                     TestConnection,
+	RotateSecrets.String():                      RotateSecrets,
+	Repair.String():                             Repair,
+	ReadRecordingPolicy.String():                ReadRecordingPolicy,
+	ScopeUsage.String():                         ScopeUsage,
+	ListWorkerUtilization.String():              ListWorkerUtilization,
+	Impersonate.String():                        Impersonate,
+	SetReadOnly.String():                        SetReadOnly,
+	SetTargetRules.String():                     SetTargetRules,
+	SetDeletionProtection.String():              SetDeletionProtection,
+	ListDanglingReferences.String():             ListDanglingReferences,
+	Validate.String():                           Validate,
 }
 
 var DeprecatedMap = map[string]Type{
@@ -226,6 +262,24 @@ func (a Type) String() string {
 		"remove-grant-scopes",
 		"monthly-active-users",
 		"list-resolvable-aliases",
+		"introspect",
+		"revoke",
+		"create-activation-token",
+		"list-activation-tokens",
+		"revoke-activation-token",
+		"read-logs",
+		"test-connection",
+		"rotate-secrets",
+		"repair",
+		"read-recording-policy",
+		"scope-usage",
+		"list-worker-utilization",
+		"impersonate",
+		"set-read-only",
+		"set-target-rules",
+		"set-deletion-protection",
+		"list-dangling-references",
+		"validate",
 	}[a]
 }
 
@@ -170,6 +170,78 @@ func TestAction(t *testing.T) {
 			action: ListResolvableAliases,
 			want:   "list-resolvable-aliases",
 		},
+		{
+			action: Introspect,
+			want:   "introspect",
+		},
+		{
+			action: Revoke,
+			want:   "revoke",
+		},
+		{
+			action: CreateWorkerActivationToken,
+			want:   "create-activation-token",
+		},
+		{
+			action: ListWorkerActivationTokens,
+			want:   "list-activation-tokens",
+		},
+		{
+			action: RevokeWorkerActivationToken,
+			want:   "revoke-activation-token",
+		},
+		{
+			action: ReadLogs,
+			want:   "read-logs",
+		},
+		{
+			action: TestConnection,
+			want:   "test-connection",
+		},
+		{
+			action: RotateSecrets,
+			want:   "rotate-secrets",
+		},
+		{
+			action: Repair,
+			want:   "repair",
+		},
+		{
+			action: ReadRecordingPolicy,
+			want:   "read-recording-policy",
+		},
+		{
+			action: ScopeUsage,
+			want:   "scope-usage",
+		},
+		{
+			action: ListWorkerUtilization,
+			want:   "list-worker-utilization",
+		},
+		{
+			action: Impersonate,
+			want:   "impersonate",
+		},
+		{
+			action: SetReadOnly,
+			want:   "set-read-only",
+		},
+		{
+			action: SetTargetRules,
+			want:   "set-target-rules",
+		},
+		{
+			action: SetDeletionProtection,
+			want:   "set-deletion-protection",
+		},
+		{
+			action: ListDanglingReferences,
+			want:   "list-dangling-references",
+		},
+		{
+			action: Validate,
+			want:   "validate",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
@@ -37,6 +37,13 @@ const (
 	Policy
 	Billing
 	Alias
+	ListToken
+	Feature
+	Maintenance
+	Environment
+	TargetTemplate
+	Search
+	Filter
 	// NOTE: When adding a new type, be sure to update:
 	//
 	// * The Grant.validateType function and test
@@ -78,6 +85,13 @@ func (r Type) String() string {
 		"policy",
 		"billing",
 		"alias",
+		"list-token",
+		"feature",
+		"maintenance",
+		"environment",
+		"target-template",
+		"search",
+		"filter",
 	}[r]
 }
 
@@ -138,6 +152,13 @@ var Map = map[string]Type{
 	Policy.String():            Policy,
 	Billing.String():           Billing,
 	Alias.String():             Alias,
+	ListToken.String():         ListToken,
+	Feature.String():           Feature,
+	Maintenance.String():       Maintenance,
+	Environment.String():       Environment,
+	TargetTemplate.String():    TargetTemplate,
+	Search.String():            Search,
+	Filter.String():            Filter,
 }
 
 // Parent returns the parent type for a given type; if there is no parent, it
@@ -182,6 +203,11 @@ func TopLevelType(typ Type) bool {
 		StorageBucket,
 		Policy,
 		Alias,
+		Feature,
+		Environment,
+		TargetTemplate,
+		Search,
+		Filter,
 		Worker:
 		return true
 	}
@@ -96,6 +96,39 @@ func Test_Resource(t *testing.T) {
 			want:         Alias,
 			topLevelType: true,
 		},
+		{
+			typeString: "list-token",
+			want:       ListToken,
+		},
+		{
+			typeString:   "feature",
+			want:         Feature,
+			topLevelType: true,
+		},
+		{
+			typeString: "maintenance",
+			want:       Maintenance,
+		},
+		{
+			typeString:   "environment",
+			want:         Environment,
+			topLevelType: true,
+		},
+		{
+			typeString:   "target-template",
+			want:         TargetTemplate,
+			topLevelType: true,
+		},
+		{
+			typeString:   "search",
+			want:         Search,
+			topLevelType: true,
+		},
+		{
+			typeString:   "filter",
+			want:         Filter,
+			topLevelType: true,
+		},
 		{
 			typeString:   "session",
 			want:         Session,
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// ValidateEnvironment checks the definition of environment variables set in
+// sessions: names must be valid environment variable names and values must be
+// valid templates.
+func ValidateEnvironment(ctx context.Context, env map[string]string) error {
+	const op = "util.template.ValidateEnvironment"
+	for name, value := range env {
+		if !validEnvironmentName(name) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid environment variable name %q", name))
+		}
+		if value == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("empty value for environment variable %q", name))
+		}
+		if _, err := New(ctx, value); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("invalid value for environment variable %q", name)))
+		}
+	}
+	return nil
+}
+
+// GenerateEnvironment generates the values of the environment variables from
+// their templates and the provided data, which is usually a Data struct. An
+// error is returned if any value refers to data which is not populated.
+func GenerateEnvironment(ctx context.Context, env map[string]string, data any) (map[string]string, error) {
+	const op = "util.template.GenerateEnvironment"
+	if len(env) == 0 {
+		return nil, nil
+	}
+	ret := make(map[string]string, len(env))
+	for name, value := range env {
+		parsed, err := New(ctx, value)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("invalid value for environment variable %q", name)))
+		}
+		if ret[name], err = parsed.Generate(ctx, data); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to generate environment variable %q", name)))
+		}
+	}
+	return ret, nil
+}
+
+// validEnvironmentName reports whether the name only contains letters, digits
+// and underscores and does not start with a digit, as expected by shells.
+func validEnvironmentName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, c := range name {
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c == '_':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEnvironment(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			env: map[string]string{
+				"BOUNDARY_USER": "{{ .User.Name }}",
+				"TICKET_ID":     "OPS-1234",
+				"_session2":     "{{ .Session.Id }}",
+			},
+		},
+		{
+			name:    "name starts with digit",
+			env:     map[string]string{"1USER": "{{ .User.Name }}"},
+			wantErr: `invalid environment variable name "1USER"`,
+		},
+		{
+			name:    "name with dash",
+			env:     map[string]string{"BOUNDARY-USER": "{{ .User.Name }}"},
+			wantErr: `invalid environment variable name "BOUNDARY-USER"`,
+		},
+		{
+			name:    "empty value",
+			env:     map[string]string{"BOUNDARY_USER": ""},
+			wantErr: `empty value for environment variable "BOUNDARY_USER"`,
+		},
+		{
+			name:    "bad template",
+			env:     map[string]string{"BOUNDARY_USER": "{{ .User.Name"},
+			wantErr: `invalid value for environment variable "BOUNDARY_USER"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEnvironment(ctx, tt.env)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGenerateEnvironment(t *testing.T) {
+	require, assert := require.New(t), assert.New(t)
+	ctx := context.Background()
+	data := Data{
+		User: User{
+			Id:   util.Pointer("u_1234567890"),
+			Name: util.Pointer("jdoe"),
+		},
+		Target: Target{
+			Id:   util.Pointer("tssh_1234567890"),
+			Name: util.Pointer("prod-db"),
+		},
+		Session: Session{
+			Id: util.Pointer("s_1234567890"),
+		},
+	}
+
+	out, err := GenerateEnvironment(ctx, nil, data)
+	require.NoError(err)
+	assert.Nil(out)
+
+	out, err = GenerateEnvironment(ctx, map[string]string{
+		"BOUNDARY_USER":    "{{ .User.Name }}",
+		"BOUNDARY_SESSION": "{{ .Session.Id }} ({{ .Target.Name }})",
+		"TICKET_ID":        "OPS-1234",
+	}, data)
+	require.NoError(err)
+	assert.Equal(map[string]string{
+		"BOUNDARY_USER":    "jdoe",
+		"BOUNDARY_SESSION": "s_1234567890 (prod-db)",
+		"TICKET_ID":        "OPS-1234",
+	}, out)
+
+	// Data which is not populated is an error rather than an empty value
+	_, err = GenerateEnvironment(ctx, map[string]string{"BOUNDARY_EMAIL": "{{ .User.Email }}"}, data)
+	assert.Error(err)
+}
@@ -9,6 +9,8 @@ package template
 type Data struct {
 	User    User
 	Account Account
+	Target  Target
+	Session Session
 }
 
 // User contains user information. FullName and Email are not always populated
@@ -35,3 +37,17 @@ type Account struct {
 	Subject   *string
 	Email     *string
 }
+
+// Target contains information about the target a session is for. It is only
+// populated when templating data for a session.
+type Target struct {
+	Id      *string
+	Name    *string
+	ScopeId *string
+}
+
+// Session contains session information. It is only populated when templating
+// data for a session.
+type Session struct {
+	Id *string
+}
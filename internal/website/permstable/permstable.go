@@ -413,6 +413,9 @@ var resources = map[resource.Type]info{
 	resource.CredentialStore: {
 		scopes: infraScope,
 	},
+	resource.Environment: {
+		scopes: infraScope,
+	},
 	resource.Group: {
 		scopes: append(iamScopes, infraScope...),
 	},
@@ -458,6 +461,9 @@ var resources = map[resource.Type]info{
 			action.AuthorizeSession: "Authorize a session via the target",
 		},
 	},
+	resource.TargetTemplate: {
+		scopes: infraScope,
+	},
 	resource.User: {
 		scopes: iamScopes,
 	},
@@ -54,7 +54,8 @@ type ChannelRecording struct {
 	Duration *durationpb.Duration `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty" class:"public" eventstream:"observation"` // @gotags: class:"public" eventstream:"observation"
 	// MimeTypes define the mime types that can
 	// be used to consume the recording of this Channel.
-	// The only supported mime type is "application/x-asciicast".
+	// Supported mime types are "application/x-asciicast" and "text/plain",
+	// a plain text transcript of the terminal output of an SSH Channel recording.
 	MimeTypes []string `protobuf:"bytes,9,rep,name=mime_types,proto3" json:"mime_types,omitempty" class:"public" eventstream:"observation"` // @gotags: class:"public" eventstream:"observation"
 }
 
@@ -1933,10 +1934,10 @@ type SessionRecording struct {
 	// Type of the Session that was recorded (e.g. ssh).
 	Type string `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty" class:"public" eventstream:"observation"` // @gotags: class:"public" eventstream:"observation"
 	// The current state of the session recording. One of
-	// "started", "available" and "unknown".
+	// "started", "available", "partial" and "unknown".
 	State string `protobuf:"bytes,13,opt,name=state,proto3" json:"state,omitempty" class:"public" eventstream:"observation"` // @gotags: class:"public" eventstream:"observation"
 	// Any error seen during the closing of the session recording.
-	// Currently only set if state is "unknown".
+	// Currently only set if state is "unknown" or "partial".
 	ErrorDetails string `protobuf:"bytes,14,opt,name=error_details,json=errorDetails,proto3" json:"error_details,omitempty" class:"public"` // @gotags: class:"public"
 	// MimeTypes define the mime types that can
 	// be used to consume the recording of this Session.
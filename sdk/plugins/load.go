@@ -77,8 +77,14 @@ func createPlugin(
 		append(
 			opts.withPluginOptions,
 			pluginutil.WithPluginClientCreationFunc(
-				func(pluginPath string, _ ...pluginutil.Option) (*plugin.Client, error) {
-					return NewPluginClient(pluginPath, pluginSetName, WithLogger(opts.withLogger))
+				func(pluginPath string, plgOpt ...pluginutil.Option) (*plugin.Client, error) {
+					plgOpts, err := pluginutil.GetOpts(plgOpt...)
+					if err != nil {
+						return nil, err
+					}
+					// Plugins loaded from files on disk come with the checksum
+					// they are pinned to, which is checked before execution
+					return NewPluginClient(pluginPath, pluginSetName, WithLogger(opts.withLogger), WithSecureConfig(plgOpts.WithSecureConfig), WithSandbox(opts.withSandbox))
 				}),
 		)...)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
 )
 
@@ -32,6 +33,8 @@ type Option func(*options) error
 type options struct {
 	withPluginOptions []pluginutil.Option
 	withLogger        hclog.Logger
+	withSecureConfig  *plugin.SecureConfig
+	withSandbox       *Sandbox
 }
 
 func getDefaultOptions() *options {
@@ -54,3 +57,12 @@ func WithLogger(logger hclog.Logger) Option {
 		return nil
 	}
 }
+
+// WithSecureConfig allows passing the checksum a plugin binary must match
+// before it is executed
+func WithSecureConfig(secureConfig *plugin.SecureConfig) Option {
+	return func(o *options) error {
+		o.withSecureConfig = secureConfig
+		return nil
+	}
+}
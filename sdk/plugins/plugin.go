@@ -89,17 +89,34 @@ func NewPluginClient(pluginPath, setName string, opt ...Option) (*plugin.Client,
 		set = plugin.PluginSet{storageServicePluginSetName: &storagePlugin{}}
 	}
 
+	cmd := exec.Command(pluginPath)
+	secureConfig := opts.withSecureConfig
+	var socketCfg *plugin.UnixSocketConfig
+	if opts.withSandbox != nil {
+		cmd, socketCfg, err = opts.withSandbox.command(pluginPath, secureConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error sandboxing plugin: %w", err)
+		}
+		if opts.withSandbox.needsLauncher() {
+			// The checksum of the plugin has been verified, and the command
+			// now runs the launcher
+			secureConfig = nil
+		}
+	}
+
 	return plugin.NewClient(&plugin.ClientConfig{
 		HandshakeConfig: HandshakeConfig,
 		VersionedPlugins: map[int]plugin.PluginSet{
 			1: set,
 		},
-		Cmd: exec.Command(pluginPath),
+		Cmd: cmd,
 		AllowedProtocols: []plugin.Protocol{
 			plugin.ProtocolGRPC,
 		},
-		Logger:   opts.withLogger,
-		AutoMTLS: true,
+		Logger:           opts.withLogger,
+		AutoMTLS:         true,
+		SecureConfig:     secureConfig,
+		UnixSocketConfig: socketCfg,
 	}), nil
 }
 
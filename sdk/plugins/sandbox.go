@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package external_plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/hashicorp/go-plugin"
+)
+
+// sandboxEnv is the environment variable a sandbox launcher is started with.
+// It holds the JSON encoding of the Sandbox to apply.
+const sandboxEnv = "BOUNDARY_PLUGIN_SANDBOX"
+
+// Sandbox reduces the privileges of plugin processes, to limit what a
+// compromised plugin can do on the host. Not every setting is available on
+// every platform; Validate reports the ones that are not.
+//
+// Applying an AppArmor profile or a seccomp filter on Linux, or a job object
+// on Windows, requires a launcher process: the host program re-executes
+// itself, applies the restrictions and then runs the plugin. Programs that
+// sandbox plugins must call RunSandboxLauncher at the start of main.
+type Sandbox struct {
+	// User is the name or uid of the user plugins run as. Starting plugins as
+	// another user requires the host to have the privilege to change its
+	// user, e.g. CAP_SETUID and CAP_SETGID on Linux. Not supported on
+	// Windows.
+	User string `json:"user,omitempty"`
+
+	// Group is the name or gid of the group plugins run as. It defaults to
+	// the primary group of User. The plugin binary and the socket the host
+	// connects to are shared through this group, so the host must be a
+	// member of it.
+	Group string `json:"group,omitempty"`
+
+	// AppArmorProfile is the AppArmor profile plugins are confined by. The
+	// profile must be loaded. Linux only.
+	AppArmorProfile string `json:"apparmor_profile,omitempty"`
+
+	// SeccompFilter is the path of a compiled seccomp BPF program, such as
+	// one exported with libseccomp's seccomp_export_bpf, that filters the
+	// system calls of plugins. The filter must allow the plugin to be
+	// executed. Linux only.
+	SeccompFilter string `json:"seccomp_filter,omitempty"`
+
+	// MemoryLimitBytes limits the memory committed by a plugin, through the
+	// job object it runs in. Windows only.
+	MemoryLimitBytes uint64 `json:"memory_limit_bytes,omitempty"`
+
+	// ProcessLimit limits the number of processes a plugin can run, itself
+	// included, through the job object it runs in. Windows only.
+	ProcessLimit uint32 `json:"process_limit,omitempty"`
+}
+
+// WithSandbox allows running plugins with reduced privileges
+func WithSandbox(sandbox *Sandbox) Option {
+	return func(o *options) error {
+		o.withSandbox = sandbox
+		return nil
+	}
+}
+
+// RunSandboxLauncher runs the plugin given to a sandbox launcher, if the
+// process was started as one, and exits with the plugin's status. Otherwise it
+// returns immediately. It must be called at the start of main by programs
+// sandboxing plugins, before anything is written to stdout, which carries the
+// plugin handshake.
+func RunSandboxLauncher() {
+	v, ok := os.LookupEnv(sandboxEnv)
+	if !ok {
+		return
+	}
+	// The plugin must not see the variable, or it would act as a launcher
+	// itself if it's built with this package
+	os.Unsetenv(sandboxEnv)
+
+	var s Sandbox
+	if err := json.Unmarshal([]byte(v), &s); err != nil {
+		fmt.Fprintf(os.Stderr, "error decoding plugin sandbox: %v\n", err)
+		os.Exit(1)
+	}
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "plugin sandbox launcher expects the path of the plugin as its only argument")
+		os.Exit(1)
+	}
+	code, err := s.launch(os.Args[1])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error launching sandboxed plugin: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(code)
+}
+
+// command returns the command running the plugin at pluginPath in the
+// sandbox, through a launcher if needed. The checksum of the plugin is checked
+// here when a launcher is used, since go-plugin would check the launcher's.
+func (s *Sandbox) command(pluginPath string, secureConfig *plugin.SecureConfig) (*exec.Cmd, *plugin.UnixSocketConfig, error) {
+	if err := s.Validate(); err != nil {
+		return nil, nil, err
+	}
+	cmd := exec.Command(pluginPath)
+	if s.needsLauncher() {
+		if secureConfig != nil {
+			ok, err := secureConfig.Check(pluginPath)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error verifying checksum: %w", err)
+			}
+			if !ok {
+				return nil, nil, plugin.ErrChecksumsDoNotMatch
+			}
+		}
+		self, err := os.Executable()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error finding plugin sandbox launcher: %w", err)
+		}
+		b, err := json.Marshal(s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error encoding plugin sandbox: %w", err)
+		}
+		cmd = exec.Command(self, pluginPath)
+		cmd.Env = []string{fmt.Sprintf("%s=%s", sandboxEnv, b)}
+	}
+	socketCfg, err := s.configure(cmd, pluginPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cmd, socketCfg, nil
+}
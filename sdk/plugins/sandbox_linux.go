@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build linux
+// +build linux
+
+package external_plugins
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// sockFilterSize is the size of a seccomp BPF instruction.
+const sockFilterSize = 8
+
+// Validate returns an error if the sandbox uses settings unsupported on this
+// platform.
+func (s *Sandbox) Validate() error {
+	if s.MemoryLimitBytes != 0 || s.ProcessLimit != 0 {
+		return errors.New("plugin memory and process limits are only supported on Windows")
+	}
+	return nil
+}
+
+func (s *Sandbox) needsLauncher() bool {
+	return s.AppArmorProfile != "" || s.SeccompFilter != ""
+}
+
+// launch confines the launcher with the AppArmor profile and seccomp filter,
+// and replaces it with the plugin, which inherits them.
+func (s *Sandbox) launch(pluginPath string) (int, error) {
+	// Both the AppArmor transition and the seccomp filter apply to the
+	// calling thread, which must be the one executing the plugin
+	runtime.LockOSThread()
+
+	var filter []unix.SockFilter
+	if s.SeccompFilter != "" {
+		var err error
+		if filter, err = readSeccompFilter(s.SeccompFilter); err != nil {
+			return 0, err
+		}
+	}
+	if s.AppArmorProfile != "" {
+		if err := setAppArmorExecProfile(s.AppArmorProfile); err != nil {
+			return 0, err
+		}
+	}
+	if len(filter) > 0 {
+		// Installing a filter without CAP_SYS_ADMIN requires no_new_privs,
+		// which also keeps the plugin from gaining privileges through setuid
+		// binaries
+		if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+			return 0, fmt.Errorf("error setting no_new_privs: %w", err)
+		}
+		prog := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+		if err := unix.Prctl(unix.PR_SET_SECCOMP, unix.SECCOMP_MODE_FILTER, uintptr(unsafe.Pointer(&prog)), 0, 0); err != nil {
+			return 0, fmt.Errorf("error installing seccomp filter: %w", err)
+		}
+	}
+	if err := unix.Exec(pluginPath, []string{pluginPath}, os.Environ()); err != nil {
+		return 0, fmt.Errorf("error executing plugin: %w", err)
+	}
+	return 0, nil
+}
+
+// readSeccompFilter reads a compiled seccomp BPF program, a sequence of
+// struct sock_filter in the host's byte order.
+func readSeccompFilter(path string) ([]unix.SockFilter, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading seccomp filter: %w", err)
+	}
+	if len(b) == 0 || len(b)%sockFilterSize != 0 {
+		return nil, fmt.Errorf("seccomp filter %q is not a BPF program", path)
+	}
+	if len(b)/sockFilterSize > unix.BPF_MAXINSNS {
+		return nil, fmt.Errorf("seccomp filter %q has more than %d instructions", path, unix.BPF_MAXINSNS)
+	}
+	filter := make([]unix.SockFilter, 0, len(b)/sockFilterSize)
+	for i := 0; i < len(b); i += sockFilterSize {
+		filter = append(filter, unix.SockFilter{
+			Code: binary.NativeEndian.Uint16(b[i:]),
+			Jt:   b[i+2],
+			Jf:   b[i+3],
+			K:    binary.NativeEndian.Uint32(b[i+4:]),
+		})
+	}
+	return filter, nil
+}
+
+// setAppArmorExecProfile makes the next program executed by the calling
+// thread run confined by the profile.
+func setAppArmorExecProfile(profile string) error {
+	var err error
+	for _, p := range []string{"/proc/thread-self/attr/apparmor/exec", "/proc/thread-self/attr/exec"} {
+		if err = writeAttr(p, "exec "+profile); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("error setting apparmor profile %q: %w", profile, err)
+}
+
+func writeAttr(path, value string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	_, err = f.WriteString(value)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build linux
+// +build linux
+
+package external_plugins
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+func TestReadSeccompFilter(t *testing.T) {
+	dir := t.TempDir()
+	// A single "return allow" instruction
+	insn := make([]byte, sockFilterSize)
+	binary.NativeEndian.PutUint16(insn, unix.BPF_RET|unix.BPF_K)
+	binary.NativeEndian.PutUint32(insn[4:], unix.SECCOMP_RET_ALLOW)
+	p := filepath.Join(dir, "allow.bpf")
+	require.NoError(t, os.WriteFile(p, insn, 0o600))
+
+	filter, err := readSeccompFilter(p)
+	require.NoError(t, err)
+	assert.Equal(t, []unix.SockFilter{{Code: unix.BPF_RET | unix.BPF_K, K: unix.SECCOMP_RET_ALLOW}}, filter)
+
+	p = filepath.Join(dir, "truncated.bpf")
+	require.NoError(t, os.WriteFile(p, insn[:5], 0o600))
+	_, err = readSeccompFilter(p)
+	assert.ErrorContains(t, err, "is not a BPF program")
+}
+
+func TestSandboxCommand(t *testing.T) {
+	pluginPath := filepath.Join(t.TempDir(), "boundary-plugin-test")
+	require.NoError(t, os.WriteFile(pluginPath, []byte("plugin"), 0o700))
+
+	s := &Sandbox{MemoryLimitBytes: 1 << 30}
+	_, _, err := s.command(pluginPath, nil)
+	assert.ErrorContains(t, err, "only supported on Windows")
+
+	// Without a profile or filter, the plugin is run directly
+	s = &Sandbox{Group: "0"}
+	cmd, socketCfg, err := s.command(pluginPath, nil)
+	if os.Getuid() != 0 {
+		// Only root can share the plugin with a group it's not a member of
+		t.Skip("requires root")
+	}
+	require.NoError(t, err)
+	assert.Equal(t, pluginPath, cmd.Path)
+	require.NotNil(t, cmd.SysProcAttr.Credential)
+	assert.Equal(t, uint32(0), cmd.SysProcAttr.Credential.Gid)
+	assert.Equal(t, "0", socketCfg.Group)
+
+	// With one, the launcher runs the plugin
+	s = &Sandbox{AppArmorProfile: "boundary-plugin"}
+	cmd, _, err = s.command(pluginPath, nil)
+	require.NoError(t, err)
+	self, err := os.Executable()
+	require.NoError(t, err)
+	assert.Equal(t, []string{self, pluginPath}, cmd.Args)
+	require.Len(t, cmd.Env, 1)
+	assert.True(t, strings.HasPrefix(cmd.Env[0], sandboxEnv+"="))
+}
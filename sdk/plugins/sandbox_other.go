@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !linux && !windows
+// +build !linux,!windows
+
+package external_plugins
+
+import (
+	"errors"
+	"runtime"
+)
+
+// Validate returns an error if the sandbox uses settings unsupported on this
+// platform.
+func (s *Sandbox) Validate() error {
+	switch {
+	case s.AppArmorProfile != "" || s.SeccompFilter != "":
+		return errors.New("plugin apparmor profiles and seccomp filters are only supported on Linux")
+	case s.MemoryLimitBytes != 0 || s.ProcessLimit != 0:
+		return errors.New("plugin memory and process limits are only supported on Windows")
+	}
+	return nil
+}
+
+func (s *Sandbox) needsLauncher() bool {
+	return false
+}
+
+func (s *Sandbox) launch(string) (int, error) {
+	return 0, errors.New("plugin sandbox launcher is not supported on " + runtime.GOOS)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !windows
+// +build !windows
+
+package external_plugins
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"strconv"
+	"syscall"
+
+	"github.com/hashicorp/go-plugin"
+)
+
+// configure sets the user and group the command runs as. The plugin binary is
+// shared with the group, since it's written for the host only, and so is the
+// socket the plugin listens on.
+func (s *Sandbox) configure(cmd *exec.Cmd, pluginPath string) (*plugin.UnixSocketConfig, error) {
+	if s.User == "" && s.Group == "" {
+		return nil, nil
+	}
+	uid, gid, err := s.credential()
+	if err != nil {
+		return nil, err
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Credential: &syscall.Credential{Uid: uid, Gid: gid, NoSetGroups: true},
+	}
+	if err := os.Chown(pluginPath, -1, int(gid)); err != nil {
+		return nil, fmt.Errorf("error sharing plugin with group %d: %w", gid, err)
+	}
+	if err := os.Chmod(pluginPath, 0o750); err != nil {
+		return nil, fmt.Errorf("error sharing plugin with group %d: %w", gid, err)
+	}
+	return &plugin.UnixSocketConfig{Group: strconv.FormatUint(uint64(gid), 10)}, nil
+}
+
+// credential returns the uid and gid plugins run as. The uid defaults to the
+// host's and the gid to the primary group of the user.
+func (s *Sandbox) credential() (uint32, uint32, error) {
+	uid, gid := uint32(os.Getuid()), uint32(os.Getgid())
+	if s.User != "" {
+		u, err := user.Lookup(s.User)
+		if _, ok := err.(user.UnknownUserError); ok {
+			u, err = user.LookupId(s.User)
+		}
+		if err != nil {
+			return 0, 0, fmt.Errorf("error looking up plugin user %q: %w", s.User, err)
+		}
+		if uid, err = parseId(u.Uid); err != nil {
+			return 0, 0, fmt.Errorf("error parsing uid of plugin user %q: %w", s.User, err)
+		}
+		if gid, err = parseId(u.Gid); err != nil {
+			return 0, 0, fmt.Errorf("error parsing gid of plugin user %q: %w", s.User, err)
+		}
+	}
+	if s.Group != "" {
+		g, err := user.LookupGroup(s.Group)
+		if _, ok := err.(user.UnknownGroupError); ok {
+			g, err = user.LookupGroupId(s.Group)
+		}
+		if err != nil {
+			return 0, 0, fmt.Errorf("error looking up plugin group %q: %w", s.Group, err)
+		}
+		if gid, err = parseId(g.Gid); err != nil {
+			return 0, 0, fmt.Errorf("error parsing gid of plugin group %q: %w", s.Group, err)
+		}
+	}
+	return uid, gid, nil
+}
+
+func parseId(id string) (uint32, error) {
+	v, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(v), nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build windows
+// +build windows
+
+package external_plugins
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"unsafe"
+
+	"github.com/hashicorp/go-plugin"
+	"golang.org/x/sys/windows"
+)
+
+// Validate returns an error if the sandbox uses settings unsupported on this
+// platform.
+func (s *Sandbox) Validate() error {
+	switch {
+	case s.User != "" || s.Group != "":
+		return errors.New("plugin users and groups are not supported on Windows")
+	case s.AppArmorProfile != "" || s.SeccompFilter != "":
+		return errors.New("plugin apparmor profiles and seccomp filters are only supported on Linux")
+	}
+	return nil
+}
+
+// needsLauncher is always true, as plugins always run in a job object, which
+// is created by the launcher.
+func (s *Sandbox) needsLauncher() bool {
+	return true
+}
+
+func (s *Sandbox) configure(*exec.Cmd, string) (*plugin.UnixSocketConfig, error) {
+	return nil, nil
+}
+
+// launch runs the plugin in a job object the launcher belongs to. The job is
+// killed when the launcher exits, so killing the launcher kills the plugin,
+// and the plugin can't break away from it.
+func (s *Sandbox) launch(pluginPath string) (int, error) {
+	job, err := windows.CreateJobObject(nil, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error creating job object: %w", err)
+	}
+	info := windows.JOBOBJECT_EXTENDED_LIMIT_INFORMATION{
+		BasicLimitInformation: windows.JOBOBJECT_BASIC_LIMIT_INFORMATION{
+			LimitFlags: windows.JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE | windows.JOB_OBJECT_LIMIT_DIE_ON_UNHANDLED_EXCEPTION,
+		},
+	}
+	if s.MemoryLimitBytes != 0 {
+		info.BasicLimitInformation.LimitFlags |= windows.JOB_OBJECT_LIMIT_PROCESS_MEMORY
+		info.ProcessMemoryLimit = uintptr(s.MemoryLimitBytes)
+	}
+	if s.ProcessLimit != 0 {
+		// The launcher is part of the job too
+		info.BasicLimitInformation.LimitFlags |= windows.JOB_OBJECT_LIMIT_ACTIVE_PROCESS
+		info.BasicLimitInformation.ActiveProcessLimit = s.ProcessLimit + 1
+	}
+	if _, err := windows.SetInformationJobObject(job, windows.JobObjectExtendedLimitInformation, uintptr(unsafe.Pointer(&info)), uint32(unsafe.Sizeof(info))); err != nil {
+		return 0, fmt.Errorf("error setting job object limits: %w", err)
+	}
+	if err := windows.AssignProcessToJobObject(job, windows.CurrentProcess()); err != nil {
+		return 0, fmt.Errorf("error assigning launcher to job object: %w", err)
+	}
+
+	cmd := exec.Command(pluginPath)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode(), nil
+		}
+		return 0, fmt.Errorf("error running plugin: %w", err)
+	}
+	return 0, nil
+}